@@ -0,0 +1,2678 @@
+package fp
+
+import "fmt"
+import "sync"
+
+// AgentInt - holds int which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentInt struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   int
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionInt
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionInt struct {
+	f     func(int) int
+	epoch int
+}
+
+// NewAgentInt creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentInt(v int, queueSize int) *AgentInt {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentInt{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentInt(0, 100)
+//	total.Send(func(v int) int { return v + 1 })
+//	total.Await()
+func (a *AgentInt) Send(f func(int) int) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentInt(0, 1)
+//	counter.SendNested(func(v int, send func(func(int) int)) int {
+//		send(func(v int) int { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentInt) SendNested(f func(int, func(func(int) int)) int) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v int) int {
+		var held []func(int) int
+		v = f(v, func(g func(int) int) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionInt{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentInt) enqueue(f func(int) int) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionInt{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentInt) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionInt{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentInt) apply(f func(int) int, v int) (newVal int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentInt) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentInt) Deref() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentInt) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentInt) Restart(v int) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentInt64 - holds int64 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentInt64 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   int64
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionInt64
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionInt64 struct {
+	f     func(int64) int64
+	epoch int
+}
+
+// NewAgentInt64 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentInt64(v int64, queueSize int) *AgentInt64 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentInt64{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentInt64(0, 100)
+//	total.Send(func(v int64) int64 { return v + 1 })
+//	total.Await()
+func (a *AgentInt64) Send(f func(int64) int64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentInt64(0, 1)
+//	counter.SendNested(func(v int64, send func(func(int64) int64)) int64 {
+//		send(func(v int64) int64 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentInt64) SendNested(f func(int64, func(func(int64) int64)) int64) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v int64) int64 {
+		var held []func(int64) int64
+		v = f(v, func(g func(int64) int64) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionInt64{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentInt64) enqueue(f func(int64) int64) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionInt64{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentInt64) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionInt64{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentInt64) apply(f func(int64) int64, v int64) (newVal int64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentInt64) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentInt64) Deref() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentInt64) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentInt64) Restart(v int64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentInt32 - holds int32 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentInt32 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   int32
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionInt32
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionInt32 struct {
+	f     func(int32) int32
+	epoch int
+}
+
+// NewAgentInt32 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentInt32(v int32, queueSize int) *AgentInt32 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentInt32{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentInt32(0, 100)
+//	total.Send(func(v int32) int32 { return v + 1 })
+//	total.Await()
+func (a *AgentInt32) Send(f func(int32) int32) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentInt32(0, 1)
+//	counter.SendNested(func(v int32, send func(func(int32) int32)) int32 {
+//		send(func(v int32) int32 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentInt32) SendNested(f func(int32, func(func(int32) int32)) int32) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v int32) int32 {
+		var held []func(int32) int32
+		v = f(v, func(g func(int32) int32) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionInt32{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentInt32) enqueue(f func(int32) int32) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionInt32{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentInt32) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionInt32{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentInt32) apply(f func(int32) int32, v int32) (newVal int32, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentInt32) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentInt32) Deref() int32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentInt32) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentInt32) Restart(v int32) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentInt16 - holds int16 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentInt16 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   int16
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionInt16
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionInt16 struct {
+	f     func(int16) int16
+	epoch int
+}
+
+// NewAgentInt16 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentInt16(v int16, queueSize int) *AgentInt16 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentInt16{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentInt16(0, 100)
+//	total.Send(func(v int16) int16 { return v + 1 })
+//	total.Await()
+func (a *AgentInt16) Send(f func(int16) int16) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentInt16(0, 1)
+//	counter.SendNested(func(v int16, send func(func(int16) int16)) int16 {
+//		send(func(v int16) int16 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentInt16) SendNested(f func(int16, func(func(int16) int16)) int16) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v int16) int16 {
+		var held []func(int16) int16
+		v = f(v, func(g func(int16) int16) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionInt16{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentInt16) enqueue(f func(int16) int16) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionInt16{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentInt16) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionInt16{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentInt16) apply(f func(int16) int16, v int16) (newVal int16, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentInt16) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentInt16) Deref() int16 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentInt16) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentInt16) Restart(v int16) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentInt8 - holds int8 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentInt8 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   int8
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionInt8
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionInt8 struct {
+	f     func(int8) int8
+	epoch int
+}
+
+// NewAgentInt8 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentInt8(v int8, queueSize int) *AgentInt8 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentInt8{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentInt8(0, 100)
+//	total.Send(func(v int8) int8 { return v + 1 })
+//	total.Await()
+func (a *AgentInt8) Send(f func(int8) int8) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentInt8(0, 1)
+//	counter.SendNested(func(v int8, send func(func(int8) int8)) int8 {
+//		send(func(v int8) int8 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentInt8) SendNested(f func(int8, func(func(int8) int8)) int8) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v int8) int8 {
+		var held []func(int8) int8
+		v = f(v, func(g func(int8) int8) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionInt8{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentInt8) enqueue(f func(int8) int8) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionInt8{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentInt8) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionInt8{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentInt8) apply(f func(int8) int8, v int8) (newVal int8, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentInt8) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentInt8) Deref() int8 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentInt8) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentInt8) Restart(v int8) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentUint - holds uint which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentUint struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   uint
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionUint
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionUint struct {
+	f     func(uint) uint
+	epoch int
+}
+
+// NewAgentUint creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentUint(v uint, queueSize int) *AgentUint {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentUint{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentUint(0, 100)
+//	total.Send(func(v uint) uint { return v + 1 })
+//	total.Await()
+func (a *AgentUint) Send(f func(uint) uint) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentUint(0, 1)
+//	counter.SendNested(func(v uint, send func(func(uint) uint)) uint {
+//		send(func(v uint) uint { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentUint) SendNested(f func(uint, func(func(uint) uint)) uint) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v uint) uint {
+		var held []func(uint) uint
+		v = f(v, func(g func(uint) uint) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionUint{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentUint) enqueue(f func(uint) uint) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionUint{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentUint) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionUint{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentUint) apply(f func(uint) uint, v uint) (newVal uint, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentUint) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentUint) Deref() uint {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentUint) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentUint) Restart(v uint) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentUint64 - holds uint64 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentUint64 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   uint64
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionUint64
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionUint64 struct {
+	f     func(uint64) uint64
+	epoch int
+}
+
+// NewAgentUint64 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentUint64(v uint64, queueSize int) *AgentUint64 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentUint64{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentUint64(0, 100)
+//	total.Send(func(v uint64) uint64 { return v + 1 })
+//	total.Await()
+func (a *AgentUint64) Send(f func(uint64) uint64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentUint64(0, 1)
+//	counter.SendNested(func(v uint64, send func(func(uint64) uint64)) uint64 {
+//		send(func(v uint64) uint64 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentUint64) SendNested(f func(uint64, func(func(uint64) uint64)) uint64) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v uint64) uint64 {
+		var held []func(uint64) uint64
+		v = f(v, func(g func(uint64) uint64) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionUint64{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentUint64) enqueue(f func(uint64) uint64) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionUint64{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentUint64) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionUint64{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentUint64) apply(f func(uint64) uint64, v uint64) (newVal uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentUint64) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentUint64) Deref() uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentUint64) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentUint64) Restart(v uint64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentUint32 - holds uint32 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentUint32 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   uint32
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionUint32
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionUint32 struct {
+	f     func(uint32) uint32
+	epoch int
+}
+
+// NewAgentUint32 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentUint32(v uint32, queueSize int) *AgentUint32 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentUint32{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentUint32(0, 100)
+//	total.Send(func(v uint32) uint32 { return v + 1 })
+//	total.Await()
+func (a *AgentUint32) Send(f func(uint32) uint32) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentUint32(0, 1)
+//	counter.SendNested(func(v uint32, send func(func(uint32) uint32)) uint32 {
+//		send(func(v uint32) uint32 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentUint32) SendNested(f func(uint32, func(func(uint32) uint32)) uint32) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v uint32) uint32 {
+		var held []func(uint32) uint32
+		v = f(v, func(g func(uint32) uint32) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionUint32{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentUint32) enqueue(f func(uint32) uint32) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionUint32{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentUint32) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionUint32{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentUint32) apply(f func(uint32) uint32, v uint32) (newVal uint32, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentUint32) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentUint32) Deref() uint32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentUint32) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentUint32) Restart(v uint32) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentUint16 - holds uint16 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentUint16 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   uint16
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionUint16
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionUint16 struct {
+	f     func(uint16) uint16
+	epoch int
+}
+
+// NewAgentUint16 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentUint16(v uint16, queueSize int) *AgentUint16 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentUint16{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentUint16(0, 100)
+//	total.Send(func(v uint16) uint16 { return v + 1 })
+//	total.Await()
+func (a *AgentUint16) Send(f func(uint16) uint16) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentUint16(0, 1)
+//	counter.SendNested(func(v uint16, send func(func(uint16) uint16)) uint16 {
+//		send(func(v uint16) uint16 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentUint16) SendNested(f func(uint16, func(func(uint16) uint16)) uint16) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v uint16) uint16 {
+		var held []func(uint16) uint16
+		v = f(v, func(g func(uint16) uint16) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionUint16{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentUint16) enqueue(f func(uint16) uint16) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionUint16{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentUint16) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionUint16{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentUint16) apply(f func(uint16) uint16, v uint16) (newVal uint16, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentUint16) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentUint16) Deref() uint16 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentUint16) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentUint16) Restart(v uint16) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentUint8 - holds uint8 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentUint8 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   uint8
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionUint8
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionUint8 struct {
+	f     func(uint8) uint8
+	epoch int
+}
+
+// NewAgentUint8 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentUint8(v uint8, queueSize int) *AgentUint8 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentUint8{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentUint8(0, 100)
+//	total.Send(func(v uint8) uint8 { return v + 1 })
+//	total.Await()
+func (a *AgentUint8) Send(f func(uint8) uint8) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentUint8(0, 1)
+//	counter.SendNested(func(v uint8, send func(func(uint8) uint8)) uint8 {
+//		send(func(v uint8) uint8 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentUint8) SendNested(f func(uint8, func(func(uint8) uint8)) uint8) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v uint8) uint8 {
+		var held []func(uint8) uint8
+		v = f(v, func(g func(uint8) uint8) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionUint8{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentUint8) enqueue(f func(uint8) uint8) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionUint8{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentUint8) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionUint8{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentUint8) apply(f func(uint8) uint8, v uint8) (newVal uint8, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentUint8) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentUint8) Deref() uint8 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentUint8) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentUint8) Restart(v uint8) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentFloat64 - holds float64 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentFloat64 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   float64
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionFloat64
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionFloat64 struct {
+	f     func(float64) float64
+	epoch int
+}
+
+// NewAgentFloat64 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentFloat64(v float64, queueSize int) *AgentFloat64 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentFloat64{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentFloat64(0, 100)
+//	total.Send(func(v float64) float64 { return v + 1 })
+//	total.Await()
+func (a *AgentFloat64) Send(f func(float64) float64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentFloat64(0, 1)
+//	counter.SendNested(func(v float64, send func(func(float64) float64)) float64 {
+//		send(func(v float64) float64 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentFloat64) SendNested(f func(float64, func(func(float64) float64)) float64) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v float64) float64 {
+		var held []func(float64) float64
+		v = f(v, func(g func(float64) float64) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionFloat64{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentFloat64) enqueue(f func(float64) float64) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionFloat64{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentFloat64) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionFloat64{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentFloat64) apply(f func(float64) float64, v float64) (newVal float64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentFloat64) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentFloat64) Deref() float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentFloat64) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentFloat64) Restart(v float64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentFloat32 - holds float32 which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentFloat32 struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   float32
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionFloat32
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionFloat32 struct {
+	f     func(float32) float32
+	epoch int
+}
+
+// NewAgentFloat32 creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentFloat32(v float32, queueSize int) *AgentFloat32 {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentFloat32{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentFloat32(0, 100)
+//	total.Send(func(v float32) float32 { return v + 1 })
+//	total.Await()
+func (a *AgentFloat32) Send(f func(float32) float32) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentFloat32(0, 1)
+//	counter.SendNested(func(v float32, send func(func(float32) float32)) float32 {
+//		send(func(v float32) float32 { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentFloat32) SendNested(f func(float32, func(func(float32) float32)) float32) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v float32) float32 {
+		var held []func(float32) float32
+		v = f(v, func(g func(float32) float32) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionFloat32{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentFloat32) enqueue(f func(float32) float32) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionFloat32{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentFloat32) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionFloat32{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentFloat32) apply(f func(float32) float32, v float32) (newVal float32, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentFloat32) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentFloat32) Deref() float32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentFloat32) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentFloat32) Restart(v float32) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentStr - holds string which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentStr struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   string
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionStr
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionStr struct {
+	f     func(string) string
+	epoch int
+}
+
+// NewAgentStr creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentStr(v string, queueSize int) *AgentStr {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentStr{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentStr(0, 100)
+//	total.Send(func(v string) string { return v + 1 })
+//	total.Await()
+func (a *AgentStr) Send(f func(string) string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentStr(0, 1)
+//	counter.SendNested(func(v string, send func(func(string) string)) string {
+//		send(func(v string) string { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentStr) SendNested(f func(string, func(func(string) string)) string) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v string) string {
+		var held []func(string) string
+		v = f(v, func(g func(string) string) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionStr{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentStr) enqueue(f func(string) string) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionStr{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentStr) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionStr{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentStr) apply(f func(string) string, v string) (newVal string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentStr) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentStr) Deref() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentStr) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentStr) Restart(v string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// AgentBool - holds bool which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentBool struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   bool
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionBool
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionBool struct {
+	f     func(bool) bool
+	epoch int
+}
+
+// NewAgentBool creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentBool(v bool, queueSize int) *AgentBool {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentBool{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentBool(0, 100)
+//	total.Send(func(v bool) bool { return v + 1 })
+//	total.Await()
+func (a *AgentBool) Send(f func(bool) bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentBool(0, 1)
+//	counter.SendNested(func(v bool, send func(func(bool) bool)) bool {
+//		send(func(v bool) bool { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentBool) SendNested(f func(bool, func(func(bool) bool)) bool) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v bool) bool {
+		var held []func(bool) bool
+		v = f(v, func(g func(bool) bool) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionBool{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentBool) enqueue(f func(bool) bool) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionBool{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentBool) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionBool{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentBool) apply(f func(bool) bool, v bool) (newVal bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentBool) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentBool) Deref() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentBool) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentBool) Restart(v bool) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
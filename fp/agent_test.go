@@ -0,0 +1,895 @@
+package fp
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestAgentInt(t *testing.T) {
+	a := NewAgentInt(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v int) int { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedInt(t *testing.T) {
+	a := NewAgentInt(0, 1)
+	inc := func(v int) int { return v + 1 }
+	a.SendNested(func(v int, send func(func(int) int)) int {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v int) int { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v int, send func(func(int) int)) int {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedInt failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorInt(t *testing.T) {
+	a := NewAgentInt(1, 0)
+	a.Send(func(v int) int { return v + 1 })
+	a.Send(func(v int) int { panic("boom") })
+	a.Send(func(v int) int { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorInt failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v int) int { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorInt failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorInt failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v int) int { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorInt failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentInt64(t *testing.T) {
+	a := NewAgentInt64(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v int64) int64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt64 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt64 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedInt64(t *testing.T) {
+	a := NewAgentInt64(0, 1)
+	inc := func(v int64) int64 { return v + 1 }
+	a.SendNested(func(v int64, send func(func(int64) int64)) int64 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v int64) int64 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt64 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v int64, send func(func(int64) int64)) int64 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt64 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedInt64 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorInt64(t *testing.T) {
+	a := NewAgentInt64(1, 0)
+	a.Send(func(v int64) int64 { return v + 1 })
+	a.Send(func(v int64) int64 { panic("boom") })
+	a.Send(func(v int64) int64 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorInt64 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v int64) int64 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorInt64 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorInt64 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v int64) int64 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorInt64 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentInt32(t *testing.T) {
+	a := NewAgentInt32(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v int32) int32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt32 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt32 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedInt32(t *testing.T) {
+	a := NewAgentInt32(0, 1)
+	inc := func(v int32) int32 { return v + 1 }
+	a.SendNested(func(v int32, send func(func(int32) int32)) int32 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v int32) int32 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt32 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v int32, send func(func(int32) int32)) int32 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt32 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedInt32 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorInt32(t *testing.T) {
+	a := NewAgentInt32(1, 0)
+	a.Send(func(v int32) int32 { return v + 1 })
+	a.Send(func(v int32) int32 { panic("boom") })
+	a.Send(func(v int32) int32 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorInt32 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v int32) int32 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorInt32 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorInt32 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v int32) int32 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorInt32 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentInt16(t *testing.T) {
+	a := NewAgentInt16(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v int16) int16 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt16 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt16 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedInt16(t *testing.T) {
+	a := NewAgentInt16(0, 1)
+	inc := func(v int16) int16 { return v + 1 }
+	a.SendNested(func(v int16, send func(func(int16) int16)) int16 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v int16) int16 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt16 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v int16, send func(func(int16) int16)) int16 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt16 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedInt16 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorInt16(t *testing.T) {
+	a := NewAgentInt16(1, 0)
+	a.Send(func(v int16) int16 { return v + 1 })
+	a.Send(func(v int16) int16 { panic("boom") })
+	a.Send(func(v int16) int16 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorInt16 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v int16) int16 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorInt16 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorInt16 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v int16) int16 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorInt16 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentInt8(t *testing.T) {
+	a := NewAgentInt8(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v int8) int8 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt8 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentInt8 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedInt8(t *testing.T) {
+	a := NewAgentInt8(0, 1)
+	inc := func(v int8) int8 { return v + 1 }
+	a.SendNested(func(v int8, send func(func(int8) int8)) int8 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v int8) int8 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt8 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v int8, send func(func(int8) int8)) int8 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedInt8 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedInt8 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorInt8(t *testing.T) {
+	a := NewAgentInt8(1, 0)
+	a.Send(func(v int8) int8 { return v + 1 })
+	a.Send(func(v int8) int8 { panic("boom") })
+	a.Send(func(v int8) int8 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorInt8 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v int8) int8 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorInt8 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorInt8 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v int8) int8 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorInt8 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentUint(t *testing.T) {
+	a := NewAgentUint(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v uint) uint { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedUint(t *testing.T) {
+	a := NewAgentUint(0, 1)
+	inc := func(v uint) uint { return v + 1 }
+	a.SendNested(func(v uint, send func(func(uint) uint)) uint {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v uint) uint { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v uint, send func(func(uint) uint)) uint {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedUint failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorUint(t *testing.T) {
+	a := NewAgentUint(1, 0)
+	a.Send(func(v uint) uint { return v + 1 })
+	a.Send(func(v uint) uint { panic("boom") })
+	a.Send(func(v uint) uint { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorUint failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v uint) uint { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorUint failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorUint failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v uint) uint { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorUint failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentUint64(t *testing.T) {
+	a := NewAgentUint64(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v uint64) uint64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint64 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint64 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedUint64(t *testing.T) {
+	a := NewAgentUint64(0, 1)
+	inc := func(v uint64) uint64 { return v + 1 }
+	a.SendNested(func(v uint64, send func(func(uint64) uint64)) uint64 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v uint64) uint64 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint64 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v uint64, send func(func(uint64) uint64)) uint64 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint64 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedUint64 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorUint64(t *testing.T) {
+	a := NewAgentUint64(1, 0)
+	a.Send(func(v uint64) uint64 { return v + 1 })
+	a.Send(func(v uint64) uint64 { panic("boom") })
+	a.Send(func(v uint64) uint64 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorUint64 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v uint64) uint64 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorUint64 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorUint64 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v uint64) uint64 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorUint64 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentUint32(t *testing.T) {
+	a := NewAgentUint32(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v uint32) uint32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint32 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint32 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedUint32(t *testing.T) {
+	a := NewAgentUint32(0, 1)
+	inc := func(v uint32) uint32 { return v + 1 }
+	a.SendNested(func(v uint32, send func(func(uint32) uint32)) uint32 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v uint32) uint32 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint32 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v uint32, send func(func(uint32) uint32)) uint32 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint32 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedUint32 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorUint32(t *testing.T) {
+	a := NewAgentUint32(1, 0)
+	a.Send(func(v uint32) uint32 { return v + 1 })
+	a.Send(func(v uint32) uint32 { panic("boom") })
+	a.Send(func(v uint32) uint32 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorUint32 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v uint32) uint32 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorUint32 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorUint32 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v uint32) uint32 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorUint32 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentUint16(t *testing.T) {
+	a := NewAgentUint16(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v uint16) uint16 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint16 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint16 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedUint16(t *testing.T) {
+	a := NewAgentUint16(0, 1)
+	inc := func(v uint16) uint16 { return v + 1 }
+	a.SendNested(func(v uint16, send func(func(uint16) uint16)) uint16 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v uint16) uint16 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint16 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v uint16, send func(func(uint16) uint16)) uint16 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint16 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedUint16 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorUint16(t *testing.T) {
+	a := NewAgentUint16(1, 0)
+	a.Send(func(v uint16) uint16 { return v + 1 })
+	a.Send(func(v uint16) uint16 { panic("boom") })
+	a.Send(func(v uint16) uint16 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorUint16 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v uint16) uint16 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorUint16 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorUint16 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v uint16) uint16 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorUint16 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentUint8(t *testing.T) {
+	a := NewAgentUint8(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v uint8) uint8 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint8 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentUint8 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedUint8(t *testing.T) {
+	a := NewAgentUint8(0, 1)
+	inc := func(v uint8) uint8 { return v + 1 }
+	a.SendNested(func(v uint8, send func(func(uint8) uint8)) uint8 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v uint8) uint8 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint8 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v uint8, send func(func(uint8) uint8)) uint8 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedUint8 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedUint8 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorUint8(t *testing.T) {
+	a := NewAgentUint8(1, 0)
+	a.Send(func(v uint8) uint8 { return v + 1 })
+	a.Send(func(v uint8) uint8 { panic("boom") })
+	a.Send(func(v uint8) uint8 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorUint8 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v uint8) uint8 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorUint8 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorUint8 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v uint8) uint8 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorUint8 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentFloat64(t *testing.T) {
+	a := NewAgentFloat64(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v float64) float64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentFloat64 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentFloat64 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedFloat64(t *testing.T) {
+	a := NewAgentFloat64(0, 1)
+	inc := func(v float64) float64 { return v + 1 }
+	a.SendNested(func(v float64, send func(func(float64) float64)) float64 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v float64) float64 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedFloat64 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v float64, send func(func(float64) float64)) float64 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedFloat64 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedFloat64 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorFloat64(t *testing.T) {
+	a := NewAgentFloat64(1, 0)
+	a.Send(func(v float64) float64 { return v + 1 })
+	a.Send(func(v float64) float64 { panic("boom") })
+	a.Send(func(v float64) float64 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorFloat64 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v float64) float64 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorFloat64 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorFloat64 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v float64) float64 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorFloat64 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentFloat32(t *testing.T) {
+	a := NewAgentFloat32(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v float32) float32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentFloat32 failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgentFloat32 failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNestedFloat32(t *testing.T) {
+	a := NewAgentFloat32(0, 1)
+	inc := func(v float32) float32 { return v + 1 }
+	a.SendNested(func(v float32, send func(func(float32) float32)) float32 {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v float32) float32 { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedFloat32 failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v float32, send func(func(float32) float32)) float32 {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNestedFloat32 failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNestedFloat32 failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentErrorFloat32(t *testing.T) {
+	a := NewAgentFloat32(1, 0)
+	a.Send(func(v float32) float32 { return v + 1 })
+	a.Send(func(v float32) float32 { panic("boom") })
+	a.Send(func(v float32) float32 { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentErrorFloat32 failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v float32) float32 { return v + 1 }); err == nil {
+		t.Errorf("TestAgentErrorFloat32 failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentErrorFloat32 failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v float32) float32 { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentErrorFloat32 failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+
+func TestAgentStr(t *testing.T) {
+	a := NewAgentStr("", 2)
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		s := s
+		a.Send(func(v string) string { return v + s })
+	}
+	if err := a.Await(); err != nil || a.Deref() != "abcde" {
+		t.Errorf("TestAgentStr failed. Expected actions in the order they are sent=%v, actual=%v", "abcde", a.Deref())
+	}
+}
+
+func TestAgentErrorStr(t *testing.T) {
+	errBoom := errors.New("boom")
+	a := NewAgentStr("a", 1)
+	a.Send(func(v string) string { panic(errBoom) })
+	a.Send(func(v string) string { return v + "b" })
+
+	if err := a.Await(); err != errBoom || a.Deref() != "a" {
+		t.Errorf("TestAgentErrorStr failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	a.Restart("x")
+	a.Send(func(v string) string { return v + "y" })
+	if err := a.Await(); err != nil || a.Deref() != "xy" {
+		t.Errorf("TestAgentErrorStr failed. Expected=%v, actual=%v", "xy", a.Deref())
+	}
+}
+
+func TestAgentBool(t *testing.T) {
+	a := NewAgentBool(false, 3)
+	for i := 0; i < 5; i++ {
+		a.Send(func(v bool) bool { return !v })
+	}
+	if err := a.Await(); err != nil || a.Deref() != true {
+		t.Errorf("TestAgentBool failed. Expected=%v, actual=%v", true, a.Deref())
+	}
+}
+
+func TestAgentErrorBool(t *testing.T) {
+	a := NewAgentBool(true, 1)
+	a.Send(func(v bool) bool { panic("boom") })
+	if err := a.Await(); err == nil || a.Deref() != true {
+		t.Errorf("TestAgentErrorBool failed. Expected error, actual=%v", err)
+	}
+	if !a.Restart(false) || a.Err() != nil {
+		t.Errorf("TestAgentErrorBool failed. Expected Restart to clear error")
+	}
+}
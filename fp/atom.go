@@ -0,0 +1,2145 @@
+package fp
+
+import "sync"
+
+// AtomInt - holds int shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of int
+type AtomInt struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   int
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(int) error
+	watches   map[string]func(key string, oldVal, newVal int)
+}
+
+// NewAtomInt creates atom with the initial value
+func NewAtomInt(v int) *AtomInt {
+	return &AtomInt{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomInt) load() (int, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomInt) store(version uint64, v int) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomInt) Deref() int {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomInt(0)
+//	counter.Swap(func(v int) int { return v + 1 })
+func (a *AtomInt) Swap(f func(int) int) (int, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomInt) Reset(v int) error {
+	_, err := a.Swap(func(int) int { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomInt) CompareAndSet(oldVal, newVal int) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomInt) SetValidator(validator func(int) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomInt) validate(v int) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomInt) AddWatch(key string, f func(key string, oldVal, newVal int)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, int, int))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomInt) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomInt) notify(oldVal, newVal int) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, int, int), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomInt64 - holds int64 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of int64
+type AtomInt64 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   int64
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(int64) error
+	watches   map[string]func(key string, oldVal, newVal int64)
+}
+
+// NewAtomInt64 creates atom with the initial value
+func NewAtomInt64(v int64) *AtomInt64 {
+	return &AtomInt64{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomInt64) load() (int64, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomInt64) store(version uint64, v int64) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomInt64) Deref() int64 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomInt64(0)
+//	counter.Swap(func(v int64) int64 { return v + 1 })
+func (a *AtomInt64) Swap(f func(int64) int64) (int64, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomInt64) Reset(v int64) error {
+	_, err := a.Swap(func(int64) int64 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomInt64) CompareAndSet(oldVal, newVal int64) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomInt64) SetValidator(validator func(int64) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomInt64) validate(v int64) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomInt64) AddWatch(key string, f func(key string, oldVal, newVal int64)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, int64, int64))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomInt64) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomInt64) notify(oldVal, newVal int64) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, int64, int64), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomInt32 - holds int32 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of int32
+type AtomInt32 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   int32
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(int32) error
+	watches   map[string]func(key string, oldVal, newVal int32)
+}
+
+// NewAtomInt32 creates atom with the initial value
+func NewAtomInt32(v int32) *AtomInt32 {
+	return &AtomInt32{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomInt32) load() (int32, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomInt32) store(version uint64, v int32) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomInt32) Deref() int32 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomInt32(0)
+//	counter.Swap(func(v int32) int32 { return v + 1 })
+func (a *AtomInt32) Swap(f func(int32) int32) (int32, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomInt32) Reset(v int32) error {
+	_, err := a.Swap(func(int32) int32 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomInt32) CompareAndSet(oldVal, newVal int32) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomInt32) SetValidator(validator func(int32) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomInt32) validate(v int32) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomInt32) AddWatch(key string, f func(key string, oldVal, newVal int32)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, int32, int32))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomInt32) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomInt32) notify(oldVal, newVal int32) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, int32, int32), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomInt16 - holds int16 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of int16
+type AtomInt16 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   int16
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(int16) error
+	watches   map[string]func(key string, oldVal, newVal int16)
+}
+
+// NewAtomInt16 creates atom with the initial value
+func NewAtomInt16(v int16) *AtomInt16 {
+	return &AtomInt16{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomInt16) load() (int16, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomInt16) store(version uint64, v int16) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomInt16) Deref() int16 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomInt16(0)
+//	counter.Swap(func(v int16) int16 { return v + 1 })
+func (a *AtomInt16) Swap(f func(int16) int16) (int16, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomInt16) Reset(v int16) error {
+	_, err := a.Swap(func(int16) int16 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomInt16) CompareAndSet(oldVal, newVal int16) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomInt16) SetValidator(validator func(int16) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomInt16) validate(v int16) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomInt16) AddWatch(key string, f func(key string, oldVal, newVal int16)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, int16, int16))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomInt16) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomInt16) notify(oldVal, newVal int16) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, int16, int16), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomInt8 - holds int8 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of int8
+type AtomInt8 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   int8
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(int8) error
+	watches   map[string]func(key string, oldVal, newVal int8)
+}
+
+// NewAtomInt8 creates atom with the initial value
+func NewAtomInt8(v int8) *AtomInt8 {
+	return &AtomInt8{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomInt8) load() (int8, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomInt8) store(version uint64, v int8) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomInt8) Deref() int8 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomInt8(0)
+//	counter.Swap(func(v int8) int8 { return v + 1 })
+func (a *AtomInt8) Swap(f func(int8) int8) (int8, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomInt8) Reset(v int8) error {
+	_, err := a.Swap(func(int8) int8 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomInt8) CompareAndSet(oldVal, newVal int8) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomInt8) SetValidator(validator func(int8) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomInt8) validate(v int8) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomInt8) AddWatch(key string, f func(key string, oldVal, newVal int8)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, int8, int8))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomInt8) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomInt8) notify(oldVal, newVal int8) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, int8, int8), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomUint - holds uint shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of uint
+type AtomUint struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   uint
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(uint) error
+	watches   map[string]func(key string, oldVal, newVal uint)
+}
+
+// NewAtomUint creates atom with the initial value
+func NewAtomUint(v uint) *AtomUint {
+	return &AtomUint{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomUint) load() (uint, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomUint) store(version uint64, v uint) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomUint) Deref() uint {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomUint(0)
+//	counter.Swap(func(v uint) uint { return v + 1 })
+func (a *AtomUint) Swap(f func(uint) uint) (uint, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomUint) Reset(v uint) error {
+	_, err := a.Swap(func(uint) uint { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomUint) CompareAndSet(oldVal, newVal uint) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomUint) SetValidator(validator func(uint) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomUint) validate(v uint) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomUint) AddWatch(key string, f func(key string, oldVal, newVal uint)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, uint, uint))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomUint) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomUint) notify(oldVal, newVal uint) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, uint, uint), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomUint64 - holds uint64 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of uint64
+type AtomUint64 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   uint64
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(uint64) error
+	watches   map[string]func(key string, oldVal, newVal uint64)
+}
+
+// NewAtomUint64 creates atom with the initial value
+func NewAtomUint64(v uint64) *AtomUint64 {
+	return &AtomUint64{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomUint64) load() (uint64, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomUint64) store(version uint64, v uint64) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomUint64) Deref() uint64 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomUint64(0)
+//	counter.Swap(func(v uint64) uint64 { return v + 1 })
+func (a *AtomUint64) Swap(f func(uint64) uint64) (uint64, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomUint64) Reset(v uint64) error {
+	_, err := a.Swap(func(uint64) uint64 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomUint64) CompareAndSet(oldVal, newVal uint64) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomUint64) SetValidator(validator func(uint64) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomUint64) validate(v uint64) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomUint64) AddWatch(key string, f func(key string, oldVal, newVal uint64)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, uint64, uint64))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomUint64) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomUint64) notify(oldVal, newVal uint64) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, uint64, uint64), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomUint32 - holds uint32 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of uint32
+type AtomUint32 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   uint32
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(uint32) error
+	watches   map[string]func(key string, oldVal, newVal uint32)
+}
+
+// NewAtomUint32 creates atom with the initial value
+func NewAtomUint32(v uint32) *AtomUint32 {
+	return &AtomUint32{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomUint32) load() (uint32, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomUint32) store(version uint64, v uint32) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomUint32) Deref() uint32 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomUint32(0)
+//	counter.Swap(func(v uint32) uint32 { return v + 1 })
+func (a *AtomUint32) Swap(f func(uint32) uint32) (uint32, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomUint32) Reset(v uint32) error {
+	_, err := a.Swap(func(uint32) uint32 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomUint32) CompareAndSet(oldVal, newVal uint32) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomUint32) SetValidator(validator func(uint32) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomUint32) validate(v uint32) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomUint32) AddWatch(key string, f func(key string, oldVal, newVal uint32)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, uint32, uint32))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomUint32) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomUint32) notify(oldVal, newVal uint32) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, uint32, uint32), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomUint16 - holds uint16 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of uint16
+type AtomUint16 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   uint16
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(uint16) error
+	watches   map[string]func(key string, oldVal, newVal uint16)
+}
+
+// NewAtomUint16 creates atom with the initial value
+func NewAtomUint16(v uint16) *AtomUint16 {
+	return &AtomUint16{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomUint16) load() (uint16, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomUint16) store(version uint64, v uint16) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomUint16) Deref() uint16 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomUint16(0)
+//	counter.Swap(func(v uint16) uint16 { return v + 1 })
+func (a *AtomUint16) Swap(f func(uint16) uint16) (uint16, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomUint16) Reset(v uint16) error {
+	_, err := a.Swap(func(uint16) uint16 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomUint16) CompareAndSet(oldVal, newVal uint16) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomUint16) SetValidator(validator func(uint16) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomUint16) validate(v uint16) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomUint16) AddWatch(key string, f func(key string, oldVal, newVal uint16)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, uint16, uint16))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomUint16) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomUint16) notify(oldVal, newVal uint16) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, uint16, uint16), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomUint8 - holds uint8 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of uint8
+type AtomUint8 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   uint8
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(uint8) error
+	watches   map[string]func(key string, oldVal, newVal uint8)
+}
+
+// NewAtomUint8 creates atom with the initial value
+func NewAtomUint8(v uint8) *AtomUint8 {
+	return &AtomUint8{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomUint8) load() (uint8, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomUint8) store(version uint64, v uint8) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomUint8) Deref() uint8 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomUint8(0)
+//	counter.Swap(func(v uint8) uint8 { return v + 1 })
+func (a *AtomUint8) Swap(f func(uint8) uint8) (uint8, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomUint8) Reset(v uint8) error {
+	_, err := a.Swap(func(uint8) uint8 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomUint8) CompareAndSet(oldVal, newVal uint8) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomUint8) SetValidator(validator func(uint8) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomUint8) validate(v uint8) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomUint8) AddWatch(key string, f func(key string, oldVal, newVal uint8)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, uint8, uint8))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomUint8) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomUint8) notify(oldVal, newVal uint8) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, uint8, uint8), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomFloat64 - holds float64 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of float64
+type AtomFloat64 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   float64
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(float64) error
+	watches   map[string]func(key string, oldVal, newVal float64)
+}
+
+// NewAtomFloat64 creates atom with the initial value
+func NewAtomFloat64(v float64) *AtomFloat64 {
+	return &AtomFloat64{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomFloat64) load() (float64, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomFloat64) store(version uint64, v float64) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomFloat64) Deref() float64 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomFloat64(0)
+//	counter.Swap(func(v float64) float64 { return v + 1 })
+func (a *AtomFloat64) Swap(f func(float64) float64) (float64, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomFloat64) Reset(v float64) error {
+	_, err := a.Swap(func(float64) float64 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomFloat64) CompareAndSet(oldVal, newVal float64) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomFloat64) SetValidator(validator func(float64) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomFloat64) validate(v float64) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomFloat64) AddWatch(key string, f func(key string, oldVal, newVal float64)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, float64, float64))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomFloat64) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomFloat64) notify(oldVal, newVal float64) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, float64, float64), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomFloat32 - holds float32 shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of float32
+type AtomFloat32 struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   float32
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(float32) error
+	watches   map[string]func(key string, oldVal, newVal float32)
+}
+
+// NewAtomFloat32 creates atom with the initial value
+func NewAtomFloat32(v float32) *AtomFloat32 {
+	return &AtomFloat32{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomFloat32) load() (float32, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomFloat32) store(version uint64, v float32) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomFloat32) Deref() float32 {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomFloat32(0)
+//	counter.Swap(func(v float32) float32 { return v + 1 })
+func (a *AtomFloat32) Swap(f func(float32) float32) (float32, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomFloat32) Reset(v float32) error {
+	_, err := a.Swap(func(float32) float32 { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomFloat32) CompareAndSet(oldVal, newVal float32) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomFloat32) SetValidator(validator func(float32) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomFloat32) validate(v float32) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomFloat32) AddWatch(key string, f func(key string, oldVal, newVal float32)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, float32, float32))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomFloat32) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomFloat32) notify(oldVal, newVal float32) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, float32, float32), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomStr - holds string shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of string
+type AtomStr struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   string
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(string) error
+	watches   map[string]func(key string, oldVal, newVal string)
+}
+
+// NewAtomStr creates atom with the initial value
+func NewAtomStr(v string) *AtomStr {
+	return &AtomStr{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomStr) load() (string, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomStr) store(version uint64, v string) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomStr) Deref() string {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomStr(0)
+//	counter.Swap(func(v string) string { return v + 1 })
+func (a *AtomStr) Swap(f func(string) string) (string, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomStr) Reset(v string) error {
+	_, err := a.Swap(func(string) string { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomStr) CompareAndSet(oldVal, newVal string) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomStr) SetValidator(validator func(string) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomStr) validate(v string) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomStr) AddWatch(key string, f func(key string, oldVal, newVal string)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, string, string))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomStr) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomStr) notify(oldVal, newVal string) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, string, string), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AtomBool - holds bool shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of bool
+type AtomBool struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   bool
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(bool) error
+	watches   map[string]func(key string, oldVal, newVal bool)
+}
+
+// NewAtomBool creates atom with the initial value
+func NewAtomBool(v bool) *AtomBool {
+	return &AtomBool{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomBool) load() (bool, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomBool) store(version uint64, v bool) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomBool) Deref() bool {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomBool(0)
+//	counter.Swap(func(v bool) bool { return v + 1 })
+func (a *AtomBool) Swap(f func(bool) bool) (bool, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomBool) Reset(v bool) error {
+	_, err := a.Swap(func(bool) bool { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomBool) CompareAndSet(oldVal, newVal bool) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomBool) SetValidator(validator func(bool) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomBool) validate(v bool) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomBool) AddWatch(key string, f func(key string, oldVal, newVal bool)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, bool, bool))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomBool) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomBool) notify(oldVal, newVal bool) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, bool, bool), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
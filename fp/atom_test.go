@@ -0,0 +1,1250 @@
+package fp
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestAtomInt(t *testing.T) {
+	a := NewAtomInt(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v int) int { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomInt failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomInt failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomInt failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomInt failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomInt failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomInt
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomInt failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v int) int { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomInt failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorInt(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomInt(10)
+	if err := a.SetValidator(func(v int) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorInt failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v int) int { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorInt failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorInt failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorInt failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v int) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorInt failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchInt(t *testing.T) {
+	a := NewAtomInt(1)
+	var changes [][2]int
+	a.AddWatch("log", func(key string, oldVal, newVal int) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchInt failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]int{oldVal, newVal})
+	})
+
+	a.Swap(func(v int) int { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]int{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchInt failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomInt64(t *testing.T) {
+	a := NewAtomInt64(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v int64) int64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomInt64 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomInt64 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomInt64 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomInt64 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomInt64 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomInt64
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomInt64 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v int64) int64 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomInt64 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorInt64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomInt64(10)
+	if err := a.SetValidator(func(v int64) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorInt64 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v int64) int64 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v int64) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorInt64 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchInt64(t *testing.T) {
+	a := NewAtomInt64(1)
+	var changes [][2]int64
+	a.AddWatch("log", func(key string, oldVal, newVal int64) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchInt64 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]int64{oldVal, newVal})
+	})
+
+	a.Swap(func(v int64) int64 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]int64{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchInt64 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomInt32(t *testing.T) {
+	a := NewAtomInt32(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v int32) int32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomInt32 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomInt32 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomInt32 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomInt32 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomInt32 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomInt32
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomInt32 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v int32) int32 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomInt32 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorInt32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomInt32(10)
+	if err := a.SetValidator(func(v int32) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorInt32 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v int32) int32 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v int32) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorInt32 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchInt32(t *testing.T) {
+	a := NewAtomInt32(1)
+	var changes [][2]int32
+	a.AddWatch("log", func(key string, oldVal, newVal int32) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchInt32 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]int32{oldVal, newVal})
+	})
+
+	a.Swap(func(v int32) int32 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]int32{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchInt32 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomInt16(t *testing.T) {
+	a := NewAtomInt16(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v int16) int16 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomInt16 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomInt16 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomInt16 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomInt16 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomInt16 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomInt16
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomInt16 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v int16) int16 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomInt16 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorInt16(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomInt16(10)
+	if err := a.SetValidator(func(v int16) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorInt16 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v int16) int16 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v int16) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorInt16 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchInt16(t *testing.T) {
+	a := NewAtomInt16(1)
+	var changes [][2]int16
+	a.AddWatch("log", func(key string, oldVal, newVal int16) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchInt16 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]int16{oldVal, newVal})
+	})
+
+	a.Swap(func(v int16) int16 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]int16{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchInt16 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomInt8(t *testing.T) {
+	a := NewAtomInt8(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v int8) int8 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomInt8 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomInt8 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomInt8 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomInt8 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomInt8 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomInt8
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomInt8 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v int8) int8 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomInt8 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorInt8(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomInt8(10)
+	if err := a.SetValidator(func(v int8) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorInt8 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v int8) int8 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v int8) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorInt8 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchInt8(t *testing.T) {
+	a := NewAtomInt8(1)
+	var changes [][2]int8
+	a.AddWatch("log", func(key string, oldVal, newVal int8) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchInt8 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]int8{oldVal, newVal})
+	})
+
+	a.Swap(func(v int8) int8 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]int8{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchInt8 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomUint(t *testing.T) {
+	a := NewAtomUint(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v uint) uint { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomUint failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomUint failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomUint failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomUint failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomUint failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomUint
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomUint failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v uint) uint { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomUint failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorUint(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomUint(10)
+	if err := a.SetValidator(func(v uint) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorUint failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v uint) uint { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorUint failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorUint failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorUint failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v uint) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorUint failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchUint(t *testing.T) {
+	a := NewAtomUint(1)
+	var changes [][2]uint
+	a.AddWatch("log", func(key string, oldVal, newVal uint) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchUint failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]uint{oldVal, newVal})
+	})
+
+	a.Swap(func(v uint) uint { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]uint{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchUint failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomUint64(t *testing.T) {
+	a := NewAtomUint64(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v uint64) uint64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomUint64 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomUint64 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomUint64 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomUint64 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomUint64 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomUint64
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomUint64 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v uint64) uint64 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomUint64 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorUint64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomUint64(10)
+	if err := a.SetValidator(func(v uint64) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorUint64 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v uint64) uint64 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v uint64) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorUint64 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchUint64(t *testing.T) {
+	a := NewAtomUint64(1)
+	var changes [][2]uint64
+	a.AddWatch("log", func(key string, oldVal, newVal uint64) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchUint64 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]uint64{oldVal, newVal})
+	})
+
+	a.Swap(func(v uint64) uint64 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]uint64{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchUint64 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomUint32(t *testing.T) {
+	a := NewAtomUint32(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v uint32) uint32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomUint32 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomUint32 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomUint32 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomUint32 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomUint32 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomUint32
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomUint32 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v uint32) uint32 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomUint32 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorUint32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomUint32(10)
+	if err := a.SetValidator(func(v uint32) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorUint32 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v uint32) uint32 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v uint32) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorUint32 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchUint32(t *testing.T) {
+	a := NewAtomUint32(1)
+	var changes [][2]uint32
+	a.AddWatch("log", func(key string, oldVal, newVal uint32) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchUint32 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]uint32{oldVal, newVal})
+	})
+
+	a.Swap(func(v uint32) uint32 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]uint32{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchUint32 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomUint16(t *testing.T) {
+	a := NewAtomUint16(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v uint16) uint16 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomUint16 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomUint16 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomUint16 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomUint16 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomUint16 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomUint16
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomUint16 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v uint16) uint16 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomUint16 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorUint16(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomUint16(10)
+	if err := a.SetValidator(func(v uint16) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorUint16 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v uint16) uint16 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v uint16) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorUint16 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchUint16(t *testing.T) {
+	a := NewAtomUint16(1)
+	var changes [][2]uint16
+	a.AddWatch("log", func(key string, oldVal, newVal uint16) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchUint16 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]uint16{oldVal, newVal})
+	})
+
+	a.Swap(func(v uint16) uint16 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]uint16{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchUint16 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomUint8(t *testing.T) {
+	a := NewAtomUint8(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v uint8) uint8 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomUint8 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomUint8 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomUint8 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomUint8 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomUint8 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomUint8
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomUint8 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v uint8) uint8 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomUint8 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorUint8(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomUint8(10)
+	if err := a.SetValidator(func(v uint8) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorUint8 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v uint8) uint8 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v uint8) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorUint8 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchUint8(t *testing.T) {
+	a := NewAtomUint8(1)
+	var changes [][2]uint8
+	a.AddWatch("log", func(key string, oldVal, newVal uint8) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchUint8 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]uint8{oldVal, newVal})
+	})
+
+	a.Swap(func(v uint8) uint8 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]uint8{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchUint8 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomFloat64(t *testing.T) {
+	a := NewAtomFloat64(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v float64) float64 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomFloat64 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomFloat64 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomFloat64 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomFloat64 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomFloat64 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomFloat64
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomFloat64 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v float64) float64 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomFloat64 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorFloat64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomFloat64(10)
+	if err := a.SetValidator(func(v float64) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorFloat64 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v float64) float64 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v float64) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorFloat64 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchFloat64(t *testing.T) {
+	a := NewAtomFloat64(1)
+	var changes [][2]float64
+	a.AddWatch("log", func(key string, oldVal, newVal float64) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchFloat64 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]float64{oldVal, newVal})
+	})
+
+	a.Swap(func(v float64) float64 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]float64{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchFloat64 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomFloat32(t *testing.T) {
+	a := NewAtomFloat32(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v float32) float32 { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtomFloat32 failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtomFloat32 failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtomFloat32 failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtomFloat32 failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtomFloat32 failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero AtomFloat32
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtomFloat32 failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v float32) float32 { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtomFloat32 failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidatorFloat32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtomFloat32(10)
+	if err := a.SetValidator(func(v float32) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidatorFloat32 failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v float32) float32 { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v float32) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidatorFloat32 failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatchFloat32(t *testing.T) {
+	a := NewAtomFloat32(1)
+	var changes [][2]float32
+	a.AddWatch("log", func(key string, oldVal, newVal float32) {
+		if key != "log" {
+			t.Errorf("TestAtomWatchFloat32 failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]float32{oldVal, newVal})
+	})
+
+	a.Swap(func(v float32) float32 { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]float32{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchFloat32 failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomStr(t *testing.T) {
+	a := NewAtomStr("")
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v string) string { return v + "a" })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); len(v) != 100 {
+		t.Errorf("TestAtomStr failed. Expected length=%v, actual=%v", 100, len(v))
+	}
+
+	a.Reset("a")
+	if ok, _ := a.CompareAndSet("b", "c"); ok || a.Deref() != "a" {
+		t.Errorf("TestAtomStr failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet("a", "c"); !ok || a.Deref() != "c" {
+		t.Errorf("TestAtomStr failed. Expected=%v, actual=%v", "c", a.Deref())
+	}
+
+	var zero AtomStr
+	if v, _ := zero.Swap(func(v string) string { return v + "x" }); v != "x" {
+		t.Errorf("TestAtomStr failed. Expected=%v, actual=%v", "x", v)
+	}
+}
+
+func TestAtomValidatorStr(t *testing.T) {
+	errEmpty := errors.New("empty")
+	a := NewAtomStr("a")
+	a.SetValidator(func(v string) error {
+		if v == "" {
+			return errEmpty
+		}
+		return nil
+	})
+
+	if err := a.Reset(""); err != errEmpty || a.Deref() != "a" {
+		t.Errorf("TestAtomValidatorStr failed. Expected error=%v, actual=%v", errEmpty, err)
+	}
+	if err := a.Reset("b"); err != nil || a.Deref() != "b" {
+		t.Errorf("TestAtomValidatorStr failed. Expected=%v, actual=%v", "b", a.Deref())
+	}
+}
+
+func TestAtomWatchStr(t *testing.T) {
+	a := NewAtomStr("a")
+	var changes [][2]string
+	a.AddWatch("log", func(_ string, oldVal, newVal string) {
+		changes = append(changes, [2]string{oldVal, newVal})
+	})
+	a.Swap(func(v string) string { return v + "b" })
+	a.RemoveWatch("log")
+	a.Reset("c")
+
+	expected := [][2]string{{"a", "ab"}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchStr failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+
+func TestAtomBool(t *testing.T) {
+	a := NewAtomBool(false)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v bool) bool { return !v })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != false {
+		t.Errorf("TestAtomBool failed. Expected=%v, actual=%v", false, v)
+	}
+
+	if ok, _ := a.CompareAndSet(true, false); ok {
+		t.Errorf("TestAtomBool failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(false, true); !ok || a.Deref() != true {
+		t.Errorf("TestAtomBool failed. Expected=%v, actual=%v", true, a.Deref())
+	}
+}
+
+func TestAtomValidatorBool(t *testing.T) {
+	errFalse := errors.New("false")
+	a := NewAtomBool(true)
+	a.SetValidator(func(v bool) error {
+		if !v {
+			return errFalse
+		}
+		return nil
+	})
+	if err := a.Reset(false); err != errFalse || a.Deref() != true {
+		t.Errorf("TestAtomValidatorBool failed. Expected error=%v, actual=%v", errFalse, err)
+	}
+}
+
+func TestAtomWatchBool(t *testing.T) {
+	a := NewAtomBool(false)
+	var changes [][2]bool
+	a.AddWatch("log", func(_ string, oldVal, newVal bool) {
+		changes = append(changes, [2]bool{oldVal, newVal})
+	})
+	a.Reset(true)
+
+	expected := [][2]bool{{false, true}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatchBool failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
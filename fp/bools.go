@@ -0,0 +1,29 @@
+package fp
+
+// Bools - list of bool whose methods can be chained. See Ints
+type Bools []bool
+
+// ToSlice returns the list as []bool
+func (l Bools) ToSlice() []bool {
+	return []bool(l)
+}
+
+// Len returns number of items
+func (l Bools) Len() int {
+	return len(l)
+}
+
+// Every - see EveryBool
+func (l Bools) Every(f func() bool) bool {
+	return EveryBool(f, l)
+}
+
+// Dedupe - see DedupeBool
+func (l Bools) Dedupe() Bools {
+	return DedupeBool(l)
+}
+
+// Find - see FindOptBool
+func (l Bools) Find(pred func(bool) bool) OptionBool {
+	return FindOptBool(pred, l)
+}
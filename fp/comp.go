@@ -0,0 +1,393 @@
+package fp
+
+// CompInt composes functions from right to left and returns the composed function.
+// CompInt(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompInt(fs ...func(int) int) func(int) int {
+	return func(v int) int {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeInt composes functions from left to right and returns the composed function.
+// PipeInt(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeInt(fs ...func(int) int) func(int) int {
+	return func(v int) int {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompInt64 composes functions from right to left and returns the composed function.
+// CompInt64(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompInt64(fs ...func(int64) int64) func(int64) int64 {
+	return func(v int64) int64 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeInt64 composes functions from left to right and returns the composed function.
+// PipeInt64(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeInt64(fs ...func(int64) int64) func(int64) int64 {
+	return func(v int64) int64 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompInt32 composes functions from right to left and returns the composed function.
+// CompInt32(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompInt32(fs ...func(int32) int32) func(int32) int32 {
+	return func(v int32) int32 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeInt32 composes functions from left to right and returns the composed function.
+// PipeInt32(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeInt32(fs ...func(int32) int32) func(int32) int32 {
+	return func(v int32) int32 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompInt16 composes functions from right to left and returns the composed function.
+// CompInt16(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompInt16(fs ...func(int16) int16) func(int16) int16 {
+	return func(v int16) int16 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeInt16 composes functions from left to right and returns the composed function.
+// PipeInt16(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeInt16(fs ...func(int16) int16) func(int16) int16 {
+	return func(v int16) int16 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompInt8 composes functions from right to left and returns the composed function.
+// CompInt8(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompInt8(fs ...func(int8) int8) func(int8) int8 {
+	return func(v int8) int8 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeInt8 composes functions from left to right and returns the composed function.
+// PipeInt8(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeInt8(fs ...func(int8) int8) func(int8) int8 {
+	return func(v int8) int8 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompUint composes functions from right to left and returns the composed function.
+// CompUint(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompUint(fs ...func(uint) uint) func(uint) uint {
+	return func(v uint) uint {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeUint composes functions from left to right and returns the composed function.
+// PipeUint(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeUint(fs ...func(uint) uint) func(uint) uint {
+	return func(v uint) uint {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompUint64 composes functions from right to left and returns the composed function.
+// CompUint64(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompUint64(fs ...func(uint64) uint64) func(uint64) uint64 {
+	return func(v uint64) uint64 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeUint64 composes functions from left to right and returns the composed function.
+// PipeUint64(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeUint64(fs ...func(uint64) uint64) func(uint64) uint64 {
+	return func(v uint64) uint64 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompUint32 composes functions from right to left and returns the composed function.
+// CompUint32(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompUint32(fs ...func(uint32) uint32) func(uint32) uint32 {
+	return func(v uint32) uint32 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeUint32 composes functions from left to right and returns the composed function.
+// PipeUint32(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeUint32(fs ...func(uint32) uint32) func(uint32) uint32 {
+	return func(v uint32) uint32 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompUint16 composes functions from right to left and returns the composed function.
+// CompUint16(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompUint16(fs ...func(uint16) uint16) func(uint16) uint16 {
+	return func(v uint16) uint16 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeUint16 composes functions from left to right and returns the composed function.
+// PipeUint16(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeUint16(fs ...func(uint16) uint16) func(uint16) uint16 {
+	return func(v uint16) uint16 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompUint8 composes functions from right to left and returns the composed function.
+// CompUint8(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompUint8(fs ...func(uint8) uint8) func(uint8) uint8 {
+	return func(v uint8) uint8 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeUint8 composes functions from left to right and returns the composed function.
+// PipeUint8(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeUint8(fs ...func(uint8) uint8) func(uint8) uint8 {
+	return func(v uint8) uint8 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompFloat64 composes functions from right to left and returns the composed function.
+// CompFloat64(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompFloat64(fs ...func(float64) float64) func(float64) float64 {
+	return func(v float64) float64 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeFloat64 composes functions from left to right and returns the composed function.
+// PipeFloat64(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeFloat64(fs ...func(float64) float64) func(float64) float64 {
+	return func(v float64) float64 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompFloat32 composes functions from right to left and returns the composed function.
+// CompFloat32(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompFloat32(fs ...func(float32) float32) func(float32) float32 {
+	return func(v float32) float32 {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeFloat32 composes functions from left to right and returns the composed function.
+// PipeFloat32(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeFloat32(fs ...func(float32) float32) func(float32) float32 {
+	return func(v float32) float32 {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompStr composes functions from right to left and returns the composed function.
+// CompStr(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompStr(fs ...func(string) string) func(string) string {
+	return func(v string) string {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeStr composes functions from left to right and returns the composed function.
+// PipeStr(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeStr(fs ...func(string) string) func(string) string {
+	return func(v string) string {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+// CompBool composes functions from right to left and returns the composed function.
+// CompBool(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func CompBool(fs ...func(bool) bool) func(bool) bool {
+	return func(v bool) bool {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// PipeBool composes functions from left to right and returns the composed function.
+// PipeBool(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func PipeBool(fs ...func(bool) bool) func(bool) bool {
+	return func(v bool) bool {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
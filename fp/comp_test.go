@@ -0,0 +1,566 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompInt(t *testing.T) {
+	f1 := func(v int) int { return v + 1 }
+	f2 := func(v int) int { return v * 2 }
+
+	expected := int(7)
+	actual := CompInt(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int(4)
+	actual = CompInt(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int(3)
+	actual = CompInt()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeInt(t *testing.T) {
+	f1 := func(v int) int { return v + 1 }
+	f2 := func(v int) int { return v * 2 }
+
+	expected := int(8)
+	actual := PipeInt(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int(3)
+	actual = PipeInt()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompInt64(t *testing.T) {
+	f1 := func(v int64) int64 { return v + 1 }
+	f2 := func(v int64) int64 { return v * 2 }
+
+	expected := int64(7)
+	actual := CompInt64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int64(4)
+	actual = CompInt64(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int64(3)
+	actual = CompInt64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeInt64(t *testing.T) {
+	f1 := func(v int64) int64 { return v + 1 }
+	f2 := func(v int64) int64 { return v * 2 }
+
+	expected := int64(8)
+	actual := PipeInt64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int64(3)
+	actual = PipeInt64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompInt32(t *testing.T) {
+	f1 := func(v int32) int32 { return v + 1 }
+	f2 := func(v int32) int32 { return v * 2 }
+
+	expected := int32(7)
+	actual := CompInt32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int32(4)
+	actual = CompInt32(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int32(3)
+	actual = CompInt32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeInt32(t *testing.T) {
+	f1 := func(v int32) int32 { return v + 1 }
+	f2 := func(v int32) int32 { return v * 2 }
+
+	expected := int32(8)
+	actual := PipeInt32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int32(3)
+	actual = PipeInt32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompInt16(t *testing.T) {
+	f1 := func(v int16) int16 { return v + 1 }
+	f2 := func(v int16) int16 { return v * 2 }
+
+	expected := int16(7)
+	actual := CompInt16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int16(4)
+	actual = CompInt16(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int16(3)
+	actual = CompInt16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeInt16(t *testing.T) {
+	f1 := func(v int16) int16 { return v + 1 }
+	f2 := func(v int16) int16 { return v * 2 }
+
+	expected := int16(8)
+	actual := PipeInt16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int16(3)
+	actual = PipeInt16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompInt8(t *testing.T) {
+	f1 := func(v int8) int8 { return v + 1 }
+	f2 := func(v int8) int8 { return v * 2 }
+
+	expected := int8(7)
+	actual := CompInt8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int8(4)
+	actual = CompInt8(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int8(3)
+	actual = CompInt8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeInt8(t *testing.T) {
+	f1 := func(v int8) int8 { return v + 1 }
+	f2 := func(v int8) int8 { return v * 2 }
+
+	expected := int8(8)
+	actual := PipeInt8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = int8(3)
+	actual = PipeInt8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompUint(t *testing.T) {
+	f1 := func(v uint) uint { return v + 1 }
+	f2 := func(v uint) uint { return v * 2 }
+
+	expected := uint(7)
+	actual := CompUint(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint(4)
+	actual = CompUint(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint(3)
+	actual = CompUint()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeUint(t *testing.T) {
+	f1 := func(v uint) uint { return v + 1 }
+	f2 := func(v uint) uint { return v * 2 }
+
+	expected := uint(8)
+	actual := PipeUint(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint(3)
+	actual = PipeUint()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompUint64(t *testing.T) {
+	f1 := func(v uint64) uint64 { return v + 1 }
+	f2 := func(v uint64) uint64 { return v * 2 }
+
+	expected := uint64(7)
+	actual := CompUint64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint64(4)
+	actual = CompUint64(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint64(3)
+	actual = CompUint64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeUint64(t *testing.T) {
+	f1 := func(v uint64) uint64 { return v + 1 }
+	f2 := func(v uint64) uint64 { return v * 2 }
+
+	expected := uint64(8)
+	actual := PipeUint64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint64(3)
+	actual = PipeUint64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompUint32(t *testing.T) {
+	f1 := func(v uint32) uint32 { return v + 1 }
+	f2 := func(v uint32) uint32 { return v * 2 }
+
+	expected := uint32(7)
+	actual := CompUint32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint32(4)
+	actual = CompUint32(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint32(3)
+	actual = CompUint32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeUint32(t *testing.T) {
+	f1 := func(v uint32) uint32 { return v + 1 }
+	f2 := func(v uint32) uint32 { return v * 2 }
+
+	expected := uint32(8)
+	actual := PipeUint32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint32(3)
+	actual = PipeUint32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompUint16(t *testing.T) {
+	f1 := func(v uint16) uint16 { return v + 1 }
+	f2 := func(v uint16) uint16 { return v * 2 }
+
+	expected := uint16(7)
+	actual := CompUint16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint16(4)
+	actual = CompUint16(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint16(3)
+	actual = CompUint16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeUint16(t *testing.T) {
+	f1 := func(v uint16) uint16 { return v + 1 }
+	f2 := func(v uint16) uint16 { return v * 2 }
+
+	expected := uint16(8)
+	actual := PipeUint16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint16(3)
+	actual = PipeUint16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompUint8(t *testing.T) {
+	f1 := func(v uint8) uint8 { return v + 1 }
+	f2 := func(v uint8) uint8 { return v * 2 }
+
+	expected := uint8(7)
+	actual := CompUint8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint8(4)
+	actual = CompUint8(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint8(3)
+	actual = CompUint8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeUint8(t *testing.T) {
+	f1 := func(v uint8) uint8 { return v + 1 }
+	f2 := func(v uint8) uint8 { return v * 2 }
+
+	expected := uint8(8)
+	actual := PipeUint8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = uint8(3)
+	actual = PipeUint8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompFloat64(t *testing.T) {
+	f1 := func(v float64) float64 { return v + 1 }
+	f2 := func(v float64) float64 { return v * 2 }
+
+	expected := float64(7)
+	actual := CompFloat64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float64(4)
+	actual = CompFloat64(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float64(3)
+	actual = CompFloat64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeFloat64(t *testing.T) {
+	f1 := func(v float64) float64 { return v + 1 }
+	f2 := func(v float64) float64 { return v * 2 }
+
+	expected := float64(8)
+	actual := PipeFloat64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float64(3)
+	actual = PipeFloat64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompFloat32(t *testing.T) {
+	f1 := func(v float32) float32 { return v + 1 }
+	f2 := func(v float32) float32 { return v * 2 }
+
+	expected := float32(7)
+	actual := CompFloat32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float32(4)
+	actual = CompFloat32(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float32(3)
+	actual = CompFloat32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeFloat32(t *testing.T) {
+	f1 := func(v float32) float32 { return v + 1 }
+	f2 := func(v float32) float32 { return v * 2 }
+
+	expected := float32(8)
+	actual := PipeFloat32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = float32(3)
+	actual = PipeFloat32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompStr(t *testing.T) {
+	f1 := func(v string) string { return v + "a" }
+	f2 := func(v string) string { return v + "b" }
+
+	expected := string("xba")
+	actual := CompStr(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = string("xa")
+	actual = CompStr(f1, nil)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = string("x")
+	actual = CompStr()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeStr(t *testing.T) {
+	f1 := func(v string) string { return v + "a" }
+	f2 := func(v string) string { return v + "b" }
+
+	expected := string("xab")
+	actual := PipeStr(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = string("x")
+	actual = PipeStr()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompBool(t *testing.T) {
+	f1 := func(v bool) bool { return !v }
+	f2 := func(v bool) bool { return v }
+
+	expected := bool(false)
+	actual := CompBool(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = bool(false)
+	actual = CompBool(f1, nil)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = bool(true)
+	actual = CompBool()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestCompBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipeBool(t *testing.T) {
+	f1 := func(v bool) bool { return !v }
+	f2 := func(v bool) bool { return v }
+
+	expected := bool(false)
+	actual := PipeBool(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = bool(true)
+	actual = PipeBool()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipeBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,456 @@
+package fp
+
+// GtInt returns the predicate which checks if the item is greater than v.
+func GtInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item > v
+	}
+}
+
+// GeInt returns the predicate which checks if the item is greater than or equal to v.
+func GeInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item >= v
+	}
+}
+
+// LtInt returns the predicate which checks if the item is less than v.
+func LtInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item < v
+	}
+}
+
+// LeInt returns the predicate which checks if the item is less than or equal to v.
+func LeInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item <= v
+	}
+}
+
+// BetweenInt returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenInt(lower, upper int) func(int) bool {
+	return func(item int) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtInt64 returns the predicate which checks if the item is greater than v.
+func GtInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item > v
+	}
+}
+
+// GeInt64 returns the predicate which checks if the item is greater than or equal to v.
+func GeInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item >= v
+	}
+}
+
+// LtInt64 returns the predicate which checks if the item is less than v.
+func LtInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item < v
+	}
+}
+
+// LeInt64 returns the predicate which checks if the item is less than or equal to v.
+func LeInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item <= v
+	}
+}
+
+// BetweenInt64 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenInt64(lower, upper int64) func(int64) bool {
+	return func(item int64) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtInt32 returns the predicate which checks if the item is greater than v.
+func GtInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item > v
+	}
+}
+
+// GeInt32 returns the predicate which checks if the item is greater than or equal to v.
+func GeInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item >= v
+	}
+}
+
+// LtInt32 returns the predicate which checks if the item is less than v.
+func LtInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item < v
+	}
+}
+
+// LeInt32 returns the predicate which checks if the item is less than or equal to v.
+func LeInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item <= v
+	}
+}
+
+// BetweenInt32 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenInt32(lower, upper int32) func(int32) bool {
+	return func(item int32) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtInt16 returns the predicate which checks if the item is greater than v.
+func GtInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item > v
+	}
+}
+
+// GeInt16 returns the predicate which checks if the item is greater than or equal to v.
+func GeInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item >= v
+	}
+}
+
+// LtInt16 returns the predicate which checks if the item is less than v.
+func LtInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item < v
+	}
+}
+
+// LeInt16 returns the predicate which checks if the item is less than or equal to v.
+func LeInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item <= v
+	}
+}
+
+// BetweenInt16 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenInt16(lower, upper int16) func(int16) bool {
+	return func(item int16) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtInt8 returns the predicate which checks if the item is greater than v.
+func GtInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item > v
+	}
+}
+
+// GeInt8 returns the predicate which checks if the item is greater than or equal to v.
+func GeInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item >= v
+	}
+}
+
+// LtInt8 returns the predicate which checks if the item is less than v.
+func LtInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item < v
+	}
+}
+
+// LeInt8 returns the predicate which checks if the item is less than or equal to v.
+func LeInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item <= v
+	}
+}
+
+// BetweenInt8 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenInt8(lower, upper int8) func(int8) bool {
+	return func(item int8) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtUint returns the predicate which checks if the item is greater than v.
+func GtUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item > v
+	}
+}
+
+// GeUint returns the predicate which checks if the item is greater than or equal to v.
+func GeUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item >= v
+	}
+}
+
+// LtUint returns the predicate which checks if the item is less than v.
+func LtUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item < v
+	}
+}
+
+// LeUint returns the predicate which checks if the item is less than or equal to v.
+func LeUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item <= v
+	}
+}
+
+// BetweenUint returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenUint(lower, upper uint) func(uint) bool {
+	return func(item uint) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtUint64 returns the predicate which checks if the item is greater than v.
+func GtUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item > v
+	}
+}
+
+// GeUint64 returns the predicate which checks if the item is greater than or equal to v.
+func GeUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item >= v
+	}
+}
+
+// LtUint64 returns the predicate which checks if the item is less than v.
+func LtUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item < v
+	}
+}
+
+// LeUint64 returns the predicate which checks if the item is less than or equal to v.
+func LeUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item <= v
+	}
+}
+
+// BetweenUint64 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenUint64(lower, upper uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtUint32 returns the predicate which checks if the item is greater than v.
+func GtUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item > v
+	}
+}
+
+// GeUint32 returns the predicate which checks if the item is greater than or equal to v.
+func GeUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item >= v
+	}
+}
+
+// LtUint32 returns the predicate which checks if the item is less than v.
+func LtUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item < v
+	}
+}
+
+// LeUint32 returns the predicate which checks if the item is less than or equal to v.
+func LeUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item <= v
+	}
+}
+
+// BetweenUint32 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenUint32(lower, upper uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtUint16 returns the predicate which checks if the item is greater than v.
+func GtUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item > v
+	}
+}
+
+// GeUint16 returns the predicate which checks if the item is greater than or equal to v.
+func GeUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item >= v
+	}
+}
+
+// LtUint16 returns the predicate which checks if the item is less than v.
+func LtUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item < v
+	}
+}
+
+// LeUint16 returns the predicate which checks if the item is less than or equal to v.
+func LeUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item <= v
+	}
+}
+
+// BetweenUint16 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenUint16(lower, upper uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtUint8 returns the predicate which checks if the item is greater than v.
+func GtUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item > v
+	}
+}
+
+// GeUint8 returns the predicate which checks if the item is greater than or equal to v.
+func GeUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item >= v
+	}
+}
+
+// LtUint8 returns the predicate which checks if the item is less than v.
+func LtUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item < v
+	}
+}
+
+// LeUint8 returns the predicate which checks if the item is less than or equal to v.
+func LeUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item <= v
+	}
+}
+
+// BetweenUint8 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenUint8(lower, upper uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtFloat64 returns the predicate which checks if the item is greater than v.
+func GtFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item > v
+	}
+}
+
+// GeFloat64 returns the predicate which checks if the item is greater than or equal to v.
+func GeFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item >= v
+	}
+}
+
+// LtFloat64 returns the predicate which checks if the item is less than v.
+func LtFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item < v
+	}
+}
+
+// LeFloat64 returns the predicate which checks if the item is less than or equal to v.
+func LeFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item <= v
+	}
+}
+
+// BetweenFloat64 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenFloat64(lower, upper float64) func(float64) bool {
+	return func(item float64) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtFloat32 returns the predicate which checks if the item is greater than v.
+func GtFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item > v
+	}
+}
+
+// GeFloat32 returns the predicate which checks if the item is greater than or equal to v.
+func GeFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item >= v
+	}
+}
+
+// LtFloat32 returns the predicate which checks if the item is less than v.
+func LtFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item < v
+	}
+}
+
+// LeFloat32 returns the predicate which checks if the item is less than or equal to v.
+func LeFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item <= v
+	}
+}
+
+// BetweenFloat32 returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenFloat32(lower, upper float32) func(float32) bool {
+	return func(item float32) bool {
+		return item >= lower && item <= upper
+	}
+}
+
+// GtStr returns the predicate which checks if the item is greater than v.
+func GtStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item > v
+	}
+}
+
+// GeStr returns the predicate which checks if the item is greater than or equal to v.
+func GeStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item >= v
+	}
+}
+
+// LtStr returns the predicate which checks if the item is less than v.
+func LtStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item < v
+	}
+}
+
+// LeStr returns the predicate which checks if the item is less than or equal to v.
+func LeStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item <= v
+	}
+}
+
+// BetweenStr returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func BetweenStr(lower, upper string) func(string) bool {
+	return func(item string) bool {
+		return item >= lower && item <= upper
+	}
+}
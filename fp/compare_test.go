@@ -0,0 +1,436 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareInt(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+
+	expected := []int{4, 5}
+	actual := FilterInt(GtInt(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{3, 4, 5}
+	actual = FilterInt(GeInt(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{1, 2}
+	actual = FilterInt(LtInt(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{1, 2, 3}
+	actual = FilterInt(LeInt(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{2, 3, 4}
+	actual = FilterInt(BetweenInt(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareInt64(t *testing.T) {
+	list := []int64{1, 2, 3, 4, 5}
+
+	expected := []int64{4, 5}
+	actual := FilterInt64(GtInt64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{3, 4, 5}
+	actual = FilterInt64(GeInt64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{1, 2}
+	actual = FilterInt64(LtInt64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{1, 2, 3}
+	actual = FilterInt64(LeInt64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{2, 3, 4}
+	actual = FilterInt64(BetweenInt64(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareInt32(t *testing.T) {
+	list := []int32{1, 2, 3, 4, 5}
+
+	expected := []int32{4, 5}
+	actual := FilterInt32(GtInt32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{3, 4, 5}
+	actual = FilterInt32(GeInt32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{1, 2}
+	actual = FilterInt32(LtInt32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{1, 2, 3}
+	actual = FilterInt32(LeInt32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{2, 3, 4}
+	actual = FilterInt32(BetweenInt32(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareInt16(t *testing.T) {
+	list := []int16{1, 2, 3, 4, 5}
+
+	expected := []int16{4, 5}
+	actual := FilterInt16(GtInt16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{3, 4, 5}
+	actual = FilterInt16(GeInt16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{1, 2}
+	actual = FilterInt16(LtInt16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{1, 2, 3}
+	actual = FilterInt16(LeInt16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{2, 3, 4}
+	actual = FilterInt16(BetweenInt16(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareInt8(t *testing.T) {
+	list := []int8{1, 2, 3, 4, 5}
+
+	expected := []int8{4, 5}
+	actual := FilterInt8(GtInt8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{3, 4, 5}
+	actual = FilterInt8(GeInt8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{1, 2}
+	actual = FilterInt8(LtInt8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{1, 2, 3}
+	actual = FilterInt8(LeInt8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{2, 3, 4}
+	actual = FilterInt8(BetweenInt8(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareUint(t *testing.T) {
+	list := []uint{1, 2, 3, 4, 5}
+
+	expected := []uint{4, 5}
+	actual := FilterUint(GtUint(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{3, 4, 5}
+	actual = FilterUint(GeUint(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{1, 2}
+	actual = FilterUint(LtUint(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{1, 2, 3}
+	actual = FilterUint(LeUint(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{2, 3, 4}
+	actual = FilterUint(BetweenUint(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareUint64(t *testing.T) {
+	list := []uint64{1, 2, 3, 4, 5}
+
+	expected := []uint64{4, 5}
+	actual := FilterUint64(GtUint64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{3, 4, 5}
+	actual = FilterUint64(GeUint64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{1, 2}
+	actual = FilterUint64(LtUint64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{1, 2, 3}
+	actual = FilterUint64(LeUint64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{2, 3, 4}
+	actual = FilterUint64(BetweenUint64(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareUint32(t *testing.T) {
+	list := []uint32{1, 2, 3, 4, 5}
+
+	expected := []uint32{4, 5}
+	actual := FilterUint32(GtUint32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{3, 4, 5}
+	actual = FilterUint32(GeUint32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{1, 2}
+	actual = FilterUint32(LtUint32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{1, 2, 3}
+	actual = FilterUint32(LeUint32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{2, 3, 4}
+	actual = FilterUint32(BetweenUint32(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareUint16(t *testing.T) {
+	list := []uint16{1, 2, 3, 4, 5}
+
+	expected := []uint16{4, 5}
+	actual := FilterUint16(GtUint16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{3, 4, 5}
+	actual = FilterUint16(GeUint16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{1, 2}
+	actual = FilterUint16(LtUint16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{1, 2, 3}
+	actual = FilterUint16(LeUint16(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{2, 3, 4}
+	actual = FilterUint16(BetweenUint16(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareUint8(t *testing.T) {
+	list := []uint8{1, 2, 3, 4, 5}
+
+	expected := []uint8{4, 5}
+	actual := FilterUint8(GtUint8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{3, 4, 5}
+	actual = FilterUint8(GeUint8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{1, 2}
+	actual = FilterUint8(LtUint8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{1, 2, 3}
+	actual = FilterUint8(LeUint8(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{2, 3, 4}
+	actual = FilterUint8(BetweenUint8(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareFloat64(t *testing.T) {
+	list := []float64{1, 2, 3, 4, 5}
+
+	expected := []float64{4, 5}
+	actual := FilterFloat64(GtFloat64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{3, 4, 5}
+	actual = FilterFloat64(GeFloat64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{1, 2}
+	actual = FilterFloat64(LtFloat64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{1, 2, 3}
+	actual = FilterFloat64(LeFloat64(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{2, 3, 4}
+	actual = FilterFloat64(BetweenFloat64(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareFloat32(t *testing.T) {
+	list := []float32{1, 2, 3, 4, 5}
+
+	expected := []float32{4, 5}
+	actual := FilterFloat32(GtFloat32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{3, 4, 5}
+	actual = FilterFloat32(GeFloat32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{1, 2}
+	actual = FilterFloat32(LtFloat32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLtFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{1, 2, 3}
+	actual = FilterFloat32(LeFloat32(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{2, 3, 4}
+	actual = FilterFloat32(BetweenFloat32(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestCompareStr(t *testing.T) {
+	list := []string{"a", "b", "c", "d"}
+
+	expected := []string{"c", "d"}
+	actual := FilterStr(GtStr("b"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGtStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{"a", "b"}
+	actual = FilterStr(LeStr("b"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLeStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{"b", "c"}
+	actual = FilterStr(BetweenStr("b", "c"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetweenStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,155 @@
+package fp
+
+// ComplementInt takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementInt(pred func(int) bool) func(int) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v int) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementInt64 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementInt64(pred func(int64) bool) func(int64) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v int64) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementInt32 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementInt32(pred func(int32) bool) func(int32) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v int32) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementInt16 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementInt16(pred func(int16) bool) func(int16) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v int16) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementInt8 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementInt8(pred func(int8) bool) func(int8) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v int8) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementUint takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementUint(pred func(uint) bool) func(uint) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v uint) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementUint64 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementUint64(pred func(uint64) bool) func(uint64) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v uint64) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementUint32 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementUint32(pred func(uint32) bool) func(uint32) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v uint32) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementUint16 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementUint16(pred func(uint16) bool) func(uint16) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v uint16) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementUint8 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementUint8(pred func(uint8) bool) func(uint8) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v uint8) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementFloat64 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementFloat64(pred func(float64) bool) func(float64) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v float64) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementFloat32 takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementFloat32(pred func(float32) bool) func(float32) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v float32) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementStr takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementStr(pred func(string) bool) func(string) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v string) bool {
+		return !pred(v)
+	}
+}
+
+// ComplementBool takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func ComplementBool(pred func(bool) bool) func(bool) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v bool) bool {
+		return !pred(v)
+	}
+}
@@ -0,0 +1,266 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComplementInt(t *testing.T) {
+	pred := func(v int) bool { return v > 2 }
+	notPred := ComplementInt(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementInt failed.")
+	}
+
+	expected := []int{1, 2}
+	actual := FilterInt(notPred, []int{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementInt(nil) != nil {
+		t.Errorf("TestComplementInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementInt64(t *testing.T) {
+	pred := func(v int64) bool { return v > 2 }
+	notPred := ComplementInt64(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementInt64 failed.")
+	}
+
+	expected := []int64{1, 2}
+	actual := FilterInt64(notPred, []int64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementInt64(nil) != nil {
+		t.Errorf("TestComplementInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementInt32(t *testing.T) {
+	pred := func(v int32) bool { return v > 2 }
+	notPred := ComplementInt32(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementInt32 failed.")
+	}
+
+	expected := []int32{1, 2}
+	actual := FilterInt32(notPred, []int32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementInt32(nil) != nil {
+		t.Errorf("TestComplementInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementInt16(t *testing.T) {
+	pred := func(v int16) bool { return v > 2 }
+	notPred := ComplementInt16(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementInt16 failed.")
+	}
+
+	expected := []int16{1, 2}
+	actual := FilterInt16(notPred, []int16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementInt16(nil) != nil {
+		t.Errorf("TestComplementInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementInt8(t *testing.T) {
+	pred := func(v int8) bool { return v > 2 }
+	notPred := ComplementInt8(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementInt8 failed.")
+	}
+
+	expected := []int8{1, 2}
+	actual := FilterInt8(notPred, []int8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementInt8(nil) != nil {
+		t.Errorf("TestComplementInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementUint(t *testing.T) {
+	pred := func(v uint) bool { return v > 2 }
+	notPred := ComplementUint(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementUint failed.")
+	}
+
+	expected := []uint{1, 2}
+	actual := FilterUint(notPred, []uint{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementUint(nil) != nil {
+		t.Errorf("TestComplementUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementUint64(t *testing.T) {
+	pred := func(v uint64) bool { return v > 2 }
+	notPred := ComplementUint64(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementUint64 failed.")
+	}
+
+	expected := []uint64{1, 2}
+	actual := FilterUint64(notPred, []uint64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementUint64(nil) != nil {
+		t.Errorf("TestComplementUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementUint32(t *testing.T) {
+	pred := func(v uint32) bool { return v > 2 }
+	notPred := ComplementUint32(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementUint32 failed.")
+	}
+
+	expected := []uint32{1, 2}
+	actual := FilterUint32(notPred, []uint32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementUint32(nil) != nil {
+		t.Errorf("TestComplementUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementUint16(t *testing.T) {
+	pred := func(v uint16) bool { return v > 2 }
+	notPred := ComplementUint16(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementUint16 failed.")
+	}
+
+	expected := []uint16{1, 2}
+	actual := FilterUint16(notPred, []uint16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementUint16(nil) != nil {
+		t.Errorf("TestComplementUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementUint8(t *testing.T) {
+	pred := func(v uint8) bool { return v > 2 }
+	notPred := ComplementUint8(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementUint8 failed.")
+	}
+
+	expected := []uint8{1, 2}
+	actual := FilterUint8(notPred, []uint8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementUint8(nil) != nil {
+		t.Errorf("TestComplementUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementFloat64(t *testing.T) {
+	pred := func(v float64) bool { return v > 2 }
+	notPred := ComplementFloat64(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementFloat64 failed.")
+	}
+
+	expected := []float64{1, 2}
+	actual := FilterFloat64(notPred, []float64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementFloat64(nil) != nil {
+		t.Errorf("TestComplementFloat64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementFloat32(t *testing.T) {
+	pred := func(v float32) bool { return v > 2 }
+	notPred := ComplementFloat32(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplementFloat32 failed.")
+	}
+
+	expected := []float32{1, 2}
+	actual := FilterFloat32(notPred, []float32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementFloat32(nil) != nil {
+		t.Errorf("TestComplementFloat32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementStr(t *testing.T) {
+	pred := func(v string) bool { return v == "" }
+	notPred := ComplementStr(pred)
+
+	if notPred("") || !notPred("a") {
+		t.Errorf("TestComplementStr failed.")
+	}
+
+	expected := []string{"a", "b"}
+	actual := FilterStr(notPred, []string{"a", "", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplementStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if ComplementStr(nil) != nil {
+		t.Errorf("TestComplementStr failed. Expected nil function for nil input")
+	}
+}
+
+func TestComplementBool(t *testing.T) {
+	pred := func(v bool) bool { return v }
+	notPred := ComplementBool(pred)
+
+	if notPred(true) || !notPred(false) {
+		t.Errorf("TestComplementBool failed.")
+	}
+
+	if ComplementBool(nil) != nil {
+		t.Errorf("TestComplementBool failed. Expected nil function for nil input")
+	}
+}
@@ -0,0 +1,99 @@
+package fp
+
+// ConstantlyInt returns the function which ignores its argument and always returns v.
+func ConstantlyInt(v int) func(int) int {
+	return func(int) int {
+		return v
+	}
+}
+
+// ConstantlyInt64 returns the function which ignores its argument and always returns v.
+func ConstantlyInt64(v int64) func(int64) int64 {
+	return func(int64) int64 {
+		return v
+	}
+}
+
+// ConstantlyInt32 returns the function which ignores its argument and always returns v.
+func ConstantlyInt32(v int32) func(int32) int32 {
+	return func(int32) int32 {
+		return v
+	}
+}
+
+// ConstantlyInt16 returns the function which ignores its argument and always returns v.
+func ConstantlyInt16(v int16) func(int16) int16 {
+	return func(int16) int16 {
+		return v
+	}
+}
+
+// ConstantlyInt8 returns the function which ignores its argument and always returns v.
+func ConstantlyInt8(v int8) func(int8) int8 {
+	return func(int8) int8 {
+		return v
+	}
+}
+
+// ConstantlyUint returns the function which ignores its argument and always returns v.
+func ConstantlyUint(v uint) func(uint) uint {
+	return func(uint) uint {
+		return v
+	}
+}
+
+// ConstantlyUint64 returns the function which ignores its argument and always returns v.
+func ConstantlyUint64(v uint64) func(uint64) uint64 {
+	return func(uint64) uint64 {
+		return v
+	}
+}
+
+// ConstantlyUint32 returns the function which ignores its argument and always returns v.
+func ConstantlyUint32(v uint32) func(uint32) uint32 {
+	return func(uint32) uint32 {
+		return v
+	}
+}
+
+// ConstantlyUint16 returns the function which ignores its argument and always returns v.
+func ConstantlyUint16(v uint16) func(uint16) uint16 {
+	return func(uint16) uint16 {
+		return v
+	}
+}
+
+// ConstantlyUint8 returns the function which ignores its argument and always returns v.
+func ConstantlyUint8(v uint8) func(uint8) uint8 {
+	return func(uint8) uint8 {
+		return v
+	}
+}
+
+// ConstantlyFloat64 returns the function which ignores its argument and always returns v.
+func ConstantlyFloat64(v float64) func(float64) float64 {
+	return func(float64) float64 {
+		return v
+	}
+}
+
+// ConstantlyFloat32 returns the function which ignores its argument and always returns v.
+func ConstantlyFloat32(v float32) func(float32) float32 {
+	return func(float32) float32 {
+		return v
+	}
+}
+
+// ConstantlyStr returns the function which ignores its argument and always returns v.
+func ConstantlyStr(v string) func(string) string {
+	return func(string) string {
+		return v
+	}
+}
+
+// ConstantlyBool returns the function which ignores its argument and always returns v.
+func ConstantlyBool(v bool) func(bool) bool {
+	return func(bool) bool {
+		return v
+	}
+}
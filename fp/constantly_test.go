@@ -0,0 +1,190 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConstantlyInt(t *testing.T) {
+	f := ConstantlyInt(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyInt failed.")
+	}
+
+	expected := []int{7, 7, 7}
+	actual := MapInt(f, []int{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyInt64(t *testing.T) {
+	f := ConstantlyInt64(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyInt64 failed.")
+	}
+
+	expected := []int64{7, 7, 7}
+	actual := MapInt64(f, []int64{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyInt32(t *testing.T) {
+	f := ConstantlyInt32(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyInt32 failed.")
+	}
+
+	expected := []int32{7, 7, 7}
+	actual := MapInt32(f, []int32{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyInt16(t *testing.T) {
+	f := ConstantlyInt16(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyInt16 failed.")
+	}
+
+	expected := []int16{7, 7, 7}
+	actual := MapInt16(f, []int16{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyInt8(t *testing.T) {
+	f := ConstantlyInt8(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyInt8 failed.")
+	}
+
+	expected := []int8{7, 7, 7}
+	actual := MapInt8(f, []int8{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyUint(t *testing.T) {
+	f := ConstantlyUint(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyUint failed.")
+	}
+
+	expected := []uint{7, 7, 7}
+	actual := MapUint(f, []uint{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyUint64(t *testing.T) {
+	f := ConstantlyUint64(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyUint64 failed.")
+	}
+
+	expected := []uint64{7, 7, 7}
+	actual := MapUint64(f, []uint64{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyUint32(t *testing.T) {
+	f := ConstantlyUint32(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyUint32 failed.")
+	}
+
+	expected := []uint32{7, 7, 7}
+	actual := MapUint32(f, []uint32{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyUint16(t *testing.T) {
+	f := ConstantlyUint16(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyUint16 failed.")
+	}
+
+	expected := []uint16{7, 7, 7}
+	actual := MapUint16(f, []uint16{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyUint8(t *testing.T) {
+	f := ConstantlyUint8(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyUint8 failed.")
+	}
+
+	expected := []uint8{7, 7, 7}
+	actual := MapUint8(f, []uint8{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyFloat64(t *testing.T) {
+	f := ConstantlyFloat64(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyFloat64 failed.")
+	}
+
+	expected := []float64{7, 7, 7}
+	actual := MapFloat64(f, []float64{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyFloat32(t *testing.T) {
+	f := ConstantlyFloat32(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantlyFloat32 failed.")
+	}
+
+	expected := []float32{7, 7, 7}
+	actual := MapFloat32(f, []float32{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantlyFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestConstantlyStr(t *testing.T) {
+	f := ConstantlyStr("a")
+
+	if f("a") != "a" || f("b") != "a" {
+		t.Errorf("TestConstantlyStr failed.")
+	}
+}
+
+func TestConstantlyBool(t *testing.T) {
+	f := ConstantlyBool(true)
+
+	if !f(true) || !f(false) {
+		t.Errorf("TestConstantlyBool failed.")
+	}
+}
@@ -0,0 +1,211 @@
+package fp
+
+// CycleInt repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleInt(n int, list []int) []int {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []int{}
+	}
+
+	newList := make([]int, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleInt64 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleInt64(n int, list []int64) []int64 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []int64{}
+	}
+
+	newList := make([]int64, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleInt32 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleInt32(n int, list []int32) []int32 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []int32{}
+	}
+
+	newList := make([]int32, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleInt16 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleInt16(n int, list []int16) []int16 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []int16{}
+	}
+
+	newList := make([]int16, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleInt8 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleInt8(n int, list []int8) []int8 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []int8{}
+	}
+
+	newList := make([]int8, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleUint repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleUint(n int, list []uint) []uint {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []uint{}
+	}
+
+	newList := make([]uint, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleUint64 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleUint64(n int, list []uint64) []uint64 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleUint32 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleUint32(n int, list []uint32) []uint32 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleUint16 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleUint16(n int, list []uint16) []uint16 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleUint8 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleUint8(n int, list []uint8) []uint8 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleFloat64 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleFloat64(n int, list []float64) []float64 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []float64{}
+	}
+
+	newList := make([]float64, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleFloat32 repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleFloat32(n int, list []float32) []float32 {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []float32{}
+	}
+
+	newList := make([]float32, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleStr repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleStr(n int, list []string) []string {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []string{}
+	}
+
+	newList := make([]string, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+// CycleBool repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func CycleBool(n int, list []bool) []bool {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []bool{}
+	}
+
+	newList := make([]bool, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
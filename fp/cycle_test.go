@@ -0,0 +1,334 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCycleInt(t *testing.T) {
+	expectedList := []int{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleInt(7, []int{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int{1, 2}
+	actualList = CycleInt(2, []int{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int{}
+	actualList = CycleInt(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleInt(0, []int{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleInt64(t *testing.T) {
+	expectedList := []int64{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleInt64(7, []int64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int64{1, 2}
+	actualList = CycleInt64(2, []int64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int64{}
+	actualList = CycleInt64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleInt64(0, []int64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleInt32(t *testing.T) {
+	expectedList := []int32{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleInt32(7, []int32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int32{1, 2}
+	actualList = CycleInt32(2, []int32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int32{}
+	actualList = CycleInt32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleInt32(0, []int32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleInt16(t *testing.T) {
+	expectedList := []int16{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleInt16(7, []int16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int16{1, 2}
+	actualList = CycleInt16(2, []int16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int16{}
+	actualList = CycleInt16(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleInt16(0, []int16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleInt8(t *testing.T) {
+	expectedList := []int8{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleInt8(7, []int8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int8{1, 2}
+	actualList = CycleInt8(2, []int8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int8{}
+	actualList = CycleInt8(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleInt8(0, []int8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleUint(t *testing.T) {
+	expectedList := []uint{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleUint(7, []uint{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint{1, 2}
+	actualList = CycleUint(2, []uint{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint{}
+	actualList = CycleUint(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleUint(0, []uint{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleUint64(t *testing.T) {
+	expectedList := []uint64{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleUint64(7, []uint64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint64{1, 2}
+	actualList = CycleUint64(2, []uint64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint64{}
+	actualList = CycleUint64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleUint64(0, []uint64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleUint32(t *testing.T) {
+	expectedList := []uint32{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleUint32(7, []uint32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint32{1, 2}
+	actualList = CycleUint32(2, []uint32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint32{}
+	actualList = CycleUint32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleUint32(0, []uint32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleUint16(t *testing.T) {
+	expectedList := []uint16{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleUint16(7, []uint16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint16{1, 2}
+	actualList = CycleUint16(2, []uint16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint16{}
+	actualList = CycleUint16(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleUint16(0, []uint16{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleUint8(t *testing.T) {
+	expectedList := []uint8{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleUint8(7, []uint8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint8{1, 2}
+	actualList = CycleUint8(2, []uint8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint8{}
+	actualList = CycleUint8(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleUint8(0, []uint8{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleFloat64(t *testing.T) {
+	expectedList := []float64{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleFloat64(7, []float64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float64{1, 2}
+	actualList = CycleFloat64(2, []float64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float64{}
+	actualList = CycleFloat64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleFloat64(0, []float64{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleFloat32(t *testing.T) {
+	expectedList := []float32{1, 2, 3, 1, 2, 3, 1}
+	actualList := CycleFloat32(7, []float32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float32{1, 2}
+	actualList = CycleFloat32(2, []float32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float32{}
+	actualList = CycleFloat32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = CycleFloat32(0, []float32{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleStr(t *testing.T) {
+	expectedList := []string{"a", "b", "a", "b", "a"}
+	actualList := CycleStr(5, []string{"a", "b"})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []string{}
+	actualList = CycleStr(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestCycleBool(t *testing.T) {
+	expectedList := []bool{true, false, true, false, true}
+	actualList := CycleBool(5, []bool{true, false})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []bool{}
+	actualList = CycleBool(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycleBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
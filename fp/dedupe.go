@@ -0,0 +1,197 @@
+package fp
+
+// DedupeInt removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeInt(list []int) []int {
+	newList := make([]int, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeInt64 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeInt64(list []int64) []int64 {
+	newList := make([]int64, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeInt32 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeInt32(list []int32) []int32 {
+	newList := make([]int32, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeInt16 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeInt16(list []int16) []int16 {
+	newList := make([]int16, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeInt8 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeInt8(list []int8) []int8 {
+	newList := make([]int8, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeUint removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeUint(list []uint) []uint {
+	newList := make([]uint, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeUint64 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeUint64(list []uint64) []uint64 {
+	newList := make([]uint64, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeUint32 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeUint32(list []uint32) []uint32 {
+	newList := make([]uint32, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeUint16 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeUint16(list []uint16) []uint16 {
+	newList := make([]uint16, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeUint8 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeUint8(list []uint8) []uint8 {
+	newList := make([]uint8, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeFloat64 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeFloat64(list []float64) []float64 {
+	newList := make([]float64, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeFloat32 removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeFloat32(list []float32) []float32 {
+	newList := make([]float32, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeStr removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeStr(list []string) []string {
+	newList := make([]string, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DedupeBool removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func DedupeBool(list []bool) []bool {
+	newList := make([]bool, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
@@ -0,0 +1,384 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupeInt(t *testing.T) {
+	list := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []int{1, 2, 1, 3}
+	actualList := DedupeInt(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int{1, 2, 3}
+	expectedList = []int{1, 2, 3}
+	actualList = DedupeInt(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int{}
+	expectedList = []int{}
+	actualList = DedupeInt(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeInt(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeInt64(t *testing.T) {
+	list := []int64{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []int64{1, 2, 1, 3}
+	actualList := DedupeInt64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int64{1, 2, 3}
+	expectedList = []int64{1, 2, 3}
+	actualList = DedupeInt64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int64{}
+	expectedList = []int64{}
+	actualList = DedupeInt64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeInt64(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeInt32(t *testing.T) {
+	list := []int32{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []int32{1, 2, 1, 3}
+	actualList := DedupeInt32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int32{1, 2, 3}
+	expectedList = []int32{1, 2, 3}
+	actualList = DedupeInt32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int32{}
+	expectedList = []int32{}
+	actualList = DedupeInt32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeInt32(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeInt16(t *testing.T) {
+	list := []int16{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []int16{1, 2, 1, 3}
+	actualList := DedupeInt16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int16{1, 2, 3}
+	expectedList = []int16{1, 2, 3}
+	actualList = DedupeInt16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int16{}
+	expectedList = []int16{}
+	actualList = DedupeInt16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeInt16(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeInt8(t *testing.T) {
+	list := []int8{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []int8{1, 2, 1, 3}
+	actualList := DedupeInt8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int8{1, 2, 3}
+	expectedList = []int8{1, 2, 3}
+	actualList = DedupeInt8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []int8{}
+	expectedList = []int8{}
+	actualList = DedupeInt8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeInt8(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeUint(t *testing.T) {
+	list := []uint{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []uint{1, 2, 1, 3}
+	actualList := DedupeUint(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint{1, 2, 3}
+	expectedList = []uint{1, 2, 3}
+	actualList = DedupeUint(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint{}
+	expectedList = []uint{}
+	actualList = DedupeUint(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeUint(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeUint64(t *testing.T) {
+	list := []uint64{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []uint64{1, 2, 1, 3}
+	actualList := DedupeUint64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint64{1, 2, 3}
+	expectedList = []uint64{1, 2, 3}
+	actualList = DedupeUint64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint64{}
+	expectedList = []uint64{}
+	actualList = DedupeUint64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeUint64(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeUint32(t *testing.T) {
+	list := []uint32{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []uint32{1, 2, 1, 3}
+	actualList := DedupeUint32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint32{1, 2, 3}
+	expectedList = []uint32{1, 2, 3}
+	actualList = DedupeUint32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint32{}
+	expectedList = []uint32{}
+	actualList = DedupeUint32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeUint32(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeUint16(t *testing.T) {
+	list := []uint16{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []uint16{1, 2, 1, 3}
+	actualList := DedupeUint16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint16{1, 2, 3}
+	expectedList = []uint16{1, 2, 3}
+	actualList = DedupeUint16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint16{}
+	expectedList = []uint16{}
+	actualList = DedupeUint16(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeUint16(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeUint8(t *testing.T) {
+	list := []uint8{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []uint8{1, 2, 1, 3}
+	actualList := DedupeUint8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint8{1, 2, 3}
+	expectedList = []uint8{1, 2, 3}
+	actualList = DedupeUint8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []uint8{}
+	expectedList = []uint8{}
+	actualList = DedupeUint8(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeUint8(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeFloat64(t *testing.T) {
+	list := []float64{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []float64{1, 2, 1, 3}
+	actualList := DedupeFloat64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []float64{1, 2, 3}
+	expectedList = []float64{1, 2, 3}
+	actualList = DedupeFloat64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []float64{}
+	expectedList = []float64{}
+	actualList = DedupeFloat64(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeFloat64(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeFloat32(t *testing.T) {
+	list := []float32{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []float32{1, 2, 1, 3}
+	actualList := DedupeFloat32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []float32{1, 2, 3}
+	expectedList = []float32{1, 2, 3}
+	actualList = DedupeFloat32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []float32{}
+	expectedList = []float32{}
+	actualList = DedupeFloat32(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeFloat32(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeStr(t *testing.T) {
+	list := []string{"a", "a", "b", "b", "b", "a", "c", "c"}
+	expectedList := []string{"a", "b", "a", "c"}
+	actualList := DedupeStr(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []string{}
+	expectedList = []string{}
+	actualList = DedupeStr(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeStr(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestDedupeBool(t *testing.T) {
+	list := []bool{true, true, false, false, true}
+	expectedList := []bool{true, false, true}
+	actualList := DedupeBool(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []bool{}
+	expectedList = []bool{}
+	actualList = DedupeBool(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = DedupeBool(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupeBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
@@ -0,0 +1,33 @@
+package fp
+
+// DeepMerge merges nested maps of type map[string]interface{} and returns a new map.
+// When a key exists in more than one map and both values are of type map[string]interface{},
+// they are merged recursively. Otherwise the value from the latter map is kept.
+// Input maps are not modified.
+//
+// Example:
+//	defaults := map[string]interface{}{"db": map[string]interface{}{"host": "localhost", "port": 5432}}
+//	config := map[string]interface{}{"db": map[string]interface{}{"host": "db.example.com"}}
+//	fp.DeepMerge(defaults, config) // Returns map[db:map[host:db.example.com port:5432]]
+func DeepMerge(maps ...map[string]interface{}) map[string]interface{} {
+	newMap := make(map[string]interface{})
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = deepMergeValue(newMap[k], v)
+		}
+	}
+	return newMap
+}
+
+func deepMergeValue(merged, v interface{}) interface{} {
+	vMap, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	mergedMap, ok := merged.(map[string]interface{})
+	if !ok {
+		return DeepMerge(vMap)
+	}
+	return DeepMerge(mergedMap, vMap)
+}
@@ -0,0 +1,52 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeepMerge(t *testing.T) {
+	defaults := map[string]interface{}{
+		"name": "app",
+		"db":   map[string]interface{}{"host": "localhost", "port": 5432},
+		"tags": []string{"a"},
+	}
+	config := map[string]interface{}{
+		"db":   map[string]interface{}{"host": "db.example.com"},
+		"tags": []string{"b"},
+	}
+	override := map[string]interface{}{
+		"db": map[string]interface{}{"pool": map[string]interface{}{"size": 10}},
+	}
+
+	expected := map[string]interface{}{
+		"name": "app",
+		"db":   map[string]interface{}{"host": "db.example.com", "port": 5432, "pool": map[string]interface{}{"size": 10}},
+		"tags": []string{"b"},
+	}
+	actual := DeepMerge(defaults, nil, config, override)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDeepMerge failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// Inputs are not modified
+	expectedDB := map[string]interface{}{"host": "localhost", "port": 5432}
+	if !reflect.DeepEqual(expectedDB, defaults["db"]) {
+		t.Errorf("TestDeepMerge failed. Input modified=%v", defaults["db"])
+	}
+
+	actual["db"].(map[string]interface{})["host"] = "changed"
+	if config["db"].(map[string]interface{})["host"] != "db.example.com" {
+		t.Errorf("TestDeepMerge failed. Result shares nested map with input")
+	}
+
+	// Non map value replaces map value
+	actual = DeepMerge(map[string]interface{}{"db": map[string]interface{}{"host": "a"}}, map[string]interface{}{"db": "none"})
+	if !reflect.DeepEqual(map[string]interface{}{"db": "none"}, actual) {
+		t.Errorf("TestDeepMerge failed. actual=%v", actual)
+	}
+
+	if len(DeepMerge()) != 0 {
+		t.Errorf("TestDeepMerge failed. Expected empty map")
+	}
+}
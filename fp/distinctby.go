@@ -0,0 +1,4033 @@
+package fp
+
+// DistinctByInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByInt(f func(int) int, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntInt64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntInt64(f func(int) int64, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntInt32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntInt32(f func(int) int32, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntInt16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntInt16(f func(int) int16, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntInt8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntInt8(f func(int) int8, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntUint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntUint(f func(int) uint, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntUint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntUint64(f func(int) uint64, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntUint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntUint32(f func(int) uint32, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntUint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntUint16(f func(int) uint16, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntUint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntUint8(f func(int) uint8, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntStr(f func(int) string, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByIntBool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntBool(f func(int) bool, list []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	newList := make([]int, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Int(f func(int64) int, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64(f func(int64) int64, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Int32(f func(int64) int32, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Int16(f func(int64) int16, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Int8(f func(int64) int8, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Uint(f func(int64) uint, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Uint64(f func(int64) uint64, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Uint32(f func(int64) uint32, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Uint16(f func(int64) uint16, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Uint8(f func(int64) uint8, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Str(f func(int64) string, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt64Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type int64
+//	Empty list if either of arguments is nil
+func DistinctByInt64Bool(f func(int64) bool, list []int64) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+
+	newList := make([]int64, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Int(f func(int32) int, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Int64(f func(int32) int64, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32(f func(int32) int32, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Int16(f func(int32) int16, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Int8(f func(int32) int8, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Uint(f func(int32) uint, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Uint64(f func(int32) uint64, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Uint32(f func(int32) uint32, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Uint16(f func(int32) uint16, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Uint8(f func(int32) uint8, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Str(f func(int32) string, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt32Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type int32
+//	Empty list if either of arguments is nil
+func DistinctByInt32Bool(f func(int32) bool, list []int32) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+
+	newList := make([]int32, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Int(f func(int16) int, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Int64(f func(int16) int64, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Int32(f func(int16) int32, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16(f func(int16) int16, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Int8(f func(int16) int8, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Uint(f func(int16) uint, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Uint64(f func(int16) uint64, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Uint32(f func(int16) uint32, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Uint16(f func(int16) uint16, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Uint8(f func(int16) uint8, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Str(f func(int16) string, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt16Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type int16
+//	Empty list if either of arguments is nil
+func DistinctByInt16Bool(f func(int16) bool, list []int16) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+
+	newList := make([]int16, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Int(f func(int8) int, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Int64(f func(int8) int64, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Int32(f func(int8) int32, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Int16(f func(int8) int16, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8(f func(int8) int8, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Uint(f func(int8) uint, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Uint64(f func(int8) uint64, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Uint32(f func(int8) uint32, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Uint16(f func(int8) uint16, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Uint8(f func(int8) uint8, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Str(f func(int8) string, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByInt8Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type int8
+//	Empty list if either of arguments is nil
+func DistinctByInt8Bool(f func(int8) bool, list []int8) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+
+	newList := make([]int8, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintInt(f func(uint) int, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintInt64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintInt64(f func(uint) int64, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintInt32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintInt32(f func(uint) int32, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintInt16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintInt16(f func(uint) int16, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintInt8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintInt8(f func(uint) int8, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUint(f func(uint) uint, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintUint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintUint64(f func(uint) uint64, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintUint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintUint32(f func(uint) uint32, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintUint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintUint16(f func(uint) uint16, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintUint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintUint8(f func(uint) uint8, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintStr(f func(uint) string, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUintBool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type uint
+//	Empty list if either of arguments is nil
+func DistinctByUintBool(f func(uint) bool, list []uint) []uint {
+	if f == nil {
+		return []uint{}
+	}
+
+	newList := make([]uint, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Int(f func(uint64) int, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Int64(f func(uint64) int64, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Int32(f func(uint64) int32, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Int16(f func(uint64) int16, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Int8(f func(uint64) int8, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Uint(f func(uint64) uint, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64(f func(uint64) uint64, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Uint32(f func(uint64) uint32, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Uint16(f func(uint64) uint16, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Uint8(f func(uint64) uint8, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Str(f func(uint64) string, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint64Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type uint64
+//	Empty list if either of arguments is nil
+func DistinctByUint64Bool(f func(uint64) bool, list []uint64) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Int(f func(uint32) int, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Int64(f func(uint32) int64, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Int32(f func(uint32) int32, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Int16(f func(uint32) int16, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Int8(f func(uint32) int8, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Uint(f func(uint32) uint, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Uint64(f func(uint32) uint64, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32(f func(uint32) uint32, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Uint16(f func(uint32) uint16, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Uint8(f func(uint32) uint8, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Str(f func(uint32) string, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint32Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type uint32
+//	Empty list if either of arguments is nil
+func DistinctByUint32Bool(f func(uint32) bool, list []uint32) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Int(f func(uint16) int, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Int64(f func(uint16) int64, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Int32(f func(uint16) int32, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Int16(f func(uint16) int16, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Int8(f func(uint16) int8, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Uint(f func(uint16) uint, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Uint64(f func(uint16) uint64, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Uint32(f func(uint16) uint32, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16(f func(uint16) uint16, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Uint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Uint8(f func(uint16) uint8, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Str(f func(uint16) string, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint16Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type uint16
+//	Empty list if either of arguments is nil
+func DistinctByUint16Bool(f func(uint16) bool, list []uint16) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Int removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Int(f func(uint8) int, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Int64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Int64(f func(uint8) int64, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Int32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Int32(f func(uint8) int32, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Int16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Int16(f func(uint8) int16, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Int8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Int8(f func(uint8) int8, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Uint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Uint(f func(uint8) uint, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Uint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Uint64(f func(uint8) uint64, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Uint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Uint32(f func(uint8) uint32, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Uint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Uint16(f func(uint8) uint16, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8(f func(uint8) uint8, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Str removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Str(f func(uint8) string, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByUint8Bool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type uint8
+//	Empty list if either of arguments is nil
+func DistinctByUint8Bool(f func(uint8) bool, list []uint8) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrInt(f func(string) int, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrInt64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrInt64(f func(string) int64, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrInt32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrInt32(f func(string) int32, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrInt16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrInt16(f func(string) int16, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrInt8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrInt8(f func(string) int8, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrUint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrUint(f func(string) uint, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrUint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrUint64(f func(string) uint64, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrUint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrUint32(f func(string) uint32, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrUint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrUint16(f func(string) uint16, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrUint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrUint8(f func(string) uint8, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStr(f func(string) string, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByStrBool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrBool(f func(string) bool, list []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	newList := make([]string, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolInt(f func(bool) int, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolInt64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: int64
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolInt64(f func(bool) int64, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolInt32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: int32
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolInt32(f func(bool) int32, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[int32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolInt16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: int16
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolInt16(f func(bool) int16, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[int16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolInt8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: int8
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolInt8(f func(bool) int8, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[int8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolUint removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: uint
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolUint(f func(bool) uint, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[uint]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolUint64 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: uint64
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolUint64(f func(bool) uint64, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[uint64]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolUint32 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: uint32
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolUint32(f func(bool) uint32, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[uint32]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolUint16 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: uint16
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolUint16(f func(bool) uint16, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[uint16]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolUint8 removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: uint8
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolUint8(f func(bool) uint8, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[uint8]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBoolStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBoolStr(f func(bool) string, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// DistinctByBool removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool and returns key of type: bool
+//	2. List
+//
+// Returns
+//	New List of type bool
+//	Empty list if either of arguments is nil
+func DistinctByBool(f func(bool) bool, list []bool) []bool {
+	if f == nil {
+		return []bool{}
+	}
+
+	newList := make([]bool, 0, len(list))
+	s := make(map[bool]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
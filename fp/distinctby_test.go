@@ -0,0 +1,3279 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistinctByInt(t *testing.T) {
+	key := func(v int) int { return int(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByInt(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByInt(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntInt64(t *testing.T) {
+	key := func(v int) int64 { return int64(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntInt64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntInt64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntInt64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntInt32(t *testing.T) {
+	key := func(v int) int32 { return int32(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntInt32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntInt32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntInt32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntInt16(t *testing.T) {
+	key := func(v int) int16 { return int16(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntInt16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntInt16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntInt16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntInt8(t *testing.T) {
+	key := func(v int) int8 { return int8(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntInt8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntInt8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntInt8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntUint(t *testing.T) {
+	key := func(v int) uint { return uint(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntUint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntUint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntUint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntUint64(t *testing.T) {
+	key := func(v int) uint64 { return uint64(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntUint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntUint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntUint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntUint32(t *testing.T) {
+	key := func(v int) uint32 { return uint32(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntUint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntUint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntUint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntUint16(t *testing.T) {
+	key := func(v int) uint16 { return uint16(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntUint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntUint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntUint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntUint8(t *testing.T) {
+	key := func(v int) uint8 { return uint8(v % 3) }
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2, 3}
+	actualList := DistinctByIntUint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntUint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntUint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntStr(t *testing.T) {
+	key := func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []int{1, 2, 3, 4, 5, 6}
+	expectedList := []int{1, 2}
+	actualList := DistinctByIntStr(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntStr(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntStr(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByIntBool(t *testing.T) {
+	key := func(v int) bool { return v%2 == 0 }
+
+	list := []int{1, 3, 2, 5, 4}
+	expectedList := []int{1, 2}
+	actualList := DistinctByIntBool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int{}
+	actualList = DistinctByIntBool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByIntBool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByIntBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Int(t *testing.T) {
+	key := func(v int64) int { return int(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64(t *testing.T) {
+	key := func(v int64) int64 { return int64(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Int32(t *testing.T) {
+	key := func(v int64) int32 { return int32(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Int16(t *testing.T) {
+	key := func(v int64) int16 { return int16(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Int8(t *testing.T) {
+	key := func(v int64) int8 { return int8(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Uint(t *testing.T) {
+	key := func(v int64) uint { return uint(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Uint64(t *testing.T) {
+	key := func(v int64) uint64 { return uint64(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Uint32(t *testing.T) {
+	key := func(v int64) uint32 { return uint32(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Uint16(t *testing.T) {
+	key := func(v int64) uint16 { return uint16(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Uint8(t *testing.T) {
+	key := func(v int64) uint8 { return uint8(v % 3) }
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2, 3}
+	actualList := DistinctByInt64Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Str(t *testing.T) {
+	key := func(v int64) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []int64{1, 2, 3, 4, 5, 6}
+	expectedList := []int64{1, 2}
+	actualList := DistinctByInt64Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt64Bool(t *testing.T) {
+	key := func(v int64) bool { return v%2 == 0 }
+
+	list := []int64{1, 3, 2, 5, 4}
+	expectedList := []int64{1, 2}
+	actualList := DistinctByInt64Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int64{}
+	actualList = DistinctByInt64Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt64Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Int(t *testing.T) {
+	key := func(v int32) int { return int(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Int64(t *testing.T) {
+	key := func(v int32) int64 { return int64(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32(t *testing.T) {
+	key := func(v int32) int32 { return int32(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Int16(t *testing.T) {
+	key := func(v int32) int16 { return int16(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Int8(t *testing.T) {
+	key := func(v int32) int8 { return int8(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Uint(t *testing.T) {
+	key := func(v int32) uint { return uint(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Uint64(t *testing.T) {
+	key := func(v int32) uint64 { return uint64(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Uint32(t *testing.T) {
+	key := func(v int32) uint32 { return uint32(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Uint16(t *testing.T) {
+	key := func(v int32) uint16 { return uint16(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Uint8(t *testing.T) {
+	key := func(v int32) uint8 { return uint8(v % 3) }
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2, 3}
+	actualList := DistinctByInt32Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Str(t *testing.T) {
+	key := func(v int32) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []int32{1, 2, 3, 4, 5, 6}
+	expectedList := []int32{1, 2}
+	actualList := DistinctByInt32Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt32Bool(t *testing.T) {
+	key := func(v int32) bool { return v%2 == 0 }
+
+	list := []int32{1, 3, 2, 5, 4}
+	expectedList := []int32{1, 2}
+	actualList := DistinctByInt32Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int32{}
+	actualList = DistinctByInt32Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt32Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Int(t *testing.T) {
+	key := func(v int16) int { return int(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Int64(t *testing.T) {
+	key := func(v int16) int64 { return int64(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Int32(t *testing.T) {
+	key := func(v int16) int32 { return int32(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16(t *testing.T) {
+	key := func(v int16) int16 { return int16(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Int8(t *testing.T) {
+	key := func(v int16) int8 { return int8(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Uint(t *testing.T) {
+	key := func(v int16) uint { return uint(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Uint64(t *testing.T) {
+	key := func(v int16) uint64 { return uint64(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Uint32(t *testing.T) {
+	key := func(v int16) uint32 { return uint32(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Uint16(t *testing.T) {
+	key := func(v int16) uint16 { return uint16(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Uint8(t *testing.T) {
+	key := func(v int16) uint8 { return uint8(v % 3) }
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2, 3}
+	actualList := DistinctByInt16Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Str(t *testing.T) {
+	key := func(v int16) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []int16{1, 2, 3, 4, 5, 6}
+	expectedList := []int16{1, 2}
+	actualList := DistinctByInt16Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt16Bool(t *testing.T) {
+	key := func(v int16) bool { return v%2 == 0 }
+
+	list := []int16{1, 3, 2, 5, 4}
+	expectedList := []int16{1, 2}
+	actualList := DistinctByInt16Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int16{}
+	actualList = DistinctByInt16Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt16Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Int(t *testing.T) {
+	key := func(v int8) int { return int(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Int64(t *testing.T) {
+	key := func(v int8) int64 { return int64(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Int32(t *testing.T) {
+	key := func(v int8) int32 { return int32(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Int16(t *testing.T) {
+	key := func(v int8) int16 { return int16(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8(t *testing.T) {
+	key := func(v int8) int8 { return int8(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Uint(t *testing.T) {
+	key := func(v int8) uint { return uint(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Uint64(t *testing.T) {
+	key := func(v int8) uint64 { return uint64(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Uint32(t *testing.T) {
+	key := func(v int8) uint32 { return uint32(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Uint16(t *testing.T) {
+	key := func(v int8) uint16 { return uint16(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Uint8(t *testing.T) {
+	key := func(v int8) uint8 { return uint8(v % 3) }
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2, 3}
+	actualList := DistinctByInt8Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Str(t *testing.T) {
+	key := func(v int8) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []int8{1, 2, 3, 4, 5, 6}
+	expectedList := []int8{1, 2}
+	actualList := DistinctByInt8Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByInt8Bool(t *testing.T) {
+	key := func(v int8) bool { return v%2 == 0 }
+
+	list := []int8{1, 3, 2, 5, 4}
+	expectedList := []int8{1, 2}
+	actualList := DistinctByInt8Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []int8{}
+	actualList = DistinctByInt8Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByInt8Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByInt8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintInt(t *testing.T) {
+	key := func(v uint) int { return int(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintInt(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintInt(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintInt(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintInt64(t *testing.T) {
+	key := func(v uint) int64 { return int64(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintInt64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintInt64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintInt64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintInt32(t *testing.T) {
+	key := func(v uint) int32 { return int32(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintInt32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintInt32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintInt32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintInt16(t *testing.T) {
+	key := func(v uint) int16 { return int16(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintInt16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintInt16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintInt16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintInt8(t *testing.T) {
+	key := func(v uint) int8 { return int8(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintInt8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintInt8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintInt8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint(t *testing.T) {
+	key := func(v uint) uint { return uint(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintUint64(t *testing.T) {
+	key := func(v uint) uint64 { return uint64(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintUint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintUint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintUint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintUint32(t *testing.T) {
+	key := func(v uint) uint32 { return uint32(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintUint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintUint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintUint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintUint16(t *testing.T) {
+	key := func(v uint) uint16 { return uint16(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintUint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintUint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintUint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintUint8(t *testing.T) {
+	key := func(v uint) uint8 { return uint8(v % 3) }
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2, 3}
+	actualList := DistinctByUintUint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintUint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintUint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintStr(t *testing.T) {
+	key := func(v uint) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []uint{1, 2, 3, 4, 5, 6}
+	expectedList := []uint{1, 2}
+	actualList := DistinctByUintStr(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintStr(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintStr(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUintBool(t *testing.T) {
+	key := func(v uint) bool { return v%2 == 0 }
+
+	list := []uint{1, 3, 2, 5, 4}
+	expectedList := []uint{1, 2}
+	actualList := DistinctByUintBool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint{}
+	actualList = DistinctByUintBool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUintBool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUintBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Int(t *testing.T) {
+	key := func(v uint64) int { return int(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Int64(t *testing.T) {
+	key := func(v uint64) int64 { return int64(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Int32(t *testing.T) {
+	key := func(v uint64) int32 { return int32(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Int16(t *testing.T) {
+	key := func(v uint64) int16 { return int16(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Int8(t *testing.T) {
+	key := func(v uint64) int8 { return int8(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Uint(t *testing.T) {
+	key := func(v uint64) uint { return uint(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64(t *testing.T) {
+	key := func(v uint64) uint64 { return uint64(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Uint32(t *testing.T) {
+	key := func(v uint64) uint32 { return uint32(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Uint16(t *testing.T) {
+	key := func(v uint64) uint16 { return uint16(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Uint8(t *testing.T) {
+	key := func(v uint64) uint8 { return uint8(v % 3) }
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2, 3}
+	actualList := DistinctByUint64Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Str(t *testing.T) {
+	key := func(v uint64) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []uint64{1, 2, 3, 4, 5, 6}
+	expectedList := []uint64{1, 2}
+	actualList := DistinctByUint64Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint64Bool(t *testing.T) {
+	key := func(v uint64) bool { return v%2 == 0 }
+
+	list := []uint64{1, 3, 2, 5, 4}
+	expectedList := []uint64{1, 2}
+	actualList := DistinctByUint64Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint64{}
+	actualList = DistinctByUint64Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint64Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint64Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Int(t *testing.T) {
+	key := func(v uint32) int { return int(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Int64(t *testing.T) {
+	key := func(v uint32) int64 { return int64(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Int32(t *testing.T) {
+	key := func(v uint32) int32 { return int32(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Int16(t *testing.T) {
+	key := func(v uint32) int16 { return int16(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Int8(t *testing.T) {
+	key := func(v uint32) int8 { return int8(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Uint(t *testing.T) {
+	key := func(v uint32) uint { return uint(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Uint64(t *testing.T) {
+	key := func(v uint32) uint64 { return uint64(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32(t *testing.T) {
+	key := func(v uint32) uint32 { return uint32(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Uint16(t *testing.T) {
+	key := func(v uint32) uint16 { return uint16(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Uint8(t *testing.T) {
+	key := func(v uint32) uint8 { return uint8(v % 3) }
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2, 3}
+	actualList := DistinctByUint32Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Str(t *testing.T) {
+	key := func(v uint32) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []uint32{1, 2, 3, 4, 5, 6}
+	expectedList := []uint32{1, 2}
+	actualList := DistinctByUint32Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint32Bool(t *testing.T) {
+	key := func(v uint32) bool { return v%2 == 0 }
+
+	list := []uint32{1, 3, 2, 5, 4}
+	expectedList := []uint32{1, 2}
+	actualList := DistinctByUint32Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint32{}
+	actualList = DistinctByUint32Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint32Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint32Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Int(t *testing.T) {
+	key := func(v uint16) int { return int(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Int64(t *testing.T) {
+	key := func(v uint16) int64 { return int64(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Int32(t *testing.T) {
+	key := func(v uint16) int32 { return int32(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Int16(t *testing.T) {
+	key := func(v uint16) int16 { return int16(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Int8(t *testing.T) {
+	key := func(v uint16) int8 { return int8(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Uint(t *testing.T) {
+	key := func(v uint16) uint { return uint(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Uint64(t *testing.T) {
+	key := func(v uint16) uint64 { return uint64(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Uint32(t *testing.T) {
+	key := func(v uint16) uint32 { return uint32(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16(t *testing.T) {
+	key := func(v uint16) uint16 { return uint16(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Uint8(t *testing.T) {
+	key := func(v uint16) uint8 { return uint8(v % 3) }
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2, 3}
+	actualList := DistinctByUint16Uint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Uint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Uint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Uint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Str(t *testing.T) {
+	key := func(v uint16) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []uint16{1, 2, 3, 4, 5, 6}
+	expectedList := []uint16{1, 2}
+	actualList := DistinctByUint16Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint16Bool(t *testing.T) {
+	key := func(v uint16) bool { return v%2 == 0 }
+
+	list := []uint16{1, 3, 2, 5, 4}
+	expectedList := []uint16{1, 2}
+	actualList := DistinctByUint16Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint16{}
+	actualList = DistinctByUint16Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint16Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint16Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Int(t *testing.T) {
+	key := func(v uint8) int { return int(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Int(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Int(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Int(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Int64(t *testing.T) {
+	key := func(v uint8) int64 { return int64(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Int64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Int64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Int64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Int32(t *testing.T) {
+	key := func(v uint8) int32 { return int32(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Int32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Int32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Int32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Int16(t *testing.T) {
+	key := func(v uint8) int16 { return int16(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Int16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Int16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Int16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Int8(t *testing.T) {
+	key := func(v uint8) int8 { return int8(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Int8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Int8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Int8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Int8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Uint(t *testing.T) {
+	key := func(v uint8) uint { return uint(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Uint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Uint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Uint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Uint64(t *testing.T) {
+	key := func(v uint8) uint64 { return uint64(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Uint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Uint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Uint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Uint32(t *testing.T) {
+	key := func(v uint8) uint32 { return uint32(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Uint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Uint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Uint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Uint16(t *testing.T) {
+	key := func(v uint8) uint16 { return uint16(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8Uint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Uint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Uint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Uint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8(t *testing.T) {
+	key := func(v uint8) uint8 { return uint8(v % 3) }
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2, 3}
+	actualList := DistinctByUint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Str(t *testing.T) {
+	key := func(v uint8) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []uint8{1, 2, 3, 4, 5, 6}
+	expectedList := []uint8{1, 2}
+	actualList := DistinctByUint8Str(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Str(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Str(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Str failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByUint8Bool(t *testing.T) {
+	key := func(v uint8) bool { return v%2 == 0 }
+
+	list := []uint8{1, 3, 2, 5, 4}
+	expectedList := []uint8{1, 2}
+	actualList := DistinctByUint8Bool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []uint8{}
+	actualList = DistinctByUint8Bool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByUint8Bool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByUint8Bool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrInt(t *testing.T) {
+	key := func(v string) int { return int(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrInt(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrInt(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrInt(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrInt64(t *testing.T) {
+	key := func(v string) int64 { return int64(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrInt64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrInt64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrInt64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrInt32(t *testing.T) {
+	key := func(v string) int32 { return int32(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrInt32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrInt32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrInt32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrInt16(t *testing.T) {
+	key := func(v string) int16 { return int16(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrInt16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrInt16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrInt16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrInt8(t *testing.T) {
+	key := func(v string) int8 { return int8(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrInt8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrInt8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrInt8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrUint(t *testing.T) {
+	key := func(v string) uint { return uint(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrUint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrUint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrUint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrUint64(t *testing.T) {
+	key := func(v string) uint64 { return uint64(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrUint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrUint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrUint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrUint32(t *testing.T) {
+	key := func(v string) uint32 { return uint32(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrUint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrUint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrUint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrUint16(t *testing.T) {
+	key := func(v string) uint16 { return uint16(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrUint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrUint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrUint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrUint8(t *testing.T) {
+	key := func(v string) uint8 { return uint8(len(v)) }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb", "eee"}
+	actualList := DistinctByStrUint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrUint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrUint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStr(t *testing.T) {
+	key := func(v string) string { return v[:1] }
+
+	list := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	expectedList := []string{"apple", "banana", "cherry"}
+	actualList := DistinctByStr(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStr(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStr(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByStrBool(t *testing.T) {
+	key := func(v string) bool { return len(v) > 1 }
+
+	list := []string{"a", "bb", "cc", "d", "eee"}
+	expectedList := []string{"a", "bb"}
+	actualList := DistinctByStrBool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []string{}
+	actualList = DistinctByStrBool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByStrBool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByStrBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolInt(t *testing.T) {
+	key := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolInt(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolInt(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolInt(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolInt64(t *testing.T) {
+	key := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolInt64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolInt64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolInt64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolInt32(t *testing.T) {
+	key := func(v bool) int32 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolInt32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolInt32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolInt32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolInt16(t *testing.T) {
+	key := func(v bool) int16 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolInt16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolInt16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolInt16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolInt8(t *testing.T) {
+	key := func(v bool) int8 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolInt8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolInt8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolInt8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolInt8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolUint(t *testing.T) {
+	key := func(v bool) uint {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolUint(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolUint(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolUint(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolUint64(t *testing.T) {
+	key := func(v bool) uint64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolUint64(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolUint64(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolUint64(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint64 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolUint32(t *testing.T) {
+	key := func(v bool) uint32 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolUint32(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolUint32(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolUint32(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint32 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolUint16(t *testing.T) {
+	key := func(v bool) uint16 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolUint16(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolUint16(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolUint16(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint16 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolUint8(t *testing.T) {
+	key := func(v bool) uint8 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []bool{false, false, true, true}
+	expectedList := []bool{false, true}
+	actualList := DistinctByBoolUint8(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolUint8(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolUint8(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolUint8 failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBoolStr(t *testing.T) {
+	key := func(v bool) string {
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+
+	list := []bool{true, true, false, true, false}
+	expectedList := []bool{true, false}
+	actualList := DistinctByBoolStr(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBoolStr(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBoolStr(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBoolStr failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+
+func TestDistinctByBool(t *testing.T) {
+	key := func(v bool) bool { return !v }
+
+	list := []bool{true, true, false, true, false}
+	expectedList := []bool{true, false}
+	actualList := DistinctByBool(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []bool{}
+	actualList = DistinctByBool(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctByBool(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctByBool failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
@@ -0,0 +1,197 @@
+package fp
+
+// EqInt returns the predicate which checks if the item is equal to v.
+func EqInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item == v
+	}
+}
+
+// NeInt returns the predicate which checks if the item is not equal to v.
+func NeInt(v int) func(int) bool {
+	return func(item int) bool {
+		return item != v
+	}
+}
+
+// EqInt64 returns the predicate which checks if the item is equal to v.
+func EqInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item == v
+	}
+}
+
+// NeInt64 returns the predicate which checks if the item is not equal to v.
+func NeInt64(v int64) func(int64) bool {
+	return func(item int64) bool {
+		return item != v
+	}
+}
+
+// EqInt32 returns the predicate which checks if the item is equal to v.
+func EqInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item == v
+	}
+}
+
+// NeInt32 returns the predicate which checks if the item is not equal to v.
+func NeInt32(v int32) func(int32) bool {
+	return func(item int32) bool {
+		return item != v
+	}
+}
+
+// EqInt16 returns the predicate which checks if the item is equal to v.
+func EqInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item == v
+	}
+}
+
+// NeInt16 returns the predicate which checks if the item is not equal to v.
+func NeInt16(v int16) func(int16) bool {
+	return func(item int16) bool {
+		return item != v
+	}
+}
+
+// EqInt8 returns the predicate which checks if the item is equal to v.
+func EqInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item == v
+	}
+}
+
+// NeInt8 returns the predicate which checks if the item is not equal to v.
+func NeInt8(v int8) func(int8) bool {
+	return func(item int8) bool {
+		return item != v
+	}
+}
+
+// EqUint returns the predicate which checks if the item is equal to v.
+func EqUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item == v
+	}
+}
+
+// NeUint returns the predicate which checks if the item is not equal to v.
+func NeUint(v uint) func(uint) bool {
+	return func(item uint) bool {
+		return item != v
+	}
+}
+
+// EqUint64 returns the predicate which checks if the item is equal to v.
+func EqUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item == v
+	}
+}
+
+// NeUint64 returns the predicate which checks if the item is not equal to v.
+func NeUint64(v uint64) func(uint64) bool {
+	return func(item uint64) bool {
+		return item != v
+	}
+}
+
+// EqUint32 returns the predicate which checks if the item is equal to v.
+func EqUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item == v
+	}
+}
+
+// NeUint32 returns the predicate which checks if the item is not equal to v.
+func NeUint32(v uint32) func(uint32) bool {
+	return func(item uint32) bool {
+		return item != v
+	}
+}
+
+// EqUint16 returns the predicate which checks if the item is equal to v.
+func EqUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item == v
+	}
+}
+
+// NeUint16 returns the predicate which checks if the item is not equal to v.
+func NeUint16(v uint16) func(uint16) bool {
+	return func(item uint16) bool {
+		return item != v
+	}
+}
+
+// EqUint8 returns the predicate which checks if the item is equal to v.
+func EqUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item == v
+	}
+}
+
+// NeUint8 returns the predicate which checks if the item is not equal to v.
+func NeUint8(v uint8) func(uint8) bool {
+	return func(item uint8) bool {
+		return item != v
+	}
+}
+
+// EqFloat64 returns the predicate which checks if the item is equal to v.
+func EqFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item == v
+	}
+}
+
+// NeFloat64 returns the predicate which checks if the item is not equal to v.
+func NeFloat64(v float64) func(float64) bool {
+	return func(item float64) bool {
+		return item != v
+	}
+}
+
+// EqFloat32 returns the predicate which checks if the item is equal to v.
+func EqFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item == v
+	}
+}
+
+// NeFloat32 returns the predicate which checks if the item is not equal to v.
+func NeFloat32(v float32) func(float32) bool {
+	return func(item float32) bool {
+		return item != v
+	}
+}
+
+// EqStr returns the predicate which checks if the item is equal to v.
+func EqStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item == v
+	}
+}
+
+// NeStr returns the predicate which checks if the item is not equal to v.
+func NeStr(v string) func(string) bool {
+	return func(item string) bool {
+		return item != v
+	}
+}
+
+// EqBool returns the predicate which checks if the item is equal to v.
+func EqBool(v bool) func(bool) bool {
+	return func(item bool) bool {
+		return item == v
+	}
+}
+
+// NeBool returns the predicate which checks if the item is not equal to v.
+func NeBool(v bool) func(bool) bool {
+	return func(item bool) bool {
+		return item != v
+	}
+}
@@ -0,0 +1,198 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEqNeInt(t *testing.T) {
+	expected := []int{2, 2}
+	actual := FilterInt(EqInt(2), []int{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{1, 3}
+	actual = FilterInt(NeInt(2), []int{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeInt64(t *testing.T) {
+	expected := []int64{2, 2}
+	actual := FilterInt64(EqInt64(2), []int64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{1, 3}
+	actual = FilterInt64(NeInt64(2), []int64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeInt32(t *testing.T) {
+	expected := []int32{2, 2}
+	actual := FilterInt32(EqInt32(2), []int32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{1, 3}
+	actual = FilterInt32(NeInt32(2), []int32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeInt16(t *testing.T) {
+	expected := []int16{2, 2}
+	actual := FilterInt16(EqInt16(2), []int16{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{1, 3}
+	actual = FilterInt16(NeInt16(2), []int16{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeInt8(t *testing.T) {
+	expected := []int8{2, 2}
+	actual := FilterInt8(EqInt8(2), []int8{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{1, 3}
+	actual = FilterInt8(NeInt8(2), []int8{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeUint(t *testing.T) {
+	expected := []uint{2, 2}
+	actual := FilterUint(EqUint(2), []uint{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{1, 3}
+	actual = FilterUint(NeUint(2), []uint{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeUint64(t *testing.T) {
+	expected := []uint64{2, 2}
+	actual := FilterUint64(EqUint64(2), []uint64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{1, 3}
+	actual = FilterUint64(NeUint64(2), []uint64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeUint32(t *testing.T) {
+	expected := []uint32{2, 2}
+	actual := FilterUint32(EqUint32(2), []uint32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{1, 3}
+	actual = FilterUint32(NeUint32(2), []uint32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeUint16(t *testing.T) {
+	expected := []uint16{2, 2}
+	actual := FilterUint16(EqUint16(2), []uint16{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{1, 3}
+	actual = FilterUint16(NeUint16(2), []uint16{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeUint8(t *testing.T) {
+	expected := []uint8{2, 2}
+	actual := FilterUint8(EqUint8(2), []uint8{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{1, 3}
+	actual = FilterUint8(NeUint8(2), []uint8{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeFloat64(t *testing.T) {
+	expected := []float64{2, 2}
+	actual := FilterFloat64(EqFloat64(2), []float64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{1, 3}
+	actual = FilterFloat64(NeFloat64(2), []float64{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeFloat32(t *testing.T) {
+	expected := []float32{2, 2}
+	actual := FilterFloat32(EqFloat32(2), []float32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{1, 3}
+	actual = FilterFloat32(NeFloat32(2), []float32{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeStr(t *testing.T) {
+	expected := []string{"b", "b"}
+	actual := FilterStr(EqStr("b"), []string{"a", "b", "c", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{"a", "c"}
+	actual = FilterStr(NeStr("b"), []string{"a", "b", "c", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNeStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqNeBool(t *testing.T) {
+	if !EqBool(true)(true) || EqBool(true)(false) {
+		t.Errorf("TestEqBool failed.")
+	}
+
+	if NeBool(true)(true) || !NeBool(true)(false) {
+		t.Errorf("TestNeBool failed.")
+	}
+}
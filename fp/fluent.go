@@ -0,0 +1,1379 @@
+package fp
+
+// Ints - list of int whose methods can be chained.
+//
+// Example:
+//	fp.Ints(list).Filter(pred).Map(f).Reduce(f2)
+type Ints []int
+
+// ToSlice returns the list as []int
+func (l Ints) ToSlice() []int {
+	return []int(l)
+}
+
+// Len returns number of items
+func (l Ints) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterInt
+func (l Ints) Filter(f func(int) bool) Ints {
+	return FilterInt(f, l)
+}
+
+// Remove - see RemoveInt
+func (l Ints) Remove(f func(int) bool) Ints {
+	return RemoveInt(f, l)
+}
+
+// Map - see MapInt
+func (l Ints) Map(f func(int) int) Ints {
+	return MapInt(f, l)
+}
+
+// PMap - see PMapInt
+func (l Ints) PMap(f func(int) int) Ints {
+	return PMapInt(f, l)
+}
+
+// FilterMap - see FilterMapInt
+func (l Ints) FilterMap(fFilter func(int) bool, fMap func(int) int) Ints {
+	return FilterMapInt(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileInt
+func (l Ints) DropWhile(f func(int) bool) Ints {
+	return DropWhileInt(f, l)
+}
+
+// TakeWhile - see TakeWhileInt
+func (l Ints) TakeWhile(f func(int) bool) Ints {
+	return TakeWhileInt(f, l)
+}
+
+// Distinct - see DistinctInt
+func (l Ints) Distinct() Ints {
+	return DistinctInt(l)
+}
+
+// Dedupe - see DedupeInt
+func (l Ints) Dedupe() Ints {
+	return DedupeInt(l)
+}
+
+// Drop - see DropInt
+func (l Ints) Drop(v int) Ints {
+	return DropInt(v, l)
+}
+
+// Rest - see RestInt
+func (l Ints) Rest() Ints {
+	return RestInt(l)
+}
+
+// Reduce - see ReduceInt
+func (l Ints) Reduce(f func(int, int) int, initializer ...int) int {
+	return ReduceInt(f, l, initializer...)
+}
+
+// Some - see SomeInt
+func (l Ints) Some(f func(int) bool) bool {
+	return SomeInt(f, l)
+}
+
+// Every - see EveryInt
+func (l Ints) Every(f func(int) bool) bool {
+	return EveryInt(f, l)
+}
+
+// Exists - see ExistsInt
+func (l Ints) Exists(v int) bool {
+	return ExistsInt(v, l)
+}
+
+// Find - see FindOptInt
+func (l Ints) Find(pred func(int) bool) OptionInt {
+	return FindOptInt(pred, l)
+}
+
+// Max - see MaxOptInt
+func (l Ints) Max() OptionInt {
+	return MaxOptInt(l)
+}
+
+// Min - see MinOptInt
+func (l Ints) Min() OptionInt {
+	return MinOptInt(l)
+}
+
+// Int64s - list of int64 whose methods can be chained.
+//
+// Example:
+//	fp.Int64s(list).Filter(pred).Map(f).Reduce(f2)
+type Int64s []int64
+
+// ToSlice returns the list as []int64
+func (l Int64s) ToSlice() []int64 {
+	return []int64(l)
+}
+
+// Len returns number of items
+func (l Int64s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterInt64
+func (l Int64s) Filter(f func(int64) bool) Int64s {
+	return FilterInt64(f, l)
+}
+
+// Remove - see RemoveInt64
+func (l Int64s) Remove(f func(int64) bool) Int64s {
+	return RemoveInt64(f, l)
+}
+
+// Map - see MapInt64
+func (l Int64s) Map(f func(int64) int64) Int64s {
+	return MapInt64(f, l)
+}
+
+// PMap - see PMapInt64
+func (l Int64s) PMap(f func(int64) int64) Int64s {
+	return PMapInt64(f, l)
+}
+
+// FilterMap - see FilterMapInt64
+func (l Int64s) FilterMap(fFilter func(int64) bool, fMap func(int64) int64) Int64s {
+	return FilterMapInt64(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileInt64
+func (l Int64s) DropWhile(f func(int64) bool) Int64s {
+	return DropWhileInt64(f, l)
+}
+
+// TakeWhile - see TakeWhileInt64
+func (l Int64s) TakeWhile(f func(int64) bool) Int64s {
+	return TakeWhileInt64(f, l)
+}
+
+// Distinct - see DistinctInt64
+func (l Int64s) Distinct() Int64s {
+	return DistinctInt64(l)
+}
+
+// Dedupe - see DedupeInt64
+func (l Int64s) Dedupe() Int64s {
+	return DedupeInt64(l)
+}
+
+// Drop - see DropInt64
+func (l Int64s) Drop(v int64) Int64s {
+	return DropInt64(v, l)
+}
+
+// Rest - see RestInt64
+func (l Int64s) Rest() Int64s {
+	return RestInt64(l)
+}
+
+// Reduce - see ReduceInt64
+func (l Int64s) Reduce(f func(int64, int64) int64, initializer ...int64) int64 {
+	return ReduceInt64(f, l, initializer...)
+}
+
+// Some - see SomeInt64
+func (l Int64s) Some(f func(int64) bool) bool {
+	return SomeInt64(f, l)
+}
+
+// Every - see EveryInt64
+func (l Int64s) Every(f func(int64) bool) bool {
+	return EveryInt64(f, l)
+}
+
+// Exists - see ExistsInt64
+func (l Int64s) Exists(v int64) bool {
+	return ExistsInt64(v, l)
+}
+
+// Find - see FindOptInt64
+func (l Int64s) Find(pred func(int64) bool) OptionInt64 {
+	return FindOptInt64(pred, l)
+}
+
+// Max - see MaxOptInt64
+func (l Int64s) Max() OptionInt64 {
+	return MaxOptInt64(l)
+}
+
+// Min - see MinOptInt64
+func (l Int64s) Min() OptionInt64 {
+	return MinOptInt64(l)
+}
+
+// Int32s - list of int32 whose methods can be chained.
+//
+// Example:
+//	fp.Int32s(list).Filter(pred).Map(f).Reduce(f2)
+type Int32s []int32
+
+// ToSlice returns the list as []int32
+func (l Int32s) ToSlice() []int32 {
+	return []int32(l)
+}
+
+// Len returns number of items
+func (l Int32s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterInt32
+func (l Int32s) Filter(f func(int32) bool) Int32s {
+	return FilterInt32(f, l)
+}
+
+// Remove - see RemoveInt32
+func (l Int32s) Remove(f func(int32) bool) Int32s {
+	return RemoveInt32(f, l)
+}
+
+// Map - see MapInt32
+func (l Int32s) Map(f func(int32) int32) Int32s {
+	return MapInt32(f, l)
+}
+
+// PMap - see PMapInt32
+func (l Int32s) PMap(f func(int32) int32) Int32s {
+	return PMapInt32(f, l)
+}
+
+// FilterMap - see FilterMapInt32
+func (l Int32s) FilterMap(fFilter func(int32) bool, fMap func(int32) int32) Int32s {
+	return FilterMapInt32(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileInt32
+func (l Int32s) DropWhile(f func(int32) bool) Int32s {
+	return DropWhileInt32(f, l)
+}
+
+// TakeWhile - see TakeWhileInt32
+func (l Int32s) TakeWhile(f func(int32) bool) Int32s {
+	return TakeWhileInt32(f, l)
+}
+
+// Distinct - see DistinctInt32
+func (l Int32s) Distinct() Int32s {
+	return DistinctInt32(l)
+}
+
+// Dedupe - see DedupeInt32
+func (l Int32s) Dedupe() Int32s {
+	return DedupeInt32(l)
+}
+
+// Drop - see DropInt32
+func (l Int32s) Drop(v int32) Int32s {
+	return DropInt32(v, l)
+}
+
+// Rest - see RestInt32
+func (l Int32s) Rest() Int32s {
+	return RestInt32(l)
+}
+
+// Reduce - see ReduceInt32
+func (l Int32s) Reduce(f func(int32, int32) int32, initializer ...int32) int32 {
+	return ReduceInt32(f, l, initializer...)
+}
+
+// Some - see SomeInt32
+func (l Int32s) Some(f func(int32) bool) bool {
+	return SomeInt32(f, l)
+}
+
+// Every - see EveryInt32
+func (l Int32s) Every(f func(int32) bool) bool {
+	return EveryInt32(f, l)
+}
+
+// Exists - see ExistsInt32
+func (l Int32s) Exists(v int32) bool {
+	return ExistsInt32(v, l)
+}
+
+// Find - see FindOptInt32
+func (l Int32s) Find(pred func(int32) bool) OptionInt32 {
+	return FindOptInt32(pred, l)
+}
+
+// Max - see MaxOptInt32
+func (l Int32s) Max() OptionInt32 {
+	return MaxOptInt32(l)
+}
+
+// Min - see MinOptInt32
+func (l Int32s) Min() OptionInt32 {
+	return MinOptInt32(l)
+}
+
+// Int16s - list of int16 whose methods can be chained.
+//
+// Example:
+//	fp.Int16s(list).Filter(pred).Map(f).Reduce(f2)
+type Int16s []int16
+
+// ToSlice returns the list as []int16
+func (l Int16s) ToSlice() []int16 {
+	return []int16(l)
+}
+
+// Len returns number of items
+func (l Int16s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterInt16
+func (l Int16s) Filter(f func(int16) bool) Int16s {
+	return FilterInt16(f, l)
+}
+
+// Remove - see RemoveInt16
+func (l Int16s) Remove(f func(int16) bool) Int16s {
+	return RemoveInt16(f, l)
+}
+
+// Map - see MapInt16
+func (l Int16s) Map(f func(int16) int16) Int16s {
+	return MapInt16(f, l)
+}
+
+// PMap - see PMapInt16
+func (l Int16s) PMap(f func(int16) int16) Int16s {
+	return PMapInt16(f, l)
+}
+
+// FilterMap - see FilterMapInt16
+func (l Int16s) FilterMap(fFilter func(int16) bool, fMap func(int16) int16) Int16s {
+	return FilterMapInt16(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileInt16
+func (l Int16s) DropWhile(f func(int16) bool) Int16s {
+	return DropWhileInt16(f, l)
+}
+
+// TakeWhile - see TakeWhileInt16
+func (l Int16s) TakeWhile(f func(int16) bool) Int16s {
+	return TakeWhileInt16(f, l)
+}
+
+// Distinct - see DistinctInt16
+func (l Int16s) Distinct() Int16s {
+	return DistinctInt16(l)
+}
+
+// Dedupe - see DedupeInt16
+func (l Int16s) Dedupe() Int16s {
+	return DedupeInt16(l)
+}
+
+// Drop - see DropInt16
+func (l Int16s) Drop(v int16) Int16s {
+	return DropInt16(v, l)
+}
+
+// Rest - see RestInt16
+func (l Int16s) Rest() Int16s {
+	return RestInt16(l)
+}
+
+// Reduce - see ReduceInt16
+func (l Int16s) Reduce(f func(int16, int16) int16, initializer ...int16) int16 {
+	return ReduceInt16(f, l, initializer...)
+}
+
+// Some - see SomeInt16
+func (l Int16s) Some(f func(int16) bool) bool {
+	return SomeInt16(f, l)
+}
+
+// Every - see EveryInt16
+func (l Int16s) Every(f func(int16) bool) bool {
+	return EveryInt16(f, l)
+}
+
+// Exists - see ExistsInt16
+func (l Int16s) Exists(v int16) bool {
+	return ExistsInt16(v, l)
+}
+
+// Find - see FindOptInt16
+func (l Int16s) Find(pred func(int16) bool) OptionInt16 {
+	return FindOptInt16(pred, l)
+}
+
+// Max - see MaxOptInt16
+func (l Int16s) Max() OptionInt16 {
+	return MaxOptInt16(l)
+}
+
+// Min - see MinOptInt16
+func (l Int16s) Min() OptionInt16 {
+	return MinOptInt16(l)
+}
+
+// Int8s - list of int8 whose methods can be chained.
+//
+// Example:
+//	fp.Int8s(list).Filter(pred).Map(f).Reduce(f2)
+type Int8s []int8
+
+// ToSlice returns the list as []int8
+func (l Int8s) ToSlice() []int8 {
+	return []int8(l)
+}
+
+// Len returns number of items
+func (l Int8s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterInt8
+func (l Int8s) Filter(f func(int8) bool) Int8s {
+	return FilterInt8(f, l)
+}
+
+// Remove - see RemoveInt8
+func (l Int8s) Remove(f func(int8) bool) Int8s {
+	return RemoveInt8(f, l)
+}
+
+// Map - see MapInt8
+func (l Int8s) Map(f func(int8) int8) Int8s {
+	return MapInt8(f, l)
+}
+
+// PMap - see PMapInt8
+func (l Int8s) PMap(f func(int8) int8) Int8s {
+	return PMapInt8(f, l)
+}
+
+// FilterMap - see FilterMapInt8
+func (l Int8s) FilterMap(fFilter func(int8) bool, fMap func(int8) int8) Int8s {
+	return FilterMapInt8(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileInt8
+func (l Int8s) DropWhile(f func(int8) bool) Int8s {
+	return DropWhileInt8(f, l)
+}
+
+// TakeWhile - see TakeWhileInt8
+func (l Int8s) TakeWhile(f func(int8) bool) Int8s {
+	return TakeWhileInt8(f, l)
+}
+
+// Distinct - see DistinctInt8
+func (l Int8s) Distinct() Int8s {
+	return DistinctInt8(l)
+}
+
+// Dedupe - see DedupeInt8
+func (l Int8s) Dedupe() Int8s {
+	return DedupeInt8(l)
+}
+
+// Drop - see DropInt8
+func (l Int8s) Drop(v int8) Int8s {
+	return DropInt8(v, l)
+}
+
+// Rest - see RestInt8
+func (l Int8s) Rest() Int8s {
+	return RestInt8(l)
+}
+
+// Reduce - see ReduceInt8
+func (l Int8s) Reduce(f func(int8, int8) int8, initializer ...int8) int8 {
+	return ReduceInt8(f, l, initializer...)
+}
+
+// Some - see SomeInt8
+func (l Int8s) Some(f func(int8) bool) bool {
+	return SomeInt8(f, l)
+}
+
+// Every - see EveryInt8
+func (l Int8s) Every(f func(int8) bool) bool {
+	return EveryInt8(f, l)
+}
+
+// Exists - see ExistsInt8
+func (l Int8s) Exists(v int8) bool {
+	return ExistsInt8(v, l)
+}
+
+// Find - see FindOptInt8
+func (l Int8s) Find(pred func(int8) bool) OptionInt8 {
+	return FindOptInt8(pred, l)
+}
+
+// Max - see MaxOptInt8
+func (l Int8s) Max() OptionInt8 {
+	return MaxOptInt8(l)
+}
+
+// Min - see MinOptInt8
+func (l Int8s) Min() OptionInt8 {
+	return MinOptInt8(l)
+}
+
+// Uints - list of uint whose methods can be chained.
+//
+// Example:
+//	fp.Uints(list).Filter(pred).Map(f).Reduce(f2)
+type Uints []uint
+
+// ToSlice returns the list as []uint
+func (l Uints) ToSlice() []uint {
+	return []uint(l)
+}
+
+// Len returns number of items
+func (l Uints) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterUint
+func (l Uints) Filter(f func(uint) bool) Uints {
+	return FilterUint(f, l)
+}
+
+// Remove - see RemoveUint
+func (l Uints) Remove(f func(uint) bool) Uints {
+	return RemoveUint(f, l)
+}
+
+// Map - see MapUint
+func (l Uints) Map(f func(uint) uint) Uints {
+	return MapUint(f, l)
+}
+
+// PMap - see PMapUint
+func (l Uints) PMap(f func(uint) uint) Uints {
+	return PMapUint(f, l)
+}
+
+// FilterMap - see FilterMapUint
+func (l Uints) FilterMap(fFilter func(uint) bool, fMap func(uint) uint) Uints {
+	return FilterMapUint(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileUint
+func (l Uints) DropWhile(f func(uint) bool) Uints {
+	return DropWhileUint(f, l)
+}
+
+// TakeWhile - see TakeWhileUint
+func (l Uints) TakeWhile(f func(uint) bool) Uints {
+	return TakeWhileUint(f, l)
+}
+
+// Distinct - see DistinctUint
+func (l Uints) Distinct() Uints {
+	return DistinctUint(l)
+}
+
+// Dedupe - see DedupeUint
+func (l Uints) Dedupe() Uints {
+	return DedupeUint(l)
+}
+
+// Drop - see DropUint
+func (l Uints) Drop(v uint) Uints {
+	return DropUint(v, l)
+}
+
+// Rest - see RestUint
+func (l Uints) Rest() Uints {
+	return RestUint(l)
+}
+
+// Reduce - see ReduceUint
+func (l Uints) Reduce(f func(uint, uint) uint, initializer ...uint) uint {
+	return ReduceUint(f, l, initializer...)
+}
+
+// Some - see SomeUint
+func (l Uints) Some(f func(uint) bool) bool {
+	return SomeUint(f, l)
+}
+
+// Every - see EveryUint
+func (l Uints) Every(f func(uint) bool) bool {
+	return EveryUint(f, l)
+}
+
+// Exists - see ExistsUint
+func (l Uints) Exists(v uint) bool {
+	return ExistsUint(v, l)
+}
+
+// Find - see FindOptUint
+func (l Uints) Find(pred func(uint) bool) OptionUint {
+	return FindOptUint(pred, l)
+}
+
+// Max - see MaxOptUint
+func (l Uints) Max() OptionUint {
+	return MaxOptUint(l)
+}
+
+// Min - see MinOptUint
+func (l Uints) Min() OptionUint {
+	return MinOptUint(l)
+}
+
+// Uint64s - list of uint64 whose methods can be chained.
+//
+// Example:
+//	fp.Uint64s(list).Filter(pred).Map(f).Reduce(f2)
+type Uint64s []uint64
+
+// ToSlice returns the list as []uint64
+func (l Uint64s) ToSlice() []uint64 {
+	return []uint64(l)
+}
+
+// Len returns number of items
+func (l Uint64s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterUint64
+func (l Uint64s) Filter(f func(uint64) bool) Uint64s {
+	return FilterUint64(f, l)
+}
+
+// Remove - see RemoveUint64
+func (l Uint64s) Remove(f func(uint64) bool) Uint64s {
+	return RemoveUint64(f, l)
+}
+
+// Map - see MapUint64
+func (l Uint64s) Map(f func(uint64) uint64) Uint64s {
+	return MapUint64(f, l)
+}
+
+// PMap - see PMapUint64
+func (l Uint64s) PMap(f func(uint64) uint64) Uint64s {
+	return PMapUint64(f, l)
+}
+
+// FilterMap - see FilterMapUint64
+func (l Uint64s) FilterMap(fFilter func(uint64) bool, fMap func(uint64) uint64) Uint64s {
+	return FilterMapUint64(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileUint64
+func (l Uint64s) DropWhile(f func(uint64) bool) Uint64s {
+	return DropWhileUint64(f, l)
+}
+
+// TakeWhile - see TakeWhileUint64
+func (l Uint64s) TakeWhile(f func(uint64) bool) Uint64s {
+	return TakeWhileUint64(f, l)
+}
+
+// Distinct - see DistinctUint64
+func (l Uint64s) Distinct() Uint64s {
+	return DistinctUint64(l)
+}
+
+// Dedupe - see DedupeUint64
+func (l Uint64s) Dedupe() Uint64s {
+	return DedupeUint64(l)
+}
+
+// Drop - see DropUint64
+func (l Uint64s) Drop(v uint64) Uint64s {
+	return DropUint64(v, l)
+}
+
+// Rest - see RestUint64
+func (l Uint64s) Rest() Uint64s {
+	return RestUint64(l)
+}
+
+// Reduce - see ReduceUint64
+func (l Uint64s) Reduce(f func(uint64, uint64) uint64, initializer ...uint64) uint64 {
+	return ReduceUint64(f, l, initializer...)
+}
+
+// Some - see SomeUint64
+func (l Uint64s) Some(f func(uint64) bool) bool {
+	return SomeUint64(f, l)
+}
+
+// Every - see EveryUint64
+func (l Uint64s) Every(f func(uint64) bool) bool {
+	return EveryUint64(f, l)
+}
+
+// Exists - see ExistsUint64
+func (l Uint64s) Exists(v uint64) bool {
+	return ExistsUint64(v, l)
+}
+
+// Find - see FindOptUint64
+func (l Uint64s) Find(pred func(uint64) bool) OptionUint64 {
+	return FindOptUint64(pred, l)
+}
+
+// Max - see MaxOptUint64
+func (l Uint64s) Max() OptionUint64 {
+	return MaxOptUint64(l)
+}
+
+// Min - see MinOptUint64
+func (l Uint64s) Min() OptionUint64 {
+	return MinOptUint64(l)
+}
+
+// Uint32s - list of uint32 whose methods can be chained.
+//
+// Example:
+//	fp.Uint32s(list).Filter(pred).Map(f).Reduce(f2)
+type Uint32s []uint32
+
+// ToSlice returns the list as []uint32
+func (l Uint32s) ToSlice() []uint32 {
+	return []uint32(l)
+}
+
+// Len returns number of items
+func (l Uint32s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterUint32
+func (l Uint32s) Filter(f func(uint32) bool) Uint32s {
+	return FilterUint32(f, l)
+}
+
+// Remove - see RemoveUint32
+func (l Uint32s) Remove(f func(uint32) bool) Uint32s {
+	return RemoveUint32(f, l)
+}
+
+// Map - see MapUint32
+func (l Uint32s) Map(f func(uint32) uint32) Uint32s {
+	return MapUint32(f, l)
+}
+
+// PMap - see PMapUint32
+func (l Uint32s) PMap(f func(uint32) uint32) Uint32s {
+	return PMapUint32(f, l)
+}
+
+// FilterMap - see FilterMapUint32
+func (l Uint32s) FilterMap(fFilter func(uint32) bool, fMap func(uint32) uint32) Uint32s {
+	return FilterMapUint32(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileUint32
+func (l Uint32s) DropWhile(f func(uint32) bool) Uint32s {
+	return DropWhileUint32(f, l)
+}
+
+// TakeWhile - see TakeWhileUint32
+func (l Uint32s) TakeWhile(f func(uint32) bool) Uint32s {
+	return TakeWhileUint32(f, l)
+}
+
+// Distinct - see DistinctUint32
+func (l Uint32s) Distinct() Uint32s {
+	return DistinctUint32(l)
+}
+
+// Dedupe - see DedupeUint32
+func (l Uint32s) Dedupe() Uint32s {
+	return DedupeUint32(l)
+}
+
+// Drop - see DropUint32
+func (l Uint32s) Drop(v uint32) Uint32s {
+	return DropUint32(v, l)
+}
+
+// Rest - see RestUint32
+func (l Uint32s) Rest() Uint32s {
+	return RestUint32(l)
+}
+
+// Reduce - see ReduceUint32
+func (l Uint32s) Reduce(f func(uint32, uint32) uint32, initializer ...uint32) uint32 {
+	return ReduceUint32(f, l, initializer...)
+}
+
+// Some - see SomeUint32
+func (l Uint32s) Some(f func(uint32) bool) bool {
+	return SomeUint32(f, l)
+}
+
+// Every - see EveryUint32
+func (l Uint32s) Every(f func(uint32) bool) bool {
+	return EveryUint32(f, l)
+}
+
+// Exists - see ExistsUint32
+func (l Uint32s) Exists(v uint32) bool {
+	return ExistsUint32(v, l)
+}
+
+// Find - see FindOptUint32
+func (l Uint32s) Find(pred func(uint32) bool) OptionUint32 {
+	return FindOptUint32(pred, l)
+}
+
+// Max - see MaxOptUint32
+func (l Uint32s) Max() OptionUint32 {
+	return MaxOptUint32(l)
+}
+
+// Min - see MinOptUint32
+func (l Uint32s) Min() OptionUint32 {
+	return MinOptUint32(l)
+}
+
+// Uint16s - list of uint16 whose methods can be chained.
+//
+// Example:
+//	fp.Uint16s(list).Filter(pred).Map(f).Reduce(f2)
+type Uint16s []uint16
+
+// ToSlice returns the list as []uint16
+func (l Uint16s) ToSlice() []uint16 {
+	return []uint16(l)
+}
+
+// Len returns number of items
+func (l Uint16s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterUint16
+func (l Uint16s) Filter(f func(uint16) bool) Uint16s {
+	return FilterUint16(f, l)
+}
+
+// Remove - see RemoveUint16
+func (l Uint16s) Remove(f func(uint16) bool) Uint16s {
+	return RemoveUint16(f, l)
+}
+
+// Map - see MapUint16
+func (l Uint16s) Map(f func(uint16) uint16) Uint16s {
+	return MapUint16(f, l)
+}
+
+// PMap - see PMapUint16
+func (l Uint16s) PMap(f func(uint16) uint16) Uint16s {
+	return PMapUint16(f, l)
+}
+
+// FilterMap - see FilterMapUint16
+func (l Uint16s) FilterMap(fFilter func(uint16) bool, fMap func(uint16) uint16) Uint16s {
+	return FilterMapUint16(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileUint16
+func (l Uint16s) DropWhile(f func(uint16) bool) Uint16s {
+	return DropWhileUint16(f, l)
+}
+
+// TakeWhile - see TakeWhileUint16
+func (l Uint16s) TakeWhile(f func(uint16) bool) Uint16s {
+	return TakeWhileUint16(f, l)
+}
+
+// Distinct - see DistinctUint16
+func (l Uint16s) Distinct() Uint16s {
+	return DistinctUint16(l)
+}
+
+// Dedupe - see DedupeUint16
+func (l Uint16s) Dedupe() Uint16s {
+	return DedupeUint16(l)
+}
+
+// Drop - see DropUint16
+func (l Uint16s) Drop(v uint16) Uint16s {
+	return DropUint16(v, l)
+}
+
+// Rest - see RestUint16
+func (l Uint16s) Rest() Uint16s {
+	return RestUint16(l)
+}
+
+// Reduce - see ReduceUint16
+func (l Uint16s) Reduce(f func(uint16, uint16) uint16, initializer ...uint16) uint16 {
+	return ReduceUint16(f, l, initializer...)
+}
+
+// Some - see SomeUint16
+func (l Uint16s) Some(f func(uint16) bool) bool {
+	return SomeUint16(f, l)
+}
+
+// Every - see EveryUint16
+func (l Uint16s) Every(f func(uint16) bool) bool {
+	return EveryUint16(f, l)
+}
+
+// Exists - see ExistsUint16
+func (l Uint16s) Exists(v uint16) bool {
+	return ExistsUint16(v, l)
+}
+
+// Find - see FindOptUint16
+func (l Uint16s) Find(pred func(uint16) bool) OptionUint16 {
+	return FindOptUint16(pred, l)
+}
+
+// Max - see MaxOptUint16
+func (l Uint16s) Max() OptionUint16 {
+	return MaxOptUint16(l)
+}
+
+// Min - see MinOptUint16
+func (l Uint16s) Min() OptionUint16 {
+	return MinOptUint16(l)
+}
+
+// Uint8s - list of uint8 whose methods can be chained.
+//
+// Example:
+//	fp.Uint8s(list).Filter(pred).Map(f).Reduce(f2)
+type Uint8s []uint8
+
+// ToSlice returns the list as []uint8
+func (l Uint8s) ToSlice() []uint8 {
+	return []uint8(l)
+}
+
+// Len returns number of items
+func (l Uint8s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterUint8
+func (l Uint8s) Filter(f func(uint8) bool) Uint8s {
+	return FilterUint8(f, l)
+}
+
+// Remove - see RemoveUint8
+func (l Uint8s) Remove(f func(uint8) bool) Uint8s {
+	return RemoveUint8(f, l)
+}
+
+// Map - see MapUint8
+func (l Uint8s) Map(f func(uint8) uint8) Uint8s {
+	return MapUint8(f, l)
+}
+
+// PMap - see PMapUint8
+func (l Uint8s) PMap(f func(uint8) uint8) Uint8s {
+	return PMapUint8(f, l)
+}
+
+// FilterMap - see FilterMapUint8
+func (l Uint8s) FilterMap(fFilter func(uint8) bool, fMap func(uint8) uint8) Uint8s {
+	return FilterMapUint8(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileUint8
+func (l Uint8s) DropWhile(f func(uint8) bool) Uint8s {
+	return DropWhileUint8(f, l)
+}
+
+// TakeWhile - see TakeWhileUint8
+func (l Uint8s) TakeWhile(f func(uint8) bool) Uint8s {
+	return TakeWhileUint8(f, l)
+}
+
+// Distinct - see DistinctUint8
+func (l Uint8s) Distinct() Uint8s {
+	return DistinctUint8(l)
+}
+
+// Dedupe - see DedupeUint8
+func (l Uint8s) Dedupe() Uint8s {
+	return DedupeUint8(l)
+}
+
+// Drop - see DropUint8
+func (l Uint8s) Drop(v uint8) Uint8s {
+	return DropUint8(v, l)
+}
+
+// Rest - see RestUint8
+func (l Uint8s) Rest() Uint8s {
+	return RestUint8(l)
+}
+
+// Reduce - see ReduceUint8
+func (l Uint8s) Reduce(f func(uint8, uint8) uint8, initializer ...uint8) uint8 {
+	return ReduceUint8(f, l, initializer...)
+}
+
+// Some - see SomeUint8
+func (l Uint8s) Some(f func(uint8) bool) bool {
+	return SomeUint8(f, l)
+}
+
+// Every - see EveryUint8
+func (l Uint8s) Every(f func(uint8) bool) bool {
+	return EveryUint8(f, l)
+}
+
+// Exists - see ExistsUint8
+func (l Uint8s) Exists(v uint8) bool {
+	return ExistsUint8(v, l)
+}
+
+// Find - see FindOptUint8
+func (l Uint8s) Find(pred func(uint8) bool) OptionUint8 {
+	return FindOptUint8(pred, l)
+}
+
+// Max - see MaxOptUint8
+func (l Uint8s) Max() OptionUint8 {
+	return MaxOptUint8(l)
+}
+
+// Min - see MinOptUint8
+func (l Uint8s) Min() OptionUint8 {
+	return MinOptUint8(l)
+}
+
+// Float64s - list of float64 whose methods can be chained.
+//
+// Example:
+//	fp.Float64s(list).Filter(pred).Map(f).Reduce(f2)
+type Float64s []float64
+
+// ToSlice returns the list as []float64
+func (l Float64s) ToSlice() []float64 {
+	return []float64(l)
+}
+
+// Len returns number of items
+func (l Float64s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterFloat64
+func (l Float64s) Filter(f func(float64) bool) Float64s {
+	return FilterFloat64(f, l)
+}
+
+// Remove - see RemoveFloat64
+func (l Float64s) Remove(f func(float64) bool) Float64s {
+	return RemoveFloat64(f, l)
+}
+
+// Map - see MapFloat64
+func (l Float64s) Map(f func(float64) float64) Float64s {
+	return MapFloat64(f, l)
+}
+
+// PMap - see PMapFloat64
+func (l Float64s) PMap(f func(float64) float64) Float64s {
+	return PMapFloat64(f, l)
+}
+
+// FilterMap - see FilterMapFloat64
+func (l Float64s) FilterMap(fFilter func(float64) bool, fMap func(float64) float64) Float64s {
+	return FilterMapFloat64(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileFloat64
+func (l Float64s) DropWhile(f func(float64) bool) Float64s {
+	return DropWhileFloat64(f, l)
+}
+
+// TakeWhile - see TakeWhileFloat64
+func (l Float64s) TakeWhile(f func(float64) bool) Float64s {
+	return TakeWhileFloat64(f, l)
+}
+
+// Distinct - see DistinctFloat64
+func (l Float64s) Distinct() Float64s {
+	return DistinctFloat64(l)
+}
+
+// Dedupe - see DedupeFloat64
+func (l Float64s) Dedupe() Float64s {
+	return DedupeFloat64(l)
+}
+
+// Drop - see DropFloat64
+func (l Float64s) Drop(v float64) Float64s {
+	return DropFloat64(v, l)
+}
+
+// Rest - see RestFloat64
+func (l Float64s) Rest() Float64s {
+	return RestFloat64(l)
+}
+
+// Reduce - see ReduceFloat64
+func (l Float64s) Reduce(f func(float64, float64) float64, initializer ...float64) float64 {
+	return ReduceFloat64(f, l, initializer...)
+}
+
+// Some - see SomeFloat64
+func (l Float64s) Some(f func(float64) bool) bool {
+	return SomeFloat64(f, l)
+}
+
+// Every - see EveryFloat64
+func (l Float64s) Every(f func(float64) bool) bool {
+	return EveryFloat64(f, l)
+}
+
+// Exists - see ExistsFloat64
+func (l Float64s) Exists(v float64) bool {
+	return ExistsFloat64(v, l)
+}
+
+// Find - see FindOptFloat64
+func (l Float64s) Find(pred func(float64) bool) OptionFloat64 {
+	return FindOptFloat64(pred, l)
+}
+
+// Max - see MaxOptFloat64
+func (l Float64s) Max() OptionFloat64 {
+	return MaxOptFloat64(l)
+}
+
+// Min - see MinOptFloat64
+func (l Float64s) Min() OptionFloat64 {
+	return MinOptFloat64(l)
+}
+
+// Float32s - list of float32 whose methods can be chained.
+//
+// Example:
+//	fp.Float32s(list).Filter(pred).Map(f).Reduce(f2)
+type Float32s []float32
+
+// ToSlice returns the list as []float32
+func (l Float32s) ToSlice() []float32 {
+	return []float32(l)
+}
+
+// Len returns number of items
+func (l Float32s) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterFloat32
+func (l Float32s) Filter(f func(float32) bool) Float32s {
+	return FilterFloat32(f, l)
+}
+
+// Remove - see RemoveFloat32
+func (l Float32s) Remove(f func(float32) bool) Float32s {
+	return RemoveFloat32(f, l)
+}
+
+// Map - see MapFloat32
+func (l Float32s) Map(f func(float32) float32) Float32s {
+	return MapFloat32(f, l)
+}
+
+// PMap - see PMapFloat32
+func (l Float32s) PMap(f func(float32) float32) Float32s {
+	return PMapFloat32(f, l)
+}
+
+// FilterMap - see FilterMapFloat32
+func (l Float32s) FilterMap(fFilter func(float32) bool, fMap func(float32) float32) Float32s {
+	return FilterMapFloat32(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileFloat32
+func (l Float32s) DropWhile(f func(float32) bool) Float32s {
+	return DropWhileFloat32(f, l)
+}
+
+// TakeWhile - see TakeWhileFloat32
+func (l Float32s) TakeWhile(f func(float32) bool) Float32s {
+	return TakeWhileFloat32(f, l)
+}
+
+// Distinct - see DistinctFloat32
+func (l Float32s) Distinct() Float32s {
+	return DistinctFloat32(l)
+}
+
+// Dedupe - see DedupeFloat32
+func (l Float32s) Dedupe() Float32s {
+	return DedupeFloat32(l)
+}
+
+// Drop - see DropFloat32
+func (l Float32s) Drop(v float32) Float32s {
+	return DropFloat32(v, l)
+}
+
+// Rest - see RestFloat32
+func (l Float32s) Rest() Float32s {
+	return RestFloat32(l)
+}
+
+// Reduce - see ReduceFloat32
+func (l Float32s) Reduce(f func(float32, float32) float32, initializer ...float32) float32 {
+	return ReduceFloat32(f, l, initializer...)
+}
+
+// Some - see SomeFloat32
+func (l Float32s) Some(f func(float32) bool) bool {
+	return SomeFloat32(f, l)
+}
+
+// Every - see EveryFloat32
+func (l Float32s) Every(f func(float32) bool) bool {
+	return EveryFloat32(f, l)
+}
+
+// Exists - see ExistsFloat32
+func (l Float32s) Exists(v float32) bool {
+	return ExistsFloat32(v, l)
+}
+
+// Find - see FindOptFloat32
+func (l Float32s) Find(pred func(float32) bool) OptionFloat32 {
+	return FindOptFloat32(pred, l)
+}
+
+// Max - see MaxOptFloat32
+func (l Float32s) Max() OptionFloat32 {
+	return MaxOptFloat32(l)
+}
+
+// Min - see MinOptFloat32
+func (l Float32s) Min() OptionFloat32 {
+	return MinOptFloat32(l)
+}
+
+// Strs - list of string whose methods can be chained.
+//
+// Example:
+//	fp.Strs(list).Filter(pred).Map(f).Reduce(f2)
+type Strs []string
+
+// ToSlice returns the list as []string
+func (l Strs) ToSlice() []string {
+	return []string(l)
+}
+
+// Len returns number of items
+func (l Strs) Len() int {
+	return len(l)
+}
+
+// Filter - see FilterStr
+func (l Strs) Filter(f func(string) bool) Strs {
+	return FilterStr(f, l)
+}
+
+// Remove - see RemoveStr
+func (l Strs) Remove(f func(string) bool) Strs {
+	return RemoveStr(f, l)
+}
+
+// Map - see MapStr
+func (l Strs) Map(f func(string) string) Strs {
+	return MapStr(f, l)
+}
+
+// PMap - see PMapStr
+func (l Strs) PMap(f func(string) string) Strs {
+	return PMapStr(f, l)
+}
+
+// FilterMap - see FilterMapStr
+func (l Strs) FilterMap(fFilter func(string) bool, fMap func(string) string) Strs {
+	return FilterMapStr(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhileStr
+func (l Strs) DropWhile(f func(string) bool) Strs {
+	return DropWhileStr(f, l)
+}
+
+// TakeWhile - see TakeWhileStr
+func (l Strs) TakeWhile(f func(string) bool) Strs {
+	return TakeWhileStr(f, l)
+}
+
+// Distinct - see DistinctStr
+func (l Strs) Distinct() Strs {
+	return DistinctStr(l)
+}
+
+// Dedupe - see DedupeStr
+func (l Strs) Dedupe() Strs {
+	return DedupeStr(l)
+}
+
+// Drop - see DropStr
+func (l Strs) Drop(v string) Strs {
+	return DropStr(v, l)
+}
+
+// Rest - see RestStr
+func (l Strs) Rest() Strs {
+	return RestStr(l)
+}
+
+// Reduce - see ReduceStr
+func (l Strs) Reduce(f func(string, string) string, initializer ...string) string {
+	return ReduceStr(f, l, initializer...)
+}
+
+// Some - see SomeStr
+func (l Strs) Some(f func(string) bool) bool {
+	return SomeStr(f, l)
+}
+
+// Every - see EveryStr
+func (l Strs) Every(f func(string) bool) bool {
+	return EveryStr(f, l)
+}
+
+// Exists - see ExistsStr
+func (l Strs) Exists(v string) bool {
+	return ExistsStr(v, l)
+}
+
+// Find - see FindOptStr
+func (l Strs) Find(pred func(string) bool) OptionStr {
+	return FindOptStr(pred, l)
+}
+
+// Max - see MaxOptStr
+func (l Strs) Max() OptionStr {
+	return MaxOptStr(l)
+}
+
+// Min - see MinOptStr
+func (l Strs) Min() OptionStr {
+	return MinOptStr(l)
+}
@@ -0,0 +1,370 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFluentInt(t *testing.T) {
+	list := Ints{1, 2, 3, 4, 4}
+	large := func(v int) bool { return v > 2 }
+	double := func(v int) int { return v * 2 }
+	add := func(a, b int) int { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentInt failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentInt failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]int{6, 8}, v) {
+		t.Errorf("TestFluentInt failed. Expected=%v, actual=%v", []int{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v int) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Ints{4}, v) {
+		t.Errorf("TestFluentInt failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v int) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Ints{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentInt failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentInt failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Ints(nil).Max().IsSome() {
+		t.Errorf("TestFluentInt failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentInt64(t *testing.T) {
+	list := Int64s{1, 2, 3, 4, 4}
+	large := func(v int64) bool { return v > 2 }
+	double := func(v int64) int64 { return v * 2 }
+	add := func(a, b int64) int64 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentInt64 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentInt64 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]int64{6, 8}, v) {
+		t.Errorf("TestFluentInt64 failed. Expected=%v, actual=%v", []int64{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v int64) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Int64s{4}, v) {
+		t.Errorf("TestFluentInt64 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v int64) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Int64s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentInt64 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentInt64 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Int64s(nil).Max().IsSome() {
+		t.Errorf("TestFluentInt64 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentInt32(t *testing.T) {
+	list := Int32s{1, 2, 3, 4, 4}
+	large := func(v int32) bool { return v > 2 }
+	double := func(v int32) int32 { return v * 2 }
+	add := func(a, b int32) int32 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentInt32 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentInt32 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]int32{6, 8}, v) {
+		t.Errorf("TestFluentInt32 failed. Expected=%v, actual=%v", []int32{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v int32) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Int32s{4}, v) {
+		t.Errorf("TestFluentInt32 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v int32) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Int32s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentInt32 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentInt32 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Int32s(nil).Max().IsSome() {
+		t.Errorf("TestFluentInt32 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentInt16(t *testing.T) {
+	list := Int16s{1, 2, 3, 4, 4}
+	large := func(v int16) bool { return v > 2 }
+	double := func(v int16) int16 { return v * 2 }
+	add := func(a, b int16) int16 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentInt16 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentInt16 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]int16{6, 8}, v) {
+		t.Errorf("TestFluentInt16 failed. Expected=%v, actual=%v", []int16{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v int16) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Int16s{4}, v) {
+		t.Errorf("TestFluentInt16 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v int16) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Int16s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentInt16 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentInt16 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Int16s(nil).Max().IsSome() {
+		t.Errorf("TestFluentInt16 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentInt8(t *testing.T) {
+	list := Int8s{1, 2, 3, 4, 4}
+	large := func(v int8) bool { return v > 2 }
+	double := func(v int8) int8 { return v * 2 }
+	add := func(a, b int8) int8 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentInt8 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentInt8 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]int8{6, 8}, v) {
+		t.Errorf("TestFluentInt8 failed. Expected=%v, actual=%v", []int8{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v int8) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Int8s{4}, v) {
+		t.Errorf("TestFluentInt8 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v int8) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Int8s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentInt8 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentInt8 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Int8s(nil).Max().IsSome() {
+		t.Errorf("TestFluentInt8 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentUint(t *testing.T) {
+	list := Uints{1, 2, 3, 4, 4}
+	large := func(v uint) bool { return v > 2 }
+	double := func(v uint) uint { return v * 2 }
+	add := func(a, b uint) uint { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentUint failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentUint failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]uint{6, 8}, v) {
+		t.Errorf("TestFluentUint failed. Expected=%v, actual=%v", []uint{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v uint) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Uints{4}, v) {
+		t.Errorf("TestFluentUint failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v uint) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Uints{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentUint failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentUint failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Uints(nil).Max().IsSome() {
+		t.Errorf("TestFluentUint failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentUint64(t *testing.T) {
+	list := Uint64s{1, 2, 3, 4, 4}
+	large := func(v uint64) bool { return v > 2 }
+	double := func(v uint64) uint64 { return v * 2 }
+	add := func(a, b uint64) uint64 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentUint64 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentUint64 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]uint64{6, 8}, v) {
+		t.Errorf("TestFluentUint64 failed. Expected=%v, actual=%v", []uint64{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v uint64) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Uint64s{4}, v) {
+		t.Errorf("TestFluentUint64 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v uint64) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Uint64s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentUint64 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentUint64 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Uint64s(nil).Max().IsSome() {
+		t.Errorf("TestFluentUint64 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentUint32(t *testing.T) {
+	list := Uint32s{1, 2, 3, 4, 4}
+	large := func(v uint32) bool { return v > 2 }
+	double := func(v uint32) uint32 { return v * 2 }
+	add := func(a, b uint32) uint32 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentUint32 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentUint32 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]uint32{6, 8}, v) {
+		t.Errorf("TestFluentUint32 failed. Expected=%v, actual=%v", []uint32{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v uint32) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Uint32s{4}, v) {
+		t.Errorf("TestFluentUint32 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v uint32) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Uint32s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentUint32 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentUint32 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Uint32s(nil).Max().IsSome() {
+		t.Errorf("TestFluentUint32 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentUint16(t *testing.T) {
+	list := Uint16s{1, 2, 3, 4, 4}
+	large := func(v uint16) bool { return v > 2 }
+	double := func(v uint16) uint16 { return v * 2 }
+	add := func(a, b uint16) uint16 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentUint16 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentUint16 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]uint16{6, 8}, v) {
+		t.Errorf("TestFluentUint16 failed. Expected=%v, actual=%v", []uint16{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v uint16) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Uint16s{4}, v) {
+		t.Errorf("TestFluentUint16 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v uint16) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Uint16s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentUint16 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentUint16 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Uint16s(nil).Max().IsSome() {
+		t.Errorf("TestFluentUint16 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentUint8(t *testing.T) {
+	list := Uint8s{1, 2, 3, 4, 4}
+	large := func(v uint8) bool { return v > 2 }
+	double := func(v uint8) uint8 { return v * 2 }
+	add := func(a, b uint8) uint8 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentUint8 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentUint8 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]uint8{6, 8}, v) {
+		t.Errorf("TestFluentUint8 failed. Expected=%v, actual=%v", []uint8{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v uint8) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Uint8s{4}, v) {
+		t.Errorf("TestFluentUint8 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v uint8) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Uint8s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentUint8 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentUint8 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Uint8s(nil).Max().IsSome() {
+		t.Errorf("TestFluentUint8 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentFloat64(t *testing.T) {
+	list := Float64s{1, 2, 3, 4, 4}
+	large := func(v float64) bool { return v > 2 }
+	double := func(v float64) float64 { return v * 2 }
+	add := func(a, b float64) float64 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentFloat64 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentFloat64 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]float64{6, 8}, v) {
+		t.Errorf("TestFluentFloat64 failed. Expected=%v, actual=%v", []float64{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v float64) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Float64s{4}, v) {
+		t.Errorf("TestFluentFloat64 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v float64) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Float64s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentFloat64 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentFloat64 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Float64s(nil).Max().IsSome() {
+		t.Errorf("TestFluentFloat64 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentFloat32(t *testing.T) {
+	list := Float32s{1, 2, 3, 4, 4}
+	large := func(v float32) bool { return v > 2 }
+	double := func(v float32) float32 { return v * 2 }
+	add := func(a, b float32) float32 { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluentFloat32 failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluentFloat32 failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]float32{6, 8}, v) {
+		t.Errorf("TestFluentFloat32 failed. Expected=%v, actual=%v", []float32{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v float32) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(Float32s{4}, v) {
+		t.Errorf("TestFluentFloat32 failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v float32) bool { return v < 3 }).Rest(); !reflect.DeepEqual(Float32s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluentFloat32 failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluentFloat32 failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || Float32s(nil).Max().IsSome() {
+		t.Errorf("TestFluentFloat32 failed. Unexpected Find, Max or Min")
+	}
+}
+
+func TestFluentStr(t *testing.T) {
+	list := Strs{"a", "bb", "cc", "cc"}
+	long := func(v string) bool { return len(v) > 1 }
+	upper := func(v string) string { return v + "!" }
+
+	if v := list.Filter(long).Distinct().Map(upper).Reduce(func(a, b string) string { return a + b }); v != "bb!cc!" {
+		t.Errorf("TestFluentStr failed. Expected=%v, actual=%v", "bb!cc!", v)
+	}
+	if v := list.Dedupe().Drop("a").PMap(upper).ToSlice(); !reflect.DeepEqual([]string{"bb!", "cc!"}, v) {
+		t.Errorf("TestFluentStr failed. Expected=[bb! cc!], actual=%v", v)
+	}
+	if !list.Some(long) || list.Every(long) || list.Max().Unwrap() != "cc" || list.Find(long).Unwrap() != "bb" {
+		t.Errorf("TestFluentStr failed. Unexpected Some, Every, Max or Find")
+	}
+}
@@ -0,0 +1,1981 @@
+package fp
+
+// MapToInt64 - see MapIntInt64
+func (l Ints) MapToInt64(f func(int) int64) Int64s {
+	return MapIntInt64(f, l)
+}
+
+// PMapToInt64 - see PMapIntInt64
+func (l Ints) PMapToInt64(f func(int) int64) Int64s {
+	return PMapIntInt64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapIntInt64
+func (l Ints) FilterMapToInt64(fFilter func(int) bool, fMap func(int) int64) Int64s {
+	return FilterMapIntInt64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapIntInt32
+func (l Ints) MapToInt32(f func(int) int32) Int32s {
+	return MapIntInt32(f, l)
+}
+
+// PMapToInt32 - see PMapIntInt32
+func (l Ints) PMapToInt32(f func(int) int32) Int32s {
+	return PMapIntInt32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapIntInt32
+func (l Ints) FilterMapToInt32(fFilter func(int) bool, fMap func(int) int32) Int32s {
+	return FilterMapIntInt32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapIntInt16
+func (l Ints) MapToInt16(f func(int) int16) Int16s {
+	return MapIntInt16(f, l)
+}
+
+// PMapToInt16 - see PMapIntInt16
+func (l Ints) PMapToInt16(f func(int) int16) Int16s {
+	return PMapIntInt16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapIntInt16
+func (l Ints) FilterMapToInt16(fFilter func(int) bool, fMap func(int) int16) Int16s {
+	return FilterMapIntInt16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapIntInt8
+func (l Ints) MapToInt8(f func(int) int8) Int8s {
+	return MapIntInt8(f, l)
+}
+
+// PMapToInt8 - see PMapIntInt8
+func (l Ints) PMapToInt8(f func(int) int8) Int8s {
+	return PMapIntInt8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapIntInt8
+func (l Ints) FilterMapToInt8(fFilter func(int) bool, fMap func(int) int8) Int8s {
+	return FilterMapIntInt8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapIntUint
+func (l Ints) MapToUint(f func(int) uint) Uints {
+	return MapIntUint(f, l)
+}
+
+// PMapToUint - see PMapIntUint
+func (l Ints) PMapToUint(f func(int) uint) Uints {
+	return PMapIntUint(f, l)
+}
+
+// FilterMapToUint - see FilterMapIntUint
+func (l Ints) FilterMapToUint(fFilter func(int) bool, fMap func(int) uint) Uints {
+	return FilterMapIntUint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapIntUint64
+func (l Ints) MapToUint64(f func(int) uint64) Uint64s {
+	return MapIntUint64(f, l)
+}
+
+// PMapToUint64 - see PMapIntUint64
+func (l Ints) PMapToUint64(f func(int) uint64) Uint64s {
+	return PMapIntUint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapIntUint64
+func (l Ints) FilterMapToUint64(fFilter func(int) bool, fMap func(int) uint64) Uint64s {
+	return FilterMapIntUint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapIntUint32
+func (l Ints) MapToUint32(f func(int) uint32) Uint32s {
+	return MapIntUint32(f, l)
+}
+
+// PMapToUint32 - see PMapIntUint32
+func (l Ints) PMapToUint32(f func(int) uint32) Uint32s {
+	return PMapIntUint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapIntUint32
+func (l Ints) FilterMapToUint32(fFilter func(int) bool, fMap func(int) uint32) Uint32s {
+	return FilterMapIntUint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapIntUint16
+func (l Ints) MapToUint16(f func(int) uint16) Uint16s {
+	return MapIntUint16(f, l)
+}
+
+// PMapToUint16 - see PMapIntUint16
+func (l Ints) PMapToUint16(f func(int) uint16) Uint16s {
+	return PMapIntUint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapIntUint16
+func (l Ints) FilterMapToUint16(fFilter func(int) bool, fMap func(int) uint16) Uint16s {
+	return FilterMapIntUint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapIntUint8
+func (l Ints) MapToUint8(f func(int) uint8) Uint8s {
+	return MapIntUint8(f, l)
+}
+
+// PMapToUint8 - see PMapIntUint8
+func (l Ints) PMapToUint8(f func(int) uint8) Uint8s {
+	return PMapIntUint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapIntUint8
+func (l Ints) FilterMapToUint8(fFilter func(int) bool, fMap func(int) uint8) Uint8s {
+	return FilterMapIntUint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapIntStr
+func (l Ints) MapToStr(f func(int) string) Strs {
+	return MapIntStr(f, l)
+}
+
+// PMapToStr - see PMapIntStr
+func (l Ints) PMapToStr(f func(int) string) Strs {
+	return PMapIntStr(f, l)
+}
+
+// FilterMapToStr - see FilterMapIntStr
+func (l Ints) FilterMapToStr(fFilter func(int) bool, fMap func(int) string) Strs {
+	return FilterMapIntStr(fFilter, fMap, l)
+}
+
+// MapToBool - see MapIntBool
+func (l Ints) MapToBool(f func(int) bool) Bools {
+	return MapIntBool(f, l)
+}
+
+// PMapToBool - see PMapIntBool
+func (l Ints) PMapToBool(f func(int) bool) Bools {
+	return PMapIntBool(f, l)
+}
+
+// FilterMapToBool - see FilterMapIntBool
+func (l Ints) FilterMapToBool(fFilter func(int) bool, fMap func(int) bool) Bools {
+	return FilterMapIntBool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapInt64Int
+func (l Int64s) MapToInt(f func(int64) int) Ints {
+	return MapInt64Int(f, l)
+}
+
+// PMapToInt - see PMapInt64Int
+func (l Int64s) PMapToInt(f func(int64) int) Ints {
+	return PMapInt64Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapInt64Int
+func (l Int64s) FilterMapToInt(fFilter func(int64) bool, fMap func(int64) int) Ints {
+	return FilterMapInt64Int(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapInt64Int32
+func (l Int64s) MapToInt32(f func(int64) int32) Int32s {
+	return MapInt64Int32(f, l)
+}
+
+// PMapToInt32 - see PMapInt64Int32
+func (l Int64s) PMapToInt32(f func(int64) int32) Int32s {
+	return PMapInt64Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapInt64Int32
+func (l Int64s) FilterMapToInt32(fFilter func(int64) bool, fMap func(int64) int32) Int32s {
+	return FilterMapInt64Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapInt64Int16
+func (l Int64s) MapToInt16(f func(int64) int16) Int16s {
+	return MapInt64Int16(f, l)
+}
+
+// PMapToInt16 - see PMapInt64Int16
+func (l Int64s) PMapToInt16(f func(int64) int16) Int16s {
+	return PMapInt64Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapInt64Int16
+func (l Int64s) FilterMapToInt16(fFilter func(int64) bool, fMap func(int64) int16) Int16s {
+	return FilterMapInt64Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapInt64Int8
+func (l Int64s) MapToInt8(f func(int64) int8) Int8s {
+	return MapInt64Int8(f, l)
+}
+
+// PMapToInt8 - see PMapInt64Int8
+func (l Int64s) PMapToInt8(f func(int64) int8) Int8s {
+	return PMapInt64Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapInt64Int8
+func (l Int64s) FilterMapToInt8(fFilter func(int64) bool, fMap func(int64) int8) Int8s {
+	return FilterMapInt64Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapInt64Uint
+func (l Int64s) MapToUint(f func(int64) uint) Uints {
+	return MapInt64Uint(f, l)
+}
+
+// PMapToUint - see PMapInt64Uint
+func (l Int64s) PMapToUint(f func(int64) uint) Uints {
+	return PMapInt64Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapInt64Uint
+func (l Int64s) FilterMapToUint(fFilter func(int64) bool, fMap func(int64) uint) Uints {
+	return FilterMapInt64Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapInt64Uint64
+func (l Int64s) MapToUint64(f func(int64) uint64) Uint64s {
+	return MapInt64Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapInt64Uint64
+func (l Int64s) PMapToUint64(f func(int64) uint64) Uint64s {
+	return PMapInt64Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapInt64Uint64
+func (l Int64s) FilterMapToUint64(fFilter func(int64) bool, fMap func(int64) uint64) Uint64s {
+	return FilterMapInt64Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapInt64Uint32
+func (l Int64s) MapToUint32(f func(int64) uint32) Uint32s {
+	return MapInt64Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapInt64Uint32
+func (l Int64s) PMapToUint32(f func(int64) uint32) Uint32s {
+	return PMapInt64Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapInt64Uint32
+func (l Int64s) FilterMapToUint32(fFilter func(int64) bool, fMap func(int64) uint32) Uint32s {
+	return FilterMapInt64Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapInt64Uint16
+func (l Int64s) MapToUint16(f func(int64) uint16) Uint16s {
+	return MapInt64Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapInt64Uint16
+func (l Int64s) PMapToUint16(f func(int64) uint16) Uint16s {
+	return PMapInt64Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapInt64Uint16
+func (l Int64s) FilterMapToUint16(fFilter func(int64) bool, fMap func(int64) uint16) Uint16s {
+	return FilterMapInt64Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapInt64Uint8
+func (l Int64s) MapToUint8(f func(int64) uint8) Uint8s {
+	return MapInt64Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapInt64Uint8
+func (l Int64s) PMapToUint8(f func(int64) uint8) Uint8s {
+	return PMapInt64Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapInt64Uint8
+func (l Int64s) FilterMapToUint8(fFilter func(int64) bool, fMap func(int64) uint8) Uint8s {
+	return FilterMapInt64Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapInt64Str
+func (l Int64s) MapToStr(f func(int64) string) Strs {
+	return MapInt64Str(f, l)
+}
+
+// PMapToStr - see PMapInt64Str
+func (l Int64s) PMapToStr(f func(int64) string) Strs {
+	return PMapInt64Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapInt64Str
+func (l Int64s) FilterMapToStr(fFilter func(int64) bool, fMap func(int64) string) Strs {
+	return FilterMapInt64Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapInt64Bool
+func (l Int64s) MapToBool(f func(int64) bool) Bools {
+	return MapInt64Bool(f, l)
+}
+
+// PMapToBool - see PMapInt64Bool
+func (l Int64s) PMapToBool(f func(int64) bool) Bools {
+	return PMapInt64Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapInt64Bool
+func (l Int64s) FilterMapToBool(fFilter func(int64) bool, fMap func(int64) bool) Bools {
+	return FilterMapInt64Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapInt32Int
+func (l Int32s) MapToInt(f func(int32) int) Ints {
+	return MapInt32Int(f, l)
+}
+
+// PMapToInt - see PMapInt32Int
+func (l Int32s) PMapToInt(f func(int32) int) Ints {
+	return PMapInt32Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapInt32Int
+func (l Int32s) FilterMapToInt(fFilter func(int32) bool, fMap func(int32) int) Ints {
+	return FilterMapInt32Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapInt32Int64
+func (l Int32s) MapToInt64(f func(int32) int64) Int64s {
+	return MapInt32Int64(f, l)
+}
+
+// PMapToInt64 - see PMapInt32Int64
+func (l Int32s) PMapToInt64(f func(int32) int64) Int64s {
+	return PMapInt32Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapInt32Int64
+func (l Int32s) FilterMapToInt64(fFilter func(int32) bool, fMap func(int32) int64) Int64s {
+	return FilterMapInt32Int64(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapInt32Int16
+func (l Int32s) MapToInt16(f func(int32) int16) Int16s {
+	return MapInt32Int16(f, l)
+}
+
+// PMapToInt16 - see PMapInt32Int16
+func (l Int32s) PMapToInt16(f func(int32) int16) Int16s {
+	return PMapInt32Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapInt32Int16
+func (l Int32s) FilterMapToInt16(fFilter func(int32) bool, fMap func(int32) int16) Int16s {
+	return FilterMapInt32Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapInt32Int8
+func (l Int32s) MapToInt8(f func(int32) int8) Int8s {
+	return MapInt32Int8(f, l)
+}
+
+// PMapToInt8 - see PMapInt32Int8
+func (l Int32s) PMapToInt8(f func(int32) int8) Int8s {
+	return PMapInt32Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapInt32Int8
+func (l Int32s) FilterMapToInt8(fFilter func(int32) bool, fMap func(int32) int8) Int8s {
+	return FilterMapInt32Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapInt32Uint
+func (l Int32s) MapToUint(f func(int32) uint) Uints {
+	return MapInt32Uint(f, l)
+}
+
+// PMapToUint - see PMapInt32Uint
+func (l Int32s) PMapToUint(f func(int32) uint) Uints {
+	return PMapInt32Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapInt32Uint
+func (l Int32s) FilterMapToUint(fFilter func(int32) bool, fMap func(int32) uint) Uints {
+	return FilterMapInt32Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapInt32Uint64
+func (l Int32s) MapToUint64(f func(int32) uint64) Uint64s {
+	return MapInt32Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapInt32Uint64
+func (l Int32s) PMapToUint64(f func(int32) uint64) Uint64s {
+	return PMapInt32Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapInt32Uint64
+func (l Int32s) FilterMapToUint64(fFilter func(int32) bool, fMap func(int32) uint64) Uint64s {
+	return FilterMapInt32Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapInt32Uint32
+func (l Int32s) MapToUint32(f func(int32) uint32) Uint32s {
+	return MapInt32Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapInt32Uint32
+func (l Int32s) PMapToUint32(f func(int32) uint32) Uint32s {
+	return PMapInt32Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapInt32Uint32
+func (l Int32s) FilterMapToUint32(fFilter func(int32) bool, fMap func(int32) uint32) Uint32s {
+	return FilterMapInt32Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapInt32Uint16
+func (l Int32s) MapToUint16(f func(int32) uint16) Uint16s {
+	return MapInt32Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapInt32Uint16
+func (l Int32s) PMapToUint16(f func(int32) uint16) Uint16s {
+	return PMapInt32Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapInt32Uint16
+func (l Int32s) FilterMapToUint16(fFilter func(int32) bool, fMap func(int32) uint16) Uint16s {
+	return FilterMapInt32Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapInt32Uint8
+func (l Int32s) MapToUint8(f func(int32) uint8) Uint8s {
+	return MapInt32Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapInt32Uint8
+func (l Int32s) PMapToUint8(f func(int32) uint8) Uint8s {
+	return PMapInt32Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapInt32Uint8
+func (l Int32s) FilterMapToUint8(fFilter func(int32) bool, fMap func(int32) uint8) Uint8s {
+	return FilterMapInt32Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapInt32Str
+func (l Int32s) MapToStr(f func(int32) string) Strs {
+	return MapInt32Str(f, l)
+}
+
+// PMapToStr - see PMapInt32Str
+func (l Int32s) PMapToStr(f func(int32) string) Strs {
+	return PMapInt32Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapInt32Str
+func (l Int32s) FilterMapToStr(fFilter func(int32) bool, fMap func(int32) string) Strs {
+	return FilterMapInt32Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapInt32Bool
+func (l Int32s) MapToBool(f func(int32) bool) Bools {
+	return MapInt32Bool(f, l)
+}
+
+// PMapToBool - see PMapInt32Bool
+func (l Int32s) PMapToBool(f func(int32) bool) Bools {
+	return PMapInt32Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapInt32Bool
+func (l Int32s) FilterMapToBool(fFilter func(int32) bool, fMap func(int32) bool) Bools {
+	return FilterMapInt32Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapInt16Int
+func (l Int16s) MapToInt(f func(int16) int) Ints {
+	return MapInt16Int(f, l)
+}
+
+// PMapToInt - see PMapInt16Int
+func (l Int16s) PMapToInt(f func(int16) int) Ints {
+	return PMapInt16Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapInt16Int
+func (l Int16s) FilterMapToInt(fFilter func(int16) bool, fMap func(int16) int) Ints {
+	return FilterMapInt16Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapInt16Int64
+func (l Int16s) MapToInt64(f func(int16) int64) Int64s {
+	return MapInt16Int64(f, l)
+}
+
+// PMapToInt64 - see PMapInt16Int64
+func (l Int16s) PMapToInt64(f func(int16) int64) Int64s {
+	return PMapInt16Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapInt16Int64
+func (l Int16s) FilterMapToInt64(fFilter func(int16) bool, fMap func(int16) int64) Int64s {
+	return FilterMapInt16Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapInt16Int32
+func (l Int16s) MapToInt32(f func(int16) int32) Int32s {
+	return MapInt16Int32(f, l)
+}
+
+// PMapToInt32 - see PMapInt16Int32
+func (l Int16s) PMapToInt32(f func(int16) int32) Int32s {
+	return PMapInt16Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapInt16Int32
+func (l Int16s) FilterMapToInt32(fFilter func(int16) bool, fMap func(int16) int32) Int32s {
+	return FilterMapInt16Int32(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapInt16Int8
+func (l Int16s) MapToInt8(f func(int16) int8) Int8s {
+	return MapInt16Int8(f, l)
+}
+
+// PMapToInt8 - see PMapInt16Int8
+func (l Int16s) PMapToInt8(f func(int16) int8) Int8s {
+	return PMapInt16Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapInt16Int8
+func (l Int16s) FilterMapToInt8(fFilter func(int16) bool, fMap func(int16) int8) Int8s {
+	return FilterMapInt16Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapInt16Uint
+func (l Int16s) MapToUint(f func(int16) uint) Uints {
+	return MapInt16Uint(f, l)
+}
+
+// PMapToUint - see PMapInt16Uint
+func (l Int16s) PMapToUint(f func(int16) uint) Uints {
+	return PMapInt16Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapInt16Uint
+func (l Int16s) FilterMapToUint(fFilter func(int16) bool, fMap func(int16) uint) Uints {
+	return FilterMapInt16Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapInt16Uint64
+func (l Int16s) MapToUint64(f func(int16) uint64) Uint64s {
+	return MapInt16Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapInt16Uint64
+func (l Int16s) PMapToUint64(f func(int16) uint64) Uint64s {
+	return PMapInt16Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapInt16Uint64
+func (l Int16s) FilterMapToUint64(fFilter func(int16) bool, fMap func(int16) uint64) Uint64s {
+	return FilterMapInt16Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapInt16Uint32
+func (l Int16s) MapToUint32(f func(int16) uint32) Uint32s {
+	return MapInt16Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapInt16Uint32
+func (l Int16s) PMapToUint32(f func(int16) uint32) Uint32s {
+	return PMapInt16Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapInt16Uint32
+func (l Int16s) FilterMapToUint32(fFilter func(int16) bool, fMap func(int16) uint32) Uint32s {
+	return FilterMapInt16Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapInt16Uint16
+func (l Int16s) MapToUint16(f func(int16) uint16) Uint16s {
+	return MapInt16Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapInt16Uint16
+func (l Int16s) PMapToUint16(f func(int16) uint16) Uint16s {
+	return PMapInt16Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapInt16Uint16
+func (l Int16s) FilterMapToUint16(fFilter func(int16) bool, fMap func(int16) uint16) Uint16s {
+	return FilterMapInt16Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapInt16Uint8
+func (l Int16s) MapToUint8(f func(int16) uint8) Uint8s {
+	return MapInt16Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapInt16Uint8
+func (l Int16s) PMapToUint8(f func(int16) uint8) Uint8s {
+	return PMapInt16Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapInt16Uint8
+func (l Int16s) FilterMapToUint8(fFilter func(int16) bool, fMap func(int16) uint8) Uint8s {
+	return FilterMapInt16Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapInt16Str
+func (l Int16s) MapToStr(f func(int16) string) Strs {
+	return MapInt16Str(f, l)
+}
+
+// PMapToStr - see PMapInt16Str
+func (l Int16s) PMapToStr(f func(int16) string) Strs {
+	return PMapInt16Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapInt16Str
+func (l Int16s) FilterMapToStr(fFilter func(int16) bool, fMap func(int16) string) Strs {
+	return FilterMapInt16Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapInt16Bool
+func (l Int16s) MapToBool(f func(int16) bool) Bools {
+	return MapInt16Bool(f, l)
+}
+
+// PMapToBool - see PMapInt16Bool
+func (l Int16s) PMapToBool(f func(int16) bool) Bools {
+	return PMapInt16Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapInt16Bool
+func (l Int16s) FilterMapToBool(fFilter func(int16) bool, fMap func(int16) bool) Bools {
+	return FilterMapInt16Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapInt8Int
+func (l Int8s) MapToInt(f func(int8) int) Ints {
+	return MapInt8Int(f, l)
+}
+
+// PMapToInt - see PMapInt8Int
+func (l Int8s) PMapToInt(f func(int8) int) Ints {
+	return PMapInt8Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapInt8Int
+func (l Int8s) FilterMapToInt(fFilter func(int8) bool, fMap func(int8) int) Ints {
+	return FilterMapInt8Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapInt8Int64
+func (l Int8s) MapToInt64(f func(int8) int64) Int64s {
+	return MapInt8Int64(f, l)
+}
+
+// PMapToInt64 - see PMapInt8Int64
+func (l Int8s) PMapToInt64(f func(int8) int64) Int64s {
+	return PMapInt8Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapInt8Int64
+func (l Int8s) FilterMapToInt64(fFilter func(int8) bool, fMap func(int8) int64) Int64s {
+	return FilterMapInt8Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapInt8Int32
+func (l Int8s) MapToInt32(f func(int8) int32) Int32s {
+	return MapInt8Int32(f, l)
+}
+
+// PMapToInt32 - see PMapInt8Int32
+func (l Int8s) PMapToInt32(f func(int8) int32) Int32s {
+	return PMapInt8Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapInt8Int32
+func (l Int8s) FilterMapToInt32(fFilter func(int8) bool, fMap func(int8) int32) Int32s {
+	return FilterMapInt8Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapInt8Int16
+func (l Int8s) MapToInt16(f func(int8) int16) Int16s {
+	return MapInt8Int16(f, l)
+}
+
+// PMapToInt16 - see PMapInt8Int16
+func (l Int8s) PMapToInt16(f func(int8) int16) Int16s {
+	return PMapInt8Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapInt8Int16
+func (l Int8s) FilterMapToInt16(fFilter func(int8) bool, fMap func(int8) int16) Int16s {
+	return FilterMapInt8Int16(fFilter, fMap, l)
+}
+
+// MapToUint - see MapInt8Uint
+func (l Int8s) MapToUint(f func(int8) uint) Uints {
+	return MapInt8Uint(f, l)
+}
+
+// PMapToUint - see PMapInt8Uint
+func (l Int8s) PMapToUint(f func(int8) uint) Uints {
+	return PMapInt8Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapInt8Uint
+func (l Int8s) FilterMapToUint(fFilter func(int8) bool, fMap func(int8) uint) Uints {
+	return FilterMapInt8Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapInt8Uint64
+func (l Int8s) MapToUint64(f func(int8) uint64) Uint64s {
+	return MapInt8Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapInt8Uint64
+func (l Int8s) PMapToUint64(f func(int8) uint64) Uint64s {
+	return PMapInt8Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapInt8Uint64
+func (l Int8s) FilterMapToUint64(fFilter func(int8) bool, fMap func(int8) uint64) Uint64s {
+	return FilterMapInt8Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapInt8Uint32
+func (l Int8s) MapToUint32(f func(int8) uint32) Uint32s {
+	return MapInt8Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapInt8Uint32
+func (l Int8s) PMapToUint32(f func(int8) uint32) Uint32s {
+	return PMapInt8Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapInt8Uint32
+func (l Int8s) FilterMapToUint32(fFilter func(int8) bool, fMap func(int8) uint32) Uint32s {
+	return FilterMapInt8Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapInt8Uint16
+func (l Int8s) MapToUint16(f func(int8) uint16) Uint16s {
+	return MapInt8Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapInt8Uint16
+func (l Int8s) PMapToUint16(f func(int8) uint16) Uint16s {
+	return PMapInt8Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapInt8Uint16
+func (l Int8s) FilterMapToUint16(fFilter func(int8) bool, fMap func(int8) uint16) Uint16s {
+	return FilterMapInt8Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapInt8Uint8
+func (l Int8s) MapToUint8(f func(int8) uint8) Uint8s {
+	return MapInt8Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapInt8Uint8
+func (l Int8s) PMapToUint8(f func(int8) uint8) Uint8s {
+	return PMapInt8Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapInt8Uint8
+func (l Int8s) FilterMapToUint8(fFilter func(int8) bool, fMap func(int8) uint8) Uint8s {
+	return FilterMapInt8Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapInt8Str
+func (l Int8s) MapToStr(f func(int8) string) Strs {
+	return MapInt8Str(f, l)
+}
+
+// PMapToStr - see PMapInt8Str
+func (l Int8s) PMapToStr(f func(int8) string) Strs {
+	return PMapInt8Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapInt8Str
+func (l Int8s) FilterMapToStr(fFilter func(int8) bool, fMap func(int8) string) Strs {
+	return FilterMapInt8Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapInt8Bool
+func (l Int8s) MapToBool(f func(int8) bool) Bools {
+	return MapInt8Bool(f, l)
+}
+
+// PMapToBool - see PMapInt8Bool
+func (l Int8s) PMapToBool(f func(int8) bool) Bools {
+	return PMapInt8Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapInt8Bool
+func (l Int8s) FilterMapToBool(fFilter func(int8) bool, fMap func(int8) bool) Bools {
+	return FilterMapInt8Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapUintInt
+func (l Uints) MapToInt(f func(uint) int) Ints {
+	return MapUintInt(f, l)
+}
+
+// PMapToInt - see PMapUintInt
+func (l Uints) PMapToInt(f func(uint) int) Ints {
+	return PMapUintInt(f, l)
+}
+
+// FilterMapToInt - see FilterMapUintInt
+func (l Uints) FilterMapToInt(fFilter func(uint) bool, fMap func(uint) int) Ints {
+	return FilterMapUintInt(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapUintInt64
+func (l Uints) MapToInt64(f func(uint) int64) Int64s {
+	return MapUintInt64(f, l)
+}
+
+// PMapToInt64 - see PMapUintInt64
+func (l Uints) PMapToInt64(f func(uint) int64) Int64s {
+	return PMapUintInt64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapUintInt64
+func (l Uints) FilterMapToInt64(fFilter func(uint) bool, fMap func(uint) int64) Int64s {
+	return FilterMapUintInt64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapUintInt32
+func (l Uints) MapToInt32(f func(uint) int32) Int32s {
+	return MapUintInt32(f, l)
+}
+
+// PMapToInt32 - see PMapUintInt32
+func (l Uints) PMapToInt32(f func(uint) int32) Int32s {
+	return PMapUintInt32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapUintInt32
+func (l Uints) FilterMapToInt32(fFilter func(uint) bool, fMap func(uint) int32) Int32s {
+	return FilterMapUintInt32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapUintInt16
+func (l Uints) MapToInt16(f func(uint) int16) Int16s {
+	return MapUintInt16(f, l)
+}
+
+// PMapToInt16 - see PMapUintInt16
+func (l Uints) PMapToInt16(f func(uint) int16) Int16s {
+	return PMapUintInt16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapUintInt16
+func (l Uints) FilterMapToInt16(fFilter func(uint) bool, fMap func(uint) int16) Int16s {
+	return FilterMapUintInt16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapUintInt8
+func (l Uints) MapToInt8(f func(uint) int8) Int8s {
+	return MapUintInt8(f, l)
+}
+
+// PMapToInt8 - see PMapUintInt8
+func (l Uints) PMapToInt8(f func(uint) int8) Int8s {
+	return PMapUintInt8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapUintInt8
+func (l Uints) FilterMapToInt8(fFilter func(uint) bool, fMap func(uint) int8) Int8s {
+	return FilterMapUintInt8(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapUintUint64
+func (l Uints) MapToUint64(f func(uint) uint64) Uint64s {
+	return MapUintUint64(f, l)
+}
+
+// PMapToUint64 - see PMapUintUint64
+func (l Uints) PMapToUint64(f func(uint) uint64) Uint64s {
+	return PMapUintUint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapUintUint64
+func (l Uints) FilterMapToUint64(fFilter func(uint) bool, fMap func(uint) uint64) Uint64s {
+	return FilterMapUintUint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapUintUint32
+func (l Uints) MapToUint32(f func(uint) uint32) Uint32s {
+	return MapUintUint32(f, l)
+}
+
+// PMapToUint32 - see PMapUintUint32
+func (l Uints) PMapToUint32(f func(uint) uint32) Uint32s {
+	return PMapUintUint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapUintUint32
+func (l Uints) FilterMapToUint32(fFilter func(uint) bool, fMap func(uint) uint32) Uint32s {
+	return FilterMapUintUint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapUintUint16
+func (l Uints) MapToUint16(f func(uint) uint16) Uint16s {
+	return MapUintUint16(f, l)
+}
+
+// PMapToUint16 - see PMapUintUint16
+func (l Uints) PMapToUint16(f func(uint) uint16) Uint16s {
+	return PMapUintUint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapUintUint16
+func (l Uints) FilterMapToUint16(fFilter func(uint) bool, fMap func(uint) uint16) Uint16s {
+	return FilterMapUintUint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapUintUint8
+func (l Uints) MapToUint8(f func(uint) uint8) Uint8s {
+	return MapUintUint8(f, l)
+}
+
+// PMapToUint8 - see PMapUintUint8
+func (l Uints) PMapToUint8(f func(uint) uint8) Uint8s {
+	return PMapUintUint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapUintUint8
+func (l Uints) FilterMapToUint8(fFilter func(uint) bool, fMap func(uint) uint8) Uint8s {
+	return FilterMapUintUint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapUintStr
+func (l Uints) MapToStr(f func(uint) string) Strs {
+	return MapUintStr(f, l)
+}
+
+// PMapToStr - see PMapUintStr
+func (l Uints) PMapToStr(f func(uint) string) Strs {
+	return PMapUintStr(f, l)
+}
+
+// FilterMapToStr - see FilterMapUintStr
+func (l Uints) FilterMapToStr(fFilter func(uint) bool, fMap func(uint) string) Strs {
+	return FilterMapUintStr(fFilter, fMap, l)
+}
+
+// MapToBool - see MapUintBool
+func (l Uints) MapToBool(f func(uint) bool) Bools {
+	return MapUintBool(f, l)
+}
+
+// PMapToBool - see PMapUintBool
+func (l Uints) PMapToBool(f func(uint) bool) Bools {
+	return PMapUintBool(f, l)
+}
+
+// FilterMapToBool - see FilterMapUintBool
+func (l Uints) FilterMapToBool(fFilter func(uint) bool, fMap func(uint) bool) Bools {
+	return FilterMapUintBool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapUint64Int
+func (l Uint64s) MapToInt(f func(uint64) int) Ints {
+	return MapUint64Int(f, l)
+}
+
+// PMapToInt - see PMapUint64Int
+func (l Uint64s) PMapToInt(f func(uint64) int) Ints {
+	return PMapUint64Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapUint64Int
+func (l Uint64s) FilterMapToInt(fFilter func(uint64) bool, fMap func(uint64) int) Ints {
+	return FilterMapUint64Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapUint64Int64
+func (l Uint64s) MapToInt64(f func(uint64) int64) Int64s {
+	return MapUint64Int64(f, l)
+}
+
+// PMapToInt64 - see PMapUint64Int64
+func (l Uint64s) PMapToInt64(f func(uint64) int64) Int64s {
+	return PMapUint64Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapUint64Int64
+func (l Uint64s) FilterMapToInt64(fFilter func(uint64) bool, fMap func(uint64) int64) Int64s {
+	return FilterMapUint64Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapUint64Int32
+func (l Uint64s) MapToInt32(f func(uint64) int32) Int32s {
+	return MapUint64Int32(f, l)
+}
+
+// PMapToInt32 - see PMapUint64Int32
+func (l Uint64s) PMapToInt32(f func(uint64) int32) Int32s {
+	return PMapUint64Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapUint64Int32
+func (l Uint64s) FilterMapToInt32(fFilter func(uint64) bool, fMap func(uint64) int32) Int32s {
+	return FilterMapUint64Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapUint64Int16
+func (l Uint64s) MapToInt16(f func(uint64) int16) Int16s {
+	return MapUint64Int16(f, l)
+}
+
+// PMapToInt16 - see PMapUint64Int16
+func (l Uint64s) PMapToInt16(f func(uint64) int16) Int16s {
+	return PMapUint64Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapUint64Int16
+func (l Uint64s) FilterMapToInt16(fFilter func(uint64) bool, fMap func(uint64) int16) Int16s {
+	return FilterMapUint64Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapUint64Int8
+func (l Uint64s) MapToInt8(f func(uint64) int8) Int8s {
+	return MapUint64Int8(f, l)
+}
+
+// PMapToInt8 - see PMapUint64Int8
+func (l Uint64s) PMapToInt8(f func(uint64) int8) Int8s {
+	return PMapUint64Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapUint64Int8
+func (l Uint64s) FilterMapToInt8(fFilter func(uint64) bool, fMap func(uint64) int8) Int8s {
+	return FilterMapUint64Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapUint64Uint
+func (l Uint64s) MapToUint(f func(uint64) uint) Uints {
+	return MapUint64Uint(f, l)
+}
+
+// PMapToUint - see PMapUint64Uint
+func (l Uint64s) PMapToUint(f func(uint64) uint) Uints {
+	return PMapUint64Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapUint64Uint
+func (l Uint64s) FilterMapToUint(fFilter func(uint64) bool, fMap func(uint64) uint) Uints {
+	return FilterMapUint64Uint(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapUint64Uint32
+func (l Uint64s) MapToUint32(f func(uint64) uint32) Uint32s {
+	return MapUint64Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapUint64Uint32
+func (l Uint64s) PMapToUint32(f func(uint64) uint32) Uint32s {
+	return PMapUint64Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapUint64Uint32
+func (l Uint64s) FilterMapToUint32(fFilter func(uint64) bool, fMap func(uint64) uint32) Uint32s {
+	return FilterMapUint64Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapUint64Uint16
+func (l Uint64s) MapToUint16(f func(uint64) uint16) Uint16s {
+	return MapUint64Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapUint64Uint16
+func (l Uint64s) PMapToUint16(f func(uint64) uint16) Uint16s {
+	return PMapUint64Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapUint64Uint16
+func (l Uint64s) FilterMapToUint16(fFilter func(uint64) bool, fMap func(uint64) uint16) Uint16s {
+	return FilterMapUint64Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapUint64Uint8
+func (l Uint64s) MapToUint8(f func(uint64) uint8) Uint8s {
+	return MapUint64Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapUint64Uint8
+func (l Uint64s) PMapToUint8(f func(uint64) uint8) Uint8s {
+	return PMapUint64Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapUint64Uint8
+func (l Uint64s) FilterMapToUint8(fFilter func(uint64) bool, fMap func(uint64) uint8) Uint8s {
+	return FilterMapUint64Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapUint64Str
+func (l Uint64s) MapToStr(f func(uint64) string) Strs {
+	return MapUint64Str(f, l)
+}
+
+// PMapToStr - see PMapUint64Str
+func (l Uint64s) PMapToStr(f func(uint64) string) Strs {
+	return PMapUint64Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapUint64Str
+func (l Uint64s) FilterMapToStr(fFilter func(uint64) bool, fMap func(uint64) string) Strs {
+	return FilterMapUint64Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapUint64Bool
+func (l Uint64s) MapToBool(f func(uint64) bool) Bools {
+	return MapUint64Bool(f, l)
+}
+
+// PMapToBool - see PMapUint64Bool
+func (l Uint64s) PMapToBool(f func(uint64) bool) Bools {
+	return PMapUint64Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapUint64Bool
+func (l Uint64s) FilterMapToBool(fFilter func(uint64) bool, fMap func(uint64) bool) Bools {
+	return FilterMapUint64Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapUint32Int
+func (l Uint32s) MapToInt(f func(uint32) int) Ints {
+	return MapUint32Int(f, l)
+}
+
+// PMapToInt - see PMapUint32Int
+func (l Uint32s) PMapToInt(f func(uint32) int) Ints {
+	return PMapUint32Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapUint32Int
+func (l Uint32s) FilterMapToInt(fFilter func(uint32) bool, fMap func(uint32) int) Ints {
+	return FilterMapUint32Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapUint32Int64
+func (l Uint32s) MapToInt64(f func(uint32) int64) Int64s {
+	return MapUint32Int64(f, l)
+}
+
+// PMapToInt64 - see PMapUint32Int64
+func (l Uint32s) PMapToInt64(f func(uint32) int64) Int64s {
+	return PMapUint32Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapUint32Int64
+func (l Uint32s) FilterMapToInt64(fFilter func(uint32) bool, fMap func(uint32) int64) Int64s {
+	return FilterMapUint32Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapUint32Int32
+func (l Uint32s) MapToInt32(f func(uint32) int32) Int32s {
+	return MapUint32Int32(f, l)
+}
+
+// PMapToInt32 - see PMapUint32Int32
+func (l Uint32s) PMapToInt32(f func(uint32) int32) Int32s {
+	return PMapUint32Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapUint32Int32
+func (l Uint32s) FilterMapToInt32(fFilter func(uint32) bool, fMap func(uint32) int32) Int32s {
+	return FilterMapUint32Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapUint32Int16
+func (l Uint32s) MapToInt16(f func(uint32) int16) Int16s {
+	return MapUint32Int16(f, l)
+}
+
+// PMapToInt16 - see PMapUint32Int16
+func (l Uint32s) PMapToInt16(f func(uint32) int16) Int16s {
+	return PMapUint32Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapUint32Int16
+func (l Uint32s) FilterMapToInt16(fFilter func(uint32) bool, fMap func(uint32) int16) Int16s {
+	return FilterMapUint32Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapUint32Int8
+func (l Uint32s) MapToInt8(f func(uint32) int8) Int8s {
+	return MapUint32Int8(f, l)
+}
+
+// PMapToInt8 - see PMapUint32Int8
+func (l Uint32s) PMapToInt8(f func(uint32) int8) Int8s {
+	return PMapUint32Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapUint32Int8
+func (l Uint32s) FilterMapToInt8(fFilter func(uint32) bool, fMap func(uint32) int8) Int8s {
+	return FilterMapUint32Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapUint32Uint
+func (l Uint32s) MapToUint(f func(uint32) uint) Uints {
+	return MapUint32Uint(f, l)
+}
+
+// PMapToUint - see PMapUint32Uint
+func (l Uint32s) PMapToUint(f func(uint32) uint) Uints {
+	return PMapUint32Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapUint32Uint
+func (l Uint32s) FilterMapToUint(fFilter func(uint32) bool, fMap func(uint32) uint) Uints {
+	return FilterMapUint32Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapUint32Uint64
+func (l Uint32s) MapToUint64(f func(uint32) uint64) Uint64s {
+	return MapUint32Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapUint32Uint64
+func (l Uint32s) PMapToUint64(f func(uint32) uint64) Uint64s {
+	return PMapUint32Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapUint32Uint64
+func (l Uint32s) FilterMapToUint64(fFilter func(uint32) bool, fMap func(uint32) uint64) Uint64s {
+	return FilterMapUint32Uint64(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapUint32Uint16
+func (l Uint32s) MapToUint16(f func(uint32) uint16) Uint16s {
+	return MapUint32Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapUint32Uint16
+func (l Uint32s) PMapToUint16(f func(uint32) uint16) Uint16s {
+	return PMapUint32Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapUint32Uint16
+func (l Uint32s) FilterMapToUint16(fFilter func(uint32) bool, fMap func(uint32) uint16) Uint16s {
+	return FilterMapUint32Uint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapUint32Uint8
+func (l Uint32s) MapToUint8(f func(uint32) uint8) Uint8s {
+	return MapUint32Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapUint32Uint8
+func (l Uint32s) PMapToUint8(f func(uint32) uint8) Uint8s {
+	return PMapUint32Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapUint32Uint8
+func (l Uint32s) FilterMapToUint8(fFilter func(uint32) bool, fMap func(uint32) uint8) Uint8s {
+	return FilterMapUint32Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapUint32Str
+func (l Uint32s) MapToStr(f func(uint32) string) Strs {
+	return MapUint32Str(f, l)
+}
+
+// PMapToStr - see PMapUint32Str
+func (l Uint32s) PMapToStr(f func(uint32) string) Strs {
+	return PMapUint32Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapUint32Str
+func (l Uint32s) FilterMapToStr(fFilter func(uint32) bool, fMap func(uint32) string) Strs {
+	return FilterMapUint32Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapUint32Bool
+func (l Uint32s) MapToBool(f func(uint32) bool) Bools {
+	return MapUint32Bool(f, l)
+}
+
+// PMapToBool - see PMapUint32Bool
+func (l Uint32s) PMapToBool(f func(uint32) bool) Bools {
+	return PMapUint32Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapUint32Bool
+func (l Uint32s) FilterMapToBool(fFilter func(uint32) bool, fMap func(uint32) bool) Bools {
+	return FilterMapUint32Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapUint16Int
+func (l Uint16s) MapToInt(f func(uint16) int) Ints {
+	return MapUint16Int(f, l)
+}
+
+// PMapToInt - see PMapUint16Int
+func (l Uint16s) PMapToInt(f func(uint16) int) Ints {
+	return PMapUint16Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapUint16Int
+func (l Uint16s) FilterMapToInt(fFilter func(uint16) bool, fMap func(uint16) int) Ints {
+	return FilterMapUint16Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapUint16Int64
+func (l Uint16s) MapToInt64(f func(uint16) int64) Int64s {
+	return MapUint16Int64(f, l)
+}
+
+// PMapToInt64 - see PMapUint16Int64
+func (l Uint16s) PMapToInt64(f func(uint16) int64) Int64s {
+	return PMapUint16Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapUint16Int64
+func (l Uint16s) FilterMapToInt64(fFilter func(uint16) bool, fMap func(uint16) int64) Int64s {
+	return FilterMapUint16Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapUint16Int32
+func (l Uint16s) MapToInt32(f func(uint16) int32) Int32s {
+	return MapUint16Int32(f, l)
+}
+
+// PMapToInt32 - see PMapUint16Int32
+func (l Uint16s) PMapToInt32(f func(uint16) int32) Int32s {
+	return PMapUint16Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapUint16Int32
+func (l Uint16s) FilterMapToInt32(fFilter func(uint16) bool, fMap func(uint16) int32) Int32s {
+	return FilterMapUint16Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapUint16Int16
+func (l Uint16s) MapToInt16(f func(uint16) int16) Int16s {
+	return MapUint16Int16(f, l)
+}
+
+// PMapToInt16 - see PMapUint16Int16
+func (l Uint16s) PMapToInt16(f func(uint16) int16) Int16s {
+	return PMapUint16Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapUint16Int16
+func (l Uint16s) FilterMapToInt16(fFilter func(uint16) bool, fMap func(uint16) int16) Int16s {
+	return FilterMapUint16Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapUint16Int8
+func (l Uint16s) MapToInt8(f func(uint16) int8) Int8s {
+	return MapUint16Int8(f, l)
+}
+
+// PMapToInt8 - see PMapUint16Int8
+func (l Uint16s) PMapToInt8(f func(uint16) int8) Int8s {
+	return PMapUint16Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapUint16Int8
+func (l Uint16s) FilterMapToInt8(fFilter func(uint16) bool, fMap func(uint16) int8) Int8s {
+	return FilterMapUint16Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapUint16Uint
+func (l Uint16s) MapToUint(f func(uint16) uint) Uints {
+	return MapUint16Uint(f, l)
+}
+
+// PMapToUint - see PMapUint16Uint
+func (l Uint16s) PMapToUint(f func(uint16) uint) Uints {
+	return PMapUint16Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapUint16Uint
+func (l Uint16s) FilterMapToUint(fFilter func(uint16) bool, fMap func(uint16) uint) Uints {
+	return FilterMapUint16Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapUint16Uint64
+func (l Uint16s) MapToUint64(f func(uint16) uint64) Uint64s {
+	return MapUint16Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapUint16Uint64
+func (l Uint16s) PMapToUint64(f func(uint16) uint64) Uint64s {
+	return PMapUint16Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapUint16Uint64
+func (l Uint16s) FilterMapToUint64(fFilter func(uint16) bool, fMap func(uint16) uint64) Uint64s {
+	return FilterMapUint16Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapUint16Uint32
+func (l Uint16s) MapToUint32(f func(uint16) uint32) Uint32s {
+	return MapUint16Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapUint16Uint32
+func (l Uint16s) PMapToUint32(f func(uint16) uint32) Uint32s {
+	return PMapUint16Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapUint16Uint32
+func (l Uint16s) FilterMapToUint32(fFilter func(uint16) bool, fMap func(uint16) uint32) Uint32s {
+	return FilterMapUint16Uint32(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapUint16Uint8
+func (l Uint16s) MapToUint8(f func(uint16) uint8) Uint8s {
+	return MapUint16Uint8(f, l)
+}
+
+// PMapToUint8 - see PMapUint16Uint8
+func (l Uint16s) PMapToUint8(f func(uint16) uint8) Uint8s {
+	return PMapUint16Uint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapUint16Uint8
+func (l Uint16s) FilterMapToUint8(fFilter func(uint16) bool, fMap func(uint16) uint8) Uint8s {
+	return FilterMapUint16Uint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapUint16Str
+func (l Uint16s) MapToStr(f func(uint16) string) Strs {
+	return MapUint16Str(f, l)
+}
+
+// PMapToStr - see PMapUint16Str
+func (l Uint16s) PMapToStr(f func(uint16) string) Strs {
+	return PMapUint16Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapUint16Str
+func (l Uint16s) FilterMapToStr(fFilter func(uint16) bool, fMap func(uint16) string) Strs {
+	return FilterMapUint16Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapUint16Bool
+func (l Uint16s) MapToBool(f func(uint16) bool) Bools {
+	return MapUint16Bool(f, l)
+}
+
+// PMapToBool - see PMapUint16Bool
+func (l Uint16s) PMapToBool(f func(uint16) bool) Bools {
+	return PMapUint16Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapUint16Bool
+func (l Uint16s) FilterMapToBool(fFilter func(uint16) bool, fMap func(uint16) bool) Bools {
+	return FilterMapUint16Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapUint8Int
+func (l Uint8s) MapToInt(f func(uint8) int) Ints {
+	return MapUint8Int(f, l)
+}
+
+// PMapToInt - see PMapUint8Int
+func (l Uint8s) PMapToInt(f func(uint8) int) Ints {
+	return PMapUint8Int(f, l)
+}
+
+// FilterMapToInt - see FilterMapUint8Int
+func (l Uint8s) FilterMapToInt(fFilter func(uint8) bool, fMap func(uint8) int) Ints {
+	return FilterMapUint8Int(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapUint8Int64
+func (l Uint8s) MapToInt64(f func(uint8) int64) Int64s {
+	return MapUint8Int64(f, l)
+}
+
+// PMapToInt64 - see PMapUint8Int64
+func (l Uint8s) PMapToInt64(f func(uint8) int64) Int64s {
+	return PMapUint8Int64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapUint8Int64
+func (l Uint8s) FilterMapToInt64(fFilter func(uint8) bool, fMap func(uint8) int64) Int64s {
+	return FilterMapUint8Int64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapUint8Int32
+func (l Uint8s) MapToInt32(f func(uint8) int32) Int32s {
+	return MapUint8Int32(f, l)
+}
+
+// PMapToInt32 - see PMapUint8Int32
+func (l Uint8s) PMapToInt32(f func(uint8) int32) Int32s {
+	return PMapUint8Int32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapUint8Int32
+func (l Uint8s) FilterMapToInt32(fFilter func(uint8) bool, fMap func(uint8) int32) Int32s {
+	return FilterMapUint8Int32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapUint8Int16
+func (l Uint8s) MapToInt16(f func(uint8) int16) Int16s {
+	return MapUint8Int16(f, l)
+}
+
+// PMapToInt16 - see PMapUint8Int16
+func (l Uint8s) PMapToInt16(f func(uint8) int16) Int16s {
+	return PMapUint8Int16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapUint8Int16
+func (l Uint8s) FilterMapToInt16(fFilter func(uint8) bool, fMap func(uint8) int16) Int16s {
+	return FilterMapUint8Int16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapUint8Int8
+func (l Uint8s) MapToInt8(f func(uint8) int8) Int8s {
+	return MapUint8Int8(f, l)
+}
+
+// PMapToInt8 - see PMapUint8Int8
+func (l Uint8s) PMapToInt8(f func(uint8) int8) Int8s {
+	return PMapUint8Int8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapUint8Int8
+func (l Uint8s) FilterMapToInt8(fFilter func(uint8) bool, fMap func(uint8) int8) Int8s {
+	return FilterMapUint8Int8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapUint8Uint
+func (l Uint8s) MapToUint(f func(uint8) uint) Uints {
+	return MapUint8Uint(f, l)
+}
+
+// PMapToUint - see PMapUint8Uint
+func (l Uint8s) PMapToUint(f func(uint8) uint) Uints {
+	return PMapUint8Uint(f, l)
+}
+
+// FilterMapToUint - see FilterMapUint8Uint
+func (l Uint8s) FilterMapToUint(fFilter func(uint8) bool, fMap func(uint8) uint) Uints {
+	return FilterMapUint8Uint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapUint8Uint64
+func (l Uint8s) MapToUint64(f func(uint8) uint64) Uint64s {
+	return MapUint8Uint64(f, l)
+}
+
+// PMapToUint64 - see PMapUint8Uint64
+func (l Uint8s) PMapToUint64(f func(uint8) uint64) Uint64s {
+	return PMapUint8Uint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapUint8Uint64
+func (l Uint8s) FilterMapToUint64(fFilter func(uint8) bool, fMap func(uint8) uint64) Uint64s {
+	return FilterMapUint8Uint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapUint8Uint32
+func (l Uint8s) MapToUint32(f func(uint8) uint32) Uint32s {
+	return MapUint8Uint32(f, l)
+}
+
+// PMapToUint32 - see PMapUint8Uint32
+func (l Uint8s) PMapToUint32(f func(uint8) uint32) Uint32s {
+	return PMapUint8Uint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapUint8Uint32
+func (l Uint8s) FilterMapToUint32(fFilter func(uint8) bool, fMap func(uint8) uint32) Uint32s {
+	return FilterMapUint8Uint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapUint8Uint16
+func (l Uint8s) MapToUint16(f func(uint8) uint16) Uint16s {
+	return MapUint8Uint16(f, l)
+}
+
+// PMapToUint16 - see PMapUint8Uint16
+func (l Uint8s) PMapToUint16(f func(uint8) uint16) Uint16s {
+	return PMapUint8Uint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapUint8Uint16
+func (l Uint8s) FilterMapToUint16(fFilter func(uint8) bool, fMap func(uint8) uint16) Uint16s {
+	return FilterMapUint8Uint16(fFilter, fMap, l)
+}
+
+// MapToStr - see MapUint8Str
+func (l Uint8s) MapToStr(f func(uint8) string) Strs {
+	return MapUint8Str(f, l)
+}
+
+// PMapToStr - see PMapUint8Str
+func (l Uint8s) PMapToStr(f func(uint8) string) Strs {
+	return PMapUint8Str(f, l)
+}
+
+// FilterMapToStr - see FilterMapUint8Str
+func (l Uint8s) FilterMapToStr(fFilter func(uint8) bool, fMap func(uint8) string) Strs {
+	return FilterMapUint8Str(fFilter, fMap, l)
+}
+
+// MapToBool - see MapUint8Bool
+func (l Uint8s) MapToBool(f func(uint8) bool) Bools {
+	return MapUint8Bool(f, l)
+}
+
+// PMapToBool - see PMapUint8Bool
+func (l Uint8s) PMapToBool(f func(uint8) bool) Bools {
+	return PMapUint8Bool(f, l)
+}
+
+// FilterMapToBool - see FilterMapUint8Bool
+func (l Uint8s) FilterMapToBool(fFilter func(uint8) bool, fMap func(uint8) bool) Bools {
+	return FilterMapUint8Bool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapStrInt
+func (l Strs) MapToInt(f func(string) int) Ints {
+	return MapStrInt(f, l)
+}
+
+// PMapToInt - see PMapStrInt
+func (l Strs) PMapToInt(f func(string) int) Ints {
+	return PMapStrInt(f, l)
+}
+
+// FilterMapToInt - see FilterMapStrInt
+func (l Strs) FilterMapToInt(fFilter func(string) bool, fMap func(string) int) Ints {
+	return FilterMapStrInt(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapStrInt64
+func (l Strs) MapToInt64(f func(string) int64) Int64s {
+	return MapStrInt64(f, l)
+}
+
+// PMapToInt64 - see PMapStrInt64
+func (l Strs) PMapToInt64(f func(string) int64) Int64s {
+	return PMapStrInt64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapStrInt64
+func (l Strs) FilterMapToInt64(fFilter func(string) bool, fMap func(string) int64) Int64s {
+	return FilterMapStrInt64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapStrInt32
+func (l Strs) MapToInt32(f func(string) int32) Int32s {
+	return MapStrInt32(f, l)
+}
+
+// PMapToInt32 - see PMapStrInt32
+func (l Strs) PMapToInt32(f func(string) int32) Int32s {
+	return PMapStrInt32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapStrInt32
+func (l Strs) FilterMapToInt32(fFilter func(string) bool, fMap func(string) int32) Int32s {
+	return FilterMapStrInt32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapStrInt16
+func (l Strs) MapToInt16(f func(string) int16) Int16s {
+	return MapStrInt16(f, l)
+}
+
+// PMapToInt16 - see PMapStrInt16
+func (l Strs) PMapToInt16(f func(string) int16) Int16s {
+	return PMapStrInt16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapStrInt16
+func (l Strs) FilterMapToInt16(fFilter func(string) bool, fMap func(string) int16) Int16s {
+	return FilterMapStrInt16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapStrInt8
+func (l Strs) MapToInt8(f func(string) int8) Int8s {
+	return MapStrInt8(f, l)
+}
+
+// PMapToInt8 - see PMapStrInt8
+func (l Strs) PMapToInt8(f func(string) int8) Int8s {
+	return PMapStrInt8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapStrInt8
+func (l Strs) FilterMapToInt8(fFilter func(string) bool, fMap func(string) int8) Int8s {
+	return FilterMapStrInt8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapStrUint
+func (l Strs) MapToUint(f func(string) uint) Uints {
+	return MapStrUint(f, l)
+}
+
+// PMapToUint - see PMapStrUint
+func (l Strs) PMapToUint(f func(string) uint) Uints {
+	return PMapStrUint(f, l)
+}
+
+// FilterMapToUint - see FilterMapStrUint
+func (l Strs) FilterMapToUint(fFilter func(string) bool, fMap func(string) uint) Uints {
+	return FilterMapStrUint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapStrUint64
+func (l Strs) MapToUint64(f func(string) uint64) Uint64s {
+	return MapStrUint64(f, l)
+}
+
+// PMapToUint64 - see PMapStrUint64
+func (l Strs) PMapToUint64(f func(string) uint64) Uint64s {
+	return PMapStrUint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapStrUint64
+func (l Strs) FilterMapToUint64(fFilter func(string) bool, fMap func(string) uint64) Uint64s {
+	return FilterMapStrUint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapStrUint32
+func (l Strs) MapToUint32(f func(string) uint32) Uint32s {
+	return MapStrUint32(f, l)
+}
+
+// PMapToUint32 - see PMapStrUint32
+func (l Strs) PMapToUint32(f func(string) uint32) Uint32s {
+	return PMapStrUint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapStrUint32
+func (l Strs) FilterMapToUint32(fFilter func(string) bool, fMap func(string) uint32) Uint32s {
+	return FilterMapStrUint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapStrUint16
+func (l Strs) MapToUint16(f func(string) uint16) Uint16s {
+	return MapStrUint16(f, l)
+}
+
+// PMapToUint16 - see PMapStrUint16
+func (l Strs) PMapToUint16(f func(string) uint16) Uint16s {
+	return PMapStrUint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapStrUint16
+func (l Strs) FilterMapToUint16(fFilter func(string) bool, fMap func(string) uint16) Uint16s {
+	return FilterMapStrUint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapStrUint8
+func (l Strs) MapToUint8(f func(string) uint8) Uint8s {
+	return MapStrUint8(f, l)
+}
+
+// PMapToUint8 - see PMapStrUint8
+func (l Strs) PMapToUint8(f func(string) uint8) Uint8s {
+	return PMapStrUint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapStrUint8
+func (l Strs) FilterMapToUint8(fFilter func(string) bool, fMap func(string) uint8) Uint8s {
+	return FilterMapStrUint8(fFilter, fMap, l)
+}
+
+// MapToBool - see MapStrBool
+func (l Strs) MapToBool(f func(string) bool) Bools {
+	return MapStrBool(f, l)
+}
+
+// PMapToBool - see PMapStrBool
+func (l Strs) PMapToBool(f func(string) bool) Bools {
+	return PMapStrBool(f, l)
+}
+
+// FilterMapToBool - see FilterMapStrBool
+func (l Strs) FilterMapToBool(fFilter func(string) bool, fMap func(string) bool) Bools {
+	return FilterMapStrBool(fFilter, fMap, l)
+}
+
+// MapToInt - see MapBoolInt
+func (l Bools) MapToInt(f func(bool) int) Ints {
+	return MapBoolInt(f, l)
+}
+
+// PMapToInt - see PMapBoolInt
+func (l Bools) PMapToInt(f func(bool) int) Ints {
+	return PMapBoolInt(f, l)
+}
+
+// FilterMapToInt - see FilterMapBoolInt
+func (l Bools) FilterMapToInt(fFilter func(bool) bool, fMap func(bool) int) Ints {
+	return FilterMapBoolInt(fFilter, fMap, l)
+}
+
+// MapToInt64 - see MapBoolInt64
+func (l Bools) MapToInt64(f func(bool) int64) Int64s {
+	return MapBoolInt64(f, l)
+}
+
+// PMapToInt64 - see PMapBoolInt64
+func (l Bools) PMapToInt64(f func(bool) int64) Int64s {
+	return PMapBoolInt64(f, l)
+}
+
+// FilterMapToInt64 - see FilterMapBoolInt64
+func (l Bools) FilterMapToInt64(fFilter func(bool) bool, fMap func(bool) int64) Int64s {
+	return FilterMapBoolInt64(fFilter, fMap, l)
+}
+
+// MapToInt32 - see MapBoolInt32
+func (l Bools) MapToInt32(f func(bool) int32) Int32s {
+	return MapBoolInt32(f, l)
+}
+
+// PMapToInt32 - see PMapBoolInt32
+func (l Bools) PMapToInt32(f func(bool) int32) Int32s {
+	return PMapBoolInt32(f, l)
+}
+
+// FilterMapToInt32 - see FilterMapBoolInt32
+func (l Bools) FilterMapToInt32(fFilter func(bool) bool, fMap func(bool) int32) Int32s {
+	return FilterMapBoolInt32(fFilter, fMap, l)
+}
+
+// MapToInt16 - see MapBoolInt16
+func (l Bools) MapToInt16(f func(bool) int16) Int16s {
+	return MapBoolInt16(f, l)
+}
+
+// PMapToInt16 - see PMapBoolInt16
+func (l Bools) PMapToInt16(f func(bool) int16) Int16s {
+	return PMapBoolInt16(f, l)
+}
+
+// FilterMapToInt16 - see FilterMapBoolInt16
+func (l Bools) FilterMapToInt16(fFilter func(bool) bool, fMap func(bool) int16) Int16s {
+	return FilterMapBoolInt16(fFilter, fMap, l)
+}
+
+// MapToInt8 - see MapBoolInt8
+func (l Bools) MapToInt8(f func(bool) int8) Int8s {
+	return MapBoolInt8(f, l)
+}
+
+// PMapToInt8 - see PMapBoolInt8
+func (l Bools) PMapToInt8(f func(bool) int8) Int8s {
+	return PMapBoolInt8(f, l)
+}
+
+// FilterMapToInt8 - see FilterMapBoolInt8
+func (l Bools) FilterMapToInt8(fFilter func(bool) bool, fMap func(bool) int8) Int8s {
+	return FilterMapBoolInt8(fFilter, fMap, l)
+}
+
+// MapToUint - see MapBoolUint
+func (l Bools) MapToUint(f func(bool) uint) Uints {
+	return MapBoolUint(f, l)
+}
+
+// PMapToUint - see PMapBoolUint
+func (l Bools) PMapToUint(f func(bool) uint) Uints {
+	return PMapBoolUint(f, l)
+}
+
+// FilterMapToUint - see FilterMapBoolUint
+func (l Bools) FilterMapToUint(fFilter func(bool) bool, fMap func(bool) uint) Uints {
+	return FilterMapBoolUint(fFilter, fMap, l)
+}
+
+// MapToUint64 - see MapBoolUint64
+func (l Bools) MapToUint64(f func(bool) uint64) Uint64s {
+	return MapBoolUint64(f, l)
+}
+
+// PMapToUint64 - see PMapBoolUint64
+func (l Bools) PMapToUint64(f func(bool) uint64) Uint64s {
+	return PMapBoolUint64(f, l)
+}
+
+// FilterMapToUint64 - see FilterMapBoolUint64
+func (l Bools) FilterMapToUint64(fFilter func(bool) bool, fMap func(bool) uint64) Uint64s {
+	return FilterMapBoolUint64(fFilter, fMap, l)
+}
+
+// MapToUint32 - see MapBoolUint32
+func (l Bools) MapToUint32(f func(bool) uint32) Uint32s {
+	return MapBoolUint32(f, l)
+}
+
+// PMapToUint32 - see PMapBoolUint32
+func (l Bools) PMapToUint32(f func(bool) uint32) Uint32s {
+	return PMapBoolUint32(f, l)
+}
+
+// FilterMapToUint32 - see FilterMapBoolUint32
+func (l Bools) FilterMapToUint32(fFilter func(bool) bool, fMap func(bool) uint32) Uint32s {
+	return FilterMapBoolUint32(fFilter, fMap, l)
+}
+
+// MapToUint16 - see MapBoolUint16
+func (l Bools) MapToUint16(f func(bool) uint16) Uint16s {
+	return MapBoolUint16(f, l)
+}
+
+// PMapToUint16 - see PMapBoolUint16
+func (l Bools) PMapToUint16(f func(bool) uint16) Uint16s {
+	return PMapBoolUint16(f, l)
+}
+
+// FilterMapToUint16 - see FilterMapBoolUint16
+func (l Bools) FilterMapToUint16(fFilter func(bool) bool, fMap func(bool) uint16) Uint16s {
+	return FilterMapBoolUint16(fFilter, fMap, l)
+}
+
+// MapToUint8 - see MapBoolUint8
+func (l Bools) MapToUint8(f func(bool) uint8) Uint8s {
+	return MapBoolUint8(f, l)
+}
+
+// PMapToUint8 - see PMapBoolUint8
+func (l Bools) PMapToUint8(f func(bool) uint8) Uint8s {
+	return PMapBoolUint8(f, l)
+}
+
+// FilterMapToUint8 - see FilterMapBoolUint8
+func (l Bools) FilterMapToUint8(fFilter func(bool) bool, fMap func(bool) uint8) Uint8s {
+	return FilterMapBoolUint8(fFilter, fMap, l)
+}
+
+// MapToStr - see MapBoolStr
+func (l Bools) MapToStr(f func(bool) string) Strs {
+	return MapBoolStr(f, l)
+}
+
+// PMapToStr - see PMapBoolStr
+func (l Bools) PMapToStr(f func(bool) string) Strs {
+	return PMapBoolStr(f, l)
+}
+
+// FilterMapToStr - see FilterMapBoolStr
+func (l Bools) FilterMapToStr(fFilter func(bool) bool, fMap func(bool) string) Strs {
+	return FilterMapBoolStr(fFilter, fMap, l)
+}
@@ -0,0 +1,1706 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFluentIntInt64(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) int64 { return int64(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntInt64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntInt32(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) int32 { return int32(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntInt32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntInt16(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) int16 { return int16(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntInt16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntInt8(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) int8 { return int8(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntInt8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentIntInt8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntUint(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) uint { return uint(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentIntUint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntUint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentIntUint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntUint64(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) uint64 { return uint64(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntUint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntUint32(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) uint32 { return uint32(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntUint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntUint16(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) uint16 { return uint16(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntUint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntUint8(t *testing.T) {
+	list := Ints{1, 2, 3}
+	double := func(v int) uint8 { return uint8(v * 2) }
+	large := func(v int) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentIntUint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentIntUint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentIntStr(t *testing.T) {
+	size := func(v int) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Ints{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentIntStr failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentIntBool(t *testing.T) {
+	large := func(v int) bool { return v > 1 }
+	if v := (Ints{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentIntBool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Int(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) int { return int(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Int32(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) int32 { return int32(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Int16(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) int16 { return int16(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Int8(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) int8 { return int8(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Uint(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) uint { return uint(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Uint64(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) uint64 { return uint64(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Uint32(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) uint32 { return uint32(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Uint16(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) uint16 { return uint16(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Uint8(t *testing.T) {
+	list := Int64s{1, 2, 3}
+	double := func(v int64) uint8 { return uint8(v * 2) }
+	large := func(v int64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt64Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Str(t *testing.T) {
+	size := func(v int64) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Int64s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentInt64Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentInt64Bool(t *testing.T) {
+	large := func(v int64) bool { return v > 1 }
+	if v := (Int64s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentInt64Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Int(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) int { return int(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Int64(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) int64 { return int64(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Int16(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) int16 { return int16(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Int8(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) int8 { return int8(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Uint(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) uint { return uint(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Uint64(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) uint64 { return uint64(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Uint32(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) uint32 { return uint32(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Uint16(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) uint16 { return uint16(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Uint8(t *testing.T) {
+	list := Int32s{1, 2, 3}
+	double := func(v int32) uint8 { return uint8(v * 2) }
+	large := func(v int32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt32Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Str(t *testing.T) {
+	size := func(v int32) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Int32s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentInt32Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentInt32Bool(t *testing.T) {
+	large := func(v int32) bool { return v > 1 }
+	if v := (Int32s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentInt32Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Int(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) int { return int(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Int64(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) int64 { return int64(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Int32(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) int32 { return int32(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Int8(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) int8 { return int8(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Uint(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) uint { return uint(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Uint64(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) uint64 { return uint64(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Uint32(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) uint32 { return uint32(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Uint16(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) uint16 { return uint16(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Uint8(t *testing.T) {
+	list := Int16s{1, 2, 3}
+	double := func(v int16) uint8 { return uint8(v * 2) }
+	large := func(v int16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt16Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Str(t *testing.T) {
+	size := func(v int16) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Int16s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentInt16Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentInt16Bool(t *testing.T) {
+	large := func(v int16) bool { return v > 1 }
+	if v := (Int16s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentInt16Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Int(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) int { return int(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Int64(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) int64 { return int64(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Int32(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) int32 { return int32(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Int16(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) int16 { return int16(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Uint(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) uint { return uint(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Uint64(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) uint64 { return uint64(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Uint32(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) uint32 { return uint32(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Uint16(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) uint16 { return uint16(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Uint8(t *testing.T) {
+	list := Int8s{1, 2, 3}
+	double := func(v int8) uint8 { return uint8(v * 2) }
+	large := func(v int8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentInt8Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Str(t *testing.T) {
+	size := func(v int8) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Int8s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentInt8Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentInt8Bool(t *testing.T) {
+	large := func(v int8) bool { return v > 1 }
+	if v := (Int8s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentInt8Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentUintInt(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) int { return int(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUintInt failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintInt failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUintInt failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintInt64(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) int64 { return int64(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintInt64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintInt32(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) int32 { return int32(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintInt32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintInt16(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) int16 { return int16(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintInt16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintInt8(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) int8 { return int8(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintInt8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUintInt8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintUint64(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) uint64 { return uint64(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintUint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintUint32(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) uint32 { return uint32(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintUint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintUint16(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) uint16 { return uint16(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintUint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintUint8(t *testing.T) {
+	list := Uints{1, 2, 3}
+	double := func(v uint) uint8 { return uint8(v * 2) }
+	large := func(v uint) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUintUint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUintUint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUintStr(t *testing.T) {
+	size := func(v uint) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Uints{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentUintStr failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentUintBool(t *testing.T) {
+	large := func(v uint) bool { return v > 1 }
+	if v := (Uints{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentUintBool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Int(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) int { return int(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Int64(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) int64 { return int64(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Int32(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) int32 { return int32(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Int16(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) int16 { return int16(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Int8(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) int8 { return int8(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Uint(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) uint { return uint(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Uint32(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) uint32 { return uint32(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Uint16(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) uint16 { return uint16(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Uint8(t *testing.T) {
+	list := Uint64s{1, 2, 3}
+	double := func(v uint64) uint8 { return uint8(v * 2) }
+	large := func(v uint64) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint64Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Str(t *testing.T) {
+	size := func(v uint64) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Uint64s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentUint64Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentUint64Bool(t *testing.T) {
+	large := func(v uint64) bool { return v > 1 }
+	if v := (Uint64s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentUint64Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Int(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) int { return int(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Int64(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) int64 { return int64(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Int32(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) int32 { return int32(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Int16(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) int16 { return int16(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Int8(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) int8 { return int8(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Uint(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) uint { return uint(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Uint64(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) uint64 { return uint64(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Uint16(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) uint16 { return uint16(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Uint8(t *testing.T) {
+	list := Uint32s{1, 2, 3}
+	double := func(v uint32) uint8 { return uint8(v * 2) }
+	large := func(v uint32) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint32Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Str(t *testing.T) {
+	size := func(v uint32) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Uint32s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentUint32Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentUint32Bool(t *testing.T) {
+	large := func(v uint32) bool { return v > 1 }
+	if v := (Uint32s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentUint32Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Int(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) int { return int(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Int64(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) int64 { return int64(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Int32(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) int32 { return int32(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Int16(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) int16 { return int16(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Int8(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) int8 { return int8(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Uint(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) uint { return uint(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Uint64(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) uint64 { return uint64(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Uint32(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) uint32 { return uint32(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Uint8(t *testing.T) {
+	list := Uint16s{1, 2, 3}
+	double := func(v uint16) uint8 { return uint8(v * 2) }
+	large := func(v uint16) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint8(double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint8(double); !reflect.DeepEqual(Uint8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint8(large, double); !reflect.DeepEqual(Uint8s{4, 6}, v) {
+		t.Errorf("TestFluentUint16Uint8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Str(t *testing.T) {
+	size := func(v uint16) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Uint16s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentUint16Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentUint16Bool(t *testing.T) {
+	large := func(v uint16) bool { return v > 1 }
+	if v := (Uint16s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentUint16Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Int(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) int { return int(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt(double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt(double); !reflect.DeepEqual(Ints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Int failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt(large, double); !reflect.DeepEqual(Ints{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Int64(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) int64 { return int64(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt64(double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt64(double); !reflect.DeepEqual(Int64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Int64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt64(large, double); !reflect.DeepEqual(Int64s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Int32(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) int32 { return int32(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt32(double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt32(double); !reflect.DeepEqual(Int32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Int32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt32(large, double); !reflect.DeepEqual(Int32s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Int16(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) int16 { return int16(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt16(double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt16(double); !reflect.DeepEqual(Int16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Int16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt16(large, double); !reflect.DeepEqual(Int16s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Int8(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) int8 { return int8(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToInt8(double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToInt8(double); !reflect.DeepEqual(Int8s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Int8 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToInt8(large, double); !reflect.DeepEqual(Int8s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Int8 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Uint(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) uint { return uint(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint(double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint(double); !reflect.DeepEqual(Uints{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint(large, double); !reflect.DeepEqual(Uints{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Uint64(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) uint64 { return uint64(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint64(double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint64(double); !reflect.DeepEqual(Uint64s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint64 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint64(large, double); !reflect.DeepEqual(Uint64s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint64 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Uint32(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) uint32 { return uint32(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint32(double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint32(double); !reflect.DeepEqual(Uint32s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint32 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint32(large, double); !reflect.DeepEqual(Uint32s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint32 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Uint16(t *testing.T) {
+	list := Uint8s{1, 2, 3}
+	double := func(v uint8) uint16 { return uint16(v * 2) }
+	large := func(v uint8) bool { return v > 1 }
+
+	if v := list.Filter(large).MapToUint16(double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapToUint16(double); !reflect.DeepEqual(Uint16s{2, 4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint16 failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapToUint16(large, double); !reflect.DeepEqual(Uint16s{4, 6}, v) {
+		t.Errorf("TestFluentUint8Uint16 failed. Expected=[4 6], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Str(t *testing.T) {
+	size := func(v uint8) string {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (Uint8s{1, 2}).MapToStr(size).Distinct(); !reflect.DeepEqual(Strs{"small", "large"}, v) {
+		t.Errorf("TestFluentUint8Str failed. Expected=[small large], actual=%v", v)
+	}
+}
+
+func TestFluentUint8Bool(t *testing.T) {
+	large := func(v uint8) bool { return v > 1 }
+	if v := (Uint8s{1, 2}).MapToBool(large); !reflect.DeepEqual(Bools{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluentUint8Bool failed. Expected=[false true], actual=%v", v)
+	}
+}
+
+func TestFluentStrInt(t *testing.T) {
+	length := func(v string) int { return int(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToInt(length).Reduce(func(a, b int) int { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrInt failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrInt64(t *testing.T) {
+	length := func(v string) int64 { return int64(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToInt64(length).Reduce(func(a, b int64) int64 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrInt64 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrInt32(t *testing.T) {
+	length := func(v string) int32 { return int32(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToInt32(length).Reduce(func(a, b int32) int32 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrInt32 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrInt16(t *testing.T) {
+	length := func(v string) int16 { return int16(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToInt16(length).Reduce(func(a, b int16) int16 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrInt16 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrInt8(t *testing.T) {
+	length := func(v string) int8 { return int8(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToInt8(length).Reduce(func(a, b int8) int8 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrInt8 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrUint(t *testing.T) {
+	length := func(v string) uint { return uint(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToUint(length).Reduce(func(a, b uint) uint { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrUint failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrUint64(t *testing.T) {
+	length := func(v string) uint64 { return uint64(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToUint64(length).Reduce(func(a, b uint64) uint64 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrUint64 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrUint32(t *testing.T) {
+	length := func(v string) uint32 { return uint32(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToUint32(length).Reduce(func(a, b uint32) uint32 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrUint32 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrUint16(t *testing.T) {
+	length := func(v string) uint16 { return uint16(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToUint16(length).Reduce(func(a, b uint16) uint16 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrUint16 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+
+func TestFluentStrUint8(t *testing.T) {
+	length := func(v string) uint8 { return uint8(len(v)) }
+	if v := (Strs{"a", "bb"}).MapToUint8(length).Reduce(func(a, b uint8) uint8 { return a + b }); v != 3 {
+		t.Errorf("TestFluentStrUint8 failed. Expected=%v, actual=%v", 3, v)
+	}
+}
@@ -0,0 +1,319 @@
+package fp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// ErrNoFutures is the error of Any and Race when no future is passed
+var ErrNoFutures = errors.New("fp: no futures")
+
+// PanicError is the error of a future whose function panicked
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("fp: future panicked: %v", e.Value)
+}
+
+// Future - result of work which is done in the background. It is completed once with a value or an error.
+//
+// NewFuture, Then, All, Any and Race wait until the futures they depend on are completed.
+// Their Ctx versions(NewFutureCtx, ThenCtx ...) stop waiting and fail with error of the context when it is done,
+// so no goroutine is left waiting for a future which is never completed.
+//
+// FutureInt, FutureStr ... are the typed versions. gofp generates them for user defined types as well
+type Future struct {
+	done  chan struct{}
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// Promise - write side of a future. The first Deliver or Fail completes the future
+type Promise struct {
+	future *Future
+}
+
+// NewPromise creates promise with a future which is not completed
+func NewPromise() *Promise {
+	return &Promise{future: &Future{done: make(chan struct{})}}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *Promise) Deliver(v interface{}) bool {
+	return p.future.complete(v, nil)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *Promise) Fail(err error) bool {
+	return p.future.complete(nil, err)
+}
+
+// Future returns the future completed by the promise
+func (p *Promise) Future() *Future {
+	return p.future
+}
+
+// NewFuture runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+//
+// Example:
+//
+//	f := NewFuture(func() (interface{}, error) { return fetch(url) })
+//	// do other work
+//	body, err := f.Await(ctx)
+func NewFuture(f func() (interface{}, error)) *Future {
+	p := NewPromise()
+	go func() {
+		v, err := callFuture(f)
+		p.future.complete(v, err)
+	}()
+	return p.future
+}
+
+// NewFutureCtx runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done, even if the function is still running.
+// The function should return when the context is done. If the function panics, the future fails with *PanicError
+//
+// Example:
+//	f := NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return fetch(ctx, url) })
+func NewFutureCtx(ctx context.Context, f func(context.Context) (interface{}, error)) *Future {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p := NewPromise()
+	go func() {
+		v, err := callFuture(func() (interface{}, error) {
+			if f == nil {
+				return nil, nil
+			}
+			return f(ctx)
+		})
+		p.future.complete(v, err)
+	}()
+	go p.future.failOnDone(ctx)
+	return p.future
+}
+
+// callFuture calls the function and returns *PanicError if it panics. nil function returns nil
+func callFuture(f func() (interface{}, error)) (v interface{}, err error) {
+	if f == nil {
+		return nil, nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			v, err = nil, &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return f()
+}
+
+// failOnDone fails the future with error of the context if the context is done before the future is completed
+func (f *Future) failOnDone(ctx context.Context) {
+	if ctx.Done() == nil {
+		return
+	}
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		f.complete(nil, ctx.Err())
+	}
+}
+
+func (f *Future) complete(v interface{}, err error) bool {
+	completed := false
+	f.once.Do(func() {
+		f.value, f.err = v, err
+		close(f.done)
+		completed = true
+	})
+	return completed
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *Future) Await(ctx context.Context) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if f.IsDone() {
+		return f.value, f.err
+	}
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// IsDone returns true if the future is completed
+func (f *Future) IsDone() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *Future) Then(fn func(interface{}) (interface{}, error)) *Future {
+	return f.ThenCtx(context.Background(), fn)
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed.
+// The function is not called in that case
+func (f *Future) ThenCtx(ctx context.Context, fn func(interface{}) (interface{}, error)) *Future {
+	p := NewPromise()
+	go func() {
+		v, err := f.Await(ctx)
+		if err == nil && fn != nil {
+			v, err = callFuture(func() (interface{}, error) { return fn(v) })
+		}
+		p.future.complete(v, err)
+	}()
+	return p.future
+}
+
+// All returns future of the values of all the futures in the same order.
+// It fails as soon as one of the futures fails, with the error of that future
+func All(futures ...*Future) *Future {
+	return AllCtx(context.Background(), futures...)
+}
+
+// AllCtx is All which fails with error of the context if the context is done first
+func AllCtx(ctx context.Context, futures ...*Future) *Future {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p := NewPromise()
+	go func() {
+		values := make([]interface{}, len(futures))
+		done := completions(ctx, p.future.done, futures)
+		for range futures {
+			i, ok := nextCompletion(ctx, done)
+			if !ok {
+				p.Fail(ctx.Err())
+				return
+			}
+			if err := futures[i].err; err != nil {
+				p.Fail(err)
+				return
+			}
+			values[i] = futures[i].value
+		}
+		p.Deliver(values)
+	}()
+	return p.future
+}
+
+// Any returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func Any(futures ...*Future) *Future {
+	return AnyCtx(context.Background(), futures...)
+}
+
+// AnyCtx is Any which fails with error of the context if the context is done first
+func AnyCtx(ctx context.Context, futures ...*Future) *Future {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p := NewPromise()
+	go func() {
+		if len(futures) == 0 {
+			p.Fail(ErrNoFutures)
+			return
+		}
+		done := completions(ctx, p.future.done, futures)
+		for range futures {
+			i, ok := nextCompletion(ctx, done)
+			if !ok {
+				p.Fail(ctx.Err())
+				return
+			}
+			if futures[i].err == nil {
+				p.Deliver(futures[i].value)
+				return
+			}
+		}
+		p.Fail(futures[0].err)
+	}()
+	return p.future
+}
+
+// Race returns future of the value or the error of the first future which is completed
+func Race(futures ...*Future) *Future {
+	return RaceCtx(context.Background(), futures...)
+}
+
+// RaceCtx is Race which fails with error of the context if the context is done first
+func RaceCtx(ctx context.Context, futures ...*Future) *Future {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p := NewPromise()
+	go func() {
+		if len(futures) == 0 {
+			p.Fail(ErrNoFutures)
+			return
+		}
+		i, ok := nextCompletion(ctx, completions(ctx, p.future.done, futures))
+		if !ok {
+			p.Fail(ctx.Err())
+			return
+		}
+		p.future.complete(futures[i].value, futures[i].err)
+	}()
+	return p.future
+}
+
+// completions returns channel which receives index of each future when it is completed.
+// Futures already completed are received first. The goroutines stop waiting when the context is done
+// or stop is closed, so none is left waiting for a future which is never completed
+func completions(ctx context.Context, stop <-chan struct{}, futures []*Future) <-chan int {
+	done := make(chan int, len(futures))
+	for i, f := range futures {
+		if f.IsDone() {
+			done <- i
+			continue
+		}
+		go func(i int, f *Future) {
+			select {
+			case <-f.done:
+				done <- i
+			case <-ctx.Done():
+			case <-stop:
+			}
+		}(i, f)
+	}
+	return done
+}
+
+// nextCompletion returns index of the next completed future. Returns false if the context is done first.
+// A completed future is preferred over the done context
+func nextCompletion(ctx context.Context, done <-chan int) (int, bool) {
+	select {
+	case i := <-done:
+		return i, true
+	default:
+	}
+	select {
+	case i := <-done:
+		return i, true
+	case <-ctx.Done():
+		return 0, false
+	}
+}
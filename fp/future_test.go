@@ -0,0 +1,109 @@
+package fp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestFutureCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromise().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) {
+		close(started)
+		<-release
+		return "late", nil
+	})
+	called := false
+	futures := []*Future{
+		never.ThenCtx(ctx, func(v interface{}) (interface{}, error) { called = true; return v, nil }),
+		AllCtx(ctx, never, f),
+		AnyCtx(ctx, never),
+		RaceCtx(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(nil); err != context.Canceled {
+			t.Errorf("TestFutureCtx failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if called {
+		t.Errorf("TestFutureCtx failed. Expected function of ThenCtx not to be called")
+	}
+
+	// completed futures are not affected by the context
+	done := NewFutureCtx(context.Background(), func(ctx context.Context) (interface{}, error) { return 1, nil })
+	<-done.Done()
+	if v, err := RaceCtx(ctx, done).Await(nil); v != 1 || err != nil {
+		t.Errorf("TestFutureCtx failed. Expected=1, actual=%v, error=%v", v, err)
+	}
+	if v, err := NewFutureCtx(nil, nil).Await(nil); v != nil || err != nil {
+		t.Errorf("TestFutureCtx failed. Expected nil for nil function, actual=%v, error=%v", v, err)
+	}
+}
+
+func TestFutureCombinatorsStop(t *testing.T) {
+	before := runtime.NumGoroutine()
+	never := NewPromise().Future()
+	done := NewFuture(func() (interface{}, error) { return 1, nil })
+	failed := NewFuture(func() (interface{}, error) { return nil, errors.New("boom") })
+	<-done.Done()
+	<-failed.Done()
+	for i := 0; i < 10; i++ {
+		Any(never, never, done).Await(nil)
+		Race(never, done).Await(nil)
+		All(never, failed).Await(nil)
+	}
+
+	// goroutines waiting for the future which is never completed exit once the combined future is completed
+	deadline := time.Now().Add(10 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("TestFutureCombinatorsStop failed. Expected goroutines to exit, before=%v, actual=%v", before, runtime.NumGoroutine())
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestFutureUntyped(t *testing.T) {
+	ctx := context.Background()
+	a := NewFuture(func() (interface{}, error) { return 1, nil })
+	b := NewFuture(func() (interface{}, error) { return "b", nil })
+
+	values, err := All(a, b).Await(ctx)
+	if err != nil || !reflect.DeepEqual([]interface{}{1, "b"}, values) {
+		t.Errorf("TestFutureUntyped failed. Expected=[1 b], actual=%v, error=%v", values, err)
+	}
+	if values, err := All().Await(ctx); err != nil || len(values.([]interface{})) != 0 {
+		t.Errorf("TestFutureUntyped failed. Expected empty list, actual=%v", values)
+	}
+
+	then := a.Then(func(v interface{}) (interface{}, error) { panic(errors.New("boom")) })
+	if _, err := then.Await(ctx); err == nil || err.Error() != "fp: future panicked: boom" {
+		t.Errorf("TestFutureUntyped failed. Expected panic of Then to be returned as error, actual=%v", err)
+	}
+	if e, ok := err.(*PanicError); ok && len(e.Stack) == 0 {
+		t.Errorf("TestFutureUntyped failed. Expected stack of the panic")
+	}
+
+	if v, err := NewFuture(nil).Await(nil); v != nil || err != nil {
+		t.Errorf("TestFutureUntyped failed. Expected nil for nil function, actual=%v, error=%v", v, err)
+	}
+	if _, err := Any().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureUntyped failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	never := NewPromise().Future()
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := Race(never, NewPromise().Future()).Await(timeout); err != context.DeadlineExceeded {
+		t.Errorf("TestFutureUntyped failed. Expected error=%v, actual=%v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,2187 @@
+package fp
+
+import "context"
+
+// FutureInt - result of type int which is computed in the background. See Future
+type FutureInt struct {
+	future *Future
+}
+
+// PromiseInt - write side of FutureInt. The first Deliver or Fail completes the future
+type PromiseInt struct {
+	promise *Promise
+}
+
+// NewFutureInt runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureInt(f func() (int, error)) *FutureInt {
+	if f == nil {
+		return &FutureInt{future: NewFuture(nil)}
+	}
+	return &FutureInt{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxInt runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxInt(ctx context.Context, f func(context.Context) (int, error)) *FutureInt {
+	if f == nil {
+		return &FutureInt{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureInt{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseInt creates promise with a future which is not completed
+func NewPromiseInt() *PromiseInt {
+	return &PromiseInt{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseInt) Deliver(v int) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseInt) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseInt) Future() *FutureInt {
+	return &FutureInt{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureInt) Await(ctx context.Context) (int, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero int
+		return zero, err
+	}
+	return futureValueInt(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureInt) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureInt) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureInt) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureInt) Then(fn func(int) (int, error)) *FutureInt {
+	if fn == nil {
+		return f
+	}
+	return &FutureInt{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueInt(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureInt) ThenCtx(ctx context.Context, fn func(int) (int, error)) *FutureInt {
+	if fn == nil {
+		return &FutureInt{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureInt{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueInt(v)) })}
+}
+
+// AwaitAllInt waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllInt(ctx context.Context, futures ...*FutureInt) ([]int, error) {
+	values, err := AllCtxInt(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]int), nil
+}
+
+// AllInt returns future of the values of all the futures in the same order, as []int.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllInt(futures ...*FutureInt) *Future {
+	return AllCtxInt(context.Background(), futures...)
+}
+
+// AllCtxInt is AllInt which fails with error of the context if the context is done first
+func AllCtxInt(ctx context.Context, futures ...*FutureInt) *Future {
+	return AllCtx(ctx, untypedFuturesInt(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]int, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueInt(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyInt returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyInt(futures ...*FutureInt) *FutureInt {
+	return &FutureInt{future: Any(untypedFuturesInt(futures)...)}
+}
+
+// RaceInt returns future of the value or the error of the first future which is completed
+func RaceInt(futures ...*FutureInt) *FutureInt {
+	return &FutureInt{future: Race(untypedFuturesInt(futures)...)}
+}
+
+// AnyCtxInt is AnyInt which fails with error of the context if the context is done first
+func AnyCtxInt(ctx context.Context, futures ...*FutureInt) *FutureInt {
+	return &FutureInt{future: AnyCtx(ctx, untypedFuturesInt(futures)...)}
+}
+
+// RaceCtxInt is RaceInt which fails with error of the context if the context is done first
+func RaceCtxInt(ctx context.Context, futures ...*FutureInt) *FutureInt {
+	return &FutureInt{future: RaceCtx(ctx, untypedFuturesInt(futures)...)}
+}
+
+// futureValueInt returns the value as int. nil is the zero value
+func futureValueInt(v interface{}) int {
+	if v == nil {
+		var zero int
+		return zero
+	}
+	return v.(int)
+}
+
+func untypedFuturesInt(futures []*FutureInt) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureInt64 - result of type int64 which is computed in the background. See Future
+type FutureInt64 struct {
+	future *Future
+}
+
+// PromiseInt64 - write side of FutureInt64. The first Deliver or Fail completes the future
+type PromiseInt64 struct {
+	promise *Promise
+}
+
+// NewFutureInt64 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureInt64(f func() (int64, error)) *FutureInt64 {
+	if f == nil {
+		return &FutureInt64{future: NewFuture(nil)}
+	}
+	return &FutureInt64{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxInt64 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxInt64(ctx context.Context, f func(context.Context) (int64, error)) *FutureInt64 {
+	if f == nil {
+		return &FutureInt64{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureInt64{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseInt64 creates promise with a future which is not completed
+func NewPromiseInt64() *PromiseInt64 {
+	return &PromiseInt64{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseInt64) Deliver(v int64) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseInt64) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseInt64) Future() *FutureInt64 {
+	return &FutureInt64{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureInt64) Await(ctx context.Context) (int64, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero int64
+		return zero, err
+	}
+	return futureValueInt64(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureInt64) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureInt64) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureInt64) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureInt64) Then(fn func(int64) (int64, error)) *FutureInt64 {
+	if fn == nil {
+		return f
+	}
+	return &FutureInt64{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueInt64(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureInt64) ThenCtx(ctx context.Context, fn func(int64) (int64, error)) *FutureInt64 {
+	if fn == nil {
+		return &FutureInt64{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureInt64{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueInt64(v)) })}
+}
+
+// AwaitAllInt64 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllInt64(ctx context.Context, futures ...*FutureInt64) ([]int64, error) {
+	values, err := AllCtxInt64(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]int64), nil
+}
+
+// AllInt64 returns future of the values of all the futures in the same order, as []int64.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllInt64(futures ...*FutureInt64) *Future {
+	return AllCtxInt64(context.Background(), futures...)
+}
+
+// AllCtxInt64 is AllInt64 which fails with error of the context if the context is done first
+func AllCtxInt64(ctx context.Context, futures ...*FutureInt64) *Future {
+	return AllCtx(ctx, untypedFuturesInt64(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]int64, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueInt64(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyInt64 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyInt64(futures ...*FutureInt64) *FutureInt64 {
+	return &FutureInt64{future: Any(untypedFuturesInt64(futures)...)}
+}
+
+// RaceInt64 returns future of the value or the error of the first future which is completed
+func RaceInt64(futures ...*FutureInt64) *FutureInt64 {
+	return &FutureInt64{future: Race(untypedFuturesInt64(futures)...)}
+}
+
+// AnyCtxInt64 is AnyInt64 which fails with error of the context if the context is done first
+func AnyCtxInt64(ctx context.Context, futures ...*FutureInt64) *FutureInt64 {
+	return &FutureInt64{future: AnyCtx(ctx, untypedFuturesInt64(futures)...)}
+}
+
+// RaceCtxInt64 is RaceInt64 which fails with error of the context if the context is done first
+func RaceCtxInt64(ctx context.Context, futures ...*FutureInt64) *FutureInt64 {
+	return &FutureInt64{future: RaceCtx(ctx, untypedFuturesInt64(futures)...)}
+}
+
+// futureValueInt64 returns the value as int64. nil is the zero value
+func futureValueInt64(v interface{}) int64 {
+	if v == nil {
+		var zero int64
+		return zero
+	}
+	return v.(int64)
+}
+
+func untypedFuturesInt64(futures []*FutureInt64) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureInt32 - result of type int32 which is computed in the background. See Future
+type FutureInt32 struct {
+	future *Future
+}
+
+// PromiseInt32 - write side of FutureInt32. The first Deliver or Fail completes the future
+type PromiseInt32 struct {
+	promise *Promise
+}
+
+// NewFutureInt32 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureInt32(f func() (int32, error)) *FutureInt32 {
+	if f == nil {
+		return &FutureInt32{future: NewFuture(nil)}
+	}
+	return &FutureInt32{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxInt32 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxInt32(ctx context.Context, f func(context.Context) (int32, error)) *FutureInt32 {
+	if f == nil {
+		return &FutureInt32{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureInt32{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseInt32 creates promise with a future which is not completed
+func NewPromiseInt32() *PromiseInt32 {
+	return &PromiseInt32{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseInt32) Deliver(v int32) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseInt32) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseInt32) Future() *FutureInt32 {
+	return &FutureInt32{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureInt32) Await(ctx context.Context) (int32, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero int32
+		return zero, err
+	}
+	return futureValueInt32(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureInt32) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureInt32) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureInt32) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureInt32) Then(fn func(int32) (int32, error)) *FutureInt32 {
+	if fn == nil {
+		return f
+	}
+	return &FutureInt32{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueInt32(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureInt32) ThenCtx(ctx context.Context, fn func(int32) (int32, error)) *FutureInt32 {
+	if fn == nil {
+		return &FutureInt32{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureInt32{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueInt32(v)) })}
+}
+
+// AwaitAllInt32 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllInt32(ctx context.Context, futures ...*FutureInt32) ([]int32, error) {
+	values, err := AllCtxInt32(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]int32), nil
+}
+
+// AllInt32 returns future of the values of all the futures in the same order, as []int32.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllInt32(futures ...*FutureInt32) *Future {
+	return AllCtxInt32(context.Background(), futures...)
+}
+
+// AllCtxInt32 is AllInt32 which fails with error of the context if the context is done first
+func AllCtxInt32(ctx context.Context, futures ...*FutureInt32) *Future {
+	return AllCtx(ctx, untypedFuturesInt32(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]int32, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueInt32(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyInt32 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyInt32(futures ...*FutureInt32) *FutureInt32 {
+	return &FutureInt32{future: Any(untypedFuturesInt32(futures)...)}
+}
+
+// RaceInt32 returns future of the value or the error of the first future which is completed
+func RaceInt32(futures ...*FutureInt32) *FutureInt32 {
+	return &FutureInt32{future: Race(untypedFuturesInt32(futures)...)}
+}
+
+// AnyCtxInt32 is AnyInt32 which fails with error of the context if the context is done first
+func AnyCtxInt32(ctx context.Context, futures ...*FutureInt32) *FutureInt32 {
+	return &FutureInt32{future: AnyCtx(ctx, untypedFuturesInt32(futures)...)}
+}
+
+// RaceCtxInt32 is RaceInt32 which fails with error of the context if the context is done first
+func RaceCtxInt32(ctx context.Context, futures ...*FutureInt32) *FutureInt32 {
+	return &FutureInt32{future: RaceCtx(ctx, untypedFuturesInt32(futures)...)}
+}
+
+// futureValueInt32 returns the value as int32. nil is the zero value
+func futureValueInt32(v interface{}) int32 {
+	if v == nil {
+		var zero int32
+		return zero
+	}
+	return v.(int32)
+}
+
+func untypedFuturesInt32(futures []*FutureInt32) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureInt16 - result of type int16 which is computed in the background. See Future
+type FutureInt16 struct {
+	future *Future
+}
+
+// PromiseInt16 - write side of FutureInt16. The first Deliver or Fail completes the future
+type PromiseInt16 struct {
+	promise *Promise
+}
+
+// NewFutureInt16 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureInt16(f func() (int16, error)) *FutureInt16 {
+	if f == nil {
+		return &FutureInt16{future: NewFuture(nil)}
+	}
+	return &FutureInt16{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxInt16 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxInt16(ctx context.Context, f func(context.Context) (int16, error)) *FutureInt16 {
+	if f == nil {
+		return &FutureInt16{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureInt16{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseInt16 creates promise with a future which is not completed
+func NewPromiseInt16() *PromiseInt16 {
+	return &PromiseInt16{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseInt16) Deliver(v int16) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseInt16) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseInt16) Future() *FutureInt16 {
+	return &FutureInt16{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureInt16) Await(ctx context.Context) (int16, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero int16
+		return zero, err
+	}
+	return futureValueInt16(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureInt16) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureInt16) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureInt16) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureInt16) Then(fn func(int16) (int16, error)) *FutureInt16 {
+	if fn == nil {
+		return f
+	}
+	return &FutureInt16{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueInt16(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureInt16) ThenCtx(ctx context.Context, fn func(int16) (int16, error)) *FutureInt16 {
+	if fn == nil {
+		return &FutureInt16{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureInt16{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueInt16(v)) })}
+}
+
+// AwaitAllInt16 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllInt16(ctx context.Context, futures ...*FutureInt16) ([]int16, error) {
+	values, err := AllCtxInt16(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]int16), nil
+}
+
+// AllInt16 returns future of the values of all the futures in the same order, as []int16.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllInt16(futures ...*FutureInt16) *Future {
+	return AllCtxInt16(context.Background(), futures...)
+}
+
+// AllCtxInt16 is AllInt16 which fails with error of the context if the context is done first
+func AllCtxInt16(ctx context.Context, futures ...*FutureInt16) *Future {
+	return AllCtx(ctx, untypedFuturesInt16(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]int16, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueInt16(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyInt16 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyInt16(futures ...*FutureInt16) *FutureInt16 {
+	return &FutureInt16{future: Any(untypedFuturesInt16(futures)...)}
+}
+
+// RaceInt16 returns future of the value or the error of the first future which is completed
+func RaceInt16(futures ...*FutureInt16) *FutureInt16 {
+	return &FutureInt16{future: Race(untypedFuturesInt16(futures)...)}
+}
+
+// AnyCtxInt16 is AnyInt16 which fails with error of the context if the context is done first
+func AnyCtxInt16(ctx context.Context, futures ...*FutureInt16) *FutureInt16 {
+	return &FutureInt16{future: AnyCtx(ctx, untypedFuturesInt16(futures)...)}
+}
+
+// RaceCtxInt16 is RaceInt16 which fails with error of the context if the context is done first
+func RaceCtxInt16(ctx context.Context, futures ...*FutureInt16) *FutureInt16 {
+	return &FutureInt16{future: RaceCtx(ctx, untypedFuturesInt16(futures)...)}
+}
+
+// futureValueInt16 returns the value as int16. nil is the zero value
+func futureValueInt16(v interface{}) int16 {
+	if v == nil {
+		var zero int16
+		return zero
+	}
+	return v.(int16)
+}
+
+func untypedFuturesInt16(futures []*FutureInt16) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureInt8 - result of type int8 which is computed in the background. See Future
+type FutureInt8 struct {
+	future *Future
+}
+
+// PromiseInt8 - write side of FutureInt8. The first Deliver or Fail completes the future
+type PromiseInt8 struct {
+	promise *Promise
+}
+
+// NewFutureInt8 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureInt8(f func() (int8, error)) *FutureInt8 {
+	if f == nil {
+		return &FutureInt8{future: NewFuture(nil)}
+	}
+	return &FutureInt8{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxInt8 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxInt8(ctx context.Context, f func(context.Context) (int8, error)) *FutureInt8 {
+	if f == nil {
+		return &FutureInt8{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureInt8{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseInt8 creates promise with a future which is not completed
+func NewPromiseInt8() *PromiseInt8 {
+	return &PromiseInt8{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseInt8) Deliver(v int8) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseInt8) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseInt8) Future() *FutureInt8 {
+	return &FutureInt8{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureInt8) Await(ctx context.Context) (int8, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero int8
+		return zero, err
+	}
+	return futureValueInt8(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureInt8) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureInt8) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureInt8) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureInt8) Then(fn func(int8) (int8, error)) *FutureInt8 {
+	if fn == nil {
+		return f
+	}
+	return &FutureInt8{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueInt8(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureInt8) ThenCtx(ctx context.Context, fn func(int8) (int8, error)) *FutureInt8 {
+	if fn == nil {
+		return &FutureInt8{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureInt8{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueInt8(v)) })}
+}
+
+// AwaitAllInt8 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllInt8(ctx context.Context, futures ...*FutureInt8) ([]int8, error) {
+	values, err := AllCtxInt8(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]int8), nil
+}
+
+// AllInt8 returns future of the values of all the futures in the same order, as []int8.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllInt8(futures ...*FutureInt8) *Future {
+	return AllCtxInt8(context.Background(), futures...)
+}
+
+// AllCtxInt8 is AllInt8 which fails with error of the context if the context is done first
+func AllCtxInt8(ctx context.Context, futures ...*FutureInt8) *Future {
+	return AllCtx(ctx, untypedFuturesInt8(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]int8, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueInt8(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyInt8 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyInt8(futures ...*FutureInt8) *FutureInt8 {
+	return &FutureInt8{future: Any(untypedFuturesInt8(futures)...)}
+}
+
+// RaceInt8 returns future of the value or the error of the first future which is completed
+func RaceInt8(futures ...*FutureInt8) *FutureInt8 {
+	return &FutureInt8{future: Race(untypedFuturesInt8(futures)...)}
+}
+
+// AnyCtxInt8 is AnyInt8 which fails with error of the context if the context is done first
+func AnyCtxInt8(ctx context.Context, futures ...*FutureInt8) *FutureInt8 {
+	return &FutureInt8{future: AnyCtx(ctx, untypedFuturesInt8(futures)...)}
+}
+
+// RaceCtxInt8 is RaceInt8 which fails with error of the context if the context is done first
+func RaceCtxInt8(ctx context.Context, futures ...*FutureInt8) *FutureInt8 {
+	return &FutureInt8{future: RaceCtx(ctx, untypedFuturesInt8(futures)...)}
+}
+
+// futureValueInt8 returns the value as int8. nil is the zero value
+func futureValueInt8(v interface{}) int8 {
+	if v == nil {
+		var zero int8
+		return zero
+	}
+	return v.(int8)
+}
+
+func untypedFuturesInt8(futures []*FutureInt8) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureUint - result of type uint which is computed in the background. See Future
+type FutureUint struct {
+	future *Future
+}
+
+// PromiseUint - write side of FutureUint. The first Deliver or Fail completes the future
+type PromiseUint struct {
+	promise *Promise
+}
+
+// NewFutureUint runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureUint(f func() (uint, error)) *FutureUint {
+	if f == nil {
+		return &FutureUint{future: NewFuture(nil)}
+	}
+	return &FutureUint{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxUint runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxUint(ctx context.Context, f func(context.Context) (uint, error)) *FutureUint {
+	if f == nil {
+		return &FutureUint{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureUint{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseUint creates promise with a future which is not completed
+func NewPromiseUint() *PromiseUint {
+	return &PromiseUint{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseUint) Deliver(v uint) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseUint) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseUint) Future() *FutureUint {
+	return &FutureUint{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureUint) Await(ctx context.Context) (uint, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero uint
+		return zero, err
+	}
+	return futureValueUint(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureUint) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureUint) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureUint) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureUint) Then(fn func(uint) (uint, error)) *FutureUint {
+	if fn == nil {
+		return f
+	}
+	return &FutureUint{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueUint(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureUint) ThenCtx(ctx context.Context, fn func(uint) (uint, error)) *FutureUint {
+	if fn == nil {
+		return &FutureUint{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureUint{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueUint(v)) })}
+}
+
+// AwaitAllUint waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllUint(ctx context.Context, futures ...*FutureUint) ([]uint, error) {
+	values, err := AllCtxUint(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]uint), nil
+}
+
+// AllUint returns future of the values of all the futures in the same order, as []uint.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllUint(futures ...*FutureUint) *Future {
+	return AllCtxUint(context.Background(), futures...)
+}
+
+// AllCtxUint is AllUint which fails with error of the context if the context is done first
+func AllCtxUint(ctx context.Context, futures ...*FutureUint) *Future {
+	return AllCtx(ctx, untypedFuturesUint(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]uint, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueUint(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyUint returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyUint(futures ...*FutureUint) *FutureUint {
+	return &FutureUint{future: Any(untypedFuturesUint(futures)...)}
+}
+
+// RaceUint returns future of the value or the error of the first future which is completed
+func RaceUint(futures ...*FutureUint) *FutureUint {
+	return &FutureUint{future: Race(untypedFuturesUint(futures)...)}
+}
+
+// AnyCtxUint is AnyUint which fails with error of the context if the context is done first
+func AnyCtxUint(ctx context.Context, futures ...*FutureUint) *FutureUint {
+	return &FutureUint{future: AnyCtx(ctx, untypedFuturesUint(futures)...)}
+}
+
+// RaceCtxUint is RaceUint which fails with error of the context if the context is done first
+func RaceCtxUint(ctx context.Context, futures ...*FutureUint) *FutureUint {
+	return &FutureUint{future: RaceCtx(ctx, untypedFuturesUint(futures)...)}
+}
+
+// futureValueUint returns the value as uint. nil is the zero value
+func futureValueUint(v interface{}) uint {
+	if v == nil {
+		var zero uint
+		return zero
+	}
+	return v.(uint)
+}
+
+func untypedFuturesUint(futures []*FutureUint) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureUint64 - result of type uint64 which is computed in the background. See Future
+type FutureUint64 struct {
+	future *Future
+}
+
+// PromiseUint64 - write side of FutureUint64. The first Deliver or Fail completes the future
+type PromiseUint64 struct {
+	promise *Promise
+}
+
+// NewFutureUint64 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureUint64(f func() (uint64, error)) *FutureUint64 {
+	if f == nil {
+		return &FutureUint64{future: NewFuture(nil)}
+	}
+	return &FutureUint64{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxUint64 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxUint64(ctx context.Context, f func(context.Context) (uint64, error)) *FutureUint64 {
+	if f == nil {
+		return &FutureUint64{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureUint64{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseUint64 creates promise with a future which is not completed
+func NewPromiseUint64() *PromiseUint64 {
+	return &PromiseUint64{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseUint64) Deliver(v uint64) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseUint64) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseUint64) Future() *FutureUint64 {
+	return &FutureUint64{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureUint64) Await(ctx context.Context) (uint64, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero uint64
+		return zero, err
+	}
+	return futureValueUint64(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureUint64) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureUint64) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureUint64) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureUint64) Then(fn func(uint64) (uint64, error)) *FutureUint64 {
+	if fn == nil {
+		return f
+	}
+	return &FutureUint64{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueUint64(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureUint64) ThenCtx(ctx context.Context, fn func(uint64) (uint64, error)) *FutureUint64 {
+	if fn == nil {
+		return &FutureUint64{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureUint64{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueUint64(v)) })}
+}
+
+// AwaitAllUint64 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllUint64(ctx context.Context, futures ...*FutureUint64) ([]uint64, error) {
+	values, err := AllCtxUint64(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]uint64), nil
+}
+
+// AllUint64 returns future of the values of all the futures in the same order, as []uint64.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllUint64(futures ...*FutureUint64) *Future {
+	return AllCtxUint64(context.Background(), futures...)
+}
+
+// AllCtxUint64 is AllUint64 which fails with error of the context if the context is done first
+func AllCtxUint64(ctx context.Context, futures ...*FutureUint64) *Future {
+	return AllCtx(ctx, untypedFuturesUint64(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]uint64, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueUint64(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyUint64 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyUint64(futures ...*FutureUint64) *FutureUint64 {
+	return &FutureUint64{future: Any(untypedFuturesUint64(futures)...)}
+}
+
+// RaceUint64 returns future of the value or the error of the first future which is completed
+func RaceUint64(futures ...*FutureUint64) *FutureUint64 {
+	return &FutureUint64{future: Race(untypedFuturesUint64(futures)...)}
+}
+
+// AnyCtxUint64 is AnyUint64 which fails with error of the context if the context is done first
+func AnyCtxUint64(ctx context.Context, futures ...*FutureUint64) *FutureUint64 {
+	return &FutureUint64{future: AnyCtx(ctx, untypedFuturesUint64(futures)...)}
+}
+
+// RaceCtxUint64 is RaceUint64 which fails with error of the context if the context is done first
+func RaceCtxUint64(ctx context.Context, futures ...*FutureUint64) *FutureUint64 {
+	return &FutureUint64{future: RaceCtx(ctx, untypedFuturesUint64(futures)...)}
+}
+
+// futureValueUint64 returns the value as uint64. nil is the zero value
+func futureValueUint64(v interface{}) uint64 {
+	if v == nil {
+		var zero uint64
+		return zero
+	}
+	return v.(uint64)
+}
+
+func untypedFuturesUint64(futures []*FutureUint64) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureUint32 - result of type uint32 which is computed in the background. See Future
+type FutureUint32 struct {
+	future *Future
+}
+
+// PromiseUint32 - write side of FutureUint32. The first Deliver or Fail completes the future
+type PromiseUint32 struct {
+	promise *Promise
+}
+
+// NewFutureUint32 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureUint32(f func() (uint32, error)) *FutureUint32 {
+	if f == nil {
+		return &FutureUint32{future: NewFuture(nil)}
+	}
+	return &FutureUint32{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxUint32 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxUint32(ctx context.Context, f func(context.Context) (uint32, error)) *FutureUint32 {
+	if f == nil {
+		return &FutureUint32{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureUint32{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseUint32 creates promise with a future which is not completed
+func NewPromiseUint32() *PromiseUint32 {
+	return &PromiseUint32{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseUint32) Deliver(v uint32) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseUint32) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseUint32) Future() *FutureUint32 {
+	return &FutureUint32{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureUint32) Await(ctx context.Context) (uint32, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero uint32
+		return zero, err
+	}
+	return futureValueUint32(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureUint32) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureUint32) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureUint32) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureUint32) Then(fn func(uint32) (uint32, error)) *FutureUint32 {
+	if fn == nil {
+		return f
+	}
+	return &FutureUint32{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueUint32(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureUint32) ThenCtx(ctx context.Context, fn func(uint32) (uint32, error)) *FutureUint32 {
+	if fn == nil {
+		return &FutureUint32{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureUint32{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueUint32(v)) })}
+}
+
+// AwaitAllUint32 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllUint32(ctx context.Context, futures ...*FutureUint32) ([]uint32, error) {
+	values, err := AllCtxUint32(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]uint32), nil
+}
+
+// AllUint32 returns future of the values of all the futures in the same order, as []uint32.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllUint32(futures ...*FutureUint32) *Future {
+	return AllCtxUint32(context.Background(), futures...)
+}
+
+// AllCtxUint32 is AllUint32 which fails with error of the context if the context is done first
+func AllCtxUint32(ctx context.Context, futures ...*FutureUint32) *Future {
+	return AllCtx(ctx, untypedFuturesUint32(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]uint32, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueUint32(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyUint32 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyUint32(futures ...*FutureUint32) *FutureUint32 {
+	return &FutureUint32{future: Any(untypedFuturesUint32(futures)...)}
+}
+
+// RaceUint32 returns future of the value or the error of the first future which is completed
+func RaceUint32(futures ...*FutureUint32) *FutureUint32 {
+	return &FutureUint32{future: Race(untypedFuturesUint32(futures)...)}
+}
+
+// AnyCtxUint32 is AnyUint32 which fails with error of the context if the context is done first
+func AnyCtxUint32(ctx context.Context, futures ...*FutureUint32) *FutureUint32 {
+	return &FutureUint32{future: AnyCtx(ctx, untypedFuturesUint32(futures)...)}
+}
+
+// RaceCtxUint32 is RaceUint32 which fails with error of the context if the context is done first
+func RaceCtxUint32(ctx context.Context, futures ...*FutureUint32) *FutureUint32 {
+	return &FutureUint32{future: RaceCtx(ctx, untypedFuturesUint32(futures)...)}
+}
+
+// futureValueUint32 returns the value as uint32. nil is the zero value
+func futureValueUint32(v interface{}) uint32 {
+	if v == nil {
+		var zero uint32
+		return zero
+	}
+	return v.(uint32)
+}
+
+func untypedFuturesUint32(futures []*FutureUint32) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureUint16 - result of type uint16 which is computed in the background. See Future
+type FutureUint16 struct {
+	future *Future
+}
+
+// PromiseUint16 - write side of FutureUint16. The first Deliver or Fail completes the future
+type PromiseUint16 struct {
+	promise *Promise
+}
+
+// NewFutureUint16 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureUint16(f func() (uint16, error)) *FutureUint16 {
+	if f == nil {
+		return &FutureUint16{future: NewFuture(nil)}
+	}
+	return &FutureUint16{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxUint16 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxUint16(ctx context.Context, f func(context.Context) (uint16, error)) *FutureUint16 {
+	if f == nil {
+		return &FutureUint16{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureUint16{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseUint16 creates promise with a future which is not completed
+func NewPromiseUint16() *PromiseUint16 {
+	return &PromiseUint16{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseUint16) Deliver(v uint16) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseUint16) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseUint16) Future() *FutureUint16 {
+	return &FutureUint16{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureUint16) Await(ctx context.Context) (uint16, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero uint16
+		return zero, err
+	}
+	return futureValueUint16(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureUint16) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureUint16) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureUint16) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureUint16) Then(fn func(uint16) (uint16, error)) *FutureUint16 {
+	if fn == nil {
+		return f
+	}
+	return &FutureUint16{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueUint16(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureUint16) ThenCtx(ctx context.Context, fn func(uint16) (uint16, error)) *FutureUint16 {
+	if fn == nil {
+		return &FutureUint16{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureUint16{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueUint16(v)) })}
+}
+
+// AwaitAllUint16 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllUint16(ctx context.Context, futures ...*FutureUint16) ([]uint16, error) {
+	values, err := AllCtxUint16(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]uint16), nil
+}
+
+// AllUint16 returns future of the values of all the futures in the same order, as []uint16.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllUint16(futures ...*FutureUint16) *Future {
+	return AllCtxUint16(context.Background(), futures...)
+}
+
+// AllCtxUint16 is AllUint16 which fails with error of the context if the context is done first
+func AllCtxUint16(ctx context.Context, futures ...*FutureUint16) *Future {
+	return AllCtx(ctx, untypedFuturesUint16(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]uint16, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueUint16(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyUint16 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyUint16(futures ...*FutureUint16) *FutureUint16 {
+	return &FutureUint16{future: Any(untypedFuturesUint16(futures)...)}
+}
+
+// RaceUint16 returns future of the value or the error of the first future which is completed
+func RaceUint16(futures ...*FutureUint16) *FutureUint16 {
+	return &FutureUint16{future: Race(untypedFuturesUint16(futures)...)}
+}
+
+// AnyCtxUint16 is AnyUint16 which fails with error of the context if the context is done first
+func AnyCtxUint16(ctx context.Context, futures ...*FutureUint16) *FutureUint16 {
+	return &FutureUint16{future: AnyCtx(ctx, untypedFuturesUint16(futures)...)}
+}
+
+// RaceCtxUint16 is RaceUint16 which fails with error of the context if the context is done first
+func RaceCtxUint16(ctx context.Context, futures ...*FutureUint16) *FutureUint16 {
+	return &FutureUint16{future: RaceCtx(ctx, untypedFuturesUint16(futures)...)}
+}
+
+// futureValueUint16 returns the value as uint16. nil is the zero value
+func futureValueUint16(v interface{}) uint16 {
+	if v == nil {
+		var zero uint16
+		return zero
+	}
+	return v.(uint16)
+}
+
+func untypedFuturesUint16(futures []*FutureUint16) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureUint8 - result of type uint8 which is computed in the background. See Future
+type FutureUint8 struct {
+	future *Future
+}
+
+// PromiseUint8 - write side of FutureUint8. The first Deliver or Fail completes the future
+type PromiseUint8 struct {
+	promise *Promise
+}
+
+// NewFutureUint8 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureUint8(f func() (uint8, error)) *FutureUint8 {
+	if f == nil {
+		return &FutureUint8{future: NewFuture(nil)}
+	}
+	return &FutureUint8{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxUint8 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxUint8(ctx context.Context, f func(context.Context) (uint8, error)) *FutureUint8 {
+	if f == nil {
+		return &FutureUint8{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureUint8{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseUint8 creates promise with a future which is not completed
+func NewPromiseUint8() *PromiseUint8 {
+	return &PromiseUint8{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseUint8) Deliver(v uint8) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseUint8) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseUint8) Future() *FutureUint8 {
+	return &FutureUint8{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureUint8) Await(ctx context.Context) (uint8, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero uint8
+		return zero, err
+	}
+	return futureValueUint8(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureUint8) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureUint8) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureUint8) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureUint8) Then(fn func(uint8) (uint8, error)) *FutureUint8 {
+	if fn == nil {
+		return f
+	}
+	return &FutureUint8{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueUint8(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureUint8) ThenCtx(ctx context.Context, fn func(uint8) (uint8, error)) *FutureUint8 {
+	if fn == nil {
+		return &FutureUint8{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureUint8{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueUint8(v)) })}
+}
+
+// AwaitAllUint8 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllUint8(ctx context.Context, futures ...*FutureUint8) ([]uint8, error) {
+	values, err := AllCtxUint8(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]uint8), nil
+}
+
+// AllUint8 returns future of the values of all the futures in the same order, as []uint8.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllUint8(futures ...*FutureUint8) *Future {
+	return AllCtxUint8(context.Background(), futures...)
+}
+
+// AllCtxUint8 is AllUint8 which fails with error of the context if the context is done first
+func AllCtxUint8(ctx context.Context, futures ...*FutureUint8) *Future {
+	return AllCtx(ctx, untypedFuturesUint8(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]uint8, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueUint8(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyUint8 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyUint8(futures ...*FutureUint8) *FutureUint8 {
+	return &FutureUint8{future: Any(untypedFuturesUint8(futures)...)}
+}
+
+// RaceUint8 returns future of the value or the error of the first future which is completed
+func RaceUint8(futures ...*FutureUint8) *FutureUint8 {
+	return &FutureUint8{future: Race(untypedFuturesUint8(futures)...)}
+}
+
+// AnyCtxUint8 is AnyUint8 which fails with error of the context if the context is done first
+func AnyCtxUint8(ctx context.Context, futures ...*FutureUint8) *FutureUint8 {
+	return &FutureUint8{future: AnyCtx(ctx, untypedFuturesUint8(futures)...)}
+}
+
+// RaceCtxUint8 is RaceUint8 which fails with error of the context if the context is done first
+func RaceCtxUint8(ctx context.Context, futures ...*FutureUint8) *FutureUint8 {
+	return &FutureUint8{future: RaceCtx(ctx, untypedFuturesUint8(futures)...)}
+}
+
+// futureValueUint8 returns the value as uint8. nil is the zero value
+func futureValueUint8(v interface{}) uint8 {
+	if v == nil {
+		var zero uint8
+		return zero
+	}
+	return v.(uint8)
+}
+
+func untypedFuturesUint8(futures []*FutureUint8) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureFloat64 - result of type float64 which is computed in the background. See Future
+type FutureFloat64 struct {
+	future *Future
+}
+
+// PromiseFloat64 - write side of FutureFloat64. The first Deliver or Fail completes the future
+type PromiseFloat64 struct {
+	promise *Promise
+}
+
+// NewFutureFloat64 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureFloat64(f func() (float64, error)) *FutureFloat64 {
+	if f == nil {
+		return &FutureFloat64{future: NewFuture(nil)}
+	}
+	return &FutureFloat64{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxFloat64 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxFloat64(ctx context.Context, f func(context.Context) (float64, error)) *FutureFloat64 {
+	if f == nil {
+		return &FutureFloat64{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureFloat64{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseFloat64 creates promise with a future which is not completed
+func NewPromiseFloat64() *PromiseFloat64 {
+	return &PromiseFloat64{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseFloat64) Deliver(v float64) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseFloat64) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseFloat64) Future() *FutureFloat64 {
+	return &FutureFloat64{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureFloat64) Await(ctx context.Context) (float64, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero float64
+		return zero, err
+	}
+	return futureValueFloat64(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureFloat64) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureFloat64) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureFloat64) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureFloat64) Then(fn func(float64) (float64, error)) *FutureFloat64 {
+	if fn == nil {
+		return f
+	}
+	return &FutureFloat64{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueFloat64(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureFloat64) ThenCtx(ctx context.Context, fn func(float64) (float64, error)) *FutureFloat64 {
+	if fn == nil {
+		return &FutureFloat64{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureFloat64{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueFloat64(v)) })}
+}
+
+// AwaitAllFloat64 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllFloat64(ctx context.Context, futures ...*FutureFloat64) ([]float64, error) {
+	values, err := AllCtxFloat64(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]float64), nil
+}
+
+// AllFloat64 returns future of the values of all the futures in the same order, as []float64.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllFloat64(futures ...*FutureFloat64) *Future {
+	return AllCtxFloat64(context.Background(), futures...)
+}
+
+// AllCtxFloat64 is AllFloat64 which fails with error of the context if the context is done first
+func AllCtxFloat64(ctx context.Context, futures ...*FutureFloat64) *Future {
+	return AllCtx(ctx, untypedFuturesFloat64(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]float64, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueFloat64(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyFloat64 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyFloat64(futures ...*FutureFloat64) *FutureFloat64 {
+	return &FutureFloat64{future: Any(untypedFuturesFloat64(futures)...)}
+}
+
+// RaceFloat64 returns future of the value or the error of the first future which is completed
+func RaceFloat64(futures ...*FutureFloat64) *FutureFloat64 {
+	return &FutureFloat64{future: Race(untypedFuturesFloat64(futures)...)}
+}
+
+// AnyCtxFloat64 is AnyFloat64 which fails with error of the context if the context is done first
+func AnyCtxFloat64(ctx context.Context, futures ...*FutureFloat64) *FutureFloat64 {
+	return &FutureFloat64{future: AnyCtx(ctx, untypedFuturesFloat64(futures)...)}
+}
+
+// RaceCtxFloat64 is RaceFloat64 which fails with error of the context if the context is done first
+func RaceCtxFloat64(ctx context.Context, futures ...*FutureFloat64) *FutureFloat64 {
+	return &FutureFloat64{future: RaceCtx(ctx, untypedFuturesFloat64(futures)...)}
+}
+
+// futureValueFloat64 returns the value as float64. nil is the zero value
+func futureValueFloat64(v interface{}) float64 {
+	if v == nil {
+		var zero float64
+		return zero
+	}
+	return v.(float64)
+}
+
+func untypedFuturesFloat64(futures []*FutureFloat64) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureFloat32 - result of type float32 which is computed in the background. See Future
+type FutureFloat32 struct {
+	future *Future
+}
+
+// PromiseFloat32 - write side of FutureFloat32. The first Deliver or Fail completes the future
+type PromiseFloat32 struct {
+	promise *Promise
+}
+
+// NewFutureFloat32 runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureFloat32(f func() (float32, error)) *FutureFloat32 {
+	if f == nil {
+		return &FutureFloat32{future: NewFuture(nil)}
+	}
+	return &FutureFloat32{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxFloat32 runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxFloat32(ctx context.Context, f func(context.Context) (float32, error)) *FutureFloat32 {
+	if f == nil {
+		return &FutureFloat32{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureFloat32{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseFloat32 creates promise with a future which is not completed
+func NewPromiseFloat32() *PromiseFloat32 {
+	return &PromiseFloat32{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseFloat32) Deliver(v float32) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseFloat32) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseFloat32) Future() *FutureFloat32 {
+	return &FutureFloat32{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureFloat32) Await(ctx context.Context) (float32, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero float32
+		return zero, err
+	}
+	return futureValueFloat32(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureFloat32) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureFloat32) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureFloat32) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureFloat32) Then(fn func(float32) (float32, error)) *FutureFloat32 {
+	if fn == nil {
+		return f
+	}
+	return &FutureFloat32{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueFloat32(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureFloat32) ThenCtx(ctx context.Context, fn func(float32) (float32, error)) *FutureFloat32 {
+	if fn == nil {
+		return &FutureFloat32{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureFloat32{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueFloat32(v)) })}
+}
+
+// AwaitAllFloat32 waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllFloat32(ctx context.Context, futures ...*FutureFloat32) ([]float32, error) {
+	values, err := AllCtxFloat32(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]float32), nil
+}
+
+// AllFloat32 returns future of the values of all the futures in the same order, as []float32.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllFloat32(futures ...*FutureFloat32) *Future {
+	return AllCtxFloat32(context.Background(), futures...)
+}
+
+// AllCtxFloat32 is AllFloat32 which fails with error of the context if the context is done first
+func AllCtxFloat32(ctx context.Context, futures ...*FutureFloat32) *Future {
+	return AllCtx(ctx, untypedFuturesFloat32(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]float32, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueFloat32(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyFloat32 returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyFloat32(futures ...*FutureFloat32) *FutureFloat32 {
+	return &FutureFloat32{future: Any(untypedFuturesFloat32(futures)...)}
+}
+
+// RaceFloat32 returns future of the value or the error of the first future which is completed
+func RaceFloat32(futures ...*FutureFloat32) *FutureFloat32 {
+	return &FutureFloat32{future: Race(untypedFuturesFloat32(futures)...)}
+}
+
+// AnyCtxFloat32 is AnyFloat32 which fails with error of the context if the context is done first
+func AnyCtxFloat32(ctx context.Context, futures ...*FutureFloat32) *FutureFloat32 {
+	return &FutureFloat32{future: AnyCtx(ctx, untypedFuturesFloat32(futures)...)}
+}
+
+// RaceCtxFloat32 is RaceFloat32 which fails with error of the context if the context is done first
+func RaceCtxFloat32(ctx context.Context, futures ...*FutureFloat32) *FutureFloat32 {
+	return &FutureFloat32{future: RaceCtx(ctx, untypedFuturesFloat32(futures)...)}
+}
+
+// futureValueFloat32 returns the value as float32. nil is the zero value
+func futureValueFloat32(v interface{}) float32 {
+	if v == nil {
+		var zero float32
+		return zero
+	}
+	return v.(float32)
+}
+
+func untypedFuturesFloat32(futures []*FutureFloat32) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureStr - result of type string which is computed in the background. See Future
+type FutureStr struct {
+	future *Future
+}
+
+// PromiseStr - write side of FutureStr. The first Deliver or Fail completes the future
+type PromiseStr struct {
+	promise *Promise
+}
+
+// NewFutureStr runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureStr(f func() (string, error)) *FutureStr {
+	if f == nil {
+		return &FutureStr{future: NewFuture(nil)}
+	}
+	return &FutureStr{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxStr runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxStr(ctx context.Context, f func(context.Context) (string, error)) *FutureStr {
+	if f == nil {
+		return &FutureStr{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureStr{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseStr creates promise with a future which is not completed
+func NewPromiseStr() *PromiseStr {
+	return &PromiseStr{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseStr) Deliver(v string) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseStr) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseStr) Future() *FutureStr {
+	return &FutureStr{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureStr) Await(ctx context.Context) (string, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero string
+		return zero, err
+	}
+	return futureValueStr(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureStr) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureStr) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureStr) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureStr) Then(fn func(string) (string, error)) *FutureStr {
+	if fn == nil {
+		return f
+	}
+	return &FutureStr{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueStr(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureStr) ThenCtx(ctx context.Context, fn func(string) (string, error)) *FutureStr {
+	if fn == nil {
+		return &FutureStr{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureStr{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueStr(v)) })}
+}
+
+// AwaitAllStr waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllStr(ctx context.Context, futures ...*FutureStr) ([]string, error) {
+	values, err := AllCtxStr(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]string), nil
+}
+
+// AllStr returns future of the values of all the futures in the same order, as []string.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllStr(futures ...*FutureStr) *Future {
+	return AllCtxStr(context.Background(), futures...)
+}
+
+// AllCtxStr is AllStr which fails with error of the context if the context is done first
+func AllCtxStr(ctx context.Context, futures ...*FutureStr) *Future {
+	return AllCtx(ctx, untypedFuturesStr(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]string, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueStr(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyStr returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyStr(futures ...*FutureStr) *FutureStr {
+	return &FutureStr{future: Any(untypedFuturesStr(futures)...)}
+}
+
+// RaceStr returns future of the value or the error of the first future which is completed
+func RaceStr(futures ...*FutureStr) *FutureStr {
+	return &FutureStr{future: Race(untypedFuturesStr(futures)...)}
+}
+
+// AnyCtxStr is AnyStr which fails with error of the context if the context is done first
+func AnyCtxStr(ctx context.Context, futures ...*FutureStr) *FutureStr {
+	return &FutureStr{future: AnyCtx(ctx, untypedFuturesStr(futures)...)}
+}
+
+// RaceCtxStr is RaceStr which fails with error of the context if the context is done first
+func RaceCtxStr(ctx context.Context, futures ...*FutureStr) *FutureStr {
+	return &FutureStr{future: RaceCtx(ctx, untypedFuturesStr(futures)...)}
+}
+
+// futureValueStr returns the value as string. nil is the zero value
+func futureValueStr(v interface{}) string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.(string)
+}
+
+func untypedFuturesStr(futures []*FutureStr) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+// FutureBool - result of type bool which is computed in the background. See Future
+type FutureBool struct {
+	future *Future
+}
+
+// PromiseBool - write side of FutureBool. The first Deliver or Fail completes the future
+type PromiseBool struct {
+	promise *Promise
+}
+
+// NewFutureBool runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFutureBool(f func() (bool, error)) *FutureBool {
+	if f == nil {
+		return &FutureBool{future: NewFuture(nil)}
+	}
+	return &FutureBool{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtxBool runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtxBool(ctx context.Context, f func(context.Context) (bool, error)) *FutureBool {
+	if f == nil {
+		return &FutureBool{future: NewFutureCtx(ctx, nil)}
+	}
+	return &FutureBool{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromiseBool creates promise with a future which is not completed
+func NewPromiseBool() *PromiseBool {
+	return &PromiseBool{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *PromiseBool) Deliver(v bool) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *PromiseBool) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *PromiseBool) Future() *FutureBool {
+	return &FutureBool{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *FutureBool) Await(ctx context.Context) (bool, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero bool
+		return zero, err
+	}
+	return futureValueBool(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *FutureBool) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *FutureBool) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *FutureBool) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *FutureBool) Then(fn func(bool) (bool, error)) *FutureBool {
+	if fn == nil {
+		return f
+	}
+	return &FutureBool{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueBool(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *FutureBool) ThenCtx(ctx context.Context, fn func(bool) (bool, error)) *FutureBool {
+	if fn == nil {
+		return &FutureBool{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureBool{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueBool(v)) })}
+}
+
+// AwaitAllBool waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAllBool(ctx context.Context, futures ...*FutureBool) ([]bool, error) {
+	values, err := AllCtxBool(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]bool), nil
+}
+
+// AllBool returns future of the values of all the futures in the same order, as []bool.
+// It fails as soon as one of the futures fails, with the error of that future
+func AllBool(futures ...*FutureBool) *Future {
+	return AllCtxBool(context.Background(), futures...)
+}
+
+// AllCtxBool is AllBool which fails with error of the context if the context is done first
+func AllCtxBool(ctx context.Context, futures ...*FutureBool) *Future {
+	return AllCtx(ctx, untypedFuturesBool(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]bool, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueBool(v)
+		}
+		return list, nil
+	})
+}
+
+// AnyBool returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func AnyBool(futures ...*FutureBool) *FutureBool {
+	return &FutureBool{future: Any(untypedFuturesBool(futures)...)}
+}
+
+// RaceBool returns future of the value or the error of the first future which is completed
+func RaceBool(futures ...*FutureBool) *FutureBool {
+	return &FutureBool{future: Race(untypedFuturesBool(futures)...)}
+}
+
+// AnyCtxBool is AnyBool which fails with error of the context if the context is done first
+func AnyCtxBool(ctx context.Context, futures ...*FutureBool) *FutureBool {
+	return &FutureBool{future: AnyCtx(ctx, untypedFuturesBool(futures)...)}
+}
+
+// RaceCtxBool is RaceBool which fails with error of the context if the context is done first
+func RaceCtxBool(ctx context.Context, futures ...*FutureBool) *FutureBool {
+	return &FutureBool{future: RaceCtx(ctx, untypedFuturesBool(futures)...)}
+}
+
+// futureValueBool returns the value as bool. nil is the zero value
+func futureValueBool(v interface{}) bool {
+	if v == nil {
+		var zero bool
+		return zero
+	}
+	return v.(bool)
+}
+
+func untypedFuturesBool(futures []*FutureBool) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
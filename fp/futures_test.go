@@ -0,0 +1,1525 @@
+package fp
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFutureInt(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureInt(func() (int, error) { return 2, nil }).
+		Then(func(v int) (int, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureInt failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt(func() (int, error) { return 0, errBoom }).
+		Then(func(v int) (int, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureInt failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureInt(func() (int, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureInt failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureInt failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseInt()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureInt failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureInt failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureInt failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsInt(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseInt()
+	fast := NewFutureInt(func() (int, error) { return 1, nil })
+
+	if v, err := RaceInt(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt(func() (int, error) { return 0, errBoom })
+	if v, err := AnyInt(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyInt(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceInt().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllInt(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllInt(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllInt(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsInt failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueInt(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v int) (int, error) { return v + 1, nil }
+	empty := NewFutureInt(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureInt(func() (int, error) { return 2, nil })
+	values, err := AwaitAllInt(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueInt failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllInt(two, empty).Await(ctx)
+	if list, ok := all.([]int); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueInt failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxInt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseInt().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxInt(ctx, func(ctx context.Context) (int, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureInt{
+		never.ThenCtx(ctx, func(v int) (int, error) { return v, nil }),
+		AnyCtxInt(ctx, never),
+		RaceCtxInt(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxInt failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllInt(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxInt failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureInt64(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureInt64(func() (int64, error) { return 2, nil }).
+		Then(func(v int64) (int64, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureInt64 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt64(func() (int64, error) { return 0, errBoom }).
+		Then(func(v int64) (int64, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureInt64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureInt64(func() (int64, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureInt64 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureInt64 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseInt64()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureInt64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureInt64 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureInt64 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsInt64(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseInt64()
+	fast := NewFutureInt64(func() (int64, error) { return 1, nil })
+
+	if v, err := RaceInt64(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt64(func() (int64, error) { return 0, errBoom })
+	if v, err := AnyInt64(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyInt64(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceInt64().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllInt64(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllInt64(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllInt64(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsInt64 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueInt64(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v int64) (int64, error) { return v + 1, nil }
+	empty := NewFutureInt64(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureInt64(func() (int64, error) { return 2, nil })
+	values, err := AwaitAllInt64(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueInt64 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllInt64(two, empty).Await(ctx)
+	if list, ok := all.([]int64); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueInt64 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxInt64(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseInt64().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxInt64(ctx, func(ctx context.Context) (int64, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureInt64{
+		never.ThenCtx(ctx, func(v int64) (int64, error) { return v, nil }),
+		AnyCtxInt64(ctx, never),
+		RaceCtxInt64(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxInt64 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllInt64(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxInt64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureInt32(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureInt32(func() (int32, error) { return 2, nil }).
+		Then(func(v int32) (int32, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureInt32 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt32(func() (int32, error) { return 0, errBoom }).
+		Then(func(v int32) (int32, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureInt32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureInt32(func() (int32, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureInt32 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureInt32 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseInt32()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureInt32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureInt32 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureInt32 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsInt32(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseInt32()
+	fast := NewFutureInt32(func() (int32, error) { return 1, nil })
+
+	if v, err := RaceInt32(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt32(func() (int32, error) { return 0, errBoom })
+	if v, err := AnyInt32(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyInt32(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceInt32().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllInt32(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllInt32(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllInt32(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsInt32 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueInt32(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v int32) (int32, error) { return v + 1, nil }
+	empty := NewFutureInt32(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureInt32(func() (int32, error) { return 2, nil })
+	values, err := AwaitAllInt32(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueInt32 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllInt32(two, empty).Await(ctx)
+	if list, ok := all.([]int32); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueInt32 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxInt32(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseInt32().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxInt32(ctx, func(ctx context.Context) (int32, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureInt32{
+		never.ThenCtx(ctx, func(v int32) (int32, error) { return v, nil }),
+		AnyCtxInt32(ctx, never),
+		RaceCtxInt32(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxInt32 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllInt32(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxInt32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureInt16(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureInt16(func() (int16, error) { return 2, nil }).
+		Then(func(v int16) (int16, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureInt16 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt16(func() (int16, error) { return 0, errBoom }).
+		Then(func(v int16) (int16, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureInt16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureInt16(func() (int16, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureInt16 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureInt16 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseInt16()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureInt16 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureInt16 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureInt16 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsInt16(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseInt16()
+	fast := NewFutureInt16(func() (int16, error) { return 1, nil })
+
+	if v, err := RaceInt16(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt16(func() (int16, error) { return 0, errBoom })
+	if v, err := AnyInt16(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyInt16(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceInt16().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllInt16(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllInt16(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllInt16(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsInt16 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueInt16(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v int16) (int16, error) { return v + 1, nil }
+	empty := NewFutureInt16(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureInt16(func() (int16, error) { return 2, nil })
+	values, err := AwaitAllInt16(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueInt16 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllInt16(two, empty).Await(ctx)
+	if list, ok := all.([]int16); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueInt16 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxInt16(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseInt16().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxInt16(ctx, func(ctx context.Context) (int16, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureInt16{
+		never.ThenCtx(ctx, func(v int16) (int16, error) { return v, nil }),
+		AnyCtxInt16(ctx, never),
+		RaceCtxInt16(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxInt16 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllInt16(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxInt16 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureInt8(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureInt8(func() (int8, error) { return 2, nil }).
+		Then(func(v int8) (int8, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureInt8 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt8(func() (int8, error) { return 0, errBoom }).
+		Then(func(v int8) (int8, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureInt8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureInt8(func() (int8, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureInt8 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureInt8 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseInt8()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureInt8 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureInt8 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureInt8 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsInt8(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseInt8()
+	fast := NewFutureInt8(func() (int8, error) { return 1, nil })
+
+	if v, err := RaceInt8(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureInt8(func() (int8, error) { return 0, errBoom })
+	if v, err := AnyInt8(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyInt8(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceInt8().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllInt8(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllInt8(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllInt8(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsInt8 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueInt8(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v int8) (int8, error) { return v + 1, nil }
+	empty := NewFutureInt8(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueInt8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureInt8(func() (int8, error) { return 2, nil })
+	values, err := AwaitAllInt8(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueInt8 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllInt8(two, empty).Await(ctx)
+	if list, ok := all.([]int8); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueInt8 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxInt8(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseInt8().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxInt8(ctx, func(ctx context.Context) (int8, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureInt8{
+		never.ThenCtx(ctx, func(v int8) (int8, error) { return v, nil }),
+		AnyCtxInt8(ctx, never),
+		RaceCtxInt8(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxInt8 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllInt8(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxInt8 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureUint(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureUint(func() (uint, error) { return 2, nil }).
+		Then(func(v uint) (uint, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureUint failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint(func() (uint, error) { return 0, errBoom }).
+		Then(func(v uint) (uint, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureUint failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureUint(func() (uint, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureUint failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureUint failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseUint()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureUint failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureUint failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureUint failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsUint(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseUint()
+	fast := NewFutureUint(func() (uint, error) { return 1, nil })
+
+	if v, err := RaceUint(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint(func() (uint, error) { return 0, errBoom })
+	if v, err := AnyUint(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyUint(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceUint().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllUint(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllUint(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllUint(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsUint failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueUint(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v uint) (uint, error) { return v + 1, nil }
+	empty := NewFutureUint(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureUint(func() (uint, error) { return 2, nil })
+	values, err := AwaitAllUint(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueUint failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllUint(two, empty).Await(ctx)
+	if list, ok := all.([]uint); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueUint failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxUint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseUint().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxUint(ctx, func(ctx context.Context) (uint, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureUint{
+		never.ThenCtx(ctx, func(v uint) (uint, error) { return v, nil }),
+		AnyCtxUint(ctx, never),
+		RaceCtxUint(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxUint failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllUint(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxUint failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureUint64(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureUint64(func() (uint64, error) { return 2, nil }).
+		Then(func(v uint64) (uint64, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureUint64 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint64(func() (uint64, error) { return 0, errBoom }).
+		Then(func(v uint64) (uint64, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureUint64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureUint64(func() (uint64, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureUint64 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureUint64 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseUint64()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureUint64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureUint64 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureUint64 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsUint64(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseUint64()
+	fast := NewFutureUint64(func() (uint64, error) { return 1, nil })
+
+	if v, err := RaceUint64(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint64(func() (uint64, error) { return 0, errBoom })
+	if v, err := AnyUint64(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyUint64(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceUint64().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllUint64(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllUint64(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllUint64(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsUint64 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueUint64(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v uint64) (uint64, error) { return v + 1, nil }
+	empty := NewFutureUint64(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureUint64(func() (uint64, error) { return 2, nil })
+	values, err := AwaitAllUint64(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueUint64 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllUint64(two, empty).Await(ctx)
+	if list, ok := all.([]uint64); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueUint64 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxUint64(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseUint64().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxUint64(ctx, func(ctx context.Context) (uint64, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureUint64{
+		never.ThenCtx(ctx, func(v uint64) (uint64, error) { return v, nil }),
+		AnyCtxUint64(ctx, never),
+		RaceCtxUint64(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxUint64 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllUint64(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxUint64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureUint32(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureUint32(func() (uint32, error) { return 2, nil }).
+		Then(func(v uint32) (uint32, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureUint32 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint32(func() (uint32, error) { return 0, errBoom }).
+		Then(func(v uint32) (uint32, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureUint32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureUint32(func() (uint32, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureUint32 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureUint32 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseUint32()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureUint32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureUint32 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureUint32 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsUint32(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseUint32()
+	fast := NewFutureUint32(func() (uint32, error) { return 1, nil })
+
+	if v, err := RaceUint32(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint32(func() (uint32, error) { return 0, errBoom })
+	if v, err := AnyUint32(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyUint32(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceUint32().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllUint32(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllUint32(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllUint32(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsUint32 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueUint32(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v uint32) (uint32, error) { return v + 1, nil }
+	empty := NewFutureUint32(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureUint32(func() (uint32, error) { return 2, nil })
+	values, err := AwaitAllUint32(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueUint32 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllUint32(two, empty).Await(ctx)
+	if list, ok := all.([]uint32); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueUint32 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxUint32(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseUint32().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxUint32(ctx, func(ctx context.Context) (uint32, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureUint32{
+		never.ThenCtx(ctx, func(v uint32) (uint32, error) { return v, nil }),
+		AnyCtxUint32(ctx, never),
+		RaceCtxUint32(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxUint32 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllUint32(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxUint32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureUint16(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureUint16(func() (uint16, error) { return 2, nil }).
+		Then(func(v uint16) (uint16, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureUint16 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint16(func() (uint16, error) { return 0, errBoom }).
+		Then(func(v uint16) (uint16, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureUint16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureUint16(func() (uint16, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureUint16 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureUint16 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseUint16()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureUint16 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureUint16 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureUint16 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsUint16(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseUint16()
+	fast := NewFutureUint16(func() (uint16, error) { return 1, nil })
+
+	if v, err := RaceUint16(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint16(func() (uint16, error) { return 0, errBoom })
+	if v, err := AnyUint16(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyUint16(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceUint16().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllUint16(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllUint16(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllUint16(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsUint16 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueUint16(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v uint16) (uint16, error) { return v + 1, nil }
+	empty := NewFutureUint16(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint16 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureUint16(func() (uint16, error) { return 2, nil })
+	values, err := AwaitAllUint16(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueUint16 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllUint16(two, empty).Await(ctx)
+	if list, ok := all.([]uint16); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueUint16 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxUint16(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseUint16().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxUint16(ctx, func(ctx context.Context) (uint16, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureUint16{
+		never.ThenCtx(ctx, func(v uint16) (uint16, error) { return v, nil }),
+		AnyCtxUint16(ctx, never),
+		RaceCtxUint16(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxUint16 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllUint16(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxUint16 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureUint8(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureUint8(func() (uint8, error) { return 2, nil }).
+		Then(func(v uint8) (uint8, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureUint8 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint8(func() (uint8, error) { return 0, errBoom }).
+		Then(func(v uint8) (uint8, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureUint8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureUint8(func() (uint8, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureUint8 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureUint8 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseUint8()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureUint8 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureUint8 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureUint8 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsUint8(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseUint8()
+	fast := NewFutureUint8(func() (uint8, error) { return 1, nil })
+
+	if v, err := RaceUint8(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureUint8(func() (uint8, error) { return 0, errBoom })
+	if v, err := AnyUint8(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyUint8(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceUint8().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllUint8(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllUint8(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllUint8(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsUint8 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueUint8(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v uint8) (uint8, error) { return v + 1, nil }
+	empty := NewFutureUint8(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueUint8 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureUint8(func() (uint8, error) { return 2, nil })
+	values, err := AwaitAllUint8(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueUint8 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllUint8(two, empty).Await(ctx)
+	if list, ok := all.([]uint8); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueUint8 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxUint8(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseUint8().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxUint8(ctx, func(ctx context.Context) (uint8, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureUint8{
+		never.ThenCtx(ctx, func(v uint8) (uint8, error) { return v, nil }),
+		AnyCtxUint8(ctx, never),
+		RaceCtxUint8(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxUint8 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllUint8(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxUint8 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureFloat64(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureFloat64(func() (float64, error) { return 2, nil }).
+		Then(func(v float64) (float64, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureFloat64 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureFloat64(func() (float64, error) { return 0, errBoom }).
+		Then(func(v float64) (float64, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureFloat64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureFloat64(func() (float64, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureFloat64 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureFloat64 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseFloat64()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureFloat64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureFloat64 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureFloat64 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsFloat64(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseFloat64()
+	fast := NewFutureFloat64(func() (float64, error) { return 1, nil })
+
+	if v, err := RaceFloat64(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureFloat64(func() (float64, error) { return 0, errBoom })
+	if v, err := AnyFloat64(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyFloat64(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceFloat64().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllFloat64(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllFloat64(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllFloat64(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsFloat64 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueFloat64(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v float64) (float64, error) { return v + 1, nil }
+	empty := NewFutureFloat64(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueFloat64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueFloat64 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureFloat64(func() (float64, error) { return 2, nil })
+	values, err := AwaitAllFloat64(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueFloat64 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllFloat64(two, empty).Await(ctx)
+	if list, ok := all.([]float64); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueFloat64 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxFloat64(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseFloat64().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxFloat64(ctx, func(ctx context.Context) (float64, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureFloat64{
+		never.ThenCtx(ctx, func(v float64) (float64, error) { return v, nil }),
+		AnyCtxFloat64(ctx, never),
+		RaceCtxFloat64(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxFloat64 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllFloat64(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxFloat64 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureFloat32(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureFloat32(func() (float32, error) { return 2, nil }).
+		Then(func(v float32) (float32, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFutureFloat32 failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureFloat32(func() (float32, error) { return 0, errBoom }).
+		Then(func(v float32) (float32, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFutureFloat32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFutureFloat32(func() (float32, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFutureFloat32 failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFutureFloat32 failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromiseFloat32()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFutureFloat32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFutureFloat32 failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFutureFloat32 failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinatorsFloat32(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromiseFloat32()
+	fast := NewFutureFloat32(func() (float32, error) { return 1, nil })
+
+	if v, err := RaceFloat32(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFutureFloat32(func() (float32, error) { return 0, errBoom })
+	if v, err := AnyFloat32(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := AnyFloat32(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := RaceFloat32().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAllFloat32(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAllFloat32(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAllFloat32(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinatorsFloat32 failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValueFloat32(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v float32) (float32, error) { return v + 1, nil }
+	empty := NewFutureFloat32(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueFloat32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValueFloat32 failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFutureFloat32(func() (float32, error) { return 2, nil })
+	values, err := AwaitAllFloat32(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValueFloat32 failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := AllFloat32(two, empty).Await(ctx)
+	if list, ok := all.([]float32); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValueFloat32 failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtxFloat32(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromiseFloat32().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtxFloat32(ctx, func(ctx context.Context) (float32, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*FutureFloat32{
+		never.ThenCtx(ctx, func(v float32) (float32, error) { return v, nil }),
+		AnyCtxFloat32(ctx, never),
+		RaceCtxFloat32(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtxFloat32 failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAllFloat32(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtxFloat32 failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+
+func TestFutureStr(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureStr(func() (string, error) { return "a", nil }).
+		Then(func(v string) (string, error) { return v + "b", nil })
+	if v, err := f.Await(ctx); err != nil || v != "ab" {
+		t.Errorf("TestFutureStr failed. Expected=%v, actual=%v, error=%v", "ab", v, err)
+	}
+
+	errBoom := errors.New("boom")
+	p := NewPromiseStr()
+	p.Fail(errBoom)
+	if _, err := p.Future().Then(func(v string) (string, error) { return v, nil }).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureStr failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+}
+
+func TestFutureCombinatorsStr(t *testing.T) {
+	ctx := context.Background()
+	a := NewFutureStr(func() (string, error) { return "a", nil })
+	b := NewFutureStr(func() (string, error) { return "b", nil })
+	values, err := AwaitAllStr(ctx, a, b)
+	if err != nil || len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Errorf("TestFutureCombinatorsStr failed. Expected=[a b], actual=%v, error=%v", values, err)
+	}
+	if v, _ := AnyStr(a).Await(ctx); v != "a" {
+		t.Errorf("TestFutureCombinatorsStr failed. Expected=%v, actual=%v", "a", v)
+	}
+	if v, _ := RaceStr(b).Await(ctx); v != "b" {
+		t.Errorf("TestFutureCombinatorsStr failed. Expected=%v, actual=%v", "b", v)
+	}
+}
+
+func TestFutureBool(t *testing.T) {
+	ctx := context.Background()
+	f := NewFutureBool(func() (bool, error) { return true, nil }).
+		Then(func(v bool) (bool, error) { return !v, nil })
+	if v, err := f.Await(ctx); err != nil || v != false {
+		t.Errorf("TestFutureBool failed. Expected=%v, actual=%v, error=%v", false, v, err)
+	}
+}
+
+func TestFutureCombinatorsBool(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+	failed := NewFutureBool(func() (bool, error) { return false, errBoom })
+	ok := NewFutureBool(func() (bool, error) { return true, nil })
+	if v, err := AnyBool(failed, ok).Await(ctx); err != nil || v != true {
+		t.Errorf("TestFutureCombinatorsBool failed. Expected=%v, actual=%v, error=%v", true, v, err)
+	}
+	if _, err := AwaitAllBool(ctx, ok, failed); err != errBoom {
+		t.Errorf("TestFutureCombinatorsBool failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+}
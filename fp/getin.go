@@ -0,0 +1,308 @@
+package fp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrPathNotFound is returned by typed GetIn functions when the path does not exist
+var ErrPathNotFound = errors.New("path not found")
+
+// TypeMismatchError is returned by typed GetIn functions when the value at the path is not of the expected type
+type TypeMismatchError struct {
+	Path     []interface{}
+	Expected string
+	Actual   interface{}
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("value at path %v: expected %s, got %T", e.Path, e.Expected, e.Actual)
+}
+
+// PathError is returned by AssocInE and UpdateInE when the value can't be set at the path
+type PathError struct {
+	// Path is the part of the path up to the element which can't be followed
+	Path   []interface{}
+	Reason string
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("path %v: %s", e.Path, e.Reason)
+}
+
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// GetIn returns the value in nested structure of maps and slices, such as decoded JSON.
+// Each element of the path is either string(key of map[string]interface{}) or int(index of []interface{}).
+//
+// Returns
+//	value and true if the path exists. nil and false otherwise
+//
+// Example:
+//	m := map[string]interface{}{"users": []interface{}{map[string]interface{}{"name": "Ram"}}}
+//	fp.GetIn(m, "users", 0, "name") // Returns "Ram", true
+func GetIn(m map[string]interface{}, path ...interface{}) (interface{}, bool) {
+	var node interface{} = m
+	for _, p := range path {
+		switch key := p.(type) {
+		case string:
+			nodeMap, ok := node.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			node, ok = nodeMap[key]
+			if !ok {
+				return nil, false
+			}
+		case int:
+			nodeList, ok := node.([]interface{})
+			if !ok || key < 0 || key >= len(nodeList) {
+				return nil, false
+			}
+			node = nodeList[key]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// GetInStr returns the string value at the path.
+// Returns ErrPathNotFound if the path does not exist and *TypeMismatchError if the value is not a string
+func GetInStr(m map[string]interface{}, path ...interface{}) (string, error) {
+	v, ok := GetIn(m, path...)
+	if !ok {
+		return "", ErrPathNotFound
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", &TypeMismatchError{Path: path, Expected: "string", Actual: v}
+	}
+	return s, nil
+}
+
+// GetInInt returns the int value at the path. Numbers decoded from JSON(float64 and json.Number) are
+// accepted when they hold an integer.
+// Returns ErrPathNotFound if the path does not exist and *TypeMismatchError if the value is not an integer
+// or doesn't fit in int
+func GetInInt(m map[string]interface{}, path ...interface{}) (int, error) {
+	v, ok := GetIn(m, path...)
+	if !ok {
+		return 0, ErrPathNotFound
+	}
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		if n >= int64(minInt) && n <= int64(maxInt) {
+			return int(n), nil
+		}
+	case int32:
+		return int(n), nil
+	case float64:
+		// float64(maxInt) is rounded up, so the upper bound is checked with -float64(minInt)
+		if n == math.Trunc(n) && n >= float64(minInt) && n < -float64(minInt) {
+			return int(n), nil
+		}
+	case json.Number:
+		if i, err := n.Int64(); err == nil && i >= int64(minInt) && i <= int64(maxInt) {
+			return int(i), nil
+		}
+	}
+	return 0, &TypeMismatchError{Path: path, Expected: "int", Actual: v}
+}
+
+// GetInFloat64 returns the float64 value at the path. Integers and json.Number are converted to float64.
+// Returns ErrPathNotFound if the path does not exist and *TypeMismatchError if the value is not a number
+func GetInFloat64(m map[string]interface{}, path ...interface{}) (float64, error) {
+	v, ok := GetIn(m, path...)
+	if !ok {
+		return 0, ErrPathNotFound
+	}
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case json.Number:
+		if f, err := n.Float64(); err == nil {
+			return f, nil
+		}
+	}
+	return 0, &TypeMismatchError{Path: path, Expected: "float64", Actual: v}
+}
+
+// GetInBool returns the bool value at the path.
+// Returns ErrPathNotFound if the path does not exist and *TypeMismatchError if the value is not a bool
+func GetInBool(m map[string]interface{}, path ...interface{}) (bool, error) {
+	v, ok := GetIn(m, path...)
+	if !ok {
+		return false, ErrPathNotFound
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, &TypeMismatchError{Path: path, Expected: "bool", Actual: v}
+	}
+	return b, nil
+}
+
+// AssocIn returns a new structure with the value set at the path. The original structure is not modified,
+// only maps and slices along the path are copied.
+//
+// Missing keys of maps are created as map[string]interface{}. Index of a slice can be equal to its length
+// to append the value.
+//
+// Panics if an index is out of range, or the path goes through a value which is not a map(for string key)
+// or a slice(for int index). See AssocInE which returns error instead
+//
+// Example:
+//	m := map[string]interface{}{"db": map[string]interface{}{"host": "localhost"}}
+//	fp.AssocIn(m, []interface{}{"db", "port"}, 5432) // Returns map[db:map[host:localhost port:5432]]
+func AssocIn(m map[string]interface{}, path []interface{}, v interface{}) map[string]interface{} {
+	newMap, err := AssocInE(m, path, v)
+	if err != nil {
+		panic("AssocIn: " + err.Error())
+	}
+	return newMap
+}
+
+// AssocInE is AssocIn which returns *PathError instead of panic. The map is nil when error is returned
+func AssocInE(m map[string]interface{}, path []interface{}, v interface{}) (map[string]interface{}, error) {
+	if len(path) == 0 {
+		return copyMapStrInterface(m), nil
+	}
+	newMap, err := assocIn(m, path, 0, v)
+	if err != nil {
+		return nil, err
+	}
+	return newMap.(map[string]interface{}), nil
+}
+
+func assocIn(node interface{}, path []interface{}, i int, v interface{}) (interface{}, error) {
+	if i == len(path) {
+		return v, nil
+	}
+
+	switch key := path[i].(type) {
+	case string:
+		var nodeMap map[string]interface{}
+		if node != nil {
+			var ok bool
+			nodeMap, ok = node.(map[string]interface{})
+			if !ok {
+				return nil, &PathError{Path: path[:i], Reason: fmt.Sprintf("value is %T, not map[string]interface{}", node)}
+			}
+		}
+		child, err := assocIn(nodeMap[key], path, i+1, v)
+		if err != nil {
+			return nil, err
+		}
+		newMap := copyMapStrInterface(nodeMap)
+		newMap[key] = child
+		return newMap, nil
+
+	case int:
+		nodeList, ok := node.([]interface{})
+		if !ok {
+			return nil, &PathError{Path: path[:i], Reason: fmt.Sprintf("value is %T, not []interface{}", node)}
+		}
+		if key < 0 || key > len(nodeList) {
+			return nil, &PathError{Path: path[:i], Reason: fmt.Sprintf("index %d out of range with length %d", key, len(nodeList))}
+		}
+		var old interface{}
+		if key < len(nodeList) {
+			old = nodeList[key]
+		}
+		child, err := assocIn(old, path, i+1, v)
+		if err != nil {
+			return nil, err
+		}
+		newList := make([]interface{}, len(nodeList), len(nodeList)+1)
+		copy(newList, nodeList)
+		if key == len(nodeList) {
+			newList = append(newList, child)
+		} else {
+			newList[key] = child
+		}
+		return newList, nil
+	}
+	return nil, &PathError{Path: path[:i], Reason: fmt.Sprintf("path element %v is %T, should be string or int", path[i], path[i])}
+}
+
+// UpdateIn returns a new structure where the value at the path is replaced with the result of the function.
+// The function gets nil if the path does not exist. The original structure is not modified.
+// Returns a copy of the map if the function is nil. Panics in the same cases as AssocIn. See UpdateInE which returns error instead
+//
+// Example:
+//	m := map[string]interface{}{"counter": 1}
+//	fp.UpdateIn(m, []interface{}{"counter"}, func(v interface{}) interface{} { return v.(int) + 1 }) // Returns map[counter:2]
+func UpdateIn(m map[string]interface{}, path []interface{}, f func(interface{}) interface{}) map[string]interface{} {
+	if f == nil {
+		return copyMapStrInterface(m)
+	}
+	v, _ := GetIn(m, path...)
+	return AssocIn(m, path, f(v))
+}
+
+// UpdateInE is UpdateIn which returns *PathError instead of panic. The function is not called when error is returned
+func UpdateInE(m map[string]interface{}, path []interface{}, f func(interface{}) interface{}) (map[string]interface{}, error) {
+	if f == nil {
+		return copyMapStrInterface(m), nil
+	}
+	// the path is checked first, so the function is not called for a path which can't be set
+	if _, err := AssocInE(m, path, nil); err != nil {
+		return nil, err
+	}
+	v, _ := GetIn(m, path...)
+	return AssocInE(m, path, f(v))
+}
+
+// DissocIn returns a new structure without the key(or slice element) at the end of the path.
+// The original structure is not modified. Returns a copy of the map if the path does not exist
+//
+// Example:
+//	m := map[string]interface{}{"db": map[string]interface{}{"host": "localhost", "port": 5432}}
+//	fp.DissocIn(m, "db", "port") // Returns map[db:map[host:localhost]]
+func DissocIn(m map[string]interface{}, path ...interface{}) map[string]interface{} {
+	if len(path) == 0 {
+		return copyMapStrInterface(m)
+	}
+	if _, ok := GetIn(m, path...); !ok {
+		return copyMapStrInterface(m)
+	}
+
+	parent, _ := GetIn(m, path[:len(path)-1]...)
+	switch key := path[len(path)-1].(type) {
+	case string:
+		newMap := copyMapStrInterface(parent.(map[string]interface{}))
+		delete(newMap, key)
+		parent = newMap
+	case int:
+		nodeList := parent.([]interface{})
+		newList := make([]interface{}, 0, len(nodeList)-1)
+		newList = append(newList, nodeList[:key]...)
+		parent = append(newList, nodeList[key+1:]...)
+	}
+
+	if len(path) == 1 {
+		return parent.(map[string]interface{})
+	}
+	return AssocIn(m, path[:len(path)-1], parent)
+}
+
+func copyMapStrInterface(m map[string]interface{}) map[string]interface{} {
+	newMap := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	return newMap
+}
@@ -0,0 +1,221 @@
+package fp
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, s string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestGetIn(t *testing.T) {
+	m := decodeJSON(t, `{"users": [{"name": "Ram", "age": 30, "admin": true}], "score": 9.5}`)
+
+	v, ok := GetIn(m, "users", 0, "name")
+	if !ok || v != "Ram" {
+		t.Errorf("TestGetIn failed. Expected=Ram, actual=%v", v)
+	}
+
+	for _, path := range [][]interface{}{{"users", 1}, {"users", "0"}, {"missing"}, {"score", "x"}, {"users", -1}, {1.5}} {
+		if v, ok := GetIn(m, path...); ok {
+			t.Errorf("TestGetIn failed. Expected path %v to be missing, actual=%v", path, v)
+		}
+	}
+
+	if v, ok := GetIn(m); !ok || !reflect.DeepEqual(v, m) {
+		t.Errorf("TestGetIn failed. Expected map for empty path")
+	}
+}
+
+func TestGetInTyped(t *testing.T) {
+	m := decodeJSON(t, `{"users": [{"name": "Ram", "age": 30, "admin": true}], "score": 9.5}`)
+
+	name, err := GetInStr(m, "users", 0, "name")
+	if err != nil || name != "Ram" {
+		t.Errorf("TestGetInStr failed. Expected=Ram, actual=%v, err=%v", name, err)
+	}
+
+	age, err := GetInInt(m, "users", 0, "age")
+	if err != nil || age != 30 {
+		t.Errorf("TestGetInInt failed. Expected=30, actual=%v, err=%v", age, err)
+	}
+
+	score, err := GetInFloat64(m, "score")
+	if err != nil || score != 9.5 {
+		t.Errorf("TestGetInFloat64 failed. Expected=9.5, actual=%v, err=%v", score, err)
+	}
+
+	admin, err := GetInBool(m, "users", 0, "admin")
+	if err != nil || !admin {
+		t.Errorf("TestGetInBool failed. Expected=true, actual=%v, err=%v", admin, err)
+	}
+
+	if _, err := GetInStr(m, "users", 0, "email"); err != ErrPathNotFound {
+		t.Errorf("TestGetInStr failed. Expected ErrPathNotFound, actual=%v", err)
+	}
+
+	_, err = GetInInt(m, "score")
+	mismatch, ok := err.(*TypeMismatchError)
+	if !ok || mismatch.Expected != "int" || mismatch.Actual != 9.5 {
+		t.Errorf("TestGetInInt failed. Expected type mismatch, actual=%v", err)
+	}
+
+	if _, err := GetInStr(m, "users", 0, "age"); err == nil || err.Error() != "value at path [users 0 age]: expected string, got float64" {
+		t.Errorf("TestGetInStr failed. Expected type mismatch, actual=%v", err)
+	}
+
+	if _, err := GetInBool(m, "score"); err == nil {
+		t.Errorf("TestGetInBool failed. Expected type mismatch")
+	}
+}
+
+func TestGetInIntOutOfRange(t *testing.T) {
+	m := map[string]interface{}{"float": 1e20, "inf": math.Inf(1), "number": json.Number("100000000000000000000"), "max": float64(1 << 30)}
+	for _, key := range []string{"float", "inf", "number"} {
+		if v, err := GetInInt(m, key); err == nil {
+			t.Errorf("TestGetInIntOutOfRange failed. Expected type mismatch for %v, actual=%v", key, v)
+		} else if _, ok := err.(*TypeMismatchError); !ok {
+			t.Errorf("TestGetInIntOutOfRange failed. Expected *TypeMismatchError for %v, actual=%v", key, err)
+		}
+	}
+	if v, err := GetInInt(m, "max"); err != nil || v != 1<<30 {
+		t.Errorf("TestGetInIntOutOfRange failed. Expected=%v, actual=%v, %v", 1<<30, v, err)
+	}
+
+	if maxInt == math.MaxInt32 {
+		m["int64"] = int64(math.MaxInt32) + 1
+		if _, err := GetInInt(m, "int64"); err == nil {
+			t.Errorf("TestGetInIntOutOfRange failed. Expected type mismatch for int64 out of range of int")
+		}
+	}
+}
+
+func TestAssocIn(t *testing.T) {
+	m := decodeJSON(t, `{"db": {"host": "localhost"}, "tags": ["a", "b"]}`)
+	original := decodeJSON(t, `{"db": {"host": "localhost"}, "tags": ["a", "b"]}`)
+
+	actual := AssocIn(m, []interface{}{"db", "port"}, 5432)
+	actual = AssocIn(actual, []interface{}{"tags", 0}, "x")
+	actual = AssocIn(actual, []interface{}{"tags", 2}, "c")
+	actual = AssocIn(actual, []interface{}{"cache", "redis", "host"}, "redis")
+
+	expected := map[string]interface{}{
+		"db":    map[string]interface{}{"host": "localhost", "port": 5432},
+		"tags":  []interface{}{"x", "b", "c"},
+		"cache": map[string]interface{}{"redis": map[string]interface{}{"host": "redis"}},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAssocIn failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !reflect.DeepEqual(original, m) {
+		t.Errorf("TestAssocIn failed. Expected original map not to be modified, actual=%v", m)
+	}
+
+	for _, path := range [][]interface{}{{"tags", 5}, {"db", 0}, {"db", "host", "x"}, {1.5}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("TestAssocIn failed. Expected panic for path %v", path)
+				}
+			}()
+			AssocIn(m, path, 1)
+		}()
+	}
+}
+
+func TestAssocInE(t *testing.T) {
+	m := decodeJSON(t, `{"db": {"host": "localhost"}, "tags": ["a", "b"]}`)
+
+	actual, err := AssocInE(m, []interface{}{"db", "port"}, 5432)
+	if err != nil || !reflect.DeepEqual(5432, actual["db"].(map[string]interface{})["port"]) {
+		t.Errorf("TestAssocInE failed. Expected port=5432, actual=%v, %v", actual, err)
+	}
+
+	for _, path := range [][]interface{}{{"tags", 5}, {"db", 0}, {"db", "host", "x"}, {1.5}} {
+		actual, err := AssocInE(m, path, 1)
+		if _, ok := err.(*PathError); !ok || actual != nil {
+			t.Errorf("TestAssocInE failed. Expected *PathError for path %v, actual=%v, %v", path, actual, err)
+		}
+	}
+	if _, err := AssocInE(m, []interface{}{"db", "host", "x"}, 1); err == nil || err.Error() != "path [db host]: value is string, not map[string]interface{}" {
+		t.Errorf("TestAssocInE failed. Unexpected error %v", err)
+	}
+}
+
+func TestUpdateInE(t *testing.T) {
+	m := map[string]interface{}{"counter": 1}
+	called := false
+	inc := func(v interface{}) interface{} {
+		called = true
+		return v.(int) + 1
+	}
+
+	actual, err := UpdateInE(m, []interface{}{"counter"}, inc)
+	if err != nil || actual["counter"] != 2 || m["counter"] != 1 {
+		t.Errorf("TestUpdateInE failed. Expected counter=2, actual=%v, %v", actual, err)
+	}
+
+	called = false
+	if _, err := UpdateInE(m, []interface{}{"counter", "hits"}, inc); err == nil || called {
+		t.Errorf("TestUpdateInE failed. Expected *PathError without calling the function, actual=%v, called=%v", err, called)
+	}
+}
+
+func TestUpdateIn(t *testing.T) {
+	m := map[string]interface{}{"counter": map[string]interface{}{"hits": 1}}
+	inc := func(v interface{}) interface{} {
+		if v == nil {
+			return 1
+		}
+		return v.(int) + 1
+	}
+
+	actual := UpdateIn(m, []interface{}{"counter", "hits"}, inc)
+	actual = UpdateIn(actual, []interface{}{"counter", "misses"}, inc)
+	expected := map[string]interface{}{"counter": map[string]interface{}{"hits": 2, "misses": 1}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestUpdateIn failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if m["counter"].(map[string]interface{})["hits"] != 1 {
+		t.Errorf("TestUpdateIn failed. Expected original map not to be modified")
+	}
+
+	if !reflect.DeepEqual(UpdateIn(m, []interface{}{"counter"}, nil), m) {
+		t.Errorf("TestUpdateIn failed. Expected same map for nil function")
+	}
+}
+
+func TestDissocIn(t *testing.T) {
+	m := decodeJSON(t, `{"db": {"host": "localhost", "port": 5432}, "tags": ["a", "b", "c"]}`)
+	original := decodeJSON(t, `{"db": {"host": "localhost", "port": 5432}, "tags": ["a", "b", "c"]}`)
+
+	actual := DissocIn(m, "db", "port")
+	actual = DissocIn(actual, "tags", 1)
+	actual = DissocIn(actual, "missing", "key")
+	expected := map[string]interface{}{
+		"db":   map[string]interface{}{"host": "localhost"},
+		"tags": []interface{}{"a", "c"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocIn failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]interface{}{"tags": []interface{}{"a", "b", "c"}}
+	actual = DissocIn(m, "db")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocIn failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !reflect.DeepEqual(original, m) {
+		t.Errorf("TestDissocIn failed. Expected original map not to be modified, actual=%v", m)
+	}
+}
@@ -0,0 +1,105 @@
+package fp
+
+// InSetInt returns the predicate which checks if the item exists in the set.
+// Works with set.Int and set.IntSync of package "github.com/logic-building/functional-go/set".
+func InSetInt(s interface{ Contains(int) bool }) func(int) bool {
+	return func(item int) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetInt64 returns the predicate which checks if the item exists in the set.
+// Works with set.Int64 and set.Int64Sync of package "github.com/logic-building/functional-go/set".
+func InSetInt64(s interface{ Contains(int64) bool }) func(int64) bool {
+	return func(item int64) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetInt32 returns the predicate which checks if the item exists in the set.
+// Works with set.Int32 and set.Int32Sync of package "github.com/logic-building/functional-go/set".
+func InSetInt32(s interface{ Contains(int32) bool }) func(int32) bool {
+	return func(item int32) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetInt16 returns the predicate which checks if the item exists in the set.
+// Works with set.Int16 and set.Int16Sync of package "github.com/logic-building/functional-go/set".
+func InSetInt16(s interface{ Contains(int16) bool }) func(int16) bool {
+	return func(item int16) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetInt8 returns the predicate which checks if the item exists in the set.
+// Works with set.Int8 and set.Int8Sync of package "github.com/logic-building/functional-go/set".
+func InSetInt8(s interface{ Contains(int8) bool }) func(int8) bool {
+	return func(item int8) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetUint returns the predicate which checks if the item exists in the set.
+// Works with set.Uint and set.UintSync of package "github.com/logic-building/functional-go/set".
+func InSetUint(s interface{ Contains(uint) bool }) func(uint) bool {
+	return func(item uint) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetUint64 returns the predicate which checks if the item exists in the set.
+// Works with set.Uint64 and set.Uint64Sync of package "github.com/logic-building/functional-go/set".
+func InSetUint64(s interface{ Contains(uint64) bool }) func(uint64) bool {
+	return func(item uint64) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetUint32 returns the predicate which checks if the item exists in the set.
+// Works with set.Uint32 and set.Uint32Sync of package "github.com/logic-building/functional-go/set".
+func InSetUint32(s interface{ Contains(uint32) bool }) func(uint32) bool {
+	return func(item uint32) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetUint16 returns the predicate which checks if the item exists in the set.
+// Works with set.Uint16 and set.Uint16Sync of package "github.com/logic-building/functional-go/set".
+func InSetUint16(s interface{ Contains(uint16) bool }) func(uint16) bool {
+	return func(item uint16) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetUint8 returns the predicate which checks if the item exists in the set.
+// Works with set.Uint8 and set.Uint8Sync of package "github.com/logic-building/functional-go/set".
+func InSetUint8(s interface{ Contains(uint8) bool }) func(uint8) bool {
+	return func(item uint8) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetFloat64 returns the predicate which checks if the item exists in the set.
+// Works with set.Float64 and set.Float64Sync of package "github.com/logic-building/functional-go/set".
+func InSetFloat64(s interface{ Contains(float64) bool }) func(float64) bool {
+	return func(item float64) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetFloat32 returns the predicate which checks if the item exists in the set.
+// Works with set.Float32 and set.Float32Sync of package "github.com/logic-building/functional-go/set".
+func InSetFloat32(s interface{ Contains(float32) bool }) func(float32) bool {
+	return func(item float32) bool {
+		return s.Contains(item)
+	}
+}
+
+// InSetStr returns the predicate which checks if the item exists in the set.
+// Works with set.Str and set.StrSync of package "github.com/logic-building/functional-go/set".
+func InSetStr(s interface{ Contains(string) bool }) func(string) bool {
+	return func(item string) bool {
+		return s.Contains(item)
+	}
+}
@@ -0,0 +1,184 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/logic-building/functional-go/set"
+)
+
+func TestInSetInt(t *testing.T) {
+	expected := []int{2, 4}
+	actual := FilterInt(InSetInt(set.NewInt([]int{2, 4, 6})), []int{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{1, 3, 5}
+	actual = RemoveInt(InSetInt(set.NewIntSync([]int{2, 4, 6})), []int{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetInt64(t *testing.T) {
+	expected := []int64{2, 4}
+	actual := FilterInt64(InSetInt64(set.NewInt64([]int64{2, 4, 6})), []int64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{1, 3, 5}
+	actual = RemoveInt64(InSetInt64(set.NewInt64Sync([]int64{2, 4, 6})), []int64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetInt32(t *testing.T) {
+	expected := []int32{2, 4}
+	actual := FilterInt32(InSetInt32(set.NewInt32([]int32{2, 4, 6})), []int32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{1, 3, 5}
+	actual = RemoveInt32(InSetInt32(set.NewInt32Sync([]int32{2, 4, 6})), []int32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetInt16(t *testing.T) {
+	expected := []int16{2, 4}
+	actual := FilterInt16(InSetInt16(set.NewInt16([]int16{2, 4, 6})), []int16{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{1, 3, 5}
+	actual = RemoveInt16(InSetInt16(set.NewInt16Sync([]int16{2, 4, 6})), []int16{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetInt8(t *testing.T) {
+	expected := []int8{2, 4}
+	actual := FilterInt8(InSetInt8(set.NewInt8([]int8{2, 4, 6})), []int8{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{1, 3, 5}
+	actual = RemoveInt8(InSetInt8(set.NewInt8Sync([]int8{2, 4, 6})), []int8{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetUint(t *testing.T) {
+	expected := []uint{2, 4}
+	actual := FilterUint(InSetUint(set.NewUint([]uint{2, 4, 6})), []uint{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{1, 3, 5}
+	actual = RemoveUint(InSetUint(set.NewUintSync([]uint{2, 4, 6})), []uint{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetUint64(t *testing.T) {
+	expected := []uint64{2, 4}
+	actual := FilterUint64(InSetUint64(set.NewUint64([]uint64{2, 4, 6})), []uint64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{1, 3, 5}
+	actual = RemoveUint64(InSetUint64(set.NewUint64Sync([]uint64{2, 4, 6})), []uint64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetUint32(t *testing.T) {
+	expected := []uint32{2, 4}
+	actual := FilterUint32(InSetUint32(set.NewUint32([]uint32{2, 4, 6})), []uint32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{1, 3, 5}
+	actual = RemoveUint32(InSetUint32(set.NewUint32Sync([]uint32{2, 4, 6})), []uint32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetUint16(t *testing.T) {
+	expected := []uint16{2, 4}
+	actual := FilterUint16(InSetUint16(set.NewUint16([]uint16{2, 4, 6})), []uint16{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{1, 3, 5}
+	actual = RemoveUint16(InSetUint16(set.NewUint16Sync([]uint16{2, 4, 6})), []uint16{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetUint8(t *testing.T) {
+	expected := []uint8{2, 4}
+	actual := FilterUint8(InSetUint8(set.NewUint8([]uint8{2, 4, 6})), []uint8{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{1, 3, 5}
+	actual = RemoveUint8(InSetUint8(set.NewUint8Sync([]uint8{2, 4, 6})), []uint8{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetFloat64(t *testing.T) {
+	expected := []float64{2, 4}
+	actual := FilterFloat64(InSetFloat64(set.NewFloat64([]float64{2, 4, 6})), []float64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{1, 3, 5}
+	actual = RemoveFloat64(InSetFloat64(set.NewFloat64Sync([]float64{2, 4, 6})), []float64{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetFloat32(t *testing.T) {
+	expected := []float32{2, 4}
+	actual := FilterFloat32(InSetFloat32(set.NewFloat32([]float32{2, 4, 6})), []float32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{1, 3, 5}
+	actual = RemoveFloat32(InSetFloat32(set.NewFloat32Sync([]float32{2, 4, 6})), []float32{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestInSetStr(t *testing.T) {
+	expected := []string{"b", "d"}
+	actual := FilterStr(InSetStr(set.NewStr([]string{"b", "d", "f"})), []string{"a", "b", "c", "d"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSetStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,1236 @@
+package fp
+
+// MapIntInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]int, 0, 1024)
+//	for ... {
+//		buf = MapIntInto(buf, f, list)
+//	}
+func MapIntInto(dst []int, f func(int) int, list []int) []int {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]int, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterIntInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterIntInto(dst []int, f func(int) bool, list []int) []int {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropIntInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropIntInto(dst []int, item int, list []int) []int {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapIntInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapIntInPlace(f func(int) int, list []int) []int {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterIntInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterIntInPlace(f, list)
+func FilterIntInPlace(f func(int) bool, list []int) []int {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero int
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapInt64Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]int64, 0, 1024)
+//	for ... {
+//		buf = MapInt64Into(buf, f, list)
+//	}
+func MapInt64Into(dst []int64, f func(int64) int64, list []int64) []int64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]int64, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterInt64Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterInt64Into(dst []int64, f func(int64) bool, list []int64) []int64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropInt64Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropInt64Into(dst []int64, item int64, list []int64) []int64 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapInt64InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapInt64InPlace(f func(int64) int64, list []int64) []int64 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterInt64InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterInt64InPlace(f, list)
+func FilterInt64InPlace(f func(int64) bool, list []int64) []int64 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero int64
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapInt32Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]int32, 0, 1024)
+//	for ... {
+//		buf = MapInt32Into(buf, f, list)
+//	}
+func MapInt32Into(dst []int32, f func(int32) int32, list []int32) []int32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]int32, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterInt32Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterInt32Into(dst []int32, f func(int32) bool, list []int32) []int32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropInt32Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropInt32Into(dst []int32, item int32, list []int32) []int32 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapInt32InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapInt32InPlace(f func(int32) int32, list []int32) []int32 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterInt32InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterInt32InPlace(f, list)
+func FilterInt32InPlace(f func(int32) bool, list []int32) []int32 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero int32
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapInt16Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]int16, 0, 1024)
+//	for ... {
+//		buf = MapInt16Into(buf, f, list)
+//	}
+func MapInt16Into(dst []int16, f func(int16) int16, list []int16) []int16 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]int16, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterInt16Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterInt16Into(dst []int16, f func(int16) bool, list []int16) []int16 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropInt16Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropInt16Into(dst []int16, item int16, list []int16) []int16 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapInt16InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapInt16InPlace(f func(int16) int16, list []int16) []int16 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterInt16InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterInt16InPlace(f, list)
+func FilterInt16InPlace(f func(int16) bool, list []int16) []int16 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero int16
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapInt8Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]int8, 0, 1024)
+//	for ... {
+//		buf = MapInt8Into(buf, f, list)
+//	}
+func MapInt8Into(dst []int8, f func(int8) int8, list []int8) []int8 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]int8, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterInt8Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterInt8Into(dst []int8, f func(int8) bool, list []int8) []int8 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropInt8Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropInt8Into(dst []int8, item int8, list []int8) []int8 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapInt8InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapInt8InPlace(f func(int8) int8, list []int8) []int8 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterInt8InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterInt8InPlace(f, list)
+func FilterInt8InPlace(f func(int8) bool, list []int8) []int8 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero int8
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapUintInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]uint, 0, 1024)
+//	for ... {
+//		buf = MapUintInto(buf, f, list)
+//	}
+func MapUintInto(dst []uint, f func(uint) uint, list []uint) []uint {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]uint, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterUintInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterUintInto(dst []uint, f func(uint) bool, list []uint) []uint {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropUintInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropUintInto(dst []uint, item uint, list []uint) []uint {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapUintInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapUintInPlace(f func(uint) uint, list []uint) []uint {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterUintInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterUintInPlace(f, list)
+func FilterUintInPlace(f func(uint) bool, list []uint) []uint {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero uint
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapUint64Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]uint64, 0, 1024)
+//	for ... {
+//		buf = MapUint64Into(buf, f, list)
+//	}
+func MapUint64Into(dst []uint64, f func(uint64) uint64, list []uint64) []uint64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]uint64, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterUint64Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterUint64Into(dst []uint64, f func(uint64) bool, list []uint64) []uint64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropUint64Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropUint64Into(dst []uint64, item uint64, list []uint64) []uint64 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapUint64InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapUint64InPlace(f func(uint64) uint64, list []uint64) []uint64 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterUint64InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterUint64InPlace(f, list)
+func FilterUint64InPlace(f func(uint64) bool, list []uint64) []uint64 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero uint64
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapUint32Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]uint32, 0, 1024)
+//	for ... {
+//		buf = MapUint32Into(buf, f, list)
+//	}
+func MapUint32Into(dst []uint32, f func(uint32) uint32, list []uint32) []uint32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]uint32, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterUint32Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterUint32Into(dst []uint32, f func(uint32) bool, list []uint32) []uint32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropUint32Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropUint32Into(dst []uint32, item uint32, list []uint32) []uint32 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapUint32InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapUint32InPlace(f func(uint32) uint32, list []uint32) []uint32 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterUint32InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterUint32InPlace(f, list)
+func FilterUint32InPlace(f func(uint32) bool, list []uint32) []uint32 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero uint32
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapUint16Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]uint16, 0, 1024)
+//	for ... {
+//		buf = MapUint16Into(buf, f, list)
+//	}
+func MapUint16Into(dst []uint16, f func(uint16) uint16, list []uint16) []uint16 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]uint16, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterUint16Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterUint16Into(dst []uint16, f func(uint16) bool, list []uint16) []uint16 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropUint16Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropUint16Into(dst []uint16, item uint16, list []uint16) []uint16 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapUint16InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapUint16InPlace(f func(uint16) uint16, list []uint16) []uint16 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterUint16InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterUint16InPlace(f, list)
+func FilterUint16InPlace(f func(uint16) bool, list []uint16) []uint16 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero uint16
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapUint8Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]uint8, 0, 1024)
+//	for ... {
+//		buf = MapUint8Into(buf, f, list)
+//	}
+func MapUint8Into(dst []uint8, f func(uint8) uint8, list []uint8) []uint8 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]uint8, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterUint8Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterUint8Into(dst []uint8, f func(uint8) bool, list []uint8) []uint8 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropUint8Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropUint8Into(dst []uint8, item uint8, list []uint8) []uint8 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapUint8InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapUint8InPlace(f func(uint8) uint8, list []uint8) []uint8 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterUint8InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterUint8InPlace(f, list)
+func FilterUint8InPlace(f func(uint8) bool, list []uint8) []uint8 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero uint8
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapFloat64Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]float64, 0, 1024)
+//	for ... {
+//		buf = MapFloat64Into(buf, f, list)
+//	}
+func MapFloat64Into(dst []float64, f func(float64) float64, list []float64) []float64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]float64, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterFloat64Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterFloat64Into(dst []float64, f func(float64) bool, list []float64) []float64 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropFloat64Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropFloat64Into(dst []float64, item float64, list []float64) []float64 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapFloat64InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapFloat64InPlace(f func(float64) float64, list []float64) []float64 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterFloat64InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterFloat64InPlace(f, list)
+func FilterFloat64InPlace(f func(float64) bool, list []float64) []float64 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero float64
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapFloat32Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]float32, 0, 1024)
+//	for ... {
+//		buf = MapFloat32Into(buf, f, list)
+//	}
+func MapFloat32Into(dst []float32, f func(float32) float32, list []float32) []float32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]float32, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterFloat32Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterFloat32Into(dst []float32, f func(float32) bool, list []float32) []float32 {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropFloat32Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropFloat32Into(dst []float32, item float32, list []float32) []float32 {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapFloat32InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapFloat32InPlace(f func(float32) float32, list []float32) []float32 {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterFloat32InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterFloat32InPlace(f, list)
+func FilterFloat32InPlace(f func(float32) bool, list []float32) []float32 {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero float32
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+// MapStrInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]string, 0, 1024)
+//	for ... {
+//		buf = MapStrInto(buf, f, list)
+//	}
+func MapStrInto(dst []string, f func(string) string, list []string) []string {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]string, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterStrInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterStrInto(dst []string, f func(string) bool, list []string) []string {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropStrInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropStrInto(dst []string, item string, list []string) []string {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapStrInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapStrInPlace(f func(string) string, list []string) []string {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterStrInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterStrInPlace(f, list)
+func FilterStrInPlace(f func(string) bool, list []string) []string {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero string
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
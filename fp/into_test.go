@@ -0,0 +1,790 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapIntInto(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	list := []int{1, 2, 3}
+
+	dst := make([]int, 5, 10)
+	result := MapIntInto(dst, double, list)
+	if !reflect.DeepEqual([]int{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapIntInto failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapIntInto(nil, double, list); !reflect.DeepEqual([]int{2, 4, 6}, result) {
+		t.Errorf("TestMapIntInto failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapIntInto(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapIntInto failed. Expected=[], actual=%v", result)
+	}
+	if result := MapIntInto(list, double, list); !reflect.DeepEqual([]int{2, 4, 6}, result) {
+		t.Errorf("TestMapIntInto failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapIntInto(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapIntInto failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterIntInto(t *testing.T) {
+	large := func(v int) bool { return v > 1 }
+	list := []int{1, 2, 3, 1}
+
+	dst := make([]int, 0, 4)
+	if result := FilterIntInto(dst, large, list); !reflect.DeepEqual([]int{2, 3}, result) {
+		t.Errorf("TestFilterIntInto failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropIntInto(dst, 1, list); !reflect.DeepEqual([]int{2, 3}, result) {
+		t.Errorf("TestDropIntInto failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterIntInto(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterIntInto failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterIntInto(dst, large, list)
+		dst = DropIntInto(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterIntInto failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterIntInPlace(t *testing.T) {
+	list := []int{1, 2, 3, 1, 4}
+	result := FilterIntInPlace(func(v int) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]int{2, 3, 4}, result) || !reflect.DeepEqual([]int{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterIntInPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapIntInPlace(func(v int) int { return v + 1 }, result); !reflect.DeepEqual([]int{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapIntInPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterIntInPlace(nil, list) == nil || len(FilterIntInPlace(nil, list)) != 0 || len(MapIntInPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterIntInPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapInt64Into(t *testing.T) {
+	double := func(v int64) int64 { return v * 2 }
+	list := []int64{1, 2, 3}
+
+	dst := make([]int64, 5, 10)
+	result := MapInt64Into(dst, double, list)
+	if !reflect.DeepEqual([]int64{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapInt64Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapInt64Into(nil, double, list); !reflect.DeepEqual([]int64{2, 4, 6}, result) {
+		t.Errorf("TestMapInt64Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapInt64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapInt64Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapInt64Into(list, double, list); !reflect.DeepEqual([]int64{2, 4, 6}, result) {
+		t.Errorf("TestMapInt64Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapInt64Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapInt64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt64Into(t *testing.T) {
+	large := func(v int64) bool { return v > 1 }
+	list := []int64{1, 2, 3, 1}
+
+	dst := make([]int64, 0, 4)
+	if result := FilterInt64Into(dst, large, list); !reflect.DeepEqual([]int64{2, 3}, result) {
+		t.Errorf("TestFilterInt64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropInt64Into(dst, 1, list); !reflect.DeepEqual([]int64{2, 3}, result) {
+		t.Errorf("TestDropInt64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterInt64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterInt64Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterInt64Into(dst, large, list)
+		dst = DropInt64Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterInt64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt64InPlace(t *testing.T) {
+	list := []int64{1, 2, 3, 1, 4}
+	result := FilterInt64InPlace(func(v int64) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]int64{2, 3, 4}, result) || !reflect.DeepEqual([]int64{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterInt64InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapInt64InPlace(func(v int64) int64 { return v + 1 }, result); !reflect.DeepEqual([]int64{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapInt64InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterInt64InPlace(nil, list) == nil || len(FilterInt64InPlace(nil, list)) != 0 || len(MapInt64InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterInt64InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapInt32Into(t *testing.T) {
+	double := func(v int32) int32 { return v * 2 }
+	list := []int32{1, 2, 3}
+
+	dst := make([]int32, 5, 10)
+	result := MapInt32Into(dst, double, list)
+	if !reflect.DeepEqual([]int32{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapInt32Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapInt32Into(nil, double, list); !reflect.DeepEqual([]int32{2, 4, 6}, result) {
+		t.Errorf("TestMapInt32Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapInt32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapInt32Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapInt32Into(list, double, list); !reflect.DeepEqual([]int32{2, 4, 6}, result) {
+		t.Errorf("TestMapInt32Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapInt32Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapInt32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt32Into(t *testing.T) {
+	large := func(v int32) bool { return v > 1 }
+	list := []int32{1, 2, 3, 1}
+
+	dst := make([]int32, 0, 4)
+	if result := FilterInt32Into(dst, large, list); !reflect.DeepEqual([]int32{2, 3}, result) {
+		t.Errorf("TestFilterInt32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropInt32Into(dst, 1, list); !reflect.DeepEqual([]int32{2, 3}, result) {
+		t.Errorf("TestDropInt32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterInt32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterInt32Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterInt32Into(dst, large, list)
+		dst = DropInt32Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterInt32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt32InPlace(t *testing.T) {
+	list := []int32{1, 2, 3, 1, 4}
+	result := FilterInt32InPlace(func(v int32) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]int32{2, 3, 4}, result) || !reflect.DeepEqual([]int32{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterInt32InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapInt32InPlace(func(v int32) int32 { return v + 1 }, result); !reflect.DeepEqual([]int32{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapInt32InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterInt32InPlace(nil, list) == nil || len(FilterInt32InPlace(nil, list)) != 0 || len(MapInt32InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterInt32InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapInt16Into(t *testing.T) {
+	double := func(v int16) int16 { return v * 2 }
+	list := []int16{1, 2, 3}
+
+	dst := make([]int16, 5, 10)
+	result := MapInt16Into(dst, double, list)
+	if !reflect.DeepEqual([]int16{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapInt16Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapInt16Into(nil, double, list); !reflect.DeepEqual([]int16{2, 4, 6}, result) {
+		t.Errorf("TestMapInt16Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapInt16Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapInt16Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapInt16Into(list, double, list); !reflect.DeepEqual([]int16{2, 4, 6}, result) {
+		t.Errorf("TestMapInt16Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapInt16Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapInt16Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt16Into(t *testing.T) {
+	large := func(v int16) bool { return v > 1 }
+	list := []int16{1, 2, 3, 1}
+
+	dst := make([]int16, 0, 4)
+	if result := FilterInt16Into(dst, large, list); !reflect.DeepEqual([]int16{2, 3}, result) {
+		t.Errorf("TestFilterInt16Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropInt16Into(dst, 1, list); !reflect.DeepEqual([]int16{2, 3}, result) {
+		t.Errorf("TestDropInt16Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterInt16Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterInt16Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterInt16Into(dst, large, list)
+		dst = DropInt16Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterInt16Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt16InPlace(t *testing.T) {
+	list := []int16{1, 2, 3, 1, 4}
+	result := FilterInt16InPlace(func(v int16) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]int16{2, 3, 4}, result) || !reflect.DeepEqual([]int16{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterInt16InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapInt16InPlace(func(v int16) int16 { return v + 1 }, result); !reflect.DeepEqual([]int16{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapInt16InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterInt16InPlace(nil, list) == nil || len(FilterInt16InPlace(nil, list)) != 0 || len(MapInt16InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterInt16InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapInt8Into(t *testing.T) {
+	double := func(v int8) int8 { return v * 2 }
+	list := []int8{1, 2, 3}
+
+	dst := make([]int8, 5, 10)
+	result := MapInt8Into(dst, double, list)
+	if !reflect.DeepEqual([]int8{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapInt8Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapInt8Into(nil, double, list); !reflect.DeepEqual([]int8{2, 4, 6}, result) {
+		t.Errorf("TestMapInt8Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapInt8Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapInt8Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapInt8Into(list, double, list); !reflect.DeepEqual([]int8{2, 4, 6}, result) {
+		t.Errorf("TestMapInt8Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapInt8Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapInt8Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt8Into(t *testing.T) {
+	large := func(v int8) bool { return v > 1 }
+	list := []int8{1, 2, 3, 1}
+
+	dst := make([]int8, 0, 4)
+	if result := FilterInt8Into(dst, large, list); !reflect.DeepEqual([]int8{2, 3}, result) {
+		t.Errorf("TestFilterInt8Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropInt8Into(dst, 1, list); !reflect.DeepEqual([]int8{2, 3}, result) {
+		t.Errorf("TestDropInt8Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterInt8Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterInt8Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterInt8Into(dst, large, list)
+		dst = DropInt8Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterInt8Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterInt8InPlace(t *testing.T) {
+	list := []int8{1, 2, 3, 1, 4}
+	result := FilterInt8InPlace(func(v int8) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]int8{2, 3, 4}, result) || !reflect.DeepEqual([]int8{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterInt8InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapInt8InPlace(func(v int8) int8 { return v + 1 }, result); !reflect.DeepEqual([]int8{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapInt8InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterInt8InPlace(nil, list) == nil || len(FilterInt8InPlace(nil, list)) != 0 || len(MapInt8InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterInt8InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapUintInto(t *testing.T) {
+	double := func(v uint) uint { return v * 2 }
+	list := []uint{1, 2, 3}
+
+	dst := make([]uint, 5, 10)
+	result := MapUintInto(dst, double, list)
+	if !reflect.DeepEqual([]uint{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapUintInto failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapUintInto(nil, double, list); !reflect.DeepEqual([]uint{2, 4, 6}, result) {
+		t.Errorf("TestMapUintInto failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapUintInto(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapUintInto failed. Expected=[], actual=%v", result)
+	}
+	if result := MapUintInto(list, double, list); !reflect.DeepEqual([]uint{2, 4, 6}, result) {
+		t.Errorf("TestMapUintInto failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapUintInto(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapUintInto failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUintInto(t *testing.T) {
+	large := func(v uint) bool { return v > 1 }
+	list := []uint{1, 2, 3, 1}
+
+	dst := make([]uint, 0, 4)
+	if result := FilterUintInto(dst, large, list); !reflect.DeepEqual([]uint{2, 3}, result) {
+		t.Errorf("TestFilterUintInto failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropUintInto(dst, 1, list); !reflect.DeepEqual([]uint{2, 3}, result) {
+		t.Errorf("TestDropUintInto failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterUintInto(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterUintInto failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterUintInto(dst, large, list)
+		dst = DropUintInto(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterUintInto failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUintInPlace(t *testing.T) {
+	list := []uint{1, 2, 3, 1, 4}
+	result := FilterUintInPlace(func(v uint) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]uint{2, 3, 4}, result) || !reflect.DeepEqual([]uint{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterUintInPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapUintInPlace(func(v uint) uint { return v + 1 }, result); !reflect.DeepEqual([]uint{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapUintInPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterUintInPlace(nil, list) == nil || len(FilterUintInPlace(nil, list)) != 0 || len(MapUintInPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterUintInPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapUint64Into(t *testing.T) {
+	double := func(v uint64) uint64 { return v * 2 }
+	list := []uint64{1, 2, 3}
+
+	dst := make([]uint64, 5, 10)
+	result := MapUint64Into(dst, double, list)
+	if !reflect.DeepEqual([]uint64{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapUint64Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapUint64Into(nil, double, list); !reflect.DeepEqual([]uint64{2, 4, 6}, result) {
+		t.Errorf("TestMapUint64Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapUint64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapUint64Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapUint64Into(list, double, list); !reflect.DeepEqual([]uint64{2, 4, 6}, result) {
+		t.Errorf("TestMapUint64Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapUint64Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapUint64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint64Into(t *testing.T) {
+	large := func(v uint64) bool { return v > 1 }
+	list := []uint64{1, 2, 3, 1}
+
+	dst := make([]uint64, 0, 4)
+	if result := FilterUint64Into(dst, large, list); !reflect.DeepEqual([]uint64{2, 3}, result) {
+		t.Errorf("TestFilterUint64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropUint64Into(dst, 1, list); !reflect.DeepEqual([]uint64{2, 3}, result) {
+		t.Errorf("TestDropUint64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterUint64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterUint64Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterUint64Into(dst, large, list)
+		dst = DropUint64Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterUint64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint64InPlace(t *testing.T) {
+	list := []uint64{1, 2, 3, 1, 4}
+	result := FilterUint64InPlace(func(v uint64) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]uint64{2, 3, 4}, result) || !reflect.DeepEqual([]uint64{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterUint64InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapUint64InPlace(func(v uint64) uint64 { return v + 1 }, result); !reflect.DeepEqual([]uint64{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapUint64InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterUint64InPlace(nil, list) == nil || len(FilterUint64InPlace(nil, list)) != 0 || len(MapUint64InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterUint64InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapUint32Into(t *testing.T) {
+	double := func(v uint32) uint32 { return v * 2 }
+	list := []uint32{1, 2, 3}
+
+	dst := make([]uint32, 5, 10)
+	result := MapUint32Into(dst, double, list)
+	if !reflect.DeepEqual([]uint32{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapUint32Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapUint32Into(nil, double, list); !reflect.DeepEqual([]uint32{2, 4, 6}, result) {
+		t.Errorf("TestMapUint32Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapUint32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapUint32Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapUint32Into(list, double, list); !reflect.DeepEqual([]uint32{2, 4, 6}, result) {
+		t.Errorf("TestMapUint32Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapUint32Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapUint32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint32Into(t *testing.T) {
+	large := func(v uint32) bool { return v > 1 }
+	list := []uint32{1, 2, 3, 1}
+
+	dst := make([]uint32, 0, 4)
+	if result := FilterUint32Into(dst, large, list); !reflect.DeepEqual([]uint32{2, 3}, result) {
+		t.Errorf("TestFilterUint32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropUint32Into(dst, 1, list); !reflect.DeepEqual([]uint32{2, 3}, result) {
+		t.Errorf("TestDropUint32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterUint32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterUint32Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterUint32Into(dst, large, list)
+		dst = DropUint32Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterUint32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint32InPlace(t *testing.T) {
+	list := []uint32{1, 2, 3, 1, 4}
+	result := FilterUint32InPlace(func(v uint32) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]uint32{2, 3, 4}, result) || !reflect.DeepEqual([]uint32{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterUint32InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapUint32InPlace(func(v uint32) uint32 { return v + 1 }, result); !reflect.DeepEqual([]uint32{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapUint32InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterUint32InPlace(nil, list) == nil || len(FilterUint32InPlace(nil, list)) != 0 || len(MapUint32InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterUint32InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapUint16Into(t *testing.T) {
+	double := func(v uint16) uint16 { return v * 2 }
+	list := []uint16{1, 2, 3}
+
+	dst := make([]uint16, 5, 10)
+	result := MapUint16Into(dst, double, list)
+	if !reflect.DeepEqual([]uint16{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapUint16Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapUint16Into(nil, double, list); !reflect.DeepEqual([]uint16{2, 4, 6}, result) {
+		t.Errorf("TestMapUint16Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapUint16Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapUint16Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapUint16Into(list, double, list); !reflect.DeepEqual([]uint16{2, 4, 6}, result) {
+		t.Errorf("TestMapUint16Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapUint16Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapUint16Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint16Into(t *testing.T) {
+	large := func(v uint16) bool { return v > 1 }
+	list := []uint16{1, 2, 3, 1}
+
+	dst := make([]uint16, 0, 4)
+	if result := FilterUint16Into(dst, large, list); !reflect.DeepEqual([]uint16{2, 3}, result) {
+		t.Errorf("TestFilterUint16Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropUint16Into(dst, 1, list); !reflect.DeepEqual([]uint16{2, 3}, result) {
+		t.Errorf("TestDropUint16Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterUint16Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterUint16Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterUint16Into(dst, large, list)
+		dst = DropUint16Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterUint16Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint16InPlace(t *testing.T) {
+	list := []uint16{1, 2, 3, 1, 4}
+	result := FilterUint16InPlace(func(v uint16) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]uint16{2, 3, 4}, result) || !reflect.DeepEqual([]uint16{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterUint16InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapUint16InPlace(func(v uint16) uint16 { return v + 1 }, result); !reflect.DeepEqual([]uint16{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapUint16InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterUint16InPlace(nil, list) == nil || len(FilterUint16InPlace(nil, list)) != 0 || len(MapUint16InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterUint16InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapUint8Into(t *testing.T) {
+	double := func(v uint8) uint8 { return v * 2 }
+	list := []uint8{1, 2, 3}
+
+	dst := make([]uint8, 5, 10)
+	result := MapUint8Into(dst, double, list)
+	if !reflect.DeepEqual([]uint8{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapUint8Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapUint8Into(nil, double, list); !reflect.DeepEqual([]uint8{2, 4, 6}, result) {
+		t.Errorf("TestMapUint8Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapUint8Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapUint8Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapUint8Into(list, double, list); !reflect.DeepEqual([]uint8{2, 4, 6}, result) {
+		t.Errorf("TestMapUint8Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapUint8Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapUint8Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint8Into(t *testing.T) {
+	large := func(v uint8) bool { return v > 1 }
+	list := []uint8{1, 2, 3, 1}
+
+	dst := make([]uint8, 0, 4)
+	if result := FilterUint8Into(dst, large, list); !reflect.DeepEqual([]uint8{2, 3}, result) {
+		t.Errorf("TestFilterUint8Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropUint8Into(dst, 1, list); !reflect.DeepEqual([]uint8{2, 3}, result) {
+		t.Errorf("TestDropUint8Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterUint8Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterUint8Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterUint8Into(dst, large, list)
+		dst = DropUint8Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterUint8Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterUint8InPlace(t *testing.T) {
+	list := []uint8{1, 2, 3, 1, 4}
+	result := FilterUint8InPlace(func(v uint8) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]uint8{2, 3, 4}, result) || !reflect.DeepEqual([]uint8{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterUint8InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapUint8InPlace(func(v uint8) uint8 { return v + 1 }, result); !reflect.DeepEqual([]uint8{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapUint8InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterUint8InPlace(nil, list) == nil || len(FilterUint8InPlace(nil, list)) != 0 || len(MapUint8InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterUint8InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapFloat64Into(t *testing.T) {
+	double := func(v float64) float64 { return v * 2 }
+	list := []float64{1, 2, 3}
+
+	dst := make([]float64, 5, 10)
+	result := MapFloat64Into(dst, double, list)
+	if !reflect.DeepEqual([]float64{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapFloat64Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapFloat64Into(nil, double, list); !reflect.DeepEqual([]float64{2, 4, 6}, result) {
+		t.Errorf("TestMapFloat64Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapFloat64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapFloat64Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapFloat64Into(list, double, list); !reflect.DeepEqual([]float64{2, 4, 6}, result) {
+		t.Errorf("TestMapFloat64Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapFloat64Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapFloat64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterFloat64Into(t *testing.T) {
+	large := func(v float64) bool { return v > 1 }
+	list := []float64{1, 2, 3, 1}
+
+	dst := make([]float64, 0, 4)
+	if result := FilterFloat64Into(dst, large, list); !reflect.DeepEqual([]float64{2, 3}, result) {
+		t.Errorf("TestFilterFloat64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropFloat64Into(dst, 1, list); !reflect.DeepEqual([]float64{2, 3}, result) {
+		t.Errorf("TestDropFloat64Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterFloat64Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterFloat64Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterFloat64Into(dst, large, list)
+		dst = DropFloat64Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterFloat64Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterFloat64InPlace(t *testing.T) {
+	list := []float64{1, 2, 3, 1, 4}
+	result := FilterFloat64InPlace(func(v float64) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]float64{2, 3, 4}, result) || !reflect.DeepEqual([]float64{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterFloat64InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapFloat64InPlace(func(v float64) float64 { return v + 1 }, result); !reflect.DeepEqual([]float64{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapFloat64InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterFloat64InPlace(nil, list) == nil || len(FilterFloat64InPlace(nil, list)) != 0 || len(MapFloat64InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterFloat64InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapFloat32Into(t *testing.T) {
+	double := func(v float32) float32 { return v * 2 }
+	list := []float32{1, 2, 3}
+
+	dst := make([]float32, 5, 10)
+	result := MapFloat32Into(dst, double, list)
+	if !reflect.DeepEqual([]float32{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMapFloat32Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := MapFloat32Into(nil, double, list); !reflect.DeepEqual([]float32{2, 4, 6}, result) {
+		t.Errorf("TestMapFloat32Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := MapFloat32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMapFloat32Into failed. Expected=[], actual=%v", result)
+	}
+	if result := MapFloat32Into(list, double, list); !reflect.DeepEqual([]float32{2, 4, 6}, result) {
+		t.Errorf("TestMapFloat32Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = MapFloat32Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMapFloat32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterFloat32Into(t *testing.T) {
+	large := func(v float32) bool { return v > 1 }
+	list := []float32{1, 2, 3, 1}
+
+	dst := make([]float32, 0, 4)
+	if result := FilterFloat32Into(dst, large, list); !reflect.DeepEqual([]float32{2, 3}, result) {
+		t.Errorf("TestFilterFloat32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := DropFloat32Into(dst, 1, list); !reflect.DeepEqual([]float32{2, 3}, result) {
+		t.Errorf("TestDropFloat32Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := FilterFloat32Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilterFloat32Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = FilterFloat32Into(dst, large, list)
+		dst = DropFloat32Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilterFloat32Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilterFloat32InPlace(t *testing.T) {
+	list := []float32{1, 2, 3, 1, 4}
+	result := FilterFloat32InPlace(func(v float32) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]float32{2, 3, 4}, result) || !reflect.DeepEqual([]float32{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilterFloat32InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := MapFloat32InPlace(func(v float32) float32 { return v + 1 }, result); !reflect.DeepEqual([]float32{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMapFloat32InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if FilterFloat32InPlace(nil, list) == nil || len(FilterFloat32InPlace(nil, list)) != 0 || len(MapFloat32InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilterFloat32InPlace failed. Unexpected result for nil function")
+	}
+}
+
+func TestMapStrInto(t *testing.T) {
+	upper := func(v string) string { return v + "!" }
+	list := []string{"a", "b"}
+
+	dst := make([]string, 0, 2)
+	if result := MapStrInto(dst, upper, list); !reflect.DeepEqual([]string{"a!", "b!"}, result) {
+		t.Errorf("TestMapStrInto failed. Expected=[a! b!], actual=%v", result)
+	}
+	if result := FilterStrInto(dst, func(v string) bool { return v == "b" }, list); !reflect.DeepEqual([]string{"b"}, result) {
+		t.Errorf("TestFilterStrInto failed. Expected=[b], actual=%v", result)
+	}
+	if result := DropStrInto(dst, "b", list); !reflect.DeepEqual([]string{"a"}, result) {
+		t.Errorf("TestDropStrInto failed. Expected=[a], actual=%v", result)
+	}
+}
+
+func TestFilterStrInPlace(t *testing.T) {
+	list := []string{"a", "bb", "c"}
+	result := FilterStrInPlace(func(v string) bool { return len(v) == 1 }, list)
+	if !reflect.DeepEqual([]string{"a", "c"}, result) || list[2] != "" {
+		t.Errorf("TestFilterStrInPlace failed. Expected=[a c], actual=%v and list=%v", result, list)
+	}
+	MapStrInPlace(func(v string) string { return v + v }, result)
+	if !reflect.DeepEqual([]string{"aa", "cc", ""}, list) {
+		t.Errorf("TestMapStrInPlace failed. Expected=[aa cc ], actual=%v", list)
+	}
+}
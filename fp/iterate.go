@@ -0,0 +1,211 @@
+package fp
+
+// IterateInt returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateInt(f func(int) int, seed int, n int) []int {
+	if f == nil || n <= 0 {
+		return []int{}
+	}
+
+	newList := make([]int, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateInt64 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateInt64(f func(int64) int64, seed int64, n int) []int64 {
+	if f == nil || n <= 0 {
+		return []int64{}
+	}
+
+	newList := make([]int64, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateInt32 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateInt32(f func(int32) int32, seed int32, n int) []int32 {
+	if f == nil || n <= 0 {
+		return []int32{}
+	}
+
+	newList := make([]int32, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateInt16 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateInt16(f func(int16) int16, seed int16, n int) []int16 {
+	if f == nil || n <= 0 {
+		return []int16{}
+	}
+
+	newList := make([]int16, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateInt8 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateInt8(f func(int8) int8, seed int8, n int) []int8 {
+	if f == nil || n <= 0 {
+		return []int8{}
+	}
+
+	newList := make([]int8, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateUint returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateUint(f func(uint) uint, seed uint, n int) []uint {
+	if f == nil || n <= 0 {
+		return []uint{}
+	}
+
+	newList := make([]uint, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateUint64 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateUint64(f func(uint64) uint64, seed uint64, n int) []uint64 {
+	if f == nil || n <= 0 {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateUint32 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateUint32(f func(uint32) uint32, seed uint32, n int) []uint32 {
+	if f == nil || n <= 0 {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateUint16 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateUint16(f func(uint16) uint16, seed uint16, n int) []uint16 {
+	if f == nil || n <= 0 {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateUint8 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateUint8(f func(uint8) uint8, seed uint8, n int) []uint8 {
+	if f == nil || n <= 0 {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateFloat64 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateFloat64(f func(float64) float64, seed float64, n int) []float64 {
+	if f == nil || n <= 0 {
+		return []float64{}
+	}
+
+	newList := make([]float64, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateFloat32 returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateFloat32(f func(float32) float32, seed float32, n int) []float32 {
+	if f == nil || n <= 0 {
+		return []float32{}
+	}
+
+	newList := make([]float32, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateStr returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateStr(f func(string) string, seed string, n int) []string {
+	if f == nil || n <= 0 {
+		return []string{}
+	}
+
+	newList := make([]string, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+// IterateBool returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func IterateBool(f func(bool) bool, seed bool, n int) []bool {
+	if f == nil || n <= 0 {
+		return []bool{}
+	}
+
+	newList := make([]bool, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
@@ -0,0 +1,290 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIterateInt(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+
+	expectedList := []int{1, 2, 4, 8}
+	actualList := IterateInt(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int{}
+	actualList = IterateInt(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateInt(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateInt64(t *testing.T) {
+	double := func(v int64) int64 { return v * 2 }
+
+	expectedList := []int64{1, 2, 4, 8}
+	actualList := IterateInt64(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int64{}
+	actualList = IterateInt64(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateInt64(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateInt32(t *testing.T) {
+	double := func(v int32) int32 { return v * 2 }
+
+	expectedList := []int32{1, 2, 4, 8}
+	actualList := IterateInt32(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int32{}
+	actualList = IterateInt32(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateInt32(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateInt16(t *testing.T) {
+	double := func(v int16) int16 { return v * 2 }
+
+	expectedList := []int16{1, 2, 4, 8}
+	actualList := IterateInt16(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int16{}
+	actualList = IterateInt16(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateInt16(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateInt8(t *testing.T) {
+	double := func(v int8) int8 { return v * 2 }
+
+	expectedList := []int8{1, 2, 4, 8}
+	actualList := IterateInt8(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int8{}
+	actualList = IterateInt8(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateInt8(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateUint(t *testing.T) {
+	double := func(v uint) uint { return v * 2 }
+
+	expectedList := []uint{1, 2, 4, 8}
+	actualList := IterateUint(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint{}
+	actualList = IterateUint(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateUint(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateUint64(t *testing.T) {
+	double := func(v uint64) uint64 { return v * 2 }
+
+	expectedList := []uint64{1, 2, 4, 8}
+	actualList := IterateUint64(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint64{}
+	actualList = IterateUint64(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateUint64(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateUint32(t *testing.T) {
+	double := func(v uint32) uint32 { return v * 2 }
+
+	expectedList := []uint32{1, 2, 4, 8}
+	actualList := IterateUint32(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint32{}
+	actualList = IterateUint32(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateUint32(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateUint16(t *testing.T) {
+	double := func(v uint16) uint16 { return v * 2 }
+
+	expectedList := []uint16{1, 2, 4, 8}
+	actualList := IterateUint16(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint16{}
+	actualList = IterateUint16(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateUint16(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateUint8(t *testing.T) {
+	double := func(v uint8) uint8 { return v * 2 }
+
+	expectedList := []uint8{1, 2, 4, 8}
+	actualList := IterateUint8(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint8{}
+	actualList = IterateUint8(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateUint8(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateFloat64(t *testing.T) {
+	double := func(v float64) float64 { return v * 2 }
+
+	expectedList := []float64{1, 2, 4, 8}
+	actualList := IterateFloat64(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float64{}
+	actualList = IterateFloat64(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateFloat64(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateFloat32(t *testing.T) {
+	double := func(v float32) float32 { return v * 2 }
+
+	expectedList := []float32{1, 2, 4, 8}
+	actualList := IterateFloat32(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float32{}
+	actualList = IterateFloat32(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = IterateFloat32(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateStr(t *testing.T) {
+	appendA := func(v string) string { return v + "a" }
+
+	expectedList := []string{"", "a", "aa"}
+	actualList := IterateStr(appendA, "", 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []string{}
+	actualList = IterateStr(nil, "", 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestIterateBool(t *testing.T) {
+	not := func(v bool) bool { return !v }
+
+	expectedList := []bool{true, false, true}
+	actualList := IterateBool(not, true, 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []bool{}
+	actualList = IterateBool(nil, true, 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterateBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
@@ -0,0 +1,253 @@
+package fp
+
+// JuxtInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtInt(fs ...func(int) int) func(int) []int {
+	funcs := make([]func(int) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtInt64(fs ...func(int64) int64) func(int64) []int64 {
+	funcs := make([]func(int64) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtInt32(fs ...func(int32) int32) func(int32) []int32 {
+	funcs := make([]func(int32) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtInt16(fs ...func(int16) int16) func(int16) []int16 {
+	funcs := make([]func(int16) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtInt8(fs ...func(int8) int8) func(int8) []int8 {
+	funcs := make([]func(int8) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtUint(fs ...func(uint) uint) func(uint) []uint {
+	funcs := make([]func(uint) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtUint64(fs ...func(uint64) uint64) func(uint64) []uint64 {
+	funcs := make([]func(uint64) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtUint32(fs ...func(uint32) uint32) func(uint32) []uint32 {
+	funcs := make([]func(uint32) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtUint16(fs ...func(uint16) uint16) func(uint16) []uint16 {
+	funcs := make([]func(uint16) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtUint8(fs ...func(uint8) uint8) func(uint8) []uint8 {
+	funcs := make([]func(uint8) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtFloat64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtFloat64(fs ...func(float64) float64) func(float64) []float64 {
+	funcs := make([]func(float64) float64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v float64) []float64 {
+		newList := make([]float64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtFloat32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtFloat32(fs ...func(float32) float32) func(float32) []float32 {
+	funcs := make([]func(float32) float32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v float32) []float32 {
+		newList := make([]float32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtStr(fs ...func(string) string) func(string) []string {
+	funcs := make([]func(string) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func JuxtBool(fs ...func(bool) bool) func(bool) []bool {
+	funcs := make([]func(bool) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
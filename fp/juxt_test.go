@@ -0,0 +1,328 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJuxtInt(t *testing.T) {
+	f1 := func(v int) int { return v + 1 }
+	f2 := func(v int) int { return v * 2 }
+
+	expected := []int{4, 6}
+	actual := JuxtInt(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtInt()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64(t *testing.T) {
+	f1 := func(v int64) int64 { return v + 1 }
+	f2 := func(v int64) int64 { return v * 2 }
+
+	expected := []int64{4, 6}
+	actual := JuxtInt64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtInt64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32(t *testing.T) {
+	f1 := func(v int32) int32 { return v + 1 }
+	f2 := func(v int32) int32 { return v * 2 }
+
+	expected := []int32{4, 6}
+	actual := JuxtInt32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtInt32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16(t *testing.T) {
+	f1 := func(v int16) int16 { return v + 1 }
+	f2 := func(v int16) int16 { return v * 2 }
+
+	expected := []int16{4, 6}
+	actual := JuxtInt16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtInt16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8(t *testing.T) {
+	f1 := func(v int8) int8 { return v + 1 }
+	f2 := func(v int8) int8 { return v * 2 }
+
+	expected := []int8{4, 6}
+	actual := JuxtInt8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtInt8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint(t *testing.T) {
+	f1 := func(v uint) uint { return v + 1 }
+	f2 := func(v uint) uint { return v * 2 }
+
+	expected := []uint{4, 6}
+	actual := JuxtUint(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtUint()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64(t *testing.T) {
+	f1 := func(v uint64) uint64 { return v + 1 }
+	f2 := func(v uint64) uint64 { return v * 2 }
+
+	expected := []uint64{4, 6}
+	actual := JuxtUint64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtUint64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32(t *testing.T) {
+	f1 := func(v uint32) uint32 { return v + 1 }
+	f2 := func(v uint32) uint32 { return v * 2 }
+
+	expected := []uint32{4, 6}
+	actual := JuxtUint32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtUint32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16(t *testing.T) {
+	f1 := func(v uint16) uint16 { return v + 1 }
+	f2 := func(v uint16) uint16 { return v * 2 }
+
+	expected := []uint16{4, 6}
+	actual := JuxtUint16(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtUint16()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8(t *testing.T) {
+	f1 := func(v uint8) uint8 { return v + 1 }
+	f2 := func(v uint8) uint8 { return v * 2 }
+
+	expected := []uint8{4, 6}
+	actual := JuxtUint8(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtUint8()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtFloat64(t *testing.T) {
+	f1 := func(v float64) float64 { return v + 1 }
+	f2 := func(v float64) float64 { return v * 2 }
+
+	expected := []float64{4, 6}
+	actual := JuxtFloat64(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtFloat64(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{}
+	actual = JuxtFloat64()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtFloat32(t *testing.T) {
+	f1 := func(v float32) float32 { return v + 1 }
+	f2 := func(v float32) float32 { return v * 2 }
+
+	expected := []float32{4, 6}
+	actual := JuxtFloat32(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtFloat32(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{}
+	actual = JuxtFloat32()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStr(t *testing.T) {
+	f1 := func(v string) string { return v + "a" }
+	f2 := func(v string) string { return v + "b" }
+
+	expected := []string{"xa", "xb"}
+	actual := JuxtStr(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStr(nil, f1, nil, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtStr()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBool(t *testing.T) {
+	f1 := func(v bool) bool { return !v }
+	f2 := func(v bool) bool { return v }
+
+	expected := []bool{false, true}
+	actual := JuxtBool(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBool(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtBool()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,3037 @@
+package fp
+
+// JuxtIntInt64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtIntInt64(fs ...func(int) int64) func(int) []int64 {
+	funcs := make([]func(int) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntInt32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtIntInt32(fs ...func(int) int32) func(int) []int32 {
+	funcs := make([]func(int) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntInt16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtIntInt16(fs ...func(int) int16) func(int) []int16 {
+	funcs := make([]func(int) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntInt8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtIntInt8(fs ...func(int) int8) func(int) []int8 {
+	funcs := make([]func(int) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntUint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtIntUint(fs ...func(int) uint) func(int) []uint {
+	funcs := make([]func(int) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntUint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtIntUint64(fs ...func(int) uint64) func(int) []uint64 {
+	funcs := make([]func(int) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntUint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtIntUint32(fs ...func(int) uint32) func(int) []uint32 {
+	funcs := make([]func(int) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntUint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtIntUint16(fs ...func(int) uint16) func(int) []uint16 {
+	funcs := make([]func(int) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntUint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtIntUint8(fs ...func(int) uint8) func(int) []uint8 {
+	funcs := make([]func(int) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtIntStr(fs ...func(int) string) func(int) []string {
+	funcs := make([]func(int) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtIntBool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtIntBool(fs ...func(int) bool) func(int) []bool {
+	funcs := make([]func(int) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtInt64Int(fs ...func(int64) int) func(int64) []int {
+	funcs := make([]func(int64) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtInt64Int32(fs ...func(int64) int32) func(int64) []int32 {
+	funcs := make([]func(int64) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtInt64Int16(fs ...func(int64) int16) func(int64) []int16 {
+	funcs := make([]func(int64) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtInt64Int8(fs ...func(int64) int8) func(int64) []int8 {
+	funcs := make([]func(int64) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtInt64Uint(fs ...func(int64) uint) func(int64) []uint {
+	funcs := make([]func(int64) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtInt64Uint64(fs ...func(int64) uint64) func(int64) []uint64 {
+	funcs := make([]func(int64) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtInt64Uint32(fs ...func(int64) uint32) func(int64) []uint32 {
+	funcs := make([]func(int64) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtInt64Uint16(fs ...func(int64) uint16) func(int64) []uint16 {
+	funcs := make([]func(int64) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtInt64Uint8(fs ...func(int64) uint8) func(int64) []uint8 {
+	funcs := make([]func(int64) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtInt64Str(fs ...func(int64) string) func(int64) []string {
+	funcs := make([]func(int64) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt64Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int64 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtInt64Bool(fs ...func(int64) bool) func(int64) []bool {
+	funcs := make([]func(int64) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int64) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtInt32Int(fs ...func(int32) int) func(int32) []int {
+	funcs := make([]func(int32) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtInt32Int64(fs ...func(int32) int64) func(int32) []int64 {
+	funcs := make([]func(int32) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtInt32Int16(fs ...func(int32) int16) func(int32) []int16 {
+	funcs := make([]func(int32) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtInt32Int8(fs ...func(int32) int8) func(int32) []int8 {
+	funcs := make([]func(int32) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtInt32Uint(fs ...func(int32) uint) func(int32) []uint {
+	funcs := make([]func(int32) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtInt32Uint64(fs ...func(int32) uint64) func(int32) []uint64 {
+	funcs := make([]func(int32) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtInt32Uint32(fs ...func(int32) uint32) func(int32) []uint32 {
+	funcs := make([]func(int32) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtInt32Uint16(fs ...func(int32) uint16) func(int32) []uint16 {
+	funcs := make([]func(int32) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtInt32Uint8(fs ...func(int32) uint8) func(int32) []uint8 {
+	funcs := make([]func(int32) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtInt32Str(fs ...func(int32) string) func(int32) []string {
+	funcs := make([]func(int32) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt32Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int32 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtInt32Bool(fs ...func(int32) bool) func(int32) []bool {
+	funcs := make([]func(int32) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int32) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtInt16Int(fs ...func(int16) int) func(int16) []int {
+	funcs := make([]func(int16) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtInt16Int64(fs ...func(int16) int64) func(int16) []int64 {
+	funcs := make([]func(int16) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtInt16Int32(fs ...func(int16) int32) func(int16) []int32 {
+	funcs := make([]func(int16) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtInt16Int8(fs ...func(int16) int8) func(int16) []int8 {
+	funcs := make([]func(int16) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtInt16Uint(fs ...func(int16) uint) func(int16) []uint {
+	funcs := make([]func(int16) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtInt16Uint64(fs ...func(int16) uint64) func(int16) []uint64 {
+	funcs := make([]func(int16) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtInt16Uint32(fs ...func(int16) uint32) func(int16) []uint32 {
+	funcs := make([]func(int16) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtInt16Uint16(fs ...func(int16) uint16) func(int16) []uint16 {
+	funcs := make([]func(int16) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtInt16Uint8(fs ...func(int16) uint8) func(int16) []uint8 {
+	funcs := make([]func(int16) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtInt16Str(fs ...func(int16) string) func(int16) []string {
+	funcs := make([]func(int16) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt16Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int16 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtInt16Bool(fs ...func(int16) bool) func(int16) []bool {
+	funcs := make([]func(int16) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int16) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtInt8Int(fs ...func(int8) int) func(int8) []int {
+	funcs := make([]func(int8) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtInt8Int64(fs ...func(int8) int64) func(int8) []int64 {
+	funcs := make([]func(int8) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtInt8Int32(fs ...func(int8) int32) func(int8) []int32 {
+	funcs := make([]func(int8) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtInt8Int16(fs ...func(int8) int16) func(int8) []int16 {
+	funcs := make([]func(int8) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtInt8Uint(fs ...func(int8) uint) func(int8) []uint {
+	funcs := make([]func(int8) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtInt8Uint64(fs ...func(int8) uint64) func(int8) []uint64 {
+	funcs := make([]func(int8) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtInt8Uint32(fs ...func(int8) uint32) func(int8) []uint32 {
+	funcs := make([]func(int8) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtInt8Uint16(fs ...func(int8) uint16) func(int8) []uint16 {
+	funcs := make([]func(int8) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtInt8Uint8(fs ...func(int8) uint8) func(int8) []uint8 {
+	funcs := make([]func(int8) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtInt8Str(fs ...func(int8) string) func(int8) []string {
+	funcs := make([]func(int8) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtInt8Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int8 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtInt8Bool(fs ...func(int8) bool) func(int8) []bool {
+	funcs := make([]func(int8) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int8) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtUintInt(fs ...func(uint) int) func(uint) []int {
+	funcs := make([]func(uint) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintInt64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtUintInt64(fs ...func(uint) int64) func(uint) []int64 {
+	funcs := make([]func(uint) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintInt32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtUintInt32(fs ...func(uint) int32) func(uint) []int32 {
+	funcs := make([]func(uint) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintInt16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtUintInt16(fs ...func(uint) int16) func(uint) []int16 {
+	funcs := make([]func(uint) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintInt8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtUintInt8(fs ...func(uint) int8) func(uint) []int8 {
+	funcs := make([]func(uint) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintUint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtUintUint64(fs ...func(uint) uint64) func(uint) []uint64 {
+	funcs := make([]func(uint) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintUint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtUintUint32(fs ...func(uint) uint32) func(uint) []uint32 {
+	funcs := make([]func(uint) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintUint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtUintUint16(fs ...func(uint) uint16) func(uint) []uint16 {
+	funcs := make([]func(uint) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintUint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtUintUint8(fs ...func(uint) uint8) func(uint) []uint8 {
+	funcs := make([]func(uint) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtUintStr(fs ...func(uint) string) func(uint) []string {
+	funcs := make([]func(uint) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUintBool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtUintBool(fs ...func(uint) bool) func(uint) []bool {
+	funcs := make([]func(uint) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtUint64Int(fs ...func(uint64) int) func(uint64) []int {
+	funcs := make([]func(uint64) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtUint64Int64(fs ...func(uint64) int64) func(uint64) []int64 {
+	funcs := make([]func(uint64) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtUint64Int32(fs ...func(uint64) int32) func(uint64) []int32 {
+	funcs := make([]func(uint64) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtUint64Int16(fs ...func(uint64) int16) func(uint64) []int16 {
+	funcs := make([]func(uint64) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtUint64Int8(fs ...func(uint64) int8) func(uint64) []int8 {
+	funcs := make([]func(uint64) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtUint64Uint(fs ...func(uint64) uint) func(uint64) []uint {
+	funcs := make([]func(uint64) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtUint64Uint32(fs ...func(uint64) uint32) func(uint64) []uint32 {
+	funcs := make([]func(uint64) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtUint64Uint16(fs ...func(uint64) uint16) func(uint64) []uint16 {
+	funcs := make([]func(uint64) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtUint64Uint8(fs ...func(uint64) uint8) func(uint64) []uint8 {
+	funcs := make([]func(uint64) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtUint64Str(fs ...func(uint64) string) func(uint64) []string {
+	funcs := make([]func(uint64) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint64Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint64 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtUint64Bool(fs ...func(uint64) bool) func(uint64) []bool {
+	funcs := make([]func(uint64) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint64) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtUint32Int(fs ...func(uint32) int) func(uint32) []int {
+	funcs := make([]func(uint32) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtUint32Int64(fs ...func(uint32) int64) func(uint32) []int64 {
+	funcs := make([]func(uint32) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtUint32Int32(fs ...func(uint32) int32) func(uint32) []int32 {
+	funcs := make([]func(uint32) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtUint32Int16(fs ...func(uint32) int16) func(uint32) []int16 {
+	funcs := make([]func(uint32) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtUint32Int8(fs ...func(uint32) int8) func(uint32) []int8 {
+	funcs := make([]func(uint32) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtUint32Uint(fs ...func(uint32) uint) func(uint32) []uint {
+	funcs := make([]func(uint32) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtUint32Uint64(fs ...func(uint32) uint64) func(uint32) []uint64 {
+	funcs := make([]func(uint32) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtUint32Uint16(fs ...func(uint32) uint16) func(uint32) []uint16 {
+	funcs := make([]func(uint32) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtUint32Uint8(fs ...func(uint32) uint8) func(uint32) []uint8 {
+	funcs := make([]func(uint32) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtUint32Str(fs ...func(uint32) string) func(uint32) []string {
+	funcs := make([]func(uint32) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint32Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint32 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtUint32Bool(fs ...func(uint32) bool) func(uint32) []bool {
+	funcs := make([]func(uint32) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint32) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtUint16Int(fs ...func(uint16) int) func(uint16) []int {
+	funcs := make([]func(uint16) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtUint16Int64(fs ...func(uint16) int64) func(uint16) []int64 {
+	funcs := make([]func(uint16) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtUint16Int32(fs ...func(uint16) int32) func(uint16) []int32 {
+	funcs := make([]func(uint16) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtUint16Int16(fs ...func(uint16) int16) func(uint16) []int16 {
+	funcs := make([]func(uint16) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtUint16Int8(fs ...func(uint16) int8) func(uint16) []int8 {
+	funcs := make([]func(uint16) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtUint16Uint(fs ...func(uint16) uint) func(uint16) []uint {
+	funcs := make([]func(uint16) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtUint16Uint64(fs ...func(uint16) uint64) func(uint16) []uint64 {
+	funcs := make([]func(uint16) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtUint16Uint32(fs ...func(uint16) uint32) func(uint16) []uint32 {
+	funcs := make([]func(uint16) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Uint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtUint16Uint8(fs ...func(uint16) uint8) func(uint16) []uint8 {
+	funcs := make([]func(uint16) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtUint16Str(fs ...func(uint16) string) func(uint16) []string {
+	funcs := make([]func(uint16) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint16Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint16 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtUint16Bool(fs ...func(uint16) bool) func(uint16) []bool {
+	funcs := make([]func(uint16) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint16) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Int takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtUint8Int(fs ...func(uint8) int) func(uint8) []int {
+	funcs := make([]func(uint8) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Int64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtUint8Int64(fs ...func(uint8) int64) func(uint8) []int64 {
+	funcs := make([]func(uint8) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Int32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtUint8Int32(fs ...func(uint8) int32) func(uint8) []int32 {
+	funcs := make([]func(uint8) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Int16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtUint8Int16(fs ...func(uint8) int16) func(uint8) []int16 {
+	funcs := make([]func(uint8) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Int8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtUint8Int8(fs ...func(uint8) int8) func(uint8) []int8 {
+	funcs := make([]func(uint8) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Uint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtUint8Uint(fs ...func(uint8) uint) func(uint8) []uint {
+	funcs := make([]func(uint8) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Uint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtUint8Uint64(fs ...func(uint8) uint64) func(uint8) []uint64 {
+	funcs := make([]func(uint8) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Uint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtUint8Uint32(fs ...func(uint8) uint32) func(uint8) []uint32 {
+	funcs := make([]func(uint8) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Uint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtUint8Uint16(fs ...func(uint8) uint16) func(uint8) []uint16 {
+	funcs := make([]func(uint8) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Str takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtUint8Str(fs ...func(uint8) string) func(uint8) []string {
+	funcs := make([]func(uint8) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtUint8Bool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: uint8 output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtUint8Bool(fs ...func(uint8) bool) func(uint8) []bool {
+	funcs := make([]func(uint8) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v uint8) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtStrInt(fs ...func(string) int) func(string) []int {
+	funcs := make([]func(string) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrInt64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtStrInt64(fs ...func(string) int64) func(string) []int64 {
+	funcs := make([]func(string) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrInt32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtStrInt32(fs ...func(string) int32) func(string) []int32 {
+	funcs := make([]func(string) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrInt16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtStrInt16(fs ...func(string) int16) func(string) []int16 {
+	funcs := make([]func(string) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrInt8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtStrInt8(fs ...func(string) int8) func(string) []int8 {
+	funcs := make([]func(string) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrUint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtStrUint(fs ...func(string) uint) func(string) []uint {
+	funcs := make([]func(string) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrUint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtStrUint64(fs ...func(string) uint64) func(string) []uint64 {
+	funcs := make([]func(string) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrUint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtStrUint32(fs ...func(string) uint32) func(string) []uint32 {
+	funcs := make([]func(string) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrUint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtStrUint16(fs ...func(string) uint16) func(string) []uint16 {
+	funcs := make([]func(string) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrUint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtStrUint8(fs ...func(string) uint8) func(string) []uint8 {
+	funcs := make([]func(string) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtStrBool takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: bool
+//
+// Returns
+//	Function which returns the list of type bool
+func JuxtStrBool(fs ...func(string) bool) func(string) []bool {
+	funcs := make([]func(string) bool, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []bool {
+		newList := make([]bool, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtBoolInt(fs ...func(bool) int) func(bool) []int {
+	funcs := make([]func(bool) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolInt64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: int64
+//
+// Returns
+//	Function which returns the list of type int64
+func JuxtBoolInt64(fs ...func(bool) int64) func(bool) []int64 {
+	funcs := make([]func(bool) int64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []int64 {
+		newList := make([]int64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolInt32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: int32
+//
+// Returns
+//	Function which returns the list of type int32
+func JuxtBoolInt32(fs ...func(bool) int32) func(bool) []int32 {
+	funcs := make([]func(bool) int32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []int32 {
+		newList := make([]int32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolInt16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: int16
+//
+// Returns
+//	Function which returns the list of type int16
+func JuxtBoolInt16(fs ...func(bool) int16) func(bool) []int16 {
+	funcs := make([]func(bool) int16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []int16 {
+		newList := make([]int16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolInt8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: int8
+//
+// Returns
+//	Function which returns the list of type int8
+func JuxtBoolInt8(fs ...func(bool) int8) func(bool) []int8 {
+	funcs := make([]func(bool) int8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []int8 {
+		newList := make([]int8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolUint takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: uint
+//
+// Returns
+//	Function which returns the list of type uint
+func JuxtBoolUint(fs ...func(bool) uint) func(bool) []uint {
+	funcs := make([]func(bool) uint, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []uint {
+		newList := make([]uint, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolUint64 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: uint64
+//
+// Returns
+//	Function which returns the list of type uint64
+func JuxtBoolUint64(fs ...func(bool) uint64) func(bool) []uint64 {
+	funcs := make([]func(bool) uint64, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []uint64 {
+		newList := make([]uint64, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolUint32 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: uint32
+//
+// Returns
+//	Function which returns the list of type uint32
+func JuxtBoolUint32(fs ...func(bool) uint32) func(bool) []uint32 {
+	funcs := make([]func(bool) uint32, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []uint32 {
+		newList := make([]uint32, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolUint16 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: uint16
+//
+// Returns
+//	Function which returns the list of type uint16
+func JuxtBoolUint16(fs ...func(bool) uint16) func(bool) []uint16 {
+	funcs := make([]func(bool) uint16, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []uint16 {
+		newList := make([]uint16, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolUint8 takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: uint8
+//
+// Returns
+//	Function which returns the list of type uint8
+func JuxtBoolUint8(fs ...func(bool) uint8) func(bool) []uint8 {
+	funcs := make([]func(bool) uint8, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []uint8 {
+		newList := make([]uint8, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+// JuxtBoolStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: bool output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtBoolStr(fs ...func(bool) string) func(bool) []string {
+	funcs := make([]func(bool) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v bool) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
@@ -0,0 +1,3147 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJuxtIntInt64(t *testing.T) {
+	f1 := func(v int) int64 { return int64(v) }
+	f2 := func(v int) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtIntInt64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntInt64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtIntInt64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntInt32(t *testing.T) {
+	f1 := func(v int) int32 { return int32(v) }
+	f2 := func(v int) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtIntInt32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntInt32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtIntInt32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntInt16(t *testing.T) {
+	f1 := func(v int) int16 { return int16(v) }
+	f2 := func(v int) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtIntInt16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntInt16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtIntInt16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntInt8(t *testing.T) {
+	f1 := func(v int) int8 { return int8(v) }
+	f2 := func(v int) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtIntInt8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntInt8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtIntInt8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntUint(t *testing.T) {
+	f1 := func(v int) uint { return uint(v) }
+	f2 := func(v int) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtIntUint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntUint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtIntUint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntUint64(t *testing.T) {
+	f1 := func(v int) uint64 { return uint64(v) }
+	f2 := func(v int) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtIntUint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntUint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtIntUint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntUint32(t *testing.T) {
+	f1 := func(v int) uint32 { return uint32(v) }
+	f2 := func(v int) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtIntUint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntUint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtIntUint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntUint16(t *testing.T) {
+	f1 := func(v int) uint16 { return uint16(v) }
+	f2 := func(v int) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtIntUint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntUint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtIntUint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntUint8(t *testing.T) {
+	f1 := func(v int) uint8 { return uint8(v) }
+	f2 := func(v int) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtIntUint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntUint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtIntUint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntStr(t *testing.T) {
+	f1 := func(v int) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v int) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtIntStr(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntStr(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtIntStr()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtIntBool(t *testing.T) {
+	f1 := func(v int) bool { return v > 1 }
+	f2 := func(v int) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtIntBool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtIntBool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtIntBool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Int(t *testing.T) {
+	f1 := func(v int64) int { return int(v) }
+	f2 := func(v int64) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtInt64Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtInt64Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Int32(t *testing.T) {
+	f1 := func(v int64) int32 { return int32(v) }
+	f2 := func(v int64) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtInt64Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtInt64Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Int16(t *testing.T) {
+	f1 := func(v int64) int16 { return int16(v) }
+	f2 := func(v int64) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtInt64Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtInt64Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Int8(t *testing.T) {
+	f1 := func(v int64) int8 { return int8(v) }
+	f2 := func(v int64) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtInt64Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtInt64Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Uint(t *testing.T) {
+	f1 := func(v int64) uint { return uint(v) }
+	f2 := func(v int64) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtInt64Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtInt64Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Uint64(t *testing.T) {
+	f1 := func(v int64) uint64 { return uint64(v) }
+	f2 := func(v int64) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtInt64Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtInt64Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Uint32(t *testing.T) {
+	f1 := func(v int64) uint32 { return uint32(v) }
+	f2 := func(v int64) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtInt64Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtInt64Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Uint16(t *testing.T) {
+	f1 := func(v int64) uint16 { return uint16(v) }
+	f2 := func(v int64) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtInt64Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtInt64Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Uint8(t *testing.T) {
+	f1 := func(v int64) uint8 { return uint8(v) }
+	f2 := func(v int64) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtInt64Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtInt64Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Str(t *testing.T) {
+	f1 := func(v int64) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v int64) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtInt64Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtInt64Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt64Bool(t *testing.T) {
+	f1 := func(v int64) bool { return v > 1 }
+	f2 := func(v int64) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtInt64Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt64Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtInt64Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Int(t *testing.T) {
+	f1 := func(v int32) int { return int(v) }
+	f2 := func(v int32) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtInt32Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtInt32Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Int64(t *testing.T) {
+	f1 := func(v int32) int64 { return int64(v) }
+	f2 := func(v int32) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtInt32Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtInt32Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Int16(t *testing.T) {
+	f1 := func(v int32) int16 { return int16(v) }
+	f2 := func(v int32) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtInt32Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtInt32Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Int8(t *testing.T) {
+	f1 := func(v int32) int8 { return int8(v) }
+	f2 := func(v int32) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtInt32Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtInt32Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Uint(t *testing.T) {
+	f1 := func(v int32) uint { return uint(v) }
+	f2 := func(v int32) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtInt32Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtInt32Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Uint64(t *testing.T) {
+	f1 := func(v int32) uint64 { return uint64(v) }
+	f2 := func(v int32) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtInt32Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtInt32Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Uint32(t *testing.T) {
+	f1 := func(v int32) uint32 { return uint32(v) }
+	f2 := func(v int32) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtInt32Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtInt32Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Uint16(t *testing.T) {
+	f1 := func(v int32) uint16 { return uint16(v) }
+	f2 := func(v int32) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtInt32Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtInt32Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Uint8(t *testing.T) {
+	f1 := func(v int32) uint8 { return uint8(v) }
+	f2 := func(v int32) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtInt32Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtInt32Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Str(t *testing.T) {
+	f1 := func(v int32) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v int32) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtInt32Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtInt32Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt32Bool(t *testing.T) {
+	f1 := func(v int32) bool { return v > 1 }
+	f2 := func(v int32) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtInt32Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt32Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtInt32Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Int(t *testing.T) {
+	f1 := func(v int16) int { return int(v) }
+	f2 := func(v int16) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtInt16Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtInt16Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Int64(t *testing.T) {
+	f1 := func(v int16) int64 { return int64(v) }
+	f2 := func(v int16) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtInt16Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtInt16Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Int32(t *testing.T) {
+	f1 := func(v int16) int32 { return int32(v) }
+	f2 := func(v int16) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtInt16Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtInt16Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Int8(t *testing.T) {
+	f1 := func(v int16) int8 { return int8(v) }
+	f2 := func(v int16) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtInt16Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtInt16Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Uint(t *testing.T) {
+	f1 := func(v int16) uint { return uint(v) }
+	f2 := func(v int16) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtInt16Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtInt16Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Uint64(t *testing.T) {
+	f1 := func(v int16) uint64 { return uint64(v) }
+	f2 := func(v int16) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtInt16Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtInt16Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Uint32(t *testing.T) {
+	f1 := func(v int16) uint32 { return uint32(v) }
+	f2 := func(v int16) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtInt16Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtInt16Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Uint16(t *testing.T) {
+	f1 := func(v int16) uint16 { return uint16(v) }
+	f2 := func(v int16) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtInt16Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtInt16Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Uint8(t *testing.T) {
+	f1 := func(v int16) uint8 { return uint8(v) }
+	f2 := func(v int16) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtInt16Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtInt16Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Str(t *testing.T) {
+	f1 := func(v int16) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v int16) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtInt16Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtInt16Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt16Bool(t *testing.T) {
+	f1 := func(v int16) bool { return v > 1 }
+	f2 := func(v int16) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtInt16Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt16Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtInt16Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Int(t *testing.T) {
+	f1 := func(v int8) int { return int(v) }
+	f2 := func(v int8) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtInt8Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtInt8Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Int64(t *testing.T) {
+	f1 := func(v int8) int64 { return int64(v) }
+	f2 := func(v int8) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtInt8Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtInt8Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Int32(t *testing.T) {
+	f1 := func(v int8) int32 { return int32(v) }
+	f2 := func(v int8) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtInt8Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtInt8Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Int16(t *testing.T) {
+	f1 := func(v int8) int16 { return int16(v) }
+	f2 := func(v int8) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtInt8Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtInt8Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Uint(t *testing.T) {
+	f1 := func(v int8) uint { return uint(v) }
+	f2 := func(v int8) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtInt8Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtInt8Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Uint64(t *testing.T) {
+	f1 := func(v int8) uint64 { return uint64(v) }
+	f2 := func(v int8) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtInt8Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtInt8Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Uint32(t *testing.T) {
+	f1 := func(v int8) uint32 { return uint32(v) }
+	f2 := func(v int8) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtInt8Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtInt8Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Uint16(t *testing.T) {
+	f1 := func(v int8) uint16 { return uint16(v) }
+	f2 := func(v int8) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtInt8Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtInt8Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Uint8(t *testing.T) {
+	f1 := func(v int8) uint8 { return uint8(v) }
+	f2 := func(v int8) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtInt8Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtInt8Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Str(t *testing.T) {
+	f1 := func(v int8) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v int8) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtInt8Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtInt8Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtInt8Bool(t *testing.T) {
+	f1 := func(v int8) bool { return v > 1 }
+	f2 := func(v int8) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtInt8Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtInt8Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtInt8Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintInt(t *testing.T) {
+	f1 := func(v uint) int { return int(v) }
+	f2 := func(v uint) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtUintInt(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintInt(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtUintInt()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintInt64(t *testing.T) {
+	f1 := func(v uint) int64 { return int64(v) }
+	f2 := func(v uint) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtUintInt64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintInt64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtUintInt64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintInt32(t *testing.T) {
+	f1 := func(v uint) int32 { return int32(v) }
+	f2 := func(v uint) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtUintInt32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintInt32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtUintInt32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintInt16(t *testing.T) {
+	f1 := func(v uint) int16 { return int16(v) }
+	f2 := func(v uint) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtUintInt16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintInt16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtUintInt16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintInt8(t *testing.T) {
+	f1 := func(v uint) int8 { return int8(v) }
+	f2 := func(v uint) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtUintInt8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintInt8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtUintInt8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintUint64(t *testing.T) {
+	f1 := func(v uint) uint64 { return uint64(v) }
+	f2 := func(v uint) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtUintUint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintUint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtUintUint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintUint32(t *testing.T) {
+	f1 := func(v uint) uint32 { return uint32(v) }
+	f2 := func(v uint) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtUintUint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintUint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtUintUint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintUint16(t *testing.T) {
+	f1 := func(v uint) uint16 { return uint16(v) }
+	f2 := func(v uint) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtUintUint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintUint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtUintUint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintUint8(t *testing.T) {
+	f1 := func(v uint) uint8 { return uint8(v) }
+	f2 := func(v uint) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtUintUint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintUint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtUintUint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintStr(t *testing.T) {
+	f1 := func(v uint) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v uint) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtUintStr(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintStr(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtUintStr()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUintBool(t *testing.T) {
+	f1 := func(v uint) bool { return v > 1 }
+	f2 := func(v uint) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtUintBool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUintBool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtUintBool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Int(t *testing.T) {
+	f1 := func(v uint64) int { return int(v) }
+	f2 := func(v uint64) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtUint64Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtUint64Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Int64(t *testing.T) {
+	f1 := func(v uint64) int64 { return int64(v) }
+	f2 := func(v uint64) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtUint64Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtUint64Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Int32(t *testing.T) {
+	f1 := func(v uint64) int32 { return int32(v) }
+	f2 := func(v uint64) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtUint64Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtUint64Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Int16(t *testing.T) {
+	f1 := func(v uint64) int16 { return int16(v) }
+	f2 := func(v uint64) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtUint64Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtUint64Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Int8(t *testing.T) {
+	f1 := func(v uint64) int8 { return int8(v) }
+	f2 := func(v uint64) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtUint64Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtUint64Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Uint(t *testing.T) {
+	f1 := func(v uint64) uint { return uint(v) }
+	f2 := func(v uint64) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtUint64Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtUint64Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Uint32(t *testing.T) {
+	f1 := func(v uint64) uint32 { return uint32(v) }
+	f2 := func(v uint64) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtUint64Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtUint64Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Uint16(t *testing.T) {
+	f1 := func(v uint64) uint16 { return uint16(v) }
+	f2 := func(v uint64) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtUint64Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtUint64Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Uint8(t *testing.T) {
+	f1 := func(v uint64) uint8 { return uint8(v) }
+	f2 := func(v uint64) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtUint64Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtUint64Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Str(t *testing.T) {
+	f1 := func(v uint64) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v uint64) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtUint64Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtUint64Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint64Bool(t *testing.T) {
+	f1 := func(v uint64) bool { return v > 1 }
+	f2 := func(v uint64) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtUint64Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint64Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtUint64Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Int(t *testing.T) {
+	f1 := func(v uint32) int { return int(v) }
+	f2 := func(v uint32) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtUint32Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtUint32Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Int64(t *testing.T) {
+	f1 := func(v uint32) int64 { return int64(v) }
+	f2 := func(v uint32) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtUint32Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtUint32Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Int32(t *testing.T) {
+	f1 := func(v uint32) int32 { return int32(v) }
+	f2 := func(v uint32) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtUint32Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtUint32Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Int16(t *testing.T) {
+	f1 := func(v uint32) int16 { return int16(v) }
+	f2 := func(v uint32) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtUint32Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtUint32Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Int8(t *testing.T) {
+	f1 := func(v uint32) int8 { return int8(v) }
+	f2 := func(v uint32) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtUint32Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtUint32Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Uint(t *testing.T) {
+	f1 := func(v uint32) uint { return uint(v) }
+	f2 := func(v uint32) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtUint32Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtUint32Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Uint64(t *testing.T) {
+	f1 := func(v uint32) uint64 { return uint64(v) }
+	f2 := func(v uint32) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtUint32Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtUint32Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Uint16(t *testing.T) {
+	f1 := func(v uint32) uint16 { return uint16(v) }
+	f2 := func(v uint32) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtUint32Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtUint32Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Uint8(t *testing.T) {
+	f1 := func(v uint32) uint8 { return uint8(v) }
+	f2 := func(v uint32) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtUint32Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtUint32Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Str(t *testing.T) {
+	f1 := func(v uint32) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v uint32) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtUint32Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtUint32Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint32Bool(t *testing.T) {
+	f1 := func(v uint32) bool { return v > 1 }
+	f2 := func(v uint32) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtUint32Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint32Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtUint32Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Int(t *testing.T) {
+	f1 := func(v uint16) int { return int(v) }
+	f2 := func(v uint16) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtUint16Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtUint16Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Int64(t *testing.T) {
+	f1 := func(v uint16) int64 { return int64(v) }
+	f2 := func(v uint16) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtUint16Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtUint16Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Int32(t *testing.T) {
+	f1 := func(v uint16) int32 { return int32(v) }
+	f2 := func(v uint16) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtUint16Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtUint16Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Int16(t *testing.T) {
+	f1 := func(v uint16) int16 { return int16(v) }
+	f2 := func(v uint16) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtUint16Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtUint16Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Int8(t *testing.T) {
+	f1 := func(v uint16) int8 { return int8(v) }
+	f2 := func(v uint16) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtUint16Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtUint16Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Uint(t *testing.T) {
+	f1 := func(v uint16) uint { return uint(v) }
+	f2 := func(v uint16) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtUint16Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtUint16Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Uint64(t *testing.T) {
+	f1 := func(v uint16) uint64 { return uint64(v) }
+	f2 := func(v uint16) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtUint16Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtUint16Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Uint32(t *testing.T) {
+	f1 := func(v uint16) uint32 { return uint32(v) }
+	f2 := func(v uint16) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtUint16Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtUint16Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Uint8(t *testing.T) {
+	f1 := func(v uint16) uint8 { return uint8(v) }
+	f2 := func(v uint16) uint8 { return uint8(v + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtUint16Uint8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Uint8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtUint16Uint8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Str(t *testing.T) {
+	f1 := func(v uint16) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v uint16) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtUint16Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtUint16Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint16Bool(t *testing.T) {
+	f1 := func(v uint16) bool { return v > 1 }
+	f2 := func(v uint16) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtUint16Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint16Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtUint16Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Int(t *testing.T) {
+	f1 := func(v uint8) int { return int(v) }
+	f2 := func(v uint8) int { return int(v + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtUint8Int(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Int(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtUint8Int()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Int64(t *testing.T) {
+	f1 := func(v uint8) int64 { return int64(v) }
+	f2 := func(v uint8) int64 { return int64(v + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtUint8Int64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Int64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtUint8Int64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Int32(t *testing.T) {
+	f1 := func(v uint8) int32 { return int32(v) }
+	f2 := func(v uint8) int32 { return int32(v + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtUint8Int32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Int32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtUint8Int32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Int16(t *testing.T) {
+	f1 := func(v uint8) int16 { return int16(v) }
+	f2 := func(v uint8) int16 { return int16(v + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtUint8Int16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Int16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtUint8Int16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Int8(t *testing.T) {
+	f1 := func(v uint8) int8 { return int8(v) }
+	f2 := func(v uint8) int8 { return int8(v + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtUint8Int8(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Int8(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtUint8Int8()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Uint(t *testing.T) {
+	f1 := func(v uint8) uint { return uint(v) }
+	f2 := func(v uint8) uint { return uint(v + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtUint8Uint(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Uint(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtUint8Uint()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Uint64(t *testing.T) {
+	f1 := func(v uint8) uint64 { return uint64(v) }
+	f2 := func(v uint8) uint64 { return uint64(v + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtUint8Uint64(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Uint64(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtUint8Uint64()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Uint32(t *testing.T) {
+	f1 := func(v uint8) uint32 { return uint32(v) }
+	f2 := func(v uint8) uint32 { return uint32(v + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtUint8Uint32(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Uint32(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtUint8Uint32()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Uint16(t *testing.T) {
+	f1 := func(v uint8) uint16 { return uint16(v) }
+	f2 := func(v uint8) uint16 { return uint16(v + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtUint8Uint16(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Uint16(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtUint8Uint16()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Str(t *testing.T) {
+	f1 := func(v uint8) string {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v uint8) string { return "a" }
+
+	expected := []string{"big", "a"}
+	actual := JuxtUint8Str(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Str(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtUint8Str()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtUint8Bool(t *testing.T) {
+	f1 := func(v uint8) bool { return v > 1 }
+	f2 := func(v uint8) bool { return v > 5 }
+
+	expected := []bool{true, false}
+	actual := JuxtUint8Bool(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtUint8Bool(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtUint8Bool()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrInt(t *testing.T) {
+	f1 := func(v string) int { return int(len(v)) }
+	f2 := func(v string) int { return int(len(v) + 1) }
+
+	expected := []int{2, 3}
+	actual := JuxtStrInt(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrInt(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtStrInt()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrInt64(t *testing.T) {
+	f1 := func(v string) int64 { return int64(len(v)) }
+	f2 := func(v string) int64 { return int64(len(v) + 1) }
+
+	expected := []int64{2, 3}
+	actual := JuxtStrInt64(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrInt64(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtStrInt64()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrInt32(t *testing.T) {
+	f1 := func(v string) int32 { return int32(len(v)) }
+	f2 := func(v string) int32 { return int32(len(v) + 1) }
+
+	expected := []int32{2, 3}
+	actual := JuxtStrInt32(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrInt32(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtStrInt32()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrInt16(t *testing.T) {
+	f1 := func(v string) int16 { return int16(len(v)) }
+	f2 := func(v string) int16 { return int16(len(v) + 1) }
+
+	expected := []int16{2, 3}
+	actual := JuxtStrInt16(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrInt16(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtStrInt16()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrInt8(t *testing.T) {
+	f1 := func(v string) int8 { return int8(len(v)) }
+	f2 := func(v string) int8 { return int8(len(v) + 1) }
+
+	expected := []int8{2, 3}
+	actual := JuxtStrInt8(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrInt8(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtStrInt8()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrUint(t *testing.T) {
+	f1 := func(v string) uint { return uint(len(v)) }
+	f2 := func(v string) uint { return uint(len(v) + 1) }
+
+	expected := []uint{2, 3}
+	actual := JuxtStrUint(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrUint(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtStrUint()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrUint64(t *testing.T) {
+	f1 := func(v string) uint64 { return uint64(len(v)) }
+	f2 := func(v string) uint64 { return uint64(len(v) + 1) }
+
+	expected := []uint64{2, 3}
+	actual := JuxtStrUint64(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrUint64(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtStrUint64()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrUint32(t *testing.T) {
+	f1 := func(v string) uint32 { return uint32(len(v)) }
+	f2 := func(v string) uint32 { return uint32(len(v) + 1) }
+
+	expected := []uint32{2, 3}
+	actual := JuxtStrUint32(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrUint32(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtStrUint32()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrUint16(t *testing.T) {
+	f1 := func(v string) uint16 { return uint16(len(v)) }
+	f2 := func(v string) uint16 { return uint16(len(v) + 1) }
+
+	expected := []uint16{2, 3}
+	actual := JuxtStrUint16(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrUint16(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtStrUint16()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrUint8(t *testing.T) {
+	f1 := func(v string) uint8 { return uint8(len(v)) }
+	f2 := func(v string) uint8 { return uint8(len(v) + 1) }
+
+	expected := []uint8{2, 3}
+	actual := JuxtStrUint8(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrUint8(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtStrUint8()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtStrBool(t *testing.T) {
+	f1 := func(v string) bool { return len(v) > 1 }
+	f2 := func(v string) bool { return v == "" }
+
+	expected := []bool{true, false}
+	actual := JuxtStrBool(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtStrBool(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = JuxtStrBool()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolInt(t *testing.T) {
+	f1 := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) int { return 5 }
+
+	expected := []int{1, 5}
+	actual := JuxtBoolInt(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolInt(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = JuxtBoolInt()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolInt64(t *testing.T) {
+	f1 := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) int64 { return 5 }
+
+	expected := []int64{1, 5}
+	actual := JuxtBoolInt64(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolInt64(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = JuxtBoolInt64()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolInt32(t *testing.T) {
+	f1 := func(v bool) int32 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) int32 { return 5 }
+
+	expected := []int32{1, 5}
+	actual := JuxtBoolInt32(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolInt32(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = JuxtBoolInt32()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolInt16(t *testing.T) {
+	f1 := func(v bool) int16 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) int16 { return 5 }
+
+	expected := []int16{1, 5}
+	actual := JuxtBoolInt16(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolInt16(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = JuxtBoolInt16()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolInt8(t *testing.T) {
+	f1 := func(v bool) int8 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) int8 { return 5 }
+
+	expected := []int8{1, 5}
+	actual := JuxtBoolInt8(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolInt8(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = JuxtBoolInt8()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolUint(t *testing.T) {
+	f1 := func(v bool) uint {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) uint { return 5 }
+
+	expected := []uint{1, 5}
+	actual := JuxtBoolUint(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolUint(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = JuxtBoolUint()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolUint64(t *testing.T) {
+	f1 := func(v bool) uint64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) uint64 { return 5 }
+
+	expected := []uint64{1, 5}
+	actual := JuxtBoolUint64(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolUint64(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = JuxtBoolUint64()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolUint32(t *testing.T) {
+	f1 := func(v bool) uint32 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) uint32 { return 5 }
+
+	expected := []uint32{1, 5}
+	actual := JuxtBoolUint32(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolUint32(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = JuxtBoolUint32()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolUint16(t *testing.T) {
+	f1 := func(v bool) uint16 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) uint16 { return 5 }
+
+	expected := []uint16{1, 5}
+	actual := JuxtBoolUint16(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolUint16(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = JuxtBoolUint16()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolUint8(t *testing.T) {
+	f1 := func(v bool) uint8 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v bool) uint8 { return 5 }
+
+	expected := []uint8{1, 5}
+	actual := JuxtBoolUint8(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolUint8(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = JuxtBoolUint8()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestJuxtBoolStr(t *testing.T) {
+	f1 := func(v bool) string {
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+	f2 := func(v bool) string { return "a" }
+
+	expected := []string{"no", "a"}
+	actual := JuxtBoolStr(f1, f2)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = JuxtBoolStr(nil, f1, nil, f2)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = JuxtBoolStr()(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxtBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,20163 @@
+package fp
+
+import "sort"
+
+// KeysInt returns list of keys of the map[int]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt(m map[int]int, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt returns list of values of the map[int]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt(m map[int]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt returns a new map which contains only the given keys of the map.
+func SelectKeysInt(m map[int]int, keys ...int) map[int]int {
+	newMap := make(map[int]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt returns a new map without the given keys.
+func DissocKeysInt(m map[int]int, keys ...int) map[int]int {
+	newMap := make(map[int]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt(m map[int]int) map[int]int {
+	newMap := make(map[int]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt(f func(int) int, m map[int]int) map[int]int {
+	if f == nil {
+		return map[int]int{}
+	}
+	newMap := make(map[int]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt(f func(int) int, m map[int]int) map[int]int {
+	if f == nil {
+		return map[int]int{}
+	}
+	newMap := make(map[int]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt(pred func(int) bool, m map[int]int) map[int]int {
+	if pred == nil {
+		return map[int]int{}
+	}
+	return FilterKVInt(func(k int, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt(pred func(int) bool, m map[int]int) map[int]int {
+	if pred == nil {
+		return map[int]int{}
+	}
+	return FilterKVInt(func(_ int, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt(pred func(int, int) bool, m map[int]int) map[int]int {
+	newMap := make(map[int]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, int, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt(f func(int, int, int) int, m map[int]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntInt64 returns list of keys of the map[int]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntInt64(m map[int]int64, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntInt64 returns list of values of the map[int]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntInt64(m map[int]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntInt64 returns a new map which contains only the given keys of the map.
+func SelectKeysIntInt64(m map[int]int64, keys ...int) map[int]int64 {
+	newMap := make(map[int]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntInt64 returns a new map without the given keys.
+func DissocKeysIntInt64(m map[int]int64, keys ...int) map[int]int64 {
+	newMap := make(map[int]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntInt64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntInt64(m map[int]int64) map[int64]int {
+	newMap := make(map[int64]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntInt64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntInt64(f func(int64) int64, m map[int]int64) map[int]int64 {
+	if f == nil {
+		return map[int]int64{}
+	}
+	newMap := make(map[int]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntInt64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntInt64(f func(int) int, m map[int]int64) map[int]int64 {
+	if f == nil {
+		return map[int]int64{}
+	}
+	newMap := make(map[int]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntInt64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntInt64(pred func(int) bool, m map[int]int64) map[int]int64 {
+	if pred == nil {
+		return map[int]int64{}
+	}
+	return FilterKVIntInt64(func(k int, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsIntInt64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntInt64(pred func(int64) bool, m map[int]int64) map[int]int64 {
+	if pred == nil {
+		return map[int]int64{}
+	}
+	return FilterKVIntInt64(func(_ int, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVIntInt64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntInt64(pred func(int, int64) bool, m map[int]int64) map[int]int64 {
+	newMap := make(map[int]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntInt64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, int, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntInt64(f func(int64, int, int64) int64, m map[int]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntInt32 returns list of keys of the map[int]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntInt32(m map[int]int32, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntInt32 returns list of values of the map[int]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntInt32(m map[int]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntInt32 returns a new map which contains only the given keys of the map.
+func SelectKeysIntInt32(m map[int]int32, keys ...int) map[int]int32 {
+	newMap := make(map[int]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntInt32 returns a new map without the given keys.
+func DissocKeysIntInt32(m map[int]int32, keys ...int) map[int]int32 {
+	newMap := make(map[int]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntInt32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntInt32(m map[int]int32) map[int32]int {
+	newMap := make(map[int32]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntInt32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntInt32(f func(int32) int32, m map[int]int32) map[int]int32 {
+	if f == nil {
+		return map[int]int32{}
+	}
+	newMap := make(map[int]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntInt32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntInt32(f func(int) int, m map[int]int32) map[int]int32 {
+	if f == nil {
+		return map[int]int32{}
+	}
+	newMap := make(map[int]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntInt32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntInt32(pred func(int) bool, m map[int]int32) map[int]int32 {
+	if pred == nil {
+		return map[int]int32{}
+	}
+	return FilterKVIntInt32(func(k int, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsIntInt32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntInt32(pred func(int32) bool, m map[int]int32) map[int]int32 {
+	if pred == nil {
+		return map[int]int32{}
+	}
+	return FilterKVIntInt32(func(_ int, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVIntInt32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntInt32(pred func(int, int32) bool, m map[int]int32) map[int]int32 {
+	newMap := make(map[int]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntInt32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, int, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntInt32(f func(int32, int, int32) int32, m map[int]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntInt16 returns list of keys of the map[int]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntInt16(m map[int]int16, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntInt16 returns list of values of the map[int]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntInt16(m map[int]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntInt16 returns a new map which contains only the given keys of the map.
+func SelectKeysIntInt16(m map[int]int16, keys ...int) map[int]int16 {
+	newMap := make(map[int]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntInt16 returns a new map without the given keys.
+func DissocKeysIntInt16(m map[int]int16, keys ...int) map[int]int16 {
+	newMap := make(map[int]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntInt16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntInt16(m map[int]int16) map[int16]int {
+	newMap := make(map[int16]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntInt16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntInt16(f func(int16) int16, m map[int]int16) map[int]int16 {
+	if f == nil {
+		return map[int]int16{}
+	}
+	newMap := make(map[int]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntInt16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntInt16(f func(int) int, m map[int]int16) map[int]int16 {
+	if f == nil {
+		return map[int]int16{}
+	}
+	newMap := make(map[int]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntInt16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntInt16(pred func(int) bool, m map[int]int16) map[int]int16 {
+	if pred == nil {
+		return map[int]int16{}
+	}
+	return FilterKVIntInt16(func(k int, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsIntInt16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntInt16(pred func(int16) bool, m map[int]int16) map[int]int16 {
+	if pred == nil {
+		return map[int]int16{}
+	}
+	return FilterKVIntInt16(func(_ int, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVIntInt16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntInt16(pred func(int, int16) bool, m map[int]int16) map[int]int16 {
+	newMap := make(map[int]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntInt16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, int, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntInt16(f func(int16, int, int16) int16, m map[int]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntInt8 returns list of keys of the map[int]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntInt8(m map[int]int8, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntInt8 returns list of values of the map[int]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntInt8(m map[int]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntInt8 returns a new map which contains only the given keys of the map.
+func SelectKeysIntInt8(m map[int]int8, keys ...int) map[int]int8 {
+	newMap := make(map[int]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntInt8 returns a new map without the given keys.
+func DissocKeysIntInt8(m map[int]int8, keys ...int) map[int]int8 {
+	newMap := make(map[int]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntInt8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntInt8(m map[int]int8) map[int8]int {
+	newMap := make(map[int8]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntInt8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntInt8(f func(int8) int8, m map[int]int8) map[int]int8 {
+	if f == nil {
+		return map[int]int8{}
+	}
+	newMap := make(map[int]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntInt8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntInt8(f func(int) int, m map[int]int8) map[int]int8 {
+	if f == nil {
+		return map[int]int8{}
+	}
+	newMap := make(map[int]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntInt8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntInt8(pred func(int) bool, m map[int]int8) map[int]int8 {
+	if pred == nil {
+		return map[int]int8{}
+	}
+	return FilterKVIntInt8(func(k int, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsIntInt8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntInt8(pred func(int8) bool, m map[int]int8) map[int]int8 {
+	if pred == nil {
+		return map[int]int8{}
+	}
+	return FilterKVIntInt8(func(_ int, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVIntInt8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntInt8(pred func(int, int8) bool, m map[int]int8) map[int]int8 {
+	newMap := make(map[int]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntInt8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, int, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntInt8(f func(int8, int, int8) int8, m map[int]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntUint returns list of keys of the map[int]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntUint(m map[int]uint, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntUint returns list of values of the map[int]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntUint(m map[int]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntUint returns a new map which contains only the given keys of the map.
+func SelectKeysIntUint(m map[int]uint, keys ...int) map[int]uint {
+	newMap := make(map[int]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntUint returns a new map without the given keys.
+func DissocKeysIntUint(m map[int]uint, keys ...int) map[int]uint {
+	newMap := make(map[int]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntUint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntUint(m map[int]uint) map[uint]int {
+	newMap := make(map[uint]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntUint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntUint(f func(uint) uint, m map[int]uint) map[int]uint {
+	if f == nil {
+		return map[int]uint{}
+	}
+	newMap := make(map[int]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntUint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntUint(f func(int) int, m map[int]uint) map[int]uint {
+	if f == nil {
+		return map[int]uint{}
+	}
+	newMap := make(map[int]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntUint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntUint(pred func(int) bool, m map[int]uint) map[int]uint {
+	if pred == nil {
+		return map[int]uint{}
+	}
+	return FilterKVIntUint(func(k int, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsIntUint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntUint(pred func(uint) bool, m map[int]uint) map[int]uint {
+	if pred == nil {
+		return map[int]uint{}
+	}
+	return FilterKVIntUint(func(_ int, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVIntUint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntUint(pred func(int, uint) bool, m map[int]uint) map[int]uint {
+	newMap := make(map[int]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntUint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, int, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntUint(f func(uint, int, uint) uint, m map[int]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntUint64 returns list of keys of the map[int]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntUint64(m map[int]uint64, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntUint64 returns list of values of the map[int]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntUint64(m map[int]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntUint64 returns a new map which contains only the given keys of the map.
+func SelectKeysIntUint64(m map[int]uint64, keys ...int) map[int]uint64 {
+	newMap := make(map[int]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntUint64 returns a new map without the given keys.
+func DissocKeysIntUint64(m map[int]uint64, keys ...int) map[int]uint64 {
+	newMap := make(map[int]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntUint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntUint64(m map[int]uint64) map[uint64]int {
+	newMap := make(map[uint64]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntUint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntUint64(f func(uint64) uint64, m map[int]uint64) map[int]uint64 {
+	if f == nil {
+		return map[int]uint64{}
+	}
+	newMap := make(map[int]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntUint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntUint64(f func(int) int, m map[int]uint64) map[int]uint64 {
+	if f == nil {
+		return map[int]uint64{}
+	}
+	newMap := make(map[int]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntUint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntUint64(pred func(int) bool, m map[int]uint64) map[int]uint64 {
+	if pred == nil {
+		return map[int]uint64{}
+	}
+	return FilterKVIntUint64(func(k int, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsIntUint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntUint64(pred func(uint64) bool, m map[int]uint64) map[int]uint64 {
+	if pred == nil {
+		return map[int]uint64{}
+	}
+	return FilterKVIntUint64(func(_ int, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVIntUint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntUint64(pred func(int, uint64) bool, m map[int]uint64) map[int]uint64 {
+	newMap := make(map[int]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntUint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, int, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntUint64(f func(uint64, int, uint64) uint64, m map[int]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntUint32 returns list of keys of the map[int]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntUint32(m map[int]uint32, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntUint32 returns list of values of the map[int]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntUint32(m map[int]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntUint32 returns a new map which contains only the given keys of the map.
+func SelectKeysIntUint32(m map[int]uint32, keys ...int) map[int]uint32 {
+	newMap := make(map[int]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntUint32 returns a new map without the given keys.
+func DissocKeysIntUint32(m map[int]uint32, keys ...int) map[int]uint32 {
+	newMap := make(map[int]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntUint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntUint32(m map[int]uint32) map[uint32]int {
+	newMap := make(map[uint32]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntUint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntUint32(f func(uint32) uint32, m map[int]uint32) map[int]uint32 {
+	if f == nil {
+		return map[int]uint32{}
+	}
+	newMap := make(map[int]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntUint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntUint32(f func(int) int, m map[int]uint32) map[int]uint32 {
+	if f == nil {
+		return map[int]uint32{}
+	}
+	newMap := make(map[int]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntUint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntUint32(pred func(int) bool, m map[int]uint32) map[int]uint32 {
+	if pred == nil {
+		return map[int]uint32{}
+	}
+	return FilterKVIntUint32(func(k int, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsIntUint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntUint32(pred func(uint32) bool, m map[int]uint32) map[int]uint32 {
+	if pred == nil {
+		return map[int]uint32{}
+	}
+	return FilterKVIntUint32(func(_ int, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVIntUint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntUint32(pred func(int, uint32) bool, m map[int]uint32) map[int]uint32 {
+	newMap := make(map[int]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntUint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, int, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntUint32(f func(uint32, int, uint32) uint32, m map[int]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntUint16 returns list of keys of the map[int]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntUint16(m map[int]uint16, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntUint16 returns list of values of the map[int]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntUint16(m map[int]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntUint16 returns a new map which contains only the given keys of the map.
+func SelectKeysIntUint16(m map[int]uint16, keys ...int) map[int]uint16 {
+	newMap := make(map[int]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntUint16 returns a new map without the given keys.
+func DissocKeysIntUint16(m map[int]uint16, keys ...int) map[int]uint16 {
+	newMap := make(map[int]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntUint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntUint16(m map[int]uint16) map[uint16]int {
+	newMap := make(map[uint16]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntUint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntUint16(f func(uint16) uint16, m map[int]uint16) map[int]uint16 {
+	if f == nil {
+		return map[int]uint16{}
+	}
+	newMap := make(map[int]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntUint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntUint16(f func(int) int, m map[int]uint16) map[int]uint16 {
+	if f == nil {
+		return map[int]uint16{}
+	}
+	newMap := make(map[int]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntUint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntUint16(pred func(int) bool, m map[int]uint16) map[int]uint16 {
+	if pred == nil {
+		return map[int]uint16{}
+	}
+	return FilterKVIntUint16(func(k int, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsIntUint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntUint16(pred func(uint16) bool, m map[int]uint16) map[int]uint16 {
+	if pred == nil {
+		return map[int]uint16{}
+	}
+	return FilterKVIntUint16(func(_ int, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVIntUint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntUint16(pred func(int, uint16) bool, m map[int]uint16) map[int]uint16 {
+	newMap := make(map[int]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntUint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, int, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntUint16(f func(uint16, int, uint16) uint16, m map[int]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntUint8 returns list of keys of the map[int]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntUint8(m map[int]uint8, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntUint8 returns list of values of the map[int]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntUint8(m map[int]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntUint8 returns a new map which contains only the given keys of the map.
+func SelectKeysIntUint8(m map[int]uint8, keys ...int) map[int]uint8 {
+	newMap := make(map[int]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntUint8 returns a new map without the given keys.
+func DissocKeysIntUint8(m map[int]uint8, keys ...int) map[int]uint8 {
+	newMap := make(map[int]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntUint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntUint8(m map[int]uint8) map[uint8]int {
+	newMap := make(map[uint8]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntUint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntUint8(f func(uint8) uint8, m map[int]uint8) map[int]uint8 {
+	if f == nil {
+		return map[int]uint8{}
+	}
+	newMap := make(map[int]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntUint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntUint8(f func(int) int, m map[int]uint8) map[int]uint8 {
+	if f == nil {
+		return map[int]uint8{}
+	}
+	newMap := make(map[int]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntUint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntUint8(pred func(int) bool, m map[int]uint8) map[int]uint8 {
+	if pred == nil {
+		return map[int]uint8{}
+	}
+	return FilterKVIntUint8(func(k int, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsIntUint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntUint8(pred func(uint8) bool, m map[int]uint8) map[int]uint8 {
+	if pred == nil {
+		return map[int]uint8{}
+	}
+	return FilterKVIntUint8(func(_ int, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVIntUint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntUint8(pred func(int, uint8) bool, m map[int]uint8) map[int]uint8 {
+	newMap := make(map[int]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntUint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, int, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntUint8(f func(uint8, int, uint8) uint8, m map[int]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntStr returns list of keys of the map[int]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntStr(m map[int]string, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntStr returns list of values of the map[int]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntStr(m map[int]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntStr returns a new map which contains only the given keys of the map.
+func SelectKeysIntStr(m map[int]string, keys ...int) map[int]string {
+	newMap := make(map[int]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntStr returns a new map without the given keys.
+func DissocKeysIntStr(m map[int]string, keys ...int) map[int]string {
+	newMap := make(map[int]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntStr(m map[int]string) map[string]int {
+	newMap := make(map[string]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntStr(f func(string) string, m map[int]string) map[int]string {
+	if f == nil {
+		return map[int]string{}
+	}
+	newMap := make(map[int]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntStr(f func(int) int, m map[int]string) map[int]string {
+	if f == nil {
+		return map[int]string{}
+	}
+	newMap := make(map[int]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntStr(pred func(int) bool, m map[int]string) map[int]string {
+	if pred == nil {
+		return map[int]string{}
+	}
+	return FilterKVIntStr(func(k int, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsIntStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntStr(pred func(string) bool, m map[int]string) map[int]string {
+	if pred == nil {
+		return map[int]string{}
+	}
+	return FilterKVIntStr(func(_ int, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVIntStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntStr(pred func(int, string) bool, m map[int]string) map[int]string {
+	newMap := make(map[int]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, int, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntStr(f func(string, int, string) string, m map[int]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysIntBool returns list of keys of the map[int]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntBool(m map[int]bool, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntBool returns list of values of the map[int]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntBool(m map[int]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntBool returns a new map which contains only the given keys of the map.
+func SelectKeysIntBool(m map[int]bool, keys ...int) map[int]bool {
+	newMap := make(map[int]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysIntBool returns a new map without the given keys.
+func DissocKeysIntBool(m map[int]bool, keys ...int) map[int]bool {
+	newMap := make(map[int]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntBool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntBool(m map[int]bool) map[bool]int {
+	newMap := make(map[bool]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntBool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntBool(f func(bool) bool, m map[int]bool) map[int]bool {
+	if f == nil {
+		return map[int]bool{}
+	}
+	newMap := make(map[int]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysIntBool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntBool(f func(int) int, m map[int]bool) map[int]bool {
+	if f == nil {
+		return map[int]bool{}
+	}
+	newMap := make(map[int]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysIntBool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntBool(pred func(int) bool, m map[int]bool) map[int]bool {
+	if pred == nil {
+		return map[int]bool{}
+	}
+	return FilterKVIntBool(func(k int, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsIntBool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntBool(pred func(bool) bool, m map[int]bool) map[int]bool {
+	if pred == nil {
+		return map[int]bool{}
+	}
+	return FilterKVIntBool(func(_ int, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVIntBool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntBool(pred func(int, bool) bool, m map[int]bool) map[int]bool {
+	newMap := make(map[int]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntBool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, int, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntBool(f func(bool, int, bool) bool, m map[int]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Int returns list of keys of the map[int64]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Int(m map[int64]int, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Int returns list of values of the map[int64]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Int(m map[int64]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Int returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Int(m map[int64]int, keys ...int64) map[int64]int {
+	newMap := make(map[int64]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Int returns a new map without the given keys.
+func DissocKeysInt64Int(m map[int64]int, keys ...int64) map[int64]int {
+	newMap := make(map[int64]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Int(m map[int64]int) map[int]int64 {
+	newMap := make(map[int]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Int(f func(int) int, m map[int64]int) map[int64]int {
+	if f == nil {
+		return map[int64]int{}
+	}
+	newMap := make(map[int64]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Int(f func(int64) int64, m map[int64]int) map[int64]int {
+	if f == nil {
+		return map[int64]int{}
+	}
+	newMap := make(map[int64]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Int(pred func(int64) bool, m map[int64]int) map[int64]int {
+	if pred == nil {
+		return map[int64]int{}
+	}
+	return FilterKVInt64Int(func(k int64, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Int(pred func(int) bool, m map[int64]int) map[int64]int {
+	if pred == nil {
+		return map[int64]int{}
+	}
+	return FilterKVInt64Int(func(_ int64, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Int(pred func(int64, int) bool, m map[int64]int) map[int64]int {
+	newMap := make(map[int64]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, int64, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Int(f func(int, int64, int) int, m map[int64]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64 returns list of keys of the map[int64]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64(m map[int64]int64, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64 returns list of values of the map[int64]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64(m map[int64]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64(m map[int64]int64, keys ...int64) map[int64]int64 {
+	newMap := make(map[int64]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64 returns a new map without the given keys.
+func DissocKeysInt64(m map[int64]int64, keys ...int64) map[int64]int64 {
+	newMap := make(map[int64]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64(m map[int64]int64) map[int64]int64 {
+	newMap := make(map[int64]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64(f func(int64) int64, m map[int64]int64) map[int64]int64 {
+	if f == nil {
+		return map[int64]int64{}
+	}
+	newMap := make(map[int64]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64(f func(int64) int64, m map[int64]int64) map[int64]int64 {
+	if f == nil {
+		return map[int64]int64{}
+	}
+	newMap := make(map[int64]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64(pred func(int64) bool, m map[int64]int64) map[int64]int64 {
+	if pred == nil {
+		return map[int64]int64{}
+	}
+	return FilterKVInt64(func(k int64, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64(pred func(int64) bool, m map[int64]int64) map[int64]int64 {
+	if pred == nil {
+		return map[int64]int64{}
+	}
+	return FilterKVInt64(func(_ int64, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64(pred func(int64, int64) bool, m map[int64]int64) map[int64]int64 {
+	newMap := make(map[int64]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, int64, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64(f func(int64, int64, int64) int64, m map[int64]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Int32 returns list of keys of the map[int64]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Int32(m map[int64]int32, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Int32 returns list of values of the map[int64]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Int32(m map[int64]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Int32(m map[int64]int32, keys ...int64) map[int64]int32 {
+	newMap := make(map[int64]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Int32 returns a new map without the given keys.
+func DissocKeysInt64Int32(m map[int64]int32, keys ...int64) map[int64]int32 {
+	newMap := make(map[int64]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Int32(m map[int64]int32) map[int32]int64 {
+	newMap := make(map[int32]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Int32(f func(int32) int32, m map[int64]int32) map[int64]int32 {
+	if f == nil {
+		return map[int64]int32{}
+	}
+	newMap := make(map[int64]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Int32(f func(int64) int64, m map[int64]int32) map[int64]int32 {
+	if f == nil {
+		return map[int64]int32{}
+	}
+	newMap := make(map[int64]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Int32(pred func(int64) bool, m map[int64]int32) map[int64]int32 {
+	if pred == nil {
+		return map[int64]int32{}
+	}
+	return FilterKVInt64Int32(func(k int64, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Int32(pred func(int32) bool, m map[int64]int32) map[int64]int32 {
+	if pred == nil {
+		return map[int64]int32{}
+	}
+	return FilterKVInt64Int32(func(_ int64, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Int32(pred func(int64, int32) bool, m map[int64]int32) map[int64]int32 {
+	newMap := make(map[int64]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, int64, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Int32(f func(int32, int64, int32) int32, m map[int64]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Int16 returns list of keys of the map[int64]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Int16(m map[int64]int16, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Int16 returns list of values of the map[int64]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Int16(m map[int64]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Int16(m map[int64]int16, keys ...int64) map[int64]int16 {
+	newMap := make(map[int64]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Int16 returns a new map without the given keys.
+func DissocKeysInt64Int16(m map[int64]int16, keys ...int64) map[int64]int16 {
+	newMap := make(map[int64]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Int16(m map[int64]int16) map[int16]int64 {
+	newMap := make(map[int16]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Int16(f func(int16) int16, m map[int64]int16) map[int64]int16 {
+	if f == nil {
+		return map[int64]int16{}
+	}
+	newMap := make(map[int64]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Int16(f func(int64) int64, m map[int64]int16) map[int64]int16 {
+	if f == nil {
+		return map[int64]int16{}
+	}
+	newMap := make(map[int64]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Int16(pred func(int64) bool, m map[int64]int16) map[int64]int16 {
+	if pred == nil {
+		return map[int64]int16{}
+	}
+	return FilterKVInt64Int16(func(k int64, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Int16(pred func(int16) bool, m map[int64]int16) map[int64]int16 {
+	if pred == nil {
+		return map[int64]int16{}
+	}
+	return FilterKVInt64Int16(func(_ int64, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Int16(pred func(int64, int16) bool, m map[int64]int16) map[int64]int16 {
+	newMap := make(map[int64]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, int64, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Int16(f func(int16, int64, int16) int16, m map[int64]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Int8 returns list of keys of the map[int64]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Int8(m map[int64]int8, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Int8 returns list of values of the map[int64]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Int8(m map[int64]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Int8(m map[int64]int8, keys ...int64) map[int64]int8 {
+	newMap := make(map[int64]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Int8 returns a new map without the given keys.
+func DissocKeysInt64Int8(m map[int64]int8, keys ...int64) map[int64]int8 {
+	newMap := make(map[int64]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Int8(m map[int64]int8) map[int8]int64 {
+	newMap := make(map[int8]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Int8(f func(int8) int8, m map[int64]int8) map[int64]int8 {
+	if f == nil {
+		return map[int64]int8{}
+	}
+	newMap := make(map[int64]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Int8(f func(int64) int64, m map[int64]int8) map[int64]int8 {
+	if f == nil {
+		return map[int64]int8{}
+	}
+	newMap := make(map[int64]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Int8(pred func(int64) bool, m map[int64]int8) map[int64]int8 {
+	if pred == nil {
+		return map[int64]int8{}
+	}
+	return FilterKVInt64Int8(func(k int64, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Int8(pred func(int8) bool, m map[int64]int8) map[int64]int8 {
+	if pred == nil {
+		return map[int64]int8{}
+	}
+	return FilterKVInt64Int8(func(_ int64, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Int8(pred func(int64, int8) bool, m map[int64]int8) map[int64]int8 {
+	newMap := make(map[int64]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, int64, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Int8(f func(int8, int64, int8) int8, m map[int64]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Uint returns list of keys of the map[int64]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Uint(m map[int64]uint, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Uint returns list of values of the map[int64]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Uint(m map[int64]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Uint returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Uint(m map[int64]uint, keys ...int64) map[int64]uint {
+	newMap := make(map[int64]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Uint returns a new map without the given keys.
+func DissocKeysInt64Uint(m map[int64]uint, keys ...int64) map[int64]uint {
+	newMap := make(map[int64]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Uint(m map[int64]uint) map[uint]int64 {
+	newMap := make(map[uint]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Uint(f func(uint) uint, m map[int64]uint) map[int64]uint {
+	if f == nil {
+		return map[int64]uint{}
+	}
+	newMap := make(map[int64]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Uint(f func(int64) int64, m map[int64]uint) map[int64]uint {
+	if f == nil {
+		return map[int64]uint{}
+	}
+	newMap := make(map[int64]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Uint(pred func(int64) bool, m map[int64]uint) map[int64]uint {
+	if pred == nil {
+		return map[int64]uint{}
+	}
+	return FilterKVInt64Uint(func(k int64, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Uint(pred func(uint) bool, m map[int64]uint) map[int64]uint {
+	if pred == nil {
+		return map[int64]uint{}
+	}
+	return FilterKVInt64Uint(func(_ int64, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Uint(pred func(int64, uint) bool, m map[int64]uint) map[int64]uint {
+	newMap := make(map[int64]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, int64, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Uint(f func(uint, int64, uint) uint, m map[int64]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Uint64 returns list of keys of the map[int64]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Uint64(m map[int64]uint64, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Uint64 returns list of values of the map[int64]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Uint64(m map[int64]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Uint64(m map[int64]uint64, keys ...int64) map[int64]uint64 {
+	newMap := make(map[int64]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Uint64 returns a new map without the given keys.
+func DissocKeysInt64Uint64(m map[int64]uint64, keys ...int64) map[int64]uint64 {
+	newMap := make(map[int64]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Uint64(m map[int64]uint64) map[uint64]int64 {
+	newMap := make(map[uint64]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Uint64(f func(uint64) uint64, m map[int64]uint64) map[int64]uint64 {
+	if f == nil {
+		return map[int64]uint64{}
+	}
+	newMap := make(map[int64]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Uint64(f func(int64) int64, m map[int64]uint64) map[int64]uint64 {
+	if f == nil {
+		return map[int64]uint64{}
+	}
+	newMap := make(map[int64]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Uint64(pred func(int64) bool, m map[int64]uint64) map[int64]uint64 {
+	if pred == nil {
+		return map[int64]uint64{}
+	}
+	return FilterKVInt64Uint64(func(k int64, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Uint64(pred func(uint64) bool, m map[int64]uint64) map[int64]uint64 {
+	if pred == nil {
+		return map[int64]uint64{}
+	}
+	return FilterKVInt64Uint64(func(_ int64, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Uint64(pred func(int64, uint64) bool, m map[int64]uint64) map[int64]uint64 {
+	newMap := make(map[int64]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, int64, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Uint64(f func(uint64, int64, uint64) uint64, m map[int64]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Uint32 returns list of keys of the map[int64]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Uint32(m map[int64]uint32, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Uint32 returns list of values of the map[int64]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Uint32(m map[int64]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Uint32(m map[int64]uint32, keys ...int64) map[int64]uint32 {
+	newMap := make(map[int64]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Uint32 returns a new map without the given keys.
+func DissocKeysInt64Uint32(m map[int64]uint32, keys ...int64) map[int64]uint32 {
+	newMap := make(map[int64]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Uint32(m map[int64]uint32) map[uint32]int64 {
+	newMap := make(map[uint32]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Uint32(f func(uint32) uint32, m map[int64]uint32) map[int64]uint32 {
+	if f == nil {
+		return map[int64]uint32{}
+	}
+	newMap := make(map[int64]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Uint32(f func(int64) int64, m map[int64]uint32) map[int64]uint32 {
+	if f == nil {
+		return map[int64]uint32{}
+	}
+	newMap := make(map[int64]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Uint32(pred func(int64) bool, m map[int64]uint32) map[int64]uint32 {
+	if pred == nil {
+		return map[int64]uint32{}
+	}
+	return FilterKVInt64Uint32(func(k int64, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Uint32(pred func(uint32) bool, m map[int64]uint32) map[int64]uint32 {
+	if pred == nil {
+		return map[int64]uint32{}
+	}
+	return FilterKVInt64Uint32(func(_ int64, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Uint32(pred func(int64, uint32) bool, m map[int64]uint32) map[int64]uint32 {
+	newMap := make(map[int64]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, int64, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Uint32(f func(uint32, int64, uint32) uint32, m map[int64]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Uint16 returns list of keys of the map[int64]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Uint16(m map[int64]uint16, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Uint16 returns list of values of the map[int64]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Uint16(m map[int64]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Uint16(m map[int64]uint16, keys ...int64) map[int64]uint16 {
+	newMap := make(map[int64]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Uint16 returns a new map without the given keys.
+func DissocKeysInt64Uint16(m map[int64]uint16, keys ...int64) map[int64]uint16 {
+	newMap := make(map[int64]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Uint16(m map[int64]uint16) map[uint16]int64 {
+	newMap := make(map[uint16]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Uint16(f func(uint16) uint16, m map[int64]uint16) map[int64]uint16 {
+	if f == nil {
+		return map[int64]uint16{}
+	}
+	newMap := make(map[int64]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Uint16(f func(int64) int64, m map[int64]uint16) map[int64]uint16 {
+	if f == nil {
+		return map[int64]uint16{}
+	}
+	newMap := make(map[int64]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Uint16(pred func(int64) bool, m map[int64]uint16) map[int64]uint16 {
+	if pred == nil {
+		return map[int64]uint16{}
+	}
+	return FilterKVInt64Uint16(func(k int64, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Uint16(pred func(uint16) bool, m map[int64]uint16) map[int64]uint16 {
+	if pred == nil {
+		return map[int64]uint16{}
+	}
+	return FilterKVInt64Uint16(func(_ int64, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Uint16(pred func(int64, uint16) bool, m map[int64]uint16) map[int64]uint16 {
+	newMap := make(map[int64]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, int64, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Uint16(f func(uint16, int64, uint16) uint16, m map[int64]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Uint8 returns list of keys of the map[int64]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Uint8(m map[int64]uint8, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Uint8 returns list of values of the map[int64]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Uint8(m map[int64]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Uint8(m map[int64]uint8, keys ...int64) map[int64]uint8 {
+	newMap := make(map[int64]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Uint8 returns a new map without the given keys.
+func DissocKeysInt64Uint8(m map[int64]uint8, keys ...int64) map[int64]uint8 {
+	newMap := make(map[int64]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Uint8(m map[int64]uint8) map[uint8]int64 {
+	newMap := make(map[uint8]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Uint8(f func(uint8) uint8, m map[int64]uint8) map[int64]uint8 {
+	if f == nil {
+		return map[int64]uint8{}
+	}
+	newMap := make(map[int64]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Uint8(f func(int64) int64, m map[int64]uint8) map[int64]uint8 {
+	if f == nil {
+		return map[int64]uint8{}
+	}
+	newMap := make(map[int64]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Uint8(pred func(int64) bool, m map[int64]uint8) map[int64]uint8 {
+	if pred == nil {
+		return map[int64]uint8{}
+	}
+	return FilterKVInt64Uint8(func(k int64, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Uint8(pred func(uint8) bool, m map[int64]uint8) map[int64]uint8 {
+	if pred == nil {
+		return map[int64]uint8{}
+	}
+	return FilterKVInt64Uint8(func(_ int64, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Uint8(pred func(int64, uint8) bool, m map[int64]uint8) map[int64]uint8 {
+	newMap := make(map[int64]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, int64, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Uint8(f func(uint8, int64, uint8) uint8, m map[int64]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Str returns list of keys of the map[int64]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Str(m map[int64]string, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Str returns list of values of the map[int64]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Str(m map[int64]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Str returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Str(m map[int64]string, keys ...int64) map[int64]string {
+	newMap := make(map[int64]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Str returns a new map without the given keys.
+func DissocKeysInt64Str(m map[int64]string, keys ...int64) map[int64]string {
+	newMap := make(map[int64]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Str(m map[int64]string) map[string]int64 {
+	newMap := make(map[string]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Str(f func(string) string, m map[int64]string) map[int64]string {
+	if f == nil {
+		return map[int64]string{}
+	}
+	newMap := make(map[int64]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Str(f func(int64) int64, m map[int64]string) map[int64]string {
+	if f == nil {
+		return map[int64]string{}
+	}
+	newMap := make(map[int64]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Str(pred func(int64) bool, m map[int64]string) map[int64]string {
+	if pred == nil {
+		return map[int64]string{}
+	}
+	return FilterKVInt64Str(func(k int64, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Str(pred func(string) bool, m map[int64]string) map[int64]string {
+	if pred == nil {
+		return map[int64]string{}
+	}
+	return FilterKVInt64Str(func(_ int64, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Str(pred func(int64, string) bool, m map[int64]string) map[int64]string {
+	newMap := make(map[int64]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, int64, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Str(f func(string, int64, string) string, m map[int64]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt64Bool returns list of keys of the map[int64]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt64Bool(m map[int64]bool, less ...func(int64, int64) bool) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt64Bool returns list of values of the map[int64]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt64Bool(m map[int64]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt64Bool returns a new map which contains only the given keys of the map.
+func SelectKeysInt64Bool(m map[int64]bool, keys ...int64) map[int64]bool {
+	newMap := make(map[int64]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt64Bool returns a new map without the given keys.
+func DissocKeysInt64Bool(m map[int64]bool, keys ...int64) map[int64]bool {
+	newMap := make(map[int64]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt64Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt64Bool(m map[int64]bool) map[bool]int64 {
+	newMap := make(map[bool]int64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt64Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt64Bool(f func(bool) bool, m map[int64]bool) map[int64]bool {
+	if f == nil {
+		return map[int64]bool{}
+	}
+	newMap := make(map[int64]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt64Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt64Bool(f func(int64) int64, m map[int64]bool) map[int64]bool {
+	if f == nil {
+		return map[int64]bool{}
+	}
+	newMap := make(map[int64]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt64Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt64Bool(pred func(int64) bool, m map[int64]bool) map[int64]bool {
+	if pred == nil {
+		return map[int64]bool{}
+	}
+	return FilterKVInt64Bool(func(k int64, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsInt64Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt64Bool(pred func(bool) bool, m map[int64]bool) map[int64]bool {
+	if pred == nil {
+		return map[int64]bool{}
+	}
+	return FilterKVInt64Bool(func(_ int64, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVInt64Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt64Bool(pred func(int64, bool) bool, m map[int64]bool) map[int64]bool {
+	newMap := make(map[int64]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt64Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, int64, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt64Bool(f func(bool, int64, bool) bool, m map[int64]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Int returns list of keys of the map[int32]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Int(m map[int32]int, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Int returns list of values of the map[int32]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Int(m map[int32]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Int returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Int(m map[int32]int, keys ...int32) map[int32]int {
+	newMap := make(map[int32]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Int returns a new map without the given keys.
+func DissocKeysInt32Int(m map[int32]int, keys ...int32) map[int32]int {
+	newMap := make(map[int32]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Int(m map[int32]int) map[int]int32 {
+	newMap := make(map[int]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Int(f func(int) int, m map[int32]int) map[int32]int {
+	if f == nil {
+		return map[int32]int{}
+	}
+	newMap := make(map[int32]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Int(f func(int32) int32, m map[int32]int) map[int32]int {
+	if f == nil {
+		return map[int32]int{}
+	}
+	newMap := make(map[int32]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Int(pred func(int32) bool, m map[int32]int) map[int32]int {
+	if pred == nil {
+		return map[int32]int{}
+	}
+	return FilterKVInt32Int(func(k int32, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Int(pred func(int) bool, m map[int32]int) map[int32]int {
+	if pred == nil {
+		return map[int32]int{}
+	}
+	return FilterKVInt32Int(func(_ int32, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Int(pred func(int32, int) bool, m map[int32]int) map[int32]int {
+	newMap := make(map[int32]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, int32, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Int(f func(int, int32, int) int, m map[int32]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Int64 returns list of keys of the map[int32]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Int64(m map[int32]int64, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Int64 returns list of values of the map[int32]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Int64(m map[int32]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Int64(m map[int32]int64, keys ...int32) map[int32]int64 {
+	newMap := make(map[int32]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Int64 returns a new map without the given keys.
+func DissocKeysInt32Int64(m map[int32]int64, keys ...int32) map[int32]int64 {
+	newMap := make(map[int32]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Int64(m map[int32]int64) map[int64]int32 {
+	newMap := make(map[int64]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Int64(f func(int64) int64, m map[int32]int64) map[int32]int64 {
+	if f == nil {
+		return map[int32]int64{}
+	}
+	newMap := make(map[int32]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Int64(f func(int32) int32, m map[int32]int64) map[int32]int64 {
+	if f == nil {
+		return map[int32]int64{}
+	}
+	newMap := make(map[int32]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Int64(pred func(int32) bool, m map[int32]int64) map[int32]int64 {
+	if pred == nil {
+		return map[int32]int64{}
+	}
+	return FilterKVInt32Int64(func(k int32, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Int64(pred func(int64) bool, m map[int32]int64) map[int32]int64 {
+	if pred == nil {
+		return map[int32]int64{}
+	}
+	return FilterKVInt32Int64(func(_ int32, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Int64(pred func(int32, int64) bool, m map[int32]int64) map[int32]int64 {
+	newMap := make(map[int32]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, int32, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Int64(f func(int64, int32, int64) int64, m map[int32]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32 returns list of keys of the map[int32]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32(m map[int32]int32, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32 returns list of values of the map[int32]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32(m map[int32]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32(m map[int32]int32, keys ...int32) map[int32]int32 {
+	newMap := make(map[int32]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32 returns a new map without the given keys.
+func DissocKeysInt32(m map[int32]int32, keys ...int32) map[int32]int32 {
+	newMap := make(map[int32]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32(m map[int32]int32) map[int32]int32 {
+	newMap := make(map[int32]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32(f func(int32) int32, m map[int32]int32) map[int32]int32 {
+	if f == nil {
+		return map[int32]int32{}
+	}
+	newMap := make(map[int32]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32(f func(int32) int32, m map[int32]int32) map[int32]int32 {
+	if f == nil {
+		return map[int32]int32{}
+	}
+	newMap := make(map[int32]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32(pred func(int32) bool, m map[int32]int32) map[int32]int32 {
+	if pred == nil {
+		return map[int32]int32{}
+	}
+	return FilterKVInt32(func(k int32, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32(pred func(int32) bool, m map[int32]int32) map[int32]int32 {
+	if pred == nil {
+		return map[int32]int32{}
+	}
+	return FilterKVInt32(func(_ int32, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32(pred func(int32, int32) bool, m map[int32]int32) map[int32]int32 {
+	newMap := make(map[int32]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, int32, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32(f func(int32, int32, int32) int32, m map[int32]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Int16 returns list of keys of the map[int32]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Int16(m map[int32]int16, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Int16 returns list of values of the map[int32]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Int16(m map[int32]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Int16(m map[int32]int16, keys ...int32) map[int32]int16 {
+	newMap := make(map[int32]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Int16 returns a new map without the given keys.
+func DissocKeysInt32Int16(m map[int32]int16, keys ...int32) map[int32]int16 {
+	newMap := make(map[int32]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Int16(m map[int32]int16) map[int16]int32 {
+	newMap := make(map[int16]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Int16(f func(int16) int16, m map[int32]int16) map[int32]int16 {
+	if f == nil {
+		return map[int32]int16{}
+	}
+	newMap := make(map[int32]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Int16(f func(int32) int32, m map[int32]int16) map[int32]int16 {
+	if f == nil {
+		return map[int32]int16{}
+	}
+	newMap := make(map[int32]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Int16(pred func(int32) bool, m map[int32]int16) map[int32]int16 {
+	if pred == nil {
+		return map[int32]int16{}
+	}
+	return FilterKVInt32Int16(func(k int32, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Int16(pred func(int16) bool, m map[int32]int16) map[int32]int16 {
+	if pred == nil {
+		return map[int32]int16{}
+	}
+	return FilterKVInt32Int16(func(_ int32, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Int16(pred func(int32, int16) bool, m map[int32]int16) map[int32]int16 {
+	newMap := make(map[int32]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, int32, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Int16(f func(int16, int32, int16) int16, m map[int32]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Int8 returns list of keys of the map[int32]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Int8(m map[int32]int8, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Int8 returns list of values of the map[int32]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Int8(m map[int32]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Int8(m map[int32]int8, keys ...int32) map[int32]int8 {
+	newMap := make(map[int32]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Int8 returns a new map without the given keys.
+func DissocKeysInt32Int8(m map[int32]int8, keys ...int32) map[int32]int8 {
+	newMap := make(map[int32]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Int8(m map[int32]int8) map[int8]int32 {
+	newMap := make(map[int8]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Int8(f func(int8) int8, m map[int32]int8) map[int32]int8 {
+	if f == nil {
+		return map[int32]int8{}
+	}
+	newMap := make(map[int32]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Int8(f func(int32) int32, m map[int32]int8) map[int32]int8 {
+	if f == nil {
+		return map[int32]int8{}
+	}
+	newMap := make(map[int32]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Int8(pred func(int32) bool, m map[int32]int8) map[int32]int8 {
+	if pred == nil {
+		return map[int32]int8{}
+	}
+	return FilterKVInt32Int8(func(k int32, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Int8(pred func(int8) bool, m map[int32]int8) map[int32]int8 {
+	if pred == nil {
+		return map[int32]int8{}
+	}
+	return FilterKVInt32Int8(func(_ int32, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Int8(pred func(int32, int8) bool, m map[int32]int8) map[int32]int8 {
+	newMap := make(map[int32]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, int32, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Int8(f func(int8, int32, int8) int8, m map[int32]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Uint returns list of keys of the map[int32]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Uint(m map[int32]uint, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Uint returns list of values of the map[int32]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Uint(m map[int32]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Uint returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Uint(m map[int32]uint, keys ...int32) map[int32]uint {
+	newMap := make(map[int32]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Uint returns a new map without the given keys.
+func DissocKeysInt32Uint(m map[int32]uint, keys ...int32) map[int32]uint {
+	newMap := make(map[int32]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Uint(m map[int32]uint) map[uint]int32 {
+	newMap := make(map[uint]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Uint(f func(uint) uint, m map[int32]uint) map[int32]uint {
+	if f == nil {
+		return map[int32]uint{}
+	}
+	newMap := make(map[int32]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Uint(f func(int32) int32, m map[int32]uint) map[int32]uint {
+	if f == nil {
+		return map[int32]uint{}
+	}
+	newMap := make(map[int32]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Uint(pred func(int32) bool, m map[int32]uint) map[int32]uint {
+	if pred == nil {
+		return map[int32]uint{}
+	}
+	return FilterKVInt32Uint(func(k int32, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Uint(pred func(uint) bool, m map[int32]uint) map[int32]uint {
+	if pred == nil {
+		return map[int32]uint{}
+	}
+	return FilterKVInt32Uint(func(_ int32, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Uint(pred func(int32, uint) bool, m map[int32]uint) map[int32]uint {
+	newMap := make(map[int32]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, int32, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Uint(f func(uint, int32, uint) uint, m map[int32]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Uint64 returns list of keys of the map[int32]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Uint64(m map[int32]uint64, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Uint64 returns list of values of the map[int32]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Uint64(m map[int32]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Uint64(m map[int32]uint64, keys ...int32) map[int32]uint64 {
+	newMap := make(map[int32]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Uint64 returns a new map without the given keys.
+func DissocKeysInt32Uint64(m map[int32]uint64, keys ...int32) map[int32]uint64 {
+	newMap := make(map[int32]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Uint64(m map[int32]uint64) map[uint64]int32 {
+	newMap := make(map[uint64]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Uint64(f func(uint64) uint64, m map[int32]uint64) map[int32]uint64 {
+	if f == nil {
+		return map[int32]uint64{}
+	}
+	newMap := make(map[int32]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Uint64(f func(int32) int32, m map[int32]uint64) map[int32]uint64 {
+	if f == nil {
+		return map[int32]uint64{}
+	}
+	newMap := make(map[int32]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Uint64(pred func(int32) bool, m map[int32]uint64) map[int32]uint64 {
+	if pred == nil {
+		return map[int32]uint64{}
+	}
+	return FilterKVInt32Uint64(func(k int32, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Uint64(pred func(uint64) bool, m map[int32]uint64) map[int32]uint64 {
+	if pred == nil {
+		return map[int32]uint64{}
+	}
+	return FilterKVInt32Uint64(func(_ int32, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Uint64(pred func(int32, uint64) bool, m map[int32]uint64) map[int32]uint64 {
+	newMap := make(map[int32]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, int32, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Uint64(f func(uint64, int32, uint64) uint64, m map[int32]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Uint32 returns list of keys of the map[int32]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Uint32(m map[int32]uint32, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Uint32 returns list of values of the map[int32]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Uint32(m map[int32]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Uint32(m map[int32]uint32, keys ...int32) map[int32]uint32 {
+	newMap := make(map[int32]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Uint32 returns a new map without the given keys.
+func DissocKeysInt32Uint32(m map[int32]uint32, keys ...int32) map[int32]uint32 {
+	newMap := make(map[int32]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Uint32(m map[int32]uint32) map[uint32]int32 {
+	newMap := make(map[uint32]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Uint32(f func(uint32) uint32, m map[int32]uint32) map[int32]uint32 {
+	if f == nil {
+		return map[int32]uint32{}
+	}
+	newMap := make(map[int32]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Uint32(f func(int32) int32, m map[int32]uint32) map[int32]uint32 {
+	if f == nil {
+		return map[int32]uint32{}
+	}
+	newMap := make(map[int32]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Uint32(pred func(int32) bool, m map[int32]uint32) map[int32]uint32 {
+	if pred == nil {
+		return map[int32]uint32{}
+	}
+	return FilterKVInt32Uint32(func(k int32, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Uint32(pred func(uint32) bool, m map[int32]uint32) map[int32]uint32 {
+	if pred == nil {
+		return map[int32]uint32{}
+	}
+	return FilterKVInt32Uint32(func(_ int32, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Uint32(pred func(int32, uint32) bool, m map[int32]uint32) map[int32]uint32 {
+	newMap := make(map[int32]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, int32, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Uint32(f func(uint32, int32, uint32) uint32, m map[int32]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Uint16 returns list of keys of the map[int32]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Uint16(m map[int32]uint16, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Uint16 returns list of values of the map[int32]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Uint16(m map[int32]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Uint16(m map[int32]uint16, keys ...int32) map[int32]uint16 {
+	newMap := make(map[int32]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Uint16 returns a new map without the given keys.
+func DissocKeysInt32Uint16(m map[int32]uint16, keys ...int32) map[int32]uint16 {
+	newMap := make(map[int32]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Uint16(m map[int32]uint16) map[uint16]int32 {
+	newMap := make(map[uint16]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Uint16(f func(uint16) uint16, m map[int32]uint16) map[int32]uint16 {
+	if f == nil {
+		return map[int32]uint16{}
+	}
+	newMap := make(map[int32]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Uint16(f func(int32) int32, m map[int32]uint16) map[int32]uint16 {
+	if f == nil {
+		return map[int32]uint16{}
+	}
+	newMap := make(map[int32]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Uint16(pred func(int32) bool, m map[int32]uint16) map[int32]uint16 {
+	if pred == nil {
+		return map[int32]uint16{}
+	}
+	return FilterKVInt32Uint16(func(k int32, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Uint16(pred func(uint16) bool, m map[int32]uint16) map[int32]uint16 {
+	if pred == nil {
+		return map[int32]uint16{}
+	}
+	return FilterKVInt32Uint16(func(_ int32, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Uint16(pred func(int32, uint16) bool, m map[int32]uint16) map[int32]uint16 {
+	newMap := make(map[int32]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, int32, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Uint16(f func(uint16, int32, uint16) uint16, m map[int32]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Uint8 returns list of keys of the map[int32]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Uint8(m map[int32]uint8, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Uint8 returns list of values of the map[int32]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Uint8(m map[int32]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Uint8(m map[int32]uint8, keys ...int32) map[int32]uint8 {
+	newMap := make(map[int32]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Uint8 returns a new map without the given keys.
+func DissocKeysInt32Uint8(m map[int32]uint8, keys ...int32) map[int32]uint8 {
+	newMap := make(map[int32]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Uint8(m map[int32]uint8) map[uint8]int32 {
+	newMap := make(map[uint8]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Uint8(f func(uint8) uint8, m map[int32]uint8) map[int32]uint8 {
+	if f == nil {
+		return map[int32]uint8{}
+	}
+	newMap := make(map[int32]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Uint8(f func(int32) int32, m map[int32]uint8) map[int32]uint8 {
+	if f == nil {
+		return map[int32]uint8{}
+	}
+	newMap := make(map[int32]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Uint8(pred func(int32) bool, m map[int32]uint8) map[int32]uint8 {
+	if pred == nil {
+		return map[int32]uint8{}
+	}
+	return FilterKVInt32Uint8(func(k int32, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Uint8(pred func(uint8) bool, m map[int32]uint8) map[int32]uint8 {
+	if pred == nil {
+		return map[int32]uint8{}
+	}
+	return FilterKVInt32Uint8(func(_ int32, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Uint8(pred func(int32, uint8) bool, m map[int32]uint8) map[int32]uint8 {
+	newMap := make(map[int32]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, int32, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Uint8(f func(uint8, int32, uint8) uint8, m map[int32]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Str returns list of keys of the map[int32]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Str(m map[int32]string, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Str returns list of values of the map[int32]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Str(m map[int32]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Str returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Str(m map[int32]string, keys ...int32) map[int32]string {
+	newMap := make(map[int32]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Str returns a new map without the given keys.
+func DissocKeysInt32Str(m map[int32]string, keys ...int32) map[int32]string {
+	newMap := make(map[int32]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Str(m map[int32]string) map[string]int32 {
+	newMap := make(map[string]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Str(f func(string) string, m map[int32]string) map[int32]string {
+	if f == nil {
+		return map[int32]string{}
+	}
+	newMap := make(map[int32]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Str(f func(int32) int32, m map[int32]string) map[int32]string {
+	if f == nil {
+		return map[int32]string{}
+	}
+	newMap := make(map[int32]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Str(pred func(int32) bool, m map[int32]string) map[int32]string {
+	if pred == nil {
+		return map[int32]string{}
+	}
+	return FilterKVInt32Str(func(k int32, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Str(pred func(string) bool, m map[int32]string) map[int32]string {
+	if pred == nil {
+		return map[int32]string{}
+	}
+	return FilterKVInt32Str(func(_ int32, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Str(pred func(int32, string) bool, m map[int32]string) map[int32]string {
+	newMap := make(map[int32]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, int32, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Str(f func(string, int32, string) string, m map[int32]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt32Bool returns list of keys of the map[int32]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt32Bool(m map[int32]bool, less ...func(int32, int32) bool) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt32Bool returns list of values of the map[int32]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt32Bool(m map[int32]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt32Bool returns a new map which contains only the given keys of the map.
+func SelectKeysInt32Bool(m map[int32]bool, keys ...int32) map[int32]bool {
+	newMap := make(map[int32]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt32Bool returns a new map without the given keys.
+func DissocKeysInt32Bool(m map[int32]bool, keys ...int32) map[int32]bool {
+	newMap := make(map[int32]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt32Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt32Bool(m map[int32]bool) map[bool]int32 {
+	newMap := make(map[bool]int32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt32Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt32Bool(f func(bool) bool, m map[int32]bool) map[int32]bool {
+	if f == nil {
+		return map[int32]bool{}
+	}
+	newMap := make(map[int32]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt32Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt32Bool(f func(int32) int32, m map[int32]bool) map[int32]bool {
+	if f == nil {
+		return map[int32]bool{}
+	}
+	newMap := make(map[int32]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt32Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt32Bool(pred func(int32) bool, m map[int32]bool) map[int32]bool {
+	if pred == nil {
+		return map[int32]bool{}
+	}
+	return FilterKVInt32Bool(func(k int32, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsInt32Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt32Bool(pred func(bool) bool, m map[int32]bool) map[int32]bool {
+	if pred == nil {
+		return map[int32]bool{}
+	}
+	return FilterKVInt32Bool(func(_ int32, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVInt32Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt32Bool(pred func(int32, bool) bool, m map[int32]bool) map[int32]bool {
+	newMap := make(map[int32]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt32Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, int32, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt32Bool(f func(bool, int32, bool) bool, m map[int32]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Int returns list of keys of the map[int16]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Int(m map[int16]int, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Int returns list of values of the map[int16]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Int(m map[int16]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Int returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Int(m map[int16]int, keys ...int16) map[int16]int {
+	newMap := make(map[int16]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Int returns a new map without the given keys.
+func DissocKeysInt16Int(m map[int16]int, keys ...int16) map[int16]int {
+	newMap := make(map[int16]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Int(m map[int16]int) map[int]int16 {
+	newMap := make(map[int]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Int(f func(int) int, m map[int16]int) map[int16]int {
+	if f == nil {
+		return map[int16]int{}
+	}
+	newMap := make(map[int16]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Int(f func(int16) int16, m map[int16]int) map[int16]int {
+	if f == nil {
+		return map[int16]int{}
+	}
+	newMap := make(map[int16]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Int(pred func(int16) bool, m map[int16]int) map[int16]int {
+	if pred == nil {
+		return map[int16]int{}
+	}
+	return FilterKVInt16Int(func(k int16, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Int(pred func(int) bool, m map[int16]int) map[int16]int {
+	if pred == nil {
+		return map[int16]int{}
+	}
+	return FilterKVInt16Int(func(_ int16, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Int(pred func(int16, int) bool, m map[int16]int) map[int16]int {
+	newMap := make(map[int16]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, int16, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Int(f func(int, int16, int) int, m map[int16]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Int64 returns list of keys of the map[int16]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Int64(m map[int16]int64, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Int64 returns list of values of the map[int16]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Int64(m map[int16]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Int64(m map[int16]int64, keys ...int16) map[int16]int64 {
+	newMap := make(map[int16]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Int64 returns a new map without the given keys.
+func DissocKeysInt16Int64(m map[int16]int64, keys ...int16) map[int16]int64 {
+	newMap := make(map[int16]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Int64(m map[int16]int64) map[int64]int16 {
+	newMap := make(map[int64]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Int64(f func(int64) int64, m map[int16]int64) map[int16]int64 {
+	if f == nil {
+		return map[int16]int64{}
+	}
+	newMap := make(map[int16]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Int64(f func(int16) int16, m map[int16]int64) map[int16]int64 {
+	if f == nil {
+		return map[int16]int64{}
+	}
+	newMap := make(map[int16]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Int64(pred func(int16) bool, m map[int16]int64) map[int16]int64 {
+	if pred == nil {
+		return map[int16]int64{}
+	}
+	return FilterKVInt16Int64(func(k int16, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Int64(pred func(int64) bool, m map[int16]int64) map[int16]int64 {
+	if pred == nil {
+		return map[int16]int64{}
+	}
+	return FilterKVInt16Int64(func(_ int16, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Int64(pred func(int16, int64) bool, m map[int16]int64) map[int16]int64 {
+	newMap := make(map[int16]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, int16, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Int64(f func(int64, int16, int64) int64, m map[int16]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Int32 returns list of keys of the map[int16]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Int32(m map[int16]int32, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Int32 returns list of values of the map[int16]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Int32(m map[int16]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Int32(m map[int16]int32, keys ...int16) map[int16]int32 {
+	newMap := make(map[int16]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Int32 returns a new map without the given keys.
+func DissocKeysInt16Int32(m map[int16]int32, keys ...int16) map[int16]int32 {
+	newMap := make(map[int16]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Int32(m map[int16]int32) map[int32]int16 {
+	newMap := make(map[int32]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Int32(f func(int32) int32, m map[int16]int32) map[int16]int32 {
+	if f == nil {
+		return map[int16]int32{}
+	}
+	newMap := make(map[int16]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Int32(f func(int16) int16, m map[int16]int32) map[int16]int32 {
+	if f == nil {
+		return map[int16]int32{}
+	}
+	newMap := make(map[int16]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Int32(pred func(int16) bool, m map[int16]int32) map[int16]int32 {
+	if pred == nil {
+		return map[int16]int32{}
+	}
+	return FilterKVInt16Int32(func(k int16, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Int32(pred func(int32) bool, m map[int16]int32) map[int16]int32 {
+	if pred == nil {
+		return map[int16]int32{}
+	}
+	return FilterKVInt16Int32(func(_ int16, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Int32(pred func(int16, int32) bool, m map[int16]int32) map[int16]int32 {
+	newMap := make(map[int16]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, int16, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Int32(f func(int32, int16, int32) int32, m map[int16]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16 returns list of keys of the map[int16]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16(m map[int16]int16, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16 returns list of values of the map[int16]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16(m map[int16]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16(m map[int16]int16, keys ...int16) map[int16]int16 {
+	newMap := make(map[int16]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16 returns a new map without the given keys.
+func DissocKeysInt16(m map[int16]int16, keys ...int16) map[int16]int16 {
+	newMap := make(map[int16]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16(m map[int16]int16) map[int16]int16 {
+	newMap := make(map[int16]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16(f func(int16) int16, m map[int16]int16) map[int16]int16 {
+	if f == nil {
+		return map[int16]int16{}
+	}
+	newMap := make(map[int16]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16(f func(int16) int16, m map[int16]int16) map[int16]int16 {
+	if f == nil {
+		return map[int16]int16{}
+	}
+	newMap := make(map[int16]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16(pred func(int16) bool, m map[int16]int16) map[int16]int16 {
+	if pred == nil {
+		return map[int16]int16{}
+	}
+	return FilterKVInt16(func(k int16, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16(pred func(int16) bool, m map[int16]int16) map[int16]int16 {
+	if pred == nil {
+		return map[int16]int16{}
+	}
+	return FilterKVInt16(func(_ int16, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16(pred func(int16, int16) bool, m map[int16]int16) map[int16]int16 {
+	newMap := make(map[int16]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, int16, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16(f func(int16, int16, int16) int16, m map[int16]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Int8 returns list of keys of the map[int16]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Int8(m map[int16]int8, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Int8 returns list of values of the map[int16]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Int8(m map[int16]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Int8(m map[int16]int8, keys ...int16) map[int16]int8 {
+	newMap := make(map[int16]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Int8 returns a new map without the given keys.
+func DissocKeysInt16Int8(m map[int16]int8, keys ...int16) map[int16]int8 {
+	newMap := make(map[int16]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Int8(m map[int16]int8) map[int8]int16 {
+	newMap := make(map[int8]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Int8(f func(int8) int8, m map[int16]int8) map[int16]int8 {
+	if f == nil {
+		return map[int16]int8{}
+	}
+	newMap := make(map[int16]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Int8(f func(int16) int16, m map[int16]int8) map[int16]int8 {
+	if f == nil {
+		return map[int16]int8{}
+	}
+	newMap := make(map[int16]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Int8(pred func(int16) bool, m map[int16]int8) map[int16]int8 {
+	if pred == nil {
+		return map[int16]int8{}
+	}
+	return FilterKVInt16Int8(func(k int16, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Int8(pred func(int8) bool, m map[int16]int8) map[int16]int8 {
+	if pred == nil {
+		return map[int16]int8{}
+	}
+	return FilterKVInt16Int8(func(_ int16, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Int8(pred func(int16, int8) bool, m map[int16]int8) map[int16]int8 {
+	newMap := make(map[int16]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, int16, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Int8(f func(int8, int16, int8) int8, m map[int16]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Uint returns list of keys of the map[int16]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Uint(m map[int16]uint, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Uint returns list of values of the map[int16]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Uint(m map[int16]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Uint returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Uint(m map[int16]uint, keys ...int16) map[int16]uint {
+	newMap := make(map[int16]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Uint returns a new map without the given keys.
+func DissocKeysInt16Uint(m map[int16]uint, keys ...int16) map[int16]uint {
+	newMap := make(map[int16]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Uint(m map[int16]uint) map[uint]int16 {
+	newMap := make(map[uint]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Uint(f func(uint) uint, m map[int16]uint) map[int16]uint {
+	if f == nil {
+		return map[int16]uint{}
+	}
+	newMap := make(map[int16]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Uint(f func(int16) int16, m map[int16]uint) map[int16]uint {
+	if f == nil {
+		return map[int16]uint{}
+	}
+	newMap := make(map[int16]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Uint(pred func(int16) bool, m map[int16]uint) map[int16]uint {
+	if pred == nil {
+		return map[int16]uint{}
+	}
+	return FilterKVInt16Uint(func(k int16, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Uint(pred func(uint) bool, m map[int16]uint) map[int16]uint {
+	if pred == nil {
+		return map[int16]uint{}
+	}
+	return FilterKVInt16Uint(func(_ int16, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Uint(pred func(int16, uint) bool, m map[int16]uint) map[int16]uint {
+	newMap := make(map[int16]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, int16, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Uint(f func(uint, int16, uint) uint, m map[int16]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Uint64 returns list of keys of the map[int16]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Uint64(m map[int16]uint64, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Uint64 returns list of values of the map[int16]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Uint64(m map[int16]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Uint64(m map[int16]uint64, keys ...int16) map[int16]uint64 {
+	newMap := make(map[int16]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Uint64 returns a new map without the given keys.
+func DissocKeysInt16Uint64(m map[int16]uint64, keys ...int16) map[int16]uint64 {
+	newMap := make(map[int16]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Uint64(m map[int16]uint64) map[uint64]int16 {
+	newMap := make(map[uint64]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Uint64(f func(uint64) uint64, m map[int16]uint64) map[int16]uint64 {
+	if f == nil {
+		return map[int16]uint64{}
+	}
+	newMap := make(map[int16]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Uint64(f func(int16) int16, m map[int16]uint64) map[int16]uint64 {
+	if f == nil {
+		return map[int16]uint64{}
+	}
+	newMap := make(map[int16]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Uint64(pred func(int16) bool, m map[int16]uint64) map[int16]uint64 {
+	if pred == nil {
+		return map[int16]uint64{}
+	}
+	return FilterKVInt16Uint64(func(k int16, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Uint64(pred func(uint64) bool, m map[int16]uint64) map[int16]uint64 {
+	if pred == nil {
+		return map[int16]uint64{}
+	}
+	return FilterKVInt16Uint64(func(_ int16, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Uint64(pred func(int16, uint64) bool, m map[int16]uint64) map[int16]uint64 {
+	newMap := make(map[int16]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, int16, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Uint64(f func(uint64, int16, uint64) uint64, m map[int16]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Uint32 returns list of keys of the map[int16]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Uint32(m map[int16]uint32, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Uint32 returns list of values of the map[int16]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Uint32(m map[int16]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Uint32(m map[int16]uint32, keys ...int16) map[int16]uint32 {
+	newMap := make(map[int16]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Uint32 returns a new map without the given keys.
+func DissocKeysInt16Uint32(m map[int16]uint32, keys ...int16) map[int16]uint32 {
+	newMap := make(map[int16]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Uint32(m map[int16]uint32) map[uint32]int16 {
+	newMap := make(map[uint32]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Uint32(f func(uint32) uint32, m map[int16]uint32) map[int16]uint32 {
+	if f == nil {
+		return map[int16]uint32{}
+	}
+	newMap := make(map[int16]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Uint32(f func(int16) int16, m map[int16]uint32) map[int16]uint32 {
+	if f == nil {
+		return map[int16]uint32{}
+	}
+	newMap := make(map[int16]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Uint32(pred func(int16) bool, m map[int16]uint32) map[int16]uint32 {
+	if pred == nil {
+		return map[int16]uint32{}
+	}
+	return FilterKVInt16Uint32(func(k int16, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Uint32(pred func(uint32) bool, m map[int16]uint32) map[int16]uint32 {
+	if pred == nil {
+		return map[int16]uint32{}
+	}
+	return FilterKVInt16Uint32(func(_ int16, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Uint32(pred func(int16, uint32) bool, m map[int16]uint32) map[int16]uint32 {
+	newMap := make(map[int16]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, int16, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Uint32(f func(uint32, int16, uint32) uint32, m map[int16]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Uint16 returns list of keys of the map[int16]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Uint16(m map[int16]uint16, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Uint16 returns list of values of the map[int16]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Uint16(m map[int16]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Uint16(m map[int16]uint16, keys ...int16) map[int16]uint16 {
+	newMap := make(map[int16]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Uint16 returns a new map without the given keys.
+func DissocKeysInt16Uint16(m map[int16]uint16, keys ...int16) map[int16]uint16 {
+	newMap := make(map[int16]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Uint16(m map[int16]uint16) map[uint16]int16 {
+	newMap := make(map[uint16]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Uint16(f func(uint16) uint16, m map[int16]uint16) map[int16]uint16 {
+	if f == nil {
+		return map[int16]uint16{}
+	}
+	newMap := make(map[int16]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Uint16(f func(int16) int16, m map[int16]uint16) map[int16]uint16 {
+	if f == nil {
+		return map[int16]uint16{}
+	}
+	newMap := make(map[int16]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Uint16(pred func(int16) bool, m map[int16]uint16) map[int16]uint16 {
+	if pred == nil {
+		return map[int16]uint16{}
+	}
+	return FilterKVInt16Uint16(func(k int16, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Uint16(pred func(uint16) bool, m map[int16]uint16) map[int16]uint16 {
+	if pred == nil {
+		return map[int16]uint16{}
+	}
+	return FilterKVInt16Uint16(func(_ int16, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Uint16(pred func(int16, uint16) bool, m map[int16]uint16) map[int16]uint16 {
+	newMap := make(map[int16]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, int16, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Uint16(f func(uint16, int16, uint16) uint16, m map[int16]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Uint8 returns list of keys of the map[int16]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Uint8(m map[int16]uint8, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Uint8 returns list of values of the map[int16]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Uint8(m map[int16]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Uint8(m map[int16]uint8, keys ...int16) map[int16]uint8 {
+	newMap := make(map[int16]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Uint8 returns a new map without the given keys.
+func DissocKeysInt16Uint8(m map[int16]uint8, keys ...int16) map[int16]uint8 {
+	newMap := make(map[int16]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Uint8(m map[int16]uint8) map[uint8]int16 {
+	newMap := make(map[uint8]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Uint8(f func(uint8) uint8, m map[int16]uint8) map[int16]uint8 {
+	if f == nil {
+		return map[int16]uint8{}
+	}
+	newMap := make(map[int16]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Uint8(f func(int16) int16, m map[int16]uint8) map[int16]uint8 {
+	if f == nil {
+		return map[int16]uint8{}
+	}
+	newMap := make(map[int16]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Uint8(pred func(int16) bool, m map[int16]uint8) map[int16]uint8 {
+	if pred == nil {
+		return map[int16]uint8{}
+	}
+	return FilterKVInt16Uint8(func(k int16, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Uint8(pred func(uint8) bool, m map[int16]uint8) map[int16]uint8 {
+	if pred == nil {
+		return map[int16]uint8{}
+	}
+	return FilterKVInt16Uint8(func(_ int16, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Uint8(pred func(int16, uint8) bool, m map[int16]uint8) map[int16]uint8 {
+	newMap := make(map[int16]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, int16, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Uint8(f func(uint8, int16, uint8) uint8, m map[int16]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Str returns list of keys of the map[int16]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Str(m map[int16]string, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Str returns list of values of the map[int16]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Str(m map[int16]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Str returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Str(m map[int16]string, keys ...int16) map[int16]string {
+	newMap := make(map[int16]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Str returns a new map without the given keys.
+func DissocKeysInt16Str(m map[int16]string, keys ...int16) map[int16]string {
+	newMap := make(map[int16]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Str(m map[int16]string) map[string]int16 {
+	newMap := make(map[string]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Str(f func(string) string, m map[int16]string) map[int16]string {
+	if f == nil {
+		return map[int16]string{}
+	}
+	newMap := make(map[int16]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Str(f func(int16) int16, m map[int16]string) map[int16]string {
+	if f == nil {
+		return map[int16]string{}
+	}
+	newMap := make(map[int16]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Str(pred func(int16) bool, m map[int16]string) map[int16]string {
+	if pred == nil {
+		return map[int16]string{}
+	}
+	return FilterKVInt16Str(func(k int16, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Str(pred func(string) bool, m map[int16]string) map[int16]string {
+	if pred == nil {
+		return map[int16]string{}
+	}
+	return FilterKVInt16Str(func(_ int16, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Str(pred func(int16, string) bool, m map[int16]string) map[int16]string {
+	newMap := make(map[int16]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, int16, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Str(f func(string, int16, string) string, m map[int16]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt16Bool returns list of keys of the map[int16]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt16Bool(m map[int16]bool, less ...func(int16, int16) bool) []int16 {
+	keys := make([]int16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt16Bool returns list of values of the map[int16]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt16Bool(m map[int16]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt16Bool returns a new map which contains only the given keys of the map.
+func SelectKeysInt16Bool(m map[int16]bool, keys ...int16) map[int16]bool {
+	newMap := make(map[int16]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt16Bool returns a new map without the given keys.
+func DissocKeysInt16Bool(m map[int16]bool, keys ...int16) map[int16]bool {
+	newMap := make(map[int16]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt16Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt16Bool(m map[int16]bool) map[bool]int16 {
+	newMap := make(map[bool]int16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt16Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt16Bool(f func(bool) bool, m map[int16]bool) map[int16]bool {
+	if f == nil {
+		return map[int16]bool{}
+	}
+	newMap := make(map[int16]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt16Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt16Bool(f func(int16) int16, m map[int16]bool) map[int16]bool {
+	if f == nil {
+		return map[int16]bool{}
+	}
+	newMap := make(map[int16]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt16Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt16Bool(pred func(int16) bool, m map[int16]bool) map[int16]bool {
+	if pred == nil {
+		return map[int16]bool{}
+	}
+	return FilterKVInt16Bool(func(k int16, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsInt16Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt16Bool(pred func(bool) bool, m map[int16]bool) map[int16]bool {
+	if pred == nil {
+		return map[int16]bool{}
+	}
+	return FilterKVInt16Bool(func(_ int16, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVInt16Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt16Bool(pred func(int16, bool) bool, m map[int16]bool) map[int16]bool {
+	newMap := make(map[int16]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt16Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, int16, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt16Bool(f func(bool, int16, bool) bool, m map[int16]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Int returns list of keys of the map[int8]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Int(m map[int8]int, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Int returns list of values of the map[int8]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Int(m map[int8]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Int returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Int(m map[int8]int, keys ...int8) map[int8]int {
+	newMap := make(map[int8]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Int returns a new map without the given keys.
+func DissocKeysInt8Int(m map[int8]int, keys ...int8) map[int8]int {
+	newMap := make(map[int8]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Int(m map[int8]int) map[int]int8 {
+	newMap := make(map[int]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Int(f func(int) int, m map[int8]int) map[int8]int {
+	if f == nil {
+		return map[int8]int{}
+	}
+	newMap := make(map[int8]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Int(f func(int8) int8, m map[int8]int) map[int8]int {
+	if f == nil {
+		return map[int8]int{}
+	}
+	newMap := make(map[int8]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Int(pred func(int8) bool, m map[int8]int) map[int8]int {
+	if pred == nil {
+		return map[int8]int{}
+	}
+	return FilterKVInt8Int(func(k int8, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Int(pred func(int) bool, m map[int8]int) map[int8]int {
+	if pred == nil {
+		return map[int8]int{}
+	}
+	return FilterKVInt8Int(func(_ int8, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Int(pred func(int8, int) bool, m map[int8]int) map[int8]int {
+	newMap := make(map[int8]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, int8, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Int(f func(int, int8, int) int, m map[int8]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Int64 returns list of keys of the map[int8]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Int64(m map[int8]int64, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Int64 returns list of values of the map[int8]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Int64(m map[int8]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Int64(m map[int8]int64, keys ...int8) map[int8]int64 {
+	newMap := make(map[int8]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Int64 returns a new map without the given keys.
+func DissocKeysInt8Int64(m map[int8]int64, keys ...int8) map[int8]int64 {
+	newMap := make(map[int8]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Int64(m map[int8]int64) map[int64]int8 {
+	newMap := make(map[int64]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Int64(f func(int64) int64, m map[int8]int64) map[int8]int64 {
+	if f == nil {
+		return map[int8]int64{}
+	}
+	newMap := make(map[int8]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Int64(f func(int8) int8, m map[int8]int64) map[int8]int64 {
+	if f == nil {
+		return map[int8]int64{}
+	}
+	newMap := make(map[int8]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Int64(pred func(int8) bool, m map[int8]int64) map[int8]int64 {
+	if pred == nil {
+		return map[int8]int64{}
+	}
+	return FilterKVInt8Int64(func(k int8, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Int64(pred func(int64) bool, m map[int8]int64) map[int8]int64 {
+	if pred == nil {
+		return map[int8]int64{}
+	}
+	return FilterKVInt8Int64(func(_ int8, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Int64(pred func(int8, int64) bool, m map[int8]int64) map[int8]int64 {
+	newMap := make(map[int8]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, int8, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Int64(f func(int64, int8, int64) int64, m map[int8]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Int32 returns list of keys of the map[int8]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Int32(m map[int8]int32, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Int32 returns list of values of the map[int8]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Int32(m map[int8]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Int32(m map[int8]int32, keys ...int8) map[int8]int32 {
+	newMap := make(map[int8]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Int32 returns a new map without the given keys.
+func DissocKeysInt8Int32(m map[int8]int32, keys ...int8) map[int8]int32 {
+	newMap := make(map[int8]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Int32(m map[int8]int32) map[int32]int8 {
+	newMap := make(map[int32]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Int32(f func(int32) int32, m map[int8]int32) map[int8]int32 {
+	if f == nil {
+		return map[int8]int32{}
+	}
+	newMap := make(map[int8]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Int32(f func(int8) int8, m map[int8]int32) map[int8]int32 {
+	if f == nil {
+		return map[int8]int32{}
+	}
+	newMap := make(map[int8]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Int32(pred func(int8) bool, m map[int8]int32) map[int8]int32 {
+	if pred == nil {
+		return map[int8]int32{}
+	}
+	return FilterKVInt8Int32(func(k int8, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Int32(pred func(int32) bool, m map[int8]int32) map[int8]int32 {
+	if pred == nil {
+		return map[int8]int32{}
+	}
+	return FilterKVInt8Int32(func(_ int8, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Int32(pred func(int8, int32) bool, m map[int8]int32) map[int8]int32 {
+	newMap := make(map[int8]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, int8, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Int32(f func(int32, int8, int32) int32, m map[int8]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Int16 returns list of keys of the map[int8]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Int16(m map[int8]int16, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Int16 returns list of values of the map[int8]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Int16(m map[int8]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Int16(m map[int8]int16, keys ...int8) map[int8]int16 {
+	newMap := make(map[int8]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Int16 returns a new map without the given keys.
+func DissocKeysInt8Int16(m map[int8]int16, keys ...int8) map[int8]int16 {
+	newMap := make(map[int8]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Int16(m map[int8]int16) map[int16]int8 {
+	newMap := make(map[int16]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Int16(f func(int16) int16, m map[int8]int16) map[int8]int16 {
+	if f == nil {
+		return map[int8]int16{}
+	}
+	newMap := make(map[int8]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Int16(f func(int8) int8, m map[int8]int16) map[int8]int16 {
+	if f == nil {
+		return map[int8]int16{}
+	}
+	newMap := make(map[int8]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Int16(pred func(int8) bool, m map[int8]int16) map[int8]int16 {
+	if pred == nil {
+		return map[int8]int16{}
+	}
+	return FilterKVInt8Int16(func(k int8, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Int16(pred func(int16) bool, m map[int8]int16) map[int8]int16 {
+	if pred == nil {
+		return map[int8]int16{}
+	}
+	return FilterKVInt8Int16(func(_ int8, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Int16(pred func(int8, int16) bool, m map[int8]int16) map[int8]int16 {
+	newMap := make(map[int8]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, int8, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Int16(f func(int16, int8, int16) int16, m map[int8]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8 returns list of keys of the map[int8]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8(m map[int8]int8, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8 returns list of values of the map[int8]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8(m map[int8]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8(m map[int8]int8, keys ...int8) map[int8]int8 {
+	newMap := make(map[int8]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8 returns a new map without the given keys.
+func DissocKeysInt8(m map[int8]int8, keys ...int8) map[int8]int8 {
+	newMap := make(map[int8]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8(m map[int8]int8) map[int8]int8 {
+	newMap := make(map[int8]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8(f func(int8) int8, m map[int8]int8) map[int8]int8 {
+	if f == nil {
+		return map[int8]int8{}
+	}
+	newMap := make(map[int8]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8(f func(int8) int8, m map[int8]int8) map[int8]int8 {
+	if f == nil {
+		return map[int8]int8{}
+	}
+	newMap := make(map[int8]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8(pred func(int8) bool, m map[int8]int8) map[int8]int8 {
+	if pred == nil {
+		return map[int8]int8{}
+	}
+	return FilterKVInt8(func(k int8, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8(pred func(int8) bool, m map[int8]int8) map[int8]int8 {
+	if pred == nil {
+		return map[int8]int8{}
+	}
+	return FilterKVInt8(func(_ int8, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8(pred func(int8, int8) bool, m map[int8]int8) map[int8]int8 {
+	newMap := make(map[int8]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, int8, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8(f func(int8, int8, int8) int8, m map[int8]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Uint returns list of keys of the map[int8]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Uint(m map[int8]uint, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Uint returns list of values of the map[int8]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Uint(m map[int8]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Uint returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Uint(m map[int8]uint, keys ...int8) map[int8]uint {
+	newMap := make(map[int8]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Uint returns a new map without the given keys.
+func DissocKeysInt8Uint(m map[int8]uint, keys ...int8) map[int8]uint {
+	newMap := make(map[int8]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Uint(m map[int8]uint) map[uint]int8 {
+	newMap := make(map[uint]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Uint(f func(uint) uint, m map[int8]uint) map[int8]uint {
+	if f == nil {
+		return map[int8]uint{}
+	}
+	newMap := make(map[int8]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Uint(f func(int8) int8, m map[int8]uint) map[int8]uint {
+	if f == nil {
+		return map[int8]uint{}
+	}
+	newMap := make(map[int8]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Uint(pred func(int8) bool, m map[int8]uint) map[int8]uint {
+	if pred == nil {
+		return map[int8]uint{}
+	}
+	return FilterKVInt8Uint(func(k int8, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Uint(pred func(uint) bool, m map[int8]uint) map[int8]uint {
+	if pred == nil {
+		return map[int8]uint{}
+	}
+	return FilterKVInt8Uint(func(_ int8, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Uint(pred func(int8, uint) bool, m map[int8]uint) map[int8]uint {
+	newMap := make(map[int8]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, int8, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Uint(f func(uint, int8, uint) uint, m map[int8]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Uint64 returns list of keys of the map[int8]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Uint64(m map[int8]uint64, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Uint64 returns list of values of the map[int8]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Uint64(m map[int8]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Uint64(m map[int8]uint64, keys ...int8) map[int8]uint64 {
+	newMap := make(map[int8]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Uint64 returns a new map without the given keys.
+func DissocKeysInt8Uint64(m map[int8]uint64, keys ...int8) map[int8]uint64 {
+	newMap := make(map[int8]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Uint64(m map[int8]uint64) map[uint64]int8 {
+	newMap := make(map[uint64]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Uint64(f func(uint64) uint64, m map[int8]uint64) map[int8]uint64 {
+	if f == nil {
+		return map[int8]uint64{}
+	}
+	newMap := make(map[int8]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Uint64(f func(int8) int8, m map[int8]uint64) map[int8]uint64 {
+	if f == nil {
+		return map[int8]uint64{}
+	}
+	newMap := make(map[int8]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Uint64(pred func(int8) bool, m map[int8]uint64) map[int8]uint64 {
+	if pred == nil {
+		return map[int8]uint64{}
+	}
+	return FilterKVInt8Uint64(func(k int8, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Uint64(pred func(uint64) bool, m map[int8]uint64) map[int8]uint64 {
+	if pred == nil {
+		return map[int8]uint64{}
+	}
+	return FilterKVInt8Uint64(func(_ int8, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Uint64(pred func(int8, uint64) bool, m map[int8]uint64) map[int8]uint64 {
+	newMap := make(map[int8]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, int8, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Uint64(f func(uint64, int8, uint64) uint64, m map[int8]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Uint32 returns list of keys of the map[int8]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Uint32(m map[int8]uint32, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Uint32 returns list of values of the map[int8]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Uint32(m map[int8]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Uint32(m map[int8]uint32, keys ...int8) map[int8]uint32 {
+	newMap := make(map[int8]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Uint32 returns a new map without the given keys.
+func DissocKeysInt8Uint32(m map[int8]uint32, keys ...int8) map[int8]uint32 {
+	newMap := make(map[int8]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Uint32(m map[int8]uint32) map[uint32]int8 {
+	newMap := make(map[uint32]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Uint32(f func(uint32) uint32, m map[int8]uint32) map[int8]uint32 {
+	if f == nil {
+		return map[int8]uint32{}
+	}
+	newMap := make(map[int8]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Uint32(f func(int8) int8, m map[int8]uint32) map[int8]uint32 {
+	if f == nil {
+		return map[int8]uint32{}
+	}
+	newMap := make(map[int8]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Uint32(pred func(int8) bool, m map[int8]uint32) map[int8]uint32 {
+	if pred == nil {
+		return map[int8]uint32{}
+	}
+	return FilterKVInt8Uint32(func(k int8, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Uint32(pred func(uint32) bool, m map[int8]uint32) map[int8]uint32 {
+	if pred == nil {
+		return map[int8]uint32{}
+	}
+	return FilterKVInt8Uint32(func(_ int8, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Uint32(pred func(int8, uint32) bool, m map[int8]uint32) map[int8]uint32 {
+	newMap := make(map[int8]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, int8, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Uint32(f func(uint32, int8, uint32) uint32, m map[int8]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Uint16 returns list of keys of the map[int8]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Uint16(m map[int8]uint16, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Uint16 returns list of values of the map[int8]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Uint16(m map[int8]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Uint16(m map[int8]uint16, keys ...int8) map[int8]uint16 {
+	newMap := make(map[int8]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Uint16 returns a new map without the given keys.
+func DissocKeysInt8Uint16(m map[int8]uint16, keys ...int8) map[int8]uint16 {
+	newMap := make(map[int8]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Uint16(m map[int8]uint16) map[uint16]int8 {
+	newMap := make(map[uint16]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Uint16(f func(uint16) uint16, m map[int8]uint16) map[int8]uint16 {
+	if f == nil {
+		return map[int8]uint16{}
+	}
+	newMap := make(map[int8]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Uint16(f func(int8) int8, m map[int8]uint16) map[int8]uint16 {
+	if f == nil {
+		return map[int8]uint16{}
+	}
+	newMap := make(map[int8]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Uint16(pred func(int8) bool, m map[int8]uint16) map[int8]uint16 {
+	if pred == nil {
+		return map[int8]uint16{}
+	}
+	return FilterKVInt8Uint16(func(k int8, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Uint16(pred func(uint16) bool, m map[int8]uint16) map[int8]uint16 {
+	if pred == nil {
+		return map[int8]uint16{}
+	}
+	return FilterKVInt8Uint16(func(_ int8, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Uint16(pred func(int8, uint16) bool, m map[int8]uint16) map[int8]uint16 {
+	newMap := make(map[int8]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, int8, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Uint16(f func(uint16, int8, uint16) uint16, m map[int8]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Uint8 returns list of keys of the map[int8]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Uint8(m map[int8]uint8, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Uint8 returns list of values of the map[int8]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Uint8(m map[int8]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Uint8(m map[int8]uint8, keys ...int8) map[int8]uint8 {
+	newMap := make(map[int8]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Uint8 returns a new map without the given keys.
+func DissocKeysInt8Uint8(m map[int8]uint8, keys ...int8) map[int8]uint8 {
+	newMap := make(map[int8]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Uint8(m map[int8]uint8) map[uint8]int8 {
+	newMap := make(map[uint8]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Uint8(f func(uint8) uint8, m map[int8]uint8) map[int8]uint8 {
+	if f == nil {
+		return map[int8]uint8{}
+	}
+	newMap := make(map[int8]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Uint8(f func(int8) int8, m map[int8]uint8) map[int8]uint8 {
+	if f == nil {
+		return map[int8]uint8{}
+	}
+	newMap := make(map[int8]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Uint8(pred func(int8) bool, m map[int8]uint8) map[int8]uint8 {
+	if pred == nil {
+		return map[int8]uint8{}
+	}
+	return FilterKVInt8Uint8(func(k int8, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Uint8(pred func(uint8) bool, m map[int8]uint8) map[int8]uint8 {
+	if pred == nil {
+		return map[int8]uint8{}
+	}
+	return FilterKVInt8Uint8(func(_ int8, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Uint8(pred func(int8, uint8) bool, m map[int8]uint8) map[int8]uint8 {
+	newMap := make(map[int8]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, int8, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Uint8(f func(uint8, int8, uint8) uint8, m map[int8]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Str returns list of keys of the map[int8]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Str(m map[int8]string, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Str returns list of values of the map[int8]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Str(m map[int8]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Str returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Str(m map[int8]string, keys ...int8) map[int8]string {
+	newMap := make(map[int8]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Str returns a new map without the given keys.
+func DissocKeysInt8Str(m map[int8]string, keys ...int8) map[int8]string {
+	newMap := make(map[int8]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Str(m map[int8]string) map[string]int8 {
+	newMap := make(map[string]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Str(f func(string) string, m map[int8]string) map[int8]string {
+	if f == nil {
+		return map[int8]string{}
+	}
+	newMap := make(map[int8]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Str(f func(int8) int8, m map[int8]string) map[int8]string {
+	if f == nil {
+		return map[int8]string{}
+	}
+	newMap := make(map[int8]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Str(pred func(int8) bool, m map[int8]string) map[int8]string {
+	if pred == nil {
+		return map[int8]string{}
+	}
+	return FilterKVInt8Str(func(k int8, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Str(pred func(string) bool, m map[int8]string) map[int8]string {
+	if pred == nil {
+		return map[int8]string{}
+	}
+	return FilterKVInt8Str(func(_ int8, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Str(pred func(int8, string) bool, m map[int8]string) map[int8]string {
+	newMap := make(map[int8]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, int8, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Str(f func(string, int8, string) string, m map[int8]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysInt8Bool returns list of keys of the map[int8]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysInt8Bool(m map[int8]bool, less ...func(int8, int8) bool) []int8 {
+	keys := make([]int8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsInt8Bool returns list of values of the map[int8]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsInt8Bool(m map[int8]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysInt8Bool returns a new map which contains only the given keys of the map.
+func SelectKeysInt8Bool(m map[int8]bool, keys ...int8) map[int8]bool {
+	newMap := make(map[int8]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysInt8Bool returns a new map without the given keys.
+func DissocKeysInt8Bool(m map[int8]bool, keys ...int8) map[int8]bool {
+	newMap := make(map[int8]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertInt8Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertInt8Bool(m map[int8]bool) map[bool]int8 {
+	newMap := make(map[bool]int8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsInt8Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsInt8Bool(f func(bool) bool, m map[int8]bool) map[int8]bool {
+	if f == nil {
+		return map[int8]bool{}
+	}
+	newMap := make(map[int8]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysInt8Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysInt8Bool(f func(int8) int8, m map[int8]bool) map[int8]bool {
+	if f == nil {
+		return map[int8]bool{}
+	}
+	newMap := make(map[int8]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysInt8Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysInt8Bool(pred func(int8) bool, m map[int8]bool) map[int8]bool {
+	if pred == nil {
+		return map[int8]bool{}
+	}
+	return FilterKVInt8Bool(func(k int8, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsInt8Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsInt8Bool(pred func(bool) bool, m map[int8]bool) map[int8]bool {
+	if pred == nil {
+		return map[int8]bool{}
+	}
+	return FilterKVInt8Bool(func(_ int8, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVInt8Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVInt8Bool(pred func(int8, bool) bool, m map[int8]bool) map[int8]bool {
+	newMap := make(map[int8]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVInt8Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, int8, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVInt8Bool(f func(bool, int8, bool) bool, m map[int8]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintInt returns list of keys of the map[uint]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintInt(m map[uint]int, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintInt returns list of values of the map[uint]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintInt(m map[uint]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintInt returns a new map which contains only the given keys of the map.
+func SelectKeysUintInt(m map[uint]int, keys ...uint) map[uint]int {
+	newMap := make(map[uint]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintInt returns a new map without the given keys.
+func DissocKeysUintInt(m map[uint]int, keys ...uint) map[uint]int {
+	newMap := make(map[uint]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintInt(m map[uint]int) map[int]uint {
+	newMap := make(map[int]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintInt(f func(int) int, m map[uint]int) map[uint]int {
+	if f == nil {
+		return map[uint]int{}
+	}
+	newMap := make(map[uint]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintInt(f func(uint) uint, m map[uint]int) map[uint]int {
+	if f == nil {
+		return map[uint]int{}
+	}
+	newMap := make(map[uint]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintInt(pred func(uint) bool, m map[uint]int) map[uint]int {
+	if pred == nil {
+		return map[uint]int{}
+	}
+	return FilterKVUintInt(func(k uint, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsUintInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintInt(pred func(int) bool, m map[uint]int) map[uint]int {
+	if pred == nil {
+		return map[uint]int{}
+	}
+	return FilterKVUintInt(func(_ uint, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVUintInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintInt(pred func(uint, int) bool, m map[uint]int) map[uint]int {
+	newMap := make(map[uint]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, uint, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintInt(f func(int, uint, int) int, m map[uint]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintInt64 returns list of keys of the map[uint]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintInt64(m map[uint]int64, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintInt64 returns list of values of the map[uint]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintInt64(m map[uint]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintInt64 returns a new map which contains only the given keys of the map.
+func SelectKeysUintInt64(m map[uint]int64, keys ...uint) map[uint]int64 {
+	newMap := make(map[uint]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintInt64 returns a new map without the given keys.
+func DissocKeysUintInt64(m map[uint]int64, keys ...uint) map[uint]int64 {
+	newMap := make(map[uint]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintInt64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintInt64(m map[uint]int64) map[int64]uint {
+	newMap := make(map[int64]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintInt64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintInt64(f func(int64) int64, m map[uint]int64) map[uint]int64 {
+	if f == nil {
+		return map[uint]int64{}
+	}
+	newMap := make(map[uint]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintInt64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintInt64(f func(uint) uint, m map[uint]int64) map[uint]int64 {
+	if f == nil {
+		return map[uint]int64{}
+	}
+	newMap := make(map[uint]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintInt64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintInt64(pred func(uint) bool, m map[uint]int64) map[uint]int64 {
+	if pred == nil {
+		return map[uint]int64{}
+	}
+	return FilterKVUintInt64(func(k uint, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsUintInt64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintInt64(pred func(int64) bool, m map[uint]int64) map[uint]int64 {
+	if pred == nil {
+		return map[uint]int64{}
+	}
+	return FilterKVUintInt64(func(_ uint, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVUintInt64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintInt64(pred func(uint, int64) bool, m map[uint]int64) map[uint]int64 {
+	newMap := make(map[uint]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintInt64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, uint, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintInt64(f func(int64, uint, int64) int64, m map[uint]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintInt32 returns list of keys of the map[uint]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintInt32(m map[uint]int32, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintInt32 returns list of values of the map[uint]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintInt32(m map[uint]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintInt32 returns a new map which contains only the given keys of the map.
+func SelectKeysUintInt32(m map[uint]int32, keys ...uint) map[uint]int32 {
+	newMap := make(map[uint]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintInt32 returns a new map without the given keys.
+func DissocKeysUintInt32(m map[uint]int32, keys ...uint) map[uint]int32 {
+	newMap := make(map[uint]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintInt32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintInt32(m map[uint]int32) map[int32]uint {
+	newMap := make(map[int32]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintInt32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintInt32(f func(int32) int32, m map[uint]int32) map[uint]int32 {
+	if f == nil {
+		return map[uint]int32{}
+	}
+	newMap := make(map[uint]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintInt32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintInt32(f func(uint) uint, m map[uint]int32) map[uint]int32 {
+	if f == nil {
+		return map[uint]int32{}
+	}
+	newMap := make(map[uint]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintInt32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintInt32(pred func(uint) bool, m map[uint]int32) map[uint]int32 {
+	if pred == nil {
+		return map[uint]int32{}
+	}
+	return FilterKVUintInt32(func(k uint, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsUintInt32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintInt32(pred func(int32) bool, m map[uint]int32) map[uint]int32 {
+	if pred == nil {
+		return map[uint]int32{}
+	}
+	return FilterKVUintInt32(func(_ uint, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVUintInt32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintInt32(pred func(uint, int32) bool, m map[uint]int32) map[uint]int32 {
+	newMap := make(map[uint]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintInt32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, uint, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintInt32(f func(int32, uint, int32) int32, m map[uint]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintInt16 returns list of keys of the map[uint]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintInt16(m map[uint]int16, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintInt16 returns list of values of the map[uint]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintInt16(m map[uint]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintInt16 returns a new map which contains only the given keys of the map.
+func SelectKeysUintInt16(m map[uint]int16, keys ...uint) map[uint]int16 {
+	newMap := make(map[uint]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintInt16 returns a new map without the given keys.
+func DissocKeysUintInt16(m map[uint]int16, keys ...uint) map[uint]int16 {
+	newMap := make(map[uint]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintInt16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintInt16(m map[uint]int16) map[int16]uint {
+	newMap := make(map[int16]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintInt16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintInt16(f func(int16) int16, m map[uint]int16) map[uint]int16 {
+	if f == nil {
+		return map[uint]int16{}
+	}
+	newMap := make(map[uint]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintInt16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintInt16(f func(uint) uint, m map[uint]int16) map[uint]int16 {
+	if f == nil {
+		return map[uint]int16{}
+	}
+	newMap := make(map[uint]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintInt16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintInt16(pred func(uint) bool, m map[uint]int16) map[uint]int16 {
+	if pred == nil {
+		return map[uint]int16{}
+	}
+	return FilterKVUintInt16(func(k uint, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsUintInt16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintInt16(pred func(int16) bool, m map[uint]int16) map[uint]int16 {
+	if pred == nil {
+		return map[uint]int16{}
+	}
+	return FilterKVUintInt16(func(_ uint, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVUintInt16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintInt16(pred func(uint, int16) bool, m map[uint]int16) map[uint]int16 {
+	newMap := make(map[uint]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintInt16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, uint, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintInt16(f func(int16, uint, int16) int16, m map[uint]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintInt8 returns list of keys of the map[uint]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintInt8(m map[uint]int8, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintInt8 returns list of values of the map[uint]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintInt8(m map[uint]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintInt8 returns a new map which contains only the given keys of the map.
+func SelectKeysUintInt8(m map[uint]int8, keys ...uint) map[uint]int8 {
+	newMap := make(map[uint]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintInt8 returns a new map without the given keys.
+func DissocKeysUintInt8(m map[uint]int8, keys ...uint) map[uint]int8 {
+	newMap := make(map[uint]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintInt8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintInt8(m map[uint]int8) map[int8]uint {
+	newMap := make(map[int8]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintInt8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintInt8(f func(int8) int8, m map[uint]int8) map[uint]int8 {
+	if f == nil {
+		return map[uint]int8{}
+	}
+	newMap := make(map[uint]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintInt8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintInt8(f func(uint) uint, m map[uint]int8) map[uint]int8 {
+	if f == nil {
+		return map[uint]int8{}
+	}
+	newMap := make(map[uint]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintInt8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintInt8(pred func(uint) bool, m map[uint]int8) map[uint]int8 {
+	if pred == nil {
+		return map[uint]int8{}
+	}
+	return FilterKVUintInt8(func(k uint, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsUintInt8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintInt8(pred func(int8) bool, m map[uint]int8) map[uint]int8 {
+	if pred == nil {
+		return map[uint]int8{}
+	}
+	return FilterKVUintInt8(func(_ uint, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVUintInt8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintInt8(pred func(uint, int8) bool, m map[uint]int8) map[uint]int8 {
+	newMap := make(map[uint]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintInt8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, uint, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintInt8(f func(int8, uint, int8) int8, m map[uint]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint returns list of keys of the map[uint]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint(m map[uint]uint, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint returns list of values of the map[uint]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint(m map[uint]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint returns a new map which contains only the given keys of the map.
+func SelectKeysUint(m map[uint]uint, keys ...uint) map[uint]uint {
+	newMap := make(map[uint]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint returns a new map without the given keys.
+func DissocKeysUint(m map[uint]uint, keys ...uint) map[uint]uint {
+	newMap := make(map[uint]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint(m map[uint]uint) map[uint]uint {
+	newMap := make(map[uint]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint(f func(uint) uint, m map[uint]uint) map[uint]uint {
+	if f == nil {
+		return map[uint]uint{}
+	}
+	newMap := make(map[uint]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint(f func(uint) uint, m map[uint]uint) map[uint]uint {
+	if f == nil {
+		return map[uint]uint{}
+	}
+	newMap := make(map[uint]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint(pred func(uint) bool, m map[uint]uint) map[uint]uint {
+	if pred == nil {
+		return map[uint]uint{}
+	}
+	return FilterKVUint(func(k uint, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsUint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint(pred func(uint) bool, m map[uint]uint) map[uint]uint {
+	if pred == nil {
+		return map[uint]uint{}
+	}
+	return FilterKVUint(func(_ uint, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVUint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint(pred func(uint, uint) bool, m map[uint]uint) map[uint]uint {
+	newMap := make(map[uint]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, uint, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint(f func(uint, uint, uint) uint, m map[uint]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintUint64 returns list of keys of the map[uint]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintUint64(m map[uint]uint64, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintUint64 returns list of values of the map[uint]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintUint64(m map[uint]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintUint64 returns a new map which contains only the given keys of the map.
+func SelectKeysUintUint64(m map[uint]uint64, keys ...uint) map[uint]uint64 {
+	newMap := make(map[uint]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintUint64 returns a new map without the given keys.
+func DissocKeysUintUint64(m map[uint]uint64, keys ...uint) map[uint]uint64 {
+	newMap := make(map[uint]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintUint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintUint64(m map[uint]uint64) map[uint64]uint {
+	newMap := make(map[uint64]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintUint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintUint64(f func(uint64) uint64, m map[uint]uint64) map[uint]uint64 {
+	if f == nil {
+		return map[uint]uint64{}
+	}
+	newMap := make(map[uint]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintUint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintUint64(f func(uint) uint, m map[uint]uint64) map[uint]uint64 {
+	if f == nil {
+		return map[uint]uint64{}
+	}
+	newMap := make(map[uint]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintUint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintUint64(pred func(uint) bool, m map[uint]uint64) map[uint]uint64 {
+	if pred == nil {
+		return map[uint]uint64{}
+	}
+	return FilterKVUintUint64(func(k uint, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsUintUint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintUint64(pred func(uint64) bool, m map[uint]uint64) map[uint]uint64 {
+	if pred == nil {
+		return map[uint]uint64{}
+	}
+	return FilterKVUintUint64(func(_ uint, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVUintUint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintUint64(pred func(uint, uint64) bool, m map[uint]uint64) map[uint]uint64 {
+	newMap := make(map[uint]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintUint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, uint, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintUint64(f func(uint64, uint, uint64) uint64, m map[uint]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintUint32 returns list of keys of the map[uint]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintUint32(m map[uint]uint32, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintUint32 returns list of values of the map[uint]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintUint32(m map[uint]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintUint32 returns a new map which contains only the given keys of the map.
+func SelectKeysUintUint32(m map[uint]uint32, keys ...uint) map[uint]uint32 {
+	newMap := make(map[uint]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintUint32 returns a new map without the given keys.
+func DissocKeysUintUint32(m map[uint]uint32, keys ...uint) map[uint]uint32 {
+	newMap := make(map[uint]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintUint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintUint32(m map[uint]uint32) map[uint32]uint {
+	newMap := make(map[uint32]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintUint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintUint32(f func(uint32) uint32, m map[uint]uint32) map[uint]uint32 {
+	if f == nil {
+		return map[uint]uint32{}
+	}
+	newMap := make(map[uint]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintUint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintUint32(f func(uint) uint, m map[uint]uint32) map[uint]uint32 {
+	if f == nil {
+		return map[uint]uint32{}
+	}
+	newMap := make(map[uint]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintUint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintUint32(pred func(uint) bool, m map[uint]uint32) map[uint]uint32 {
+	if pred == nil {
+		return map[uint]uint32{}
+	}
+	return FilterKVUintUint32(func(k uint, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsUintUint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintUint32(pred func(uint32) bool, m map[uint]uint32) map[uint]uint32 {
+	if pred == nil {
+		return map[uint]uint32{}
+	}
+	return FilterKVUintUint32(func(_ uint, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVUintUint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintUint32(pred func(uint, uint32) bool, m map[uint]uint32) map[uint]uint32 {
+	newMap := make(map[uint]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintUint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, uint, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintUint32(f func(uint32, uint, uint32) uint32, m map[uint]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintUint16 returns list of keys of the map[uint]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintUint16(m map[uint]uint16, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintUint16 returns list of values of the map[uint]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintUint16(m map[uint]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintUint16 returns a new map which contains only the given keys of the map.
+func SelectKeysUintUint16(m map[uint]uint16, keys ...uint) map[uint]uint16 {
+	newMap := make(map[uint]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintUint16 returns a new map without the given keys.
+func DissocKeysUintUint16(m map[uint]uint16, keys ...uint) map[uint]uint16 {
+	newMap := make(map[uint]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintUint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintUint16(m map[uint]uint16) map[uint16]uint {
+	newMap := make(map[uint16]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintUint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintUint16(f func(uint16) uint16, m map[uint]uint16) map[uint]uint16 {
+	if f == nil {
+		return map[uint]uint16{}
+	}
+	newMap := make(map[uint]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintUint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintUint16(f func(uint) uint, m map[uint]uint16) map[uint]uint16 {
+	if f == nil {
+		return map[uint]uint16{}
+	}
+	newMap := make(map[uint]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintUint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintUint16(pred func(uint) bool, m map[uint]uint16) map[uint]uint16 {
+	if pred == nil {
+		return map[uint]uint16{}
+	}
+	return FilterKVUintUint16(func(k uint, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsUintUint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintUint16(pred func(uint16) bool, m map[uint]uint16) map[uint]uint16 {
+	if pred == nil {
+		return map[uint]uint16{}
+	}
+	return FilterKVUintUint16(func(_ uint, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVUintUint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintUint16(pred func(uint, uint16) bool, m map[uint]uint16) map[uint]uint16 {
+	newMap := make(map[uint]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintUint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, uint, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintUint16(f func(uint16, uint, uint16) uint16, m map[uint]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintUint8 returns list of keys of the map[uint]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintUint8(m map[uint]uint8, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintUint8 returns list of values of the map[uint]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintUint8(m map[uint]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintUint8 returns a new map which contains only the given keys of the map.
+func SelectKeysUintUint8(m map[uint]uint8, keys ...uint) map[uint]uint8 {
+	newMap := make(map[uint]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintUint8 returns a new map without the given keys.
+func DissocKeysUintUint8(m map[uint]uint8, keys ...uint) map[uint]uint8 {
+	newMap := make(map[uint]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintUint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintUint8(m map[uint]uint8) map[uint8]uint {
+	newMap := make(map[uint8]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintUint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintUint8(f func(uint8) uint8, m map[uint]uint8) map[uint]uint8 {
+	if f == nil {
+		return map[uint]uint8{}
+	}
+	newMap := make(map[uint]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintUint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintUint8(f func(uint) uint, m map[uint]uint8) map[uint]uint8 {
+	if f == nil {
+		return map[uint]uint8{}
+	}
+	newMap := make(map[uint]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintUint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintUint8(pred func(uint) bool, m map[uint]uint8) map[uint]uint8 {
+	if pred == nil {
+		return map[uint]uint8{}
+	}
+	return FilterKVUintUint8(func(k uint, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsUintUint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintUint8(pred func(uint8) bool, m map[uint]uint8) map[uint]uint8 {
+	if pred == nil {
+		return map[uint]uint8{}
+	}
+	return FilterKVUintUint8(func(_ uint, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVUintUint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintUint8(pred func(uint, uint8) bool, m map[uint]uint8) map[uint]uint8 {
+	newMap := make(map[uint]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintUint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, uint, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintUint8(f func(uint8, uint, uint8) uint8, m map[uint]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintStr returns list of keys of the map[uint]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintStr(m map[uint]string, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintStr returns list of values of the map[uint]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintStr(m map[uint]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintStr returns a new map which contains only the given keys of the map.
+func SelectKeysUintStr(m map[uint]string, keys ...uint) map[uint]string {
+	newMap := make(map[uint]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintStr returns a new map without the given keys.
+func DissocKeysUintStr(m map[uint]string, keys ...uint) map[uint]string {
+	newMap := make(map[uint]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintStr(m map[uint]string) map[string]uint {
+	newMap := make(map[string]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintStr(f func(string) string, m map[uint]string) map[uint]string {
+	if f == nil {
+		return map[uint]string{}
+	}
+	newMap := make(map[uint]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintStr(f func(uint) uint, m map[uint]string) map[uint]string {
+	if f == nil {
+		return map[uint]string{}
+	}
+	newMap := make(map[uint]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintStr(pred func(uint) bool, m map[uint]string) map[uint]string {
+	if pred == nil {
+		return map[uint]string{}
+	}
+	return FilterKVUintStr(func(k uint, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsUintStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintStr(pred func(string) bool, m map[uint]string) map[uint]string {
+	if pred == nil {
+		return map[uint]string{}
+	}
+	return FilterKVUintStr(func(_ uint, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVUintStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintStr(pred func(uint, string) bool, m map[uint]string) map[uint]string {
+	newMap := make(map[uint]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, uint, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintStr(f func(string, uint, string) string, m map[uint]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUintBool returns list of keys of the map[uint]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUintBool(m map[uint]bool, less ...func(uint, uint) bool) []uint {
+	keys := make([]uint, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUintBool returns list of values of the map[uint]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUintBool(m map[uint]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUintBool returns a new map which contains only the given keys of the map.
+func SelectKeysUintBool(m map[uint]bool, keys ...uint) map[uint]bool {
+	newMap := make(map[uint]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUintBool returns a new map without the given keys.
+func DissocKeysUintBool(m map[uint]bool, keys ...uint) map[uint]bool {
+	newMap := make(map[uint]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUintBool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUintBool(m map[uint]bool) map[bool]uint {
+	newMap := make(map[bool]uint, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUintBool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUintBool(f func(bool) bool, m map[uint]bool) map[uint]bool {
+	if f == nil {
+		return map[uint]bool{}
+	}
+	newMap := make(map[uint]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUintBool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUintBool(f func(uint) uint, m map[uint]bool) map[uint]bool {
+	if f == nil {
+		return map[uint]bool{}
+	}
+	newMap := make(map[uint]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUintBool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUintBool(pred func(uint) bool, m map[uint]bool) map[uint]bool {
+	if pred == nil {
+		return map[uint]bool{}
+	}
+	return FilterKVUintBool(func(k uint, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsUintBool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUintBool(pred func(bool) bool, m map[uint]bool) map[uint]bool {
+	if pred == nil {
+		return map[uint]bool{}
+	}
+	return FilterKVUintBool(func(_ uint, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVUintBool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUintBool(pred func(uint, bool) bool, m map[uint]bool) map[uint]bool {
+	newMap := make(map[uint]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUintBool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, uint, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUintBool(f func(bool, uint, bool) bool, m map[uint]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Int returns list of keys of the map[uint64]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Int(m map[uint64]int, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Int returns list of values of the map[uint64]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Int(m map[uint64]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Int returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Int(m map[uint64]int, keys ...uint64) map[uint64]int {
+	newMap := make(map[uint64]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Int returns a new map without the given keys.
+func DissocKeysUint64Int(m map[uint64]int, keys ...uint64) map[uint64]int {
+	newMap := make(map[uint64]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Int(m map[uint64]int) map[int]uint64 {
+	newMap := make(map[int]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Int(f func(int) int, m map[uint64]int) map[uint64]int {
+	if f == nil {
+		return map[uint64]int{}
+	}
+	newMap := make(map[uint64]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Int(f func(uint64) uint64, m map[uint64]int) map[uint64]int {
+	if f == nil {
+		return map[uint64]int{}
+	}
+	newMap := make(map[uint64]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Int(pred func(uint64) bool, m map[uint64]int) map[uint64]int {
+	if pred == nil {
+		return map[uint64]int{}
+	}
+	return FilterKVUint64Int(func(k uint64, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Int(pred func(int) bool, m map[uint64]int) map[uint64]int {
+	if pred == nil {
+		return map[uint64]int{}
+	}
+	return FilterKVUint64Int(func(_ uint64, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Int(pred func(uint64, int) bool, m map[uint64]int) map[uint64]int {
+	newMap := make(map[uint64]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, uint64, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Int(f func(int, uint64, int) int, m map[uint64]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Int64 returns list of keys of the map[uint64]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Int64(m map[uint64]int64, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Int64 returns list of values of the map[uint64]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Int64(m map[uint64]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Int64(m map[uint64]int64, keys ...uint64) map[uint64]int64 {
+	newMap := make(map[uint64]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Int64 returns a new map without the given keys.
+func DissocKeysUint64Int64(m map[uint64]int64, keys ...uint64) map[uint64]int64 {
+	newMap := make(map[uint64]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Int64(m map[uint64]int64) map[int64]uint64 {
+	newMap := make(map[int64]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Int64(f func(int64) int64, m map[uint64]int64) map[uint64]int64 {
+	if f == nil {
+		return map[uint64]int64{}
+	}
+	newMap := make(map[uint64]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Int64(f func(uint64) uint64, m map[uint64]int64) map[uint64]int64 {
+	if f == nil {
+		return map[uint64]int64{}
+	}
+	newMap := make(map[uint64]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Int64(pred func(uint64) bool, m map[uint64]int64) map[uint64]int64 {
+	if pred == nil {
+		return map[uint64]int64{}
+	}
+	return FilterKVUint64Int64(func(k uint64, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Int64(pred func(int64) bool, m map[uint64]int64) map[uint64]int64 {
+	if pred == nil {
+		return map[uint64]int64{}
+	}
+	return FilterKVUint64Int64(func(_ uint64, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Int64(pred func(uint64, int64) bool, m map[uint64]int64) map[uint64]int64 {
+	newMap := make(map[uint64]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, uint64, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Int64(f func(int64, uint64, int64) int64, m map[uint64]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Int32 returns list of keys of the map[uint64]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Int32(m map[uint64]int32, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Int32 returns list of values of the map[uint64]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Int32(m map[uint64]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Int32(m map[uint64]int32, keys ...uint64) map[uint64]int32 {
+	newMap := make(map[uint64]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Int32 returns a new map without the given keys.
+func DissocKeysUint64Int32(m map[uint64]int32, keys ...uint64) map[uint64]int32 {
+	newMap := make(map[uint64]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Int32(m map[uint64]int32) map[int32]uint64 {
+	newMap := make(map[int32]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Int32(f func(int32) int32, m map[uint64]int32) map[uint64]int32 {
+	if f == nil {
+		return map[uint64]int32{}
+	}
+	newMap := make(map[uint64]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Int32(f func(uint64) uint64, m map[uint64]int32) map[uint64]int32 {
+	if f == nil {
+		return map[uint64]int32{}
+	}
+	newMap := make(map[uint64]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Int32(pred func(uint64) bool, m map[uint64]int32) map[uint64]int32 {
+	if pred == nil {
+		return map[uint64]int32{}
+	}
+	return FilterKVUint64Int32(func(k uint64, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Int32(pred func(int32) bool, m map[uint64]int32) map[uint64]int32 {
+	if pred == nil {
+		return map[uint64]int32{}
+	}
+	return FilterKVUint64Int32(func(_ uint64, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Int32(pred func(uint64, int32) bool, m map[uint64]int32) map[uint64]int32 {
+	newMap := make(map[uint64]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, uint64, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Int32(f func(int32, uint64, int32) int32, m map[uint64]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Int16 returns list of keys of the map[uint64]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Int16(m map[uint64]int16, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Int16 returns list of values of the map[uint64]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Int16(m map[uint64]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Int16(m map[uint64]int16, keys ...uint64) map[uint64]int16 {
+	newMap := make(map[uint64]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Int16 returns a new map without the given keys.
+func DissocKeysUint64Int16(m map[uint64]int16, keys ...uint64) map[uint64]int16 {
+	newMap := make(map[uint64]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Int16(m map[uint64]int16) map[int16]uint64 {
+	newMap := make(map[int16]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Int16(f func(int16) int16, m map[uint64]int16) map[uint64]int16 {
+	if f == nil {
+		return map[uint64]int16{}
+	}
+	newMap := make(map[uint64]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Int16(f func(uint64) uint64, m map[uint64]int16) map[uint64]int16 {
+	if f == nil {
+		return map[uint64]int16{}
+	}
+	newMap := make(map[uint64]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Int16(pred func(uint64) bool, m map[uint64]int16) map[uint64]int16 {
+	if pred == nil {
+		return map[uint64]int16{}
+	}
+	return FilterKVUint64Int16(func(k uint64, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Int16(pred func(int16) bool, m map[uint64]int16) map[uint64]int16 {
+	if pred == nil {
+		return map[uint64]int16{}
+	}
+	return FilterKVUint64Int16(func(_ uint64, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Int16(pred func(uint64, int16) bool, m map[uint64]int16) map[uint64]int16 {
+	newMap := make(map[uint64]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, uint64, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Int16(f func(int16, uint64, int16) int16, m map[uint64]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Int8 returns list of keys of the map[uint64]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Int8(m map[uint64]int8, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Int8 returns list of values of the map[uint64]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Int8(m map[uint64]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Int8(m map[uint64]int8, keys ...uint64) map[uint64]int8 {
+	newMap := make(map[uint64]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Int8 returns a new map without the given keys.
+func DissocKeysUint64Int8(m map[uint64]int8, keys ...uint64) map[uint64]int8 {
+	newMap := make(map[uint64]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Int8(m map[uint64]int8) map[int8]uint64 {
+	newMap := make(map[int8]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Int8(f func(int8) int8, m map[uint64]int8) map[uint64]int8 {
+	if f == nil {
+		return map[uint64]int8{}
+	}
+	newMap := make(map[uint64]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Int8(f func(uint64) uint64, m map[uint64]int8) map[uint64]int8 {
+	if f == nil {
+		return map[uint64]int8{}
+	}
+	newMap := make(map[uint64]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Int8(pred func(uint64) bool, m map[uint64]int8) map[uint64]int8 {
+	if pred == nil {
+		return map[uint64]int8{}
+	}
+	return FilterKVUint64Int8(func(k uint64, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Int8(pred func(int8) bool, m map[uint64]int8) map[uint64]int8 {
+	if pred == nil {
+		return map[uint64]int8{}
+	}
+	return FilterKVUint64Int8(func(_ uint64, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Int8(pred func(uint64, int8) bool, m map[uint64]int8) map[uint64]int8 {
+	newMap := make(map[uint64]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, uint64, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Int8(f func(int8, uint64, int8) int8, m map[uint64]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Uint returns list of keys of the map[uint64]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Uint(m map[uint64]uint, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Uint returns list of values of the map[uint64]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Uint(m map[uint64]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Uint returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Uint(m map[uint64]uint, keys ...uint64) map[uint64]uint {
+	newMap := make(map[uint64]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Uint returns a new map without the given keys.
+func DissocKeysUint64Uint(m map[uint64]uint, keys ...uint64) map[uint64]uint {
+	newMap := make(map[uint64]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Uint(m map[uint64]uint) map[uint]uint64 {
+	newMap := make(map[uint]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Uint(f func(uint) uint, m map[uint64]uint) map[uint64]uint {
+	if f == nil {
+		return map[uint64]uint{}
+	}
+	newMap := make(map[uint64]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Uint(f func(uint64) uint64, m map[uint64]uint) map[uint64]uint {
+	if f == nil {
+		return map[uint64]uint{}
+	}
+	newMap := make(map[uint64]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Uint(pred func(uint64) bool, m map[uint64]uint) map[uint64]uint {
+	if pred == nil {
+		return map[uint64]uint{}
+	}
+	return FilterKVUint64Uint(func(k uint64, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Uint(pred func(uint) bool, m map[uint64]uint) map[uint64]uint {
+	if pred == nil {
+		return map[uint64]uint{}
+	}
+	return FilterKVUint64Uint(func(_ uint64, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Uint(pred func(uint64, uint) bool, m map[uint64]uint) map[uint64]uint {
+	newMap := make(map[uint64]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, uint64, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Uint(f func(uint, uint64, uint) uint, m map[uint64]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64 returns list of keys of the map[uint64]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64(m map[uint64]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64 returns list of values of the map[uint64]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64(m map[uint64]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64(m map[uint64]uint64, keys ...uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64 returns a new map without the given keys.
+func DissocKeysUint64(m map[uint64]uint64, keys ...uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64(m map[uint64]uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64(f func(uint64) uint64, m map[uint64]uint64) map[uint64]uint64 {
+	if f == nil {
+		return map[uint64]uint64{}
+	}
+	newMap := make(map[uint64]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64(f func(uint64) uint64, m map[uint64]uint64) map[uint64]uint64 {
+	if f == nil {
+		return map[uint64]uint64{}
+	}
+	newMap := make(map[uint64]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64(pred func(uint64) bool, m map[uint64]uint64) map[uint64]uint64 {
+	if pred == nil {
+		return map[uint64]uint64{}
+	}
+	return FilterKVUint64(func(k uint64, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64(pred func(uint64) bool, m map[uint64]uint64) map[uint64]uint64 {
+	if pred == nil {
+		return map[uint64]uint64{}
+	}
+	return FilterKVUint64(func(_ uint64, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64(pred func(uint64, uint64) bool, m map[uint64]uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, uint64, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64(f func(uint64, uint64, uint64) uint64, m map[uint64]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Uint32 returns list of keys of the map[uint64]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Uint32(m map[uint64]uint32, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Uint32 returns list of values of the map[uint64]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Uint32(m map[uint64]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Uint32(m map[uint64]uint32, keys ...uint64) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Uint32 returns a new map without the given keys.
+func DissocKeysUint64Uint32(m map[uint64]uint32, keys ...uint64) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Uint32(m map[uint64]uint32) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Uint32(f func(uint32) uint32, m map[uint64]uint32) map[uint64]uint32 {
+	if f == nil {
+		return map[uint64]uint32{}
+	}
+	newMap := make(map[uint64]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Uint32(f func(uint64) uint64, m map[uint64]uint32) map[uint64]uint32 {
+	if f == nil {
+		return map[uint64]uint32{}
+	}
+	newMap := make(map[uint64]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Uint32(pred func(uint64) bool, m map[uint64]uint32) map[uint64]uint32 {
+	if pred == nil {
+		return map[uint64]uint32{}
+	}
+	return FilterKVUint64Uint32(func(k uint64, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Uint32(pred func(uint32) bool, m map[uint64]uint32) map[uint64]uint32 {
+	if pred == nil {
+		return map[uint64]uint32{}
+	}
+	return FilterKVUint64Uint32(func(_ uint64, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Uint32(pred func(uint64, uint32) bool, m map[uint64]uint32) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, uint64, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Uint32(f func(uint32, uint64, uint32) uint32, m map[uint64]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Uint16 returns list of keys of the map[uint64]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Uint16(m map[uint64]uint16, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Uint16 returns list of values of the map[uint64]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Uint16(m map[uint64]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Uint16(m map[uint64]uint16, keys ...uint64) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Uint16 returns a new map without the given keys.
+func DissocKeysUint64Uint16(m map[uint64]uint16, keys ...uint64) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Uint16(m map[uint64]uint16) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Uint16(f func(uint16) uint16, m map[uint64]uint16) map[uint64]uint16 {
+	if f == nil {
+		return map[uint64]uint16{}
+	}
+	newMap := make(map[uint64]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Uint16(f func(uint64) uint64, m map[uint64]uint16) map[uint64]uint16 {
+	if f == nil {
+		return map[uint64]uint16{}
+	}
+	newMap := make(map[uint64]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Uint16(pred func(uint64) bool, m map[uint64]uint16) map[uint64]uint16 {
+	if pred == nil {
+		return map[uint64]uint16{}
+	}
+	return FilterKVUint64Uint16(func(k uint64, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Uint16(pred func(uint16) bool, m map[uint64]uint16) map[uint64]uint16 {
+	if pred == nil {
+		return map[uint64]uint16{}
+	}
+	return FilterKVUint64Uint16(func(_ uint64, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Uint16(pred func(uint64, uint16) bool, m map[uint64]uint16) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, uint64, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Uint16(f func(uint16, uint64, uint16) uint16, m map[uint64]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Uint8 returns list of keys of the map[uint64]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Uint8(m map[uint64]uint8, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Uint8 returns list of values of the map[uint64]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Uint8(m map[uint64]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Uint8(m map[uint64]uint8, keys ...uint64) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Uint8 returns a new map without the given keys.
+func DissocKeysUint64Uint8(m map[uint64]uint8, keys ...uint64) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Uint8(m map[uint64]uint8) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Uint8(f func(uint8) uint8, m map[uint64]uint8) map[uint64]uint8 {
+	if f == nil {
+		return map[uint64]uint8{}
+	}
+	newMap := make(map[uint64]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Uint8(f func(uint64) uint64, m map[uint64]uint8) map[uint64]uint8 {
+	if f == nil {
+		return map[uint64]uint8{}
+	}
+	newMap := make(map[uint64]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Uint8(pred func(uint64) bool, m map[uint64]uint8) map[uint64]uint8 {
+	if pred == nil {
+		return map[uint64]uint8{}
+	}
+	return FilterKVUint64Uint8(func(k uint64, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Uint8(pred func(uint8) bool, m map[uint64]uint8) map[uint64]uint8 {
+	if pred == nil {
+		return map[uint64]uint8{}
+	}
+	return FilterKVUint64Uint8(func(_ uint64, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Uint8(pred func(uint64, uint8) bool, m map[uint64]uint8) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, uint64, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Uint8(f func(uint8, uint64, uint8) uint8, m map[uint64]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Str returns list of keys of the map[uint64]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Str(m map[uint64]string, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Str returns list of values of the map[uint64]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Str(m map[uint64]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Str returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Str(m map[uint64]string, keys ...uint64) map[uint64]string {
+	newMap := make(map[uint64]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Str returns a new map without the given keys.
+func DissocKeysUint64Str(m map[uint64]string, keys ...uint64) map[uint64]string {
+	newMap := make(map[uint64]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Str(m map[uint64]string) map[string]uint64 {
+	newMap := make(map[string]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Str(f func(string) string, m map[uint64]string) map[uint64]string {
+	if f == nil {
+		return map[uint64]string{}
+	}
+	newMap := make(map[uint64]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Str(f func(uint64) uint64, m map[uint64]string) map[uint64]string {
+	if f == nil {
+		return map[uint64]string{}
+	}
+	newMap := make(map[uint64]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Str(pred func(uint64) bool, m map[uint64]string) map[uint64]string {
+	if pred == nil {
+		return map[uint64]string{}
+	}
+	return FilterKVUint64Str(func(k uint64, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Str(pred func(string) bool, m map[uint64]string) map[uint64]string {
+	if pred == nil {
+		return map[uint64]string{}
+	}
+	return FilterKVUint64Str(func(_ uint64, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Str(pred func(uint64, string) bool, m map[uint64]string) map[uint64]string {
+	newMap := make(map[uint64]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, uint64, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Str(f func(string, uint64, string) string, m map[uint64]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint64Bool returns list of keys of the map[uint64]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint64Bool(m map[uint64]bool, less ...func(uint64, uint64) bool) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint64Bool returns list of values of the map[uint64]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint64Bool(m map[uint64]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint64Bool returns a new map which contains only the given keys of the map.
+func SelectKeysUint64Bool(m map[uint64]bool, keys ...uint64) map[uint64]bool {
+	newMap := make(map[uint64]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint64Bool returns a new map without the given keys.
+func DissocKeysUint64Bool(m map[uint64]bool, keys ...uint64) map[uint64]bool {
+	newMap := make(map[uint64]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint64Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint64Bool(m map[uint64]bool) map[bool]uint64 {
+	newMap := make(map[bool]uint64, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint64Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint64Bool(f func(bool) bool, m map[uint64]bool) map[uint64]bool {
+	if f == nil {
+		return map[uint64]bool{}
+	}
+	newMap := make(map[uint64]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint64Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint64Bool(f func(uint64) uint64, m map[uint64]bool) map[uint64]bool {
+	if f == nil {
+		return map[uint64]bool{}
+	}
+	newMap := make(map[uint64]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint64Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint64Bool(pred func(uint64) bool, m map[uint64]bool) map[uint64]bool {
+	if pred == nil {
+		return map[uint64]bool{}
+	}
+	return FilterKVUint64Bool(func(k uint64, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsUint64Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint64Bool(pred func(bool) bool, m map[uint64]bool) map[uint64]bool {
+	if pred == nil {
+		return map[uint64]bool{}
+	}
+	return FilterKVUint64Bool(func(_ uint64, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVUint64Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint64Bool(pred func(uint64, bool) bool, m map[uint64]bool) map[uint64]bool {
+	newMap := make(map[uint64]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint64Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, uint64, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint64Bool(f func(bool, uint64, bool) bool, m map[uint64]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Int returns list of keys of the map[uint32]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Int(m map[uint32]int, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Int returns list of values of the map[uint32]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Int(m map[uint32]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Int returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Int(m map[uint32]int, keys ...uint32) map[uint32]int {
+	newMap := make(map[uint32]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Int returns a new map without the given keys.
+func DissocKeysUint32Int(m map[uint32]int, keys ...uint32) map[uint32]int {
+	newMap := make(map[uint32]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Int(m map[uint32]int) map[int]uint32 {
+	newMap := make(map[int]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Int(f func(int) int, m map[uint32]int) map[uint32]int {
+	if f == nil {
+		return map[uint32]int{}
+	}
+	newMap := make(map[uint32]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Int(f func(uint32) uint32, m map[uint32]int) map[uint32]int {
+	if f == nil {
+		return map[uint32]int{}
+	}
+	newMap := make(map[uint32]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Int(pred func(uint32) bool, m map[uint32]int) map[uint32]int {
+	if pred == nil {
+		return map[uint32]int{}
+	}
+	return FilterKVUint32Int(func(k uint32, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Int(pred func(int) bool, m map[uint32]int) map[uint32]int {
+	if pred == nil {
+		return map[uint32]int{}
+	}
+	return FilterKVUint32Int(func(_ uint32, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Int(pred func(uint32, int) bool, m map[uint32]int) map[uint32]int {
+	newMap := make(map[uint32]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, uint32, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Int(f func(int, uint32, int) int, m map[uint32]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Int64 returns list of keys of the map[uint32]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Int64(m map[uint32]int64, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Int64 returns list of values of the map[uint32]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Int64(m map[uint32]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Int64(m map[uint32]int64, keys ...uint32) map[uint32]int64 {
+	newMap := make(map[uint32]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Int64 returns a new map without the given keys.
+func DissocKeysUint32Int64(m map[uint32]int64, keys ...uint32) map[uint32]int64 {
+	newMap := make(map[uint32]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Int64(m map[uint32]int64) map[int64]uint32 {
+	newMap := make(map[int64]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Int64(f func(int64) int64, m map[uint32]int64) map[uint32]int64 {
+	if f == nil {
+		return map[uint32]int64{}
+	}
+	newMap := make(map[uint32]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Int64(f func(uint32) uint32, m map[uint32]int64) map[uint32]int64 {
+	if f == nil {
+		return map[uint32]int64{}
+	}
+	newMap := make(map[uint32]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Int64(pred func(uint32) bool, m map[uint32]int64) map[uint32]int64 {
+	if pred == nil {
+		return map[uint32]int64{}
+	}
+	return FilterKVUint32Int64(func(k uint32, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Int64(pred func(int64) bool, m map[uint32]int64) map[uint32]int64 {
+	if pred == nil {
+		return map[uint32]int64{}
+	}
+	return FilterKVUint32Int64(func(_ uint32, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Int64(pred func(uint32, int64) bool, m map[uint32]int64) map[uint32]int64 {
+	newMap := make(map[uint32]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, uint32, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Int64(f func(int64, uint32, int64) int64, m map[uint32]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Int32 returns list of keys of the map[uint32]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Int32(m map[uint32]int32, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Int32 returns list of values of the map[uint32]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Int32(m map[uint32]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Int32(m map[uint32]int32, keys ...uint32) map[uint32]int32 {
+	newMap := make(map[uint32]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Int32 returns a new map without the given keys.
+func DissocKeysUint32Int32(m map[uint32]int32, keys ...uint32) map[uint32]int32 {
+	newMap := make(map[uint32]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Int32(m map[uint32]int32) map[int32]uint32 {
+	newMap := make(map[int32]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Int32(f func(int32) int32, m map[uint32]int32) map[uint32]int32 {
+	if f == nil {
+		return map[uint32]int32{}
+	}
+	newMap := make(map[uint32]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Int32(f func(uint32) uint32, m map[uint32]int32) map[uint32]int32 {
+	if f == nil {
+		return map[uint32]int32{}
+	}
+	newMap := make(map[uint32]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Int32(pred func(uint32) bool, m map[uint32]int32) map[uint32]int32 {
+	if pred == nil {
+		return map[uint32]int32{}
+	}
+	return FilterKVUint32Int32(func(k uint32, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Int32(pred func(int32) bool, m map[uint32]int32) map[uint32]int32 {
+	if pred == nil {
+		return map[uint32]int32{}
+	}
+	return FilterKVUint32Int32(func(_ uint32, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Int32(pred func(uint32, int32) bool, m map[uint32]int32) map[uint32]int32 {
+	newMap := make(map[uint32]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, uint32, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Int32(f func(int32, uint32, int32) int32, m map[uint32]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Int16 returns list of keys of the map[uint32]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Int16(m map[uint32]int16, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Int16 returns list of values of the map[uint32]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Int16(m map[uint32]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Int16(m map[uint32]int16, keys ...uint32) map[uint32]int16 {
+	newMap := make(map[uint32]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Int16 returns a new map without the given keys.
+func DissocKeysUint32Int16(m map[uint32]int16, keys ...uint32) map[uint32]int16 {
+	newMap := make(map[uint32]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Int16(m map[uint32]int16) map[int16]uint32 {
+	newMap := make(map[int16]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Int16(f func(int16) int16, m map[uint32]int16) map[uint32]int16 {
+	if f == nil {
+		return map[uint32]int16{}
+	}
+	newMap := make(map[uint32]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Int16(f func(uint32) uint32, m map[uint32]int16) map[uint32]int16 {
+	if f == nil {
+		return map[uint32]int16{}
+	}
+	newMap := make(map[uint32]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Int16(pred func(uint32) bool, m map[uint32]int16) map[uint32]int16 {
+	if pred == nil {
+		return map[uint32]int16{}
+	}
+	return FilterKVUint32Int16(func(k uint32, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Int16(pred func(int16) bool, m map[uint32]int16) map[uint32]int16 {
+	if pred == nil {
+		return map[uint32]int16{}
+	}
+	return FilterKVUint32Int16(func(_ uint32, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Int16(pred func(uint32, int16) bool, m map[uint32]int16) map[uint32]int16 {
+	newMap := make(map[uint32]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, uint32, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Int16(f func(int16, uint32, int16) int16, m map[uint32]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Int8 returns list of keys of the map[uint32]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Int8(m map[uint32]int8, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Int8 returns list of values of the map[uint32]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Int8(m map[uint32]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Int8(m map[uint32]int8, keys ...uint32) map[uint32]int8 {
+	newMap := make(map[uint32]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Int8 returns a new map without the given keys.
+func DissocKeysUint32Int8(m map[uint32]int8, keys ...uint32) map[uint32]int8 {
+	newMap := make(map[uint32]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Int8(m map[uint32]int8) map[int8]uint32 {
+	newMap := make(map[int8]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Int8(f func(int8) int8, m map[uint32]int8) map[uint32]int8 {
+	if f == nil {
+		return map[uint32]int8{}
+	}
+	newMap := make(map[uint32]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Int8(f func(uint32) uint32, m map[uint32]int8) map[uint32]int8 {
+	if f == nil {
+		return map[uint32]int8{}
+	}
+	newMap := make(map[uint32]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Int8(pred func(uint32) bool, m map[uint32]int8) map[uint32]int8 {
+	if pred == nil {
+		return map[uint32]int8{}
+	}
+	return FilterKVUint32Int8(func(k uint32, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Int8(pred func(int8) bool, m map[uint32]int8) map[uint32]int8 {
+	if pred == nil {
+		return map[uint32]int8{}
+	}
+	return FilterKVUint32Int8(func(_ uint32, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Int8(pred func(uint32, int8) bool, m map[uint32]int8) map[uint32]int8 {
+	newMap := make(map[uint32]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, uint32, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Int8(f func(int8, uint32, int8) int8, m map[uint32]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Uint returns list of keys of the map[uint32]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Uint(m map[uint32]uint, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Uint returns list of values of the map[uint32]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Uint(m map[uint32]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Uint returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Uint(m map[uint32]uint, keys ...uint32) map[uint32]uint {
+	newMap := make(map[uint32]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Uint returns a new map without the given keys.
+func DissocKeysUint32Uint(m map[uint32]uint, keys ...uint32) map[uint32]uint {
+	newMap := make(map[uint32]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Uint(m map[uint32]uint) map[uint]uint32 {
+	newMap := make(map[uint]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Uint(f func(uint) uint, m map[uint32]uint) map[uint32]uint {
+	if f == nil {
+		return map[uint32]uint{}
+	}
+	newMap := make(map[uint32]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Uint(f func(uint32) uint32, m map[uint32]uint) map[uint32]uint {
+	if f == nil {
+		return map[uint32]uint{}
+	}
+	newMap := make(map[uint32]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Uint(pred func(uint32) bool, m map[uint32]uint) map[uint32]uint {
+	if pred == nil {
+		return map[uint32]uint{}
+	}
+	return FilterKVUint32Uint(func(k uint32, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Uint(pred func(uint) bool, m map[uint32]uint) map[uint32]uint {
+	if pred == nil {
+		return map[uint32]uint{}
+	}
+	return FilterKVUint32Uint(func(_ uint32, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Uint(pred func(uint32, uint) bool, m map[uint32]uint) map[uint32]uint {
+	newMap := make(map[uint32]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, uint32, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Uint(f func(uint, uint32, uint) uint, m map[uint32]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Uint64 returns list of keys of the map[uint32]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Uint64(m map[uint32]uint64, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Uint64 returns list of values of the map[uint32]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Uint64(m map[uint32]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Uint64(m map[uint32]uint64, keys ...uint32) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Uint64 returns a new map without the given keys.
+func DissocKeysUint32Uint64(m map[uint32]uint64, keys ...uint32) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Uint64(m map[uint32]uint64) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Uint64(f func(uint64) uint64, m map[uint32]uint64) map[uint32]uint64 {
+	if f == nil {
+		return map[uint32]uint64{}
+	}
+	newMap := make(map[uint32]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Uint64(f func(uint32) uint32, m map[uint32]uint64) map[uint32]uint64 {
+	if f == nil {
+		return map[uint32]uint64{}
+	}
+	newMap := make(map[uint32]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Uint64(pred func(uint32) bool, m map[uint32]uint64) map[uint32]uint64 {
+	if pred == nil {
+		return map[uint32]uint64{}
+	}
+	return FilterKVUint32Uint64(func(k uint32, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Uint64(pred func(uint64) bool, m map[uint32]uint64) map[uint32]uint64 {
+	if pred == nil {
+		return map[uint32]uint64{}
+	}
+	return FilterKVUint32Uint64(func(_ uint32, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Uint64(pred func(uint32, uint64) bool, m map[uint32]uint64) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, uint32, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Uint64(f func(uint64, uint32, uint64) uint64, m map[uint32]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32 returns list of keys of the map[uint32]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32(m map[uint32]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32 returns list of values of the map[uint32]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32(m map[uint32]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32(m map[uint32]uint32, keys ...uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32 returns a new map without the given keys.
+func DissocKeysUint32(m map[uint32]uint32, keys ...uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32(m map[uint32]uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32(f func(uint32) uint32, m map[uint32]uint32) map[uint32]uint32 {
+	if f == nil {
+		return map[uint32]uint32{}
+	}
+	newMap := make(map[uint32]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32(f func(uint32) uint32, m map[uint32]uint32) map[uint32]uint32 {
+	if f == nil {
+		return map[uint32]uint32{}
+	}
+	newMap := make(map[uint32]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32(pred func(uint32) bool, m map[uint32]uint32) map[uint32]uint32 {
+	if pred == nil {
+		return map[uint32]uint32{}
+	}
+	return FilterKVUint32(func(k uint32, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32(pred func(uint32) bool, m map[uint32]uint32) map[uint32]uint32 {
+	if pred == nil {
+		return map[uint32]uint32{}
+	}
+	return FilterKVUint32(func(_ uint32, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32(pred func(uint32, uint32) bool, m map[uint32]uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, uint32, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32(f func(uint32, uint32, uint32) uint32, m map[uint32]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Uint16 returns list of keys of the map[uint32]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Uint16(m map[uint32]uint16, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Uint16 returns list of values of the map[uint32]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Uint16(m map[uint32]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Uint16(m map[uint32]uint16, keys ...uint32) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Uint16 returns a new map without the given keys.
+func DissocKeysUint32Uint16(m map[uint32]uint16, keys ...uint32) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Uint16(m map[uint32]uint16) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Uint16(f func(uint16) uint16, m map[uint32]uint16) map[uint32]uint16 {
+	if f == nil {
+		return map[uint32]uint16{}
+	}
+	newMap := make(map[uint32]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Uint16(f func(uint32) uint32, m map[uint32]uint16) map[uint32]uint16 {
+	if f == nil {
+		return map[uint32]uint16{}
+	}
+	newMap := make(map[uint32]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Uint16(pred func(uint32) bool, m map[uint32]uint16) map[uint32]uint16 {
+	if pred == nil {
+		return map[uint32]uint16{}
+	}
+	return FilterKVUint32Uint16(func(k uint32, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Uint16(pred func(uint16) bool, m map[uint32]uint16) map[uint32]uint16 {
+	if pred == nil {
+		return map[uint32]uint16{}
+	}
+	return FilterKVUint32Uint16(func(_ uint32, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Uint16(pred func(uint32, uint16) bool, m map[uint32]uint16) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, uint32, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Uint16(f func(uint16, uint32, uint16) uint16, m map[uint32]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Uint8 returns list of keys of the map[uint32]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Uint8(m map[uint32]uint8, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Uint8 returns list of values of the map[uint32]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Uint8(m map[uint32]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Uint8(m map[uint32]uint8, keys ...uint32) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Uint8 returns a new map without the given keys.
+func DissocKeysUint32Uint8(m map[uint32]uint8, keys ...uint32) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Uint8(m map[uint32]uint8) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Uint8(f func(uint8) uint8, m map[uint32]uint8) map[uint32]uint8 {
+	if f == nil {
+		return map[uint32]uint8{}
+	}
+	newMap := make(map[uint32]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Uint8(f func(uint32) uint32, m map[uint32]uint8) map[uint32]uint8 {
+	if f == nil {
+		return map[uint32]uint8{}
+	}
+	newMap := make(map[uint32]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Uint8(pred func(uint32) bool, m map[uint32]uint8) map[uint32]uint8 {
+	if pred == nil {
+		return map[uint32]uint8{}
+	}
+	return FilterKVUint32Uint8(func(k uint32, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Uint8(pred func(uint8) bool, m map[uint32]uint8) map[uint32]uint8 {
+	if pred == nil {
+		return map[uint32]uint8{}
+	}
+	return FilterKVUint32Uint8(func(_ uint32, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Uint8(pred func(uint32, uint8) bool, m map[uint32]uint8) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, uint32, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Uint8(f func(uint8, uint32, uint8) uint8, m map[uint32]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Str returns list of keys of the map[uint32]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Str(m map[uint32]string, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Str returns list of values of the map[uint32]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Str(m map[uint32]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Str returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Str(m map[uint32]string, keys ...uint32) map[uint32]string {
+	newMap := make(map[uint32]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Str returns a new map without the given keys.
+func DissocKeysUint32Str(m map[uint32]string, keys ...uint32) map[uint32]string {
+	newMap := make(map[uint32]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Str(m map[uint32]string) map[string]uint32 {
+	newMap := make(map[string]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Str(f func(string) string, m map[uint32]string) map[uint32]string {
+	if f == nil {
+		return map[uint32]string{}
+	}
+	newMap := make(map[uint32]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Str(f func(uint32) uint32, m map[uint32]string) map[uint32]string {
+	if f == nil {
+		return map[uint32]string{}
+	}
+	newMap := make(map[uint32]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Str(pred func(uint32) bool, m map[uint32]string) map[uint32]string {
+	if pred == nil {
+		return map[uint32]string{}
+	}
+	return FilterKVUint32Str(func(k uint32, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Str(pred func(string) bool, m map[uint32]string) map[uint32]string {
+	if pred == nil {
+		return map[uint32]string{}
+	}
+	return FilterKVUint32Str(func(_ uint32, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Str(pred func(uint32, string) bool, m map[uint32]string) map[uint32]string {
+	newMap := make(map[uint32]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, uint32, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Str(f func(string, uint32, string) string, m map[uint32]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint32Bool returns list of keys of the map[uint32]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint32Bool(m map[uint32]bool, less ...func(uint32, uint32) bool) []uint32 {
+	keys := make([]uint32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint32Bool returns list of values of the map[uint32]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint32Bool(m map[uint32]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint32Bool returns a new map which contains only the given keys of the map.
+func SelectKeysUint32Bool(m map[uint32]bool, keys ...uint32) map[uint32]bool {
+	newMap := make(map[uint32]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint32Bool returns a new map without the given keys.
+func DissocKeysUint32Bool(m map[uint32]bool, keys ...uint32) map[uint32]bool {
+	newMap := make(map[uint32]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint32Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint32Bool(m map[uint32]bool) map[bool]uint32 {
+	newMap := make(map[bool]uint32, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint32Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint32Bool(f func(bool) bool, m map[uint32]bool) map[uint32]bool {
+	if f == nil {
+		return map[uint32]bool{}
+	}
+	newMap := make(map[uint32]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint32Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint32Bool(f func(uint32) uint32, m map[uint32]bool) map[uint32]bool {
+	if f == nil {
+		return map[uint32]bool{}
+	}
+	newMap := make(map[uint32]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint32Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint32Bool(pred func(uint32) bool, m map[uint32]bool) map[uint32]bool {
+	if pred == nil {
+		return map[uint32]bool{}
+	}
+	return FilterKVUint32Bool(func(k uint32, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsUint32Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint32Bool(pred func(bool) bool, m map[uint32]bool) map[uint32]bool {
+	if pred == nil {
+		return map[uint32]bool{}
+	}
+	return FilterKVUint32Bool(func(_ uint32, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVUint32Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint32Bool(pred func(uint32, bool) bool, m map[uint32]bool) map[uint32]bool {
+	newMap := make(map[uint32]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint32Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, uint32, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint32Bool(f func(bool, uint32, bool) bool, m map[uint32]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Int returns list of keys of the map[uint16]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Int(m map[uint16]int, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Int returns list of values of the map[uint16]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Int(m map[uint16]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Int returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Int(m map[uint16]int, keys ...uint16) map[uint16]int {
+	newMap := make(map[uint16]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Int returns a new map without the given keys.
+func DissocKeysUint16Int(m map[uint16]int, keys ...uint16) map[uint16]int {
+	newMap := make(map[uint16]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Int(m map[uint16]int) map[int]uint16 {
+	newMap := make(map[int]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Int(f func(int) int, m map[uint16]int) map[uint16]int {
+	if f == nil {
+		return map[uint16]int{}
+	}
+	newMap := make(map[uint16]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Int(f func(uint16) uint16, m map[uint16]int) map[uint16]int {
+	if f == nil {
+		return map[uint16]int{}
+	}
+	newMap := make(map[uint16]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Int(pred func(uint16) bool, m map[uint16]int) map[uint16]int {
+	if pred == nil {
+		return map[uint16]int{}
+	}
+	return FilterKVUint16Int(func(k uint16, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Int(pred func(int) bool, m map[uint16]int) map[uint16]int {
+	if pred == nil {
+		return map[uint16]int{}
+	}
+	return FilterKVUint16Int(func(_ uint16, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Int(pred func(uint16, int) bool, m map[uint16]int) map[uint16]int {
+	newMap := make(map[uint16]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, uint16, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Int(f func(int, uint16, int) int, m map[uint16]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Int64 returns list of keys of the map[uint16]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Int64(m map[uint16]int64, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Int64 returns list of values of the map[uint16]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Int64(m map[uint16]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Int64(m map[uint16]int64, keys ...uint16) map[uint16]int64 {
+	newMap := make(map[uint16]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Int64 returns a new map without the given keys.
+func DissocKeysUint16Int64(m map[uint16]int64, keys ...uint16) map[uint16]int64 {
+	newMap := make(map[uint16]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Int64(m map[uint16]int64) map[int64]uint16 {
+	newMap := make(map[int64]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Int64(f func(int64) int64, m map[uint16]int64) map[uint16]int64 {
+	if f == nil {
+		return map[uint16]int64{}
+	}
+	newMap := make(map[uint16]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Int64(f func(uint16) uint16, m map[uint16]int64) map[uint16]int64 {
+	if f == nil {
+		return map[uint16]int64{}
+	}
+	newMap := make(map[uint16]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Int64(pred func(uint16) bool, m map[uint16]int64) map[uint16]int64 {
+	if pred == nil {
+		return map[uint16]int64{}
+	}
+	return FilterKVUint16Int64(func(k uint16, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Int64(pred func(int64) bool, m map[uint16]int64) map[uint16]int64 {
+	if pred == nil {
+		return map[uint16]int64{}
+	}
+	return FilterKVUint16Int64(func(_ uint16, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Int64(pred func(uint16, int64) bool, m map[uint16]int64) map[uint16]int64 {
+	newMap := make(map[uint16]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, uint16, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Int64(f func(int64, uint16, int64) int64, m map[uint16]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Int32 returns list of keys of the map[uint16]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Int32(m map[uint16]int32, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Int32 returns list of values of the map[uint16]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Int32(m map[uint16]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Int32(m map[uint16]int32, keys ...uint16) map[uint16]int32 {
+	newMap := make(map[uint16]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Int32 returns a new map without the given keys.
+func DissocKeysUint16Int32(m map[uint16]int32, keys ...uint16) map[uint16]int32 {
+	newMap := make(map[uint16]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Int32(m map[uint16]int32) map[int32]uint16 {
+	newMap := make(map[int32]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Int32(f func(int32) int32, m map[uint16]int32) map[uint16]int32 {
+	if f == nil {
+		return map[uint16]int32{}
+	}
+	newMap := make(map[uint16]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Int32(f func(uint16) uint16, m map[uint16]int32) map[uint16]int32 {
+	if f == nil {
+		return map[uint16]int32{}
+	}
+	newMap := make(map[uint16]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Int32(pred func(uint16) bool, m map[uint16]int32) map[uint16]int32 {
+	if pred == nil {
+		return map[uint16]int32{}
+	}
+	return FilterKVUint16Int32(func(k uint16, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Int32(pred func(int32) bool, m map[uint16]int32) map[uint16]int32 {
+	if pred == nil {
+		return map[uint16]int32{}
+	}
+	return FilterKVUint16Int32(func(_ uint16, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Int32(pred func(uint16, int32) bool, m map[uint16]int32) map[uint16]int32 {
+	newMap := make(map[uint16]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, uint16, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Int32(f func(int32, uint16, int32) int32, m map[uint16]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Int16 returns list of keys of the map[uint16]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Int16(m map[uint16]int16, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Int16 returns list of values of the map[uint16]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Int16(m map[uint16]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Int16(m map[uint16]int16, keys ...uint16) map[uint16]int16 {
+	newMap := make(map[uint16]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Int16 returns a new map without the given keys.
+func DissocKeysUint16Int16(m map[uint16]int16, keys ...uint16) map[uint16]int16 {
+	newMap := make(map[uint16]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Int16(m map[uint16]int16) map[int16]uint16 {
+	newMap := make(map[int16]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Int16(f func(int16) int16, m map[uint16]int16) map[uint16]int16 {
+	if f == nil {
+		return map[uint16]int16{}
+	}
+	newMap := make(map[uint16]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Int16(f func(uint16) uint16, m map[uint16]int16) map[uint16]int16 {
+	if f == nil {
+		return map[uint16]int16{}
+	}
+	newMap := make(map[uint16]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Int16(pred func(uint16) bool, m map[uint16]int16) map[uint16]int16 {
+	if pred == nil {
+		return map[uint16]int16{}
+	}
+	return FilterKVUint16Int16(func(k uint16, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Int16(pred func(int16) bool, m map[uint16]int16) map[uint16]int16 {
+	if pred == nil {
+		return map[uint16]int16{}
+	}
+	return FilterKVUint16Int16(func(_ uint16, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Int16(pred func(uint16, int16) bool, m map[uint16]int16) map[uint16]int16 {
+	newMap := make(map[uint16]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, uint16, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Int16(f func(int16, uint16, int16) int16, m map[uint16]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Int8 returns list of keys of the map[uint16]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Int8(m map[uint16]int8, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Int8 returns list of values of the map[uint16]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Int8(m map[uint16]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Int8(m map[uint16]int8, keys ...uint16) map[uint16]int8 {
+	newMap := make(map[uint16]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Int8 returns a new map without the given keys.
+func DissocKeysUint16Int8(m map[uint16]int8, keys ...uint16) map[uint16]int8 {
+	newMap := make(map[uint16]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Int8(m map[uint16]int8) map[int8]uint16 {
+	newMap := make(map[int8]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Int8(f func(int8) int8, m map[uint16]int8) map[uint16]int8 {
+	if f == nil {
+		return map[uint16]int8{}
+	}
+	newMap := make(map[uint16]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Int8(f func(uint16) uint16, m map[uint16]int8) map[uint16]int8 {
+	if f == nil {
+		return map[uint16]int8{}
+	}
+	newMap := make(map[uint16]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Int8(pred func(uint16) bool, m map[uint16]int8) map[uint16]int8 {
+	if pred == nil {
+		return map[uint16]int8{}
+	}
+	return FilterKVUint16Int8(func(k uint16, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Int8(pred func(int8) bool, m map[uint16]int8) map[uint16]int8 {
+	if pred == nil {
+		return map[uint16]int8{}
+	}
+	return FilterKVUint16Int8(func(_ uint16, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Int8(pred func(uint16, int8) bool, m map[uint16]int8) map[uint16]int8 {
+	newMap := make(map[uint16]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, uint16, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Int8(f func(int8, uint16, int8) int8, m map[uint16]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Uint returns list of keys of the map[uint16]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Uint(m map[uint16]uint, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Uint returns list of values of the map[uint16]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Uint(m map[uint16]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Uint returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Uint(m map[uint16]uint, keys ...uint16) map[uint16]uint {
+	newMap := make(map[uint16]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Uint returns a new map without the given keys.
+func DissocKeysUint16Uint(m map[uint16]uint, keys ...uint16) map[uint16]uint {
+	newMap := make(map[uint16]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Uint(m map[uint16]uint) map[uint]uint16 {
+	newMap := make(map[uint]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Uint(f func(uint) uint, m map[uint16]uint) map[uint16]uint {
+	if f == nil {
+		return map[uint16]uint{}
+	}
+	newMap := make(map[uint16]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Uint(f func(uint16) uint16, m map[uint16]uint) map[uint16]uint {
+	if f == nil {
+		return map[uint16]uint{}
+	}
+	newMap := make(map[uint16]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Uint(pred func(uint16) bool, m map[uint16]uint) map[uint16]uint {
+	if pred == nil {
+		return map[uint16]uint{}
+	}
+	return FilterKVUint16Uint(func(k uint16, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Uint(pred func(uint) bool, m map[uint16]uint) map[uint16]uint {
+	if pred == nil {
+		return map[uint16]uint{}
+	}
+	return FilterKVUint16Uint(func(_ uint16, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Uint(pred func(uint16, uint) bool, m map[uint16]uint) map[uint16]uint {
+	newMap := make(map[uint16]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, uint16, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Uint(f func(uint, uint16, uint) uint, m map[uint16]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Uint64 returns list of keys of the map[uint16]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Uint64(m map[uint16]uint64, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Uint64 returns list of values of the map[uint16]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Uint64(m map[uint16]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Uint64(m map[uint16]uint64, keys ...uint16) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Uint64 returns a new map without the given keys.
+func DissocKeysUint16Uint64(m map[uint16]uint64, keys ...uint16) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Uint64(m map[uint16]uint64) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Uint64(f func(uint64) uint64, m map[uint16]uint64) map[uint16]uint64 {
+	if f == nil {
+		return map[uint16]uint64{}
+	}
+	newMap := make(map[uint16]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Uint64(f func(uint16) uint16, m map[uint16]uint64) map[uint16]uint64 {
+	if f == nil {
+		return map[uint16]uint64{}
+	}
+	newMap := make(map[uint16]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Uint64(pred func(uint16) bool, m map[uint16]uint64) map[uint16]uint64 {
+	if pred == nil {
+		return map[uint16]uint64{}
+	}
+	return FilterKVUint16Uint64(func(k uint16, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Uint64(pred func(uint64) bool, m map[uint16]uint64) map[uint16]uint64 {
+	if pred == nil {
+		return map[uint16]uint64{}
+	}
+	return FilterKVUint16Uint64(func(_ uint16, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Uint64(pred func(uint16, uint64) bool, m map[uint16]uint64) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, uint16, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Uint64(f func(uint64, uint16, uint64) uint64, m map[uint16]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Uint32 returns list of keys of the map[uint16]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Uint32(m map[uint16]uint32, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Uint32 returns list of values of the map[uint16]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Uint32(m map[uint16]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Uint32(m map[uint16]uint32, keys ...uint16) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Uint32 returns a new map without the given keys.
+func DissocKeysUint16Uint32(m map[uint16]uint32, keys ...uint16) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Uint32(m map[uint16]uint32) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Uint32(f func(uint32) uint32, m map[uint16]uint32) map[uint16]uint32 {
+	if f == nil {
+		return map[uint16]uint32{}
+	}
+	newMap := make(map[uint16]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Uint32(f func(uint16) uint16, m map[uint16]uint32) map[uint16]uint32 {
+	if f == nil {
+		return map[uint16]uint32{}
+	}
+	newMap := make(map[uint16]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Uint32(pred func(uint16) bool, m map[uint16]uint32) map[uint16]uint32 {
+	if pred == nil {
+		return map[uint16]uint32{}
+	}
+	return FilterKVUint16Uint32(func(k uint16, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Uint32(pred func(uint32) bool, m map[uint16]uint32) map[uint16]uint32 {
+	if pred == nil {
+		return map[uint16]uint32{}
+	}
+	return FilterKVUint16Uint32(func(_ uint16, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Uint32(pred func(uint16, uint32) bool, m map[uint16]uint32) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, uint16, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Uint32(f func(uint32, uint16, uint32) uint32, m map[uint16]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16 returns list of keys of the map[uint16]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16(m map[uint16]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16 returns list of values of the map[uint16]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16(m map[uint16]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16(m map[uint16]uint16, keys ...uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16 returns a new map without the given keys.
+func DissocKeysUint16(m map[uint16]uint16, keys ...uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16(m map[uint16]uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16(f func(uint16) uint16, m map[uint16]uint16) map[uint16]uint16 {
+	if f == nil {
+		return map[uint16]uint16{}
+	}
+	newMap := make(map[uint16]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16(f func(uint16) uint16, m map[uint16]uint16) map[uint16]uint16 {
+	if f == nil {
+		return map[uint16]uint16{}
+	}
+	newMap := make(map[uint16]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16(pred func(uint16) bool, m map[uint16]uint16) map[uint16]uint16 {
+	if pred == nil {
+		return map[uint16]uint16{}
+	}
+	return FilterKVUint16(func(k uint16, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16(pred func(uint16) bool, m map[uint16]uint16) map[uint16]uint16 {
+	if pred == nil {
+		return map[uint16]uint16{}
+	}
+	return FilterKVUint16(func(_ uint16, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16(pred func(uint16, uint16) bool, m map[uint16]uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, uint16, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16(f func(uint16, uint16, uint16) uint16, m map[uint16]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Uint8 returns list of keys of the map[uint16]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Uint8(m map[uint16]uint8, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Uint8 returns list of values of the map[uint16]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Uint8(m map[uint16]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Uint8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Uint8(m map[uint16]uint8, keys ...uint16) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Uint8 returns a new map without the given keys.
+func DissocKeysUint16Uint8(m map[uint16]uint8, keys ...uint16) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Uint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Uint8(m map[uint16]uint8) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Uint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Uint8(f func(uint8) uint8, m map[uint16]uint8) map[uint16]uint8 {
+	if f == nil {
+		return map[uint16]uint8{}
+	}
+	newMap := make(map[uint16]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Uint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Uint8(f func(uint16) uint16, m map[uint16]uint8) map[uint16]uint8 {
+	if f == nil {
+		return map[uint16]uint8{}
+	}
+	newMap := make(map[uint16]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Uint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Uint8(pred func(uint16) bool, m map[uint16]uint8) map[uint16]uint8 {
+	if pred == nil {
+		return map[uint16]uint8{}
+	}
+	return FilterKVUint16Uint8(func(k uint16, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Uint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Uint8(pred func(uint8) bool, m map[uint16]uint8) map[uint16]uint8 {
+	if pred == nil {
+		return map[uint16]uint8{}
+	}
+	return FilterKVUint16Uint8(func(_ uint16, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Uint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Uint8(pred func(uint16, uint8) bool, m map[uint16]uint8) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Uint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, uint16, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Uint8(f func(uint8, uint16, uint8) uint8, m map[uint16]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Str returns list of keys of the map[uint16]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Str(m map[uint16]string, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Str returns list of values of the map[uint16]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Str(m map[uint16]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Str returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Str(m map[uint16]string, keys ...uint16) map[uint16]string {
+	newMap := make(map[uint16]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Str returns a new map without the given keys.
+func DissocKeysUint16Str(m map[uint16]string, keys ...uint16) map[uint16]string {
+	newMap := make(map[uint16]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Str(m map[uint16]string) map[string]uint16 {
+	newMap := make(map[string]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Str(f func(string) string, m map[uint16]string) map[uint16]string {
+	if f == nil {
+		return map[uint16]string{}
+	}
+	newMap := make(map[uint16]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Str(f func(uint16) uint16, m map[uint16]string) map[uint16]string {
+	if f == nil {
+		return map[uint16]string{}
+	}
+	newMap := make(map[uint16]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Str(pred func(uint16) bool, m map[uint16]string) map[uint16]string {
+	if pred == nil {
+		return map[uint16]string{}
+	}
+	return FilterKVUint16Str(func(k uint16, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Str(pred func(string) bool, m map[uint16]string) map[uint16]string {
+	if pred == nil {
+		return map[uint16]string{}
+	}
+	return FilterKVUint16Str(func(_ uint16, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Str(pred func(uint16, string) bool, m map[uint16]string) map[uint16]string {
+	newMap := make(map[uint16]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, uint16, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Str(f func(string, uint16, string) string, m map[uint16]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint16Bool returns list of keys of the map[uint16]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint16Bool(m map[uint16]bool, less ...func(uint16, uint16) bool) []uint16 {
+	keys := make([]uint16, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint16Bool returns list of values of the map[uint16]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint16Bool(m map[uint16]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint16Bool returns a new map which contains only the given keys of the map.
+func SelectKeysUint16Bool(m map[uint16]bool, keys ...uint16) map[uint16]bool {
+	newMap := make(map[uint16]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint16Bool returns a new map without the given keys.
+func DissocKeysUint16Bool(m map[uint16]bool, keys ...uint16) map[uint16]bool {
+	newMap := make(map[uint16]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint16Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint16Bool(m map[uint16]bool) map[bool]uint16 {
+	newMap := make(map[bool]uint16, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint16Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint16Bool(f func(bool) bool, m map[uint16]bool) map[uint16]bool {
+	if f == nil {
+		return map[uint16]bool{}
+	}
+	newMap := make(map[uint16]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint16Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint16Bool(f func(uint16) uint16, m map[uint16]bool) map[uint16]bool {
+	if f == nil {
+		return map[uint16]bool{}
+	}
+	newMap := make(map[uint16]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint16Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint16Bool(pred func(uint16) bool, m map[uint16]bool) map[uint16]bool {
+	if pred == nil {
+		return map[uint16]bool{}
+	}
+	return FilterKVUint16Bool(func(k uint16, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsUint16Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint16Bool(pred func(bool) bool, m map[uint16]bool) map[uint16]bool {
+	if pred == nil {
+		return map[uint16]bool{}
+	}
+	return FilterKVUint16Bool(func(_ uint16, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVUint16Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint16Bool(pred func(uint16, bool) bool, m map[uint16]bool) map[uint16]bool {
+	newMap := make(map[uint16]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint16Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, uint16, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint16Bool(f func(bool, uint16, bool) bool, m map[uint16]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Int returns list of keys of the map[uint8]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Int(m map[uint8]int, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Int returns list of values of the map[uint8]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Int(m map[uint8]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Int returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Int(m map[uint8]int, keys ...uint8) map[uint8]int {
+	newMap := make(map[uint8]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Int returns a new map without the given keys.
+func DissocKeysUint8Int(m map[uint8]int, keys ...uint8) map[uint8]int {
+	newMap := make(map[uint8]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Int returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Int(m map[uint8]int) map[int]uint8 {
+	newMap := make(map[int]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Int applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Int(f func(int) int, m map[uint8]int) map[uint8]int {
+	if f == nil {
+		return map[uint8]int{}
+	}
+	newMap := make(map[uint8]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Int applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Int(f func(uint8) uint8, m map[uint8]int) map[uint8]int {
+	if f == nil {
+		return map[uint8]int{}
+	}
+	newMap := make(map[uint8]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Int returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Int(pred func(uint8) bool, m map[uint8]int) map[uint8]int {
+	if pred == nil {
+		return map[uint8]int{}
+	}
+	return FilterKVUint8Int(func(k uint8, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Int returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Int(pred func(int) bool, m map[uint8]int) map[uint8]int {
+	if pred == nil {
+		return map[uint8]int{}
+	}
+	return FilterKVUint8Int(func(_ uint8, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Int returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Int(pred func(uint8, int) bool, m map[uint8]int) map[uint8]int {
+	newMap := make(map[uint8]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Int reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, uint8, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Int(f func(int, uint8, int) int, m map[uint8]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Int64 returns list of keys of the map[uint8]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Int64(m map[uint8]int64, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Int64 returns list of values of the map[uint8]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Int64(m map[uint8]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Int64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Int64(m map[uint8]int64, keys ...uint8) map[uint8]int64 {
+	newMap := make(map[uint8]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Int64 returns a new map without the given keys.
+func DissocKeysUint8Int64(m map[uint8]int64, keys ...uint8) map[uint8]int64 {
+	newMap := make(map[uint8]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Int64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Int64(m map[uint8]int64) map[int64]uint8 {
+	newMap := make(map[int64]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Int64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Int64(f func(int64) int64, m map[uint8]int64) map[uint8]int64 {
+	if f == nil {
+		return map[uint8]int64{}
+	}
+	newMap := make(map[uint8]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Int64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Int64(f func(uint8) uint8, m map[uint8]int64) map[uint8]int64 {
+	if f == nil {
+		return map[uint8]int64{}
+	}
+	newMap := make(map[uint8]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Int64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Int64(pred func(uint8) bool, m map[uint8]int64) map[uint8]int64 {
+	if pred == nil {
+		return map[uint8]int64{}
+	}
+	return FilterKVUint8Int64(func(k uint8, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Int64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Int64(pred func(int64) bool, m map[uint8]int64) map[uint8]int64 {
+	if pred == nil {
+		return map[uint8]int64{}
+	}
+	return FilterKVUint8Int64(func(_ uint8, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Int64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Int64(pred func(uint8, int64) bool, m map[uint8]int64) map[uint8]int64 {
+	newMap := make(map[uint8]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Int64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, uint8, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Int64(f func(int64, uint8, int64) int64, m map[uint8]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Int32 returns list of keys of the map[uint8]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Int32(m map[uint8]int32, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Int32 returns list of values of the map[uint8]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Int32(m map[uint8]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Int32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Int32(m map[uint8]int32, keys ...uint8) map[uint8]int32 {
+	newMap := make(map[uint8]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Int32 returns a new map without the given keys.
+func DissocKeysUint8Int32(m map[uint8]int32, keys ...uint8) map[uint8]int32 {
+	newMap := make(map[uint8]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Int32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Int32(m map[uint8]int32) map[int32]uint8 {
+	newMap := make(map[int32]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Int32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Int32(f func(int32) int32, m map[uint8]int32) map[uint8]int32 {
+	if f == nil {
+		return map[uint8]int32{}
+	}
+	newMap := make(map[uint8]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Int32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Int32(f func(uint8) uint8, m map[uint8]int32) map[uint8]int32 {
+	if f == nil {
+		return map[uint8]int32{}
+	}
+	newMap := make(map[uint8]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Int32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Int32(pred func(uint8) bool, m map[uint8]int32) map[uint8]int32 {
+	if pred == nil {
+		return map[uint8]int32{}
+	}
+	return FilterKVUint8Int32(func(k uint8, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Int32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Int32(pred func(int32) bool, m map[uint8]int32) map[uint8]int32 {
+	if pred == nil {
+		return map[uint8]int32{}
+	}
+	return FilterKVUint8Int32(func(_ uint8, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Int32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Int32(pred func(uint8, int32) bool, m map[uint8]int32) map[uint8]int32 {
+	newMap := make(map[uint8]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Int32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, uint8, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Int32(f func(int32, uint8, int32) int32, m map[uint8]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Int16 returns list of keys of the map[uint8]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Int16(m map[uint8]int16, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Int16 returns list of values of the map[uint8]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Int16(m map[uint8]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Int16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Int16(m map[uint8]int16, keys ...uint8) map[uint8]int16 {
+	newMap := make(map[uint8]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Int16 returns a new map without the given keys.
+func DissocKeysUint8Int16(m map[uint8]int16, keys ...uint8) map[uint8]int16 {
+	newMap := make(map[uint8]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Int16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Int16(m map[uint8]int16) map[int16]uint8 {
+	newMap := make(map[int16]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Int16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Int16(f func(int16) int16, m map[uint8]int16) map[uint8]int16 {
+	if f == nil {
+		return map[uint8]int16{}
+	}
+	newMap := make(map[uint8]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Int16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Int16(f func(uint8) uint8, m map[uint8]int16) map[uint8]int16 {
+	if f == nil {
+		return map[uint8]int16{}
+	}
+	newMap := make(map[uint8]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Int16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Int16(pred func(uint8) bool, m map[uint8]int16) map[uint8]int16 {
+	if pred == nil {
+		return map[uint8]int16{}
+	}
+	return FilterKVUint8Int16(func(k uint8, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Int16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Int16(pred func(int16) bool, m map[uint8]int16) map[uint8]int16 {
+	if pred == nil {
+		return map[uint8]int16{}
+	}
+	return FilterKVUint8Int16(func(_ uint8, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Int16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Int16(pred func(uint8, int16) bool, m map[uint8]int16) map[uint8]int16 {
+	newMap := make(map[uint8]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Int16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, uint8, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Int16(f func(int16, uint8, int16) int16, m map[uint8]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Int8 returns list of keys of the map[uint8]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Int8(m map[uint8]int8, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Int8 returns list of values of the map[uint8]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Int8(m map[uint8]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Int8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Int8(m map[uint8]int8, keys ...uint8) map[uint8]int8 {
+	newMap := make(map[uint8]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Int8 returns a new map without the given keys.
+func DissocKeysUint8Int8(m map[uint8]int8, keys ...uint8) map[uint8]int8 {
+	newMap := make(map[uint8]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Int8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Int8(m map[uint8]int8) map[int8]uint8 {
+	newMap := make(map[int8]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Int8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Int8(f func(int8) int8, m map[uint8]int8) map[uint8]int8 {
+	if f == nil {
+		return map[uint8]int8{}
+	}
+	newMap := make(map[uint8]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Int8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Int8(f func(uint8) uint8, m map[uint8]int8) map[uint8]int8 {
+	if f == nil {
+		return map[uint8]int8{}
+	}
+	newMap := make(map[uint8]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Int8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Int8(pred func(uint8) bool, m map[uint8]int8) map[uint8]int8 {
+	if pred == nil {
+		return map[uint8]int8{}
+	}
+	return FilterKVUint8Int8(func(k uint8, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Int8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Int8(pred func(int8) bool, m map[uint8]int8) map[uint8]int8 {
+	if pred == nil {
+		return map[uint8]int8{}
+	}
+	return FilterKVUint8Int8(func(_ uint8, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Int8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Int8(pred func(uint8, int8) bool, m map[uint8]int8) map[uint8]int8 {
+	newMap := make(map[uint8]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Int8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, uint8, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Int8(f func(int8, uint8, int8) int8, m map[uint8]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Uint returns list of keys of the map[uint8]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Uint(m map[uint8]uint, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Uint returns list of values of the map[uint8]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Uint(m map[uint8]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Uint returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Uint(m map[uint8]uint, keys ...uint8) map[uint8]uint {
+	newMap := make(map[uint8]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Uint returns a new map without the given keys.
+func DissocKeysUint8Uint(m map[uint8]uint, keys ...uint8) map[uint8]uint {
+	newMap := make(map[uint8]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Uint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Uint(m map[uint8]uint) map[uint]uint8 {
+	newMap := make(map[uint]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Uint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Uint(f func(uint) uint, m map[uint8]uint) map[uint8]uint {
+	if f == nil {
+		return map[uint8]uint{}
+	}
+	newMap := make(map[uint8]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Uint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Uint(f func(uint8) uint8, m map[uint8]uint) map[uint8]uint {
+	if f == nil {
+		return map[uint8]uint{}
+	}
+	newMap := make(map[uint8]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Uint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Uint(pred func(uint8) bool, m map[uint8]uint) map[uint8]uint {
+	if pred == nil {
+		return map[uint8]uint{}
+	}
+	return FilterKVUint8Uint(func(k uint8, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Uint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Uint(pred func(uint) bool, m map[uint8]uint) map[uint8]uint {
+	if pred == nil {
+		return map[uint8]uint{}
+	}
+	return FilterKVUint8Uint(func(_ uint8, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Uint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Uint(pred func(uint8, uint) bool, m map[uint8]uint) map[uint8]uint {
+	newMap := make(map[uint8]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Uint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, uint8, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Uint(f func(uint, uint8, uint) uint, m map[uint8]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Uint64 returns list of keys of the map[uint8]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Uint64(m map[uint8]uint64, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Uint64 returns list of values of the map[uint8]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Uint64(m map[uint8]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Uint64 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Uint64(m map[uint8]uint64, keys ...uint8) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Uint64 returns a new map without the given keys.
+func DissocKeysUint8Uint64(m map[uint8]uint64, keys ...uint8) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Uint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Uint64(m map[uint8]uint64) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Uint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Uint64(f func(uint64) uint64, m map[uint8]uint64) map[uint8]uint64 {
+	if f == nil {
+		return map[uint8]uint64{}
+	}
+	newMap := make(map[uint8]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Uint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Uint64(f func(uint8) uint8, m map[uint8]uint64) map[uint8]uint64 {
+	if f == nil {
+		return map[uint8]uint64{}
+	}
+	newMap := make(map[uint8]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Uint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Uint64(pred func(uint8) bool, m map[uint8]uint64) map[uint8]uint64 {
+	if pred == nil {
+		return map[uint8]uint64{}
+	}
+	return FilterKVUint8Uint64(func(k uint8, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Uint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Uint64(pred func(uint64) bool, m map[uint8]uint64) map[uint8]uint64 {
+	if pred == nil {
+		return map[uint8]uint64{}
+	}
+	return FilterKVUint8Uint64(func(_ uint8, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Uint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Uint64(pred func(uint8, uint64) bool, m map[uint8]uint64) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Uint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, uint8, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Uint64(f func(uint64, uint8, uint64) uint64, m map[uint8]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Uint32 returns list of keys of the map[uint8]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Uint32(m map[uint8]uint32, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Uint32 returns list of values of the map[uint8]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Uint32(m map[uint8]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Uint32 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Uint32(m map[uint8]uint32, keys ...uint8) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Uint32 returns a new map without the given keys.
+func DissocKeysUint8Uint32(m map[uint8]uint32, keys ...uint8) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Uint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Uint32(m map[uint8]uint32) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Uint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Uint32(f func(uint32) uint32, m map[uint8]uint32) map[uint8]uint32 {
+	if f == nil {
+		return map[uint8]uint32{}
+	}
+	newMap := make(map[uint8]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Uint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Uint32(f func(uint8) uint8, m map[uint8]uint32) map[uint8]uint32 {
+	if f == nil {
+		return map[uint8]uint32{}
+	}
+	newMap := make(map[uint8]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Uint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Uint32(pred func(uint8) bool, m map[uint8]uint32) map[uint8]uint32 {
+	if pred == nil {
+		return map[uint8]uint32{}
+	}
+	return FilterKVUint8Uint32(func(k uint8, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Uint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Uint32(pred func(uint32) bool, m map[uint8]uint32) map[uint8]uint32 {
+	if pred == nil {
+		return map[uint8]uint32{}
+	}
+	return FilterKVUint8Uint32(func(_ uint8, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Uint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Uint32(pred func(uint8, uint32) bool, m map[uint8]uint32) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Uint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, uint8, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Uint32(f func(uint32, uint8, uint32) uint32, m map[uint8]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Uint16 returns list of keys of the map[uint8]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Uint16(m map[uint8]uint16, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Uint16 returns list of values of the map[uint8]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Uint16(m map[uint8]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Uint16 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Uint16(m map[uint8]uint16, keys ...uint8) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Uint16 returns a new map without the given keys.
+func DissocKeysUint8Uint16(m map[uint8]uint16, keys ...uint8) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Uint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Uint16(m map[uint8]uint16) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Uint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Uint16(f func(uint16) uint16, m map[uint8]uint16) map[uint8]uint16 {
+	if f == nil {
+		return map[uint8]uint16{}
+	}
+	newMap := make(map[uint8]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Uint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Uint16(f func(uint8) uint8, m map[uint8]uint16) map[uint8]uint16 {
+	if f == nil {
+		return map[uint8]uint16{}
+	}
+	newMap := make(map[uint8]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Uint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Uint16(pred func(uint8) bool, m map[uint8]uint16) map[uint8]uint16 {
+	if pred == nil {
+		return map[uint8]uint16{}
+	}
+	return FilterKVUint8Uint16(func(k uint8, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Uint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Uint16(pred func(uint16) bool, m map[uint8]uint16) map[uint8]uint16 {
+	if pred == nil {
+		return map[uint8]uint16{}
+	}
+	return FilterKVUint8Uint16(func(_ uint8, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Uint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Uint16(pred func(uint8, uint16) bool, m map[uint8]uint16) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Uint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, uint8, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Uint16(f func(uint16, uint8, uint16) uint16, m map[uint8]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8 returns list of keys of the map[uint8]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8(m map[uint8]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8 returns list of values of the map[uint8]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8(m map[uint8]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8 returns a new map which contains only the given keys of the map.
+func SelectKeysUint8(m map[uint8]uint8, keys ...uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8 returns a new map without the given keys.
+func DissocKeysUint8(m map[uint8]uint8, keys ...uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8(m map[uint8]uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8(f func(uint8) uint8, m map[uint8]uint8) map[uint8]uint8 {
+	if f == nil {
+		return map[uint8]uint8{}
+	}
+	newMap := make(map[uint8]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8(f func(uint8) uint8, m map[uint8]uint8) map[uint8]uint8 {
+	if f == nil {
+		return map[uint8]uint8{}
+	}
+	newMap := make(map[uint8]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8(pred func(uint8) bool, m map[uint8]uint8) map[uint8]uint8 {
+	if pred == nil {
+		return map[uint8]uint8{}
+	}
+	return FilterKVUint8(func(k uint8, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8(pred func(uint8) bool, m map[uint8]uint8) map[uint8]uint8 {
+	if pred == nil {
+		return map[uint8]uint8{}
+	}
+	return FilterKVUint8(func(_ uint8, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8(pred func(uint8, uint8) bool, m map[uint8]uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, uint8, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8(f func(uint8, uint8, uint8) uint8, m map[uint8]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Str returns list of keys of the map[uint8]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Str(m map[uint8]string, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Str returns list of values of the map[uint8]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Str(m map[uint8]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Str returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Str(m map[uint8]string, keys ...uint8) map[uint8]string {
+	newMap := make(map[uint8]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Str returns a new map without the given keys.
+func DissocKeysUint8Str(m map[uint8]string, keys ...uint8) map[uint8]string {
+	newMap := make(map[uint8]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Str returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Str(m map[uint8]string) map[string]uint8 {
+	newMap := make(map[string]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Str applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Str(f func(string) string, m map[uint8]string) map[uint8]string {
+	if f == nil {
+		return map[uint8]string{}
+	}
+	newMap := make(map[uint8]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Str applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Str(f func(uint8) uint8, m map[uint8]string) map[uint8]string {
+	if f == nil {
+		return map[uint8]string{}
+	}
+	newMap := make(map[uint8]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Str returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Str(pred func(uint8) bool, m map[uint8]string) map[uint8]string {
+	if pred == nil {
+		return map[uint8]string{}
+	}
+	return FilterKVUint8Str(func(k uint8, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Str returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Str(pred func(string) bool, m map[uint8]string) map[uint8]string {
+	if pred == nil {
+		return map[uint8]string{}
+	}
+	return FilterKVUint8Str(func(_ uint8, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Str returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Str(pred func(uint8, string) bool, m map[uint8]string) map[uint8]string {
+	newMap := make(map[uint8]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Str reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, uint8, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Str(f func(string, uint8, string) string, m map[uint8]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysUint8Bool returns list of keys of the map[uint8]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysUint8Bool(m map[uint8]bool, less ...func(uint8, uint8) bool) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsUint8Bool returns list of values of the map[uint8]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsUint8Bool(m map[uint8]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysUint8Bool returns a new map which contains only the given keys of the map.
+func SelectKeysUint8Bool(m map[uint8]bool, keys ...uint8) map[uint8]bool {
+	newMap := make(map[uint8]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysUint8Bool returns a new map without the given keys.
+func DissocKeysUint8Bool(m map[uint8]bool, keys ...uint8) map[uint8]bool {
+	newMap := make(map[uint8]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertUint8Bool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertUint8Bool(m map[uint8]bool) map[bool]uint8 {
+	newMap := make(map[bool]uint8, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsUint8Bool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsUint8Bool(f func(bool) bool, m map[uint8]bool) map[uint8]bool {
+	if f == nil {
+		return map[uint8]bool{}
+	}
+	newMap := make(map[uint8]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysUint8Bool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysUint8Bool(f func(uint8) uint8, m map[uint8]bool) map[uint8]bool {
+	if f == nil {
+		return map[uint8]bool{}
+	}
+	newMap := make(map[uint8]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysUint8Bool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysUint8Bool(pred func(uint8) bool, m map[uint8]bool) map[uint8]bool {
+	if pred == nil {
+		return map[uint8]bool{}
+	}
+	return FilterKVUint8Bool(func(k uint8, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsUint8Bool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsUint8Bool(pred func(bool) bool, m map[uint8]bool) map[uint8]bool {
+	if pred == nil {
+		return map[uint8]bool{}
+	}
+	return FilterKVUint8Bool(func(_ uint8, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVUint8Bool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVUint8Bool(pred func(uint8, bool) bool, m map[uint8]bool) map[uint8]bool {
+	newMap := make(map[uint8]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVUint8Bool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, uint8, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVUint8Bool(f func(bool, uint8, bool) bool, m map[uint8]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrInt returns list of keys of the map[string]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrInt(m map[string]int, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrInt returns list of values of the map[string]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrInt(m map[string]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrInt returns a new map which contains only the given keys of the map.
+func SelectKeysStrInt(m map[string]int, keys ...string) map[string]int {
+	newMap := make(map[string]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrInt returns a new map without the given keys.
+func DissocKeysStrInt(m map[string]int, keys ...string) map[string]int {
+	newMap := make(map[string]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrInt(m map[string]int) map[int]string {
+	newMap := make(map[int]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrInt(f func(int) int, m map[string]int) map[string]int {
+	if f == nil {
+		return map[string]int{}
+	}
+	newMap := make(map[string]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrInt(f func(string) string, m map[string]int) map[string]int {
+	if f == nil {
+		return map[string]int{}
+	}
+	newMap := make(map[string]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrInt(pred func(string) bool, m map[string]int) map[string]int {
+	if pred == nil {
+		return map[string]int{}
+	}
+	return FilterKVStrInt(func(k string, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsStrInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrInt(pred func(int) bool, m map[string]int) map[string]int {
+	if pred == nil {
+		return map[string]int{}
+	}
+	return FilterKVStrInt(func(_ string, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVStrInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrInt(pred func(string, int) bool, m map[string]int) map[string]int {
+	newMap := make(map[string]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, string, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrInt(f func(int, string, int) int, m map[string]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrInt64 returns list of keys of the map[string]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrInt64(m map[string]int64, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrInt64 returns list of values of the map[string]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrInt64(m map[string]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrInt64 returns a new map which contains only the given keys of the map.
+func SelectKeysStrInt64(m map[string]int64, keys ...string) map[string]int64 {
+	newMap := make(map[string]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrInt64 returns a new map without the given keys.
+func DissocKeysStrInt64(m map[string]int64, keys ...string) map[string]int64 {
+	newMap := make(map[string]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrInt64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrInt64(m map[string]int64) map[int64]string {
+	newMap := make(map[int64]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrInt64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrInt64(f func(int64) int64, m map[string]int64) map[string]int64 {
+	if f == nil {
+		return map[string]int64{}
+	}
+	newMap := make(map[string]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrInt64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrInt64(f func(string) string, m map[string]int64) map[string]int64 {
+	if f == nil {
+		return map[string]int64{}
+	}
+	newMap := make(map[string]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrInt64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrInt64(pred func(string) bool, m map[string]int64) map[string]int64 {
+	if pred == nil {
+		return map[string]int64{}
+	}
+	return FilterKVStrInt64(func(k string, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsStrInt64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrInt64(pred func(int64) bool, m map[string]int64) map[string]int64 {
+	if pred == nil {
+		return map[string]int64{}
+	}
+	return FilterKVStrInt64(func(_ string, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVStrInt64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrInt64(pred func(string, int64) bool, m map[string]int64) map[string]int64 {
+	newMap := make(map[string]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrInt64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, string, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrInt64(f func(int64, string, int64) int64, m map[string]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrInt32 returns list of keys of the map[string]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrInt32(m map[string]int32, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrInt32 returns list of values of the map[string]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrInt32(m map[string]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrInt32 returns a new map which contains only the given keys of the map.
+func SelectKeysStrInt32(m map[string]int32, keys ...string) map[string]int32 {
+	newMap := make(map[string]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrInt32 returns a new map without the given keys.
+func DissocKeysStrInt32(m map[string]int32, keys ...string) map[string]int32 {
+	newMap := make(map[string]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrInt32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrInt32(m map[string]int32) map[int32]string {
+	newMap := make(map[int32]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrInt32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrInt32(f func(int32) int32, m map[string]int32) map[string]int32 {
+	if f == nil {
+		return map[string]int32{}
+	}
+	newMap := make(map[string]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrInt32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrInt32(f func(string) string, m map[string]int32) map[string]int32 {
+	if f == nil {
+		return map[string]int32{}
+	}
+	newMap := make(map[string]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrInt32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrInt32(pred func(string) bool, m map[string]int32) map[string]int32 {
+	if pred == nil {
+		return map[string]int32{}
+	}
+	return FilterKVStrInt32(func(k string, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsStrInt32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrInt32(pred func(int32) bool, m map[string]int32) map[string]int32 {
+	if pred == nil {
+		return map[string]int32{}
+	}
+	return FilterKVStrInt32(func(_ string, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVStrInt32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrInt32(pred func(string, int32) bool, m map[string]int32) map[string]int32 {
+	newMap := make(map[string]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrInt32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, string, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrInt32(f func(int32, string, int32) int32, m map[string]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrInt16 returns list of keys of the map[string]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrInt16(m map[string]int16, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrInt16 returns list of values of the map[string]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrInt16(m map[string]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrInt16 returns a new map which contains only the given keys of the map.
+func SelectKeysStrInt16(m map[string]int16, keys ...string) map[string]int16 {
+	newMap := make(map[string]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrInt16 returns a new map without the given keys.
+func DissocKeysStrInt16(m map[string]int16, keys ...string) map[string]int16 {
+	newMap := make(map[string]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrInt16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrInt16(m map[string]int16) map[int16]string {
+	newMap := make(map[int16]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrInt16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrInt16(f func(int16) int16, m map[string]int16) map[string]int16 {
+	if f == nil {
+		return map[string]int16{}
+	}
+	newMap := make(map[string]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrInt16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrInt16(f func(string) string, m map[string]int16) map[string]int16 {
+	if f == nil {
+		return map[string]int16{}
+	}
+	newMap := make(map[string]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrInt16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrInt16(pred func(string) bool, m map[string]int16) map[string]int16 {
+	if pred == nil {
+		return map[string]int16{}
+	}
+	return FilterKVStrInt16(func(k string, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsStrInt16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrInt16(pred func(int16) bool, m map[string]int16) map[string]int16 {
+	if pred == nil {
+		return map[string]int16{}
+	}
+	return FilterKVStrInt16(func(_ string, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVStrInt16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrInt16(pred func(string, int16) bool, m map[string]int16) map[string]int16 {
+	newMap := make(map[string]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrInt16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, string, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrInt16(f func(int16, string, int16) int16, m map[string]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrInt8 returns list of keys of the map[string]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrInt8(m map[string]int8, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrInt8 returns list of values of the map[string]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrInt8(m map[string]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrInt8 returns a new map which contains only the given keys of the map.
+func SelectKeysStrInt8(m map[string]int8, keys ...string) map[string]int8 {
+	newMap := make(map[string]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrInt8 returns a new map without the given keys.
+func DissocKeysStrInt8(m map[string]int8, keys ...string) map[string]int8 {
+	newMap := make(map[string]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrInt8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrInt8(m map[string]int8) map[int8]string {
+	newMap := make(map[int8]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrInt8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrInt8(f func(int8) int8, m map[string]int8) map[string]int8 {
+	if f == nil {
+		return map[string]int8{}
+	}
+	newMap := make(map[string]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrInt8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrInt8(f func(string) string, m map[string]int8) map[string]int8 {
+	if f == nil {
+		return map[string]int8{}
+	}
+	newMap := make(map[string]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrInt8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrInt8(pred func(string) bool, m map[string]int8) map[string]int8 {
+	if pred == nil {
+		return map[string]int8{}
+	}
+	return FilterKVStrInt8(func(k string, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsStrInt8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrInt8(pred func(int8) bool, m map[string]int8) map[string]int8 {
+	if pred == nil {
+		return map[string]int8{}
+	}
+	return FilterKVStrInt8(func(_ string, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVStrInt8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrInt8(pred func(string, int8) bool, m map[string]int8) map[string]int8 {
+	newMap := make(map[string]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrInt8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, string, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrInt8(f func(int8, string, int8) int8, m map[string]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrUint returns list of keys of the map[string]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrUint(m map[string]uint, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrUint returns list of values of the map[string]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrUint(m map[string]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrUint returns a new map which contains only the given keys of the map.
+func SelectKeysStrUint(m map[string]uint, keys ...string) map[string]uint {
+	newMap := make(map[string]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrUint returns a new map without the given keys.
+func DissocKeysStrUint(m map[string]uint, keys ...string) map[string]uint {
+	newMap := make(map[string]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrUint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrUint(m map[string]uint) map[uint]string {
+	newMap := make(map[uint]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrUint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrUint(f func(uint) uint, m map[string]uint) map[string]uint {
+	if f == nil {
+		return map[string]uint{}
+	}
+	newMap := make(map[string]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrUint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrUint(f func(string) string, m map[string]uint) map[string]uint {
+	if f == nil {
+		return map[string]uint{}
+	}
+	newMap := make(map[string]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrUint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrUint(pred func(string) bool, m map[string]uint) map[string]uint {
+	if pred == nil {
+		return map[string]uint{}
+	}
+	return FilterKVStrUint(func(k string, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsStrUint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrUint(pred func(uint) bool, m map[string]uint) map[string]uint {
+	if pred == nil {
+		return map[string]uint{}
+	}
+	return FilterKVStrUint(func(_ string, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVStrUint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrUint(pred func(string, uint) bool, m map[string]uint) map[string]uint {
+	newMap := make(map[string]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrUint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, string, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrUint(f func(uint, string, uint) uint, m map[string]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrUint64 returns list of keys of the map[string]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrUint64(m map[string]uint64, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrUint64 returns list of values of the map[string]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrUint64(m map[string]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrUint64 returns a new map which contains only the given keys of the map.
+func SelectKeysStrUint64(m map[string]uint64, keys ...string) map[string]uint64 {
+	newMap := make(map[string]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrUint64 returns a new map without the given keys.
+func DissocKeysStrUint64(m map[string]uint64, keys ...string) map[string]uint64 {
+	newMap := make(map[string]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrUint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrUint64(m map[string]uint64) map[uint64]string {
+	newMap := make(map[uint64]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrUint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrUint64(f func(uint64) uint64, m map[string]uint64) map[string]uint64 {
+	if f == nil {
+		return map[string]uint64{}
+	}
+	newMap := make(map[string]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrUint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrUint64(f func(string) string, m map[string]uint64) map[string]uint64 {
+	if f == nil {
+		return map[string]uint64{}
+	}
+	newMap := make(map[string]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrUint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrUint64(pred func(string) bool, m map[string]uint64) map[string]uint64 {
+	if pred == nil {
+		return map[string]uint64{}
+	}
+	return FilterKVStrUint64(func(k string, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsStrUint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrUint64(pred func(uint64) bool, m map[string]uint64) map[string]uint64 {
+	if pred == nil {
+		return map[string]uint64{}
+	}
+	return FilterKVStrUint64(func(_ string, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVStrUint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrUint64(pred func(string, uint64) bool, m map[string]uint64) map[string]uint64 {
+	newMap := make(map[string]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrUint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, string, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrUint64(f func(uint64, string, uint64) uint64, m map[string]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrUint32 returns list of keys of the map[string]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrUint32(m map[string]uint32, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrUint32 returns list of values of the map[string]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrUint32(m map[string]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrUint32 returns a new map which contains only the given keys of the map.
+func SelectKeysStrUint32(m map[string]uint32, keys ...string) map[string]uint32 {
+	newMap := make(map[string]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrUint32 returns a new map without the given keys.
+func DissocKeysStrUint32(m map[string]uint32, keys ...string) map[string]uint32 {
+	newMap := make(map[string]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrUint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrUint32(m map[string]uint32) map[uint32]string {
+	newMap := make(map[uint32]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrUint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrUint32(f func(uint32) uint32, m map[string]uint32) map[string]uint32 {
+	if f == nil {
+		return map[string]uint32{}
+	}
+	newMap := make(map[string]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrUint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrUint32(f func(string) string, m map[string]uint32) map[string]uint32 {
+	if f == nil {
+		return map[string]uint32{}
+	}
+	newMap := make(map[string]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrUint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrUint32(pred func(string) bool, m map[string]uint32) map[string]uint32 {
+	if pred == nil {
+		return map[string]uint32{}
+	}
+	return FilterKVStrUint32(func(k string, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsStrUint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrUint32(pred func(uint32) bool, m map[string]uint32) map[string]uint32 {
+	if pred == nil {
+		return map[string]uint32{}
+	}
+	return FilterKVStrUint32(func(_ string, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVStrUint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrUint32(pred func(string, uint32) bool, m map[string]uint32) map[string]uint32 {
+	newMap := make(map[string]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrUint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, string, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrUint32(f func(uint32, string, uint32) uint32, m map[string]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrUint16 returns list of keys of the map[string]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrUint16(m map[string]uint16, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrUint16 returns list of values of the map[string]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrUint16(m map[string]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrUint16 returns a new map which contains only the given keys of the map.
+func SelectKeysStrUint16(m map[string]uint16, keys ...string) map[string]uint16 {
+	newMap := make(map[string]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrUint16 returns a new map without the given keys.
+func DissocKeysStrUint16(m map[string]uint16, keys ...string) map[string]uint16 {
+	newMap := make(map[string]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrUint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrUint16(m map[string]uint16) map[uint16]string {
+	newMap := make(map[uint16]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrUint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrUint16(f func(uint16) uint16, m map[string]uint16) map[string]uint16 {
+	if f == nil {
+		return map[string]uint16{}
+	}
+	newMap := make(map[string]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrUint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrUint16(f func(string) string, m map[string]uint16) map[string]uint16 {
+	if f == nil {
+		return map[string]uint16{}
+	}
+	newMap := make(map[string]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrUint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrUint16(pred func(string) bool, m map[string]uint16) map[string]uint16 {
+	if pred == nil {
+		return map[string]uint16{}
+	}
+	return FilterKVStrUint16(func(k string, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsStrUint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrUint16(pred func(uint16) bool, m map[string]uint16) map[string]uint16 {
+	if pred == nil {
+		return map[string]uint16{}
+	}
+	return FilterKVStrUint16(func(_ string, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVStrUint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrUint16(pred func(string, uint16) bool, m map[string]uint16) map[string]uint16 {
+	newMap := make(map[string]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrUint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, string, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrUint16(f func(uint16, string, uint16) uint16, m map[string]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrUint8 returns list of keys of the map[string]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrUint8(m map[string]uint8, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrUint8 returns list of values of the map[string]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrUint8(m map[string]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrUint8 returns a new map which contains only the given keys of the map.
+func SelectKeysStrUint8(m map[string]uint8, keys ...string) map[string]uint8 {
+	newMap := make(map[string]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrUint8 returns a new map without the given keys.
+func DissocKeysStrUint8(m map[string]uint8, keys ...string) map[string]uint8 {
+	newMap := make(map[string]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrUint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrUint8(m map[string]uint8) map[uint8]string {
+	newMap := make(map[uint8]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrUint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrUint8(f func(uint8) uint8, m map[string]uint8) map[string]uint8 {
+	if f == nil {
+		return map[string]uint8{}
+	}
+	newMap := make(map[string]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrUint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrUint8(f func(string) string, m map[string]uint8) map[string]uint8 {
+	if f == nil {
+		return map[string]uint8{}
+	}
+	newMap := make(map[string]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrUint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrUint8(pred func(string) bool, m map[string]uint8) map[string]uint8 {
+	if pred == nil {
+		return map[string]uint8{}
+	}
+	return FilterKVStrUint8(func(k string, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsStrUint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrUint8(pred func(uint8) bool, m map[string]uint8) map[string]uint8 {
+	if pred == nil {
+		return map[string]uint8{}
+	}
+	return FilterKVStrUint8(func(_ string, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVStrUint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrUint8(pred func(string, uint8) bool, m map[string]uint8) map[string]uint8 {
+	newMap := make(map[string]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrUint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, string, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrUint8(f func(uint8, string, uint8) uint8, m map[string]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStr returns list of keys of the map[string]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStr(m map[string]string, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStr returns list of values of the map[string]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStr(m map[string]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStr returns a new map which contains only the given keys of the map.
+func SelectKeysStr(m map[string]string, keys ...string) map[string]string {
+	newMap := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStr returns a new map without the given keys.
+func DissocKeysStr(m map[string]string, keys ...string) map[string]string {
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStr(m map[string]string) map[string]string {
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStr(f func(string) string, m map[string]string) map[string]string {
+	if f == nil {
+		return map[string]string{}
+	}
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStr(f func(string) string, m map[string]string) map[string]string {
+	if f == nil {
+		return map[string]string{}
+	}
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStr(pred func(string) bool, m map[string]string) map[string]string {
+	if pred == nil {
+		return map[string]string{}
+	}
+	return FilterKVStr(func(k string, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStr(pred func(string) bool, m map[string]string) map[string]string {
+	if pred == nil {
+		return map[string]string{}
+	}
+	return FilterKVStr(func(_ string, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStr(pred func(string, string) bool, m map[string]string) map[string]string {
+	newMap := make(map[string]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, string, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStr(f func(string, string, string) string, m map[string]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysStrBool returns list of keys of the map[string]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrBool(m map[string]bool, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsStrBool returns list of values of the map[string]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrBool(m map[string]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysStrBool returns a new map which contains only the given keys of the map.
+func SelectKeysStrBool(m map[string]bool, keys ...string) map[string]bool {
+	newMap := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysStrBool returns a new map without the given keys.
+func DissocKeysStrBool(m map[string]bool, keys ...string) map[string]bool {
+	newMap := make(map[string]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertStrBool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrBool(m map[string]bool) map[bool]string {
+	newMap := make(map[bool]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsStrBool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrBool(f func(bool) bool, m map[string]bool) map[string]bool {
+	if f == nil {
+		return map[string]bool{}
+	}
+	newMap := make(map[string]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysStrBool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrBool(f func(string) string, m map[string]bool) map[string]bool {
+	if f == nil {
+		return map[string]bool{}
+	}
+	newMap := make(map[string]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysStrBool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrBool(pred func(string) bool, m map[string]bool) map[string]bool {
+	if pred == nil {
+		return map[string]bool{}
+	}
+	return FilterKVStrBool(func(k string, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsStrBool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrBool(pred func(bool) bool, m map[string]bool) map[string]bool {
+	if pred == nil {
+		return map[string]bool{}
+	}
+	return FilterKVStrBool(func(_ string, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVStrBool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrBool(pred func(string, bool) bool, m map[string]bool) map[string]bool {
+	newMap := make(map[string]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVStrBool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, string, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrBool(f func(bool, string, bool) bool, m map[string]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolInt returns list of keys of the map[bool]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolInt(m map[bool]int, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolInt returns list of values of the map[bool]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolInt(m map[bool]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolInt returns a new map which contains only the given keys of the map.
+func SelectKeysBoolInt(m map[bool]int, keys ...bool) map[bool]int {
+	newMap := make(map[bool]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolInt returns a new map without the given keys.
+func DissocKeysBoolInt(m map[bool]int, keys ...bool) map[bool]int {
+	newMap := make(map[bool]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolInt(m map[bool]int) map[int]bool {
+	newMap := make(map[int]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolInt(f func(int) int, m map[bool]int) map[bool]int {
+	if f == nil {
+		return map[bool]int{}
+	}
+	newMap := make(map[bool]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolInt(f func(bool) bool, m map[bool]int) map[bool]int {
+	if f == nil {
+		return map[bool]int{}
+	}
+	newMap := make(map[bool]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolInt(pred func(bool) bool, m map[bool]int) map[bool]int {
+	if pred == nil {
+		return map[bool]int{}
+	}
+	return FilterKVBoolInt(func(k bool, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolInt(pred func(int) bool, m map[bool]int) map[bool]int {
+	if pred == nil {
+		return map[bool]int{}
+	}
+	return FilterKVBoolInt(func(_ bool, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolInt(pred func(bool, int) bool, m map[bool]int) map[bool]int {
+	newMap := make(map[bool]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, bool, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolInt(f func(int, bool, int) int, m map[bool]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolInt64 returns list of keys of the map[bool]int64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolInt64(m map[bool]int64, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolInt64 returns list of values of the map[bool]int64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolInt64(m map[bool]int64, less ...func(int64, int64) bool) []int64 {
+	vals := make([]int64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolInt64 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolInt64(m map[bool]int64, keys ...bool) map[bool]int64 {
+	newMap := make(map[bool]int64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolInt64 returns a new map without the given keys.
+func DissocKeysBoolInt64(m map[bool]int64, keys ...bool) map[bool]int64 {
+	newMap := make(map[bool]int64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolInt64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolInt64(m map[bool]int64) map[int64]bool {
+	newMap := make(map[int64]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolInt64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolInt64(f func(int64) int64, m map[bool]int64) map[bool]int64 {
+	if f == nil {
+		return map[bool]int64{}
+	}
+	newMap := make(map[bool]int64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolInt64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolInt64(f func(bool) bool, m map[bool]int64) map[bool]int64 {
+	if f == nil {
+		return map[bool]int64{}
+	}
+	newMap := make(map[bool]int64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolInt64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolInt64(pred func(bool) bool, m map[bool]int64) map[bool]int64 {
+	if pred == nil {
+		return map[bool]int64{}
+	}
+	return FilterKVBoolInt64(func(k bool, _ int64) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolInt64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolInt64(pred func(int64) bool, m map[bool]int64) map[bool]int64 {
+	if pred == nil {
+		return map[bool]int64{}
+	}
+	return FilterKVBoolInt64(func(_ bool, v int64) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolInt64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolInt64(pred func(bool, int64) bool, m map[bool]int64) map[bool]int64 {
+	newMap := make(map[bool]int64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolInt64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int64, bool, int64 and returns int64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolInt64(f func(int64, bool, int64) int64, m map[bool]int64, initializer int64) int64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolInt32 returns list of keys of the map[bool]int32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolInt32(m map[bool]int32, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolInt32 returns list of values of the map[bool]int32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolInt32(m map[bool]int32, less ...func(int32, int32) bool) []int32 {
+	vals := make([]int32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolInt32 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolInt32(m map[bool]int32, keys ...bool) map[bool]int32 {
+	newMap := make(map[bool]int32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolInt32 returns a new map without the given keys.
+func DissocKeysBoolInt32(m map[bool]int32, keys ...bool) map[bool]int32 {
+	newMap := make(map[bool]int32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolInt32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolInt32(m map[bool]int32) map[int32]bool {
+	newMap := make(map[int32]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolInt32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolInt32(f func(int32) int32, m map[bool]int32) map[bool]int32 {
+	if f == nil {
+		return map[bool]int32{}
+	}
+	newMap := make(map[bool]int32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolInt32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolInt32(f func(bool) bool, m map[bool]int32) map[bool]int32 {
+	if f == nil {
+		return map[bool]int32{}
+	}
+	newMap := make(map[bool]int32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolInt32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolInt32(pred func(bool) bool, m map[bool]int32) map[bool]int32 {
+	if pred == nil {
+		return map[bool]int32{}
+	}
+	return FilterKVBoolInt32(func(k bool, _ int32) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolInt32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolInt32(pred func(int32) bool, m map[bool]int32) map[bool]int32 {
+	if pred == nil {
+		return map[bool]int32{}
+	}
+	return FilterKVBoolInt32(func(_ bool, v int32) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolInt32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolInt32(pred func(bool, int32) bool, m map[bool]int32) map[bool]int32 {
+	newMap := make(map[bool]int32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolInt32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int32, bool, int32 and returns int32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolInt32(f func(int32, bool, int32) int32, m map[bool]int32, initializer int32) int32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolInt16 returns list of keys of the map[bool]int16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolInt16(m map[bool]int16, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolInt16 returns list of values of the map[bool]int16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolInt16(m map[bool]int16, less ...func(int16, int16) bool) []int16 {
+	vals := make([]int16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolInt16 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolInt16(m map[bool]int16, keys ...bool) map[bool]int16 {
+	newMap := make(map[bool]int16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolInt16 returns a new map without the given keys.
+func DissocKeysBoolInt16(m map[bool]int16, keys ...bool) map[bool]int16 {
+	newMap := make(map[bool]int16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolInt16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolInt16(m map[bool]int16) map[int16]bool {
+	newMap := make(map[int16]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolInt16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolInt16(f func(int16) int16, m map[bool]int16) map[bool]int16 {
+	if f == nil {
+		return map[bool]int16{}
+	}
+	newMap := make(map[bool]int16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolInt16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolInt16(f func(bool) bool, m map[bool]int16) map[bool]int16 {
+	if f == nil {
+		return map[bool]int16{}
+	}
+	newMap := make(map[bool]int16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolInt16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolInt16(pred func(bool) bool, m map[bool]int16) map[bool]int16 {
+	if pred == nil {
+		return map[bool]int16{}
+	}
+	return FilterKVBoolInt16(func(k bool, _ int16) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolInt16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolInt16(pred func(int16) bool, m map[bool]int16) map[bool]int16 {
+	if pred == nil {
+		return map[bool]int16{}
+	}
+	return FilterKVBoolInt16(func(_ bool, v int16) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolInt16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolInt16(pred func(bool, int16) bool, m map[bool]int16) map[bool]int16 {
+	newMap := make(map[bool]int16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolInt16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int16, bool, int16 and returns int16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolInt16(f func(int16, bool, int16) int16, m map[bool]int16, initializer int16) int16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolInt8 returns list of keys of the map[bool]int8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolInt8(m map[bool]int8, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolInt8 returns list of values of the map[bool]int8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolInt8(m map[bool]int8, less ...func(int8, int8) bool) []int8 {
+	vals := make([]int8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolInt8 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolInt8(m map[bool]int8, keys ...bool) map[bool]int8 {
+	newMap := make(map[bool]int8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolInt8 returns a new map without the given keys.
+func DissocKeysBoolInt8(m map[bool]int8, keys ...bool) map[bool]int8 {
+	newMap := make(map[bool]int8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolInt8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolInt8(m map[bool]int8) map[int8]bool {
+	newMap := make(map[int8]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolInt8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolInt8(f func(int8) int8, m map[bool]int8) map[bool]int8 {
+	if f == nil {
+		return map[bool]int8{}
+	}
+	newMap := make(map[bool]int8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolInt8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolInt8(f func(bool) bool, m map[bool]int8) map[bool]int8 {
+	if f == nil {
+		return map[bool]int8{}
+	}
+	newMap := make(map[bool]int8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolInt8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolInt8(pred func(bool) bool, m map[bool]int8) map[bool]int8 {
+	if pred == nil {
+		return map[bool]int8{}
+	}
+	return FilterKVBoolInt8(func(k bool, _ int8) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolInt8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolInt8(pred func(int8) bool, m map[bool]int8) map[bool]int8 {
+	if pred == nil {
+		return map[bool]int8{}
+	}
+	return FilterKVBoolInt8(func(_ bool, v int8) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolInt8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolInt8(pred func(bool, int8) bool, m map[bool]int8) map[bool]int8 {
+	newMap := make(map[bool]int8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolInt8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int8, bool, int8 and returns int8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolInt8(f func(int8, bool, int8) int8, m map[bool]int8, initializer int8) int8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolUint returns list of keys of the map[bool]uint.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolUint(m map[bool]uint, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolUint returns list of values of the map[bool]uint.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolUint(m map[bool]uint, less ...func(uint, uint) bool) []uint {
+	vals := make([]uint, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolUint returns a new map which contains only the given keys of the map.
+func SelectKeysBoolUint(m map[bool]uint, keys ...bool) map[bool]uint {
+	newMap := make(map[bool]uint, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolUint returns a new map without the given keys.
+func DissocKeysBoolUint(m map[bool]uint, keys ...bool) map[bool]uint {
+	newMap := make(map[bool]uint, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolUint returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolUint(m map[bool]uint) map[uint]bool {
+	newMap := make(map[uint]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolUint applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolUint(f func(uint) uint, m map[bool]uint) map[bool]uint {
+	if f == nil {
+		return map[bool]uint{}
+	}
+	newMap := make(map[bool]uint, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolUint applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolUint(f func(bool) bool, m map[bool]uint) map[bool]uint {
+	if f == nil {
+		return map[bool]uint{}
+	}
+	newMap := make(map[bool]uint, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolUint returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolUint(pred func(bool) bool, m map[bool]uint) map[bool]uint {
+	if pred == nil {
+		return map[bool]uint{}
+	}
+	return FilterKVBoolUint(func(k bool, _ uint) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolUint returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolUint(pred func(uint) bool, m map[bool]uint) map[bool]uint {
+	if pred == nil {
+		return map[bool]uint{}
+	}
+	return FilterKVBoolUint(func(_ bool, v uint) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolUint returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolUint(pred func(bool, uint) bool, m map[bool]uint) map[bool]uint {
+	newMap := make(map[bool]uint)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolUint reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint, bool, uint and returns uint
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolUint(f func(uint, bool, uint) uint, m map[bool]uint, initializer uint) uint {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolUint64 returns list of keys of the map[bool]uint64.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolUint64(m map[bool]uint64, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolUint64 returns list of values of the map[bool]uint64.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolUint64(m map[bool]uint64, less ...func(uint64, uint64) bool) []uint64 {
+	vals := make([]uint64, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolUint64 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolUint64(m map[bool]uint64, keys ...bool) map[bool]uint64 {
+	newMap := make(map[bool]uint64, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolUint64 returns a new map without the given keys.
+func DissocKeysBoolUint64(m map[bool]uint64, keys ...bool) map[bool]uint64 {
+	newMap := make(map[bool]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolUint64 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolUint64(m map[bool]uint64) map[uint64]bool {
+	newMap := make(map[uint64]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolUint64 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolUint64(f func(uint64) uint64, m map[bool]uint64) map[bool]uint64 {
+	if f == nil {
+		return map[bool]uint64{}
+	}
+	newMap := make(map[bool]uint64, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolUint64 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolUint64(f func(bool) bool, m map[bool]uint64) map[bool]uint64 {
+	if f == nil {
+		return map[bool]uint64{}
+	}
+	newMap := make(map[bool]uint64, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolUint64 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolUint64(pred func(bool) bool, m map[bool]uint64) map[bool]uint64 {
+	if pred == nil {
+		return map[bool]uint64{}
+	}
+	return FilterKVBoolUint64(func(k bool, _ uint64) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolUint64 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolUint64(pred func(uint64) bool, m map[bool]uint64) map[bool]uint64 {
+	if pred == nil {
+		return map[bool]uint64{}
+	}
+	return FilterKVBoolUint64(func(_ bool, v uint64) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolUint64 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolUint64(pred func(bool, uint64) bool, m map[bool]uint64) map[bool]uint64 {
+	newMap := make(map[bool]uint64)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolUint64 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint64, bool, uint64 and returns uint64
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolUint64(f func(uint64, bool, uint64) uint64, m map[bool]uint64, initializer uint64) uint64 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolUint32 returns list of keys of the map[bool]uint32.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolUint32(m map[bool]uint32, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolUint32 returns list of values of the map[bool]uint32.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolUint32(m map[bool]uint32, less ...func(uint32, uint32) bool) []uint32 {
+	vals := make([]uint32, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolUint32 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolUint32(m map[bool]uint32, keys ...bool) map[bool]uint32 {
+	newMap := make(map[bool]uint32, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolUint32 returns a new map without the given keys.
+func DissocKeysBoolUint32(m map[bool]uint32, keys ...bool) map[bool]uint32 {
+	newMap := make(map[bool]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolUint32 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolUint32(m map[bool]uint32) map[uint32]bool {
+	newMap := make(map[uint32]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolUint32 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolUint32(f func(uint32) uint32, m map[bool]uint32) map[bool]uint32 {
+	if f == nil {
+		return map[bool]uint32{}
+	}
+	newMap := make(map[bool]uint32, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolUint32 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolUint32(f func(bool) bool, m map[bool]uint32) map[bool]uint32 {
+	if f == nil {
+		return map[bool]uint32{}
+	}
+	newMap := make(map[bool]uint32, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolUint32 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolUint32(pred func(bool) bool, m map[bool]uint32) map[bool]uint32 {
+	if pred == nil {
+		return map[bool]uint32{}
+	}
+	return FilterKVBoolUint32(func(k bool, _ uint32) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolUint32 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolUint32(pred func(uint32) bool, m map[bool]uint32) map[bool]uint32 {
+	if pred == nil {
+		return map[bool]uint32{}
+	}
+	return FilterKVBoolUint32(func(_ bool, v uint32) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolUint32 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolUint32(pred func(bool, uint32) bool, m map[bool]uint32) map[bool]uint32 {
+	newMap := make(map[bool]uint32)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolUint32 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint32, bool, uint32 and returns uint32
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolUint32(f func(uint32, bool, uint32) uint32, m map[bool]uint32, initializer uint32) uint32 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolUint16 returns list of keys of the map[bool]uint16.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolUint16(m map[bool]uint16, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolUint16 returns list of values of the map[bool]uint16.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolUint16(m map[bool]uint16, less ...func(uint16, uint16) bool) []uint16 {
+	vals := make([]uint16, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolUint16 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolUint16(m map[bool]uint16, keys ...bool) map[bool]uint16 {
+	newMap := make(map[bool]uint16, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolUint16 returns a new map without the given keys.
+func DissocKeysBoolUint16(m map[bool]uint16, keys ...bool) map[bool]uint16 {
+	newMap := make(map[bool]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolUint16 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolUint16(m map[bool]uint16) map[uint16]bool {
+	newMap := make(map[uint16]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolUint16 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolUint16(f func(uint16) uint16, m map[bool]uint16) map[bool]uint16 {
+	if f == nil {
+		return map[bool]uint16{}
+	}
+	newMap := make(map[bool]uint16, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolUint16 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolUint16(f func(bool) bool, m map[bool]uint16) map[bool]uint16 {
+	if f == nil {
+		return map[bool]uint16{}
+	}
+	newMap := make(map[bool]uint16, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolUint16 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolUint16(pred func(bool) bool, m map[bool]uint16) map[bool]uint16 {
+	if pred == nil {
+		return map[bool]uint16{}
+	}
+	return FilterKVBoolUint16(func(k bool, _ uint16) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolUint16 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolUint16(pred func(uint16) bool, m map[bool]uint16) map[bool]uint16 {
+	if pred == nil {
+		return map[bool]uint16{}
+	}
+	return FilterKVBoolUint16(func(_ bool, v uint16) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolUint16 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolUint16(pred func(bool, uint16) bool, m map[bool]uint16) map[bool]uint16 {
+	newMap := make(map[bool]uint16)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolUint16 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint16, bool, uint16 and returns uint16
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolUint16(f func(uint16, bool, uint16) uint16, m map[bool]uint16, initializer uint16) uint16 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolUint8 returns list of keys of the map[bool]uint8.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolUint8(m map[bool]uint8, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolUint8 returns list of values of the map[bool]uint8.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolUint8(m map[bool]uint8, less ...func(uint8, uint8) bool) []uint8 {
+	vals := make([]uint8, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolUint8 returns a new map which contains only the given keys of the map.
+func SelectKeysBoolUint8(m map[bool]uint8, keys ...bool) map[bool]uint8 {
+	newMap := make(map[bool]uint8, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolUint8 returns a new map without the given keys.
+func DissocKeysBoolUint8(m map[bool]uint8, keys ...bool) map[bool]uint8 {
+	newMap := make(map[bool]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolUint8 returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolUint8(m map[bool]uint8) map[uint8]bool {
+	newMap := make(map[uint8]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolUint8 applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolUint8(f func(uint8) uint8, m map[bool]uint8) map[bool]uint8 {
+	if f == nil {
+		return map[bool]uint8{}
+	}
+	newMap := make(map[bool]uint8, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolUint8 applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolUint8(f func(bool) bool, m map[bool]uint8) map[bool]uint8 {
+	if f == nil {
+		return map[bool]uint8{}
+	}
+	newMap := make(map[bool]uint8, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolUint8 returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolUint8(pred func(bool) bool, m map[bool]uint8) map[bool]uint8 {
+	if pred == nil {
+		return map[bool]uint8{}
+	}
+	return FilterKVBoolUint8(func(k bool, _ uint8) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolUint8 returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolUint8(pred func(uint8) bool, m map[bool]uint8) map[bool]uint8 {
+	if pred == nil {
+		return map[bool]uint8{}
+	}
+	return FilterKVBoolUint8(func(_ bool, v uint8) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolUint8 returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolUint8(pred func(bool, uint8) bool, m map[bool]uint8) map[bool]uint8 {
+	newMap := make(map[bool]uint8)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolUint8 reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: uint8, bool, uint8 and returns uint8
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolUint8(f func(uint8, bool, uint8) uint8, m map[bool]uint8, initializer uint8) uint8 {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBoolStr returns list of keys of the map[bool]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBoolStr(m map[bool]string, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBoolStr returns list of values of the map[bool]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBoolStr(m map[bool]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBoolStr returns a new map which contains only the given keys of the map.
+func SelectKeysBoolStr(m map[bool]string, keys ...bool) map[bool]string {
+	newMap := make(map[bool]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBoolStr returns a new map without the given keys.
+func DissocKeysBoolStr(m map[bool]string, keys ...bool) map[bool]string {
+	newMap := make(map[bool]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBoolStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBoolStr(m map[bool]string) map[string]bool {
+	newMap := make(map[string]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBoolStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBoolStr(f func(string) string, m map[bool]string) map[bool]string {
+	if f == nil {
+		return map[bool]string{}
+	}
+	newMap := make(map[bool]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBoolStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBoolStr(f func(bool) bool, m map[bool]string) map[bool]string {
+	if f == nil {
+		return map[bool]string{}
+	}
+	newMap := make(map[bool]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBoolStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBoolStr(pred func(bool) bool, m map[bool]string) map[bool]string {
+	if pred == nil {
+		return map[bool]string{}
+	}
+	return FilterKVBoolStr(func(k bool, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsBoolStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBoolStr(pred func(string) bool, m map[bool]string) map[bool]string {
+	if pred == nil {
+		return map[bool]string{}
+	}
+	return FilterKVBoolStr(func(_ bool, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVBoolStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBoolStr(pred func(bool, string) bool, m map[bool]string) map[bool]string {
+	newMap := make(map[bool]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBoolStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, bool, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBoolStr(f func(string, bool, string) string, m map[bool]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// KeysBool returns list of keys of the map[bool]bool.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysBool(m map[bool]bool, less ...func(bool, bool) bool) []bool {
+	keys := make([]bool, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsBool returns list of values of the map[bool]bool.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsBool(m map[bool]bool, less ...func(bool, bool) bool) []bool {
+	vals := make([]bool, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysBool returns a new map which contains only the given keys of the map.
+func SelectKeysBool(m map[bool]bool, keys ...bool) map[bool]bool {
+	newMap := make(map[bool]bool, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysBool returns a new map without the given keys.
+func DissocKeysBool(m map[bool]bool, keys ...bool) map[bool]bool {
+	newMap := make(map[bool]bool, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertBool returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertBool(m map[bool]bool) map[bool]bool {
+	newMap := make(map[bool]bool, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsBool applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsBool(f func(bool) bool, m map[bool]bool) map[bool]bool {
+	if f == nil {
+		return map[bool]bool{}
+	}
+	newMap := make(map[bool]bool, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysBool applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysBool(f func(bool) bool, m map[bool]bool) map[bool]bool {
+	if f == nil {
+		return map[bool]bool{}
+	}
+	newMap := make(map[bool]bool, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysBool returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysBool(pred func(bool) bool, m map[bool]bool) map[bool]bool {
+	if pred == nil {
+		return map[bool]bool{}
+	}
+	return FilterKVBool(func(k bool, _ bool) bool { return pred(k) }, m)
+}
+
+// FilterValsBool returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsBool(pred func(bool) bool, m map[bool]bool) map[bool]bool {
+	if pred == nil {
+		return map[bool]bool{}
+	}
+	return FilterKVBool(func(_ bool, v bool) bool { return pred(v) }, m)
+}
+
+// FilterKVBool returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVBool(pred func(bool, bool) bool, m map[bool]bool) map[bool]bool {
+	newMap := make(map[bool]bool)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVBool reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: bool, bool, bool and returns bool
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVBool(f func(bool, bool, bool) bool, m map[bool]bool, initializer bool) bool {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
@@ -0,0 +1,10578 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapKVInt(t *testing.T) {
+	m := map[int]int{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysInt(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt(m)) != 2 {
+		t.Errorf("TestKeysInt failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsInt(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt(m)) != 2 {
+		t.Errorf("TestValsInt failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]int{1: 10}
+	actual := SelectKeysInt(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt(func(k int, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int{2: 20}
+	actual = DissocKeysInt(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]int{10: 1, 20: 2}
+	actualInverted := InvertInt(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]int{1: 11, 2: 21}
+	actual = MapValsInt(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int{10: 10, 20: 20}
+	actual = MapKeysInt(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVInt(func(acc int, k int, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt(nil, m)) != 0 || len(MapKeysInt(nil, m)) != 0 || len(FilterKVInt(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntInt64(t *testing.T) {
+	m := map[int]int64{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntInt64(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntInt64(m)) != 2 {
+		t.Errorf("TestKeysIntInt64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsIntInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntInt64(m)) != 2 {
+		t.Errorf("TestValsIntInt64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]int64{1: 10}
+	actual := SelectKeysIntInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntInt64(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntInt64(func(k int, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int64{2: 20}
+	actual = DissocKeysIntInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntInt64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]int{10: 1, 20: 2}
+	actualInverted := InvertIntInt64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntInt64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]int64{1: 11, 2: 21}
+	actual = MapValsIntInt64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int64{10: 10, 20: 20}
+	actual = MapKeysIntInt64(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVIntInt64(func(acc int64, k int, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntInt64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntInt64(nil, m)) != 0 || len(MapKeysIntInt64(nil, m)) != 0 || len(FilterKVIntInt64(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntInt64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntInt32(t *testing.T) {
+	m := map[int]int32{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntInt32(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntInt32(m)) != 2 {
+		t.Errorf("TestKeysIntInt32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsIntInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntInt32(m)) != 2 {
+		t.Errorf("TestValsIntInt32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]int32{1: 10}
+	actual := SelectKeysIntInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntInt32(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntInt32(func(k int, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int32{2: 20}
+	actual = DissocKeysIntInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntInt32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]int{10: 1, 20: 2}
+	actualInverted := InvertIntInt32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntInt32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]int32{1: 11, 2: 21}
+	actual = MapValsIntInt32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int32{10: 10, 20: 20}
+	actual = MapKeysIntInt32(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVIntInt32(func(acc int32, k int, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntInt32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntInt32(nil, m)) != 0 || len(MapKeysIntInt32(nil, m)) != 0 || len(FilterKVIntInt32(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntInt32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntInt16(t *testing.T) {
+	m := map[int]int16{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntInt16(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntInt16(m)) != 2 {
+		t.Errorf("TestKeysIntInt16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsIntInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntInt16(m)) != 2 {
+		t.Errorf("TestValsIntInt16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]int16{1: 10}
+	actual := SelectKeysIntInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntInt16(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntInt16(func(k int, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int16{2: 20}
+	actual = DissocKeysIntInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntInt16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]int{10: 1, 20: 2}
+	actualInverted := InvertIntInt16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntInt16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]int16{1: 11, 2: 21}
+	actual = MapValsIntInt16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int16{10: 10, 20: 20}
+	actual = MapKeysIntInt16(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVIntInt16(func(acc int16, k int, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntInt16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntInt16(nil, m)) != 0 || len(MapKeysIntInt16(nil, m)) != 0 || len(FilterKVIntInt16(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntInt16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntInt8(t *testing.T) {
+	m := map[int]int8{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntInt8(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntInt8(m)) != 2 {
+		t.Errorf("TestKeysIntInt8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsIntInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntInt8(m)) != 2 {
+		t.Errorf("TestValsIntInt8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]int8{1: 10}
+	actual := SelectKeysIntInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntInt8(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntInt8(func(k int, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int8{2: 20}
+	actual = DissocKeysIntInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntInt8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]int{10: 1, 20: 2}
+	actualInverted := InvertIntInt8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntInt8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]int8{1: 11, 2: 21}
+	actual = MapValsIntInt8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int8{10: 10, 20: 20}
+	actual = MapKeysIntInt8(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVIntInt8(func(acc int8, k int, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntInt8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntInt8(nil, m)) != 0 || len(MapKeysIntInt8(nil, m)) != 0 || len(FilterKVIntInt8(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntInt8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntUint(t *testing.T) {
+	m := map[int]uint{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntUint(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntUint(m)) != 2 {
+		t.Errorf("TestKeysIntUint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsIntUint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntUint(m)) != 2 {
+		t.Errorf("TestValsIntUint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]uint{1: 10}
+	actual := SelectKeysIntUint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntUint(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntUint(func(k int, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint{2: 20}
+	actual = DissocKeysIntUint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntUint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]int{10: 1, 20: 2}
+	actualInverted := InvertIntUint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntUint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]uint{1: 11, 2: 21}
+	actual = MapValsIntUint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint{10: 10, 20: 20}
+	actual = MapKeysIntUint(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVIntUint(func(acc uint, k int, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntUint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntUint(nil, m)) != 0 || len(MapKeysIntUint(nil, m)) != 0 || len(FilterKVIntUint(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntUint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntUint64(t *testing.T) {
+	m := map[int]uint64{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntUint64(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntUint64(m)) != 2 {
+		t.Errorf("TestKeysIntUint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsIntUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntUint64(m)) != 2 {
+		t.Errorf("TestValsIntUint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]uint64{1: 10}
+	actual := SelectKeysIntUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntUint64(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntUint64(func(k int, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint64{2: 20}
+	actual = DissocKeysIntUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntUint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]int{10: 1, 20: 2}
+	actualInverted := InvertIntUint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntUint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]uint64{1: 11, 2: 21}
+	actual = MapValsIntUint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint64{10: 10, 20: 20}
+	actual = MapKeysIntUint64(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVIntUint64(func(acc uint64, k int, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntUint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntUint64(nil, m)) != 0 || len(MapKeysIntUint64(nil, m)) != 0 || len(FilterKVIntUint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntUint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntUint32(t *testing.T) {
+	m := map[int]uint32{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntUint32(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntUint32(m)) != 2 {
+		t.Errorf("TestKeysIntUint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsIntUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntUint32(m)) != 2 {
+		t.Errorf("TestValsIntUint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]uint32{1: 10}
+	actual := SelectKeysIntUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntUint32(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntUint32(func(k int, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint32{2: 20}
+	actual = DissocKeysIntUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntUint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]int{10: 1, 20: 2}
+	actualInverted := InvertIntUint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntUint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]uint32{1: 11, 2: 21}
+	actual = MapValsIntUint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint32{10: 10, 20: 20}
+	actual = MapKeysIntUint32(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVIntUint32(func(acc uint32, k int, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntUint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntUint32(nil, m)) != 0 || len(MapKeysIntUint32(nil, m)) != 0 || len(FilterKVIntUint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntUint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntUint16(t *testing.T) {
+	m := map[int]uint16{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntUint16(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntUint16(m)) != 2 {
+		t.Errorf("TestKeysIntUint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsIntUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntUint16(m)) != 2 {
+		t.Errorf("TestValsIntUint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]uint16{1: 10}
+	actual := SelectKeysIntUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntUint16(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntUint16(func(k int, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint16{2: 20}
+	actual = DissocKeysIntUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntUint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]int{10: 1, 20: 2}
+	actualInverted := InvertIntUint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntUint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]uint16{1: 11, 2: 21}
+	actual = MapValsIntUint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint16{10: 10, 20: 20}
+	actual = MapKeysIntUint16(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVIntUint16(func(acc uint16, k int, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntUint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntUint16(nil, m)) != 0 || len(MapKeysIntUint16(nil, m)) != 0 || len(FilterKVIntUint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntUint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntUint8(t *testing.T) {
+	m := map[int]uint8{1: 10, 2: 20}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntUint8(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntUint8(m)) != 2 {
+		t.Errorf("TestKeysIntUint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsIntUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntUint8(m)) != 2 {
+		t.Errorf("TestValsIntUint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]uint8{1: 10}
+	actual := SelectKeysIntUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntUint8(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntUint8(func(k int, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint8{2: 20}
+	actual = DissocKeysIntUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntUint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]int{10: 1, 20: 2}
+	actualInverted := InvertIntUint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntUint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]uint8{1: 11, 2: 21}
+	actual = MapValsIntUint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint8{10: 10, 20: 20}
+	actual = MapKeysIntUint8(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVIntUint8(func(acc uint8, k int, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntUint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntUint8(nil, m)) != 0 || len(MapKeysIntUint8(nil, m)) != 0 || len(FilterKVIntUint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntUint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntStr(t *testing.T) {
+	m := map[int]string{1: "x", 2: "y"}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntStr(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntStr(m)) != 2 {
+		t.Errorf("TestKeysIntStr failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsIntStr(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntStr(m)) != 2 {
+		t.Errorf("TestValsIntStr failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]string{1: "x"}
+	actual := SelectKeysIntStr(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntStr(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntStr(func(k int, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]string{2: "y"}
+	actual = DissocKeysIntStr(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntStr(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]int{"x": 1, "y": 2}
+	actualInverted := InvertIntStr(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntStr failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]string{1: "x!", 2: "y!"}
+	actual = MapValsIntStr(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]string{10: "x", 20: "y"}
+	actual = MapKeysIntStr(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVIntStr(func(acc string, k int, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntStr failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntStr(nil, m)) != 0 || len(MapKeysIntStr(nil, m)) != 0 || len(FilterKVIntStr(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntStr failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVIntBool(t *testing.T) {
+	m := map[int]bool{1: false, 2: true}
+
+	expectedKeys := []int{1, 2}
+	actualKeys := KeysIntBool(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysIntBool(m)) != 2 {
+		t.Errorf("TestKeysIntBool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsIntBool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsIntBool(m)) != 2 {
+		t.Errorf("TestValsIntBool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int]bool{1: false}
+	actual := SelectKeysIntBool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysIntBool(func(k int) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVIntBool(func(k int, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]bool{2: true}
+	actual = DissocKeysIntBool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsIntBool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]int{false: 1, true: 2}
+	actualInverted := InvertIntBool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertIntBool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int]bool{1: true, 2: false}
+	actual = MapValsIntBool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]bool{10: false, 20: true}
+	actual = MapKeysIntBool(func(k int) int { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVIntBool(func(acc bool, k int, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVIntBool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsIntBool(nil, m)) != 0 || len(MapKeysIntBool(nil, m)) != 0 || len(FilterKVIntBool(nil, m)) != 0 {
+		t.Errorf("TestMapKVIntBool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Int(t *testing.T) {
+	m := map[int64]int{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Int(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Int(m)) != 2 {
+		t.Errorf("TestKeysInt64Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsInt64Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Int(m)) != 2 {
+		t.Errorf("TestValsInt64Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]int{1: 10}
+	actual := SelectKeysInt64Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Int(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Int(func(k int64, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int{2: 20}
+	actual = DissocKeysInt64Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]int{1: 11, 2: 21}
+	actual = MapValsInt64Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int{10: 10, 20: 20}
+	actual = MapKeysInt64Int(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVInt64Int(func(acc int, k int64, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Int(nil, m)) != 0 || len(MapKeysInt64Int(nil, m)) != 0 || len(FilterKVInt64Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64(t *testing.T) {
+	m := map[int64]int64{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64(m)) != 2 {
+		t.Errorf("TestKeysInt64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64(m)) != 2 {
+		t.Errorf("TestValsInt64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]int64{1: 10}
+	actual := SelectKeysInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64(func(k int64, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int64{2: 20}
+	actual = DissocKeysInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]int64{1: 11, 2: 21}
+	actual = MapValsInt64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int64{10: 10, 20: 20}
+	actual = MapKeysInt64(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVInt64(func(acc int64, k int64, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64(nil, m)) != 0 || len(MapKeysInt64(nil, m)) != 0 || len(FilterKVInt64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Int32(t *testing.T) {
+	m := map[int64]int32{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Int32(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Int32(m)) != 2 {
+		t.Errorf("TestKeysInt64Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsInt64Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Int32(m)) != 2 {
+		t.Errorf("TestValsInt64Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]int32{1: 10}
+	actual := SelectKeysInt64Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Int32(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Int32(func(k int64, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int32{2: 20}
+	actual = DissocKeysInt64Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]int32{1: 11, 2: 21}
+	actual = MapValsInt64Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int32{10: 10, 20: 20}
+	actual = MapKeysInt64Int32(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVInt64Int32(func(acc int32, k int64, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Int32(nil, m)) != 0 || len(MapKeysInt64Int32(nil, m)) != 0 || len(FilterKVInt64Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Int16(t *testing.T) {
+	m := map[int64]int16{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Int16(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Int16(m)) != 2 {
+		t.Errorf("TestKeysInt64Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsInt64Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Int16(m)) != 2 {
+		t.Errorf("TestValsInt64Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]int16{1: 10}
+	actual := SelectKeysInt64Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Int16(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Int16(func(k int64, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int16{2: 20}
+	actual = DissocKeysInt64Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]int16{1: 11, 2: 21}
+	actual = MapValsInt64Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int16{10: 10, 20: 20}
+	actual = MapKeysInt64Int16(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVInt64Int16(func(acc int16, k int64, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Int16(nil, m)) != 0 || len(MapKeysInt64Int16(nil, m)) != 0 || len(FilterKVInt64Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Int8(t *testing.T) {
+	m := map[int64]int8{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Int8(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Int8(m)) != 2 {
+		t.Errorf("TestKeysInt64Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsInt64Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Int8(m)) != 2 {
+		t.Errorf("TestValsInt64Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]int8{1: 10}
+	actual := SelectKeysInt64Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Int8(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Int8(func(k int64, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int8{2: 20}
+	actual = DissocKeysInt64Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]int8{1: 11, 2: 21}
+	actual = MapValsInt64Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int8{10: 10, 20: 20}
+	actual = MapKeysInt64Int8(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVInt64Int8(func(acc int8, k int64, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Int8(nil, m)) != 0 || len(MapKeysInt64Int8(nil, m)) != 0 || len(FilterKVInt64Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Uint(t *testing.T) {
+	m := map[int64]uint{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Uint(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Uint(m)) != 2 {
+		t.Errorf("TestKeysInt64Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsInt64Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Uint(m)) != 2 {
+		t.Errorf("TestValsInt64Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]uint{1: 10}
+	actual := SelectKeysInt64Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Uint(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Uint(func(k int64, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint{2: 20}
+	actual = DissocKeysInt64Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]uint{1: 11, 2: 21}
+	actual = MapValsInt64Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint{10: 10, 20: 20}
+	actual = MapKeysInt64Uint(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVInt64Uint(func(acc uint, k int64, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Uint(nil, m)) != 0 || len(MapKeysInt64Uint(nil, m)) != 0 || len(FilterKVInt64Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Uint64(t *testing.T) {
+	m := map[int64]uint64{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Uint64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Uint64(m)) != 2 {
+		t.Errorf("TestKeysInt64Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsInt64Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Uint64(m)) != 2 {
+		t.Errorf("TestValsInt64Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]uint64{1: 10}
+	actual := SelectKeysInt64Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Uint64(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Uint64(func(k int64, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint64{2: 20}
+	actual = DissocKeysInt64Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]uint64{1: 11, 2: 21}
+	actual = MapValsInt64Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint64{10: 10, 20: 20}
+	actual = MapKeysInt64Uint64(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVInt64Uint64(func(acc uint64, k int64, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Uint64(nil, m)) != 0 || len(MapKeysInt64Uint64(nil, m)) != 0 || len(FilterKVInt64Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Uint32(t *testing.T) {
+	m := map[int64]uint32{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Uint32(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Uint32(m)) != 2 {
+		t.Errorf("TestKeysInt64Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsInt64Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Uint32(m)) != 2 {
+		t.Errorf("TestValsInt64Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]uint32{1: 10}
+	actual := SelectKeysInt64Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Uint32(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Uint32(func(k int64, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint32{2: 20}
+	actual = DissocKeysInt64Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]uint32{1: 11, 2: 21}
+	actual = MapValsInt64Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint32{10: 10, 20: 20}
+	actual = MapKeysInt64Uint32(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVInt64Uint32(func(acc uint32, k int64, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Uint32(nil, m)) != 0 || len(MapKeysInt64Uint32(nil, m)) != 0 || len(FilterKVInt64Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Uint16(t *testing.T) {
+	m := map[int64]uint16{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Uint16(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Uint16(m)) != 2 {
+		t.Errorf("TestKeysInt64Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsInt64Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Uint16(m)) != 2 {
+		t.Errorf("TestValsInt64Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]uint16{1: 10}
+	actual := SelectKeysInt64Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Uint16(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Uint16(func(k int64, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint16{2: 20}
+	actual = DissocKeysInt64Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]uint16{1: 11, 2: 21}
+	actual = MapValsInt64Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint16{10: 10, 20: 20}
+	actual = MapKeysInt64Uint16(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVInt64Uint16(func(acc uint16, k int64, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Uint16(nil, m)) != 0 || len(MapKeysInt64Uint16(nil, m)) != 0 || len(FilterKVInt64Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Uint8(t *testing.T) {
+	m := map[int64]uint8{1: 10, 2: 20}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Uint8(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Uint8(m)) != 2 {
+		t.Errorf("TestKeysInt64Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsInt64Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Uint8(m)) != 2 {
+		t.Errorf("TestValsInt64Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]uint8{1: 10}
+	actual := SelectKeysInt64Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Uint8(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Uint8(func(k int64, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint8{2: 20}
+	actual = DissocKeysInt64Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]int64{10: 1, 20: 2}
+	actualInverted := InvertInt64Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]uint8{1: 11, 2: 21}
+	actual = MapValsInt64Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint8{10: 10, 20: 20}
+	actual = MapKeysInt64Uint8(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVInt64Uint8(func(acc uint8, k int64, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Uint8(nil, m)) != 0 || len(MapKeysInt64Uint8(nil, m)) != 0 || len(FilterKVInt64Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Str(t *testing.T) {
+	m := map[int64]string{1: "x", 2: "y"}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Str(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Str(m)) != 2 {
+		t.Errorf("TestKeysInt64Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsInt64Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Str(m)) != 2 {
+		t.Errorf("TestValsInt64Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]string{1: "x"}
+	actual := SelectKeysInt64Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Str(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Str(func(k int64, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]string{2: "y"}
+	actual = DissocKeysInt64Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]int64{"x": 1, "y": 2}
+	actualInverted := InvertInt64Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]string{1: "x!", 2: "y!"}
+	actual = MapValsInt64Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]string{10: "x", 20: "y"}
+	actual = MapKeysInt64Str(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVInt64Str(func(acc string, k int64, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Str(nil, m)) != 0 || len(MapKeysInt64Str(nil, m)) != 0 || len(FilterKVInt64Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt64Bool(t *testing.T) {
+	m := map[int64]bool{1: false, 2: true}
+
+	expectedKeys := []int64{1, 2}
+	actualKeys := KeysInt64Bool(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt64Bool(m)) != 2 {
+		t.Errorf("TestKeysInt64Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsInt64Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt64Bool(m)) != 2 {
+		t.Errorf("TestValsInt64Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int64]bool{1: false}
+	actual := SelectKeysInt64Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt64Bool(func(k int64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt64Bool(func(k int64, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]bool{2: true}
+	actual = DissocKeysInt64Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt64Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]int64{false: 1, true: 2}
+	actualInverted := InvertInt64Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt64Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int64]bool{1: true, 2: false}
+	actual = MapValsInt64Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]bool{10: false, 20: true}
+	actual = MapKeysInt64Bool(func(k int64) int64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVInt64Bool(func(acc bool, k int64, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt64Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt64Bool(nil, m)) != 0 || len(MapKeysInt64Bool(nil, m)) != 0 || len(FilterKVInt64Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt64Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Int(t *testing.T) {
+	m := map[int32]int{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Int(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Int(m)) != 2 {
+		t.Errorf("TestKeysInt32Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsInt32Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Int(m)) != 2 {
+		t.Errorf("TestValsInt32Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]int{1: 10}
+	actual := SelectKeysInt32Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Int(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Int(func(k int32, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int{2: 20}
+	actual = DissocKeysInt32Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]int{1: 11, 2: 21}
+	actual = MapValsInt32Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int{10: 10, 20: 20}
+	actual = MapKeysInt32Int(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVInt32Int(func(acc int, k int32, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Int(nil, m)) != 0 || len(MapKeysInt32Int(nil, m)) != 0 || len(FilterKVInt32Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Int64(t *testing.T) {
+	m := map[int32]int64{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Int64(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Int64(m)) != 2 {
+		t.Errorf("TestKeysInt32Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsInt32Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Int64(m)) != 2 {
+		t.Errorf("TestValsInt32Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]int64{1: 10}
+	actual := SelectKeysInt32Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Int64(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Int64(func(k int32, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int64{2: 20}
+	actual = DissocKeysInt32Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]int64{1: 11, 2: 21}
+	actual = MapValsInt32Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int64{10: 10, 20: 20}
+	actual = MapKeysInt32Int64(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVInt32Int64(func(acc int64, k int32, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Int64(nil, m)) != 0 || len(MapKeysInt32Int64(nil, m)) != 0 || len(FilterKVInt32Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32(t *testing.T) {
+	m := map[int32]int32{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32(m)) != 2 {
+		t.Errorf("TestKeysInt32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32(m)) != 2 {
+		t.Errorf("TestValsInt32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]int32{1: 10}
+	actual := SelectKeysInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32(func(k int32, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int32{2: 20}
+	actual = DissocKeysInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]int32{1: 11, 2: 21}
+	actual = MapValsInt32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int32{10: 10, 20: 20}
+	actual = MapKeysInt32(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVInt32(func(acc int32, k int32, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32(nil, m)) != 0 || len(MapKeysInt32(nil, m)) != 0 || len(FilterKVInt32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Int16(t *testing.T) {
+	m := map[int32]int16{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Int16(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Int16(m)) != 2 {
+		t.Errorf("TestKeysInt32Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsInt32Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Int16(m)) != 2 {
+		t.Errorf("TestValsInt32Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]int16{1: 10}
+	actual := SelectKeysInt32Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Int16(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Int16(func(k int32, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int16{2: 20}
+	actual = DissocKeysInt32Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]int16{1: 11, 2: 21}
+	actual = MapValsInt32Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int16{10: 10, 20: 20}
+	actual = MapKeysInt32Int16(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVInt32Int16(func(acc int16, k int32, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Int16(nil, m)) != 0 || len(MapKeysInt32Int16(nil, m)) != 0 || len(FilterKVInt32Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Int8(t *testing.T) {
+	m := map[int32]int8{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Int8(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Int8(m)) != 2 {
+		t.Errorf("TestKeysInt32Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsInt32Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Int8(m)) != 2 {
+		t.Errorf("TestValsInt32Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]int8{1: 10}
+	actual := SelectKeysInt32Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Int8(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Int8(func(k int32, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int8{2: 20}
+	actual = DissocKeysInt32Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]int8{1: 11, 2: 21}
+	actual = MapValsInt32Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int8{10: 10, 20: 20}
+	actual = MapKeysInt32Int8(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVInt32Int8(func(acc int8, k int32, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Int8(nil, m)) != 0 || len(MapKeysInt32Int8(nil, m)) != 0 || len(FilterKVInt32Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Uint(t *testing.T) {
+	m := map[int32]uint{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Uint(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Uint(m)) != 2 {
+		t.Errorf("TestKeysInt32Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsInt32Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Uint(m)) != 2 {
+		t.Errorf("TestValsInt32Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]uint{1: 10}
+	actual := SelectKeysInt32Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Uint(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Uint(func(k int32, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint{2: 20}
+	actual = DissocKeysInt32Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]uint{1: 11, 2: 21}
+	actual = MapValsInt32Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint{10: 10, 20: 20}
+	actual = MapKeysInt32Uint(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVInt32Uint(func(acc uint, k int32, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Uint(nil, m)) != 0 || len(MapKeysInt32Uint(nil, m)) != 0 || len(FilterKVInt32Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Uint64(t *testing.T) {
+	m := map[int32]uint64{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Uint64(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Uint64(m)) != 2 {
+		t.Errorf("TestKeysInt32Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsInt32Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Uint64(m)) != 2 {
+		t.Errorf("TestValsInt32Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]uint64{1: 10}
+	actual := SelectKeysInt32Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Uint64(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Uint64(func(k int32, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint64{2: 20}
+	actual = DissocKeysInt32Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]uint64{1: 11, 2: 21}
+	actual = MapValsInt32Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint64{10: 10, 20: 20}
+	actual = MapKeysInt32Uint64(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVInt32Uint64(func(acc uint64, k int32, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Uint64(nil, m)) != 0 || len(MapKeysInt32Uint64(nil, m)) != 0 || len(FilterKVInt32Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Uint32(t *testing.T) {
+	m := map[int32]uint32{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Uint32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Uint32(m)) != 2 {
+		t.Errorf("TestKeysInt32Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsInt32Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Uint32(m)) != 2 {
+		t.Errorf("TestValsInt32Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]uint32{1: 10}
+	actual := SelectKeysInt32Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Uint32(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Uint32(func(k int32, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint32{2: 20}
+	actual = DissocKeysInt32Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]uint32{1: 11, 2: 21}
+	actual = MapValsInt32Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint32{10: 10, 20: 20}
+	actual = MapKeysInt32Uint32(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVInt32Uint32(func(acc uint32, k int32, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Uint32(nil, m)) != 0 || len(MapKeysInt32Uint32(nil, m)) != 0 || len(FilterKVInt32Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Uint16(t *testing.T) {
+	m := map[int32]uint16{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Uint16(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Uint16(m)) != 2 {
+		t.Errorf("TestKeysInt32Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsInt32Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Uint16(m)) != 2 {
+		t.Errorf("TestValsInt32Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]uint16{1: 10}
+	actual := SelectKeysInt32Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Uint16(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Uint16(func(k int32, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint16{2: 20}
+	actual = DissocKeysInt32Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]uint16{1: 11, 2: 21}
+	actual = MapValsInt32Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint16{10: 10, 20: 20}
+	actual = MapKeysInt32Uint16(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVInt32Uint16(func(acc uint16, k int32, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Uint16(nil, m)) != 0 || len(MapKeysInt32Uint16(nil, m)) != 0 || len(FilterKVInt32Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Uint8(t *testing.T) {
+	m := map[int32]uint8{1: 10, 2: 20}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Uint8(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Uint8(m)) != 2 {
+		t.Errorf("TestKeysInt32Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsInt32Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Uint8(m)) != 2 {
+		t.Errorf("TestValsInt32Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]uint8{1: 10}
+	actual := SelectKeysInt32Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Uint8(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Uint8(func(k int32, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint8{2: 20}
+	actual = DissocKeysInt32Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]int32{10: 1, 20: 2}
+	actualInverted := InvertInt32Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]uint8{1: 11, 2: 21}
+	actual = MapValsInt32Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint8{10: 10, 20: 20}
+	actual = MapKeysInt32Uint8(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVInt32Uint8(func(acc uint8, k int32, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Uint8(nil, m)) != 0 || len(MapKeysInt32Uint8(nil, m)) != 0 || len(FilterKVInt32Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Str(t *testing.T) {
+	m := map[int32]string{1: "x", 2: "y"}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Str(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Str(m)) != 2 {
+		t.Errorf("TestKeysInt32Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsInt32Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Str(m)) != 2 {
+		t.Errorf("TestValsInt32Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]string{1: "x"}
+	actual := SelectKeysInt32Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Str(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Str(func(k int32, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]string{2: "y"}
+	actual = DissocKeysInt32Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]int32{"x": 1, "y": 2}
+	actualInverted := InvertInt32Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]string{1: "x!", 2: "y!"}
+	actual = MapValsInt32Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]string{10: "x", 20: "y"}
+	actual = MapKeysInt32Str(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVInt32Str(func(acc string, k int32, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Str(nil, m)) != 0 || len(MapKeysInt32Str(nil, m)) != 0 || len(FilterKVInt32Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt32Bool(t *testing.T) {
+	m := map[int32]bool{1: false, 2: true}
+
+	expectedKeys := []int32{1, 2}
+	actualKeys := KeysInt32Bool(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt32Bool(m)) != 2 {
+		t.Errorf("TestKeysInt32Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsInt32Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt32Bool(m)) != 2 {
+		t.Errorf("TestValsInt32Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int32]bool{1: false}
+	actual := SelectKeysInt32Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt32Bool(func(k int32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt32Bool(func(k int32, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]bool{2: true}
+	actual = DissocKeysInt32Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt32Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]int32{false: 1, true: 2}
+	actualInverted := InvertInt32Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt32Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int32]bool{1: true, 2: false}
+	actual = MapValsInt32Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]bool{10: false, 20: true}
+	actual = MapKeysInt32Bool(func(k int32) int32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVInt32Bool(func(acc bool, k int32, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt32Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt32Bool(nil, m)) != 0 || len(MapKeysInt32Bool(nil, m)) != 0 || len(FilterKVInt32Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt32Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Int(t *testing.T) {
+	m := map[int16]int{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Int(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Int(m)) != 2 {
+		t.Errorf("TestKeysInt16Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsInt16Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Int(m)) != 2 {
+		t.Errorf("TestValsInt16Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]int{1: 10}
+	actual := SelectKeysInt16Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Int(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Int(func(k int16, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int{2: 20}
+	actual = DissocKeysInt16Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]int{1: 11, 2: 21}
+	actual = MapValsInt16Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int{10: 10, 20: 20}
+	actual = MapKeysInt16Int(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVInt16Int(func(acc int, k int16, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Int(nil, m)) != 0 || len(MapKeysInt16Int(nil, m)) != 0 || len(FilterKVInt16Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Int64(t *testing.T) {
+	m := map[int16]int64{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Int64(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Int64(m)) != 2 {
+		t.Errorf("TestKeysInt16Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsInt16Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Int64(m)) != 2 {
+		t.Errorf("TestValsInt16Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]int64{1: 10}
+	actual := SelectKeysInt16Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Int64(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Int64(func(k int16, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int64{2: 20}
+	actual = DissocKeysInt16Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]int64{1: 11, 2: 21}
+	actual = MapValsInt16Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int64{10: 10, 20: 20}
+	actual = MapKeysInt16Int64(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVInt16Int64(func(acc int64, k int16, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Int64(nil, m)) != 0 || len(MapKeysInt16Int64(nil, m)) != 0 || len(FilterKVInt16Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Int32(t *testing.T) {
+	m := map[int16]int32{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Int32(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Int32(m)) != 2 {
+		t.Errorf("TestKeysInt16Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsInt16Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Int32(m)) != 2 {
+		t.Errorf("TestValsInt16Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]int32{1: 10}
+	actual := SelectKeysInt16Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Int32(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Int32(func(k int16, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int32{2: 20}
+	actual = DissocKeysInt16Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]int32{1: 11, 2: 21}
+	actual = MapValsInt16Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int32{10: 10, 20: 20}
+	actual = MapKeysInt16Int32(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVInt16Int32(func(acc int32, k int16, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Int32(nil, m)) != 0 || len(MapKeysInt16Int32(nil, m)) != 0 || len(FilterKVInt16Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16(t *testing.T) {
+	m := map[int16]int16{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16(m)) != 2 {
+		t.Errorf("TestKeysInt16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16(m)) != 2 {
+		t.Errorf("TestValsInt16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]int16{1: 10}
+	actual := SelectKeysInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16(func(k int16, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int16{2: 20}
+	actual = DissocKeysInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]int16{1: 11, 2: 21}
+	actual = MapValsInt16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int16{10: 10, 20: 20}
+	actual = MapKeysInt16(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVInt16(func(acc int16, k int16, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16(nil, m)) != 0 || len(MapKeysInt16(nil, m)) != 0 || len(FilterKVInt16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Int8(t *testing.T) {
+	m := map[int16]int8{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Int8(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Int8(m)) != 2 {
+		t.Errorf("TestKeysInt16Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsInt16Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Int8(m)) != 2 {
+		t.Errorf("TestValsInt16Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]int8{1: 10}
+	actual := SelectKeysInt16Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Int8(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Int8(func(k int16, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int8{2: 20}
+	actual = DissocKeysInt16Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]int8{1: 11, 2: 21}
+	actual = MapValsInt16Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int8{10: 10, 20: 20}
+	actual = MapKeysInt16Int8(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVInt16Int8(func(acc int8, k int16, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Int8(nil, m)) != 0 || len(MapKeysInt16Int8(nil, m)) != 0 || len(FilterKVInt16Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Uint(t *testing.T) {
+	m := map[int16]uint{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Uint(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Uint(m)) != 2 {
+		t.Errorf("TestKeysInt16Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsInt16Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Uint(m)) != 2 {
+		t.Errorf("TestValsInt16Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]uint{1: 10}
+	actual := SelectKeysInt16Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Uint(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Uint(func(k int16, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint{2: 20}
+	actual = DissocKeysInt16Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]uint{1: 11, 2: 21}
+	actual = MapValsInt16Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint{10: 10, 20: 20}
+	actual = MapKeysInt16Uint(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVInt16Uint(func(acc uint, k int16, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Uint(nil, m)) != 0 || len(MapKeysInt16Uint(nil, m)) != 0 || len(FilterKVInt16Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Uint64(t *testing.T) {
+	m := map[int16]uint64{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Uint64(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Uint64(m)) != 2 {
+		t.Errorf("TestKeysInt16Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsInt16Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Uint64(m)) != 2 {
+		t.Errorf("TestValsInt16Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]uint64{1: 10}
+	actual := SelectKeysInt16Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Uint64(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Uint64(func(k int16, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint64{2: 20}
+	actual = DissocKeysInt16Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]uint64{1: 11, 2: 21}
+	actual = MapValsInt16Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint64{10: 10, 20: 20}
+	actual = MapKeysInt16Uint64(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVInt16Uint64(func(acc uint64, k int16, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Uint64(nil, m)) != 0 || len(MapKeysInt16Uint64(nil, m)) != 0 || len(FilterKVInt16Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Uint32(t *testing.T) {
+	m := map[int16]uint32{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Uint32(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Uint32(m)) != 2 {
+		t.Errorf("TestKeysInt16Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsInt16Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Uint32(m)) != 2 {
+		t.Errorf("TestValsInt16Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]uint32{1: 10}
+	actual := SelectKeysInt16Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Uint32(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Uint32(func(k int16, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint32{2: 20}
+	actual = DissocKeysInt16Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]uint32{1: 11, 2: 21}
+	actual = MapValsInt16Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint32{10: 10, 20: 20}
+	actual = MapKeysInt16Uint32(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVInt16Uint32(func(acc uint32, k int16, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Uint32(nil, m)) != 0 || len(MapKeysInt16Uint32(nil, m)) != 0 || len(FilterKVInt16Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Uint16(t *testing.T) {
+	m := map[int16]uint16{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Uint16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Uint16(m)) != 2 {
+		t.Errorf("TestKeysInt16Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsInt16Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Uint16(m)) != 2 {
+		t.Errorf("TestValsInt16Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]uint16{1: 10}
+	actual := SelectKeysInt16Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Uint16(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Uint16(func(k int16, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint16{2: 20}
+	actual = DissocKeysInt16Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]uint16{1: 11, 2: 21}
+	actual = MapValsInt16Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint16{10: 10, 20: 20}
+	actual = MapKeysInt16Uint16(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVInt16Uint16(func(acc uint16, k int16, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Uint16(nil, m)) != 0 || len(MapKeysInt16Uint16(nil, m)) != 0 || len(FilterKVInt16Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Uint8(t *testing.T) {
+	m := map[int16]uint8{1: 10, 2: 20}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Uint8(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Uint8(m)) != 2 {
+		t.Errorf("TestKeysInt16Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsInt16Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Uint8(m)) != 2 {
+		t.Errorf("TestValsInt16Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]uint8{1: 10}
+	actual := SelectKeysInt16Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Uint8(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Uint8(func(k int16, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint8{2: 20}
+	actual = DissocKeysInt16Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]int16{10: 1, 20: 2}
+	actualInverted := InvertInt16Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]uint8{1: 11, 2: 21}
+	actual = MapValsInt16Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint8{10: 10, 20: 20}
+	actual = MapKeysInt16Uint8(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVInt16Uint8(func(acc uint8, k int16, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Uint8(nil, m)) != 0 || len(MapKeysInt16Uint8(nil, m)) != 0 || len(FilterKVInt16Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Str(t *testing.T) {
+	m := map[int16]string{1: "x", 2: "y"}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Str(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Str(m)) != 2 {
+		t.Errorf("TestKeysInt16Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsInt16Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Str(m)) != 2 {
+		t.Errorf("TestValsInt16Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]string{1: "x"}
+	actual := SelectKeysInt16Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Str(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Str(func(k int16, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]string{2: "y"}
+	actual = DissocKeysInt16Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]int16{"x": 1, "y": 2}
+	actualInverted := InvertInt16Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]string{1: "x!", 2: "y!"}
+	actual = MapValsInt16Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]string{10: "x", 20: "y"}
+	actual = MapKeysInt16Str(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVInt16Str(func(acc string, k int16, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Str(nil, m)) != 0 || len(MapKeysInt16Str(nil, m)) != 0 || len(FilterKVInt16Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt16Bool(t *testing.T) {
+	m := map[int16]bool{1: false, 2: true}
+
+	expectedKeys := []int16{1, 2}
+	actualKeys := KeysInt16Bool(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt16Bool(m)) != 2 {
+		t.Errorf("TestKeysInt16Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsInt16Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt16Bool(m)) != 2 {
+		t.Errorf("TestValsInt16Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int16]bool{1: false}
+	actual := SelectKeysInt16Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt16Bool(func(k int16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt16Bool(func(k int16, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]bool{2: true}
+	actual = DissocKeysInt16Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt16Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]int16{false: 1, true: 2}
+	actualInverted := InvertInt16Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt16Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int16]bool{1: true, 2: false}
+	actual = MapValsInt16Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]bool{10: false, 20: true}
+	actual = MapKeysInt16Bool(func(k int16) int16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVInt16Bool(func(acc bool, k int16, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt16Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt16Bool(nil, m)) != 0 || len(MapKeysInt16Bool(nil, m)) != 0 || len(FilterKVInt16Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt16Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Int(t *testing.T) {
+	m := map[int8]int{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Int(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Int(m)) != 2 {
+		t.Errorf("TestKeysInt8Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsInt8Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Int(m)) != 2 {
+		t.Errorf("TestValsInt8Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]int{1: 10}
+	actual := SelectKeysInt8Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Int(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Int(func(k int8, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int{2: 20}
+	actual = DissocKeysInt8Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]int{1: 11, 2: 21}
+	actual = MapValsInt8Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int{10: 10, 20: 20}
+	actual = MapKeysInt8Int(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVInt8Int(func(acc int, k int8, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Int(nil, m)) != 0 || len(MapKeysInt8Int(nil, m)) != 0 || len(FilterKVInt8Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Int64(t *testing.T) {
+	m := map[int8]int64{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Int64(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Int64(m)) != 2 {
+		t.Errorf("TestKeysInt8Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsInt8Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Int64(m)) != 2 {
+		t.Errorf("TestValsInt8Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]int64{1: 10}
+	actual := SelectKeysInt8Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Int64(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Int64(func(k int8, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int64{2: 20}
+	actual = DissocKeysInt8Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]int64{1: 11, 2: 21}
+	actual = MapValsInt8Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int64{10: 10, 20: 20}
+	actual = MapKeysInt8Int64(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVInt8Int64(func(acc int64, k int8, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Int64(nil, m)) != 0 || len(MapKeysInt8Int64(nil, m)) != 0 || len(FilterKVInt8Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Int32(t *testing.T) {
+	m := map[int8]int32{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Int32(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Int32(m)) != 2 {
+		t.Errorf("TestKeysInt8Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsInt8Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Int32(m)) != 2 {
+		t.Errorf("TestValsInt8Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]int32{1: 10}
+	actual := SelectKeysInt8Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Int32(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Int32(func(k int8, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int32{2: 20}
+	actual = DissocKeysInt8Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]int32{1: 11, 2: 21}
+	actual = MapValsInt8Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int32{10: 10, 20: 20}
+	actual = MapKeysInt8Int32(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVInt8Int32(func(acc int32, k int8, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Int32(nil, m)) != 0 || len(MapKeysInt8Int32(nil, m)) != 0 || len(FilterKVInt8Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Int16(t *testing.T) {
+	m := map[int8]int16{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Int16(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Int16(m)) != 2 {
+		t.Errorf("TestKeysInt8Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsInt8Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Int16(m)) != 2 {
+		t.Errorf("TestValsInt8Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]int16{1: 10}
+	actual := SelectKeysInt8Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Int16(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Int16(func(k int8, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int16{2: 20}
+	actual = DissocKeysInt8Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]int16{1: 11, 2: 21}
+	actual = MapValsInt8Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int16{10: 10, 20: 20}
+	actual = MapKeysInt8Int16(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVInt8Int16(func(acc int16, k int8, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Int16(nil, m)) != 0 || len(MapKeysInt8Int16(nil, m)) != 0 || len(FilterKVInt8Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8(t *testing.T) {
+	m := map[int8]int8{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8(m)) != 2 {
+		t.Errorf("TestKeysInt8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8(m)) != 2 {
+		t.Errorf("TestValsInt8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]int8{1: 10}
+	actual := SelectKeysInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8(func(k int8, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int8{2: 20}
+	actual = DissocKeysInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]int8{1: 11, 2: 21}
+	actual = MapValsInt8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int8{10: 10, 20: 20}
+	actual = MapKeysInt8(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVInt8(func(acc int8, k int8, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8(nil, m)) != 0 || len(MapKeysInt8(nil, m)) != 0 || len(FilterKVInt8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Uint(t *testing.T) {
+	m := map[int8]uint{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Uint(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Uint(m)) != 2 {
+		t.Errorf("TestKeysInt8Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsInt8Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Uint(m)) != 2 {
+		t.Errorf("TestValsInt8Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]uint{1: 10}
+	actual := SelectKeysInt8Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Uint(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Uint(func(k int8, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint{2: 20}
+	actual = DissocKeysInt8Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]uint{1: 11, 2: 21}
+	actual = MapValsInt8Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint{10: 10, 20: 20}
+	actual = MapKeysInt8Uint(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVInt8Uint(func(acc uint, k int8, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Uint(nil, m)) != 0 || len(MapKeysInt8Uint(nil, m)) != 0 || len(FilterKVInt8Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Uint64(t *testing.T) {
+	m := map[int8]uint64{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Uint64(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Uint64(m)) != 2 {
+		t.Errorf("TestKeysInt8Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsInt8Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Uint64(m)) != 2 {
+		t.Errorf("TestValsInt8Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]uint64{1: 10}
+	actual := SelectKeysInt8Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Uint64(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Uint64(func(k int8, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint64{2: 20}
+	actual = DissocKeysInt8Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]uint64{1: 11, 2: 21}
+	actual = MapValsInt8Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint64{10: 10, 20: 20}
+	actual = MapKeysInt8Uint64(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVInt8Uint64(func(acc uint64, k int8, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Uint64(nil, m)) != 0 || len(MapKeysInt8Uint64(nil, m)) != 0 || len(FilterKVInt8Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Uint32(t *testing.T) {
+	m := map[int8]uint32{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Uint32(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Uint32(m)) != 2 {
+		t.Errorf("TestKeysInt8Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsInt8Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Uint32(m)) != 2 {
+		t.Errorf("TestValsInt8Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]uint32{1: 10}
+	actual := SelectKeysInt8Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Uint32(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Uint32(func(k int8, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint32{2: 20}
+	actual = DissocKeysInt8Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]uint32{1: 11, 2: 21}
+	actual = MapValsInt8Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint32{10: 10, 20: 20}
+	actual = MapKeysInt8Uint32(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVInt8Uint32(func(acc uint32, k int8, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Uint32(nil, m)) != 0 || len(MapKeysInt8Uint32(nil, m)) != 0 || len(FilterKVInt8Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Uint16(t *testing.T) {
+	m := map[int8]uint16{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Uint16(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Uint16(m)) != 2 {
+		t.Errorf("TestKeysInt8Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsInt8Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Uint16(m)) != 2 {
+		t.Errorf("TestValsInt8Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]uint16{1: 10}
+	actual := SelectKeysInt8Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Uint16(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Uint16(func(k int8, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint16{2: 20}
+	actual = DissocKeysInt8Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]uint16{1: 11, 2: 21}
+	actual = MapValsInt8Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint16{10: 10, 20: 20}
+	actual = MapKeysInt8Uint16(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVInt8Uint16(func(acc uint16, k int8, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Uint16(nil, m)) != 0 || len(MapKeysInt8Uint16(nil, m)) != 0 || len(FilterKVInt8Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Uint8(t *testing.T) {
+	m := map[int8]uint8{1: 10, 2: 20}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Uint8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Uint8(m)) != 2 {
+		t.Errorf("TestKeysInt8Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsInt8Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Uint8(m)) != 2 {
+		t.Errorf("TestValsInt8Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]uint8{1: 10}
+	actual := SelectKeysInt8Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Uint8(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Uint8(func(k int8, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint8{2: 20}
+	actual = DissocKeysInt8Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]int8{10: 1, 20: 2}
+	actualInverted := InvertInt8Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]uint8{1: 11, 2: 21}
+	actual = MapValsInt8Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint8{10: 10, 20: 20}
+	actual = MapKeysInt8Uint8(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVInt8Uint8(func(acc uint8, k int8, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Uint8(nil, m)) != 0 || len(MapKeysInt8Uint8(nil, m)) != 0 || len(FilterKVInt8Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Str(t *testing.T) {
+	m := map[int8]string{1: "x", 2: "y"}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Str(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Str(m)) != 2 {
+		t.Errorf("TestKeysInt8Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsInt8Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Str(m)) != 2 {
+		t.Errorf("TestValsInt8Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]string{1: "x"}
+	actual := SelectKeysInt8Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Str(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Str(func(k int8, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]string{2: "y"}
+	actual = DissocKeysInt8Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]int8{"x": 1, "y": 2}
+	actualInverted := InvertInt8Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]string{1: "x!", 2: "y!"}
+	actual = MapValsInt8Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]string{10: "x", 20: "y"}
+	actual = MapKeysInt8Str(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVInt8Str(func(acc string, k int8, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Str(nil, m)) != 0 || len(MapKeysInt8Str(nil, m)) != 0 || len(FilterKVInt8Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVInt8Bool(t *testing.T) {
+	m := map[int8]bool{1: false, 2: true}
+
+	expectedKeys := []int8{1, 2}
+	actualKeys := KeysInt8Bool(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysInt8Bool(m)) != 2 {
+		t.Errorf("TestKeysInt8Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsInt8Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsInt8Bool(m)) != 2 {
+		t.Errorf("TestValsInt8Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[int8]bool{1: false}
+	actual := SelectKeysInt8Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysInt8Bool(func(k int8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVInt8Bool(func(k int8, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]bool{2: true}
+	actual = DissocKeysInt8Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsInt8Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]int8{false: 1, true: 2}
+	actualInverted := InvertInt8Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertInt8Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[int8]bool{1: true, 2: false}
+	actual = MapValsInt8Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]bool{10: false, 20: true}
+	actual = MapKeysInt8Bool(func(k int8) int8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVInt8Bool(func(acc bool, k int8, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVInt8Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsInt8Bool(nil, m)) != 0 || len(MapKeysInt8Bool(nil, m)) != 0 || len(FilterKVInt8Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVInt8Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintInt(t *testing.T) {
+	m := map[uint]int{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintInt(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintInt(m)) != 2 {
+		t.Errorf("TestKeysUintInt failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsUintInt(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintInt(m)) != 2 {
+		t.Errorf("TestValsUintInt failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]int{1: 10}
+	actual := SelectKeysUintInt(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintInt(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintInt(func(k uint, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int{2: 20}
+	actual = DissocKeysUintInt(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintInt(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]uint{10: 1, 20: 2}
+	actualInverted := InvertUintInt(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintInt failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]int{1: 11, 2: 21}
+	actual = MapValsUintInt(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int{10: 10, 20: 20}
+	actual = MapKeysUintInt(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVUintInt(func(acc int, k uint, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintInt failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintInt(nil, m)) != 0 || len(MapKeysUintInt(nil, m)) != 0 || len(FilterKVUintInt(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintInt failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintInt64(t *testing.T) {
+	m := map[uint]int64{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintInt64(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintInt64(m)) != 2 {
+		t.Errorf("TestKeysUintInt64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsUintInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintInt64(m)) != 2 {
+		t.Errorf("TestValsUintInt64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]int64{1: 10}
+	actual := SelectKeysUintInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintInt64(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintInt64(func(k uint, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int64{2: 20}
+	actual = DissocKeysUintInt64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintInt64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]uint{10: 1, 20: 2}
+	actualInverted := InvertUintInt64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintInt64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]int64{1: 11, 2: 21}
+	actual = MapValsUintInt64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int64{10: 10, 20: 20}
+	actual = MapKeysUintInt64(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVUintInt64(func(acc int64, k uint, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintInt64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintInt64(nil, m)) != 0 || len(MapKeysUintInt64(nil, m)) != 0 || len(FilterKVUintInt64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintInt64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintInt32(t *testing.T) {
+	m := map[uint]int32{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintInt32(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintInt32(m)) != 2 {
+		t.Errorf("TestKeysUintInt32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsUintInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintInt32(m)) != 2 {
+		t.Errorf("TestValsUintInt32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]int32{1: 10}
+	actual := SelectKeysUintInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintInt32(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintInt32(func(k uint, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int32{2: 20}
+	actual = DissocKeysUintInt32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintInt32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]uint{10: 1, 20: 2}
+	actualInverted := InvertUintInt32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintInt32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]int32{1: 11, 2: 21}
+	actual = MapValsUintInt32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int32{10: 10, 20: 20}
+	actual = MapKeysUintInt32(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVUintInt32(func(acc int32, k uint, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintInt32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintInt32(nil, m)) != 0 || len(MapKeysUintInt32(nil, m)) != 0 || len(FilterKVUintInt32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintInt32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintInt16(t *testing.T) {
+	m := map[uint]int16{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintInt16(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintInt16(m)) != 2 {
+		t.Errorf("TestKeysUintInt16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsUintInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintInt16(m)) != 2 {
+		t.Errorf("TestValsUintInt16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]int16{1: 10}
+	actual := SelectKeysUintInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintInt16(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintInt16(func(k uint, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int16{2: 20}
+	actual = DissocKeysUintInt16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintInt16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]uint{10: 1, 20: 2}
+	actualInverted := InvertUintInt16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintInt16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]int16{1: 11, 2: 21}
+	actual = MapValsUintInt16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int16{10: 10, 20: 20}
+	actual = MapKeysUintInt16(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVUintInt16(func(acc int16, k uint, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintInt16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintInt16(nil, m)) != 0 || len(MapKeysUintInt16(nil, m)) != 0 || len(FilterKVUintInt16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintInt16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintInt8(t *testing.T) {
+	m := map[uint]int8{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintInt8(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintInt8(m)) != 2 {
+		t.Errorf("TestKeysUintInt8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsUintInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintInt8(m)) != 2 {
+		t.Errorf("TestValsUintInt8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]int8{1: 10}
+	actual := SelectKeysUintInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintInt8(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintInt8(func(k uint, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int8{2: 20}
+	actual = DissocKeysUintInt8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintInt8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]uint{10: 1, 20: 2}
+	actualInverted := InvertUintInt8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintInt8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]int8{1: 11, 2: 21}
+	actual = MapValsUintInt8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int8{10: 10, 20: 20}
+	actual = MapKeysUintInt8(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVUintInt8(func(acc int8, k uint, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintInt8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintInt8(nil, m)) != 0 || len(MapKeysUintInt8(nil, m)) != 0 || len(FilterKVUintInt8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintInt8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint(t *testing.T) {
+	m := map[uint]uint{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint(m)) != 2 {
+		t.Errorf("TestKeysUint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsUint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint(m)) != 2 {
+		t.Errorf("TestValsUint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]uint{1: 10}
+	actual := SelectKeysUint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint(func(k uint, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint{2: 20}
+	actual = DissocKeysUint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]uint{10: 1, 20: 2}
+	actualInverted := InvertUint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]uint{1: 11, 2: 21}
+	actual = MapValsUint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint{10: 10, 20: 20}
+	actual = MapKeysUint(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVUint(func(acc uint, k uint, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint(nil, m)) != 0 || len(MapKeysUint(nil, m)) != 0 || len(FilterKVUint(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintUint64(t *testing.T) {
+	m := map[uint]uint64{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintUint64(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintUint64(m)) != 2 {
+		t.Errorf("TestKeysUintUint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsUintUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintUint64(m)) != 2 {
+		t.Errorf("TestValsUintUint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]uint64{1: 10}
+	actual := SelectKeysUintUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintUint64(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintUint64(func(k uint, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint64{2: 20}
+	actual = DissocKeysUintUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintUint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]uint{10: 1, 20: 2}
+	actualInverted := InvertUintUint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintUint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]uint64{1: 11, 2: 21}
+	actual = MapValsUintUint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint64{10: 10, 20: 20}
+	actual = MapKeysUintUint64(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVUintUint64(func(acc uint64, k uint, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintUint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintUint64(nil, m)) != 0 || len(MapKeysUintUint64(nil, m)) != 0 || len(FilterKVUintUint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintUint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintUint32(t *testing.T) {
+	m := map[uint]uint32{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintUint32(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintUint32(m)) != 2 {
+		t.Errorf("TestKeysUintUint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsUintUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintUint32(m)) != 2 {
+		t.Errorf("TestValsUintUint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]uint32{1: 10}
+	actual := SelectKeysUintUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintUint32(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintUint32(func(k uint, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint32{2: 20}
+	actual = DissocKeysUintUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintUint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]uint{10: 1, 20: 2}
+	actualInverted := InvertUintUint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintUint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]uint32{1: 11, 2: 21}
+	actual = MapValsUintUint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint32{10: 10, 20: 20}
+	actual = MapKeysUintUint32(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVUintUint32(func(acc uint32, k uint, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintUint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintUint32(nil, m)) != 0 || len(MapKeysUintUint32(nil, m)) != 0 || len(FilterKVUintUint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintUint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintUint16(t *testing.T) {
+	m := map[uint]uint16{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintUint16(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintUint16(m)) != 2 {
+		t.Errorf("TestKeysUintUint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsUintUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintUint16(m)) != 2 {
+		t.Errorf("TestValsUintUint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]uint16{1: 10}
+	actual := SelectKeysUintUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintUint16(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintUint16(func(k uint, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint16{2: 20}
+	actual = DissocKeysUintUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintUint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]uint{10: 1, 20: 2}
+	actualInverted := InvertUintUint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintUint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]uint16{1: 11, 2: 21}
+	actual = MapValsUintUint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint16{10: 10, 20: 20}
+	actual = MapKeysUintUint16(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVUintUint16(func(acc uint16, k uint, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintUint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintUint16(nil, m)) != 0 || len(MapKeysUintUint16(nil, m)) != 0 || len(FilterKVUintUint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintUint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintUint8(t *testing.T) {
+	m := map[uint]uint8{1: 10, 2: 20}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintUint8(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintUint8(m)) != 2 {
+		t.Errorf("TestKeysUintUint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsUintUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintUint8(m)) != 2 {
+		t.Errorf("TestValsUintUint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]uint8{1: 10}
+	actual := SelectKeysUintUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintUint8(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintUint8(func(k uint, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint8{2: 20}
+	actual = DissocKeysUintUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintUint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]uint{10: 1, 20: 2}
+	actualInverted := InvertUintUint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintUint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]uint8{1: 11, 2: 21}
+	actual = MapValsUintUint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint8{10: 10, 20: 20}
+	actual = MapKeysUintUint8(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVUintUint8(func(acc uint8, k uint, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintUint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintUint8(nil, m)) != 0 || len(MapKeysUintUint8(nil, m)) != 0 || len(FilterKVUintUint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintUint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintStr(t *testing.T) {
+	m := map[uint]string{1: "x", 2: "y"}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintStr(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintStr(m)) != 2 {
+		t.Errorf("TestKeysUintStr failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsUintStr(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintStr(m)) != 2 {
+		t.Errorf("TestValsUintStr failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]string{1: "x"}
+	actual := SelectKeysUintStr(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintStr(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintStr(func(k uint, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]string{2: "y"}
+	actual = DissocKeysUintStr(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintStr(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]uint{"x": 1, "y": 2}
+	actualInverted := InvertUintStr(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintStr failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]string{1: "x!", 2: "y!"}
+	actual = MapValsUintStr(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]string{10: "x", 20: "y"}
+	actual = MapKeysUintStr(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVUintStr(func(acc string, k uint, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintStr failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintStr(nil, m)) != 0 || len(MapKeysUintStr(nil, m)) != 0 || len(FilterKVUintStr(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintStr failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUintBool(t *testing.T) {
+	m := map[uint]bool{1: false, 2: true}
+
+	expectedKeys := []uint{1, 2}
+	actualKeys := KeysUintBool(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUintBool(m)) != 2 {
+		t.Errorf("TestKeysUintBool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsUintBool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUintBool(m)) != 2 {
+		t.Errorf("TestValsUintBool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint]bool{1: false}
+	actual := SelectKeysUintBool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUintBool(func(k uint) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUintBool(func(k uint, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]bool{2: true}
+	actual = DissocKeysUintBool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUintBool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]uint{false: 1, true: 2}
+	actualInverted := InvertUintBool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUintBool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint]bool{1: true, 2: false}
+	actual = MapValsUintBool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]bool{10: false, 20: true}
+	actual = MapKeysUintBool(func(k uint) uint { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVUintBool(func(acc bool, k uint, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUintBool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUintBool(nil, m)) != 0 || len(MapKeysUintBool(nil, m)) != 0 || len(FilterKVUintBool(nil, m)) != 0 {
+		t.Errorf("TestMapKVUintBool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Int(t *testing.T) {
+	m := map[uint64]int{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Int(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Int(m)) != 2 {
+		t.Errorf("TestKeysUint64Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsUint64Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Int(m)) != 2 {
+		t.Errorf("TestValsUint64Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]int{1: 10}
+	actual := SelectKeysUint64Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Int(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Int(func(k uint64, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int{2: 20}
+	actual = DissocKeysUint64Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]int{1: 11, 2: 21}
+	actual = MapValsUint64Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int{10: 10, 20: 20}
+	actual = MapKeysUint64Int(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVUint64Int(func(acc int, k uint64, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Int(nil, m)) != 0 || len(MapKeysUint64Int(nil, m)) != 0 || len(FilterKVUint64Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Int64(t *testing.T) {
+	m := map[uint64]int64{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Int64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Int64(m)) != 2 {
+		t.Errorf("TestKeysUint64Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsUint64Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Int64(m)) != 2 {
+		t.Errorf("TestValsUint64Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]int64{1: 10}
+	actual := SelectKeysUint64Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Int64(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Int64(func(k uint64, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int64{2: 20}
+	actual = DissocKeysUint64Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]int64{1: 11, 2: 21}
+	actual = MapValsUint64Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int64{10: 10, 20: 20}
+	actual = MapKeysUint64Int64(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVUint64Int64(func(acc int64, k uint64, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Int64(nil, m)) != 0 || len(MapKeysUint64Int64(nil, m)) != 0 || len(FilterKVUint64Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Int32(t *testing.T) {
+	m := map[uint64]int32{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Int32(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Int32(m)) != 2 {
+		t.Errorf("TestKeysUint64Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsUint64Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Int32(m)) != 2 {
+		t.Errorf("TestValsUint64Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]int32{1: 10}
+	actual := SelectKeysUint64Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Int32(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Int32(func(k uint64, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int32{2: 20}
+	actual = DissocKeysUint64Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]int32{1: 11, 2: 21}
+	actual = MapValsUint64Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int32{10: 10, 20: 20}
+	actual = MapKeysUint64Int32(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVUint64Int32(func(acc int32, k uint64, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Int32(nil, m)) != 0 || len(MapKeysUint64Int32(nil, m)) != 0 || len(FilterKVUint64Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Int16(t *testing.T) {
+	m := map[uint64]int16{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Int16(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Int16(m)) != 2 {
+		t.Errorf("TestKeysUint64Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsUint64Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Int16(m)) != 2 {
+		t.Errorf("TestValsUint64Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]int16{1: 10}
+	actual := SelectKeysUint64Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Int16(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Int16(func(k uint64, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int16{2: 20}
+	actual = DissocKeysUint64Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]int16{1: 11, 2: 21}
+	actual = MapValsUint64Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int16{10: 10, 20: 20}
+	actual = MapKeysUint64Int16(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVUint64Int16(func(acc int16, k uint64, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Int16(nil, m)) != 0 || len(MapKeysUint64Int16(nil, m)) != 0 || len(FilterKVUint64Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Int8(t *testing.T) {
+	m := map[uint64]int8{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Int8(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Int8(m)) != 2 {
+		t.Errorf("TestKeysUint64Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsUint64Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Int8(m)) != 2 {
+		t.Errorf("TestValsUint64Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]int8{1: 10}
+	actual := SelectKeysUint64Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Int8(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Int8(func(k uint64, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int8{2: 20}
+	actual = DissocKeysUint64Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]int8{1: 11, 2: 21}
+	actual = MapValsUint64Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int8{10: 10, 20: 20}
+	actual = MapKeysUint64Int8(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVUint64Int8(func(acc int8, k uint64, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Int8(nil, m)) != 0 || len(MapKeysUint64Int8(nil, m)) != 0 || len(FilterKVUint64Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Uint(t *testing.T) {
+	m := map[uint64]uint{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Uint(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Uint(m)) != 2 {
+		t.Errorf("TestKeysUint64Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsUint64Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Uint(m)) != 2 {
+		t.Errorf("TestValsUint64Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]uint{1: 10}
+	actual := SelectKeysUint64Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Uint(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Uint(func(k uint64, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint{2: 20}
+	actual = DissocKeysUint64Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]uint{1: 11, 2: 21}
+	actual = MapValsUint64Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint{10: 10, 20: 20}
+	actual = MapKeysUint64Uint(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVUint64Uint(func(acc uint, k uint64, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Uint(nil, m)) != 0 || len(MapKeysUint64Uint(nil, m)) != 0 || len(FilterKVUint64Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64(t *testing.T) {
+	m := map[uint64]uint64{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64(m)) != 2 {
+		t.Errorf("TestKeysUint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64(m)) != 2 {
+		t.Errorf("TestValsUint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]uint64{1: 10}
+	actual := SelectKeysUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64(func(k uint64, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint64{2: 20}
+	actual = DissocKeysUint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]uint64{1: 11, 2: 21}
+	actual = MapValsUint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint64{10: 10, 20: 20}
+	actual = MapKeysUint64(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVUint64(func(acc uint64, k uint64, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64(nil, m)) != 0 || len(MapKeysUint64(nil, m)) != 0 || len(FilterKVUint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Uint32(t *testing.T) {
+	m := map[uint64]uint32{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Uint32(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Uint32(m)) != 2 {
+		t.Errorf("TestKeysUint64Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsUint64Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Uint32(m)) != 2 {
+		t.Errorf("TestValsUint64Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]uint32{1: 10}
+	actual := SelectKeysUint64Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Uint32(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Uint32(func(k uint64, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint32{2: 20}
+	actual = DissocKeysUint64Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]uint32{1: 11, 2: 21}
+	actual = MapValsUint64Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint32{10: 10, 20: 20}
+	actual = MapKeysUint64Uint32(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVUint64Uint32(func(acc uint32, k uint64, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Uint32(nil, m)) != 0 || len(MapKeysUint64Uint32(nil, m)) != 0 || len(FilterKVUint64Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Uint16(t *testing.T) {
+	m := map[uint64]uint16{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Uint16(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Uint16(m)) != 2 {
+		t.Errorf("TestKeysUint64Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsUint64Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Uint16(m)) != 2 {
+		t.Errorf("TestValsUint64Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]uint16{1: 10}
+	actual := SelectKeysUint64Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Uint16(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Uint16(func(k uint64, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint16{2: 20}
+	actual = DissocKeysUint64Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]uint16{1: 11, 2: 21}
+	actual = MapValsUint64Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint16{10: 10, 20: 20}
+	actual = MapKeysUint64Uint16(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVUint64Uint16(func(acc uint16, k uint64, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Uint16(nil, m)) != 0 || len(MapKeysUint64Uint16(nil, m)) != 0 || len(FilterKVUint64Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Uint8(t *testing.T) {
+	m := map[uint64]uint8{1: 10, 2: 20}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Uint8(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Uint8(m)) != 2 {
+		t.Errorf("TestKeysUint64Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsUint64Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Uint8(m)) != 2 {
+		t.Errorf("TestValsUint64Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]uint8{1: 10}
+	actual := SelectKeysUint64Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Uint8(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Uint8(func(k uint64, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint8{2: 20}
+	actual = DissocKeysUint64Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]uint64{10: 1, 20: 2}
+	actualInverted := InvertUint64Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]uint8{1: 11, 2: 21}
+	actual = MapValsUint64Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint8{10: 10, 20: 20}
+	actual = MapKeysUint64Uint8(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVUint64Uint8(func(acc uint8, k uint64, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Uint8(nil, m)) != 0 || len(MapKeysUint64Uint8(nil, m)) != 0 || len(FilterKVUint64Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Str(t *testing.T) {
+	m := map[uint64]string{1: "x", 2: "y"}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Str(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Str(m)) != 2 {
+		t.Errorf("TestKeysUint64Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsUint64Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Str(m)) != 2 {
+		t.Errorf("TestValsUint64Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]string{1: "x"}
+	actual := SelectKeysUint64Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Str(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Str(func(k uint64, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]string{2: "y"}
+	actual = DissocKeysUint64Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]uint64{"x": 1, "y": 2}
+	actualInverted := InvertUint64Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]string{1: "x!", 2: "y!"}
+	actual = MapValsUint64Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]string{10: "x", 20: "y"}
+	actual = MapKeysUint64Str(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVUint64Str(func(acc string, k uint64, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Str(nil, m)) != 0 || len(MapKeysUint64Str(nil, m)) != 0 || len(FilterKVUint64Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint64Bool(t *testing.T) {
+	m := map[uint64]bool{1: false, 2: true}
+
+	expectedKeys := []uint64{1, 2}
+	actualKeys := KeysUint64Bool(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint64Bool(m)) != 2 {
+		t.Errorf("TestKeysUint64Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsUint64Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint64Bool(m)) != 2 {
+		t.Errorf("TestValsUint64Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint64]bool{1: false}
+	actual := SelectKeysUint64Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint64Bool(func(k uint64) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint64Bool(func(k uint64, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]bool{2: true}
+	actual = DissocKeysUint64Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint64Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]uint64{false: 1, true: 2}
+	actualInverted := InvertUint64Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint64Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint64]bool{1: true, 2: false}
+	actual = MapValsUint64Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]bool{10: false, 20: true}
+	actual = MapKeysUint64Bool(func(k uint64) uint64 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVUint64Bool(func(acc bool, k uint64, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint64Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint64Bool(nil, m)) != 0 || len(MapKeysUint64Bool(nil, m)) != 0 || len(FilterKVUint64Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint64Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Int(t *testing.T) {
+	m := map[uint32]int{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Int(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Int(m)) != 2 {
+		t.Errorf("TestKeysUint32Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsUint32Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Int(m)) != 2 {
+		t.Errorf("TestValsUint32Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]int{1: 10}
+	actual := SelectKeysUint32Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Int(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Int(func(k uint32, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int{2: 20}
+	actual = DissocKeysUint32Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]int{1: 11, 2: 21}
+	actual = MapValsUint32Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int{10: 10, 20: 20}
+	actual = MapKeysUint32Int(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVUint32Int(func(acc int, k uint32, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Int(nil, m)) != 0 || len(MapKeysUint32Int(nil, m)) != 0 || len(FilterKVUint32Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Int64(t *testing.T) {
+	m := map[uint32]int64{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Int64(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Int64(m)) != 2 {
+		t.Errorf("TestKeysUint32Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsUint32Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Int64(m)) != 2 {
+		t.Errorf("TestValsUint32Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]int64{1: 10}
+	actual := SelectKeysUint32Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Int64(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Int64(func(k uint32, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int64{2: 20}
+	actual = DissocKeysUint32Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]int64{1: 11, 2: 21}
+	actual = MapValsUint32Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int64{10: 10, 20: 20}
+	actual = MapKeysUint32Int64(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVUint32Int64(func(acc int64, k uint32, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Int64(nil, m)) != 0 || len(MapKeysUint32Int64(nil, m)) != 0 || len(FilterKVUint32Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Int32(t *testing.T) {
+	m := map[uint32]int32{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Int32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Int32(m)) != 2 {
+		t.Errorf("TestKeysUint32Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsUint32Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Int32(m)) != 2 {
+		t.Errorf("TestValsUint32Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]int32{1: 10}
+	actual := SelectKeysUint32Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Int32(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Int32(func(k uint32, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int32{2: 20}
+	actual = DissocKeysUint32Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]int32{1: 11, 2: 21}
+	actual = MapValsUint32Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int32{10: 10, 20: 20}
+	actual = MapKeysUint32Int32(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVUint32Int32(func(acc int32, k uint32, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Int32(nil, m)) != 0 || len(MapKeysUint32Int32(nil, m)) != 0 || len(FilterKVUint32Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Int16(t *testing.T) {
+	m := map[uint32]int16{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Int16(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Int16(m)) != 2 {
+		t.Errorf("TestKeysUint32Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsUint32Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Int16(m)) != 2 {
+		t.Errorf("TestValsUint32Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]int16{1: 10}
+	actual := SelectKeysUint32Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Int16(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Int16(func(k uint32, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int16{2: 20}
+	actual = DissocKeysUint32Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]int16{1: 11, 2: 21}
+	actual = MapValsUint32Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int16{10: 10, 20: 20}
+	actual = MapKeysUint32Int16(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVUint32Int16(func(acc int16, k uint32, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Int16(nil, m)) != 0 || len(MapKeysUint32Int16(nil, m)) != 0 || len(FilterKVUint32Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Int8(t *testing.T) {
+	m := map[uint32]int8{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Int8(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Int8(m)) != 2 {
+		t.Errorf("TestKeysUint32Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsUint32Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Int8(m)) != 2 {
+		t.Errorf("TestValsUint32Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]int8{1: 10}
+	actual := SelectKeysUint32Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Int8(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Int8(func(k uint32, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int8{2: 20}
+	actual = DissocKeysUint32Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]int8{1: 11, 2: 21}
+	actual = MapValsUint32Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int8{10: 10, 20: 20}
+	actual = MapKeysUint32Int8(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVUint32Int8(func(acc int8, k uint32, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Int8(nil, m)) != 0 || len(MapKeysUint32Int8(nil, m)) != 0 || len(FilterKVUint32Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Uint(t *testing.T) {
+	m := map[uint32]uint{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Uint(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Uint(m)) != 2 {
+		t.Errorf("TestKeysUint32Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsUint32Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Uint(m)) != 2 {
+		t.Errorf("TestValsUint32Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]uint{1: 10}
+	actual := SelectKeysUint32Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Uint(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Uint(func(k uint32, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint{2: 20}
+	actual = DissocKeysUint32Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]uint{1: 11, 2: 21}
+	actual = MapValsUint32Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint{10: 10, 20: 20}
+	actual = MapKeysUint32Uint(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVUint32Uint(func(acc uint, k uint32, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Uint(nil, m)) != 0 || len(MapKeysUint32Uint(nil, m)) != 0 || len(FilterKVUint32Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Uint64(t *testing.T) {
+	m := map[uint32]uint64{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Uint64(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Uint64(m)) != 2 {
+		t.Errorf("TestKeysUint32Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsUint32Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Uint64(m)) != 2 {
+		t.Errorf("TestValsUint32Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]uint64{1: 10}
+	actual := SelectKeysUint32Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Uint64(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Uint64(func(k uint32, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint64{2: 20}
+	actual = DissocKeysUint32Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]uint64{1: 11, 2: 21}
+	actual = MapValsUint32Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint64{10: 10, 20: 20}
+	actual = MapKeysUint32Uint64(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVUint32Uint64(func(acc uint64, k uint32, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Uint64(nil, m)) != 0 || len(MapKeysUint32Uint64(nil, m)) != 0 || len(FilterKVUint32Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32(t *testing.T) {
+	m := map[uint32]uint32{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32(m)) != 2 {
+		t.Errorf("TestKeysUint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32(m)) != 2 {
+		t.Errorf("TestValsUint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]uint32{1: 10}
+	actual := SelectKeysUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32(func(k uint32, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint32{2: 20}
+	actual = DissocKeysUint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]uint32{1: 11, 2: 21}
+	actual = MapValsUint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint32{10: 10, 20: 20}
+	actual = MapKeysUint32(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVUint32(func(acc uint32, k uint32, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32(nil, m)) != 0 || len(MapKeysUint32(nil, m)) != 0 || len(FilterKVUint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Uint16(t *testing.T) {
+	m := map[uint32]uint16{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Uint16(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Uint16(m)) != 2 {
+		t.Errorf("TestKeysUint32Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsUint32Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Uint16(m)) != 2 {
+		t.Errorf("TestValsUint32Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]uint16{1: 10}
+	actual := SelectKeysUint32Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Uint16(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Uint16(func(k uint32, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint16{2: 20}
+	actual = DissocKeysUint32Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]uint16{1: 11, 2: 21}
+	actual = MapValsUint32Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint16{10: 10, 20: 20}
+	actual = MapKeysUint32Uint16(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVUint32Uint16(func(acc uint16, k uint32, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Uint16(nil, m)) != 0 || len(MapKeysUint32Uint16(nil, m)) != 0 || len(FilterKVUint32Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Uint8(t *testing.T) {
+	m := map[uint32]uint8{1: 10, 2: 20}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Uint8(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Uint8(m)) != 2 {
+		t.Errorf("TestKeysUint32Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsUint32Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Uint8(m)) != 2 {
+		t.Errorf("TestValsUint32Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]uint8{1: 10}
+	actual := SelectKeysUint32Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Uint8(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Uint8(func(k uint32, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint8{2: 20}
+	actual = DissocKeysUint32Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]uint32{10: 1, 20: 2}
+	actualInverted := InvertUint32Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]uint8{1: 11, 2: 21}
+	actual = MapValsUint32Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint8{10: 10, 20: 20}
+	actual = MapKeysUint32Uint8(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVUint32Uint8(func(acc uint8, k uint32, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Uint8(nil, m)) != 0 || len(MapKeysUint32Uint8(nil, m)) != 0 || len(FilterKVUint32Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Str(t *testing.T) {
+	m := map[uint32]string{1: "x", 2: "y"}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Str(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Str(m)) != 2 {
+		t.Errorf("TestKeysUint32Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsUint32Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Str(m)) != 2 {
+		t.Errorf("TestValsUint32Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]string{1: "x"}
+	actual := SelectKeysUint32Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Str(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Str(func(k uint32, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]string{2: "y"}
+	actual = DissocKeysUint32Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]uint32{"x": 1, "y": 2}
+	actualInverted := InvertUint32Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]string{1: "x!", 2: "y!"}
+	actual = MapValsUint32Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]string{10: "x", 20: "y"}
+	actual = MapKeysUint32Str(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVUint32Str(func(acc string, k uint32, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Str(nil, m)) != 0 || len(MapKeysUint32Str(nil, m)) != 0 || len(FilterKVUint32Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint32Bool(t *testing.T) {
+	m := map[uint32]bool{1: false, 2: true}
+
+	expectedKeys := []uint32{1, 2}
+	actualKeys := KeysUint32Bool(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint32Bool(m)) != 2 {
+		t.Errorf("TestKeysUint32Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsUint32Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint32Bool(m)) != 2 {
+		t.Errorf("TestValsUint32Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint32]bool{1: false}
+	actual := SelectKeysUint32Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint32Bool(func(k uint32) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint32Bool(func(k uint32, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]bool{2: true}
+	actual = DissocKeysUint32Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint32Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]uint32{false: 1, true: 2}
+	actualInverted := InvertUint32Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint32Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint32]bool{1: true, 2: false}
+	actual = MapValsUint32Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]bool{10: false, 20: true}
+	actual = MapKeysUint32Bool(func(k uint32) uint32 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVUint32Bool(func(acc bool, k uint32, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint32Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint32Bool(nil, m)) != 0 || len(MapKeysUint32Bool(nil, m)) != 0 || len(FilterKVUint32Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint32Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Int(t *testing.T) {
+	m := map[uint16]int{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Int(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Int(m)) != 2 {
+		t.Errorf("TestKeysUint16Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsUint16Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Int(m)) != 2 {
+		t.Errorf("TestValsUint16Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]int{1: 10}
+	actual := SelectKeysUint16Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Int(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Int(func(k uint16, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int{2: 20}
+	actual = DissocKeysUint16Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]int{1: 11, 2: 21}
+	actual = MapValsUint16Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int{10: 10, 20: 20}
+	actual = MapKeysUint16Int(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVUint16Int(func(acc int, k uint16, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Int(nil, m)) != 0 || len(MapKeysUint16Int(nil, m)) != 0 || len(FilterKVUint16Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Int64(t *testing.T) {
+	m := map[uint16]int64{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Int64(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Int64(m)) != 2 {
+		t.Errorf("TestKeysUint16Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsUint16Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Int64(m)) != 2 {
+		t.Errorf("TestValsUint16Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]int64{1: 10}
+	actual := SelectKeysUint16Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Int64(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Int64(func(k uint16, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int64{2: 20}
+	actual = DissocKeysUint16Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]int64{1: 11, 2: 21}
+	actual = MapValsUint16Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int64{10: 10, 20: 20}
+	actual = MapKeysUint16Int64(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVUint16Int64(func(acc int64, k uint16, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Int64(nil, m)) != 0 || len(MapKeysUint16Int64(nil, m)) != 0 || len(FilterKVUint16Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Int32(t *testing.T) {
+	m := map[uint16]int32{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Int32(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Int32(m)) != 2 {
+		t.Errorf("TestKeysUint16Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsUint16Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Int32(m)) != 2 {
+		t.Errorf("TestValsUint16Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]int32{1: 10}
+	actual := SelectKeysUint16Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Int32(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Int32(func(k uint16, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int32{2: 20}
+	actual = DissocKeysUint16Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]int32{1: 11, 2: 21}
+	actual = MapValsUint16Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int32{10: 10, 20: 20}
+	actual = MapKeysUint16Int32(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVUint16Int32(func(acc int32, k uint16, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Int32(nil, m)) != 0 || len(MapKeysUint16Int32(nil, m)) != 0 || len(FilterKVUint16Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Int16(t *testing.T) {
+	m := map[uint16]int16{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Int16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Int16(m)) != 2 {
+		t.Errorf("TestKeysUint16Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsUint16Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Int16(m)) != 2 {
+		t.Errorf("TestValsUint16Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]int16{1: 10}
+	actual := SelectKeysUint16Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Int16(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Int16(func(k uint16, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int16{2: 20}
+	actual = DissocKeysUint16Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]int16{1: 11, 2: 21}
+	actual = MapValsUint16Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int16{10: 10, 20: 20}
+	actual = MapKeysUint16Int16(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVUint16Int16(func(acc int16, k uint16, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Int16(nil, m)) != 0 || len(MapKeysUint16Int16(nil, m)) != 0 || len(FilterKVUint16Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Int8(t *testing.T) {
+	m := map[uint16]int8{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Int8(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Int8(m)) != 2 {
+		t.Errorf("TestKeysUint16Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsUint16Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Int8(m)) != 2 {
+		t.Errorf("TestValsUint16Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]int8{1: 10}
+	actual := SelectKeysUint16Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Int8(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Int8(func(k uint16, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int8{2: 20}
+	actual = DissocKeysUint16Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]int8{1: 11, 2: 21}
+	actual = MapValsUint16Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int8{10: 10, 20: 20}
+	actual = MapKeysUint16Int8(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVUint16Int8(func(acc int8, k uint16, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Int8(nil, m)) != 0 || len(MapKeysUint16Int8(nil, m)) != 0 || len(FilterKVUint16Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Uint(t *testing.T) {
+	m := map[uint16]uint{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Uint(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Uint(m)) != 2 {
+		t.Errorf("TestKeysUint16Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsUint16Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Uint(m)) != 2 {
+		t.Errorf("TestValsUint16Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]uint{1: 10}
+	actual := SelectKeysUint16Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Uint(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Uint(func(k uint16, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint{2: 20}
+	actual = DissocKeysUint16Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]uint{1: 11, 2: 21}
+	actual = MapValsUint16Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint{10: 10, 20: 20}
+	actual = MapKeysUint16Uint(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVUint16Uint(func(acc uint, k uint16, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Uint(nil, m)) != 0 || len(MapKeysUint16Uint(nil, m)) != 0 || len(FilterKVUint16Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Uint64(t *testing.T) {
+	m := map[uint16]uint64{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Uint64(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Uint64(m)) != 2 {
+		t.Errorf("TestKeysUint16Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsUint16Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Uint64(m)) != 2 {
+		t.Errorf("TestValsUint16Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]uint64{1: 10}
+	actual := SelectKeysUint16Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Uint64(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Uint64(func(k uint16, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint64{2: 20}
+	actual = DissocKeysUint16Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]uint64{1: 11, 2: 21}
+	actual = MapValsUint16Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint64{10: 10, 20: 20}
+	actual = MapKeysUint16Uint64(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVUint16Uint64(func(acc uint64, k uint16, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Uint64(nil, m)) != 0 || len(MapKeysUint16Uint64(nil, m)) != 0 || len(FilterKVUint16Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Uint32(t *testing.T) {
+	m := map[uint16]uint32{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Uint32(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Uint32(m)) != 2 {
+		t.Errorf("TestKeysUint16Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsUint16Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Uint32(m)) != 2 {
+		t.Errorf("TestValsUint16Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]uint32{1: 10}
+	actual := SelectKeysUint16Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Uint32(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Uint32(func(k uint16, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint32{2: 20}
+	actual = DissocKeysUint16Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]uint32{1: 11, 2: 21}
+	actual = MapValsUint16Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint32{10: 10, 20: 20}
+	actual = MapKeysUint16Uint32(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVUint16Uint32(func(acc uint32, k uint16, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Uint32(nil, m)) != 0 || len(MapKeysUint16Uint32(nil, m)) != 0 || len(FilterKVUint16Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16(t *testing.T) {
+	m := map[uint16]uint16{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16(m)) != 2 {
+		t.Errorf("TestKeysUint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16(m)) != 2 {
+		t.Errorf("TestValsUint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]uint16{1: 10}
+	actual := SelectKeysUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16(func(k uint16, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint16{2: 20}
+	actual = DissocKeysUint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]uint16{1: 11, 2: 21}
+	actual = MapValsUint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint16{10: 10, 20: 20}
+	actual = MapKeysUint16(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVUint16(func(acc uint16, k uint16, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16(nil, m)) != 0 || len(MapKeysUint16(nil, m)) != 0 || len(FilterKVUint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Uint8(t *testing.T) {
+	m := map[uint16]uint8{1: 10, 2: 20}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Uint8(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Uint8(m)) != 2 {
+		t.Errorf("TestKeysUint16Uint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsUint16Uint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Uint8(m)) != 2 {
+		t.Errorf("TestValsUint16Uint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]uint8{1: 10}
+	actual := SelectKeysUint16Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Uint8(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Uint8(func(k uint16, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint8{2: 20}
+	actual = DissocKeysUint16Uint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Uint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]uint16{10: 1, 20: 2}
+	actualInverted := InvertUint16Uint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Uint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]uint8{1: 11, 2: 21}
+	actual = MapValsUint16Uint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint8{10: 10, 20: 20}
+	actual = MapKeysUint16Uint8(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVUint16Uint8(func(acc uint8, k uint16, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Uint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Uint8(nil, m)) != 0 || len(MapKeysUint16Uint8(nil, m)) != 0 || len(FilterKVUint16Uint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Uint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Str(t *testing.T) {
+	m := map[uint16]string{1: "x", 2: "y"}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Str(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Str(m)) != 2 {
+		t.Errorf("TestKeysUint16Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsUint16Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Str(m)) != 2 {
+		t.Errorf("TestValsUint16Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]string{1: "x"}
+	actual := SelectKeysUint16Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Str(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Str(func(k uint16, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]string{2: "y"}
+	actual = DissocKeysUint16Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]uint16{"x": 1, "y": 2}
+	actualInverted := InvertUint16Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]string{1: "x!", 2: "y!"}
+	actual = MapValsUint16Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]string{10: "x", 20: "y"}
+	actual = MapKeysUint16Str(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVUint16Str(func(acc string, k uint16, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Str(nil, m)) != 0 || len(MapKeysUint16Str(nil, m)) != 0 || len(FilterKVUint16Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint16Bool(t *testing.T) {
+	m := map[uint16]bool{1: false, 2: true}
+
+	expectedKeys := []uint16{1, 2}
+	actualKeys := KeysUint16Bool(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint16Bool(m)) != 2 {
+		t.Errorf("TestKeysUint16Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsUint16Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint16Bool(m)) != 2 {
+		t.Errorf("TestValsUint16Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint16]bool{1: false}
+	actual := SelectKeysUint16Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint16Bool(func(k uint16) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint16Bool(func(k uint16, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]bool{2: true}
+	actual = DissocKeysUint16Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint16Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]uint16{false: 1, true: 2}
+	actualInverted := InvertUint16Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint16Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint16]bool{1: true, 2: false}
+	actual = MapValsUint16Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]bool{10: false, 20: true}
+	actual = MapKeysUint16Bool(func(k uint16) uint16 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVUint16Bool(func(acc bool, k uint16, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint16Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint16Bool(nil, m)) != 0 || len(MapKeysUint16Bool(nil, m)) != 0 || len(FilterKVUint16Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint16Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Int(t *testing.T) {
+	m := map[uint8]int{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Int(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Int(m)) != 2 {
+		t.Errorf("TestKeysUint8Int failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsUint8Int(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Int(m)) != 2 {
+		t.Errorf("TestValsUint8Int failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]int{1: 10}
+	actual := SelectKeysUint8Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Int(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Int(func(k uint8, v int) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int{2: 20}
+	actual = DissocKeysUint8Int(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Int(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Int(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Int failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]int{1: 11, 2: 21}
+	actual = MapValsUint8Int(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int{10: 10, 20: 20}
+	actual = MapKeysUint8Int(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVUint8Int(func(acc int, k uint8, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Int failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Int(nil, m)) != 0 || len(MapKeysUint8Int(nil, m)) != 0 || len(FilterKVUint8Int(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Int failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Int64(t *testing.T) {
+	m := map[uint8]int64{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Int64(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Int64(m)) != 2 {
+		t.Errorf("TestKeysUint8Int64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsUint8Int64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Int64(m)) != 2 {
+		t.Errorf("TestValsUint8Int64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]int64{1: 10}
+	actual := SelectKeysUint8Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Int64(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Int64(func(k uint8, v int64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int64{2: 20}
+	actual = DissocKeysUint8Int64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Int64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Int64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Int64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]int64{1: 11, 2: 21}
+	actual = MapValsUint8Int64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int64{10: 10, 20: 20}
+	actual = MapKeysUint8Int64(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVUint8Int64(func(acc int64, k uint8, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Int64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Int64(nil, m)) != 0 || len(MapKeysUint8Int64(nil, m)) != 0 || len(FilterKVUint8Int64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Int64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Int32(t *testing.T) {
+	m := map[uint8]int32{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Int32(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Int32(m)) != 2 {
+		t.Errorf("TestKeysUint8Int32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsUint8Int32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Int32(m)) != 2 {
+		t.Errorf("TestValsUint8Int32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]int32{1: 10}
+	actual := SelectKeysUint8Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Int32(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Int32(func(k uint8, v int32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int32{2: 20}
+	actual = DissocKeysUint8Int32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Int32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Int32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Int32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]int32{1: 11, 2: 21}
+	actual = MapValsUint8Int32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int32{10: 10, 20: 20}
+	actual = MapKeysUint8Int32(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVUint8Int32(func(acc int32, k uint8, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Int32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Int32(nil, m)) != 0 || len(MapKeysUint8Int32(nil, m)) != 0 || len(FilterKVUint8Int32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Int32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Int16(t *testing.T) {
+	m := map[uint8]int16{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Int16(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Int16(m)) != 2 {
+		t.Errorf("TestKeysUint8Int16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsUint8Int16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Int16(m)) != 2 {
+		t.Errorf("TestValsUint8Int16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]int16{1: 10}
+	actual := SelectKeysUint8Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Int16(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Int16(func(k uint8, v int16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int16{2: 20}
+	actual = DissocKeysUint8Int16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Int16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Int16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Int16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]int16{1: 11, 2: 21}
+	actual = MapValsUint8Int16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int16{10: 10, 20: 20}
+	actual = MapKeysUint8Int16(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVUint8Int16(func(acc int16, k uint8, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Int16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Int16(nil, m)) != 0 || len(MapKeysUint8Int16(nil, m)) != 0 || len(FilterKVUint8Int16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Int16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Int8(t *testing.T) {
+	m := map[uint8]int8{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Int8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Int8(m)) != 2 {
+		t.Errorf("TestKeysUint8Int8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsUint8Int8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Int8(m)) != 2 {
+		t.Errorf("TestValsUint8Int8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]int8{1: 10}
+	actual := SelectKeysUint8Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Int8(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Int8(func(k uint8, v int8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int8{2: 20}
+	actual = DissocKeysUint8Int8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Int8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Int8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Int8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]int8{1: 11, 2: 21}
+	actual = MapValsUint8Int8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int8{10: 10, 20: 20}
+	actual = MapKeysUint8Int8(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVUint8Int8(func(acc int8, k uint8, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Int8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Int8(nil, m)) != 0 || len(MapKeysUint8Int8(nil, m)) != 0 || len(FilterKVUint8Int8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Int8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Uint(t *testing.T) {
+	m := map[uint8]uint{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Uint(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Uint(m)) != 2 {
+		t.Errorf("TestKeysUint8Uint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsUint8Uint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Uint(m)) != 2 {
+		t.Errorf("TestValsUint8Uint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]uint{1: 10}
+	actual := SelectKeysUint8Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Uint(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Uint(func(k uint8, v uint) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint{2: 20}
+	actual = DissocKeysUint8Uint(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Uint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Uint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Uint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]uint{1: 11, 2: 21}
+	actual = MapValsUint8Uint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint{10: 10, 20: 20}
+	actual = MapKeysUint8Uint(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVUint8Uint(func(acc uint, k uint8, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Uint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Uint(nil, m)) != 0 || len(MapKeysUint8Uint(nil, m)) != 0 || len(FilterKVUint8Uint(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Uint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Uint64(t *testing.T) {
+	m := map[uint8]uint64{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Uint64(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Uint64(m)) != 2 {
+		t.Errorf("TestKeysUint8Uint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsUint8Uint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Uint64(m)) != 2 {
+		t.Errorf("TestValsUint8Uint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]uint64{1: 10}
+	actual := SelectKeysUint8Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Uint64(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Uint64(func(k uint8, v uint64) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint64{2: 20}
+	actual = DissocKeysUint8Uint64(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Uint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Uint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Uint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]uint64{1: 11, 2: 21}
+	actual = MapValsUint8Uint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint64{10: 10, 20: 20}
+	actual = MapKeysUint8Uint64(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVUint8Uint64(func(acc uint64, k uint8, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Uint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Uint64(nil, m)) != 0 || len(MapKeysUint8Uint64(nil, m)) != 0 || len(FilterKVUint8Uint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Uint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Uint32(t *testing.T) {
+	m := map[uint8]uint32{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Uint32(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Uint32(m)) != 2 {
+		t.Errorf("TestKeysUint8Uint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsUint8Uint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Uint32(m)) != 2 {
+		t.Errorf("TestValsUint8Uint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]uint32{1: 10}
+	actual := SelectKeysUint8Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Uint32(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Uint32(func(k uint8, v uint32) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint32{2: 20}
+	actual = DissocKeysUint8Uint32(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Uint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Uint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Uint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]uint32{1: 11, 2: 21}
+	actual = MapValsUint8Uint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint32{10: 10, 20: 20}
+	actual = MapKeysUint8Uint32(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVUint8Uint32(func(acc uint32, k uint8, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Uint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Uint32(nil, m)) != 0 || len(MapKeysUint8Uint32(nil, m)) != 0 || len(FilterKVUint8Uint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Uint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Uint16(t *testing.T) {
+	m := map[uint8]uint16{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Uint16(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Uint16(m)) != 2 {
+		t.Errorf("TestKeysUint8Uint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsUint8Uint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Uint16(m)) != 2 {
+		t.Errorf("TestValsUint8Uint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]uint16{1: 10}
+	actual := SelectKeysUint8Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Uint16(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Uint16(func(k uint8, v uint16) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint16{2: 20}
+	actual = DissocKeysUint8Uint16(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Uint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8Uint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Uint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]uint16{1: 11, 2: 21}
+	actual = MapValsUint8Uint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint16{10: 10, 20: 20}
+	actual = MapKeysUint8Uint16(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVUint8Uint16(func(acc uint16, k uint8, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Uint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Uint16(nil, m)) != 0 || len(MapKeysUint8Uint16(nil, m)) != 0 || len(FilterKVUint8Uint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Uint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8(t *testing.T) {
+	m := map[uint8]uint8{1: 10, 2: 20}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8(m)) != 2 {
+		t.Errorf("TestKeysUint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8(m)) != 2 {
+		t.Errorf("TestValsUint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]uint8{1: 10}
+	actual := SelectKeysUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8(func(k uint8, v uint8) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint8{2: 20}
+	actual = DissocKeysUint8(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]uint8{10: 1, 20: 2}
+	actualInverted := InvertUint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]uint8{1: 11, 2: 21}
+	actual = MapValsUint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint8{10: 10, 20: 20}
+	actual = MapKeysUint8(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVUint8(func(acc uint8, k uint8, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8(nil, m)) != 0 || len(MapKeysUint8(nil, m)) != 0 || len(FilterKVUint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Str(t *testing.T) {
+	m := map[uint8]string{1: "x", 2: "y"}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Str(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Str(m)) != 2 {
+		t.Errorf("TestKeysUint8Str failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsUint8Str(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Str(m)) != 2 {
+		t.Errorf("TestValsUint8Str failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]string{1: "x"}
+	actual := SelectKeysUint8Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Str(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Str(func(k uint8, v string) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]string{2: "y"}
+	actual = DissocKeysUint8Str(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Str(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]uint8{"x": 1, "y": 2}
+	actualInverted := InvertUint8Str(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Str failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]string{1: "x!", 2: "y!"}
+	actual = MapValsUint8Str(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]string{10: "x", 20: "y"}
+	actual = MapKeysUint8Str(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVUint8Str(func(acc string, k uint8, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Str failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Str(nil, m)) != 0 || len(MapKeysUint8Str(nil, m)) != 0 || len(FilterKVUint8Str(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Str failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVUint8Bool(t *testing.T) {
+	m := map[uint8]bool{1: false, 2: true}
+
+	expectedKeys := []uint8{1, 2}
+	actualKeys := KeysUint8Bool(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysUint8Bool(m)) != 2 {
+		t.Errorf("TestKeysUint8Bool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsUint8Bool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsUint8Bool(m)) != 2 {
+		t.Errorf("TestValsUint8Bool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[uint8]bool{1: false}
+	actual := SelectKeysUint8Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysUint8Bool(func(k uint8) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVUint8Bool(func(k uint8, v bool) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]bool{2: true}
+	actual = DissocKeysUint8Bool(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsUint8Bool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]uint8{false: 1, true: 2}
+	actualInverted := InvertUint8Bool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertUint8Bool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[uint8]bool{1: true, 2: false}
+	actual = MapValsUint8Bool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]bool{10: false, 20: true}
+	actual = MapKeysUint8Bool(func(k uint8) uint8 { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVUint8Bool(func(acc bool, k uint8, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVUint8Bool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsUint8Bool(nil, m)) != 0 || len(MapKeysUint8Bool(nil, m)) != 0 || len(FilterKVUint8Bool(nil, m)) != 0 {
+		t.Errorf("TestMapKVUint8Bool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrInt(t *testing.T) {
+	m := map[string]int{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrInt(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrInt(m)) != 2 {
+		t.Errorf("TestKeysStrInt failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsStrInt(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrInt(m)) != 2 {
+		t.Errorf("TestValsStrInt failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]int{"a": 10}
+	actual := SelectKeysStrInt(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrInt(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrInt(func(k string, v int) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int{"b": 20}
+	actual = DissocKeysStrInt(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrInt(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]string{10: "a", 20: "b"}
+	actualInverted := InvertStrInt(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrInt failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]int{"a": 11, "b": 21}
+	actual = MapValsStrInt(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int{"a!": 10, "b!": 20}
+	actual = MapKeysStrInt(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVStrInt(func(acc int, k string, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrInt failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrInt(nil, m)) != 0 || len(MapKeysStrInt(nil, m)) != 0 || len(FilterKVStrInt(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrInt failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrInt64(t *testing.T) {
+	m := map[string]int64{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrInt64(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrInt64(m)) != 2 {
+		t.Errorf("TestKeysStrInt64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsStrInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrInt64(m)) != 2 {
+		t.Errorf("TestValsStrInt64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]int64{"a": 10}
+	actual := SelectKeysStrInt64(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrInt64(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrInt64(func(k string, v int64) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int64{"b": 20}
+	actual = DissocKeysStrInt64(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrInt64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]string{10: "a", 20: "b"}
+	actualInverted := InvertStrInt64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrInt64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]int64{"a": 11, "b": 21}
+	actual = MapValsStrInt64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int64{"a!": 10, "b!": 20}
+	actual = MapKeysStrInt64(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVStrInt64(func(acc int64, k string, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrInt64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrInt64(nil, m)) != 0 || len(MapKeysStrInt64(nil, m)) != 0 || len(FilterKVStrInt64(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrInt64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrInt32(t *testing.T) {
+	m := map[string]int32{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrInt32(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrInt32(m)) != 2 {
+		t.Errorf("TestKeysStrInt32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsStrInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrInt32(m)) != 2 {
+		t.Errorf("TestValsStrInt32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]int32{"a": 10}
+	actual := SelectKeysStrInt32(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrInt32(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrInt32(func(k string, v int32) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int32{"b": 20}
+	actual = DissocKeysStrInt32(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrInt32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]string{10: "a", 20: "b"}
+	actualInverted := InvertStrInt32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrInt32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]int32{"a": 11, "b": 21}
+	actual = MapValsStrInt32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int32{"a!": 10, "b!": 20}
+	actual = MapKeysStrInt32(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVStrInt32(func(acc int32, k string, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrInt32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrInt32(nil, m)) != 0 || len(MapKeysStrInt32(nil, m)) != 0 || len(FilterKVStrInt32(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrInt32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrInt16(t *testing.T) {
+	m := map[string]int16{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrInt16(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrInt16(m)) != 2 {
+		t.Errorf("TestKeysStrInt16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsStrInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrInt16(m)) != 2 {
+		t.Errorf("TestValsStrInt16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]int16{"a": 10}
+	actual := SelectKeysStrInt16(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrInt16(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrInt16(func(k string, v int16) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int16{"b": 20}
+	actual = DissocKeysStrInt16(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrInt16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]string{10: "a", 20: "b"}
+	actualInverted := InvertStrInt16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrInt16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]int16{"a": 11, "b": 21}
+	actual = MapValsStrInt16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int16{"a!": 10, "b!": 20}
+	actual = MapKeysStrInt16(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVStrInt16(func(acc int16, k string, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrInt16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrInt16(nil, m)) != 0 || len(MapKeysStrInt16(nil, m)) != 0 || len(FilterKVStrInt16(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrInt16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrInt8(t *testing.T) {
+	m := map[string]int8{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrInt8(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrInt8(m)) != 2 {
+		t.Errorf("TestKeysStrInt8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsStrInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrInt8(m)) != 2 {
+		t.Errorf("TestValsStrInt8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]int8{"a": 10}
+	actual := SelectKeysStrInt8(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrInt8(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrInt8(func(k string, v int8) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int8{"b": 20}
+	actual = DissocKeysStrInt8(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrInt8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]string{10: "a", 20: "b"}
+	actualInverted := InvertStrInt8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrInt8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]int8{"a": 11, "b": 21}
+	actual = MapValsStrInt8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int8{"a!": 10, "b!": 20}
+	actual = MapKeysStrInt8(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVStrInt8(func(acc int8, k string, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrInt8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrInt8(nil, m)) != 0 || len(MapKeysStrInt8(nil, m)) != 0 || len(FilterKVStrInt8(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrInt8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrUint(t *testing.T) {
+	m := map[string]uint{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrUint(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrUint(m)) != 2 {
+		t.Errorf("TestKeysStrUint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsStrUint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrUint(m)) != 2 {
+		t.Errorf("TestValsStrUint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]uint{"a": 10}
+	actual := SelectKeysStrUint(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrUint(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrUint(func(k string, v uint) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint{"b": 20}
+	actual = DissocKeysStrUint(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrUint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]string{10: "a", 20: "b"}
+	actualInverted := InvertStrUint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrUint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]uint{"a": 11, "b": 21}
+	actual = MapValsStrUint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint{"a!": 10, "b!": 20}
+	actual = MapKeysStrUint(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVStrUint(func(acc uint, k string, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrUint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrUint(nil, m)) != 0 || len(MapKeysStrUint(nil, m)) != 0 || len(FilterKVStrUint(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrUint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrUint64(t *testing.T) {
+	m := map[string]uint64{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrUint64(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrUint64(m)) != 2 {
+		t.Errorf("TestKeysStrUint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsStrUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrUint64(m)) != 2 {
+		t.Errorf("TestValsStrUint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]uint64{"a": 10}
+	actual := SelectKeysStrUint64(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrUint64(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrUint64(func(k string, v uint64) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint64{"b": 20}
+	actual = DissocKeysStrUint64(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrUint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]string{10: "a", 20: "b"}
+	actualInverted := InvertStrUint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrUint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]uint64{"a": 11, "b": 21}
+	actual = MapValsStrUint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint64{"a!": 10, "b!": 20}
+	actual = MapKeysStrUint64(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVStrUint64(func(acc uint64, k string, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrUint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrUint64(nil, m)) != 0 || len(MapKeysStrUint64(nil, m)) != 0 || len(FilterKVStrUint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrUint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrUint32(t *testing.T) {
+	m := map[string]uint32{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrUint32(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrUint32(m)) != 2 {
+		t.Errorf("TestKeysStrUint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsStrUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrUint32(m)) != 2 {
+		t.Errorf("TestValsStrUint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]uint32{"a": 10}
+	actual := SelectKeysStrUint32(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrUint32(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrUint32(func(k string, v uint32) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint32{"b": 20}
+	actual = DissocKeysStrUint32(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrUint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]string{10: "a", 20: "b"}
+	actualInverted := InvertStrUint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrUint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]uint32{"a": 11, "b": 21}
+	actual = MapValsStrUint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint32{"a!": 10, "b!": 20}
+	actual = MapKeysStrUint32(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVStrUint32(func(acc uint32, k string, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrUint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrUint32(nil, m)) != 0 || len(MapKeysStrUint32(nil, m)) != 0 || len(FilterKVStrUint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrUint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrUint16(t *testing.T) {
+	m := map[string]uint16{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrUint16(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrUint16(m)) != 2 {
+		t.Errorf("TestKeysStrUint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsStrUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrUint16(m)) != 2 {
+		t.Errorf("TestValsStrUint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]uint16{"a": 10}
+	actual := SelectKeysStrUint16(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrUint16(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrUint16(func(k string, v uint16) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint16{"b": 20}
+	actual = DissocKeysStrUint16(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrUint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]string{10: "a", 20: "b"}
+	actualInverted := InvertStrUint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrUint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]uint16{"a": 11, "b": 21}
+	actual = MapValsStrUint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint16{"a!": 10, "b!": 20}
+	actual = MapKeysStrUint16(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVStrUint16(func(acc uint16, k string, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrUint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrUint16(nil, m)) != 0 || len(MapKeysStrUint16(nil, m)) != 0 || len(FilterKVStrUint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrUint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrUint8(t *testing.T) {
+	m := map[string]uint8{"a": 10, "b": 20}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrUint8(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrUint8(m)) != 2 {
+		t.Errorf("TestKeysStrUint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsStrUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrUint8(m)) != 2 {
+		t.Errorf("TestValsStrUint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]uint8{"a": 10}
+	actual := SelectKeysStrUint8(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrUint8(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrUint8(func(k string, v uint8) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint8{"b": 20}
+	actual = DissocKeysStrUint8(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrUint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]string{10: "a", 20: "b"}
+	actualInverted := InvertStrUint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrUint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]uint8{"a": 11, "b": 21}
+	actual = MapValsStrUint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint8{"a!": 10, "b!": 20}
+	actual = MapKeysStrUint8(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVStrUint8(func(acc uint8, k string, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrUint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrUint8(nil, m)) != 0 || len(MapKeysStrUint8(nil, m)) != 0 || len(FilterKVStrUint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrUint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStr(t *testing.T) {
+	m := map[string]string{"a": "x", "b": "y"}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStr(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStr(m)) != 2 {
+		t.Errorf("TestKeysStr failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsStr(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStr(m)) != 2 {
+		t.Errorf("TestValsStr failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]string{"a": "x"}
+	actual := SelectKeysStr(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStr(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStr(func(k string, v string) bool { return k == "a" && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]string{"b": "y"}
+	actual = DissocKeysStr(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStr(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]string{"x": "a", "y": "b"}
+	actualInverted := InvertStr(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStr failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]string{"a": "x!", "b": "y!"}
+	actual = MapValsStr(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]string{"a!": "x", "b!": "y"}
+	actual = MapKeysStr(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVStr(func(acc string, k string, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStr failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStr(nil, m)) != 0 || len(MapKeysStr(nil, m)) != 0 || len(FilterKVStr(nil, m)) != 0 {
+		t.Errorf("TestMapKVStr failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVStrBool(t *testing.T) {
+	m := map[string]bool{"a": false, "b": true}
+
+	expectedKeys := []string{"a", "b"}
+	actualKeys := KeysStrBool(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysStrBool(m)) != 2 {
+		t.Errorf("TestKeysStrBool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsStrBool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsStrBool(m)) != 2 {
+		t.Errorf("TestValsStrBool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[string]bool{"a": false}
+	actual := SelectKeysStrBool(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysStrBool(func(k string) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVStrBool(func(k string, v bool) bool { return k == "a" && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]bool{"b": true}
+	actual = DissocKeysStrBool(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsStrBool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]string{false: "a", true: "b"}
+	actualInverted := InvertStrBool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertStrBool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[string]bool{"a": true, "b": false}
+	actual = MapValsStrBool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]bool{"a!": false, "b!": true}
+	actual = MapKeysStrBool(func(k string) string { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVStrBool(func(acc bool, k string, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVStrBool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsStrBool(nil, m)) != 0 || len(MapKeysStrBool(nil, m)) != 0 || len(FilterKVStrBool(nil, m)) != 0 {
+		t.Errorf("TestMapKVStrBool failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolInt(t *testing.T) {
+	m := map[bool]int{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolInt(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolInt(m)) != 2 {
+		t.Errorf("TestKeysBoolInt failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int{10, 20}
+	actualVals := ValsBoolInt(m, func(a, b int) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolInt(m)) != 2 {
+		t.Errorf("TestValsBoolInt failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]int{false: 10}
+	actual := SelectKeysBoolInt(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolInt(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolInt(func(k bool, v int) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int{true: 20}
+	actual = DissocKeysBoolInt(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolInt(func(v int) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int]bool{10: false, 20: true}
+	actualInverted := InvertBoolInt(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolInt failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]int{false: 11, true: 21}
+	actual = MapValsBoolInt(func(v int) int { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int{true: 10, false: 20}
+	actual = MapKeysBoolInt(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int(30)
+	actualReduced := ReduceKVBoolInt(func(acc int, k bool, v int) int {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolInt failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolInt(nil, m)) != 0 || len(MapKeysBoolInt(nil, m)) != 0 || len(FilterKVBoolInt(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolInt failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolInt64(t *testing.T) {
+	m := map[bool]int64{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolInt64(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolInt64(m)) != 2 {
+		t.Errorf("TestKeysBoolInt64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int64{10, 20}
+	actualVals := ValsBoolInt64(m, func(a, b int64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolInt64(m)) != 2 {
+		t.Errorf("TestValsBoolInt64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]int64{false: 10}
+	actual := SelectKeysBoolInt64(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolInt64(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolInt64(func(k bool, v int64) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int64{true: 20}
+	actual = DissocKeysBoolInt64(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolInt64(func(v int64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int64]bool{10: false, 20: true}
+	actualInverted := InvertBoolInt64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolInt64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]int64{false: 11, true: 21}
+	actual = MapValsBoolInt64(func(v int64) int64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int64{true: 10, false: 20}
+	actual = MapKeysBoolInt64(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int64(30)
+	actualReduced := ReduceKVBoolInt64(func(acc int64, k bool, v int64) int64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolInt64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolInt64(nil, m)) != 0 || len(MapKeysBoolInt64(nil, m)) != 0 || len(FilterKVBoolInt64(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolInt64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolInt32(t *testing.T) {
+	m := map[bool]int32{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolInt32(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolInt32(m)) != 2 {
+		t.Errorf("TestKeysBoolInt32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int32{10, 20}
+	actualVals := ValsBoolInt32(m, func(a, b int32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolInt32(m)) != 2 {
+		t.Errorf("TestValsBoolInt32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]int32{false: 10}
+	actual := SelectKeysBoolInt32(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolInt32(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolInt32(func(k bool, v int32) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int32{true: 20}
+	actual = DissocKeysBoolInt32(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolInt32(func(v int32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int32]bool{10: false, 20: true}
+	actualInverted := InvertBoolInt32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolInt32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]int32{false: 11, true: 21}
+	actual = MapValsBoolInt32(func(v int32) int32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int32{true: 10, false: 20}
+	actual = MapKeysBoolInt32(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int32(30)
+	actualReduced := ReduceKVBoolInt32(func(acc int32, k bool, v int32) int32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolInt32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolInt32(nil, m)) != 0 || len(MapKeysBoolInt32(nil, m)) != 0 || len(FilterKVBoolInt32(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolInt32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolInt16(t *testing.T) {
+	m := map[bool]int16{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolInt16(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolInt16(m)) != 2 {
+		t.Errorf("TestKeysBoolInt16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int16{10, 20}
+	actualVals := ValsBoolInt16(m, func(a, b int16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolInt16(m)) != 2 {
+		t.Errorf("TestValsBoolInt16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]int16{false: 10}
+	actual := SelectKeysBoolInt16(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolInt16(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolInt16(func(k bool, v int16) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int16{true: 20}
+	actual = DissocKeysBoolInt16(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolInt16(func(v int16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int16]bool{10: false, 20: true}
+	actualInverted := InvertBoolInt16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolInt16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]int16{false: 11, true: 21}
+	actual = MapValsBoolInt16(func(v int16) int16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int16{true: 10, false: 20}
+	actual = MapKeysBoolInt16(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int16(30)
+	actualReduced := ReduceKVBoolInt16(func(acc int16, k bool, v int16) int16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolInt16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolInt16(nil, m)) != 0 || len(MapKeysBoolInt16(nil, m)) != 0 || len(FilterKVBoolInt16(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolInt16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolInt8(t *testing.T) {
+	m := map[bool]int8{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolInt8(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolInt8(m)) != 2 {
+		t.Errorf("TestKeysBoolInt8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []int8{10, 20}
+	actualVals := ValsBoolInt8(m, func(a, b int8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolInt8(m)) != 2 {
+		t.Errorf("TestValsBoolInt8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]int8{false: 10}
+	actual := SelectKeysBoolInt8(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolInt8(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolInt8(func(k bool, v int8) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int8{true: 20}
+	actual = DissocKeysBoolInt8(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolInt8(func(v int8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[int8]bool{10: false, 20: true}
+	actualInverted := InvertBoolInt8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolInt8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]int8{false: 11, true: 21}
+	actual = MapValsBoolInt8(func(v int8) int8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int8{true: 10, false: 20}
+	actual = MapKeysBoolInt8(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := int8(30)
+	actualReduced := ReduceKVBoolInt8(func(acc int8, k bool, v int8) int8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolInt8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolInt8(nil, m)) != 0 || len(MapKeysBoolInt8(nil, m)) != 0 || len(FilterKVBoolInt8(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolInt8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolUint(t *testing.T) {
+	m := map[bool]uint{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolUint(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolUint(m)) != 2 {
+		t.Errorf("TestKeysBoolUint failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint{10, 20}
+	actualVals := ValsBoolUint(m, func(a, b uint) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolUint(m)) != 2 {
+		t.Errorf("TestValsBoolUint failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]uint{false: 10}
+	actual := SelectKeysBoolUint(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolUint(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolUint(func(k bool, v uint) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint{true: 20}
+	actual = DissocKeysBoolUint(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolUint(func(v uint) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint]bool{10: false, 20: true}
+	actualInverted := InvertBoolUint(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolUint failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]uint{false: 11, true: 21}
+	actual = MapValsBoolUint(func(v uint) uint { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint{true: 10, false: 20}
+	actual = MapKeysBoolUint(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint(30)
+	actualReduced := ReduceKVBoolUint(func(acc uint, k bool, v uint) uint {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolUint failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolUint(nil, m)) != 0 || len(MapKeysBoolUint(nil, m)) != 0 || len(FilterKVBoolUint(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolUint failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolUint64(t *testing.T) {
+	m := map[bool]uint64{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolUint64(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolUint64(m)) != 2 {
+		t.Errorf("TestKeysBoolUint64 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint64{10, 20}
+	actualVals := ValsBoolUint64(m, func(a, b uint64) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolUint64(m)) != 2 {
+		t.Errorf("TestValsBoolUint64 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]uint64{false: 10}
+	actual := SelectKeysBoolUint64(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolUint64(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolUint64(func(k bool, v uint64) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint64{true: 20}
+	actual = DissocKeysBoolUint64(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolUint64(func(v uint64) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint64]bool{10: false, 20: true}
+	actualInverted := InvertBoolUint64(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolUint64 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]uint64{false: 11, true: 21}
+	actual = MapValsBoolUint64(func(v uint64) uint64 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint64{true: 10, false: 20}
+	actual = MapKeysBoolUint64(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint64(30)
+	actualReduced := ReduceKVBoolUint64(func(acc uint64, k bool, v uint64) uint64 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolUint64 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolUint64(nil, m)) != 0 || len(MapKeysBoolUint64(nil, m)) != 0 || len(FilterKVBoolUint64(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolUint64 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolUint32(t *testing.T) {
+	m := map[bool]uint32{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolUint32(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolUint32(m)) != 2 {
+		t.Errorf("TestKeysBoolUint32 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint32{10, 20}
+	actualVals := ValsBoolUint32(m, func(a, b uint32) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolUint32(m)) != 2 {
+		t.Errorf("TestValsBoolUint32 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]uint32{false: 10}
+	actual := SelectKeysBoolUint32(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolUint32(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolUint32(func(k bool, v uint32) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint32{true: 20}
+	actual = DissocKeysBoolUint32(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolUint32(func(v uint32) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint32]bool{10: false, 20: true}
+	actualInverted := InvertBoolUint32(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolUint32 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]uint32{false: 11, true: 21}
+	actual = MapValsBoolUint32(func(v uint32) uint32 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint32{true: 10, false: 20}
+	actual = MapKeysBoolUint32(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint32(30)
+	actualReduced := ReduceKVBoolUint32(func(acc uint32, k bool, v uint32) uint32 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolUint32 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolUint32(nil, m)) != 0 || len(MapKeysBoolUint32(nil, m)) != 0 || len(FilterKVBoolUint32(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolUint32 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolUint16(t *testing.T) {
+	m := map[bool]uint16{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolUint16(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolUint16(m)) != 2 {
+		t.Errorf("TestKeysBoolUint16 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint16{10, 20}
+	actualVals := ValsBoolUint16(m, func(a, b uint16) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolUint16(m)) != 2 {
+		t.Errorf("TestValsBoolUint16 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]uint16{false: 10}
+	actual := SelectKeysBoolUint16(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolUint16(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolUint16(func(k bool, v uint16) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint16{true: 20}
+	actual = DissocKeysBoolUint16(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolUint16(func(v uint16) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint16]bool{10: false, 20: true}
+	actualInverted := InvertBoolUint16(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolUint16 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]uint16{false: 11, true: 21}
+	actual = MapValsBoolUint16(func(v uint16) uint16 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint16{true: 10, false: 20}
+	actual = MapKeysBoolUint16(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint16(30)
+	actualReduced := ReduceKVBoolUint16(func(acc uint16, k bool, v uint16) uint16 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolUint16 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolUint16(nil, m)) != 0 || len(MapKeysBoolUint16(nil, m)) != 0 || len(FilterKVBoolUint16(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolUint16 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolUint8(t *testing.T) {
+	m := map[bool]uint8{false: 10, true: 20}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolUint8(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolUint8(m)) != 2 {
+		t.Errorf("TestKeysBoolUint8 failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []uint8{10, 20}
+	actualVals := ValsBoolUint8(m, func(a, b uint8) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolUint8(m)) != 2 {
+		t.Errorf("TestValsBoolUint8 failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]uint8{false: 10}
+	actual := SelectKeysBoolUint8(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolUint8(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolUint8(func(k bool, v uint8) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint8{true: 20}
+	actual = DissocKeysBoolUint8(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolUint8(func(v uint8) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[uint8]bool{10: false, 20: true}
+	actualInverted := InvertBoolUint8(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolUint8 failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]uint8{false: 11, true: 21}
+	actual = MapValsBoolUint8(func(v uint8) uint8 { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint8{true: 10, false: 20}
+	actual = MapKeysBoolUint8(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := uint8(30)
+	actualReduced := ReduceKVBoolUint8(func(acc uint8, k bool, v uint8) uint8 {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolUint8 failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolUint8(nil, m)) != 0 || len(MapKeysBoolUint8(nil, m)) != 0 || len(FilterKVBoolUint8(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolUint8 failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBoolStr(t *testing.T) {
+	m := map[bool]string{false: "x", true: "y"}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBoolStr(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBoolStr(m)) != 2 {
+		t.Errorf("TestKeysBoolStr failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []string{"x", "y"}
+	actualVals := ValsBoolStr(m, func(a, b string) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBoolStr(m)) != 2 {
+		t.Errorf("TestValsBoolStr failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]string{false: "x"}
+	actual := SelectKeysBoolStr(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBoolStr(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBoolStr(func(k bool, v string) bool { return k == false && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]string{true: "y"}
+	actual = DissocKeysBoolStr(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBoolStr(func(v string) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[string]bool{"x": false, "y": true}
+	actualInverted := InvertBoolStr(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBoolStr failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]string{false: "x!", true: "y!"}
+	actual = MapValsBoolStr(func(v string) string { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]string{true: "x", false: "y"}
+	actual = MapKeysBoolStr(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := string("y")
+	actualReduced := ReduceKVBoolStr(func(acc string, k bool, v string) string {
+		return func() string {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBoolStr failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBoolStr(nil, m)) != 0 || len(MapKeysBoolStr(nil, m)) != 0 || len(FilterKVBoolStr(nil, m)) != 0 {
+		t.Errorf("TestMapKVBoolStr failed. Expected empty map for nil function")
+	}
+}
+
+func TestMapKVBool(t *testing.T) {
+	m := map[bool]bool{false: false, true: true}
+
+	expectedKeys := []bool{false, true}
+	actualKeys := KeysBool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(KeysBool(m)) != 2 {
+		t.Errorf("TestKeysBool failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []bool{false, true}
+	actualVals := ValsBool(m, func(a, b bool) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(ValsBool(m)) != 2 {
+		t.Errorf("TestValsBool failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[bool]bool{false: false}
+	actual := SelectKeysBool(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeysBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeysBool(func(k bool) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeysBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKVBool(func(k bool, v bool) bool { return k == false && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKVBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]bool{true: true}
+	actual = DissocKeysBool(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeysBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterValsBool(func(v bool) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterValsBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[bool]bool{false: false, true: true}
+	actualInverted := InvertBool(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvertBool failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[bool]bool{false: true, true: false}
+	actual = MapValsBool(func(v bool) bool { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapValsBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]bool{true: false, false: true}
+	actual = MapKeysBool(func(k bool) bool { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeysBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := bool(true)
+	actualReduced := ReduceKVBool(func(acc bool, k bool, v bool) bool {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKVBool failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapValsBool(nil, m)) != 0 || len(MapKeysBool(nil, m)) != 0 || len(FilterKVBool(nil, m)) != 0 {
+		t.Errorf("TestMapKVBool failed. Expected empty map for nil function")
+	}
+}
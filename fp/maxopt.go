@@ -0,0 +1,365 @@
+package fp
+
+// MaxOptInt returns max item from the list. Returns None if the list is empty
+func MaxOptInt(list []int) OptionInt {
+	if len(list) == 0 {
+		return OptionInt{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptInt(max)
+}
+
+// MinOptInt returns min item from the list. Returns None if the list is empty
+func MinOptInt(list []int) OptionInt {
+	if len(list) == 0 {
+		return OptionInt{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptInt(min)
+}
+
+// MaxOptInt64 returns max item from the list. Returns None if the list is empty
+func MaxOptInt64(list []int64) OptionInt64 {
+	if len(list) == 0 {
+		return OptionInt64{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptInt64(max)
+}
+
+// MinOptInt64 returns min item from the list. Returns None if the list is empty
+func MinOptInt64(list []int64) OptionInt64 {
+	if len(list) == 0 {
+		return OptionInt64{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptInt64(min)
+}
+
+// MaxOptInt32 returns max item from the list. Returns None if the list is empty
+func MaxOptInt32(list []int32) OptionInt32 {
+	if len(list) == 0 {
+		return OptionInt32{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptInt32(max)
+}
+
+// MinOptInt32 returns min item from the list. Returns None if the list is empty
+func MinOptInt32(list []int32) OptionInt32 {
+	if len(list) == 0 {
+		return OptionInt32{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptInt32(min)
+}
+
+// MaxOptInt16 returns max item from the list. Returns None if the list is empty
+func MaxOptInt16(list []int16) OptionInt16 {
+	if len(list) == 0 {
+		return OptionInt16{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptInt16(max)
+}
+
+// MinOptInt16 returns min item from the list. Returns None if the list is empty
+func MinOptInt16(list []int16) OptionInt16 {
+	if len(list) == 0 {
+		return OptionInt16{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptInt16(min)
+}
+
+// MaxOptInt8 returns max item from the list. Returns None if the list is empty
+func MaxOptInt8(list []int8) OptionInt8 {
+	if len(list) == 0 {
+		return OptionInt8{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptInt8(max)
+}
+
+// MinOptInt8 returns min item from the list. Returns None if the list is empty
+func MinOptInt8(list []int8) OptionInt8 {
+	if len(list) == 0 {
+		return OptionInt8{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptInt8(min)
+}
+
+// MaxOptUint returns max item from the list. Returns None if the list is empty
+func MaxOptUint(list []uint) OptionUint {
+	if len(list) == 0 {
+		return OptionUint{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptUint(max)
+}
+
+// MinOptUint returns min item from the list. Returns None if the list is empty
+func MinOptUint(list []uint) OptionUint {
+	if len(list) == 0 {
+		return OptionUint{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptUint(min)
+}
+
+// MaxOptUint64 returns max item from the list. Returns None if the list is empty
+func MaxOptUint64(list []uint64) OptionUint64 {
+	if len(list) == 0 {
+		return OptionUint64{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptUint64(max)
+}
+
+// MinOptUint64 returns min item from the list. Returns None if the list is empty
+func MinOptUint64(list []uint64) OptionUint64 {
+	if len(list) == 0 {
+		return OptionUint64{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptUint64(min)
+}
+
+// MaxOptUint32 returns max item from the list. Returns None if the list is empty
+func MaxOptUint32(list []uint32) OptionUint32 {
+	if len(list) == 0 {
+		return OptionUint32{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptUint32(max)
+}
+
+// MinOptUint32 returns min item from the list. Returns None if the list is empty
+func MinOptUint32(list []uint32) OptionUint32 {
+	if len(list) == 0 {
+		return OptionUint32{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptUint32(min)
+}
+
+// MaxOptUint16 returns max item from the list. Returns None if the list is empty
+func MaxOptUint16(list []uint16) OptionUint16 {
+	if len(list) == 0 {
+		return OptionUint16{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptUint16(max)
+}
+
+// MinOptUint16 returns min item from the list. Returns None if the list is empty
+func MinOptUint16(list []uint16) OptionUint16 {
+	if len(list) == 0 {
+		return OptionUint16{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptUint16(min)
+}
+
+// MaxOptUint8 returns max item from the list. Returns None if the list is empty
+func MaxOptUint8(list []uint8) OptionUint8 {
+	if len(list) == 0 {
+		return OptionUint8{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptUint8(max)
+}
+
+// MinOptUint8 returns min item from the list. Returns None if the list is empty
+func MinOptUint8(list []uint8) OptionUint8 {
+	if len(list) == 0 {
+		return OptionUint8{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptUint8(min)
+}
+
+// MaxOptFloat64 returns max item from the list. Returns None if the list is empty
+func MaxOptFloat64(list []float64) OptionFloat64 {
+	if len(list) == 0 {
+		return OptionFloat64{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptFloat64(max)
+}
+
+// MinOptFloat64 returns min item from the list. Returns None if the list is empty
+func MinOptFloat64(list []float64) OptionFloat64 {
+	if len(list) == 0 {
+		return OptionFloat64{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptFloat64(min)
+}
+
+// MaxOptFloat32 returns max item from the list. Returns None if the list is empty
+func MaxOptFloat32(list []float32) OptionFloat32 {
+	if len(list) == 0 {
+		return OptionFloat32{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptFloat32(max)
+}
+
+// MinOptFloat32 returns min item from the list. Returns None if the list is empty
+func MinOptFloat32(list []float32) OptionFloat32 {
+	if len(list) == 0 {
+		return OptionFloat32{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptFloat32(min)
+}
+
+// MaxOptStr returns max item from the list. Returns None if the list is empty
+func MaxOptStr(list []string) OptionStr {
+	if len(list) == 0 {
+		return OptionStr{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOptStr(max)
+}
+
+// MinOptStr returns min item from the list. Returns None if the list is empty
+func MinOptStr(list []string) OptionStr {
+	if len(list) == 0 {
+		return OptionStr{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOptStr(min)
+}
@@ -0,0 +1,166 @@
+package fp
+
+import "testing"
+
+func TestMaxOptInt(t *testing.T) {
+	list := []int{3, 1, 5, 2}
+	if v := MaxOptInt(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptInt failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptInt(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptInt failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptInt(nil).IsSome() || MinOptInt([]int{}).IsSome() {
+		t.Errorf("TestMaxOptInt failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptInt64(t *testing.T) {
+	list := []int64{3, 1, 5, 2}
+	if v := MaxOptInt64(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptInt64 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptInt64(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptInt64 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptInt64(nil).IsSome() || MinOptInt64([]int64{}).IsSome() {
+		t.Errorf("TestMaxOptInt64 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptInt32(t *testing.T) {
+	list := []int32{3, 1, 5, 2}
+	if v := MaxOptInt32(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptInt32 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptInt32(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptInt32 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptInt32(nil).IsSome() || MinOptInt32([]int32{}).IsSome() {
+		t.Errorf("TestMaxOptInt32 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptInt16(t *testing.T) {
+	list := []int16{3, 1, 5, 2}
+	if v := MaxOptInt16(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptInt16 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptInt16(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptInt16 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptInt16(nil).IsSome() || MinOptInt16([]int16{}).IsSome() {
+		t.Errorf("TestMaxOptInt16 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptInt8(t *testing.T) {
+	list := []int8{3, 1, 5, 2}
+	if v := MaxOptInt8(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptInt8 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptInt8(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptInt8 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptInt8(nil).IsSome() || MinOptInt8([]int8{}).IsSome() {
+		t.Errorf("TestMaxOptInt8 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptUint(t *testing.T) {
+	list := []uint{3, 1, 5, 2}
+	if v := MaxOptUint(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptUint failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptUint(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptUint failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptUint(nil).IsSome() || MinOptUint([]uint{}).IsSome() {
+		t.Errorf("TestMaxOptUint failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptUint64(t *testing.T) {
+	list := []uint64{3, 1, 5, 2}
+	if v := MaxOptUint64(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptUint64 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptUint64(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptUint64 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptUint64(nil).IsSome() || MinOptUint64([]uint64{}).IsSome() {
+		t.Errorf("TestMaxOptUint64 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptUint32(t *testing.T) {
+	list := []uint32{3, 1, 5, 2}
+	if v := MaxOptUint32(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptUint32 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptUint32(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptUint32 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptUint32(nil).IsSome() || MinOptUint32([]uint32{}).IsSome() {
+		t.Errorf("TestMaxOptUint32 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptUint16(t *testing.T) {
+	list := []uint16{3, 1, 5, 2}
+	if v := MaxOptUint16(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptUint16 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptUint16(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptUint16 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptUint16(nil).IsSome() || MinOptUint16([]uint16{}).IsSome() {
+		t.Errorf("TestMaxOptUint16 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptUint8(t *testing.T) {
+	list := []uint8{3, 1, 5, 2}
+	if v := MaxOptUint8(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptUint8 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptUint8(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptUint8 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptUint8(nil).IsSome() || MinOptUint8([]uint8{}).IsSome() {
+		t.Errorf("TestMaxOptUint8 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptFloat64(t *testing.T) {
+	list := []float64{3, 1, 5, 2}
+	if v := MaxOptFloat64(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptFloat64 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptFloat64(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptFloat64 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptFloat64(nil).IsSome() || MinOptFloat64([]float64{}).IsSome() {
+		t.Errorf("TestMaxOptFloat64 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptFloat32(t *testing.T) {
+	list := []float32{3, 1, 5, 2}
+	if v := MaxOptFloat32(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOptFloat32 failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOptFloat32(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOptFloat32 failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOptFloat32(nil).IsSome() || MinOptFloat32([]float32{}).IsSome() {
+		t.Errorf("TestMaxOptFloat32 failed. Expected None for empty list")
+	}
+}
+
+func TestMaxOptStr(t *testing.T) {
+	list := []string{"b", "a", "c"}
+	if MaxOptStr(list).Unwrap() != "c" || MinOptStr(list).Unwrap() != "a" || MaxOptStr(nil).IsSome() {
+		t.Errorf("TestMaxOptStr failed. Expected max=c and min=a")
+	}
+}
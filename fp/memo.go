@@ -0,0 +1,166 @@
+package fp
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// MemoizeOptions configures the cache used by Memoize functions.
+// The zero value caches every result forever.
+type MemoizeOptions struct {
+	// Capacity is the maximum number of results kept in the cache.
+	// Least recently used result is evicted first. 0 means unlimited
+	Capacity int
+
+	// TTL is the duration for which a result is kept in the cache. 0 means results never expire
+	TTL time.Duration
+
+	// Now returns the current time. It is used for TTL and can be replaced in tests. Default is time.Now
+	Now func() time.Time
+}
+
+// Memo is a concurrency safe cache used by Memoize functions.
+// Concurrent calls for the same key, which is not cached yet, are deduplicated:
+// only one of them computes the value and the others wait for the result.
+//
+// A key which is not equal to itself, e.g. NaN, can never be found again. It is not cached:
+// the value is computed on every call.
+type Memo struct {
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	gen      uint64
+
+	items   map[interface{}]*list.Element
+	order   *list.List
+	pending map[interface{}]*memoCall
+	sync.Mutex
+}
+
+type memoEntry struct {
+	key     interface{}
+	value   interface{}
+	expires time.Time
+}
+
+type memoCall struct {
+	wg    sync.WaitGroup
+	gen   uint64
+	dups  int
+	value interface{}
+	ok    bool
+}
+
+// NewMemo creates cache. Only the 1st option is used if more than one is passed
+func NewMemo(opts ...MemoizeOptions) *Memo {
+	var opt MemoizeOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Now == nil {
+		opt.Now = time.Now
+	}
+	return &Memo{
+		capacity: opt.Capacity,
+		ttl:      opt.TTL,
+		now:      opt.Now,
+		items:    make(map[interface{}]*list.Element),
+		order:    list.New(),
+		pending:  make(map[interface{}]*memoCall),
+	}
+}
+
+// Get returns the cached value for the key. If the key is not cached or the value is expired,
+// f is called to compute the value which is cached and returned.
+//
+// If f panics, nothing is cached and the panic is propagated to the caller.
+// Callers waiting for the same key retry the computation.
+func (m *Memo) Get(key interface{}, f func() interface{}) interface{} {
+	if key != key {
+		return f()
+	}
+	for {
+		m.Lock()
+		if e, ok := m.items[key]; ok {
+			entry := e.Value.(*memoEntry)
+			if m.ttl <= 0 || m.now().Before(entry.expires) {
+				m.order.MoveToFront(e)
+				m.Unlock()
+				return entry.value
+			}
+			m.removeElement(e)
+		}
+
+		if call, ok := m.pending[key]; ok {
+			call.dups++
+			m.Unlock()
+			call.wg.Wait()
+			if call.ok {
+				return call.value
+			}
+			continue
+		}
+
+		call := &memoCall{gen: m.gen}
+		call.wg.Add(1)
+		m.pending[key] = call
+		m.Unlock()
+
+		m.compute(key, call, f)
+		return call.value
+	}
+}
+
+func (m *Memo) compute(key interface{}, call *memoCall, f func() interface{}) {
+	defer func() {
+		m.Lock()
+		// the value computed before Clear is stale. Pending calls are replaced by Clear as well
+		if call.gen == m.gen {
+			delete(m.pending, key)
+			if call.ok {
+				m.add(key, call.value)
+			}
+		}
+		m.Unlock()
+		call.wg.Done()
+	}()
+
+	call.value = f()
+	call.ok = true
+}
+
+func (m *Memo) add(key, value interface{}) {
+	entry := &memoEntry{key: key, value: value}
+	if m.ttl > 0 {
+		entry.expires = m.now().Add(m.ttl)
+	}
+	m.items[key] = m.order.PushFront(entry)
+
+	if m.capacity > 0 && m.order.Len() > m.capacity {
+		m.removeElement(m.order.Back())
+	}
+}
+
+func (m *Memo) removeElement(e *list.Element) {
+	m.order.Remove(e)
+	delete(m.items, e.Value.(*memoEntry).key)
+}
+
+// Len returns number of cached values. Expired values are counted until they are accessed again
+func (m *Memo) Len() int {
+	m.Lock()
+	defer m.Unlock()
+	return m.order.Len()
+}
+
+// Clear removes all the cached values.
+// Values being computed when Clear is called are returned to their callers but not cached
+func (m *Memo) Clear() {
+	m.Lock()
+	defer m.Unlock()
+	m.gen++
+	m.items = make(map[interface{}]*list.Element)
+	m.order.Init()
+	m.pending = make(map[interface{}]*memoCall)
+}
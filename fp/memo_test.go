@@ -0,0 +1,206 @@
+package fp
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoGet(t *testing.T) {
+	memo := NewMemo()
+	calls := 0
+	square := func(v int) func() interface{} {
+		return func() interface{} {
+			calls++
+			return v * v
+		}
+	}
+
+	if memo.Get(3, square(3)).(int) != 9 || memo.Get(3, square(3)).(int) != 9 {
+		t.Errorf("TestMemoGet failed. Expected=9")
+	}
+
+	if calls != 1 {
+		t.Errorf("TestMemoGet failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memo.Get(4, square(4))
+	if memo.Len() != 2 {
+		t.Errorf("TestMemoGet failed. Expected len=2, actual=%v", memo.Len())
+	}
+
+	memo.Clear()
+	memo.Get(3, square(3))
+	if memo.Len() != 1 || calls != 3 {
+		t.Errorf("TestMemoGet failed. Expected len=1 calls=3, actual len=%v calls=%v", memo.Len(), calls)
+	}
+}
+
+func TestMemoCapacity(t *testing.T) {
+	memo := NewMemo(MemoizeOptions{Capacity: 2})
+	calls := 0
+	identity := func(v int) func() interface{} {
+		return func() interface{} {
+			calls++
+			return v
+		}
+	}
+
+	memo.Get(1, identity(1))
+	memo.Get(2, identity(2))
+	memo.Get(1, identity(1)) // 1 is most recently used now
+	memo.Get(3, identity(3)) // evicts 2
+
+	if memo.Len() != 2 || calls != 3 {
+		t.Errorf("TestMemoCapacity failed. Expected len=2 calls=3, actual len=%v calls=%v", memo.Len(), calls)
+	}
+
+	memo.Get(1, identity(1))
+	if calls != 3 {
+		t.Errorf("TestMemoCapacity failed. Expected 1 to be cached")
+	}
+
+	memo.Get(2, identity(2))
+	if calls != 4 {
+		t.Errorf("TestMemoCapacity failed. Expected 2 to be evicted")
+	}
+}
+
+func TestMemoTTL(t *testing.T) {
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	memo := NewMemo(MemoizeOptions{TTL: time.Minute, Now: clock})
+	calls := 0
+	f := func() interface{} {
+		calls++
+		return calls
+	}
+
+	memo.Get("a", f)
+	now = now.Add(59 * time.Second)
+	if memo.Get("a", f).(int) != 1 {
+		t.Errorf("TestMemoTTL failed. Expected cached value")
+	}
+
+	now = now.Add(time.Second)
+	if memo.Get("a", f).(int) != 2 {
+		t.Errorf("TestMemoTTL failed. Expected expired value to be computed again")
+	}
+}
+
+func TestMemoSingleFlight(t *testing.T) {
+	memo := NewMemo()
+	var calls int32
+	release := make(chan struct{})
+	f := func() interface{} {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 42
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = memo.Get("key", f).(int)
+		}(i)
+	}
+
+	waitMemoDups(t, memo, "key", len(results)-1)
+	close(release)
+	wg.Wait()
+
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("TestMemoSingleFlight failed. Expected function to be called once, actual=%v", calls)
+	}
+	for _, v := range results {
+		if v != 42 {
+			t.Errorf("TestMemoSingleFlight failed. Expected=42, actual=%v", v)
+		}
+	}
+}
+
+// waitMemoDups waits until n calls wait for the value of the key which is being computed
+func waitMemoDups(t *testing.T, memo *Memo, key interface{}, n int) {
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		memo.Lock()
+		call, ok := memo.pending[key]
+		done := ok && call.dups == n
+		memo.Unlock()
+		if done {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %v calls of %v", n, key)
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestMemoNaN(t *testing.T) {
+	memo := NewMemo(MemoizeOptions{Capacity: 2})
+	calls := 0
+	f := func() interface{} {
+		calls++
+		return 0.0
+	}
+	for i := 0; i < 3; i++ {
+		memo.Get(math.NaN(), f)
+	}
+	if memo.Len() != 0 || len(memo.items) != 0 || len(memo.pending) != 0 || calls != 3 {
+		t.Errorf("TestMemoNaN failed. Expected NaN not to be cached, actual len=%v calls=%v", len(memo.items), calls)
+	}
+}
+
+func TestMemoClearDuringCompute(t *testing.T) {
+	memo := NewMemo()
+	started, release := make(chan struct{}), make(chan struct{})
+	stale := make(chan interface{})
+	go func() {
+		stale <- memo.Get("key", func() interface{} {
+			close(started)
+			<-release
+			return "stale"
+		})
+	}()
+
+	<-started
+	memo.Clear()
+	if v := memo.Get("key", func() interface{} { return "fresh" }); v != "fresh" {
+		t.Errorf("TestMemoClearDuringCompute failed. Expected=fresh, actual=%v", v)
+	}
+	close(release)
+	if v := <-stale; v != "stale" {
+		t.Errorf("TestMemoClearDuringCompute failed. Expected caller to get its value, actual=%v", v)
+	}
+	if v := memo.Get("key", func() interface{} { return "recomputed" }); v != "fresh" || memo.Len() != 1 {
+		t.Errorf("TestMemoClearDuringCompute failed. Expected stale value not to be cached, actual=%v", v)
+	}
+}
+
+func TestMemoPanic(t *testing.T) {
+	memo := NewMemo()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("TestMemoPanic failed. Expected panic to be propagated")
+			}
+		}()
+		memo.Get(1, func() interface{} { panic("failed") })
+	}()
+
+	if memo.Len() != 0 {
+		t.Errorf("TestMemoPanic failed. Expected nothing to be cached")
+	}
+
+	if memo.Get(1, func() interface{} { return 1 }).(int) != 1 {
+		t.Errorf("TestMemoPanic failed. Expected value to be computed after panic")
+	}
+}
@@ -0,0 +1,197 @@
+package fp
+
+// MemoizeInt returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeInt(f func(int) int, opts ...MemoizeOptions) func(int) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeInt64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeInt64(f func(int64) int64, opts ...MemoizeOptions) func(int64) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeInt32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeInt32(f func(int32) int32, opts ...MemoizeOptions) func(int32) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeInt16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeInt16(f func(int16) int16, opts ...MemoizeOptions) func(int16) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeInt8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeInt8(f func(int8) int8, opts ...MemoizeOptions) func(int8) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeUint(f func(uint) uint, opts ...MemoizeOptions) func(uint) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeUint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeUint64(f func(uint64) uint64, opts ...MemoizeOptions) func(uint64) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeUint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeUint32(f func(uint32) uint32, opts ...MemoizeOptions) func(uint32) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeUint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeUint16(f func(uint16) uint16, opts ...MemoizeOptions) func(uint16) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeUint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeUint8(f func(uint8) uint8, opts ...MemoizeOptions) func(uint8) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeFloat64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeFloat64(f func(float64) float64, opts ...MemoizeOptions) func(float64) float64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v float64) float64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(float64)
+	}
+}
+
+// MemoizeFloat32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeFloat32(f func(float32) float32, opts ...MemoizeOptions) func(float32) float32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v float32) float32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(float32)
+	}
+}
+
+// MemoizeStr returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeStr(f func(string) string, opts ...MemoizeOptions) func(string) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeBool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func MemoizeBool(f func(bool) bool, opts ...MemoizeOptions) func(bool) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
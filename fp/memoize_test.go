@@ -0,0 +1,398 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemoizeInt(t *testing.T) {
+	calls := 0
+	f := func(v int) int {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeInt(f)
+	expected := int(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt(nil) != nil {
+		t.Errorf("TestMemoizeInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64(t *testing.T) {
+	calls := 0
+	f := func(v int64) int64 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeInt64(f)
+	expected := int64(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64(nil) != nil {
+		t.Errorf("TestMemoizeInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32(t *testing.T) {
+	calls := 0
+	f := func(v int32) int32 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeInt32(f)
+	expected := int32(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32(nil) != nil {
+		t.Errorf("TestMemoizeInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16(t *testing.T) {
+	calls := 0
+	f := func(v int16) int16 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeInt16(f)
+	expected := int16(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16(nil) != nil {
+		t.Errorf("TestMemoizeInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8(t *testing.T) {
+	calls := 0
+	f := func(v int8) int8 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeInt8(f)
+	expected := int8(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8(nil) != nil {
+		t.Errorf("TestMemoizeInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint(t *testing.T) {
+	calls := 0
+	f := func(v uint) uint {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeUint(f)
+	expected := uint(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint(nil) != nil {
+		t.Errorf("TestMemoizeUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64(t *testing.T) {
+	calls := 0
+	f := func(v uint64) uint64 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeUint64(f)
+	expected := uint64(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64(nil) != nil {
+		t.Errorf("TestMemoizeUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32(t *testing.T) {
+	calls := 0
+	f := func(v uint32) uint32 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeUint32(f)
+	expected := uint32(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32(nil) != nil {
+		t.Errorf("TestMemoizeUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16(t *testing.T) {
+	calls := 0
+	f := func(v uint16) uint16 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeUint16(f)
+	expected := uint16(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16(nil) != nil {
+		t.Errorf("TestMemoizeUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8(t *testing.T) {
+	calls := 0
+	f := func(v uint8) uint8 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeUint8(f)
+	expected := uint8(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8(nil) != nil {
+		t.Errorf("TestMemoizeUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeFloat64(t *testing.T) {
+	calls := 0
+	f := func(v float64) float64 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeFloat64(f)
+	expected := float64(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeFloat64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeFloat64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeFloat64(nil) != nil {
+		t.Errorf("TestMemoizeFloat64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeFloat32(t *testing.T) {
+	calls := 0
+	f := func(v float32) float32 {
+		calls++
+		return v * 2
+	}
+
+	memoized := MemoizeFloat32(f)
+	expected := float32(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeFloat32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeFloat32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeFloat32(nil) != nil {
+		t.Errorf("TestMemoizeFloat32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStr(t *testing.T) {
+	calls := 0
+	f := func(v string) string {
+		calls++
+		return v + "a"
+	}
+
+	memoized := MemoizeStr(f)
+	expected := string("xa")
+	memoized("x")
+	actual := memoized("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStr failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("y")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStr failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStr(nil) != nil {
+		t.Errorf("TestMemoizeStr failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBool(t *testing.T) {
+	calls := 0
+	f := func(v bool) bool {
+		calls++
+		return !v
+	}
+
+	memoized := MemoizeBool(f)
+	expected := bool(false)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBool(nil) != nil {
+		t.Errorf("TestMemoizeBool failed. Expected nil function for nil input")
+	}
+}
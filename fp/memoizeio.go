@@ -0,0 +1,2773 @@
+package fp
+
+// MemoizeIntInt64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntInt64(f func(int) int64, opts ...MemoizeOptions) func(int) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeIntInt32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntInt32(f func(int) int32, opts ...MemoizeOptions) func(int) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeIntInt16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntInt16(f func(int) int16, opts ...MemoizeOptions) func(int) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeIntInt8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntInt8(f func(int) int8, opts ...MemoizeOptions) func(int) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeIntUint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntUint(f func(int) uint, opts ...MemoizeOptions) func(int) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeIntUint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntUint64(f func(int) uint64, opts ...MemoizeOptions) func(int) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeIntUint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntUint32(f func(int) uint32, opts ...MemoizeOptions) func(int) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeIntUint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntUint16(f func(int) uint16, opts ...MemoizeOptions) func(int) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeIntUint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntUint8(f func(int) uint8, opts ...MemoizeOptions) func(int) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeIntStr returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntStr(f func(int) string, opts ...MemoizeOptions) func(int) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeIntBool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeIntBool(f func(int) bool, opts ...MemoizeOptions) func(int) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeInt64Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Int(f func(int64) int, opts ...MemoizeOptions) func(int64) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeInt64Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Int32(f func(int64) int32, opts ...MemoizeOptions) func(int64) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeInt64Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Int16(f func(int64) int16, opts ...MemoizeOptions) func(int64) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeInt64Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Int8(f func(int64) int8, opts ...MemoizeOptions) func(int64) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeInt64Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Uint(f func(int64) uint, opts ...MemoizeOptions) func(int64) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeInt64Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Uint64(f func(int64) uint64, opts ...MemoizeOptions) func(int64) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeInt64Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Uint32(f func(int64) uint32, opts ...MemoizeOptions) func(int64) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeInt64Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Uint16(f func(int64) uint16, opts ...MemoizeOptions) func(int64) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeInt64Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Uint8(f func(int64) uint8, opts ...MemoizeOptions) func(int64) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeInt64Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Str(f func(int64) string, opts ...MemoizeOptions) func(int64) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeInt64Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int64 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt64Bool(f func(int64) bool, opts ...MemoizeOptions) func(int64) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int64) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeInt32Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Int(f func(int32) int, opts ...MemoizeOptions) func(int32) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeInt32Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Int64(f func(int32) int64, opts ...MemoizeOptions) func(int32) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeInt32Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Int16(f func(int32) int16, opts ...MemoizeOptions) func(int32) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeInt32Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Int8(f func(int32) int8, opts ...MemoizeOptions) func(int32) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeInt32Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Uint(f func(int32) uint, opts ...MemoizeOptions) func(int32) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeInt32Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Uint64(f func(int32) uint64, opts ...MemoizeOptions) func(int32) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeInt32Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Uint32(f func(int32) uint32, opts ...MemoizeOptions) func(int32) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeInt32Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Uint16(f func(int32) uint16, opts ...MemoizeOptions) func(int32) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeInt32Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Uint8(f func(int32) uint8, opts ...MemoizeOptions) func(int32) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeInt32Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Str(f func(int32) string, opts ...MemoizeOptions) func(int32) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeInt32Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int32 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt32Bool(f func(int32) bool, opts ...MemoizeOptions) func(int32) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int32) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeInt16Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Int(f func(int16) int, opts ...MemoizeOptions) func(int16) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeInt16Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Int64(f func(int16) int64, opts ...MemoizeOptions) func(int16) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeInt16Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Int32(f func(int16) int32, opts ...MemoizeOptions) func(int16) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeInt16Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Int8(f func(int16) int8, opts ...MemoizeOptions) func(int16) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeInt16Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Uint(f func(int16) uint, opts ...MemoizeOptions) func(int16) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeInt16Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Uint64(f func(int16) uint64, opts ...MemoizeOptions) func(int16) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeInt16Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Uint32(f func(int16) uint32, opts ...MemoizeOptions) func(int16) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeInt16Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Uint16(f func(int16) uint16, opts ...MemoizeOptions) func(int16) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeInt16Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Uint8(f func(int16) uint8, opts ...MemoizeOptions) func(int16) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeInt16Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Str(f func(int16) string, opts ...MemoizeOptions) func(int16) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeInt16Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int16 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt16Bool(f func(int16) bool, opts ...MemoizeOptions) func(int16) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int16) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeInt8Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Int(f func(int8) int, opts ...MemoizeOptions) func(int8) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeInt8Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Int64(f func(int8) int64, opts ...MemoizeOptions) func(int8) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeInt8Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Int32(f func(int8) int32, opts ...MemoizeOptions) func(int8) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeInt8Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Int16(f func(int8) int16, opts ...MemoizeOptions) func(int8) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeInt8Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Uint(f func(int8) uint, opts ...MemoizeOptions) func(int8) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeInt8Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Uint64(f func(int8) uint64, opts ...MemoizeOptions) func(int8) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeInt8Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Uint32(f func(int8) uint32, opts ...MemoizeOptions) func(int8) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeInt8Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Uint16(f func(int8) uint16, opts ...MemoizeOptions) func(int8) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeInt8Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Uint8(f func(int8) uint8, opts ...MemoizeOptions) func(int8) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeInt8Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Str(f func(int8) string, opts ...MemoizeOptions) func(int8) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeInt8Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int8 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeInt8Bool(f func(int8) bool, opts ...MemoizeOptions) func(int8) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v int8) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeUintInt returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintInt(f func(uint) int, opts ...MemoizeOptions) func(uint) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeUintInt64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintInt64(f func(uint) int64, opts ...MemoizeOptions) func(uint) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeUintInt32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintInt32(f func(uint) int32, opts ...MemoizeOptions) func(uint) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeUintInt16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintInt16(f func(uint) int16, opts ...MemoizeOptions) func(uint) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeUintInt8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintInt8(f func(uint) int8, opts ...MemoizeOptions) func(uint) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUintUint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintUint64(f func(uint) uint64, opts ...MemoizeOptions) func(uint) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeUintUint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintUint32(f func(uint) uint32, opts ...MemoizeOptions) func(uint) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeUintUint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintUint16(f func(uint) uint16, opts ...MemoizeOptions) func(uint) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeUintUint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintUint8(f func(uint) uint8, opts ...MemoizeOptions) func(uint) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeUintStr returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintStr(f func(uint) string, opts ...MemoizeOptions) func(uint) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeUintBool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUintBool(f func(uint) bool, opts ...MemoizeOptions) func(uint) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeUint64Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Int(f func(uint64) int, opts ...MemoizeOptions) func(uint64) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeUint64Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Int64(f func(uint64) int64, opts ...MemoizeOptions) func(uint64) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeUint64Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Int32(f func(uint64) int32, opts ...MemoizeOptions) func(uint64) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeUint64Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Int16(f func(uint64) int16, opts ...MemoizeOptions) func(uint64) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeUint64Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Int8(f func(uint64) int8, opts ...MemoizeOptions) func(uint64) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUint64Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Uint(f func(uint64) uint, opts ...MemoizeOptions) func(uint64) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeUint64Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Uint32(f func(uint64) uint32, opts ...MemoizeOptions) func(uint64) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeUint64Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Uint16(f func(uint64) uint16, opts ...MemoizeOptions) func(uint64) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeUint64Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Uint8(f func(uint64) uint8, opts ...MemoizeOptions) func(uint64) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeUint64Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Str(f func(uint64) string, opts ...MemoizeOptions) func(uint64) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeUint64Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint64 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint64Bool(f func(uint64) bool, opts ...MemoizeOptions) func(uint64) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint64) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeUint32Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Int(f func(uint32) int, opts ...MemoizeOptions) func(uint32) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeUint32Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Int64(f func(uint32) int64, opts ...MemoizeOptions) func(uint32) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeUint32Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Int32(f func(uint32) int32, opts ...MemoizeOptions) func(uint32) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeUint32Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Int16(f func(uint32) int16, opts ...MemoizeOptions) func(uint32) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeUint32Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Int8(f func(uint32) int8, opts ...MemoizeOptions) func(uint32) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUint32Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Uint(f func(uint32) uint, opts ...MemoizeOptions) func(uint32) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeUint32Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Uint64(f func(uint32) uint64, opts ...MemoizeOptions) func(uint32) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeUint32Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Uint16(f func(uint32) uint16, opts ...MemoizeOptions) func(uint32) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeUint32Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Uint8(f func(uint32) uint8, opts ...MemoizeOptions) func(uint32) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeUint32Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Str(f func(uint32) string, opts ...MemoizeOptions) func(uint32) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeUint32Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint32 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint32Bool(f func(uint32) bool, opts ...MemoizeOptions) func(uint32) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint32) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeUint16Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Int(f func(uint16) int, opts ...MemoizeOptions) func(uint16) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeUint16Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Int64(f func(uint16) int64, opts ...MemoizeOptions) func(uint16) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeUint16Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Int32(f func(uint16) int32, opts ...MemoizeOptions) func(uint16) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeUint16Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Int16(f func(uint16) int16, opts ...MemoizeOptions) func(uint16) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeUint16Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Int8(f func(uint16) int8, opts ...MemoizeOptions) func(uint16) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUint16Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Uint(f func(uint16) uint, opts ...MemoizeOptions) func(uint16) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeUint16Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Uint64(f func(uint16) uint64, opts ...MemoizeOptions) func(uint16) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeUint16Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Uint32(f func(uint16) uint32, opts ...MemoizeOptions) func(uint16) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeUint16Uint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Uint8(f func(uint16) uint8, opts ...MemoizeOptions) func(uint16) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeUint16Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Str(f func(uint16) string, opts ...MemoizeOptions) func(uint16) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeUint16Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint16 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint16Bool(f func(uint16) bool, opts ...MemoizeOptions) func(uint16) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint16) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeUint8Int returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Int(f func(uint8) int, opts ...MemoizeOptions) func(uint8) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeUint8Int64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Int64(f func(uint8) int64, opts ...MemoizeOptions) func(uint8) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeUint8Int32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Int32(f func(uint8) int32, opts ...MemoizeOptions) func(uint8) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeUint8Int16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Int16(f func(uint8) int16, opts ...MemoizeOptions) func(uint8) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeUint8Int8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Int8(f func(uint8) int8, opts ...MemoizeOptions) func(uint8) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeUint8Uint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Uint(f func(uint8) uint, opts ...MemoizeOptions) func(uint8) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeUint8Uint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Uint64(f func(uint8) uint64, opts ...MemoizeOptions) func(uint8) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeUint8Uint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Uint32(f func(uint8) uint32, opts ...MemoizeOptions) func(uint8) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeUint8Uint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Uint16(f func(uint8) uint16, opts ...MemoizeOptions) func(uint8) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeUint8Str returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Str(f func(uint8) string, opts ...MemoizeOptions) func(uint8) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+// MemoizeUint8Bool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: uint8 output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeUint8Bool(f func(uint8) bool, opts ...MemoizeOptions) func(uint8) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v uint8) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeStrInt returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrInt(f func(string) int, opts ...MemoizeOptions) func(string) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeStrInt64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrInt64(f func(string) int64, opts ...MemoizeOptions) func(string) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeStrInt32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrInt32(f func(string) int32, opts ...MemoizeOptions) func(string) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeStrInt16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrInt16(f func(string) int16, opts ...MemoizeOptions) func(string) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeStrInt8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrInt8(f func(string) int8, opts ...MemoizeOptions) func(string) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeStrUint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrUint(f func(string) uint, opts ...MemoizeOptions) func(string) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeStrUint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrUint64(f func(string) uint64, opts ...MemoizeOptions) func(string) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeStrUint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrUint32(f func(string) uint32, opts ...MemoizeOptions) func(string) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeStrUint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrUint16(f func(string) uint16, opts ...MemoizeOptions) func(string) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeStrUint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrUint8(f func(string) uint8, opts ...MemoizeOptions) func(string) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeStrBool returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: bool
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeStrBool(f func(string) bool, opts ...MemoizeOptions) func(string) bool {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v string) bool {
+		return memo.Get(v, func() interface{} { return f(v) }).(bool)
+	}
+}
+
+// MemoizeBoolInt returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: int
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolInt(f func(bool) int, opts ...MemoizeOptions) func(bool) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MemoizeBoolInt64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: int64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolInt64(f func(bool) int64, opts ...MemoizeOptions) func(bool) int64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) int64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int64)
+	}
+}
+
+// MemoizeBoolInt32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: int32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolInt32(f func(bool) int32, opts ...MemoizeOptions) func(bool) int32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) int32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int32)
+	}
+}
+
+// MemoizeBoolInt16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: int16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolInt16(f func(bool) int16, opts ...MemoizeOptions) func(bool) int16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) int16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int16)
+	}
+}
+
+// MemoizeBoolInt8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: int8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolInt8(f func(bool) int8, opts ...MemoizeOptions) func(bool) int8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) int8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(int8)
+	}
+}
+
+// MemoizeBoolUint returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: uint
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolUint(f func(bool) uint, opts ...MemoizeOptions) func(bool) uint {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) uint {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint)
+	}
+}
+
+// MemoizeBoolUint64 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: uint64
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolUint64(f func(bool) uint64, opts ...MemoizeOptions) func(bool) uint64 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) uint64 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint64)
+	}
+}
+
+// MemoizeBoolUint32 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: uint32
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolUint32(f func(bool) uint32, opts ...MemoizeOptions) func(bool) uint32 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) uint32 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint32)
+	}
+}
+
+// MemoizeBoolUint16 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: uint16
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolUint16(f func(bool) uint16, opts ...MemoizeOptions) func(bool) uint16 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) uint16 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint16)
+	}
+}
+
+// MemoizeBoolUint8 returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: uint8
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolUint8(f func(bool) uint8, opts ...MemoizeOptions) func(bool) uint8 {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) uint8 {
+		return memo.Get(v, func() interface{} { return f(v) }).(uint8)
+	}
+}
+
+// MemoizeBoolStr returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: bool output type: string
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func MemoizeBoolStr(f func(bool) string, opts ...MemoizeOptions) func(bool) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v bool) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
@@ -0,0 +1,3765 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemoizeIntInt64(t *testing.T) {
+	calls := 0
+	f := func(v int) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeIntInt64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntInt64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntInt64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntInt64(nil) != nil {
+		t.Errorf("TestMemoizeIntInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntInt32(t *testing.T) {
+	calls := 0
+	f := func(v int) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeIntInt32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntInt32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntInt32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntInt32(nil) != nil {
+		t.Errorf("TestMemoizeIntInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntInt16(t *testing.T) {
+	calls := 0
+	f := func(v int) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeIntInt16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntInt16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntInt16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntInt16(nil) != nil {
+		t.Errorf("TestMemoizeIntInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntInt8(t *testing.T) {
+	calls := 0
+	f := func(v int) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeIntInt8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntInt8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntInt8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntInt8(nil) != nil {
+		t.Errorf("TestMemoizeIntInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntUint(t *testing.T) {
+	calls := 0
+	f := func(v int) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeIntUint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntUint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntUint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntUint(nil) != nil {
+		t.Errorf("TestMemoizeIntUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntUint64(t *testing.T) {
+	calls := 0
+	f := func(v int) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeIntUint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntUint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntUint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntUint64(nil) != nil {
+		t.Errorf("TestMemoizeIntUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntUint32(t *testing.T) {
+	calls := 0
+	f := func(v int) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeIntUint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntUint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntUint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntUint32(nil) != nil {
+		t.Errorf("TestMemoizeIntUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntUint16(t *testing.T) {
+	calls := 0
+	f := func(v int) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeIntUint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntUint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntUint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntUint16(nil) != nil {
+		t.Errorf("TestMemoizeIntUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntUint8(t *testing.T) {
+	calls := 0
+	f := func(v int) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeIntUint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntUint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntUint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntUint8(nil) != nil {
+		t.Errorf("TestMemoizeIntUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntStr(t *testing.T) {
+	calls := 0
+	f := func(v int) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeIntStr(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntStr failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntStr failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntStr(nil) != nil {
+		t.Errorf("TestMemoizeIntStr failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeIntBool(t *testing.T) {
+	calls := 0
+	f := func(v int) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeIntBool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeIntBool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeIntBool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeIntBool(nil) != nil {
+		t.Errorf("TestMemoizeIntBool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Int(t *testing.T) {
+	calls := 0
+	f := func(v int64) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeInt64Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Int(nil) != nil {
+		t.Errorf("TestMemoizeInt64Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Int32(t *testing.T) {
+	calls := 0
+	f := func(v int64) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeInt64Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Int32(nil) != nil {
+		t.Errorf("TestMemoizeInt64Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Int16(t *testing.T) {
+	calls := 0
+	f := func(v int64) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeInt64Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Int16(nil) != nil {
+		t.Errorf("TestMemoizeInt64Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Int8(t *testing.T) {
+	calls := 0
+	f := func(v int64) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeInt64Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Int8(nil) != nil {
+		t.Errorf("TestMemoizeInt64Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Uint(t *testing.T) {
+	calls := 0
+	f := func(v int64) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeInt64Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Uint(nil) != nil {
+		t.Errorf("TestMemoizeInt64Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Uint64(t *testing.T) {
+	calls := 0
+	f := func(v int64) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeInt64Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Uint64(nil) != nil {
+		t.Errorf("TestMemoizeInt64Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Uint32(t *testing.T) {
+	calls := 0
+	f := func(v int64) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeInt64Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Uint32(nil) != nil {
+		t.Errorf("TestMemoizeInt64Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Uint16(t *testing.T) {
+	calls := 0
+	f := func(v int64) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeInt64Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Uint16(nil) != nil {
+		t.Errorf("TestMemoizeInt64Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Uint8(t *testing.T) {
+	calls := 0
+	f := func(v int64) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeInt64Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Uint8(nil) != nil {
+		t.Errorf("TestMemoizeInt64Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Str(t *testing.T) {
+	calls := 0
+	f := func(v int64) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeInt64Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Str(nil) != nil {
+		t.Errorf("TestMemoizeInt64Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt64Bool(t *testing.T) {
+	calls := 0
+	f := func(v int64) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeInt64Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt64Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt64Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt64Bool(nil) != nil {
+		t.Errorf("TestMemoizeInt64Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Int(t *testing.T) {
+	calls := 0
+	f := func(v int32) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeInt32Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Int(nil) != nil {
+		t.Errorf("TestMemoizeInt32Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Int64(t *testing.T) {
+	calls := 0
+	f := func(v int32) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeInt32Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Int64(nil) != nil {
+		t.Errorf("TestMemoizeInt32Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Int16(t *testing.T) {
+	calls := 0
+	f := func(v int32) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeInt32Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Int16(nil) != nil {
+		t.Errorf("TestMemoizeInt32Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Int8(t *testing.T) {
+	calls := 0
+	f := func(v int32) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeInt32Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Int8(nil) != nil {
+		t.Errorf("TestMemoizeInt32Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Uint(t *testing.T) {
+	calls := 0
+	f := func(v int32) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeInt32Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Uint(nil) != nil {
+		t.Errorf("TestMemoizeInt32Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Uint64(t *testing.T) {
+	calls := 0
+	f := func(v int32) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeInt32Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Uint64(nil) != nil {
+		t.Errorf("TestMemoizeInt32Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Uint32(t *testing.T) {
+	calls := 0
+	f := func(v int32) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeInt32Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Uint32(nil) != nil {
+		t.Errorf("TestMemoizeInt32Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Uint16(t *testing.T) {
+	calls := 0
+	f := func(v int32) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeInt32Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Uint16(nil) != nil {
+		t.Errorf("TestMemoizeInt32Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Uint8(t *testing.T) {
+	calls := 0
+	f := func(v int32) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeInt32Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Uint8(nil) != nil {
+		t.Errorf("TestMemoizeInt32Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Str(t *testing.T) {
+	calls := 0
+	f := func(v int32) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeInt32Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Str(nil) != nil {
+		t.Errorf("TestMemoizeInt32Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt32Bool(t *testing.T) {
+	calls := 0
+	f := func(v int32) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeInt32Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt32Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt32Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt32Bool(nil) != nil {
+		t.Errorf("TestMemoizeInt32Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Int(t *testing.T) {
+	calls := 0
+	f := func(v int16) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeInt16Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Int(nil) != nil {
+		t.Errorf("TestMemoizeInt16Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Int64(t *testing.T) {
+	calls := 0
+	f := func(v int16) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeInt16Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Int64(nil) != nil {
+		t.Errorf("TestMemoizeInt16Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Int32(t *testing.T) {
+	calls := 0
+	f := func(v int16) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeInt16Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Int32(nil) != nil {
+		t.Errorf("TestMemoizeInt16Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Int8(t *testing.T) {
+	calls := 0
+	f := func(v int16) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeInt16Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Int8(nil) != nil {
+		t.Errorf("TestMemoizeInt16Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Uint(t *testing.T) {
+	calls := 0
+	f := func(v int16) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeInt16Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Uint(nil) != nil {
+		t.Errorf("TestMemoizeInt16Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Uint64(t *testing.T) {
+	calls := 0
+	f := func(v int16) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeInt16Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Uint64(nil) != nil {
+		t.Errorf("TestMemoizeInt16Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Uint32(t *testing.T) {
+	calls := 0
+	f := func(v int16) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeInt16Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Uint32(nil) != nil {
+		t.Errorf("TestMemoizeInt16Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Uint16(t *testing.T) {
+	calls := 0
+	f := func(v int16) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeInt16Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Uint16(nil) != nil {
+		t.Errorf("TestMemoizeInt16Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Uint8(t *testing.T) {
+	calls := 0
+	f := func(v int16) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeInt16Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Uint8(nil) != nil {
+		t.Errorf("TestMemoizeInt16Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Str(t *testing.T) {
+	calls := 0
+	f := func(v int16) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeInt16Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Str(nil) != nil {
+		t.Errorf("TestMemoizeInt16Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt16Bool(t *testing.T) {
+	calls := 0
+	f := func(v int16) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeInt16Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt16Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt16Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt16Bool(nil) != nil {
+		t.Errorf("TestMemoizeInt16Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Int(t *testing.T) {
+	calls := 0
+	f := func(v int8) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeInt8Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Int(nil) != nil {
+		t.Errorf("TestMemoizeInt8Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Int64(t *testing.T) {
+	calls := 0
+	f := func(v int8) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeInt8Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Int64(nil) != nil {
+		t.Errorf("TestMemoizeInt8Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Int32(t *testing.T) {
+	calls := 0
+	f := func(v int8) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeInt8Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Int32(nil) != nil {
+		t.Errorf("TestMemoizeInt8Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Int16(t *testing.T) {
+	calls := 0
+	f := func(v int8) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeInt8Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Int16(nil) != nil {
+		t.Errorf("TestMemoizeInt8Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Uint(t *testing.T) {
+	calls := 0
+	f := func(v int8) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeInt8Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Uint(nil) != nil {
+		t.Errorf("TestMemoizeInt8Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Uint64(t *testing.T) {
+	calls := 0
+	f := func(v int8) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeInt8Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Uint64(nil) != nil {
+		t.Errorf("TestMemoizeInt8Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Uint32(t *testing.T) {
+	calls := 0
+	f := func(v int8) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeInt8Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Uint32(nil) != nil {
+		t.Errorf("TestMemoizeInt8Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Uint16(t *testing.T) {
+	calls := 0
+	f := func(v int8) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeInt8Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Uint16(nil) != nil {
+		t.Errorf("TestMemoizeInt8Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Uint8(t *testing.T) {
+	calls := 0
+	f := func(v int8) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeInt8Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Uint8(nil) != nil {
+		t.Errorf("TestMemoizeInt8Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Str(t *testing.T) {
+	calls := 0
+	f := func(v int8) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeInt8Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Str(nil) != nil {
+		t.Errorf("TestMemoizeInt8Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeInt8Bool(t *testing.T) {
+	calls := 0
+	f := func(v int8) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeInt8Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeInt8Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeInt8Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeInt8Bool(nil) != nil {
+		t.Errorf("TestMemoizeInt8Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintInt(t *testing.T) {
+	calls := 0
+	f := func(v uint) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeUintInt(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintInt failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintInt failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintInt(nil) != nil {
+		t.Errorf("TestMemoizeUintInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintInt64(t *testing.T) {
+	calls := 0
+	f := func(v uint) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeUintInt64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintInt64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintInt64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintInt64(nil) != nil {
+		t.Errorf("TestMemoizeUintInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintInt32(t *testing.T) {
+	calls := 0
+	f := func(v uint) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeUintInt32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintInt32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintInt32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintInt32(nil) != nil {
+		t.Errorf("TestMemoizeUintInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintInt16(t *testing.T) {
+	calls := 0
+	f := func(v uint) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeUintInt16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintInt16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintInt16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintInt16(nil) != nil {
+		t.Errorf("TestMemoizeUintInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintInt8(t *testing.T) {
+	calls := 0
+	f := func(v uint) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeUintInt8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintInt8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintInt8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintInt8(nil) != nil {
+		t.Errorf("TestMemoizeUintInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintUint64(t *testing.T) {
+	calls := 0
+	f := func(v uint) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeUintUint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintUint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintUint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintUint64(nil) != nil {
+		t.Errorf("TestMemoizeUintUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintUint32(t *testing.T) {
+	calls := 0
+	f := func(v uint) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeUintUint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintUint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintUint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintUint32(nil) != nil {
+		t.Errorf("TestMemoizeUintUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintUint16(t *testing.T) {
+	calls := 0
+	f := func(v uint) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeUintUint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintUint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintUint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintUint16(nil) != nil {
+		t.Errorf("TestMemoizeUintUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintUint8(t *testing.T) {
+	calls := 0
+	f := func(v uint) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeUintUint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintUint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintUint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintUint8(nil) != nil {
+		t.Errorf("TestMemoizeUintUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintStr(t *testing.T) {
+	calls := 0
+	f := func(v uint) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeUintStr(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintStr failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintStr failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintStr(nil) != nil {
+		t.Errorf("TestMemoizeUintStr failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUintBool(t *testing.T) {
+	calls := 0
+	f := func(v uint) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeUintBool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUintBool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUintBool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUintBool(nil) != nil {
+		t.Errorf("TestMemoizeUintBool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Int(t *testing.T) {
+	calls := 0
+	f := func(v uint64) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeUint64Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Int(nil) != nil {
+		t.Errorf("TestMemoizeUint64Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Int64(t *testing.T) {
+	calls := 0
+	f := func(v uint64) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeUint64Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Int64(nil) != nil {
+		t.Errorf("TestMemoizeUint64Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Int32(t *testing.T) {
+	calls := 0
+	f := func(v uint64) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeUint64Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Int32(nil) != nil {
+		t.Errorf("TestMemoizeUint64Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Int16(t *testing.T) {
+	calls := 0
+	f := func(v uint64) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeUint64Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Int16(nil) != nil {
+		t.Errorf("TestMemoizeUint64Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Int8(t *testing.T) {
+	calls := 0
+	f := func(v uint64) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeUint64Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Int8(nil) != nil {
+		t.Errorf("TestMemoizeUint64Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Uint(t *testing.T) {
+	calls := 0
+	f := func(v uint64) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeUint64Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Uint(nil) != nil {
+		t.Errorf("TestMemoizeUint64Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Uint32(t *testing.T) {
+	calls := 0
+	f := func(v uint64) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeUint64Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Uint32(nil) != nil {
+		t.Errorf("TestMemoizeUint64Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Uint16(t *testing.T) {
+	calls := 0
+	f := func(v uint64) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeUint64Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Uint16(nil) != nil {
+		t.Errorf("TestMemoizeUint64Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Uint8(t *testing.T) {
+	calls := 0
+	f := func(v uint64) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeUint64Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Uint8(nil) != nil {
+		t.Errorf("TestMemoizeUint64Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Str(t *testing.T) {
+	calls := 0
+	f := func(v uint64) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeUint64Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Str(nil) != nil {
+		t.Errorf("TestMemoizeUint64Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint64Bool(t *testing.T) {
+	calls := 0
+	f := func(v uint64) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeUint64Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint64Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint64Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint64Bool(nil) != nil {
+		t.Errorf("TestMemoizeUint64Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Int(t *testing.T) {
+	calls := 0
+	f := func(v uint32) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeUint32Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Int(nil) != nil {
+		t.Errorf("TestMemoizeUint32Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Int64(t *testing.T) {
+	calls := 0
+	f := func(v uint32) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeUint32Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Int64(nil) != nil {
+		t.Errorf("TestMemoizeUint32Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Int32(t *testing.T) {
+	calls := 0
+	f := func(v uint32) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeUint32Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Int32(nil) != nil {
+		t.Errorf("TestMemoizeUint32Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Int16(t *testing.T) {
+	calls := 0
+	f := func(v uint32) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeUint32Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Int16(nil) != nil {
+		t.Errorf("TestMemoizeUint32Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Int8(t *testing.T) {
+	calls := 0
+	f := func(v uint32) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeUint32Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Int8(nil) != nil {
+		t.Errorf("TestMemoizeUint32Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Uint(t *testing.T) {
+	calls := 0
+	f := func(v uint32) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeUint32Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Uint(nil) != nil {
+		t.Errorf("TestMemoizeUint32Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Uint64(t *testing.T) {
+	calls := 0
+	f := func(v uint32) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeUint32Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Uint64(nil) != nil {
+		t.Errorf("TestMemoizeUint32Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Uint16(t *testing.T) {
+	calls := 0
+	f := func(v uint32) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeUint32Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Uint16(nil) != nil {
+		t.Errorf("TestMemoizeUint32Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Uint8(t *testing.T) {
+	calls := 0
+	f := func(v uint32) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeUint32Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Uint8(nil) != nil {
+		t.Errorf("TestMemoizeUint32Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Str(t *testing.T) {
+	calls := 0
+	f := func(v uint32) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeUint32Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Str(nil) != nil {
+		t.Errorf("TestMemoizeUint32Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint32Bool(t *testing.T) {
+	calls := 0
+	f := func(v uint32) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeUint32Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint32Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint32Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint32Bool(nil) != nil {
+		t.Errorf("TestMemoizeUint32Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Int(t *testing.T) {
+	calls := 0
+	f := func(v uint16) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeUint16Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Int(nil) != nil {
+		t.Errorf("TestMemoizeUint16Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Int64(t *testing.T) {
+	calls := 0
+	f := func(v uint16) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeUint16Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Int64(nil) != nil {
+		t.Errorf("TestMemoizeUint16Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Int32(t *testing.T) {
+	calls := 0
+	f := func(v uint16) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeUint16Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Int32(nil) != nil {
+		t.Errorf("TestMemoizeUint16Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Int16(t *testing.T) {
+	calls := 0
+	f := func(v uint16) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeUint16Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Int16(nil) != nil {
+		t.Errorf("TestMemoizeUint16Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Int8(t *testing.T) {
+	calls := 0
+	f := func(v uint16) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeUint16Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Int8(nil) != nil {
+		t.Errorf("TestMemoizeUint16Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Uint(t *testing.T) {
+	calls := 0
+	f := func(v uint16) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeUint16Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Uint(nil) != nil {
+		t.Errorf("TestMemoizeUint16Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Uint64(t *testing.T) {
+	calls := 0
+	f := func(v uint16) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeUint16Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Uint64(nil) != nil {
+		t.Errorf("TestMemoizeUint16Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Uint32(t *testing.T) {
+	calls := 0
+	f := func(v uint16) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeUint16Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Uint32(nil) != nil {
+		t.Errorf("TestMemoizeUint16Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Uint8(t *testing.T) {
+	calls := 0
+	f := func(v uint16) uint8 {
+		calls++
+		return uint8(v + 1)
+	}
+
+	memoized := MemoizeUint16Uint8(f)
+	expected := uint8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Uint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Uint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Uint8(nil) != nil {
+		t.Errorf("TestMemoizeUint16Uint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Str(t *testing.T) {
+	calls := 0
+	f := func(v uint16) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeUint16Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Str(nil) != nil {
+		t.Errorf("TestMemoizeUint16Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint16Bool(t *testing.T) {
+	calls := 0
+	f := func(v uint16) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeUint16Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint16Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint16Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint16Bool(nil) != nil {
+		t.Errorf("TestMemoizeUint16Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Int(t *testing.T) {
+	calls := 0
+	f := func(v uint8) int {
+		calls++
+		return int(v + 1)
+	}
+
+	memoized := MemoizeUint8Int(f)
+	expected := int(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Int failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Int failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Int(nil) != nil {
+		t.Errorf("TestMemoizeUint8Int failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Int64(t *testing.T) {
+	calls := 0
+	f := func(v uint8) int64 {
+		calls++
+		return int64(v + 1)
+	}
+
+	memoized := MemoizeUint8Int64(f)
+	expected := int64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Int64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Int64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Int64(nil) != nil {
+		t.Errorf("TestMemoizeUint8Int64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Int32(t *testing.T) {
+	calls := 0
+	f := func(v uint8) int32 {
+		calls++
+		return int32(v + 1)
+	}
+
+	memoized := MemoizeUint8Int32(f)
+	expected := int32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Int32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Int32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Int32(nil) != nil {
+		t.Errorf("TestMemoizeUint8Int32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Int16(t *testing.T) {
+	calls := 0
+	f := func(v uint8) int16 {
+		calls++
+		return int16(v + 1)
+	}
+
+	memoized := MemoizeUint8Int16(f)
+	expected := int16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Int16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Int16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Int16(nil) != nil {
+		t.Errorf("TestMemoizeUint8Int16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Int8(t *testing.T) {
+	calls := 0
+	f := func(v uint8) int8 {
+		calls++
+		return int8(v + 1)
+	}
+
+	memoized := MemoizeUint8Int8(f)
+	expected := int8(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Int8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Int8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Int8(nil) != nil {
+		t.Errorf("TestMemoizeUint8Int8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Uint(t *testing.T) {
+	calls := 0
+	f := func(v uint8) uint {
+		calls++
+		return uint(v + 1)
+	}
+
+	memoized := MemoizeUint8Uint(f)
+	expected := uint(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Uint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Uint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Uint(nil) != nil {
+		t.Errorf("TestMemoizeUint8Uint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Uint64(t *testing.T) {
+	calls := 0
+	f := func(v uint8) uint64 {
+		calls++
+		return uint64(v + 1)
+	}
+
+	memoized := MemoizeUint8Uint64(f)
+	expected := uint64(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Uint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Uint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Uint64(nil) != nil {
+		t.Errorf("TestMemoizeUint8Uint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Uint32(t *testing.T) {
+	calls := 0
+	f := func(v uint8) uint32 {
+		calls++
+		return uint32(v + 1)
+	}
+
+	memoized := MemoizeUint8Uint32(f)
+	expected := uint32(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Uint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Uint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Uint32(nil) != nil {
+		t.Errorf("TestMemoizeUint8Uint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Uint16(t *testing.T) {
+	calls := 0
+	f := func(v uint8) uint16 {
+		calls++
+		return uint16(v + 1)
+	}
+
+	memoized := MemoizeUint8Uint16(f)
+	expected := uint16(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Uint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Uint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Uint16(nil) != nil {
+		t.Errorf("TestMemoizeUint8Uint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Str(t *testing.T) {
+	calls := 0
+	f := func(v uint8) string {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := MemoizeUint8Str(f)
+	expected := string("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Str failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Str failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Str(nil) != nil {
+		t.Errorf("TestMemoizeUint8Str failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeUint8Bool(t *testing.T) {
+	calls := 0
+	f := func(v uint8) bool {
+		calls++
+		return v > 1
+	}
+
+	memoized := MemoizeUint8Bool(f)
+	expected := bool(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeUint8Bool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoizeUint8Bool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeUint8Bool(nil) != nil {
+		t.Errorf("TestMemoizeUint8Bool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrInt(t *testing.T) {
+	calls := 0
+	f := func(v string) int {
+		calls++
+		return int(len(v))
+	}
+
+	memoized := MemoizeStrInt(f)
+	expected := int(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrInt failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrInt failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrInt(nil) != nil {
+		t.Errorf("TestMemoizeStrInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrInt64(t *testing.T) {
+	calls := 0
+	f := func(v string) int64 {
+		calls++
+		return int64(len(v))
+	}
+
+	memoized := MemoizeStrInt64(f)
+	expected := int64(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrInt64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrInt64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrInt64(nil) != nil {
+		t.Errorf("TestMemoizeStrInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrInt32(t *testing.T) {
+	calls := 0
+	f := func(v string) int32 {
+		calls++
+		return int32(len(v))
+	}
+
+	memoized := MemoizeStrInt32(f)
+	expected := int32(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrInt32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrInt32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrInt32(nil) != nil {
+		t.Errorf("TestMemoizeStrInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrInt16(t *testing.T) {
+	calls := 0
+	f := func(v string) int16 {
+		calls++
+		return int16(len(v))
+	}
+
+	memoized := MemoizeStrInt16(f)
+	expected := int16(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrInt16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrInt16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrInt16(nil) != nil {
+		t.Errorf("TestMemoizeStrInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrInt8(t *testing.T) {
+	calls := 0
+	f := func(v string) int8 {
+		calls++
+		return int8(len(v))
+	}
+
+	memoized := MemoizeStrInt8(f)
+	expected := int8(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrInt8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrInt8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrInt8(nil) != nil {
+		t.Errorf("TestMemoizeStrInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrUint(t *testing.T) {
+	calls := 0
+	f := func(v string) uint {
+		calls++
+		return uint(len(v))
+	}
+
+	memoized := MemoizeStrUint(f)
+	expected := uint(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrUint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrUint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrUint(nil) != nil {
+		t.Errorf("TestMemoizeStrUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrUint64(t *testing.T) {
+	calls := 0
+	f := func(v string) uint64 {
+		calls++
+		return uint64(len(v))
+	}
+
+	memoized := MemoizeStrUint64(f)
+	expected := uint64(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrUint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrUint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrUint64(nil) != nil {
+		t.Errorf("TestMemoizeStrUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrUint32(t *testing.T) {
+	calls := 0
+	f := func(v string) uint32 {
+		calls++
+		return uint32(len(v))
+	}
+
+	memoized := MemoizeStrUint32(f)
+	expected := uint32(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrUint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrUint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrUint32(nil) != nil {
+		t.Errorf("TestMemoizeStrUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrUint16(t *testing.T) {
+	calls := 0
+	f := func(v string) uint16 {
+		calls++
+		return uint16(len(v))
+	}
+
+	memoized := MemoizeStrUint16(f)
+	expected := uint16(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrUint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrUint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrUint16(nil) != nil {
+		t.Errorf("TestMemoizeStrUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrUint8(t *testing.T) {
+	calls := 0
+	f := func(v string) uint8 {
+		calls++
+		return uint8(len(v))
+	}
+
+	memoized := MemoizeStrUint8(f)
+	expected := uint8(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrUint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrUint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrUint8(nil) != nil {
+		t.Errorf("TestMemoizeStrUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeStrBool(t *testing.T) {
+	calls := 0
+	f := func(v string) bool {
+		calls++
+		return len(v) > 1
+	}
+
+	memoized := MemoizeStrBool(f)
+	expected := bool(true)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeStrBool failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("a")
+	if calls != 2 {
+		t.Errorf("TestMemoizeStrBool failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeStrBool(nil) != nil {
+		t.Errorf("TestMemoizeStrBool failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolInt(t *testing.T) {
+	calls := 0
+	f := func(v bool) int {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolInt(f)
+	expected := int(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolInt failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolInt failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolInt(nil) != nil {
+		t.Errorf("TestMemoizeBoolInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolInt64(t *testing.T) {
+	calls := 0
+	f := func(v bool) int64 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolInt64(f)
+	expected := int64(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolInt64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolInt64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolInt64(nil) != nil {
+		t.Errorf("TestMemoizeBoolInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolInt32(t *testing.T) {
+	calls := 0
+	f := func(v bool) int32 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolInt32(f)
+	expected := int32(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolInt32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolInt32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolInt32(nil) != nil {
+		t.Errorf("TestMemoizeBoolInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolInt16(t *testing.T) {
+	calls := 0
+	f := func(v bool) int16 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolInt16(f)
+	expected := int16(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolInt16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolInt16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolInt16(nil) != nil {
+		t.Errorf("TestMemoizeBoolInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolInt8(t *testing.T) {
+	calls := 0
+	f := func(v bool) int8 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolInt8(f)
+	expected := int8(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolInt8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolInt8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolInt8(nil) != nil {
+		t.Errorf("TestMemoizeBoolInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolUint(t *testing.T) {
+	calls := 0
+	f := func(v bool) uint {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolUint(f)
+	expected := uint(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolUint failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolUint failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolUint(nil) != nil {
+		t.Errorf("TestMemoizeBoolUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolUint64(t *testing.T) {
+	calls := 0
+	f := func(v bool) uint64 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolUint64(f)
+	expected := uint64(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolUint64 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolUint64 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolUint64(nil) != nil {
+		t.Errorf("TestMemoizeBoolUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolUint32(t *testing.T) {
+	calls := 0
+	f := func(v bool) uint32 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolUint32(f)
+	expected := uint32(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolUint32 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolUint32 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolUint32(nil) != nil {
+		t.Errorf("TestMemoizeBoolUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolUint16(t *testing.T) {
+	calls := 0
+	f := func(v bool) uint16 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolUint16(f)
+	expected := uint16(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolUint16 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolUint16 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolUint16(nil) != nil {
+		t.Errorf("TestMemoizeBoolUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolUint8(t *testing.T) {
+	calls := 0
+	f := func(v bool) uint8 {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := MemoizeBoolUint8(f)
+	expected := uint8(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolUint8 failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolUint8 failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolUint8(nil) != nil {
+		t.Errorf("TestMemoizeBoolUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestMemoizeBoolStr(t *testing.T) {
+	calls := 0
+	f := func(v bool) string {
+		calls++
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+
+	memoized := MemoizeBoolStr(f)
+	expected := string("yes")
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoizeBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoizeBoolStr failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoizeBoolStr failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if MemoizeBoolStr(nil) != nil {
+		t.Errorf("TestMemoizeBoolStr failed. Expected nil function for nil input")
+	}
+}
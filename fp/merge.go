@@ -1,1973 +1,9 @@
 package fp
 
-// MergeInt takes two inputs: map[int]int and map[int]int and merge two maps and returns a new map[int]int.
-func MergeInt(map1, map2 map[int]int) map[int]int {
-	if map1 == nil && map2 == nil {
-		return map[int]int{}
-	}
-
-	newMap := make(map[int]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntInt64 takes two inputs: map[int]int64 and map[int]int64 and merge two maps and returns a new map[int]int64.
-func MergeIntInt64(map1, map2 map[int]int64) map[int]int64 {
-	if map1 == nil && map2 == nil {
-		return map[int]int64{}
-	}
-
-	newMap := make(map[int]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntInt32 takes two inputs: map[int]int32 and map[int]int32 and merge two maps and returns a new map[int]int32.
-func MergeIntInt32(map1, map2 map[int]int32) map[int]int32 {
-	if map1 == nil && map2 == nil {
-		return map[int]int32{}
-	}
-
-	newMap := make(map[int]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntInt16 takes two inputs: map[int]int16 and map[int]int16 and merge two maps and returns a new map[int]int16.
-func MergeIntInt16(map1, map2 map[int]int16) map[int]int16 {
-	if map1 == nil && map2 == nil {
-		return map[int]int16{}
-	}
-
-	newMap := make(map[int]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntInt8 takes two inputs: map[int]int8 and map[int]int8 and merge two maps and returns a new map[int]int8.
-func MergeIntInt8(map1, map2 map[int]int8) map[int]int8 {
-	if map1 == nil && map2 == nil {
-		return map[int]int8{}
-	}
-
-	newMap := make(map[int]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntUint takes two inputs: map[int]uint and map[int]uint and merge two maps and returns a new map[int]uint.
-func MergeIntUint(map1, map2 map[int]uint) map[int]uint {
-	if map1 == nil && map2 == nil {
-		return map[int]uint{}
-	}
-
-	newMap := make(map[int]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntUint64 takes two inputs: map[int]uint64 and map[int]uint64 and merge two maps and returns a new map[int]uint64.
-func MergeIntUint64(map1, map2 map[int]uint64) map[int]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[int]uint64{}
-	}
-
-	newMap := make(map[int]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntUint32 takes two inputs: map[int]uint32 and map[int]uint32 and merge two maps and returns a new map[int]uint32.
-func MergeIntUint32(map1, map2 map[int]uint32) map[int]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[int]uint32{}
-	}
-
-	newMap := make(map[int]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntUint16 takes two inputs: map[int]uint16 and map[int]uint16 and merge two maps and returns a new map[int]uint16.
-func MergeIntUint16(map1, map2 map[int]uint16) map[int]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[int]uint16{}
-	}
-
-	newMap := make(map[int]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntUint8 takes two inputs: map[int]uint8 and map[int]uint8 and merge two maps and returns a new map[int]uint8.
-func MergeIntUint8(map1, map2 map[int]uint8) map[int]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[int]uint8{}
-	}
-
-	newMap := make(map[int]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntStr takes two inputs: map[int]string and map[int]string and merge two maps and returns a new map[int]string.
-func MergeIntStr(map1, map2 map[int]string) map[int]string {
-	if map1 == nil && map2 == nil {
-		return map[int]string{}
-	}
-
-	newMap := make(map[int]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeIntBool takes two inputs: map[int]bool and map[int]bool and merge two maps and returns a new map[int]bool.
-func MergeIntBool(map1, map2 map[int]bool) map[int]bool {
-	if map1 == nil && map2 == nil {
-		return map[int]bool{}
-	}
-
-	newMap := make(map[int]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Int takes two inputs: map[int64]int and map[int64]int and merge two maps and returns a new map[int64]int.
-func MergeInt64Int(map1, map2 map[int64]int) map[int64]int {
-	if map1 == nil && map2 == nil {
-		return map[int64]int{}
-	}
-
-	newMap := make(map[int64]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64 takes two inputs: map[int64]int64 and map[int64]int64 and merge two maps and returns a new map[int64]int64.
-func MergeInt64(map1, map2 map[int64]int64) map[int64]int64 {
-	if map1 == nil && map2 == nil {
-		return map[int64]int64{}
-	}
-
-	newMap := make(map[int64]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Int32 takes two inputs: map[int64]int32 and map[int64]int32 and merge two maps and returns a new map[int64]int32.
-func MergeInt64Int32(map1, map2 map[int64]int32) map[int64]int32 {
-	if map1 == nil && map2 == nil {
-		return map[int64]int32{}
-	}
-
-	newMap := make(map[int64]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Int16 takes two inputs: map[int64]int16 and map[int64]int16 and merge two maps and returns a new map[int64]int16.
-func MergeInt64Int16(map1, map2 map[int64]int16) map[int64]int16 {
-	if map1 == nil && map2 == nil {
-		return map[int64]int16{}
-	}
-
-	newMap := make(map[int64]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Int8 takes two inputs: map[int64]int8 and map[int64]int8 and merge two maps and returns a new map[int64]int8.
-func MergeInt64Int8(map1, map2 map[int64]int8) map[int64]int8 {
-	if map1 == nil && map2 == nil {
-		return map[int64]int8{}
-	}
-
-	newMap := make(map[int64]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Uint takes two inputs: map[int64]uint and map[int64]uint and merge two maps and returns a new map[int64]uint.
-func MergeInt64Uint(map1, map2 map[int64]uint) map[int64]uint {
-	if map1 == nil && map2 == nil {
-		return map[int64]uint{}
-	}
-
-	newMap := make(map[int64]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Uint64 takes two inputs: map[int64]uint64 and map[int64]uint64 and merge two maps and returns a new map[int64]uint64.
-func MergeInt64Uint64(map1, map2 map[int64]uint64) map[int64]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[int64]uint64{}
-	}
-
-	newMap := make(map[int64]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Uint32 takes two inputs: map[int64]uint32 and map[int64]uint32 and merge two maps and returns a new map[int64]uint32.
-func MergeInt64Uint32(map1, map2 map[int64]uint32) map[int64]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[int64]uint32{}
-	}
-
-	newMap := make(map[int64]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Uint16 takes two inputs: map[int64]uint16 and map[int64]uint16 and merge two maps and returns a new map[int64]uint16.
-func MergeInt64Uint16(map1, map2 map[int64]uint16) map[int64]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[int64]uint16{}
-	}
-
-	newMap := make(map[int64]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Uint8 takes two inputs: map[int64]uint8 and map[int64]uint8 and merge two maps and returns a new map[int64]uint8.
-func MergeInt64Uint8(map1, map2 map[int64]uint8) map[int64]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[int64]uint8{}
-	}
-
-	newMap := make(map[int64]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Str takes two inputs: map[int64]string and map[int64]string and merge two maps and returns a new map[int64]string.
-func MergeInt64Str(map1, map2 map[int64]string) map[int64]string {
-	if map1 == nil && map2 == nil {
-		return map[int64]string{}
-	}
-
-	newMap := make(map[int64]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt64Bool takes two inputs: map[int64]bool and map[int64]bool and merge two maps and returns a new map[int64]bool.
-func MergeInt64Bool(map1, map2 map[int64]bool) map[int64]bool {
-	if map1 == nil && map2 == nil {
-		return map[int64]bool{}
-	}
-
-	newMap := make(map[int64]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Int takes two inputs: map[int32]int and map[int32]int and merge two maps and returns a new map[int32]int.
-func MergeInt32Int(map1, map2 map[int32]int) map[int32]int {
-	if map1 == nil && map2 == nil {
-		return map[int32]int{}
-	}
-
-	newMap := make(map[int32]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Int64 takes two inputs: map[int32]int64 and map[int32]int64 and merge two maps and returns a new map[int32]int64.
-func MergeInt32Int64(map1, map2 map[int32]int64) map[int32]int64 {
-	if map1 == nil && map2 == nil {
-		return map[int32]int64{}
-	}
-
-	newMap := make(map[int32]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32 takes two inputs: map[int32]int32 and map[int32]int32 and merge two maps and returns a new map[int32]int32.
-func MergeInt32(map1, map2 map[int32]int32) map[int32]int32 {
-	if map1 == nil && map2 == nil {
-		return map[int32]int32{}
-	}
-
-	newMap := make(map[int32]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Int16 takes two inputs: map[int32]int16 and map[int32]int16 and merge two maps and returns a new map[int32]int16.
-func MergeInt32Int16(map1, map2 map[int32]int16) map[int32]int16 {
-	if map1 == nil && map2 == nil {
-		return map[int32]int16{}
-	}
-
-	newMap := make(map[int32]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Int8 takes two inputs: map[int32]int8 and map[int32]int8 and merge two maps and returns a new map[int32]int8.
-func MergeInt32Int8(map1, map2 map[int32]int8) map[int32]int8 {
-	if map1 == nil && map2 == nil {
-		return map[int32]int8{}
-	}
-
-	newMap := make(map[int32]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Uint takes two inputs: map[int32]uint and map[int32]uint and merge two maps and returns a new map[int32]uint.
-func MergeInt32Uint(map1, map2 map[int32]uint) map[int32]uint {
-	if map1 == nil && map2 == nil {
-		return map[int32]uint{}
-	}
-
-	newMap := make(map[int32]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Uint64 takes two inputs: map[int32]uint64 and map[int32]uint64 and merge two maps and returns a new map[int32]uint64.
-func MergeInt32Uint64(map1, map2 map[int32]uint64) map[int32]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[int32]uint64{}
-	}
-
-	newMap := make(map[int32]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Uint32 takes two inputs: map[int32]uint32 and map[int32]uint32 and merge two maps and returns a new map[int32]uint32.
-func MergeInt32Uint32(map1, map2 map[int32]uint32) map[int32]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[int32]uint32{}
-	}
-
-	newMap := make(map[int32]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Uint16 takes two inputs: map[int32]uint16 and map[int32]uint16 and merge two maps and returns a new map[int32]uint16.
-func MergeInt32Uint16(map1, map2 map[int32]uint16) map[int32]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[int32]uint16{}
-	}
-
-	newMap := make(map[int32]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Uint8 takes two inputs: map[int32]uint8 and map[int32]uint8 and merge two maps and returns a new map[int32]uint8.
-func MergeInt32Uint8(map1, map2 map[int32]uint8) map[int32]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[int32]uint8{}
-	}
-
-	newMap := make(map[int32]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Str takes two inputs: map[int32]string and map[int32]string and merge two maps and returns a new map[int32]string.
-func MergeInt32Str(map1, map2 map[int32]string) map[int32]string {
-	if map1 == nil && map2 == nil {
-		return map[int32]string{}
-	}
-
-	newMap := make(map[int32]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt32Bool takes two inputs: map[int32]bool and map[int32]bool and merge two maps and returns a new map[int32]bool.
-func MergeInt32Bool(map1, map2 map[int32]bool) map[int32]bool {
-	if map1 == nil && map2 == nil {
-		return map[int32]bool{}
-	}
-
-	newMap := make(map[int32]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Int takes two inputs: map[int16]int and map[int16]int and merge two maps and returns a new map[int16]int.
-func MergeInt16Int(map1, map2 map[int16]int) map[int16]int {
-	if map1 == nil && map2 == nil {
-		return map[int16]int{}
-	}
-
-	newMap := make(map[int16]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Int64 takes two inputs: map[int16]int64 and map[int16]int64 and merge two maps and returns a new map[int16]int64.
-func MergeInt16Int64(map1, map2 map[int16]int64) map[int16]int64 {
-	if map1 == nil && map2 == nil {
-		return map[int16]int64{}
-	}
-
-	newMap := make(map[int16]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Int32 takes two inputs: map[int16]int32 and map[int16]int32 and merge two maps and returns a new map[int16]int32.
-func MergeInt16Int32(map1, map2 map[int16]int32) map[int16]int32 {
-	if map1 == nil && map2 == nil {
-		return map[int16]int32{}
-	}
-
-	newMap := make(map[int16]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16 takes two inputs: map[int16]int16 and map[int16]int16 and merge two maps and returns a new map[int16]int16.
-func MergeInt16(map1, map2 map[int16]int16) map[int16]int16 {
-	if map1 == nil && map2 == nil {
-		return map[int16]int16{}
-	}
-
-	newMap := make(map[int16]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Int8 takes two inputs: map[int16]int8 and map[int16]int8 and merge two maps and returns a new map[int16]int8.
-func MergeInt16Int8(map1, map2 map[int16]int8) map[int16]int8 {
-	if map1 == nil && map2 == nil {
-		return map[int16]int8{}
-	}
-
-	newMap := make(map[int16]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Uint takes two inputs: map[int16]uint and map[int16]uint and merge two maps and returns a new map[int16]uint.
-func MergeInt16Uint(map1, map2 map[int16]uint) map[int16]uint {
-	if map1 == nil && map2 == nil {
-		return map[int16]uint{}
-	}
-
-	newMap := make(map[int16]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Uint64 takes two inputs: map[int16]uint64 and map[int16]uint64 and merge two maps and returns a new map[int16]uint64.
-func MergeInt16Uint64(map1, map2 map[int16]uint64) map[int16]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[int16]uint64{}
-	}
-
-	newMap := make(map[int16]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Uint32 takes two inputs: map[int16]uint32 and map[int16]uint32 and merge two maps and returns a new map[int16]uint32.
-func MergeInt16Uint32(map1, map2 map[int16]uint32) map[int16]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[int16]uint32{}
-	}
-
-	newMap := make(map[int16]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Uint16 takes two inputs: map[int16]uint16 and map[int16]uint16 and merge two maps and returns a new map[int16]uint16.
-func MergeInt16Uint16(map1, map2 map[int16]uint16) map[int16]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[int16]uint16{}
-	}
-
-	newMap := make(map[int16]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Uint8 takes two inputs: map[int16]uint8 and map[int16]uint8 and merge two maps and returns a new map[int16]uint8.
-func MergeInt16Uint8(map1, map2 map[int16]uint8) map[int16]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[int16]uint8{}
-	}
-
-	newMap := make(map[int16]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Str takes two inputs: map[int16]string and map[int16]string and merge two maps and returns a new map[int16]string.
-func MergeInt16Str(map1, map2 map[int16]string) map[int16]string {
-	if map1 == nil && map2 == nil {
-		return map[int16]string{}
-	}
-
-	newMap := make(map[int16]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt16Bool takes two inputs: map[int16]bool and map[int16]bool and merge two maps and returns a new map[int16]bool.
-func MergeInt16Bool(map1, map2 map[int16]bool) map[int16]bool {
-	if map1 == nil && map2 == nil {
-		return map[int16]bool{}
-	}
-
-	newMap := make(map[int16]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Int takes two inputs: map[int8]int and map[int8]int and merge two maps and returns a new map[int8]int.
-func MergeInt8Int(map1, map2 map[int8]int) map[int8]int {
-	if map1 == nil && map2 == nil {
-		return map[int8]int{}
-	}
-
-	newMap := make(map[int8]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Int64 takes two inputs: map[int8]int64 and map[int8]int64 and merge two maps and returns a new map[int8]int64.
-func MergeInt8Int64(map1, map2 map[int8]int64) map[int8]int64 {
-	if map1 == nil && map2 == nil {
-		return map[int8]int64{}
-	}
-
-	newMap := make(map[int8]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Int32 takes two inputs: map[int8]int32 and map[int8]int32 and merge two maps and returns a new map[int8]int32.
-func MergeInt8Int32(map1, map2 map[int8]int32) map[int8]int32 {
-	if map1 == nil && map2 == nil {
-		return map[int8]int32{}
-	}
-
-	newMap := make(map[int8]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Int16 takes two inputs: map[int8]int16 and map[int8]int16 and merge two maps and returns a new map[int8]int16.
-func MergeInt8Int16(map1, map2 map[int8]int16) map[int8]int16 {
-	if map1 == nil && map2 == nil {
-		return map[int8]int16{}
-	}
-
-	newMap := make(map[int8]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8 takes two inputs: map[int8]int8 and map[int8]int8 and merge two maps and returns a new map[int8]int8.
-func MergeInt8(map1, map2 map[int8]int8) map[int8]int8 {
-	if map1 == nil && map2 == nil {
-		return map[int8]int8{}
-	}
-
-	newMap := make(map[int8]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Uint takes two inputs: map[int8]uint and map[int8]uint and merge two maps and returns a new map[int8]uint.
-func MergeInt8Uint(map1, map2 map[int8]uint) map[int8]uint {
-	if map1 == nil && map2 == nil {
-		return map[int8]uint{}
-	}
-
-	newMap := make(map[int8]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Uint64 takes two inputs: map[int8]uint64 and map[int8]uint64 and merge two maps and returns a new map[int8]uint64.
-func MergeInt8Uint64(map1, map2 map[int8]uint64) map[int8]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[int8]uint64{}
-	}
-
-	newMap := make(map[int8]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Uint32 takes two inputs: map[int8]uint32 and map[int8]uint32 and merge two maps and returns a new map[int8]uint32.
-func MergeInt8Uint32(map1, map2 map[int8]uint32) map[int8]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[int8]uint32{}
-	}
-
-	newMap := make(map[int8]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Uint16 takes two inputs: map[int8]uint16 and map[int8]uint16 and merge two maps and returns a new map[int8]uint16.
-func MergeInt8Uint16(map1, map2 map[int8]uint16) map[int8]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[int8]uint16{}
-	}
-
-	newMap := make(map[int8]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Uint8 takes two inputs: map[int8]uint8 and map[int8]uint8 and merge two maps and returns a new map[int8]uint8.
-func MergeInt8Uint8(map1, map2 map[int8]uint8) map[int8]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[int8]uint8{}
-	}
-
-	newMap := make(map[int8]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Str takes two inputs: map[int8]string and map[int8]string and merge two maps and returns a new map[int8]string.
-func MergeInt8Str(map1, map2 map[int8]string) map[int8]string {
-	if map1 == nil && map2 == nil {
-		return map[int8]string{}
-	}
-
-	newMap := make(map[int8]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
-	}
-
-	return newMap
-}
-
-// MergeInt8Bool takes two inputs: map[int8]bool and map[int8]bool and merge two maps and returns a new map[int8]bool.
-func MergeInt8Bool(map1, map2 map[int8]bool) map[int8]bool {
-	if map1 == nil && map2 == nil {
-		return map[int8]bool{}
-	}
-
-	newMap := make(map[int8]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// MergeInt takes two or more inputs of type map[int]int and merge them and returns a new map[int]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt(map1, map2 map[int]int, maps ...map[int]int) map[int]int {
+	newMap := make(map[int]int, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -1977,63 +13,19 @@ func MergeInt8Bool(map1, map2 map[int8]bool) map[int8]bool {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintInt takes two inputs: map[uint]int and map[uint]int and merge two maps and returns a new map[uint]int.
-func MergeUintInt(map1, map2 map[uint]int) map[uint]int {
-	if map1 == nil && map2 == nil {
-		return map[uint]int{}
-	}
-
-	newMap := make(map[uint]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
 	}
 
 	return newMap
 }
 
-// MergeUintInt64 takes two inputs: map[uint]int64 and map[uint]int64 and merge two maps and returns a new map[uint]int64.
-func MergeUintInt64(map1, map2 map[uint]int64) map[uint]int64 {
-	if map1 == nil && map2 == nil {
-		return map[uint]int64{}
-	}
-
-	newMap := make(map[uint]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// MergeIntInt64 takes two or more inputs of type map[int]int64 and merge them and returns a new map[int]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntInt64(map1, map2 map[int]int64, maps ...map[int]int64) map[int]int64 {
+	newMap := make(map[int]int64, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2043,63 +35,19 @@ func MergeUintInt64(map1, map2 map[uint]int64) map[uint]int64 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintInt32 takes two inputs: map[uint]int32 and map[uint]int32 and merge two maps and returns a new map[uint]int32.
-func MergeUintInt32(map1, map2 map[uint]int32) map[uint]int32 {
-	if map1 == nil && map2 == nil {
-		return map[uint]int32{}
-	}
-
-	newMap := make(map[uint]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
 	}
 
 	return newMap
 }
 
-// MergeUintInt16 takes two inputs: map[uint]int16 and map[uint]int16 and merge two maps and returns a new map[uint]int16.
-func MergeUintInt16(map1, map2 map[uint]int16) map[uint]int16 {
-	if map1 == nil && map2 == nil {
-		return map[uint]int16{}
-	}
-
-	newMap := make(map[uint]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// MergeIntInt32 takes two or more inputs of type map[int]int32 and merge them and returns a new map[int]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntInt32(map1, map2 map[int]int32, maps ...map[int]int32) map[int]int32 {
+	newMap := make(map[int]int32, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2109,63 +57,19 @@ func MergeUintInt16(map1, map2 map[uint]int16) map[uint]int16 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintInt8 takes two inputs: map[uint]int8 and map[uint]int8 and merge two maps and returns a new map[uint]int8.
-func MergeUintInt8(map1, map2 map[uint]int8) map[uint]int8 {
-	if map1 == nil && map2 == nil {
-		return map[uint]int8{}
-	}
-
-	newMap := make(map[uint]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
 	}
 
 	return newMap
 }
 
-// MergeUint takes two inputs: map[uint]uint and map[uint]uint and merge two maps and returns a new map[uint]uint.
-func MergeUint(map1, map2 map[uint]uint) map[uint]uint {
-	if map1 == nil && map2 == nil {
-		return map[uint]uint{}
-	}
-
-	newMap := make(map[uint]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// MergeIntInt16 takes two or more inputs of type map[int]int16 and merge them and returns a new map[int]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntInt16(map1, map2 map[int]int16, maps ...map[int]int16) map[int]int16 {
+	newMap := make(map[int]int16, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2175,63 +79,19 @@ func MergeUint(map1, map2 map[uint]uint) map[uint]uint {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintUint64 takes two inputs: map[uint]uint64 and map[uint]uint64 and merge two maps and returns a new map[uint]uint64.
-func MergeUintUint64(map1, map2 map[uint]uint64) map[uint]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[uint]uint64{}
-	}
-
-	newMap := make(map[uint]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
-	}
-
-	for k, v := range map1 {
-		newMap[k] = v
-	}
-
-	for k, v := range map2 {
-		newMap[k] = v
 	}
 
 	return newMap
 }
 
-// MergeUintUint32 takes two inputs: map[uint]uint32 and map[uint]uint32 and merge two maps and returns a new map[uint]uint32.
-func MergeUintUint32(map1, map2 map[uint]uint32) map[uint]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[uint]uint32{}
-	}
-
-	newMap := make(map[uint]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// MergeIntInt8 takes two or more inputs of type map[int]int8 and merge them and returns a new map[int]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntInt8(map1, map2 map[int]int8, maps ...map[int]int8) map[int]int8 {
+	newMap := make(map[int]int8, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2241,30 +101,19 @@ func MergeUintUint32(map1, map2 map[uint]uint32) map[uint]uint32 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintUint16 takes two inputs: map[uint]uint16 and map[uint]uint16 and merge two maps and returns a new map[uint]uint16.
-func MergeUintUint16(map1, map2 map[uint]uint16) map[uint]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[uint]uint16{}
-	}
-
-	newMap := make(map[uint]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntUint takes two or more inputs of type map[int]uint and merge them and returns a new map[int]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntUint(map1, map2 map[int]uint, maps ...map[int]uint) map[int]uint {
+	newMap := make(map[int]uint, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2274,30 +123,19 @@ func MergeUintUint16(map1, map2 map[uint]uint16) map[uint]uint16 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintUint8 takes two inputs: map[uint]uint8 and map[uint]uint8 and merge two maps and returns a new map[uint]uint8.
-func MergeUintUint8(map1, map2 map[uint]uint8) map[uint]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[uint]uint8{}
-	}
-
-	newMap := make(map[uint]uint8)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntUint64 takes two or more inputs of type map[int]uint64 and merge them and returns a new map[int]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntUint64(map1, map2 map[int]uint64, maps ...map[int]uint64) map[int]uint64 {
+	newMap := make(map[int]uint64, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2307,30 +145,19 @@ func MergeUintUint8(map1, map2 map[uint]uint8) map[uint]uint8 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintStr takes two inputs: map[uint]string and map[uint]string and merge two maps and returns a new map[uint]string.
-func MergeUintStr(map1, map2 map[uint]string) map[uint]string {
-	if map1 == nil && map2 == nil {
-		return map[uint]string{}
-	}
-
-	newMap := make(map[uint]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntUint32 takes two or more inputs of type map[int]uint32 and merge them and returns a new map[int]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntUint32(map1, map2 map[int]uint32, maps ...map[int]uint32) map[int]uint32 {
+	newMap := make(map[int]uint32, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2340,30 +167,19 @@ func MergeUintStr(map1, map2 map[uint]string) map[uint]string {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUintBool takes two inputs: map[uint]bool and map[uint]bool and merge two maps and returns a new map[uint]bool.
-func MergeUintBool(map1, map2 map[uint]bool) map[uint]bool {
-	if map1 == nil && map2 == nil {
-		return map[uint]bool{}
-	}
-
-	newMap := make(map[uint]bool)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntUint16 takes two or more inputs of type map[int]uint16 and merge them and returns a new map[int]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntUint16(map1, map2 map[int]uint16, maps ...map[int]uint16) map[int]uint16 {
+	newMap := make(map[int]uint16, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2373,30 +189,19 @@ func MergeUintBool(map1, map2 map[uint]bool) map[uint]bool {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Int takes two inputs: map[uint64]int and map[uint64]int and merge two maps and returns a new map[uint64]int.
-func MergeUint64Int(map1, map2 map[uint64]int) map[uint64]int {
-	if map1 == nil && map2 == nil {
-		return map[uint64]int{}
-	}
-
-	newMap := make(map[uint64]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntUint8 takes two or more inputs of type map[int]uint8 and merge them and returns a new map[int]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntUint8(map1, map2 map[int]uint8, maps ...map[int]uint8) map[int]uint8 {
+	newMap := make(map[int]uint8, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2406,30 +211,19 @@ func MergeUint64Int(map1, map2 map[uint64]int) map[uint64]int {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Int64 takes two inputs: map[uint64]int64 and map[uint64]int64 and merge two maps and returns a new map[uint64]int64.
-func MergeUint64Int64(map1, map2 map[uint64]int64) map[uint64]int64 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]int64{}
-	}
-
-	newMap := make(map[uint64]int64)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntStr takes two or more inputs of type map[int]string and merge them and returns a new map[int]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntStr(map1, map2 map[int]string, maps ...map[int]string) map[int]string {
+	newMap := make(map[int]string, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2439,30 +233,19 @@ func MergeUint64Int64(map1, map2 map[uint64]int64) map[uint64]int64 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Int32 takes two inputs: map[uint64]int32 and map[uint64]int32 and merge two maps and returns a new map[uint64]int32.
-func MergeUint64Int32(map1, map2 map[uint64]int32) map[uint64]int32 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]int32{}
-	}
-
-	newMap := make(map[uint64]int32)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeIntBool takes two or more inputs of type map[int]bool and merge them and returns a new map[int]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntBool(map1, map2 map[int]bool, maps ...map[int]bool) map[int]bool {
+	newMap := make(map[int]bool, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2472,30 +255,19 @@ func MergeUint64Int32(map1, map2 map[uint64]int32) map[uint64]int32 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Int16 takes two inputs: map[uint64]int16 and map[uint64]int16 and merge two maps and returns a new map[uint64]int16.
-func MergeUint64Int16(map1, map2 map[uint64]int16) map[uint64]int16 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]int16{}
-	}
-
-	newMap := make(map[uint64]int16)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeInt64Int takes two or more inputs of type map[int64]int and merge them and returns a new map[int64]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Int(map1, map2 map[int64]int, maps ...map[int64]int) map[int64]int {
+	newMap := make(map[int64]int, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2505,30 +277,19 @@ func MergeUint64Int16(map1, map2 map[uint64]int16) map[uint64]int16 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Int8 takes two inputs: map[uint64]int8 and map[uint64]int8 and merge two maps and returns a new map[uint64]int8.
-func MergeUint64Int8(map1, map2 map[uint64]int8) map[uint64]int8 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]int8{}
-	}
-
-	newMap := make(map[uint64]int8)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeInt64 takes two or more inputs of type map[int64]int64 and merge them and returns a new map[int64]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64(map1, map2 map[int64]int64, maps ...map[int64]int64) map[int64]int64 {
+	newMap := make(map[int64]int64, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2538,30 +299,19 @@ func MergeUint64Int8(map1, map2 map[uint64]int8) map[uint64]int8 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Uint takes two inputs: map[uint64]uint and map[uint64]uint and merge two maps and returns a new map[uint64]uint.
-func MergeUint64Uint(map1, map2 map[uint64]uint) map[uint64]uint {
-	if map1 == nil && map2 == nil {
-		return map[uint64]uint{}
-	}
-
-	newMap := make(map[uint64]uint)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeInt64Int32 takes two or more inputs of type map[int64]int32 and merge them and returns a new map[int64]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Int32(map1, map2 map[int64]int32, maps ...map[int64]int32) map[int64]int32 {
+	newMap := make(map[int64]int32, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2571,30 +321,19 @@ func MergeUint64Uint(map1, map2 map[uint64]uint) map[uint64]uint {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64 takes two inputs: map[uint64]uint64 and map[uint64]uint64 and merge two maps and returns a new map[uint64]uint64.
-func MergeUint64(map1, map2 map[uint64]uint64) map[uint64]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]uint64{}
-	}
-
-	newMap := make(map[uint64]uint64)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeInt64Int16 takes two or more inputs of type map[int64]int16 and merge them and returns a new map[int64]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Int16(map1, map2 map[int64]int16, maps ...map[int64]int16) map[int64]int16 {
+	newMap := make(map[int64]int16, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2604,30 +343,19 @@ func MergeUint64(map1, map2 map[uint64]uint64) map[uint64]uint64 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeUint64Uint32 takes two inputs: map[uint64]uint32 and map[uint64]uint32 and merge two maps and returns a new map[uint64]uint32.
-func MergeUint64Uint32(map1, map2 map[uint64]uint32) map[uint64]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]uint32{}
-	}
-
-	newMap := make(map[uint64]uint32)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeInt64Int8 takes two or more inputs of type map[int64]int8 and merge them and returns a new map[int64]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Int8(map1, map2 map[int64]int8, maps ...map[int64]int8) map[int64]int8 {
+	newMap := make(map[int64]int8, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -2637,31 +365,42 @@ func MergeUint64Uint32(map1, map2 map[uint64]uint32) map[uint64]uint32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint64Uint16 takes two inputs: map[uint64]uint16 and map[uint64]uint16 and merge two maps and returns a new map[uint64]uint16.
-func MergeUint64Uint16(map1, map2 map[uint64]uint16) map[uint64]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]uint16{}
-	}
+// MergeInt64Uint takes two or more inputs of type map[int64]uint and merge them and returns a new map[int64]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Uint(map1, map2 map[int64]uint, maps ...map[int64]uint) map[int64]uint {
+	newMap := make(map[int64]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint64]uint16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt64Uint64 takes two or more inputs of type map[int64]uint64 and merge them and returns a new map[int64]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Uint64(map1, map2 map[int64]uint64, maps ...map[int64]uint64) map[int64]uint64 {
+	newMap := make(map[int64]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2670,31 +409,42 @@ func MergeUint64Uint16(map1, map2 map[uint64]uint16) map[uint64]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint64Uint8 takes two inputs: map[uint64]uint8 and map[uint64]uint8 and merge two maps and returns a new map[uint64]uint8.
-func MergeUint64Uint8(map1, map2 map[uint64]uint8) map[uint64]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[uint64]uint8{}
-	}
+// MergeInt64Uint32 takes two or more inputs of type map[int64]uint32 and merge them and returns a new map[int64]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Uint32(map1, map2 map[int64]uint32, maps ...map[int64]uint32) map[int64]uint32 {
+	newMap := make(map[int64]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint64]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt64Uint16 takes two or more inputs of type map[int64]uint16 and merge them and returns a new map[int64]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Uint16(map1, map2 map[int64]uint16, maps ...map[int64]uint16) map[int64]uint16 {
+	newMap := make(map[int64]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2703,31 +453,42 @@ func MergeUint64Uint8(map1, map2 map[uint64]uint8) map[uint64]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint64Str takes two inputs: map[uint64]string and map[uint64]string and merge two maps and returns a new map[uint64]string.
-func MergeUint64Str(map1, map2 map[uint64]string) map[uint64]string {
-	if map1 == nil && map2 == nil {
-		return map[uint64]string{}
-	}
+// MergeInt64Uint8 takes two or more inputs of type map[int64]uint8 and merge them and returns a new map[int64]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Uint8(map1, map2 map[int64]uint8, maps ...map[int64]uint8) map[int64]uint8 {
+	newMap := make(map[int64]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint64]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt64Str takes two or more inputs of type map[int64]string and merge them and returns a new map[int64]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Str(map1, map2 map[int64]string, maps ...map[int64]string) map[int64]string {
+	newMap := make(map[int64]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2736,31 +497,42 @@ func MergeUint64Str(map1, map2 map[uint64]string) map[uint64]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint64Bool takes two inputs: map[uint64]bool and map[uint64]bool and merge two maps and returns a new map[uint64]bool.
-func MergeUint64Bool(map1, map2 map[uint64]bool) map[uint64]bool {
-	if map1 == nil && map2 == nil {
-		return map[uint64]bool{}
-	}
+// MergeInt64Bool takes two or more inputs of type map[int64]bool and merge them and returns a new map[int64]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt64Bool(map1, map2 map[int64]bool, maps ...map[int64]bool) map[int64]bool {
+	newMap := make(map[int64]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint64]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32Int takes two or more inputs of type map[int32]int and merge them and returns a new map[int32]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Int(map1, map2 map[int32]int, maps ...map[int32]int) map[int32]int {
+	newMap := make(map[int32]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2769,31 +541,42 @@ func MergeUint64Bool(map1, map2 map[uint64]bool) map[uint64]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Int takes two inputs: map[uint32]int and map[uint32]int and merge two maps and returns a new map[uint32]int.
-func MergeUint32Int(map1, map2 map[uint32]int) map[uint32]int {
-	if map1 == nil && map2 == nil {
-		return map[uint32]int{}
-	}
+// MergeInt32Int64 takes two or more inputs of type map[int32]int64 and merge them and returns a new map[int32]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Int64(map1, map2 map[int32]int64, maps ...map[int32]int64) map[int32]int64 {
+	newMap := make(map[int32]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint32]int)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32 takes two or more inputs of type map[int32]int32 and merge them and returns a new map[int32]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32(map1, map2 map[int32]int32, maps ...map[int32]int32) map[int32]int32 {
+	newMap := make(map[int32]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2802,31 +585,42 @@ func MergeUint32Int(map1, map2 map[uint32]int) map[uint32]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Int64 takes two inputs: map[uint32]int64 and map[uint32]int64 and merge two maps and returns a new map[uint32]int64.
-func MergeUint32Int64(map1, map2 map[uint32]int64) map[uint32]int64 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]int64{}
-	}
+// MergeInt32Int16 takes two or more inputs of type map[int32]int16 and merge them and returns a new map[int32]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Int16(map1, map2 map[int32]int16, maps ...map[int32]int16) map[int32]int16 {
+	newMap := make(map[int32]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint32]int64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32Int8 takes two or more inputs of type map[int32]int8 and merge them and returns a new map[int32]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Int8(map1, map2 map[int32]int8, maps ...map[int32]int8) map[int32]int8 {
+	newMap := make(map[int32]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2835,31 +629,42 @@ func MergeUint32Int64(map1, map2 map[uint32]int64) map[uint32]int64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Int32 takes two inputs: map[uint32]int32 and map[uint32]int32 and merge two maps and returns a new map[uint32]int32.
-func MergeUint32Int32(map1, map2 map[uint32]int32) map[uint32]int32 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]int32{}
-	}
+// MergeInt32Uint takes two or more inputs of type map[int32]uint and merge them and returns a new map[int32]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Uint(map1, map2 map[int32]uint, maps ...map[int32]uint) map[int32]uint {
+	newMap := make(map[int32]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint32]int32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32Uint64 takes two or more inputs of type map[int32]uint64 and merge them and returns a new map[int32]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Uint64(map1, map2 map[int32]uint64, maps ...map[int32]uint64) map[int32]uint64 {
+	newMap := make(map[int32]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2868,31 +673,42 @@ func MergeUint32Int32(map1, map2 map[uint32]int32) map[uint32]int32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Int16 takes two inputs: map[uint32]int16 and map[uint32]int16 and merge two maps and returns a new map[uint32]int16.
-func MergeUint32Int16(map1, map2 map[uint32]int16) map[uint32]int16 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]int16{}
-	}
+// MergeInt32Uint32 takes two or more inputs of type map[int32]uint32 and merge them and returns a new map[int32]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Uint32(map1, map2 map[int32]uint32, maps ...map[int32]uint32) map[int32]uint32 {
+	newMap := make(map[int32]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint32]int16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32Uint16 takes two or more inputs of type map[int32]uint16 and merge them and returns a new map[int32]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Uint16(map1, map2 map[int32]uint16, maps ...map[int32]uint16) map[int32]uint16 {
+	newMap := make(map[int32]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2901,31 +717,42 @@ func MergeUint32Int16(map1, map2 map[uint32]int16) map[uint32]int16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Int8 takes two inputs: map[uint32]int8 and map[uint32]int8 and merge two maps and returns a new map[uint32]int8.
-func MergeUint32Int8(map1, map2 map[uint32]int8) map[uint32]int8 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]int8{}
-	}
+// MergeInt32Uint8 takes two or more inputs of type map[int32]uint8 and merge them and returns a new map[int32]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Uint8(map1, map2 map[int32]uint8, maps ...map[int32]uint8) map[int32]uint8 {
+	newMap := make(map[int32]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint32]int8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt32Str takes two or more inputs of type map[int32]string and merge them and returns a new map[int32]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Str(map1, map2 map[int32]string, maps ...map[int32]string) map[int32]string {
+	newMap := make(map[int32]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2934,31 +761,42 @@ func MergeUint32Int8(map1, map2 map[uint32]int8) map[uint32]int8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Uint takes two inputs: map[uint32]uint and map[uint32]uint and merge two maps and returns a new map[uint32]uint.
-func MergeUint32Uint(map1, map2 map[uint32]uint) map[uint32]uint {
-	if map1 == nil && map2 == nil {
-		return map[uint32]uint{}
-	}
+// MergeInt32Bool takes two or more inputs of type map[int32]bool and merge them and returns a new map[int32]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt32Bool(map1, map2 map[int32]bool, maps ...map[int32]bool) map[int32]bool {
+	newMap := make(map[int32]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint32]uint)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Int takes two or more inputs of type map[int16]int and merge them and returns a new map[int16]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Int(map1, map2 map[int16]int, maps ...map[int16]int) map[int16]int {
+	newMap := make(map[int16]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -2967,31 +805,42 @@ func MergeUint32Uint(map1, map2 map[uint32]uint) map[uint32]uint {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Uint64 takes two inputs: map[uint32]uint64 and map[uint32]uint64 and merge two maps and returns a new map[uint32]uint64.
-func MergeUint32Uint64(map1, map2 map[uint32]uint64) map[uint32]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]uint64{}
-	}
+// MergeInt16Int64 takes two or more inputs of type map[int16]int64 and merge them and returns a new map[int16]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Int64(map1, map2 map[int16]int64, maps ...map[int16]int64) map[int16]int64 {
+	newMap := make(map[int16]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint32]uint64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Int32 takes two or more inputs of type map[int16]int32 and merge them and returns a new map[int16]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Int32(map1, map2 map[int16]int32, maps ...map[int16]int32) map[int16]int32 {
+	newMap := make(map[int16]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3000,31 +849,42 @@ func MergeUint32Uint64(map1, map2 map[uint32]uint64) map[uint32]uint64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32 takes two inputs: map[uint32]uint32 and map[uint32]uint32 and merge two maps and returns a new map[uint32]uint32.
-func MergeUint32(map1, map2 map[uint32]uint32) map[uint32]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]uint32{}
-	}
+// MergeInt16 takes two or more inputs of type map[int16]int16 and merge them and returns a new map[int16]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16(map1, map2 map[int16]int16, maps ...map[int16]int16) map[int16]int16 {
+	newMap := make(map[int16]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint32]uint32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Int8 takes two or more inputs of type map[int16]int8 and merge them and returns a new map[int16]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Int8(map1, map2 map[int16]int8, maps ...map[int16]int8) map[int16]int8 {
+	newMap := make(map[int16]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3033,31 +893,42 @@ func MergeUint32(map1, map2 map[uint32]uint32) map[uint32]uint32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Uint16 takes two inputs: map[uint32]uint16 and map[uint32]uint16 and merge two maps and returns a new map[uint32]uint16.
-func MergeUint32Uint16(map1, map2 map[uint32]uint16) map[uint32]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]uint16{}
-	}
+// MergeInt16Uint takes two or more inputs of type map[int16]uint and merge them and returns a new map[int16]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Uint(map1, map2 map[int16]uint, maps ...map[int16]uint) map[int16]uint {
+	newMap := make(map[int16]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint32]uint16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Uint64 takes two or more inputs of type map[int16]uint64 and merge them and returns a new map[int16]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Uint64(map1, map2 map[int16]uint64, maps ...map[int16]uint64) map[int16]uint64 {
+	newMap := make(map[int16]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3066,31 +937,42 @@ func MergeUint32Uint16(map1, map2 map[uint32]uint16) map[uint32]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Uint8 takes two inputs: map[uint32]uint8 and map[uint32]uint8 and merge two maps and returns a new map[uint32]uint8.
-func MergeUint32Uint8(map1, map2 map[uint32]uint8) map[uint32]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[uint32]uint8{}
-	}
+// MergeInt16Uint32 takes two or more inputs of type map[int16]uint32 and merge them and returns a new map[int16]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Uint32(map1, map2 map[int16]uint32, maps ...map[int16]uint32) map[int16]uint32 {
+	newMap := make(map[int16]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint32]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Uint16 takes two or more inputs of type map[int16]uint16 and merge them and returns a new map[int16]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Uint16(map1, map2 map[int16]uint16, maps ...map[int16]uint16) map[int16]uint16 {
+	newMap := make(map[int16]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3099,31 +981,42 @@ func MergeUint32Uint8(map1, map2 map[uint32]uint8) map[uint32]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Str takes two inputs: map[uint32]string and map[uint32]string and merge two maps and returns a new map[uint32]string.
-func MergeUint32Str(map1, map2 map[uint32]string) map[uint32]string {
-	if map1 == nil && map2 == nil {
-		return map[uint32]string{}
-	}
+// MergeInt16Uint8 takes two or more inputs of type map[int16]uint8 and merge them and returns a new map[int16]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Uint8(map1, map2 map[int16]uint8, maps ...map[int16]uint8) map[int16]uint8 {
+	newMap := make(map[int16]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint32]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt16Str takes two or more inputs of type map[int16]string and merge them and returns a new map[int16]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Str(map1, map2 map[int16]string, maps ...map[int16]string) map[int16]string {
+	newMap := make(map[int16]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3132,31 +1025,42 @@ func MergeUint32Str(map1, map2 map[uint32]string) map[uint32]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint32Bool takes two inputs: map[uint32]bool and map[uint32]bool and merge two maps and returns a new map[uint32]bool.
-func MergeUint32Bool(map1, map2 map[uint32]bool) map[uint32]bool {
-	if map1 == nil && map2 == nil {
-		return map[uint32]bool{}
-	}
+// MergeInt16Bool takes two or more inputs of type map[int16]bool and merge them and returns a new map[int16]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt16Bool(map1, map2 map[int16]bool, maps ...map[int16]bool) map[int16]bool {
+	newMap := make(map[int16]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint32]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8Int takes two or more inputs of type map[int8]int and merge them and returns a new map[int8]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Int(map1, map2 map[int8]int, maps ...map[int8]int) map[int8]int {
+	newMap := make(map[int8]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3165,31 +1069,42 @@ func MergeUint32Bool(map1, map2 map[uint32]bool) map[uint32]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Int takes two inputs: map[uint16]int and map[uint16]int and merge two maps and returns a new map[uint16]int.
-func MergeUint16Int(map1, map2 map[uint16]int) map[uint16]int {
-	if map1 == nil && map2 == nil {
-		return map[uint16]int{}
-	}
+// MergeInt8Int64 takes two or more inputs of type map[int8]int64 and merge them and returns a new map[int8]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Int64(map1, map2 map[int8]int64, maps ...map[int8]int64) map[int8]int64 {
+	newMap := make(map[int8]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint16]int)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8Int32 takes two or more inputs of type map[int8]int32 and merge them and returns a new map[int8]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Int32(map1, map2 map[int8]int32, maps ...map[int8]int32) map[int8]int32 {
+	newMap := make(map[int8]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3198,31 +1113,42 @@ func MergeUint16Int(map1, map2 map[uint16]int) map[uint16]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Int64 takes two inputs: map[uint16]int64 and map[uint16]int64 and merge two maps and returns a new map[uint16]int64.
-func MergeUint16Int64(map1, map2 map[uint16]int64) map[uint16]int64 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]int64{}
-	}
+// MergeInt8Int16 takes two or more inputs of type map[int8]int16 and merge them and returns a new map[int8]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Int16(map1, map2 map[int8]int16, maps ...map[int8]int16) map[int8]int16 {
+	newMap := make(map[int8]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint16]int64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8 takes two or more inputs of type map[int8]int8 and merge them and returns a new map[int8]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8(map1, map2 map[int8]int8, maps ...map[int8]int8) map[int8]int8 {
+	newMap := make(map[int8]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3231,31 +1157,42 @@ func MergeUint16Int64(map1, map2 map[uint16]int64) map[uint16]int64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Int32 takes two inputs: map[uint16]int32 and map[uint16]int32 and merge two maps and returns a new map[uint16]int32.
-func MergeUint16Int32(map1, map2 map[uint16]int32) map[uint16]int32 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]int32{}
-	}
+// MergeInt8Uint takes two or more inputs of type map[int8]uint and merge them and returns a new map[int8]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Uint(map1, map2 map[int8]uint, maps ...map[int8]uint) map[int8]uint {
+	newMap := make(map[int8]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint16]int32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8Uint64 takes two or more inputs of type map[int8]uint64 and merge them and returns a new map[int8]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Uint64(map1, map2 map[int8]uint64, maps ...map[int8]uint64) map[int8]uint64 {
+	newMap := make(map[int8]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3264,31 +1201,42 @@ func MergeUint16Int32(map1, map2 map[uint16]int32) map[uint16]int32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Int16 takes two inputs: map[uint16]int16 and map[uint16]int16 and merge two maps and returns a new map[uint16]int16.
-func MergeUint16Int16(map1, map2 map[uint16]int16) map[uint16]int16 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]int16{}
-	}
+// MergeInt8Uint32 takes two or more inputs of type map[int8]uint32 and merge them and returns a new map[int8]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Uint32(map1, map2 map[int8]uint32, maps ...map[int8]uint32) map[int8]uint32 {
+	newMap := make(map[int8]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint16]int16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8Uint16 takes two or more inputs of type map[int8]uint16 and merge them and returns a new map[int8]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Uint16(map1, map2 map[int8]uint16, maps ...map[int8]uint16) map[int8]uint16 {
+	newMap := make(map[int8]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3297,31 +1245,42 @@ func MergeUint16Int16(map1, map2 map[uint16]int16) map[uint16]int16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Int8 takes two inputs: map[uint16]int8 and map[uint16]int8 and merge two maps and returns a new map[uint16]int8.
-func MergeUint16Int8(map1, map2 map[uint16]int8) map[uint16]int8 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]int8{}
-	}
+// MergeInt8Uint8 takes two or more inputs of type map[int8]uint8 and merge them and returns a new map[int8]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Uint8(map1, map2 map[int8]uint8, maps ...map[int8]uint8) map[int8]uint8 {
+	newMap := make(map[int8]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint16]int8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeInt8Str takes two or more inputs of type map[int8]string and merge them and returns a new map[int8]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Str(map1, map2 map[int8]string, maps ...map[int8]string) map[int8]string {
+	newMap := make(map[int8]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3330,31 +1289,42 @@ func MergeUint16Int8(map1, map2 map[uint16]int8) map[uint16]int8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Uint takes two inputs: map[uint16]uint and map[uint16]uint and merge two maps and returns a new map[uint16]uint.
-func MergeUint16Uint(map1, map2 map[uint16]uint) map[uint16]uint {
-	if map1 == nil && map2 == nil {
-		return map[uint16]uint{}
-	}
+// MergeInt8Bool takes two or more inputs of type map[int8]bool and merge them and returns a new map[int8]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeInt8Bool(map1, map2 map[int8]bool, maps ...map[int8]bool) map[int8]bool {
+	newMap := make(map[int8]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint16]uint)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintInt takes two or more inputs of type map[uint]int and merge them and returns a new map[uint]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintInt(map1, map2 map[uint]int, maps ...map[uint]int) map[uint]int {
+	newMap := make(map[uint]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3363,31 +1333,42 @@ func MergeUint16Uint(map1, map2 map[uint16]uint) map[uint16]uint {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Uint64 takes two inputs: map[uint16]uint64 and map[uint16]uint64 and merge two maps and returns a new map[uint16]uint64.
-func MergeUint16Uint64(map1, map2 map[uint16]uint64) map[uint16]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]uint64{}
-	}
+// MergeUintInt64 takes two or more inputs of type map[uint]int64 and merge them and returns a new map[uint]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintInt64(map1, map2 map[uint]int64, maps ...map[uint]int64) map[uint]int64 {
+	newMap := make(map[uint]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint16]uint64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintInt32 takes two or more inputs of type map[uint]int32 and merge them and returns a new map[uint]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintInt32(map1, map2 map[uint]int32, maps ...map[uint]int32) map[uint]int32 {
+	newMap := make(map[uint]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3396,31 +1377,42 @@ func MergeUint16Uint64(map1, map2 map[uint16]uint64) map[uint16]uint64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Uint32 takes two inputs: map[uint16]uint32 and map[uint16]uint32 and merge two maps and returns a new map[uint16]uint32.
-func MergeUint16Uint32(map1, map2 map[uint16]uint32) map[uint16]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]uint32{}
-	}
+// MergeUintInt16 takes two or more inputs of type map[uint]int16 and merge them and returns a new map[uint]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintInt16(map1, map2 map[uint]int16, maps ...map[uint]int16) map[uint]int16 {
+	newMap := make(map[uint]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint16]uint32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintInt8 takes two or more inputs of type map[uint]int8 and merge them and returns a new map[uint]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintInt8(map1, map2 map[uint]int8, maps ...map[uint]int8) map[uint]int8 {
+	newMap := make(map[uint]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3429,31 +1421,42 @@ func MergeUint16Uint32(map1, map2 map[uint16]uint32) map[uint16]uint32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16 takes two inputs: map[uint16]uint16 and map[uint16]uint16 and merge two maps and returns a new map[uint16]uint16.
-func MergeUint16(map1, map2 map[uint16]uint16) map[uint16]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]uint16{}
-	}
+// MergeUint takes two or more inputs of type map[uint]uint and merge them and returns a new map[uint]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint(map1, map2 map[uint]uint, maps ...map[uint]uint) map[uint]uint {
+	newMap := make(map[uint]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint16]uint16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintUint64 takes two or more inputs of type map[uint]uint64 and merge them and returns a new map[uint]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintUint64(map1, map2 map[uint]uint64, maps ...map[uint]uint64) map[uint]uint64 {
+	newMap := make(map[uint]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3462,31 +1465,42 @@ func MergeUint16(map1, map2 map[uint16]uint16) map[uint16]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Uint8 takes two inputs: map[uint16]uint8 and map[uint16]uint8 and merge two maps and returns a new map[uint16]uint8.
-func MergeUint16Uint8(map1, map2 map[uint16]uint8) map[uint16]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[uint16]uint8{}
-	}
+// MergeUintUint32 takes two or more inputs of type map[uint]uint32 and merge them and returns a new map[uint]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintUint32(map1, map2 map[uint]uint32, maps ...map[uint]uint32) map[uint]uint32 {
+	newMap := make(map[uint]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint16]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintUint16 takes two or more inputs of type map[uint]uint16 and merge them and returns a new map[uint]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintUint16(map1, map2 map[uint]uint16, maps ...map[uint]uint16) map[uint]uint16 {
+	newMap := make(map[uint]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3495,31 +1509,42 @@ func MergeUint16Uint8(map1, map2 map[uint16]uint8) map[uint16]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Str takes two inputs: map[uint16]string and map[uint16]string and merge two maps and returns a new map[uint16]string.
-func MergeUint16Str(map1, map2 map[uint16]string) map[uint16]string {
-	if map1 == nil && map2 == nil {
-		return map[uint16]string{}
-	}
+// MergeUintUint8 takes two or more inputs of type map[uint]uint8 and merge them and returns a new map[uint]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintUint8(map1, map2 map[uint]uint8, maps ...map[uint]uint8) map[uint]uint8 {
+	newMap := make(map[uint]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint16]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUintStr takes two or more inputs of type map[uint]string and merge them and returns a new map[uint]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintStr(map1, map2 map[uint]string, maps ...map[uint]string) map[uint]string {
+	newMap := make(map[uint]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3528,31 +1553,42 @@ func MergeUint16Str(map1, map2 map[uint16]string) map[uint16]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint16Bool takes two inputs: map[uint16]bool and map[uint16]bool and merge two maps and returns a new map[uint16]bool.
-func MergeUint16Bool(map1, map2 map[uint16]bool) map[uint16]bool {
-	if map1 == nil && map2 == nil {
-		return map[uint16]bool{}
-	}
+// MergeUintBool takes two or more inputs of type map[uint]bool and merge them and returns a new map[uint]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUintBool(map1, map2 map[uint]bool, maps ...map[uint]bool) map[uint]bool {
+	newMap := make(map[uint]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint16]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64Int takes two or more inputs of type map[uint64]int and merge them and returns a new map[uint64]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Int(map1, map2 map[uint64]int, maps ...map[uint64]int) map[uint64]int {
+	newMap := make(map[uint64]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3561,31 +1597,42 @@ func MergeUint16Bool(map1, map2 map[uint16]bool) map[uint16]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Int takes two inputs: map[uint8]int and map[uint8]int and merge two maps and returns a new map[uint8]int.
-func MergeUint8Int(map1, map2 map[uint8]int) map[uint8]int {
-	if map1 == nil && map2 == nil {
-		return map[uint8]int{}
-	}
+// MergeUint64Int64 takes two or more inputs of type map[uint64]int64 and merge them and returns a new map[uint64]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Int64(map1, map2 map[uint64]int64, maps ...map[uint64]int64) map[uint64]int64 {
+	newMap := make(map[uint64]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint8]int)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64Int32 takes two or more inputs of type map[uint64]int32 and merge them and returns a new map[uint64]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Int32(map1, map2 map[uint64]int32, maps ...map[uint64]int32) map[uint64]int32 {
+	newMap := make(map[uint64]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3594,31 +1641,42 @@ func MergeUint8Int(map1, map2 map[uint8]int) map[uint8]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Int64 takes two inputs: map[uint8]int64 and map[uint8]int64 and merge two maps and returns a new map[uint8]int64.
-func MergeUint8Int64(map1, map2 map[uint8]int64) map[uint8]int64 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]int64{}
-	}
+// MergeUint64Int16 takes two or more inputs of type map[uint64]int16 and merge them and returns a new map[uint64]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Int16(map1, map2 map[uint64]int16, maps ...map[uint64]int16) map[uint64]int16 {
+	newMap := make(map[uint64]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint8]int64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64Int8 takes two or more inputs of type map[uint64]int8 and merge them and returns a new map[uint64]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Int8(map1, map2 map[uint64]int8, maps ...map[uint64]int8) map[uint64]int8 {
+	newMap := make(map[uint64]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3627,31 +1685,42 @@ func MergeUint8Int64(map1, map2 map[uint8]int64) map[uint8]int64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Int32 takes two inputs: map[uint8]int32 and map[uint8]int32 and merge two maps and returns a new map[uint8]int32.
-func MergeUint8Int32(map1, map2 map[uint8]int32) map[uint8]int32 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]int32{}
-	}
+// MergeUint64Uint takes two or more inputs of type map[uint64]uint and merge them and returns a new map[uint64]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Uint(map1, map2 map[uint64]uint, maps ...map[uint64]uint) map[uint64]uint {
+	newMap := make(map[uint64]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint8]int32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64 takes two or more inputs of type map[uint64]uint64 and merge them and returns a new map[uint64]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64(map1, map2 map[uint64]uint64, maps ...map[uint64]uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3660,31 +1729,42 @@ func MergeUint8Int32(map1, map2 map[uint8]int32) map[uint8]int32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Int16 takes two inputs: map[uint8]int16 and map[uint8]int16 and merge two maps and returns a new map[uint8]int16.
-func MergeUint8Int16(map1, map2 map[uint8]int16) map[uint8]int16 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]int16{}
-	}
+// MergeUint64Uint32 takes two or more inputs of type map[uint64]uint32 and merge them and returns a new map[uint64]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Uint32(map1, map2 map[uint64]uint32, maps ...map[uint64]uint32) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint8]int16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64Uint16 takes two or more inputs of type map[uint64]uint16 and merge them and returns a new map[uint64]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Uint16(map1, map2 map[uint64]uint16, maps ...map[uint64]uint16) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3693,31 +1773,42 @@ func MergeUint8Int16(map1, map2 map[uint8]int16) map[uint8]int16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Int8 takes two inputs: map[uint8]int8 and map[uint8]int8 and merge two maps and returns a new map[uint8]int8.
-func MergeUint8Int8(map1, map2 map[uint8]int8) map[uint8]int8 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]int8{}
-	}
+// MergeUint64Uint8 takes two or more inputs of type map[uint64]uint8 and merge them and returns a new map[uint64]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Uint8(map1, map2 map[uint64]uint8, maps ...map[uint64]uint8) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint8]int8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint64Str takes two or more inputs of type map[uint64]string and merge them and returns a new map[uint64]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Str(map1, map2 map[uint64]string, maps ...map[uint64]string) map[uint64]string {
+	newMap := make(map[uint64]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3726,31 +1817,42 @@ func MergeUint8Int8(map1, map2 map[uint8]int8) map[uint8]int8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Uint takes two inputs: map[uint8]uint and map[uint8]uint and merge two maps and returns a new map[uint8]uint.
-func MergeUint8Uint(map1, map2 map[uint8]uint) map[uint8]uint {
-	if map1 == nil && map2 == nil {
-		return map[uint8]uint{}
-	}
+// MergeUint64Bool takes two or more inputs of type map[uint64]bool and merge them and returns a new map[uint64]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint64Bool(map1, map2 map[uint64]bool, maps ...map[uint64]bool) map[uint64]bool {
+	newMap := make(map[uint64]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint8]uint)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Int takes two or more inputs of type map[uint32]int and merge them and returns a new map[uint32]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Int(map1, map2 map[uint32]int, maps ...map[uint32]int) map[uint32]int {
+	newMap := make(map[uint32]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3759,31 +1861,42 @@ func MergeUint8Uint(map1, map2 map[uint8]uint) map[uint8]uint {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Uint64 takes two inputs: map[uint8]uint64 and map[uint8]uint64 and merge two maps and returns a new map[uint8]uint64.
-func MergeUint8Uint64(map1, map2 map[uint8]uint64) map[uint8]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]uint64{}
-	}
+// MergeUint32Int64 takes two or more inputs of type map[uint32]int64 and merge them and returns a new map[uint32]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Int64(map1, map2 map[uint32]int64, maps ...map[uint32]int64) map[uint32]int64 {
+	newMap := make(map[uint32]int64, len(map1)+len(map2))
 
-	newMap := make(map[uint8]uint64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Int32 takes two or more inputs of type map[uint32]int32 and merge them and returns a new map[uint32]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Int32(map1, map2 map[uint32]int32, maps ...map[uint32]int32) map[uint32]int32 {
+	newMap := make(map[uint32]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3792,31 +1905,42 @@ func MergeUint8Uint64(map1, map2 map[uint8]uint64) map[uint8]uint64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Uint32 takes two inputs: map[uint8]uint32 and map[uint8]uint32 and merge two maps and returns a new map[uint8]uint32.
-func MergeUint8Uint32(map1, map2 map[uint8]uint32) map[uint8]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]uint32{}
-	}
+// MergeUint32Int16 takes two or more inputs of type map[uint32]int16 and merge them and returns a new map[uint32]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Int16(map1, map2 map[uint32]int16, maps ...map[uint32]int16) map[uint32]int16 {
+	newMap := make(map[uint32]int16, len(map1)+len(map2))
 
-	newMap := make(map[uint8]uint32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Int8 takes two or more inputs of type map[uint32]int8 and merge them and returns a new map[uint32]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Int8(map1, map2 map[uint32]int8, maps ...map[uint32]int8) map[uint32]int8 {
+	newMap := make(map[uint32]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3825,31 +1949,42 @@ func MergeUint8Uint32(map1, map2 map[uint8]uint32) map[uint8]uint32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Uint16 takes two inputs: map[uint8]uint16 and map[uint8]uint16 and merge two maps and returns a new map[uint8]uint16.
-func MergeUint8Uint16(map1, map2 map[uint8]uint16) map[uint8]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]uint16{}
-	}
+// MergeUint32Uint takes two or more inputs of type map[uint32]uint and merge them and returns a new map[uint32]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Uint(map1, map2 map[uint32]uint, maps ...map[uint32]uint) map[uint32]uint {
+	newMap := make(map[uint32]uint, len(map1)+len(map2))
 
-	newMap := make(map[uint8]uint16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Uint64 takes two or more inputs of type map[uint32]uint64 and merge them and returns a new map[uint32]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Uint64(map1, map2 map[uint32]uint64, maps ...map[uint32]uint64) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3858,31 +1993,42 @@ func MergeUint8Uint16(map1, map2 map[uint8]uint16) map[uint8]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8 takes two inputs: map[uint8]uint8 and map[uint8]uint8 and merge two maps and returns a new map[uint8]uint8.
-func MergeUint8(map1, map2 map[uint8]uint8) map[uint8]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[uint8]uint8{}
-	}
+// MergeUint32 takes two or more inputs of type map[uint32]uint32 and merge them and returns a new map[uint32]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32(map1, map2 map[uint32]uint32, maps ...map[uint32]uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32, len(map1)+len(map2))
 
-	newMap := make(map[uint8]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Uint16 takes two or more inputs of type map[uint32]uint16 and merge them and returns a new map[uint32]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Uint16(map1, map2 map[uint32]uint16, maps ...map[uint32]uint16) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3891,31 +2037,42 @@ func MergeUint8(map1, map2 map[uint8]uint8) map[uint8]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Str takes two inputs: map[uint8]string and map[uint8]string and merge two maps and returns a new map[uint8]string.
-func MergeUint8Str(map1, map2 map[uint8]string) map[uint8]string {
-	if map1 == nil && map2 == nil {
-		return map[uint8]string{}
-	}
+// MergeUint32Uint8 takes two or more inputs of type map[uint32]uint8 and merge them and returns a new map[uint32]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Uint8(map1, map2 map[uint32]uint8, maps ...map[uint32]uint8) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8, len(map1)+len(map2))
 
-	newMap := make(map[uint8]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint32Str takes two or more inputs of type map[uint32]string and merge them and returns a new map[uint32]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Str(map1, map2 map[uint32]string, maps ...map[uint32]string) map[uint32]string {
+	newMap := make(map[uint32]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3924,31 +2081,42 @@ func MergeUint8Str(map1, map2 map[uint8]string) map[uint8]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeUint8Bool takes two inputs: map[uint8]bool and map[uint8]bool and merge two maps and returns a new map[uint8]bool.
-func MergeUint8Bool(map1, map2 map[uint8]bool) map[uint8]bool {
-	if map1 == nil && map2 == nil {
-		return map[uint8]bool{}
-	}
+// MergeUint32Bool takes two or more inputs of type map[uint32]bool and merge them and returns a new map[uint32]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint32Bool(map1, map2 map[uint32]bool, maps ...map[uint32]bool) map[uint32]bool {
+	newMap := make(map[uint32]bool, len(map1)+len(map2))
 
-	newMap := make(map[uint8]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16Int takes two or more inputs of type map[uint16]int and merge them and returns a new map[uint16]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Int(map1, map2 map[uint16]int, maps ...map[uint16]int) map[uint16]int {
+	newMap := make(map[uint16]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3957,31 +2125,42 @@ func MergeUint8Bool(map1, map2 map[uint8]bool) map[uint8]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrInt takes two inputs: map[string]int and map[string]int and merge two maps and returns a new map[string]int.
-func MergeStrInt(map1, map2 map[string]int) map[string]int {
-	if map1 == nil && map2 == nil {
-		return map[string]int{}
-	}
+// MergeUint16Int64 takes two or more inputs of type map[uint16]int64 and merge them and returns a new map[uint16]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Int64(map1, map2 map[uint16]int64, maps ...map[uint16]int64) map[uint16]int64 {
+	newMap := make(map[uint16]int64, len(map1)+len(map2))
 
-	newMap := make(map[string]int)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16Int32 takes two or more inputs of type map[uint16]int32 and merge them and returns a new map[uint16]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Int32(map1, map2 map[uint16]int32, maps ...map[uint16]int32) map[uint16]int32 {
+	newMap := make(map[uint16]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -3990,31 +2169,42 @@ func MergeStrInt(map1, map2 map[string]int) map[string]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrInt64 takes two inputs: map[string]int64 and map[string]int64 and merge two maps and returns a new map[string]int64.
-func MergeStrInt64(map1, map2 map[string]int64) map[string]int64 {
-	if map1 == nil && map2 == nil {
-		return map[string]int64{}
-	}
+// MergeUint16Int16 takes two or more inputs of type map[uint16]int16 and merge them and returns a new map[uint16]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Int16(map1, map2 map[uint16]int16, maps ...map[uint16]int16) map[uint16]int16 {
+	newMap := make(map[uint16]int16, len(map1)+len(map2))
 
-	newMap := make(map[string]int64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16Int8 takes two or more inputs of type map[uint16]int8 and merge them and returns a new map[uint16]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Int8(map1, map2 map[uint16]int8, maps ...map[uint16]int8) map[uint16]int8 {
+	newMap := make(map[uint16]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4023,31 +2213,42 @@ func MergeStrInt64(map1, map2 map[string]int64) map[string]int64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrInt32 takes two inputs: map[string]int32 and map[string]int32 and merge two maps and returns a new map[string]int32.
-func MergeStrInt32(map1, map2 map[string]int32) map[string]int32 {
-	if map1 == nil && map2 == nil {
-		return map[string]int32{}
-	}
+// MergeUint16Uint takes two or more inputs of type map[uint16]uint and merge them and returns a new map[uint16]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Uint(map1, map2 map[uint16]uint, maps ...map[uint16]uint) map[uint16]uint {
+	newMap := make(map[uint16]uint, len(map1)+len(map2))
 
-	newMap := make(map[string]int32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16Uint64 takes two or more inputs of type map[uint16]uint64 and merge them and returns a new map[uint16]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Uint64(map1, map2 map[uint16]uint64, maps ...map[uint16]uint64) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4056,31 +2257,42 @@ func MergeStrInt32(map1, map2 map[string]int32) map[string]int32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrInt16 takes two inputs: map[string]int16 and map[string]int16 and merge two maps and returns a new map[string]int16.
-func MergeStrInt16(map1, map2 map[string]int16) map[string]int16 {
-	if map1 == nil && map2 == nil {
-		return map[string]int16{}
-	}
+// MergeUint16Uint32 takes two or more inputs of type map[uint16]uint32 and merge them and returns a new map[uint16]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Uint32(map1, map2 map[uint16]uint32, maps ...map[uint16]uint32) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32, len(map1)+len(map2))
 
-	newMap := make(map[string]int16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16 takes two or more inputs of type map[uint16]uint16 and merge them and returns a new map[uint16]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16(map1, map2 map[uint16]uint16, maps ...map[uint16]uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4089,31 +2301,42 @@ func MergeStrInt16(map1, map2 map[string]int16) map[string]int16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrInt8 takes two inputs: map[string]int8 and map[string]int8 and merge two maps and returns a new map[string]int8.
-func MergeStrInt8(map1, map2 map[string]int8) map[string]int8 {
-	if map1 == nil && map2 == nil {
-		return map[string]int8{}
-	}
+// MergeUint16Uint8 takes two or more inputs of type map[uint16]uint8 and merge them and returns a new map[uint16]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Uint8(map1, map2 map[uint16]uint8, maps ...map[uint16]uint8) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8, len(map1)+len(map2))
 
-	newMap := make(map[string]int8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint16Str takes two or more inputs of type map[uint16]string and merge them and returns a new map[uint16]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Str(map1, map2 map[uint16]string, maps ...map[uint16]string) map[uint16]string {
+	newMap := make(map[uint16]string, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4122,31 +2345,42 @@ func MergeStrInt8(map1, map2 map[string]int8) map[string]int8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrUint takes two inputs: map[string]uint and map[string]uint and merge two maps and returns a new map[string]uint.
-func MergeStrUint(map1, map2 map[string]uint) map[string]uint {
-	if map1 == nil && map2 == nil {
-		return map[string]uint{}
-	}
+// MergeUint16Bool takes two or more inputs of type map[uint16]bool and merge them and returns a new map[uint16]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint16Bool(map1, map2 map[uint16]bool, maps ...map[uint16]bool) map[uint16]bool {
+	newMap := make(map[uint16]bool, len(map1)+len(map2))
 
-	newMap := make(map[string]uint)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8Int takes two or more inputs of type map[uint8]int and merge them and returns a new map[uint8]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Int(map1, map2 map[uint8]int, maps ...map[uint8]int) map[uint8]int {
+	newMap := make(map[uint8]int, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4155,31 +2389,42 @@ func MergeStrUint(map1, map2 map[string]uint) map[string]uint {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrUint64 takes two inputs: map[string]uint64 and map[string]uint64 and merge two maps and returns a new map[string]uint64.
-func MergeStrUint64(map1, map2 map[string]uint64) map[string]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[string]uint64{}
-	}
+// MergeUint8Int64 takes two or more inputs of type map[uint8]int64 and merge them and returns a new map[uint8]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Int64(map1, map2 map[uint8]int64, maps ...map[uint8]int64) map[uint8]int64 {
+	newMap := make(map[uint8]int64, len(map1)+len(map2))
 
-	newMap := make(map[string]uint64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8Int32 takes two or more inputs of type map[uint8]int32 and merge them and returns a new map[uint8]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Int32(map1, map2 map[uint8]int32, maps ...map[uint8]int32) map[uint8]int32 {
+	newMap := make(map[uint8]int32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4188,31 +2433,42 @@ func MergeStrUint64(map1, map2 map[string]uint64) map[string]uint64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrUint32 takes two inputs: map[string]uint32 and map[string]uint32 and merge two maps and returns a new map[string]uint32.
-func MergeStrUint32(map1, map2 map[string]uint32) map[string]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[string]uint32{}
-	}
+// MergeUint8Int16 takes two or more inputs of type map[uint8]int16 and merge them and returns a new map[uint8]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Int16(map1, map2 map[uint8]int16, maps ...map[uint8]int16) map[uint8]int16 {
+	newMap := make(map[uint8]int16, len(map1)+len(map2))
 
-	newMap := make(map[string]uint32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8Int8 takes two or more inputs of type map[uint8]int8 and merge them and returns a new map[uint8]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Int8(map1, map2 map[uint8]int8, maps ...map[uint8]int8) map[uint8]int8 {
+	newMap := make(map[uint8]int8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4221,30 +2477,19 @@ func MergeStrUint32(map1, map2 map[string]uint32) map[string]uint32 {
 		newMap[k] = v
 	}
 
-	return newMap
-}
-
-// MergeStrUint16 takes two inputs: map[string]uint16 and map[string]uint16 and merge two maps and returns a new map[string]uint16.
-func MergeStrUint16(map1, map2 map[string]uint16) map[string]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[string]uint16{}
-	}
-
-	newMap := make(map[string]uint16)
-
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+	return newMap
+}
+
+// MergeUint8Uint takes two or more inputs of type map[uint8]uint and merge them and returns a new map[uint8]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Uint(map1, map2 map[uint8]uint, maps ...map[uint8]uint) map[uint8]uint {
+	newMap := make(map[uint8]uint, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -4254,31 +2499,42 @@ func MergeStrUint16(map1, map2 map[string]uint16) map[string]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrUint8 takes two inputs: map[string]uint8 and map[string]uint8 and merge two maps and returns a new map[string]uint8.
-func MergeStrUint8(map1, map2 map[string]uint8) map[string]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[string]uint8{}
-	}
+// MergeUint8Uint64 takes two or more inputs of type map[uint8]uint64 and merge them and returns a new map[uint8]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Uint64(map1, map2 map[uint8]uint64, maps ...map[uint8]uint64) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64, len(map1)+len(map2))
 
-	newMap := make(map[string]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8Uint32 takes two or more inputs of type map[uint8]uint32 and merge them and returns a new map[uint8]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Uint32(map1, map2 map[uint8]uint32, maps ...map[uint8]uint32) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4287,31 +2543,42 @@ func MergeStrUint8(map1, map2 map[string]uint8) map[string]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStr takes two inputs: map[string]string and map[string]string and merge two maps and returns a new map[string]string.
-func MergeStr(map1, map2 map[string]string) map[string]string {
-	if map1 == nil && map2 == nil {
-		return map[string]string{}
-	}
+// MergeUint8Uint16 takes two or more inputs of type map[uint8]uint16 and merge them and returns a new map[uint8]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Uint16(map1, map2 map[uint8]uint16, maps ...map[uint8]uint16) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16, len(map1)+len(map2))
 
-	newMap := make(map[string]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8 takes two or more inputs of type map[uint8]uint8 and merge them and returns a new map[uint8]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8(map1, map2 map[uint8]uint8, maps ...map[uint8]uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4320,31 +2587,42 @@ func MergeStr(map1, map2 map[string]string) map[string]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeStrBool takes two inputs: map[string]bool and map[string]bool and merge two maps and returns a new map[string]bool.
-func MergeStrBool(map1, map2 map[string]bool) map[string]bool {
-	if map1 == nil && map2 == nil {
-		return map[string]bool{}
-	}
+// MergeUint8Str takes two or more inputs of type map[uint8]string and merge them and returns a new map[uint8]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Str(map1, map2 map[uint8]string, maps ...map[uint8]string) map[uint8]string {
+	newMap := make(map[uint8]string, len(map1)+len(map2))
 
-	newMap := make(map[string]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeUint8Bool takes two or more inputs of type map[uint8]bool and merge them and returns a new map[uint8]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeUint8Bool(map1, map2 map[uint8]bool, maps ...map[uint8]bool) map[uint8]bool {
+	newMap := make(map[uint8]bool, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4353,31 +2631,42 @@ func MergeStrBool(map1, map2 map[string]bool) map[string]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolInt takes two inputs: map[bool]int and map[bool]int and merge two maps and returns a new map[bool]int.
-func MergeBoolInt(map1, map2 map[bool]int) map[bool]int {
-	if map1 == nil && map2 == nil {
-		return map[bool]int{}
-	}
+// MergeStrInt takes two or more inputs of type map[string]int and merge them and returns a new map[string]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrInt(map1, map2 map[string]int, maps ...map[string]int) map[string]int {
+	newMap := make(map[string]int, len(map1)+len(map2))
 
-	newMap := make(map[bool]int)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrInt64 takes two or more inputs of type map[string]int64 and merge them and returns a new map[string]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrInt64(map1, map2 map[string]int64, maps ...map[string]int64) map[string]int64 {
+	newMap := make(map[string]int64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4386,31 +2675,42 @@ func MergeBoolInt(map1, map2 map[bool]int) map[bool]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolInt64 takes two inputs: map[bool]int64 and map[bool]int64 and merge two maps and returns a new map[bool]int64.
-func MergeBoolInt64(map1, map2 map[bool]int64) map[bool]int64 {
-	if map1 == nil && map2 == nil {
-		return map[bool]int64{}
-	}
+// MergeStrInt32 takes two or more inputs of type map[string]int32 and merge them and returns a new map[string]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrInt32(map1, map2 map[string]int32, maps ...map[string]int32) map[string]int32 {
+	newMap := make(map[string]int32, len(map1)+len(map2))
 
-	newMap := make(map[bool]int64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrInt16 takes two or more inputs of type map[string]int16 and merge them and returns a new map[string]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrInt16(map1, map2 map[string]int16, maps ...map[string]int16) map[string]int16 {
+	newMap := make(map[string]int16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4419,31 +2719,42 @@ func MergeBoolInt64(map1, map2 map[bool]int64) map[bool]int64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolInt32 takes two inputs: map[bool]int32 and map[bool]int32 and merge two maps and returns a new map[bool]int32.
-func MergeBoolInt32(map1, map2 map[bool]int32) map[bool]int32 {
-	if map1 == nil && map2 == nil {
-		return map[bool]int32{}
-	}
+// MergeStrInt8 takes two or more inputs of type map[string]int8 and merge them and returns a new map[string]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrInt8(map1, map2 map[string]int8, maps ...map[string]int8) map[string]int8 {
+	newMap := make(map[string]int8, len(map1)+len(map2))
 
-	newMap := make(map[bool]int32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrUint takes two or more inputs of type map[string]uint and merge them and returns a new map[string]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrUint(map1, map2 map[string]uint, maps ...map[string]uint) map[string]uint {
+	newMap := make(map[string]uint, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4452,31 +2763,42 @@ func MergeBoolInt32(map1, map2 map[bool]int32) map[bool]int32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolInt16 takes two inputs: map[bool]int16 and map[bool]int16 and merge two maps and returns a new map[bool]int16.
-func MergeBoolInt16(map1, map2 map[bool]int16) map[bool]int16 {
-	if map1 == nil && map2 == nil {
-		return map[bool]int16{}
-	}
+// MergeStrUint64 takes two or more inputs of type map[string]uint64 and merge them and returns a new map[string]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrUint64(map1, map2 map[string]uint64, maps ...map[string]uint64) map[string]uint64 {
+	newMap := make(map[string]uint64, len(map1)+len(map2))
 
-	newMap := make(map[bool]int16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrUint32 takes two or more inputs of type map[string]uint32 and merge them and returns a new map[string]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrUint32(map1, map2 map[string]uint32, maps ...map[string]uint32) map[string]uint32 {
+	newMap := make(map[string]uint32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4485,31 +2807,42 @@ func MergeBoolInt16(map1, map2 map[bool]int16) map[bool]int16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolInt8 takes two inputs: map[bool]int8 and map[bool]int8 and merge two maps and returns a new map[bool]int8.
-func MergeBoolInt8(map1, map2 map[bool]int8) map[bool]int8 {
-	if map1 == nil && map2 == nil {
-		return map[bool]int8{}
-	}
+// MergeStrUint16 takes two or more inputs of type map[string]uint16 and merge them and returns a new map[string]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrUint16(map1, map2 map[string]uint16, maps ...map[string]uint16) map[string]uint16 {
+	newMap := make(map[string]uint16, len(map1)+len(map2))
 
-	newMap := make(map[bool]int8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrUint8 takes two or more inputs of type map[string]uint8 and merge them and returns a new map[string]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrUint8(map1, map2 map[string]uint8, maps ...map[string]uint8) map[string]uint8 {
+	newMap := make(map[string]uint8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4518,31 +2851,42 @@ func MergeBoolInt8(map1, map2 map[bool]int8) map[bool]int8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolUint takes two inputs: map[bool]uint and map[bool]uint and merge two maps and returns a new map[bool]uint.
-func MergeBoolUint(map1, map2 map[bool]uint) map[bool]uint {
-	if map1 == nil && map2 == nil {
-		return map[bool]uint{}
-	}
+// MergeStr takes two or more inputs of type map[string]string and merge them and returns a new map[string]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStr(map1, map2 map[string]string, maps ...map[string]string) map[string]string {
+	newMap := make(map[string]string, len(map1)+len(map2))
 
-	newMap := make(map[bool]uint)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeStrBool takes two or more inputs of type map[string]bool and merge them and returns a new map[string]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrBool(map1, map2 map[string]bool, maps ...map[string]bool) map[string]bool {
+	newMap := make(map[string]bool, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4551,31 +2895,42 @@ func MergeBoolUint(map1, map2 map[bool]uint) map[bool]uint {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolUint64 takes two inputs: map[bool]uint64 and map[bool]uint64 and merge two maps and returns a new map[bool]uint64.
-func MergeBoolUint64(map1, map2 map[bool]uint64) map[bool]uint64 {
-	if map1 == nil && map2 == nil {
-		return map[bool]uint64{}
-	}
+// MergeBoolInt takes two or more inputs of type map[bool]int and merge them and returns a new map[bool]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolInt(map1, map2 map[bool]int, maps ...map[bool]int) map[bool]int {
+	newMap := make(map[bool]int, len(map1)+len(map2))
 
-	newMap := make(map[bool]uint64)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBoolInt64 takes two or more inputs of type map[bool]int64 and merge them and returns a new map[bool]int64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolInt64(map1, map2 map[bool]int64, maps ...map[bool]int64) map[bool]int64 {
+	newMap := make(map[bool]int64, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4584,31 +2939,42 @@ func MergeBoolUint64(map1, map2 map[bool]uint64) map[bool]uint64 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolUint32 takes two inputs: map[bool]uint32 and map[bool]uint32 and merge two maps and returns a new map[bool]uint32.
-func MergeBoolUint32(map1, map2 map[bool]uint32) map[bool]uint32 {
-	if map1 == nil && map2 == nil {
-		return map[bool]uint32{}
-	}
+// MergeBoolInt32 takes two or more inputs of type map[bool]int32 and merge them and returns a new map[bool]int32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolInt32(map1, map2 map[bool]int32, maps ...map[bool]int32) map[bool]int32 {
+	newMap := make(map[bool]int32, len(map1)+len(map2))
 
-	newMap := make(map[bool]uint32)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBoolInt16 takes two or more inputs of type map[bool]int16 and merge them and returns a new map[bool]int16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolInt16(map1, map2 map[bool]int16, maps ...map[bool]int16) map[bool]int16 {
+	newMap := make(map[bool]int16, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4617,31 +2983,42 @@ func MergeBoolUint32(map1, map2 map[bool]uint32) map[bool]uint32 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolUint16 takes two inputs: map[bool]uint16 and map[bool]uint16 and merge two maps and returns a new map[bool]uint16.
-func MergeBoolUint16(map1, map2 map[bool]uint16) map[bool]uint16 {
-	if map1 == nil && map2 == nil {
-		return map[bool]uint16{}
-	}
+// MergeBoolInt8 takes two or more inputs of type map[bool]int8 and merge them and returns a new map[bool]int8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolInt8(map1, map2 map[bool]int8, maps ...map[bool]int8) map[bool]int8 {
+	newMap := make(map[bool]int8, len(map1)+len(map2))
 
-	newMap := make(map[bool]uint16)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBoolUint takes two or more inputs of type map[bool]uint and merge them and returns a new map[bool]uint.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolUint(map1, map2 map[bool]uint, maps ...map[bool]uint) map[bool]uint {
+	newMap := make(map[bool]uint, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4650,31 +3027,42 @@ func MergeBoolUint16(map1, map2 map[bool]uint16) map[bool]uint16 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolUint8 takes two inputs: map[bool]uint8 and map[bool]uint8 and merge two maps and returns a new map[bool]uint8.
-func MergeBoolUint8(map1, map2 map[bool]uint8) map[bool]uint8 {
-	if map1 == nil && map2 == nil {
-		return map[bool]uint8{}
-	}
+// MergeBoolUint64 takes two or more inputs of type map[bool]uint64 and merge them and returns a new map[bool]uint64.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolUint64(map1, map2 map[bool]uint64, maps ...map[bool]uint64) map[bool]uint64 {
+	newMap := make(map[bool]uint64, len(map1)+len(map2))
 
-	newMap := make(map[bool]uint8)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBoolUint32 takes two or more inputs of type map[bool]uint32 and merge them and returns a new map[bool]uint32.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolUint32(map1, map2 map[bool]uint32, maps ...map[bool]uint32) map[bool]uint32 {
+	newMap := make(map[bool]uint32, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4683,31 +3071,42 @@ func MergeBoolUint8(map1, map2 map[bool]uint8) map[bool]uint8 {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBoolStr takes two inputs: map[bool]string and map[bool]string and merge two maps and returns a new map[bool]string.
-func MergeBoolStr(map1, map2 map[bool]string) map[bool]string {
-	if map1 == nil && map2 == nil {
-		return map[bool]string{}
-	}
+// MergeBoolUint16 takes two or more inputs of type map[bool]uint16 and merge them and returns a new map[bool]uint16.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolUint16(map1, map2 map[bool]uint16, maps ...map[bool]uint16) map[bool]uint16 {
+	newMap := make(map[bool]uint16, len(map1)+len(map2))
 
-	newMap := make(map[bool]string)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBoolUint8 takes two or more inputs of type map[bool]uint8 and merge them and returns a new map[bool]uint8.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolUint8(map1, map2 map[bool]uint8, maps ...map[bool]uint8) map[bool]uint8 {
+	newMap := make(map[bool]uint8, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4716,31 +3115,42 @@ func MergeBoolStr(map1, map2 map[bool]string) map[bool]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// MergeBool takes two inputs: map[bool]bool and map[bool]bool and merge two maps and returns a new map[bool]bool.
-func MergeBool(map1, map2 map[bool]bool) map[bool]bool {
-	if map1 == nil && map2 == nil {
-		return map[bool]bool{}
-	}
+// MergeBoolStr takes two or more inputs of type map[bool]string and merge them and returns a new map[bool]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBoolStr(map1, map2 map[bool]string, maps ...map[bool]string) map[bool]string {
+	newMap := make(map[bool]string, len(map1)+len(map2))
 
-	newMap := make(map[bool]bool)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
+	return newMap
+}
+
+// MergeBool takes two or more inputs of type map[bool]bool and merge them and returns a new map[bool]bool.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeBool(map1, map2 map[bool]bool, maps ...map[bool]bool) map[bool]bool {
+	newMap := make(map[bool]bool, len(map1)+len(map2))
+
 	for k, v := range map1 {
 		newMap[k] = v
 	}
@@ -4749,5 +3159,11 @@ func MergeBool(map1, map2 map[bool]bool) map[bool]bool {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
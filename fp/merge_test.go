@@ -61,6 +61,17 @@ func TestMergeInt(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]int{1: 10, 2: 20}
+	map2 = map[int]int{2: 30}
+	map3 := map[int]int{2: 40, 3: 50}
+
+	expected = map[int]int{1: 10, 2: 40, 3: 50}
+	actual = MergeInt(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntInt64(t *testing.T) {
@@ -119,6 +130,17 @@ func TestMergeIntInt64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntInt64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]int64{1: 10, 2: 20}
+	map2 = map[int]int64{2: 30}
+	map3 := map[int]int64{2: 40, 3: 50}
+
+	expected = map[int]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeIntInt64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntInt32(t *testing.T) {
@@ -177,6 +199,17 @@ func TestMergeIntInt32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntInt32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]int32{1: 10, 2: 20}
+	map2 = map[int]int32{2: 30}
+	map3 := map[int]int32{2: 40, 3: 50}
+
+	expected = map[int]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeIntInt32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntInt16(t *testing.T) {
@@ -235,6 +268,17 @@ func TestMergeIntInt16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntInt16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]int16{1: 10, 2: 20}
+	map2 = map[int]int16{2: 30}
+	map3 := map[int]int16{2: 40, 3: 50}
+
+	expected = map[int]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeIntInt16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntInt8(t *testing.T) {
@@ -293,6 +337,17 @@ func TestMergeIntInt8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntInt8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]int8{1: 10, 2: 20}
+	map2 = map[int]int8{2: 30}
+	map3 := map[int]int8{2: 40, 3: 50}
+
+	expected = map[int]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeIntInt8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntUint(t *testing.T) {
@@ -351,6 +406,17 @@ func TestMergeIntUint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntUint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]uint{1: 10, 2: 20}
+	map2 = map[int]uint{2: 30}
+	map3 := map[int]uint{2: 40, 3: 50}
+
+	expected = map[int]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeIntUint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntUint64(t *testing.T) {
@@ -409,6 +475,17 @@ func TestMergeIntUint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntUint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]uint64{1: 10, 2: 20}
+	map2 = map[int]uint64{2: 30}
+	map3 := map[int]uint64{2: 40, 3: 50}
+
+	expected = map[int]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeIntUint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntUint32(t *testing.T) {
@@ -467,6 +544,17 @@ func TestMergeIntUint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntUint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]uint32{1: 10, 2: 20}
+	map2 = map[int]uint32{2: 30}
+	map3 := map[int]uint32{2: 40, 3: 50}
+
+	expected = map[int]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeIntUint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntUint16(t *testing.T) {
@@ -525,6 +613,17 @@ func TestMergeIntUint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntUint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]uint16{1: 10, 2: 20}
+	map2 = map[int]uint16{2: 30}
+	map3 := map[int]uint16{2: 40, 3: 50}
+
+	expected = map[int]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeIntUint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntUint8(t *testing.T) {
@@ -583,6 +682,17 @@ func TestMergeIntUint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeIntUint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int]uint8{1: 10, 2: 20}
+	map2 = map[int]uint8{2: 30}
+	map3 := map[int]uint8{2: 40, 3: 50}
+
+	expected = map[int]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeIntUint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeIntStr(t *testing.T) {
@@ -757,6 +867,17 @@ func TestMergeInt64Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]int{1: 10, 2: 20}
+	map2 = map[int64]int{2: 30}
+	map3 := map[int64]int{2: 40, 3: 50}
+
+	expected = map[int64]int{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64(t *testing.T) {
@@ -815,6 +936,17 @@ func TestMergeInt64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]int64{1: 10, 2: 20}
+	map2 = map[int64]int64{2: 30}
+	map3 := map[int64]int64{2: 40, 3: 50}
+
+	expected = map[int64]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Int32(t *testing.T) {
@@ -873,6 +1005,17 @@ func TestMergeInt64Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]int32{1: 10, 2: 20}
+	map2 = map[int64]int32{2: 30}
+	map3 := map[int64]int32{2: 40, 3: 50}
+
+	expected = map[int64]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Int16(t *testing.T) {
@@ -931,6 +1074,17 @@ func TestMergeInt64Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]int16{1: 10, 2: 20}
+	map2 = map[int64]int16{2: 30}
+	map3 := map[int64]int16{2: 40, 3: 50}
+
+	expected = map[int64]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Int8(t *testing.T) {
@@ -989,6 +1143,17 @@ func TestMergeInt64Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]int8{1: 10, 2: 20}
+	map2 = map[int64]int8{2: 30}
+	map3 := map[int64]int8{2: 40, 3: 50}
+
+	expected = map[int64]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Uint(t *testing.T) {
@@ -1047,6 +1212,17 @@ func TestMergeInt64Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]uint{1: 10, 2: 20}
+	map2 = map[int64]uint{2: 30}
+	map3 := map[int64]uint{2: 40, 3: 50}
+
+	expected = map[int64]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Uint64(t *testing.T) {
@@ -1105,6 +1281,17 @@ func TestMergeInt64Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]uint64{1: 10, 2: 20}
+	map2 = map[int64]uint64{2: 30}
+	map3 := map[int64]uint64{2: 40, 3: 50}
+
+	expected = map[int64]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Uint32(t *testing.T) {
@@ -1163,6 +1350,17 @@ func TestMergeInt64Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]uint32{1: 10, 2: 20}
+	map2 = map[int64]uint32{2: 30}
+	map3 := map[int64]uint32{2: 40, 3: 50}
+
+	expected = map[int64]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Uint16(t *testing.T) {
@@ -1221,6 +1419,17 @@ func TestMergeInt64Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]uint16{1: 10, 2: 20}
+	map2 = map[int64]uint16{2: 30}
+	map3 := map[int64]uint16{2: 40, 3: 50}
+
+	expected = map[int64]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Uint8(t *testing.T) {
@@ -1279,6 +1488,17 @@ func TestMergeInt64Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt64Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int64]uint8{1: 10, 2: 20}
+	map2 = map[int64]uint8{2: 30}
+	map3 := map[int64]uint8{2: 40, 3: 50}
+
+	expected = map[int64]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt64Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt64Str(t *testing.T) {
@@ -1453,6 +1673,17 @@ func TestMergeInt32Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]int{1: 10, 2: 20}
+	map2 = map[int32]int{2: 30}
+	map3 := map[int32]int{2: 40, 3: 50}
+
+	expected = map[int32]int{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Int64(t *testing.T) {
@@ -1511,6 +1742,17 @@ func TestMergeInt32Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]int64{1: 10, 2: 20}
+	map2 = map[int32]int64{2: 30}
+	map3 := map[int32]int64{2: 40, 3: 50}
+
+	expected = map[int32]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32(t *testing.T) {
@@ -1569,6 +1811,17 @@ func TestMergeInt32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]int32{1: 10, 2: 20}
+	map2 = map[int32]int32{2: 30}
+	map3 := map[int32]int32{2: 40, 3: 50}
+
+	expected = map[int32]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Int16(t *testing.T) {
@@ -1627,6 +1880,17 @@ func TestMergeInt32Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]int16{1: 10, 2: 20}
+	map2 = map[int32]int16{2: 30}
+	map3 := map[int32]int16{2: 40, 3: 50}
+
+	expected = map[int32]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Int8(t *testing.T) {
@@ -1685,6 +1949,17 @@ func TestMergeInt32Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]int8{1: 10, 2: 20}
+	map2 = map[int32]int8{2: 30}
+	map3 := map[int32]int8{2: 40, 3: 50}
+
+	expected = map[int32]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Uint(t *testing.T) {
@@ -1743,6 +2018,17 @@ func TestMergeInt32Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]uint{1: 10, 2: 20}
+	map2 = map[int32]uint{2: 30}
+	map3 := map[int32]uint{2: 40, 3: 50}
+
+	expected = map[int32]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Uint64(t *testing.T) {
@@ -1801,6 +2087,17 @@ func TestMergeInt32Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]uint64{1: 10, 2: 20}
+	map2 = map[int32]uint64{2: 30}
+	map3 := map[int32]uint64{2: 40, 3: 50}
+
+	expected = map[int32]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Uint32(t *testing.T) {
@@ -1859,6 +2156,17 @@ func TestMergeInt32Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]uint32{1: 10, 2: 20}
+	map2 = map[int32]uint32{2: 30}
+	map3 := map[int32]uint32{2: 40, 3: 50}
+
+	expected = map[int32]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Uint16(t *testing.T) {
@@ -1917,6 +2225,17 @@ func TestMergeInt32Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]uint16{1: 10, 2: 20}
+	map2 = map[int32]uint16{2: 30}
+	map3 := map[int32]uint16{2: 40, 3: 50}
+
+	expected = map[int32]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Uint8(t *testing.T) {
@@ -1975,6 +2294,17 @@ func TestMergeInt32Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt32Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int32]uint8{1: 10, 2: 20}
+	map2 = map[int32]uint8{2: 30}
+	map3 := map[int32]uint8{2: 40, 3: 50}
+
+	expected = map[int32]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt32Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt32Str(t *testing.T) {
@@ -2149,6 +2479,17 @@ func TestMergeInt16Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]int{1: 10, 2: 20}
+	map2 = map[int16]int{2: 30}
+	map3 := map[int16]int{2: 40, 3: 50}
+
+	expected = map[int16]int{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Int64(t *testing.T) {
@@ -2207,6 +2548,17 @@ func TestMergeInt16Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]int64{1: 10, 2: 20}
+	map2 = map[int16]int64{2: 30}
+	map3 := map[int16]int64{2: 40, 3: 50}
+
+	expected = map[int16]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Int32(t *testing.T) {
@@ -2265,6 +2617,17 @@ func TestMergeInt16Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]int32{1: 10, 2: 20}
+	map2 = map[int16]int32{2: 30}
+	map3 := map[int16]int32{2: 40, 3: 50}
+
+	expected = map[int16]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16(t *testing.T) {
@@ -2323,6 +2686,17 @@ func TestMergeInt16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]int16{1: 10, 2: 20}
+	map2 = map[int16]int16{2: 30}
+	map3 := map[int16]int16{2: 40, 3: 50}
+
+	expected = map[int16]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Int8(t *testing.T) {
@@ -2381,6 +2755,17 @@ func TestMergeInt16Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]int8{1: 10, 2: 20}
+	map2 = map[int16]int8{2: 30}
+	map3 := map[int16]int8{2: 40, 3: 50}
+
+	expected = map[int16]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Uint(t *testing.T) {
@@ -2439,6 +2824,17 @@ func TestMergeInt16Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]uint{1: 10, 2: 20}
+	map2 = map[int16]uint{2: 30}
+	map3 := map[int16]uint{2: 40, 3: 50}
+
+	expected = map[int16]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Uint64(t *testing.T) {
@@ -2497,6 +2893,17 @@ func TestMergeInt16Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]uint64{1: 10, 2: 20}
+	map2 = map[int16]uint64{2: 30}
+	map3 := map[int16]uint64{2: 40, 3: 50}
+
+	expected = map[int16]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Uint32(t *testing.T) {
@@ -2555,6 +2962,17 @@ func TestMergeInt16Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]uint32{1: 10, 2: 20}
+	map2 = map[int16]uint32{2: 30}
+	map3 := map[int16]uint32{2: 40, 3: 50}
+
+	expected = map[int16]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Uint16(t *testing.T) {
@@ -2613,6 +3031,17 @@ func TestMergeInt16Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]uint16{1: 10, 2: 20}
+	map2 = map[int16]uint16{2: 30}
+	map3 := map[int16]uint16{2: 40, 3: 50}
+
+	expected = map[int16]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Uint8(t *testing.T) {
@@ -2671,6 +3100,17 @@ func TestMergeInt16Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt16Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int16]uint8{1: 10, 2: 20}
+	map2 = map[int16]uint8{2: 30}
+	map3 := map[int16]uint8{2: 40, 3: 50}
+
+	expected = map[int16]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt16Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt16Str(t *testing.T) {
@@ -2845,6 +3285,17 @@ func TestMergeInt8Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]int{1: 10, 2: 20}
+	map2 = map[int8]int{2: 30}
+	map3 := map[int8]int{2: 40, 3: 50}
+
+	expected = map[int8]int{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Int64(t *testing.T) {
@@ -2903,6 +3354,17 @@ func TestMergeInt8Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]int64{1: 10, 2: 20}
+	map2 = map[int8]int64{2: 30}
+	map3 := map[int8]int64{2: 40, 3: 50}
+
+	expected = map[int8]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Int32(t *testing.T) {
@@ -2961,11 +3423,22 @@ func TestMergeInt8Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
-}
 
-func TestMergeInt8Int16(t *testing.T) {
-	map1 := map[int8]int16{1: 10, 2: 20, 3: 30}
-	map2 := map[int8]int16{4: 40, 5: 50, 3: 30}
+	map1 = map[int8]int32{1: 10, 2: 20}
+	map2 = map[int8]int32{2: 30}
+	map3 := map[int8]int32{2: 40, 3: 50}
+
+	expected = map[int8]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestMergeInt8Int16(t *testing.T) {
+	map1 := map[int8]int16{1: 10, 2: 20, 3: 30}
+	map2 := map[int8]int16{4: 40, 5: 50, 3: 30}
 
 	expected := map[int8]int16{1: 10, 2: 20, 4: 40, 5: 50, 3: 30}
 	actual := MergeInt8Int16(map1, map2)
@@ -3019,6 +3492,17 @@ func TestMergeInt8Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]int16{1: 10, 2: 20}
+	map2 = map[int8]int16{2: 30}
+	map3 := map[int8]int16{2: 40, 3: 50}
+
+	expected = map[int8]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8(t *testing.T) {
@@ -3077,6 +3561,17 @@ func TestMergeInt8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]int8{1: 10, 2: 20}
+	map2 = map[int8]int8{2: 30}
+	map3 := map[int8]int8{2: 40, 3: 50}
+
+	expected = map[int8]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Uint(t *testing.T) {
@@ -3135,6 +3630,17 @@ func TestMergeInt8Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]uint{1: 10, 2: 20}
+	map2 = map[int8]uint{2: 30}
+	map3 := map[int8]uint{2: 40, 3: 50}
+
+	expected = map[int8]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Uint64(t *testing.T) {
@@ -3193,6 +3699,17 @@ func TestMergeInt8Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]uint64{1: 10, 2: 20}
+	map2 = map[int8]uint64{2: 30}
+	map3 := map[int8]uint64{2: 40, 3: 50}
+
+	expected = map[int8]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Uint32(t *testing.T) {
@@ -3251,6 +3768,17 @@ func TestMergeInt8Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]uint32{1: 10, 2: 20}
+	map2 = map[int8]uint32{2: 30}
+	map3 := map[int8]uint32{2: 40, 3: 50}
+
+	expected = map[int8]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Uint16(t *testing.T) {
@@ -3309,6 +3837,17 @@ func TestMergeInt8Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]uint16{1: 10, 2: 20}
+	map2 = map[int8]uint16{2: 30}
+	map3 := map[int8]uint16{2: 40, 3: 50}
+
+	expected = map[int8]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Uint8(t *testing.T) {
@@ -3367,6 +3906,17 @@ func TestMergeInt8Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeInt8Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[int8]uint8{1: 10, 2: 20}
+	map2 = map[int8]uint8{2: 30}
+	map3 := map[int8]uint8{2: 40, 3: 50}
+
+	expected = map[int8]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeInt8Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeInt8Str(t *testing.T) {
@@ -3541,6 +4091,17 @@ func TestMergeUintInt(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintInt failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]int{1: 10, 2: 20}
+	map2 = map[uint]int{2: 30}
+	map3 := map[uint]int{2: 40, 3: 50}
+
+	expected = map[uint]int{1: 10, 2: 40, 3: 50}
+	actual = MergeUintInt(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintInt64(t *testing.T) {
@@ -3599,6 +4160,17 @@ func TestMergeUintInt64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintInt64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]int64{1: 10, 2: 20}
+	map2 = map[uint]int64{2: 30}
+	map3 := map[uint]int64{2: 40, 3: 50}
+
+	expected = map[uint]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeUintInt64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintInt32(t *testing.T) {
@@ -3657,6 +4229,17 @@ func TestMergeUintInt32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintInt32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]int32{1: 10, 2: 20}
+	map2 = map[uint]int32{2: 30}
+	map3 := map[uint]int32{2: 40, 3: 50}
+
+	expected = map[uint]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeUintInt32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintInt16(t *testing.T) {
@@ -3715,6 +4298,17 @@ func TestMergeUintInt16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintInt16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]int16{1: 10, 2: 20}
+	map2 = map[uint]int16{2: 30}
+	map3 := map[uint]int16{2: 40, 3: 50}
+
+	expected = map[uint]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeUintInt16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintInt8(t *testing.T) {
@@ -3773,6 +4367,17 @@ func TestMergeUintInt8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintInt8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]int8{1: 10, 2: 20}
+	map2 = map[uint]int8{2: 30}
+	map3 := map[uint]int8{2: 40, 3: 50}
+
+	expected = map[uint]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeUintInt8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint(t *testing.T) {
@@ -3831,6 +4436,17 @@ func TestMergeUint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]uint{1: 10, 2: 20}
+	map2 = map[uint]uint{2: 30}
+	map3 := map[uint]uint{2: 40, 3: 50}
+
+	expected = map[uint]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeUint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintUint64(t *testing.T) {
@@ -3889,6 +4505,17 @@ func TestMergeUintUint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintUint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]uint64{1: 10, 2: 20}
+	map2 = map[uint]uint64{2: 30}
+	map3 := map[uint]uint64{2: 40, 3: 50}
+
+	expected = map[uint]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeUintUint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintUint32(t *testing.T) {
@@ -3947,6 +4574,17 @@ func TestMergeUintUint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintUint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]uint32{1: 10, 2: 20}
+	map2 = map[uint]uint32{2: 30}
+	map3 := map[uint]uint32{2: 40, 3: 50}
+
+	expected = map[uint]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeUintUint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintUint16(t *testing.T) {
@@ -4005,6 +4643,17 @@ func TestMergeUintUint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintUint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]uint16{1: 10, 2: 20}
+	map2 = map[uint]uint16{2: 30}
+	map3 := map[uint]uint16{2: 40, 3: 50}
+
+	expected = map[uint]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeUintUint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintUint8(t *testing.T) {
@@ -4063,6 +4712,17 @@ func TestMergeUintUint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUintUint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint]uint8{1: 10, 2: 20}
+	map2 = map[uint]uint8{2: 30}
+	map3 := map[uint]uint8{2: 40, 3: 50}
+
+	expected = map[uint]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeUintUint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUintStr(t *testing.T) {
@@ -4237,6 +4897,17 @@ func TestMergeUint64Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]int{1: 10, 2: 20}
+	map2 = map[uint64]int{2: 30}
+	map3 := map[uint64]int{2: 40, 3: 50}
+
+	expected = map[uint64]int{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Int64(t *testing.T) {
@@ -4295,6 +4966,17 @@ func TestMergeUint64Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]int64{1: 10, 2: 20}
+	map2 = map[uint64]int64{2: 30}
+	map3 := map[uint64]int64{2: 40, 3: 50}
+
+	expected = map[uint64]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Int32(t *testing.T) {
@@ -4353,6 +5035,17 @@ func TestMergeUint64Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]int32{1: 10, 2: 20}
+	map2 = map[uint64]int32{2: 30}
+	map3 := map[uint64]int32{2: 40, 3: 50}
+
+	expected = map[uint64]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Int16(t *testing.T) {
@@ -4411,6 +5104,17 @@ func TestMergeUint64Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]int16{1: 10, 2: 20}
+	map2 = map[uint64]int16{2: 30}
+	map3 := map[uint64]int16{2: 40, 3: 50}
+
+	expected = map[uint64]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Int8(t *testing.T) {
@@ -4469,6 +5173,17 @@ func TestMergeUint64Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]int8{1: 10, 2: 20}
+	map2 = map[uint64]int8{2: 30}
+	map3 := map[uint64]int8{2: 40, 3: 50}
+
+	expected = map[uint64]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Uint(t *testing.T) {
@@ -4527,6 +5242,17 @@ func TestMergeUint64Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]uint{1: 10, 2: 20}
+	map2 = map[uint64]uint{2: 30}
+	map3 := map[uint64]uint{2: 40, 3: 50}
+
+	expected = map[uint64]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64(t *testing.T) {
@@ -4585,6 +5311,17 @@ func TestMergeUint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]uint64{1: 10, 2: 20}
+	map2 = map[uint64]uint64{2: 30}
+	map3 := map[uint64]uint64{2: 40, 3: 50}
+
+	expected = map[uint64]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Uint32(t *testing.T) {
@@ -4643,6 +5380,17 @@ func TestMergeUint64Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]uint32{1: 10, 2: 20}
+	map2 = map[uint64]uint32{2: 30}
+	map3 := map[uint64]uint32{2: 40, 3: 50}
+
+	expected = map[uint64]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Uint16(t *testing.T) {
@@ -4701,6 +5449,17 @@ func TestMergeUint64Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]uint16{1: 10, 2: 20}
+	map2 = map[uint64]uint16{2: 30}
+	map3 := map[uint64]uint16{2: 40, 3: 50}
+
+	expected = map[uint64]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Uint8(t *testing.T) {
@@ -4759,6 +5518,17 @@ func TestMergeUint64Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint64Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint64]uint8{1: 10, 2: 20}
+	map2 = map[uint64]uint8{2: 30}
+	map3 := map[uint64]uint8{2: 40, 3: 50}
+
+	expected = map[uint64]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint64Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint64Str(t *testing.T) {
@@ -4933,6 +5703,17 @@ func TestMergeUint32Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]int{1: 10, 2: 20}
+	map2 = map[uint32]int{2: 30}
+	map3 := map[uint32]int{2: 40, 3: 50}
+
+	expected = map[uint32]int{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Int64(t *testing.T) {
@@ -4991,6 +5772,17 @@ func TestMergeUint32Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]int64{1: 10, 2: 20}
+	map2 = map[uint32]int64{2: 30}
+	map3 := map[uint32]int64{2: 40, 3: 50}
+
+	expected = map[uint32]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Int32(t *testing.T) {
@@ -5049,6 +5841,17 @@ func TestMergeUint32Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]int32{1: 10, 2: 20}
+	map2 = map[uint32]int32{2: 30}
+	map3 := map[uint32]int32{2: 40, 3: 50}
+
+	expected = map[uint32]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Int16(t *testing.T) {
@@ -5107,6 +5910,17 @@ func TestMergeUint32Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]int16{1: 10, 2: 20}
+	map2 = map[uint32]int16{2: 30}
+	map3 := map[uint32]int16{2: 40, 3: 50}
+
+	expected = map[uint32]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Int8(t *testing.T) {
@@ -5165,6 +5979,17 @@ func TestMergeUint32Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]int8{1: 10, 2: 20}
+	map2 = map[uint32]int8{2: 30}
+	map3 := map[uint32]int8{2: 40, 3: 50}
+
+	expected = map[uint32]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Uint(t *testing.T) {
@@ -5223,6 +6048,17 @@ func TestMergeUint32Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]uint{1: 10, 2: 20}
+	map2 = map[uint32]uint{2: 30}
+	map3 := map[uint32]uint{2: 40, 3: 50}
+
+	expected = map[uint32]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Uint64(t *testing.T) {
@@ -5281,6 +6117,17 @@ func TestMergeUint32Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]uint64{1: 10, 2: 20}
+	map2 = map[uint32]uint64{2: 30}
+	map3 := map[uint32]uint64{2: 40, 3: 50}
+
+	expected = map[uint32]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32(t *testing.T) {
@@ -5339,6 +6186,17 @@ func TestMergeUint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]uint32{1: 10, 2: 20}
+	map2 = map[uint32]uint32{2: 30}
+	map3 := map[uint32]uint32{2: 40, 3: 50}
+
+	expected = map[uint32]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Uint16(t *testing.T) {
@@ -5397,6 +6255,17 @@ func TestMergeUint32Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]uint16{1: 10, 2: 20}
+	map2 = map[uint32]uint16{2: 30}
+	map3 := map[uint32]uint16{2: 40, 3: 50}
+
+	expected = map[uint32]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Uint8(t *testing.T) {
@@ -5455,6 +6324,17 @@ func TestMergeUint32Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint32Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint32]uint8{1: 10, 2: 20}
+	map2 = map[uint32]uint8{2: 30}
+	map3 := map[uint32]uint8{2: 40, 3: 50}
+
+	expected = map[uint32]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint32Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint32Str(t *testing.T) {
@@ -5629,6 +6509,17 @@ func TestMergeUint16Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]int{1: 10, 2: 20}
+	map2 = map[uint16]int{2: 30}
+	map3 := map[uint16]int{2: 40, 3: 50}
+
+	expected = map[uint16]int{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Int64(t *testing.T) {
@@ -5687,6 +6578,17 @@ func TestMergeUint16Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]int64{1: 10, 2: 20}
+	map2 = map[uint16]int64{2: 30}
+	map3 := map[uint16]int64{2: 40, 3: 50}
+
+	expected = map[uint16]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Int32(t *testing.T) {
@@ -5745,6 +6647,17 @@ func TestMergeUint16Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]int32{1: 10, 2: 20}
+	map2 = map[uint16]int32{2: 30}
+	map3 := map[uint16]int32{2: 40, 3: 50}
+
+	expected = map[uint16]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Int16(t *testing.T) {
@@ -5803,6 +6716,17 @@ func TestMergeUint16Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]int16{1: 10, 2: 20}
+	map2 = map[uint16]int16{2: 30}
+	map3 := map[uint16]int16{2: 40, 3: 50}
+
+	expected = map[uint16]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Int8(t *testing.T) {
@@ -5861,6 +6785,17 @@ func TestMergeUint16Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]int8{1: 10, 2: 20}
+	map2 = map[uint16]int8{2: 30}
+	map3 := map[uint16]int8{2: 40, 3: 50}
+
+	expected = map[uint16]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Uint(t *testing.T) {
@@ -5919,6 +6854,17 @@ func TestMergeUint16Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]uint{1: 10, 2: 20}
+	map2 = map[uint16]uint{2: 30}
+	map3 := map[uint16]uint{2: 40, 3: 50}
+
+	expected = map[uint16]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Uint64(t *testing.T) {
@@ -5977,6 +6923,17 @@ func TestMergeUint16Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]uint64{1: 10, 2: 20}
+	map2 = map[uint16]uint64{2: 30}
+	map3 := map[uint16]uint64{2: 40, 3: 50}
+
+	expected = map[uint16]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Uint32(t *testing.T) {
@@ -6035,6 +6992,17 @@ func TestMergeUint16Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]uint32{1: 10, 2: 20}
+	map2 = map[uint16]uint32{2: 30}
+	map3 := map[uint16]uint32{2: 40, 3: 50}
+
+	expected = map[uint16]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16(t *testing.T) {
@@ -6093,6 +7061,17 @@ func TestMergeUint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]uint16{1: 10, 2: 20}
+	map2 = map[uint16]uint16{2: 30}
+	map3 := map[uint16]uint16{2: 40, 3: 50}
+
+	expected = map[uint16]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Uint8(t *testing.T) {
@@ -6151,6 +7130,17 @@ func TestMergeUint16Uint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint16Uint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint16]uint8{1: 10, 2: 20}
+	map2 = map[uint16]uint8{2: 30}
+	map3 := map[uint16]uint8{2: 40, 3: 50}
+
+	expected = map[uint16]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint16Uint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint16Str(t *testing.T) {
@@ -6325,6 +7315,17 @@ func TestMergeUint8Int(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Int failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]int{1: 10, 2: 20}
+	map2 = map[uint8]int{2: 30}
+	map3 := map[uint8]int{2: 40, 3: 50}
+
+	expected = map[uint8]int{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Int(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Int64(t *testing.T) {
@@ -6383,6 +7384,17 @@ func TestMergeUint8Int64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Int64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]int64{1: 10, 2: 20}
+	map2 = map[uint8]int64{2: 30}
+	map3 := map[uint8]int64{2: 40, 3: 50}
+
+	expected = map[uint8]int64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Int64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Int32(t *testing.T) {
@@ -6441,6 +7453,17 @@ func TestMergeUint8Int32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Int32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]int32{1: 10, 2: 20}
+	map2 = map[uint8]int32{2: 30}
+	map3 := map[uint8]int32{2: 40, 3: 50}
+
+	expected = map[uint8]int32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Int32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Int16(t *testing.T) {
@@ -6499,6 +7522,17 @@ func TestMergeUint8Int16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Int16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]int16{1: 10, 2: 20}
+	map2 = map[uint8]int16{2: 30}
+	map3 := map[uint8]int16{2: 40, 3: 50}
+
+	expected = map[uint8]int16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Int16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Int8(t *testing.T) {
@@ -6557,6 +7591,17 @@ func TestMergeUint8Int8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Int8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]int8{1: 10, 2: 20}
+	map2 = map[uint8]int8{2: 30}
+	map3 := map[uint8]int8{2: 40, 3: 50}
+
+	expected = map[uint8]int8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Int8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Uint(t *testing.T) {
@@ -6615,6 +7660,17 @@ func TestMergeUint8Uint(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Uint failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]uint{1: 10, 2: 20}
+	map2 = map[uint8]uint{2: 30}
+	map3 := map[uint8]uint{2: 40, 3: 50}
+
+	expected = map[uint8]uint{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Uint(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Uint64(t *testing.T) {
@@ -6673,6 +7729,17 @@ func TestMergeUint8Uint64(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Uint64 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]uint64{1: 10, 2: 20}
+	map2 = map[uint8]uint64{2: 30}
+	map3 := map[uint8]uint64{2: 40, 3: 50}
+
+	expected = map[uint8]uint64{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Uint64(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Uint32(t *testing.T) {
@@ -6731,6 +7798,17 @@ func TestMergeUint8Uint32(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Uint32 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]uint32{1: 10, 2: 20}
+	map2 = map[uint8]uint32{2: 30}
+	map3 := map[uint8]uint32{2: 40, 3: 50}
+
+	expected = map[uint8]uint32{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Uint32(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Uint16(t *testing.T) {
@@ -6789,6 +7867,17 @@ func TestMergeUint8Uint16(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8Uint16 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]uint16{1: 10, 2: 20}
+	map2 = map[uint8]uint16{2: 30}
+	map3 := map[uint8]uint16{2: 40, 3: 50}
+
+	expected = map[uint8]uint16{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8Uint16(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8(t *testing.T) {
@@ -6847,6 +7936,17 @@ func TestMergeUint8(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMergeUint8 failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[uint8]uint8{1: 10, 2: 20}
+	map2 = map[uint8]uint8{2: 30}
+	map3 := map[uint8]uint8{2: 40, 3: 50}
+
+	expected = map[uint8]uint8{1: 10, 2: 40, 3: 50}
+	actual = MergeUint8(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 
 func TestMergeUint8Str(t *testing.T) {
@@ -0,0 +1,3457 @@
+package fp
+
+// MergeWithInt merges maps of type map[int]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt(f func(int, int) int, maps ...map[int]int) map[int]int {
+	newMap := make(map[int]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntInt64 merges maps of type map[int]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntInt64(f func(int64, int64) int64, maps ...map[int]int64) map[int]int64 {
+	newMap := make(map[int]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntInt32 merges maps of type map[int]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntInt32(f func(int32, int32) int32, maps ...map[int]int32) map[int]int32 {
+	newMap := make(map[int]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntInt16 merges maps of type map[int]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntInt16(f func(int16, int16) int16, maps ...map[int]int16) map[int]int16 {
+	newMap := make(map[int]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntInt8 merges maps of type map[int]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntInt8(f func(int8, int8) int8, maps ...map[int]int8) map[int]int8 {
+	newMap := make(map[int]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntUint merges maps of type map[int]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntUint(f func(uint, uint) uint, maps ...map[int]uint) map[int]uint {
+	newMap := make(map[int]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntUint64 merges maps of type map[int]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntUint64(f func(uint64, uint64) uint64, maps ...map[int]uint64) map[int]uint64 {
+	newMap := make(map[int]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntUint32 merges maps of type map[int]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntUint32(f func(uint32, uint32) uint32, maps ...map[int]uint32) map[int]uint32 {
+	newMap := make(map[int]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntUint16 merges maps of type map[int]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntUint16(f func(uint16, uint16) uint16, maps ...map[int]uint16) map[int]uint16 {
+	newMap := make(map[int]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntUint8 merges maps of type map[int]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntUint8(f func(uint8, uint8) uint8, maps ...map[int]uint8) map[int]uint8 {
+	newMap := make(map[int]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntStr merges maps of type map[int]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntStr(f func(string, string) string, maps ...map[int]string) map[int]string {
+	newMap := make(map[int]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithIntBool merges maps of type map[int]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntBool(f func(bool, bool) bool, maps ...map[int]bool) map[int]bool {
+	newMap := make(map[int]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Int merges maps of type map[int64]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Int(f func(int, int) int, maps ...map[int64]int) map[int64]int {
+	newMap := make(map[int64]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64 merges maps of type map[int64]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64(f func(int64, int64) int64, maps ...map[int64]int64) map[int64]int64 {
+	newMap := make(map[int64]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Int32 merges maps of type map[int64]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Int32(f func(int32, int32) int32, maps ...map[int64]int32) map[int64]int32 {
+	newMap := make(map[int64]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Int16 merges maps of type map[int64]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Int16(f func(int16, int16) int16, maps ...map[int64]int16) map[int64]int16 {
+	newMap := make(map[int64]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Int8 merges maps of type map[int64]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Int8(f func(int8, int8) int8, maps ...map[int64]int8) map[int64]int8 {
+	newMap := make(map[int64]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Uint merges maps of type map[int64]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Uint(f func(uint, uint) uint, maps ...map[int64]uint) map[int64]uint {
+	newMap := make(map[int64]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Uint64 merges maps of type map[int64]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Uint64(f func(uint64, uint64) uint64, maps ...map[int64]uint64) map[int64]uint64 {
+	newMap := make(map[int64]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Uint32 merges maps of type map[int64]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Uint32(f func(uint32, uint32) uint32, maps ...map[int64]uint32) map[int64]uint32 {
+	newMap := make(map[int64]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Uint16 merges maps of type map[int64]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Uint16(f func(uint16, uint16) uint16, maps ...map[int64]uint16) map[int64]uint16 {
+	newMap := make(map[int64]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Uint8 merges maps of type map[int64]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Uint8(f func(uint8, uint8) uint8, maps ...map[int64]uint8) map[int64]uint8 {
+	newMap := make(map[int64]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Str merges maps of type map[int64]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Str(f func(string, string) string, maps ...map[int64]string) map[int64]string {
+	newMap := make(map[int64]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt64Bool merges maps of type map[int64]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt64Bool(f func(bool, bool) bool, maps ...map[int64]bool) map[int64]bool {
+	newMap := make(map[int64]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Int merges maps of type map[int32]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Int(f func(int, int) int, maps ...map[int32]int) map[int32]int {
+	newMap := make(map[int32]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Int64 merges maps of type map[int32]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Int64(f func(int64, int64) int64, maps ...map[int32]int64) map[int32]int64 {
+	newMap := make(map[int32]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32 merges maps of type map[int32]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32(f func(int32, int32) int32, maps ...map[int32]int32) map[int32]int32 {
+	newMap := make(map[int32]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Int16 merges maps of type map[int32]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Int16(f func(int16, int16) int16, maps ...map[int32]int16) map[int32]int16 {
+	newMap := make(map[int32]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Int8 merges maps of type map[int32]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Int8(f func(int8, int8) int8, maps ...map[int32]int8) map[int32]int8 {
+	newMap := make(map[int32]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Uint merges maps of type map[int32]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Uint(f func(uint, uint) uint, maps ...map[int32]uint) map[int32]uint {
+	newMap := make(map[int32]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Uint64 merges maps of type map[int32]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Uint64(f func(uint64, uint64) uint64, maps ...map[int32]uint64) map[int32]uint64 {
+	newMap := make(map[int32]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Uint32 merges maps of type map[int32]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Uint32(f func(uint32, uint32) uint32, maps ...map[int32]uint32) map[int32]uint32 {
+	newMap := make(map[int32]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Uint16 merges maps of type map[int32]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Uint16(f func(uint16, uint16) uint16, maps ...map[int32]uint16) map[int32]uint16 {
+	newMap := make(map[int32]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Uint8 merges maps of type map[int32]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Uint8(f func(uint8, uint8) uint8, maps ...map[int32]uint8) map[int32]uint8 {
+	newMap := make(map[int32]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Str merges maps of type map[int32]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Str(f func(string, string) string, maps ...map[int32]string) map[int32]string {
+	newMap := make(map[int32]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt32Bool merges maps of type map[int32]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt32Bool(f func(bool, bool) bool, maps ...map[int32]bool) map[int32]bool {
+	newMap := make(map[int32]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Int merges maps of type map[int16]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Int(f func(int, int) int, maps ...map[int16]int) map[int16]int {
+	newMap := make(map[int16]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Int64 merges maps of type map[int16]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Int64(f func(int64, int64) int64, maps ...map[int16]int64) map[int16]int64 {
+	newMap := make(map[int16]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Int32 merges maps of type map[int16]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Int32(f func(int32, int32) int32, maps ...map[int16]int32) map[int16]int32 {
+	newMap := make(map[int16]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16 merges maps of type map[int16]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16(f func(int16, int16) int16, maps ...map[int16]int16) map[int16]int16 {
+	newMap := make(map[int16]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Int8 merges maps of type map[int16]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Int8(f func(int8, int8) int8, maps ...map[int16]int8) map[int16]int8 {
+	newMap := make(map[int16]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Uint merges maps of type map[int16]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Uint(f func(uint, uint) uint, maps ...map[int16]uint) map[int16]uint {
+	newMap := make(map[int16]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Uint64 merges maps of type map[int16]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Uint64(f func(uint64, uint64) uint64, maps ...map[int16]uint64) map[int16]uint64 {
+	newMap := make(map[int16]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Uint32 merges maps of type map[int16]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Uint32(f func(uint32, uint32) uint32, maps ...map[int16]uint32) map[int16]uint32 {
+	newMap := make(map[int16]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Uint16 merges maps of type map[int16]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Uint16(f func(uint16, uint16) uint16, maps ...map[int16]uint16) map[int16]uint16 {
+	newMap := make(map[int16]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Uint8 merges maps of type map[int16]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Uint8(f func(uint8, uint8) uint8, maps ...map[int16]uint8) map[int16]uint8 {
+	newMap := make(map[int16]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Str merges maps of type map[int16]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Str(f func(string, string) string, maps ...map[int16]string) map[int16]string {
+	newMap := make(map[int16]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt16Bool merges maps of type map[int16]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt16Bool(f func(bool, bool) bool, maps ...map[int16]bool) map[int16]bool {
+	newMap := make(map[int16]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Int merges maps of type map[int8]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Int(f func(int, int) int, maps ...map[int8]int) map[int8]int {
+	newMap := make(map[int8]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Int64 merges maps of type map[int8]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Int64(f func(int64, int64) int64, maps ...map[int8]int64) map[int8]int64 {
+	newMap := make(map[int8]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Int32 merges maps of type map[int8]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Int32(f func(int32, int32) int32, maps ...map[int8]int32) map[int8]int32 {
+	newMap := make(map[int8]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Int16 merges maps of type map[int8]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Int16(f func(int16, int16) int16, maps ...map[int8]int16) map[int8]int16 {
+	newMap := make(map[int8]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8 merges maps of type map[int8]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8(f func(int8, int8) int8, maps ...map[int8]int8) map[int8]int8 {
+	newMap := make(map[int8]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Uint merges maps of type map[int8]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Uint(f func(uint, uint) uint, maps ...map[int8]uint) map[int8]uint {
+	newMap := make(map[int8]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Uint64 merges maps of type map[int8]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Uint64(f func(uint64, uint64) uint64, maps ...map[int8]uint64) map[int8]uint64 {
+	newMap := make(map[int8]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Uint32 merges maps of type map[int8]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Uint32(f func(uint32, uint32) uint32, maps ...map[int8]uint32) map[int8]uint32 {
+	newMap := make(map[int8]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Uint16 merges maps of type map[int8]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Uint16(f func(uint16, uint16) uint16, maps ...map[int8]uint16) map[int8]uint16 {
+	newMap := make(map[int8]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Uint8 merges maps of type map[int8]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Uint8(f func(uint8, uint8) uint8, maps ...map[int8]uint8) map[int8]uint8 {
+	newMap := make(map[int8]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Str merges maps of type map[int8]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Str(f func(string, string) string, maps ...map[int8]string) map[int8]string {
+	newMap := make(map[int8]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithInt8Bool merges maps of type map[int8]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithInt8Bool(f func(bool, bool) bool, maps ...map[int8]bool) map[int8]bool {
+	newMap := make(map[int8]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintInt merges maps of type map[uint]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintInt(f func(int, int) int, maps ...map[uint]int) map[uint]int {
+	newMap := make(map[uint]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintInt64 merges maps of type map[uint]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintInt64(f func(int64, int64) int64, maps ...map[uint]int64) map[uint]int64 {
+	newMap := make(map[uint]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintInt32 merges maps of type map[uint]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintInt32(f func(int32, int32) int32, maps ...map[uint]int32) map[uint]int32 {
+	newMap := make(map[uint]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintInt16 merges maps of type map[uint]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintInt16(f func(int16, int16) int16, maps ...map[uint]int16) map[uint]int16 {
+	newMap := make(map[uint]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintInt8 merges maps of type map[uint]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintInt8(f func(int8, int8) int8, maps ...map[uint]int8) map[uint]int8 {
+	newMap := make(map[uint]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint merges maps of type map[uint]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint(f func(uint, uint) uint, maps ...map[uint]uint) map[uint]uint {
+	newMap := make(map[uint]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintUint64 merges maps of type map[uint]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintUint64(f func(uint64, uint64) uint64, maps ...map[uint]uint64) map[uint]uint64 {
+	newMap := make(map[uint]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintUint32 merges maps of type map[uint]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintUint32(f func(uint32, uint32) uint32, maps ...map[uint]uint32) map[uint]uint32 {
+	newMap := make(map[uint]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintUint16 merges maps of type map[uint]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintUint16(f func(uint16, uint16) uint16, maps ...map[uint]uint16) map[uint]uint16 {
+	newMap := make(map[uint]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintUint8 merges maps of type map[uint]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintUint8(f func(uint8, uint8) uint8, maps ...map[uint]uint8) map[uint]uint8 {
+	newMap := make(map[uint]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintStr merges maps of type map[uint]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintStr(f func(string, string) string, maps ...map[uint]string) map[uint]string {
+	newMap := make(map[uint]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUintBool merges maps of type map[uint]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUintBool(f func(bool, bool) bool, maps ...map[uint]bool) map[uint]bool {
+	newMap := make(map[uint]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Int merges maps of type map[uint64]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Int(f func(int, int) int, maps ...map[uint64]int) map[uint64]int {
+	newMap := make(map[uint64]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Int64 merges maps of type map[uint64]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Int64(f func(int64, int64) int64, maps ...map[uint64]int64) map[uint64]int64 {
+	newMap := make(map[uint64]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Int32 merges maps of type map[uint64]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Int32(f func(int32, int32) int32, maps ...map[uint64]int32) map[uint64]int32 {
+	newMap := make(map[uint64]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Int16 merges maps of type map[uint64]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Int16(f func(int16, int16) int16, maps ...map[uint64]int16) map[uint64]int16 {
+	newMap := make(map[uint64]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Int8 merges maps of type map[uint64]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Int8(f func(int8, int8) int8, maps ...map[uint64]int8) map[uint64]int8 {
+	newMap := make(map[uint64]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Uint merges maps of type map[uint64]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Uint(f func(uint, uint) uint, maps ...map[uint64]uint) map[uint64]uint {
+	newMap := make(map[uint64]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64 merges maps of type map[uint64]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64(f func(uint64, uint64) uint64, maps ...map[uint64]uint64) map[uint64]uint64 {
+	newMap := make(map[uint64]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Uint32 merges maps of type map[uint64]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Uint32(f func(uint32, uint32) uint32, maps ...map[uint64]uint32) map[uint64]uint32 {
+	newMap := make(map[uint64]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Uint16 merges maps of type map[uint64]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Uint16(f func(uint16, uint16) uint16, maps ...map[uint64]uint16) map[uint64]uint16 {
+	newMap := make(map[uint64]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Uint8 merges maps of type map[uint64]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Uint8(f func(uint8, uint8) uint8, maps ...map[uint64]uint8) map[uint64]uint8 {
+	newMap := make(map[uint64]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Str merges maps of type map[uint64]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Str(f func(string, string) string, maps ...map[uint64]string) map[uint64]string {
+	newMap := make(map[uint64]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint64Bool merges maps of type map[uint64]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint64Bool(f func(bool, bool) bool, maps ...map[uint64]bool) map[uint64]bool {
+	newMap := make(map[uint64]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Int merges maps of type map[uint32]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Int(f func(int, int) int, maps ...map[uint32]int) map[uint32]int {
+	newMap := make(map[uint32]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Int64 merges maps of type map[uint32]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Int64(f func(int64, int64) int64, maps ...map[uint32]int64) map[uint32]int64 {
+	newMap := make(map[uint32]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Int32 merges maps of type map[uint32]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Int32(f func(int32, int32) int32, maps ...map[uint32]int32) map[uint32]int32 {
+	newMap := make(map[uint32]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Int16 merges maps of type map[uint32]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Int16(f func(int16, int16) int16, maps ...map[uint32]int16) map[uint32]int16 {
+	newMap := make(map[uint32]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Int8 merges maps of type map[uint32]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Int8(f func(int8, int8) int8, maps ...map[uint32]int8) map[uint32]int8 {
+	newMap := make(map[uint32]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Uint merges maps of type map[uint32]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Uint(f func(uint, uint) uint, maps ...map[uint32]uint) map[uint32]uint {
+	newMap := make(map[uint32]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Uint64 merges maps of type map[uint32]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Uint64(f func(uint64, uint64) uint64, maps ...map[uint32]uint64) map[uint32]uint64 {
+	newMap := make(map[uint32]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32 merges maps of type map[uint32]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32(f func(uint32, uint32) uint32, maps ...map[uint32]uint32) map[uint32]uint32 {
+	newMap := make(map[uint32]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Uint16 merges maps of type map[uint32]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Uint16(f func(uint16, uint16) uint16, maps ...map[uint32]uint16) map[uint32]uint16 {
+	newMap := make(map[uint32]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Uint8 merges maps of type map[uint32]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Uint8(f func(uint8, uint8) uint8, maps ...map[uint32]uint8) map[uint32]uint8 {
+	newMap := make(map[uint32]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Str merges maps of type map[uint32]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Str(f func(string, string) string, maps ...map[uint32]string) map[uint32]string {
+	newMap := make(map[uint32]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint32Bool merges maps of type map[uint32]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint32Bool(f func(bool, bool) bool, maps ...map[uint32]bool) map[uint32]bool {
+	newMap := make(map[uint32]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Int merges maps of type map[uint16]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Int(f func(int, int) int, maps ...map[uint16]int) map[uint16]int {
+	newMap := make(map[uint16]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Int64 merges maps of type map[uint16]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Int64(f func(int64, int64) int64, maps ...map[uint16]int64) map[uint16]int64 {
+	newMap := make(map[uint16]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Int32 merges maps of type map[uint16]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Int32(f func(int32, int32) int32, maps ...map[uint16]int32) map[uint16]int32 {
+	newMap := make(map[uint16]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Int16 merges maps of type map[uint16]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Int16(f func(int16, int16) int16, maps ...map[uint16]int16) map[uint16]int16 {
+	newMap := make(map[uint16]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Int8 merges maps of type map[uint16]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Int8(f func(int8, int8) int8, maps ...map[uint16]int8) map[uint16]int8 {
+	newMap := make(map[uint16]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Uint merges maps of type map[uint16]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Uint(f func(uint, uint) uint, maps ...map[uint16]uint) map[uint16]uint {
+	newMap := make(map[uint16]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Uint64 merges maps of type map[uint16]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Uint64(f func(uint64, uint64) uint64, maps ...map[uint16]uint64) map[uint16]uint64 {
+	newMap := make(map[uint16]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Uint32 merges maps of type map[uint16]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Uint32(f func(uint32, uint32) uint32, maps ...map[uint16]uint32) map[uint16]uint32 {
+	newMap := make(map[uint16]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16 merges maps of type map[uint16]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16(f func(uint16, uint16) uint16, maps ...map[uint16]uint16) map[uint16]uint16 {
+	newMap := make(map[uint16]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Uint8 merges maps of type map[uint16]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Uint8(f func(uint8, uint8) uint8, maps ...map[uint16]uint8) map[uint16]uint8 {
+	newMap := make(map[uint16]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Str merges maps of type map[uint16]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Str(f func(string, string) string, maps ...map[uint16]string) map[uint16]string {
+	newMap := make(map[uint16]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint16Bool merges maps of type map[uint16]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint16Bool(f func(bool, bool) bool, maps ...map[uint16]bool) map[uint16]bool {
+	newMap := make(map[uint16]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Int merges maps of type map[uint8]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Int(f func(int, int) int, maps ...map[uint8]int) map[uint8]int {
+	newMap := make(map[uint8]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Int64 merges maps of type map[uint8]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Int64(f func(int64, int64) int64, maps ...map[uint8]int64) map[uint8]int64 {
+	newMap := make(map[uint8]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Int32 merges maps of type map[uint8]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Int32(f func(int32, int32) int32, maps ...map[uint8]int32) map[uint8]int32 {
+	newMap := make(map[uint8]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Int16 merges maps of type map[uint8]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Int16(f func(int16, int16) int16, maps ...map[uint8]int16) map[uint8]int16 {
+	newMap := make(map[uint8]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Int8 merges maps of type map[uint8]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Int8(f func(int8, int8) int8, maps ...map[uint8]int8) map[uint8]int8 {
+	newMap := make(map[uint8]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Uint merges maps of type map[uint8]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Uint(f func(uint, uint) uint, maps ...map[uint8]uint) map[uint8]uint {
+	newMap := make(map[uint8]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Uint64 merges maps of type map[uint8]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Uint64(f func(uint64, uint64) uint64, maps ...map[uint8]uint64) map[uint8]uint64 {
+	newMap := make(map[uint8]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Uint32 merges maps of type map[uint8]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Uint32(f func(uint32, uint32) uint32, maps ...map[uint8]uint32) map[uint8]uint32 {
+	newMap := make(map[uint8]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Uint16 merges maps of type map[uint8]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Uint16(f func(uint16, uint16) uint16, maps ...map[uint8]uint16) map[uint8]uint16 {
+	newMap := make(map[uint8]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8 merges maps of type map[uint8]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8(f func(uint8, uint8) uint8, maps ...map[uint8]uint8) map[uint8]uint8 {
+	newMap := make(map[uint8]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Str merges maps of type map[uint8]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Str(f func(string, string) string, maps ...map[uint8]string) map[uint8]string {
+	newMap := make(map[uint8]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithUint8Bool merges maps of type map[uint8]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithUint8Bool(f func(bool, bool) bool, maps ...map[uint8]bool) map[uint8]bool {
+	newMap := make(map[uint8]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrInt merges maps of type map[string]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrInt(f func(int, int) int, maps ...map[string]int) map[string]int {
+	newMap := make(map[string]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrInt64 merges maps of type map[string]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrInt64(f func(int64, int64) int64, maps ...map[string]int64) map[string]int64 {
+	newMap := make(map[string]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrInt32 merges maps of type map[string]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrInt32(f func(int32, int32) int32, maps ...map[string]int32) map[string]int32 {
+	newMap := make(map[string]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrInt16 merges maps of type map[string]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrInt16(f func(int16, int16) int16, maps ...map[string]int16) map[string]int16 {
+	newMap := make(map[string]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrInt8 merges maps of type map[string]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrInt8(f func(int8, int8) int8, maps ...map[string]int8) map[string]int8 {
+	newMap := make(map[string]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrUint merges maps of type map[string]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrUint(f func(uint, uint) uint, maps ...map[string]uint) map[string]uint {
+	newMap := make(map[string]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrUint64 merges maps of type map[string]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrUint64(f func(uint64, uint64) uint64, maps ...map[string]uint64) map[string]uint64 {
+	newMap := make(map[string]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrUint32 merges maps of type map[string]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrUint32(f func(uint32, uint32) uint32, maps ...map[string]uint32) map[string]uint32 {
+	newMap := make(map[string]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrUint16 merges maps of type map[string]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrUint16(f func(uint16, uint16) uint16, maps ...map[string]uint16) map[string]uint16 {
+	newMap := make(map[string]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrUint8 merges maps of type map[string]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrUint8(f func(uint8, uint8) uint8, maps ...map[string]uint8) map[string]uint8 {
+	newMap := make(map[string]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStr merges maps of type map[string]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStr(f func(string, string) string, maps ...map[string]string) map[string]string {
+	newMap := make(map[string]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithStrBool merges maps of type map[string]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrBool(f func(bool, bool) bool, maps ...map[string]bool) map[string]bool {
+	newMap := make(map[string]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolInt merges maps of type map[bool]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolInt(f func(int, int) int, maps ...map[bool]int) map[bool]int {
+	newMap := make(map[bool]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolInt64 merges maps of type map[bool]int64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolInt64(f func(int64, int64) int64, maps ...map[bool]int64) map[bool]int64 {
+	newMap := make(map[bool]int64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolInt32 merges maps of type map[bool]int32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolInt32(f func(int32, int32) int32, maps ...map[bool]int32) map[bool]int32 {
+	newMap := make(map[bool]int32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolInt16 merges maps of type map[bool]int16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolInt16(f func(int16, int16) int16, maps ...map[bool]int16) map[bool]int16 {
+	newMap := make(map[bool]int16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolInt8 merges maps of type map[bool]int8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolInt8(f func(int8, int8) int8, maps ...map[bool]int8) map[bool]int8 {
+	newMap := make(map[bool]int8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolUint merges maps of type map[bool]uint and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolUint(f func(uint, uint) uint, maps ...map[bool]uint) map[bool]uint {
+	newMap := make(map[bool]uint)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolUint64 merges maps of type map[bool]uint64 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolUint64(f func(uint64, uint64) uint64, maps ...map[bool]uint64) map[bool]uint64 {
+	newMap := make(map[bool]uint64)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolUint32 merges maps of type map[bool]uint32 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolUint32(f func(uint32, uint32) uint32, maps ...map[bool]uint32) map[bool]uint32 {
+	newMap := make(map[bool]uint32)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolUint16 merges maps of type map[bool]uint16 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolUint16(f func(uint16, uint16) uint16, maps ...map[bool]uint16) map[bool]uint16 {
+	newMap := make(map[bool]uint16)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolUint8 merges maps of type map[bool]uint8 and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolUint8(f func(uint8, uint8) uint8, maps ...map[bool]uint8) map[bool]uint8 {
+	newMap := make(map[bool]uint8)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBoolStr merges maps of type map[bool]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBoolStr(f func(string, string) string, maps ...map[bool]string) map[bool]string {
+	newMap := make(map[bool]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithBool merges maps of type map[bool]bool and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithBool(f func(bool, bool) bool, maps ...map[bool]bool) map[bool]bool {
+	newMap := make(map[bool]bool)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
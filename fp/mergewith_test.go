@@ -0,0 +1,3318 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeWithInt(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[int]int{1: 1, 2: 2}
+	map2 := map[int]int{2: 10}
+
+	expected := map[int]int{1: 1, 2: 12}
+	actual := MergeWithInt(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int{1: 1, 2: 10}
+	actual = MergeWithInt(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntInt64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[int]int64{1: 1, 2: 2}
+	map2 := map[int]int64{2: 10}
+
+	expected := map[int]int64{1: 1, 2: 12}
+	actual := MergeWithIntInt64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int64{1: 1, 2: 10}
+	actual = MergeWithIntInt64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntInt64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntInt64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntInt32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[int]int32{1: 1, 2: 2}
+	map2 := map[int]int32{2: 10}
+
+	expected := map[int]int32{1: 1, 2: 12}
+	actual := MergeWithIntInt32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int32{1: 1, 2: 10}
+	actual = MergeWithIntInt32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntInt32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntInt32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntInt16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[int]int16{1: 1, 2: 2}
+	map2 := map[int]int16{2: 10}
+
+	expected := map[int]int16{1: 1, 2: 12}
+	actual := MergeWithIntInt16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int16{1: 1, 2: 10}
+	actual = MergeWithIntInt16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntInt16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntInt16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntInt8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[int]int8{1: 1, 2: 2}
+	map2 := map[int]int8{2: 10}
+
+	expected := map[int]int8{1: 1, 2: 12}
+	actual := MergeWithIntInt8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]int8{1: 1, 2: 10}
+	actual = MergeWithIntInt8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntInt8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntInt8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntUint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[int]uint{1: 1, 2: 2}
+	map2 := map[int]uint{2: 10}
+
+	expected := map[int]uint{1: 1, 2: 12}
+	actual := MergeWithIntUint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint{1: 1, 2: 10}
+	actual = MergeWithIntUint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntUint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntUint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntUint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[int]uint64{1: 1, 2: 2}
+	map2 := map[int]uint64{2: 10}
+
+	expected := map[int]uint64{1: 1, 2: 12}
+	actual := MergeWithIntUint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint64{1: 1, 2: 10}
+	actual = MergeWithIntUint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntUint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntUint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntUint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[int]uint32{1: 1, 2: 2}
+	map2 := map[int]uint32{2: 10}
+
+	expected := map[int]uint32{1: 1, 2: 12}
+	actual := MergeWithIntUint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint32{1: 1, 2: 10}
+	actual = MergeWithIntUint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntUint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntUint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntUint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[int]uint16{1: 1, 2: 2}
+	map2 := map[int]uint16{2: 10}
+
+	expected := map[int]uint16{1: 1, 2: 12}
+	actual := MergeWithIntUint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint16{1: 1, 2: 10}
+	actual = MergeWithIntUint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntUint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntUint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntUint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[int]uint8{1: 1, 2: 2}
+	map2 := map[int]uint8{2: 10}
+
+	expected := map[int]uint8{1: 1, 2: 12}
+	actual := MergeWithIntUint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]uint8{1: 1, 2: 10}
+	actual = MergeWithIntUint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntUint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntUint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntStr(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[int]string{1: "a", 2: "b"}
+	map2 := map[int]string{2: "c"}
+
+	expected := map[int]string{1: "a", 2: "bc"}
+	actual := MergeWithIntStr(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]string{1: "a", 2: "c"}
+	actual = MergeWithIntStr(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntStr(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntStr failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithIntBool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[int]bool{1: false, 2: false}
+	map2 := map[int]bool{2: true}
+
+	expected := map[int]bool{1: false, 2: true}
+	actual := MergeWithIntBool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int]bool{1: false, 2: true}
+	actual = MergeWithIntBool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithIntBool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithIntBool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[int64]int{1: 1, 2: 2}
+	map2 := map[int64]int{2: 10}
+
+	expected := map[int64]int{1: 1, 2: 12}
+	actual := MergeWithInt64Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int{1: 1, 2: 10}
+	actual = MergeWithInt64Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[int64]int64{1: 1, 2: 2}
+	map2 := map[int64]int64{2: 10}
+
+	expected := map[int64]int64{1: 1, 2: 12}
+	actual := MergeWithInt64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int64{1: 1, 2: 10}
+	actual = MergeWithInt64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[int64]int32{1: 1, 2: 2}
+	map2 := map[int64]int32{2: 10}
+
+	expected := map[int64]int32{1: 1, 2: 12}
+	actual := MergeWithInt64Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int32{1: 1, 2: 10}
+	actual = MergeWithInt64Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[int64]int16{1: 1, 2: 2}
+	map2 := map[int64]int16{2: 10}
+
+	expected := map[int64]int16{1: 1, 2: 12}
+	actual := MergeWithInt64Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int16{1: 1, 2: 10}
+	actual = MergeWithInt64Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[int64]int8{1: 1, 2: 2}
+	map2 := map[int64]int8{2: 10}
+
+	expected := map[int64]int8{1: 1, 2: 12}
+	actual := MergeWithInt64Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]int8{1: 1, 2: 10}
+	actual = MergeWithInt64Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[int64]uint{1: 1, 2: 2}
+	map2 := map[int64]uint{2: 10}
+
+	expected := map[int64]uint{1: 1, 2: 12}
+	actual := MergeWithInt64Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint{1: 1, 2: 10}
+	actual = MergeWithInt64Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[int64]uint64{1: 1, 2: 2}
+	map2 := map[int64]uint64{2: 10}
+
+	expected := map[int64]uint64{1: 1, 2: 12}
+	actual := MergeWithInt64Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint64{1: 1, 2: 10}
+	actual = MergeWithInt64Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[int64]uint32{1: 1, 2: 2}
+	map2 := map[int64]uint32{2: 10}
+
+	expected := map[int64]uint32{1: 1, 2: 12}
+	actual := MergeWithInt64Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint32{1: 1, 2: 10}
+	actual = MergeWithInt64Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[int64]uint16{1: 1, 2: 2}
+	map2 := map[int64]uint16{2: 10}
+
+	expected := map[int64]uint16{1: 1, 2: 12}
+	actual := MergeWithInt64Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint16{1: 1, 2: 10}
+	actual = MergeWithInt64Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[int64]uint8{1: 1, 2: 2}
+	map2 := map[int64]uint8{2: 10}
+
+	expected := map[int64]uint8{1: 1, 2: 12}
+	actual := MergeWithInt64Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]uint8{1: 1, 2: 10}
+	actual = MergeWithInt64Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[int64]string{1: "a", 2: "b"}
+	map2 := map[int64]string{2: "c"}
+
+	expected := map[int64]string{1: "a", 2: "bc"}
+	actual := MergeWithInt64Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]string{1: "a", 2: "c"}
+	actual = MergeWithInt64Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt64Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[int64]bool{1: false, 2: false}
+	map2 := map[int64]bool{2: true}
+
+	expected := map[int64]bool{1: false, 2: true}
+	actual := MergeWithInt64Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int64]bool{1: false, 2: true}
+	actual = MergeWithInt64Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt64Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt64Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[int32]int{1: 1, 2: 2}
+	map2 := map[int32]int{2: 10}
+
+	expected := map[int32]int{1: 1, 2: 12}
+	actual := MergeWithInt32Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int{1: 1, 2: 10}
+	actual = MergeWithInt32Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[int32]int64{1: 1, 2: 2}
+	map2 := map[int32]int64{2: 10}
+
+	expected := map[int32]int64{1: 1, 2: 12}
+	actual := MergeWithInt32Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int64{1: 1, 2: 10}
+	actual = MergeWithInt32Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[int32]int32{1: 1, 2: 2}
+	map2 := map[int32]int32{2: 10}
+
+	expected := map[int32]int32{1: 1, 2: 12}
+	actual := MergeWithInt32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int32{1: 1, 2: 10}
+	actual = MergeWithInt32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[int32]int16{1: 1, 2: 2}
+	map2 := map[int32]int16{2: 10}
+
+	expected := map[int32]int16{1: 1, 2: 12}
+	actual := MergeWithInt32Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int16{1: 1, 2: 10}
+	actual = MergeWithInt32Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[int32]int8{1: 1, 2: 2}
+	map2 := map[int32]int8{2: 10}
+
+	expected := map[int32]int8{1: 1, 2: 12}
+	actual := MergeWithInt32Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]int8{1: 1, 2: 10}
+	actual = MergeWithInt32Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[int32]uint{1: 1, 2: 2}
+	map2 := map[int32]uint{2: 10}
+
+	expected := map[int32]uint{1: 1, 2: 12}
+	actual := MergeWithInt32Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint{1: 1, 2: 10}
+	actual = MergeWithInt32Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[int32]uint64{1: 1, 2: 2}
+	map2 := map[int32]uint64{2: 10}
+
+	expected := map[int32]uint64{1: 1, 2: 12}
+	actual := MergeWithInt32Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint64{1: 1, 2: 10}
+	actual = MergeWithInt32Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[int32]uint32{1: 1, 2: 2}
+	map2 := map[int32]uint32{2: 10}
+
+	expected := map[int32]uint32{1: 1, 2: 12}
+	actual := MergeWithInt32Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint32{1: 1, 2: 10}
+	actual = MergeWithInt32Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[int32]uint16{1: 1, 2: 2}
+	map2 := map[int32]uint16{2: 10}
+
+	expected := map[int32]uint16{1: 1, 2: 12}
+	actual := MergeWithInt32Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint16{1: 1, 2: 10}
+	actual = MergeWithInt32Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[int32]uint8{1: 1, 2: 2}
+	map2 := map[int32]uint8{2: 10}
+
+	expected := map[int32]uint8{1: 1, 2: 12}
+	actual := MergeWithInt32Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]uint8{1: 1, 2: 10}
+	actual = MergeWithInt32Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[int32]string{1: "a", 2: "b"}
+	map2 := map[int32]string{2: "c"}
+
+	expected := map[int32]string{1: "a", 2: "bc"}
+	actual := MergeWithInt32Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]string{1: "a", 2: "c"}
+	actual = MergeWithInt32Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt32Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[int32]bool{1: false, 2: false}
+	map2 := map[int32]bool{2: true}
+
+	expected := map[int32]bool{1: false, 2: true}
+	actual := MergeWithInt32Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int32]bool{1: false, 2: true}
+	actual = MergeWithInt32Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt32Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt32Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[int16]int{1: 1, 2: 2}
+	map2 := map[int16]int{2: 10}
+
+	expected := map[int16]int{1: 1, 2: 12}
+	actual := MergeWithInt16Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int{1: 1, 2: 10}
+	actual = MergeWithInt16Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[int16]int64{1: 1, 2: 2}
+	map2 := map[int16]int64{2: 10}
+
+	expected := map[int16]int64{1: 1, 2: 12}
+	actual := MergeWithInt16Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int64{1: 1, 2: 10}
+	actual = MergeWithInt16Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[int16]int32{1: 1, 2: 2}
+	map2 := map[int16]int32{2: 10}
+
+	expected := map[int16]int32{1: 1, 2: 12}
+	actual := MergeWithInt16Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int32{1: 1, 2: 10}
+	actual = MergeWithInt16Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[int16]int16{1: 1, 2: 2}
+	map2 := map[int16]int16{2: 10}
+
+	expected := map[int16]int16{1: 1, 2: 12}
+	actual := MergeWithInt16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int16{1: 1, 2: 10}
+	actual = MergeWithInt16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[int16]int8{1: 1, 2: 2}
+	map2 := map[int16]int8{2: 10}
+
+	expected := map[int16]int8{1: 1, 2: 12}
+	actual := MergeWithInt16Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]int8{1: 1, 2: 10}
+	actual = MergeWithInt16Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[int16]uint{1: 1, 2: 2}
+	map2 := map[int16]uint{2: 10}
+
+	expected := map[int16]uint{1: 1, 2: 12}
+	actual := MergeWithInt16Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint{1: 1, 2: 10}
+	actual = MergeWithInt16Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[int16]uint64{1: 1, 2: 2}
+	map2 := map[int16]uint64{2: 10}
+
+	expected := map[int16]uint64{1: 1, 2: 12}
+	actual := MergeWithInt16Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint64{1: 1, 2: 10}
+	actual = MergeWithInt16Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[int16]uint32{1: 1, 2: 2}
+	map2 := map[int16]uint32{2: 10}
+
+	expected := map[int16]uint32{1: 1, 2: 12}
+	actual := MergeWithInt16Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint32{1: 1, 2: 10}
+	actual = MergeWithInt16Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[int16]uint16{1: 1, 2: 2}
+	map2 := map[int16]uint16{2: 10}
+
+	expected := map[int16]uint16{1: 1, 2: 12}
+	actual := MergeWithInt16Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint16{1: 1, 2: 10}
+	actual = MergeWithInt16Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[int16]uint8{1: 1, 2: 2}
+	map2 := map[int16]uint8{2: 10}
+
+	expected := map[int16]uint8{1: 1, 2: 12}
+	actual := MergeWithInt16Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]uint8{1: 1, 2: 10}
+	actual = MergeWithInt16Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[int16]string{1: "a", 2: "b"}
+	map2 := map[int16]string{2: "c"}
+
+	expected := map[int16]string{1: "a", 2: "bc"}
+	actual := MergeWithInt16Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]string{1: "a", 2: "c"}
+	actual = MergeWithInt16Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt16Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[int16]bool{1: false, 2: false}
+	map2 := map[int16]bool{2: true}
+
+	expected := map[int16]bool{1: false, 2: true}
+	actual := MergeWithInt16Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int16]bool{1: false, 2: true}
+	actual = MergeWithInt16Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt16Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt16Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[int8]int{1: 1, 2: 2}
+	map2 := map[int8]int{2: 10}
+
+	expected := map[int8]int{1: 1, 2: 12}
+	actual := MergeWithInt8Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int{1: 1, 2: 10}
+	actual = MergeWithInt8Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[int8]int64{1: 1, 2: 2}
+	map2 := map[int8]int64{2: 10}
+
+	expected := map[int8]int64{1: 1, 2: 12}
+	actual := MergeWithInt8Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int64{1: 1, 2: 10}
+	actual = MergeWithInt8Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[int8]int32{1: 1, 2: 2}
+	map2 := map[int8]int32{2: 10}
+
+	expected := map[int8]int32{1: 1, 2: 12}
+	actual := MergeWithInt8Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int32{1: 1, 2: 10}
+	actual = MergeWithInt8Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[int8]int16{1: 1, 2: 2}
+	map2 := map[int8]int16{2: 10}
+
+	expected := map[int8]int16{1: 1, 2: 12}
+	actual := MergeWithInt8Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int16{1: 1, 2: 10}
+	actual = MergeWithInt8Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[int8]int8{1: 1, 2: 2}
+	map2 := map[int8]int8{2: 10}
+
+	expected := map[int8]int8{1: 1, 2: 12}
+	actual := MergeWithInt8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]int8{1: 1, 2: 10}
+	actual = MergeWithInt8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[int8]uint{1: 1, 2: 2}
+	map2 := map[int8]uint{2: 10}
+
+	expected := map[int8]uint{1: 1, 2: 12}
+	actual := MergeWithInt8Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint{1: 1, 2: 10}
+	actual = MergeWithInt8Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[int8]uint64{1: 1, 2: 2}
+	map2 := map[int8]uint64{2: 10}
+
+	expected := map[int8]uint64{1: 1, 2: 12}
+	actual := MergeWithInt8Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint64{1: 1, 2: 10}
+	actual = MergeWithInt8Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[int8]uint32{1: 1, 2: 2}
+	map2 := map[int8]uint32{2: 10}
+
+	expected := map[int8]uint32{1: 1, 2: 12}
+	actual := MergeWithInt8Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint32{1: 1, 2: 10}
+	actual = MergeWithInt8Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[int8]uint16{1: 1, 2: 2}
+	map2 := map[int8]uint16{2: 10}
+
+	expected := map[int8]uint16{1: 1, 2: 12}
+	actual := MergeWithInt8Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint16{1: 1, 2: 10}
+	actual = MergeWithInt8Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[int8]uint8{1: 1, 2: 2}
+	map2 := map[int8]uint8{2: 10}
+
+	expected := map[int8]uint8{1: 1, 2: 12}
+	actual := MergeWithInt8Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]uint8{1: 1, 2: 10}
+	actual = MergeWithInt8Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[int8]string{1: "a", 2: "b"}
+	map2 := map[int8]string{2: "c"}
+
+	expected := map[int8]string{1: "a", 2: "bc"}
+	actual := MergeWithInt8Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]string{1: "a", 2: "c"}
+	actual = MergeWithInt8Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithInt8Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[int8]bool{1: false, 2: false}
+	map2 := map[int8]bool{2: true}
+
+	expected := map[int8]bool{1: false, 2: true}
+	actual := MergeWithInt8Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[int8]bool{1: false, 2: true}
+	actual = MergeWithInt8Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithInt8Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithInt8Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintInt(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[uint]int{1: 1, 2: 2}
+	map2 := map[uint]int{2: 10}
+
+	expected := map[uint]int{1: 1, 2: 12}
+	actual := MergeWithUintInt(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int{1: 1, 2: 10}
+	actual = MergeWithUintInt(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintInt(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintInt failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintInt64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[uint]int64{1: 1, 2: 2}
+	map2 := map[uint]int64{2: 10}
+
+	expected := map[uint]int64{1: 1, 2: 12}
+	actual := MergeWithUintInt64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int64{1: 1, 2: 10}
+	actual = MergeWithUintInt64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintInt64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintInt64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintInt32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[uint]int32{1: 1, 2: 2}
+	map2 := map[uint]int32{2: 10}
+
+	expected := map[uint]int32{1: 1, 2: 12}
+	actual := MergeWithUintInt32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int32{1: 1, 2: 10}
+	actual = MergeWithUintInt32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintInt32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintInt32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintInt16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[uint]int16{1: 1, 2: 2}
+	map2 := map[uint]int16{2: 10}
+
+	expected := map[uint]int16{1: 1, 2: 12}
+	actual := MergeWithUintInt16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int16{1: 1, 2: 10}
+	actual = MergeWithUintInt16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintInt16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintInt16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintInt8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[uint]int8{1: 1, 2: 2}
+	map2 := map[uint]int8{2: 10}
+
+	expected := map[uint]int8{1: 1, 2: 12}
+	actual := MergeWithUintInt8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]int8{1: 1, 2: 10}
+	actual = MergeWithUintInt8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintInt8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintInt8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[uint]uint{1: 1, 2: 2}
+	map2 := map[uint]uint{2: 10}
+
+	expected := map[uint]uint{1: 1, 2: 12}
+	actual := MergeWithUint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint{1: 1, 2: 10}
+	actual = MergeWithUint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintUint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[uint]uint64{1: 1, 2: 2}
+	map2 := map[uint]uint64{2: 10}
+
+	expected := map[uint]uint64{1: 1, 2: 12}
+	actual := MergeWithUintUint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint64{1: 1, 2: 10}
+	actual = MergeWithUintUint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintUint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintUint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintUint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[uint]uint32{1: 1, 2: 2}
+	map2 := map[uint]uint32{2: 10}
+
+	expected := map[uint]uint32{1: 1, 2: 12}
+	actual := MergeWithUintUint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint32{1: 1, 2: 10}
+	actual = MergeWithUintUint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintUint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintUint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintUint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[uint]uint16{1: 1, 2: 2}
+	map2 := map[uint]uint16{2: 10}
+
+	expected := map[uint]uint16{1: 1, 2: 12}
+	actual := MergeWithUintUint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint16{1: 1, 2: 10}
+	actual = MergeWithUintUint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintUint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintUint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintUint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[uint]uint8{1: 1, 2: 2}
+	map2 := map[uint]uint8{2: 10}
+
+	expected := map[uint]uint8{1: 1, 2: 12}
+	actual := MergeWithUintUint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]uint8{1: 1, 2: 10}
+	actual = MergeWithUintUint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintUint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintUint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintStr(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[uint]string{1: "a", 2: "b"}
+	map2 := map[uint]string{2: "c"}
+
+	expected := map[uint]string{1: "a", 2: "bc"}
+	actual := MergeWithUintStr(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]string{1: "a", 2: "c"}
+	actual = MergeWithUintStr(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintStr(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintStr failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUintBool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[uint]bool{1: false, 2: false}
+	map2 := map[uint]bool{2: true}
+
+	expected := map[uint]bool{1: false, 2: true}
+	actual := MergeWithUintBool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint]bool{1: false, 2: true}
+	actual = MergeWithUintBool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUintBool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUintBool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[uint64]int{1: 1, 2: 2}
+	map2 := map[uint64]int{2: 10}
+
+	expected := map[uint64]int{1: 1, 2: 12}
+	actual := MergeWithUint64Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int{1: 1, 2: 10}
+	actual = MergeWithUint64Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[uint64]int64{1: 1, 2: 2}
+	map2 := map[uint64]int64{2: 10}
+
+	expected := map[uint64]int64{1: 1, 2: 12}
+	actual := MergeWithUint64Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int64{1: 1, 2: 10}
+	actual = MergeWithUint64Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[uint64]int32{1: 1, 2: 2}
+	map2 := map[uint64]int32{2: 10}
+
+	expected := map[uint64]int32{1: 1, 2: 12}
+	actual := MergeWithUint64Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int32{1: 1, 2: 10}
+	actual = MergeWithUint64Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[uint64]int16{1: 1, 2: 2}
+	map2 := map[uint64]int16{2: 10}
+
+	expected := map[uint64]int16{1: 1, 2: 12}
+	actual := MergeWithUint64Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int16{1: 1, 2: 10}
+	actual = MergeWithUint64Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[uint64]int8{1: 1, 2: 2}
+	map2 := map[uint64]int8{2: 10}
+
+	expected := map[uint64]int8{1: 1, 2: 12}
+	actual := MergeWithUint64Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]int8{1: 1, 2: 10}
+	actual = MergeWithUint64Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[uint64]uint{1: 1, 2: 2}
+	map2 := map[uint64]uint{2: 10}
+
+	expected := map[uint64]uint{1: 1, 2: 12}
+	actual := MergeWithUint64Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint{1: 1, 2: 10}
+	actual = MergeWithUint64Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[uint64]uint64{1: 1, 2: 2}
+	map2 := map[uint64]uint64{2: 10}
+
+	expected := map[uint64]uint64{1: 1, 2: 12}
+	actual := MergeWithUint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint64{1: 1, 2: 10}
+	actual = MergeWithUint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[uint64]uint32{1: 1, 2: 2}
+	map2 := map[uint64]uint32{2: 10}
+
+	expected := map[uint64]uint32{1: 1, 2: 12}
+	actual := MergeWithUint64Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint32{1: 1, 2: 10}
+	actual = MergeWithUint64Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[uint64]uint16{1: 1, 2: 2}
+	map2 := map[uint64]uint16{2: 10}
+
+	expected := map[uint64]uint16{1: 1, 2: 12}
+	actual := MergeWithUint64Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint16{1: 1, 2: 10}
+	actual = MergeWithUint64Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[uint64]uint8{1: 1, 2: 2}
+	map2 := map[uint64]uint8{2: 10}
+
+	expected := map[uint64]uint8{1: 1, 2: 12}
+	actual := MergeWithUint64Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]uint8{1: 1, 2: 10}
+	actual = MergeWithUint64Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[uint64]string{1: "a", 2: "b"}
+	map2 := map[uint64]string{2: "c"}
+
+	expected := map[uint64]string{1: "a", 2: "bc"}
+	actual := MergeWithUint64Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]string{1: "a", 2: "c"}
+	actual = MergeWithUint64Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint64Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[uint64]bool{1: false, 2: false}
+	map2 := map[uint64]bool{2: true}
+
+	expected := map[uint64]bool{1: false, 2: true}
+	actual := MergeWithUint64Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint64]bool{1: false, 2: true}
+	actual = MergeWithUint64Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint64Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint64Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[uint32]int{1: 1, 2: 2}
+	map2 := map[uint32]int{2: 10}
+
+	expected := map[uint32]int{1: 1, 2: 12}
+	actual := MergeWithUint32Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int{1: 1, 2: 10}
+	actual = MergeWithUint32Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[uint32]int64{1: 1, 2: 2}
+	map2 := map[uint32]int64{2: 10}
+
+	expected := map[uint32]int64{1: 1, 2: 12}
+	actual := MergeWithUint32Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int64{1: 1, 2: 10}
+	actual = MergeWithUint32Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[uint32]int32{1: 1, 2: 2}
+	map2 := map[uint32]int32{2: 10}
+
+	expected := map[uint32]int32{1: 1, 2: 12}
+	actual := MergeWithUint32Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int32{1: 1, 2: 10}
+	actual = MergeWithUint32Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[uint32]int16{1: 1, 2: 2}
+	map2 := map[uint32]int16{2: 10}
+
+	expected := map[uint32]int16{1: 1, 2: 12}
+	actual := MergeWithUint32Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int16{1: 1, 2: 10}
+	actual = MergeWithUint32Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[uint32]int8{1: 1, 2: 2}
+	map2 := map[uint32]int8{2: 10}
+
+	expected := map[uint32]int8{1: 1, 2: 12}
+	actual := MergeWithUint32Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]int8{1: 1, 2: 10}
+	actual = MergeWithUint32Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[uint32]uint{1: 1, 2: 2}
+	map2 := map[uint32]uint{2: 10}
+
+	expected := map[uint32]uint{1: 1, 2: 12}
+	actual := MergeWithUint32Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint{1: 1, 2: 10}
+	actual = MergeWithUint32Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[uint32]uint64{1: 1, 2: 2}
+	map2 := map[uint32]uint64{2: 10}
+
+	expected := map[uint32]uint64{1: 1, 2: 12}
+	actual := MergeWithUint32Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint64{1: 1, 2: 10}
+	actual = MergeWithUint32Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[uint32]uint32{1: 1, 2: 2}
+	map2 := map[uint32]uint32{2: 10}
+
+	expected := map[uint32]uint32{1: 1, 2: 12}
+	actual := MergeWithUint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint32{1: 1, 2: 10}
+	actual = MergeWithUint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[uint32]uint16{1: 1, 2: 2}
+	map2 := map[uint32]uint16{2: 10}
+
+	expected := map[uint32]uint16{1: 1, 2: 12}
+	actual := MergeWithUint32Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint16{1: 1, 2: 10}
+	actual = MergeWithUint32Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[uint32]uint8{1: 1, 2: 2}
+	map2 := map[uint32]uint8{2: 10}
+
+	expected := map[uint32]uint8{1: 1, 2: 12}
+	actual := MergeWithUint32Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]uint8{1: 1, 2: 10}
+	actual = MergeWithUint32Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[uint32]string{1: "a", 2: "b"}
+	map2 := map[uint32]string{2: "c"}
+
+	expected := map[uint32]string{1: "a", 2: "bc"}
+	actual := MergeWithUint32Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]string{1: "a", 2: "c"}
+	actual = MergeWithUint32Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint32Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[uint32]bool{1: false, 2: false}
+	map2 := map[uint32]bool{2: true}
+
+	expected := map[uint32]bool{1: false, 2: true}
+	actual := MergeWithUint32Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint32]bool{1: false, 2: true}
+	actual = MergeWithUint32Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint32Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint32Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[uint16]int{1: 1, 2: 2}
+	map2 := map[uint16]int{2: 10}
+
+	expected := map[uint16]int{1: 1, 2: 12}
+	actual := MergeWithUint16Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int{1: 1, 2: 10}
+	actual = MergeWithUint16Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[uint16]int64{1: 1, 2: 2}
+	map2 := map[uint16]int64{2: 10}
+
+	expected := map[uint16]int64{1: 1, 2: 12}
+	actual := MergeWithUint16Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int64{1: 1, 2: 10}
+	actual = MergeWithUint16Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[uint16]int32{1: 1, 2: 2}
+	map2 := map[uint16]int32{2: 10}
+
+	expected := map[uint16]int32{1: 1, 2: 12}
+	actual := MergeWithUint16Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int32{1: 1, 2: 10}
+	actual = MergeWithUint16Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[uint16]int16{1: 1, 2: 2}
+	map2 := map[uint16]int16{2: 10}
+
+	expected := map[uint16]int16{1: 1, 2: 12}
+	actual := MergeWithUint16Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int16{1: 1, 2: 10}
+	actual = MergeWithUint16Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[uint16]int8{1: 1, 2: 2}
+	map2 := map[uint16]int8{2: 10}
+
+	expected := map[uint16]int8{1: 1, 2: 12}
+	actual := MergeWithUint16Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]int8{1: 1, 2: 10}
+	actual = MergeWithUint16Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[uint16]uint{1: 1, 2: 2}
+	map2 := map[uint16]uint{2: 10}
+
+	expected := map[uint16]uint{1: 1, 2: 12}
+	actual := MergeWithUint16Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint{1: 1, 2: 10}
+	actual = MergeWithUint16Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[uint16]uint64{1: 1, 2: 2}
+	map2 := map[uint16]uint64{2: 10}
+
+	expected := map[uint16]uint64{1: 1, 2: 12}
+	actual := MergeWithUint16Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint64{1: 1, 2: 10}
+	actual = MergeWithUint16Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[uint16]uint32{1: 1, 2: 2}
+	map2 := map[uint16]uint32{2: 10}
+
+	expected := map[uint16]uint32{1: 1, 2: 12}
+	actual := MergeWithUint16Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint32{1: 1, 2: 10}
+	actual = MergeWithUint16Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[uint16]uint16{1: 1, 2: 2}
+	map2 := map[uint16]uint16{2: 10}
+
+	expected := map[uint16]uint16{1: 1, 2: 12}
+	actual := MergeWithUint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint16{1: 1, 2: 10}
+	actual = MergeWithUint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Uint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[uint16]uint8{1: 1, 2: 2}
+	map2 := map[uint16]uint8{2: 10}
+
+	expected := map[uint16]uint8{1: 1, 2: 12}
+	actual := MergeWithUint16Uint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]uint8{1: 1, 2: 10}
+	actual = MergeWithUint16Uint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Uint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Uint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[uint16]string{1: "a", 2: "b"}
+	map2 := map[uint16]string{2: "c"}
+
+	expected := map[uint16]string{1: "a", 2: "bc"}
+	actual := MergeWithUint16Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]string{1: "a", 2: "c"}
+	actual = MergeWithUint16Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint16Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[uint16]bool{1: false, 2: false}
+	map2 := map[uint16]bool{2: true}
+
+	expected := map[uint16]bool{1: false, 2: true}
+	actual := MergeWithUint16Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint16]bool{1: false, 2: true}
+	actual = MergeWithUint16Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint16Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint16Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Int(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[uint8]int{1: 1, 2: 2}
+	map2 := map[uint8]int{2: 10}
+
+	expected := map[uint8]int{1: 1, 2: 12}
+	actual := MergeWithUint8Int(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int{1: 1, 2: 10}
+	actual = MergeWithUint8Int(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Int(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Int failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Int64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[uint8]int64{1: 1, 2: 2}
+	map2 := map[uint8]int64{2: 10}
+
+	expected := map[uint8]int64{1: 1, 2: 12}
+	actual := MergeWithUint8Int64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int64{1: 1, 2: 10}
+	actual = MergeWithUint8Int64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Int64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Int64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Int32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[uint8]int32{1: 1, 2: 2}
+	map2 := map[uint8]int32{2: 10}
+
+	expected := map[uint8]int32{1: 1, 2: 12}
+	actual := MergeWithUint8Int32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int32{1: 1, 2: 10}
+	actual = MergeWithUint8Int32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Int32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Int32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Int16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[uint8]int16{1: 1, 2: 2}
+	map2 := map[uint8]int16{2: 10}
+
+	expected := map[uint8]int16{1: 1, 2: 12}
+	actual := MergeWithUint8Int16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int16{1: 1, 2: 10}
+	actual = MergeWithUint8Int16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Int16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Int16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Int8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[uint8]int8{1: 1, 2: 2}
+	map2 := map[uint8]int8{2: 10}
+
+	expected := map[uint8]int8{1: 1, 2: 12}
+	actual := MergeWithUint8Int8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]int8{1: 1, 2: 10}
+	actual = MergeWithUint8Int8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Int8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Int8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Uint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[uint8]uint{1: 1, 2: 2}
+	map2 := map[uint8]uint{2: 10}
+
+	expected := map[uint8]uint{1: 1, 2: 12}
+	actual := MergeWithUint8Uint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint{1: 1, 2: 10}
+	actual = MergeWithUint8Uint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Uint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Uint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Uint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[uint8]uint64{1: 1, 2: 2}
+	map2 := map[uint8]uint64{2: 10}
+
+	expected := map[uint8]uint64{1: 1, 2: 12}
+	actual := MergeWithUint8Uint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint64{1: 1, 2: 10}
+	actual = MergeWithUint8Uint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Uint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Uint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Uint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[uint8]uint32{1: 1, 2: 2}
+	map2 := map[uint8]uint32{2: 10}
+
+	expected := map[uint8]uint32{1: 1, 2: 12}
+	actual := MergeWithUint8Uint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint32{1: 1, 2: 10}
+	actual = MergeWithUint8Uint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Uint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Uint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Uint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[uint8]uint16{1: 1, 2: 2}
+	map2 := map[uint8]uint16{2: 10}
+
+	expected := map[uint8]uint16{1: 1, 2: 12}
+	actual := MergeWithUint8Uint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint16{1: 1, 2: 10}
+	actual = MergeWithUint8Uint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Uint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Uint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[uint8]uint8{1: 1, 2: 2}
+	map2 := map[uint8]uint8{2: 10}
+
+	expected := map[uint8]uint8{1: 1, 2: 12}
+	actual := MergeWithUint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]uint8{1: 1, 2: 10}
+	actual = MergeWithUint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Str(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[uint8]string{1: "a", 2: "b"}
+	map2 := map[uint8]string{2: "c"}
+
+	expected := map[uint8]string{1: "a", 2: "bc"}
+	actual := MergeWithUint8Str(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]string{1: "a", 2: "c"}
+	actual = MergeWithUint8Str(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Str(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Str failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithUint8Bool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[uint8]bool{1: false, 2: false}
+	map2 := map[uint8]bool{2: true}
+
+	expected := map[uint8]bool{1: false, 2: true}
+	actual := MergeWithUint8Bool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[uint8]bool{1: false, 2: true}
+	actual = MergeWithUint8Bool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithUint8Bool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithUint8Bool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrInt(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[string]int{"a": 1, "b": 2}
+	map2 := map[string]int{"b": 10}
+
+	expected := map[string]int{"a": 1, "b": 12}
+	actual := MergeWithStrInt(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int{"a": 1, "b": 10}
+	actual = MergeWithStrInt(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrInt(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrInt failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrInt64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[string]int64{"a": 1, "b": 2}
+	map2 := map[string]int64{"b": 10}
+
+	expected := map[string]int64{"a": 1, "b": 12}
+	actual := MergeWithStrInt64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int64{"a": 1, "b": 10}
+	actual = MergeWithStrInt64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrInt64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrInt64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrInt32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[string]int32{"a": 1, "b": 2}
+	map2 := map[string]int32{"b": 10}
+
+	expected := map[string]int32{"a": 1, "b": 12}
+	actual := MergeWithStrInt32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int32{"a": 1, "b": 10}
+	actual = MergeWithStrInt32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrInt32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrInt32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrInt16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[string]int16{"a": 1, "b": 2}
+	map2 := map[string]int16{"b": 10}
+
+	expected := map[string]int16{"a": 1, "b": 12}
+	actual := MergeWithStrInt16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int16{"a": 1, "b": 10}
+	actual = MergeWithStrInt16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrInt16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrInt16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrInt8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[string]int8{"a": 1, "b": 2}
+	map2 := map[string]int8{"b": 10}
+
+	expected := map[string]int8{"a": 1, "b": 12}
+	actual := MergeWithStrInt8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]int8{"a": 1, "b": 10}
+	actual = MergeWithStrInt8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrInt8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrInt8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrUint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[string]uint{"a": 1, "b": 2}
+	map2 := map[string]uint{"b": 10}
+
+	expected := map[string]uint{"a": 1, "b": 12}
+	actual := MergeWithStrUint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint{"a": 1, "b": 10}
+	actual = MergeWithStrUint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrUint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrUint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrUint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[string]uint64{"a": 1, "b": 2}
+	map2 := map[string]uint64{"b": 10}
+
+	expected := map[string]uint64{"a": 1, "b": 12}
+	actual := MergeWithStrUint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint64{"a": 1, "b": 10}
+	actual = MergeWithStrUint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrUint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrUint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrUint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[string]uint32{"a": 1, "b": 2}
+	map2 := map[string]uint32{"b": 10}
+
+	expected := map[string]uint32{"a": 1, "b": 12}
+	actual := MergeWithStrUint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint32{"a": 1, "b": 10}
+	actual = MergeWithStrUint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrUint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrUint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrUint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[string]uint16{"a": 1, "b": 2}
+	map2 := map[string]uint16{"b": 10}
+
+	expected := map[string]uint16{"a": 1, "b": 12}
+	actual := MergeWithStrUint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint16{"a": 1, "b": 10}
+	actual = MergeWithStrUint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrUint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrUint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrUint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[string]uint8{"a": 1, "b": 2}
+	map2 := map[string]uint8{"b": 10}
+
+	expected := map[string]uint8{"a": 1, "b": 12}
+	actual := MergeWithStrUint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]uint8{"a": 1, "b": 10}
+	actual = MergeWithStrUint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrUint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrUint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStr(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[string]string{"a": "a", "b": "b"}
+	map2 := map[string]string{"b": "c"}
+
+	expected := map[string]string{"a": "a", "b": "bc"}
+	actual := MergeWithStr(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]string{"a": "a", "b": "c"}
+	actual = MergeWithStr(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStr(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStr failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithStrBool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[string]bool{"a": false, "b": false}
+	map2 := map[string]bool{"b": true}
+
+	expected := map[string]bool{"a": false, "b": true}
+	actual := MergeWithStrBool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]bool{"a": false, "b": true}
+	actual = MergeWithStrBool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithStrBool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithStrBool failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolInt(t *testing.T) {
+	f := func(v1, v2 int) int { return v1 + v2 }
+	map1 := map[bool]int{true: 1, false: 2}
+	map2 := map[bool]int{false: 10}
+
+	expected := map[bool]int{true: 1, false: 12}
+	actual := MergeWithBoolInt(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int{true: 1, false: 10}
+	actual = MergeWithBoolInt(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolInt(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolInt failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolInt64(t *testing.T) {
+	f := func(v1, v2 int64) int64 { return v1 + v2 }
+	map1 := map[bool]int64{true: 1, false: 2}
+	map2 := map[bool]int64{false: 10}
+
+	expected := map[bool]int64{true: 1, false: 12}
+	actual := MergeWithBoolInt64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int64{true: 1, false: 10}
+	actual = MergeWithBoolInt64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolInt64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolInt64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolInt32(t *testing.T) {
+	f := func(v1, v2 int32) int32 { return v1 + v2 }
+	map1 := map[bool]int32{true: 1, false: 2}
+	map2 := map[bool]int32{false: 10}
+
+	expected := map[bool]int32{true: 1, false: 12}
+	actual := MergeWithBoolInt32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int32{true: 1, false: 10}
+	actual = MergeWithBoolInt32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolInt32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolInt32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolInt16(t *testing.T) {
+	f := func(v1, v2 int16) int16 { return v1 + v2 }
+	map1 := map[bool]int16{true: 1, false: 2}
+	map2 := map[bool]int16{false: 10}
+
+	expected := map[bool]int16{true: 1, false: 12}
+	actual := MergeWithBoolInt16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int16{true: 1, false: 10}
+	actual = MergeWithBoolInt16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolInt16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolInt16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolInt8(t *testing.T) {
+	f := func(v1, v2 int8) int8 { return v1 + v2 }
+	map1 := map[bool]int8{true: 1, false: 2}
+	map2 := map[bool]int8{false: 10}
+
+	expected := map[bool]int8{true: 1, false: 12}
+	actual := MergeWithBoolInt8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]int8{true: 1, false: 10}
+	actual = MergeWithBoolInt8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolInt8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolInt8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolUint(t *testing.T) {
+	f := func(v1, v2 uint) uint { return v1 + v2 }
+	map1 := map[bool]uint{true: 1, false: 2}
+	map2 := map[bool]uint{false: 10}
+
+	expected := map[bool]uint{true: 1, false: 12}
+	actual := MergeWithBoolUint(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint{true: 1, false: 10}
+	actual = MergeWithBoolUint(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolUint(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolUint failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolUint64(t *testing.T) {
+	f := func(v1, v2 uint64) uint64 { return v1 + v2 }
+	map1 := map[bool]uint64{true: 1, false: 2}
+	map2 := map[bool]uint64{false: 10}
+
+	expected := map[bool]uint64{true: 1, false: 12}
+	actual := MergeWithBoolUint64(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint64{true: 1, false: 10}
+	actual = MergeWithBoolUint64(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolUint64(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolUint64 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolUint32(t *testing.T) {
+	f := func(v1, v2 uint32) uint32 { return v1 + v2 }
+	map1 := map[bool]uint32{true: 1, false: 2}
+	map2 := map[bool]uint32{false: 10}
+
+	expected := map[bool]uint32{true: 1, false: 12}
+	actual := MergeWithBoolUint32(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint32{true: 1, false: 10}
+	actual = MergeWithBoolUint32(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolUint32(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolUint32 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolUint16(t *testing.T) {
+	f := func(v1, v2 uint16) uint16 { return v1 + v2 }
+	map1 := map[bool]uint16{true: 1, false: 2}
+	map2 := map[bool]uint16{false: 10}
+
+	expected := map[bool]uint16{true: 1, false: 12}
+	actual := MergeWithBoolUint16(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint16{true: 1, false: 10}
+	actual = MergeWithBoolUint16(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolUint16(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolUint16 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolUint8(t *testing.T) {
+	f := func(v1, v2 uint8) uint8 { return v1 + v2 }
+	map1 := map[bool]uint8{true: 1, false: 2}
+	map2 := map[bool]uint8{false: 10}
+
+	expected := map[bool]uint8{true: 1, false: 12}
+	actual := MergeWithBoolUint8(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]uint8{true: 1, false: 10}
+	actual = MergeWithBoolUint8(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolUint8(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolUint8 failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBoolStr(t *testing.T) {
+	f := func(v1, v2 string) string { return v1 + v2 }
+	map1 := map[bool]string{true: "a", false: "b"}
+	map2 := map[bool]string{false: "c"}
+
+	expected := map[bool]string{true: "a", false: "bc"}
+	actual := MergeWithBoolStr(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]string{true: "a", false: "c"}
+	actual = MergeWithBoolStr(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBoolStr(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBoolStr failed. Expected=empty map, actual=%v", actual)
+	}
+}
+
+func TestMergeWithBool(t *testing.T) {
+	f := func(v1, v2 bool) bool { return v1 || v2 }
+	map1 := map[bool]bool{true: false, false: false}
+	map2 := map[bool]bool{false: true}
+
+	expected := map[bool]bool{true: false, false: true}
+	actual := MergeWithBool(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[bool]bool{true: false, false: true}
+	actual = MergeWithBool(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWithBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWithBool(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWithBool failed. Expected=empty map, actual=%v", actual)
+	}
+}
@@ -0,0 +1,3095 @@
+package fp
+
+// OptionInt - int which may be absent. The zero value is absent
+type OptionInt struct {
+	value int
+	ok    bool
+}
+
+// SomeOptInt returns option with the value
+func SomeOptInt(v int) OptionInt {
+	return OptionInt{value: v, ok: true}
+}
+
+// NoneOptInt returns option without value
+func NoneOptInt() OptionInt {
+	return OptionInt{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionInt) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionInt) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionInt) Get() (int, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionInt) Unwrap() int {
+	if !o.ok {
+		panic("OptionInt: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionInt) OrElse(v int) int {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionInt) OrElseGet(f func() int) int {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt) Map(f func(int) int) OptionInt {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptInt(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt) FlatMap(f func(int) OptionInt) OptionInt {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionInt) Filter(pred func(int) bool) OptionInt {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionInt{}
+	}
+	return o
+}
+
+// ResultInt - int or error. The zero value is Ok with zero value of int
+type ResultInt struct {
+	value int
+	err   error
+}
+
+// OkInt returns result with the value
+func OkInt(v int) ResultInt {
+	return ResultInt{value: v}
+}
+
+// ErrInt returns result with the error
+func ErrInt(err error) ResultInt {
+	return ResultInt{err: err}
+}
+
+// ResultOfInt returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfInt(f(v))
+func ResultOfInt(v int, err error) ResultInt {
+	if err != nil {
+		return ResultInt{err: err}
+	}
+	return ResultInt{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultInt) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultInt) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultInt) Get() (int, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultInt) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultInt) Unwrap() int {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultInt) OrElse(v int) int {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt) Map(f func(int) int) ResultInt {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkInt(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt) FlatMap(f func(int) ResultInt) ResultInt {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultInt) Option() OptionInt {
+	if r.err != nil {
+		return OptionInt{}
+	}
+	return SomeOptInt(r.value)
+}
+
+// FindOptInt returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptInt(pred func(int) bool, list []int) OptionInt {
+	if pred == nil {
+		return OptionInt{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptInt(v)
+		}
+	}
+	return OptionInt{}
+}
+
+// NthOptInt returns the item at the index. Returns None if the index is out of range
+func NthOptInt(list []int, i int) OptionInt {
+	if i < 0 || i >= len(list) {
+		return OptionInt{}
+	}
+	return SomeOptInt(list[i])
+}
+
+// ReduceOptInt reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptInt(f func(int, int) int, list []int) OptionInt {
+	if f == nil || len(list) == 0 {
+		return OptionInt{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptInt(acc)
+}
+
+// TraverseResultInt applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v int) ResultInt { return ResultOfInt(check(v)) }
+//	TraverseResultInt(parse, list)
+func TraverseResultInt(f func(int) ResultInt, list []int) ([]int, error) {
+	if f == nil {
+		return []int{}, nil
+	}
+	newList := make([]int, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionInt64 - int64 which may be absent. The zero value is absent
+type OptionInt64 struct {
+	value int64
+	ok    bool
+}
+
+// SomeOptInt64 returns option with the value
+func SomeOptInt64(v int64) OptionInt64 {
+	return OptionInt64{value: v, ok: true}
+}
+
+// NoneOptInt64 returns option without value
+func NoneOptInt64() OptionInt64 {
+	return OptionInt64{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionInt64) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionInt64) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionInt64) Get() (int64, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionInt64) Unwrap() int64 {
+	if !o.ok {
+		panic("OptionInt64: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionInt64) OrElse(v int64) int64 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionInt64) OrElseGet(f func() int64) int64 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt64) Map(f func(int64) int64) OptionInt64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptInt64(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt64) FlatMap(f func(int64) OptionInt64) OptionInt64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionInt64) Filter(pred func(int64) bool) OptionInt64 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionInt64{}
+	}
+	return o
+}
+
+// ResultInt64 - int64 or error. The zero value is Ok with zero value of int64
+type ResultInt64 struct {
+	value int64
+	err   error
+}
+
+// OkInt64 returns result with the value
+func OkInt64(v int64) ResultInt64 {
+	return ResultInt64{value: v}
+}
+
+// ErrInt64 returns result with the error
+func ErrInt64(err error) ResultInt64 {
+	return ResultInt64{err: err}
+}
+
+// ResultOfInt64 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfInt64(f(v))
+func ResultOfInt64(v int64, err error) ResultInt64 {
+	if err != nil {
+		return ResultInt64{err: err}
+	}
+	return ResultInt64{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultInt64) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultInt64) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultInt64) Get() (int64, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultInt64) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultInt64) Unwrap() int64 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultInt64) OrElse(v int64) int64 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt64) Map(f func(int64) int64) ResultInt64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkInt64(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt64) FlatMap(f func(int64) ResultInt64) ResultInt64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultInt64) Option() OptionInt64 {
+	if r.err != nil {
+		return OptionInt64{}
+	}
+	return SomeOptInt64(r.value)
+}
+
+// FindOptInt64 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptInt64(pred func(int64) bool, list []int64) OptionInt64 {
+	if pred == nil {
+		return OptionInt64{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptInt64(v)
+		}
+	}
+	return OptionInt64{}
+}
+
+// NthOptInt64 returns the item at the index. Returns None if the index is out of range
+func NthOptInt64(list []int64, i int) OptionInt64 {
+	if i < 0 || i >= len(list) {
+		return OptionInt64{}
+	}
+	return SomeOptInt64(list[i])
+}
+
+// ReduceOptInt64 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptInt64(f func(int64, int64) int64, list []int64) OptionInt64 {
+	if f == nil || len(list) == 0 {
+		return OptionInt64{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptInt64(acc)
+}
+
+// TraverseResultInt64 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v int64) ResultInt64 { return ResultOfInt64(check(v)) }
+//	TraverseResultInt64(parse, list)
+func TraverseResultInt64(f func(int64) ResultInt64, list []int64) ([]int64, error) {
+	if f == nil {
+		return []int64{}, nil
+	}
+	newList := make([]int64, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionInt32 - int32 which may be absent. The zero value is absent
+type OptionInt32 struct {
+	value int32
+	ok    bool
+}
+
+// SomeOptInt32 returns option with the value
+func SomeOptInt32(v int32) OptionInt32 {
+	return OptionInt32{value: v, ok: true}
+}
+
+// NoneOptInt32 returns option without value
+func NoneOptInt32() OptionInt32 {
+	return OptionInt32{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionInt32) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionInt32) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionInt32) Get() (int32, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionInt32) Unwrap() int32 {
+	if !o.ok {
+		panic("OptionInt32: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionInt32) OrElse(v int32) int32 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionInt32) OrElseGet(f func() int32) int32 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt32) Map(f func(int32) int32) OptionInt32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptInt32(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt32) FlatMap(f func(int32) OptionInt32) OptionInt32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionInt32) Filter(pred func(int32) bool) OptionInt32 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionInt32{}
+	}
+	return o
+}
+
+// ResultInt32 - int32 or error. The zero value is Ok with zero value of int32
+type ResultInt32 struct {
+	value int32
+	err   error
+}
+
+// OkInt32 returns result with the value
+func OkInt32(v int32) ResultInt32 {
+	return ResultInt32{value: v}
+}
+
+// ErrInt32 returns result with the error
+func ErrInt32(err error) ResultInt32 {
+	return ResultInt32{err: err}
+}
+
+// ResultOfInt32 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfInt32(f(v))
+func ResultOfInt32(v int32, err error) ResultInt32 {
+	if err != nil {
+		return ResultInt32{err: err}
+	}
+	return ResultInt32{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultInt32) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultInt32) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultInt32) Get() (int32, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultInt32) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultInt32) Unwrap() int32 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultInt32) OrElse(v int32) int32 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt32) Map(f func(int32) int32) ResultInt32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkInt32(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt32) FlatMap(f func(int32) ResultInt32) ResultInt32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultInt32) Option() OptionInt32 {
+	if r.err != nil {
+		return OptionInt32{}
+	}
+	return SomeOptInt32(r.value)
+}
+
+// FindOptInt32 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptInt32(pred func(int32) bool, list []int32) OptionInt32 {
+	if pred == nil {
+		return OptionInt32{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptInt32(v)
+		}
+	}
+	return OptionInt32{}
+}
+
+// NthOptInt32 returns the item at the index. Returns None if the index is out of range
+func NthOptInt32(list []int32, i int) OptionInt32 {
+	if i < 0 || i >= len(list) {
+		return OptionInt32{}
+	}
+	return SomeOptInt32(list[i])
+}
+
+// ReduceOptInt32 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptInt32(f func(int32, int32) int32, list []int32) OptionInt32 {
+	if f == nil || len(list) == 0 {
+		return OptionInt32{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptInt32(acc)
+}
+
+// TraverseResultInt32 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v int32) ResultInt32 { return ResultOfInt32(check(v)) }
+//	TraverseResultInt32(parse, list)
+func TraverseResultInt32(f func(int32) ResultInt32, list []int32) ([]int32, error) {
+	if f == nil {
+		return []int32{}, nil
+	}
+	newList := make([]int32, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionInt16 - int16 which may be absent. The zero value is absent
+type OptionInt16 struct {
+	value int16
+	ok    bool
+}
+
+// SomeOptInt16 returns option with the value
+func SomeOptInt16(v int16) OptionInt16 {
+	return OptionInt16{value: v, ok: true}
+}
+
+// NoneOptInt16 returns option without value
+func NoneOptInt16() OptionInt16 {
+	return OptionInt16{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionInt16) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionInt16) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionInt16) Get() (int16, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionInt16) Unwrap() int16 {
+	if !o.ok {
+		panic("OptionInt16: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionInt16) OrElse(v int16) int16 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionInt16) OrElseGet(f func() int16) int16 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt16) Map(f func(int16) int16) OptionInt16 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptInt16(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt16) FlatMap(f func(int16) OptionInt16) OptionInt16 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionInt16) Filter(pred func(int16) bool) OptionInt16 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionInt16{}
+	}
+	return o
+}
+
+// ResultInt16 - int16 or error. The zero value is Ok with zero value of int16
+type ResultInt16 struct {
+	value int16
+	err   error
+}
+
+// OkInt16 returns result with the value
+func OkInt16(v int16) ResultInt16 {
+	return ResultInt16{value: v}
+}
+
+// ErrInt16 returns result with the error
+func ErrInt16(err error) ResultInt16 {
+	return ResultInt16{err: err}
+}
+
+// ResultOfInt16 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfInt16(f(v))
+func ResultOfInt16(v int16, err error) ResultInt16 {
+	if err != nil {
+		return ResultInt16{err: err}
+	}
+	return ResultInt16{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultInt16) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultInt16) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultInt16) Get() (int16, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultInt16) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultInt16) Unwrap() int16 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultInt16) OrElse(v int16) int16 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt16) Map(f func(int16) int16) ResultInt16 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkInt16(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt16) FlatMap(f func(int16) ResultInt16) ResultInt16 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultInt16) Option() OptionInt16 {
+	if r.err != nil {
+		return OptionInt16{}
+	}
+	return SomeOptInt16(r.value)
+}
+
+// FindOptInt16 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptInt16(pred func(int16) bool, list []int16) OptionInt16 {
+	if pred == nil {
+		return OptionInt16{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptInt16(v)
+		}
+	}
+	return OptionInt16{}
+}
+
+// NthOptInt16 returns the item at the index. Returns None if the index is out of range
+func NthOptInt16(list []int16, i int) OptionInt16 {
+	if i < 0 || i >= len(list) {
+		return OptionInt16{}
+	}
+	return SomeOptInt16(list[i])
+}
+
+// ReduceOptInt16 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptInt16(f func(int16, int16) int16, list []int16) OptionInt16 {
+	if f == nil || len(list) == 0 {
+		return OptionInt16{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptInt16(acc)
+}
+
+// TraverseResultInt16 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v int16) ResultInt16 { return ResultOfInt16(check(v)) }
+//	TraverseResultInt16(parse, list)
+func TraverseResultInt16(f func(int16) ResultInt16, list []int16) ([]int16, error) {
+	if f == nil {
+		return []int16{}, nil
+	}
+	newList := make([]int16, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionInt8 - int8 which may be absent. The zero value is absent
+type OptionInt8 struct {
+	value int8
+	ok    bool
+}
+
+// SomeOptInt8 returns option with the value
+func SomeOptInt8(v int8) OptionInt8 {
+	return OptionInt8{value: v, ok: true}
+}
+
+// NoneOptInt8 returns option without value
+func NoneOptInt8() OptionInt8 {
+	return OptionInt8{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionInt8) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionInt8) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionInt8) Get() (int8, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionInt8) Unwrap() int8 {
+	if !o.ok {
+		panic("OptionInt8: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionInt8) OrElse(v int8) int8 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionInt8) OrElseGet(f func() int8) int8 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt8) Map(f func(int8) int8) OptionInt8 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptInt8(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionInt8) FlatMap(f func(int8) OptionInt8) OptionInt8 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionInt8) Filter(pred func(int8) bool) OptionInt8 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionInt8{}
+	}
+	return o
+}
+
+// ResultInt8 - int8 or error. The zero value is Ok with zero value of int8
+type ResultInt8 struct {
+	value int8
+	err   error
+}
+
+// OkInt8 returns result with the value
+func OkInt8(v int8) ResultInt8 {
+	return ResultInt8{value: v}
+}
+
+// ErrInt8 returns result with the error
+func ErrInt8(err error) ResultInt8 {
+	return ResultInt8{err: err}
+}
+
+// ResultOfInt8 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfInt8(f(v))
+func ResultOfInt8(v int8, err error) ResultInt8 {
+	if err != nil {
+		return ResultInt8{err: err}
+	}
+	return ResultInt8{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultInt8) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultInt8) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultInt8) Get() (int8, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultInt8) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultInt8) Unwrap() int8 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultInt8) OrElse(v int8) int8 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt8) Map(f func(int8) int8) ResultInt8 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkInt8(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultInt8) FlatMap(f func(int8) ResultInt8) ResultInt8 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultInt8) Option() OptionInt8 {
+	if r.err != nil {
+		return OptionInt8{}
+	}
+	return SomeOptInt8(r.value)
+}
+
+// FindOptInt8 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptInt8(pred func(int8) bool, list []int8) OptionInt8 {
+	if pred == nil {
+		return OptionInt8{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptInt8(v)
+		}
+	}
+	return OptionInt8{}
+}
+
+// NthOptInt8 returns the item at the index. Returns None if the index is out of range
+func NthOptInt8(list []int8, i int) OptionInt8 {
+	if i < 0 || i >= len(list) {
+		return OptionInt8{}
+	}
+	return SomeOptInt8(list[i])
+}
+
+// ReduceOptInt8 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptInt8(f func(int8, int8) int8, list []int8) OptionInt8 {
+	if f == nil || len(list) == 0 {
+		return OptionInt8{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptInt8(acc)
+}
+
+// TraverseResultInt8 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v int8) ResultInt8 { return ResultOfInt8(check(v)) }
+//	TraverseResultInt8(parse, list)
+func TraverseResultInt8(f func(int8) ResultInt8, list []int8) ([]int8, error) {
+	if f == nil {
+		return []int8{}, nil
+	}
+	newList := make([]int8, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionUint - uint which may be absent. The zero value is absent
+type OptionUint struct {
+	value uint
+	ok    bool
+}
+
+// SomeOptUint returns option with the value
+func SomeOptUint(v uint) OptionUint {
+	return OptionUint{value: v, ok: true}
+}
+
+// NoneOptUint returns option without value
+func NoneOptUint() OptionUint {
+	return OptionUint{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionUint) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionUint) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionUint) Get() (uint, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionUint) Unwrap() uint {
+	if !o.ok {
+		panic("OptionUint: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionUint) OrElse(v uint) uint {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionUint) OrElseGet(f func() uint) uint {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint) Map(f func(uint) uint) OptionUint {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptUint(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint) FlatMap(f func(uint) OptionUint) OptionUint {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionUint) Filter(pred func(uint) bool) OptionUint {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionUint{}
+	}
+	return o
+}
+
+// ResultUint - uint or error. The zero value is Ok with zero value of uint
+type ResultUint struct {
+	value uint
+	err   error
+}
+
+// OkUint returns result with the value
+func OkUint(v uint) ResultUint {
+	return ResultUint{value: v}
+}
+
+// ErrUint returns result with the error
+func ErrUint(err error) ResultUint {
+	return ResultUint{err: err}
+}
+
+// ResultOfUint returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfUint(f(v))
+func ResultOfUint(v uint, err error) ResultUint {
+	if err != nil {
+		return ResultUint{err: err}
+	}
+	return ResultUint{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultUint) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultUint) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultUint) Get() (uint, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultUint) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultUint) Unwrap() uint {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultUint) OrElse(v uint) uint {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint) Map(f func(uint) uint) ResultUint {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkUint(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint) FlatMap(f func(uint) ResultUint) ResultUint {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultUint) Option() OptionUint {
+	if r.err != nil {
+		return OptionUint{}
+	}
+	return SomeOptUint(r.value)
+}
+
+// FindOptUint returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptUint(pred func(uint) bool, list []uint) OptionUint {
+	if pred == nil {
+		return OptionUint{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptUint(v)
+		}
+	}
+	return OptionUint{}
+}
+
+// NthOptUint returns the item at the index. Returns None if the index is out of range
+func NthOptUint(list []uint, i int) OptionUint {
+	if i < 0 || i >= len(list) {
+		return OptionUint{}
+	}
+	return SomeOptUint(list[i])
+}
+
+// ReduceOptUint reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptUint(f func(uint, uint) uint, list []uint) OptionUint {
+	if f == nil || len(list) == 0 {
+		return OptionUint{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptUint(acc)
+}
+
+// TraverseResultUint applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v uint) ResultUint { return ResultOfUint(check(v)) }
+//	TraverseResultUint(parse, list)
+func TraverseResultUint(f func(uint) ResultUint, list []uint) ([]uint, error) {
+	if f == nil {
+		return []uint{}, nil
+	}
+	newList := make([]uint, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionUint64 - uint64 which may be absent. The zero value is absent
+type OptionUint64 struct {
+	value uint64
+	ok    bool
+}
+
+// SomeOptUint64 returns option with the value
+func SomeOptUint64(v uint64) OptionUint64 {
+	return OptionUint64{value: v, ok: true}
+}
+
+// NoneOptUint64 returns option without value
+func NoneOptUint64() OptionUint64 {
+	return OptionUint64{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionUint64) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionUint64) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionUint64) Get() (uint64, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionUint64) Unwrap() uint64 {
+	if !o.ok {
+		panic("OptionUint64: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionUint64) OrElse(v uint64) uint64 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionUint64) OrElseGet(f func() uint64) uint64 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint64) Map(f func(uint64) uint64) OptionUint64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptUint64(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint64) FlatMap(f func(uint64) OptionUint64) OptionUint64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionUint64) Filter(pred func(uint64) bool) OptionUint64 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionUint64{}
+	}
+	return o
+}
+
+// ResultUint64 - uint64 or error. The zero value is Ok with zero value of uint64
+type ResultUint64 struct {
+	value uint64
+	err   error
+}
+
+// OkUint64 returns result with the value
+func OkUint64(v uint64) ResultUint64 {
+	return ResultUint64{value: v}
+}
+
+// ErrUint64 returns result with the error
+func ErrUint64(err error) ResultUint64 {
+	return ResultUint64{err: err}
+}
+
+// ResultOfUint64 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfUint64(f(v))
+func ResultOfUint64(v uint64, err error) ResultUint64 {
+	if err != nil {
+		return ResultUint64{err: err}
+	}
+	return ResultUint64{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultUint64) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultUint64) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultUint64) Get() (uint64, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultUint64) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultUint64) Unwrap() uint64 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultUint64) OrElse(v uint64) uint64 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint64) Map(f func(uint64) uint64) ResultUint64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkUint64(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint64) FlatMap(f func(uint64) ResultUint64) ResultUint64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultUint64) Option() OptionUint64 {
+	if r.err != nil {
+		return OptionUint64{}
+	}
+	return SomeOptUint64(r.value)
+}
+
+// FindOptUint64 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptUint64(pred func(uint64) bool, list []uint64) OptionUint64 {
+	if pred == nil {
+		return OptionUint64{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptUint64(v)
+		}
+	}
+	return OptionUint64{}
+}
+
+// NthOptUint64 returns the item at the index. Returns None if the index is out of range
+func NthOptUint64(list []uint64, i int) OptionUint64 {
+	if i < 0 || i >= len(list) {
+		return OptionUint64{}
+	}
+	return SomeOptUint64(list[i])
+}
+
+// ReduceOptUint64 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptUint64(f func(uint64, uint64) uint64, list []uint64) OptionUint64 {
+	if f == nil || len(list) == 0 {
+		return OptionUint64{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptUint64(acc)
+}
+
+// TraverseResultUint64 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v uint64) ResultUint64 { return ResultOfUint64(check(v)) }
+//	TraverseResultUint64(parse, list)
+func TraverseResultUint64(f func(uint64) ResultUint64, list []uint64) ([]uint64, error) {
+	if f == nil {
+		return []uint64{}, nil
+	}
+	newList := make([]uint64, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionUint32 - uint32 which may be absent. The zero value is absent
+type OptionUint32 struct {
+	value uint32
+	ok    bool
+}
+
+// SomeOptUint32 returns option with the value
+func SomeOptUint32(v uint32) OptionUint32 {
+	return OptionUint32{value: v, ok: true}
+}
+
+// NoneOptUint32 returns option without value
+func NoneOptUint32() OptionUint32 {
+	return OptionUint32{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionUint32) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionUint32) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionUint32) Get() (uint32, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionUint32) Unwrap() uint32 {
+	if !o.ok {
+		panic("OptionUint32: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionUint32) OrElse(v uint32) uint32 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionUint32) OrElseGet(f func() uint32) uint32 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint32) Map(f func(uint32) uint32) OptionUint32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptUint32(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint32) FlatMap(f func(uint32) OptionUint32) OptionUint32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionUint32) Filter(pred func(uint32) bool) OptionUint32 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionUint32{}
+	}
+	return o
+}
+
+// ResultUint32 - uint32 or error. The zero value is Ok with zero value of uint32
+type ResultUint32 struct {
+	value uint32
+	err   error
+}
+
+// OkUint32 returns result with the value
+func OkUint32(v uint32) ResultUint32 {
+	return ResultUint32{value: v}
+}
+
+// ErrUint32 returns result with the error
+func ErrUint32(err error) ResultUint32 {
+	return ResultUint32{err: err}
+}
+
+// ResultOfUint32 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfUint32(f(v))
+func ResultOfUint32(v uint32, err error) ResultUint32 {
+	if err != nil {
+		return ResultUint32{err: err}
+	}
+	return ResultUint32{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultUint32) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultUint32) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultUint32) Get() (uint32, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultUint32) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultUint32) Unwrap() uint32 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultUint32) OrElse(v uint32) uint32 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint32) Map(f func(uint32) uint32) ResultUint32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkUint32(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint32) FlatMap(f func(uint32) ResultUint32) ResultUint32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultUint32) Option() OptionUint32 {
+	if r.err != nil {
+		return OptionUint32{}
+	}
+	return SomeOptUint32(r.value)
+}
+
+// FindOptUint32 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptUint32(pred func(uint32) bool, list []uint32) OptionUint32 {
+	if pred == nil {
+		return OptionUint32{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptUint32(v)
+		}
+	}
+	return OptionUint32{}
+}
+
+// NthOptUint32 returns the item at the index. Returns None if the index is out of range
+func NthOptUint32(list []uint32, i int) OptionUint32 {
+	if i < 0 || i >= len(list) {
+		return OptionUint32{}
+	}
+	return SomeOptUint32(list[i])
+}
+
+// ReduceOptUint32 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptUint32(f func(uint32, uint32) uint32, list []uint32) OptionUint32 {
+	if f == nil || len(list) == 0 {
+		return OptionUint32{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptUint32(acc)
+}
+
+// TraverseResultUint32 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v uint32) ResultUint32 { return ResultOfUint32(check(v)) }
+//	TraverseResultUint32(parse, list)
+func TraverseResultUint32(f func(uint32) ResultUint32, list []uint32) ([]uint32, error) {
+	if f == nil {
+		return []uint32{}, nil
+	}
+	newList := make([]uint32, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionUint16 - uint16 which may be absent. The zero value is absent
+type OptionUint16 struct {
+	value uint16
+	ok    bool
+}
+
+// SomeOptUint16 returns option with the value
+func SomeOptUint16(v uint16) OptionUint16 {
+	return OptionUint16{value: v, ok: true}
+}
+
+// NoneOptUint16 returns option without value
+func NoneOptUint16() OptionUint16 {
+	return OptionUint16{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionUint16) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionUint16) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionUint16) Get() (uint16, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionUint16) Unwrap() uint16 {
+	if !o.ok {
+		panic("OptionUint16: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionUint16) OrElse(v uint16) uint16 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionUint16) OrElseGet(f func() uint16) uint16 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint16) Map(f func(uint16) uint16) OptionUint16 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptUint16(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint16) FlatMap(f func(uint16) OptionUint16) OptionUint16 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionUint16) Filter(pred func(uint16) bool) OptionUint16 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionUint16{}
+	}
+	return o
+}
+
+// ResultUint16 - uint16 or error. The zero value is Ok with zero value of uint16
+type ResultUint16 struct {
+	value uint16
+	err   error
+}
+
+// OkUint16 returns result with the value
+func OkUint16(v uint16) ResultUint16 {
+	return ResultUint16{value: v}
+}
+
+// ErrUint16 returns result with the error
+func ErrUint16(err error) ResultUint16 {
+	return ResultUint16{err: err}
+}
+
+// ResultOfUint16 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfUint16(f(v))
+func ResultOfUint16(v uint16, err error) ResultUint16 {
+	if err != nil {
+		return ResultUint16{err: err}
+	}
+	return ResultUint16{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultUint16) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultUint16) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultUint16) Get() (uint16, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultUint16) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultUint16) Unwrap() uint16 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultUint16) OrElse(v uint16) uint16 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint16) Map(f func(uint16) uint16) ResultUint16 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkUint16(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint16) FlatMap(f func(uint16) ResultUint16) ResultUint16 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultUint16) Option() OptionUint16 {
+	if r.err != nil {
+		return OptionUint16{}
+	}
+	return SomeOptUint16(r.value)
+}
+
+// FindOptUint16 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptUint16(pred func(uint16) bool, list []uint16) OptionUint16 {
+	if pred == nil {
+		return OptionUint16{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptUint16(v)
+		}
+	}
+	return OptionUint16{}
+}
+
+// NthOptUint16 returns the item at the index. Returns None if the index is out of range
+func NthOptUint16(list []uint16, i int) OptionUint16 {
+	if i < 0 || i >= len(list) {
+		return OptionUint16{}
+	}
+	return SomeOptUint16(list[i])
+}
+
+// ReduceOptUint16 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptUint16(f func(uint16, uint16) uint16, list []uint16) OptionUint16 {
+	if f == nil || len(list) == 0 {
+		return OptionUint16{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptUint16(acc)
+}
+
+// TraverseResultUint16 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v uint16) ResultUint16 { return ResultOfUint16(check(v)) }
+//	TraverseResultUint16(parse, list)
+func TraverseResultUint16(f func(uint16) ResultUint16, list []uint16) ([]uint16, error) {
+	if f == nil {
+		return []uint16{}, nil
+	}
+	newList := make([]uint16, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionUint8 - uint8 which may be absent. The zero value is absent
+type OptionUint8 struct {
+	value uint8
+	ok    bool
+}
+
+// SomeOptUint8 returns option with the value
+func SomeOptUint8(v uint8) OptionUint8 {
+	return OptionUint8{value: v, ok: true}
+}
+
+// NoneOptUint8 returns option without value
+func NoneOptUint8() OptionUint8 {
+	return OptionUint8{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionUint8) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionUint8) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionUint8) Get() (uint8, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionUint8) Unwrap() uint8 {
+	if !o.ok {
+		panic("OptionUint8: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionUint8) OrElse(v uint8) uint8 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionUint8) OrElseGet(f func() uint8) uint8 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint8) Map(f func(uint8) uint8) OptionUint8 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptUint8(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionUint8) FlatMap(f func(uint8) OptionUint8) OptionUint8 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionUint8) Filter(pred func(uint8) bool) OptionUint8 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionUint8{}
+	}
+	return o
+}
+
+// ResultUint8 - uint8 or error. The zero value is Ok with zero value of uint8
+type ResultUint8 struct {
+	value uint8
+	err   error
+}
+
+// OkUint8 returns result with the value
+func OkUint8(v uint8) ResultUint8 {
+	return ResultUint8{value: v}
+}
+
+// ErrUint8 returns result with the error
+func ErrUint8(err error) ResultUint8 {
+	return ResultUint8{err: err}
+}
+
+// ResultOfUint8 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfUint8(f(v))
+func ResultOfUint8(v uint8, err error) ResultUint8 {
+	if err != nil {
+		return ResultUint8{err: err}
+	}
+	return ResultUint8{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultUint8) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultUint8) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultUint8) Get() (uint8, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultUint8) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultUint8) Unwrap() uint8 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultUint8) OrElse(v uint8) uint8 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint8) Map(f func(uint8) uint8) ResultUint8 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkUint8(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultUint8) FlatMap(f func(uint8) ResultUint8) ResultUint8 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultUint8) Option() OptionUint8 {
+	if r.err != nil {
+		return OptionUint8{}
+	}
+	return SomeOptUint8(r.value)
+}
+
+// FindOptUint8 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptUint8(pred func(uint8) bool, list []uint8) OptionUint8 {
+	if pred == nil {
+		return OptionUint8{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptUint8(v)
+		}
+	}
+	return OptionUint8{}
+}
+
+// NthOptUint8 returns the item at the index. Returns None if the index is out of range
+func NthOptUint8(list []uint8, i int) OptionUint8 {
+	if i < 0 || i >= len(list) {
+		return OptionUint8{}
+	}
+	return SomeOptUint8(list[i])
+}
+
+// ReduceOptUint8 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptUint8(f func(uint8, uint8) uint8, list []uint8) OptionUint8 {
+	if f == nil || len(list) == 0 {
+		return OptionUint8{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptUint8(acc)
+}
+
+// TraverseResultUint8 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v uint8) ResultUint8 { return ResultOfUint8(check(v)) }
+//	TraverseResultUint8(parse, list)
+func TraverseResultUint8(f func(uint8) ResultUint8, list []uint8) ([]uint8, error) {
+	if f == nil {
+		return []uint8{}, nil
+	}
+	newList := make([]uint8, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionFloat64 - float64 which may be absent. The zero value is absent
+type OptionFloat64 struct {
+	value float64
+	ok    bool
+}
+
+// SomeOptFloat64 returns option with the value
+func SomeOptFloat64(v float64) OptionFloat64 {
+	return OptionFloat64{value: v, ok: true}
+}
+
+// NoneOptFloat64 returns option without value
+func NoneOptFloat64() OptionFloat64 {
+	return OptionFloat64{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionFloat64) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionFloat64) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionFloat64) Get() (float64, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionFloat64) Unwrap() float64 {
+	if !o.ok {
+		panic("OptionFloat64: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionFloat64) OrElse(v float64) float64 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionFloat64) OrElseGet(f func() float64) float64 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionFloat64) Map(f func(float64) float64) OptionFloat64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptFloat64(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionFloat64) FlatMap(f func(float64) OptionFloat64) OptionFloat64 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionFloat64) Filter(pred func(float64) bool) OptionFloat64 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionFloat64{}
+	}
+	return o
+}
+
+// ResultFloat64 - float64 or error. The zero value is Ok with zero value of float64
+type ResultFloat64 struct {
+	value float64
+	err   error
+}
+
+// OkFloat64 returns result with the value
+func OkFloat64(v float64) ResultFloat64 {
+	return ResultFloat64{value: v}
+}
+
+// ErrFloat64 returns result with the error
+func ErrFloat64(err error) ResultFloat64 {
+	return ResultFloat64{err: err}
+}
+
+// ResultOfFloat64 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfFloat64(f(v))
+func ResultOfFloat64(v float64, err error) ResultFloat64 {
+	if err != nil {
+		return ResultFloat64{err: err}
+	}
+	return ResultFloat64{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultFloat64) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultFloat64) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultFloat64) Get() (float64, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultFloat64) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultFloat64) Unwrap() float64 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultFloat64) OrElse(v float64) float64 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultFloat64) Map(f func(float64) float64) ResultFloat64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkFloat64(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultFloat64) FlatMap(f func(float64) ResultFloat64) ResultFloat64 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultFloat64) Option() OptionFloat64 {
+	if r.err != nil {
+		return OptionFloat64{}
+	}
+	return SomeOptFloat64(r.value)
+}
+
+// FindOptFloat64 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptFloat64(pred func(float64) bool, list []float64) OptionFloat64 {
+	if pred == nil {
+		return OptionFloat64{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptFloat64(v)
+		}
+	}
+	return OptionFloat64{}
+}
+
+// NthOptFloat64 returns the item at the index. Returns None if the index is out of range
+func NthOptFloat64(list []float64, i int) OptionFloat64 {
+	if i < 0 || i >= len(list) {
+		return OptionFloat64{}
+	}
+	return SomeOptFloat64(list[i])
+}
+
+// ReduceOptFloat64 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptFloat64(f func(float64, float64) float64, list []float64) OptionFloat64 {
+	if f == nil || len(list) == 0 {
+		return OptionFloat64{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptFloat64(acc)
+}
+
+// TraverseResultFloat64 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v float64) ResultFloat64 { return ResultOfFloat64(check(v)) }
+//	TraverseResultFloat64(parse, list)
+func TraverseResultFloat64(f func(float64) ResultFloat64, list []float64) ([]float64, error) {
+	if f == nil {
+		return []float64{}, nil
+	}
+	newList := make([]float64, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionFloat32 - float32 which may be absent. The zero value is absent
+type OptionFloat32 struct {
+	value float32
+	ok    bool
+}
+
+// SomeOptFloat32 returns option with the value
+func SomeOptFloat32(v float32) OptionFloat32 {
+	return OptionFloat32{value: v, ok: true}
+}
+
+// NoneOptFloat32 returns option without value
+func NoneOptFloat32() OptionFloat32 {
+	return OptionFloat32{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionFloat32) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionFloat32) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionFloat32) Get() (float32, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionFloat32) Unwrap() float32 {
+	if !o.ok {
+		panic("OptionFloat32: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionFloat32) OrElse(v float32) float32 {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionFloat32) OrElseGet(f func() float32) float32 {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionFloat32) Map(f func(float32) float32) OptionFloat32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptFloat32(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionFloat32) FlatMap(f func(float32) OptionFloat32) OptionFloat32 {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionFloat32) Filter(pred func(float32) bool) OptionFloat32 {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionFloat32{}
+	}
+	return o
+}
+
+// ResultFloat32 - float32 or error. The zero value is Ok with zero value of float32
+type ResultFloat32 struct {
+	value float32
+	err   error
+}
+
+// OkFloat32 returns result with the value
+func OkFloat32(v float32) ResultFloat32 {
+	return ResultFloat32{value: v}
+}
+
+// ErrFloat32 returns result with the error
+func ErrFloat32(err error) ResultFloat32 {
+	return ResultFloat32{err: err}
+}
+
+// ResultOfFloat32 returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfFloat32(f(v))
+func ResultOfFloat32(v float32, err error) ResultFloat32 {
+	if err != nil {
+		return ResultFloat32{err: err}
+	}
+	return ResultFloat32{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultFloat32) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultFloat32) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultFloat32) Get() (float32, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultFloat32) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultFloat32) Unwrap() float32 {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultFloat32) OrElse(v float32) float32 {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultFloat32) Map(f func(float32) float32) ResultFloat32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkFloat32(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultFloat32) FlatMap(f func(float32) ResultFloat32) ResultFloat32 {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultFloat32) Option() OptionFloat32 {
+	if r.err != nil {
+		return OptionFloat32{}
+	}
+	return SomeOptFloat32(r.value)
+}
+
+// FindOptFloat32 returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptFloat32(pred func(float32) bool, list []float32) OptionFloat32 {
+	if pred == nil {
+		return OptionFloat32{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptFloat32(v)
+		}
+	}
+	return OptionFloat32{}
+}
+
+// NthOptFloat32 returns the item at the index. Returns None if the index is out of range
+func NthOptFloat32(list []float32, i int) OptionFloat32 {
+	if i < 0 || i >= len(list) {
+		return OptionFloat32{}
+	}
+	return SomeOptFloat32(list[i])
+}
+
+// ReduceOptFloat32 reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptFloat32(f func(float32, float32) float32, list []float32) OptionFloat32 {
+	if f == nil || len(list) == 0 {
+		return OptionFloat32{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptFloat32(acc)
+}
+
+// TraverseResultFloat32 applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v float32) ResultFloat32 { return ResultOfFloat32(check(v)) }
+//	TraverseResultFloat32(parse, list)
+func TraverseResultFloat32(f func(float32) ResultFloat32, list []float32) ([]float32, error) {
+	if f == nil {
+		return []float32{}, nil
+	}
+	newList := make([]float32, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionStr - string which may be absent. The zero value is absent
+type OptionStr struct {
+	value string
+	ok    bool
+}
+
+// SomeOptStr returns option with the value
+func SomeOptStr(v string) OptionStr {
+	return OptionStr{value: v, ok: true}
+}
+
+// NoneOptStr returns option without value
+func NoneOptStr() OptionStr {
+	return OptionStr{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionStr) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionStr) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionStr) Get() (string, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionStr) Unwrap() string {
+	if !o.ok {
+		panic("OptionStr: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionStr) OrElse(v string) string {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionStr) OrElseGet(f func() string) string {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionStr) Map(f func(string) string) OptionStr {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptStr(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionStr) FlatMap(f func(string) OptionStr) OptionStr {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionStr) Filter(pred func(string) bool) OptionStr {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionStr{}
+	}
+	return o
+}
+
+// ResultStr - string or error. The zero value is Ok with zero value of string
+type ResultStr struct {
+	value string
+	err   error
+}
+
+// OkStr returns result with the value
+func OkStr(v string) ResultStr {
+	return ResultStr{value: v}
+}
+
+// ErrStr returns result with the error
+func ErrStr(err error) ResultStr {
+	return ResultStr{err: err}
+}
+
+// ResultOfStr returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfStr(f(v))
+func ResultOfStr(v string, err error) ResultStr {
+	if err != nil {
+		return ResultStr{err: err}
+	}
+	return ResultStr{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultStr) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultStr) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultStr) Get() (string, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultStr) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultStr) Unwrap() string {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultStr) OrElse(v string) string {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultStr) Map(f func(string) string) ResultStr {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkStr(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultStr) FlatMap(f func(string) ResultStr) ResultStr {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultStr) Option() OptionStr {
+	if r.err != nil {
+		return OptionStr{}
+	}
+	return SomeOptStr(r.value)
+}
+
+// FindOptStr returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptStr(pred func(string) bool, list []string) OptionStr {
+	if pred == nil {
+		return OptionStr{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptStr(v)
+		}
+	}
+	return OptionStr{}
+}
+
+// NthOptStr returns the item at the index. Returns None if the index is out of range
+func NthOptStr(list []string, i int) OptionStr {
+	if i < 0 || i >= len(list) {
+		return OptionStr{}
+	}
+	return SomeOptStr(list[i])
+}
+
+// ReduceOptStr reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptStr(f func(string, string) string, list []string) OptionStr {
+	if f == nil || len(list) == 0 {
+		return OptionStr{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptStr(acc)
+}
+
+// TraverseResultStr applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v string) ResultStr { return ResultOfStr(check(v)) }
+//	TraverseResultStr(parse, list)
+func TraverseResultStr(f func(string) ResultStr, list []string) ([]string, error) {
+	if f == nil {
+		return []string{}, nil
+	}
+	newList := make([]string, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// OptionBool - bool which may be absent. The zero value is absent
+type OptionBool struct {
+	value bool
+	ok    bool
+}
+
+// SomeOptBool returns option with the value
+func SomeOptBool(v bool) OptionBool {
+	return OptionBool{value: v, ok: true}
+}
+
+// NoneOptBool returns option without value
+func NoneOptBool() OptionBool {
+	return OptionBool{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionBool) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionBool) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionBool) Get() (bool, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionBool) Unwrap() bool {
+	if !o.ok {
+		panic("OptionBool: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionBool) OrElse(v bool) bool {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionBool) OrElseGet(f func() bool) bool {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionBool) Map(f func(bool) bool) OptionBool {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptBool(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionBool) FlatMap(f func(bool) OptionBool) OptionBool {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionBool) Filter(pred func(bool) bool) OptionBool {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionBool{}
+	}
+	return o
+}
+
+// ResultBool - bool or error. The zero value is Ok with zero value of bool
+type ResultBool struct {
+	value bool
+	err   error
+}
+
+// OkBool returns result with the value
+func OkBool(v bool) ResultBool {
+	return ResultBool{value: v}
+}
+
+// ErrBool returns result with the error
+func ErrBool(err error) ResultBool {
+	return ResultBool{err: err}
+}
+
+// ResultOfBool returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfBool(f(v))
+func ResultOfBool(v bool, err error) ResultBool {
+	if err != nil {
+		return ResultBool{err: err}
+	}
+	return ResultBool{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultBool) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultBool) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultBool) Get() (bool, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultBool) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultBool) Unwrap() bool {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultBool) OrElse(v bool) bool {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultBool) Map(f func(bool) bool) ResultBool {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkBool(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultBool) FlatMap(f func(bool) ResultBool) ResultBool {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultBool) Option() OptionBool {
+	if r.err != nil {
+		return OptionBool{}
+	}
+	return SomeOptBool(r.value)
+}
+
+// FindOptBool returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptBool(pred func(bool) bool, list []bool) OptionBool {
+	if pred == nil {
+		return OptionBool{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptBool(v)
+		}
+	}
+	return OptionBool{}
+}
+
+// NthOptBool returns the item at the index. Returns None if the index is out of range
+func NthOptBool(list []bool, i int) OptionBool {
+	if i < 0 || i >= len(list) {
+		return OptionBool{}
+	}
+	return SomeOptBool(list[i])
+}
+
+// ReduceOptBool reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptBool(f func(bool, bool) bool, list []bool) OptionBool {
+	if f == nil || len(list) == 0 {
+		return OptionBool{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptBool(acc)
+}
+
+// TraverseResultBool applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v bool) ResultBool { return ResultOfBool(check(v)) }
+//	TraverseResultBool(parse, list)
+func TraverseResultBool(f func(bool) ResultBool, list []bool) ([]bool, error) {
+	if f == nil {
+		return []bool{}, nil
+	}
+	newList := make([]bool, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
@@ -0,0 +1,1300 @@
+package fp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOptionInt(t *testing.T) {
+	inc := func(v int) int { return v + 1 }
+	some := SomeOptInt(1)
+	none := NoneOptInt()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionInt failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionInt failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() int { return 3 }); v != 3 {
+		t.Errorf("TestOptionInt failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v int) OptionInt {
+		if v > 1 {
+			return SomeOptInt(v)
+		}
+		return NoneOptInt()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionInt failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v int) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionInt failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkInt(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionInt failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionInt failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultInt(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v int) int { return v + 1 }
+
+	if v, err := OkInt(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultInt failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrInt(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultInt failed. Expected error to be kept")
+	}
+	if r := OkInt(1).FlatMap(func(int) ResultInt { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultInt failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfInt(1, nil).IsOk() || ResultOfInt(1, errBoom).IsOk() {
+		t.Errorf("TestResultInt failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultInt failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptInt(t *testing.T) {
+	list := []int{1, 2, 3}
+	if v, ok := FindOptInt(func(v int) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptInt failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptInt(func(v int) bool { return v > 3 }, list).IsSome() || FindOptInt(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt failed. Expected None")
+	}
+	if NthOptInt(list, 2).Unwrap() != 3 || NthOptInt(list, 3).IsSome() || NthOptInt(list, -1).IsSome() {
+		t.Errorf("TestFindOptInt failed. Unexpected NthOpt")
+	}
+	add := func(a, b int) int { return a + b }
+	if ReduceOptInt(add, list).Unwrap() != 6 || ReduceOptInt(add, nil).IsSome() || ReduceOptInt(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultInt(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v int) ResultInt {
+		if v > 2 {
+			return ErrInt(errTooLarge)
+		}
+		return OkInt(v + 1)
+	}
+	if list, err := TraverseResultInt(check, []int{1, 2}); err != nil || !reflect.DeepEqual([]int{2, 3}, list) {
+		t.Errorf("TestTraverseResultInt failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultInt(check, []int{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultInt failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultInt(nil, []int{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultInt failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionInt64(t *testing.T) {
+	inc := func(v int64) int64 { return v + 1 }
+	some := SomeOptInt64(1)
+	none := NoneOptInt64()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionInt64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionInt64 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() int64 { return 3 }); v != 3 {
+		t.Errorf("TestOptionInt64 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v int64) OptionInt64 {
+		if v > 1 {
+			return SomeOptInt64(v)
+		}
+		return NoneOptInt64()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionInt64 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v int64) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionInt64 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkInt64(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionInt64 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionInt64 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultInt64(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v int64) int64 { return v + 1 }
+
+	if v, err := OkInt64(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultInt64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrInt64(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultInt64 failed. Expected error to be kept")
+	}
+	if r := OkInt64(1).FlatMap(func(int64) ResultInt64 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultInt64 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfInt64(1, nil).IsOk() || ResultOfInt64(1, errBoom).IsOk() {
+		t.Errorf("TestResultInt64 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultInt64 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptInt64(t *testing.T) {
+	list := []int64{1, 2, 3}
+	if v, ok := FindOptInt64(func(v int64) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptInt64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptInt64(func(v int64) bool { return v > 3 }, list).IsSome() || FindOptInt64(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt64 failed. Expected None")
+	}
+	if NthOptInt64(list, 2).Unwrap() != 3 || NthOptInt64(list, 3).IsSome() || NthOptInt64(list, -1).IsSome() {
+		t.Errorf("TestFindOptInt64 failed. Unexpected NthOpt")
+	}
+	add := func(a, b int64) int64 { return a + b }
+	if ReduceOptInt64(add, list).Unwrap() != 6 || ReduceOptInt64(add, nil).IsSome() || ReduceOptInt64(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt64 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultInt64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v int64) ResultInt64 {
+		if v > 2 {
+			return ErrInt64(errTooLarge)
+		}
+		return OkInt64(v + 1)
+	}
+	if list, err := TraverseResultInt64(check, []int64{1, 2}); err != nil || !reflect.DeepEqual([]int64{2, 3}, list) {
+		t.Errorf("TestTraverseResultInt64 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultInt64(check, []int64{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultInt64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultInt64(nil, []int64{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultInt64 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionInt32(t *testing.T) {
+	inc := func(v int32) int32 { return v + 1 }
+	some := SomeOptInt32(1)
+	none := NoneOptInt32()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionInt32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionInt32 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() int32 { return 3 }); v != 3 {
+		t.Errorf("TestOptionInt32 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v int32) OptionInt32 {
+		if v > 1 {
+			return SomeOptInt32(v)
+		}
+		return NoneOptInt32()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionInt32 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v int32) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionInt32 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkInt32(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionInt32 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionInt32 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultInt32(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v int32) int32 { return v + 1 }
+
+	if v, err := OkInt32(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultInt32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrInt32(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultInt32 failed. Expected error to be kept")
+	}
+	if r := OkInt32(1).FlatMap(func(int32) ResultInt32 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultInt32 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfInt32(1, nil).IsOk() || ResultOfInt32(1, errBoom).IsOk() {
+		t.Errorf("TestResultInt32 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultInt32 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptInt32(t *testing.T) {
+	list := []int32{1, 2, 3}
+	if v, ok := FindOptInt32(func(v int32) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptInt32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptInt32(func(v int32) bool { return v > 3 }, list).IsSome() || FindOptInt32(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt32 failed. Expected None")
+	}
+	if NthOptInt32(list, 2).Unwrap() != 3 || NthOptInt32(list, 3).IsSome() || NthOptInt32(list, -1).IsSome() {
+		t.Errorf("TestFindOptInt32 failed. Unexpected NthOpt")
+	}
+	add := func(a, b int32) int32 { return a + b }
+	if ReduceOptInt32(add, list).Unwrap() != 6 || ReduceOptInt32(add, nil).IsSome() || ReduceOptInt32(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt32 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultInt32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v int32) ResultInt32 {
+		if v > 2 {
+			return ErrInt32(errTooLarge)
+		}
+		return OkInt32(v + 1)
+	}
+	if list, err := TraverseResultInt32(check, []int32{1, 2}); err != nil || !reflect.DeepEqual([]int32{2, 3}, list) {
+		t.Errorf("TestTraverseResultInt32 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultInt32(check, []int32{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultInt32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultInt32(nil, []int32{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultInt32 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionInt16(t *testing.T) {
+	inc := func(v int16) int16 { return v + 1 }
+	some := SomeOptInt16(1)
+	none := NoneOptInt16()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionInt16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionInt16 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() int16 { return 3 }); v != 3 {
+		t.Errorf("TestOptionInt16 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v int16) OptionInt16 {
+		if v > 1 {
+			return SomeOptInt16(v)
+		}
+		return NoneOptInt16()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionInt16 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v int16) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionInt16 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkInt16(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionInt16 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionInt16 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultInt16(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v int16) int16 { return v + 1 }
+
+	if v, err := OkInt16(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultInt16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrInt16(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultInt16 failed. Expected error to be kept")
+	}
+	if r := OkInt16(1).FlatMap(func(int16) ResultInt16 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultInt16 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfInt16(1, nil).IsOk() || ResultOfInt16(1, errBoom).IsOk() {
+		t.Errorf("TestResultInt16 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultInt16 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptInt16(t *testing.T) {
+	list := []int16{1, 2, 3}
+	if v, ok := FindOptInt16(func(v int16) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptInt16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptInt16(func(v int16) bool { return v > 3 }, list).IsSome() || FindOptInt16(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt16 failed. Expected None")
+	}
+	if NthOptInt16(list, 2).Unwrap() != 3 || NthOptInt16(list, 3).IsSome() || NthOptInt16(list, -1).IsSome() {
+		t.Errorf("TestFindOptInt16 failed. Unexpected NthOpt")
+	}
+	add := func(a, b int16) int16 { return a + b }
+	if ReduceOptInt16(add, list).Unwrap() != 6 || ReduceOptInt16(add, nil).IsSome() || ReduceOptInt16(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt16 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultInt16(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v int16) ResultInt16 {
+		if v > 2 {
+			return ErrInt16(errTooLarge)
+		}
+		return OkInt16(v + 1)
+	}
+	if list, err := TraverseResultInt16(check, []int16{1, 2}); err != nil || !reflect.DeepEqual([]int16{2, 3}, list) {
+		t.Errorf("TestTraverseResultInt16 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultInt16(check, []int16{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultInt16 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultInt16(nil, []int16{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultInt16 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionInt8(t *testing.T) {
+	inc := func(v int8) int8 { return v + 1 }
+	some := SomeOptInt8(1)
+	none := NoneOptInt8()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionInt8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionInt8 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() int8 { return 3 }); v != 3 {
+		t.Errorf("TestOptionInt8 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v int8) OptionInt8 {
+		if v > 1 {
+			return SomeOptInt8(v)
+		}
+		return NoneOptInt8()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionInt8 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v int8) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionInt8 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkInt8(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionInt8 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionInt8 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultInt8(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v int8) int8 { return v + 1 }
+
+	if v, err := OkInt8(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultInt8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrInt8(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultInt8 failed. Expected error to be kept")
+	}
+	if r := OkInt8(1).FlatMap(func(int8) ResultInt8 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultInt8 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfInt8(1, nil).IsOk() || ResultOfInt8(1, errBoom).IsOk() {
+		t.Errorf("TestResultInt8 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultInt8 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptInt8(t *testing.T) {
+	list := []int8{1, 2, 3}
+	if v, ok := FindOptInt8(func(v int8) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptInt8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptInt8(func(v int8) bool { return v > 3 }, list).IsSome() || FindOptInt8(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt8 failed. Expected None")
+	}
+	if NthOptInt8(list, 2).Unwrap() != 3 || NthOptInt8(list, 3).IsSome() || NthOptInt8(list, -1).IsSome() {
+		t.Errorf("TestFindOptInt8 failed. Unexpected NthOpt")
+	}
+	add := func(a, b int8) int8 { return a + b }
+	if ReduceOptInt8(add, list).Unwrap() != 6 || ReduceOptInt8(add, nil).IsSome() || ReduceOptInt8(nil, list).IsSome() {
+		t.Errorf("TestFindOptInt8 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultInt8(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v int8) ResultInt8 {
+		if v > 2 {
+			return ErrInt8(errTooLarge)
+		}
+		return OkInt8(v + 1)
+	}
+	if list, err := TraverseResultInt8(check, []int8{1, 2}); err != nil || !reflect.DeepEqual([]int8{2, 3}, list) {
+		t.Errorf("TestTraverseResultInt8 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultInt8(check, []int8{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultInt8 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultInt8(nil, []int8{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultInt8 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionUint(t *testing.T) {
+	inc := func(v uint) uint { return v + 1 }
+	some := SomeOptUint(1)
+	none := NoneOptUint()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUint failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUint failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() uint { return 3 }); v != 3 {
+		t.Errorf("TestOptionUint failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v uint) OptionUint {
+		if v > 1 {
+			return SomeOptUint(v)
+		}
+		return NoneOptUint()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUint failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v uint) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUint failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkUint(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUint failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUint failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUint(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v uint) uint { return v + 1 }
+
+	if v, err := OkUint(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultUint failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrUint(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultUint failed. Expected error to be kept")
+	}
+	if r := OkUint(1).FlatMap(func(uint) ResultUint { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultUint failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfUint(1, nil).IsOk() || ResultOfUint(1, errBoom).IsOk() {
+		t.Errorf("TestResultUint failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUint failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptUint(t *testing.T) {
+	list := []uint{1, 2, 3}
+	if v, ok := FindOptUint(func(v uint) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptUint failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptUint(func(v uint) bool { return v > 3 }, list).IsSome() || FindOptUint(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint failed. Expected None")
+	}
+	if NthOptUint(list, 2).Unwrap() != 3 || NthOptUint(list, 3).IsSome() || NthOptUint(list, -1).IsSome() {
+		t.Errorf("TestFindOptUint failed. Unexpected NthOpt")
+	}
+	add := func(a, b uint) uint { return a + b }
+	if ReduceOptUint(add, list).Unwrap() != 6 || ReduceOptUint(add, nil).IsSome() || ReduceOptUint(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultUint(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v uint) ResultUint {
+		if v > 2 {
+			return ErrUint(errTooLarge)
+		}
+		return OkUint(v + 1)
+	}
+	if list, err := TraverseResultUint(check, []uint{1, 2}); err != nil || !reflect.DeepEqual([]uint{2, 3}, list) {
+		t.Errorf("TestTraverseResultUint failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultUint(check, []uint{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultUint failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultUint(nil, []uint{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultUint failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionUint64(t *testing.T) {
+	inc := func(v uint64) uint64 { return v + 1 }
+	some := SomeOptUint64(1)
+	none := NoneOptUint64()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUint64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUint64 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() uint64 { return 3 }); v != 3 {
+		t.Errorf("TestOptionUint64 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v uint64) OptionUint64 {
+		if v > 1 {
+			return SomeOptUint64(v)
+		}
+		return NoneOptUint64()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUint64 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v uint64) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUint64 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkUint64(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUint64 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUint64 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUint64(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v uint64) uint64 { return v + 1 }
+
+	if v, err := OkUint64(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultUint64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrUint64(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultUint64 failed. Expected error to be kept")
+	}
+	if r := OkUint64(1).FlatMap(func(uint64) ResultUint64 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultUint64 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfUint64(1, nil).IsOk() || ResultOfUint64(1, errBoom).IsOk() {
+		t.Errorf("TestResultUint64 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUint64 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptUint64(t *testing.T) {
+	list := []uint64{1, 2, 3}
+	if v, ok := FindOptUint64(func(v uint64) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptUint64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptUint64(func(v uint64) bool { return v > 3 }, list).IsSome() || FindOptUint64(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint64 failed. Expected None")
+	}
+	if NthOptUint64(list, 2).Unwrap() != 3 || NthOptUint64(list, 3).IsSome() || NthOptUint64(list, -1).IsSome() {
+		t.Errorf("TestFindOptUint64 failed. Unexpected NthOpt")
+	}
+	add := func(a, b uint64) uint64 { return a + b }
+	if ReduceOptUint64(add, list).Unwrap() != 6 || ReduceOptUint64(add, nil).IsSome() || ReduceOptUint64(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint64 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultUint64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v uint64) ResultUint64 {
+		if v > 2 {
+			return ErrUint64(errTooLarge)
+		}
+		return OkUint64(v + 1)
+	}
+	if list, err := TraverseResultUint64(check, []uint64{1, 2}); err != nil || !reflect.DeepEqual([]uint64{2, 3}, list) {
+		t.Errorf("TestTraverseResultUint64 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultUint64(check, []uint64{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultUint64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultUint64(nil, []uint64{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultUint64 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionUint32(t *testing.T) {
+	inc := func(v uint32) uint32 { return v + 1 }
+	some := SomeOptUint32(1)
+	none := NoneOptUint32()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUint32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUint32 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() uint32 { return 3 }); v != 3 {
+		t.Errorf("TestOptionUint32 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v uint32) OptionUint32 {
+		if v > 1 {
+			return SomeOptUint32(v)
+		}
+		return NoneOptUint32()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUint32 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v uint32) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUint32 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkUint32(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUint32 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUint32 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUint32(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v uint32) uint32 { return v + 1 }
+
+	if v, err := OkUint32(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultUint32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrUint32(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultUint32 failed. Expected error to be kept")
+	}
+	if r := OkUint32(1).FlatMap(func(uint32) ResultUint32 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultUint32 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfUint32(1, nil).IsOk() || ResultOfUint32(1, errBoom).IsOk() {
+		t.Errorf("TestResultUint32 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUint32 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptUint32(t *testing.T) {
+	list := []uint32{1, 2, 3}
+	if v, ok := FindOptUint32(func(v uint32) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptUint32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptUint32(func(v uint32) bool { return v > 3 }, list).IsSome() || FindOptUint32(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint32 failed. Expected None")
+	}
+	if NthOptUint32(list, 2).Unwrap() != 3 || NthOptUint32(list, 3).IsSome() || NthOptUint32(list, -1).IsSome() {
+		t.Errorf("TestFindOptUint32 failed. Unexpected NthOpt")
+	}
+	add := func(a, b uint32) uint32 { return a + b }
+	if ReduceOptUint32(add, list).Unwrap() != 6 || ReduceOptUint32(add, nil).IsSome() || ReduceOptUint32(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint32 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultUint32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v uint32) ResultUint32 {
+		if v > 2 {
+			return ErrUint32(errTooLarge)
+		}
+		return OkUint32(v + 1)
+	}
+	if list, err := TraverseResultUint32(check, []uint32{1, 2}); err != nil || !reflect.DeepEqual([]uint32{2, 3}, list) {
+		t.Errorf("TestTraverseResultUint32 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultUint32(check, []uint32{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultUint32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultUint32(nil, []uint32{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultUint32 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionUint16(t *testing.T) {
+	inc := func(v uint16) uint16 { return v + 1 }
+	some := SomeOptUint16(1)
+	none := NoneOptUint16()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUint16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUint16 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() uint16 { return 3 }); v != 3 {
+		t.Errorf("TestOptionUint16 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v uint16) OptionUint16 {
+		if v > 1 {
+			return SomeOptUint16(v)
+		}
+		return NoneOptUint16()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUint16 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v uint16) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUint16 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkUint16(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUint16 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUint16 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUint16(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v uint16) uint16 { return v + 1 }
+
+	if v, err := OkUint16(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultUint16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrUint16(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultUint16 failed. Expected error to be kept")
+	}
+	if r := OkUint16(1).FlatMap(func(uint16) ResultUint16 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultUint16 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfUint16(1, nil).IsOk() || ResultOfUint16(1, errBoom).IsOk() {
+		t.Errorf("TestResultUint16 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUint16 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptUint16(t *testing.T) {
+	list := []uint16{1, 2, 3}
+	if v, ok := FindOptUint16(func(v uint16) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptUint16 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptUint16(func(v uint16) bool { return v > 3 }, list).IsSome() || FindOptUint16(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint16 failed. Expected None")
+	}
+	if NthOptUint16(list, 2).Unwrap() != 3 || NthOptUint16(list, 3).IsSome() || NthOptUint16(list, -1).IsSome() {
+		t.Errorf("TestFindOptUint16 failed. Unexpected NthOpt")
+	}
+	add := func(a, b uint16) uint16 { return a + b }
+	if ReduceOptUint16(add, list).Unwrap() != 6 || ReduceOptUint16(add, nil).IsSome() || ReduceOptUint16(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint16 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultUint16(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v uint16) ResultUint16 {
+		if v > 2 {
+			return ErrUint16(errTooLarge)
+		}
+		return OkUint16(v + 1)
+	}
+	if list, err := TraverseResultUint16(check, []uint16{1, 2}); err != nil || !reflect.DeepEqual([]uint16{2, 3}, list) {
+		t.Errorf("TestTraverseResultUint16 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultUint16(check, []uint16{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultUint16 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultUint16(nil, []uint16{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultUint16 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionUint8(t *testing.T) {
+	inc := func(v uint8) uint8 { return v + 1 }
+	some := SomeOptUint8(1)
+	none := NoneOptUint8()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUint8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUint8 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() uint8 { return 3 }); v != 3 {
+		t.Errorf("TestOptionUint8 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v uint8) OptionUint8 {
+		if v > 1 {
+			return SomeOptUint8(v)
+		}
+		return NoneOptUint8()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUint8 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v uint8) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUint8 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkUint8(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUint8 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUint8 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUint8(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v uint8) uint8 { return v + 1 }
+
+	if v, err := OkUint8(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultUint8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrUint8(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultUint8 failed. Expected error to be kept")
+	}
+	if r := OkUint8(1).FlatMap(func(uint8) ResultUint8 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultUint8 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfUint8(1, nil).IsOk() || ResultOfUint8(1, errBoom).IsOk() {
+		t.Errorf("TestResultUint8 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUint8 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptUint8(t *testing.T) {
+	list := []uint8{1, 2, 3}
+	if v, ok := FindOptUint8(func(v uint8) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptUint8 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptUint8(func(v uint8) bool { return v > 3 }, list).IsSome() || FindOptUint8(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint8 failed. Expected None")
+	}
+	if NthOptUint8(list, 2).Unwrap() != 3 || NthOptUint8(list, 3).IsSome() || NthOptUint8(list, -1).IsSome() {
+		t.Errorf("TestFindOptUint8 failed. Unexpected NthOpt")
+	}
+	add := func(a, b uint8) uint8 { return a + b }
+	if ReduceOptUint8(add, list).Unwrap() != 6 || ReduceOptUint8(add, nil).IsSome() || ReduceOptUint8(nil, list).IsSome() {
+		t.Errorf("TestFindOptUint8 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultUint8(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v uint8) ResultUint8 {
+		if v > 2 {
+			return ErrUint8(errTooLarge)
+		}
+		return OkUint8(v + 1)
+	}
+	if list, err := TraverseResultUint8(check, []uint8{1, 2}); err != nil || !reflect.DeepEqual([]uint8{2, 3}, list) {
+		t.Errorf("TestTraverseResultUint8 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultUint8(check, []uint8{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultUint8 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultUint8(nil, []uint8{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultUint8 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionFloat64(t *testing.T) {
+	inc := func(v float64) float64 { return v + 1 }
+	some := SomeOptFloat64(1)
+	none := NoneOptFloat64()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionFloat64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionFloat64 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() float64 { return 3 }); v != 3 {
+		t.Errorf("TestOptionFloat64 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v float64) OptionFloat64 {
+		if v > 1 {
+			return SomeOptFloat64(v)
+		}
+		return NoneOptFloat64()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionFloat64 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v float64) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionFloat64 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkFloat64(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionFloat64 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionFloat64 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultFloat64(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v float64) float64 { return v + 1 }
+
+	if v, err := OkFloat64(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultFloat64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrFloat64(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultFloat64 failed. Expected error to be kept")
+	}
+	if r := OkFloat64(1).FlatMap(func(float64) ResultFloat64 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultFloat64 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfFloat64(1, nil).IsOk() || ResultOfFloat64(1, errBoom).IsOk() {
+		t.Errorf("TestResultFloat64 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultFloat64 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptFloat64(t *testing.T) {
+	list := []float64{1, 2, 3}
+	if v, ok := FindOptFloat64(func(v float64) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptFloat64 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptFloat64(func(v float64) bool { return v > 3 }, list).IsSome() || FindOptFloat64(nil, list).IsSome() {
+		t.Errorf("TestFindOptFloat64 failed. Expected None")
+	}
+	if NthOptFloat64(list, 2).Unwrap() != 3 || NthOptFloat64(list, 3).IsSome() || NthOptFloat64(list, -1).IsSome() {
+		t.Errorf("TestFindOptFloat64 failed. Unexpected NthOpt")
+	}
+	add := func(a, b float64) float64 { return a + b }
+	if ReduceOptFloat64(add, list).Unwrap() != 6 || ReduceOptFloat64(add, nil).IsSome() || ReduceOptFloat64(nil, list).IsSome() {
+		t.Errorf("TestFindOptFloat64 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultFloat64(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v float64) ResultFloat64 {
+		if v > 2 {
+			return ErrFloat64(errTooLarge)
+		}
+		return OkFloat64(v + 1)
+	}
+	if list, err := TraverseResultFloat64(check, []float64{1, 2}); err != nil || !reflect.DeepEqual([]float64{2, 3}, list) {
+		t.Errorf("TestTraverseResultFloat64 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultFloat64(check, []float64{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultFloat64 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultFloat64(nil, []float64{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultFloat64 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionFloat32(t *testing.T) {
+	inc := func(v float32) float32 { return v + 1 }
+	some := SomeOptFloat32(1)
+	none := NoneOptFloat32()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionFloat32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionFloat32 failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() float32 { return 3 }); v != 3 {
+		t.Errorf("TestOptionFloat32 failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v float32) OptionFloat32 {
+		if v > 1 {
+			return SomeOptFloat32(v)
+		}
+		return NoneOptFloat32()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionFloat32 failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v float32) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionFloat32 failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || OkFloat32(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionFloat32 failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionFloat32 failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultFloat32(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v float32) float32 { return v + 1 }
+
+	if v, err := OkFloat32(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResultFloat32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := ErrFloat32(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResultFloat32 failed. Expected error to be kept")
+	}
+	if r := OkFloat32(1).FlatMap(func(float32) ResultFloat32 { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResultFloat32 failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOfFloat32(1, nil).IsOk() || ResultOfFloat32(1, errBoom).IsOk() {
+		t.Errorf("TestResultFloat32 failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultFloat32 failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOptFloat32(t *testing.T) {
+	list := []float32{1, 2, 3}
+	if v, ok := FindOptFloat32(func(v float32) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOptFloat32 failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOptFloat32(func(v float32) bool { return v > 3 }, list).IsSome() || FindOptFloat32(nil, list).IsSome() {
+		t.Errorf("TestFindOptFloat32 failed. Expected None")
+	}
+	if NthOptFloat32(list, 2).Unwrap() != 3 || NthOptFloat32(list, 3).IsSome() || NthOptFloat32(list, -1).IsSome() {
+		t.Errorf("TestFindOptFloat32 failed. Unexpected NthOpt")
+	}
+	add := func(a, b float32) float32 { return a + b }
+	if ReduceOptFloat32(add, list).Unwrap() != 6 || ReduceOptFloat32(add, nil).IsSome() || ReduceOptFloat32(nil, list).IsSome() {
+		t.Errorf("TestFindOptFloat32 failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResultFloat32(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v float32) ResultFloat32 {
+		if v > 2 {
+			return ErrFloat32(errTooLarge)
+		}
+		return OkFloat32(v + 1)
+	}
+	if list, err := TraverseResultFloat32(check, []float32{1, 2}); err != nil || !reflect.DeepEqual([]float32{2, 3}, list) {
+		t.Errorf("TestTraverseResultFloat32 failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResultFloat32(check, []float32{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResultFloat32 failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResultFloat32(nil, []float32{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResultFloat32 failed. Expected empty list for nil function")
+	}
+}
+
+func TestOptionStr(t *testing.T) {
+	upper := func(v string) string { return v + "!" }
+	if v := SomeOptStr("a").Map(upper).OrElse("z"); v != "a!" {
+		t.Errorf("TestOptionStr failed. Expected=%v, actual=%v", "a!", v)
+	}
+	if v := NoneOptStr().Map(upper).OrElse("z"); v != "z" {
+		t.Errorf("TestOptionStr failed. Expected=%v, actual=%v", "z", v)
+	}
+	var zero OptionStr
+	if zero.IsSome() {
+		t.Errorf("TestOptionStr failed. Expected zero value to be None")
+	}
+}
+
+func TestResultStr(t *testing.T) {
+	errBoom := errors.New("boom")
+	if v, err := ResultOfStr("a", nil).Map(func(v string) string { return v + "b" }).Get(); err != nil || v != "ab" {
+		t.Errorf("TestResultStr failed. Expected=%v, actual=%v", "ab", v)
+	}
+	if ErrStr(errBoom).OrElse("z") != "z" {
+		t.Errorf("TestResultStr failed. Expected default value for error")
+	}
+}
+
+func TestFindOptStr(t *testing.T) {
+	list := []string{"a", "bb", "cc"}
+	if v := FindOptStr(func(v string) bool { return len(v) == 2 }, list).Unwrap(); v != "bb" {
+		t.Errorf("TestFindOptStr failed. Expected=%v, actual=%v", "bb", v)
+	}
+	if NthOptStr(list, 5).IsSome() || ReduceOptStr(func(a, b string) string { return a + b }, list).Unwrap() != "abbcc" {
+		t.Errorf("TestFindOptStr failed. Unexpected NthOpt or ReduceOpt")
+	}
+}
+
+func TestTraverseResultStr(t *testing.T) {
+	errEmpty := errors.New("empty")
+	check := func(v string) ResultStr {
+		if v == "" {
+			return ErrStr(errEmpty)
+		}
+		return OkStr(v)
+	}
+	if list, err := TraverseResultStr(check, []string{"a", "b"}); err != nil || !reflect.DeepEqual([]string{"a", "b"}, list) {
+		t.Errorf("TestTraverseResultStr failed. Expected=[a b], actual=%v", list)
+	}
+	if _, err := TraverseResultStr(check, []string{"a", ""}); err != errEmpty {
+		t.Errorf("TestTraverseResultStr failed. Expected error=%v, actual=%v", errEmpty, err)
+	}
+}
+
+func TestOptionBool(t *testing.T) {
+	not := func(v bool) bool { return !v }
+	if v := SomeOptBool(true).Map(not).OrElse(true); v != false {
+		t.Errorf("TestOptionBool failed. Expected=%v, actual=%v", false, v)
+	}
+	if NoneOptBool().Map(not).IsSome() {
+		t.Errorf("TestOptionBool failed. Expected None")
+	}
+}
+
+func TestResultBool(t *testing.T) {
+	errBoom := errors.New("boom")
+	if ErrBool(errBoom).Option().IsSome() || !OkBool(true).Option().Unwrap() {
+		t.Errorf("TestResultBool failed. Unexpected Option")
+	}
+}
+
+func TestFindOptBool(t *testing.T) {
+	list := []bool{false, true}
+	if !FindOptBool(func(v bool) bool { return v }, list).IsSome() || NthOptBool(list, 2).IsSome() {
+		t.Errorf("TestFindOptBool failed. Unexpected FindOpt or NthOpt")
+	}
+}
+
+func TestTraverseResultBool(t *testing.T) {
+	list, err := TraverseResultBool(func(v bool) ResultBool { return OkBool(!v) }, []bool{true, false})
+	if err != nil || !reflect.DeepEqual([]bool{false, true}, list) {
+		t.Errorf("TestTraverseResultBool failed. Expected=[false true], actual=%v", list)
+	}
+}
@@ -0,0 +1,5041 @@
+package fp
+
+// PairIntInt holds two values: First of type int and Second of type int.
+type PairIntInt struct {
+	First  int
+	Second int
+}
+
+// ZipPairsInt takes two inputs: first list of type: []int, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt(list1 []int, list2 []int) []PairIntInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt
+func UnzipInt(pairs []PairIntInt) ([]int, []int) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntInt64 holds two values: First of type int and Second of type int64.
+type PairIntInt64 struct {
+	First  int
+	Second int64
+}
+
+// ZipPairsIntInt64 takes two inputs: first list of type: []int, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntInt64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntInt64(list1 []int, list2 []int64) []PairIntInt64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntInt64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntInt64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntInt64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntInt64
+func UnzipIntInt64(pairs []PairIntInt64) ([]int, []int64) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntInt32 holds two values: First of type int and Second of type int32.
+type PairIntInt32 struct {
+	First  int
+	Second int32
+}
+
+// ZipPairsIntInt32 takes two inputs: first list of type: []int, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntInt32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntInt32(list1 []int, list2 []int32) []PairIntInt32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntInt32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntInt32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntInt32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntInt32
+func UnzipIntInt32(pairs []PairIntInt32) ([]int, []int32) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntInt16 holds two values: First of type int and Second of type int16.
+type PairIntInt16 struct {
+	First  int
+	Second int16
+}
+
+// ZipPairsIntInt16 takes two inputs: first list of type: []int, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntInt16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntInt16(list1 []int, list2 []int16) []PairIntInt16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntInt16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntInt16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntInt16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntInt16
+func UnzipIntInt16(pairs []PairIntInt16) ([]int, []int16) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntInt8 holds two values: First of type int and Second of type int8.
+type PairIntInt8 struct {
+	First  int
+	Second int8
+}
+
+// ZipPairsIntInt8 takes two inputs: first list of type: []int, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntInt8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntInt8(list1 []int, list2 []int8) []PairIntInt8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntInt8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntInt8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntInt8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntInt8
+func UnzipIntInt8(pairs []PairIntInt8) ([]int, []int8) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntUint holds two values: First of type int and Second of type uint.
+type PairIntUint struct {
+	First  int
+	Second uint
+}
+
+// ZipPairsIntUint takes two inputs: first list of type: []int, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntUint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntUint(list1 []int, list2 []uint) []PairIntUint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntUint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntUint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntUint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntUint
+func UnzipIntUint(pairs []PairIntUint) ([]int, []uint) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntUint64 holds two values: First of type int and Second of type uint64.
+type PairIntUint64 struct {
+	First  int
+	Second uint64
+}
+
+// ZipPairsIntUint64 takes two inputs: first list of type: []int, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntUint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntUint64(list1 []int, list2 []uint64) []PairIntUint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntUint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntUint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntUint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntUint64
+func UnzipIntUint64(pairs []PairIntUint64) ([]int, []uint64) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntUint32 holds two values: First of type int and Second of type uint32.
+type PairIntUint32 struct {
+	First  int
+	Second uint32
+}
+
+// ZipPairsIntUint32 takes two inputs: first list of type: []int, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntUint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntUint32(list1 []int, list2 []uint32) []PairIntUint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntUint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntUint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntUint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntUint32
+func UnzipIntUint32(pairs []PairIntUint32) ([]int, []uint32) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntUint16 holds two values: First of type int and Second of type uint16.
+type PairIntUint16 struct {
+	First  int
+	Second uint16
+}
+
+// ZipPairsIntUint16 takes two inputs: first list of type: []int, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntUint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntUint16(list1 []int, list2 []uint16) []PairIntUint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntUint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntUint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntUint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntUint16
+func UnzipIntUint16(pairs []PairIntUint16) ([]int, []uint16) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntUint8 holds two values: First of type int and Second of type uint8.
+type PairIntUint8 struct {
+	First  int
+	Second uint8
+}
+
+// ZipPairsIntUint8 takes two inputs: first list of type: []int, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntUint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntUint8(list1 []int, list2 []uint8) []PairIntUint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntUint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntUint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntUint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntUint8
+func UnzipIntUint8(pairs []PairIntUint8) ([]int, []uint8) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntStr holds two values: First of type int and Second of type string.
+type PairIntStr struct {
+	First  int
+	Second string
+}
+
+// ZipPairsIntStr takes two inputs: first list of type: []int, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntStr(list1 []int, list2 []string) []PairIntStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntStr{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntStr
+func UnzipIntStr(pairs []PairIntStr) ([]int, []string) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairIntBool holds two values: First of type int and Second of type bool.
+type PairIntBool struct {
+	First  int
+	Second bool
+}
+
+// ZipPairsIntBool takes two inputs: first list of type: []int, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntBool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntBool(list1 []int, list2 []bool) []PairIntBool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntBool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntBool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntBool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntBool
+func UnzipIntBool(pairs []PairIntBool) ([]int, []bool) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Int holds two values: First of type int64 and Second of type int.
+type PairInt64Int struct {
+	First  int64
+	Second int
+}
+
+// ZipPairsInt64Int takes two inputs: first list of type: []int64, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Int(list1 []int64, list2 []int) []PairInt64Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Int
+func UnzipInt64Int(pairs []PairInt64Int) ([]int64, []int) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Int64 holds two values: First of type int64 and Second of type int64.
+type PairInt64Int64 struct {
+	First  int64
+	Second int64
+}
+
+// ZipPairsInt64 takes two inputs: first list of type: []int64, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64(list1 []int64, list2 []int64) []PairInt64Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64
+func UnzipInt64(pairs []PairInt64Int64) ([]int64, []int64) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Int32 holds two values: First of type int64 and Second of type int32.
+type PairInt64Int32 struct {
+	First  int64
+	Second int32
+}
+
+// ZipPairsInt64Int32 takes two inputs: first list of type: []int64, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Int32(list1 []int64, list2 []int32) []PairInt64Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Int32
+func UnzipInt64Int32(pairs []PairInt64Int32) ([]int64, []int32) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Int16 holds two values: First of type int64 and Second of type int16.
+type PairInt64Int16 struct {
+	First  int64
+	Second int16
+}
+
+// ZipPairsInt64Int16 takes two inputs: first list of type: []int64, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Int16(list1 []int64, list2 []int16) []PairInt64Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Int16
+func UnzipInt64Int16(pairs []PairInt64Int16) ([]int64, []int16) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Int8 holds two values: First of type int64 and Second of type int8.
+type PairInt64Int8 struct {
+	First  int64
+	Second int8
+}
+
+// ZipPairsInt64Int8 takes two inputs: first list of type: []int64, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Int8(list1 []int64, list2 []int8) []PairInt64Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Int8
+func UnzipInt64Int8(pairs []PairInt64Int8) ([]int64, []int8) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Uint holds two values: First of type int64 and Second of type uint.
+type PairInt64Uint struct {
+	First  int64
+	Second uint
+}
+
+// ZipPairsInt64Uint takes two inputs: first list of type: []int64, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Uint(list1 []int64, list2 []uint) []PairInt64Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Uint
+func UnzipInt64Uint(pairs []PairInt64Uint) ([]int64, []uint) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Uint64 holds two values: First of type int64 and Second of type uint64.
+type PairInt64Uint64 struct {
+	First  int64
+	Second uint64
+}
+
+// ZipPairsInt64Uint64 takes two inputs: first list of type: []int64, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Uint64(list1 []int64, list2 []uint64) []PairInt64Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Uint64
+func UnzipInt64Uint64(pairs []PairInt64Uint64) ([]int64, []uint64) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Uint32 holds two values: First of type int64 and Second of type uint32.
+type PairInt64Uint32 struct {
+	First  int64
+	Second uint32
+}
+
+// ZipPairsInt64Uint32 takes two inputs: first list of type: []int64, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Uint32(list1 []int64, list2 []uint32) []PairInt64Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Uint32
+func UnzipInt64Uint32(pairs []PairInt64Uint32) ([]int64, []uint32) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Uint16 holds two values: First of type int64 and Second of type uint16.
+type PairInt64Uint16 struct {
+	First  int64
+	Second uint16
+}
+
+// ZipPairsInt64Uint16 takes two inputs: first list of type: []int64, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Uint16(list1 []int64, list2 []uint16) []PairInt64Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Uint16
+func UnzipInt64Uint16(pairs []PairInt64Uint16) ([]int64, []uint16) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Uint8 holds two values: First of type int64 and Second of type uint8.
+type PairInt64Uint8 struct {
+	First  int64
+	Second uint8
+}
+
+// ZipPairsInt64Uint8 takes two inputs: first list of type: []int64, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Uint8(list1 []int64, list2 []uint8) []PairInt64Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Uint8
+func UnzipInt64Uint8(pairs []PairInt64Uint8) ([]int64, []uint8) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Str holds two values: First of type int64 and Second of type string.
+type PairInt64Str struct {
+	First  int64
+	Second string
+}
+
+// ZipPairsInt64Str takes two inputs: first list of type: []int64, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Str(list1 []int64, list2 []string) []PairInt64Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Str
+func UnzipInt64Str(pairs []PairInt64Str) ([]int64, []string) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt64Bool holds two values: First of type int64 and Second of type bool.
+type PairInt64Bool struct {
+	First  int64
+	Second bool
+}
+
+// ZipPairsInt64Bool takes two inputs: first list of type: []int64, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt64Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt64Bool(list1 []int64, list2 []bool) []PairInt64Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt64Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt64Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt64Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt64Bool
+func UnzipInt64Bool(pairs []PairInt64Bool) ([]int64, []bool) {
+	list1 := make([]int64, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Int holds two values: First of type int32 and Second of type int.
+type PairInt32Int struct {
+	First  int32
+	Second int
+}
+
+// ZipPairsInt32Int takes two inputs: first list of type: []int32, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Int(list1 []int32, list2 []int) []PairInt32Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Int
+func UnzipInt32Int(pairs []PairInt32Int) ([]int32, []int) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Int64 holds two values: First of type int32 and Second of type int64.
+type PairInt32Int64 struct {
+	First  int32
+	Second int64
+}
+
+// ZipPairsInt32Int64 takes two inputs: first list of type: []int32, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Int64(list1 []int32, list2 []int64) []PairInt32Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Int64
+func UnzipInt32Int64(pairs []PairInt32Int64) ([]int32, []int64) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Int32 holds two values: First of type int32 and Second of type int32.
+type PairInt32Int32 struct {
+	First  int32
+	Second int32
+}
+
+// ZipPairsInt32 takes two inputs: first list of type: []int32, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32(list1 []int32, list2 []int32) []PairInt32Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32
+func UnzipInt32(pairs []PairInt32Int32) ([]int32, []int32) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Int16 holds two values: First of type int32 and Second of type int16.
+type PairInt32Int16 struct {
+	First  int32
+	Second int16
+}
+
+// ZipPairsInt32Int16 takes two inputs: first list of type: []int32, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Int16(list1 []int32, list2 []int16) []PairInt32Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Int16
+func UnzipInt32Int16(pairs []PairInt32Int16) ([]int32, []int16) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Int8 holds two values: First of type int32 and Second of type int8.
+type PairInt32Int8 struct {
+	First  int32
+	Second int8
+}
+
+// ZipPairsInt32Int8 takes two inputs: first list of type: []int32, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Int8(list1 []int32, list2 []int8) []PairInt32Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Int8
+func UnzipInt32Int8(pairs []PairInt32Int8) ([]int32, []int8) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Uint holds two values: First of type int32 and Second of type uint.
+type PairInt32Uint struct {
+	First  int32
+	Second uint
+}
+
+// ZipPairsInt32Uint takes two inputs: first list of type: []int32, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Uint(list1 []int32, list2 []uint) []PairInt32Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Uint
+func UnzipInt32Uint(pairs []PairInt32Uint) ([]int32, []uint) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Uint64 holds two values: First of type int32 and Second of type uint64.
+type PairInt32Uint64 struct {
+	First  int32
+	Second uint64
+}
+
+// ZipPairsInt32Uint64 takes two inputs: first list of type: []int32, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Uint64(list1 []int32, list2 []uint64) []PairInt32Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Uint64
+func UnzipInt32Uint64(pairs []PairInt32Uint64) ([]int32, []uint64) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Uint32 holds two values: First of type int32 and Second of type uint32.
+type PairInt32Uint32 struct {
+	First  int32
+	Second uint32
+}
+
+// ZipPairsInt32Uint32 takes two inputs: first list of type: []int32, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Uint32(list1 []int32, list2 []uint32) []PairInt32Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Uint32
+func UnzipInt32Uint32(pairs []PairInt32Uint32) ([]int32, []uint32) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Uint16 holds two values: First of type int32 and Second of type uint16.
+type PairInt32Uint16 struct {
+	First  int32
+	Second uint16
+}
+
+// ZipPairsInt32Uint16 takes two inputs: first list of type: []int32, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Uint16(list1 []int32, list2 []uint16) []PairInt32Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Uint16
+func UnzipInt32Uint16(pairs []PairInt32Uint16) ([]int32, []uint16) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Uint8 holds two values: First of type int32 and Second of type uint8.
+type PairInt32Uint8 struct {
+	First  int32
+	Second uint8
+}
+
+// ZipPairsInt32Uint8 takes two inputs: first list of type: []int32, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Uint8(list1 []int32, list2 []uint8) []PairInt32Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Uint8
+func UnzipInt32Uint8(pairs []PairInt32Uint8) ([]int32, []uint8) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Str holds two values: First of type int32 and Second of type string.
+type PairInt32Str struct {
+	First  int32
+	Second string
+}
+
+// ZipPairsInt32Str takes two inputs: first list of type: []int32, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Str(list1 []int32, list2 []string) []PairInt32Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Str
+func UnzipInt32Str(pairs []PairInt32Str) ([]int32, []string) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt32Bool holds two values: First of type int32 and Second of type bool.
+type PairInt32Bool struct {
+	First  int32
+	Second bool
+}
+
+// ZipPairsInt32Bool takes two inputs: first list of type: []int32, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt32Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt32Bool(list1 []int32, list2 []bool) []PairInt32Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt32Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt32Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt32Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt32Bool
+func UnzipInt32Bool(pairs []PairInt32Bool) ([]int32, []bool) {
+	list1 := make([]int32, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Int holds two values: First of type int16 and Second of type int.
+type PairInt16Int struct {
+	First  int16
+	Second int
+}
+
+// ZipPairsInt16Int takes two inputs: first list of type: []int16, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Int(list1 []int16, list2 []int) []PairInt16Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Int
+func UnzipInt16Int(pairs []PairInt16Int) ([]int16, []int) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Int64 holds two values: First of type int16 and Second of type int64.
+type PairInt16Int64 struct {
+	First  int16
+	Second int64
+}
+
+// ZipPairsInt16Int64 takes two inputs: first list of type: []int16, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Int64(list1 []int16, list2 []int64) []PairInt16Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Int64
+func UnzipInt16Int64(pairs []PairInt16Int64) ([]int16, []int64) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Int32 holds two values: First of type int16 and Second of type int32.
+type PairInt16Int32 struct {
+	First  int16
+	Second int32
+}
+
+// ZipPairsInt16Int32 takes two inputs: first list of type: []int16, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Int32(list1 []int16, list2 []int32) []PairInt16Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Int32
+func UnzipInt16Int32(pairs []PairInt16Int32) ([]int16, []int32) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Int16 holds two values: First of type int16 and Second of type int16.
+type PairInt16Int16 struct {
+	First  int16
+	Second int16
+}
+
+// ZipPairsInt16 takes two inputs: first list of type: []int16, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16(list1 []int16, list2 []int16) []PairInt16Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16
+func UnzipInt16(pairs []PairInt16Int16) ([]int16, []int16) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Int8 holds two values: First of type int16 and Second of type int8.
+type PairInt16Int8 struct {
+	First  int16
+	Second int8
+}
+
+// ZipPairsInt16Int8 takes two inputs: first list of type: []int16, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Int8(list1 []int16, list2 []int8) []PairInt16Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Int8
+func UnzipInt16Int8(pairs []PairInt16Int8) ([]int16, []int8) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Uint holds two values: First of type int16 and Second of type uint.
+type PairInt16Uint struct {
+	First  int16
+	Second uint
+}
+
+// ZipPairsInt16Uint takes two inputs: first list of type: []int16, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Uint(list1 []int16, list2 []uint) []PairInt16Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Uint
+func UnzipInt16Uint(pairs []PairInt16Uint) ([]int16, []uint) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Uint64 holds two values: First of type int16 and Second of type uint64.
+type PairInt16Uint64 struct {
+	First  int16
+	Second uint64
+}
+
+// ZipPairsInt16Uint64 takes two inputs: first list of type: []int16, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Uint64(list1 []int16, list2 []uint64) []PairInt16Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Uint64
+func UnzipInt16Uint64(pairs []PairInt16Uint64) ([]int16, []uint64) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Uint32 holds two values: First of type int16 and Second of type uint32.
+type PairInt16Uint32 struct {
+	First  int16
+	Second uint32
+}
+
+// ZipPairsInt16Uint32 takes two inputs: first list of type: []int16, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Uint32(list1 []int16, list2 []uint32) []PairInt16Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Uint32
+func UnzipInt16Uint32(pairs []PairInt16Uint32) ([]int16, []uint32) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Uint16 holds two values: First of type int16 and Second of type uint16.
+type PairInt16Uint16 struct {
+	First  int16
+	Second uint16
+}
+
+// ZipPairsInt16Uint16 takes two inputs: first list of type: []int16, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Uint16(list1 []int16, list2 []uint16) []PairInt16Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Uint16
+func UnzipInt16Uint16(pairs []PairInt16Uint16) ([]int16, []uint16) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Uint8 holds two values: First of type int16 and Second of type uint8.
+type PairInt16Uint8 struct {
+	First  int16
+	Second uint8
+}
+
+// ZipPairsInt16Uint8 takes two inputs: first list of type: []int16, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Uint8(list1 []int16, list2 []uint8) []PairInt16Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Uint8
+func UnzipInt16Uint8(pairs []PairInt16Uint8) ([]int16, []uint8) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Str holds two values: First of type int16 and Second of type string.
+type PairInt16Str struct {
+	First  int16
+	Second string
+}
+
+// ZipPairsInt16Str takes two inputs: first list of type: []int16, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Str(list1 []int16, list2 []string) []PairInt16Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Str
+func UnzipInt16Str(pairs []PairInt16Str) ([]int16, []string) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt16Bool holds two values: First of type int16 and Second of type bool.
+type PairInt16Bool struct {
+	First  int16
+	Second bool
+}
+
+// ZipPairsInt16Bool takes two inputs: first list of type: []int16, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt16Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt16Bool(list1 []int16, list2 []bool) []PairInt16Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt16Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt16Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt16Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt16Bool
+func UnzipInt16Bool(pairs []PairInt16Bool) ([]int16, []bool) {
+	list1 := make([]int16, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Int holds two values: First of type int8 and Second of type int.
+type PairInt8Int struct {
+	First  int8
+	Second int
+}
+
+// ZipPairsInt8Int takes two inputs: first list of type: []int8, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Int(list1 []int8, list2 []int) []PairInt8Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Int
+func UnzipInt8Int(pairs []PairInt8Int) ([]int8, []int) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Int64 holds two values: First of type int8 and Second of type int64.
+type PairInt8Int64 struct {
+	First  int8
+	Second int64
+}
+
+// ZipPairsInt8Int64 takes two inputs: first list of type: []int8, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Int64(list1 []int8, list2 []int64) []PairInt8Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Int64
+func UnzipInt8Int64(pairs []PairInt8Int64) ([]int8, []int64) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Int32 holds two values: First of type int8 and Second of type int32.
+type PairInt8Int32 struct {
+	First  int8
+	Second int32
+}
+
+// ZipPairsInt8Int32 takes two inputs: first list of type: []int8, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Int32(list1 []int8, list2 []int32) []PairInt8Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Int32
+func UnzipInt8Int32(pairs []PairInt8Int32) ([]int8, []int32) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Int16 holds two values: First of type int8 and Second of type int16.
+type PairInt8Int16 struct {
+	First  int8
+	Second int16
+}
+
+// ZipPairsInt8Int16 takes two inputs: first list of type: []int8, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Int16(list1 []int8, list2 []int16) []PairInt8Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Int16
+func UnzipInt8Int16(pairs []PairInt8Int16) ([]int8, []int16) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Int8 holds two values: First of type int8 and Second of type int8.
+type PairInt8Int8 struct {
+	First  int8
+	Second int8
+}
+
+// ZipPairsInt8 takes two inputs: first list of type: []int8, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8(list1 []int8, list2 []int8) []PairInt8Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8
+func UnzipInt8(pairs []PairInt8Int8) ([]int8, []int8) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Uint holds two values: First of type int8 and Second of type uint.
+type PairInt8Uint struct {
+	First  int8
+	Second uint
+}
+
+// ZipPairsInt8Uint takes two inputs: first list of type: []int8, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Uint(list1 []int8, list2 []uint) []PairInt8Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Uint
+func UnzipInt8Uint(pairs []PairInt8Uint) ([]int8, []uint) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Uint64 holds two values: First of type int8 and Second of type uint64.
+type PairInt8Uint64 struct {
+	First  int8
+	Second uint64
+}
+
+// ZipPairsInt8Uint64 takes two inputs: first list of type: []int8, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Uint64(list1 []int8, list2 []uint64) []PairInt8Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Uint64
+func UnzipInt8Uint64(pairs []PairInt8Uint64) ([]int8, []uint64) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Uint32 holds two values: First of type int8 and Second of type uint32.
+type PairInt8Uint32 struct {
+	First  int8
+	Second uint32
+}
+
+// ZipPairsInt8Uint32 takes two inputs: first list of type: []int8, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Uint32(list1 []int8, list2 []uint32) []PairInt8Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Uint32
+func UnzipInt8Uint32(pairs []PairInt8Uint32) ([]int8, []uint32) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Uint16 holds two values: First of type int8 and Second of type uint16.
+type PairInt8Uint16 struct {
+	First  int8
+	Second uint16
+}
+
+// ZipPairsInt8Uint16 takes two inputs: first list of type: []int8, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Uint16(list1 []int8, list2 []uint16) []PairInt8Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Uint16
+func UnzipInt8Uint16(pairs []PairInt8Uint16) ([]int8, []uint16) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Uint8 holds two values: First of type int8 and Second of type uint8.
+type PairInt8Uint8 struct {
+	First  int8
+	Second uint8
+}
+
+// ZipPairsInt8Uint8 takes two inputs: first list of type: []int8, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Uint8(list1 []int8, list2 []uint8) []PairInt8Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Uint8
+func UnzipInt8Uint8(pairs []PairInt8Uint8) ([]int8, []uint8) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Str holds two values: First of type int8 and Second of type string.
+type PairInt8Str struct {
+	First  int8
+	Second string
+}
+
+// ZipPairsInt8Str takes two inputs: first list of type: []int8, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Str(list1 []int8, list2 []string) []PairInt8Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Str
+func UnzipInt8Str(pairs []PairInt8Str) ([]int8, []string) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairInt8Bool holds two values: First of type int8 and Second of type bool.
+type PairInt8Bool struct {
+	First  int8
+	Second bool
+}
+
+// ZipPairsInt8Bool takes two inputs: first list of type: []int8, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipInt8Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsInt8Bool(list1 []int8, list2 []bool) []PairInt8Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairInt8Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairInt8Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipInt8Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsInt8Bool
+func UnzipInt8Bool(pairs []PairInt8Bool) ([]int8, []bool) {
+	list1 := make([]int8, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintInt holds two values: First of type uint and Second of type int.
+type PairUintInt struct {
+	First  uint
+	Second int
+}
+
+// ZipPairsUintInt takes two inputs: first list of type: []uint, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintInt(list1 []uint, list2 []int) []PairUintInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintInt
+func UnzipUintInt(pairs []PairUintInt) ([]uint, []int) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintInt64 holds two values: First of type uint and Second of type int64.
+type PairUintInt64 struct {
+	First  uint
+	Second int64
+}
+
+// ZipPairsUintInt64 takes two inputs: first list of type: []uint, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintInt64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintInt64(list1 []uint, list2 []int64) []PairUintInt64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintInt64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintInt64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintInt64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintInt64
+func UnzipUintInt64(pairs []PairUintInt64) ([]uint, []int64) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintInt32 holds two values: First of type uint and Second of type int32.
+type PairUintInt32 struct {
+	First  uint
+	Second int32
+}
+
+// ZipPairsUintInt32 takes two inputs: first list of type: []uint, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintInt32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintInt32(list1 []uint, list2 []int32) []PairUintInt32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintInt32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintInt32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintInt32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintInt32
+func UnzipUintInt32(pairs []PairUintInt32) ([]uint, []int32) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintInt16 holds two values: First of type uint and Second of type int16.
+type PairUintInt16 struct {
+	First  uint
+	Second int16
+}
+
+// ZipPairsUintInt16 takes two inputs: first list of type: []uint, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintInt16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintInt16(list1 []uint, list2 []int16) []PairUintInt16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintInt16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintInt16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintInt16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintInt16
+func UnzipUintInt16(pairs []PairUintInt16) ([]uint, []int16) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintInt8 holds two values: First of type uint and Second of type int8.
+type PairUintInt8 struct {
+	First  uint
+	Second int8
+}
+
+// ZipPairsUintInt8 takes two inputs: first list of type: []uint, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintInt8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintInt8(list1 []uint, list2 []int8) []PairUintInt8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintInt8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintInt8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintInt8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintInt8
+func UnzipUintInt8(pairs []PairUintInt8) ([]uint, []int8) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintUint holds two values: First of type uint and Second of type uint.
+type PairUintUint struct {
+	First  uint
+	Second uint
+}
+
+// ZipPairsUint takes two inputs: first list of type: []uint, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint(list1 []uint, list2 []uint) []PairUintUint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintUint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintUint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint
+func UnzipUint(pairs []PairUintUint) ([]uint, []uint) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintUint64 holds two values: First of type uint and Second of type uint64.
+type PairUintUint64 struct {
+	First  uint
+	Second uint64
+}
+
+// ZipPairsUintUint64 takes two inputs: first list of type: []uint, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintUint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintUint64(list1 []uint, list2 []uint64) []PairUintUint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintUint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintUint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintUint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintUint64
+func UnzipUintUint64(pairs []PairUintUint64) ([]uint, []uint64) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintUint32 holds two values: First of type uint and Second of type uint32.
+type PairUintUint32 struct {
+	First  uint
+	Second uint32
+}
+
+// ZipPairsUintUint32 takes two inputs: first list of type: []uint, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintUint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintUint32(list1 []uint, list2 []uint32) []PairUintUint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintUint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintUint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintUint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintUint32
+func UnzipUintUint32(pairs []PairUintUint32) ([]uint, []uint32) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintUint16 holds two values: First of type uint and Second of type uint16.
+type PairUintUint16 struct {
+	First  uint
+	Second uint16
+}
+
+// ZipPairsUintUint16 takes two inputs: first list of type: []uint, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintUint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintUint16(list1 []uint, list2 []uint16) []PairUintUint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintUint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintUint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintUint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintUint16
+func UnzipUintUint16(pairs []PairUintUint16) ([]uint, []uint16) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintUint8 holds two values: First of type uint and Second of type uint8.
+type PairUintUint8 struct {
+	First  uint
+	Second uint8
+}
+
+// ZipPairsUintUint8 takes two inputs: first list of type: []uint, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintUint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintUint8(list1 []uint, list2 []uint8) []PairUintUint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintUint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintUint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintUint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintUint8
+func UnzipUintUint8(pairs []PairUintUint8) ([]uint, []uint8) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintStr holds two values: First of type uint and Second of type string.
+type PairUintStr struct {
+	First  uint
+	Second string
+}
+
+// ZipPairsUintStr takes two inputs: first list of type: []uint, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintStr(list1 []uint, list2 []string) []PairUintStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintStr{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintStr
+func UnzipUintStr(pairs []PairUintStr) ([]uint, []string) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUintBool holds two values: First of type uint and Second of type bool.
+type PairUintBool struct {
+	First  uint
+	Second bool
+}
+
+// ZipPairsUintBool takes two inputs: first list of type: []uint, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUintBool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUintBool(list1 []uint, list2 []bool) []PairUintBool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUintBool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUintBool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUintBool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUintBool
+func UnzipUintBool(pairs []PairUintBool) ([]uint, []bool) {
+	list1 := make([]uint, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Int holds two values: First of type uint64 and Second of type int.
+type PairUint64Int struct {
+	First  uint64
+	Second int
+}
+
+// ZipPairsUint64Int takes two inputs: first list of type: []uint64, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Int(list1 []uint64, list2 []int) []PairUint64Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Int
+func UnzipUint64Int(pairs []PairUint64Int) ([]uint64, []int) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Int64 holds two values: First of type uint64 and Second of type int64.
+type PairUint64Int64 struct {
+	First  uint64
+	Second int64
+}
+
+// ZipPairsUint64Int64 takes two inputs: first list of type: []uint64, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Int64(list1 []uint64, list2 []int64) []PairUint64Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Int64
+func UnzipUint64Int64(pairs []PairUint64Int64) ([]uint64, []int64) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Int32 holds two values: First of type uint64 and Second of type int32.
+type PairUint64Int32 struct {
+	First  uint64
+	Second int32
+}
+
+// ZipPairsUint64Int32 takes two inputs: first list of type: []uint64, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Int32(list1 []uint64, list2 []int32) []PairUint64Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Int32
+func UnzipUint64Int32(pairs []PairUint64Int32) ([]uint64, []int32) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Int16 holds two values: First of type uint64 and Second of type int16.
+type PairUint64Int16 struct {
+	First  uint64
+	Second int16
+}
+
+// ZipPairsUint64Int16 takes two inputs: first list of type: []uint64, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Int16(list1 []uint64, list2 []int16) []PairUint64Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Int16
+func UnzipUint64Int16(pairs []PairUint64Int16) ([]uint64, []int16) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Int8 holds two values: First of type uint64 and Second of type int8.
+type PairUint64Int8 struct {
+	First  uint64
+	Second int8
+}
+
+// ZipPairsUint64Int8 takes two inputs: first list of type: []uint64, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Int8(list1 []uint64, list2 []int8) []PairUint64Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Int8
+func UnzipUint64Int8(pairs []PairUint64Int8) ([]uint64, []int8) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Uint holds two values: First of type uint64 and Second of type uint.
+type PairUint64Uint struct {
+	First  uint64
+	Second uint
+}
+
+// ZipPairsUint64Uint takes two inputs: first list of type: []uint64, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Uint(list1 []uint64, list2 []uint) []PairUint64Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Uint
+func UnzipUint64Uint(pairs []PairUint64Uint) ([]uint64, []uint) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Uint64 holds two values: First of type uint64 and Second of type uint64.
+type PairUint64Uint64 struct {
+	First  uint64
+	Second uint64
+}
+
+// ZipPairsUint64 takes two inputs: first list of type: []uint64, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64(list1 []uint64, list2 []uint64) []PairUint64Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64
+func UnzipUint64(pairs []PairUint64Uint64) ([]uint64, []uint64) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Uint32 holds two values: First of type uint64 and Second of type uint32.
+type PairUint64Uint32 struct {
+	First  uint64
+	Second uint32
+}
+
+// ZipPairsUint64Uint32 takes two inputs: first list of type: []uint64, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Uint32(list1 []uint64, list2 []uint32) []PairUint64Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Uint32
+func UnzipUint64Uint32(pairs []PairUint64Uint32) ([]uint64, []uint32) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Uint16 holds two values: First of type uint64 and Second of type uint16.
+type PairUint64Uint16 struct {
+	First  uint64
+	Second uint16
+}
+
+// ZipPairsUint64Uint16 takes two inputs: first list of type: []uint64, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Uint16(list1 []uint64, list2 []uint16) []PairUint64Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Uint16
+func UnzipUint64Uint16(pairs []PairUint64Uint16) ([]uint64, []uint16) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Uint8 holds two values: First of type uint64 and Second of type uint8.
+type PairUint64Uint8 struct {
+	First  uint64
+	Second uint8
+}
+
+// ZipPairsUint64Uint8 takes two inputs: first list of type: []uint64, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Uint8(list1 []uint64, list2 []uint8) []PairUint64Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Uint8
+func UnzipUint64Uint8(pairs []PairUint64Uint8) ([]uint64, []uint8) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Str holds two values: First of type uint64 and Second of type string.
+type PairUint64Str struct {
+	First  uint64
+	Second string
+}
+
+// ZipPairsUint64Str takes two inputs: first list of type: []uint64, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Str(list1 []uint64, list2 []string) []PairUint64Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Str
+func UnzipUint64Str(pairs []PairUint64Str) ([]uint64, []string) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint64Bool holds two values: First of type uint64 and Second of type bool.
+type PairUint64Bool struct {
+	First  uint64
+	Second bool
+}
+
+// ZipPairsUint64Bool takes two inputs: first list of type: []uint64, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint64Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint64Bool(list1 []uint64, list2 []bool) []PairUint64Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint64Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint64Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint64Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint64Bool
+func UnzipUint64Bool(pairs []PairUint64Bool) ([]uint64, []bool) {
+	list1 := make([]uint64, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Int holds two values: First of type uint32 and Second of type int.
+type PairUint32Int struct {
+	First  uint32
+	Second int
+}
+
+// ZipPairsUint32Int takes two inputs: first list of type: []uint32, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Int(list1 []uint32, list2 []int) []PairUint32Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Int
+func UnzipUint32Int(pairs []PairUint32Int) ([]uint32, []int) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Int64 holds two values: First of type uint32 and Second of type int64.
+type PairUint32Int64 struct {
+	First  uint32
+	Second int64
+}
+
+// ZipPairsUint32Int64 takes two inputs: first list of type: []uint32, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Int64(list1 []uint32, list2 []int64) []PairUint32Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Int64
+func UnzipUint32Int64(pairs []PairUint32Int64) ([]uint32, []int64) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Int32 holds two values: First of type uint32 and Second of type int32.
+type PairUint32Int32 struct {
+	First  uint32
+	Second int32
+}
+
+// ZipPairsUint32Int32 takes two inputs: first list of type: []uint32, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Int32(list1 []uint32, list2 []int32) []PairUint32Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Int32
+func UnzipUint32Int32(pairs []PairUint32Int32) ([]uint32, []int32) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Int16 holds two values: First of type uint32 and Second of type int16.
+type PairUint32Int16 struct {
+	First  uint32
+	Second int16
+}
+
+// ZipPairsUint32Int16 takes two inputs: first list of type: []uint32, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Int16(list1 []uint32, list2 []int16) []PairUint32Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Int16
+func UnzipUint32Int16(pairs []PairUint32Int16) ([]uint32, []int16) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Int8 holds two values: First of type uint32 and Second of type int8.
+type PairUint32Int8 struct {
+	First  uint32
+	Second int8
+}
+
+// ZipPairsUint32Int8 takes two inputs: first list of type: []uint32, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Int8(list1 []uint32, list2 []int8) []PairUint32Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Int8
+func UnzipUint32Int8(pairs []PairUint32Int8) ([]uint32, []int8) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Uint holds two values: First of type uint32 and Second of type uint.
+type PairUint32Uint struct {
+	First  uint32
+	Second uint
+}
+
+// ZipPairsUint32Uint takes two inputs: first list of type: []uint32, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Uint(list1 []uint32, list2 []uint) []PairUint32Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Uint
+func UnzipUint32Uint(pairs []PairUint32Uint) ([]uint32, []uint) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Uint64 holds two values: First of type uint32 and Second of type uint64.
+type PairUint32Uint64 struct {
+	First  uint32
+	Second uint64
+}
+
+// ZipPairsUint32Uint64 takes two inputs: first list of type: []uint32, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Uint64(list1 []uint32, list2 []uint64) []PairUint32Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Uint64
+func UnzipUint32Uint64(pairs []PairUint32Uint64) ([]uint32, []uint64) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Uint32 holds two values: First of type uint32 and Second of type uint32.
+type PairUint32Uint32 struct {
+	First  uint32
+	Second uint32
+}
+
+// ZipPairsUint32 takes two inputs: first list of type: []uint32, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32(list1 []uint32, list2 []uint32) []PairUint32Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32
+func UnzipUint32(pairs []PairUint32Uint32) ([]uint32, []uint32) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Uint16 holds two values: First of type uint32 and Second of type uint16.
+type PairUint32Uint16 struct {
+	First  uint32
+	Second uint16
+}
+
+// ZipPairsUint32Uint16 takes two inputs: first list of type: []uint32, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Uint16(list1 []uint32, list2 []uint16) []PairUint32Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Uint16
+func UnzipUint32Uint16(pairs []PairUint32Uint16) ([]uint32, []uint16) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Uint8 holds two values: First of type uint32 and Second of type uint8.
+type PairUint32Uint8 struct {
+	First  uint32
+	Second uint8
+}
+
+// ZipPairsUint32Uint8 takes two inputs: first list of type: []uint32, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Uint8(list1 []uint32, list2 []uint8) []PairUint32Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Uint8
+func UnzipUint32Uint8(pairs []PairUint32Uint8) ([]uint32, []uint8) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Str holds two values: First of type uint32 and Second of type string.
+type PairUint32Str struct {
+	First  uint32
+	Second string
+}
+
+// ZipPairsUint32Str takes two inputs: first list of type: []uint32, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Str(list1 []uint32, list2 []string) []PairUint32Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Str
+func UnzipUint32Str(pairs []PairUint32Str) ([]uint32, []string) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint32Bool holds two values: First of type uint32 and Second of type bool.
+type PairUint32Bool struct {
+	First  uint32
+	Second bool
+}
+
+// ZipPairsUint32Bool takes two inputs: first list of type: []uint32, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint32Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint32Bool(list1 []uint32, list2 []bool) []PairUint32Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint32Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint32Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint32Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint32Bool
+func UnzipUint32Bool(pairs []PairUint32Bool) ([]uint32, []bool) {
+	list1 := make([]uint32, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Int holds two values: First of type uint16 and Second of type int.
+type PairUint16Int struct {
+	First  uint16
+	Second int
+}
+
+// ZipPairsUint16Int takes two inputs: first list of type: []uint16, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Int(list1 []uint16, list2 []int) []PairUint16Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Int
+func UnzipUint16Int(pairs []PairUint16Int) ([]uint16, []int) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Int64 holds two values: First of type uint16 and Second of type int64.
+type PairUint16Int64 struct {
+	First  uint16
+	Second int64
+}
+
+// ZipPairsUint16Int64 takes two inputs: first list of type: []uint16, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Int64(list1 []uint16, list2 []int64) []PairUint16Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Int64
+func UnzipUint16Int64(pairs []PairUint16Int64) ([]uint16, []int64) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Int32 holds two values: First of type uint16 and Second of type int32.
+type PairUint16Int32 struct {
+	First  uint16
+	Second int32
+}
+
+// ZipPairsUint16Int32 takes two inputs: first list of type: []uint16, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Int32(list1 []uint16, list2 []int32) []PairUint16Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Int32
+func UnzipUint16Int32(pairs []PairUint16Int32) ([]uint16, []int32) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Int16 holds two values: First of type uint16 and Second of type int16.
+type PairUint16Int16 struct {
+	First  uint16
+	Second int16
+}
+
+// ZipPairsUint16Int16 takes two inputs: first list of type: []uint16, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Int16(list1 []uint16, list2 []int16) []PairUint16Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Int16
+func UnzipUint16Int16(pairs []PairUint16Int16) ([]uint16, []int16) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Int8 holds two values: First of type uint16 and Second of type int8.
+type PairUint16Int8 struct {
+	First  uint16
+	Second int8
+}
+
+// ZipPairsUint16Int8 takes two inputs: first list of type: []uint16, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Int8(list1 []uint16, list2 []int8) []PairUint16Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Int8
+func UnzipUint16Int8(pairs []PairUint16Int8) ([]uint16, []int8) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Uint holds two values: First of type uint16 and Second of type uint.
+type PairUint16Uint struct {
+	First  uint16
+	Second uint
+}
+
+// ZipPairsUint16Uint takes two inputs: first list of type: []uint16, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Uint(list1 []uint16, list2 []uint) []PairUint16Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Uint
+func UnzipUint16Uint(pairs []PairUint16Uint) ([]uint16, []uint) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Uint64 holds two values: First of type uint16 and Second of type uint64.
+type PairUint16Uint64 struct {
+	First  uint16
+	Second uint64
+}
+
+// ZipPairsUint16Uint64 takes two inputs: first list of type: []uint16, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Uint64(list1 []uint16, list2 []uint64) []PairUint16Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Uint64
+func UnzipUint16Uint64(pairs []PairUint16Uint64) ([]uint16, []uint64) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Uint32 holds two values: First of type uint16 and Second of type uint32.
+type PairUint16Uint32 struct {
+	First  uint16
+	Second uint32
+}
+
+// ZipPairsUint16Uint32 takes two inputs: first list of type: []uint16, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Uint32(list1 []uint16, list2 []uint32) []PairUint16Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Uint32
+func UnzipUint16Uint32(pairs []PairUint16Uint32) ([]uint16, []uint32) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Uint16 holds two values: First of type uint16 and Second of type uint16.
+type PairUint16Uint16 struct {
+	First  uint16
+	Second uint16
+}
+
+// ZipPairsUint16 takes two inputs: first list of type: []uint16, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16(list1 []uint16, list2 []uint16) []PairUint16Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16
+func UnzipUint16(pairs []PairUint16Uint16) ([]uint16, []uint16) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Uint8 holds two values: First of type uint16 and Second of type uint8.
+type PairUint16Uint8 struct {
+	First  uint16
+	Second uint8
+}
+
+// ZipPairsUint16Uint8 takes two inputs: first list of type: []uint16, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Uint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Uint8(list1 []uint16, list2 []uint8) []PairUint16Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Uint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Uint8
+func UnzipUint16Uint8(pairs []PairUint16Uint8) ([]uint16, []uint8) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Str holds two values: First of type uint16 and Second of type string.
+type PairUint16Str struct {
+	First  uint16
+	Second string
+}
+
+// ZipPairsUint16Str takes two inputs: first list of type: []uint16, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Str(list1 []uint16, list2 []string) []PairUint16Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Str
+func UnzipUint16Str(pairs []PairUint16Str) ([]uint16, []string) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint16Bool holds two values: First of type uint16 and Second of type bool.
+type PairUint16Bool struct {
+	First  uint16
+	Second bool
+}
+
+// ZipPairsUint16Bool takes two inputs: first list of type: []uint16, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint16Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint16Bool(list1 []uint16, list2 []bool) []PairUint16Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint16Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint16Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint16Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint16Bool
+func UnzipUint16Bool(pairs []PairUint16Bool) ([]uint16, []bool) {
+	list1 := make([]uint16, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Int holds two values: First of type uint8 and Second of type int.
+type PairUint8Int struct {
+	First  uint8
+	Second int
+}
+
+// ZipPairsUint8Int takes two inputs: first list of type: []uint8, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Int, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Int(list1 []uint8, list2 []int) []PairUint8Int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Int{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Int takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Int
+func UnzipUint8Int(pairs []PairUint8Int) ([]uint8, []int) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Int64 holds two values: First of type uint8 and Second of type int64.
+type PairUint8Int64 struct {
+	First  uint8
+	Second int64
+}
+
+// ZipPairsUint8Int64 takes two inputs: first list of type: []uint8, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Int64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Int64(list1 []uint8, list2 []int64) []PairUint8Int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Int64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Int64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Int64
+func UnzipUint8Int64(pairs []PairUint8Int64) ([]uint8, []int64) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Int32 holds two values: First of type uint8 and Second of type int32.
+type PairUint8Int32 struct {
+	First  uint8
+	Second int32
+}
+
+// ZipPairsUint8Int32 takes two inputs: first list of type: []uint8, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Int32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Int32(list1 []uint8, list2 []int32) []PairUint8Int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Int32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Int32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Int32
+func UnzipUint8Int32(pairs []PairUint8Int32) ([]uint8, []int32) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Int16 holds two values: First of type uint8 and Second of type int16.
+type PairUint8Int16 struct {
+	First  uint8
+	Second int16
+}
+
+// ZipPairsUint8Int16 takes two inputs: first list of type: []uint8, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Int16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Int16(list1 []uint8, list2 []int16) []PairUint8Int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Int16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Int16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Int16
+func UnzipUint8Int16(pairs []PairUint8Int16) ([]uint8, []int16) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Int8 holds two values: First of type uint8 and Second of type int8.
+type PairUint8Int8 struct {
+	First  uint8
+	Second int8
+}
+
+// ZipPairsUint8Int8 takes two inputs: first list of type: []uint8, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Int8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Int8(list1 []uint8, list2 []int8) []PairUint8Int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Int8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Int8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Int8
+func UnzipUint8Int8(pairs []PairUint8Int8) ([]uint8, []int8) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Uint holds two values: First of type uint8 and Second of type uint.
+type PairUint8Uint struct {
+	First  uint8
+	Second uint
+}
+
+// ZipPairsUint8Uint takes two inputs: first list of type: []uint8, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Uint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Uint(list1 []uint8, list2 []uint) []PairUint8Uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Uint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Uint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Uint
+func UnzipUint8Uint(pairs []PairUint8Uint) ([]uint8, []uint) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Uint64 holds two values: First of type uint8 and Second of type uint64.
+type PairUint8Uint64 struct {
+	First  uint8
+	Second uint64
+}
+
+// ZipPairsUint8Uint64 takes two inputs: first list of type: []uint8, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Uint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Uint64(list1 []uint8, list2 []uint64) []PairUint8Uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Uint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Uint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Uint64
+func UnzipUint8Uint64(pairs []PairUint8Uint64) ([]uint8, []uint64) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Uint32 holds two values: First of type uint8 and Second of type uint32.
+type PairUint8Uint32 struct {
+	First  uint8
+	Second uint32
+}
+
+// ZipPairsUint8Uint32 takes two inputs: first list of type: []uint8, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Uint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Uint32(list1 []uint8, list2 []uint32) []PairUint8Uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Uint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Uint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Uint32
+func UnzipUint8Uint32(pairs []PairUint8Uint32) ([]uint8, []uint32) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Uint16 holds two values: First of type uint8 and Second of type uint16.
+type PairUint8Uint16 struct {
+	First  uint8
+	Second uint16
+}
+
+// ZipPairsUint8Uint16 takes two inputs: first list of type: []uint8, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Uint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Uint16(list1 []uint8, list2 []uint16) []PairUint8Uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Uint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Uint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Uint16
+func UnzipUint8Uint16(pairs []PairUint8Uint16) ([]uint8, []uint16) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Uint8 holds two values: First of type uint8 and Second of type uint8.
+type PairUint8Uint8 struct {
+	First  uint8
+	Second uint8
+}
+
+// ZipPairsUint8 takes two inputs: first list of type: []uint8, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8(list1 []uint8, list2 []uint8) []PairUint8Uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Uint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8
+func UnzipUint8(pairs []PairUint8Uint8) ([]uint8, []uint8) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Str holds two values: First of type uint8 and Second of type string.
+type PairUint8Str struct {
+	First  uint8
+	Second string
+}
+
+// ZipPairsUint8Str takes two inputs: first list of type: []uint8, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Str, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Str(list1 []uint8, list2 []string) []PairUint8Str {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Str, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Str{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Str takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Str
+func UnzipUint8Str(pairs []PairUint8Str) ([]uint8, []string) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairUint8Bool holds two values: First of type uint8 and Second of type bool.
+type PairUint8Bool struct {
+	First  uint8
+	Second bool
+}
+
+// ZipPairsUint8Bool takes two inputs: first list of type: []uint8, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipUint8Bool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsUint8Bool(list1 []uint8, list2 []bool) []PairUint8Bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairUint8Bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairUint8Bool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipUint8Bool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsUint8Bool
+func UnzipUint8Bool(pairs []PairUint8Bool) ([]uint8, []bool) {
+	list1 := make([]uint8, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrInt holds two values: First of type string and Second of type int.
+type PairStrInt struct {
+	First  string
+	Second int
+}
+
+// ZipPairsStrInt takes two inputs: first list of type: []string, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrInt(list1 []string, list2 []int) []PairStrInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrInt
+func UnzipStrInt(pairs []PairStrInt) ([]string, []int) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrInt64 holds two values: First of type string and Second of type int64.
+type PairStrInt64 struct {
+	First  string
+	Second int64
+}
+
+// ZipPairsStrInt64 takes two inputs: first list of type: []string, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrInt64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrInt64(list1 []string, list2 []int64) []PairStrInt64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrInt64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrInt64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrInt64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrInt64
+func UnzipStrInt64(pairs []PairStrInt64) ([]string, []int64) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrInt32 holds two values: First of type string and Second of type int32.
+type PairStrInt32 struct {
+	First  string
+	Second int32
+}
+
+// ZipPairsStrInt32 takes two inputs: first list of type: []string, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrInt32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrInt32(list1 []string, list2 []int32) []PairStrInt32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrInt32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrInt32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrInt32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrInt32
+func UnzipStrInt32(pairs []PairStrInt32) ([]string, []int32) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrInt16 holds two values: First of type string and Second of type int16.
+type PairStrInt16 struct {
+	First  string
+	Second int16
+}
+
+// ZipPairsStrInt16 takes two inputs: first list of type: []string, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrInt16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrInt16(list1 []string, list2 []int16) []PairStrInt16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrInt16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrInt16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrInt16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrInt16
+func UnzipStrInt16(pairs []PairStrInt16) ([]string, []int16) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrInt8 holds two values: First of type string and Second of type int8.
+type PairStrInt8 struct {
+	First  string
+	Second int8
+}
+
+// ZipPairsStrInt8 takes two inputs: first list of type: []string, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrInt8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrInt8(list1 []string, list2 []int8) []PairStrInt8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrInt8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrInt8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrInt8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrInt8
+func UnzipStrInt8(pairs []PairStrInt8) ([]string, []int8) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrUint holds two values: First of type string and Second of type uint.
+type PairStrUint struct {
+	First  string
+	Second uint
+}
+
+// ZipPairsStrUint takes two inputs: first list of type: []string, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrUint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrUint(list1 []string, list2 []uint) []PairStrUint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrUint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrUint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrUint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrUint
+func UnzipStrUint(pairs []PairStrUint) ([]string, []uint) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrUint64 holds two values: First of type string and Second of type uint64.
+type PairStrUint64 struct {
+	First  string
+	Second uint64
+}
+
+// ZipPairsStrUint64 takes two inputs: first list of type: []string, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrUint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrUint64(list1 []string, list2 []uint64) []PairStrUint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrUint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrUint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrUint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrUint64
+func UnzipStrUint64(pairs []PairStrUint64) ([]string, []uint64) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrUint32 holds two values: First of type string and Second of type uint32.
+type PairStrUint32 struct {
+	First  string
+	Second uint32
+}
+
+// ZipPairsStrUint32 takes two inputs: first list of type: []string, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrUint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrUint32(list1 []string, list2 []uint32) []PairStrUint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrUint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrUint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrUint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrUint32
+func UnzipStrUint32(pairs []PairStrUint32) ([]string, []uint32) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrUint16 holds two values: First of type string and Second of type uint16.
+type PairStrUint16 struct {
+	First  string
+	Second uint16
+}
+
+// ZipPairsStrUint16 takes two inputs: first list of type: []string, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrUint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrUint16(list1 []string, list2 []uint16) []PairStrUint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrUint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrUint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrUint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrUint16
+func UnzipStrUint16(pairs []PairStrUint16) ([]string, []uint16) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrUint8 holds two values: First of type string and Second of type uint8.
+type PairStrUint8 struct {
+	First  string
+	Second uint8
+}
+
+// ZipPairsStrUint8 takes two inputs: first list of type: []string, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrUint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrUint8(list1 []string, list2 []uint8) []PairStrUint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrUint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrUint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrUint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrUint8
+func UnzipStrUint8(pairs []PairStrUint8) ([]string, []uint8) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrStr holds two values: First of type string and Second of type string.
+type PairStrStr struct {
+	First  string
+	Second string
+}
+
+// ZipPairsStr takes two inputs: first list of type: []string, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStr(list1 []string, list2 []string) []PairStrStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrStr{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStr
+func UnzipStr(pairs []PairStrStr) ([]string, []string) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairStrBool holds two values: First of type string and Second of type bool.
+type PairStrBool struct {
+	First  string
+	Second bool
+}
+
+// ZipPairsStrBool takes two inputs: first list of type: []string, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrBool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrBool(list1 []string, list2 []bool) []PairStrBool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairStrBool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrBool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipStrBool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrBool
+func UnzipStrBool(pairs []PairStrBool) ([]string, []bool) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolInt holds two values: First of type bool and Second of type int.
+type PairBoolInt struct {
+	First  bool
+	Second int
+}
+
+// ZipPairsBoolInt takes two inputs: first list of type: []bool, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolInt(list1 []bool, list2 []int) []PairBoolInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolInt
+func UnzipBoolInt(pairs []PairBoolInt) ([]bool, []int) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolInt64 holds two values: First of type bool and Second of type int64.
+type PairBoolInt64 struct {
+	First  bool
+	Second int64
+}
+
+// ZipPairsBoolInt64 takes two inputs: first list of type: []bool, second list of type: []int64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolInt64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolInt64(list1 []bool, list2 []int64) []PairBoolInt64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolInt64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolInt64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolInt64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolInt64
+func UnzipBoolInt64(pairs []PairBoolInt64) ([]bool, []int64) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]int64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolInt32 holds two values: First of type bool and Second of type int32.
+type PairBoolInt32 struct {
+	First  bool
+	Second int32
+}
+
+// ZipPairsBoolInt32 takes two inputs: first list of type: []bool, second list of type: []int32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolInt32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolInt32(list1 []bool, list2 []int32) []PairBoolInt32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolInt32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolInt32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolInt32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolInt32
+func UnzipBoolInt32(pairs []PairBoolInt32) ([]bool, []int32) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]int32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolInt16 holds two values: First of type bool and Second of type int16.
+type PairBoolInt16 struct {
+	First  bool
+	Second int16
+}
+
+// ZipPairsBoolInt16 takes two inputs: first list of type: []bool, second list of type: []int16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolInt16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolInt16(list1 []bool, list2 []int16) []PairBoolInt16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolInt16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolInt16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolInt16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolInt16
+func UnzipBoolInt16(pairs []PairBoolInt16) ([]bool, []int16) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]int16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolInt8 holds two values: First of type bool and Second of type int8.
+type PairBoolInt8 struct {
+	First  bool
+	Second int8
+}
+
+// ZipPairsBoolInt8 takes two inputs: first list of type: []bool, second list of type: []int8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolInt8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolInt8(list1 []bool, list2 []int8) []PairBoolInt8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolInt8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolInt8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolInt8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolInt8
+func UnzipBoolInt8(pairs []PairBoolInt8) ([]bool, []int8) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]int8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolUint holds two values: First of type bool and Second of type uint.
+type PairBoolUint struct {
+	First  bool
+	Second uint
+}
+
+// ZipPairsBoolUint takes two inputs: first list of type: []bool, second list of type: []uint.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolUint, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolUint(list1 []bool, list2 []uint) []PairBoolUint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolUint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolUint{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolUint takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolUint
+func UnzipBoolUint(pairs []PairBoolUint) ([]bool, []uint) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]uint, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolUint64 holds two values: First of type bool and Second of type uint64.
+type PairBoolUint64 struct {
+	First  bool
+	Second uint64
+}
+
+// ZipPairsBoolUint64 takes two inputs: first list of type: []bool, second list of type: []uint64.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolUint64, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolUint64(list1 []bool, list2 []uint64) []PairBoolUint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolUint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolUint64{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolUint64 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolUint64
+func UnzipBoolUint64(pairs []PairBoolUint64) ([]bool, []uint64) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]uint64, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolUint32 holds two values: First of type bool and Second of type uint32.
+type PairBoolUint32 struct {
+	First  bool
+	Second uint32
+}
+
+// ZipPairsBoolUint32 takes two inputs: first list of type: []bool, second list of type: []uint32.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolUint32, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolUint32(list1 []bool, list2 []uint32) []PairBoolUint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolUint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolUint32{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolUint32 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolUint32
+func UnzipBoolUint32(pairs []PairBoolUint32) ([]bool, []uint32) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]uint32, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolUint16 holds two values: First of type bool and Second of type uint16.
+type PairBoolUint16 struct {
+	First  bool
+	Second uint16
+}
+
+// ZipPairsBoolUint16 takes two inputs: first list of type: []bool, second list of type: []uint16.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolUint16, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolUint16(list1 []bool, list2 []uint16) []PairBoolUint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolUint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolUint16{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolUint16 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolUint16
+func UnzipBoolUint16(pairs []PairBoolUint16) ([]bool, []uint16) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]uint16, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolUint8 holds two values: First of type bool and Second of type uint8.
+type PairBoolUint8 struct {
+	First  bool
+	Second uint8
+}
+
+// ZipPairsBoolUint8 takes two inputs: first list of type: []bool, second list of type: []uint8.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolUint8, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolUint8(list1 []bool, list2 []uint8) []PairBoolUint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolUint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolUint8{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolUint8 takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolUint8
+func UnzipBoolUint8(pairs []PairBoolUint8) ([]bool, []uint8) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]uint8, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolStr holds two values: First of type bool and Second of type string.
+type PairBoolStr struct {
+	First  bool
+	Second string
+}
+
+// ZipPairsBoolStr takes two inputs: first list of type: []bool, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBoolStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBoolStr(list1 []bool, list2 []string) []PairBoolStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolStr{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBoolStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBoolStr
+func UnzipBoolStr(pairs []PairBoolStr) ([]bool, []string) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// PairBoolBool holds two values: First of type bool and Second of type bool.
+type PairBoolBool struct {
+	First  bool
+	Second bool
+}
+
+// ZipPairsBool takes two inputs: first list of type: []bool, second list of type: []bool.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipBool, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsBool(list1 []bool, list2 []bool) []PairBoolBool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairBoolBool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairBoolBool{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipBool takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsBool
+func UnzipBool(pairs []PairBoolBool) ([]bool, []bool) {
+	list1 := make([]bool, len(pairs))
+	list2 := make([]bool, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
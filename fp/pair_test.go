@@ -0,0 +1,3174 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZipPairsInt(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairIntInt{{1, 10}, {1, 20}}
+	actual := ZipPairsInt(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntInt{}
+	actual = ZipPairsInt(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntInt64(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairIntInt64{{1, 10}, {1, 20}}
+	actual := ZipPairsIntInt64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntInt64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntInt64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntInt64{}
+	actual = ZipPairsIntInt64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntInt32(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairIntInt32{{1, 10}, {1, 20}}
+	actual := ZipPairsIntInt32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntInt32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntInt32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntInt32{}
+	actual = ZipPairsIntInt32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntInt16(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairIntInt16{{1, 10}, {1, 20}}
+	actual := ZipPairsIntInt16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntInt16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntInt16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntInt16{}
+	actual = ZipPairsIntInt16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntInt8(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairIntInt8{{1, 10}, {1, 20}}
+	actual := ZipPairsIntInt8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntInt8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntInt8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntInt8{}
+	actual = ZipPairsIntInt8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntUint(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairIntUint{{1, 10}, {1, 20}}
+	actual := ZipPairsIntUint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntUint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntUint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntUint{}
+	actual = ZipPairsIntUint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntUint64(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairIntUint64{{1, 10}, {1, 20}}
+	actual := ZipPairsIntUint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntUint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntUint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntUint64{}
+	actual = ZipPairsIntUint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntUint32(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairIntUint32{{1, 10}, {1, 20}}
+	actual := ZipPairsIntUint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntUint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntUint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntUint32{}
+	actual = ZipPairsIntUint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntUint16(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairIntUint16{{1, 10}, {1, 20}}
+	actual := ZipPairsIntUint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntUint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntUint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntUint16{}
+	actual = ZipPairsIntUint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntUint8(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairIntUint8{{1, 10}, {1, 20}}
+	actual := ZipPairsIntUint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntUint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntUint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntUint8{}
+	actual = ZipPairsIntUint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntStr(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairIntStr{{1, "x"}, {1, "y"}}
+	actual := ZipPairsIntStr(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntStr(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntStr failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntStr{}
+	actual = ZipPairsIntStr(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsIntBool(t *testing.T) {
+	list1 := []int{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairIntBool{{1, false}, {1, true}}
+	actual := ZipPairsIntBool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipIntBool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipIntBool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairIntBool{}
+	actual = ZipPairsIntBool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Int(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairInt64Int{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Int{}
+	actual = ZipPairsInt64Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairInt64Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Int64{}
+	actual = ZipPairsInt64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Int32(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairInt64Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Int32{}
+	actual = ZipPairsInt64Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Int16(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairInt64Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Int16{}
+	actual = ZipPairsInt64Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Int8(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairInt64Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Int8{}
+	actual = ZipPairsInt64Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Uint(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairInt64Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Uint{}
+	actual = ZipPairsInt64Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Uint64(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairInt64Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Uint64{}
+	actual = ZipPairsInt64Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Uint32(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairInt64Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Uint32{}
+	actual = ZipPairsInt64Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Uint16(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairInt64Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Uint16{}
+	actual = ZipPairsInt64Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Uint8(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairInt64Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt64Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Uint8{}
+	actual = ZipPairsInt64Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Str(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairInt64Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsInt64Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Str{}
+	actual = ZipPairsInt64Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt64Bool(t *testing.T) {
+	list1 := []int64{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairInt64Bool{{1, false}, {1, true}}
+	actual := ZipPairsInt64Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt64Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt64Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt64Bool{}
+	actual = ZipPairsInt64Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Int(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairInt32Int{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Int{}
+	actual = ZipPairsInt32Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Int64(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairInt32Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Int64{}
+	actual = ZipPairsInt32Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairInt32Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Int32{}
+	actual = ZipPairsInt32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Int16(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairInt32Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Int16{}
+	actual = ZipPairsInt32Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Int8(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairInt32Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Int8{}
+	actual = ZipPairsInt32Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Uint(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairInt32Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Uint{}
+	actual = ZipPairsInt32Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Uint64(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairInt32Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Uint64{}
+	actual = ZipPairsInt32Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Uint32(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairInt32Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Uint32{}
+	actual = ZipPairsInt32Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Uint16(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairInt32Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Uint16{}
+	actual = ZipPairsInt32Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Uint8(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairInt32Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt32Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Uint8{}
+	actual = ZipPairsInt32Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Str(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairInt32Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsInt32Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Str{}
+	actual = ZipPairsInt32Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt32Bool(t *testing.T) {
+	list1 := []int32{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairInt32Bool{{1, false}, {1, true}}
+	actual := ZipPairsInt32Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt32Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt32Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt32Bool{}
+	actual = ZipPairsInt32Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Int(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairInt16Int{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Int{}
+	actual = ZipPairsInt16Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Int64(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairInt16Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Int64{}
+	actual = ZipPairsInt16Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Int32(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairInt16Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Int32{}
+	actual = ZipPairsInt16Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairInt16Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Int16{}
+	actual = ZipPairsInt16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Int8(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairInt16Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Int8{}
+	actual = ZipPairsInt16Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Uint(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairInt16Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Uint{}
+	actual = ZipPairsInt16Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Uint64(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairInt16Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Uint64{}
+	actual = ZipPairsInt16Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Uint32(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairInt16Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Uint32{}
+	actual = ZipPairsInt16Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Uint16(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairInt16Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Uint16{}
+	actual = ZipPairsInt16Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Uint8(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairInt16Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt16Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Uint8{}
+	actual = ZipPairsInt16Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Str(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairInt16Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsInt16Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Str{}
+	actual = ZipPairsInt16Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt16Bool(t *testing.T) {
+	list1 := []int16{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairInt16Bool{{1, false}, {1, true}}
+	actual := ZipPairsInt16Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt16Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt16Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt16Bool{}
+	actual = ZipPairsInt16Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Int(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairInt8Int{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Int{}
+	actual = ZipPairsInt8Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Int64(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairInt8Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Int64{}
+	actual = ZipPairsInt8Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Int32(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairInt8Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Int32{}
+	actual = ZipPairsInt8Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Int16(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairInt8Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Int16{}
+	actual = ZipPairsInt8Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairInt8Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Int8{}
+	actual = ZipPairsInt8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Uint(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairInt8Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Uint{}
+	actual = ZipPairsInt8Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Uint64(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairInt8Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Uint64{}
+	actual = ZipPairsInt8Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Uint32(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairInt8Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Uint32{}
+	actual = ZipPairsInt8Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Uint16(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairInt8Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Uint16{}
+	actual = ZipPairsInt8Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Uint8(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairInt8Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsInt8Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Uint8{}
+	actual = ZipPairsInt8Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Str(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairInt8Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsInt8Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Str{}
+	actual = ZipPairsInt8Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsInt8Bool(t *testing.T) {
+	list1 := []int8{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairInt8Bool{{1, false}, {1, true}}
+	actual := ZipPairsInt8Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipInt8Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipInt8Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairInt8Bool{}
+	actual = ZipPairsInt8Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsInt8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintInt(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairUintInt{{1, 10}, {1, 20}}
+	actual := ZipPairsUintInt(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintInt(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintInt failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintInt{}
+	actual = ZipPairsUintInt(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintInt64(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairUintInt64{{1, 10}, {1, 20}}
+	actual := ZipPairsUintInt64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintInt64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintInt64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintInt64{}
+	actual = ZipPairsUintInt64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintInt32(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairUintInt32{{1, 10}, {1, 20}}
+	actual := ZipPairsUintInt32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintInt32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintInt32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintInt32{}
+	actual = ZipPairsUintInt32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintInt16(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairUintInt16{{1, 10}, {1, 20}}
+	actual := ZipPairsUintInt16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintInt16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintInt16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintInt16{}
+	actual = ZipPairsUintInt16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintInt8(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairUintInt8{{1, 10}, {1, 20}}
+	actual := ZipPairsUintInt8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintInt8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintInt8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintInt8{}
+	actual = ZipPairsUintInt8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairUintUint{{1, 10}, {1, 20}}
+	actual := ZipPairsUint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintUint{}
+	actual = ZipPairsUint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintUint64(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairUintUint64{{1, 10}, {1, 20}}
+	actual := ZipPairsUintUint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintUint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintUint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintUint64{}
+	actual = ZipPairsUintUint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintUint32(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairUintUint32{{1, 10}, {1, 20}}
+	actual := ZipPairsUintUint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintUint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintUint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintUint32{}
+	actual = ZipPairsUintUint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintUint16(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairUintUint16{{1, 10}, {1, 20}}
+	actual := ZipPairsUintUint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintUint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintUint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintUint16{}
+	actual = ZipPairsUintUint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintUint8(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairUintUint8{{1, 10}, {1, 20}}
+	actual := ZipPairsUintUint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintUint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintUint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintUint8{}
+	actual = ZipPairsUintUint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintStr(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairUintStr{{1, "x"}, {1, "y"}}
+	actual := ZipPairsUintStr(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintStr(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintStr failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintStr{}
+	actual = ZipPairsUintStr(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUintBool(t *testing.T) {
+	list1 := []uint{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairUintBool{{1, false}, {1, true}}
+	actual := ZipPairsUintBool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUintBool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUintBool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUintBool{}
+	actual = ZipPairsUintBool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Int(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairUint64Int{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Int{}
+	actual = ZipPairsUint64Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Int64(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairUint64Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Int64{}
+	actual = ZipPairsUint64Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Int32(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairUint64Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Int32{}
+	actual = ZipPairsUint64Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Int16(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairUint64Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Int16{}
+	actual = ZipPairsUint64Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Int8(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairUint64Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Int8{}
+	actual = ZipPairsUint64Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Uint(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairUint64Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Uint{}
+	actual = ZipPairsUint64Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairUint64Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Uint64{}
+	actual = ZipPairsUint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Uint32(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairUint64Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Uint32{}
+	actual = ZipPairsUint64Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Uint16(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairUint64Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Uint16{}
+	actual = ZipPairsUint64Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Uint8(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairUint64Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint64Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Uint8{}
+	actual = ZipPairsUint64Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Str(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairUint64Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsUint64Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Str{}
+	actual = ZipPairsUint64Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint64Bool(t *testing.T) {
+	list1 := []uint64{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairUint64Bool{{1, false}, {1, true}}
+	actual := ZipPairsUint64Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint64Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint64Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint64Bool{}
+	actual = ZipPairsUint64Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Int(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairUint32Int{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Int{}
+	actual = ZipPairsUint32Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Int64(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairUint32Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Int64{}
+	actual = ZipPairsUint32Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Int32(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairUint32Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Int32{}
+	actual = ZipPairsUint32Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Int16(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairUint32Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Int16{}
+	actual = ZipPairsUint32Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Int8(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairUint32Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Int8{}
+	actual = ZipPairsUint32Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Uint(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairUint32Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Uint{}
+	actual = ZipPairsUint32Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Uint64(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairUint32Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Uint64{}
+	actual = ZipPairsUint32Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairUint32Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Uint32{}
+	actual = ZipPairsUint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Uint16(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairUint32Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Uint16{}
+	actual = ZipPairsUint32Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Uint8(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairUint32Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint32Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Uint8{}
+	actual = ZipPairsUint32Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Str(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairUint32Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsUint32Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Str{}
+	actual = ZipPairsUint32Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint32Bool(t *testing.T) {
+	list1 := []uint32{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairUint32Bool{{1, false}, {1, true}}
+	actual := ZipPairsUint32Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint32Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint32Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint32Bool{}
+	actual = ZipPairsUint32Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Int(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairUint16Int{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Int{}
+	actual = ZipPairsUint16Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Int64(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairUint16Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Int64{}
+	actual = ZipPairsUint16Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Int32(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairUint16Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Int32{}
+	actual = ZipPairsUint16Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Int16(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairUint16Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Int16{}
+	actual = ZipPairsUint16Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Int8(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairUint16Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Int8{}
+	actual = ZipPairsUint16Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Uint(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairUint16Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Uint{}
+	actual = ZipPairsUint16Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Uint64(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairUint16Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Uint64{}
+	actual = ZipPairsUint16Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Uint32(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairUint16Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Uint32{}
+	actual = ZipPairsUint16Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairUint16Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Uint16{}
+	actual = ZipPairsUint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Uint8(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairUint16Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint16Uint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Uint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Uint8{}
+	actual = ZipPairsUint16Uint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Str(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairUint16Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsUint16Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Str{}
+	actual = ZipPairsUint16Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint16Bool(t *testing.T) {
+	list1 := []uint16{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairUint16Bool{{1, false}, {1, true}}
+	actual := ZipPairsUint16Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint16Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint16Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint16Bool{}
+	actual = ZipPairsUint16Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Int(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []int{10, 20}
+
+	expected := []PairUint8Int{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Int(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Int failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Int{}
+	actual = ZipPairsUint8Int(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Int64(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []int64{10, 20}
+
+	expected := []PairUint8Int64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Int64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Int64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Int64{}
+	actual = ZipPairsUint8Int64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Int32(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []int32{10, 20}
+
+	expected := []PairUint8Int32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Int32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Int32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Int32{}
+	actual = ZipPairsUint8Int32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Int16(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []int16{10, 20}
+
+	expected := []PairUint8Int16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Int16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Int16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Int16{}
+	actual = ZipPairsUint8Int16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Int8(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []int8{10, 20}
+
+	expected := []PairUint8Int8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Int8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Int8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Int8{}
+	actual = ZipPairsUint8Int8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Uint(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []uint{10, 20}
+
+	expected := []PairUint8Uint{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Uint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Uint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Uint{}
+	actual = ZipPairsUint8Uint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Uint64(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []uint64{10, 20}
+
+	expected := []PairUint8Uint64{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Uint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Uint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Uint64{}
+	actual = ZipPairsUint8Uint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Uint32(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []uint32{10, 20}
+
+	expected := []PairUint8Uint32{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Uint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Uint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Uint32{}
+	actual = ZipPairsUint8Uint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Uint16(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []uint16{10, 20}
+
+	expected := []PairUint8Uint16{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8Uint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Uint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Uint16{}
+	actual = ZipPairsUint8Uint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []uint8{10, 20}
+
+	expected := []PairUint8Uint8{{1, 10}, {1, 20}}
+	actual := ZipPairsUint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Uint8{}
+	actual = ZipPairsUint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Str(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []string{"x", "y"}
+
+	expected := []PairUint8Str{{1, "x"}, {1, "y"}}
+	actual := ZipPairsUint8Str(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Str failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Str{}
+	actual = ZipPairsUint8Str(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsUint8Bool(t *testing.T) {
+	list1 := []uint8{1, 1, 2}
+	list2 := []bool{false, true}
+
+	expected := []PairUint8Bool{{1, false}, {1, true}}
+	actual := ZipPairsUint8Bool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipUint8Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipUint8Bool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairUint8Bool{}
+	actual = ZipPairsUint8Bool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsUint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrInt(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []int{10, 20}
+
+	expected := []PairStrInt{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrInt(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrInt(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrInt failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrInt{}
+	actual = ZipPairsStrInt(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrInt64(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []int64{10, 20}
+
+	expected := []PairStrInt64{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrInt64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrInt64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrInt64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrInt64{}
+	actual = ZipPairsStrInt64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrInt32(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []int32{10, 20}
+
+	expected := []PairStrInt32{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrInt32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrInt32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrInt32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrInt32{}
+	actual = ZipPairsStrInt32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrInt16(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []int16{10, 20}
+
+	expected := []PairStrInt16{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrInt16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrInt16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrInt16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrInt16{}
+	actual = ZipPairsStrInt16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrInt8(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []int8{10, 20}
+
+	expected := []PairStrInt8{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrInt8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrInt8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrInt8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrInt8{}
+	actual = ZipPairsStrInt8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrUint(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []uint{10, 20}
+
+	expected := []PairStrUint{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrUint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrUint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrUint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrUint{}
+	actual = ZipPairsStrUint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrUint64(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []uint64{10, 20}
+
+	expected := []PairStrUint64{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrUint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrUint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrUint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrUint64{}
+	actual = ZipPairsStrUint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrUint32(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []uint32{10, 20}
+
+	expected := []PairStrUint32{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrUint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrUint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrUint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrUint32{}
+	actual = ZipPairsStrUint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrUint16(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []uint16{10, 20}
+
+	expected := []PairStrUint16{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrUint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrUint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrUint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrUint16{}
+	actual = ZipPairsStrUint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrUint8(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []uint8{10, 20}
+
+	expected := []PairStrUint8{{"a", 10}, {"a", 20}}
+	actual := ZipPairsStrUint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrUint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrUint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrUint8{}
+	actual = ZipPairsStrUint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStr(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []string{"x", "y"}
+
+	expected := []PairStrStr{{"a", "x"}, {"a", "y"}}
+	actual := ZipPairsStr(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStr(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStr failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrStr{}
+	actual = ZipPairsStr(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsStrBool(t *testing.T) {
+	list1 := []string{"a", "a", "b"}
+	list2 := []bool{false, true}
+
+	expected := []PairStrBool{{"a", false}, {"a", true}}
+	actual := ZipPairsStrBool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipStrBool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipStrBool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairStrBool{}
+	actual = ZipPairsStrBool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsStrBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolInt(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []int{10, 20}
+
+	expected := []PairBoolInt{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolInt(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolInt(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolInt failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolInt{}
+	actual = ZipPairsBoolInt(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolInt64(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []int64{10, 20}
+
+	expected := []PairBoolInt64{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolInt64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolInt64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolInt64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolInt64{}
+	actual = ZipPairsBoolInt64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolInt32(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []int32{10, 20}
+
+	expected := []PairBoolInt32{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolInt32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolInt32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolInt32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolInt32{}
+	actual = ZipPairsBoolInt32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolInt16(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []int16{10, 20}
+
+	expected := []PairBoolInt16{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolInt16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolInt16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolInt16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolInt16{}
+	actual = ZipPairsBoolInt16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolInt8(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []int8{10, 20}
+
+	expected := []PairBoolInt8{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolInt8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolInt8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolInt8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolInt8{}
+	actual = ZipPairsBoolInt8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolUint(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []uint{10, 20}
+
+	expected := []PairBoolUint{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolUint(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolUint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolUint failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolUint{}
+	actual = ZipPairsBoolUint(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolUint64(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []uint64{10, 20}
+
+	expected := []PairBoolUint64{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolUint64(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolUint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolUint64 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolUint64{}
+	actual = ZipPairsBoolUint64(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolUint32(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []uint32{10, 20}
+
+	expected := []PairBoolUint32{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolUint32(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolUint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolUint32 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolUint32{}
+	actual = ZipPairsBoolUint32(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolUint16(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []uint16{10, 20}
+
+	expected := []PairBoolUint16{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolUint16(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolUint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolUint16 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolUint16{}
+	actual = ZipPairsBoolUint16(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolUint8(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []uint8{10, 20}
+
+	expected := []PairBoolUint8{{true, 10}, {true, 20}}
+	actual := ZipPairsBoolUint8(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolUint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolUint8 failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolUint8{}
+	actual = ZipPairsBoolUint8(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBoolStr(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []string{"x", "y"}
+
+	expected := []PairBoolStr{{true, "x"}, {true, "y"}}
+	actual := ZipPairsBoolStr(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBoolStr(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBoolStr failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolStr{}
+	actual = ZipPairsBoolStr(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBoolStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipPairsBool(t *testing.T) {
+	list1 := []bool{true, true, false}
+	list2 := []bool{false, true}
+
+	expected := []PairBoolBool{{true, false}, {true, true}}
+	actual := ZipPairsBool(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := UnzipBool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzipBool failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []PairBoolBool{}
+	actual = ZipPairsBool(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairsBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
@@ -0,0 +1,157 @@
+package fp
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOptions configures the parallel functions(PFilterInt, PSomeInt ...).
+// The zero value runs runtime.NumCPU() goroutines
+type ParallelOptions struct {
+	// Workers is the maximum number of goroutines which call the function at the same time. 0 means runtime.NumCPU()
+	Workers int
+
+	// ChunkSize is the number of items reduced by one call in PReduce functions. 0 means the list is divided evenly among the workers
+	ChunkSize int
+
+	// LookAhead is the maximum number of items which ParallelStream and PMapStream functions start ahead of the reader.
+	// 0 means 2 * Workers. Less than 2 is taken as 2
+	LookAhead int
+}
+
+// ParallelChunkSize returns the number of items in each chunk when n items are divided for the parallel functions. See ParallelOptions
+func ParallelChunkSize(n int, opts ...ParallelOptions) int {
+	if len(opts) > 0 && opts[0].ChunkSize > 0 {
+		return opts[0].ChunkSize
+	}
+	workers := parallelWorkers(opts)
+	if n <= workers {
+		return 1
+	}
+	return (n + workers - 1) / workers
+}
+
+func parallelWorkers(opts []ParallelOptions) int {
+	if len(opts) > 0 && opts[0].Workers > 0 {
+		return opts[0].Workers
+	}
+	return runtime.NumCPU()
+}
+
+// ParallelFor calls f(i) for i = 0 .. n-1 with a bounded number of goroutines and waits for them.
+// The indexes are handed out in order. Once f returns false, no more indexes are handed out,
+// but the calls which are already running are finished.
+// Only the 1st option is used if more than one is passed.
+//
+// Returns false if any call of f returned false
+//
+// Example: stop as soon as a negative number is found
+//	ok := ParallelFor(len(list), func(i int) bool { return list[i] >= 0 }, ParallelOptions{Workers: 4})
+func ParallelFor(n int, f func(i int) bool, opts ...ParallelOptions) bool {
+	if f == nil || n <= 0 {
+		return true
+	}
+	workers := parallelWorkers(opts)
+	if workers > n {
+		workers = n
+	}
+
+	var next int64 = -1
+	var stopped int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&stopped) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if !f(i) {
+					atomic.StoreInt32(&stopped, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return stopped == 0
+}
+
+// ParallelStream calls f(i) for i = 0 .. n-1 with a bounded number of goroutines, and sends i to the returned channel
+// in order, as soon as f(i) and all the calls before it are finished. At most LookAhead calls are started ahead of the reader.
+// Only the 1st option is used if more than one is passed.
+//
+// The channel is closed after the last index, or when the context is done. nil context never gets done.
+// The reader must read until the channel is closed or cancel the context, otherwise goroutines are left blocked
+//
+// Example: PMapStreamInt is built on it
+//	results := make([]int, len(list))
+//	for i := range ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }) {
+//		save(results[i])
+//	}
+func ParallelStream(ctx context.Context, n int, f func(i int), opts ...ParallelOptions) <-chan int {
+	out := make(chan int)
+	if f == nil || n <= 0 {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	lookAhead := 2 * parallelWorkers(opts)
+	if len(opts) > 0 && opts[0].LookAhead > 0 {
+		lookAhead = opts[0].LookAhead
+	}
+	if lookAhead < 2 {
+		lookAhead = 2
+	}
+
+	// pending has done channels of the started calls in order. One more is held by the 2nd goroutine
+	// and one more can be held by a goroutine which forwards the results(PMapStream), so its capacity is lookAhead - 2
+	pending := make(chan chan struct{}, lookAhead-2)
+	workers := make(chan struct{}, parallelWorkers(opts))
+	go func() {
+		defer close(pending)
+		for i := 0; i < n; i++ {
+			done := make(chan struct{})
+			select {
+			case pending <- done:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int) {
+				f(i)
+				close(done)
+				<-workers
+			}(i)
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		i := 0
+		for done := range pending {
+			select {
+			case <-done:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case out <- i:
+			case <-ctx.Done():
+				return
+			}
+			i++
+		}
+	}()
+	return out
+}
@@ -0,0 +1,96 @@
+package fp
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelFor(t *testing.T) {
+	seen := make([]int32, 100)
+	if !ParallelFor(len(seen), func(i int) bool { atomic.AddInt32(&seen[i], 1); return true }, ParallelOptions{Workers: 3}) {
+		t.Errorf("TestParallelFor failed. Expected=true")
+	}
+	for i, v := range seen {
+		if v != 1 {
+			t.Errorf("TestParallelFor failed. Expected index %v to be called once, actual=%v", i, v)
+		}
+	}
+
+	var running, maxRunning int32
+	ParallelFor(50, func(i int) bool {
+		r := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+				break
+			}
+		}
+		atomic.AddInt32(&running, -1)
+		return true
+	}, ParallelOptions{Workers: 2})
+	if maxRunning > 2 {
+		t.Errorf("TestParallelFor failed. Expected at most 2 workers, actual=%v", maxRunning)
+	}
+
+	if !ParallelFor(0, func(i int) bool { return false }) || !ParallelFor(10, nil) {
+		t.Errorf("TestParallelFor failed. Expected=true for empty range or nil function")
+	}
+}
+
+func TestParallelForStop(t *testing.T) {
+	var calls int32
+	ok := ParallelFor(1000, func(i int) bool {
+		atomic.AddInt32(&calls, 1)
+		return i != 5
+	}, ParallelOptions{Workers: 1})
+	if ok || calls != 6 {
+		t.Errorf("TestParallelForStop failed. Expected=false after 6 calls, actual=%v after %v calls", ok, calls)
+	}
+
+	calls = 0
+	if ParallelFor(1000, func(i int) bool { atomic.AddInt32(&calls, 1); return i < 10 }, ParallelOptions{Workers: 4}) || calls > 20 {
+		t.Errorf("TestParallelForStop failed. Expected=false with few calls, actual %v calls", calls)
+	}
+}
+
+func TestParallelChunkSize(t *testing.T) {
+	if size := ParallelChunkSize(10, ParallelOptions{Workers: 3}); size != 4 {
+		t.Errorf("TestParallelChunkSize failed. Expected=4, actual=%v", size)
+	}
+	if size := ParallelChunkSize(2, ParallelOptions{Workers: 3}); size != 1 {
+		t.Errorf("TestParallelChunkSize failed. Expected=1, actual=%v", size)
+	}
+	if size := ParallelChunkSize(10, ParallelOptions{Workers: 3, ChunkSize: 7}); size != 7 {
+		t.Errorf("TestParallelChunkSize failed. Expected=7, actual=%v", size)
+	}
+}
+
+func TestParallelStream(t *testing.T) {
+	done := make([]int32, 20)
+	var indexes []int
+	for i := range ParallelStream(nil, len(done), func(i int) { atomic.StoreInt32(&done[i], 1) }, ParallelOptions{Workers: 3, LookAhead: 4}) {
+		if atomic.LoadInt32(&done[i]) != 1 {
+			t.Errorf("TestParallelStream failed. Expected index %v to be sent after the call", i)
+		}
+		indexes = append(indexes, i)
+	}
+	for i, v := range indexes {
+		if i != v {
+			t.Errorf("TestParallelStream failed. Expected indexes in order, actual=%v", indexes)
+			break
+		}
+	}
+	if len(indexes) != 20 {
+		t.Errorf("TestParallelStream failed. Expected 20 indexes, actual=%v", len(indexes))
+	}
+
+	if _, ok := <-ParallelStream(nil, 10, nil); ok {
+		t.Errorf("TestParallelStream failed. Expected closed channel for nil function")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for range ParallelStream(ctx, 1000, func(i int) {}) {
+	}
+}
@@ -0,0 +1,337 @@
+package fp
+
+// PartialInt2 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt2(f func(int, int) int, v1 int) func(int) int {
+	if f == nil {
+		return nil
+	}
+	return func(v2 int) int {
+		return f(v1, v2)
+	}
+}
+
+// PartialInt3 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt3(f func(int, int, int) int, v1, v2 int) func(int) int {
+	if f == nil {
+		return nil
+	}
+	return func(v3 int) int {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialInt642 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt642(f func(int64, int64) int64, v1 int64) func(int64) int64 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 int64) int64 {
+		return f(v1, v2)
+	}
+}
+
+// PartialInt643 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt643(f func(int64, int64, int64) int64, v1, v2 int64) func(int64) int64 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 int64) int64 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialInt322 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt322(f func(int32, int32) int32, v1 int32) func(int32) int32 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 int32) int32 {
+		return f(v1, v2)
+	}
+}
+
+// PartialInt323 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt323(f func(int32, int32, int32) int32, v1, v2 int32) func(int32) int32 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 int32) int32 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialInt162 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt162(f func(int16, int16) int16, v1 int16) func(int16) int16 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 int16) int16 {
+		return f(v1, v2)
+	}
+}
+
+// PartialInt163 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt163(f func(int16, int16, int16) int16, v1, v2 int16) func(int16) int16 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 int16) int16 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialInt82 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt82(f func(int8, int8) int8, v1 int8) func(int8) int8 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 int8) int8 {
+		return f(v1, v2)
+	}
+}
+
+// PartialInt83 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialInt83(f func(int8, int8, int8) int8, v1, v2 int8) func(int8) int8 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 int8) int8 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialUint2 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint2(f func(uint, uint) uint, v1 uint) func(uint) uint {
+	if f == nil {
+		return nil
+	}
+	return func(v2 uint) uint {
+		return f(v1, v2)
+	}
+}
+
+// PartialUint3 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint3(f func(uint, uint, uint) uint, v1, v2 uint) func(uint) uint {
+	if f == nil {
+		return nil
+	}
+	return func(v3 uint) uint {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialUint642 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint642(f func(uint64, uint64) uint64, v1 uint64) func(uint64) uint64 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 uint64) uint64 {
+		return f(v1, v2)
+	}
+}
+
+// PartialUint643 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint643(f func(uint64, uint64, uint64) uint64, v1, v2 uint64) func(uint64) uint64 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 uint64) uint64 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialUint322 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint322(f func(uint32, uint32) uint32, v1 uint32) func(uint32) uint32 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 uint32) uint32 {
+		return f(v1, v2)
+	}
+}
+
+// PartialUint323 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint323(f func(uint32, uint32, uint32) uint32, v1, v2 uint32) func(uint32) uint32 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 uint32) uint32 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialUint162 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint162(f func(uint16, uint16) uint16, v1 uint16) func(uint16) uint16 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 uint16) uint16 {
+		return f(v1, v2)
+	}
+}
+
+// PartialUint163 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint163(f func(uint16, uint16, uint16) uint16, v1, v2 uint16) func(uint16) uint16 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 uint16) uint16 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialUint82 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint82(f func(uint8, uint8) uint8, v1 uint8) func(uint8) uint8 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 uint8) uint8 {
+		return f(v1, v2)
+	}
+}
+
+// PartialUint83 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialUint83(f func(uint8, uint8, uint8) uint8, v1, v2 uint8) func(uint8) uint8 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 uint8) uint8 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialFloat642 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialFloat642(f func(float64, float64) float64, v1 float64) func(float64) float64 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 float64) float64 {
+		return f(v1, v2)
+	}
+}
+
+// PartialFloat643 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialFloat643(f func(float64, float64, float64) float64, v1, v2 float64) func(float64) float64 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 float64) float64 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialFloat322 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialFloat322(f func(float32, float32) float32, v1 float32) func(float32) float32 {
+	if f == nil {
+		return nil
+	}
+	return func(v2 float32) float32 {
+		return f(v1, v2)
+	}
+}
+
+// PartialFloat323 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialFloat323(f func(float32, float32, float32) float32, v1, v2 float32) func(float32) float32 {
+	if f == nil {
+		return nil
+	}
+	return func(v3 float32) float32 {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialStr2 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialStr2(f func(string, string) string, v1 string) func(string) string {
+	if f == nil {
+		return nil
+	}
+	return func(v2 string) string {
+		return f(v1, v2)
+	}
+}
+
+// PartialStr3 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialStr3(f func(string, string, string) string, v1, v2 string) func(string) string {
+	if f == nil {
+		return nil
+	}
+	return func(v3 string) string {
+		return f(v1, v2, v3)
+	}
+}
+
+// PartialBool2 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialBool2(f func(bool, bool) bool, v1 bool) func(bool) bool {
+	if f == nil {
+		return nil
+	}
+	return func(v2 bool) bool {
+		return f(v1, v2)
+	}
+}
+
+// PartialBool3 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func PartialBool3(f func(bool, bool, bool) bool, v1, v2 bool) func(bool) bool {
+	if f == nil {
+		return nil
+	}
+	return func(v3 bool) bool {
+		return f(v1, v2, v3)
+	}
+}
@@ -0,0 +1,286 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPartialInt(t *testing.T) {
+	f2 := func(v1, v2 int) int { return v1 - v2 }
+	expected := int(2)
+	actual := PartialInt2(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 int) int { return v1*v2 + v3 }
+	expected = int(16)
+	actual = PartialInt3(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialInt2(nil, 5) != nil || PartialInt3(nil, 5, 3) != nil {
+		t.Errorf("TestPartialInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialInt64(t *testing.T) {
+	f2 := func(v1, v2 int64) int64 { return v1 - v2 }
+	expected := int64(2)
+	actual := PartialInt642(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 int64) int64 { return v1*v2 + v3 }
+	expected = int64(16)
+	actual = PartialInt643(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialInt642(nil, 5) != nil || PartialInt643(nil, 5, 3) != nil {
+		t.Errorf("TestPartialInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialInt32(t *testing.T) {
+	f2 := func(v1, v2 int32) int32 { return v1 - v2 }
+	expected := int32(2)
+	actual := PartialInt322(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 int32) int32 { return v1*v2 + v3 }
+	expected = int32(16)
+	actual = PartialInt323(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialInt322(nil, 5) != nil || PartialInt323(nil, 5, 3) != nil {
+		t.Errorf("TestPartialInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialInt16(t *testing.T) {
+	f2 := func(v1, v2 int16) int16 { return v1 - v2 }
+	expected := int16(2)
+	actual := PartialInt162(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 int16) int16 { return v1*v2 + v3 }
+	expected = int16(16)
+	actual = PartialInt163(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialInt162(nil, 5) != nil || PartialInt163(nil, 5, 3) != nil {
+		t.Errorf("TestPartialInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialInt8(t *testing.T) {
+	f2 := func(v1, v2 int8) int8 { return v1 - v2 }
+	expected := int8(2)
+	actual := PartialInt82(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 int8) int8 { return v1*v2 + v3 }
+	expected = int8(16)
+	actual = PartialInt83(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialInt82(nil, 5) != nil || PartialInt83(nil, 5, 3) != nil {
+		t.Errorf("TestPartialInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialUint(t *testing.T) {
+	f2 := func(v1, v2 uint) uint { return v1 - v2 }
+	expected := uint(2)
+	actual := PartialUint2(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 uint) uint { return v1*v2 + v3 }
+	expected = uint(16)
+	actual = PartialUint3(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialUint2(nil, 5) != nil || PartialUint3(nil, 5, 3) != nil {
+		t.Errorf("TestPartialUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialUint64(t *testing.T) {
+	f2 := func(v1, v2 uint64) uint64 { return v1 - v2 }
+	expected := uint64(2)
+	actual := PartialUint642(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 uint64) uint64 { return v1*v2 + v3 }
+	expected = uint64(16)
+	actual = PartialUint643(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialUint642(nil, 5) != nil || PartialUint643(nil, 5, 3) != nil {
+		t.Errorf("TestPartialUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialUint32(t *testing.T) {
+	f2 := func(v1, v2 uint32) uint32 { return v1 - v2 }
+	expected := uint32(2)
+	actual := PartialUint322(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 uint32) uint32 { return v1*v2 + v3 }
+	expected = uint32(16)
+	actual = PartialUint323(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialUint322(nil, 5) != nil || PartialUint323(nil, 5, 3) != nil {
+		t.Errorf("TestPartialUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialUint16(t *testing.T) {
+	f2 := func(v1, v2 uint16) uint16 { return v1 - v2 }
+	expected := uint16(2)
+	actual := PartialUint162(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 uint16) uint16 { return v1*v2 + v3 }
+	expected = uint16(16)
+	actual = PartialUint163(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialUint162(nil, 5) != nil || PartialUint163(nil, 5, 3) != nil {
+		t.Errorf("TestPartialUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialUint8(t *testing.T) {
+	f2 := func(v1, v2 uint8) uint8 { return v1 - v2 }
+	expected := uint8(2)
+	actual := PartialUint82(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 uint8) uint8 { return v1*v2 + v3 }
+	expected = uint8(16)
+	actual = PartialUint83(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialUint82(nil, 5) != nil || PartialUint83(nil, 5, 3) != nil {
+		t.Errorf("TestPartialUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialFloat64(t *testing.T) {
+	f2 := func(v1, v2 float64) float64 { return v1 - v2 }
+	expected := float64(2)
+	actual := PartialFloat642(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 float64) float64 { return v1*v2 + v3 }
+	expected = float64(16)
+	actual = PartialFloat643(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialFloat642(nil, 5) != nil || PartialFloat643(nil, 5, 3) != nil {
+		t.Errorf("TestPartialFloat64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialFloat32(t *testing.T) {
+	f2 := func(v1, v2 float32) float32 { return v1 - v2 }
+	expected := float32(2)
+	actual := PartialFloat322(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 float32) float32 { return v1*v2 + v3 }
+	expected = float32(16)
+	actual = PartialFloat323(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialFloat322(nil, 5) != nil || PartialFloat323(nil, 5, 3) != nil {
+		t.Errorf("TestPartialFloat32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialStr(t *testing.T) {
+	f2 := func(v1, v2 string) string { return v1 + v2 }
+	expected := string("ab")
+	actual := PartialStr2(f2, "a")("b")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 string) string { return v1 + v2 + v3 }
+	expected = string("abc")
+	actual = PartialStr3(f3, "a", "b")("c")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialStr2(nil, "a") != nil || PartialStr3(nil, "a", "b") != nil {
+		t.Errorf("TestPartialStr failed. Expected nil function for nil input")
+	}
+}
+
+func TestPartialBool(t *testing.T) {
+	f2 := func(v1, v2 bool) bool { return v1 && v2 }
+	expected := bool(false)
+	actual := PartialBool2(f2, true)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 bool) bool { return v1 && v2 || v3 }
+	expected = bool(true)
+	actual = PartialBool3(f3, true, false)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartialBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if PartialBool2(nil, true) != nil || PartialBool3(nil, true, false) != nil {
+		t.Errorf("TestPartialBool failed. Expected nil function for nil input")
+	}
+}
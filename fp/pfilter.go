@@ -0,0 +1,1197 @@
+package fp
+
+// PFilterInt filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterInt(isValid, list, ParallelOptions{Workers: 8})
+func PFilterInt(f func(int) bool, list []int, opts ...ParallelOptions) []int {
+	if f == nil {
+		return []int{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]int, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveInt removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveInt(f func(int) bool, list []int, opts ...ParallelOptions) []int {
+	if f == nil {
+		return []int{}
+	}
+	return PFilterInt(func(v int) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapInt filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapInt(fFilter func(int) bool, fMap func(int) int, list []int, opts ...ParallelOptions) []int {
+	if fFilter == nil || fMap == nil {
+		return []int{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]int, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]int, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeInt returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeInt(f func(int) bool, list []int, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryInt returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryInt(f func(int) bool, list []int, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterInt64 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterInt64(isValid, list, ParallelOptions{Workers: 8})
+func PFilterInt64(f func(int64) bool, list []int64, opts ...ParallelOptions) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]int64, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveInt64 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveInt64(f func(int64) bool, list []int64, opts ...ParallelOptions) []int64 {
+	if f == nil {
+		return []int64{}
+	}
+	return PFilterInt64(func(v int64) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapInt64 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapInt64(fFilter func(int64) bool, fMap func(int64) int64, list []int64, opts ...ParallelOptions) []int64 {
+	if fFilter == nil || fMap == nil {
+		return []int64{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]int64, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]int64, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeInt64 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeInt64(f func(int64) bool, list []int64, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryInt64 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryInt64(f func(int64) bool, list []int64, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterInt32 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterInt32(isValid, list, ParallelOptions{Workers: 8})
+func PFilterInt32(f func(int32) bool, list []int32, opts ...ParallelOptions) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]int32, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveInt32 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveInt32(f func(int32) bool, list []int32, opts ...ParallelOptions) []int32 {
+	if f == nil {
+		return []int32{}
+	}
+	return PFilterInt32(func(v int32) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapInt32 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapInt32(fFilter func(int32) bool, fMap func(int32) int32, list []int32, opts ...ParallelOptions) []int32 {
+	if fFilter == nil || fMap == nil {
+		return []int32{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]int32, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]int32, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeInt32 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeInt32(f func(int32) bool, list []int32, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryInt32 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryInt32(f func(int32) bool, list []int32, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterInt16 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterInt16(isValid, list, ParallelOptions{Workers: 8})
+func PFilterInt16(f func(int16) bool, list []int16, opts ...ParallelOptions) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]int16, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveInt16 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveInt16(f func(int16) bool, list []int16, opts ...ParallelOptions) []int16 {
+	if f == nil {
+		return []int16{}
+	}
+	return PFilterInt16(func(v int16) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapInt16 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapInt16(fFilter func(int16) bool, fMap func(int16) int16, list []int16, opts ...ParallelOptions) []int16 {
+	if fFilter == nil || fMap == nil {
+		return []int16{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]int16, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]int16, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeInt16 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeInt16(f func(int16) bool, list []int16, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryInt16 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryInt16(f func(int16) bool, list []int16, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterInt8 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterInt8(isValid, list, ParallelOptions{Workers: 8})
+func PFilterInt8(f func(int8) bool, list []int8, opts ...ParallelOptions) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]int8, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveInt8 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveInt8(f func(int8) bool, list []int8, opts ...ParallelOptions) []int8 {
+	if f == nil {
+		return []int8{}
+	}
+	return PFilterInt8(func(v int8) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapInt8 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapInt8(fFilter func(int8) bool, fMap func(int8) int8, list []int8, opts ...ParallelOptions) []int8 {
+	if fFilter == nil || fMap == nil {
+		return []int8{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]int8, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]int8, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeInt8 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeInt8(f func(int8) bool, list []int8, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryInt8 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryInt8(f func(int8) bool, list []int8, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterUint filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterUint(isValid, list, ParallelOptions{Workers: 8})
+func PFilterUint(f func(uint) bool, list []uint, opts ...ParallelOptions) []uint {
+	if f == nil {
+		return []uint{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]uint, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveUint removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveUint(f func(uint) bool, list []uint, opts ...ParallelOptions) []uint {
+	if f == nil {
+		return []uint{}
+	}
+	return PFilterUint(func(v uint) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapUint filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapUint(fFilter func(uint) bool, fMap func(uint) uint, list []uint, opts ...ParallelOptions) []uint {
+	if fFilter == nil || fMap == nil {
+		return []uint{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]uint, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]uint, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeUint returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeUint(f func(uint) bool, list []uint, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryUint returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryUint(f func(uint) bool, list []uint, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterUint64 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterUint64(isValid, list, ParallelOptions{Workers: 8})
+func PFilterUint64(f func(uint64) bool, list []uint64, opts ...ParallelOptions) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]uint64, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveUint64 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveUint64(f func(uint64) bool, list []uint64, opts ...ParallelOptions) []uint64 {
+	if f == nil {
+		return []uint64{}
+	}
+	return PFilterUint64(func(v uint64) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapUint64 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapUint64(fFilter func(uint64) bool, fMap func(uint64) uint64, list []uint64, opts ...ParallelOptions) []uint64 {
+	if fFilter == nil || fMap == nil {
+		return []uint64{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]uint64, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]uint64, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeUint64 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeUint64(f func(uint64) bool, list []uint64, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryUint64 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryUint64(f func(uint64) bool, list []uint64, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterUint32 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterUint32(isValid, list, ParallelOptions{Workers: 8})
+func PFilterUint32(f func(uint32) bool, list []uint32, opts ...ParallelOptions) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]uint32, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveUint32 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveUint32(f func(uint32) bool, list []uint32, opts ...ParallelOptions) []uint32 {
+	if f == nil {
+		return []uint32{}
+	}
+	return PFilterUint32(func(v uint32) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapUint32 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapUint32(fFilter func(uint32) bool, fMap func(uint32) uint32, list []uint32, opts ...ParallelOptions) []uint32 {
+	if fFilter == nil || fMap == nil {
+		return []uint32{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]uint32, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]uint32, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeUint32 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeUint32(f func(uint32) bool, list []uint32, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryUint32 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryUint32(f func(uint32) bool, list []uint32, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterUint16 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterUint16(isValid, list, ParallelOptions{Workers: 8})
+func PFilterUint16(f func(uint16) bool, list []uint16, opts ...ParallelOptions) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]uint16, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveUint16 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveUint16(f func(uint16) bool, list []uint16, opts ...ParallelOptions) []uint16 {
+	if f == nil {
+		return []uint16{}
+	}
+	return PFilterUint16(func(v uint16) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapUint16 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapUint16(fFilter func(uint16) bool, fMap func(uint16) uint16, list []uint16, opts ...ParallelOptions) []uint16 {
+	if fFilter == nil || fMap == nil {
+		return []uint16{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]uint16, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]uint16, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeUint16 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeUint16(f func(uint16) bool, list []uint16, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryUint16 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryUint16(f func(uint16) bool, list []uint16, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterUint8 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterUint8(isValid, list, ParallelOptions{Workers: 8})
+func PFilterUint8(f func(uint8) bool, list []uint8, opts ...ParallelOptions) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]uint8, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveUint8 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveUint8(f func(uint8) bool, list []uint8, opts ...ParallelOptions) []uint8 {
+	if f == nil {
+		return []uint8{}
+	}
+	return PFilterUint8(func(v uint8) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapUint8 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapUint8(fFilter func(uint8) bool, fMap func(uint8) uint8, list []uint8, opts ...ParallelOptions) []uint8 {
+	if fFilter == nil || fMap == nil {
+		return []uint8{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]uint8, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]uint8, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeUint8 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeUint8(f func(uint8) bool, list []uint8, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryUint8 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryUint8(f func(uint8) bool, list []uint8, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterFloat64 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterFloat64(isValid, list, ParallelOptions{Workers: 8})
+func PFilterFloat64(f func(float64) bool, list []float64, opts ...ParallelOptions) []float64 {
+	if f == nil {
+		return []float64{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]float64, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveFloat64 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveFloat64(f func(float64) bool, list []float64, opts ...ParallelOptions) []float64 {
+	if f == nil {
+		return []float64{}
+	}
+	return PFilterFloat64(func(v float64) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapFloat64 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapFloat64(fFilter func(float64) bool, fMap func(float64) float64, list []float64, opts ...ParallelOptions) []float64 {
+	if fFilter == nil || fMap == nil {
+		return []float64{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]float64, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]float64, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeFloat64 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeFloat64(f func(float64) bool, list []float64, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryFloat64 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryFloat64(f func(float64) bool, list []float64, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterFloat32 filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterFloat32(isValid, list, ParallelOptions{Workers: 8})
+func PFilterFloat32(f func(float32) bool, list []float32, opts ...ParallelOptions) []float32 {
+	if f == nil {
+		return []float32{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]float32, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveFloat32 removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveFloat32(f func(float32) bool, list []float32, opts ...ParallelOptions) []float32 {
+	if f == nil {
+		return []float32{}
+	}
+	return PFilterFloat32(func(v float32) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapFloat32 filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapFloat32(fFilter func(float32) bool, fMap func(float32) float32, list []float32, opts ...ParallelOptions) []float32 {
+	if fFilter == nil || fMap == nil {
+		return []float32{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]float32, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]float32, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeFloat32 returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeFloat32(f func(float32) bool, list []float32, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryFloat32 returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryFloat32(f func(float32) bool, list []float32, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+// PFilterStr filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilterStr(isValid, list, ParallelOptions{Workers: 8})
+func PFilterStr(f func(string) bool, list []string, opts ...ParallelOptions) []string {
+	if f == nil {
+		return []string{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]string, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemoveStr removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemoveStr(f func(string) bool, list []string, opts ...ParallelOptions) []string {
+	if f == nil {
+		return []string{}
+	}
+	return PFilterStr(func(v string) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMapStr filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMapStr(fFilter func(string) bool, fMap func(string) string, list []string, opts ...ParallelOptions) []string {
+	if fFilter == nil || fMap == nil {
+		return []string{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]string, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]string, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSomeStr returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSomeStr(f func(string) bool, list []string, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEveryStr returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEveryStr(f func(string) bool, list []string, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
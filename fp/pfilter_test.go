@@ -0,0 +1,516 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPFilterInt(t *testing.T) {
+	large := func(v int) bool { return v > 2 }
+	list := []int{1, 5, 2, 4, 3}
+
+	if result := PFilterInt(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]int{5, 4, 3}, result) {
+		t.Errorf("TestPFilterInt failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveInt(large, list); !reflect.DeepEqual([]int{1, 2}, result) {
+		t.Errorf("TestPRemoveInt failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapInt(large, func(v int) int { return v * 2 }, list); !reflect.DeepEqual([]int{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapInt failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterInt(nil, list)) != 0 || len(PRemoveInt(nil, list)) != 0 || len(PFilterMapInt(large, nil, list)) != 0 || len(PFilterInt(large, nil)) != 0 {
+		t.Errorf("TestPFilterInt failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeInt(t *testing.T) {
+	large := func(v int) bool { return v > 2 }
+	if !PSomeInt(large, []int{1, 3}) || PSomeInt(large, []int{1, 2}) || PSomeInt(nil, []int{3}) || PSomeInt(large, nil) {
+		t.Errorf("TestPSomeInt failed")
+	}
+	if !PEveryInt(large, []int{3, 4}) || PEveryInt(large, []int{3, 1}) || PEveryInt(nil, []int{3}) || PEveryInt(large, nil) {
+		t.Errorf("TestPEveryInt failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]int, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v int) bool { calls++; return v > 2 }
+	if !PSomeInt(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeInt failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryInt(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryInt failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterInt64(t *testing.T) {
+	large := func(v int64) bool { return v > 2 }
+	list := []int64{1, 5, 2, 4, 3}
+
+	if result := PFilterInt64(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]int64{5, 4, 3}, result) {
+		t.Errorf("TestPFilterInt64 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveInt64(large, list); !reflect.DeepEqual([]int64{1, 2}, result) {
+		t.Errorf("TestPRemoveInt64 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapInt64(large, func(v int64) int64 { return v * 2 }, list); !reflect.DeepEqual([]int64{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapInt64 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterInt64(nil, list)) != 0 || len(PRemoveInt64(nil, list)) != 0 || len(PFilterMapInt64(large, nil, list)) != 0 || len(PFilterInt64(large, nil)) != 0 {
+		t.Errorf("TestPFilterInt64 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeInt64(t *testing.T) {
+	large := func(v int64) bool { return v > 2 }
+	if !PSomeInt64(large, []int64{1, 3}) || PSomeInt64(large, []int64{1, 2}) || PSomeInt64(nil, []int64{3}) || PSomeInt64(large, nil) {
+		t.Errorf("TestPSomeInt64 failed")
+	}
+	if !PEveryInt64(large, []int64{3, 4}) || PEveryInt64(large, []int64{3, 1}) || PEveryInt64(nil, []int64{3}) || PEveryInt64(large, nil) {
+		t.Errorf("TestPEveryInt64 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]int64, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v int64) bool { calls++; return v > 2 }
+	if !PSomeInt64(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeInt64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryInt64(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryInt64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterInt32(t *testing.T) {
+	large := func(v int32) bool { return v > 2 }
+	list := []int32{1, 5, 2, 4, 3}
+
+	if result := PFilterInt32(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]int32{5, 4, 3}, result) {
+		t.Errorf("TestPFilterInt32 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveInt32(large, list); !reflect.DeepEqual([]int32{1, 2}, result) {
+		t.Errorf("TestPRemoveInt32 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapInt32(large, func(v int32) int32 { return v * 2 }, list); !reflect.DeepEqual([]int32{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapInt32 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterInt32(nil, list)) != 0 || len(PRemoveInt32(nil, list)) != 0 || len(PFilterMapInt32(large, nil, list)) != 0 || len(PFilterInt32(large, nil)) != 0 {
+		t.Errorf("TestPFilterInt32 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeInt32(t *testing.T) {
+	large := func(v int32) bool { return v > 2 }
+	if !PSomeInt32(large, []int32{1, 3}) || PSomeInt32(large, []int32{1, 2}) || PSomeInt32(nil, []int32{3}) || PSomeInt32(large, nil) {
+		t.Errorf("TestPSomeInt32 failed")
+	}
+	if !PEveryInt32(large, []int32{3, 4}) || PEveryInt32(large, []int32{3, 1}) || PEveryInt32(nil, []int32{3}) || PEveryInt32(large, nil) {
+		t.Errorf("TestPEveryInt32 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]int32, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v int32) bool { calls++; return v > 2 }
+	if !PSomeInt32(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeInt32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryInt32(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryInt32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterInt16(t *testing.T) {
+	large := func(v int16) bool { return v > 2 }
+	list := []int16{1, 5, 2, 4, 3}
+
+	if result := PFilterInt16(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]int16{5, 4, 3}, result) {
+		t.Errorf("TestPFilterInt16 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveInt16(large, list); !reflect.DeepEqual([]int16{1, 2}, result) {
+		t.Errorf("TestPRemoveInt16 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapInt16(large, func(v int16) int16 { return v * 2 }, list); !reflect.DeepEqual([]int16{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapInt16 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterInt16(nil, list)) != 0 || len(PRemoveInt16(nil, list)) != 0 || len(PFilterMapInt16(large, nil, list)) != 0 || len(PFilterInt16(large, nil)) != 0 {
+		t.Errorf("TestPFilterInt16 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeInt16(t *testing.T) {
+	large := func(v int16) bool { return v > 2 }
+	if !PSomeInt16(large, []int16{1, 3}) || PSomeInt16(large, []int16{1, 2}) || PSomeInt16(nil, []int16{3}) || PSomeInt16(large, nil) {
+		t.Errorf("TestPSomeInt16 failed")
+	}
+	if !PEveryInt16(large, []int16{3, 4}) || PEveryInt16(large, []int16{3, 1}) || PEveryInt16(nil, []int16{3}) || PEveryInt16(large, nil) {
+		t.Errorf("TestPEveryInt16 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]int16, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v int16) bool { calls++; return v > 2 }
+	if !PSomeInt16(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeInt16 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryInt16(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryInt16 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterInt8(t *testing.T) {
+	large := func(v int8) bool { return v > 2 }
+	list := []int8{1, 5, 2, 4, 3}
+
+	if result := PFilterInt8(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]int8{5, 4, 3}, result) {
+		t.Errorf("TestPFilterInt8 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveInt8(large, list); !reflect.DeepEqual([]int8{1, 2}, result) {
+		t.Errorf("TestPRemoveInt8 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapInt8(large, func(v int8) int8 { return v * 2 }, list); !reflect.DeepEqual([]int8{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapInt8 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterInt8(nil, list)) != 0 || len(PRemoveInt8(nil, list)) != 0 || len(PFilterMapInt8(large, nil, list)) != 0 || len(PFilterInt8(large, nil)) != 0 {
+		t.Errorf("TestPFilterInt8 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeInt8(t *testing.T) {
+	large := func(v int8) bool { return v > 2 }
+	if !PSomeInt8(large, []int8{1, 3}) || PSomeInt8(large, []int8{1, 2}) || PSomeInt8(nil, []int8{3}) || PSomeInt8(large, nil) {
+		t.Errorf("TestPSomeInt8 failed")
+	}
+	if !PEveryInt8(large, []int8{3, 4}) || PEveryInt8(large, []int8{3, 1}) || PEveryInt8(nil, []int8{3}) || PEveryInt8(large, nil) {
+		t.Errorf("TestPEveryInt8 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]int8, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v int8) bool { calls++; return v > 2 }
+	if !PSomeInt8(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeInt8 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryInt8(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryInt8 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterUint(t *testing.T) {
+	large := func(v uint) bool { return v > 2 }
+	list := []uint{1, 5, 2, 4, 3}
+
+	if result := PFilterUint(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]uint{5, 4, 3}, result) {
+		t.Errorf("TestPFilterUint failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveUint(large, list); !reflect.DeepEqual([]uint{1, 2}, result) {
+		t.Errorf("TestPRemoveUint failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapUint(large, func(v uint) uint { return v * 2 }, list); !reflect.DeepEqual([]uint{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapUint failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterUint(nil, list)) != 0 || len(PRemoveUint(nil, list)) != 0 || len(PFilterMapUint(large, nil, list)) != 0 || len(PFilterUint(large, nil)) != 0 {
+		t.Errorf("TestPFilterUint failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeUint(t *testing.T) {
+	large := func(v uint) bool { return v > 2 }
+	if !PSomeUint(large, []uint{1, 3}) || PSomeUint(large, []uint{1, 2}) || PSomeUint(nil, []uint{3}) || PSomeUint(large, nil) {
+		t.Errorf("TestPSomeUint failed")
+	}
+	if !PEveryUint(large, []uint{3, 4}) || PEveryUint(large, []uint{3, 1}) || PEveryUint(nil, []uint{3}) || PEveryUint(large, nil) {
+		t.Errorf("TestPEveryUint failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]uint, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v uint) bool { calls++; return v > 2 }
+	if !PSomeUint(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeUint failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryUint(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryUint failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterUint64(t *testing.T) {
+	large := func(v uint64) bool { return v > 2 }
+	list := []uint64{1, 5, 2, 4, 3}
+
+	if result := PFilterUint64(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]uint64{5, 4, 3}, result) {
+		t.Errorf("TestPFilterUint64 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveUint64(large, list); !reflect.DeepEqual([]uint64{1, 2}, result) {
+		t.Errorf("TestPRemoveUint64 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapUint64(large, func(v uint64) uint64 { return v * 2 }, list); !reflect.DeepEqual([]uint64{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapUint64 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterUint64(nil, list)) != 0 || len(PRemoveUint64(nil, list)) != 0 || len(PFilterMapUint64(large, nil, list)) != 0 || len(PFilterUint64(large, nil)) != 0 {
+		t.Errorf("TestPFilterUint64 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeUint64(t *testing.T) {
+	large := func(v uint64) bool { return v > 2 }
+	if !PSomeUint64(large, []uint64{1, 3}) || PSomeUint64(large, []uint64{1, 2}) || PSomeUint64(nil, []uint64{3}) || PSomeUint64(large, nil) {
+		t.Errorf("TestPSomeUint64 failed")
+	}
+	if !PEveryUint64(large, []uint64{3, 4}) || PEveryUint64(large, []uint64{3, 1}) || PEveryUint64(nil, []uint64{3}) || PEveryUint64(large, nil) {
+		t.Errorf("TestPEveryUint64 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]uint64, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v uint64) bool { calls++; return v > 2 }
+	if !PSomeUint64(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeUint64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryUint64(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryUint64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterUint32(t *testing.T) {
+	large := func(v uint32) bool { return v > 2 }
+	list := []uint32{1, 5, 2, 4, 3}
+
+	if result := PFilterUint32(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]uint32{5, 4, 3}, result) {
+		t.Errorf("TestPFilterUint32 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveUint32(large, list); !reflect.DeepEqual([]uint32{1, 2}, result) {
+		t.Errorf("TestPRemoveUint32 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapUint32(large, func(v uint32) uint32 { return v * 2 }, list); !reflect.DeepEqual([]uint32{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapUint32 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterUint32(nil, list)) != 0 || len(PRemoveUint32(nil, list)) != 0 || len(PFilterMapUint32(large, nil, list)) != 0 || len(PFilterUint32(large, nil)) != 0 {
+		t.Errorf("TestPFilterUint32 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeUint32(t *testing.T) {
+	large := func(v uint32) bool { return v > 2 }
+	if !PSomeUint32(large, []uint32{1, 3}) || PSomeUint32(large, []uint32{1, 2}) || PSomeUint32(nil, []uint32{3}) || PSomeUint32(large, nil) {
+		t.Errorf("TestPSomeUint32 failed")
+	}
+	if !PEveryUint32(large, []uint32{3, 4}) || PEveryUint32(large, []uint32{3, 1}) || PEveryUint32(nil, []uint32{3}) || PEveryUint32(large, nil) {
+		t.Errorf("TestPEveryUint32 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]uint32, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v uint32) bool { calls++; return v > 2 }
+	if !PSomeUint32(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeUint32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryUint32(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryUint32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterUint16(t *testing.T) {
+	large := func(v uint16) bool { return v > 2 }
+	list := []uint16{1, 5, 2, 4, 3}
+
+	if result := PFilterUint16(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]uint16{5, 4, 3}, result) {
+		t.Errorf("TestPFilterUint16 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveUint16(large, list); !reflect.DeepEqual([]uint16{1, 2}, result) {
+		t.Errorf("TestPRemoveUint16 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapUint16(large, func(v uint16) uint16 { return v * 2 }, list); !reflect.DeepEqual([]uint16{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapUint16 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterUint16(nil, list)) != 0 || len(PRemoveUint16(nil, list)) != 0 || len(PFilterMapUint16(large, nil, list)) != 0 || len(PFilterUint16(large, nil)) != 0 {
+		t.Errorf("TestPFilterUint16 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeUint16(t *testing.T) {
+	large := func(v uint16) bool { return v > 2 }
+	if !PSomeUint16(large, []uint16{1, 3}) || PSomeUint16(large, []uint16{1, 2}) || PSomeUint16(nil, []uint16{3}) || PSomeUint16(large, nil) {
+		t.Errorf("TestPSomeUint16 failed")
+	}
+	if !PEveryUint16(large, []uint16{3, 4}) || PEveryUint16(large, []uint16{3, 1}) || PEveryUint16(nil, []uint16{3}) || PEveryUint16(large, nil) {
+		t.Errorf("TestPEveryUint16 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]uint16, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v uint16) bool { calls++; return v > 2 }
+	if !PSomeUint16(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeUint16 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryUint16(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryUint16 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterUint8(t *testing.T) {
+	large := func(v uint8) bool { return v > 2 }
+	list := []uint8{1, 5, 2, 4, 3}
+
+	if result := PFilterUint8(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]uint8{5, 4, 3}, result) {
+		t.Errorf("TestPFilterUint8 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveUint8(large, list); !reflect.DeepEqual([]uint8{1, 2}, result) {
+		t.Errorf("TestPRemoveUint8 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapUint8(large, func(v uint8) uint8 { return v * 2 }, list); !reflect.DeepEqual([]uint8{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapUint8 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterUint8(nil, list)) != 0 || len(PRemoveUint8(nil, list)) != 0 || len(PFilterMapUint8(large, nil, list)) != 0 || len(PFilterUint8(large, nil)) != 0 {
+		t.Errorf("TestPFilterUint8 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeUint8(t *testing.T) {
+	large := func(v uint8) bool { return v > 2 }
+	if !PSomeUint8(large, []uint8{1, 3}) || PSomeUint8(large, []uint8{1, 2}) || PSomeUint8(nil, []uint8{3}) || PSomeUint8(large, nil) {
+		t.Errorf("TestPSomeUint8 failed")
+	}
+	if !PEveryUint8(large, []uint8{3, 4}) || PEveryUint8(large, []uint8{3, 1}) || PEveryUint8(nil, []uint8{3}) || PEveryUint8(large, nil) {
+		t.Errorf("TestPEveryUint8 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]uint8, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v uint8) bool { calls++; return v > 2 }
+	if !PSomeUint8(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeUint8 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryUint8(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryUint8 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterFloat64(t *testing.T) {
+	large := func(v float64) bool { return v > 2 }
+	list := []float64{1, 5, 2, 4, 3}
+
+	if result := PFilterFloat64(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]float64{5, 4, 3}, result) {
+		t.Errorf("TestPFilterFloat64 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveFloat64(large, list); !reflect.DeepEqual([]float64{1, 2}, result) {
+		t.Errorf("TestPRemoveFloat64 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapFloat64(large, func(v float64) float64 { return v * 2 }, list); !reflect.DeepEqual([]float64{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapFloat64 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterFloat64(nil, list)) != 0 || len(PRemoveFloat64(nil, list)) != 0 || len(PFilterMapFloat64(large, nil, list)) != 0 || len(PFilterFloat64(large, nil)) != 0 {
+		t.Errorf("TestPFilterFloat64 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeFloat64(t *testing.T) {
+	large := func(v float64) bool { return v > 2 }
+	if !PSomeFloat64(large, []float64{1, 3}) || PSomeFloat64(large, []float64{1, 2}) || PSomeFloat64(nil, []float64{3}) || PSomeFloat64(large, nil) {
+		t.Errorf("TestPSomeFloat64 failed")
+	}
+	if !PEveryFloat64(large, []float64{3, 4}) || PEveryFloat64(large, []float64{3, 1}) || PEveryFloat64(nil, []float64{3}) || PEveryFloat64(large, nil) {
+		t.Errorf("TestPEveryFloat64 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]float64, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v float64) bool { calls++; return v > 2 }
+	if !PSomeFloat64(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeFloat64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryFloat64(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryFloat64 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterFloat32(t *testing.T) {
+	large := func(v float32) bool { return v > 2 }
+	list := []float32{1, 5, 2, 4, 3}
+
+	if result := PFilterFloat32(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]float32{5, 4, 3}, result) {
+		t.Errorf("TestPFilterFloat32 failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemoveFloat32(large, list); !reflect.DeepEqual([]float32{1, 2}, result) {
+		t.Errorf("TestPRemoveFloat32 failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMapFloat32(large, func(v float32) float32 { return v * 2 }, list); !reflect.DeepEqual([]float32{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMapFloat32 failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilterFloat32(nil, list)) != 0 || len(PRemoveFloat32(nil, list)) != 0 || len(PFilterMapFloat32(large, nil, list)) != 0 || len(PFilterFloat32(large, nil)) != 0 {
+		t.Errorf("TestPFilterFloat32 failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSomeFloat32(t *testing.T) {
+	large := func(v float32) bool { return v > 2 }
+	if !PSomeFloat32(large, []float32{1, 3}) || PSomeFloat32(large, []float32{1, 2}) || PSomeFloat32(nil, []float32{3}) || PSomeFloat32(large, nil) {
+		t.Errorf("TestPSomeFloat32 failed")
+	}
+	if !PEveryFloat32(large, []float32{3, 4}) || PEveryFloat32(large, []float32{3, 1}) || PEveryFloat32(nil, []float32{3}) || PEveryFloat32(large, nil) {
+		t.Errorf("TestPEveryFloat32 failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]float32, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v float32) bool { calls++; return v > 2 }
+	if !PSomeFloat32(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSomeFloat32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEveryFloat32(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEveryFloat32 failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+
+func TestPFilterStr(t *testing.T) {
+	long := func(v string) bool { return len(v) > 1 }
+	list := []string{"a", "bb", "c", "dd"}
+
+	if result := PFilterStr(long, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]string{"bb", "dd"}, result) {
+		t.Errorf("TestPFilterStr failed. Expected=[bb dd], actual=%v", result)
+	}
+	if result := PRemoveStr(long, list); !reflect.DeepEqual([]string{"a", "c"}, result) {
+		t.Errorf("TestPRemoveStr failed. Expected=[a c], actual=%v", result)
+	}
+	if result := PFilterMapStr(long, func(v string) string { return v + "!" }, list); !reflect.DeepEqual([]string{"bb!", "dd!"}, result) {
+		t.Errorf("TestPFilterMapStr failed. Expected=[bb! dd!], actual=%v", result)
+	}
+	if !PSomeStr(long, list) || PEveryStr(long, list) || !PEveryStr(long, []string{"bb"}) {
+		t.Errorf("TestPSomeStr failed")
+	}
+}
@@ -0,0 +1,550 @@
+package fp
+
+import "context"
+
+
+// PMapStreamInt applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamInt(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamInt(ctx context.Context, f func(int) int, list []int, opts ...ParallelOptions) <-chan int {
+	out := make(chan int)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]int, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero int
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamInt64 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamInt64(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamInt64(ctx context.Context, f func(int64) int64, list []int64, opts ...ParallelOptions) <-chan int64 {
+	out := make(chan int64)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]int64, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero int64
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamInt32 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamInt32(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamInt32(ctx context.Context, f func(int32) int32, list []int32, opts ...ParallelOptions) <-chan int32 {
+	out := make(chan int32)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]int32, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero int32
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamInt16 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamInt16(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamInt16(ctx context.Context, f func(int16) int16, list []int16, opts ...ParallelOptions) <-chan int16 {
+	out := make(chan int16)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]int16, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero int16
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamInt8 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamInt8(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamInt8(ctx context.Context, f func(int8) int8, list []int8, opts ...ParallelOptions) <-chan int8 {
+	out := make(chan int8)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]int8, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero int8
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamUint applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamUint(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamUint(ctx context.Context, f func(uint) uint, list []uint, opts ...ParallelOptions) <-chan uint {
+	out := make(chan uint)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]uint, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero uint
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamUint64 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamUint64(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamUint64(ctx context.Context, f func(uint64) uint64, list []uint64, opts ...ParallelOptions) <-chan uint64 {
+	out := make(chan uint64)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]uint64, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero uint64
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamUint32 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamUint32(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamUint32(ctx context.Context, f func(uint32) uint32, list []uint32, opts ...ParallelOptions) <-chan uint32 {
+	out := make(chan uint32)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]uint32, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero uint32
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamUint16 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamUint16(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamUint16(ctx context.Context, f func(uint16) uint16, list []uint16, opts ...ParallelOptions) <-chan uint16 {
+	out := make(chan uint16)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]uint16, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero uint16
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamUint8 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamUint8(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamUint8(ctx context.Context, f func(uint8) uint8, list []uint8, opts ...ParallelOptions) <-chan uint8 {
+	out := make(chan uint8)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]uint8, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero uint8
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamFloat64 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamFloat64(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamFloat64(ctx context.Context, f func(float64) float64, list []float64, opts ...ParallelOptions) <-chan float64 {
+	out := make(chan float64)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]float64, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero float64
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamFloat32 applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamFloat32(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamFloat32(ctx context.Context, f func(float32) float32, list []float32, opts ...ParallelOptions) <-chan float32 {
+	out := make(chan float32)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]float32, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero float32
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PMapStreamStr applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStreamStr(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStreamStr(ctx context.Context, f func(string) string, list []string, opts ...ParallelOptions) <-chan string {
+	out := make(chan string)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]string, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero string
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,782 @@
+package fp
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPMapStreamInt(t *testing.T) {
+	list := make([]int, 50)
+	for i := range list {
+		list[i] = int(i % 10)
+	}
+	double := func(v int) int { return v * 2 }
+
+	var result []int
+	for v := range PMapStreamInt(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapInt(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamInt failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamInt(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamInt failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamInt(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamInt failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamIntLookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v int) int {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamIntLookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamInt(ctx, count, make([]int, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamIntLookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamIntLookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamInt64(t *testing.T) {
+	list := make([]int64, 50)
+	for i := range list {
+		list[i] = int64(i % 10)
+	}
+	double := func(v int64) int64 { return v * 2 }
+
+	var result []int64
+	for v := range PMapStreamInt64(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapInt64(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamInt64 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamInt64(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamInt64 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamInt64(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamInt64 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamInt64LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v int64) int64 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamInt64LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamInt64(ctx, count, make([]int64, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamInt64LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamInt64LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamInt32(t *testing.T) {
+	list := make([]int32, 50)
+	for i := range list {
+		list[i] = int32(i % 10)
+	}
+	double := func(v int32) int32 { return v * 2 }
+
+	var result []int32
+	for v := range PMapStreamInt32(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapInt32(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamInt32 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamInt32(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamInt32 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamInt32(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamInt32 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamInt32LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v int32) int32 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamInt32LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamInt32(ctx, count, make([]int32, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamInt32LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamInt32LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamInt16(t *testing.T) {
+	list := make([]int16, 50)
+	for i := range list {
+		list[i] = int16(i % 10)
+	}
+	double := func(v int16) int16 { return v * 2 }
+
+	var result []int16
+	for v := range PMapStreamInt16(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapInt16(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamInt16 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamInt16(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamInt16 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamInt16(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamInt16 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamInt16LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v int16) int16 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamInt16LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamInt16(ctx, count, make([]int16, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamInt16LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamInt16LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamInt8(t *testing.T) {
+	list := make([]int8, 50)
+	for i := range list {
+		list[i] = int8(i % 10)
+	}
+	double := func(v int8) int8 { return v * 2 }
+
+	var result []int8
+	for v := range PMapStreamInt8(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapInt8(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamInt8 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamInt8(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamInt8 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamInt8(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamInt8 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamInt8LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v int8) int8 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamInt8LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamInt8(ctx, count, make([]int8, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamInt8LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamInt8LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamUint(t *testing.T) {
+	list := make([]uint, 50)
+	for i := range list {
+		list[i] = uint(i % 10)
+	}
+	double := func(v uint) uint { return v * 2 }
+
+	var result []uint
+	for v := range PMapStreamUint(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapUint(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamUint failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamUint(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamUint failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamUint(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamUint failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamUintLookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v uint) uint {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamUintLookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamUint(ctx, count, make([]uint, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamUintLookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamUintLookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamUint64(t *testing.T) {
+	list := make([]uint64, 50)
+	for i := range list {
+		list[i] = uint64(i % 10)
+	}
+	double := func(v uint64) uint64 { return v * 2 }
+
+	var result []uint64
+	for v := range PMapStreamUint64(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapUint64(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamUint64 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamUint64(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamUint64 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamUint64(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamUint64 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamUint64LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v uint64) uint64 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamUint64LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamUint64(ctx, count, make([]uint64, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamUint64LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamUint64LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamUint32(t *testing.T) {
+	list := make([]uint32, 50)
+	for i := range list {
+		list[i] = uint32(i % 10)
+	}
+	double := func(v uint32) uint32 { return v * 2 }
+
+	var result []uint32
+	for v := range PMapStreamUint32(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapUint32(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamUint32 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamUint32(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamUint32 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamUint32(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamUint32 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamUint32LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v uint32) uint32 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamUint32LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamUint32(ctx, count, make([]uint32, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamUint32LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamUint32LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamUint16(t *testing.T) {
+	list := make([]uint16, 50)
+	for i := range list {
+		list[i] = uint16(i % 10)
+	}
+	double := func(v uint16) uint16 { return v * 2 }
+
+	var result []uint16
+	for v := range PMapStreamUint16(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapUint16(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamUint16 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamUint16(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamUint16 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamUint16(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamUint16 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamUint16LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v uint16) uint16 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamUint16LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamUint16(ctx, count, make([]uint16, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamUint16LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamUint16LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamUint8(t *testing.T) {
+	list := make([]uint8, 50)
+	for i := range list {
+		list[i] = uint8(i % 10)
+	}
+	double := func(v uint8) uint8 { return v * 2 }
+
+	var result []uint8
+	for v := range PMapStreamUint8(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapUint8(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamUint8 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamUint8(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamUint8 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamUint8(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamUint8 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamUint8LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v uint8) uint8 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamUint8LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamUint8(ctx, count, make([]uint8, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamUint8LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamUint8LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamFloat64(t *testing.T) {
+	list := make([]float64, 50)
+	for i := range list {
+		list[i] = float64(i % 10)
+	}
+	double := func(v float64) float64 { return v * 2 }
+
+	var result []float64
+	for v := range PMapStreamFloat64(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapFloat64(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamFloat64 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamFloat64(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamFloat64 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamFloat64(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamFloat64 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamFloat64LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v float64) float64 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamFloat64LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamFloat64(ctx, count, make([]float64, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamFloat64LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamFloat64LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamFloat32(t *testing.T) {
+	list := make([]float32, 50)
+	for i := range list {
+		list[i] = float32(i % 10)
+	}
+	double := func(v float32) float32 { return v * 2 }
+
+	var result []float32
+	for v := range PMapStreamFloat32(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := MapFloat32(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamFloat32 failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStreamFloat32(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamFloat32 failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStreamFloat32(nil, double, nil); ok {
+		t.Errorf("TestPMapStreamFloat32 failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStreamFloat32LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v float32) float32 {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStreamFloat32LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStreamFloat32(ctx, count, make([]float32, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStreamFloat32LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStreamFloat32LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+
+func TestPMapStreamStr(t *testing.T) {
+	list := []string{"a", "b", "c", "d", "e"}
+	upper := func(v string) string { return v + "!" }
+
+	var result []string
+	for v := range PMapStreamStr(nil, upper, list, ParallelOptions{Workers: 2, LookAhead: 2}) {
+		result = append(result, v)
+	}
+	if expected := MapStr(upper, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStreamStr failed. Expected=%v, actual=%v", expected, result)
+	}
+	if _, ok := <-PMapStreamStr(nil, nil, list); ok {
+		t.Errorf("TestPMapStreamStr failed. Expected closed channel for nil function")
+	}
+}
@@ -0,0 +1,687 @@
+package fp
+
+// AndInt combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndInt(preds ...func(int) bool) func(int) bool {
+	return func(v int) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrInt combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrInt(preds ...func(int) bool) func(int) bool {
+	return func(v int) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotInt returns the predicate which returns the opposite truth value. Same as ComplementInt.
+// Returns nil if the predicate is nil
+func NotInt(pred func(int) bool) func(int) bool {
+	return ComplementInt(pred)
+}
+
+// NoneInt returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneInt(pred func(int) bool, list []int) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndInt64 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndInt64(preds ...func(int64) bool) func(int64) bool {
+	return func(v int64) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrInt64 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrInt64(preds ...func(int64) bool) func(int64) bool {
+	return func(v int64) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotInt64 returns the predicate which returns the opposite truth value. Same as ComplementInt64.
+// Returns nil if the predicate is nil
+func NotInt64(pred func(int64) bool) func(int64) bool {
+	return ComplementInt64(pred)
+}
+
+// NoneInt64 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneInt64(pred func(int64) bool, list []int64) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndInt32 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndInt32(preds ...func(int32) bool) func(int32) bool {
+	return func(v int32) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrInt32 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrInt32(preds ...func(int32) bool) func(int32) bool {
+	return func(v int32) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotInt32 returns the predicate which returns the opposite truth value. Same as ComplementInt32.
+// Returns nil if the predicate is nil
+func NotInt32(pred func(int32) bool) func(int32) bool {
+	return ComplementInt32(pred)
+}
+
+// NoneInt32 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneInt32(pred func(int32) bool, list []int32) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndInt16 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndInt16(preds ...func(int16) bool) func(int16) bool {
+	return func(v int16) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrInt16 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrInt16(preds ...func(int16) bool) func(int16) bool {
+	return func(v int16) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotInt16 returns the predicate which returns the opposite truth value. Same as ComplementInt16.
+// Returns nil if the predicate is nil
+func NotInt16(pred func(int16) bool) func(int16) bool {
+	return ComplementInt16(pred)
+}
+
+// NoneInt16 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneInt16(pred func(int16) bool, list []int16) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndInt8 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndInt8(preds ...func(int8) bool) func(int8) bool {
+	return func(v int8) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrInt8 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrInt8(preds ...func(int8) bool) func(int8) bool {
+	return func(v int8) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotInt8 returns the predicate which returns the opposite truth value. Same as ComplementInt8.
+// Returns nil if the predicate is nil
+func NotInt8(pred func(int8) bool) func(int8) bool {
+	return ComplementInt8(pred)
+}
+
+// NoneInt8 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneInt8(pred func(int8) bool, list []int8) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndUint combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndUint(preds ...func(uint) bool) func(uint) bool {
+	return func(v uint) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrUint combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrUint(preds ...func(uint) bool) func(uint) bool {
+	return func(v uint) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotUint returns the predicate which returns the opposite truth value. Same as ComplementUint.
+// Returns nil if the predicate is nil
+func NotUint(pred func(uint) bool) func(uint) bool {
+	return ComplementUint(pred)
+}
+
+// NoneUint returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneUint(pred func(uint) bool, list []uint) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndUint64 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndUint64(preds ...func(uint64) bool) func(uint64) bool {
+	return func(v uint64) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrUint64 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrUint64(preds ...func(uint64) bool) func(uint64) bool {
+	return func(v uint64) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotUint64 returns the predicate which returns the opposite truth value. Same as ComplementUint64.
+// Returns nil if the predicate is nil
+func NotUint64(pred func(uint64) bool) func(uint64) bool {
+	return ComplementUint64(pred)
+}
+
+// NoneUint64 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneUint64(pred func(uint64) bool, list []uint64) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndUint32 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndUint32(preds ...func(uint32) bool) func(uint32) bool {
+	return func(v uint32) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrUint32 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrUint32(preds ...func(uint32) bool) func(uint32) bool {
+	return func(v uint32) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotUint32 returns the predicate which returns the opposite truth value. Same as ComplementUint32.
+// Returns nil if the predicate is nil
+func NotUint32(pred func(uint32) bool) func(uint32) bool {
+	return ComplementUint32(pred)
+}
+
+// NoneUint32 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneUint32(pred func(uint32) bool, list []uint32) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndUint16 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndUint16(preds ...func(uint16) bool) func(uint16) bool {
+	return func(v uint16) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrUint16 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrUint16(preds ...func(uint16) bool) func(uint16) bool {
+	return func(v uint16) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotUint16 returns the predicate which returns the opposite truth value. Same as ComplementUint16.
+// Returns nil if the predicate is nil
+func NotUint16(pred func(uint16) bool) func(uint16) bool {
+	return ComplementUint16(pred)
+}
+
+// NoneUint16 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneUint16(pred func(uint16) bool, list []uint16) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndUint8 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndUint8(preds ...func(uint8) bool) func(uint8) bool {
+	return func(v uint8) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrUint8 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrUint8(preds ...func(uint8) bool) func(uint8) bool {
+	return func(v uint8) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotUint8 returns the predicate which returns the opposite truth value. Same as ComplementUint8.
+// Returns nil if the predicate is nil
+func NotUint8(pred func(uint8) bool) func(uint8) bool {
+	return ComplementUint8(pred)
+}
+
+// NoneUint8 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneUint8(pred func(uint8) bool, list []uint8) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndFloat64 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndFloat64(preds ...func(float64) bool) func(float64) bool {
+	return func(v float64) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrFloat64 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrFloat64(preds ...func(float64) bool) func(float64) bool {
+	return func(v float64) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotFloat64 returns the predicate which returns the opposite truth value. Same as ComplementFloat64.
+// Returns nil if the predicate is nil
+func NotFloat64(pred func(float64) bool) func(float64) bool {
+	return ComplementFloat64(pred)
+}
+
+// NoneFloat64 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneFloat64(pred func(float64) bool, list []float64) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndFloat32 combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndFloat32(preds ...func(float32) bool) func(float32) bool {
+	return func(v float32) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrFloat32 combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrFloat32(preds ...func(float32) bool) func(float32) bool {
+	return func(v float32) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotFloat32 returns the predicate which returns the opposite truth value. Same as ComplementFloat32.
+// Returns nil if the predicate is nil
+func NotFloat32(pred func(float32) bool) func(float32) bool {
+	return ComplementFloat32(pred)
+}
+
+// NoneFloat32 returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneFloat32(pred func(float32) bool, list []float32) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndStr combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndStr(preds ...func(string) bool) func(string) bool {
+	return func(v string) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrStr combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrStr(preds ...func(string) bool) func(string) bool {
+	return func(v string) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotStr returns the predicate which returns the opposite truth value. Same as ComplementStr.
+// Returns nil if the predicate is nil
+func NotStr(pred func(string) bool) func(string) bool {
+	return ComplementStr(pred)
+}
+
+// NoneStr returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneStr(pred func(string) bool, list []string) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AndBool combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func AndBool(preds ...func(bool) bool) func(bool) bool {
+	return func(v bool) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// OrBool combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func OrBool(preds ...func(bool) bool) func(bool) bool {
+	return func(v bool) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotBool returns the predicate which returns the opposite truth value. Same as ComplementBool.
+// Returns nil if the predicate is nil
+func NotBool(pred func(bool) bool) func(bool) bool {
+	return ComplementBool(pred)
+}
+
+// NoneBool returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func NoneBool(pred func(bool) bool, list []bool) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
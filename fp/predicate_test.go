@@ -0,0 +1,595 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAndOrNotInt(t *testing.T) {
+	gt1 := func(v int) bool { return v > 1 }
+	lt4 := func(v int) bool { return v < 4 }
+
+	expected := []int{2, 3}
+	actual := FilterInt(AndInt(gt1, lt4), []int{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{1, 4}
+	actual = FilterInt(OrInt(NotInt(gt1), NotInt(lt4)), []int{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndInt()(1) || !AndInt(nil, gt1)(2) {
+		t.Errorf("TestAndInt failed.")
+	}
+
+	if OrInt()(1) || !OrInt(nil, gt1)(2) {
+		t.Errorf("TestOrInt failed.")
+	}
+
+	if NotInt(nil) != nil {
+		t.Errorf("TestNotInt failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneInt(t *testing.T) {
+	gt5 := func(v int) bool { return v > 5 }
+
+	if !NoneInt(gt5, []int{1, 2, 3}) {
+		t.Errorf("TestNoneInt failed.")
+	}
+
+	if NoneInt(gt5, []int{1, 6, 3}) {
+		t.Errorf("TestNoneInt failed.")
+	}
+
+	if !NoneInt(gt5, nil) || NoneInt(nil, []int{1}) {
+		t.Errorf("TestNoneInt failed.")
+	}
+}
+
+func TestAndOrNotInt64(t *testing.T) {
+	gt1 := func(v int64) bool { return v > 1 }
+	lt4 := func(v int64) bool { return v < 4 }
+
+	expected := []int64{2, 3}
+	actual := FilterInt64(AndInt64(gt1, lt4), []int64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{1, 4}
+	actual = FilterInt64(OrInt64(NotInt64(gt1), NotInt64(lt4)), []int64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndInt64()(1) || !AndInt64(nil, gt1)(2) {
+		t.Errorf("TestAndInt64 failed.")
+	}
+
+	if OrInt64()(1) || !OrInt64(nil, gt1)(2) {
+		t.Errorf("TestOrInt64 failed.")
+	}
+
+	if NotInt64(nil) != nil {
+		t.Errorf("TestNotInt64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneInt64(t *testing.T) {
+	gt5 := func(v int64) bool { return v > 5 }
+
+	if !NoneInt64(gt5, []int64{1, 2, 3}) {
+		t.Errorf("TestNoneInt64 failed.")
+	}
+
+	if NoneInt64(gt5, []int64{1, 6, 3}) {
+		t.Errorf("TestNoneInt64 failed.")
+	}
+
+	if !NoneInt64(gt5, nil) || NoneInt64(nil, []int64{1}) {
+		t.Errorf("TestNoneInt64 failed.")
+	}
+}
+
+func TestAndOrNotInt32(t *testing.T) {
+	gt1 := func(v int32) bool { return v > 1 }
+	lt4 := func(v int32) bool { return v < 4 }
+
+	expected := []int32{2, 3}
+	actual := FilterInt32(AndInt32(gt1, lt4), []int32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{1, 4}
+	actual = FilterInt32(OrInt32(NotInt32(gt1), NotInt32(lt4)), []int32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndInt32()(1) || !AndInt32(nil, gt1)(2) {
+		t.Errorf("TestAndInt32 failed.")
+	}
+
+	if OrInt32()(1) || !OrInt32(nil, gt1)(2) {
+		t.Errorf("TestOrInt32 failed.")
+	}
+
+	if NotInt32(nil) != nil {
+		t.Errorf("TestNotInt32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneInt32(t *testing.T) {
+	gt5 := func(v int32) bool { return v > 5 }
+
+	if !NoneInt32(gt5, []int32{1, 2, 3}) {
+		t.Errorf("TestNoneInt32 failed.")
+	}
+
+	if NoneInt32(gt5, []int32{1, 6, 3}) {
+		t.Errorf("TestNoneInt32 failed.")
+	}
+
+	if !NoneInt32(gt5, nil) || NoneInt32(nil, []int32{1}) {
+		t.Errorf("TestNoneInt32 failed.")
+	}
+}
+
+func TestAndOrNotInt16(t *testing.T) {
+	gt1 := func(v int16) bool { return v > 1 }
+	lt4 := func(v int16) bool { return v < 4 }
+
+	expected := []int16{2, 3}
+	actual := FilterInt16(AndInt16(gt1, lt4), []int16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{1, 4}
+	actual = FilterInt16(OrInt16(NotInt16(gt1), NotInt16(lt4)), []int16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndInt16()(1) || !AndInt16(nil, gt1)(2) {
+		t.Errorf("TestAndInt16 failed.")
+	}
+
+	if OrInt16()(1) || !OrInt16(nil, gt1)(2) {
+		t.Errorf("TestOrInt16 failed.")
+	}
+
+	if NotInt16(nil) != nil {
+		t.Errorf("TestNotInt16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneInt16(t *testing.T) {
+	gt5 := func(v int16) bool { return v > 5 }
+
+	if !NoneInt16(gt5, []int16{1, 2, 3}) {
+		t.Errorf("TestNoneInt16 failed.")
+	}
+
+	if NoneInt16(gt5, []int16{1, 6, 3}) {
+		t.Errorf("TestNoneInt16 failed.")
+	}
+
+	if !NoneInt16(gt5, nil) || NoneInt16(nil, []int16{1}) {
+		t.Errorf("TestNoneInt16 failed.")
+	}
+}
+
+func TestAndOrNotInt8(t *testing.T) {
+	gt1 := func(v int8) bool { return v > 1 }
+	lt4 := func(v int8) bool { return v < 4 }
+
+	expected := []int8{2, 3}
+	actual := FilterInt8(AndInt8(gt1, lt4), []int8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{1, 4}
+	actual = FilterInt8(OrInt8(NotInt8(gt1), NotInt8(lt4)), []int8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndInt8()(1) || !AndInt8(nil, gt1)(2) {
+		t.Errorf("TestAndInt8 failed.")
+	}
+
+	if OrInt8()(1) || !OrInt8(nil, gt1)(2) {
+		t.Errorf("TestOrInt8 failed.")
+	}
+
+	if NotInt8(nil) != nil {
+		t.Errorf("TestNotInt8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneInt8(t *testing.T) {
+	gt5 := func(v int8) bool { return v > 5 }
+
+	if !NoneInt8(gt5, []int8{1, 2, 3}) {
+		t.Errorf("TestNoneInt8 failed.")
+	}
+
+	if NoneInt8(gt5, []int8{1, 6, 3}) {
+		t.Errorf("TestNoneInt8 failed.")
+	}
+
+	if !NoneInt8(gt5, nil) || NoneInt8(nil, []int8{1}) {
+		t.Errorf("TestNoneInt8 failed.")
+	}
+}
+
+func TestAndOrNotUint(t *testing.T) {
+	gt1 := func(v uint) bool { return v > 1 }
+	lt4 := func(v uint) bool { return v < 4 }
+
+	expected := []uint{2, 3}
+	actual := FilterUint(AndUint(gt1, lt4), []uint{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{1, 4}
+	actual = FilterUint(OrUint(NotUint(gt1), NotUint(lt4)), []uint{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndUint()(1) || !AndUint(nil, gt1)(2) {
+		t.Errorf("TestAndUint failed.")
+	}
+
+	if OrUint()(1) || !OrUint(nil, gt1)(2) {
+		t.Errorf("TestOrUint failed.")
+	}
+
+	if NotUint(nil) != nil {
+		t.Errorf("TestNotUint failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneUint(t *testing.T) {
+	gt5 := func(v uint) bool { return v > 5 }
+
+	if !NoneUint(gt5, []uint{1, 2, 3}) {
+		t.Errorf("TestNoneUint failed.")
+	}
+
+	if NoneUint(gt5, []uint{1, 6, 3}) {
+		t.Errorf("TestNoneUint failed.")
+	}
+
+	if !NoneUint(gt5, nil) || NoneUint(nil, []uint{1}) {
+		t.Errorf("TestNoneUint failed.")
+	}
+}
+
+func TestAndOrNotUint64(t *testing.T) {
+	gt1 := func(v uint64) bool { return v > 1 }
+	lt4 := func(v uint64) bool { return v < 4 }
+
+	expected := []uint64{2, 3}
+	actual := FilterUint64(AndUint64(gt1, lt4), []uint64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{1, 4}
+	actual = FilterUint64(OrUint64(NotUint64(gt1), NotUint64(lt4)), []uint64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndUint64()(1) || !AndUint64(nil, gt1)(2) {
+		t.Errorf("TestAndUint64 failed.")
+	}
+
+	if OrUint64()(1) || !OrUint64(nil, gt1)(2) {
+		t.Errorf("TestOrUint64 failed.")
+	}
+
+	if NotUint64(nil) != nil {
+		t.Errorf("TestNotUint64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneUint64(t *testing.T) {
+	gt5 := func(v uint64) bool { return v > 5 }
+
+	if !NoneUint64(gt5, []uint64{1, 2, 3}) {
+		t.Errorf("TestNoneUint64 failed.")
+	}
+
+	if NoneUint64(gt5, []uint64{1, 6, 3}) {
+		t.Errorf("TestNoneUint64 failed.")
+	}
+
+	if !NoneUint64(gt5, nil) || NoneUint64(nil, []uint64{1}) {
+		t.Errorf("TestNoneUint64 failed.")
+	}
+}
+
+func TestAndOrNotUint32(t *testing.T) {
+	gt1 := func(v uint32) bool { return v > 1 }
+	lt4 := func(v uint32) bool { return v < 4 }
+
+	expected := []uint32{2, 3}
+	actual := FilterUint32(AndUint32(gt1, lt4), []uint32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{1, 4}
+	actual = FilterUint32(OrUint32(NotUint32(gt1), NotUint32(lt4)), []uint32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndUint32()(1) || !AndUint32(nil, gt1)(2) {
+		t.Errorf("TestAndUint32 failed.")
+	}
+
+	if OrUint32()(1) || !OrUint32(nil, gt1)(2) {
+		t.Errorf("TestOrUint32 failed.")
+	}
+
+	if NotUint32(nil) != nil {
+		t.Errorf("TestNotUint32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneUint32(t *testing.T) {
+	gt5 := func(v uint32) bool { return v > 5 }
+
+	if !NoneUint32(gt5, []uint32{1, 2, 3}) {
+		t.Errorf("TestNoneUint32 failed.")
+	}
+
+	if NoneUint32(gt5, []uint32{1, 6, 3}) {
+		t.Errorf("TestNoneUint32 failed.")
+	}
+
+	if !NoneUint32(gt5, nil) || NoneUint32(nil, []uint32{1}) {
+		t.Errorf("TestNoneUint32 failed.")
+	}
+}
+
+func TestAndOrNotUint16(t *testing.T) {
+	gt1 := func(v uint16) bool { return v > 1 }
+	lt4 := func(v uint16) bool { return v < 4 }
+
+	expected := []uint16{2, 3}
+	actual := FilterUint16(AndUint16(gt1, lt4), []uint16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{1, 4}
+	actual = FilterUint16(OrUint16(NotUint16(gt1), NotUint16(lt4)), []uint16{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndUint16()(1) || !AndUint16(nil, gt1)(2) {
+		t.Errorf("TestAndUint16 failed.")
+	}
+
+	if OrUint16()(1) || !OrUint16(nil, gt1)(2) {
+		t.Errorf("TestOrUint16 failed.")
+	}
+
+	if NotUint16(nil) != nil {
+		t.Errorf("TestNotUint16 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneUint16(t *testing.T) {
+	gt5 := func(v uint16) bool { return v > 5 }
+
+	if !NoneUint16(gt5, []uint16{1, 2, 3}) {
+		t.Errorf("TestNoneUint16 failed.")
+	}
+
+	if NoneUint16(gt5, []uint16{1, 6, 3}) {
+		t.Errorf("TestNoneUint16 failed.")
+	}
+
+	if !NoneUint16(gt5, nil) || NoneUint16(nil, []uint16{1}) {
+		t.Errorf("TestNoneUint16 failed.")
+	}
+}
+
+func TestAndOrNotUint8(t *testing.T) {
+	gt1 := func(v uint8) bool { return v > 1 }
+	lt4 := func(v uint8) bool { return v < 4 }
+
+	expected := []uint8{2, 3}
+	actual := FilterUint8(AndUint8(gt1, lt4), []uint8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{1, 4}
+	actual = FilterUint8(OrUint8(NotUint8(gt1), NotUint8(lt4)), []uint8{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndUint8()(1) || !AndUint8(nil, gt1)(2) {
+		t.Errorf("TestAndUint8 failed.")
+	}
+
+	if OrUint8()(1) || !OrUint8(nil, gt1)(2) {
+		t.Errorf("TestOrUint8 failed.")
+	}
+
+	if NotUint8(nil) != nil {
+		t.Errorf("TestNotUint8 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneUint8(t *testing.T) {
+	gt5 := func(v uint8) bool { return v > 5 }
+
+	if !NoneUint8(gt5, []uint8{1, 2, 3}) {
+		t.Errorf("TestNoneUint8 failed.")
+	}
+
+	if NoneUint8(gt5, []uint8{1, 6, 3}) {
+		t.Errorf("TestNoneUint8 failed.")
+	}
+
+	if !NoneUint8(gt5, nil) || NoneUint8(nil, []uint8{1}) {
+		t.Errorf("TestNoneUint8 failed.")
+	}
+}
+
+func TestAndOrNotFloat64(t *testing.T) {
+	gt1 := func(v float64) bool { return v > 1 }
+	lt4 := func(v float64) bool { return v < 4 }
+
+	expected := []float64{2, 3}
+	actual := FilterFloat64(AndFloat64(gt1, lt4), []float64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float64{1, 4}
+	actual = FilterFloat64(OrFloat64(NotFloat64(gt1), NotFloat64(lt4)), []float64{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndFloat64()(1) || !AndFloat64(nil, gt1)(2) {
+		t.Errorf("TestAndFloat64 failed.")
+	}
+
+	if OrFloat64()(1) || !OrFloat64(nil, gt1)(2) {
+		t.Errorf("TestOrFloat64 failed.")
+	}
+
+	if NotFloat64(nil) != nil {
+		t.Errorf("TestNotFloat64 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneFloat64(t *testing.T) {
+	gt5 := func(v float64) bool { return v > 5 }
+
+	if !NoneFloat64(gt5, []float64{1, 2, 3}) {
+		t.Errorf("TestNoneFloat64 failed.")
+	}
+
+	if NoneFloat64(gt5, []float64{1, 6, 3}) {
+		t.Errorf("TestNoneFloat64 failed.")
+	}
+
+	if !NoneFloat64(gt5, nil) || NoneFloat64(nil, []float64{1}) {
+		t.Errorf("TestNoneFloat64 failed.")
+	}
+}
+
+func TestAndOrNotFloat32(t *testing.T) {
+	gt1 := func(v float32) bool { return v > 1 }
+	lt4 := func(v float32) bool { return v < 4 }
+
+	expected := []float32{2, 3}
+	actual := FilterFloat32(AndFloat32(gt1, lt4), []float32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []float32{1, 4}
+	actual = FilterFloat32(OrFloat32(NotFloat32(gt1), NotFloat32(lt4)), []float32{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !AndFloat32()(1) || !AndFloat32(nil, gt1)(2) {
+		t.Errorf("TestAndFloat32 failed.")
+	}
+
+	if OrFloat32()(1) || !OrFloat32(nil, gt1)(2) {
+		t.Errorf("TestOrFloat32 failed.")
+	}
+
+	if NotFloat32(nil) != nil {
+		t.Errorf("TestNotFloat32 failed. Expected nil function for nil input")
+	}
+}
+
+func TestNoneFloat32(t *testing.T) {
+	gt5 := func(v float32) bool { return v > 5 }
+
+	if !NoneFloat32(gt5, []float32{1, 2, 3}) {
+		t.Errorf("TestNoneFloat32 failed.")
+	}
+
+	if NoneFloat32(gt5, []float32{1, 6, 3}) {
+		t.Errorf("TestNoneFloat32 failed.")
+	}
+
+	if !NoneFloat32(gt5, nil) || NoneFloat32(nil, []float32{1}) {
+		t.Errorf("TestNoneFloat32 failed.")
+	}
+}
+
+func TestAndOrNotStr(t *testing.T) {
+	notEmpty := func(v string) bool { return v != "" }
+	short := func(v string) bool { return len(v) < 3 }
+
+	expected := []string{"a", "bb"}
+	actual := FilterStr(AndStr(notEmpty, short), []string{"a", "", "bb", "ccc"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAndStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{"", "ccc"}
+	actual = FilterStr(OrStr(NotStr(notEmpty), NotStr(short)), []string{"a", "", "bb", "ccc"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOrStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestNoneStr(t *testing.T) {
+	empty := func(v string) bool { return v == "" }
+
+	if !NoneStr(empty, []string{"a", "b"}) || NoneStr(empty, []string{"a", ""}) {
+		t.Errorf("TestNoneStr failed.")
+	}
+
+	if NoneStr(nil, []string{"a"}) {
+		t.Errorf("TestNoneStr failed.")
+	}
+}
+
+func TestAndOrNotBool(t *testing.T) {
+	identity := func(v bool) bool { return v }
+
+	if !AndBool(identity, identity)(true) || AndBool(identity, NotBool(identity))(true) {
+		t.Errorf("TestAndBool failed.")
+	}
+
+	if !OrBool(identity, NotBool(identity))(false) || OrBool(identity)(false) {
+		t.Errorf("TestOrBool failed.")
+	}
+}
+
+func TestNoneBool(t *testing.T) {
+	identity := func(v bool) bool { return v }
+
+	if !NoneBool(identity, []bool{false, false}) || NoneBool(identity, []bool{false, true}) {
+		t.Errorf("TestNoneBool failed.")
+	}
+}
@@ -0,0 +1,43 @@
+package fp
+
+import "strings"
+
+// HasPrefixStr returns the predicate which checks if the string begins with prefix
+//
+// Example:
+//	fp.FilterStr(fp.HasPrefixStr("go"), []string{"gopher", "rust", "golang"}) // Returns ["gopher", "golang"]
+func HasPrefixStr(prefix string) func(string) bool {
+	return func(s string) bool {
+		return strings.HasPrefix(s, prefix)
+	}
+}
+
+// HasSuffixStr returns the predicate which checks if the string ends with suffix
+//
+// Example:
+//	fp.FilterStr(fp.HasSuffixStr(".go"), []string{"main.go", "README.md"}) // Returns ["main.go"]
+func HasSuffixStr(suffix string) func(string) bool {
+	return func(s string) bool {
+		return strings.HasSuffix(s, suffix)
+	}
+}
+
+// HasSubstrStr returns the predicate which checks if the string contains substr
+//
+// Example:
+//	fp.FilterStr(fp.HasSubstrStr("ph"), []string{"gopher", "golang"}) // Returns ["gopher"]
+func HasSubstrStr(substr string) func(string) bool {
+	return func(s string) bool {
+		return strings.Contains(s, substr)
+	}
+}
+
+// EqualFoldStr returns the predicate which checks if the string is equal to v under case-folding
+//
+// Example:
+//	fp.FilterStr(fp.EqualFoldStr("go"), []string{"Go", "GO", "rust"}) // Returns ["Go", "GO"]
+func EqualFoldStr(v string) func(string) bool {
+	return func(s string) bool {
+		return strings.EqualFold(s, v)
+	}
+}
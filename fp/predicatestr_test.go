@@ -0,0 +1,42 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHasPrefixStr(t *testing.T) {
+	expected := []string{"gopher", "golang"}
+	actual := FilterStr(HasPrefixStr("go"), []string{"gopher", "rust", "golang"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestHasPrefixStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestHasSuffixStr(t *testing.T) {
+	expected := []string{"main.go"}
+	actual := FilterStr(HasSuffixStr(".go"), []string{"main.go", "README.md"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestHasSuffixStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestHasSubstrStr(t *testing.T) {
+	expected := []string{"gopher"}
+	actual := FilterStr(HasSubstrStr("ph"), []string{"gopher", "golang"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestHasSubstrStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestEqualFoldStr(t *testing.T) {
+	expected := []string{"Go", "GO"}
+	actual := FilterStr(EqualFoldStr("go"), []string{"Go", "GO", "rust"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEqualFoldStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !SomeStr(OrStr(HasPrefixStr("x"), EqualFoldStr("RUST")), []string{"Go", "rust"}) {
+		t.Errorf("TestEqualFoldStr failed.")
+	}
+}
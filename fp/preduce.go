@@ -0,0 +1,505 @@
+package fp
+
+// PReduceInt divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b int) int { return a + b }
+//	addSquare := func(acc, v int) int { return acc + v*v }
+//	PReduceInt(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceInt(fReduce, fCombine func(int, int) int, identity int, list []int, opts ...ParallelOptions) int {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]int, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceInt64 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b int64) int64 { return a + b }
+//	addSquare := func(acc, v int64) int64 { return acc + v*v }
+//	PReduceInt64(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceInt64(fReduce, fCombine func(int64, int64) int64, identity int64, list []int64, opts ...ParallelOptions) int64 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]int64, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceInt32 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b int32) int32 { return a + b }
+//	addSquare := func(acc, v int32) int32 { return acc + v*v }
+//	PReduceInt32(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceInt32(fReduce, fCombine func(int32, int32) int32, identity int32, list []int32, opts ...ParallelOptions) int32 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]int32, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceInt16 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b int16) int16 { return a + b }
+//	addSquare := func(acc, v int16) int16 { return acc + v*v }
+//	PReduceInt16(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceInt16(fReduce, fCombine func(int16, int16) int16, identity int16, list []int16, opts ...ParallelOptions) int16 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]int16, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceInt8 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b int8) int8 { return a + b }
+//	addSquare := func(acc, v int8) int8 { return acc + v*v }
+//	PReduceInt8(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceInt8(fReduce, fCombine func(int8, int8) int8, identity int8, list []int8, opts ...ParallelOptions) int8 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]int8, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceUint divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b uint) uint { return a + b }
+//	addSquare := func(acc, v uint) uint { return acc + v*v }
+//	PReduceUint(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceUint(fReduce, fCombine func(uint, uint) uint, identity uint, list []uint, opts ...ParallelOptions) uint {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]uint, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceUint64 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b uint64) uint64 { return a + b }
+//	addSquare := func(acc, v uint64) uint64 { return acc + v*v }
+//	PReduceUint64(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceUint64(fReduce, fCombine func(uint64, uint64) uint64, identity uint64, list []uint64, opts ...ParallelOptions) uint64 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]uint64, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceUint32 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b uint32) uint32 { return a + b }
+//	addSquare := func(acc, v uint32) uint32 { return acc + v*v }
+//	PReduceUint32(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceUint32(fReduce, fCombine func(uint32, uint32) uint32, identity uint32, list []uint32, opts ...ParallelOptions) uint32 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]uint32, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceUint16 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b uint16) uint16 { return a + b }
+//	addSquare := func(acc, v uint16) uint16 { return acc + v*v }
+//	PReduceUint16(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceUint16(fReduce, fCombine func(uint16, uint16) uint16, identity uint16, list []uint16, opts ...ParallelOptions) uint16 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]uint16, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceUint8 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b uint8) uint8 { return a + b }
+//	addSquare := func(acc, v uint8) uint8 { return acc + v*v }
+//	PReduceUint8(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceUint8(fReduce, fCombine func(uint8, uint8) uint8, identity uint8, list []uint8, opts ...ParallelOptions) uint8 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]uint8, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceFloat64 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b float64) float64 { return a + b }
+//	addSquare := func(acc, v float64) float64 { return acc + v*v }
+//	PReduceFloat64(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceFloat64(fReduce, fCombine func(float64, float64) float64, identity float64, list []float64, opts ...ParallelOptions) float64 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]float64, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// PReduceFloat32 divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b float32) float32 { return a + b }
+//	addSquare := func(acc, v float32) float32 { return acc + v*v }
+//	PReduceFloat32(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduceFloat32(fReduce, fCombine func(float32, float32) float32, identity float32, list []float32, opts ...ParallelOptions) float32 {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]float32, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
@@ -0,0 +1,267 @@
+package fp
+
+import "testing"
+
+func TestPReduceInt(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	addSquare := func(acc, v int) int { return acc + v*v }
+	list := []int{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceInt(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceInt failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceInt(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceInt failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceInt(func(a, b int) int { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceInt failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceInt(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceInt failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceInt(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceInt failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceInt64(t *testing.T) {
+	add := func(a, b int64) int64 { return a + b }
+	addSquare := func(acc, v int64) int64 { return acc + v*v }
+	list := []int64{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceInt64(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceInt64 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceInt64(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceInt64 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceInt64(func(a, b int64) int64 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceInt64 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceInt64(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceInt64 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceInt64(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceInt64 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceInt32(t *testing.T) {
+	add := func(a, b int32) int32 { return a + b }
+	addSquare := func(acc, v int32) int32 { return acc + v*v }
+	list := []int32{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceInt32(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceInt32 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceInt32(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceInt32 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceInt32(func(a, b int32) int32 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceInt32 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceInt32(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceInt32 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceInt32(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceInt32 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceInt16(t *testing.T) {
+	add := func(a, b int16) int16 { return a + b }
+	addSquare := func(acc, v int16) int16 { return acc + v*v }
+	list := []int16{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceInt16(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceInt16 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceInt16(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceInt16 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceInt16(func(a, b int16) int16 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceInt16 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceInt16(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceInt16 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceInt16(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceInt16 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceInt8(t *testing.T) {
+	add := func(a, b int8) int8 { return a + b }
+	addSquare := func(acc, v int8) int8 { return acc + v*v }
+	list := []int8{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceInt8(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceInt8 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceInt8(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceInt8 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceInt8(func(a, b int8) int8 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceInt8 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceInt8(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceInt8 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceInt8(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceInt8 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceUint(t *testing.T) {
+	add := func(a, b uint) uint { return a + b }
+	addSquare := func(acc, v uint) uint { return acc + v*v }
+	list := []uint{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceUint(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceUint failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceUint(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceUint failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceUint(func(a, b uint) uint { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceUint failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceUint(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceUint failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceUint(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceUint failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceUint64(t *testing.T) {
+	add := func(a, b uint64) uint64 { return a + b }
+	addSquare := func(acc, v uint64) uint64 { return acc + v*v }
+	list := []uint64{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceUint64(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceUint64 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceUint64(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceUint64 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceUint64(func(a, b uint64) uint64 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceUint64 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceUint64(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceUint64 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceUint64(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceUint64 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceUint32(t *testing.T) {
+	add := func(a, b uint32) uint32 { return a + b }
+	addSquare := func(acc, v uint32) uint32 { return acc + v*v }
+	list := []uint32{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceUint32(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceUint32 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceUint32(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceUint32 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceUint32(func(a, b uint32) uint32 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceUint32 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceUint32(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceUint32 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceUint32(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceUint32 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceUint16(t *testing.T) {
+	add := func(a, b uint16) uint16 { return a + b }
+	addSquare := func(acc, v uint16) uint16 { return acc + v*v }
+	list := []uint16{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceUint16(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceUint16 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceUint16(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceUint16 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceUint16(func(a, b uint16) uint16 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceUint16 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceUint16(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceUint16 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceUint16(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceUint16 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceUint8(t *testing.T) {
+	add := func(a, b uint8) uint8 { return a + b }
+	addSquare := func(acc, v uint8) uint8 { return acc + v*v }
+	list := []uint8{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceUint8(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceUint8 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceUint8(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceUint8 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceUint8(func(a, b uint8) uint8 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceUint8 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceUint8(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceUint8 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceUint8(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceUint8 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceFloat64(t *testing.T) {
+	add := func(a, b float64) float64 { return a + b }
+	addSquare := func(acc, v float64) float64 { return acc + v*v }
+	list := []float64{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceFloat64(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceFloat64 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceFloat64(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceFloat64 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceFloat64(func(a, b float64) float64 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceFloat64 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceFloat64(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceFloat64 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceFloat64(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceFloat64 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+
+func TestPReduceFloat32(t *testing.T) {
+	add := func(a, b float32) float32 { return a + b }
+	addSquare := func(acc, v float32) float32 { return acc + v*v }
+	list := []float32{1, 2, 3, 4, 5, 6}
+
+	if v := PReduceFloat32(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduceFloat32 failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduceFloat32(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduceFloat32 failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduceFloat32(func(a, b float32) float32 { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduceFloat32 failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduceFloat32(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduceFloat32 failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduceFloat32(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduceFloat32 failed. Expected identity for nil function, actual=%v", v)
+	}
+}
@@ -0,0 +1,5223 @@
+package fp
+
+// StackInt - persistent(immutable) stack of int. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackInt struct {
+	top  int
+	rest *StackInt
+	size int
+}
+
+// NewStackInt creates stack from the list. Last item of the list is on top
+func NewStackInt(list []int) *StackInt {
+	var s *StackInt
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackInt) Push(v int) *StackInt {
+	return &StackInt{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackInt) Pop() *StackInt {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackInt) Peek() (int, bool) {
+	if s == nil {
+		var zero int
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackInt) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackInt) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackInt(s.ToSlice()) is same as the stack
+func (s *StackInt) ToSlice() []int {
+	list := make([]int, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueInt - persistent(immutable) FIFO queue of int. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueInt struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackInt
+	rear  *StackInt
+}
+
+// NewQueueInt creates queue from the list. First item of the list is at the front
+func NewQueueInt(list []int) *QueueInt {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueInt{front: reversedStackInt(list)}
+}
+
+// reversedStackInt creates stack with the first item of the list on top
+func reversedStackInt(list []int) *StackInt {
+	var s *StackInt
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueInt) Push(v int) *QueueInt {
+	if q == nil {
+		return &QueueInt{front: (*StackInt)(nil).Push(v)}
+	}
+	return &QueueInt{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueInt
+func (q *QueueInt) Pop() *QueueInt {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueInt{front: front, rear: q.rear}
+	}
+	return &QueueInt{front: reversedStackInt(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueInt) Peek() (int, bool) {
+	if q == nil {
+		var zero int
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueInt) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueInt) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueInt) ToSlice() []int {
+	if q == nil {
+		return []int{}
+	}
+	list := make([]int, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeInt - persistent(immutable) double-ended queue of int. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeInt struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackInt
+	back  *StackInt
+}
+
+// NewDequeInt creates deque from the list. First item of the list is at the front
+func NewDequeInt(list []int) *DequeInt {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeInt{front: reversedStackInt(list[:half]), back: NewStackInt(list[half:])}
+}
+
+// newDequeInt creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeInt(front, back *StackInt) *DequeInt {
+	d := &DequeInt{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeInt(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeInt) PushFront(v int) *DequeInt {
+	if d == nil {
+		return newDequeInt((*StackInt)(nil).Push(v), nil)
+	}
+	return newDequeInt(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeInt) PushBack(v int) *DequeInt {
+	if d == nil {
+		return newDequeInt(nil, (*StackInt)(nil).Push(v))
+	}
+	return newDequeInt(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt) PopFront() *DequeInt {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt) PopBack() *DequeInt {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeInt) PeekFront() (int, bool) {
+	if d == nil {
+		var zero int
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeInt) PeekBack() (int, bool) {
+	if d == nil {
+		var zero int
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeInt) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeInt) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeInt) ToSlice() []int {
+	if d == nil {
+		return []int{}
+	}
+	list := make([]int, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueInt - binary heap of int. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueInt, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueInt struct {
+	key   func(int) float64
+	items []priorityItemInt
+	seq   int
+}
+
+type priorityItemInt struct {
+	value int
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueInt creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueInt(key func(int) float64, list []int) *PriorityQueueInt {
+	pq := &PriorityQueueInt{key: key, items: make([]priorityItemInt, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueInt) Push(v int) *PriorityQueueInt {
+	item := priorityItemInt{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt) Pop() (int, bool) {
+	if len(pq.items) == 0 {
+		var zero int
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt) Peek() (int, bool) {
+	if len(pq.items) == 0 {
+		var zero int
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueInt) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueInt) ToSlice() []int {
+	clone := &PriorityQueueInt{items: make([]priorityItemInt, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]int, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueInt) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackInt64 - persistent(immutable) stack of int64. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackInt64 struct {
+	top  int64
+	rest *StackInt64
+	size int
+}
+
+// NewStackInt64 creates stack from the list. Last item of the list is on top
+func NewStackInt64(list []int64) *StackInt64 {
+	var s *StackInt64
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackInt64) Push(v int64) *StackInt64 {
+	return &StackInt64{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackInt64) Pop() *StackInt64 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackInt64) Peek() (int64, bool) {
+	if s == nil {
+		var zero int64
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackInt64) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackInt64) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackInt64(s.ToSlice()) is same as the stack
+func (s *StackInt64) ToSlice() []int64 {
+	list := make([]int64, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueInt64 - persistent(immutable) FIFO queue of int64. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueInt64 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackInt64
+	rear  *StackInt64
+}
+
+// NewQueueInt64 creates queue from the list. First item of the list is at the front
+func NewQueueInt64(list []int64) *QueueInt64 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueInt64{front: reversedStackInt64(list)}
+}
+
+// reversedStackInt64 creates stack with the first item of the list on top
+func reversedStackInt64(list []int64) *StackInt64 {
+	var s *StackInt64
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueInt64) Push(v int64) *QueueInt64 {
+	if q == nil {
+		return &QueueInt64{front: (*StackInt64)(nil).Push(v)}
+	}
+	return &QueueInt64{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueInt64
+func (q *QueueInt64) Pop() *QueueInt64 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueInt64{front: front, rear: q.rear}
+	}
+	return &QueueInt64{front: reversedStackInt64(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueInt64) Peek() (int64, bool) {
+	if q == nil {
+		var zero int64
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueInt64) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueInt64) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueInt64) ToSlice() []int64 {
+	if q == nil {
+		return []int64{}
+	}
+	list := make([]int64, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeInt64 - persistent(immutable) double-ended queue of int64. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeInt64 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackInt64
+	back  *StackInt64
+}
+
+// NewDequeInt64 creates deque from the list. First item of the list is at the front
+func NewDequeInt64(list []int64) *DequeInt64 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeInt64{front: reversedStackInt64(list[:half]), back: NewStackInt64(list[half:])}
+}
+
+// newDequeInt64 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeInt64(front, back *StackInt64) *DequeInt64 {
+	d := &DequeInt64{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeInt64(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeInt64) PushFront(v int64) *DequeInt64 {
+	if d == nil {
+		return newDequeInt64((*StackInt64)(nil).Push(v), nil)
+	}
+	return newDequeInt64(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeInt64) PushBack(v int64) *DequeInt64 {
+	if d == nil {
+		return newDequeInt64(nil, (*StackInt64)(nil).Push(v))
+	}
+	return newDequeInt64(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt64) PopFront() *DequeInt64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt64(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt64) PopBack() *DequeInt64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt64(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeInt64) PeekFront() (int64, bool) {
+	if d == nil {
+		var zero int64
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeInt64) PeekBack() (int64, bool) {
+	if d == nil {
+		var zero int64
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeInt64) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeInt64) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeInt64) ToSlice() []int64 {
+	if d == nil {
+		return []int64{}
+	}
+	list := make([]int64, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueInt64 - binary heap of int64. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueInt64, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueInt64 struct {
+	key   func(int64) float64
+	items []priorityItemInt64
+	seq   int
+}
+
+type priorityItemInt64 struct {
+	value int64
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueInt64 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueInt64(key func(int64) float64, list []int64) *PriorityQueueInt64 {
+	pq := &PriorityQueueInt64{key: key, items: make([]priorityItemInt64, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueInt64) Push(v int64) *PriorityQueueInt64 {
+	item := priorityItemInt64{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt64) Pop() (int64, bool) {
+	if len(pq.items) == 0 {
+		var zero int64
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt64) Peek() (int64, bool) {
+	if len(pq.items) == 0 {
+		var zero int64
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueInt64) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueInt64) ToSlice() []int64 {
+	clone := &PriorityQueueInt64{items: make([]priorityItemInt64, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]int64, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueInt64) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackInt32 - persistent(immutable) stack of int32. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackInt32 struct {
+	top  int32
+	rest *StackInt32
+	size int
+}
+
+// NewStackInt32 creates stack from the list. Last item of the list is on top
+func NewStackInt32(list []int32) *StackInt32 {
+	var s *StackInt32
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackInt32) Push(v int32) *StackInt32 {
+	return &StackInt32{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackInt32) Pop() *StackInt32 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackInt32) Peek() (int32, bool) {
+	if s == nil {
+		var zero int32
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackInt32) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackInt32) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackInt32(s.ToSlice()) is same as the stack
+func (s *StackInt32) ToSlice() []int32 {
+	list := make([]int32, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueInt32 - persistent(immutable) FIFO queue of int32. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueInt32 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackInt32
+	rear  *StackInt32
+}
+
+// NewQueueInt32 creates queue from the list. First item of the list is at the front
+func NewQueueInt32(list []int32) *QueueInt32 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueInt32{front: reversedStackInt32(list)}
+}
+
+// reversedStackInt32 creates stack with the first item of the list on top
+func reversedStackInt32(list []int32) *StackInt32 {
+	var s *StackInt32
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueInt32) Push(v int32) *QueueInt32 {
+	if q == nil {
+		return &QueueInt32{front: (*StackInt32)(nil).Push(v)}
+	}
+	return &QueueInt32{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueInt32
+func (q *QueueInt32) Pop() *QueueInt32 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueInt32{front: front, rear: q.rear}
+	}
+	return &QueueInt32{front: reversedStackInt32(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueInt32) Peek() (int32, bool) {
+	if q == nil {
+		var zero int32
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueInt32) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueInt32) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueInt32) ToSlice() []int32 {
+	if q == nil {
+		return []int32{}
+	}
+	list := make([]int32, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeInt32 - persistent(immutable) double-ended queue of int32. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeInt32 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackInt32
+	back  *StackInt32
+}
+
+// NewDequeInt32 creates deque from the list. First item of the list is at the front
+func NewDequeInt32(list []int32) *DequeInt32 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeInt32{front: reversedStackInt32(list[:half]), back: NewStackInt32(list[half:])}
+}
+
+// newDequeInt32 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeInt32(front, back *StackInt32) *DequeInt32 {
+	d := &DequeInt32{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeInt32(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeInt32) PushFront(v int32) *DequeInt32 {
+	if d == nil {
+		return newDequeInt32((*StackInt32)(nil).Push(v), nil)
+	}
+	return newDequeInt32(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeInt32) PushBack(v int32) *DequeInt32 {
+	if d == nil {
+		return newDequeInt32(nil, (*StackInt32)(nil).Push(v))
+	}
+	return newDequeInt32(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt32) PopFront() *DequeInt32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt32(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt32) PopBack() *DequeInt32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt32(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeInt32) PeekFront() (int32, bool) {
+	if d == nil {
+		var zero int32
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeInt32) PeekBack() (int32, bool) {
+	if d == nil {
+		var zero int32
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeInt32) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeInt32) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeInt32) ToSlice() []int32 {
+	if d == nil {
+		return []int32{}
+	}
+	list := make([]int32, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueInt32 - binary heap of int32. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueInt32, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueInt32 struct {
+	key   func(int32) float64
+	items []priorityItemInt32
+	seq   int
+}
+
+type priorityItemInt32 struct {
+	value int32
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueInt32 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueInt32(key func(int32) float64, list []int32) *PriorityQueueInt32 {
+	pq := &PriorityQueueInt32{key: key, items: make([]priorityItemInt32, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueInt32) Push(v int32) *PriorityQueueInt32 {
+	item := priorityItemInt32{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt32) Pop() (int32, bool) {
+	if len(pq.items) == 0 {
+		var zero int32
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt32) Peek() (int32, bool) {
+	if len(pq.items) == 0 {
+		var zero int32
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueInt32) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueInt32) ToSlice() []int32 {
+	clone := &PriorityQueueInt32{items: make([]priorityItemInt32, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]int32, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueInt32) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackInt16 - persistent(immutable) stack of int16. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackInt16 struct {
+	top  int16
+	rest *StackInt16
+	size int
+}
+
+// NewStackInt16 creates stack from the list. Last item of the list is on top
+func NewStackInt16(list []int16) *StackInt16 {
+	var s *StackInt16
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackInt16) Push(v int16) *StackInt16 {
+	return &StackInt16{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackInt16) Pop() *StackInt16 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackInt16) Peek() (int16, bool) {
+	if s == nil {
+		var zero int16
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackInt16) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackInt16) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackInt16(s.ToSlice()) is same as the stack
+func (s *StackInt16) ToSlice() []int16 {
+	list := make([]int16, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueInt16 - persistent(immutable) FIFO queue of int16. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueInt16 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackInt16
+	rear  *StackInt16
+}
+
+// NewQueueInt16 creates queue from the list. First item of the list is at the front
+func NewQueueInt16(list []int16) *QueueInt16 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueInt16{front: reversedStackInt16(list)}
+}
+
+// reversedStackInt16 creates stack with the first item of the list on top
+func reversedStackInt16(list []int16) *StackInt16 {
+	var s *StackInt16
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueInt16) Push(v int16) *QueueInt16 {
+	if q == nil {
+		return &QueueInt16{front: (*StackInt16)(nil).Push(v)}
+	}
+	return &QueueInt16{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueInt16
+func (q *QueueInt16) Pop() *QueueInt16 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueInt16{front: front, rear: q.rear}
+	}
+	return &QueueInt16{front: reversedStackInt16(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueInt16) Peek() (int16, bool) {
+	if q == nil {
+		var zero int16
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueInt16) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueInt16) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueInt16) ToSlice() []int16 {
+	if q == nil {
+		return []int16{}
+	}
+	list := make([]int16, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeInt16 - persistent(immutable) double-ended queue of int16. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeInt16 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackInt16
+	back  *StackInt16
+}
+
+// NewDequeInt16 creates deque from the list. First item of the list is at the front
+func NewDequeInt16(list []int16) *DequeInt16 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeInt16{front: reversedStackInt16(list[:half]), back: NewStackInt16(list[half:])}
+}
+
+// newDequeInt16 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeInt16(front, back *StackInt16) *DequeInt16 {
+	d := &DequeInt16{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeInt16(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeInt16) PushFront(v int16) *DequeInt16 {
+	if d == nil {
+		return newDequeInt16((*StackInt16)(nil).Push(v), nil)
+	}
+	return newDequeInt16(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeInt16) PushBack(v int16) *DequeInt16 {
+	if d == nil {
+		return newDequeInt16(nil, (*StackInt16)(nil).Push(v))
+	}
+	return newDequeInt16(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt16) PopFront() *DequeInt16 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt16(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt16) PopBack() *DequeInt16 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt16(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeInt16) PeekFront() (int16, bool) {
+	if d == nil {
+		var zero int16
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeInt16) PeekBack() (int16, bool) {
+	if d == nil {
+		var zero int16
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeInt16) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeInt16) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeInt16) ToSlice() []int16 {
+	if d == nil {
+		return []int16{}
+	}
+	list := make([]int16, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueInt16 - binary heap of int16. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueInt16, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueInt16 struct {
+	key   func(int16) float64
+	items []priorityItemInt16
+	seq   int
+}
+
+type priorityItemInt16 struct {
+	value int16
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueInt16 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueInt16(key func(int16) float64, list []int16) *PriorityQueueInt16 {
+	pq := &PriorityQueueInt16{key: key, items: make([]priorityItemInt16, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueInt16) Push(v int16) *PriorityQueueInt16 {
+	item := priorityItemInt16{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt16) Pop() (int16, bool) {
+	if len(pq.items) == 0 {
+		var zero int16
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt16) Peek() (int16, bool) {
+	if len(pq.items) == 0 {
+		var zero int16
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueInt16) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueInt16) ToSlice() []int16 {
+	clone := &PriorityQueueInt16{items: make([]priorityItemInt16, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]int16, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueInt16) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackInt8 - persistent(immutable) stack of int8. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackInt8 struct {
+	top  int8
+	rest *StackInt8
+	size int
+}
+
+// NewStackInt8 creates stack from the list. Last item of the list is on top
+func NewStackInt8(list []int8) *StackInt8 {
+	var s *StackInt8
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackInt8) Push(v int8) *StackInt8 {
+	return &StackInt8{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackInt8) Pop() *StackInt8 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackInt8) Peek() (int8, bool) {
+	if s == nil {
+		var zero int8
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackInt8) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackInt8) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackInt8(s.ToSlice()) is same as the stack
+func (s *StackInt8) ToSlice() []int8 {
+	list := make([]int8, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueInt8 - persistent(immutable) FIFO queue of int8. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueInt8 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackInt8
+	rear  *StackInt8
+}
+
+// NewQueueInt8 creates queue from the list. First item of the list is at the front
+func NewQueueInt8(list []int8) *QueueInt8 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueInt8{front: reversedStackInt8(list)}
+}
+
+// reversedStackInt8 creates stack with the first item of the list on top
+func reversedStackInt8(list []int8) *StackInt8 {
+	var s *StackInt8
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueInt8) Push(v int8) *QueueInt8 {
+	if q == nil {
+		return &QueueInt8{front: (*StackInt8)(nil).Push(v)}
+	}
+	return &QueueInt8{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueInt8
+func (q *QueueInt8) Pop() *QueueInt8 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueInt8{front: front, rear: q.rear}
+	}
+	return &QueueInt8{front: reversedStackInt8(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueInt8) Peek() (int8, bool) {
+	if q == nil {
+		var zero int8
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueInt8) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueInt8) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueInt8) ToSlice() []int8 {
+	if q == nil {
+		return []int8{}
+	}
+	list := make([]int8, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeInt8 - persistent(immutable) double-ended queue of int8. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeInt8 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackInt8
+	back  *StackInt8
+}
+
+// NewDequeInt8 creates deque from the list. First item of the list is at the front
+func NewDequeInt8(list []int8) *DequeInt8 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeInt8{front: reversedStackInt8(list[:half]), back: NewStackInt8(list[half:])}
+}
+
+// newDequeInt8 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeInt8(front, back *StackInt8) *DequeInt8 {
+	d := &DequeInt8{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeInt8(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeInt8) PushFront(v int8) *DequeInt8 {
+	if d == nil {
+		return newDequeInt8((*StackInt8)(nil).Push(v), nil)
+	}
+	return newDequeInt8(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeInt8) PushBack(v int8) *DequeInt8 {
+	if d == nil {
+		return newDequeInt8(nil, (*StackInt8)(nil).Push(v))
+	}
+	return newDequeInt8(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt8) PopFront() *DequeInt8 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt8(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeInt8) PopBack() *DequeInt8 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeInt8(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeInt8) PeekFront() (int8, bool) {
+	if d == nil {
+		var zero int8
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeInt8) PeekBack() (int8, bool) {
+	if d == nil {
+		var zero int8
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeInt8) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeInt8) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeInt8) ToSlice() []int8 {
+	if d == nil {
+		return []int8{}
+	}
+	list := make([]int8, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueInt8 - binary heap of int8. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueInt8, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueInt8 struct {
+	key   func(int8) float64
+	items []priorityItemInt8
+	seq   int
+}
+
+type priorityItemInt8 struct {
+	value int8
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueInt8 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueInt8(key func(int8) float64, list []int8) *PriorityQueueInt8 {
+	pq := &PriorityQueueInt8{key: key, items: make([]priorityItemInt8, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueInt8) Push(v int8) *PriorityQueueInt8 {
+	item := priorityItemInt8{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt8) Pop() (int8, bool) {
+	if len(pq.items) == 0 {
+		var zero int8
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueInt8) Peek() (int8, bool) {
+	if len(pq.items) == 0 {
+		var zero int8
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueInt8) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueInt8) ToSlice() []int8 {
+	clone := &PriorityQueueInt8{items: make([]priorityItemInt8, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]int8, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueInt8) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackUint - persistent(immutable) stack of uint. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackUint struct {
+	top  uint
+	rest *StackUint
+	size int
+}
+
+// NewStackUint creates stack from the list. Last item of the list is on top
+func NewStackUint(list []uint) *StackUint {
+	var s *StackUint
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackUint) Push(v uint) *StackUint {
+	return &StackUint{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackUint) Pop() *StackUint {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackUint) Peek() (uint, bool) {
+	if s == nil {
+		var zero uint
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackUint) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackUint) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackUint(s.ToSlice()) is same as the stack
+func (s *StackUint) ToSlice() []uint {
+	list := make([]uint, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueUint - persistent(immutable) FIFO queue of uint. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueUint struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackUint
+	rear  *StackUint
+}
+
+// NewQueueUint creates queue from the list. First item of the list is at the front
+func NewQueueUint(list []uint) *QueueUint {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueUint{front: reversedStackUint(list)}
+}
+
+// reversedStackUint creates stack with the first item of the list on top
+func reversedStackUint(list []uint) *StackUint {
+	var s *StackUint
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueUint) Push(v uint) *QueueUint {
+	if q == nil {
+		return &QueueUint{front: (*StackUint)(nil).Push(v)}
+	}
+	return &QueueUint{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueUint
+func (q *QueueUint) Pop() *QueueUint {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueUint{front: front, rear: q.rear}
+	}
+	return &QueueUint{front: reversedStackUint(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueUint) Peek() (uint, bool) {
+	if q == nil {
+		var zero uint
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueUint) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueUint) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueUint) ToSlice() []uint {
+	if q == nil {
+		return []uint{}
+	}
+	list := make([]uint, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeUint - persistent(immutable) double-ended queue of uint. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeUint struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackUint
+	back  *StackUint
+}
+
+// NewDequeUint creates deque from the list. First item of the list is at the front
+func NewDequeUint(list []uint) *DequeUint {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeUint{front: reversedStackUint(list[:half]), back: NewStackUint(list[half:])}
+}
+
+// newDequeUint creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeUint(front, back *StackUint) *DequeUint {
+	d := &DequeUint{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeUint(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeUint) PushFront(v uint) *DequeUint {
+	if d == nil {
+		return newDequeUint((*StackUint)(nil).Push(v), nil)
+	}
+	return newDequeUint(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeUint) PushBack(v uint) *DequeUint {
+	if d == nil {
+		return newDequeUint(nil, (*StackUint)(nil).Push(v))
+	}
+	return newDequeUint(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint) PopFront() *DequeUint {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint) PopBack() *DequeUint {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeUint) PeekFront() (uint, bool) {
+	if d == nil {
+		var zero uint
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeUint) PeekBack() (uint, bool) {
+	if d == nil {
+		var zero uint
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeUint) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeUint) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeUint) ToSlice() []uint {
+	if d == nil {
+		return []uint{}
+	}
+	list := make([]uint, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueUint - binary heap of uint. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueUint, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueUint struct {
+	key   func(uint) float64
+	items []priorityItemUint
+	seq   int
+}
+
+type priorityItemUint struct {
+	value uint
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueUint creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueUint(key func(uint) float64, list []uint) *PriorityQueueUint {
+	pq := &PriorityQueueUint{key: key, items: make([]priorityItemUint, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueUint) Push(v uint) *PriorityQueueUint {
+	item := priorityItemUint{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint) Pop() (uint, bool) {
+	if len(pq.items) == 0 {
+		var zero uint
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint) Peek() (uint, bool) {
+	if len(pq.items) == 0 {
+		var zero uint
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueUint) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueUint) ToSlice() []uint {
+	clone := &PriorityQueueUint{items: make([]priorityItemUint, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]uint, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueUint) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackUint64 - persistent(immutable) stack of uint64. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackUint64 struct {
+	top  uint64
+	rest *StackUint64
+	size int
+}
+
+// NewStackUint64 creates stack from the list. Last item of the list is on top
+func NewStackUint64(list []uint64) *StackUint64 {
+	var s *StackUint64
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackUint64) Push(v uint64) *StackUint64 {
+	return &StackUint64{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackUint64) Pop() *StackUint64 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackUint64) Peek() (uint64, bool) {
+	if s == nil {
+		var zero uint64
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackUint64) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackUint64) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackUint64(s.ToSlice()) is same as the stack
+func (s *StackUint64) ToSlice() []uint64 {
+	list := make([]uint64, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueUint64 - persistent(immutable) FIFO queue of uint64. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueUint64 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackUint64
+	rear  *StackUint64
+}
+
+// NewQueueUint64 creates queue from the list. First item of the list is at the front
+func NewQueueUint64(list []uint64) *QueueUint64 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueUint64{front: reversedStackUint64(list)}
+}
+
+// reversedStackUint64 creates stack with the first item of the list on top
+func reversedStackUint64(list []uint64) *StackUint64 {
+	var s *StackUint64
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueUint64) Push(v uint64) *QueueUint64 {
+	if q == nil {
+		return &QueueUint64{front: (*StackUint64)(nil).Push(v)}
+	}
+	return &QueueUint64{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueUint64
+func (q *QueueUint64) Pop() *QueueUint64 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueUint64{front: front, rear: q.rear}
+	}
+	return &QueueUint64{front: reversedStackUint64(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueUint64) Peek() (uint64, bool) {
+	if q == nil {
+		var zero uint64
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueUint64) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueUint64) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueUint64) ToSlice() []uint64 {
+	if q == nil {
+		return []uint64{}
+	}
+	list := make([]uint64, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeUint64 - persistent(immutable) double-ended queue of uint64. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeUint64 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackUint64
+	back  *StackUint64
+}
+
+// NewDequeUint64 creates deque from the list. First item of the list is at the front
+func NewDequeUint64(list []uint64) *DequeUint64 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeUint64{front: reversedStackUint64(list[:half]), back: NewStackUint64(list[half:])}
+}
+
+// newDequeUint64 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeUint64(front, back *StackUint64) *DequeUint64 {
+	d := &DequeUint64{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeUint64(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeUint64) PushFront(v uint64) *DequeUint64 {
+	if d == nil {
+		return newDequeUint64((*StackUint64)(nil).Push(v), nil)
+	}
+	return newDequeUint64(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeUint64) PushBack(v uint64) *DequeUint64 {
+	if d == nil {
+		return newDequeUint64(nil, (*StackUint64)(nil).Push(v))
+	}
+	return newDequeUint64(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint64) PopFront() *DequeUint64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint64(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint64) PopBack() *DequeUint64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint64(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeUint64) PeekFront() (uint64, bool) {
+	if d == nil {
+		var zero uint64
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeUint64) PeekBack() (uint64, bool) {
+	if d == nil {
+		var zero uint64
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeUint64) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeUint64) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeUint64) ToSlice() []uint64 {
+	if d == nil {
+		return []uint64{}
+	}
+	list := make([]uint64, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueUint64 - binary heap of uint64. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueUint64, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueUint64 struct {
+	key   func(uint64) float64
+	items []priorityItemUint64
+	seq   int
+}
+
+type priorityItemUint64 struct {
+	value uint64
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueUint64 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueUint64(key func(uint64) float64, list []uint64) *PriorityQueueUint64 {
+	pq := &PriorityQueueUint64{key: key, items: make([]priorityItemUint64, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueUint64) Push(v uint64) *PriorityQueueUint64 {
+	item := priorityItemUint64{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint64) Pop() (uint64, bool) {
+	if len(pq.items) == 0 {
+		var zero uint64
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint64) Peek() (uint64, bool) {
+	if len(pq.items) == 0 {
+		var zero uint64
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueUint64) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueUint64) ToSlice() []uint64 {
+	clone := &PriorityQueueUint64{items: make([]priorityItemUint64, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]uint64, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueUint64) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackUint32 - persistent(immutable) stack of uint32. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackUint32 struct {
+	top  uint32
+	rest *StackUint32
+	size int
+}
+
+// NewStackUint32 creates stack from the list. Last item of the list is on top
+func NewStackUint32(list []uint32) *StackUint32 {
+	var s *StackUint32
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackUint32) Push(v uint32) *StackUint32 {
+	return &StackUint32{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackUint32) Pop() *StackUint32 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackUint32) Peek() (uint32, bool) {
+	if s == nil {
+		var zero uint32
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackUint32) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackUint32) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackUint32(s.ToSlice()) is same as the stack
+func (s *StackUint32) ToSlice() []uint32 {
+	list := make([]uint32, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueUint32 - persistent(immutable) FIFO queue of uint32. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueUint32 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackUint32
+	rear  *StackUint32
+}
+
+// NewQueueUint32 creates queue from the list. First item of the list is at the front
+func NewQueueUint32(list []uint32) *QueueUint32 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueUint32{front: reversedStackUint32(list)}
+}
+
+// reversedStackUint32 creates stack with the first item of the list on top
+func reversedStackUint32(list []uint32) *StackUint32 {
+	var s *StackUint32
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueUint32) Push(v uint32) *QueueUint32 {
+	if q == nil {
+		return &QueueUint32{front: (*StackUint32)(nil).Push(v)}
+	}
+	return &QueueUint32{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueUint32
+func (q *QueueUint32) Pop() *QueueUint32 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueUint32{front: front, rear: q.rear}
+	}
+	return &QueueUint32{front: reversedStackUint32(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueUint32) Peek() (uint32, bool) {
+	if q == nil {
+		var zero uint32
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueUint32) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueUint32) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueUint32) ToSlice() []uint32 {
+	if q == nil {
+		return []uint32{}
+	}
+	list := make([]uint32, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeUint32 - persistent(immutable) double-ended queue of uint32. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeUint32 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackUint32
+	back  *StackUint32
+}
+
+// NewDequeUint32 creates deque from the list. First item of the list is at the front
+func NewDequeUint32(list []uint32) *DequeUint32 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeUint32{front: reversedStackUint32(list[:half]), back: NewStackUint32(list[half:])}
+}
+
+// newDequeUint32 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeUint32(front, back *StackUint32) *DequeUint32 {
+	d := &DequeUint32{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeUint32(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeUint32) PushFront(v uint32) *DequeUint32 {
+	if d == nil {
+		return newDequeUint32((*StackUint32)(nil).Push(v), nil)
+	}
+	return newDequeUint32(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeUint32) PushBack(v uint32) *DequeUint32 {
+	if d == nil {
+		return newDequeUint32(nil, (*StackUint32)(nil).Push(v))
+	}
+	return newDequeUint32(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint32) PopFront() *DequeUint32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint32(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint32) PopBack() *DequeUint32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint32(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeUint32) PeekFront() (uint32, bool) {
+	if d == nil {
+		var zero uint32
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeUint32) PeekBack() (uint32, bool) {
+	if d == nil {
+		var zero uint32
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeUint32) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeUint32) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeUint32) ToSlice() []uint32 {
+	if d == nil {
+		return []uint32{}
+	}
+	list := make([]uint32, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueUint32 - binary heap of uint32. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueUint32, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueUint32 struct {
+	key   func(uint32) float64
+	items []priorityItemUint32
+	seq   int
+}
+
+type priorityItemUint32 struct {
+	value uint32
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueUint32 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueUint32(key func(uint32) float64, list []uint32) *PriorityQueueUint32 {
+	pq := &PriorityQueueUint32{key: key, items: make([]priorityItemUint32, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueUint32) Push(v uint32) *PriorityQueueUint32 {
+	item := priorityItemUint32{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint32) Pop() (uint32, bool) {
+	if len(pq.items) == 0 {
+		var zero uint32
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint32) Peek() (uint32, bool) {
+	if len(pq.items) == 0 {
+		var zero uint32
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueUint32) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueUint32) ToSlice() []uint32 {
+	clone := &PriorityQueueUint32{items: make([]priorityItemUint32, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]uint32, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueUint32) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackUint16 - persistent(immutable) stack of uint16. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackUint16 struct {
+	top  uint16
+	rest *StackUint16
+	size int
+}
+
+// NewStackUint16 creates stack from the list. Last item of the list is on top
+func NewStackUint16(list []uint16) *StackUint16 {
+	var s *StackUint16
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackUint16) Push(v uint16) *StackUint16 {
+	return &StackUint16{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackUint16) Pop() *StackUint16 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackUint16) Peek() (uint16, bool) {
+	if s == nil {
+		var zero uint16
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackUint16) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackUint16) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackUint16(s.ToSlice()) is same as the stack
+func (s *StackUint16) ToSlice() []uint16 {
+	list := make([]uint16, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueUint16 - persistent(immutable) FIFO queue of uint16. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueUint16 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackUint16
+	rear  *StackUint16
+}
+
+// NewQueueUint16 creates queue from the list. First item of the list is at the front
+func NewQueueUint16(list []uint16) *QueueUint16 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueUint16{front: reversedStackUint16(list)}
+}
+
+// reversedStackUint16 creates stack with the first item of the list on top
+func reversedStackUint16(list []uint16) *StackUint16 {
+	var s *StackUint16
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueUint16) Push(v uint16) *QueueUint16 {
+	if q == nil {
+		return &QueueUint16{front: (*StackUint16)(nil).Push(v)}
+	}
+	return &QueueUint16{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueUint16
+func (q *QueueUint16) Pop() *QueueUint16 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueUint16{front: front, rear: q.rear}
+	}
+	return &QueueUint16{front: reversedStackUint16(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueUint16) Peek() (uint16, bool) {
+	if q == nil {
+		var zero uint16
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueUint16) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueUint16) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueUint16) ToSlice() []uint16 {
+	if q == nil {
+		return []uint16{}
+	}
+	list := make([]uint16, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeUint16 - persistent(immutable) double-ended queue of uint16. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeUint16 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackUint16
+	back  *StackUint16
+}
+
+// NewDequeUint16 creates deque from the list. First item of the list is at the front
+func NewDequeUint16(list []uint16) *DequeUint16 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeUint16{front: reversedStackUint16(list[:half]), back: NewStackUint16(list[half:])}
+}
+
+// newDequeUint16 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeUint16(front, back *StackUint16) *DequeUint16 {
+	d := &DequeUint16{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeUint16(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeUint16) PushFront(v uint16) *DequeUint16 {
+	if d == nil {
+		return newDequeUint16((*StackUint16)(nil).Push(v), nil)
+	}
+	return newDequeUint16(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeUint16) PushBack(v uint16) *DequeUint16 {
+	if d == nil {
+		return newDequeUint16(nil, (*StackUint16)(nil).Push(v))
+	}
+	return newDequeUint16(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint16) PopFront() *DequeUint16 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint16(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint16) PopBack() *DequeUint16 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint16(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeUint16) PeekFront() (uint16, bool) {
+	if d == nil {
+		var zero uint16
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeUint16) PeekBack() (uint16, bool) {
+	if d == nil {
+		var zero uint16
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeUint16) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeUint16) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeUint16) ToSlice() []uint16 {
+	if d == nil {
+		return []uint16{}
+	}
+	list := make([]uint16, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueUint16 - binary heap of uint16. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueUint16, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueUint16 struct {
+	key   func(uint16) float64
+	items []priorityItemUint16
+	seq   int
+}
+
+type priorityItemUint16 struct {
+	value uint16
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueUint16 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueUint16(key func(uint16) float64, list []uint16) *PriorityQueueUint16 {
+	pq := &PriorityQueueUint16{key: key, items: make([]priorityItemUint16, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueUint16) Push(v uint16) *PriorityQueueUint16 {
+	item := priorityItemUint16{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint16) Pop() (uint16, bool) {
+	if len(pq.items) == 0 {
+		var zero uint16
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint16) Peek() (uint16, bool) {
+	if len(pq.items) == 0 {
+		var zero uint16
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueUint16) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueUint16) ToSlice() []uint16 {
+	clone := &PriorityQueueUint16{items: make([]priorityItemUint16, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]uint16, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueUint16) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackUint8 - persistent(immutable) stack of uint8. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackUint8 struct {
+	top  uint8
+	rest *StackUint8
+	size int
+}
+
+// NewStackUint8 creates stack from the list. Last item of the list is on top
+func NewStackUint8(list []uint8) *StackUint8 {
+	var s *StackUint8
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackUint8) Push(v uint8) *StackUint8 {
+	return &StackUint8{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackUint8) Pop() *StackUint8 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackUint8) Peek() (uint8, bool) {
+	if s == nil {
+		var zero uint8
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackUint8) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackUint8) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackUint8(s.ToSlice()) is same as the stack
+func (s *StackUint8) ToSlice() []uint8 {
+	list := make([]uint8, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueUint8 - persistent(immutable) FIFO queue of uint8. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueUint8 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackUint8
+	rear  *StackUint8
+}
+
+// NewQueueUint8 creates queue from the list. First item of the list is at the front
+func NewQueueUint8(list []uint8) *QueueUint8 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueUint8{front: reversedStackUint8(list)}
+}
+
+// reversedStackUint8 creates stack with the first item of the list on top
+func reversedStackUint8(list []uint8) *StackUint8 {
+	var s *StackUint8
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueUint8) Push(v uint8) *QueueUint8 {
+	if q == nil {
+		return &QueueUint8{front: (*StackUint8)(nil).Push(v)}
+	}
+	return &QueueUint8{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueUint8
+func (q *QueueUint8) Pop() *QueueUint8 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueUint8{front: front, rear: q.rear}
+	}
+	return &QueueUint8{front: reversedStackUint8(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueUint8) Peek() (uint8, bool) {
+	if q == nil {
+		var zero uint8
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueUint8) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueUint8) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueUint8) ToSlice() []uint8 {
+	if q == nil {
+		return []uint8{}
+	}
+	list := make([]uint8, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeUint8 - persistent(immutable) double-ended queue of uint8. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeUint8 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackUint8
+	back  *StackUint8
+}
+
+// NewDequeUint8 creates deque from the list. First item of the list is at the front
+func NewDequeUint8(list []uint8) *DequeUint8 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeUint8{front: reversedStackUint8(list[:half]), back: NewStackUint8(list[half:])}
+}
+
+// newDequeUint8 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeUint8(front, back *StackUint8) *DequeUint8 {
+	d := &DequeUint8{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeUint8(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeUint8) PushFront(v uint8) *DequeUint8 {
+	if d == nil {
+		return newDequeUint8((*StackUint8)(nil).Push(v), nil)
+	}
+	return newDequeUint8(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeUint8) PushBack(v uint8) *DequeUint8 {
+	if d == nil {
+		return newDequeUint8(nil, (*StackUint8)(nil).Push(v))
+	}
+	return newDequeUint8(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint8) PopFront() *DequeUint8 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint8(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeUint8) PopBack() *DequeUint8 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeUint8(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeUint8) PeekFront() (uint8, bool) {
+	if d == nil {
+		var zero uint8
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeUint8) PeekBack() (uint8, bool) {
+	if d == nil {
+		var zero uint8
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeUint8) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeUint8) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeUint8) ToSlice() []uint8 {
+	if d == nil {
+		return []uint8{}
+	}
+	list := make([]uint8, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueUint8 - binary heap of uint8. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueUint8, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueUint8 struct {
+	key   func(uint8) float64
+	items []priorityItemUint8
+	seq   int
+}
+
+type priorityItemUint8 struct {
+	value uint8
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueUint8 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueUint8(key func(uint8) float64, list []uint8) *PriorityQueueUint8 {
+	pq := &PriorityQueueUint8{key: key, items: make([]priorityItemUint8, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueUint8) Push(v uint8) *PriorityQueueUint8 {
+	item := priorityItemUint8{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint8) Pop() (uint8, bool) {
+	if len(pq.items) == 0 {
+		var zero uint8
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueUint8) Peek() (uint8, bool) {
+	if len(pq.items) == 0 {
+		var zero uint8
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueUint8) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueUint8) ToSlice() []uint8 {
+	clone := &PriorityQueueUint8{items: make([]priorityItemUint8, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]uint8, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueUint8) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackFloat64 - persistent(immutable) stack of float64. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackFloat64 struct {
+	top  float64
+	rest *StackFloat64
+	size int
+}
+
+// NewStackFloat64 creates stack from the list. Last item of the list is on top
+func NewStackFloat64(list []float64) *StackFloat64 {
+	var s *StackFloat64
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackFloat64) Push(v float64) *StackFloat64 {
+	return &StackFloat64{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackFloat64) Pop() *StackFloat64 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackFloat64) Peek() (float64, bool) {
+	if s == nil {
+		var zero float64
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackFloat64) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackFloat64) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackFloat64(s.ToSlice()) is same as the stack
+func (s *StackFloat64) ToSlice() []float64 {
+	list := make([]float64, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueFloat64 - persistent(immutable) FIFO queue of float64. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueFloat64 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackFloat64
+	rear  *StackFloat64
+}
+
+// NewQueueFloat64 creates queue from the list. First item of the list is at the front
+func NewQueueFloat64(list []float64) *QueueFloat64 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueFloat64{front: reversedStackFloat64(list)}
+}
+
+// reversedStackFloat64 creates stack with the first item of the list on top
+func reversedStackFloat64(list []float64) *StackFloat64 {
+	var s *StackFloat64
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueFloat64) Push(v float64) *QueueFloat64 {
+	if q == nil {
+		return &QueueFloat64{front: (*StackFloat64)(nil).Push(v)}
+	}
+	return &QueueFloat64{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueFloat64
+func (q *QueueFloat64) Pop() *QueueFloat64 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueFloat64{front: front, rear: q.rear}
+	}
+	return &QueueFloat64{front: reversedStackFloat64(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueFloat64) Peek() (float64, bool) {
+	if q == nil {
+		var zero float64
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueFloat64) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueFloat64) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueFloat64) ToSlice() []float64 {
+	if q == nil {
+		return []float64{}
+	}
+	list := make([]float64, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeFloat64 - persistent(immutable) double-ended queue of float64. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeFloat64 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackFloat64
+	back  *StackFloat64
+}
+
+// NewDequeFloat64 creates deque from the list. First item of the list is at the front
+func NewDequeFloat64(list []float64) *DequeFloat64 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeFloat64{front: reversedStackFloat64(list[:half]), back: NewStackFloat64(list[half:])}
+}
+
+// newDequeFloat64 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeFloat64(front, back *StackFloat64) *DequeFloat64 {
+	d := &DequeFloat64{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeFloat64(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeFloat64) PushFront(v float64) *DequeFloat64 {
+	if d == nil {
+		return newDequeFloat64((*StackFloat64)(nil).Push(v), nil)
+	}
+	return newDequeFloat64(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeFloat64) PushBack(v float64) *DequeFloat64 {
+	if d == nil {
+		return newDequeFloat64(nil, (*StackFloat64)(nil).Push(v))
+	}
+	return newDequeFloat64(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeFloat64) PopFront() *DequeFloat64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeFloat64(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeFloat64) PopBack() *DequeFloat64 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeFloat64(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeFloat64) PeekFront() (float64, bool) {
+	if d == nil {
+		var zero float64
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeFloat64) PeekBack() (float64, bool) {
+	if d == nil {
+		var zero float64
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeFloat64) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeFloat64) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeFloat64) ToSlice() []float64 {
+	if d == nil {
+		return []float64{}
+	}
+	list := make([]float64, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueFloat64 - binary heap of float64. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueFloat64, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueFloat64 struct {
+	key   func(float64) float64
+	items []priorityItemFloat64
+	seq   int
+}
+
+type priorityItemFloat64 struct {
+	value float64
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueFloat64 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueFloat64(key func(float64) float64, list []float64) *PriorityQueueFloat64 {
+	pq := &PriorityQueueFloat64{key: key, items: make([]priorityItemFloat64, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueFloat64) Push(v float64) *PriorityQueueFloat64 {
+	item := priorityItemFloat64{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueFloat64) Pop() (float64, bool) {
+	if len(pq.items) == 0 {
+		var zero float64
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueFloat64) Peek() (float64, bool) {
+	if len(pq.items) == 0 {
+		var zero float64
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueFloat64) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueFloat64) ToSlice() []float64 {
+	clone := &PriorityQueueFloat64{items: make([]priorityItemFloat64, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]float64, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueFloat64) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackFloat32 - persistent(immutable) stack of float32. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackFloat32 struct {
+	top  float32
+	rest *StackFloat32
+	size int
+}
+
+// NewStackFloat32 creates stack from the list. Last item of the list is on top
+func NewStackFloat32(list []float32) *StackFloat32 {
+	var s *StackFloat32
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackFloat32) Push(v float32) *StackFloat32 {
+	return &StackFloat32{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackFloat32) Pop() *StackFloat32 {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackFloat32) Peek() (float32, bool) {
+	if s == nil {
+		var zero float32
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackFloat32) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackFloat32) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackFloat32(s.ToSlice()) is same as the stack
+func (s *StackFloat32) ToSlice() []float32 {
+	list := make([]float32, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueFloat32 - persistent(immutable) FIFO queue of float32. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueFloat32 struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackFloat32
+	rear  *StackFloat32
+}
+
+// NewQueueFloat32 creates queue from the list. First item of the list is at the front
+func NewQueueFloat32(list []float32) *QueueFloat32 {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueFloat32{front: reversedStackFloat32(list)}
+}
+
+// reversedStackFloat32 creates stack with the first item of the list on top
+func reversedStackFloat32(list []float32) *StackFloat32 {
+	var s *StackFloat32
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueFloat32) Push(v float32) *QueueFloat32 {
+	if q == nil {
+		return &QueueFloat32{front: (*StackFloat32)(nil).Push(v)}
+	}
+	return &QueueFloat32{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueFloat32
+func (q *QueueFloat32) Pop() *QueueFloat32 {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueFloat32{front: front, rear: q.rear}
+	}
+	return &QueueFloat32{front: reversedStackFloat32(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueFloat32) Peek() (float32, bool) {
+	if q == nil {
+		var zero float32
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueFloat32) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueFloat32) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueFloat32) ToSlice() []float32 {
+	if q == nil {
+		return []float32{}
+	}
+	list := make([]float32, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeFloat32 - persistent(immutable) double-ended queue of float32. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeFloat32 struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackFloat32
+	back  *StackFloat32
+}
+
+// NewDequeFloat32 creates deque from the list. First item of the list is at the front
+func NewDequeFloat32(list []float32) *DequeFloat32 {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeFloat32{front: reversedStackFloat32(list[:half]), back: NewStackFloat32(list[half:])}
+}
+
+// newDequeFloat32 creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeFloat32(front, back *StackFloat32) *DequeFloat32 {
+	d := &DequeFloat32{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeFloat32(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeFloat32) PushFront(v float32) *DequeFloat32 {
+	if d == nil {
+		return newDequeFloat32((*StackFloat32)(nil).Push(v), nil)
+	}
+	return newDequeFloat32(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeFloat32) PushBack(v float32) *DequeFloat32 {
+	if d == nil {
+		return newDequeFloat32(nil, (*StackFloat32)(nil).Push(v))
+	}
+	return newDequeFloat32(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeFloat32) PopFront() *DequeFloat32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeFloat32(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeFloat32) PopBack() *DequeFloat32 {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeFloat32(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeFloat32) PeekFront() (float32, bool) {
+	if d == nil {
+		var zero float32
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeFloat32) PeekBack() (float32, bool) {
+	if d == nil {
+		var zero float32
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeFloat32) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeFloat32) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeFloat32) ToSlice() []float32 {
+	if d == nil {
+		return []float32{}
+	}
+	list := make([]float32, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueFloat32 - binary heap of float32. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueFloat32, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueFloat32 struct {
+	key   func(float32) float64
+	items []priorityItemFloat32
+	seq   int
+}
+
+type priorityItemFloat32 struct {
+	value float32
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueFloat32 creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueFloat32(key func(float32) float64, list []float32) *PriorityQueueFloat32 {
+	pq := &PriorityQueueFloat32{key: key, items: make([]priorityItemFloat32, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueFloat32) Push(v float32) *PriorityQueueFloat32 {
+	item := priorityItemFloat32{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueFloat32) Pop() (float32, bool) {
+	if len(pq.items) == 0 {
+		var zero float32
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueFloat32) Peek() (float32, bool) {
+	if len(pq.items) == 0 {
+		var zero float32
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueFloat32) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueFloat32) ToSlice() []float32 {
+	clone := &PriorityQueueFloat32{items: make([]priorityItemFloat32, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]float32, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueFloat32) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackStr - persistent(immutable) stack of string. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackStr struct {
+	top  string
+	rest *StackStr
+	size int
+}
+
+// NewStackStr creates stack from the list. Last item of the list is on top
+func NewStackStr(list []string) *StackStr {
+	var s *StackStr
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackStr) Push(v string) *StackStr {
+	return &StackStr{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackStr) Pop() *StackStr {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackStr) Peek() (string, bool) {
+	if s == nil {
+		var zero string
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackStr) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackStr) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackStr(s.ToSlice()) is same as the stack
+func (s *StackStr) ToSlice() []string {
+	list := make([]string, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueStr - persistent(immutable) FIFO queue of string. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueStr struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackStr
+	rear  *StackStr
+}
+
+// NewQueueStr creates queue from the list. First item of the list is at the front
+func NewQueueStr(list []string) *QueueStr {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueStr{front: reversedStackStr(list)}
+}
+
+// reversedStackStr creates stack with the first item of the list on top
+func reversedStackStr(list []string) *StackStr {
+	var s *StackStr
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueStr) Push(v string) *QueueStr {
+	if q == nil {
+		return &QueueStr{front: (*StackStr)(nil).Push(v)}
+	}
+	return &QueueStr{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueStr
+func (q *QueueStr) Pop() *QueueStr {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueStr{front: front, rear: q.rear}
+	}
+	return &QueueStr{front: reversedStackStr(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueStr) Peek() (string, bool) {
+	if q == nil {
+		var zero string
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueStr) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueStr) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueStr) ToSlice() []string {
+	if q == nil {
+		return []string{}
+	}
+	list := make([]string, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeStr - persistent(immutable) double-ended queue of string. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeStr struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackStr
+	back  *StackStr
+}
+
+// NewDequeStr creates deque from the list. First item of the list is at the front
+func NewDequeStr(list []string) *DequeStr {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeStr{front: reversedStackStr(list[:half]), back: NewStackStr(list[half:])}
+}
+
+// newDequeStr creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeStr(front, back *StackStr) *DequeStr {
+	d := &DequeStr{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeStr(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeStr) PushFront(v string) *DequeStr {
+	if d == nil {
+		return newDequeStr((*StackStr)(nil).Push(v), nil)
+	}
+	return newDequeStr(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeStr) PushBack(v string) *DequeStr {
+	if d == nil {
+		return newDequeStr(nil, (*StackStr)(nil).Push(v))
+	}
+	return newDequeStr(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeStr) PopFront() *DequeStr {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeStr(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeStr) PopBack() *DequeStr {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeStr(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeStr) PeekFront() (string, bool) {
+	if d == nil {
+		var zero string
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeStr) PeekBack() (string, bool) {
+	if d == nil {
+		var zero string
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeStr) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeStr) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeStr) ToSlice() []string {
+	if d == nil {
+		return []string{}
+	}
+	list := make([]string, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueStr - binary heap of string. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueStr, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueStr struct {
+	key   func(string) float64
+	items []priorityItemStr
+	seq   int
+}
+
+type priorityItemStr struct {
+	value string
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueStr creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueStr(key func(string) float64, list []string) *PriorityQueueStr {
+	pq := &PriorityQueueStr{key: key, items: make([]priorityItemStr, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueStr) Push(v string) *PriorityQueueStr {
+	item := priorityItemStr{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueStr) Pop() (string, bool) {
+	if len(pq.items) == 0 {
+		var zero string
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueStr) Peek() (string, bool) {
+	if len(pq.items) == 0 {
+		var zero string
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueStr) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueStr) ToSlice() []string {
+	clone := &PriorityQueueStr{items: make([]priorityItemStr, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]string, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueStr) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// StackBool - persistent(immutable) stack of bool. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackBool struct {
+	top  bool
+	rest *StackBool
+	size int
+}
+
+// NewStackBool creates stack from the list. Last item of the list is on top
+func NewStackBool(list []bool) *StackBool {
+	var s *StackBool
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackBool) Push(v bool) *StackBool {
+	return &StackBool{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackBool) Pop() *StackBool {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackBool) Peek() (bool, bool) {
+	if s == nil {
+		var zero bool
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackBool) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackBool) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackBool(s.ToSlice()) is same as the stack
+func (s *StackBool) ToSlice() []bool {
+	list := make([]bool, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueBool - persistent(immutable) FIFO queue of bool. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueBool struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackBool
+	rear  *StackBool
+}
+
+// NewQueueBool creates queue from the list. First item of the list is at the front
+func NewQueueBool(list []bool) *QueueBool {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueBool{front: reversedStackBool(list)}
+}
+
+// reversedStackBool creates stack with the first item of the list on top
+func reversedStackBool(list []bool) *StackBool {
+	var s *StackBool
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueBool) Push(v bool) *QueueBool {
+	if q == nil {
+		return &QueueBool{front: (*StackBool)(nil).Push(v)}
+	}
+	return &QueueBool{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueBool
+func (q *QueueBool) Pop() *QueueBool {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueBool{front: front, rear: q.rear}
+	}
+	return &QueueBool{front: reversedStackBool(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueBool) Peek() (bool, bool) {
+	if q == nil {
+		var zero bool
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueBool) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueBool) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueBool) ToSlice() []bool {
+	if q == nil {
+		return []bool{}
+	}
+	list := make([]bool, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeBool - persistent(immutable) double-ended queue of bool. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeBool struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackBool
+	back  *StackBool
+}
+
+// NewDequeBool creates deque from the list. First item of the list is at the front
+func NewDequeBool(list []bool) *DequeBool {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeBool{front: reversedStackBool(list[:half]), back: NewStackBool(list[half:])}
+}
+
+// newDequeBool creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeBool(front, back *StackBool) *DequeBool {
+	d := &DequeBool{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeBool(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeBool) PushFront(v bool) *DequeBool {
+	if d == nil {
+		return newDequeBool((*StackBool)(nil).Push(v), nil)
+	}
+	return newDequeBool(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeBool) PushBack(v bool) *DequeBool {
+	if d == nil {
+		return newDequeBool(nil, (*StackBool)(nil).Push(v))
+	}
+	return newDequeBool(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeBool) PopFront() *DequeBool {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeBool(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeBool) PopBack() *DequeBool {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeBool(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeBool) PeekFront() (bool, bool) {
+	if d == nil {
+		var zero bool
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeBool) PeekBack() (bool, bool) {
+	if d == nil {
+		var zero bool
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeBool) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeBool) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeBool) ToSlice() []bool {
+	if d == nil {
+		return []bool{}
+	}
+	list := make([]bool, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueBool - binary heap of bool. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueBool, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueBool struct {
+	key   func(bool) float64
+	items []priorityItemBool
+	seq   int
+}
+
+type priorityItemBool struct {
+	value bool
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueBool creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueBool(key func(bool) float64, list []bool) *PriorityQueueBool {
+	pq := &PriorityQueueBool{key: key, items: make([]priorityItemBool, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueBool) Push(v bool) *PriorityQueueBool {
+	item := priorityItemBool{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueBool) Pop() (bool, bool) {
+	if len(pq.items) == 0 {
+		var zero bool
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueBool) Peek() (bool, bool) {
+	if len(pq.items) == 0 {
+		var zero bool
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueBool) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueBool) ToSlice() []bool {
+	clone := &PriorityQueueBool{items: make([]priorityItemBool, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]bool, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueBool) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
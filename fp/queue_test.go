@@ -0,0 +1,1446 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStackInt(t *testing.T) {
+	s1 := NewStackInt([]int{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackInt failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]int{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]int{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackInt failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackInt failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackInt
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackInt failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueInt(t *testing.T) {
+	q1 := NewQueueInt([]int{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueInt failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]int{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]int{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueInt failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []int
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueInt failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueInt
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueInt failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeInt(t *testing.T) {
+	d1 := NewDequeInt([]int{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]int{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]int{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeInt failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeInt failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeInt failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeInt failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeInt failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeInt
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeInt failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueInt(t *testing.T) {
+	pq := NewPriorityQueueInt(func(v int) float64 { return float64(v) }, []int{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []int{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueInt failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueInt failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []int
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueInt failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueInt(nil, []int{3, 1, 2})
+	if !reflect.DeepEqual([]int{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueInt failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackInt64(t *testing.T) {
+	s1 := NewStackInt64([]int64{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackInt64 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]int64{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]int64{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackInt64 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackInt64 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackInt64
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackInt64 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueInt64(t *testing.T) {
+	q1 := NewQueueInt64([]int64{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueInt64 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]int64{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]int64{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueInt64 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []int64
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int64{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueInt64 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueInt64
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueInt64 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeInt64(t *testing.T) {
+	d1 := NewDequeInt64([]int64{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]int64{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]int64{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeInt64 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeInt64 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeInt64 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeInt64 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeInt64 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeInt64
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeInt64 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueInt64(t *testing.T) {
+	pq := NewPriorityQueueInt64(func(v int64) float64 { return float64(v) }, []int64{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []int64{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueInt64 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueInt64 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []int64
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueInt64 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueInt64(nil, []int64{3, 1, 2})
+	if !reflect.DeepEqual([]int64{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueInt64 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackInt32(t *testing.T) {
+	s1 := NewStackInt32([]int32{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackInt32 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]int32{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]int32{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackInt32 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackInt32 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackInt32
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackInt32 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueInt32(t *testing.T) {
+	q1 := NewQueueInt32([]int32{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueInt32 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]int32{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]int32{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueInt32 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []int32
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int32{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueInt32 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueInt32
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueInt32 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeInt32(t *testing.T) {
+	d1 := NewDequeInt32([]int32{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]int32{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]int32{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeInt32 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeInt32 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeInt32 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeInt32 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeInt32 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeInt32
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeInt32 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueInt32(t *testing.T) {
+	pq := NewPriorityQueueInt32(func(v int32) float64 { return float64(v) }, []int32{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []int32{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueInt32 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueInt32 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []int32
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueInt32 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueInt32(nil, []int32{3, 1, 2})
+	if !reflect.DeepEqual([]int32{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueInt32 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackInt16(t *testing.T) {
+	s1 := NewStackInt16([]int16{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackInt16 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]int16{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]int16{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackInt16 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackInt16 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackInt16
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackInt16 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueInt16(t *testing.T) {
+	q1 := NewQueueInt16([]int16{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueInt16 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]int16{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]int16{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueInt16 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []int16
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int16{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueInt16 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueInt16
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueInt16 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeInt16(t *testing.T) {
+	d1 := NewDequeInt16([]int16{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]int16{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]int16{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeInt16 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeInt16 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeInt16 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeInt16 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeInt16 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeInt16
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeInt16 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueInt16(t *testing.T) {
+	pq := NewPriorityQueueInt16(func(v int16) float64 { return float64(v) }, []int16{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []int16{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueInt16 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueInt16 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []int16
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueInt16 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueInt16(nil, []int16{3, 1, 2})
+	if !reflect.DeepEqual([]int16{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueInt16 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackInt8(t *testing.T) {
+	s1 := NewStackInt8([]int8{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackInt8 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]int8{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]int8{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackInt8 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackInt8 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackInt8
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackInt8 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueInt8(t *testing.T) {
+	q1 := NewQueueInt8([]int8{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueInt8 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]int8{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]int8{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueInt8 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []int8
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]int8{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueInt8 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueInt8
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueInt8 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeInt8(t *testing.T) {
+	d1 := NewDequeInt8([]int8{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]int8{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]int8{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeInt8 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeInt8 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeInt8 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeInt8 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeInt8 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeInt8
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeInt8 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueInt8(t *testing.T) {
+	pq := NewPriorityQueueInt8(func(v int8) float64 { return float64(v) }, []int8{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []int8{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueInt8 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueInt8 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []int8
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueInt8 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueInt8(nil, []int8{3, 1, 2})
+	if !reflect.DeepEqual([]int8{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueInt8 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackUint(t *testing.T) {
+	s1 := NewStackUint([]uint{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackUint failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]uint{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]uint{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackUint failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackUint failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackUint
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackUint failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueUint(t *testing.T) {
+	q1 := NewQueueUint([]uint{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueUint failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]uint{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]uint{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueUint failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []uint
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]uint{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueUint failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueUint
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueUint failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeUint(t *testing.T) {
+	d1 := NewDequeUint([]uint{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]uint{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]uint{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeUint failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeUint failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeUint failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeUint failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeUint failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeUint
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeUint failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueUint(t *testing.T) {
+	pq := NewPriorityQueueUint(func(v uint) float64 { return float64(v) }, []uint{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []uint{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueUint failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueUint failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []uint
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueUint failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueUint(nil, []uint{3, 1, 2})
+	if !reflect.DeepEqual([]uint{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueUint failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackUint64(t *testing.T) {
+	s1 := NewStackUint64([]uint64{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackUint64 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]uint64{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]uint64{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackUint64 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackUint64 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackUint64
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackUint64 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueUint64(t *testing.T) {
+	q1 := NewQueueUint64([]uint64{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueUint64 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]uint64{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]uint64{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueUint64 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []uint64
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]uint64{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueUint64 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueUint64
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueUint64 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeUint64(t *testing.T) {
+	d1 := NewDequeUint64([]uint64{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]uint64{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]uint64{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeUint64 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeUint64 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeUint64 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeUint64 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeUint64 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeUint64
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeUint64 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueUint64(t *testing.T) {
+	pq := NewPriorityQueueUint64(func(v uint64) float64 { return float64(v) }, []uint64{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []uint64{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueUint64 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueUint64 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []uint64
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueUint64 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueUint64(nil, []uint64{3, 1, 2})
+	if !reflect.DeepEqual([]uint64{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueUint64 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackUint32(t *testing.T) {
+	s1 := NewStackUint32([]uint32{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackUint32 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]uint32{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]uint32{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackUint32 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackUint32 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackUint32
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackUint32 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueUint32(t *testing.T) {
+	q1 := NewQueueUint32([]uint32{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueUint32 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]uint32{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]uint32{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueUint32 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []uint32
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]uint32{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueUint32 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueUint32
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueUint32 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeUint32(t *testing.T) {
+	d1 := NewDequeUint32([]uint32{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]uint32{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]uint32{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeUint32 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeUint32 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeUint32 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeUint32 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeUint32 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeUint32
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeUint32 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueUint32(t *testing.T) {
+	pq := NewPriorityQueueUint32(func(v uint32) float64 { return float64(v) }, []uint32{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []uint32{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueUint32 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueUint32 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []uint32
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueUint32 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueUint32(nil, []uint32{3, 1, 2})
+	if !reflect.DeepEqual([]uint32{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueUint32 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackUint16(t *testing.T) {
+	s1 := NewStackUint16([]uint16{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackUint16 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]uint16{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]uint16{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackUint16 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackUint16 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackUint16
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackUint16 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueUint16(t *testing.T) {
+	q1 := NewQueueUint16([]uint16{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueUint16 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]uint16{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]uint16{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueUint16 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []uint16
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]uint16{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueUint16 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueUint16
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueUint16 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeUint16(t *testing.T) {
+	d1 := NewDequeUint16([]uint16{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]uint16{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]uint16{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeUint16 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeUint16 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeUint16 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeUint16 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeUint16 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeUint16
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeUint16 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueUint16(t *testing.T) {
+	pq := NewPriorityQueueUint16(func(v uint16) float64 { return float64(v) }, []uint16{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []uint16{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueUint16 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueUint16 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []uint16
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueUint16 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueUint16(nil, []uint16{3, 1, 2})
+	if !reflect.DeepEqual([]uint16{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueUint16 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackUint8(t *testing.T) {
+	s1 := NewStackUint8([]uint8{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackUint8 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]uint8{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]uint8{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackUint8 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackUint8 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackUint8
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackUint8 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueUint8(t *testing.T) {
+	q1 := NewQueueUint8([]uint8{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueUint8 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]uint8{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]uint8{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueUint8 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []uint8
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]uint8{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueUint8 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueUint8
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueUint8 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeUint8(t *testing.T) {
+	d1 := NewDequeUint8([]uint8{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]uint8{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]uint8{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeUint8 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeUint8 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeUint8 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeUint8 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeUint8 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeUint8
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeUint8 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueUint8(t *testing.T) {
+	pq := NewPriorityQueueUint8(func(v uint8) float64 { return float64(v) }, []uint8{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []uint8{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueUint8 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueUint8 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []uint8
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueUint8 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueUint8(nil, []uint8{3, 1, 2})
+	if !reflect.DeepEqual([]uint8{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueUint8 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackFloat64(t *testing.T) {
+	s1 := NewStackFloat64([]float64{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackFloat64 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]float64{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]float64{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackFloat64 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackFloat64 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackFloat64
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackFloat64 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueFloat64(t *testing.T) {
+	q1 := NewQueueFloat64([]float64{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueFloat64 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]float64{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]float64{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueFloat64 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []float64
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]float64{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueFloat64 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueFloat64
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueFloat64 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeFloat64(t *testing.T) {
+	d1 := NewDequeFloat64([]float64{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]float64{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]float64{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeFloat64 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeFloat64 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeFloat64 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeFloat64 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeFloat64 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeFloat64
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeFloat64 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueFloat64(t *testing.T) {
+	pq := NewPriorityQueueFloat64(func(v float64) float64 { return float64(v) }, []float64{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []float64{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueFloat64 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueFloat64 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []float64
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueFloat64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueFloat64 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueFloat64(nil, []float64{3, 1, 2})
+	if !reflect.DeepEqual([]float64{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueFloat64 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackFloat32(t *testing.T) {
+	s1 := NewStackFloat32([]float32{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStackFloat32 failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]float32{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]float32{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStackFloat32 failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStackFloat32 failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *StackFloat32
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackFloat32 failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueFloat32(t *testing.T) {
+	q1 := NewQueueFloat32([]float32{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueueFloat32 failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]float32{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]float32{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueueFloat32 failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []float32
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]float32{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueFloat32 failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueFloat32
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueFloat32 failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeFloat32(t *testing.T) {
+	d1 := NewDequeFloat32([]float32{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]float32{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]float32{2}, d1.ToSlice()) {
+		t.Errorf("TestDequeFloat32 failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDequeFloat32 failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDequeFloat32 failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDequeFloat32 failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDequeFloat32 failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *DequeFloat32
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDequeFloat32 failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueFloat32(t *testing.T) {
+	pq := NewPriorityQueueFloat32(func(v float32) float64 { return float64(v) }, []float32{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []float32{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueFloat32 failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueueFloat32 failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []float32
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueFloat32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueFloat32 failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueFloat32(nil, []float32{3, 1, 2})
+	if !reflect.DeepEqual([]float32{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueFloat32 failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackStr(t *testing.T) {
+	s1 := NewStackStr([]string{"a", "b"})
+	s2 := s1.Push("c")
+
+	if v, ok := s2.Peek(); !ok || v != "c" || s2.Size() != 3 {
+		t.Errorf("TestStackStr failed. Expected top=%v, actual=%v", "c", v)
+	}
+	if !reflect.DeepEqual([]string{"a", "b", "c"}, s2.ToSlice()) || !reflect.DeepEqual([]string{"a", "b"}, s1.ToSlice()) {
+		t.Errorf("TestStackStr failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != "b" {
+		t.Errorf("TestStackStr failed. Expected top=%v after Pop, actual=%v", "b", v)
+	}
+
+	var empty *StackStr
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStackStr failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueueStr(t *testing.T) {
+	q1 := NewQueueStr([]string{"a", "b"})
+	q2 := q1.Push("c").Pop()
+	q3 := q2.Push("a")
+
+	if v, ok := q2.Peek(); !ok || v != "b" {
+		t.Errorf("TestQueueStr failed. Expected front=%v, actual=%v", "b", v)
+	}
+	if !reflect.DeepEqual([]string{"a", "b"}, q1.ToSlice()) || !reflect.DeepEqual([]string{"b", "c", "a"}, q3.ToSlice()) {
+		t.Errorf("TestQueueStr failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []string
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]string{"b", "c", "a"}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueueStr failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *QueueStr
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push("a").Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueueStr failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDequeStr(t *testing.T) {
+	d1 := NewDequeStr([]string{"b"})
+	d2 := d1.PushFront("a").PushBack("c")
+
+	if !reflect.DeepEqual([]string{"a", "b", "c"}, d2.ToSlice()) || !reflect.DeepEqual([]string{"b"}, d1.ToSlice()) {
+		t.Errorf("TestDequeStr failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != "a" {
+		t.Errorf("TestDequeStr failed. Expected front=%v, actual=%v", "a", v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != "c" {
+		t.Errorf("TestDequeStr failed. Expected back=%v, actual=%v", "c", v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != "a" || d3.Size() != 1 {
+		t.Errorf("TestDequeStr failed. Expected back=%v after PopBack, actual=%v", "a", v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != "c" || d4.Size() != 1 {
+		t.Errorf("TestDequeStr failed. Expected front=%v after PopFront, actual=%v", "c", v)
+	}
+
+	var empty *DequeStr
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack("a").Size() != 1 {
+		t.Errorf("TestDequeStr failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueueStr(t *testing.T) {
+	pq := NewPriorityQueueStr(func(v string) float64 { return float64(v[0]) }, []string{"b", "c"})
+	pq.Push("a").Push("b")
+
+	expected := []string{"a", "b", "b", "c"}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueueStr failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != "a" {
+		t.Errorf("TestPriorityQueueStr failed. Expected peek=%v, actual=%v", "a", v)
+	}
+
+	var actual []string
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueueStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueueStr failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueueStr(nil, []string{"c", "a", "b"})
+	if !reflect.DeepEqual([]string{"c", "a", "b"}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueueStr failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+
+func TestStackBool(t *testing.T) {
+	s := NewStackBool([]bool{false}).Push(true)
+	if v, ok := s.Peek(); !ok || !v || s.Size() != 2 || !reflect.DeepEqual([]bool{false, true}, s.ToSlice()) {
+		t.Errorf("TestStackBool failed. Unexpected items %v", s.ToSlice())
+	}
+	if v, _ := s.Pop().Peek(); v {
+		t.Errorf("TestStackBool failed. Expected false after Pop")
+	}
+}
+
+func TestQueueBool(t *testing.T) {
+	q := NewQueueBool([]bool{false}).Push(true)
+	if v, ok := q.Peek(); !ok || v || !reflect.DeepEqual([]bool{false, true}, q.ToSlice()) {
+		t.Errorf("TestQueueBool failed. Unexpected items %v", q.ToSlice())
+	}
+	if v, _ := q.Pop().Peek(); !v {
+		t.Errorf("TestQueueBool failed. Expected true after Pop")
+	}
+}
+
+func TestDequeBool(t *testing.T) {
+	d := NewDequeBool(nil).PushBack(true).PushFront(false)
+	if !reflect.DeepEqual([]bool{false, true}, d.ToSlice()) {
+		t.Errorf("TestDequeBool failed. Unexpected items %v", d.ToSlice())
+	}
+	if v, _ := d.PopFront().PeekBack(); !v {
+		t.Errorf("TestDequeBool failed. Expected true after PopFront")
+	}
+	if v, _ := d.PopBack().PeekFront(); v {
+		t.Errorf("TestDequeBool failed. Expected false after PopBack")
+	}
+}
+
+func TestPriorityQueueBool(t *testing.T) {
+	trueFirst := func(v bool) float64 {
+		if v {
+			return 0
+		}
+		return 1
+	}
+	pq := NewPriorityQueueBool(trueFirst, []bool{false, true, false})
+	if !reflect.DeepEqual([]bool{true, false, false}, pq.ToSlice()) {
+		t.Errorf("TestPriorityQueueBool failed. Unexpected items %v", pq.ToSlice())
+	}
+	if v, ok := pq.Pop(); !ok || !v || pq.Size() != 2 {
+		t.Errorf("TestPriorityQueueBool failed. Expected true to be popped first")
+	}
+}
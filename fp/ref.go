@@ -0,0 +1,589 @@
+package fp
+
+// RefInt - transactional reference to int. Change it inside Dosync
+type RefInt struct {
+	ref *Ref
+}
+
+// NewRefInt creates ref with the initial value
+func NewRefInt(v int) *RefInt {
+	return &RefInt{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefInt) Deref() int {
+	return r.ref.Deref().(int)
+}
+
+// Get returns the value in the transaction
+func (r *RefInt) Get(tx *Tx) int {
+	return tx.Get(r.ref).(int)
+}
+
+// Set sets the value in the transaction
+func (r *RefInt) Set(tx *Tx, v int) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefInt) Alter(tx *Tx, f func(int) int) int {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(int)) }).(int)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefInt) Commute(tx *Tx, f func(int) int) int {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(int)) }).(int)
+}
+
+// RefInt64 - transactional reference to int64. Change it inside Dosync
+type RefInt64 struct {
+	ref *Ref
+}
+
+// NewRefInt64 creates ref with the initial value
+func NewRefInt64(v int64) *RefInt64 {
+	return &RefInt64{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefInt64) Deref() int64 {
+	return r.ref.Deref().(int64)
+}
+
+// Get returns the value in the transaction
+func (r *RefInt64) Get(tx *Tx) int64 {
+	return tx.Get(r.ref).(int64)
+}
+
+// Set sets the value in the transaction
+func (r *RefInt64) Set(tx *Tx, v int64) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefInt64) Alter(tx *Tx, f func(int64) int64) int64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(int64)) }).(int64)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefInt64) Commute(tx *Tx, f func(int64) int64) int64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(int64)) }).(int64)
+}
+
+// RefInt32 - transactional reference to int32. Change it inside Dosync
+type RefInt32 struct {
+	ref *Ref
+}
+
+// NewRefInt32 creates ref with the initial value
+func NewRefInt32(v int32) *RefInt32 {
+	return &RefInt32{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefInt32) Deref() int32 {
+	return r.ref.Deref().(int32)
+}
+
+// Get returns the value in the transaction
+func (r *RefInt32) Get(tx *Tx) int32 {
+	return tx.Get(r.ref).(int32)
+}
+
+// Set sets the value in the transaction
+func (r *RefInt32) Set(tx *Tx, v int32) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefInt32) Alter(tx *Tx, f func(int32) int32) int32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(int32)) }).(int32)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefInt32) Commute(tx *Tx, f func(int32) int32) int32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(int32)) }).(int32)
+}
+
+// RefInt16 - transactional reference to int16. Change it inside Dosync
+type RefInt16 struct {
+	ref *Ref
+}
+
+// NewRefInt16 creates ref with the initial value
+func NewRefInt16(v int16) *RefInt16 {
+	return &RefInt16{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefInt16) Deref() int16 {
+	return r.ref.Deref().(int16)
+}
+
+// Get returns the value in the transaction
+func (r *RefInt16) Get(tx *Tx) int16 {
+	return tx.Get(r.ref).(int16)
+}
+
+// Set sets the value in the transaction
+func (r *RefInt16) Set(tx *Tx, v int16) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefInt16) Alter(tx *Tx, f func(int16) int16) int16 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(int16)) }).(int16)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefInt16) Commute(tx *Tx, f func(int16) int16) int16 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(int16)) }).(int16)
+}
+
+// RefInt8 - transactional reference to int8. Change it inside Dosync
+type RefInt8 struct {
+	ref *Ref
+}
+
+// NewRefInt8 creates ref with the initial value
+func NewRefInt8(v int8) *RefInt8 {
+	return &RefInt8{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefInt8) Deref() int8 {
+	return r.ref.Deref().(int8)
+}
+
+// Get returns the value in the transaction
+func (r *RefInt8) Get(tx *Tx) int8 {
+	return tx.Get(r.ref).(int8)
+}
+
+// Set sets the value in the transaction
+func (r *RefInt8) Set(tx *Tx, v int8) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefInt8) Alter(tx *Tx, f func(int8) int8) int8 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(int8)) }).(int8)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefInt8) Commute(tx *Tx, f func(int8) int8) int8 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(int8)) }).(int8)
+}
+
+// RefUint - transactional reference to uint. Change it inside Dosync
+type RefUint struct {
+	ref *Ref
+}
+
+// NewRefUint creates ref with the initial value
+func NewRefUint(v uint) *RefUint {
+	return &RefUint{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefUint) Deref() uint {
+	return r.ref.Deref().(uint)
+}
+
+// Get returns the value in the transaction
+func (r *RefUint) Get(tx *Tx) uint {
+	return tx.Get(r.ref).(uint)
+}
+
+// Set sets the value in the transaction
+func (r *RefUint) Set(tx *Tx, v uint) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefUint) Alter(tx *Tx, f func(uint) uint) uint {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(uint)) }).(uint)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefUint) Commute(tx *Tx, f func(uint) uint) uint {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(uint)) }).(uint)
+}
+
+// RefUint64 - transactional reference to uint64. Change it inside Dosync
+type RefUint64 struct {
+	ref *Ref
+}
+
+// NewRefUint64 creates ref with the initial value
+func NewRefUint64(v uint64) *RefUint64 {
+	return &RefUint64{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefUint64) Deref() uint64 {
+	return r.ref.Deref().(uint64)
+}
+
+// Get returns the value in the transaction
+func (r *RefUint64) Get(tx *Tx) uint64 {
+	return tx.Get(r.ref).(uint64)
+}
+
+// Set sets the value in the transaction
+func (r *RefUint64) Set(tx *Tx, v uint64) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefUint64) Alter(tx *Tx, f func(uint64) uint64) uint64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(uint64)) }).(uint64)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefUint64) Commute(tx *Tx, f func(uint64) uint64) uint64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(uint64)) }).(uint64)
+}
+
+// RefUint32 - transactional reference to uint32. Change it inside Dosync
+type RefUint32 struct {
+	ref *Ref
+}
+
+// NewRefUint32 creates ref with the initial value
+func NewRefUint32(v uint32) *RefUint32 {
+	return &RefUint32{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefUint32) Deref() uint32 {
+	return r.ref.Deref().(uint32)
+}
+
+// Get returns the value in the transaction
+func (r *RefUint32) Get(tx *Tx) uint32 {
+	return tx.Get(r.ref).(uint32)
+}
+
+// Set sets the value in the transaction
+func (r *RefUint32) Set(tx *Tx, v uint32) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefUint32) Alter(tx *Tx, f func(uint32) uint32) uint32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(uint32)) }).(uint32)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefUint32) Commute(tx *Tx, f func(uint32) uint32) uint32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(uint32)) }).(uint32)
+}
+
+// RefUint16 - transactional reference to uint16. Change it inside Dosync
+type RefUint16 struct {
+	ref *Ref
+}
+
+// NewRefUint16 creates ref with the initial value
+func NewRefUint16(v uint16) *RefUint16 {
+	return &RefUint16{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefUint16) Deref() uint16 {
+	return r.ref.Deref().(uint16)
+}
+
+// Get returns the value in the transaction
+func (r *RefUint16) Get(tx *Tx) uint16 {
+	return tx.Get(r.ref).(uint16)
+}
+
+// Set sets the value in the transaction
+func (r *RefUint16) Set(tx *Tx, v uint16) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefUint16) Alter(tx *Tx, f func(uint16) uint16) uint16 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(uint16)) }).(uint16)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefUint16) Commute(tx *Tx, f func(uint16) uint16) uint16 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(uint16)) }).(uint16)
+}
+
+// RefUint8 - transactional reference to uint8. Change it inside Dosync
+type RefUint8 struct {
+	ref *Ref
+}
+
+// NewRefUint8 creates ref with the initial value
+func NewRefUint8(v uint8) *RefUint8 {
+	return &RefUint8{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefUint8) Deref() uint8 {
+	return r.ref.Deref().(uint8)
+}
+
+// Get returns the value in the transaction
+func (r *RefUint8) Get(tx *Tx) uint8 {
+	return tx.Get(r.ref).(uint8)
+}
+
+// Set sets the value in the transaction
+func (r *RefUint8) Set(tx *Tx, v uint8) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefUint8) Alter(tx *Tx, f func(uint8) uint8) uint8 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(uint8)) }).(uint8)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefUint8) Commute(tx *Tx, f func(uint8) uint8) uint8 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(uint8)) }).(uint8)
+}
+
+// RefFloat64 - transactional reference to float64. Change it inside Dosync
+type RefFloat64 struct {
+	ref *Ref
+}
+
+// NewRefFloat64 creates ref with the initial value
+func NewRefFloat64(v float64) *RefFloat64 {
+	return &RefFloat64{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefFloat64) Deref() float64 {
+	return r.ref.Deref().(float64)
+}
+
+// Get returns the value in the transaction
+func (r *RefFloat64) Get(tx *Tx) float64 {
+	return tx.Get(r.ref).(float64)
+}
+
+// Set sets the value in the transaction
+func (r *RefFloat64) Set(tx *Tx, v float64) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefFloat64) Alter(tx *Tx, f func(float64) float64) float64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(float64)) }).(float64)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefFloat64) Commute(tx *Tx, f func(float64) float64) float64 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(float64)) }).(float64)
+}
+
+// RefFloat32 - transactional reference to float32. Change it inside Dosync
+type RefFloat32 struct {
+	ref *Ref
+}
+
+// NewRefFloat32 creates ref with the initial value
+func NewRefFloat32(v float32) *RefFloat32 {
+	return &RefFloat32{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefFloat32) Deref() float32 {
+	return r.ref.Deref().(float32)
+}
+
+// Get returns the value in the transaction
+func (r *RefFloat32) Get(tx *Tx) float32 {
+	return tx.Get(r.ref).(float32)
+}
+
+// Set sets the value in the transaction
+func (r *RefFloat32) Set(tx *Tx, v float32) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefFloat32) Alter(tx *Tx, f func(float32) float32) float32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(float32)) }).(float32)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefFloat32) Commute(tx *Tx, f func(float32) float32) float32 {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(float32)) }).(float32)
+}
+
+// RefStr - transactional reference to string. Change it inside Dosync
+type RefStr struct {
+	ref *Ref
+}
+
+// NewRefStr creates ref with the initial value
+func NewRefStr(v string) *RefStr {
+	return &RefStr{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefStr) Deref() string {
+	return r.ref.Deref().(string)
+}
+
+// Get returns the value in the transaction
+func (r *RefStr) Get(tx *Tx) string {
+	return tx.Get(r.ref).(string)
+}
+
+// Set sets the value in the transaction
+func (r *RefStr) Set(tx *Tx, v string) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefStr) Alter(tx *Tx, f func(string) string) string {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(string)) }).(string)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefStr) Commute(tx *Tx, f func(string) string) string {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(string)) }).(string)
+}
+
+// RefBool - transactional reference to bool. Change it inside Dosync
+type RefBool struct {
+	ref *Ref
+}
+
+// NewRefBool creates ref with the initial value
+func NewRefBool(v bool) *RefBool {
+	return &RefBool{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *RefBool) Deref() bool {
+	return r.ref.Deref().(bool)
+}
+
+// Get returns the value in the transaction
+func (r *RefBool) Get(tx *Tx) bool {
+	return tx.Get(r.ref).(bool)
+}
+
+// Set sets the value in the transaction
+func (r *RefBool) Set(tx *Tx, v bool) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *RefBool) Alter(tx *Tx, f func(bool) bool) bool {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(bool)) }).(bool)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *RefBool) Commute(tx *Tx, f func(bool) bool) bool {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(bool)) }).(bool)
+}
@@ -0,0 +1,338 @@
+package fp
+
+import "testing"
+
+func TestRefInt(t *testing.T) {
+	from, to := NewRefInt(10), NewRefInt(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v int) int { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefInt failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefInt failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v int) int { return v + 1 }); v != 5 {
+			t.Errorf("TestRefInt failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefInt failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefInt64(t *testing.T) {
+	from, to := NewRefInt64(10), NewRefInt64(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v int64) int64 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefInt64 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefInt64 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v int64) int64 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefInt64 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefInt64 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefInt32(t *testing.T) {
+	from, to := NewRefInt32(10), NewRefInt32(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v int32) int32 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefInt32 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefInt32 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v int32) int32 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefInt32 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefInt32 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefInt16(t *testing.T) {
+	from, to := NewRefInt16(10), NewRefInt16(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v int16) int16 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefInt16 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefInt16 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v int16) int16 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefInt16 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefInt16 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefInt8(t *testing.T) {
+	from, to := NewRefInt8(10), NewRefInt8(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v int8) int8 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefInt8 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefInt8 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v int8) int8 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefInt8 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefInt8 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefUint(t *testing.T) {
+	from, to := NewRefUint(10), NewRefUint(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v uint) uint { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefUint failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefUint failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v uint) uint { return v + 1 }); v != 5 {
+			t.Errorf("TestRefUint failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefUint failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefUint64(t *testing.T) {
+	from, to := NewRefUint64(10), NewRefUint64(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v uint64) uint64 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefUint64 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefUint64 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v uint64) uint64 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefUint64 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefUint64 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefUint32(t *testing.T) {
+	from, to := NewRefUint32(10), NewRefUint32(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v uint32) uint32 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefUint32 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefUint32 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v uint32) uint32 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefUint32 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefUint32 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefUint16(t *testing.T) {
+	from, to := NewRefUint16(10), NewRefUint16(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v uint16) uint16 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefUint16 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefUint16 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v uint16) uint16 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefUint16 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefUint16 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefUint8(t *testing.T) {
+	from, to := NewRefUint8(10), NewRefUint8(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v uint8) uint8 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefUint8 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefUint8 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v uint8) uint8 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefUint8 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefUint8 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefFloat64(t *testing.T) {
+	from, to := NewRefFloat64(10), NewRefFloat64(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v float64) float64 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefFloat64 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefFloat64 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v float64) float64 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefFloat64 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefFloat64 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefFloat32(t *testing.T) {
+	from, to := NewRefFloat32(10), NewRefFloat32(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v float32) float32 { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRefFloat32 failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRefFloat32 failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v float32) float32 { return v + 1 }); v != 5 {
+			t.Errorf("TestRefFloat32 failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRefFloat32 failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+
+func TestRefStr(t *testing.T) {
+	r := NewRefStr("a")
+	Dosync(func(tx *Tx) error {
+		r.Alter(tx, func(v string) string { return v + "b" })
+		r.Set(tx, r.Get(tx)+"c")
+		return nil
+	})
+	if r.Deref() != "abc" {
+		t.Errorf("TestRefStr failed. Expected=%v, actual=%v", "abc", r.Deref())
+	}
+}
+
+func TestRefBool(t *testing.T) {
+	r := NewRefBool(false)
+	Dosync(func(tx *Tx) error {
+		r.Commute(tx, func(v bool) bool { return !v })
+		return nil
+	})
+	if r.Deref() != true {
+		t.Errorf("TestRefBool failed. Expected=%v, actual=%v", true, r.Deref())
+	}
+}
@@ -0,0 +1,197 @@
+package fp
+
+// RepeatInt returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatInt(n int, v int) []int {
+	if n <= 0 {
+		return []int{}
+	}
+
+	newList := make([]int, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatInt64 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatInt64(n int, v int64) []int64 {
+	if n <= 0 {
+		return []int64{}
+	}
+
+	newList := make([]int64, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatInt32 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatInt32(n int, v int32) []int32 {
+	if n <= 0 {
+		return []int32{}
+	}
+
+	newList := make([]int32, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatInt16 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatInt16(n int, v int16) []int16 {
+	if n <= 0 {
+		return []int16{}
+	}
+
+	newList := make([]int16, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatInt8 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatInt8(n int, v int8) []int8 {
+	if n <= 0 {
+		return []int8{}
+	}
+
+	newList := make([]int8, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatUint returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatUint(n int, v uint) []uint {
+	if n <= 0 {
+		return []uint{}
+	}
+
+	newList := make([]uint, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatUint64 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatUint64(n int, v uint64) []uint64 {
+	if n <= 0 {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatUint32 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatUint32(n int, v uint32) []uint32 {
+	if n <= 0 {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatUint16 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatUint16(n int, v uint16) []uint16 {
+	if n <= 0 {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatUint8 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatUint8(n int, v uint8) []uint8 {
+	if n <= 0 {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatFloat64 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatFloat64(n int, v float64) []float64 {
+	if n <= 0 {
+		return []float64{}
+	}
+
+	newList := make([]float64, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatFloat32 returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatFloat32(n int, v float32) []float32 {
+	if n <= 0 {
+		return []float32{}
+	}
+
+	newList := make([]float32, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatStr returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatStr(n int, v string) []string {
+	if n <= 0 {
+		return []string{}
+	}
+
+	newList := make([]string, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+// RepeatBool returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func RepeatBool(n int, v bool) []bool {
+	if n <= 0 {
+		return []bool{}
+	}
+
+	newList := make([]bool, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
@@ -0,0 +1,262 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepeatInt(t *testing.T) {
+	expectedList := []int{5, 5, 5}
+	actualList := RepeatInt(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int{}
+	actualList = RepeatInt(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatInt(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatInt64(t *testing.T) {
+	expectedList := []int64{5, 5, 5}
+	actualList := RepeatInt64(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int64{}
+	actualList = RepeatInt64(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatInt64(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatInt32(t *testing.T) {
+	expectedList := []int32{5, 5, 5}
+	actualList := RepeatInt32(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int32{}
+	actualList = RepeatInt32(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatInt32(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatInt16(t *testing.T) {
+	expectedList := []int16{5, 5, 5}
+	actualList := RepeatInt16(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int16{}
+	actualList = RepeatInt16(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatInt16(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatInt8(t *testing.T) {
+	expectedList := []int8{5, 5, 5}
+	actualList := RepeatInt8(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int8{}
+	actualList = RepeatInt8(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatInt8(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatUint(t *testing.T) {
+	expectedList := []uint{5, 5, 5}
+	actualList := RepeatUint(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint{}
+	actualList = RepeatUint(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatUint(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatUint64(t *testing.T) {
+	expectedList := []uint64{5, 5, 5}
+	actualList := RepeatUint64(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint64{}
+	actualList = RepeatUint64(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatUint64(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatUint32(t *testing.T) {
+	expectedList := []uint32{5, 5, 5}
+	actualList := RepeatUint32(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint32{}
+	actualList = RepeatUint32(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatUint32(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatUint16(t *testing.T) {
+	expectedList := []uint16{5, 5, 5}
+	actualList := RepeatUint16(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint16{}
+	actualList = RepeatUint16(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatUint16(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatUint8(t *testing.T) {
+	expectedList := []uint8{5, 5, 5}
+	actualList := RepeatUint8(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint8{}
+	actualList = RepeatUint8(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatUint8(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatFloat64(t *testing.T) {
+	expectedList := []float64{5, 5, 5}
+	actualList := RepeatFloat64(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float64{}
+	actualList = RepeatFloat64(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatFloat64(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatFloat32(t *testing.T) {
+	expectedList := []float32{5, 5, 5}
+	actualList := RepeatFloat32(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float32{}
+	actualList = RepeatFloat32(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatFloat32(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatStr(t *testing.T) {
+	expectedList := []string{"a", "a", "a"}
+	actualList := RepeatStr(3, "a")
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []string{}
+	actualList = RepeatStr(0, "a")
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatBool(t *testing.T) {
+	expectedList := []bool{true, true, true}
+	actualList := RepeatBool(3, true)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []bool{}
+	actualList = RepeatBool(0, true)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
@@ -0,0 +1,211 @@
+package fp
+
+// RepeatedlyInt calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyInt(n int, f func() int) []int {
+	if f == nil || n <= 0 {
+		return []int{}
+	}
+
+	newList := make([]int, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyInt64 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyInt64(n int, f func() int64) []int64 {
+	if f == nil || n <= 0 {
+		return []int64{}
+	}
+
+	newList := make([]int64, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyInt32 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyInt32(n int, f func() int32) []int32 {
+	if f == nil || n <= 0 {
+		return []int32{}
+	}
+
+	newList := make([]int32, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyInt16 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyInt16(n int, f func() int16) []int16 {
+	if f == nil || n <= 0 {
+		return []int16{}
+	}
+
+	newList := make([]int16, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyInt8 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyInt8(n int, f func() int8) []int8 {
+	if f == nil || n <= 0 {
+		return []int8{}
+	}
+
+	newList := make([]int8, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyUint calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyUint(n int, f func() uint) []uint {
+	if f == nil || n <= 0 {
+		return []uint{}
+	}
+
+	newList := make([]uint, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyUint64 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyUint64(n int, f func() uint64) []uint64 {
+	if f == nil || n <= 0 {
+		return []uint64{}
+	}
+
+	newList := make([]uint64, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyUint32 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyUint32(n int, f func() uint32) []uint32 {
+	if f == nil || n <= 0 {
+		return []uint32{}
+	}
+
+	newList := make([]uint32, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyUint16 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyUint16(n int, f func() uint16) []uint16 {
+	if f == nil || n <= 0 {
+		return []uint16{}
+	}
+
+	newList := make([]uint16, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyUint8 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyUint8(n int, f func() uint8) []uint8 {
+	if f == nil || n <= 0 {
+		return []uint8{}
+	}
+
+	newList := make([]uint8, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyFloat64 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyFloat64(n int, f func() float64) []float64 {
+	if f == nil || n <= 0 {
+		return []float64{}
+	}
+
+	newList := make([]float64, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyFloat32 calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyFloat32(n int, f func() float32) []float32 {
+	if f == nil || n <= 0 {
+		return []float32{}
+	}
+
+	newList := make([]float32, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyStr calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyStr(n int, f func() string) []string {
+	if f == nil || n <= 0 {
+		return []string{}
+	}
+
+	newList := make([]string, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+// RepeatedlyBool calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func RepeatedlyBool(n int, f func() bool) []bool {
+	if f == nil || n <= 0 {
+		return []bool{}
+	}
+
+	newList := make([]bool, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
@@ -0,0 +1,346 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepeatedlyInt(t *testing.T) {
+	var counter int
+	next := func() int {
+		counter++
+		return counter
+	}
+
+	expectedList := []int{1, 2, 3}
+	actualList := RepeatedlyInt(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int{}
+	actualList = RepeatedlyInt(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyInt(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyInt64(t *testing.T) {
+	var counter int64
+	next := func() int64 {
+		counter++
+		return counter
+	}
+
+	expectedList := []int64{1, 2, 3}
+	actualList := RepeatedlyInt64(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int64{}
+	actualList = RepeatedlyInt64(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyInt64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyInt32(t *testing.T) {
+	var counter int32
+	next := func() int32 {
+		counter++
+		return counter
+	}
+
+	expectedList := []int32{1, 2, 3}
+	actualList := RepeatedlyInt32(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int32{}
+	actualList = RepeatedlyInt32(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyInt32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyInt16(t *testing.T) {
+	var counter int16
+	next := func() int16 {
+		counter++
+		return counter
+	}
+
+	expectedList := []int16{1, 2, 3}
+	actualList := RepeatedlyInt16(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int16{}
+	actualList = RepeatedlyInt16(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyInt16(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyInt8(t *testing.T) {
+	var counter int8
+	next := func() int8 {
+		counter++
+		return counter
+	}
+
+	expectedList := []int8{1, 2, 3}
+	actualList := RepeatedlyInt8(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []int8{}
+	actualList = RepeatedlyInt8(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyInt8(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyInt8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyUint(t *testing.T) {
+	var counter uint
+	next := func() uint {
+		counter++
+		return counter
+	}
+
+	expectedList := []uint{1, 2, 3}
+	actualList := RepeatedlyUint(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint{}
+	actualList = RepeatedlyUint(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyUint(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyUint64(t *testing.T) {
+	var counter uint64
+	next := func() uint64 {
+		counter++
+		return counter
+	}
+
+	expectedList := []uint64{1, 2, 3}
+	actualList := RepeatedlyUint64(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint64{}
+	actualList = RepeatedlyUint64(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyUint64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyUint32(t *testing.T) {
+	var counter uint32
+	next := func() uint32 {
+		counter++
+		return counter
+	}
+
+	expectedList := []uint32{1, 2, 3}
+	actualList := RepeatedlyUint32(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint32{}
+	actualList = RepeatedlyUint32(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyUint32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyUint16(t *testing.T) {
+	var counter uint16
+	next := func() uint16 {
+		counter++
+		return counter
+	}
+
+	expectedList := []uint16{1, 2, 3}
+	actualList := RepeatedlyUint16(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint16{}
+	actualList = RepeatedlyUint16(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyUint16(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint16 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyUint8(t *testing.T) {
+	var counter uint8
+	next := func() uint8 {
+		counter++
+		return counter
+	}
+
+	expectedList := []uint8{1, 2, 3}
+	actualList := RepeatedlyUint8(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []uint8{}
+	actualList = RepeatedlyUint8(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyUint8(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyUint8 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyFloat64(t *testing.T) {
+	var counter float64
+	next := func() float64 {
+		counter++
+		return counter
+	}
+
+	expectedList := []float64{1, 2, 3}
+	actualList := RepeatedlyFloat64(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float64{}
+	actualList = RepeatedlyFloat64(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyFloat64(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat64 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyFloat32(t *testing.T) {
+	var counter float32
+	next := func() float32 {
+		counter++
+		return counter
+	}
+
+	expectedList := []float32{1, 2, 3}
+	actualList := RepeatedlyFloat32(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []float32{}
+	actualList = RepeatedlyFloat32(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = RepeatedlyFloat32(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyFloat32 failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyStr(t *testing.T) {
+	var counter string
+	next := func() string {
+		counter += "a"
+		return counter
+	}
+
+	expectedList := []string{"a", "aa", "aaa"}
+	actualList := RepeatedlyStr(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []string{}
+	actualList = RepeatedlyStr(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyStr failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+
+func TestRepeatedlyBool(t *testing.T) {
+	var flag bool
+	next := func() bool {
+		flag = !flag
+		return flag
+	}
+
+	expectedList := []bool{true, false, true}
+	actualList := RepeatedlyBool(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []bool{}
+	actualList = RepeatedlyBool(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedlyBool failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
@@ -0,0 +1,171 @@
+package fp
+
+// Option - value which may be absent. The zero value is absent.
+//
+// OptionInt, OptionStr ... are the typed versions. gofp generates them for user defined types as well
+type Option struct {
+	value interface{}
+	ok    bool
+}
+
+// SomeOpt returns option with the value
+func SomeOpt(v interface{}) Option {
+	return Option{value: v, ok: true}
+}
+
+// NoneOpt returns option without value
+func NoneOpt() Option {
+	return Option{}
+}
+
+// IsSome returns true if the option has value
+func (o Option) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o Option) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o Option) Get() (interface{}, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o Option) Unwrap() interface{} {
+	if !o.ok {
+		panic("Option: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o Option) OrElse(v interface{}) interface{} {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o Option) OrElseGet(f func() interface{}) interface{} {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o Option) Map(f func(interface{}) interface{}) Option {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOpt(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o Option) FlatMap(f func(interface{}) Option) Option {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o Option) Filter(pred func(interface{}) bool) Option {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return Option{}
+	}
+	return o
+}
+
+// Result - value or error. The zero value is Ok with nil value.
+//
+// ResultInt, ResultStr ... are the typed versions. gofp generates them for user defined types as well
+type Result struct {
+	value interface{}
+	err   error
+}
+
+// Ok returns result with the value
+func Ok(v interface{}) Result {
+	return Result{value: v}
+}
+
+// Err returns result with the error
+func Err(err error) Result {
+	return Result{err: err}
+}
+
+// ResultOf returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOf(json.Marshal(v))
+func ResultOf(v interface{}, err error) Result {
+	if err != nil {
+		return Result{err: err}
+	}
+	return Result{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r Result) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r Result) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r Result) Get() (interface{}, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r Result) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r Result) Unwrap() interface{} {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r Result) OrElse(v interface{}) interface{} {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r Result) Map(f func(interface{}) interface{}) Result {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return Ok(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r Result) FlatMap(f func(interface{}) Result) Result {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r Result) Option() Option {
+	if r.err != nil {
+		return Option{}
+	}
+	return SomeOpt(r.value)
+}
@@ -0,0 +1,75 @@
+package fp
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestOptionUntyped(t *testing.T) {
+	inc := func(v interface{}) interface{} { return v.(int) + 1 }
+	some := SomeOpt(1)
+	none := NoneOpt()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOptionUntyped failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOptionUntyped failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() interface{} { return 3 }); v != 3 {
+		t.Errorf("TestOptionUntyped failed. Expected=%v, actual=%v", 3, v)
+	}
+	if v, ok := SomeOpt(nil).Get(); !ok || v != nil {
+		t.Errorf("TestOptionUntyped failed. Expected Some of nil, actual=%v, %v", v, ok)
+	}
+
+	positive := func(v interface{}) Option {
+		if v.(int) > 1 {
+			return SomeOpt(v)
+		}
+		return NoneOpt()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOptionUntyped failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v interface{}) bool { return v.(int) > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOptionUntyped failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || Ok(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOptionUntyped failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOptionUntyped failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResultUntyped(t *testing.T) {
+	errBoom := errors.New("boom")
+	atoi := func(v interface{}) Result { return ResultOf(strconv.Atoi(v.(string))) }
+
+	if v, err := Ok("12").FlatMap(atoi).Map(func(v interface{}) interface{} { return v.(int) * 2 }).Get(); err != nil || v != 24 {
+		t.Errorf("TestResultUntyped failed. Expected=%v, actual=%v, error=%v", 24, v, err)
+	}
+	if r := Ok("x").FlatMap(atoi); !r.IsErr() || r.OrElse(0) != 0 || r.Option().IsSome() {
+		t.Errorf("TestResultUntyped failed. Expected error of Atoi, actual=%v", r.Err())
+	}
+	failed := Err(errBoom)
+	if failed.Map(func(v interface{}) interface{} { return v }).Err() != errBoom || failed.IsOk() {
+		t.Errorf("TestResultUntyped failed. Expected error to be kept")
+	}
+	if v, ok := Ok(1).Option().Get(); !ok || v != 1 {
+		t.Errorf("TestResultUntyped failed. Expected=%v, actual=%v", 1, v)
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResultUntyped failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
@@ -0,0 +1,232 @@
+package fp
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrTooManyRetries is returned by Dosync when the transaction conflicts with other transactions more than MaxRetries times
+var ErrTooManyRetries = errors.New("fp.Dosync: too many retries")
+
+// DosyncOptions configures Dosync. The zero value retries up to 10000 times
+type DosyncOptions struct {
+	// MaxRetries is the maximum number of times the transaction is run again after a conflict.
+	// 0 means the default(10000). Negative means no retries: the transaction is run once
+	MaxRetries int
+}
+
+const defaultMaxRetries = 10000
+
+var (
+	// stmClock is the version of the last commit
+	stmClock uint64
+	// stmCommitLock allows one commit at a time
+	stmCommitLock sync.Mutex
+)
+
+// Ref - transactional reference. Refs are changed only inside Dosync, and the changes of many refs
+// are committed all together or not at all. Only reads inside Dosync see them all together:
+// Deref can be called anywhere, but Deref of one ref and then of another can see a commit halfway.
+//
+// RefInt, RefStr ... are the typed versions. gofp generates them for user defined types as well
+type Ref struct {
+	mu      sync.RWMutex
+	value   interface{}
+	version uint64
+}
+
+// NewRef creates ref with the initial value
+func NewRef(v interface{}) *Ref {
+	return &Ref{value: v}
+}
+
+// Deref returns the latest committed value. Use Get inside Dosync to read many refs consistently
+func (r *Ref) Deref() interface{} {
+	v, _ := r.snapshot()
+	return v
+}
+
+func (r *Ref) snapshot() (interface{}, uint64) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.value, r.version
+}
+
+// Tx - transaction passed to the function of Dosync. It must not be used after the function returns
+type Tx struct {
+	readPoint uint64
+	// values has the value of every ref read or changed in the transaction
+	values   map[*Ref]interface{}
+	reads    map[*Ref]bool
+	writes   map[*Ref]bool
+	commutes map[*Ref][]func(interface{}) interface{}
+}
+
+// stmRetry is panicked when the transaction reads a ref changed after the transaction started. Dosync recovers it
+type stmRetry struct{}
+
+// Dosync runs the function in a transaction. Inside the function, refs are read from a snapshot taken when
+// the transaction started, and changes are not seen by the other goroutines until the function returns.
+// If another transaction changed a ref which is read or set in the meantime, the function is run again,
+// so it should be free of side effects other than changing refs.
+//
+// Returns error of the function and none of the changes is committed.
+// Returns ErrTooManyRetries if the transaction conflicts more than MaxRetries times.
+// Only the 1st option is used if more than one is passed.
+// Nested Dosync runs as a separate transaction
+//
+// Example: move 100 from one account to another. Sum of the accounts never changes for the readers
+// which read both accounts in a transaction
+//
+//	from, to := NewRefInt(500), NewRefInt(0)
+//	err := Dosync(func(tx *Tx) error {
+//		if from.Get(tx) < 100 {
+//			return errors.New("insufficient funds")
+//		}
+//		from.Alter(tx, func(v int) int { return v - 100 })
+//		to.Alter(tx, func(v int) int { return v + 100 })
+//		return nil
+//	})
+func Dosync(f func(*Tx) error, opts ...DosyncOptions) error {
+	if f == nil {
+		return nil
+	}
+	maxRetries := defaultMaxRetries
+	if len(opts) > 0 && opts[0].MaxRetries > 0 {
+		maxRetries = opts[0].MaxRetries
+	} else if len(opts) > 0 && opts[0].MaxRetries < 0 {
+		maxRetries = 0
+	}
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		tx := &Tx{
+			readPoint: atomic.LoadUint64(&stmClock),
+			values:    make(map[*Ref]interface{}),
+			reads:     make(map[*Ref]bool),
+			writes:    make(map[*Ref]bool),
+			commutes:  make(map[*Ref][]func(interface{}) interface{}),
+		}
+
+		err, retry := tx.run(f)
+		if retry {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if tx.commit() {
+			return nil
+		}
+	}
+	return ErrTooManyRetries
+}
+
+func (tx *Tx) run(f func(*Tx) error) (err error, retry bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(stmRetry); !ok {
+				panic(r)
+			}
+			retry = true
+		}
+	}()
+	return f(tx), false
+}
+
+// commit returns false if a ref which is read or set is changed by another transaction
+func (tx *Tx) commit() bool {
+	stmCommitLock.Lock()
+	defer stmCommitLock.Unlock()
+
+	for _, refs := range []map[*Ref]bool{tx.reads, tx.writes} {
+		for r := range refs {
+			if _, version := r.snapshot(); version > tx.readPoint {
+				return false
+			}
+		}
+	}
+
+	// commutes are applied to the latest value, so they don't conflict
+	newValues := make(map[*Ref]interface{}, len(tx.writes)+len(tx.commutes))
+	for r, fs := range tx.commutes {
+		v := r.Deref()
+		for _, f := range fs {
+			v = f(v)
+		}
+		newValues[r] = v
+	}
+	for r := range tx.writes {
+		newValues[r] = tx.values[r]
+	}
+
+	// transactions which start during the commit read the old clock, so they retry when they see these refs
+	version := atomic.LoadUint64(&stmClock) + 1
+	for r, v := range newValues {
+		r.mu.Lock()
+		r.value = v
+		r.version = version
+		r.mu.Unlock()
+	}
+	atomic.StoreUint64(&stmClock, version)
+	return true
+}
+
+// read returns the value of the ref in the transaction. The transaction is run again
+// if the ref is changed after the transaction started
+func (tx *Tx) read(r *Ref) interface{} {
+	if v, ok := tx.values[r]; ok {
+		return v
+	}
+	v, version := r.snapshot()
+	if version > tx.readPoint {
+		panic(stmRetry{})
+	}
+	tx.values[r] = v
+	return v
+}
+
+// Get returns the value of the ref in the transaction
+func (tx *Tx) Get(r *Ref) interface{} {
+	if len(tx.commutes[r]) == 0 {
+		tx.reads[r] = true
+	}
+	return tx.read(r)
+}
+
+// Set sets the value of the ref in the transaction. Panics if the ref is commuted in the transaction
+func (tx *Tx) Set(r *Ref, v interface{}) {
+	if len(tx.commutes[r]) > 0 {
+		panic("fp.Dosync: can't set Ref after Commute")
+	}
+	tx.values[r] = v
+	tx.writes[r] = true
+}
+
+// Alter sets the value of the ref to f(value in the transaction) and returns the new value.
+// Panics if the ref is commuted in the transaction
+func (tx *Tx) Alter(r *Ref, f func(interface{}) interface{}) interface{} {
+	v := tx.Get(r)
+	if f == nil {
+		return v
+	}
+	v = f(v)
+	tx.Set(r, v)
+	return v
+}
+
+// Commute sets the value of the ref to f(value in the transaction) and returns the new value.
+// When committed, f is called again with the latest value of the ref, so the transaction doesn't retry
+// when only commuted refs are changed by other transactions. f must be commutative(eg. adding to a counter)
+func (tx *Tx) Commute(r *Ref, f func(interface{}) interface{}) interface{} {
+	if f == nil {
+		return tx.Get(r)
+	}
+	if tx.writes[r] {
+		return tx.Alter(r, f)
+	}
+	v := f(tx.read(r))
+	tx.values[r] = v
+	tx.commutes[r] = append(tx.commutes[r], f)
+	return v
+}
@@ -0,0 +1,190 @@
+package fp
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestDosyncRetry(t *testing.T) {
+	r := NewRef(1)
+	attempts := 0
+	err := Dosync(func(tx *Tx) error {
+		attempts++
+		v := tx.Get(r).(int)
+		if attempts == 1 {
+			// another transaction changes the ref after it is read
+			Dosync(func(tx2 *Tx) error {
+				tx2.Set(r, 10)
+				return nil
+			})
+		}
+		tx.Set(r, v+1)
+		return nil
+	})
+	if err != nil || attempts != 2 || r.Deref() != 11 {
+		t.Errorf("TestDosyncRetry failed. Expected=11 after 2 attempts, actual=%v after %v attempts", r.Deref(), attempts)
+	}
+
+	// the ref is changed before it is read
+	attempts = 0
+	Dosync(func(tx *Tx) error {
+		attempts++
+		if attempts == 1 {
+			Dosync(func(tx2 *Tx) error {
+				tx2.Set(r, 20)
+				return nil
+			})
+		}
+		tx.Alter(r, func(v interface{}) interface{} { return v.(int) + 1 })
+		return nil
+	})
+	if attempts != 2 || r.Deref() != 21 {
+		t.Errorf("TestDosyncRetry failed. Expected=21 after 2 attempts, actual=%v after %v attempts", r.Deref(), attempts)
+	}
+}
+
+func TestDosyncMaxRetries(t *testing.T) {
+	r := NewRef(0)
+	attempts := 0
+	err := Dosync(func(tx *Tx) error {
+		attempts++
+		tx.Get(r)
+		Dosync(func(tx2 *Tx) error {
+			tx2.Alter(r, func(v interface{}) interface{} { return v.(int) + 1 })
+			return nil
+		})
+		return nil
+	}, DosyncOptions{MaxRetries: 3})
+	if err != ErrTooManyRetries || attempts != 4 {
+		t.Errorf("TestDosyncMaxRetries failed. Expected=%v after 4 attempts, actual=%v after %v attempts", ErrTooManyRetries, err, attempts)
+	}
+
+	attempts = 0
+	err = Dosync(func(tx *Tx) error {
+		attempts++
+		tx.Get(r)
+		Dosync(func(tx2 *Tx) error {
+			tx2.Alter(r, func(v interface{}) interface{} { return v.(int) + 1 })
+			return nil
+		})
+		return nil
+	}, DosyncOptions{MaxRetries: -1})
+	if err != ErrTooManyRetries || attempts != 1 {
+		t.Errorf("TestDosyncMaxRetries failed. Expected=%v after 1 attempt, actual=%v after %v attempts", ErrTooManyRetries, err, attempts)
+	}
+}
+
+func TestDosyncError(t *testing.T) {
+	errInsufficient := errors.New("insufficient")
+	a, b := NewRef(5), NewRef(0)
+	err := Dosync(func(tx *Tx) error {
+		tx.Set(b, 10)
+		if tx.Get(a).(int) < 10 {
+			return errInsufficient
+		}
+		return nil
+	})
+	if err != errInsufficient || b.Deref() != 0 {
+		t.Errorf("TestDosyncError failed. Expected no change, actual=%v, error=%v", b.Deref(), err)
+	}
+	if Dosync(nil) != nil {
+		t.Errorf("TestDosyncError failed. Expected nil for nil function")
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("TestDosyncError failed. Expected panic of the function, actual=%v", r)
+			}
+		}()
+		Dosync(func(tx *Tx) error {
+			tx.Set(b, 1)
+			panic("boom")
+		})
+	}()
+	if b.Deref() != 0 {
+		t.Errorf("TestDosyncError failed. Expected no change after panic, actual=%v", b.Deref())
+	}
+}
+
+func TestDosyncCommute(t *testing.T) {
+	counter := NewRef(0)
+	attempts := 0
+	inc := func(v interface{}) interface{} { return v.(int) + 1 }
+	Dosync(func(tx *Tx) error {
+		attempts++
+		if v := tx.Commute(counter, inc); v != 1 {
+			t.Errorf("TestDosyncCommute failed. Expected=1 in the transaction, actual=%v", v)
+		}
+		// another transaction changes the commuted ref. It doesn't conflict
+		Dosync(func(tx2 *Tx) error {
+			tx2.Set(counter, 10)
+			return nil
+		})
+		return nil
+	})
+	if attempts != 1 || counter.Deref() != 11 {
+		t.Errorf("TestDosyncCommute failed. Expected=11 after 1 attempt, actual=%v after %v attempts", counter.Deref(), attempts)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestDosyncCommute failed. Expected Set after Commute to panic")
+		}
+	}()
+	Dosync(func(tx *Tx) error {
+		tx.Commute(counter, inc)
+		tx.Set(counter, 0)
+		return nil
+	})
+}
+
+func TestDosyncConcurrent(t *testing.T) {
+	accounts := make([]*Ref, 5)
+	for i := range accounts {
+		accounts[i] = NewRef(100)
+	}
+	counter := NewRef(0)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				from, to := accounts[(g+i)%5], accounts[(g+2*i+1)%5]
+				err := Dosync(func(tx *Tx) error {
+					tx.Alter(from, func(v interface{}) interface{} { return v.(int) - 1 })
+					tx.Alter(to, func(v interface{}) interface{} { return v.(int) + 1 })
+					tx.Commute(counter, func(v interface{}) interface{} { return v.(int) + 1 })
+					return nil
+				})
+				if err != nil {
+					t.Errorf("TestDosyncConcurrent failed. Unexpected error %v", err)
+				}
+
+				// snapshot reads see the total unchanged
+				Dosync(func(tx *Tx) error {
+					total := 0
+					for _, a := range accounts {
+						total += tx.Get(a).(int)
+					}
+					if total != 500 {
+						t.Errorf("TestDosyncConcurrent failed. Expected total=500, actual=%v", total)
+					}
+					return nil
+				})
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, a := range accounts {
+		total += a.Deref().(int)
+	}
+	if total != 500 || counter.Deref() != 800 {
+		t.Errorf("TestDosyncConcurrent failed. Expected total=500 and counter=800, actual=%v and %v", total, counter.Deref())
+	}
+}
@@ -0,0 +1,131 @@
+package fp
+
+import "sort"
+
+// TreeSeq returns the nodes of the tree in depth-first order(node before its children).
+//
+// Takes 3 inputs
+//	1. branch - returns true if the node can have children
+//	2. children - returns children of the branch node
+//	3. root of the tree
+//
+// If both branch and children are nil, the tree is expected to be composed of map[string]interface{}
+// and []interface{}(such as decoded JSON). Values of a map are visited in the sorted order of the keys.
+//
+// Example:
+//	tree := []interface{}{1, []interface{}{2, 3}}
+//	fp.TreeSeq(nil, nil, tree) // Returns [[1 [2 3]] 1 [2 3] 2 3]
+func TreeSeq(branch func(interface{}) bool, children func(interface{}) []interface{}, root interface{}) []interface{} {
+	if branch == nil && children == nil {
+		branch = isTreeBranch
+		children = treeChildren
+	}
+
+	var result []interface{}
+	stack := []interface{}{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if branch != nil && children != nil && branch(node) {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+// Prewalk traverses the tree composed of map[string]interface{} and []interface{}. The function is applied
+// on the node before its children, and then the children of the returned value are walked.
+// Returns a new tree. The original tree is not modified. Returns the tree as it is if the function is nil
+//
+// Example:
+//	config := map[string]interface{}{"db": map[string]interface{}{"password": "secret"}}
+//	hidePassword := func(v interface{}) interface{} {
+//		if m, ok := v.(map[string]interface{}); ok {
+//			if _, ok := m["password"]; ok {
+//				return fp.AssocIn(m, []interface{}{"password"}, "***")
+//			}
+//		}
+//		return v
+//	}
+//	fp.Prewalk(hidePassword, config) // Returns map[db:map[password:***]]
+func Prewalk(f func(interface{}) interface{}, form interface{}) interface{} {
+	if f == nil {
+		return form
+	}
+	return walk(func(v interface{}) interface{} { return Prewalk(f, v) }, identity, f(form))
+}
+
+// Postwalk traverses the tree composed of map[string]interface{} and []interface{}. The function is applied
+// on the node after its children are walked.
+// Returns a new tree. The original tree is not modified. Returns the tree as it is if the function is nil
+//
+// Example:
+//	double := func(v interface{}) interface{} {
+//		if n, ok := v.(int); ok {
+//			return n * 2
+//		}
+//		return v
+//	}
+//	fp.Postwalk(double, []interface{}{1, []interface{}{2}}) // Returns [2 [4]]
+func Postwalk(f func(interface{}) interface{}, form interface{}) interface{} {
+	if f == nil {
+		return form
+	}
+	return walk(func(v interface{}) interface{} { return Postwalk(f, v) }, f, form)
+}
+
+// walk applies inner on each child of the form, builds the same kind of node and applies outer on it
+func walk(inner, outer func(interface{}) interface{}, form interface{}) interface{} {
+	switch node := form.(type) {
+	case map[string]interface{}:
+		newMap := make(map[string]interface{}, len(node))
+		for k, v := range node {
+			newMap[k] = inner(v)
+		}
+		return outer(newMap)
+	case []interface{}:
+		newList := make([]interface{}, len(node))
+		for i, v := range node {
+			newList[i] = inner(v)
+		}
+		return outer(newList)
+	}
+	return outer(form)
+}
+
+func identity(v interface{}) interface{} {
+	return v
+}
+
+func isTreeBranch(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+func treeChildren(v interface{}) []interface{} {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(node))
+		for k := range node {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		children := make([]interface{}, len(keys))
+		for i, k := range keys {
+			children[i] = node[k]
+		}
+		return children
+	case []interface{}:
+		return node
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTreeSeq(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, 3}, map[string]interface{}{"b": 5, "a": 4}}
+
+	expected := []interface{}{tree, 1, []interface{}{2, 3}, 2, 3, map[string]interface{}{"b": 5, "a": 4}, 4, 5}
+	actual := TreeSeq(nil, nil, tree)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestTreeSeq failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	type node struct {
+		value    int
+		children []interface{}
+	}
+	root := node{1, []interface{}{node{2, nil}, node{3, []interface{}{node{4, nil}}}}}
+	branch := func(v interface{}) bool { return len(v.(node).children) > 0 }
+	children := func(v interface{}) []interface{} { return v.(node).children }
+
+	var values []int
+	for _, v := range TreeSeq(branch, children, root) {
+		values = append(values, v.(node).value)
+	}
+	if !reflect.DeepEqual([]int{1, 2, 3, 4}, values) {
+		t.Errorf("TestTreeSeq failed. Expected=[1 2 3 4], actual=%v", values)
+	}
+
+	if !reflect.DeepEqual([]interface{}{root}, TreeSeq(branch, nil, root)) {
+		t.Errorf("TestTreeSeq failed. Expected only root when children is nil")
+	}
+}
+
+func TestPrewalk(t *testing.T) {
+	config := map[string]interface{}{
+		"db":    map[string]interface{}{"user": "admin", "password": "secret"},
+		"cache": []interface{}{map[string]interface{}{"password": "secret"}},
+	}
+	hidePassword := func(v interface{}) interface{} {
+		if m, ok := v.(map[string]interface{}); ok {
+			if _, ok := m["password"]; ok {
+				return AssocIn(m, []interface{}{"password"}, "***")
+			}
+		}
+		return v
+	}
+
+	expected := map[string]interface{}{
+		"db":    map[string]interface{}{"user": "admin", "password": "***"},
+		"cache": []interface{}{map[string]interface{}{"password": "***"}},
+	}
+	actual := Prewalk(hidePassword, config)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPrewalk failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if config["db"].(map[string]interface{})["password"] != "secret" {
+		t.Errorf("TestPrewalk failed. Expected original tree not to be modified")
+	}
+
+	// Children of the replaced node are walked
+	var visited []interface{}
+	expand := func(v interface{}) interface{} {
+		visited = append(visited, v)
+		if v == 1 {
+			return []interface{}{2}
+		}
+		return v
+	}
+	if !reflect.DeepEqual([]interface{}{[]interface{}{2}}, Prewalk(expand, []interface{}{1})) || len(visited) != 3 {
+		t.Errorf("TestPrewalk failed. Expected children of the replaced node to be walked, visited=%v", visited)
+	}
+
+	if !reflect.DeepEqual(config, Prewalk(nil, config)) {
+		t.Errorf("TestPrewalk failed. Expected same tree for nil function")
+	}
+}
+
+func TestPostwalk(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, 3}, map[string]interface{}{"a": 4}}
+	sum := func(v interface{}) interface{} {
+		switch node := v.(type) {
+		case []interface{}:
+			return ReduceInt(func(acc, v int) int { return acc + v }, toIntList(node), 0)
+		case map[string]interface{}:
+			return node["a"]
+		}
+		return v
+	}
+
+	actual := Postwalk(sum, tree)
+	if actual != 10 {
+		t.Errorf("TestPostwalk failed. Expected=10, actual=%v", actual)
+	}
+
+	var visited []interface{}
+	record := func(v interface{}) interface{} {
+		visited = append(visited, v)
+		return v
+	}
+	Postwalk(record, []interface{}{1, []interface{}{2}})
+	expected := []interface{}{1, 2, []interface{}{2}, []interface{}{1, []interface{}{2}}}
+	if !reflect.DeepEqual(expected, visited) {
+		t.Errorf("TestPostwalk failed. Expected=%v, actual=%v", expected, visited)
+	}
+
+	if !reflect.DeepEqual(tree, Postwalk(nil, tree)) {
+		t.Errorf("TestPostwalk failed. Expected same tree for nil function")
+	}
+}
+
+func toIntList(list []interface{}) []int {
+	newList := make([]int, len(list))
+	for i, v := range list {
+		newList[i] = v.(int)
+	}
+	return newList
+}
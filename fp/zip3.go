@@ -0,0 +1,449 @@
+package fp
+
+// Zip3Int takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Int(list1, list2, list3 []int) [][3]int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]int{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Int takes list returned by Zip3Int and returns three lists.
+func Unzip3Int(list [][3]int) ([]int, []int, []int) {
+	list1 := make([]int, len(list))
+	list2 := make([]int, len(list))
+	list3 := make([]int, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Int64 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Int64(list1, list2, list3 []int64) [][3]int64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]int64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]int64{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Int64 takes list returned by Zip3Int64 and returns three lists.
+func Unzip3Int64(list [][3]int64) ([]int64, []int64, []int64) {
+	list1 := make([]int64, len(list))
+	list2 := make([]int64, len(list))
+	list3 := make([]int64, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Int32 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Int32(list1, list2, list3 []int32) [][3]int32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]int32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]int32{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Int32 takes list returned by Zip3Int32 and returns three lists.
+func Unzip3Int32(list [][3]int32) ([]int32, []int32, []int32) {
+	list1 := make([]int32, len(list))
+	list2 := make([]int32, len(list))
+	list3 := make([]int32, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Int16 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Int16(list1, list2, list3 []int16) [][3]int16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]int16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]int16{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Int16 takes list returned by Zip3Int16 and returns three lists.
+func Unzip3Int16(list [][3]int16) ([]int16, []int16, []int16) {
+	list1 := make([]int16, len(list))
+	list2 := make([]int16, len(list))
+	list3 := make([]int16, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Int8 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Int8(list1, list2, list3 []int8) [][3]int8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]int8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]int8{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Int8 takes list returned by Zip3Int8 and returns three lists.
+func Unzip3Int8(list [][3]int8) ([]int8, []int8, []int8) {
+	list1 := make([]int8, len(list))
+	list2 := make([]int8, len(list))
+	list3 := make([]int8, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Uint takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Uint(list1, list2, list3 []uint) [][3]uint {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]uint, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]uint{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Uint takes list returned by Zip3Uint and returns three lists.
+func Unzip3Uint(list [][3]uint) ([]uint, []uint, []uint) {
+	list1 := make([]uint, len(list))
+	list2 := make([]uint, len(list))
+	list3 := make([]uint, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Uint64 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Uint64(list1, list2, list3 []uint64) [][3]uint64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]uint64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]uint64{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Uint64 takes list returned by Zip3Uint64 and returns three lists.
+func Unzip3Uint64(list [][3]uint64) ([]uint64, []uint64, []uint64) {
+	list1 := make([]uint64, len(list))
+	list2 := make([]uint64, len(list))
+	list3 := make([]uint64, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Uint32 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Uint32(list1, list2, list3 []uint32) [][3]uint32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]uint32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]uint32{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Uint32 takes list returned by Zip3Uint32 and returns three lists.
+func Unzip3Uint32(list [][3]uint32) ([]uint32, []uint32, []uint32) {
+	list1 := make([]uint32, len(list))
+	list2 := make([]uint32, len(list))
+	list3 := make([]uint32, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Uint16 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Uint16(list1, list2, list3 []uint16) [][3]uint16 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]uint16, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]uint16{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Uint16 takes list returned by Zip3Uint16 and returns three lists.
+func Unzip3Uint16(list [][3]uint16) ([]uint16, []uint16, []uint16) {
+	list1 := make([]uint16, len(list))
+	list2 := make([]uint16, len(list))
+	list3 := make([]uint16, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Uint8 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Uint8(list1, list2, list3 []uint8) [][3]uint8 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]uint8, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]uint8{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Uint8 takes list returned by Zip3Uint8 and returns three lists.
+func Unzip3Uint8(list [][3]uint8) ([]uint8, []uint8, []uint8) {
+	list1 := make([]uint8, len(list))
+	list2 := make([]uint8, len(list))
+	list3 := make([]uint8, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Float64 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Float64(list1, list2, list3 []float64) [][3]float64 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]float64, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]float64{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Float64 takes list returned by Zip3Float64 and returns three lists.
+func Unzip3Float64(list [][3]float64) ([]float64, []float64, []float64) {
+	list1 := make([]float64, len(list))
+	list2 := make([]float64, len(list))
+	list3 := make([]float64, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Float32 takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Float32(list1, list2, list3 []float32) [][3]float32 {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]float32, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]float32{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Float32 takes list returned by Zip3Float32 and returns three lists.
+func Unzip3Float32(list [][3]float32) ([]float32, []float32, []float32) {
+	list1 := make([]float32, len(list))
+	list2 := make([]float32, len(list))
+	list3 := make([]float32, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Str takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Str(list1, list2, list3 []string) [][3]string {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]string{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Str takes list returned by Zip3Str and returns three lists.
+func Unzip3Str(list [][3]string) ([]string, []string, []string) {
+	list1 := make([]string, len(list))
+	list2 := make([]string, len(list))
+	list3 := make([]string, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+// Zip3Bool takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3Bool(list1, list2, list3 []bool) [][3]bool {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]bool, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]bool{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3Bool takes list returned by Zip3Bool and returns three lists.
+func Unzip3Bool(list [][3]bool) ([]bool, []bool, []bool) {
+	list1 := make([]bool, len(list))
+	list2 := make([]bool, len(list))
+	list3 := make([]bool, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
@@ -0,0 +1,244 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip3Int(t *testing.T) {
+	list1 := []int{1, 2, 3}
+	list2 := []int{4, 5}
+	list3 := []int{6, 7, 8}
+
+	expected := [][3]int{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Int(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Int(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Int failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Int64(t *testing.T) {
+	list1 := []int64{1, 2, 3}
+	list2 := []int64{4, 5}
+	list3 := []int64{6, 7, 8}
+
+	expected := [][3]int64{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Int64(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Int64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Int64 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Int32(t *testing.T) {
+	list1 := []int32{1, 2, 3}
+	list2 := []int32{4, 5}
+	list3 := []int32{6, 7, 8}
+
+	expected := [][3]int32{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Int32(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Int32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Int32 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Int16(t *testing.T) {
+	list1 := []int16{1, 2, 3}
+	list2 := []int16{4, 5}
+	list3 := []int16{6, 7, 8}
+
+	expected := [][3]int16{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Int16(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Int16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Int16 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Int8(t *testing.T) {
+	list1 := []int8{1, 2, 3}
+	list2 := []int8{4, 5}
+	list3 := []int8{6, 7, 8}
+
+	expected := [][3]int8{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Int8(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Int8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Int8 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Uint(t *testing.T) {
+	list1 := []uint{1, 2, 3}
+	list2 := []uint{4, 5}
+	list3 := []uint{6, 7, 8}
+
+	expected := [][3]uint{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Uint(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Uint(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Uint failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Uint64(t *testing.T) {
+	list1 := []uint64{1, 2, 3}
+	list2 := []uint64{4, 5}
+	list3 := []uint64{6, 7, 8}
+
+	expected := [][3]uint64{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Uint64(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Uint64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Uint64 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Uint32(t *testing.T) {
+	list1 := []uint32{1, 2, 3}
+	list2 := []uint32{4, 5}
+	list3 := []uint32{6, 7, 8}
+
+	expected := [][3]uint32{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Uint32(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Uint32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Uint32 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Uint16(t *testing.T) {
+	list1 := []uint16{1, 2, 3}
+	list2 := []uint16{4, 5}
+	list3 := []uint16{6, 7, 8}
+
+	expected := [][3]uint16{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Uint16(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Uint16(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Uint16 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Uint8(t *testing.T) {
+	list1 := []uint8{1, 2, 3}
+	list2 := []uint8{4, 5}
+	list3 := []uint8{6, 7, 8}
+
+	expected := [][3]uint8{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Uint8(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Uint8(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Uint8 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Float64(t *testing.T) {
+	list1 := []float64{1, 2, 3}
+	list2 := []float64{4, 5}
+	list3 := []float64{6, 7, 8}
+
+	expected := [][3]float64{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Float64(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Float64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Float64(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Float64 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Float32(t *testing.T) {
+	list1 := []float32{1, 2, 3}
+	list2 := []float32{4, 5}
+	list3 := []float32{6, 7, 8}
+
+	expected := [][3]float32{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3Float32(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Float32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Float32(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Float32 failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Str(t *testing.T) {
+	list1 := []string{"a", "b", "c"}
+	list2 := []string{"d", "e"}
+	list3 := []string{"f", "g", "h"}
+
+	expected := [][3]string{{"a", "d", "f"}, {"b", "e", "g"}}
+	actual := Zip3Str(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Str failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Str(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Str failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+
+func TestZip3Bool(t *testing.T) {
+	list1 := []bool{true, true, true}
+	list2 := []bool{false, false}
+	list3 := []bool{true, false, true}
+
+	expected := [][3]bool{{true, false, true}, {true, false, false}}
+	actual := Zip3Bool(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3Bool(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3Bool failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
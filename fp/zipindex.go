@@ -0,0 +1,109 @@
+package fp
+
+// ZipIndexInt returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexInt(list []int) []PairIntInt {
+	newList := make([]PairIntInt, len(list))
+	for i, v := range list {
+		newList[i] = PairIntInt{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexInt64 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexInt64(list []int64) []PairIntInt64 {
+	newList := make([]PairIntInt64, len(list))
+	for i, v := range list {
+		newList[i] = PairIntInt64{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexInt32 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexInt32(list []int32) []PairIntInt32 {
+	newList := make([]PairIntInt32, len(list))
+	for i, v := range list {
+		newList[i] = PairIntInt32{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexInt16 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexInt16(list []int16) []PairIntInt16 {
+	newList := make([]PairIntInt16, len(list))
+	for i, v := range list {
+		newList[i] = PairIntInt16{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexInt8 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexInt8(list []int8) []PairIntInt8 {
+	newList := make([]PairIntInt8, len(list))
+	for i, v := range list {
+		newList[i] = PairIntInt8{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexUint returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexUint(list []uint) []PairIntUint {
+	newList := make([]PairIntUint, len(list))
+	for i, v := range list {
+		newList[i] = PairIntUint{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexUint64 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexUint64(list []uint64) []PairIntUint64 {
+	newList := make([]PairIntUint64, len(list))
+	for i, v := range list {
+		newList[i] = PairIntUint64{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexUint32 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexUint32(list []uint32) []PairIntUint32 {
+	newList := make([]PairIntUint32, len(list))
+	for i, v := range list {
+		newList[i] = PairIntUint32{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexUint16 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexUint16(list []uint16) []PairIntUint16 {
+	newList := make([]PairIntUint16, len(list))
+	for i, v := range list {
+		newList[i] = PairIntUint16{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexUint8 returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexUint8(list []uint8) []PairIntUint8 {
+	newList := make([]PairIntUint8, len(list))
+	for i, v := range list {
+		newList[i] = PairIntUint8{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexStr returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexStr(list []string) []PairIntStr {
+	newList := make([]PairIntStr, len(list))
+	for i, v := range list {
+		newList[i] = PairIntStr{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipIndexBool returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndexBool(list []bool) []PairIntBool {
+	newList := make([]PairIntBool, len(list))
+	for i, v := range list {
+		newList[i] = PairIntBool{First: i, Second: v}
+	}
+	return newList
+}
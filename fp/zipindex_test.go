@@ -0,0 +1,174 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZipIndexInt(t *testing.T) {
+	expected := []PairIntInt{{0, 7}, {1, 8}}
+	actual := ZipIndexInt([]int{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntInt{}
+	actual = ZipIndexInt(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexInt64(t *testing.T) {
+	expected := []PairIntInt64{{0, 7}, {1, 8}}
+	actual := ZipIndexInt64([]int64{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntInt64{}
+	actual = ZipIndexInt64(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexInt32(t *testing.T) {
+	expected := []PairIntInt32{{0, 7}, {1, 8}}
+	actual := ZipIndexInt32([]int32{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntInt32{}
+	actual = ZipIndexInt32(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexInt16(t *testing.T) {
+	expected := []PairIntInt16{{0, 7}, {1, 8}}
+	actual := ZipIndexInt16([]int16{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntInt16{}
+	actual = ZipIndexInt16(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexInt8(t *testing.T) {
+	expected := []PairIntInt8{{0, 7}, {1, 8}}
+	actual := ZipIndexInt8([]int8{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntInt8{}
+	actual = ZipIndexInt8(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexUint(t *testing.T) {
+	expected := []PairIntUint{{0, 7}, {1, 8}}
+	actual := ZipIndexUint([]uint{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntUint{}
+	actual = ZipIndexUint(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexUint64(t *testing.T) {
+	expected := []PairIntUint64{{0, 7}, {1, 8}}
+	actual := ZipIndexUint64([]uint64{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntUint64{}
+	actual = ZipIndexUint64(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexUint32(t *testing.T) {
+	expected := []PairIntUint32{{0, 7}, {1, 8}}
+	actual := ZipIndexUint32([]uint32{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntUint32{}
+	actual = ZipIndexUint32(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexUint16(t *testing.T) {
+	expected := []PairIntUint16{{0, 7}, {1, 8}}
+	actual := ZipIndexUint16([]uint16{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntUint16{}
+	actual = ZipIndexUint16(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexUint8(t *testing.T) {
+	expected := []PairIntUint8{{0, 7}, {1, 8}}
+	actual := ZipIndexUint8([]uint8{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntUint8{}
+	actual = ZipIndexUint8(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexStr(t *testing.T) {
+	expected := []PairIntStr{{0, "a"}, {1, "b"}}
+	actual := ZipIndexStr([]string{"a", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntStr{}
+	actual = ZipIndexStr(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipIndexBool(t *testing.T) {
+	expected := []PairIntBool{{0, true}, {1, false}}
+	actual := ZipIndexBool([]bool{true, false})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairIntBool{}
+	actual = ZipIndexBool(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndexBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
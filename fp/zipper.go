@@ -0,0 +1,347 @@
+package fp
+
+import "sort"
+
+// Zipper navigates and edits a tree like Clojure's clojure.zip. Zipper is immutable:
+// every move or edit returns a new zipper, and Root returns a new tree with the edits.
+// The original tree is not modified.
+//
+// Moves which are not possible(eg. Left of the leftmost node) return nil. Methods of nil zipper
+// return nil, so moves can be chained and checked once at the end.
+type Zipper struct {
+	node interface{}
+	path *zipperPath
+	fns  *zipperFns
+	end  bool
+}
+
+type zipperPath struct {
+	left       []interface{}
+	right      []interface{}
+	parent     interface{}
+	parentPath *zipperPath
+	changed    bool
+}
+
+type zipperFns struct {
+	branch   func(interface{}) bool
+	children func(interface{}) []interface{}
+	makeNode func(interface{}, []interface{}) interface{}
+}
+
+// MapEntry is a child of map[string]interface{} in the zipper created by DataZipper.
+// It is a branch with one child: the value
+type MapEntry struct {
+	Key string
+	Val interface{}
+}
+
+// NewZipper creates zipper for any tree.
+//
+// Takes 4 inputs
+//	1. branch - returns true if the node can have children
+//	2. children - returns children of the branch node
+//	3. makeNode - returns a new node from the existing node and new children
+//	4. root of the tree
+//
+// Example: tree of user defined type
+//	type Org struct {
+//		Name    string
+//		Reports []Org
+//	}
+//	branch := func(v interface{}) bool { return true }
+//	children := func(v interface{}) []interface{} { ... convert v.(Org).Reports to []interface{} }
+//	makeNode := func(v interface{}, cs []interface{}) interface{} { ... return Org with Reports from cs }
+//	fp.NewZipper(branch, children, makeNode, ceo).Down().Edit(promote).Root()
+func NewZipper(branch func(interface{}) bool, children func(interface{}) []interface{}, makeNode func(interface{}, []interface{}) interface{}, root interface{}) *Zipper {
+	return &Zipper{node: root, fns: &zipperFns{branch: branch, children: children, makeNode: makeNode}}
+}
+
+// DataZipper creates zipper for tree of []interface{} and map[string]interface{}(such as decoded JSON).
+// Children of a map are MapEntry in the sorted order of keys
+//
+// Example:
+//	tree := []interface{}{1, []interface{}{2, 3}}
+//	fp.DataZipper(tree).Down().Right().Down().Edit(inc).Root() // Returns [1 [3 3]]
+func DataZipper(root interface{}) *Zipper {
+	return NewZipper(isDataBranch, dataChildren, makeDataNode, root)
+}
+
+func isDataBranch(v interface{}) bool {
+	switch v.(type) {
+	case []interface{}, map[string]interface{}, MapEntry:
+		return true
+	}
+	return false
+}
+
+func dataChildren(v interface{}) []interface{} {
+	switch node := v.(type) {
+	case []interface{}:
+		return node
+	case map[string]interface{}:
+		keys := make([]string, 0, len(node))
+		for k := range node {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		children := make([]interface{}, len(keys))
+		for i, k := range keys {
+			children[i] = MapEntry{Key: k, Val: node[k]}
+		}
+		return children
+	case MapEntry:
+		return []interface{}{node.Val}
+	}
+	return nil
+}
+
+// makeDataNode panics if children of a map are not MapEntry, or MapEntry doesn't have exactly one child
+func makeDataNode(v interface{}, children []interface{}) interface{} {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		newMap := make(map[string]interface{}, len(children))
+		for _, child := range children {
+			e := child.(MapEntry)
+			newMap[e.Key] = e.Val
+		}
+		return newMap
+	case MapEntry:
+		if len(children) != 1 {
+			panic("fp.DataZipper: MapEntry must have one child")
+		}
+		return MapEntry{Key: node.Key, Val: children[0]}
+	}
+	newList := make([]interface{}, len(children))
+	copy(newList, children)
+	return newList
+}
+
+// concat returns a new slice, so slices shared between zippers are never modified
+func concat(lists ...[]interface{}) []interface{} {
+	size := 0
+	for _, list := range lists {
+		size += len(list)
+	}
+	newList := make([]interface{}, 0, size)
+	for _, list := range lists {
+		newList = append(newList, list...)
+	}
+	return newList
+}
+
+// Node returns the node at the location
+func (z *Zipper) Node() interface{} {
+	if z == nil {
+		return nil
+	}
+	return z.node
+}
+
+// IsBranch returns true if the node can have children
+func (z *Zipper) IsBranch() bool {
+	return z != nil && z.fns.branch != nil && z.fns.branch(z.node)
+}
+
+// Children returns children of the node. Returns nil if the node is not a branch
+func (z *Zipper) Children() []interface{} {
+	if !z.IsBranch() || z.fns.children == nil {
+		return nil
+	}
+	return z.fns.children(z.node)
+}
+
+// IsEnd returns true if Next reached the end of depth-first traversal
+func (z *Zipper) IsEnd() bool {
+	return z != nil && z.end
+}
+
+// Down moves to the leftmost child
+func (z *Zipper) Down() *Zipper {
+	children := z.Children()
+	if len(children) == 0 {
+		return nil
+	}
+	return &Zipper{
+		node: children[0],
+		path: &zipperPath{right: children[1:], parent: z.node, parentPath: z.path},
+		fns:  z.fns,
+	}
+}
+
+// Up moves to the parent. The parent is made again with makeNode if any child is changed
+func (z *Zipper) Up() *Zipper {
+	if z == nil || z.path == nil {
+		return nil
+	}
+	p := z.path
+	if !p.changed {
+		return &Zipper{node: p.parent, path: p.parentPath, fns: z.fns}
+	}
+
+	parent := z.fns.makeNode(p.parent, concat(p.left, []interface{}{z.node}, p.right))
+	return &Zipper{node: parent, path: p.parentPath.markChanged(), fns: z.fns}
+}
+
+func (p *zipperPath) markChanged() *zipperPath {
+	if p == nil {
+		return nil
+	}
+	newPath := *p
+	newPath.changed = true
+	return &newPath
+}
+
+// Root returns the root of the tree with all the edits
+func (z *Zipper) Root() interface{} {
+	if z == nil {
+		return nil
+	}
+	for z.path != nil {
+		z = z.Up()
+	}
+	return z.node
+}
+
+// Left moves to the left sibling
+func (z *Zipper) Left() *Zipper {
+	if z == nil || z.path == nil || len(z.path.left) == 0 {
+		return nil
+	}
+	p := *z.path
+	last := len(p.left) - 1
+	node := p.left[last]
+	p.left = p.left[:last:last]
+	p.right = concat([]interface{}{z.node}, p.right)
+	return &Zipper{node: node, path: &p, fns: z.fns}
+}
+
+// Right moves to the right sibling
+func (z *Zipper) Right() *Zipper {
+	if z == nil || z.path == nil || len(z.path.right) == 0 {
+		return nil
+	}
+	p := *z.path
+	node := p.right[0]
+	p.left = concat(p.left, []interface{}{z.node})
+	p.right = p.right[1:]
+	return &Zipper{node: node, path: &p, fns: z.fns}
+}
+
+// Replace returns zipper with the node replaced
+func (z *Zipper) Replace(node interface{}) *Zipper {
+	if z == nil {
+		return nil
+	}
+	return &Zipper{node: node, path: z.path.markChanged(), fns: z.fns}
+}
+
+// Edit returns zipper with the node replaced by the result of the function. Returns nil if the function is nil
+func (z *Zipper) Edit(f func(interface{}) interface{}) *Zipper {
+	if z == nil || f == nil {
+		return nil
+	}
+	return z.Replace(f(z.node))
+}
+
+// InsertLeft inserts the item as the left sibling. Returns nil at the root
+func (z *Zipper) InsertLeft(item interface{}) *Zipper {
+	if z == nil || z.path == nil {
+		return nil
+	}
+	p := *z.path
+	p.left = concat(p.left, []interface{}{item})
+	p.changed = true
+	return &Zipper{node: z.node, path: &p, fns: z.fns}
+}
+
+// InsertRight inserts the item as the right sibling. Returns nil at the root
+func (z *Zipper) InsertRight(item interface{}) *Zipper {
+	if z == nil || z.path == nil {
+		return nil
+	}
+	p := *z.path
+	p.right = concat([]interface{}{item}, p.right)
+	p.changed = true
+	return &Zipper{node: z.node, path: &p, fns: z.fns}
+}
+
+// InsertChild inserts the item as the leftmost child. Returns nil if the node is not a branch
+func (z *Zipper) InsertChild(item interface{}) *Zipper {
+	if !z.IsBranch() {
+		return nil
+	}
+	return z.Replace(z.fns.makeNode(z.node, concat([]interface{}{item}, z.Children())))
+}
+
+// AppendChild inserts the item as the rightmost child. Returns nil if the node is not a branch
+func (z *Zipper) AppendChild(item interface{}) *Zipper {
+	if !z.IsBranch() {
+		return nil
+	}
+	return z.Replace(z.fns.makeNode(z.node, concat(z.Children(), []interface{}{item})))
+}
+
+// Remove removes the node and moves to the previous node in depth-first order. Returns nil at the root
+func (z *Zipper) Remove() *Zipper {
+	if z == nil || z.path == nil {
+		return nil
+	}
+	p := z.path
+	if len(p.left) == 0 {
+		parent := z.fns.makeNode(p.parent, p.right)
+		return &Zipper{node: parent, path: p.parentPath.markChanged(), fns: z.fns}
+	}
+
+	last := len(p.left) - 1
+	newPath := *p
+	newPath.left = p.left[:last:last]
+	newPath.changed = true
+	return (&Zipper{node: p.left[last], path: &newPath, fns: z.fns}).deepestRightmost()
+}
+
+func (z *Zipper) deepestRightmost() *Zipper {
+	for {
+		child := z.Down()
+		if child == nil {
+			return z
+		}
+		for right := child.Right(); right != nil; right = child.Right() {
+			child = right
+		}
+		z = child
+	}
+}
+
+// Next moves to the next node in depth-first order. At the end, returns zipper at the root
+// for which IsEnd returns true. Next of the end is the end itself
+func (z *Zipper) Next() *Zipper {
+	if z == nil || z.end {
+		return z
+	}
+	if down := z.Down(); down != nil {
+		return down
+	}
+	if right := z.Right(); right != nil {
+		return right
+	}
+	for p := z; ; {
+		up := p.Up()
+		if up == nil {
+			return &Zipper{node: p.node, fns: z.fns, end: true}
+		}
+		if right := up.Right(); right != nil {
+			return right
+		}
+		p = up
+	}
+}
+
+// Prev moves to the previous node in depth-first order. Returns nil at the root
+func (z *Zipper) Prev() *Zipper {
+	if left := z.Left(); left != nil {
+		return left.deepestRightmost()
+	}
+	return z.Up()
+}
@@ -0,0 +1,199 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZipperNavigation(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, 3}, 4}
+	z := DataZipper(tree)
+
+	if v := z.Down().Right().Down().Right().Node(); v != 3 {
+		t.Errorf("TestZipperNavigation failed. Expected=3, actual=%v", v)
+	}
+	if v := z.Down().Right().Right().Left().Down().Node(); v != 2 {
+		t.Errorf("TestZipperNavigation failed. Expected=2, actual=%v", v)
+	}
+	if v := z.Down().Right().Down().Up().Up().Node(); !reflect.DeepEqual(tree, v) {
+		t.Errorf("TestZipperNavigation failed. Expected=%v, actual=%v", tree, v)
+	}
+
+	if z.Up() != nil || z.Left() != nil || z.Right() != nil || z.Down().Left() != nil || z.Down().Down() != nil {
+		t.Errorf("TestZipperNavigation failed. Expected nil for impossible moves")
+	}
+	if z.Down().Down().Right().Node() != nil || z.Down().Down().Right().Root() != nil {
+		t.Errorf("TestZipperNavigation failed. Expected methods of nil zipper to return nil")
+	}
+}
+
+func TestZipperEdit(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, 3}, 4}
+	inc := func(v interface{}) interface{} { return v.(int) + 1 }
+
+	expected := []interface{}{1, []interface{}{3, 3}, 4}
+	actual := DataZipper(tree).Down().Right().Down().Edit(inc).Root()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperEdit failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []interface{}{0, 1, []interface{}{5, 2, 3, 6}, 4, 7}
+	actual = DataZipper(tree).Down().InsertLeft(0).Right().InsertChild(5).AppendChild(6).Right().InsertRight(7).Root()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperEdit failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []interface{}{1, []interface{}{3}, 4}
+	actual = DataZipper(tree).Down().Right().Down().Remove().Root()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperEdit failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !reflect.DeepEqual([]interface{}{1, []interface{}{2, 3}, 4}, tree) {
+		t.Errorf("TestZipperEdit failed. Expected original tree not to be modified, actual=%v", tree)
+	}
+	if DataZipper(tree).Remove() != nil || DataZipper(tree).InsertLeft(0) != nil || DataZipper(tree).Down().InsertChild(0) != nil {
+		t.Errorf("TestZipperEdit failed. Expected nil for impossible edits")
+	}
+	if DataZipper(tree).Edit(nil) != nil {
+		t.Errorf("TestZipperEdit failed. Expected nil for nil function")
+	}
+}
+
+func TestZipperRemove(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, []interface{}{3}}, 4}
+
+	// Remove moves to the previous node in depth-first order
+	z := DataZipper(tree).Down().Right().Right().Remove()
+	if z.Node() != 3 {
+		t.Errorf("TestZipperRemove failed. Expected=3, actual=%v", z.Node())
+	}
+	expected := []interface{}{1, []interface{}{2, []interface{}{3}}}
+	if actual := z.Root(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperRemove failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// Remove every odd number
+	z = DataZipper(tree)
+	for !z.IsEnd() {
+		if v, ok := z.Node().(int); ok && v%2 == 1 {
+			z = z.Remove()
+		}
+		z = z.Next()
+	}
+	expected = []interface{}{[]interface{}{2, []interface{}{}}, 4}
+	if actual := z.Root(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperRemove failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipperNext(t *testing.T) {
+	tree := []interface{}{1, []interface{}{2, []interface{}{3}}, 4}
+
+	var visited []interface{}
+	z := DataZipper(tree)
+	for ; !z.IsEnd(); z = z.Next() {
+		if !z.IsBranch() {
+			visited = append(visited, z.Node())
+		}
+	}
+	if !reflect.DeepEqual([]interface{}{1, 2, 3, 4}, visited) {
+		t.Errorf("TestZipperNext failed. Expected=[1 2 3 4], actual=%v", visited)
+	}
+	if z.Next() != z || !reflect.DeepEqual(tree, z.Node()) {
+		t.Errorf("TestZipperNext failed. Expected end to stay at the root")
+	}
+
+	// Prev walks back in the reverse order
+	visited = nil
+	last := DataZipper(tree).Down().Right().Right()
+	for p := last; p != nil; p = p.Prev() {
+		if !p.IsBranch() {
+			visited = append(visited, p.Node())
+		}
+	}
+	if !reflect.DeepEqual([]interface{}{4, 3, 2, 1}, visited) {
+		t.Errorf("TestZipperNext failed. Expected=[4 3 2 1], actual=%v", visited)
+	}
+
+	double := func(v interface{}) interface{} {
+		if i, ok := v.(int); ok {
+			return i * 2
+		}
+		return v
+	}
+	for z = DataZipper(tree); !z.IsEnd(); z = z.Next() {
+		z = z.Edit(double)
+	}
+	expected := []interface{}{2, []interface{}{4, []interface{}{6}}, 8}
+	if actual := z.Root(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperNext failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipperMap(t *testing.T) {
+	config := map[string]interface{}{
+		"name":  "app",
+		"ports": []interface{}{80, 443},
+	}
+
+	z := DataZipper(config).Down()
+	if e := z.Node().(MapEntry); e.Key != "name" || e.Val != "app" {
+		t.Errorf("TestZipperMap failed. Expected=name:app, actual=%v", e)
+	}
+
+	expected := map[string]interface{}{"name": "app", "ports": []interface{}{8080, 443}}
+	actual := z.Right().Down().Down().Replace(8080).Root()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperMap failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[string]interface{}{"ports": []interface{}{80, 443}, "debug": true}
+	actual = z.Remove().AppendChild(MapEntry{Key: "debug", Val: true}).Root()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperMap failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if len(config) != 2 || config["name"] != "app" {
+		t.Errorf("TestZipperMap failed. Expected original map not to be modified, actual=%v", config)
+	}
+}
+
+func TestZipperUserType(t *testing.T) {
+	type org struct {
+		name    string
+		reports []org
+	}
+	branch := func(v interface{}) bool { return true }
+	children := func(v interface{}) []interface{} {
+		reports := v.(org).reports
+		list := make([]interface{}, len(reports))
+		for i, r := range reports {
+			list[i] = r
+		}
+		return list
+	}
+	makeNode := func(v interface{}, cs []interface{}) interface{} {
+		reports := make([]org, len(cs))
+		for i, c := range cs {
+			reports[i] = c.(org)
+		}
+		return org{name: v.(org).name, reports: reports}
+	}
+
+	ceo := org{"ceo", []org{{"cto", []org{{"dev", nil}}}, {"cfo", nil}}}
+	z := NewZipper(branch, children, makeNode, ceo)
+
+	actual := z.Down().Down().InsertRight(org{"qa", nil}).Root().(org)
+	expected := org{"ceo", []org{{"cto", []org{{"dev", nil}, {"qa", nil}}}, {"cfo", nil}}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipperUserType failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	var names []string
+	for z := NewZipper(branch, children, makeNode, ceo); !z.IsEnd(); z = z.Next() {
+		names = append(names, z.Node().(org).name)
+	}
+	if !reflect.DeepEqual([]string{"ceo", "cto", "dev", "cfo"}, names) {
+		t.Errorf("TestZipperUserType failed. Expected=[ceo cto dev cfo], actual=%v", names)
+	}
+}
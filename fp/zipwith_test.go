@@ -0,0 +1,4910 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZipWithIntIntInt(t *testing.T) {
+	f := func(v1 int, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntIntInt(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntIntInt(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt(t *testing.T) {
+	f := func(v1 int, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntInt64(t *testing.T) {
+	f := func(v1 int, v2 int) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithIntIntInt64(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithIntIntInt64(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntInt32(t *testing.T) {
+	f := func(v1 int, v2 int) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithIntIntInt32(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithIntIntInt32(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntInt16(t *testing.T) {
+	f := func(v1 int, v2 int) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithIntIntInt16(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithIntIntInt16(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntInt8(t *testing.T) {
+	f := func(v1 int, v2 int) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithIntIntInt8(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithIntIntInt8(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntUint(t *testing.T) {
+	f := func(v1 int, v2 int) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithIntIntUint(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithIntIntUint(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntUint64(t *testing.T) {
+	f := func(v1 int, v2 int) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithIntIntUint64(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithIntIntUint64(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntUint32(t *testing.T) {
+	f := func(v1 int, v2 int) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithIntIntUint32(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithIntIntUint32(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntUint16(t *testing.T) {
+	f := func(v1 int, v2 int) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithIntIntUint16(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithIntIntUint16(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntUint8(t *testing.T) {
+	f := func(v1 int, v2 int) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithIntIntUint8(f, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithIntIntUint8(nil, []int{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntIntBool(t *testing.T) {
+	f := func(v1 int, v2 int) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithIntIntBool(f, []int{1, 5, 3}, []int{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntIntBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt64Int(t *testing.T) {
+	f := func(v1 int, v2 int64) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntInt64Int(f, []int{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntInt64Int(nil, []int{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt64Int64(t *testing.T) {
+	f := func(v1 int, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithIntInt64Int64(f, []int{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithIntInt64Int64(nil, []int{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt32Int(t *testing.T) {
+	f := func(v1 int, v2 int32) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntInt32Int(f, []int{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntInt32Int(nil, []int{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt32Int32(t *testing.T) {
+	f := func(v1 int, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithIntInt32Int32(f, []int{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithIntInt32Int32(nil, []int{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt16Int(t *testing.T) {
+	f := func(v1 int, v2 int16) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntInt16Int(f, []int{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntInt16Int(nil, []int{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt16Int16(t *testing.T) {
+	f := func(v1 int, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithIntInt16Int16(f, []int{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithIntInt16Int16(nil, []int{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt8Int(t *testing.T) {
+	f := func(v1 int, v2 int8) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntInt8Int(f, []int{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntInt8Int(nil, []int{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntInt8Int8(t *testing.T) {
+	f := func(v1 int, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithIntInt8Int8(f, []int{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithIntInt8Int8(nil, []int{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntInt8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUintInt(t *testing.T) {
+	f := func(v1 int, v2 uint) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntUintInt(f, []int{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntUintInt(nil, []int{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUintUint(t *testing.T) {
+	f := func(v1 int, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithIntUintUint(f, []int{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithIntUintUint(nil, []int{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint64Int(t *testing.T) {
+	f := func(v1 int, v2 uint64) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntUint64Int(f, []int{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntUint64Int(nil, []int{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint64Uint64(t *testing.T) {
+	f := func(v1 int, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithIntUint64Uint64(f, []int{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithIntUint64Uint64(nil, []int{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint32Int(t *testing.T) {
+	f := func(v1 int, v2 uint32) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntUint32Int(f, []int{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntUint32Int(nil, []int{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint32Uint32(t *testing.T) {
+	f := func(v1 int, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithIntUint32Uint32(f, []int{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithIntUint32Uint32(nil, []int{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint16Int(t *testing.T) {
+	f := func(v1 int, v2 uint16) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntUint16Int(f, []int{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntUint16Int(nil, []int{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint16Uint16(t *testing.T) {
+	f := func(v1 int, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithIntUint16Uint16(f, []int{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithIntUint16Uint16(nil, []int{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint8Int(t *testing.T) {
+	f := func(v1 int, v2 uint8) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithIntUint8Int(f, []int{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithIntUint8Int(nil, []int{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithIntUint8Uint8(t *testing.T) {
+	f := func(v1 int, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithIntUint8Uint8(f, []int{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithIntUint8Uint8(nil, []int{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithIntUint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64IntInt(t *testing.T) {
+	f := func(v1 int64, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt64IntInt(f, []int64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt64IntInt(nil, []int64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64IntInt64(t *testing.T) {
+	f := func(v1 int64, v2 int) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64IntInt64(f, []int64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64IntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64IntInt64(nil, []int64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64IntInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Int(t *testing.T) {
+	f := func(v1 int64, v2 int64) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt64Int64Int(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt64Int64Int(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Int64(t *testing.T) {
+	f := func(v1 int64, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Int64Int64(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Int64Int64(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64(t *testing.T) {
+	f := func(v1 int64, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Int32(t *testing.T) {
+	f := func(v1 int64, v2 int64) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt64Int64Int32(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt64Int64Int32(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Int16(t *testing.T) {
+	f := func(v1 int64, v2 int64) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt64Int64Int16(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt64Int64Int16(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Int8(t *testing.T) {
+	f := func(v1 int64, v2 int64) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt64Int64Int8(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt64Int64Int8(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Uint(t *testing.T) {
+	f := func(v1 int64, v2 int64) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt64Int64Uint(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt64Int64Uint(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Uint64(t *testing.T) {
+	f := func(v1 int64, v2 int64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt64Int64Uint64(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt64Int64Uint64(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Uint32(t *testing.T) {
+	f := func(v1 int64, v2 int64) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt64Int64Uint32(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt64Int64Uint32(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Uint16(t *testing.T) {
+	f := func(v1 int64, v2 int64) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt64Int64Uint16(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt64Int64Uint16(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Uint8(t *testing.T) {
+	f := func(v1 int64, v2 int64) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt64Int64Uint8(f, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt64Int64Uint8(nil, []int64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int64Bool(t *testing.T) {
+	f := func(v1 int64, v2 int64) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithInt64Int64Bool(f, []int64{1, 5, 3}, []int64{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int32Int64(t *testing.T) {
+	f := func(v1 int64, v2 int32) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Int32Int64(f, []int64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Int32Int64(nil, []int64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int32Int32(t *testing.T) {
+	f := func(v1 int64, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt64Int32Int32(f, []int64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt64Int32Int32(nil, []int64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int16Int64(t *testing.T) {
+	f := func(v1 int64, v2 int16) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Int16Int64(f, []int64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Int16Int64(nil, []int64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int16Int16(t *testing.T) {
+	f := func(v1 int64, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt64Int16Int16(f, []int64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt64Int16Int16(nil, []int64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int8Int64(t *testing.T) {
+	f := func(v1 int64, v2 int8) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Int8Int64(f, []int64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Int8Int64(nil, []int64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Int8Int8(t *testing.T) {
+	f := func(v1 int64, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt64Int8Int8(f, []int64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt64Int8Int8(nil, []int64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64UintInt64(t *testing.T) {
+	f := func(v1 int64, v2 uint) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64UintInt64(f, []int64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64UintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64UintInt64(nil, []int64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64UintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64UintUint(t *testing.T) {
+	f := func(v1 int64, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt64UintUint(f, []int64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt64UintUint(nil, []int64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint64Int64(t *testing.T) {
+	f := func(v1 int64, v2 uint64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Uint64Int64(f, []int64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Uint64Int64(nil, []int64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint64Uint64(t *testing.T) {
+	f := func(v1 int64, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt64Uint64Uint64(f, []int64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt64Uint64Uint64(nil, []int64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint32Int64(t *testing.T) {
+	f := func(v1 int64, v2 uint32) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Uint32Int64(f, []int64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Uint32Int64(nil, []int64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint32Uint32(t *testing.T) {
+	f := func(v1 int64, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt64Uint32Uint32(f, []int64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt64Uint32Uint32(nil, []int64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint16Int64(t *testing.T) {
+	f := func(v1 int64, v2 uint16) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Uint16Int64(f, []int64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Uint16Int64(nil, []int64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint16Uint16(t *testing.T) {
+	f := func(v1 int64, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt64Uint16Uint16(f, []int64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt64Uint16Uint16(nil, []int64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint8Int64(t *testing.T) {
+	f := func(v1 int64, v2 uint8) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt64Uint8Int64(f, []int64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt64Uint8Int64(nil, []int64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt64Uint8Uint8(t *testing.T) {
+	f := func(v1 int64, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt64Uint8Uint8(f, []int64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt64Uint8Uint8(nil, []int64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt64Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32IntInt(t *testing.T) {
+	f := func(v1 int32, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt32IntInt(f, []int32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt32IntInt(nil, []int32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32IntInt32(t *testing.T) {
+	f := func(v1 int32, v2 int) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32IntInt32(f, []int32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32IntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32IntInt32(nil, []int32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32IntInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int64Int64(t *testing.T) {
+	f := func(v1 int32, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt32Int64Int64(f, []int32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt32Int64Int64(nil, []int32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int64Int32(t *testing.T) {
+	f := func(v1 int32, v2 int64) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Int64Int32(f, []int32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Int64Int32(nil, []int32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Int(t *testing.T) {
+	f := func(v1 int32, v2 int32) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt32Int32Int(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt32Int32Int(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Int64(t *testing.T) {
+	f := func(v1 int32, v2 int32) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt32Int32Int64(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt32Int32Int64(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Int32(t *testing.T) {
+	f := func(v1 int32, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Int32Int32(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Int32Int32(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32(t *testing.T) {
+	f := func(v1 int32, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Int16(t *testing.T) {
+	f := func(v1 int32, v2 int32) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt32Int32Int16(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt32Int32Int16(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Int8(t *testing.T) {
+	f := func(v1 int32, v2 int32) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt32Int32Int8(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt32Int32Int8(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Uint(t *testing.T) {
+	f := func(v1 int32, v2 int32) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt32Int32Uint(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt32Int32Uint(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Uint64(t *testing.T) {
+	f := func(v1 int32, v2 int32) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt32Int32Uint64(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt32Int32Uint64(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Uint32(t *testing.T) {
+	f := func(v1 int32, v2 int32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt32Int32Uint32(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt32Int32Uint32(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Uint16(t *testing.T) {
+	f := func(v1 int32, v2 int32) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt32Int32Uint16(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt32Int32Uint16(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Uint8(t *testing.T) {
+	f := func(v1 int32, v2 int32) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt32Int32Uint8(f, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt32Int32Uint8(nil, []int32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int32Bool(t *testing.T) {
+	f := func(v1 int32, v2 int32) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithInt32Int32Bool(f, []int32{1, 5, 3}, []int32{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int16Int32(t *testing.T) {
+	f := func(v1 int32, v2 int16) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Int16Int32(f, []int32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Int16Int32(nil, []int32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int16Int16(t *testing.T) {
+	f := func(v1 int32, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt32Int16Int16(f, []int32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt32Int16Int16(nil, []int32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int8Int32(t *testing.T) {
+	f := func(v1 int32, v2 int8) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Int8Int32(f, []int32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Int8Int32(nil, []int32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Int8Int8(t *testing.T) {
+	f := func(v1 int32, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt32Int8Int8(f, []int32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt32Int8Int8(nil, []int32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32UintInt32(t *testing.T) {
+	f := func(v1 int32, v2 uint) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32UintInt32(f, []int32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32UintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32UintInt32(nil, []int32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32UintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32UintUint(t *testing.T) {
+	f := func(v1 int32, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt32UintUint(f, []int32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt32UintUint(nil, []int32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint64Int32(t *testing.T) {
+	f := func(v1 int32, v2 uint64) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Uint64Int32(f, []int32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Uint64Int32(nil, []int32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint64Uint64(t *testing.T) {
+	f := func(v1 int32, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt32Uint64Uint64(f, []int32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt32Uint64Uint64(nil, []int32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint32Int32(t *testing.T) {
+	f := func(v1 int32, v2 uint32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Uint32Int32(f, []int32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Uint32Int32(nil, []int32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint32Uint32(t *testing.T) {
+	f := func(v1 int32, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt32Uint32Uint32(f, []int32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt32Uint32Uint32(nil, []int32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint16Int32(t *testing.T) {
+	f := func(v1 int32, v2 uint16) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Uint16Int32(f, []int32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Uint16Int32(nil, []int32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint16Uint16(t *testing.T) {
+	f := func(v1 int32, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt32Uint16Uint16(f, []int32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt32Uint16Uint16(nil, []int32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint8Int32(t *testing.T) {
+	f := func(v1 int32, v2 uint8) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt32Uint8Int32(f, []int32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt32Uint8Int32(nil, []int32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt32Uint8Uint8(t *testing.T) {
+	f := func(v1 int32, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt32Uint8Uint8(f, []int32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt32Uint8Uint8(nil, []int32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt32Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16IntInt(t *testing.T) {
+	f := func(v1 int16, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt16IntInt(f, []int16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt16IntInt(nil, []int16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16IntInt16(t *testing.T) {
+	f := func(v1 int16, v2 int) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16IntInt16(f, []int16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16IntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16IntInt16(nil, []int16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16IntInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int64Int64(t *testing.T) {
+	f := func(v1 int16, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt16Int64Int64(f, []int16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt16Int64Int64(nil, []int16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int64Int16(t *testing.T) {
+	f := func(v1 int16, v2 int64) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Int64Int16(f, []int16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Int64Int16(nil, []int16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int32Int32(t *testing.T) {
+	f := func(v1 int16, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt16Int32Int32(f, []int16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt16Int32Int32(nil, []int16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int32Int16(t *testing.T) {
+	f := func(v1 int16, v2 int32) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Int32Int16(f, []int16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Int32Int16(nil, []int16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Int(t *testing.T) {
+	f := func(v1 int16, v2 int16) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt16Int16Int(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt16Int16Int(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Int64(t *testing.T) {
+	f := func(v1 int16, v2 int16) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt16Int16Int64(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt16Int16Int64(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Int32(t *testing.T) {
+	f := func(v1 int16, v2 int16) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt16Int16Int32(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt16Int16Int32(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Int16(t *testing.T) {
+	f := func(v1 int16, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Int16Int16(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Int16Int16(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16(t *testing.T) {
+	f := func(v1 int16, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Int8(t *testing.T) {
+	f := func(v1 int16, v2 int16) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt16Int16Int8(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt16Int16Int8(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Uint(t *testing.T) {
+	f := func(v1 int16, v2 int16) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt16Int16Uint(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt16Int16Uint(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Uint64(t *testing.T) {
+	f := func(v1 int16, v2 int16) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt16Int16Uint64(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt16Int16Uint64(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Uint32(t *testing.T) {
+	f := func(v1 int16, v2 int16) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt16Int16Uint32(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt16Int16Uint32(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Uint16(t *testing.T) {
+	f := func(v1 int16, v2 int16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt16Int16Uint16(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt16Int16Uint16(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Uint8(t *testing.T) {
+	f := func(v1 int16, v2 int16) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt16Int16Uint8(f, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt16Int16Uint8(nil, []int16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int16Bool(t *testing.T) {
+	f := func(v1 int16, v2 int16) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithInt16Int16Bool(f, []int16{1, 5, 3}, []int16{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int8Int16(t *testing.T) {
+	f := func(v1 int16, v2 int8) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Int8Int16(f, []int16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Int8Int16(nil, []int16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Int8Int8(t *testing.T) {
+	f := func(v1 int16, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt16Int8Int8(f, []int16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt16Int8Int8(nil, []int16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16UintInt16(t *testing.T) {
+	f := func(v1 int16, v2 uint) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16UintInt16(f, []int16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16UintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16UintInt16(nil, []int16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16UintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16UintUint(t *testing.T) {
+	f := func(v1 int16, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt16UintUint(f, []int16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt16UintUint(nil, []int16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint64Int16(t *testing.T) {
+	f := func(v1 int16, v2 uint64) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Uint64Int16(f, []int16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Uint64Int16(nil, []int16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint64Uint64(t *testing.T) {
+	f := func(v1 int16, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt16Uint64Uint64(f, []int16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt16Uint64Uint64(nil, []int16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint32Int16(t *testing.T) {
+	f := func(v1 int16, v2 uint32) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Uint32Int16(f, []int16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Uint32Int16(nil, []int16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint32Uint32(t *testing.T) {
+	f := func(v1 int16, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt16Uint32Uint32(f, []int16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt16Uint32Uint32(nil, []int16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint16Int16(t *testing.T) {
+	f := func(v1 int16, v2 uint16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Uint16Int16(f, []int16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Uint16Int16(nil, []int16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint16Uint16(t *testing.T) {
+	f := func(v1 int16, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt16Uint16Uint16(f, []int16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt16Uint16Uint16(nil, []int16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint8Int16(t *testing.T) {
+	f := func(v1 int16, v2 uint8) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt16Uint8Int16(f, []int16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt16Uint8Int16(nil, []int16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt16Uint8Uint8(t *testing.T) {
+	f := func(v1 int16, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt16Uint8Uint8(f, []int16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt16Uint8Uint8(nil, []int16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt16Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8IntInt(t *testing.T) {
+	f := func(v1 int8, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt8IntInt(f, []int8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt8IntInt(nil, []int8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8IntInt8(t *testing.T) {
+	f := func(v1 int8, v2 int) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8IntInt8(f, []int8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8IntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8IntInt8(nil, []int8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8IntInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int64Int64(t *testing.T) {
+	f := func(v1 int8, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt8Int64Int64(f, []int8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt8Int64Int64(nil, []int8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int64Int8(t *testing.T) {
+	f := func(v1 int8, v2 int64) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Int64Int8(f, []int8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Int64Int8(nil, []int8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int32Int32(t *testing.T) {
+	f := func(v1 int8, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt8Int32Int32(f, []int8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt8Int32Int32(nil, []int8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int32Int8(t *testing.T) {
+	f := func(v1 int8, v2 int32) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Int32Int8(f, []int8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Int32Int8(nil, []int8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int16Int16(t *testing.T) {
+	f := func(v1 int8, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt8Int16Int16(f, []int8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt8Int16Int16(nil, []int8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int16Int8(t *testing.T) {
+	f := func(v1 int8, v2 int16) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Int16Int8(f, []int8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Int16Int8(nil, []int8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Int(t *testing.T) {
+	f := func(v1 int8, v2 int8) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithInt8Int8Int(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithInt8Int8Int(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Int64(t *testing.T) {
+	f := func(v1 int8, v2 int8) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithInt8Int8Int64(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithInt8Int8Int64(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Int32(t *testing.T) {
+	f := func(v1 int8, v2 int8) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithInt8Int8Int32(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithInt8Int8Int32(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Int16(t *testing.T) {
+	f := func(v1 int8, v2 int8) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithInt8Int8Int16(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithInt8Int8Int16(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Int8(t *testing.T) {
+	f := func(v1 int8, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Int8Int8(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Int8Int8(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8(t *testing.T) {
+	f := func(v1 int8, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Uint(t *testing.T) {
+	f := func(v1 int8, v2 int8) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt8Int8Uint(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt8Int8Uint(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Uint64(t *testing.T) {
+	f := func(v1 int8, v2 int8) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt8Int8Uint64(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt8Int8Uint64(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Uint32(t *testing.T) {
+	f := func(v1 int8, v2 int8) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt8Int8Uint32(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt8Int8Uint32(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Uint16(t *testing.T) {
+	f := func(v1 int8, v2 int8) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt8Int8Uint16(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt8Int8Uint16(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Uint8(t *testing.T) {
+	f := func(v1 int8, v2 int8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt8Int8Uint8(f, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt8Int8Uint8(nil, []int8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Int8Bool(t *testing.T) {
+	f := func(v1 int8, v2 int8) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithInt8Int8Bool(f, []int8{1, 5, 3}, []int8{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Int8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8UintInt8(t *testing.T) {
+	f := func(v1 int8, v2 uint) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8UintInt8(f, []int8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8UintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8UintInt8(nil, []int8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8UintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8UintUint(t *testing.T) {
+	f := func(v1 int8, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithInt8UintUint(f, []int8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithInt8UintUint(nil, []int8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint64Int8(t *testing.T) {
+	f := func(v1 int8, v2 uint64) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Uint64Int8(f, []int8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Uint64Int8(nil, []int8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint64Uint64(t *testing.T) {
+	f := func(v1 int8, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithInt8Uint64Uint64(f, []int8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithInt8Uint64Uint64(nil, []int8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint32Int8(t *testing.T) {
+	f := func(v1 int8, v2 uint32) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Uint32Int8(f, []int8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Uint32Int8(nil, []int8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint32Uint32(t *testing.T) {
+	f := func(v1 int8, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithInt8Uint32Uint32(f, []int8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithInt8Uint32Uint32(nil, []int8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint16Int8(t *testing.T) {
+	f := func(v1 int8, v2 uint16) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Uint16Int8(f, []int8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Uint16Int8(nil, []int8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint16Uint16(t *testing.T) {
+	f := func(v1 int8, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithInt8Uint16Uint16(f, []int8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithInt8Uint16Uint16(nil, []int8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint8Int8(t *testing.T) {
+	f := func(v1 int8, v2 uint8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithInt8Uint8Int8(f, []int8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithInt8Uint8Int8(nil, []int8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithInt8Uint8Uint8(t *testing.T) {
+	f := func(v1 int8, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithInt8Uint8Uint8(f, []int8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithInt8Uint8Uint8(nil, []int8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithInt8Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintIntInt(t *testing.T) {
+	f := func(v1 uint, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUintIntInt(f, []uint{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintIntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUintIntInt(nil, []uint{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintIntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintIntUint(t *testing.T) {
+	f := func(v1 uint, v2 int) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintIntUint(f, []uint{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintIntUint(nil, []uint{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintIntUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt64Int64(t *testing.T) {
+	f := func(v1 uint, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUintInt64Int64(f, []uint{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUintInt64Int64(nil, []uint{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt64Uint(t *testing.T) {
+	f := func(v1 uint, v2 int64) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintInt64Uint(f, []uint{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintInt64Uint(nil, []uint{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt32Int32(t *testing.T) {
+	f := func(v1 uint, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUintInt32Int32(f, []uint{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUintInt32Int32(nil, []uint{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt32Uint(t *testing.T) {
+	f := func(v1 uint, v2 int32) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintInt32Uint(f, []uint{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintInt32Uint(nil, []uint{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt16Int16(t *testing.T) {
+	f := func(v1 uint, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUintInt16Int16(f, []uint{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUintInt16Int16(nil, []uint{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt16Uint(t *testing.T) {
+	f := func(v1 uint, v2 int16) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintInt16Uint(f, []uint{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintInt16Uint(nil, []uint{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt8Int8(t *testing.T) {
+	f := func(v1 uint, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUintInt8Int8(f, []uint{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUintInt8Int8(nil, []uint{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintInt8Uint(t *testing.T) {
+	f := func(v1 uint, v2 int8) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintInt8Uint(f, []uint{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintInt8Uint(nil, []uint{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintInt8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintInt(t *testing.T) {
+	f := func(v1 uint, v2 uint) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUintUintInt(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUintUintInt(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintInt64(t *testing.T) {
+	f := func(v1 uint, v2 uint) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUintUintInt64(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUintUintInt64(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintInt32(t *testing.T) {
+	f := func(v1 uint, v2 uint) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUintUintInt32(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUintUintInt32(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintInt16(t *testing.T) {
+	f := func(v1 uint, v2 uint) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUintUintInt16(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUintUintInt16(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintInt8(t *testing.T) {
+	f := func(v1 uint, v2 uint) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUintUintInt8(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUintUintInt8(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintUint(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintUintUint(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintUintUint(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintUint64(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUintUintUint64(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUintUintUint64(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintUint32(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUintUintUint32(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUintUintUint32(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintUint16(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUintUintUint16(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUintUintUint16(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintUint8(t *testing.T) {
+	f := func(v1 uint, v2 uint) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUintUintUint8(f, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUintUintUint8(nil, []uint{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUintBool(t *testing.T) {
+	f := func(v1 uint, v2 uint) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithUintUintBool(f, []uint{1, 5, 3}, []uint{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUintBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint64Uint(t *testing.T) {
+	f := func(v1 uint, v2 uint64) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintUint64Uint(f, []uint{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintUint64Uint(nil, []uint{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint64Uint64(t *testing.T) {
+	f := func(v1 uint, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUintUint64Uint64(f, []uint{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUintUint64Uint64(nil, []uint{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint32Uint(t *testing.T) {
+	f := func(v1 uint, v2 uint32) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintUint32Uint(f, []uint{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintUint32Uint(nil, []uint{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint32Uint32(t *testing.T) {
+	f := func(v1 uint, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUintUint32Uint32(f, []uint{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUintUint32Uint32(nil, []uint{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint16Uint(t *testing.T) {
+	f := func(v1 uint, v2 uint16) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintUint16Uint(f, []uint{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintUint16Uint(nil, []uint{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint16Uint16(t *testing.T) {
+	f := func(v1 uint, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUintUint16Uint16(f, []uint{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUintUint16Uint16(nil, []uint{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint8Uint(t *testing.T) {
+	f := func(v1 uint, v2 uint8) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUintUint8Uint(f, []uint{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUintUint8Uint(nil, []uint{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUintUint8Uint8(t *testing.T) {
+	f := func(v1 uint, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUintUint8Uint8(f, []uint{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUintUint8Uint8(nil, []uint{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUintUint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64IntInt(t *testing.T) {
+	f := func(v1 uint64, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint64IntInt(f, []uint64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint64IntInt(nil, []uint64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64IntUint64(t *testing.T) {
+	f := func(v1 uint64, v2 int) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64IntUint64(f, []uint64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64IntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64IntUint64(nil, []uint64{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64IntUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int64Int64(t *testing.T) {
+	f := func(v1 uint64, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint64Int64Int64(f, []uint64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint64Int64Int64(nil, []uint64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int64Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 int64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Int64Uint64(f, []uint64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Int64Uint64(nil, []uint64{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int32Int32(t *testing.T) {
+	f := func(v1 uint64, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint64Int32Int32(f, []uint64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint64Int32Int32(nil, []uint64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int32Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 int32) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Int32Uint64(f, []uint64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Int32Uint64(nil, []uint64{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int16Int16(t *testing.T) {
+	f := func(v1 uint64, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint64Int16Int16(f, []uint64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint64Int16Int16(nil, []uint64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int16Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 int16) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Int16Uint64(f, []uint64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Int16Uint64(nil, []uint64{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int8Int8(t *testing.T) {
+	f := func(v1 uint64, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint64Int8Int8(f, []uint64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint64Int8Int8(nil, []uint64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Int8Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 int8) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Int8Uint64(f, []uint64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Int8Uint64(nil, []uint64{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Int8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64UintUint(t *testing.T) {
+	f := func(v1 uint64, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint64UintUint(f, []uint64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint64UintUint(nil, []uint64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64UintUint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64UintUint64(f, []uint64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64UintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64UintUint64(nil, []uint64{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64UintUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Int(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint64Uint64Int(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint64Uint64Int(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Int64(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint64Uint64Int64(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint64Uint64Int64(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Int32(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint64Uint64Int32(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint64Uint64Int32(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Int16(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint64Uint64Int16(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint64Uint64Int16(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Int8(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint64Uint64Int8(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint64Uint64Int8(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Uint(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint64Uint64Uint(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint64Uint64Uint(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Uint64Uint64(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Uint64Uint64(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Uint32(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint64Uint64Uint32(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint64Uint64Uint32(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Uint16(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint64Uint64Uint16(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint64Uint64Uint16(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Uint8(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint64Uint64Uint8(f, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint64Uint64Uint8(nil, []uint64{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint64Bool(t *testing.T) {
+	f := func(v1 uint64, v2 uint64) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithUint64Uint64Bool(f, []uint64{1, 5, 3}, []uint64{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint64Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint32Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint32) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Uint32Uint64(f, []uint64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Uint32Uint64(nil, []uint64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint32Uint32(t *testing.T) {
+	f := func(v1 uint64, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint64Uint32Uint32(f, []uint64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint64Uint32Uint32(nil, []uint64{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint16Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint16) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Uint16Uint64(f, []uint64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Uint16Uint64(nil, []uint64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint16Uint16(t *testing.T) {
+	f := func(v1 uint64, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint64Uint16Uint16(f, []uint64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint64Uint16Uint16(nil, []uint64{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint8Uint64(t *testing.T) {
+	f := func(v1 uint64, v2 uint8) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint64Uint8Uint64(f, []uint64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint64Uint8Uint64(nil, []uint64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint64Uint8Uint8(t *testing.T) {
+	f := func(v1 uint64, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint64Uint8Uint8(f, []uint64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint64Uint8Uint8(nil, []uint64{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint64Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32IntInt(t *testing.T) {
+	f := func(v1 uint32, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint32IntInt(f, []uint32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint32IntInt(nil, []uint32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32IntUint32(t *testing.T) {
+	f := func(v1 uint32, v2 int) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32IntUint32(f, []uint32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32IntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32IntUint32(nil, []uint32{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32IntUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int64Int64(t *testing.T) {
+	f := func(v1 uint32, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint32Int64Int64(f, []uint32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint32Int64Int64(nil, []uint32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int64Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 int64) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Int64Uint32(f, []uint32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Int64Uint32(nil, []uint32{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int32Int32(t *testing.T) {
+	f := func(v1 uint32, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint32Int32Int32(f, []uint32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint32Int32Int32(nil, []uint32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int32Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 int32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Int32Uint32(f, []uint32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Int32Uint32(nil, []uint32{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int16Int16(t *testing.T) {
+	f := func(v1 uint32, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint32Int16Int16(f, []uint32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint32Int16Int16(nil, []uint32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int16Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 int16) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Int16Uint32(f, []uint32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Int16Uint32(nil, []uint32{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int8Int8(t *testing.T) {
+	f := func(v1 uint32, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint32Int8Int8(f, []uint32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint32Int8Int8(nil, []uint32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Int8Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 int8) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Int8Uint32(f, []uint32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Int8Uint32(nil, []uint32{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Int8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32UintUint(t *testing.T) {
+	f := func(v1 uint32, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint32UintUint(f, []uint32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint32UintUint(nil, []uint32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32UintUint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32UintUint32(f, []uint32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32UintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32UintUint32(nil, []uint32{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32UintUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint64Uint64(t *testing.T) {
+	f := func(v1 uint32, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint32Uint64Uint64(f, []uint32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint32Uint64Uint64(nil, []uint32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint64Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint64) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Uint64Uint32(f, []uint32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Uint64Uint32(nil, []uint32{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint64Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Int(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint32Uint32Int(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint32Uint32Int(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Int64(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint32Uint32Int64(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint32Uint32Int64(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Int32(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint32Uint32Int32(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint32Uint32Int32(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Int16(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint32Uint32Int16(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint32Uint32Int16(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Int8(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint32Uint32Int8(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint32Uint32Int8(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Uint(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint32Uint32Uint(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint32Uint32Uint(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Uint64(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint32Uint32Uint64(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint32Uint32Uint64(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Uint32Uint32(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Uint32Uint32(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Uint16(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint32Uint32Uint16(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint32Uint32Uint16(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Uint8(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint32Uint32Uint8(f, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint32Uint32Uint8(nil, []uint32{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint32Bool(t *testing.T) {
+	f := func(v1 uint32, v2 uint32) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithUint32Uint32Bool(f, []uint32{1, 5, 3}, []uint32{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint32Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint16Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint16) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Uint16Uint32(f, []uint32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Uint16Uint32(nil, []uint32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint16Uint16(t *testing.T) {
+	f := func(v1 uint32, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint32Uint16Uint16(f, []uint32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint32Uint16Uint16(nil, []uint32{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint8Uint32(t *testing.T) {
+	f := func(v1 uint32, v2 uint8) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint32Uint8Uint32(f, []uint32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint32Uint8Uint32(nil, []uint32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint32Uint8Uint8(t *testing.T) {
+	f := func(v1 uint32, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint32Uint8Uint8(f, []uint32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint32Uint8Uint8(nil, []uint32{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint32Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16IntInt(t *testing.T) {
+	f := func(v1 uint16, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint16IntInt(f, []uint16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint16IntInt(nil, []uint16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16IntUint16(t *testing.T) {
+	f := func(v1 uint16, v2 int) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16IntUint16(f, []uint16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16IntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16IntUint16(nil, []uint16{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16IntUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int64Int64(t *testing.T) {
+	f := func(v1 uint16, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint16Int64Int64(f, []uint16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint16Int64Int64(nil, []uint16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int64Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 int64) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Int64Uint16(f, []uint16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Int64Uint16(nil, []uint16{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int32Int32(t *testing.T) {
+	f := func(v1 uint16, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint16Int32Int32(f, []uint16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint16Int32Int32(nil, []uint16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int32Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 int32) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Int32Uint16(f, []uint16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Int32Uint16(nil, []uint16{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int16Int16(t *testing.T) {
+	f := func(v1 uint16, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint16Int16Int16(f, []uint16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint16Int16Int16(nil, []uint16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int16Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 int16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Int16Uint16(f, []uint16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Int16Uint16(nil, []uint16{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int8Int8(t *testing.T) {
+	f := func(v1 uint16, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint16Int8Int8(f, []uint16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint16Int8Int8(nil, []uint16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Int8Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 int8) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Int8Uint16(f, []uint16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Int8Uint16(nil, []uint16{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Int8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16UintUint(t *testing.T) {
+	f := func(v1 uint16, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint16UintUint(f, []uint16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint16UintUint(nil, []uint16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16UintUint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16UintUint16(f, []uint16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16UintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16UintUint16(nil, []uint16{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16UintUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint64Uint64(t *testing.T) {
+	f := func(v1 uint16, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint16Uint64Uint64(f, []uint16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint16Uint64Uint64(nil, []uint16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint64Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint64) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Uint64Uint16(f, []uint16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Uint64Uint16(nil, []uint16{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint64Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint32Uint32(t *testing.T) {
+	f := func(v1 uint16, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint16Uint32Uint32(f, []uint16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint16Uint32Uint32(nil, []uint16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint32Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint32) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Uint32Uint16(f, []uint16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Uint32Uint16(nil, []uint16{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint32Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Int(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint16Uint16Int(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint16Uint16Int(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Int64(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint16Uint16Int64(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint16Uint16Int64(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Int32(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint16Uint16Int32(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint16Uint16Int32(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Int16(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint16Uint16Int16(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint16Uint16Int16(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Int8(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint16Uint16Int8(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint16Uint16Int8(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Uint(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint16Uint16Uint(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint16Uint16Uint(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Uint64(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint16Uint16Uint64(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint16Uint16Uint64(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Uint32(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint16Uint16Uint32(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint16Uint16Uint32(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Uint16Uint16(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Uint16Uint16(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Uint8(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint16Uint16Uint8(f, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint16Uint16Uint8(nil, []uint16{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint16Bool(t *testing.T) {
+	f := func(v1 uint16, v2 uint16) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithUint16Uint16Bool(f, []uint16{1, 5, 3}, []uint16{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint16Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint8Uint16(t *testing.T) {
+	f := func(v1 uint16, v2 uint8) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint16Uint8Uint16(f, []uint16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint16Uint8Uint16(nil, []uint16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint16Uint8Uint8(t *testing.T) {
+	f := func(v1 uint16, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint16Uint8Uint8(f, []uint16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint16Uint8Uint8(nil, []uint16{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint16Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8IntInt(t *testing.T) {
+	f := func(v1 uint8, v2 int) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint8IntInt(f, []uint8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint8IntInt(nil, []uint8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8IntInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8IntUint8(t *testing.T) {
+	f := func(v1 uint8, v2 int) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8IntUint8(f, []uint8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8IntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8IntUint8(nil, []uint8{1, 2, 3}, []int{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8IntUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int64Int64(t *testing.T) {
+	f := func(v1 uint8, v2 int64) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint8Int64Int64(f, []uint8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint8Int64Int64(nil, []uint8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int64Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int64Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 int64) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Int64Uint8(f, []uint8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Int64Uint8(nil, []uint8{1, 2, 3}, []int64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int32Int32(t *testing.T) {
+	f := func(v1 uint8, v2 int32) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint8Int32Int32(f, []uint8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint8Int32Int32(nil, []uint8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int32Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int32Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 int32) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Int32Uint8(f, []uint8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Int32Uint8(nil, []uint8{1, 2, 3}, []int32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int16Int16(t *testing.T) {
+	f := func(v1 uint8, v2 int16) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint8Int16Int16(f, []uint8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint8Int16Int16(nil, []uint8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int16Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int16Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 int16) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Int16Uint8(f, []uint8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Int16Uint8(nil, []uint8{1, 2, 3}, []int16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int8Int8(t *testing.T) {
+	f := func(v1 uint8, v2 int8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint8Int8Int8(f, []uint8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint8Int8Int8(nil, []uint8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Int8Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 int8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Int8Uint8(f, []uint8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Int8Uint8(nil, []uint8{1, 2, 3}, []int8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Int8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8UintUint(t *testing.T) {
+	f := func(v1 uint8, v2 uint) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint8UintUint(f, []uint8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint8UintUint(nil, []uint8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8UintUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8UintUint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8UintUint8(f, []uint8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8UintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8UintUint8(nil, []uint8{1, 2, 3}, []uint{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8UintUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint64Uint64(t *testing.T) {
+	f := func(v1 uint8, v2 uint64) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint8Uint64Uint64(f, []uint8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint8Uint64Uint64(nil, []uint8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint64Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint64Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint64) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Uint64Uint8(f, []uint8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Uint64Uint8(nil, []uint8{1, 2, 3}, []uint64{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint64Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint32Uint32(t *testing.T) {
+	f := func(v1 uint8, v2 uint32) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint8Uint32Uint32(f, []uint8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint8Uint32Uint32(nil, []uint8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint32Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint32Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint32) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Uint32Uint8(f, []uint8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Uint32Uint8(nil, []uint8{1, 2, 3}, []uint32{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint32Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint16Uint16(t *testing.T) {
+	f := func(v1 uint8, v2 uint16) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint8Uint16Uint16(f, []uint8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint8Uint16Uint16(nil, []uint8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint16Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint16Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint16) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Uint16Uint8(f, []uint8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Uint16Uint8(nil, []uint8{1, 2, 3}, []uint16{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint16Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Int(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) int { return int(v1) + int(v2) }
+
+	expected := []int{11, 22}
+	actual := ZipWithUint8Uint8Int(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int{}
+	actual = ZipWithUint8Uint8Int(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Int64(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) int64 { return int64(v1) + int64(v2) }
+
+	expected := []int64{11, 22}
+	actual := ZipWithUint8Uint8Int64(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int64{}
+	actual = ZipWithUint8Uint8Int64(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Int32(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) int32 { return int32(v1) + int32(v2) }
+
+	expected := []int32{11, 22}
+	actual := ZipWithUint8Uint8Int32(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int32{}
+	actual = ZipWithUint8Uint8Int32(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Int16(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) int16 { return int16(v1) + int16(v2) }
+
+	expected := []int16{11, 22}
+	actual := ZipWithUint8Uint8Int16(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int16{}
+	actual = ZipWithUint8Uint8Int16(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Int8(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) int8 { return int8(v1) + int8(v2) }
+
+	expected := []int8{11, 22}
+	actual := ZipWithUint8Uint8Int8(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []int8{}
+	actual = ZipWithUint8Uint8Int8(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Int8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Uint(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint { return uint(v1) + uint(v2) }
+
+	expected := []uint{11, 22}
+	actual := ZipWithUint8Uint8Uint(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint{}
+	actual = ZipWithUint8Uint8Uint(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Uint64(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint64 { return uint64(v1) + uint64(v2) }
+
+	expected := []uint64{11, 22}
+	actual := ZipWithUint8Uint8Uint64(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint64{}
+	actual = ZipWithUint8Uint8Uint64(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Uint32(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint32 { return uint32(v1) + uint32(v2) }
+
+	expected := []uint32{11, 22}
+	actual := ZipWithUint8Uint8Uint32(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint32{}
+	actual = ZipWithUint8Uint8Uint32(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Uint16(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint16 { return uint16(v1) + uint16(v2) }
+
+	expected := []uint16{11, 22}
+	actual := ZipWithUint8Uint8Uint16(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint16{}
+	actual = ZipWithUint8Uint8Uint16(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Uint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8Uint8Uint8(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8Uint8Uint8(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Uint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) uint8 { return uint8(v1) + uint8(v2) }
+
+	expected := []uint8{11, 22}
+	actual := ZipWithUint8(f, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []uint8{}
+	actual = ZipWithUint8(nil, []uint8{1, 2, 3}, []uint8{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithUint8Uint8Bool(t *testing.T) {
+	f := func(v1 uint8, v2 uint8) bool { return v1 < v2 }
+
+	expected := []bool{true, false}
+	actual := ZipWithUint8Uint8Bool(f, []uint8{1, 5, 3}, []uint8{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithUint8Uint8Bool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrInt(t *testing.T) {
+	f := func(v1 string, v2 string) int { return int(len(v1) + len(v2)) }
+
+	expected := []int{3, 5}
+	actual := ZipWithStrStrInt(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrInt failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrInt64(t *testing.T) {
+	f := func(v1 string, v2 string) int64 { return int64(len(v1) + len(v2)) }
+
+	expected := []int64{3, 5}
+	actual := ZipWithStrStrInt64(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrInt64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrInt32(t *testing.T) {
+	f := func(v1 string, v2 string) int32 { return int32(len(v1) + len(v2)) }
+
+	expected := []int32{3, 5}
+	actual := ZipWithStrStrInt32(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrInt32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrInt16(t *testing.T) {
+	f := func(v1 string, v2 string) int16 { return int16(len(v1) + len(v2)) }
+
+	expected := []int16{3, 5}
+	actual := ZipWithStrStrInt16(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrInt16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrInt8(t *testing.T) {
+	f := func(v1 string, v2 string) int8 { return int8(len(v1) + len(v2)) }
+
+	expected := []int8{3, 5}
+	actual := ZipWithStrStrInt8(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrInt8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrUint(t *testing.T) {
+	f := func(v1 string, v2 string) uint { return uint(len(v1) + len(v2)) }
+
+	expected := []uint{3, 5}
+	actual := ZipWithStrStrUint(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrUint failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrUint64(t *testing.T) {
+	f := func(v1 string, v2 string) uint64 { return uint64(len(v1) + len(v2)) }
+
+	expected := []uint64{3, 5}
+	actual := ZipWithStrStrUint64(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrUint64 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrUint32(t *testing.T) {
+	f := func(v1 string, v2 string) uint32 { return uint32(len(v1) + len(v2)) }
+
+	expected := []uint32{3, 5}
+	actual := ZipWithStrStrUint32(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrUint32 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrUint16(t *testing.T) {
+	f := func(v1 string, v2 string) uint16 { return uint16(len(v1) + len(v2)) }
+
+	expected := []uint16{3, 5}
+	actual := ZipWithStrStrUint16(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrUint16 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrUint8(t *testing.T) {
+	f := func(v1 string, v2 string) uint8 { return uint8(len(v1) + len(v2)) }
+
+	expected := []uint8{3, 5}
+	actual := ZipWithStrStrUint8(f, []string{"a", "bc"}, []string{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrUint8 failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStrStrStr(t *testing.T) {
+	f := func(v1 string, v2 string) string { return v1 + v2 }
+
+	expected := []string{"ax", "by"}
+	actual := ZipWithStrStrStr(f, []string{"a", "b"}, []string{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = ZipWithStrStrStr(nil, []string{"a", "b"}, []string{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStrStrStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithStr(t *testing.T) {
+	f := func(v1 string, v2 string) string { return v1 + v2 }
+
+	expected := []string{"ax", "by"}
+	actual := ZipWithStr(f, []string{"a", "b"}, []string{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []string{}
+	actual = ZipWithStr(nil, []string{"a", "b"}, []string{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithStr failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithBoolBoolBool(t *testing.T) {
+	f := func(v1 bool, v2 bool) bool { return v1 && v2 }
+
+	expected := []bool{false, true}
+	actual := ZipWithBoolBoolBool(f, []bool{true, true, false}, []bool{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithBoolBoolBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = ZipWithBoolBoolBool(nil, []bool{true, true, false}, []bool{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithBoolBoolBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestZipWithBool(t *testing.T) {
+	f := func(v1 bool, v2 bool) bool { return v1 && v2 }
+
+	expected := []bool{false, true}
+	actual := ZipWithBool(f, []bool{true, true, false}, []bool{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []bool{}
+	actual = ZipWithBool(nil, []bool{true, true, false}, []bool{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWithBool failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
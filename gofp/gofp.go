@@ -73,6 +73,7 @@ var (
 	pkgName     = flag.String("pkg", "", "package name for generated files")
 	types       = flag.String("type", "", "user defined type")
 	imports     = flag.String("imports", "", "import statements for user defined types when structs are in different package")
+	fluent      = flag.Bool("fluent", false, "list types with chainable methods(eg. Employees for Employee)")
 )
 
 func main() {
@@ -100,13 +101,13 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed opening destination file: %v", err)
 		}
-		generatedCode, err := generateFPCode(*pkgName, *types, *imports)
+		generatedCode, err := generateFPCode(*pkgName, *types, *imports, *fluent)
 		if err != nil {
 			usage()
 			log.Fatalf("Failed code generation: %v", err)
 		}
 
-		generatedCodeIO, err := generateFPCodeIO(*pkgName, *types)
+		generatedCodeIO, err := generateFPCodeIO(*pkgName, *types, *fluent)
 		if err != nil {
 			usage()
 			log.Fatalf("Failed code generation for different IO combination: %v", err)
@@ -136,7 +137,7 @@ func removeFirstPartOfDot(str string) string {
 	return str
 }
 
-func generateFPCode(pkg, dataTypes, imports string) (string, error) {
+func generateFPCode(pkg, dataTypes, imports string, fluent bool) (string, error) {
 	basicTypes := "int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8, float64, float32, string, bool"
 	conditionalType := ""
 	types := strings.Split(dataTypes, ",")
@@ -144,7 +145,11 @@ func generateFPCode(pkg, dataTypes, imports string) (string, error) {
 
 	template := "// Code generated by 'gofp'. DO NOT EDIT.\n"
 	template += "package <PACKAGE>\n"
+	template += "import \"context\" \n"
+	template += "import \"fmt\" \n"
+	template += "import \"sort\" \n"
 	template += "import \"sync\" \n"
+	template += "import \"github.com/logic-building/functional-go/fp\" \n"
 
 	if imports != "" {
 		importList := strings.Split(imports, ",")
@@ -192,22 +197,111 @@ func generateFPCode(pkg, dataTypes, imports string) (string, error) {
 		template += template2.Pmap()
 		template = r.Replace(template)
 
+		template += template2.PMapStream()
+		template = r.Replace(template)
+
 		template += template2.FilterMap()
 		template = r.Replace(template)
 
+		template += template2.PFilter()
+		template = r.Replace(template)
+
 		template += template2.Rest()
 		template = r.Replace(template)
 
 		template += template2.Reduce()
 		template = r.Replace(template)
 
+		template += template2.PReduce()
+		template = r.Replace(template)
+
 		template += template2.DropLast()
 		template = r.Replace(template)
+
+		template += template2.Distinct()
+		template = r.Replace(template)
+
+		template += template2.Dedupe()
+		template = r.Replace(template)
+
+		template += template2.Repeat()
+		template = r.Replace(template)
+
+		template += template2.Cycle()
+		template = r.Replace(template)
+
+		template += template2.Iterate()
+		template = r.Replace(template)
+
+		template += template2.Repeatedly()
+		template = r.Replace(template)
+
+		template += template2.Comp()
+		template = r.Replace(template)
+
+		template += template2.Partial()
+		template = r.Replace(template)
+
+		template += template2.Complement()
+		template = r.Replace(template)
+
+		template += template2.Constantly()
+		template = r.Replace(template)
+
+		template += template2.Juxt()
+		template = r.Replace(template)
+
+		template += template2.Predicate()
+		template = r.Replace(template)
+
+		template += template2.Eq()
+		template = r.Replace(template)
+
+		template += template2.Memoize()
+		template = r.Replace(template)
+
+		// Types are named after the data type even for the type of the package, so common names like Ref,
+		// Queue or Ok are left for the package
+		rType := strings.NewReplacer("<TYPE>", t, "<CONDITIONAL_TYPE>", removeFirstPartOfDot(t), "<FTYPE>", removeFirstPartOfDot(t))
+		template += template2.Ref()
+		template = rType.Replace(template)
+
+		template += template2.Future()
+		template = rType.Replace(template)
+
+		template += template2.Zip3()
+		template = r.Replace(template)
+
+		template += template2.Walk()
+		template = r.Replace(template)
+
+		if fluent {
+			template += template2.Fluent()
+			template = strings.NewReplacer("<TYPES>", removeFirstPartOfDot(t)+"s", "<FTYPE>", removeFirstPartOfDot(t)).Replace(template)
+			template = r.Replace(template)
+		}
+
+		// Basic template is used as it is, with the type name instead of <FTYPE>
+		template += basic.Queue()
+		template = rType.Replace(template)
+
+		template += basic.Atom()
+		template = rType.Replace(template)
+
+		template += basic.Agent()
+		template = rType.Replace(template)
+
+		template += basic.Option()
+		template = rType.Replace(template)
+
+		rBasic := strings.NewReplacer("<TYPE>", t, "<FTYPE>", removeFirstPartOfDot(conditionalType))
+		template += basic.Into()
+		template = rBasic.Replace(template)
 	}
 	return template, nil
 }
 
-func generateFPCodeIO(pkg, dataTypes string) (string, error) {
+func generateFPCodeIO(pkg, dataTypes string, fluent bool) (string, error) {
 	basicTypes := "int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8, float64, float32, string, bool"
 	template := ""
 	types := strings.Split(dataTypes, ",")
@@ -253,6 +347,23 @@ func generateFPCodeIO(pkg, dataTypes string) (string, error) {
 
 			template += basic.FilterMapIO()
 			template = r.Replace(template)
+
+			template += basic.JuxtIO()
+			template = r.Replace(template)
+
+			template += template2.MemoizeIO()
+			template = r.Replace(template)
+
+			// Methods can't be added to fp.Ints ..., so only lists of user defined types get them
+			if fluent && !strings.Contains(basicTypes, inputType) {
+				outputTypes := removeFirstPartOfDot(fOutputType) + "s"
+				if strings.Contains(basicTypes, outputType) {
+					outputTypes = "fp." + outputTypes
+				}
+				template += template2.FluentIO()
+				template = strings.NewReplacer("<OUTPUT_TYPES>", outputTypes).Replace(template)
+				template = r.Replace(template)
+			}
 		}
 	}
 
@@ -297,6 +408,10 @@ func generateFPCodeII(pkg, dataTypes string) (string, error) {
 				fInputType2 = "Str"
 			}
 
+			// Type names(e.g. Pair<FTYPE1><FTYPE2>) are not shortened for same data type
+			fType1 := removeFirstPartOfDot(fInputType1)
+			fType2 := removeFirstPartOfDot(fInputType2)
+
 			// Standard function name for same user defined type
 			if inputType1 == inputType2 && strings.ToLower(inputType1) == strings.ToLower(pkg) {
 				fInputType1 = ""
@@ -308,13 +423,53 @@ func generateFPCodeII(pkg, dataTypes string) (string, error) {
 				fInputType2 = ""
 			}
 
-			r := strings.NewReplacer("<FINPUT_TYPE1>", removeFirstPartOfDot(fInputType1), "<FINPUT_TYPE2>", removeFirstPartOfDot(fInputType2), "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2)
+			r := strings.NewReplacer("<FINPUT_TYPE1>", removeFirstPartOfDot(fInputType1), "<FINPUT_TYPE2>", removeFirstPartOfDot(fInputType2), "<FTYPE1>", fType1, "<FTYPE2>", fType2, "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2)
 
 			template += basic.Merge()
 			template = r.Replace(template)
 
+			template += basic.MergeWith()
+			template = r.Replace(template)
+
+			template += basic.MapKV()
+			template = r.Replace(template)
+
 			template += basic.Zip()
 			template = r.Replace(template)
+
+			template += basic.DistinctBy()
+			template = r.Replace(template)
+
+			template += basic.Pair()
+			template = r.Replace(template)
+
+			if inputType1 == "int" {
+				template += template2.ZipIndex()
+				template = r.Replace(template)
+			}
+
+			// ZipWith - every type is output type as well. Function name has the 3 types, eg. ZipWithEmployeeIntStr
+			for _, outputType := range types {
+				outputType = strings.TrimSpace(outputType)
+				if strings.Contains(basicTypes, strings.ToLower(outputType)) {
+					outputType = strings.ToLower(outputType)
+				}
+				fOutputType := strings.Title(outputType)
+				if fOutputType == "String" {
+					fOutputType = "Str"
+				}
+
+				fNames := []string{fType1 + fType2 + removeFirstPartOfDot(fOutputType)}
+				// Standard function name for same data type as well. ex. ZipWith for the type of the package
+				if inputType1 == inputType2 && inputType1 == outputType {
+					fNames = append(fNames, removeFirstPartOfDot(fInputType1))
+				}
+				for _, fName := range fNames {
+					rZipWith := strings.NewReplacer("<FZIPWITH>", fName, "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2, "<OUTPUT_TYPE>", outputType)
+					template += basic.ZipWith()
+					template = rZipWith.Replace(template)
+				}
+			}
 		}
 	}
 
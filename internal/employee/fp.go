@@ -1,6 +1,10 @@
 // Code generated by 'gofp'. DO NOT EDIT.
 package employee
+import "context" 
+import "fmt" 
+import "sort" 
 import "sync" 
+import "github.com/logic-building/functional-go/fp" 
 
 func Map(f func(Employee) Employee, list []Employee) []Employee {
 	if f == nil {
@@ -129,6 +133,34 @@ func PMap(f func(Employee) Employee, list []Employee) []Employee {
 	return newList
 }
 
+func PMapStream(ctx context.Context, f func(Employee) Employee, list []Employee, opts ...fp.ParallelOptions) <-chan Employee {
+	out := make(chan Employee)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]Employee, len(list))
+	indexes := fp.ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero Employee
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
 func FilterMap(fFilter func(Employee) bool, fMap func(Employee) Employee, list []Employee) []Employee {
 	if fFilter == nil || fMap == nil {
 		return []Employee{}
@@ -142,6 +174,69 @@ func FilterMap(fFilter func(Employee) bool, fMap func(Employee) Employee, list [
 	return newList
 }
 
+func PFilter(f func(Employee) bool, list []Employee, opts ...fp.ParallelOptions) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+	keep := make([]bool, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]Employee, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PRemove(f func(Employee) bool, list []Employee, opts ...fp.ParallelOptions) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+	return PFilter(func(v Employee) bool { return !f(v) }, list, opts...)
+}
+
+func PFilterMap(fFilter func(Employee) bool, fMap func(Employee) Employee, list []Employee, opts ...fp.ParallelOptions) []Employee {
+	if fFilter == nil || fMap == nil {
+		return []Employee{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]Employee, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]Employee, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PSome(f func(Employee) bool, list []Employee, opts ...fp.ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !fp.ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+func PEvery(f func(Employee) bool, list []Employee, opts ...fp.ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return fp.ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
 func Rest(l []Employee) []Employee {
 	if l == nil {
 		return []Employee{}
@@ -184,6 +279,36 @@ func Reduce(f func(Employee, Employee) Employee, list []Employee, initializer ..
 	return Reduce(f, list[1:], r)
 }
 
+func PReduce(fReduce, fCombine func(Employee, Employee) Employee, identity Employee, list []Employee, opts ...fp.ParallelOptions) Employee {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := fp.ParallelChunkSize(len(list), opts...)
+	results := make([]Employee, (len(list)+size-1)/size)
+	fp.ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
 // DropLast drops last item from the list and returns new list.
 // Returns empty list if there is only one item in the list or list empty
 func DropLast(list []Employee) []Employee {
@@ -201,1004 +326,8317 @@ func DropLast(list []Employee) []Employee {
 	return newList
 }
 
-func MapTeacher(f func(Teacher) Teacher, list []Teacher) []Teacher {
-	if f == nil {
-		return []Teacher{}
+func Distinct(list []Employee) []Employee {
+	var newList []Employee
+	s := make(map[Employee]struct{}, len(list))
+	for _, v := range list {
+		if _, ok := s[v]; ok {
+			continue
+		}
+		s[v] = struct{}{}
+		newList = append(newList, v)
 	}
-	newList := make([]Teacher, len(list))
+	return newList
+}
+
+func Dedupe(list []Employee) []Employee {
+	newList := make([]Employee, 0, len(list))
 	for i, v := range list {
-		newList[i] = f(v)
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
 	}
 	return newList
 }
 
-func FilterTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
-	if f == nil {
-		return []Teacher{}
+func Repeat(n int, v Employee) []Employee {
+	if n <= 0 {
+		return []Employee{}
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if f(v) {
-			newList = append(newList, v)
-		}
+
+	newList := make([]Employee, n)
+	for i := range newList {
+		newList[i] = v
 	}
 	return newList
 }
 
-func RemoveTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
-	if f == nil {
-		return []Teacher{}
+func Cycle(n int, list []Employee) []Employee {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []Employee{}
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if !f(v) {
-			newList = append(newList, v)
-		}
+
+	newList := make([]Employee, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
 	}
 	return newList
 }
 
-func SomeTeacher(f func(Teacher) bool, list []Teacher) bool {
-	if f == nil {
-		return false
+func Iterate(f func(Employee) Employee, seed Employee, n int) []Employee {
+	if f == nil || n <= 0 {
+		return []Employee{}
 	}
-	for _, v := range list {
-		if f(v) {
-			return true
-		}
+
+	newList := make([]Employee, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
 	}
-	return false
+	return newList
 }
 
-func EveryTeacher(f func(Teacher) bool, list []Teacher) bool {
-	if f == nil || len(list) == 0 {
-		return false
+func Repeatedly(n int, f func() Employee) []Employee {
+	if f == nil || n <= 0 {
+		return []Employee{}
 	}
-	for _, v := range list {
-		if !f(v) {
-			return false
-		}
+
+	newList := make([]Employee, n)
+	for i := range newList {
+		newList[i] = f()
 	}
-	return true
+	return newList
 }
 
-func DropWhileTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
-	if f == nil {
-		return []Teacher{}
+func Comp(fs ...func(Employee) Employee) func(Employee) Employee {
+	return func(v Employee) Employee {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
 	}
-	var newList []Teacher
-	for i, v := range list {
-		if !f(v) {
-			listLen := len(list)
-			newList = make([]Teacher, listLen-i)
-			j := 0
-			for i < listLen {
-				newList[j] = list[i]
-				i++
-				j++
+}
+
+func Pipe(fs ...func(Employee) Employee) func(Employee) Employee {
+	return func(v Employee) Employee {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
 			}
-			return newList
 		}
+		return v
 	}
-	return newList
 }
 
-func TakeWhileTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
+func Partial2(f func(Employee, Employee) Employee, v1 Employee) func(Employee) Employee {
 	if f == nil {
-		return []Teacher{}
+		return nil
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if !f(v) {
-			return newList
-		}
-		newList = append(newList, v)
+	return func(v2 Employee) Employee {
+		return f(v1, v2)
 	}
-	return newList
 }
 
-func PMapTeacher(f func(Teacher) Teacher, list []Teacher) []Teacher {
+func Partial3(f func(Employee, Employee, Employee) Employee, v1, v2 Employee) func(Employee) Employee {
 	if f == nil {
-		return []Teacher{}
+		return nil
 	}
+	return func(v3 Employee) Employee {
+		return f(v1, v2, v3)
+	}
+}
 
-	ch := make(chan map[int]Teacher)
-	var wg sync.WaitGroup
+func Complement(pred func(Employee) bool) func(Employee) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v Employee) bool {
+		return !pred(v)
+	}
+}
 
-	for i, v := range list {
-		wg.Add(1)
+func Constantly(v Employee) func(Employee) Employee {
+	return func(Employee) Employee {
+		return v
+	}
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v Teacher) {
-			defer wg.Done()
-			ch <- map[int]Teacher{i: f(v)}
-		}(&wg, ch, i, v)
+func Juxt(fs ...func(Employee) Employee) func(Employee) []Employee {
+	funcs := make([]func(Employee) Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
 	}
+	return func(v Employee) []Employee {
+		newList := make([]Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+func And(preds ...func(Employee) bool) func(Employee) bool {
+	return func(v Employee) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
 
-	newList := make([]Teacher, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
+func Or(preds ...func(Employee) bool) func(Employee) bool {
+	return func(v Employee) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
 		}
+		return false
 	}
-	return newList
 }
 
-func FilterMapTeacher(fFilter func(Teacher) bool, fMap func(Teacher) Teacher, list []Teacher) []Teacher {
-	if fFilter == nil || fMap == nil {
-		return []Teacher{}
+func Not(pred func(Employee) bool) func(Employee) bool {
+	return Complement(pred)
+}
+
+func None(pred func(Employee) bool, list []Employee) bool {
+	if pred == nil {
+		return false
 	}
-	var newList []Teacher
 	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
+		if pred(v) {
+			return false
 		}
 	}
-	return newList
+	return true
 }
 
-func RestTeacher(l []Teacher) []Teacher {
-	if l == nil {
-		return []Teacher{}
+func Eq(v Employee) func(Employee) bool {
+	return func(item Employee) bool {
+		return item == v
 	}
+}
 
-	len := len(l)
-	if len == 0 || len == 1 {
-		return []Teacher{}
+func Ne(v Employee) func(Employee) bool {
+	return func(item Employee) bool {
+		return item != v
 	}
+}
 
-	newList := make([]Teacher, len-1)
+func Memoize(f func(Employee) Employee, opts ...fp.MemoizeOptions) func(Employee) Employee {
+	if f == nil {
+		return nil
+	}
 
-	for i, v := range l[1:] {
-		newList[i] = v
+	memo := fp.NewMemo(opts...)
+	return func(v Employee) Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(Employee)
 	}
+}
 
-	return newList
+type RefEmployee struct {
+	ref *fp.Ref
 }
 
-func ReduceTeacher(f func(Teacher, Teacher) Teacher, list []Teacher, initializer ...Teacher) Teacher {
-	var init Teacher 
-	lenList := len(list)
+func NewRefEmployee(v Employee) *RefEmployee {
+	return &RefEmployee{ref: fp.NewRef(v)}
+}
 
-	if initializer != nil {
-		init = initializer[0]
-	} else if lenList > 0 {
-		init = list[0]
-		if lenList == 1 {
-			return list[0]
-		}
-		if lenList >= 2 {
-			list = list[1:]
-		}
-	}
-	
-	if lenList == 0 {
-		return init
-	}
-	r := f(init, list[0])
-	return ReduceTeacher(f, list[1:], r)
+func (r *RefEmployee) Deref() Employee {
+	return r.ref.Deref().(Employee)
 }
 
-// DropLastTeacher drops last item from the list and returns new list.
-// Returns empty list if there is only one item in the list or list empty
-func DropLastTeacher(list []Teacher) []Teacher {
-	listLen := len(list)
+func (r *RefEmployee) Get(tx *fp.Tx) Employee {
+	return tx.Get(r.ref).(Employee)
+}
 
-	if list == nil || listLen == 0 || listLen == 1 {
-		return []Teacher{}
-	}
+func (r *RefEmployee) Set(tx *fp.Tx, v Employee) {
+	tx.Set(r.ref, v)
+}
 
-	newList := make([]Teacher, listLen-1)
+func (r *RefEmployee) Alter(tx *fp.Tx, f func(Employee) Employee) Employee {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(Employee)) }).(Employee)
+}
 
-	for i := 0; i < listLen-1; i++ {
-		newList[i] = list[i]
+func (r *RefEmployee) Commute(tx *fp.Tx, f func(Employee) Employee) Employee {
+	if f == nil {
+		return r.Get(tx)
 	}
-	return newList
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(Employee)) }).(Employee)
 }
 
+type FutureEmployee struct {
+	future *fp.Future
+}
 
-// MapEmployeeTeacher takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployeeTeacher(f func(Employee) Teacher, list []Employee) []Teacher {
+type PromiseEmployee struct {
+	promise *fp.Promise
+}
+
+func NewFutureEmployee(f func() (Employee, error)) *FutureEmployee {
 	if f == nil {
-		return []Teacher{}
-	}
-	newList := make([]Teacher, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+		return &FutureEmployee{future: fp.NewFuture(nil)}
 	}
-	return newList
+	return &FutureEmployee{future: fp.NewFuture(func() (interface{}, error) { return f() })}
 }
 
-// PMapEmployeeTeacher applies the function(1st argument) on each item of the list and returns new list.
+func NewFutureCtxEmployee(ctx context.Context, f func(context.Context) (Employee, error)) *FutureEmployee {
+	if f == nil {
+		return &FutureEmployee{future: fp.NewFutureCtx(ctx, nil)}
+	}
+	return &FutureEmployee{future: fp.NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+func NewPromiseEmployee() *PromiseEmployee {
+	return &PromiseEmployee{promise: fp.NewPromise()}
+}
+
+func (p *PromiseEmployee) Deliver(v Employee) bool {
+	return p.promise.Deliver(v)
+}
+
+func (p *PromiseEmployee) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+func (p *PromiseEmployee) Future() *FutureEmployee {
+	return &FutureEmployee{future: p.promise.Future()}
+}
+
+func (f *FutureEmployee) Await(ctx context.Context) (Employee, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero Employee
+		return zero, err
+	}
+	return futureValueEmployee(v), nil
+}
+
+func (f *FutureEmployee) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+func (f *FutureEmployee) IsDone() bool {
+	return f.future.IsDone()
+}
+
+func (f *FutureEmployee) Untyped() *fp.Future {
+	return f.future
+}
+
+func (f *FutureEmployee) Then(fn func(Employee) (Employee, error)) *FutureEmployee {
+	if fn == nil {
+		return f
+	}
+	return &FutureEmployee{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueEmployee(v)) })}
+}
+
+func (f *FutureEmployee) ThenCtx(ctx context.Context, fn func(Employee) (Employee, error)) *FutureEmployee {
+	if fn == nil {
+		return &FutureEmployee{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureEmployee{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueEmployee(v)) })}
+}
+
+func AwaitAllEmployee(ctx context.Context, futures ...*FutureEmployee) ([]Employee, error) {
+	values, err := AllCtxEmployee(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]Employee), nil
+}
+
+func AllEmployee(futures ...*FutureEmployee) *fp.Future {
+	return AllCtxEmployee(context.Background(), futures...)
+}
+
+func AllCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *fp.Future {
+	return fp.AllCtx(ctx, untypedFuturesEmployee(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]Employee, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueEmployee(v)
+		}
+		return list, nil
+	})
+}
+
+func AnyEmployee(futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.Any(untypedFuturesEmployee(futures)...)}
+}
+
+func RaceEmployee(futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.Race(untypedFuturesEmployee(futures)...)}
+}
+
+func AnyCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.AnyCtx(ctx, untypedFuturesEmployee(futures)...)}
+}
+
+func RaceCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.RaceCtx(ctx, untypedFuturesEmployee(futures)...)}
+}
+
+func futureValueEmployee(v interface{}) Employee {
+	if v == nil {
+		var zero Employee
+		return zero
+	}
+	return v.(Employee)
+}
+
+func untypedFuturesEmployee(futures []*FutureEmployee) []*fp.Future {
+	list := make([]*fp.Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+func Zip3(list1, list2, list3 []Employee) [][3]Employee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]Employee{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+func Unzip3(list [][3]Employee) ([]Employee, []Employee, []Employee) {
+	list1 := make([]Employee, len(list))
+	list2 := make([]Employee, len(list))
+	list3 := make([]Employee, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+func TreeSeq(children func(Employee) []Employee, root Employee) []Employee {
+	var result []Employee
+	stack := []Employee{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if children != nil {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+func Prewalk(f func(Employee) Employee, children func(Employee) []Employee, withChildren func(Employee, []Employee) Employee, root Employee) Employee {
+	if f == nil {
+		return root
+	}
+	node := f(root)
+	if children == nil || withChildren == nil {
+		return node
+	}
+
+	nodes := children(node)
+	if len(nodes) == 0 {
+		return node
+	}
+	newNodes := make([]Employee, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = Prewalk(f, children, withChildren, v)
+	}
+	return withChildren(node, newNodes)
+}
+
+func Postwalk(f func(Employee) Employee, children func(Employee) []Employee, withChildren func(Employee, []Employee) Employee, root Employee) Employee {
+	if f == nil {
+		return root
+	}
+	if children == nil || withChildren == nil {
+		return f(root)
+	}
+
+	nodes := children(root)
+	if len(nodes) == 0 {
+		return f(root)
+	}
+	newNodes := make([]Employee, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = Postwalk(f, children, withChildren, v)
+	}
+	return f(withChildren(root, newNodes))
+}
+
+type Employees []Employee
+
+func (l Employees) ToSlice() []Employee {
+	return []Employee(l)
+}
+
+func (l Employees) Len() int {
+	return len(l)
+}
+
+func (l Employees) Filter(f func(Employee) bool) Employees {
+	return Filter(f, l)
+}
+
+func (l Employees) Remove(f func(Employee) bool) Employees {
+	return Remove(f, l)
+}
+
+func (l Employees) Map(f func(Employee) Employee) Employees {
+	return Map(f, l)
+}
+
+func (l Employees) PMap(f func(Employee) Employee) Employees {
+	return PMap(f, l)
+}
+
+func (l Employees) FilterMap(fFilter func(Employee) bool, fMap func(Employee) Employee) Employees {
+	return FilterMap(fFilter, fMap, l)
+}
+
+func (l Employees) DropWhile(f func(Employee) bool) Employees {
+	return DropWhile(f, l)
+}
+
+func (l Employees) TakeWhile(f func(Employee) bool) Employees {
+	return TakeWhile(f, l)
+}
+
+func (l Employees) Distinct() Employees {
+	return Distinct(l)
+}
+
+func (l Employees) Dedupe() Employees {
+	return Dedupe(l)
+}
+
+func (l Employees) Rest() Employees {
+	return Rest(l)
+}
+
+func (l Employees) Reduce(f func(Employee, Employee) Employee, initializer ...Employee) Employee {
+	return Reduce(f, l, initializer...)
+}
+
+func (l Employees) Some(f func(Employee) bool) bool {
+	return Some(f, l)
+}
+
+func (l Employees) Every(f func(Employee) bool) bool {
+	return Every(f, l)
+}
+
+func (l Employees) Find(pred func(Employee) bool) OptionEmployee {
+	return FindOptEmployee(pred, l)
+}
+
+// StackEmployee - persistent(immutable) stack of Employee. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackEmployee struct {
+	top  Employee
+	rest *StackEmployee
+	size int
+}
+
+// NewStackEmployee creates stack from the list. Last item of the list is on top
+func NewStackEmployee(list []Employee) *StackEmployee {
+	var s *StackEmployee
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackEmployee) Push(v Employee) *StackEmployee {
+	return &StackEmployee{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackEmployee) Pop() *StackEmployee {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackEmployee) Peek() (Employee, bool) {
+	if s == nil {
+		var zero Employee
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackEmployee) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackEmployee) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackEmployee(s.ToSlice()) is same as the stack
+func (s *StackEmployee) ToSlice() []Employee {
+	list := make([]Employee, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueEmployee - persistent(immutable) FIFO queue of Employee. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueEmployee struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackEmployee
+	rear  *StackEmployee
+}
+
+// NewQueueEmployee creates queue from the list. First item of the list is at the front
+func NewQueueEmployee(list []Employee) *QueueEmployee {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueEmployee{front: reversedStackEmployee(list)}
+}
+
+// reversedStackEmployee creates stack with the first item of the list on top
+func reversedStackEmployee(list []Employee) *StackEmployee {
+	var s *StackEmployee
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueEmployee) Push(v Employee) *QueueEmployee {
+	if q == nil {
+		return &QueueEmployee{front: (*StackEmployee)(nil).Push(v)}
+	}
+	return &QueueEmployee{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueEmployee
+func (q *QueueEmployee) Pop() *QueueEmployee {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueEmployee{front: front, rear: q.rear}
+	}
+	return &QueueEmployee{front: reversedStackEmployee(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueEmployee) Peek() (Employee, bool) {
+	if q == nil {
+		var zero Employee
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueEmployee) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueEmployee) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueEmployee) ToSlice() []Employee {
+	if q == nil {
+		return []Employee{}
+	}
+	list := make([]Employee, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeEmployee - persistent(immutable) double-ended queue of Employee. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeEmployee struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackEmployee
+	back  *StackEmployee
+}
+
+// NewDequeEmployee creates deque from the list. First item of the list is at the front
+func NewDequeEmployee(list []Employee) *DequeEmployee {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeEmployee{front: reversedStackEmployee(list[:half]), back: NewStackEmployee(list[half:])}
+}
+
+// newDequeEmployee creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeEmployee(front, back *StackEmployee) *DequeEmployee {
+	d := &DequeEmployee{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeEmployee(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeEmployee) PushFront(v Employee) *DequeEmployee {
+	if d == nil {
+		return newDequeEmployee((*StackEmployee)(nil).Push(v), nil)
+	}
+	return newDequeEmployee(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeEmployee) PushBack(v Employee) *DequeEmployee {
+	if d == nil {
+		return newDequeEmployee(nil, (*StackEmployee)(nil).Push(v))
+	}
+	return newDequeEmployee(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployee) PopFront() *DequeEmployee {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployee(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployee) PopBack() *DequeEmployee {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployee(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeEmployee) PeekFront() (Employee, bool) {
+	if d == nil {
+		var zero Employee
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeEmployee) PeekBack() (Employee, bool) {
+	if d == nil {
+		var zero Employee
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeEmployee) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeEmployee) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeEmployee) ToSlice() []Employee {
+	if d == nil {
+		return []Employee{}
+	}
+	list := make([]Employee, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueEmployee - binary heap of Employee. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueEmployee, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueEmployee struct {
+	key   func(Employee) float64
+	items []priorityItemEmployee
+	seq   int
+}
+
+type priorityItemEmployee struct {
+	value Employee
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueEmployee creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueEmployee(key func(Employee) float64, list []Employee) *PriorityQueueEmployee {
+	pq := &PriorityQueueEmployee{key: key, items: make([]priorityItemEmployee, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueEmployee) Push(v Employee) *PriorityQueueEmployee {
+	item := priorityItemEmployee{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployee) Pop() (Employee, bool) {
+	if len(pq.items) == 0 {
+		var zero Employee
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployee) Peek() (Employee, bool) {
+	if len(pq.items) == 0 {
+		var zero Employee
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueEmployee) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueEmployee) ToSlice() []Employee {
+	clone := &PriorityQueueEmployee{items: make([]priorityItemEmployee, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]Employee, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueEmployee) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// AtomEmployee - holds Employee shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of Employee
+type AtomEmployee struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   Employee
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(Employee) error
+	watches   map[string]func(key string, oldVal, newVal Employee)
+}
+
+// NewAtomEmployee creates atom with the initial value
+func NewAtomEmployee(v Employee) *AtomEmployee {
+	return &AtomEmployee{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomEmployee) load() (Employee, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomEmployee) store(version uint64, v Employee) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomEmployee) Deref() Employee {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomEmployee(0)
+//	counter.Swap(func(v Employee) Employee { return v + 1 })
+func (a *AtomEmployee) Swap(f func(Employee) Employee) (Employee, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomEmployee) Reset(v Employee) error {
+	_, err := a.Swap(func(Employee) Employee { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomEmployee) CompareAndSet(oldVal, newVal Employee) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomEmployee) SetValidator(validator func(Employee) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomEmployee) validate(v Employee) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomEmployee) AddWatch(key string, f func(key string, oldVal, newVal Employee)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, Employee, Employee))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomEmployee) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomEmployee) notify(oldVal, newVal Employee) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, Employee, Employee), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AgentEmployee - holds Employee which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentEmployee struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   Employee
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionEmployee
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionEmployee struct {
+	f     func(Employee) Employee
+	epoch int
+}
+
+// NewAgentEmployee creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentEmployee(v Employee, queueSize int) *AgentEmployee {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentEmployee{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentEmployee(0, 100)
+//	total.Send(func(v Employee) Employee { return v + 1 })
+//	total.Await()
+func (a *AgentEmployee) Send(f func(Employee) Employee) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentEmployee(0, 1)
+//	counter.SendNested(func(v Employee, send func(func(Employee) Employee)) Employee {
+//		send(func(v Employee) Employee { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentEmployee) SendNested(f func(Employee, func(func(Employee) Employee)) Employee) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v Employee) Employee {
+		var held []func(Employee) Employee
+		v = f(v, func(g func(Employee) Employee) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionEmployee{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentEmployee) enqueue(f func(Employee) Employee) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionEmployee{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentEmployee) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionEmployee{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentEmployee) apply(f func(Employee) Employee, v Employee) (newVal Employee, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentEmployee) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentEmployee) Deref() Employee {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentEmployee) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentEmployee) Restart(v Employee) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// OptionEmployee - Employee which may be absent. The zero value is absent
+type OptionEmployee struct {
+	value Employee
+	ok    bool
+}
+
+// SomeOptEmployee returns option with the value
+func SomeOptEmployee(v Employee) OptionEmployee {
+	return OptionEmployee{value: v, ok: true}
+}
+
+// NoneOptEmployee returns option without value
+func NoneOptEmployee() OptionEmployee {
+	return OptionEmployee{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionEmployee) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionEmployee) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionEmployee) Get() (Employee, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionEmployee) Unwrap() Employee {
+	if !o.ok {
+		panic("OptionEmployee: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionEmployee) OrElse(v Employee) Employee {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionEmployee) OrElseGet(f func() Employee) Employee {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployee) Map(f func(Employee) Employee) OptionEmployee {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptEmployee(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployee) FlatMap(f func(Employee) OptionEmployee) OptionEmployee {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionEmployee) Filter(pred func(Employee) bool) OptionEmployee {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionEmployee{}
+	}
+	return o
+}
+
+// ResultEmployee - Employee or error. The zero value is Ok with zero value of Employee
+type ResultEmployee struct {
+	value Employee
+	err   error
+}
+
+// OkEmployee returns result with the value
+func OkEmployee(v Employee) ResultEmployee {
+	return ResultEmployee{value: v}
+}
+
+// ErrEmployee returns result with the error
+func ErrEmployee(err error) ResultEmployee {
+	return ResultEmployee{err: err}
+}
+
+// ResultOfEmployee returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfEmployee(f(v))
+func ResultOfEmployee(v Employee, err error) ResultEmployee {
+	if err != nil {
+		return ResultEmployee{err: err}
+	}
+	return ResultEmployee{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultEmployee) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultEmployee) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultEmployee) Get() (Employee, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultEmployee) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultEmployee) Unwrap() Employee {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultEmployee) OrElse(v Employee) Employee {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployee) Map(f func(Employee) Employee) ResultEmployee {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkEmployee(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployee) FlatMap(f func(Employee) ResultEmployee) ResultEmployee {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultEmployee) Option() OptionEmployee {
+	if r.err != nil {
+		return OptionEmployee{}
+	}
+	return SomeOptEmployee(r.value)
+}
+
+// FindOptEmployee returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptEmployee(pred func(Employee) bool, list []Employee) OptionEmployee {
+	if pred == nil {
+		return OptionEmployee{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptEmployee(v)
+		}
+	}
+	return OptionEmployee{}
+}
+
+// NthOptEmployee returns the item at the index. Returns None if the index is out of range
+func NthOptEmployee(list []Employee, i int) OptionEmployee {
+	if i < 0 || i >= len(list) {
+		return OptionEmployee{}
+	}
+	return SomeOptEmployee(list[i])
+}
+
+// ReduceOptEmployee reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptEmployee(f func(Employee, Employee) Employee, list []Employee) OptionEmployee {
+	if f == nil || len(list) == 0 {
+		return OptionEmployee{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptEmployee(acc)
+}
+
+// TraverseResultEmployee applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v Employee) ResultEmployee { return ResultOfEmployee(check(v)) }
+//	TraverseResultEmployee(parse, list)
+func TraverseResultEmployee(f func(Employee) ResultEmployee, list []Employee) ([]Employee, error) {
+	if f == nil {
+		return []Employee{}, nil
+	}
+	newList := make([]Employee, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// MapInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]Employee, 0, 1024)
+//	for ... {
+//		buf = MapInto(buf, f, list)
+//	}
+func MapInto(dst []Employee, f func(Employee) Employee, list []Employee) []Employee {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]Employee, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterInto(dst []Employee, f func(Employee) bool, list []Employee) []Employee {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropInto(dst []Employee, item Employee, list []Employee) []Employee {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapInPlace(f func(Employee) Employee, list []Employee) []Employee {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterInPlace(f, list)
+func FilterInPlace(f func(Employee) bool, list []Employee) []Employee {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero Employee
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+func MapTeacher(f func(Teacher) Teacher, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	newList := make([]Teacher, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+func FilterTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if f(v) {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func RemoveTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if !f(v) {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func SomeTeacher(f func(Teacher) bool, list []Teacher) bool {
+	if f == nil {
+		return false
+	}
+	for _, v := range list {
+		if f(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func EveryTeacher(f func(Teacher) bool, list []Teacher) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	for _, v := range list {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func DropWhileTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for i, v := range list {
+		if !f(v) {
+			listLen := len(list)
+			newList = make([]Teacher, listLen-i)
+			j := 0
+			for i < listLen {
+				newList[j] = list[i]
+				i++
+				j++
+			}
+			return newList
+		}
+	}
+	return newList
+}
+
+func TakeWhileTeacher(f func(Teacher) bool, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if !f(v) {
+			return newList
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func PMapTeacher(f func(Teacher) Teacher, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	ch := make(chan map[int]Teacher)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v Teacher) {
+			defer wg.Done()
+			ch <- map[int]Teacher{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Teacher, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+func PMapStreamTeacher(ctx context.Context, f func(Teacher) Teacher, list []Teacher, opts ...fp.ParallelOptions) <-chan Teacher {
+	out := make(chan Teacher)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]Teacher, len(list))
+	indexes := fp.ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero Teacher
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func FilterMapTeacher(fFilter func(Teacher) bool, fMap func(Teacher) Teacher, list []Teacher) []Teacher {
+	if fFilter == nil || fMap == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+func PFilterTeacher(f func(Teacher) bool, list []Teacher, opts ...fp.ParallelOptions) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	keep := make([]bool, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]Teacher, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PRemoveTeacher(f func(Teacher) bool, list []Teacher, opts ...fp.ParallelOptions) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	return PFilterTeacher(func(v Teacher) bool { return !f(v) }, list, opts...)
+}
+
+func PFilterMapTeacher(fFilter func(Teacher) bool, fMap func(Teacher) Teacher, list []Teacher, opts ...fp.ParallelOptions) []Teacher {
+	if fFilter == nil || fMap == nil {
+		return []Teacher{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]Teacher, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]Teacher, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PSomeTeacher(f func(Teacher) bool, list []Teacher, opts ...fp.ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !fp.ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+func PEveryTeacher(f func(Teacher) bool, list []Teacher, opts ...fp.ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return fp.ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+func RestTeacher(l []Teacher) []Teacher {
+	if l == nil {
+		return []Teacher{}
+	}
+
+	len := len(l)
+	if len == 0 || len == 1 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, len-1)
+
+	for i, v := range l[1:] {
+		newList[i] = v
+	}
+
+	return newList
+}
+
+func ReduceTeacher(f func(Teacher, Teacher) Teacher, list []Teacher, initializer ...Teacher) Teacher {
+	var init Teacher 
+	lenList := len(list)
+
+	if initializer != nil {
+		init = initializer[0]
+	} else if lenList > 0 {
+		init = list[0]
+		if lenList == 1 {
+			return list[0]
+		}
+		if lenList >= 2 {
+			list = list[1:]
+		}
+	}
+	
+	if lenList == 0 {
+		return init
+	}
+	r := f(init, list[0])
+	return ReduceTeacher(f, list[1:], r)
+}
+
+func PReduceTeacher(fReduce, fCombine func(Teacher, Teacher) Teacher, identity Teacher, list []Teacher, opts ...fp.ParallelOptions) Teacher {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := fp.ParallelChunkSize(len(list), opts...)
+	results := make([]Teacher, (len(list)+size-1)/size)
+	fp.ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// DropLastTeacher drops last item from the list and returns new list.
+// Returns empty list if there is only one item in the list or list empty
+func DropLastTeacher(list []Teacher) []Teacher {
+	listLen := len(list)
+
+	if list == nil || listLen == 0 || listLen == 1 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, listLen-1)
+
+	for i := 0; i < listLen-1; i++ {
+		newList[i] = list[i]
+	}
+	return newList
+}
+
+func DistinctTeacher(list []Teacher) []Teacher {
+	var newList []Teacher
+	s := make(map[Teacher]struct{}, len(list))
+	for _, v := range list {
+		if _, ok := s[v]; ok {
+			continue
+		}
+		s[v] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func DedupeTeacher(list []Teacher) []Teacher {
+	newList := make([]Teacher, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func RepeatTeacher(n int, v Teacher) []Teacher {
+	if n <= 0 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+func CycleTeacher(n int, list []Teacher) []Teacher {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+func IterateTeacher(f func(Teacher) Teacher, seed Teacher, n int) []Teacher {
+	if f == nil || n <= 0 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+func RepeatedlyTeacher(n int, f func() Teacher) []Teacher {
+	if f == nil || n <= 0 {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+func CompTeacher(fs ...func(Teacher) Teacher) func(Teacher) Teacher {
+	return func(v Teacher) Teacher {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+func PipeTeacher(fs ...func(Teacher) Teacher) func(Teacher) Teacher {
+	return func(v Teacher) Teacher {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+func PartialTeacher2(f func(Teacher, Teacher) Teacher, v1 Teacher) func(Teacher) Teacher {
+	if f == nil {
+		return nil
+	}
+	return func(v2 Teacher) Teacher {
+		return f(v1, v2)
+	}
+}
+
+func PartialTeacher3(f func(Teacher, Teacher, Teacher) Teacher, v1, v2 Teacher) func(Teacher) Teacher {
+	if f == nil {
+		return nil
+	}
+	return func(v3 Teacher) Teacher {
+		return f(v1, v2, v3)
+	}
+}
+
+func ComplementTeacher(pred func(Teacher) bool) func(Teacher) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v Teacher) bool {
+		return !pred(v)
+	}
+}
+
+func ConstantlyTeacher(v Teacher) func(Teacher) Teacher {
+	return func(Teacher) Teacher {
+		return v
+	}
+}
+
+func JuxtTeacher(fs ...func(Teacher) Teacher) func(Teacher) []Teacher {
+	funcs := make([]func(Teacher) Teacher, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Teacher) []Teacher {
+		newList := make([]Teacher, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func AndTeacher(preds ...func(Teacher) bool) func(Teacher) bool {
+	return func(v Teacher) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func OrTeacher(preds ...func(Teacher) bool) func(Teacher) bool {
+	return func(v Teacher) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func NotTeacher(pred func(Teacher) bool) func(Teacher) bool {
+	return ComplementTeacher(pred)
+}
+
+func NoneTeacher(pred func(Teacher) bool, list []Teacher) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func EqTeacher(v Teacher) func(Teacher) bool {
+	return func(item Teacher) bool {
+		return item == v
+	}
+}
+
+func NeTeacher(v Teacher) func(Teacher) bool {
+	return func(item Teacher) bool {
+		return item != v
+	}
+}
+
+func MemoizeTeacher(f func(Teacher) Teacher, opts ...fp.MemoizeOptions) func(Teacher) Teacher {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Teacher) Teacher {
+		return memo.Get(v, func() interface{} { return f(v) }).(Teacher)
+	}
+}
+
+type RefTeacher struct {
+	ref *fp.Ref
+}
+
+func NewRefTeacher(v Teacher) *RefTeacher {
+	return &RefTeacher{ref: fp.NewRef(v)}
+}
+
+func (r *RefTeacher) Deref() Teacher {
+	return r.ref.Deref().(Teacher)
+}
+
+func (r *RefTeacher) Get(tx *fp.Tx) Teacher {
+	return tx.Get(r.ref).(Teacher)
+}
+
+func (r *RefTeacher) Set(tx *fp.Tx, v Teacher) {
+	tx.Set(r.ref, v)
+}
+
+func (r *RefTeacher) Alter(tx *fp.Tx, f func(Teacher) Teacher) Teacher {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(Teacher)) }).(Teacher)
+}
+
+func (r *RefTeacher) Commute(tx *fp.Tx, f func(Teacher) Teacher) Teacher {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(Teacher)) }).(Teacher)
+}
+
+type FutureTeacher struct {
+	future *fp.Future
+}
+
+type PromiseTeacher struct {
+	promise *fp.Promise
+}
+
+func NewFutureTeacher(f func() (Teacher, error)) *FutureTeacher {
+	if f == nil {
+		return &FutureTeacher{future: fp.NewFuture(nil)}
+	}
+	return &FutureTeacher{future: fp.NewFuture(func() (interface{}, error) { return f() })}
+}
+
+func NewFutureCtxTeacher(ctx context.Context, f func(context.Context) (Teacher, error)) *FutureTeacher {
+	if f == nil {
+		return &FutureTeacher{future: fp.NewFutureCtx(ctx, nil)}
+	}
+	return &FutureTeacher{future: fp.NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+func NewPromiseTeacher() *PromiseTeacher {
+	return &PromiseTeacher{promise: fp.NewPromise()}
+}
+
+func (p *PromiseTeacher) Deliver(v Teacher) bool {
+	return p.promise.Deliver(v)
+}
+
+func (p *PromiseTeacher) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+func (p *PromiseTeacher) Future() *FutureTeacher {
+	return &FutureTeacher{future: p.promise.Future()}
+}
+
+func (f *FutureTeacher) Await(ctx context.Context) (Teacher, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero Teacher
+		return zero, err
+	}
+	return futureValueTeacher(v), nil
+}
+
+func (f *FutureTeacher) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+func (f *FutureTeacher) IsDone() bool {
+	return f.future.IsDone()
+}
+
+func (f *FutureTeacher) Untyped() *fp.Future {
+	return f.future
+}
+
+func (f *FutureTeacher) Then(fn func(Teacher) (Teacher, error)) *FutureTeacher {
+	if fn == nil {
+		return f
+	}
+	return &FutureTeacher{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueTeacher(v)) })}
+}
+
+func (f *FutureTeacher) ThenCtx(ctx context.Context, fn func(Teacher) (Teacher, error)) *FutureTeacher {
+	if fn == nil {
+		return &FutureTeacher{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureTeacher{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueTeacher(v)) })}
+}
+
+func AwaitAllTeacher(ctx context.Context, futures ...*FutureTeacher) ([]Teacher, error) {
+	values, err := AllCtxTeacher(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]Teacher), nil
+}
+
+func AllTeacher(futures ...*FutureTeacher) *fp.Future {
+	return AllCtxTeacher(context.Background(), futures...)
+}
+
+func AllCtxTeacher(ctx context.Context, futures ...*FutureTeacher) *fp.Future {
+	return fp.AllCtx(ctx, untypedFuturesTeacher(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]Teacher, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueTeacher(v)
+		}
+		return list, nil
+	})
+}
+
+func AnyTeacher(futures ...*FutureTeacher) *FutureTeacher {
+	return &FutureTeacher{future: fp.Any(untypedFuturesTeacher(futures)...)}
+}
+
+func RaceTeacher(futures ...*FutureTeacher) *FutureTeacher {
+	return &FutureTeacher{future: fp.Race(untypedFuturesTeacher(futures)...)}
+}
+
+func AnyCtxTeacher(ctx context.Context, futures ...*FutureTeacher) *FutureTeacher {
+	return &FutureTeacher{future: fp.AnyCtx(ctx, untypedFuturesTeacher(futures)...)}
+}
+
+func RaceCtxTeacher(ctx context.Context, futures ...*FutureTeacher) *FutureTeacher {
+	return &FutureTeacher{future: fp.RaceCtx(ctx, untypedFuturesTeacher(futures)...)}
+}
+
+func futureValueTeacher(v interface{}) Teacher {
+	if v == nil {
+		var zero Teacher
+		return zero
+	}
+	return v.(Teacher)
+}
+
+func untypedFuturesTeacher(futures []*FutureTeacher) []*fp.Future {
+	list := make([]*fp.Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+func Zip3Teacher(list1, list2, list3 []Teacher) [][3]Teacher {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]Teacher{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+func Unzip3Teacher(list [][3]Teacher) ([]Teacher, []Teacher, []Teacher) {
+	list1 := make([]Teacher, len(list))
+	list2 := make([]Teacher, len(list))
+	list3 := make([]Teacher, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+func TreeSeqTeacher(children func(Teacher) []Teacher, root Teacher) []Teacher {
+	var result []Teacher
+	stack := []Teacher{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if children != nil {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+func PrewalkTeacher(f func(Teacher) Teacher, children func(Teacher) []Teacher, withChildren func(Teacher, []Teacher) Teacher, root Teacher) Teacher {
+	if f == nil {
+		return root
+	}
+	node := f(root)
+	if children == nil || withChildren == nil {
+		return node
+	}
+
+	nodes := children(node)
+	if len(nodes) == 0 {
+		return node
+	}
+	newNodes := make([]Teacher, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PrewalkTeacher(f, children, withChildren, v)
+	}
+	return withChildren(node, newNodes)
+}
+
+func PostwalkTeacher(f func(Teacher) Teacher, children func(Teacher) []Teacher, withChildren func(Teacher, []Teacher) Teacher, root Teacher) Teacher {
+	if f == nil {
+		return root
+	}
+	if children == nil || withChildren == nil {
+		return f(root)
+	}
+
+	nodes := children(root)
+	if len(nodes) == 0 {
+		return f(root)
+	}
+	newNodes := make([]Teacher, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PostwalkTeacher(f, children, withChildren, v)
+	}
+	return f(withChildren(root, newNodes))
+}
+
+type Teachers []Teacher
+
+func (l Teachers) ToSlice() []Teacher {
+	return []Teacher(l)
+}
+
+func (l Teachers) Len() int {
+	return len(l)
+}
+
+func (l Teachers) Filter(f func(Teacher) bool) Teachers {
+	return FilterTeacher(f, l)
+}
+
+func (l Teachers) Remove(f func(Teacher) bool) Teachers {
+	return RemoveTeacher(f, l)
+}
+
+func (l Teachers) Map(f func(Teacher) Teacher) Teachers {
+	return MapTeacher(f, l)
+}
+
+func (l Teachers) PMap(f func(Teacher) Teacher) Teachers {
+	return PMapTeacher(f, l)
+}
+
+func (l Teachers) FilterMap(fFilter func(Teacher) bool, fMap func(Teacher) Teacher) Teachers {
+	return FilterMapTeacher(fFilter, fMap, l)
+}
+
+func (l Teachers) DropWhile(f func(Teacher) bool) Teachers {
+	return DropWhileTeacher(f, l)
+}
+
+func (l Teachers) TakeWhile(f func(Teacher) bool) Teachers {
+	return TakeWhileTeacher(f, l)
+}
+
+func (l Teachers) Distinct() Teachers {
+	return DistinctTeacher(l)
+}
+
+func (l Teachers) Dedupe() Teachers {
+	return DedupeTeacher(l)
+}
+
+func (l Teachers) Rest() Teachers {
+	return RestTeacher(l)
+}
+
+func (l Teachers) Reduce(f func(Teacher, Teacher) Teacher, initializer ...Teacher) Teacher {
+	return ReduceTeacher(f, l, initializer...)
+}
+
+func (l Teachers) Some(f func(Teacher) bool) bool {
+	return SomeTeacher(f, l)
+}
+
+func (l Teachers) Every(f func(Teacher) bool) bool {
+	return EveryTeacher(f, l)
+}
+
+func (l Teachers) Find(pred func(Teacher) bool) OptionTeacher {
+	return FindOptTeacher(pred, l)
+}
+
+// StackTeacher - persistent(immutable) stack of Teacher. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackTeacher struct {
+	top  Teacher
+	rest *StackTeacher
+	size int
+}
+
+// NewStackTeacher creates stack from the list. Last item of the list is on top
+func NewStackTeacher(list []Teacher) *StackTeacher {
+	var s *StackTeacher
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackTeacher) Push(v Teacher) *StackTeacher {
+	return &StackTeacher{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackTeacher) Pop() *StackTeacher {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackTeacher) Peek() (Teacher, bool) {
+	if s == nil {
+		var zero Teacher
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackTeacher) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackTeacher) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackTeacher(s.ToSlice()) is same as the stack
+func (s *StackTeacher) ToSlice() []Teacher {
+	list := make([]Teacher, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueTeacher - persistent(immutable) FIFO queue of Teacher. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueTeacher struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackTeacher
+	rear  *StackTeacher
+}
+
+// NewQueueTeacher creates queue from the list. First item of the list is at the front
+func NewQueueTeacher(list []Teacher) *QueueTeacher {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueTeacher{front: reversedStackTeacher(list)}
+}
+
+// reversedStackTeacher creates stack with the first item of the list on top
+func reversedStackTeacher(list []Teacher) *StackTeacher {
+	var s *StackTeacher
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueTeacher) Push(v Teacher) *QueueTeacher {
+	if q == nil {
+		return &QueueTeacher{front: (*StackTeacher)(nil).Push(v)}
+	}
+	return &QueueTeacher{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueTeacher
+func (q *QueueTeacher) Pop() *QueueTeacher {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueTeacher{front: front, rear: q.rear}
+	}
+	return &QueueTeacher{front: reversedStackTeacher(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueTeacher) Peek() (Teacher, bool) {
+	if q == nil {
+		var zero Teacher
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueTeacher) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueTeacher) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueTeacher) ToSlice() []Teacher {
+	if q == nil {
+		return []Teacher{}
+	}
+	list := make([]Teacher, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeTeacher - persistent(immutable) double-ended queue of Teacher. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeTeacher struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackTeacher
+	back  *StackTeacher
+}
+
+// NewDequeTeacher creates deque from the list. First item of the list is at the front
+func NewDequeTeacher(list []Teacher) *DequeTeacher {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeTeacher{front: reversedStackTeacher(list[:half]), back: NewStackTeacher(list[half:])}
+}
+
+// newDequeTeacher creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeTeacher(front, back *StackTeacher) *DequeTeacher {
+	d := &DequeTeacher{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeTeacher(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeTeacher) PushFront(v Teacher) *DequeTeacher {
+	if d == nil {
+		return newDequeTeacher((*StackTeacher)(nil).Push(v), nil)
+	}
+	return newDequeTeacher(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeTeacher) PushBack(v Teacher) *DequeTeacher {
+	if d == nil {
+		return newDequeTeacher(nil, (*StackTeacher)(nil).Push(v))
+	}
+	return newDequeTeacher(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeTeacher) PopFront() *DequeTeacher {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeTeacher(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeTeacher) PopBack() *DequeTeacher {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeTeacher(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeTeacher) PeekFront() (Teacher, bool) {
+	if d == nil {
+		var zero Teacher
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeTeacher) PeekBack() (Teacher, bool) {
+	if d == nil {
+		var zero Teacher
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeTeacher) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeTeacher) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeTeacher) ToSlice() []Teacher {
+	if d == nil {
+		return []Teacher{}
+	}
+	list := make([]Teacher, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueTeacher - binary heap of Teacher. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueTeacher, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueTeacher struct {
+	key   func(Teacher) float64
+	items []priorityItemTeacher
+	seq   int
+}
+
+type priorityItemTeacher struct {
+	value Teacher
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueTeacher creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueTeacher(key func(Teacher) float64, list []Teacher) *PriorityQueueTeacher {
+	pq := &PriorityQueueTeacher{key: key, items: make([]priorityItemTeacher, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueTeacher) Push(v Teacher) *PriorityQueueTeacher {
+	item := priorityItemTeacher{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueTeacher) Pop() (Teacher, bool) {
+	if len(pq.items) == 0 {
+		var zero Teacher
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueTeacher) Peek() (Teacher, bool) {
+	if len(pq.items) == 0 {
+		var zero Teacher
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueTeacher) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueTeacher) ToSlice() []Teacher {
+	clone := &PriorityQueueTeacher{items: make([]priorityItemTeacher, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]Teacher, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueTeacher) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// AtomTeacher - holds Teacher shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of Teacher
+type AtomTeacher struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   Teacher
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(Teacher) error
+	watches   map[string]func(key string, oldVal, newVal Teacher)
+}
+
+// NewAtomTeacher creates atom with the initial value
+func NewAtomTeacher(v Teacher) *AtomTeacher {
+	return &AtomTeacher{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomTeacher) load() (Teacher, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomTeacher) store(version uint64, v Teacher) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomTeacher) Deref() Teacher {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomTeacher(0)
+//	counter.Swap(func(v Teacher) Teacher { return v + 1 })
+func (a *AtomTeacher) Swap(f func(Teacher) Teacher) (Teacher, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomTeacher) Reset(v Teacher) error {
+	_, err := a.Swap(func(Teacher) Teacher { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomTeacher) CompareAndSet(oldVal, newVal Teacher) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomTeacher) SetValidator(validator func(Teacher) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomTeacher) validate(v Teacher) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomTeacher) AddWatch(key string, f func(key string, oldVal, newVal Teacher)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, Teacher, Teacher))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomTeacher) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomTeacher) notify(oldVal, newVal Teacher) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, Teacher, Teacher), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AgentTeacher - holds Teacher which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentTeacher struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   Teacher
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionTeacher
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionTeacher struct {
+	f     func(Teacher) Teacher
+	epoch int
+}
+
+// NewAgentTeacher creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentTeacher(v Teacher, queueSize int) *AgentTeacher {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentTeacher{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentTeacher(0, 100)
+//	total.Send(func(v Teacher) Teacher { return v + 1 })
+//	total.Await()
+func (a *AgentTeacher) Send(f func(Teacher) Teacher) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentTeacher(0, 1)
+//	counter.SendNested(func(v Teacher, send func(func(Teacher) Teacher)) Teacher {
+//		send(func(v Teacher) Teacher { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentTeacher) SendNested(f func(Teacher, func(func(Teacher) Teacher)) Teacher) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v Teacher) Teacher {
+		var held []func(Teacher) Teacher
+		v = f(v, func(g func(Teacher) Teacher) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionTeacher{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentTeacher) enqueue(f func(Teacher) Teacher) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionTeacher{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentTeacher) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionTeacher{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentTeacher) apply(f func(Teacher) Teacher, v Teacher) (newVal Teacher, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentTeacher) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentTeacher) Deref() Teacher {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentTeacher) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentTeacher) Restart(v Teacher) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// OptionTeacher - Teacher which may be absent. The zero value is absent
+type OptionTeacher struct {
+	value Teacher
+	ok    bool
+}
+
+// SomeOptTeacher returns option with the value
+func SomeOptTeacher(v Teacher) OptionTeacher {
+	return OptionTeacher{value: v, ok: true}
+}
+
+// NoneOptTeacher returns option without value
+func NoneOptTeacher() OptionTeacher {
+	return OptionTeacher{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionTeacher) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionTeacher) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionTeacher) Get() (Teacher, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionTeacher) Unwrap() Teacher {
+	if !o.ok {
+		panic("OptionTeacher: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionTeacher) OrElse(v Teacher) Teacher {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionTeacher) OrElseGet(f func() Teacher) Teacher {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionTeacher) Map(f func(Teacher) Teacher) OptionTeacher {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptTeacher(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionTeacher) FlatMap(f func(Teacher) OptionTeacher) OptionTeacher {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionTeacher) Filter(pred func(Teacher) bool) OptionTeacher {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionTeacher{}
+	}
+	return o
+}
+
+// ResultTeacher - Teacher or error. The zero value is Ok with zero value of Teacher
+type ResultTeacher struct {
+	value Teacher
+	err   error
+}
+
+// OkTeacher returns result with the value
+func OkTeacher(v Teacher) ResultTeacher {
+	return ResultTeacher{value: v}
+}
+
+// ErrTeacher returns result with the error
+func ErrTeacher(err error) ResultTeacher {
+	return ResultTeacher{err: err}
+}
+
+// ResultOfTeacher returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfTeacher(f(v))
+func ResultOfTeacher(v Teacher, err error) ResultTeacher {
+	if err != nil {
+		return ResultTeacher{err: err}
+	}
+	return ResultTeacher{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultTeacher) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultTeacher) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultTeacher) Get() (Teacher, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultTeacher) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultTeacher) Unwrap() Teacher {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultTeacher) OrElse(v Teacher) Teacher {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultTeacher) Map(f func(Teacher) Teacher) ResultTeacher {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkTeacher(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultTeacher) FlatMap(f func(Teacher) ResultTeacher) ResultTeacher {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultTeacher) Option() OptionTeacher {
+	if r.err != nil {
+		return OptionTeacher{}
+	}
+	return SomeOptTeacher(r.value)
+}
+
+// FindOptTeacher returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptTeacher(pred func(Teacher) bool, list []Teacher) OptionTeacher {
+	if pred == nil {
+		return OptionTeacher{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptTeacher(v)
+		}
+	}
+	return OptionTeacher{}
+}
+
+// NthOptTeacher returns the item at the index. Returns None if the index is out of range
+func NthOptTeacher(list []Teacher, i int) OptionTeacher {
+	if i < 0 || i >= len(list) {
+		return OptionTeacher{}
+	}
+	return SomeOptTeacher(list[i])
+}
+
+// ReduceOptTeacher reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptTeacher(f func(Teacher, Teacher) Teacher, list []Teacher) OptionTeacher {
+	if f == nil || len(list) == 0 {
+		return OptionTeacher{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptTeacher(acc)
+}
+
+// TraverseResultTeacher applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v Teacher) ResultTeacher { return ResultOfTeacher(check(v)) }
+//	TraverseResultTeacher(parse, list)
+func TraverseResultTeacher(f func(Teacher) ResultTeacher, list []Teacher) ([]Teacher, error) {
+	if f == nil {
+		return []Teacher{}, nil
+	}
+	newList := make([]Teacher, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// MapTeacherInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]Teacher, 0, 1024)
+//	for ... {
+//		buf = MapTeacherInto(buf, f, list)
+//	}
+func MapTeacherInto(dst []Teacher, f func(Teacher) Teacher, list []Teacher) []Teacher {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]Teacher, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterTeacherInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterTeacherInto(dst []Teacher, f func(Teacher) bool, list []Teacher) []Teacher {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropTeacherInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropTeacherInto(dst []Teacher, item Teacher, list []Teacher) []Teacher {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapTeacherInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapTeacherInPlace(f func(Teacher) Teacher, list []Teacher) []Teacher {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterTeacherInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterTeacherInPlace(f, list)
+func FilterTeacherInPlace(f func(Teacher) bool, list []Teacher) []Teacher {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero Teacher
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+
+// MapEmployeeTeacher takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployeeTeacher(f func(Employee) Teacher, list []Employee) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	newList := make([]Teacher, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployeeTeacher applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee output type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployeeTeacher(f func(Employee) Teacher, list []Employee) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	ch := make(chan map[int]Teacher)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v Employee) {
+			defer wg.Done()
+			ch <- map[int]Teacher{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Teacher, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployeeTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Employee and returns true/false.
+//	2. Function: takes Employee as argument and returns Teacher
+// 	3. List of type Employee
+//
+// Returns:
+//	New List of type Teacher
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployeeTeacher(fFilter func(Employee) bool, fMap func(Employee) Teacher, list []Employee) []Teacher {
+	if fFilter == nil || fMap == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployeeTeacher takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Employee output type: Teacher
+//
+// Returns
+//	Function which returns the list of type Teacher
+func JuxtEmployeeTeacher(fs ...func(Employee) Teacher) func(Employee) []Teacher {
+	funcs := make([]func(Employee) Teacher, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Employee) []Teacher {
+		newList := make([]Teacher, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployeeTeacher(f func(Employee) Teacher, opts ...fp.MemoizeOptions) func(Employee) Teacher {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Employee) Teacher {
+		return memo.Get(v, func() interface{} { return f(v) }).(Teacher)
+	}
+}
+
+func (l Employees) MapToTeacher(f func(Employee) Teacher) Teachers {
+	return MapEmployeeTeacher(f, l)
+}
+
+func (l Employees) PMapToTeacher(f func(Employee) Teacher) Teachers {
+	return PMapEmployeeTeacher(f, l)
+}
+
+func (l Employees) FilterMapToTeacher(fFilter func(Employee) bool, fMap func(Employee) Teacher) Teachers {
+	return FilterMapEmployeeTeacher(fFilter, fMap, l)
+}
+
+// MapEmployeeInt takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployeeInt(f func(Employee) int, list []Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+	newList := make([]int, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployeeInt applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee output type: int
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployeeInt(f func(Employee) int, list []Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	ch := make(chan map[int]int)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v Employee) {
+			defer wg.Done()
+			ch <- map[int]int{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]int, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployeeInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Employee and returns true/false.
+//	2. Function: takes Employee as argument and returns int
+// 	3. List of type Employee
+//
+// Returns:
+//	New List of type int
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployeeInt(fFilter func(Employee) bool, fMap func(Employee) int, list []Employee) []int {
+	if fFilter == nil || fMap == nil {
+		return []int{}
+	}
+	var newList []int
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployeeInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Employee output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtEmployeeInt(fs ...func(Employee) int) func(Employee) []int {
+	funcs := make([]func(Employee) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Employee) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployeeInt(f func(Employee) int, opts ...fp.MemoizeOptions) func(Employee) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Employee) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+func (l Employees) MapToInt(f func(Employee) int) fp.Ints {
+	return MapEmployeeInt(f, l)
+}
+
+func (l Employees) PMapToInt(f func(Employee) int) fp.Ints {
+	return PMapEmployeeInt(f, l)
+}
+
+func (l Employees) FilterMapToInt(fFilter func(Employee) bool, fMap func(Employee) int) fp.Ints {
+	return FilterMapEmployeeInt(fFilter, fMap, l)
+}
+
+// MapEmployeeStr takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployeeStr(f func(Employee) string, list []Employee) []string {
+	if f == nil {
+		return []string{}
+	}
+	newList := make([]string, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployeeStr applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee output type: string
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployeeStr(f func(Employee) string, list []Employee) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	ch := make(chan map[int]string)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]string, i int, v Employee) {
+			defer wg.Done()
+			ch <- map[int]string{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]string, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployeeStr filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Employee and returns true/false.
+//	2. Function: takes Employee as argument and returns string
+// 	3. List of type Employee
+//
+// Returns:
+//	New List of type string
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployeeStr(fFilter func(Employee) bool, fMap func(Employee) string, list []Employee) []string {
+	if fFilter == nil || fMap == nil {
+		return []string{}
+	}
+	var newList []string
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployeeStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Employee output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtEmployeeStr(fs ...func(Employee) string) func(Employee) []string {
+	funcs := make([]func(Employee) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Employee) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployeeStr(f func(Employee) string, opts ...fp.MemoizeOptions) func(Employee) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Employee) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+func (l Employees) MapToStr(f func(Employee) string) fp.Strs {
+	return MapEmployeeStr(f, l)
+}
+
+func (l Employees) PMapToStr(f func(Employee) string) fp.Strs {
+	return PMapEmployeeStr(f, l)
+}
+
+func (l Employees) FilterMapToStr(fFilter func(Employee) bool, fMap func(Employee) string) fp.Strs {
+	return FilterMapEmployeeStr(fFilter, fMap, l)
+}
+
+// MapTeacherEmployee takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapTeacherEmployee(f func(Teacher) Employee, list []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+	newList := make([]Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapTeacherEmployee applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher output type: Employee
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if all arguments are nil or either one is nil
+func PMapTeacherEmployee(f func(Teacher) Employee, list []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	ch := make(chan map[int]Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v Teacher) {
+			defer wg.Done()
+			ch <- map[int]Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapTeacherEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Teacher and returns true/false.
+//	2. Function: takes Teacher as argument and returns Employee
+// 	3. List of type Teacher
+//
+// Returns:
+//	New List of type Employee
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapTeacherEmployee(fFilter func(Teacher) bool, fMap func(Teacher) Employee, list []Teacher) []Employee {
+	if fFilter == nil || fMap == nil {
+		return []Employee{}
+	}
+	var newList []Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtTeacherEmployee takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Teacher output type: Employee
+//
+// Returns
+//	Function which returns the list of type Employee
+func JuxtTeacherEmployee(fs ...func(Teacher) Employee) func(Teacher) []Employee {
+	funcs := make([]func(Teacher) Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Teacher) []Employee {
+		newList := make([]Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeTeacherEmployee(f func(Teacher) Employee, opts ...fp.MemoizeOptions) func(Teacher) Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Teacher) Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(Employee)
+	}
+}
+
+func (l Teachers) MapToEmployee(f func(Teacher) Employee) Employees {
+	return MapTeacherEmployee(f, l)
+}
+
+func (l Teachers) PMapToEmployee(f func(Teacher) Employee) Employees {
+	return PMapTeacherEmployee(f, l)
+}
+
+func (l Teachers) FilterMapToEmployee(fFilter func(Teacher) bool, fMap func(Teacher) Employee) Employees {
+	return FilterMapTeacherEmployee(fFilter, fMap, l)
+}
+
+// MapTeacherInt takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapTeacherInt(f func(Teacher) int, list []Teacher) []int {
+	if f == nil {
+		return []int{}
+	}
+	newList := make([]int, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapTeacherInt applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher output type: int
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if all arguments are nil or either one is nil
+func PMapTeacherInt(f func(Teacher) int, list []Teacher) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	ch := make(chan map[int]int)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v Teacher) {
+			defer wg.Done()
+			ch <- map[int]int{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]int, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapTeacherInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Teacher and returns true/false.
+//	2. Function: takes Teacher as argument and returns int
+// 	3. List of type Teacher
+//
+// Returns:
+//	New List of type int
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapTeacherInt(fFilter func(Teacher) bool, fMap func(Teacher) int, list []Teacher) []int {
+	if fFilter == nil || fMap == nil {
+		return []int{}
+	}
+	var newList []int
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtTeacherInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Teacher output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtTeacherInt(fs ...func(Teacher) int) func(Teacher) []int {
+	funcs := make([]func(Teacher) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Teacher) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeTeacherInt(f func(Teacher) int, opts ...fp.MemoizeOptions) func(Teacher) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Teacher) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+func (l Teachers) MapToInt(f func(Teacher) int) fp.Ints {
+	return MapTeacherInt(f, l)
+}
+
+func (l Teachers) PMapToInt(f func(Teacher) int) fp.Ints {
+	return PMapTeacherInt(f, l)
+}
+
+func (l Teachers) FilterMapToInt(fFilter func(Teacher) bool, fMap func(Teacher) int) fp.Ints {
+	return FilterMapTeacherInt(fFilter, fMap, l)
+}
+
+// MapTeacherStr takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapTeacherStr(f func(Teacher) string, list []Teacher) []string {
+	if f == nil {
+		return []string{}
+	}
+	newList := make([]string, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapTeacherStr applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher output type: string
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if all arguments are nil or either one is nil
+func PMapTeacherStr(f func(Teacher) string, list []Teacher) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	ch := make(chan map[int]string)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]string, i int, v Teacher) {
+			defer wg.Done()
+			ch <- map[int]string{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]string, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapTeacherStr filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - Teacher and returns true/false.
+//	2. Function: takes Teacher as argument and returns string
+// 	3. List of type Teacher
+//
+// Returns:
+//	New List of type string
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapTeacherStr(fFilter func(Teacher) bool, fMap func(Teacher) string, list []Teacher) []string {
+	if fFilter == nil || fMap == nil {
+		return []string{}
+	}
+	var newList []string
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtTeacherStr takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: Teacher output type: string
+//
+// Returns
+//	Function which returns the list of type string
+func JuxtTeacherStr(fs ...func(Teacher) string) func(Teacher) []string {
+	funcs := make([]func(Teacher) string, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v Teacher) []string {
+		newList := make([]string, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeTeacherStr(f func(Teacher) string, opts ...fp.MemoizeOptions) func(Teacher) string {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v Teacher) string {
+		return memo.Get(v, func() interface{} { return f(v) }).(string)
+	}
+}
+
+func (l Teachers) MapToStr(f func(Teacher) string) fp.Strs {
+	return MapTeacherStr(f, l)
+}
+
+func (l Teachers) PMapToStr(f func(Teacher) string) fp.Strs {
+	return PMapTeacherStr(f, l)
+}
+
+func (l Teachers) FilterMapToStr(fFilter func(Teacher) bool, fMap func(Teacher) string) fp.Strs {
+	return FilterMapTeacherStr(fFilter, fMap, l)
+}
+
+// MapIntEmployee takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapIntEmployee(f func(int) Employee, list []int) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+	newList := make([]Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapIntEmployee applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: Employee
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if all arguments are nil or either one is nil
+func PMapIntEmployee(f func(int) Employee, list []int) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	ch := make(chan map[int]Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v int) {
+			defer wg.Done()
+			ch <- map[int]Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapIntEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - int and returns true/false.
+//	2. Function: takes int as argument and returns Employee
+// 	3. List of type int
+//
+// Returns:
+//	New List of type Employee
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapIntEmployee(fFilter func(int) bool, fMap func(int) Employee, list []int) []Employee {
+	if fFilter == nil || fMap == nil {
+		return []Employee{}
+	}
+	var newList []Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtIntEmployee takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: Employee
+//
+// Returns
+//	Function which returns the list of type Employee
+func JuxtIntEmployee(fs ...func(int) Employee) func(int) []Employee {
+	funcs := make([]func(int) Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []Employee {
+		newList := make([]Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeIntEmployee(f func(int) Employee, opts ...fp.MemoizeOptions) func(int) Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v int) Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(Employee)
+	}
+}
+
+// MapIntTeacher takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapIntTeacher(f func(int) Teacher, list []int) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	newList := make([]Teacher, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapIntTeacher applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if all arguments are nil or either one is nil
+func PMapIntTeacher(f func(int) Teacher, list []int) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	ch := make(chan map[int]Teacher)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v int) {
+			defer wg.Done()
+			ch <- map[int]Teacher{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Teacher, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapIntTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - int and returns true/false.
+//	2. Function: takes int as argument and returns Teacher
+// 	3. List of type int
+//
+// Returns:
+//	New List of type Teacher
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapIntTeacher(fFilter func(int) bool, fMap func(int) Teacher, list []int) []Teacher {
+	if fFilter == nil || fMap == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtIntTeacher takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: Teacher
+//
+// Returns
+//	Function which returns the list of type Teacher
+func JuxtIntTeacher(fs ...func(int) Teacher) func(int) []Teacher {
+	funcs := make([]func(int) Teacher, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []Teacher {
+		newList := make([]Teacher, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeIntTeacher(f func(int) Teacher, opts ...fp.MemoizeOptions) func(int) Teacher {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v int) Teacher {
+		return memo.Get(v, func() interface{} { return f(v) }).(Teacher)
+	}
+}
+
+// MapStrEmployee takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapStrEmployee(f func(string) Employee, list []string) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+	newList := make([]Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapStrEmployee applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string output type: Employee
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if all arguments are nil or either one is nil
+func PMapStrEmployee(f func(string) Employee, list []string) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	ch := make(chan map[int]Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v string) {
+			defer wg.Done()
+			ch <- map[int]Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapStrEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - string and returns true/false.
+//	2. Function: takes string as argument and returns Employee
+// 	3. List of type string
+//
+// Returns:
+//	New List of type Employee
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapStrEmployee(fFilter func(string) bool, fMap func(string) Employee, list []string) []Employee {
+	if fFilter == nil || fMap == nil {
+		return []Employee{}
+	}
+	var newList []Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtStrEmployee takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: Employee
+//
+// Returns
+//	Function which returns the list of type Employee
+func JuxtStrEmployee(fs ...func(string) Employee) func(string) []Employee {
+	funcs := make([]func(string) Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []Employee {
+		newList := make([]Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeStrEmployee(f func(string) Employee, opts ...fp.MemoizeOptions) func(string) Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v string) Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(Employee)
+	}
+}
+
+// MapStrTeacher takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapStrTeacher(f func(string) Teacher, list []string) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+	newList := make([]Teacher, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapStrTeacher applies the function(1st argument) on each item of the list and returns new list.
 // Run in parallel. no_of_goroutines = no_of_items_in_list
 //
 // Takes 2 inputs
-//	1. Function - takes 1 input type: Employee output type: Teacher
+//	1. Function - takes 1 input type: string output type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if all arguments are nil or either one is nil
+func PMapStrTeacher(f func(string) Teacher, list []string) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	ch := make(chan map[int]Teacher)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v string) {
+			defer wg.Done()
+			ch <- map[int]Teacher{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]Teacher, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapStrTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - string and returns true/false.
+//	2. Function: takes string as argument and returns Teacher
+// 	3. List of type string
+//
+// Returns:
+//	New List of type Teacher
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapStrTeacher(fFilter func(string) bool, fMap func(string) Teacher, list []string) []Teacher {
+	if fFilter == nil || fMap == nil {
+		return []Teacher{}
+	}
+	var newList []Teacher
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtStrTeacher takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: string output type: Teacher
+//
+// Returns
+//	Function which returns the list of type Teacher
+func JuxtStrTeacher(fs ...func(string) Teacher) func(string) []Teacher {
+	funcs := make([]func(string) Teacher, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v string) []Teacher {
+		newList := make([]Teacher, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeStrTeacher(f func(string) Teacher, opts ...fp.MemoizeOptions) func(string) Teacher {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v string) Teacher {
+		return memo.Get(v, func() interface{} { return f(v) }).(Teacher)
+	}
+}
+
+
+// Merge takes two or more inputs of type map[Employee]Employee and merge them and returns a new map[Employee]Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func Merge(map1, map2 map[Employee]Employee, maps ...map[Employee]Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWith merges maps of type map[Employee]Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWith(f func(Employee, Employee) Employee, maps ...map[Employee]Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// Keys returns list of keys of the map[Employee]Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func Keys(m map[Employee]Employee, less ...func(Employee, Employee) bool) []Employee {
+	keys := make([]Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// Vals returns list of values of the map[Employee]Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func Vals(m map[Employee]Employee, less ...func(Employee, Employee) bool) []Employee {
+	vals := make([]Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeys returns a new map which contains only the given keys of the map.
+func SelectKeys(m map[Employee]Employee, keys ...Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeys returns a new map without the given keys.
+func DissocKeys(m map[Employee]Employee, keys ...Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// Invert returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func Invert(m map[Employee]Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapVals applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapVals(f func(Employee) Employee, m map[Employee]Employee) map[Employee]Employee {
+	if f == nil {
+		return map[Employee]Employee{}
+	}
+	newMap := make(map[Employee]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeys applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeys(f func(Employee) Employee, m map[Employee]Employee) map[Employee]Employee {
+	if f == nil {
+		return map[Employee]Employee{}
+	}
+	newMap := make(map[Employee]Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeys returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeys(pred func(Employee) bool, m map[Employee]Employee) map[Employee]Employee {
+	if pred == nil {
+		return map[Employee]Employee{}
+	}
+	return FilterKV(func(k Employee, _ Employee) bool { return pred(k) }, m)
+}
+
+// FilterVals returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterVals(pred func(Employee) bool, m map[Employee]Employee) map[Employee]Employee {
+	if pred == nil {
+		return map[Employee]Employee{}
+	}
+	return FilterKV(func(_ Employee, v Employee) bool { return pred(v) }, m)
+}
+
+// FilterKV returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKV(pred func(Employee, Employee) bool, m map[Employee]Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKV reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Employee, Employee, Employee and returns Employee
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKV(f func(Employee, Employee, Employee) Employee, m map[Employee]Employee, initializer Employee) Employee {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// Zip takes two inputs: first list of type: []Employee, second list of type: []Employee.
+// Then it merges two list and returns a new map of type: map[Employee]Employee
+func Zip(list1 []Employee, list2 []Employee) map[Employee]Employee {
+	newMap := make(map[Employee]Employee)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctBy removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee and returns key of type: Employee
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if either of arguments is nil
+func DistinctBy(f func(Employee) Employee, list []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	newList := make([]Employee, 0, len(list))
+	s := make(map[Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployeeEmployee holds two values: First of type Employee and Second of type Employee.
+type PairEmployeeEmployee struct {
+	First  Employee
+	Second Employee
+}
+
+// ZipPairs takes two inputs: first list of type: []Employee, second list of type: []Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike Zip, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairs(list1 []Employee, list2 []Employee) []PairEmployeeEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployeeEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeEmployee{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// Unzip takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairs
+func Unzip(pairs []PairEmployeeEmployee) ([]Employee, []Employee) {
+	list1 := make([]Employee, len(pairs))
+	list2 := make([]Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Employee and returns type: Employee
+//	2. List of type Employee
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeEmployee(f func(Employee, Employee) Employee, list1 []Employee, list2 []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWith applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Employee and returns type: Employee
+//	2. List of type Employee
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWith(f func(Employee, Employee) Employee, list1 []Employee, list2 []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeEmployeeTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Employee and returns type: Teacher
+//	2. List of type Employee
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeTeacher(f func(Employee, Employee) Teacher, list1 []Employee, list2 []Employee) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Employee and returns type: int
+//	2. List of type Employee
+//	3. List of type Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeInt(f func(Employee, Employee) int, list1 []Employee, list2 []Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeEmployeeStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Employee and returns type: string
+//	2. List of type Employee
+//	3. List of type Employee
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeStr(f func(Employee, Employee) string, list1 []Employee, list2 []Employee) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployeeTeacher takes two or more inputs of type map[Employee]Teacher and merge them and returns a new map[Employee]Teacher.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployeeTeacher(map1, map2 map[Employee]Teacher, maps ...map[Employee]Teacher) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployeeTeacher merges maps of type map[Employee]Teacher and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployeeTeacher(f func(Teacher, Teacher) Teacher, maps ...map[Employee]Teacher) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployeeTeacher returns list of keys of the map[Employee]Teacher.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployeeTeacher(m map[Employee]Teacher, less ...func(Employee, Employee) bool) []Employee {
+	keys := make([]Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployeeTeacher returns list of values of the map[Employee]Teacher.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployeeTeacher(m map[Employee]Teacher, less ...func(Teacher, Teacher) bool) []Teacher {
+	vals := make([]Teacher, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployeeTeacher returns a new map which contains only the given keys of the map.
+func SelectKeysEmployeeTeacher(m map[Employee]Teacher, keys ...Employee) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployeeTeacher returns a new map without the given keys.
+func DissocKeysEmployeeTeacher(m map[Employee]Teacher, keys ...Employee) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployeeTeacher returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployeeTeacher(m map[Employee]Teacher) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployeeTeacher applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployeeTeacher(f func(Teacher) Teacher, m map[Employee]Teacher) map[Employee]Teacher {
+	if f == nil {
+		return map[Employee]Teacher{}
+	}
+	newMap := make(map[Employee]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployeeTeacher applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployeeTeacher(f func(Employee) Employee, m map[Employee]Teacher) map[Employee]Teacher {
+	if f == nil {
+		return map[Employee]Teacher{}
+	}
+	newMap := make(map[Employee]Teacher, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployeeTeacher returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployeeTeacher(pred func(Employee) bool, m map[Employee]Teacher) map[Employee]Teacher {
+	if pred == nil {
+		return map[Employee]Teacher{}
+	}
+	return FilterKVEmployeeTeacher(func(k Employee, _ Teacher) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployeeTeacher returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployeeTeacher(pred func(Teacher) bool, m map[Employee]Teacher) map[Employee]Teacher {
+	if pred == nil {
+		return map[Employee]Teacher{}
+	}
+	return FilterKVEmployeeTeacher(func(_ Employee, v Teacher) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployeeTeacher returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployeeTeacher(pred func(Employee, Teacher) bool, m map[Employee]Teacher) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployeeTeacher reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Teacher, Employee, Teacher and returns Teacher
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployeeTeacher(f func(Teacher, Employee, Teacher) Teacher, m map[Employee]Teacher, initializer Teacher) Teacher {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployeeTeacher takes two inputs: first list of type: []Employee, second list of type: []Teacher.
+// Then it merges two list and returns a new map of type: map[Employee]Teacher
+func ZipEmployeeTeacher(list1 []Employee, list2 []Teacher) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployeeTeacher removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee and returns key of type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if either of arguments is nil
+func DistinctByEmployeeTeacher(f func(Employee) Teacher, list []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	newList := make([]Employee, 0, len(list))
+	s := make(map[Teacher]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployeeTeacher holds two values: First of type Employee and Second of type Teacher.
+type PairEmployeeTeacher struct {
+	First  Employee
+	Second Teacher
+}
+
+// ZipPairsEmployeeTeacher takes two inputs: first list of type: []Employee, second list of type: []Teacher.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployeeTeacher, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployeeTeacher(list1 []Employee, list2 []Teacher) []PairEmployeeTeacher {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployeeTeacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeTeacher{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployeeTeacher takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployeeTeacher
+func UnzipEmployeeTeacher(pairs []PairEmployeeTeacher) ([]Employee, []Teacher) {
+	list1 := make([]Employee, len(pairs))
+	list2 := make([]Teacher, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeTeacherEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Teacher and returns type: Employee
+//	2. List of type Employee
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeTeacherEmployee(f func(Employee, Teacher) Employee, list1 []Employee, list2 []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeTeacherTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Teacher and returns type: Teacher
+//	2. List of type Employee
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithEmployeeTeacherTeacher(f func(Employee, Teacher) Teacher, list1 []Employee, list2 []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeTeacherInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Teacher and returns type: int
+//	2. List of type Employee
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployeeTeacherInt(f func(Employee, Teacher) int, list1 []Employee, list2 []Teacher) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeTeacherStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, Teacher and returns type: string
+//	2. List of type Employee
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithEmployeeTeacherStr(f func(Employee, Teacher) string, list1 []Employee, list2 []Teacher) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployeeInt takes two or more inputs of type map[Employee]int and merge them and returns a new map[Employee]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployeeInt(map1, map2 map[Employee]int, maps ...map[Employee]int) map[Employee]int {
+	newMap := make(map[Employee]int, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployeeInt merges maps of type map[Employee]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployeeInt(f func(int, int) int, maps ...map[Employee]int) map[Employee]int {
+	newMap := make(map[Employee]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployeeInt returns list of keys of the map[Employee]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployeeInt(m map[Employee]int, less ...func(Employee, Employee) bool) []Employee {
+	keys := make([]Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployeeInt returns list of values of the map[Employee]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployeeInt(m map[Employee]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployeeInt returns a new map which contains only the given keys of the map.
+func SelectKeysEmployeeInt(m map[Employee]int, keys ...Employee) map[Employee]int {
+	newMap := make(map[Employee]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployeeInt returns a new map without the given keys.
+func DissocKeysEmployeeInt(m map[Employee]int, keys ...Employee) map[Employee]int {
+	newMap := make(map[Employee]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployeeInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployeeInt(m map[Employee]int) map[int]Employee {
+	newMap := make(map[int]Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployeeInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployeeInt(f func(int) int, m map[Employee]int) map[Employee]int {
+	if f == nil {
+		return map[Employee]int{}
+	}
+	newMap := make(map[Employee]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployeeInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployeeInt(f func(Employee) Employee, m map[Employee]int) map[Employee]int {
+	if f == nil {
+		return map[Employee]int{}
+	}
+	newMap := make(map[Employee]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployeeInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployeeInt(pred func(Employee) bool, m map[Employee]int) map[Employee]int {
+	if pred == nil {
+		return map[Employee]int{}
+	}
+	return FilterKVEmployeeInt(func(k Employee, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployeeInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployeeInt(pred func(int) bool, m map[Employee]int) map[Employee]int {
+	if pred == nil {
+		return map[Employee]int{}
+	}
+	return FilterKVEmployeeInt(func(_ Employee, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployeeInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployeeInt(pred func(Employee, int) bool, m map[Employee]int) map[Employee]int {
+	newMap := make(map[Employee]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployeeInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, Employee, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployeeInt(f func(int, Employee, int) int, m map[Employee]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployeeInt takes two inputs: first list of type: []Employee, second list of type: []int.
+// Then it merges two list and returns a new map of type: map[Employee]int
+func ZipEmployeeInt(list1 []Employee, list2 []int) map[Employee]int {
+	newMap := make(map[Employee]int)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployeeInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if either of arguments is nil
+func DistinctByEmployeeInt(f func(Employee) int, list []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	newList := make([]Employee, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployeeInt holds two values: First of type Employee and Second of type int.
+type PairEmployeeInt struct {
+	First  Employee
+	Second int
+}
+
+// ZipPairsEmployeeInt takes two inputs: first list of type: []Employee, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployeeInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployeeInt(list1 []Employee, list2 []int) []PairEmployeeInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployeeInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployeeInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployeeInt
+func UnzipEmployeeInt(pairs []PairEmployeeInt) ([]Employee, []int) {
+	list1 := make([]Employee, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeIntEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, int and returns type: Employee
+//	2. List of type Employee
+//	3. List of type int
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeIntEmployee(f func(Employee, int) Employee, list1 []Employee, list2 []int) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeIntTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, int and returns type: Teacher
+//	2. List of type Employee
+//	3. List of type int
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithEmployeeIntTeacher(f func(Employee, int) Teacher, list1 []Employee, list2 []int) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeIntInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, int and returns type: int
+//	2. List of type Employee
+//	3. List of type int
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployeeIntInt(f func(Employee, int) int, list1 []Employee, list2 []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeIntStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, int and returns type: string
+//	2. List of type Employee
+//	3. List of type int
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithEmployeeIntStr(f func(Employee, int) string, list1 []Employee, list2 []int) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployeeStr takes two or more inputs of type map[Employee]string and merge them and returns a new map[Employee]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployeeStr(map1, map2 map[Employee]string, maps ...map[Employee]string) map[Employee]string {
+	newMap := make(map[Employee]string, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployeeStr merges maps of type map[Employee]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployeeStr(f func(string, string) string, maps ...map[Employee]string) map[Employee]string {
+	newMap := make(map[Employee]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployeeStr returns list of keys of the map[Employee]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployeeStr(m map[Employee]string, less ...func(Employee, Employee) bool) []Employee {
+	keys := make([]Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployeeStr returns list of values of the map[Employee]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployeeStr(m map[Employee]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployeeStr returns a new map which contains only the given keys of the map.
+func SelectKeysEmployeeStr(m map[Employee]string, keys ...Employee) map[Employee]string {
+	newMap := make(map[Employee]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployeeStr returns a new map without the given keys.
+func DissocKeysEmployeeStr(m map[Employee]string, keys ...Employee) map[Employee]string {
+	newMap := make(map[Employee]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployeeStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployeeStr(m map[Employee]string) map[string]Employee {
+	newMap := make(map[string]Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployeeStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployeeStr(f func(string) string, m map[Employee]string) map[Employee]string {
+	if f == nil {
+		return map[Employee]string{}
+	}
+	newMap := make(map[Employee]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployeeStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployeeStr(f func(Employee) Employee, m map[Employee]string) map[Employee]string {
+	if f == nil {
+		return map[Employee]string{}
+	}
+	newMap := make(map[Employee]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployeeStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployeeStr(pred func(Employee) bool, m map[Employee]string) map[Employee]string {
+	if pred == nil {
+		return map[Employee]string{}
+	}
+	return FilterKVEmployeeStr(func(k Employee, _ string) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployeeStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployeeStr(pred func(string) bool, m map[Employee]string) map[Employee]string {
+	if pred == nil {
+		return map[Employee]string{}
+	}
+	return FilterKVEmployeeStr(func(_ Employee, v string) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployeeStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployeeStr(pred func(Employee, string) bool, m map[Employee]string) map[Employee]string {
+	newMap := make(map[Employee]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployeeStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: string, Employee, string and returns string
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployeeStr(f func(string, Employee, string) string, m map[Employee]string, initializer string) string {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployeeStr takes two inputs: first list of type: []Employee, second list of type: []string.
+// Then it merges two list and returns a new map of type: map[Employee]string
+func ZipEmployeeStr(list1 []Employee, list2 []string) map[Employee]string {
+	newMap := make(map[Employee]string)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployeeStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Employee and returns key of type: string
+//	2. List
+//
+// Returns
+//	New List of type Employee
+//	Empty list if either of arguments is nil
+func DistinctByEmployeeStr(f func(Employee) string, list []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	newList := make([]Employee, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployeeStr holds two values: First of type Employee and Second of type string.
+type PairEmployeeStr struct {
+	First  Employee
+	Second string
+}
+
+// ZipPairsEmployeeStr takes two inputs: first list of type: []Employee, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployeeStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployeeStr(list1 []Employee, list2 []string) []PairEmployeeStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployeeStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeStr{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployeeStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployeeStr
+func UnzipEmployeeStr(pairs []PairEmployeeStr) ([]Employee, []string) {
+	list1 := make([]Employee, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeStrEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, string and returns type: Employee
+//	2. List of type Employee
+//	3. List of type string
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeStrEmployee(f func(Employee, string) Employee, list1 []Employee, list2 []string) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeStrTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, string and returns type: Teacher
+//	2. List of type Employee
+//	3. List of type string
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithEmployeeStrTeacher(f func(Employee, string) Teacher, list1 []Employee, list2 []string) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeStrInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, string and returns type: int
+//	2. List of type Employee
+//	3. List of type string
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployeeStrInt(f func(Employee, string) int, list1 []Employee, list2 []string) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployeeStrStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Employee, string and returns type: string
+//	2. List of type Employee
+//	3. List of type string
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithEmployeeStrStr(f func(Employee, string) string, list1 []Employee, list2 []string) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeTeacherEmployee takes two or more inputs of type map[Teacher]Employee and merge them and returns a new map[Teacher]Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeTeacherEmployee(map1, map2 map[Teacher]Employee, maps ...map[Teacher]Employee) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithTeacherEmployee merges maps of type map[Teacher]Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithTeacherEmployee(f func(Employee, Employee) Employee, maps ...map[Teacher]Employee) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysTeacherEmployee returns list of keys of the map[Teacher]Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysTeacherEmployee(m map[Teacher]Employee, less ...func(Teacher, Teacher) bool) []Teacher {
+	keys := make([]Teacher, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsTeacherEmployee returns list of values of the map[Teacher]Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsTeacherEmployee(m map[Teacher]Employee, less ...func(Employee, Employee) bool) []Employee {
+	vals := make([]Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysTeacherEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysTeacherEmployee(m map[Teacher]Employee, keys ...Teacher) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysTeacherEmployee returns a new map without the given keys.
+func DissocKeysTeacherEmployee(m map[Teacher]Employee, keys ...Teacher) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertTeacherEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertTeacherEmployee(m map[Teacher]Employee) map[Employee]Teacher {
+	newMap := make(map[Employee]Teacher, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsTeacherEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsTeacherEmployee(f func(Employee) Employee, m map[Teacher]Employee) map[Teacher]Employee {
+	if f == nil {
+		return map[Teacher]Employee{}
+	}
+	newMap := make(map[Teacher]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysTeacherEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysTeacherEmployee(f func(Teacher) Teacher, m map[Teacher]Employee) map[Teacher]Employee {
+	if f == nil {
+		return map[Teacher]Employee{}
+	}
+	newMap := make(map[Teacher]Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysTeacherEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysTeacherEmployee(pred func(Teacher) bool, m map[Teacher]Employee) map[Teacher]Employee {
+	if pred == nil {
+		return map[Teacher]Employee{}
+	}
+	return FilterKVTeacherEmployee(func(k Teacher, _ Employee) bool { return pred(k) }, m)
+}
+
+// FilterValsTeacherEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsTeacherEmployee(pred func(Employee) bool, m map[Teacher]Employee) map[Teacher]Employee {
+	if pred == nil {
+		return map[Teacher]Employee{}
+	}
+	return FilterKVTeacherEmployee(func(_ Teacher, v Employee) bool { return pred(v) }, m)
+}
+
+// FilterKVTeacherEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVTeacherEmployee(pred func(Teacher, Employee) bool, m map[Teacher]Employee) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVTeacherEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Employee, Teacher, Employee and returns Employee
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVTeacherEmployee(f func(Employee, Teacher, Employee) Employee, m map[Teacher]Employee, initializer Employee) Employee {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipTeacherEmployee takes two inputs: first list of type: []Teacher, second list of type: []Employee.
+// Then it merges two list and returns a new map of type: map[Teacher]Employee
+func ZipTeacherEmployee(list1 []Teacher, list2 []Employee) map[Teacher]Employee {
+	newMap := make(map[Teacher]Employee)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByTeacherEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher and returns key of type: Employee
+//	2. List
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if either of arguments is nil
+func DistinctByTeacherEmployee(f func(Teacher) Employee, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, 0, len(list))
+	s := make(map[Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairTeacherEmployee holds two values: First of type Teacher and Second of type Employee.
+type PairTeacherEmployee struct {
+	First  Teacher
+	Second Employee
+}
+
+// ZipPairsTeacherEmployee takes two inputs: first list of type: []Teacher, second list of type: []Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipTeacherEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsTeacherEmployee(list1 []Teacher, list2 []Employee) []PairTeacherEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairTeacherEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairTeacherEmployee{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipTeacherEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsTeacherEmployee
+func UnzipTeacherEmployee(pairs []PairTeacherEmployee) ([]Teacher, []Employee) {
+	list1 := make([]Teacher, len(pairs))
+	list2 := make([]Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithTeacherEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Employee and returns type: Employee
+//	2. List of type Teacher
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithTeacherEmployeeEmployee(f func(Teacher, Employee) Employee, list1 []Teacher, list2 []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherEmployeeTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Employee and returns type: Teacher
+//	2. List of type Teacher
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithTeacherEmployeeTeacher(f func(Teacher, Employee) Teacher, list1 []Teacher, list2 []Employee) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Employee and returns type: int
+//	2. List of type Teacher
+//	3. List of type Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithTeacherEmployeeInt(f func(Teacher, Employee) int, list1 []Teacher, list2 []Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherEmployeeStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Employee and returns type: string
+//	2. List of type Teacher
+//	3. List of type Employee
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithTeacherEmployeeStr(f func(Teacher, Employee) string, list1 []Teacher, list2 []Employee) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeTeacher takes two or more inputs of type map[Teacher]Teacher and merge them and returns a new map[Teacher]Teacher.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeTeacher(map1, map2 map[Teacher]Teacher, maps ...map[Teacher]Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithTeacher merges maps of type map[Teacher]Teacher and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithTeacher(f func(Teacher, Teacher) Teacher, maps ...map[Teacher]Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysTeacher returns list of keys of the map[Teacher]Teacher.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysTeacher(m map[Teacher]Teacher, less ...func(Teacher, Teacher) bool) []Teacher {
+	keys := make([]Teacher, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsTeacher returns list of values of the map[Teacher]Teacher.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsTeacher(m map[Teacher]Teacher, less ...func(Teacher, Teacher) bool) []Teacher {
+	vals := make([]Teacher, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysTeacher returns a new map which contains only the given keys of the map.
+func SelectKeysTeacher(m map[Teacher]Teacher, keys ...Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysTeacher returns a new map without the given keys.
+func DissocKeysTeacher(m map[Teacher]Teacher, keys ...Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertTeacher returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertTeacher(m map[Teacher]Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsTeacher applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsTeacher(f func(Teacher) Teacher, m map[Teacher]Teacher) map[Teacher]Teacher {
+	if f == nil {
+		return map[Teacher]Teacher{}
+	}
+	newMap := make(map[Teacher]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysTeacher applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysTeacher(f func(Teacher) Teacher, m map[Teacher]Teacher) map[Teacher]Teacher {
+	if f == nil {
+		return map[Teacher]Teacher{}
+	}
+	newMap := make(map[Teacher]Teacher, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysTeacher returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysTeacher(pred func(Teacher) bool, m map[Teacher]Teacher) map[Teacher]Teacher {
+	if pred == nil {
+		return map[Teacher]Teacher{}
+	}
+	return FilterKVTeacher(func(k Teacher, _ Teacher) bool { return pred(k) }, m)
+}
+
+// FilterValsTeacher returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsTeacher(pred func(Teacher) bool, m map[Teacher]Teacher) map[Teacher]Teacher {
+	if pred == nil {
+		return map[Teacher]Teacher{}
+	}
+	return FilterKVTeacher(func(_ Teacher, v Teacher) bool { return pred(v) }, m)
+}
+
+// FilterKVTeacher returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVTeacher(pred func(Teacher, Teacher) bool, m map[Teacher]Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVTeacher reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Teacher, Teacher, Teacher and returns Teacher
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVTeacher(f func(Teacher, Teacher, Teacher) Teacher, m map[Teacher]Teacher, initializer Teacher) Teacher {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipTeacher takes two inputs: first list of type: []Teacher, second list of type: []Teacher.
+// Then it merges two list and returns a new map of type: map[Teacher]Teacher
+func ZipTeacher(list1 []Teacher, list2 []Teacher) map[Teacher]Teacher {
+	newMap := make(map[Teacher]Teacher)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByTeacher removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher and returns key of type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if either of arguments is nil
+func DistinctByTeacher(f func(Teacher) Teacher, list []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	newList := make([]Teacher, 0, len(list))
+	s := make(map[Teacher]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairTeacherTeacher holds two values: First of type Teacher and Second of type Teacher.
+type PairTeacherTeacher struct {
+	First  Teacher
+	Second Teacher
+}
+
+// ZipPairsTeacher takes two inputs: first list of type: []Teacher, second list of type: []Teacher.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipTeacher, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsTeacher(list1 []Teacher, list2 []Teacher) []PairTeacherTeacher {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairTeacherTeacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairTeacherTeacher{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipTeacher takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsTeacher
+func UnzipTeacher(pairs []PairTeacherTeacher) ([]Teacher, []Teacher) {
+	list1 := make([]Teacher, len(pairs))
+	list2 := make([]Teacher, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithTeacherTeacherEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Teacher and returns type: Employee
+//	2. List of type Teacher
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithTeacherTeacherEmployee(f func(Teacher, Teacher) Employee, list1 []Teacher, list2 []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherTeacherTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Teacher and returns type: Teacher
+//	2. List of type Teacher
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithTeacherTeacherTeacher(f func(Teacher, Teacher) Teacher, list1 []Teacher, list2 []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Teacher and returns type: Teacher
+//	2. List of type Teacher
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithTeacher(f func(Teacher, Teacher) Teacher, list1 []Teacher, list2 []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherTeacherInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Teacher and returns type: int
+//	2. List of type Teacher
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithTeacherTeacherInt(f func(Teacher, Teacher) int, list1 []Teacher, list2 []Teacher) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithTeacherTeacherStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, Teacher and returns type: string
+//	2. List of type Teacher
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithTeacherTeacherStr(f func(Teacher, Teacher) string, list1 []Teacher, list2 []Teacher) []string {
+	if f == nil {
+		return []string{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeTeacherInt takes two or more inputs of type map[Teacher]int and merge them and returns a new map[Teacher]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeTeacherInt(map1, map2 map[Teacher]int, maps ...map[Teacher]int) map[Teacher]int {
+	newMap := make(map[Teacher]int, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithTeacherInt merges maps of type map[Teacher]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithTeacherInt(f func(int, int) int, maps ...map[Teacher]int) map[Teacher]int {
+	newMap := make(map[Teacher]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysTeacherInt returns list of keys of the map[Teacher]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysTeacherInt(m map[Teacher]int, less ...func(Teacher, Teacher) bool) []Teacher {
+	keys := make([]Teacher, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsTeacherInt returns list of values of the map[Teacher]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsTeacherInt(m map[Teacher]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysTeacherInt returns a new map which contains only the given keys of the map.
+func SelectKeysTeacherInt(m map[Teacher]int, keys ...Teacher) map[Teacher]int {
+	newMap := make(map[Teacher]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysTeacherInt returns a new map without the given keys.
+func DissocKeysTeacherInt(m map[Teacher]int, keys ...Teacher) map[Teacher]int {
+	newMap := make(map[Teacher]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertTeacherInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertTeacherInt(m map[Teacher]int) map[int]Teacher {
+	newMap := make(map[int]Teacher, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsTeacherInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsTeacherInt(f func(int) int, m map[Teacher]int) map[Teacher]int {
+	if f == nil {
+		return map[Teacher]int{}
+	}
+	newMap := make(map[Teacher]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysTeacherInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysTeacherInt(f func(Teacher) Teacher, m map[Teacher]int) map[Teacher]int {
+	if f == nil {
+		return map[Teacher]int{}
+	}
+	newMap := make(map[Teacher]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysTeacherInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysTeacherInt(pred func(Teacher) bool, m map[Teacher]int) map[Teacher]int {
+	if pred == nil {
+		return map[Teacher]int{}
+	}
+	return FilterKVTeacherInt(func(k Teacher, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsTeacherInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsTeacherInt(pred func(int) bool, m map[Teacher]int) map[Teacher]int {
+	if pred == nil {
+		return map[Teacher]int{}
+	}
+	return FilterKVTeacherInt(func(_ Teacher, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVTeacherInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVTeacherInt(pred func(Teacher, int) bool, m map[Teacher]int) map[Teacher]int {
+	newMap := make(map[Teacher]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVTeacherInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, Teacher, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVTeacherInt(f func(int, Teacher, int) int, m map[Teacher]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipTeacherInt takes two inputs: first list of type: []Teacher, second list of type: []int.
+// Then it merges two list and returns a new map of type: map[Teacher]int
+func ZipTeacherInt(list1 []Teacher, list2 []int) map[Teacher]int {
+	newMap := make(map[Teacher]int)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByTeacherInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: Teacher and returns key of type: int
 //	2. List
 //
 // Returns
 //	New List of type Teacher
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployeeTeacher(f func(Employee) Teacher, list []Employee) []Teacher {
+//	Empty list if either of arguments is nil
+func DistinctByTeacherInt(f func(Teacher) int, list []Teacher) []Teacher {
 	if f == nil {
 		return []Teacher{}
 	}
 
-	ch := make(chan map[int]Teacher)
-	var wg sync.WaitGroup
+	newList := make([]Teacher, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
 
-	for i, v := range list {
-		wg.Add(1)
+// PairTeacherInt holds two values: First of type Teacher and Second of type int.
+type PairTeacherInt struct {
+	First  Teacher
+	Second int
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v Employee) {
-			defer wg.Done()
-			ch <- map[int]Teacher{i: f(v)}
-		}(&wg, ch, i, v)
+// ZipPairsTeacherInt takes two inputs: first list of type: []Teacher, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipTeacherInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsTeacherInt(list1 []Teacher, list2 []int) []PairTeacherInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	newList := make([]PairTeacherInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairTeacherInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
 
-	newList := make([]Teacher, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+// UnzipTeacherInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsTeacherInt
+func UnzipTeacherInt(pairs []PairTeacherInt) ([]Teacher, []int) {
+	list1 := make([]Teacher, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithTeacherIntEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, int and returns type: Employee
+//	2. List of type Teacher
+//	3. List of type int
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithTeacherIntEmployee(f func(Teacher, int) Employee, list1 []Teacher, list2 []int) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// FilterMapEmployeeTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithTeacherIntTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - Employee and returns true/false.
-//	2. Function: takes Employee as argument and returns Teacher
-// 	3. List of type Employee
+//	1. Function - takes 2 inputs type: Teacher, int and returns type: Teacher
+//	2. List of type Teacher
+//	3. List of type int
 //
-// Returns:
+// Returns
 //	New List of type Teacher
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployeeTeacher(fFilter func(Employee) bool, fMap func(Employee) Teacher, list []Employee) []Teacher {
-	if fFilter == nil || fMap == nil {
+//	Empty list if the function is nil
+func ZipWithTeacherIntTeacher(f func(Teacher, int) Teacher, list1 []Teacher, list2 []int) []Teacher {
+	if f == nil {
 		return []Teacher{}
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// MapEmployeeInt takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployeeInt(f func(Employee) int, list []Employee) []int {
+// ZipWithTeacherIntInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, int and returns type: int
+//	2. List of type Teacher
+//	3. List of type int
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithTeacherIntInt(f func(Teacher, int) int, list1 []Teacher, list2 []int) []int {
 	if f == nil {
 		return []int{}
 	}
-	newList := make([]int, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapEmployeeInt applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithTeacherIntStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: Employee output type: int
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, int and returns type: string
+//	2. List of type Teacher
+//	3. List of type int
 //
 // Returns
-//	New List of type int
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployeeInt(f func(Employee) int, list []Employee) []int {
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithTeacherIntStr(f func(Teacher, int) string, list1 []Teacher, list2 []int) []string {
 	if f == nil {
-		return []int{}
+		return []string{}
 	}
 
-	ch := make(chan map[int]int)
-	var wg sync.WaitGroup
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	for i, v := range list {
-		wg.Add(1)
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v Employee) {
-			defer wg.Done()
-			ch <- map[int]int{i: f(v)}
-		}(&wg, ch, i, v)
+// MergeTeacherStr takes two or more inputs of type map[Teacher]string and merge them and returns a new map[Teacher]string.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeTeacherStr(map1, map2 map[Teacher]string, maps ...map[Teacher]string) map[Teacher]string {
+	newMap := make(map[Teacher]string, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	newList := make([]int, len(list))
-	for m := range ch {
+	for _, m := range maps {
 		for k, v := range m {
-			newList[k] = v
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithTeacherStr merges maps of type map[Teacher]string and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithTeacherStr(f func(string, string) string, maps ...map[Teacher]string) map[Teacher]string {
+	newMap := make(map[Teacher]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysTeacherStr returns list of keys of the map[Teacher]string.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysTeacherStr(m map[Teacher]string, less ...func(Teacher, Teacher) bool) []Teacher {
+	keys := make([]Teacher, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsTeacherStr returns list of values of the map[Teacher]string.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsTeacherStr(m map[Teacher]string, less ...func(string, string) bool) []string {
+	vals := make([]string, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysTeacherStr returns a new map which contains only the given keys of the map.
+func SelectKeysTeacherStr(m map[Teacher]string, keys ...Teacher) map[Teacher]string {
+	newMap := make(map[Teacher]string, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
 		}
 	}
-	return newList
+	return newMap
 }
 
-// FilterMapEmployeeInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
-// Takes 3 inputs
-//	1. Function: takes one input type - Employee and returns true/false.
-//	2. Function: takes Employee as argument and returns int
-// 	3. List of type Employee
-//
-// Returns:
-//	New List of type int
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployeeInt(fFilter func(Employee) bool, fMap func(Employee) int, list []Employee) []int {
-	if fFilter == nil || fMap == nil {
-		return []int{}
+// DissocKeysTeacherStr returns a new map without the given keys.
+func DissocKeysTeacherStr(m map[Teacher]string, keys ...Teacher) map[Teacher]string {
+	newMap := make(map[Teacher]string, len(m))
+	for k, v := range m {
+		newMap[k] = v
 	}
-	var newList []int
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+	for _, k := range keys {
+		delete(newMap, k)
 	}
-	return newList
+	return newMap
 }
 
-// MapEmployeeStr takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployeeStr(f func(Employee) string, list []Employee) []string {
+// InvertTeacherStr returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertTeacherStr(m map[Teacher]string) map[string]Teacher {
+	newMap := make(map[string]Teacher, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsTeacherStr applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsTeacherStr(f func(string) string, m map[Teacher]string) map[Teacher]string {
 	if f == nil {
-		return []string{}
+		return map[Teacher]string{}
 	}
-	newList := make([]string, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+	newMap := make(map[Teacher]string, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
-	return newList
+	return newMap
 }
 
-// PMapEmployeeStr applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
-//
-// Takes 2 inputs
-//	1. Function - takes 1 input type: Employee output type: string
-//	2. List
-//
-// Returns
-//	New List of type string
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployeeStr(f func(Employee) string, list []Employee) []string {
+// MapKeysTeacherStr applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysTeacherStr(f func(Teacher) Teacher, m map[Teacher]string) map[Teacher]string {
 	if f == nil {
-		return []string{}
+		return map[Teacher]string{}
 	}
+	newMap := make(map[Teacher]string, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
 
-	ch := make(chan map[int]string)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]string, i int, v Employee) {
-			defer wg.Done()
-			ch <- map[int]string{i: f(v)}
-		}(&wg, ch, i, v)
+// FilterKeysTeacherStr returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysTeacherStr(pred func(Teacher) bool, m map[Teacher]string) map[Teacher]string {
+	if pred == nil {
+		return map[Teacher]string{}
 	}
+	return FilterKVTeacherStr(func(k Teacher, _ string) bool { return pred(k) }, m)
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+// FilterValsTeacherStr returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsTeacherStr(pred func(string) bool, m map[Teacher]string) map[Teacher]string {
+	if pred == nil {
+		return map[Teacher]string{}
+	}
+	return FilterKVTeacherStr(func(_ Teacher, v string) bool { return pred(v) }, m)
+}
 
-	newList := make([]string, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
+// FilterKVTeacherStr returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVTeacherStr(pred func(Teacher, string) bool, m map[Teacher]string) map[Teacher]string {
+	newMap := make(map[Teacher]string)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
 		}
 	}
-	return newList
+	return newMap
 }
 
-// FilterMapEmployeeStr filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ReduceKVTeacherStr reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - Employee and returns true/false.
-//	2. Function: takes Employee as argument and returns string
-// 	3. List of type Employee
+//	1. Function - takes 3 inputs: string, Teacher, string and returns string
+//	2. Map
+//	3. Initial value
 //
-// Returns:
-//	New List of type string
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployeeStr(fFilter func(Employee) bool, fMap func(Employee) string, list []Employee) []string {
-	if fFilter == nil || fMap == nil {
-		return []string{}
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVTeacherStr(f func(string, Teacher, string) string, m map[Teacher]string, initializer string) string {
+	if f == nil {
+		return initializer
 	}
-	var newList []string
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-	return newList
+	return result
 }
 
-// MapTeacherEmployee takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapTeacherEmployee(f func(Teacher) Employee, list []Teacher) []Employee {
-	if f == nil {
-		return []Employee{}
+// ZipTeacherStr takes two inputs: first list of type: []Teacher, second list of type: []string.
+// Then it merges two list and returns a new map of type: map[Teacher]string
+func ZipTeacherStr(list1 []Teacher, list2 []string) map[Teacher]string {
+	newMap := make(map[Teacher]string)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
 	}
-	newList := make([]Employee, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
 	}
-	return newList
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
 }
 
-// PMapTeacherEmployee applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// DistinctByTeacherStr removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
 //
 // Takes 2 inputs
-//	1. Function - takes 1 input type: Teacher output type: Employee
+//	1. Function - takes 1 input type: Teacher and returns key of type: string
 //	2. List
 //
 // Returns
-//	New List of type Employee
-//	Empty list if all arguments are nil or either one is nil
-func PMapTeacherEmployee(f func(Teacher) Employee, list []Teacher) []Employee {
+//	New List of type Teacher
+//	Empty list if either of arguments is nil
+func DistinctByTeacherStr(f func(Teacher) string, list []Teacher) []Teacher {
 	if f == nil {
-		return []Employee{}
+		return []Teacher{}
 	}
 
-	ch := make(chan map[int]Employee)
-	var wg sync.WaitGroup
+	newList := make([]Teacher, 0, len(list))
+	s := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
 
-	for i, v := range list {
-		wg.Add(1)
+// PairTeacherStr holds two values: First of type Teacher and Second of type string.
+type PairTeacherStr struct {
+	First  Teacher
+	Second string
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v Teacher) {
-			defer wg.Done()
-			ch <- map[int]Employee{i: f(v)}
-		}(&wg, ch, i, v)
+// ZipPairsTeacherStr takes two inputs: first list of type: []Teacher, second list of type: []string.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipTeacherStr, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsTeacherStr(list1 []Teacher, list2 []string) []PairTeacherStr {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]PairTeacherStr, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairTeacherStr{First: list1[i], Second: list2[i]}
 	}
 	return newList
 }
 
-// FilterMapTeacherEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// UnzipTeacherStr takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsTeacherStr
+func UnzipTeacherStr(pairs []PairTeacherStr) ([]Teacher, []string) {
+	list1 := make([]Teacher, len(pairs))
+	list2 := make([]string, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithTeacherStrEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - Teacher and returns true/false.
-//	2. Function: takes Teacher as argument and returns Employee
-// 	3. List of type Teacher
+//	1. Function - takes 2 inputs type: Teacher, string and returns type: Employee
+//	2. List of type Teacher
+//	3. List of type string
 //
-// Returns:
+// Returns
 //	New List of type Employee
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapTeacherEmployee(fFilter func(Teacher) bool, fMap func(Teacher) Employee, list []Teacher) []Employee {
-	if fFilter == nil || fMap == nil {
+//	Empty list if the function is nil
+func ZipWithTeacherStrEmployee(f func(Teacher, string) Employee, list1 []Teacher, list2 []string) []Employee {
+	if f == nil {
 		return []Employee{}
 	}
-	var newList []Employee
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
-	}
-	return newList
-}
 
-// MapTeacherInt takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapTeacherInt(f func(Teacher) int, list []Teacher) []int {
-	if f == nil {
-		return []int{}
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
-	newList := make([]int, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapTeacherInt applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithTeacherStrTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: Teacher output type: int
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, string and returns type: Teacher
+//	2. List of type Teacher
+//	3. List of type string
 //
 // Returns
-//	New List of type int
-//	Empty list if all arguments are nil or either one is nil
-func PMapTeacherInt(f func(Teacher) int, list []Teacher) []int {
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithTeacherStrTeacher(f func(Teacher, string) Teacher, list1 []Teacher, list2 []string) []Teacher {
 	if f == nil {
-		return []int{}
+		return []Teacher{}
 	}
 
-	ch := make(chan map[int]int)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v Teacher) {
-			defer wg.Done()
-			ch <- map[int]int{i: f(v)}
-		}(&wg, ch, i, v)
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]int, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// FilterMapTeacherInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithTeacherStrInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - Teacher and returns true/false.
-//	2. Function: takes Teacher as argument and returns int
-// 	3. List of type Teacher
+//	1. Function - takes 2 inputs type: Teacher, string and returns type: int
+//	2. List of type Teacher
+//	3. List of type string
 //
-// Returns:
+// Returns
 //	New List of type int
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapTeacherInt(fFilter func(Teacher) bool, fMap func(Teacher) int, list []Teacher) []int {
-	if fFilter == nil || fMap == nil {
+//	Empty list if the function is nil
+func ZipWithTeacherStrInt(f func(Teacher, string) int, list1 []Teacher, list2 []string) []int {
+	if f == nil {
 		return []int{}
 	}
-	var newList []int
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
-	}
-	return newList
-}
 
-// MapTeacherStr takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapTeacherStr(f func(Teacher) string, list []Teacher) []string {
-	if f == nil {
-		return []string{}
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
-	newList := make([]string, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapTeacherStr applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithTeacherStrStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: Teacher output type: string
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: Teacher, string and returns type: string
+//	2. List of type Teacher
+//	3. List of type string
 //
 // Returns
 //	New List of type string
-//	Empty list if all arguments are nil or either one is nil
-func PMapTeacherStr(f func(Teacher) string, list []Teacher) []string {
+//	Empty list if the function is nil
+func ZipWithTeacherStrStr(f func(Teacher, string) string, list1 []Teacher, list2 []string) []string {
 	if f == nil {
 		return []string{}
 	}
 
-	ch := make(chan map[int]string)
-	var wg sync.WaitGroup
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	for i, v := range list {
-		wg.Add(1)
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]string, i int, v Teacher) {
-			defer wg.Done()
-			ch <- map[int]string{i: f(v)}
-		}(&wg, ch, i, v)
+// MergeIntEmployee takes two or more inputs of type map[int]Employee and merge them and returns a new map[int]Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntEmployee(map1, map2 map[int]Employee, maps ...map[int]Employee) map[int]Employee {
+	newMap := make(map[int]Employee, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	newList := make([]string, len(list))
-	for m := range ch {
+	for _, m := range maps {
 		for k, v := range m {
-			newList[k] = v
+			newMap[k] = v
 		}
 	}
-	return newList
+
+	return newMap
 }
 
-// FilterMapTeacherStr filters given list, then apply function(2nd argument) on each item in the list and returns a new list
-// Takes 3 inputs
-//	1. Function: takes one input type - Teacher and returns true/false.
-//	2. Function: takes Teacher as argument and returns string
-// 	3. List of type Teacher
+// MergeWithIntEmployee merges maps of type map[int]Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
 //
-// Returns:
-//	New List of type string
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapTeacherStr(fFilter func(Teacher) bool, fMap func(Teacher) string, list []Teacher) []string {
-	if fFilter == nil || fMap == nil {
-		return []string{}
-	}
-	var newList []string
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntEmployee(f func(Employee, Employee) Employee, maps ...map[int]Employee) map[int]Employee {
+	newMap := make(map[int]Employee)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
 		}
 	}
-	return newList
+
+	return newMap
 }
 
-// MapIntEmployee takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapIntEmployee(f func(int) Employee, list []int) []Employee {
-	if f == nil {
-		return []Employee{}
+// KeysIntEmployee returns list of keys of the map[int]Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntEmployee(m map[int]Employee, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
-	newList := make([]Employee, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
 	}
-	return newList
+	return keys
 }
 
-// PMapIntEmployee applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
-//
-// Takes 2 inputs
-//	1. Function - takes 1 input type: int output type: Employee
-//	2. List
-//
-// Returns
-//	New List of type Employee
-//	Empty list if all arguments are nil or either one is nil
-func PMapIntEmployee(f func(int) Employee, list []int) []Employee {
-	if f == nil {
-		return []Employee{}
+// ValsIntEmployee returns list of values of the map[int]Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntEmployee(m map[int]Employee, less ...func(Employee, Employee) bool) []Employee {
+	vals := make([]Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
 	}
-
-	ch := make(chan map[int]Employee)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v int) {
-			defer wg.Done()
-			ch <- map[int]Employee{i: f(v)}
-		}(&wg, ch, i, v)
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
 	}
+	return vals
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
+// SelectKeysIntEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysIntEmployee(m map[int]Employee, keys ...int) map[int]Employee {
+	newMap := make(map[int]Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
 		}
 	}
-	return newList
+	return newMap
 }
 
-// FilterMapIntEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
-// Takes 3 inputs
-//	1. Function: takes one input type - int and returns true/false.
-//	2. Function: takes int as argument and returns Employee
-// 	3. List of type int
-//
-// Returns:
-//	New List of type Employee
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapIntEmployee(fFilter func(int) bool, fMap func(int) Employee, list []int) []Employee {
-	if fFilter == nil || fMap == nil {
-		return []Employee{}
+// DissocKeysIntEmployee returns a new map without the given keys.
+func DissocKeysIntEmployee(m map[int]Employee, keys ...int) map[int]Employee {
+	newMap := make(map[int]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = v
 	}
-	var newList []Employee
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+	for _, k := range keys {
+		delete(newMap, k)
 	}
-	return newList
+	return newMap
 }
 
-// MapIntTeacher takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapIntTeacher(f func(int) Teacher, list []int) []Teacher {
+// InvertIntEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntEmployee(m map[int]Employee) map[Employee]int {
+	newMap := make(map[Employee]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsIntEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntEmployee(f func(Employee) Employee, m map[int]Employee) map[int]Employee {
 	if f == nil {
-		return []Teacher{}
+		return map[int]Employee{}
 	}
-	newList := make([]Teacher, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+	newMap := make(map[int]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
-	return newList
+	return newMap
 }
 
-// PMapIntTeacher applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
-//
-// Takes 2 inputs
-//	1. Function - takes 1 input type: int output type: Teacher
-//	2. List
-//
-// Returns
-//	New List of type Teacher
-//	Empty list if all arguments are nil or either one is nil
-func PMapIntTeacher(f func(int) Teacher, list []int) []Teacher {
+// MapKeysIntEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntEmployee(f func(int) int, m map[int]Employee) map[int]Employee {
 	if f == nil {
-		return []Teacher{}
+		return map[int]Employee{}
 	}
+	newMap := make(map[int]Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
 
-	ch := make(chan map[int]Teacher)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v int) {
-			defer wg.Done()
-			ch <- map[int]Teacher{i: f(v)}
-		}(&wg, ch, i, v)
+// FilterKeysIntEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntEmployee(pred func(int) bool, m map[int]Employee) map[int]Employee {
+	if pred == nil {
+		return map[int]Employee{}
 	}
+	return FilterKVIntEmployee(func(k int, _ Employee) bool { return pred(k) }, m)
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+// FilterValsIntEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntEmployee(pred func(Employee) bool, m map[int]Employee) map[int]Employee {
+	if pred == nil {
+		return map[int]Employee{}
+	}
+	return FilterKVIntEmployee(func(_ int, v Employee) bool { return pred(v) }, m)
+}
 
-	newList := make([]Teacher, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
+// FilterKVIntEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntEmployee(pred func(int, Employee) bool, m map[int]Employee) map[int]Employee {
+	newMap := make(map[int]Employee)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
 		}
 	}
-	return newList
+	return newMap
 }
 
-// FilterMapIntTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ReduceKVIntEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - int and returns true/false.
-//	2. Function: takes int as argument and returns Teacher
-// 	3. List of type int
+//	1. Function - takes 3 inputs: Employee, int, Employee and returns Employee
+//	2. Map
+//	3. Initial value
 //
-// Returns:
-//	New List of type Teacher
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapIntTeacher(fFilter func(int) bool, fMap func(int) Teacher, list []int) []Teacher {
-	if fFilter == nil || fMap == nil {
-		return []Teacher{}
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntEmployee(f func(Employee, int, Employee) Employee, m map[int]Employee, initializer Employee) Employee {
+	if f == nil {
+		return initializer
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-	return newList
+	return result
 }
 
-// MapStrEmployee takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapStrEmployee(f func(string) Employee, list []string) []Employee {
-	if f == nil {
-		return []Employee{}
+// ZipIntEmployee takes two inputs: first list of type: []int, second list of type: []Employee.
+// Then it merges two list and returns a new map of type: map[int]Employee
+func ZipIntEmployee(list1 []int, list2 []Employee) map[int]Employee {
+	newMap := make(map[int]Employee)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
 	}
-	newList := make([]Employee, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
 	}
-	return newList
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
 }
 
-// PMapStrEmployee applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// DistinctByIntEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
 //
 // Takes 2 inputs
-//	1. Function - takes 1 input type: string output type: Employee
+//	1. Function - takes 1 input type: int and returns key of type: Employee
 //	2. List
 //
 // Returns
-//	New List of type Employee
-//	Empty list if all arguments are nil or either one is nil
-func PMapStrEmployee(f func(string) Employee, list []string) []Employee {
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntEmployee(f func(int) Employee, list []int) []int {
 	if f == nil {
-		return []Employee{}
+		return []int{}
 	}
 
-	ch := make(chan map[int]Employee)
-	var wg sync.WaitGroup
+	newList := make([]int, 0, len(list))
+	s := make(map[Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairIntEmployee holds two values: First of type int and Second of type Employee.
+type PairIntEmployee struct {
+	First  int
+	Second Employee
+}
 
-	for i, v := range list {
-		wg.Add(1)
+// ZipPairsIntEmployee takes two inputs: first list of type: []int, second list of type: []Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntEmployee(list1 []int, list2 []Employee) []PairIntEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]Employee, i int, v string) {
-			defer wg.Done()
-			ch <- map[int]Employee{i: f(v)}
-		}(&wg, ch, i, v)
+	newList := make([]PairIntEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntEmployee{First: list1[i], Second: list2[i]}
 	}
+	return newList
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+// UnzipIntEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntEmployee
+func UnzipIntEmployee(pairs []PairIntEmployee) ([]int, []Employee) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
 
-	newList := make([]Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+func ZipIndexEmployee(list []Employee) []PairIntEmployee {
+	newList := make([]PairIntEmployee, len(list))
+	for i, v := range list {
+		newList[i] = PairIntEmployee{First: i, Second: v}
 	}
 	return newList
 }
 
-// FilterMapStrEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithIntEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - string and returns true/false.
-//	2. Function: takes string as argument and returns Employee
-// 	3. List of type string
+//	1. Function - takes 2 inputs type: int, Employee and returns type: Employee
+//	2. List of type int
+//	3. List of type Employee
 //
-// Returns:
+// Returns
 //	New List of type Employee
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapStrEmployee(fFilter func(string) bool, fMap func(string) Employee, list []string) []Employee {
-	if fFilter == nil || fMap == nil {
+//	Empty list if the function is nil
+func ZipWithIntEmployeeEmployee(f func(int, Employee) Employee, list1 []int, list2 []Employee) []Employee {
+	if f == nil {
 		return []Employee{}
 	}
-	var newList []Employee
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
-	}
-	return newList
-}
 
-// MapStrTeacher takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapStrTeacher(f func(string) Teacher, list []string) []Teacher {
-	if f == nil {
-		return []Teacher{}
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
-	newList := make([]Teacher, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapStrTeacher applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithIntEmployeeTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: string output type: Teacher
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Employee and returns type: Teacher
+//	2. List of type int
+//	3. List of type Employee
 //
 // Returns
 //	New List of type Teacher
-//	Empty list if all arguments are nil or either one is nil
-func PMapStrTeacher(f func(string) Teacher, list []string) []Teacher {
+//	Empty list if the function is nil
+func ZipWithIntEmployeeTeacher(f func(int, Employee) Teacher, list1 []int, list2 []Employee) []Teacher {
 	if f == nil {
 		return []Teacher{}
 	}
 
-	ch := make(chan map[int]Teacher)
-	var wg sync.WaitGroup
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	for i, v := range list {
-		wg.Add(1)
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]Teacher, i int, v string) {
-			defer wg.Done()
-			ch <- map[int]Teacher{i: f(v)}
-		}(&wg, ch, i, v)
+// ZipWithIntEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Employee and returns type: int
+//	2. List of type int
+//	3. List of type Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithIntEmployeeInt(f func(int, Employee) int, list1 []int, list2 []Employee) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	newList := make([]Teacher, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// FilterMapStrTeacher filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithIntEmployeeStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - string and returns true/false.
-//	2. Function: takes string as argument and returns Teacher
-// 	3. List of type string
+//	1. Function - takes 2 inputs type: int, Employee and returns type: string
+//	2. List of type int
+//	3. List of type Employee
 //
-// Returns:
-//	New List of type Teacher
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapStrTeacher(fFilter func(string) bool, fMap func(string) Teacher, list []string) []Teacher {
-	if fFilter == nil || fMap == nil {
-		return []Teacher{}
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithIntEmployeeStr(f func(int, Employee) string, list1 []int, list2 []Employee) []string {
+	if f == nil {
+		return []string{}
 	}
-	var newList []Teacher
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
+// MergeIntTeacher takes two or more inputs of type map[int]Teacher and merge them and returns a new map[int]Teacher.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntTeacher(map1, map2 map[int]Teacher, maps ...map[int]Teacher) map[int]Teacher {
+	newMap := make(map[int]Teacher, len(map1)+len(map2))
 
-// Merge takes two inputs: map[Employee]Employee and map[Employee]Employee and merge two maps and returns a new map[Employee]Employee.
-func Merge(map1, map2 map[Employee]Employee) map[Employee]Employee {
-	if map1 == nil && map2 == nil {
-		return map[Employee]Employee{}
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	newMap := make(map[Employee]Employee)
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	return newMap
+}
+
+// MergeWithIntTeacher merges maps of type map[int]Teacher and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntTeacher(f func(Teacher, Teacher) Teacher, maps ...map[int]Teacher) map[int]Teacher {
+	newMap := make(map[int]Teacher)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysIntTeacher returns list of keys of the map[int]Teacher.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntTeacher(m map[int]Teacher, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsIntTeacher returns list of values of the map[int]Teacher.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntTeacher(m map[int]Teacher, less ...func(Teacher, Teacher) bool) []Teacher {
+	vals := make([]Teacher, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysIntTeacher returns a new map which contains only the given keys of the map.
+func SelectKeysIntTeacher(m map[int]Teacher, keys ...int) map[int]Teacher {
+	newMap := make(map[int]Teacher, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
+// DissocKeysIntTeacher returns a new map without the given keys.
+func DissocKeysIntTeacher(m map[int]Teacher, keys ...int) map[int]Teacher {
+	newMap := make(map[int]Teacher, len(m))
+	for k, v := range m {
 		newMap[k] = v
 	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertIntTeacher returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntTeacher(m map[int]Teacher) map[Teacher]int {
+	newMap := make(map[Teacher]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
 
-	for k, v := range map2 {
-		newMap[k] = v
+// MapValsIntTeacher applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntTeacher(f func(Teacher) Teacher, m map[int]Teacher) map[int]Teacher {
+	if f == nil {
+		return map[int]Teacher{}
+	}
+	newMap := make(map[int]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
+	return newMap
+}
 
+// MapKeysIntTeacher applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntTeacher(f func(int) int, m map[int]Teacher) map[int]Teacher {
+	if f == nil {
+		return map[int]Teacher{}
+	}
+	newMap := make(map[int]Teacher, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
 	return newMap
 }
 
-// Zip takes two inputs: first list of type: []Employee, second list of type: []Employee.
-// Then it merges two list and returns a new map of type: map[Employee]Employee
-func Zip(list1 []Employee, list2 []Employee) map[Employee]Employee {
-	newMap := make(map[Employee]Employee)
+// FilterKeysIntTeacher returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntTeacher(pred func(int) bool, m map[int]Teacher) map[int]Teacher {
+	if pred == nil {
+		return map[int]Teacher{}
+	}
+	return FilterKVIntTeacher(func(k int, _ Teacher) bool { return pred(k) }, m)
+}
+
+// FilterValsIntTeacher returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntTeacher(pred func(Teacher) bool, m map[int]Teacher) map[int]Teacher {
+	if pred == nil {
+		return map[int]Teacher{}
+	}
+	return FilterKVIntTeacher(func(_ int, v Teacher) bool { return pred(v) }, m)
+}
+
+// FilterKVIntTeacher returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntTeacher(pred func(int, Teacher) bool, m map[int]Teacher) map[int]Teacher {
+	newMap := make(map[int]Teacher)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVIntTeacher reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Teacher, int, Teacher and returns Teacher
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntTeacher(f func(Teacher, int, Teacher) Teacher, m map[int]Teacher, initializer Teacher) Teacher {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipIntTeacher takes two inputs: first list of type: []int, second list of type: []Teacher.
+// Then it merges two list and returns a new map of type: map[int]Teacher
+func ZipIntTeacher(list1 []int, list2 []Teacher) map[int]Teacher {
+	newMap := make(map[int]Teacher)
 
 	len1 := len(list1)
 	len2 := len(list2)
@@ -1219,84 +8657,193 @@ func Zip(list1 []Employee, list2 []Employee) map[Employee]Employee {
 	return newMap
 }
 
-// MergeEmployeeTeacher takes two inputs: map[Employee]Teacher and map[Employee]Teacher and merge two maps and returns a new map[Employee]Teacher.
-func MergeEmployeeTeacher(map1, map2 map[Employee]Teacher) map[Employee]Teacher {
-	if map1 == nil && map2 == nil {
-		return map[Employee]Teacher{}
+// DistinctByIntTeacher removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntTeacher(f func(int) Teacher, list []int) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	newMap := make(map[Employee]Teacher)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
+	newList := make([]int, 0, len(list))
+	s := make(map[Teacher]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
-		return newMap
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+// PairIntTeacher holds two values: First of type int and Second of type Teacher.
+type PairIntTeacher struct {
+	First  int
+	Second Teacher
+}
+
+// ZipPairsIntTeacher takes two inputs: first list of type: []int, second list of type: []Teacher.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntTeacher, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntTeacher(list1 []int, list2 []Teacher) []PairIntTeacher {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairIntTeacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntTeacher{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipIntTeacher takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntTeacher
+func UnzipIntTeacher(pairs []PairIntTeacher) ([]int, []Teacher) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]Teacher, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+func ZipIndexTeacher(list []Teacher) []PairIntTeacher {
+	newList := make([]PairIntTeacher, len(list))
+	for i, v := range list {
+		newList[i] = PairIntTeacher{First: i, Second: v}
+	}
+	return newList
+}
+
+// ZipWithIntTeacherEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Teacher and returns type: Employee
+//	2. List of type int
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithIntTeacherEmployee(f func(int, Teacher) Employee, list1 []int, list2 []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithIntTeacherTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Teacher and returns type: Teacher
+//	2. List of type int
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithIntTeacherTeacher(f func(int, Teacher) Teacher, list1 []int, list2 []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
 	}
 
-	for k, v := range map1 {
-		newMap[k] = v
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// ZipEmployeeTeacher takes two inputs: first list of type: []Employee, second list of type: []Teacher.
-// Then it merges two list and returns a new map of type: map[Employee]Teacher
-func ZipEmployeeTeacher(list1 []Employee, list2 []Teacher) map[Employee]Teacher {
-	newMap := make(map[Employee]Teacher)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithIntTeacherInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Teacher and returns type: int
+//	2. List of type int
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithIntTeacherInt(f func(int, Teacher) int, list1 []int, list2 []Teacher) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]int, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeEmployeeInt takes two inputs: map[Employee]int and map[Employee]int and merge two maps and returns a new map[Employee]int.
-func MergeEmployeeInt(map1, map2 map[Employee]int) map[Employee]int {
-	if map1 == nil && map2 == nil {
-		return map[Employee]int{}
+// ZipWithIntTeacherStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, Teacher and returns type: string
+//	2. List of type int
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithIntTeacherStr(f func(int, Teacher) string, list1 []int, list2 []Teacher) []string {
+	if f == nil {
+		return []string{}
 	}
 
-	newMap := make(map[Employee]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
+	return newList
+}
+
+// MergeStrEmployee takes two or more inputs of type map[string]Employee and merge them and returns a new map[string]Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrEmployee(map1, map2 map[string]Employee, maps ...map[string]Employee) map[string]Employee {
+	newMap := make(map[string]Employee, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -1306,127 +8853,183 @@ func MergeEmployeeInt(map1, map2 map[Employee]int) map[Employee]int {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// ZipEmployeeInt takes two inputs: first list of type: []Employee, second list of type: []int.
-// Then it merges two list and returns a new map of type: map[Employee]int
-func ZipEmployeeInt(list1 []Employee, list2 []int) map[Employee]int {
-	newMap := make(map[Employee]int)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
-	}
-
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
-	}
+// MergeWithStrEmployee merges maps of type map[string]Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrEmployee(f func(Employee, Employee) Employee, maps ...map[string]Employee) map[string]Employee {
+	newMap := make(map[string]Employee)
 
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
 	}
 
 	return newMap
 }
 
-// MergeEmployeeStr takes two inputs: map[Employee]string and map[Employee]string and merge two maps and returns a new map[Employee]string.
-func MergeEmployeeStr(map1, map2 map[Employee]string) map[Employee]string {
-	if map1 == nil && map2 == nil {
-		return map[Employee]string{}
+// KeysStrEmployee returns list of keys of the map[string]Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrEmployee(m map[string]Employee, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
 
-	newMap := make(map[Employee]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+// ValsStrEmployee returns list of values of the map[string]Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrEmployee(m map[string]Employee, less ...func(Employee, Employee) bool) []Employee {
+	vals := make([]Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
 	}
+	return vals
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
+// SelectKeysStrEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysStrEmployee(m map[string]Employee, keys ...string) map[string]Employee {
+	newMap := make(map[string]Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
+// DissocKeysStrEmployee returns a new map without the given keys.
+func DissocKeysStrEmployee(m map[string]Employee, keys ...string) map[string]Employee {
+	newMap := make(map[string]Employee, len(m))
+	for k, v := range m {
 		newMap[k] = v
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	for _, k := range keys {
+		delete(newMap, k)
 	}
-
 	return newMap
 }
 
-// ZipEmployeeStr takes two inputs: first list of type: []Employee, second list of type: []string.
-// Then it merges two list and returns a new map of type: map[Employee]string
-func ZipEmployeeStr(list1 []Employee, list2 []string) map[Employee]string {
-	newMap := make(map[Employee]string)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// InvertStrEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrEmployee(m map[string]Employee) map[Employee]string {
+	newMap := make(map[Employee]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
 	}
+	return newMap
+}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+// MapValsStrEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrEmployee(f func(Employee) Employee, m map[string]Employee) map[string]Employee {
+	if f == nil {
+		return map[string]Employee{}
 	}
-
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	newMap := make(map[string]Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
+	return newMap
+}
 
+// MapKeysStrEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrEmployee(f func(string) string, m map[string]Employee) map[string]Employee {
+	if f == nil {
+		return map[string]Employee{}
+	}
+	newMap := make(map[string]Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
 	return newMap
 }
 
-// MergeTeacherEmployee takes two inputs: map[Teacher]Employee and map[Teacher]Employee and merge two maps and returns a new map[Teacher]Employee.
-func MergeTeacherEmployee(map1, map2 map[Teacher]Employee) map[Teacher]Employee {
-	if map1 == nil && map2 == nil {
-		return map[Teacher]Employee{}
+// FilterKeysStrEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrEmployee(pred func(string) bool, m map[string]Employee) map[string]Employee {
+	if pred == nil {
+		return map[string]Employee{}
 	}
+	return FilterKVStrEmployee(func(k string, _ Employee) bool { return pred(k) }, m)
+}
 
-	newMap := make(map[Teacher]Employee)
+// FilterValsStrEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrEmployee(pred func(Employee) bool, m map[string]Employee) map[string]Employee {
+	if pred == nil {
+		return map[string]Employee{}
+	}
+	return FilterKVStrEmployee(func(_ string, v Employee) bool { return pred(v) }, m)
+}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
+// FilterKVStrEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrEmployee(pred func(string, Employee) bool, m map[string]Employee) map[string]Employee {
+	newMap := make(map[string]Employee)
+	if pred == nil {
 		return newMap
 	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for k, v := range m {
+		if pred(k, v) {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ReduceKVStrEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Employee, string, Employee and returns Employee
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrEmployee(f func(Employee, string, Employee) Employee, m map[string]Employee, initializer Employee) Employee {
+	if f == nil {
+		return initializer
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-
-	return newMap
+	return result
 }
 
-// ZipTeacherEmployee takes two inputs: first list of type: []Teacher, second list of type: []Employee.
-// Then it merges two list and returns a new map of type: map[Teacher]Employee
-func ZipTeacherEmployee(list1 []Teacher, list2 []Employee) map[Teacher]Employee {
-	newMap := make(map[Teacher]Employee)
+// ZipStrEmployee takes two inputs: first list of type: []string, second list of type: []Employee.
+// Then it merges two list and returns a new map of type: map[string]Employee
+func ZipStrEmployee(list1 []string, list2 []Employee) map[string]Employee {
+	newMap := make(map[string]Employee)
 
 	len1 := len(list1)
 	len2 := len(list2)
@@ -1447,141 +9050,185 @@ func ZipTeacherEmployee(list1 []Teacher, list2 []Employee) map[Teacher]Employee
 	return newMap
 }
 
-// MergeTeacher takes two inputs: map[Teacher]Teacher and map[Teacher]Teacher and merge two maps and returns a new map[Teacher]Teacher.
-func MergeTeacher(map1, map2 map[Teacher]Teacher) map[Teacher]Teacher {
-	if map1 == nil && map2 == nil {
-		return map[Teacher]Teacher{}
+// DistinctByStrEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: Employee
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrEmployee(f func(string) Employee, list []string) []string {
+	if f == nil {
+		return []string{}
 	}
 
-	newMap := make(map[Teacher]Teacher)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
+	newList := make([]string, 0, len(list))
+	s := make(map[Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
-		return newMap
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// PairStrEmployee holds two values: First of type string and Second of type Employee.
+type PairStrEmployee struct {
+	First  string
+	Second Employee
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ZipPairsStrEmployee takes two inputs: first list of type: []string, second list of type: []Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrEmployee(list1 []string, list2 []Employee) []PairStrEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	newList := make([]PairStrEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrEmployee{First: list1[i], Second: list2[i]}
 	}
-
-	return newMap
+	return newList
 }
 
-// ZipTeacher takes two inputs: first list of type: []Teacher, second list of type: []Teacher.
-// Then it merges two list and returns a new map of type: map[Teacher]Teacher
-func ZipTeacher(list1 []Teacher, list2 []Teacher) map[Teacher]Teacher {
-	newMap := make(map[Teacher]Teacher)
-
-	len1 := len(list1)
-	len2 := len(list2)
+// UnzipStrEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrEmployee
+func UnzipStrEmployee(pairs []PairStrEmployee) ([]string, []Employee) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
 
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithStrEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Employee and returns type: Employee
+//	2. List of type string
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithStrEmployeeEmployee(f func(string, Employee) Employee, list1 []string, list2 []Employee) []Employee {
+	if f == nil {
+		return []Employee{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]Employee, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeTeacherInt takes two inputs: map[Teacher]int and map[Teacher]int and merge two maps and returns a new map[Teacher]int.
-func MergeTeacherInt(map1, map2 map[Teacher]int) map[Teacher]int {
-	if map1 == nil && map2 == nil {
-		return map[Teacher]int{}
-	}
-
-	newMap := make(map[Teacher]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
-	}
-
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+// ZipWithStrEmployeeTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Employee and returns type: Teacher
+//	2. List of type string
+//	3. List of type Employee
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithStrEmployeeTeacher(f func(string, Employee) Teacher, list1 []string, list2 []Employee) []Teacher {
+	if f == nil {
+		return []Teacher{}
 	}
 
-	for k, v := range map1 {
-		newMap[k] = v
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// ZipTeacherInt takes two inputs: first list of type: []Teacher, second list of type: []int.
-// Then it merges two list and returns a new map of type: map[Teacher]int
-func ZipTeacherInt(list1 []Teacher, list2 []int) map[Teacher]int {
-	newMap := make(map[Teacher]int)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithStrEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Employee and returns type: int
+//	2. List of type string
+//	3. List of type Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithStrEmployeeInt(f func(string, Employee) int, list1 []string, list2 []Employee) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]int, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeTeacherStr takes two inputs: map[Teacher]string and map[Teacher]string and merge two maps and returns a new map[Teacher]string.
-func MergeTeacherStr(map1, map2 map[Teacher]string) map[Teacher]string {
-	if map1 == nil && map2 == nil {
-		return map[Teacher]string{}
+// ZipWithStrEmployeeStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Employee and returns type: string
+//	2. List of type string
+//	3. List of type Employee
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithStrEmployeeStr(f func(string, Employee) string, list1 []string, list2 []Employee) []string {
+	if f == nil {
+		return []string{}
 	}
 
-	newMap := make(map[Teacher]string)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+	newList := make([]string, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
+	return newList
+}
+
+// MergeStrTeacher takes two or more inputs of type map[string]Teacher and merge them and returns a new map[string]Teacher.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeStrTeacher(map1, map2 map[string]Teacher, maps ...map[string]Teacher) map[string]Teacher {
+	newMap := make(map[string]Teacher, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -1591,127 +9238,183 @@ func MergeTeacherStr(map1, map2 map[Teacher]string) map[Teacher]string {
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// ZipTeacherStr takes two inputs: first list of type: []Teacher, second list of type: []string.
-// Then it merges two list and returns a new map of type: map[Teacher]string
-func ZipTeacherStr(list1 []Teacher, list2 []string) map[Teacher]string {
-	newMap := make(map[Teacher]string)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
-	}
-
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
-	}
+// MergeWithStrTeacher merges maps of type map[string]Teacher and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithStrTeacher(f func(Teacher, Teacher) Teacher, maps ...map[string]Teacher) map[string]Teacher {
+	newMap := make(map[string]Teacher)
 
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
 	}
 
 	return newMap
 }
 
-// MergeIntEmployee takes two inputs: map[int]Employee and map[int]Employee and merge two maps and returns a new map[int]Employee.
-func MergeIntEmployee(map1, map2 map[int]Employee) map[int]Employee {
-	if map1 == nil && map2 == nil {
-		return map[int]Employee{}
+// KeysStrTeacher returns list of keys of the map[string]Teacher.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysStrTeacher(m map[string]Teacher, less ...func(string, string) bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
 
-	newMap := make(map[int]Employee)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+// ValsStrTeacher returns list of values of the map[string]Teacher.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsStrTeacher(m map[string]Teacher, less ...func(Teacher, Teacher) bool) []Teacher {
+	vals := make([]Teacher, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
 	}
+	return vals
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
+// SelectKeysStrTeacher returns a new map which contains only the given keys of the map.
+func SelectKeysStrTeacher(m map[string]Teacher, keys ...string) map[string]Teacher {
+	newMap := make(map[string]Teacher, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
+// DissocKeysStrTeacher returns a new map without the given keys.
+func DissocKeysStrTeacher(m map[string]Teacher, keys ...string) map[string]Teacher {
+	newMap := make(map[string]Teacher, len(m))
+	for k, v := range m {
 		newMap[k] = v
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	for _, k := range keys {
+		delete(newMap, k)
 	}
-
 	return newMap
 }
 
-// ZipIntEmployee takes two inputs: first list of type: []int, second list of type: []Employee.
-// Then it merges two list and returns a new map of type: map[int]Employee
-func ZipIntEmployee(list1 []int, list2 []Employee) map[int]Employee {
-	newMap := make(map[int]Employee)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// InvertStrTeacher returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertStrTeacher(m map[string]Teacher) map[Teacher]string {
+	newMap := make(map[Teacher]string, len(m))
+	for k, v := range m {
+		newMap[v] = k
 	}
+	return newMap
+}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+// MapValsStrTeacher applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsStrTeacher(f func(Teacher) Teacher, m map[string]Teacher) map[string]Teacher {
+	if f == nil {
+		return map[string]Teacher{}
 	}
-
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	newMap := make(map[string]Teacher, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
+	return newMap
+}
 
+// MapKeysStrTeacher applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysStrTeacher(f func(string) string, m map[string]Teacher) map[string]Teacher {
+	if f == nil {
+		return map[string]Teacher{}
+	}
+	newMap := make(map[string]Teacher, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
 	return newMap
 }
 
-// MergeIntTeacher takes two inputs: map[int]Teacher and map[int]Teacher and merge two maps and returns a new map[int]Teacher.
-func MergeIntTeacher(map1, map2 map[int]Teacher) map[int]Teacher {
-	if map1 == nil && map2 == nil {
-		return map[int]Teacher{}
+// FilterKeysStrTeacher returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysStrTeacher(pred func(string) bool, m map[string]Teacher) map[string]Teacher {
+	if pred == nil {
+		return map[string]Teacher{}
 	}
+	return FilterKVStrTeacher(func(k string, _ Teacher) bool { return pred(k) }, m)
+}
 
-	newMap := make(map[int]Teacher)
+// FilterValsStrTeacher returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsStrTeacher(pred func(Teacher) bool, m map[string]Teacher) map[string]Teacher {
+	if pred == nil {
+		return map[string]Teacher{}
+	}
+	return FilterKVStrTeacher(func(_ string, v Teacher) bool { return pred(v) }, m)
+}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
+// FilterKVStrTeacher returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVStrTeacher(pred func(string, Teacher) bool, m map[string]Teacher) map[string]Teacher {
+	newMap := make(map[string]Teacher)
+	if pred == nil {
 		return newMap
 	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for k, v := range m {
+		if pred(k, v) {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ReduceKVStrTeacher reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: Teacher, string, Teacher and returns Teacher
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVStrTeacher(f func(Teacher, string, Teacher) Teacher, m map[string]Teacher, initializer Teacher) Teacher {
+	if f == nil {
+		return initializer
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-
-	return newMap
+	return result
 }
 
-// ZipIntTeacher takes two inputs: first list of type: []int, second list of type: []Teacher.
-// Then it merges two list and returns a new map of type: map[int]Teacher
-func ZipIntTeacher(list1 []int, list2 []Teacher) map[int]Teacher {
-	newMap := make(map[int]Teacher)
+// ZipStrTeacher takes two inputs: first list of type: []string, second list of type: []Teacher.
+// Then it merges two list and returns a new map of type: map[string]Teacher
+func ZipStrTeacher(list1 []string, list2 []Teacher) map[string]Teacher {
+	newMap := make(map[string]Teacher)
 
 	len1 := len(list1)
 	len2 := len(list2)
@@ -1732,116 +9435,177 @@ func ZipIntTeacher(list1 []int, list2 []Teacher) map[int]Teacher {
 	return newMap
 }
 
-// MergeStrEmployee takes two inputs: map[string]Employee and map[string]Employee and merge two maps and returns a new map[string]Employee.
-func MergeStrEmployee(map1, map2 map[string]Employee) map[string]Employee {
-	if map1 == nil && map2 == nil {
-		return map[string]Employee{}
+// DistinctByStrTeacher removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: string and returns key of type: Teacher
+//	2. List
+//
+// Returns
+//	New List of type string
+//	Empty list if either of arguments is nil
+func DistinctByStrTeacher(f func(string) Teacher, list []string) []string {
+	if f == nil {
+		return []string{}
 	}
 
-	newMap := make(map[string]Employee)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
+	newList := make([]string, 0, len(list))
+	s := make(map[Teacher]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
-		return newMap
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// PairStrTeacher holds two values: First of type string and Second of type Teacher.
+type PairStrTeacher struct {
+	First  string
+	Second Teacher
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ZipPairsStrTeacher takes two inputs: first list of type: []string, second list of type: []Teacher.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipStrTeacher, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsStrTeacher(list1 []string, list2 []Teacher) []PairStrTeacher {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	newList := make([]PairStrTeacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairStrTeacher{First: list1[i], Second: list2[i]}
 	}
-
-	return newMap
+	return newList
 }
 
-// ZipStrEmployee takes two inputs: first list of type: []string, second list of type: []Employee.
-// Then it merges two list and returns a new map of type: map[string]Employee
-func ZipStrEmployee(list1 []string, list2 []Employee) map[string]Employee {
-	newMap := make(map[string]Employee)
-
-	len1 := len(list1)
-	len2 := len(list2)
+// UnzipStrTeacher takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsStrTeacher
+func UnzipStrTeacher(pairs []PairStrTeacher) ([]string, []Teacher) {
+	list1 := make([]string, len(pairs))
+	list2 := make([]Teacher, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
 
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithStrTeacherEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Teacher and returns type: Employee
+//	2. List of type string
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Employee
+//	Empty list if the function is nil
+func ZipWithStrTeacherEmployee(f func(string, Teacher) Employee, list1 []string, list2 []Teacher) []Employee {
+	if f == nil {
+		return []Employee{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]Employee, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeStrTeacher takes two inputs: map[string]Teacher and map[string]Teacher and merge two maps and returns a new map[string]Teacher.
-func MergeStrTeacher(map1, map2 map[string]Teacher) map[string]Teacher {
-	if map1 == nil && map2 == nil {
-		return map[string]Teacher{}
+// ZipWithStrTeacherTeacher applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Teacher and returns type: Teacher
+//	2. List of type string
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type Teacher
+//	Empty list if the function is nil
+func ZipWithStrTeacherTeacher(f func(string, Teacher) Teacher, list1 []string, list2 []Teacher) []Teacher {
+	if f == nil {
+		return []Teacher{}
 	}
 
-	newMap := make(map[string]Teacher)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+	newList := make([]Teacher, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
+	return newList
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ZipWithStrTeacherInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Teacher and returns type: int
+//	2. List of type string
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithStrTeacherInt(f func(string, Teacher) int, list1 []string, list2 []Teacher) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	return newMap
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
 }
 
-// ZipStrTeacher takes two inputs: first list of type: []string, second list of type: []Teacher.
-// Then it merges two list and returns a new map of type: map[string]Teacher
-func ZipStrTeacher(list1 []string, list2 []Teacher) map[string]Teacher {
-	newMap := make(map[string]Teacher)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithStrTeacherStr applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: string, Teacher and returns type: string
+//	2. List of type string
+//	3. List of type Teacher
+//
+// Returns
+//	New List of type string
+//	Empty list if the function is nil
+func ZipWithStrTeacherStr(f func(string, Teacher) string, list1 []string, list2 []Teacher) []string {
+	if f == nil {
+		return []string{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]string, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
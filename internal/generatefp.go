@@ -13,6 +13,7 @@ type fpCode struct {
 	codeTemplate     string
 	testTemplate     string
 	testTemplateBool string
+	testTemplateStr  string
 
 	// Test template for different combination of data type - map, filter,
 	testTemplateIONumber     string
@@ -33,6 +34,12 @@ type fpCode struct {
 	testTemplateBoolBool   string
 	testTemplateStrStr     string
 
+	// Import statements for generated file
+	importTemplate string
+
+	// Import statements for generated test file. Default is importTestTemplate
+	importTestTemplate string
+
 	dataTypes             []string
 	generatedFileName     string
 	generatedTestFileName string
@@ -68,6 +75,204 @@ var fpCodeList = []fpCode{
 		generatedTestFileName:  "merge_test.go",
 	},
 
+	fpCode{
+		function:          "MergeWith",
+		codeTemplate:      basic.MergeWith(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "mergewith.go",
+
+		testTemplate:           basic.MergeWithTest(),
+		testTemplateNumberStr:  basic.MergeWithTestNumberToStr(),
+		testTemplateStrNumber:  basic.MergeWithTestStrToNumber(),
+		testTemplateStrBool:    basic.MergeWithTestStrToBool(),
+		testTemplateBoolStr:    basic.MergeWithTestBoolToStr(),
+		testTemplateNumberBool: basic.MergeWithTestNumberToBool(),
+		testTemplateBoolNumber: basic.MergeWithTestBoolToNumber(),
+		testTemplateBoolBool:   basic.MergeWithTestBoolToBool(),
+		testTemplateStrStr:     basic.MergeWithTestStrToStr(),
+		generatedTestFileName:  "mergewith_test.go",
+	},
+
+	fpCode{
+		function:          "Queue",
+		codeTemplate:      basic.Queue(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "queue.go",
+
+		testTemplate:          basic.QueueTest(),
+		testTemplateBool:      basic.QueueBoolTest(),
+		testTemplateStr:       basic.QueueStrTest(),
+		generatedTestFileName: "queue_test.go",
+	},
+
+	fpCode{
+		function:          "Atom",
+		codeTemplate:      basic.Atom(),
+		importTemplate:    "\n\n" + `import "sync"`,
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "atom.go",
+
+		testTemplate:          basic.AtomTest(),
+		testTemplateBool:      basic.AtomBoolTest(),
+		testTemplateStr:       basic.AtomStrTest(),
+		importTestTemplate:    importSyncTestTemplate,
+		generatedTestFileName: "atom_test.go",
+	},
+
+	fpCode{
+		function:          "Agent",
+		codeTemplate:      basic.Agent(),
+		importTemplate:    "\n\n" + `import "fmt"` + "\n" + `import "sync"`,
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "agent.go",
+
+		testTemplate:          basic.AgentTest(),
+		testTemplateBool:      basic.AgentBoolTest(),
+		testTemplateStr:       basic.AgentStrTest(),
+		importTestTemplate:    importAgentTestTemplate,
+		generatedTestFileName: "agent_test.go",
+	},
+
+	fpCode{
+		function:          "Ref",
+		codeTemplate:      basic.Ref(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "ref.go",
+
+		testTemplate:          basic.RefTest(),
+		testTemplateBool:      basic.RefBoolTest(),
+		testTemplateStr:       basic.RefStrTest(),
+		importTestTemplate:    "\n\n" + `import "testing"` + "\n",
+		generatedTestFileName: "ref_test.go",
+	},
+
+	fpCode{
+		function:          "Future",
+		codeTemplate:      basic.Future(),
+		importTemplate:    "\n\n" + `import "context"`,
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "futures.go",
+
+		testTemplate:          basic.FutureTest(),
+		testTemplateBool:      basic.FutureBoolTest(),
+		testTemplateStr:       basic.FutureStrTest(),
+		importTestTemplate:    importFutureTestTemplate,
+		generatedTestFileName: "futures_test.go",
+	},
+
+	fpCode{
+		function:          "Option",
+		codeTemplate:      basic.Option(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "option.go",
+
+		testTemplate:          basic.OptionTest(),
+		testTemplateBool:      basic.OptionBoolTest(),
+		testTemplateStr:       basic.OptionStrTest(),
+		importTestTemplate:    importOptionTestTemplate,
+		generatedTestFileName: "option_test.go",
+	},
+
+	fpCode{
+		function:          "MaxOpt",
+		codeTemplate:      basic.MaxOpt(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "maxopt.go",
+
+		testTemplate:          basic.MaxOptTest(),
+		testTemplateStr:       basic.MaxOptStrTest(),
+		importTestTemplate:    "\n\n" + `import "testing"` + "\n",
+		generatedTestFileName: "maxopt_test.go",
+	},
+
+	fpCode{
+		function:          "Into",
+		codeTemplate:      basic.Into(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "into.go",
+
+		testTemplate:          basic.IntoTest(),
+		testTemplateStr:       basic.IntoStrTest(),
+		generatedTestFileName: "into_test.go",
+	},
+
+	fpCode{
+		function:          "PFilter",
+		codeTemplate:      basic.PFilter(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "pfilter.go",
+
+		testTemplate:          basic.PFilterTest(),
+		testTemplateStr:       basic.PFilterStrTest(),
+		generatedTestFileName: "pfilter_test.go",
+	},
+
+	fpCode{
+		function:          "PReduce",
+		codeTemplate:      basic.PReduce(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32"},
+		generatedFileName: "preduce.go",
+
+		testTemplate:          basic.PReduceTest(),
+		importTestTemplate:    "\n\n" + `import "testing"` + "\n",
+		generatedTestFileName: "preduce_test.go",
+	},
+
+	fpCode{
+		function:          "PMapStream",
+		codeTemplate:      basic.PMapStream(),
+		importTemplate:    "\n\n" + `import "context"` + "\n",
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "pmapstream.go",
+
+		testTemplate:          basic.PMapStreamTest(),
+		testTemplateStr:       basic.PMapStreamStrTest(),
+		importTestTemplate:    importPMapStreamTestTemplate,
+		generatedTestFileName: "pmapstream_test.go",
+	},
+
+	fpCode{
+		function:          "Fluent",
+		codeTemplate:      basic.Fluent(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "fluent.go",
+
+		testTemplate:          basic.FluentTest(),
+		testTemplateStr:       basic.FluentStrTest(),
+		generatedTestFileName: "fluent_test.go",
+	},
+
+	fpCode{
+		function:                 "FluentIO",
+		codeTemplate:             basic.FluentIO(),
+		testTemplateIONumber:     basic.FluentIONumber(),
+		testTemplateIOStrNumber:  basic.FluentIOStrNumber(),
+		testTemplateIONumberStr:  basic.FluentIONumberStr(),
+		testTemplateIONumberBool: basic.FluentIONumberBool(),
+		dataTypes:                []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName:        "fluentio.go",
+		generatedTestFileName:    "fluentio_test.go",
+	},
+
+	fpCode{
+		function:          "MapKV",
+		codeTemplate:      basic.MapKV(),
+		importTemplate:    "\n\n" + `import "sort"`,
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "mapkv.go",
+
+		testTemplate:           basic.MapKVTest(),
+		testTemplateNumberStr:  basic.MapKVTestNumberToStr(),
+		testTemplateStrNumber:  basic.MapKVTestStrToNumber(),
+		testTemplateStrBool:    basic.MapKVTestStrToBool(),
+		testTemplateBoolStr:    basic.MapKVTestBoolToStr(),
+		testTemplateNumberBool: basic.MapKVTestNumberToBool(),
+		testTemplateBoolNumber: basic.MapKVTestBoolToNumber(),
+		testTemplateBoolBool:   basic.MapKVTestBoolToBool(),
+		testTemplateStrStr:     basic.MapKVTestStrToStr(),
+		generatedTestFileName:  "mapkv_test.go",
+	},
+
 	fpCode{
 		function:          "Zip",
 		codeTemplate:      basic.Zip(),
@@ -86,6 +291,287 @@ var fpCodeList = []fpCode{
 		generatedTestFileName:  "zip_test.go",
 	},
 
+	fpCode{
+		function:          "Dedupe",
+		codeTemplate:      basic.Dedupe(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "dedupe.go",
+
+		testTemplate:          basic.DedupeTest(),
+		testTemplateBool:      basic.DedupeBoolTest(),
+		testTemplateStr:       basic.DedupeStrTest(),
+		generatedTestFileName: "dedupe_test.go",
+	},
+
+	fpCode{
+		function:          "DistinctBy",
+		codeTemplate:      basic.DistinctBy(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "distinctby.go",
+
+		testTemplate:           basic.DistinctByTestNumberToNumber(),
+		testTemplateNumberStr:  basic.DistinctByTestNumberToStr(),
+		testTemplateStrNumber:  basic.DistinctByTestStrToNumber(),
+		testTemplateStrBool:    basic.DistinctByTestStrToBool(),
+		testTemplateBoolStr:    basic.DistinctByTestBoolToStr(),
+		testTemplateNumberBool: basic.DistinctByTestNumberToBool(),
+		testTemplateBoolNumber: basic.DistinctByTestBoolToNumber(),
+		testTemplateBoolBool:   basic.DistinctByTestBoolToBool(),
+		testTemplateStrStr:     basic.DistinctByTestStrToStr(),
+		generatedTestFileName:  "distinctby_test.go",
+	},
+
+	fpCode{
+		function:          "Repeat",
+		codeTemplate:      basic.Repeat(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "repeat.go",
+
+		testTemplate:          basic.RepeatTest(),
+		testTemplateBool:      basic.RepeatBoolTest(),
+		testTemplateStr:       basic.RepeatStrTest(),
+		generatedTestFileName: "repeat_test.go",
+	},
+
+	fpCode{
+		function:          "Cycle",
+		codeTemplate:      basic.Cycle(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "cycle.go",
+
+		testTemplate:          basic.CycleTest(),
+		testTemplateBool:      basic.CycleBoolTest(),
+		testTemplateStr:       basic.CycleStrTest(),
+		generatedTestFileName: "cycle_test.go",
+	},
+
+	fpCode{
+		function:          "Iterate",
+		codeTemplate:      basic.Iterate(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "iterate.go",
+
+		testTemplate:          basic.IterateTest(),
+		testTemplateBool:      basic.IterateBoolTest(),
+		testTemplateStr:       basic.IterateStrTest(),
+		generatedTestFileName: "iterate_test.go",
+	},
+
+	fpCode{
+		function:          "Repeatedly",
+		codeTemplate:      basic.Repeatedly(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "repeatedly.go",
+
+		testTemplate:          basic.RepeatedlyTest(),
+		testTemplateBool:      basic.RepeatedlyBoolTest(),
+		testTemplateStr:       basic.RepeatedlyStrTest(),
+		generatedTestFileName: "repeatedly_test.go",
+	},
+
+	fpCode{
+		function:          "Comp",
+		codeTemplate:      basic.Comp(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "comp.go",
+
+		testTemplate:          basic.CompTest(),
+		testTemplateBool:      basic.CompBoolTest(),
+		testTemplateStr:       basic.CompStrTest(),
+		generatedTestFileName: "comp_test.go",
+	},
+
+	fpCode{
+		function:          "Partial",
+		codeTemplate:      basic.Partial(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "partial.go",
+
+		testTemplate:          basic.PartialTest(),
+		testTemplateBool:      basic.PartialBoolTest(),
+		testTemplateStr:       basic.PartialStrTest(),
+		generatedTestFileName: "partial_test.go",
+	},
+
+	fpCode{
+		function:          "Complement",
+		codeTemplate:      basic.Complement(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "complement.go",
+
+		testTemplate:          basic.ComplementTest(),
+		testTemplateBool:      basic.ComplementBoolTest(),
+		testTemplateStr:       basic.ComplementStrTest(),
+		generatedTestFileName: "complement_test.go",
+	},
+
+	fpCode{
+		function:          "Constantly",
+		codeTemplate:      basic.Constantly(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "constantly.go",
+
+		testTemplate:          basic.ConstantlyTest(),
+		testTemplateBool:      basic.ConstantlyBoolTest(),
+		testTemplateStr:       basic.ConstantlyStrTest(),
+		generatedTestFileName: "constantly_test.go",
+	},
+
+	fpCode{
+		function:          "Juxt",
+		codeTemplate:      basic.Juxt(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "juxt.go",
+
+		testTemplate:          basic.JuxtTest(),
+		testTemplateBool:      basic.JuxtBoolTest(),
+		testTemplateStr:       basic.JuxtStrTest(),
+		generatedTestFileName: "juxt_test.go",
+	},
+
+	fpCode{
+		function:          "Predicate",
+		codeTemplate:      basic.Predicate(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "predicate.go",
+
+		testTemplate:          basic.PredicateTest(),
+		testTemplateBool:      basic.PredicateBoolTest(),
+		testTemplateStr:       basic.PredicateStrTest(),
+		generatedTestFileName: "predicate_test.go",
+	},
+
+	fpCode{
+		function:          "Eq",
+		codeTemplate:      basic.Eq(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "eq.go",
+
+		testTemplate:          basic.EqTest(),
+		testTemplateBool:      basic.EqBoolTest(),
+		testTemplateStr:       basic.EqStrTest(),
+		generatedTestFileName: "eq_test.go",
+	},
+
+	fpCode{
+		function:          "Compare",
+		codeTemplate:      basic.Compare(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "compare.go",
+
+		testTemplate:          basic.CompareTest(),
+		testTemplateStr:       basic.CompareStrTest(),
+		generatedTestFileName: "compare_test.go",
+	},
+
+	fpCode{
+		function:          "InSet",
+		codeTemplate:      basic.InSet(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string"},
+		generatedFileName: "inset.go",
+
+		testTemplate:          basic.InSetTest(),
+		testTemplateStr:       basic.InSetStrTest(),
+		importTestTemplate:    importSetTestTemplate,
+		generatedTestFileName: "inset_test.go",
+	},
+
+	fpCode{
+		function:          "Memoize",
+		codeTemplate:      basic.Memoize(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "memoize.go",
+
+		testTemplate:          basic.MemoizeTest(),
+		testTemplateBool:      basic.MemoizeBoolTest(),
+		testTemplateStr:       basic.MemoizeStrTest(),
+		generatedTestFileName: "memoize_test.go",
+	},
+
+	fpCode{
+		function:          "Pair",
+		codeTemplate:      basic.Pair(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "pair.go",
+
+		testTemplate:           basic.PairTest(),
+		testTemplateNumberStr:  basic.PairTestNumberToStr(),
+		testTemplateStrNumber:  basic.PairTestStrToNumber(),
+		testTemplateStrBool:    basic.PairTestStrToBool(),
+		testTemplateBoolStr:    basic.PairTestBoolToStr(),
+		testTemplateNumberBool: basic.PairTestNumberToBool(),
+		testTemplateBoolNumber: basic.PairTestBoolToNumber(),
+		testTemplateBoolBool:   basic.PairTestBoolToBool(),
+		testTemplateStrStr:     basic.PairTestStrToStr(),
+		generatedTestFileName:  "pair_test.go",
+	},
+
+	fpCode{
+		function:          "ZipWith",
+		codeTemplate:      basic.ZipWith(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "zipwith.go",
+
+		testTemplate:           basic.ZipWithTest(),
+		testTemplateBoolBool:   basic.ZipWithTestBoolToBool(),
+		testTemplateStrStr:     basic.ZipWithTestStrToStr(),
+		testTemplateNumberBool: basic.ZipWithTestNumberToBool(),
+		testTemplateStrNumber:  basic.ZipWithTestStrToNumber(),
+		generatedTestFileName:  "zipwith_test.go",
+	},
+
+	fpCode{
+		function:          "ZipIndex",
+		codeTemplate:      basic.ZipIndex(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName: "zipindex.go",
+
+		testTemplate:          basic.ZipIndexTest(),
+		testTemplateBool:      basic.ZipIndexBoolTest(),
+		testTemplateStr:       basic.ZipIndexStrTest(),
+		generatedTestFileName: "zipindex_test.go",
+	},
+
+	fpCode{
+		function:          "Zip3",
+		codeTemplate:      basic.Zip3(),
+		dataTypes:         []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "float64", "float32", "string", "bool"},
+		generatedFileName: "zip3.go",
+
+		testTemplate:          basic.Zip3Test(),
+		testTemplateBool:      basic.Zip3BoolTest(),
+		testTemplateStr:       basic.Zip3StrTest(),
+		generatedTestFileName: "zip3_test.go",
+	},
+
+	fpCode{
+		function:                 "JuxtIO",
+		codeTemplate:             basic.JuxtIO(),
+		testTemplateIONumber:     basic.JuxtIONumber(),
+		testTemplateIOStrNumber:  basic.JuxtIOStrNumber(),
+		testTemplateIONumberStr:  basic.JuxtIONumberStr(),
+		testTemplateIONumberBool: basic.JuxtIONumberBool(),
+		testTemplateIOStrBool:    basic.JuxtIOStrBool(),
+		testTemplateIOBoolNumber: basic.JuxtIOBoolNumber(),
+		testTemplateIOBoolStr:    basic.JuxtIOBoolStr(),
+		dataTypes:                []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName:        "juxtio.go",
+		generatedTestFileName:    "juxtio_test.go",
+	},
+	fpCode{
+		function:                 "MemoizeIO",
+		codeTemplate:             basic.MemoizeIO(),
+		testTemplateIONumber:     basic.MemoizeIONumber(),
+		testTemplateIOStrNumber:  basic.MemoizeIOStrNumber(),
+		testTemplateIONumberStr:  basic.MemoizeIONumberStr(),
+		testTemplateIONumberBool: basic.MemoizeIONumberBool(),
+		testTemplateIOStrBool:    basic.MemoizeIOStrBool(),
+		testTemplateIOBoolNumber: basic.MemoizeIOBoolNumber(),
+		testTemplateIOBoolStr:    basic.MemoizeIOBoolStr(),
+		dataTypes:                []string{"int", "int64", "int32", "int16", "int8", "uint", "uint64", "uint32", "uint16", "uint8", "string", "bool"},
+		generatedFileName:        "memoizeio.go",
+		generatedTestFileName:    "memoizeio_test.go",
+	},
 	fpCode{
 		function:                 "PMapIO",
 		codeTemplate:             basic.PMapIO(),
@@ -138,6 +624,65 @@ import (
 )
 `
 
+var importSetTestTemplate = `
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/logic-building/functional-go/set"
+)
+`
+
+var importSyncTestTemplate = `
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+`
+
+var importAgentTestTemplate = `
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+`
+
+var importFutureTestTemplate = `
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+`
+
+var importOptionTestTemplate = `
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+`
+
+var importPMapStreamTestTemplate = `
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+`
+
 func main() {
 	fmt.Println("Generating fp code")
 	generateFpCode(fpCodeList)
@@ -148,14 +693,19 @@ func generateFpCode(fpCodeList []fpCode) {
 	for _, fpCode := range fpCodeList {
 		codeTemplate := "package fp"
 
-		if strings.Contains(strings.ToLower(fpCode.function), "pmap") {
+		if strings.Contains(fpCode.codeTemplate, "sync.") && !strings.Contains(fpCode.importTemplate, `"sync"`) {
 			codeTemplate += "\n\n" + `import "sync"`
 		}
+		codeTemplate += fpCode.importTemplate
 
 		codeTemplate += "\n"
 
 		testTemplate := "package fp"
-		testTemplate += importTestTemplate
+		if fpCode.importTestTemplate != "" {
+			testTemplate += fpCode.importTestTemplate
+		} else {
+			testTemplate += importTestTemplate
+		}
 
 		if strings.Contains(fpCode.codeTemplate, "<INPUT_TYPE>") &&
 			strings.Contains(fpCode.codeTemplate, "<OUTPUT_TYPE>") {
@@ -221,7 +771,65 @@ func generateFpCode(fpCodeList []fpCode) {
 				}
 			}
 
-			// For Merge, Zip -
+			// For ZipWith - every combination of 2 input types and output type
+		} else if strings.Contains(fpCode.codeTemplate, "<INPUT_TYPE1>") &&
+			strings.Contains(fpCode.codeTemplate, "<INPUT_TYPE2>") &&
+			strings.Contains(fpCode.codeTemplate, "<OUTPUT_TYPE>") {
+
+			inputTypeNumbers := "int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8, float64, float32"
+			for _, inputType1 := range fpCode.dataTypes {
+				for _, inputType2 := range fpCode.dataTypes {
+					for _, outputType := range fpCode.dataTypes {
+						fInputType1 := functionTypeName(inputType1)
+						fInputType2 := functionTypeName(inputType2)
+						fOutputType := functionTypeName(outputType)
+
+						fNames := []string{fInputType1 + fInputType2 + fOutputType}
+						// Standard function name for same data type as well. ex. ZipWithInt
+						if inputType1 == inputType2 && inputType1 == outputType {
+							fNames = append(fNames, fInputType1)
+						}
+
+						for _, fName := range fNames {
+							codeTemplate += fpCode.codeTemplate
+							r := strings.NewReplacer("<FZIPWITH>", fName, "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2, "<OUTPUT_TYPE>", outputType)
+							codeTemplate = r.Replace(codeTemplate)
+
+							sameInputType := inputType1 == inputType2
+							isNumber1 := strings.Contains(inputTypeNumbers, inputType1)
+							isNumber2 := strings.Contains(inputTypeNumbers, inputType2)
+							isNumberOut := strings.Contains(inputTypeNumbers, outputType)
+
+							// Generate tests for number types combination. Output is one of the input types or both are same
+							if isNumber1 && isNumber2 && isNumberOut && (sameInputType || outputType == inputType1 || outputType == inputType2) {
+								testTemplate += r.Replace(fpCode.testTemplate)
+							}
+
+							// Generate tests for input type1 string - input type2 string
+							if inputType1 == "string" && sameInputType && outputType == "string" {
+								testTemplate += r.Replace(fpCode.testTemplateStrStr)
+							}
+
+							// Generate tests for input type1 bool - input type2 bool
+							if inputType1 == "bool" && sameInputType && outputType == "bool" {
+								testTemplate += r.Replace(fpCode.testTemplateBoolBool)
+							}
+
+							// Generate tests for same number input types - output type bool
+							if isNumber1 && sameInputType && outputType == "bool" {
+								testTemplate += r.Replace(fpCode.testTemplateNumberBool)
+							}
+
+							// Generate tests for input type string - output type number
+							if inputType1 == "string" && sameInputType && isNumberOut {
+								testTemplate += r.Replace(fpCode.testTemplateStrNumber)
+							}
+						}
+					}
+				}
+			}
+
+			// For Merge, Zip, DistinctBy, Pair -
 		} else if strings.Contains(fpCode.codeTemplate, "<INPUT_TYPE1>") &&
 			strings.Contains(fpCode.codeTemplate, "<INPUT_TYPE2>") {
 
@@ -239,12 +847,16 @@ func generateFpCode(fpCodeList []fpCode) {
 						fInputType2 = "Str"
 					}
 
+					// Type names(e.g. Pair<FTYPE1><FTYPE2>) are not shortened for same data type
+					fType1 := fInputType1
+					fType2 := fInputType2
+
 					if fInputType1 == fInputType2 {
 						fInputType2 = ""
 					}
 
 					codeTemplate += fpCode.codeTemplate
-					r := strings.NewReplacer("<FINPUT_TYPE1>", fInputType1, "<FINPUT_TYPE2>", fInputType2, "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2)
+					r := strings.NewReplacer("<FINPUT_TYPE1>", fInputType1, "<FINPUT_TYPE2>", fInputType2, "<FTYPE1>", fType1, "<FTYPE2>", fType2, "<INPUT_TYPE1>", inputType1, "<INPUT_TYPE2>", inputType2)
 					codeTemplate = r.Replace(codeTemplate)
 
 					// Generate tests for number types combination
@@ -304,7 +916,11 @@ func generateFpCode(fpCodeList []fpCode) {
 				switch t {
 				case "string":
 					ftype = "Str"
-					testTemplate += modifyTestDataToStr(fpCode.testTemplate)
+					if fpCode.testTemplateStr != "" {
+						testTemplate += fpCode.testTemplateStr
+					} else {
+						testTemplate += modifyTestDataToStr(fpCode.testTemplate)
+					}
 
 				default:
 					if strings.ToLower(t) == "bool" {
@@ -330,6 +946,14 @@ func generateFpCode(fpCodeList []fpCode) {
 	fmt.Println("Functional code generated successfully")
 }
 
+// functionTypeName returns data type as used in function names. ex. int => Int, string => Str
+func functionTypeName(t string) string {
+	if t == "string" {
+		return "Str"
+	}
+	return strings.Title(t)
+}
+
 func writeToFile(text, file string) {
 	f, err := os.Create(file)
 	if err != nil {
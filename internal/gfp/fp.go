@@ -1,6 +1,10 @@
 // Code generated by 'gofp'. DO NOT EDIT.
 package gfp
+import "context" 
+import "fmt" 
+import "sort" 
 import "sync" 
+import "github.com/logic-building/functional-go/fp" 
 import "github.com/logic-building/functional-go/internal/employee" 
 import "github.com/logic-building/functional-go/internal/employer" 
 
@@ -131,6 +135,34 @@ func PMapEmployer(f func(employer.Employer) employer.Employer, list []employer.E
 	return newList
 }
 
+func PMapStreamEmployer(ctx context.Context, f func(employer.Employer) employer.Employer, list []employer.Employer, opts ...fp.ParallelOptions) <-chan employer.Employer {
+	out := make(chan employer.Employer)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]employer.Employer, len(list))
+	indexes := fp.ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero employer.Employer
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
 func FilterMapEmployer(fFilter func(employer.Employer) bool, fMap func(employer.Employer) employer.Employer, list []employer.Employer) []employer.Employer {
 	if fFilter == nil || fMap == nil {
 		return []employer.Employer{}
@@ -144,6 +176,69 @@ func FilterMapEmployer(fFilter func(employer.Employer) bool, fMap func(employer.
 	return newList
 }
 
+func PFilterEmployer(f func(employer.Employer) bool, list []employer.Employer, opts ...fp.ParallelOptions) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+	keep := make([]bool, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]employer.Employer, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PRemoveEmployer(f func(employer.Employer) bool, list []employer.Employer, opts ...fp.ParallelOptions) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+	return PFilterEmployer(func(v employer.Employer) bool { return !f(v) }, list, opts...)
+}
+
+func PFilterMapEmployer(fFilter func(employer.Employer) bool, fMap func(employer.Employer) employer.Employer, list []employer.Employer, opts ...fp.ParallelOptions) []employer.Employer {
+	if fFilter == nil || fMap == nil {
+		return []employer.Employer{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]employer.Employer, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]employer.Employer, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PSomeEmployer(f func(employer.Employer) bool, list []employer.Employer, opts ...fp.ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !fp.ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+func PEveryEmployer(f func(employer.Employer) bool, list []employer.Employer, opts ...fp.ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return fp.ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
 func RestEmployer(l []employer.Employer) []employer.Employer {
 	if l == nil {
 		return []employer.Employer{}
@@ -186,6 +281,36 @@ func ReduceEmployer(f func(employer.Employer, employer.Employer) employer.Employ
 	return ReduceEmployer(f, list[1:], r)
 }
 
+func PReduceEmployer(fReduce, fCombine func(employer.Employer, employer.Employer) employer.Employer, identity employer.Employer, list []employer.Employer, opts ...fp.ParallelOptions) employer.Employer {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := fp.ParallelChunkSize(len(list), opts...)
+	results := make([]employer.Employer, (len(list)+size-1)/size)
+	fp.ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
 // DropLastEmployer drops last item from the list and returns new list.
 // Returns empty list if there is only one item in the list or list empty
 func DropLastEmployer(list []employer.Employer) []employer.Employer {
@@ -203,757 +328,6342 @@ func DropLastEmployer(list []employer.Employer) []employer.Employer {
 	return newList
 }
 
-func MapEmployee(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
-	if f == nil {
-		return []employee.Employee{}
+func DistinctEmployer(list []employer.Employer) []employer.Employer {
+	var newList []employer.Employer
+	s := make(map[employer.Employer]struct{}, len(list))
+	for _, v := range list {
+		if _, ok := s[v]; ok {
+			continue
+		}
+		s[v] = struct{}{}
+		newList = append(newList, v)
 	}
-	newList := make([]employee.Employee, len(list))
+	return newList
+}
+
+func DedupeEmployer(list []employer.Employer) []employer.Employer {
+	newList := make([]employer.Employer, 0, len(list))
 	for i, v := range list {
-		newList[i] = f(v)
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
 	}
 	return newList
 }
 
-func FilterEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
-	if f == nil {
-		return []employee.Employee{}
+func RepeatEmployer(n int, v employer.Employer) []employer.Employer {
+	if n <= 0 {
+		return []employer.Employer{}
 	}
-	var newList []employee.Employee
-	for _, v := range list {
-		if f(v) {
-			newList = append(newList, v)
-		}
+
+	newList := make([]employer.Employer, n)
+	for i := range newList {
+		newList[i] = v
 	}
 	return newList
 }
 
-func RemoveEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
-	if f == nil {
-		return []employee.Employee{}
+func CycleEmployer(n int, list []employer.Employer) []employer.Employer {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []employer.Employer{}
 	}
-	var newList []employee.Employee
-	for _, v := range list {
-		if !f(v) {
-			newList = append(newList, v)
-		}
+
+	newList := make([]employer.Employer, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
 	}
 	return newList
 }
 
-func SomeEmployee(f func(employee.Employee) bool, list []employee.Employee) bool {
-	if f == nil {
-		return false
+func IterateEmployer(f func(employer.Employer) employer.Employer, seed employer.Employer, n int) []employer.Employer {
+	if f == nil || n <= 0 {
+		return []employer.Employer{}
 	}
-	for _, v := range list {
-		if f(v) {
-			return true
-		}
+
+	newList := make([]employer.Employer, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
 	}
-	return false
+	return newList
 }
 
-func EveryEmployee(f func(employee.Employee) bool, list []employee.Employee) bool {
-	if f == nil || len(list) == 0 {
-		return false
+func RepeatedlyEmployer(n int, f func() employer.Employer) []employer.Employer {
+	if f == nil || n <= 0 {
+		return []employer.Employer{}
 	}
-	for _, v := range list {
-		if !f(v) {
-			return false
-		}
+
+	newList := make([]employer.Employer, n)
+	for i := range newList {
+		newList[i] = f()
 	}
-	return true
+	return newList
 }
 
-func DropWhileEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
-	if f == nil {
-		return []employee.Employee{}
+func CompEmployer(fs ...func(employer.Employer) employer.Employer) func(employer.Employer) employer.Employer {
+	return func(v employer.Employer) employer.Employer {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
 	}
-	var newList []employee.Employee
-	for i, v := range list {
-		if !f(v) {
-			listLen := len(list)
-			newList = make([]employee.Employee, listLen-i)
-			j := 0
-			for i < listLen {
-				newList[j] = list[i]
-				i++
-				j++
+}
+
+func PipeEmployer(fs ...func(employer.Employer) employer.Employer) func(employer.Employer) employer.Employer {
+	return func(v employer.Employer) employer.Employer {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
 			}
-			return newList
 		}
+		return v
 	}
-	return newList
 }
 
-func TakeWhileEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+func PartialEmployer2(f func(employer.Employer, employer.Employer) employer.Employer, v1 employer.Employer) func(employer.Employer) employer.Employer {
 	if f == nil {
-		return []employee.Employee{}
+		return nil
 	}
-	var newList []employee.Employee
-	for _, v := range list {
-		if !f(v) {
-			return newList
-		}
-		newList = append(newList, v)
+	return func(v2 employer.Employer) employer.Employer {
+		return f(v1, v2)
 	}
-	return newList
 }
 
-func PMapEmployee(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+func PartialEmployer3(f func(employer.Employer, employer.Employer, employer.Employer) employer.Employer, v1, v2 employer.Employer) func(employer.Employer) employer.Employer {
 	if f == nil {
-		return []employee.Employee{}
+		return nil
 	}
+	return func(v3 employer.Employer) employer.Employer {
+		return f(v1, v2, v3)
+	}
+}
 
-	ch := make(chan map[int]employee.Employee)
-	var wg sync.WaitGroup
+func ComplementEmployer(pred func(employer.Employer) bool) func(employer.Employer) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v employer.Employer) bool {
+		return !pred(v)
+	}
+}
 
-	for i, v := range list {
-		wg.Add(1)
+func ConstantlyEmployer(v employer.Employer) func(employer.Employer) employer.Employer {
+	return func(employer.Employer) employer.Employer {
+		return v
+	}
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v employee.Employee) {
-			defer wg.Done()
-			ch <- map[int]employee.Employee{i: f(v)}
-		}(&wg, ch, i, v)
+func JuxtEmployer(fs ...func(employer.Employer) employer.Employer) func(employer.Employer) []employer.Employer {
+	funcs := make([]func(employer.Employer) employer.Employer, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
 	}
+	return func(v employer.Employer) []employer.Employer {
+		newList := make([]employer.Employer, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+func AndEmployer(preds ...func(employer.Employer) bool) func(employer.Employer) bool {
+	return func(v employer.Employer) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
 
-	newList := make([]employee.Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
+func OrEmployer(preds ...func(employer.Employer) bool) func(employer.Employer) bool {
+	return func(v employer.Employer) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
 		}
+		return false
 	}
-	return newList
 }
 
-func FilterMapEmployee(fFilter func(employee.Employee) bool, fMap func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
-	if fFilter == nil || fMap == nil {
-		return []employee.Employee{}
+func NotEmployer(pred func(employer.Employer) bool) func(employer.Employer) bool {
+	return ComplementEmployer(pred)
+}
+
+func NoneEmployer(pred func(employer.Employer) bool, list []employer.Employer) bool {
+	if pred == nil {
+		return false
 	}
-	var newList []employee.Employee
 	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
+		if pred(v) {
+			return false
 		}
 	}
-	return newList
+	return true
 }
 
-func RestEmployee(l []employee.Employee) []employee.Employee {
-	if l == nil {
-		return []employee.Employee{}
+func EqEmployer(v employer.Employer) func(employer.Employer) bool {
+	return func(item employer.Employer) bool {
+		return item == v
 	}
+}
 
-	len := len(l)
-	if len == 0 || len == 1 {
-		return []employee.Employee{}
+func NeEmployer(v employer.Employer) func(employer.Employer) bool {
+	return func(item employer.Employer) bool {
+		return item != v
 	}
+}
 
-	newList := make([]employee.Employee, len-1)
+func MemoizeEmployer(f func(employer.Employer) employer.Employer, opts ...fp.MemoizeOptions) func(employer.Employer) employer.Employer {
+	if f == nil {
+		return nil
+	}
 
-	for i, v := range l[1:] {
-		newList[i] = v
+	memo := fp.NewMemo(opts...)
+	return func(v employer.Employer) employer.Employer {
+		return memo.Get(v, func() interface{} { return f(v) }).(employer.Employer)
 	}
+}
 
-	return newList
+type RefEmployer struct {
+	ref *fp.Ref
 }
 
-func ReduceEmployee(f func(employee.Employee, employee.Employee) employee.Employee, list []employee.Employee, initializer ...employee.Employee) employee.Employee {
-	var init employee.Employee 
-	lenList := len(list)
+func NewRefEmployer(v employer.Employer) *RefEmployer {
+	return &RefEmployer{ref: fp.NewRef(v)}
+}
 
-	if initializer != nil {
-		init = initializer[0]
-	} else if lenList > 0 {
-		init = list[0]
-		if lenList == 1 {
-			return list[0]
-		}
-		if lenList >= 2 {
-			list = list[1:]
-		}
-	}
-	
-	if lenList == 0 {
-		return init
-	}
-	r := f(init, list[0])
-	return ReduceEmployee(f, list[1:], r)
+func (r *RefEmployer) Deref() employer.Employer {
+	return r.ref.Deref().(employer.Employer)
 }
 
-// DropLastEmployee drops last item from the list and returns new list.
-// Returns empty list if there is only one item in the list or list empty
-func DropLastEmployee(list []employee.Employee) []employee.Employee {
-	listLen := len(list)
+func (r *RefEmployer) Get(tx *fp.Tx) employer.Employer {
+	return tx.Get(r.ref).(employer.Employer)
+}
 
-	if list == nil || listLen == 0 || listLen == 1 {
-		return []employee.Employee{}
-	}
+func (r *RefEmployer) Set(tx *fp.Tx, v employer.Employer) {
+	tx.Set(r.ref, v)
+}
 
-	newList := make([]employee.Employee, listLen-1)
+func (r *RefEmployer) Alter(tx *fp.Tx, f func(employer.Employer) employer.Employer) employer.Employer {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(employer.Employer)) }).(employer.Employer)
+}
 
-	for i := 0; i < listLen-1; i++ {
-		newList[i] = list[i]
+func (r *RefEmployer) Commute(tx *fp.Tx, f func(employer.Employer) employer.Employer) employer.Employer {
+	if f == nil {
+		return r.Get(tx)
 	}
-	return newList
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(employer.Employer)) }).(employer.Employer)
 }
 
+type FutureEmployer struct {
+	future *fp.Future
+}
 
-// MapEmployerEmployee takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployerEmployee(f func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
+type PromiseEmployer struct {
+	promise *fp.Promise
+}
+
+func NewFutureEmployer(f func() (employer.Employer, error)) *FutureEmployer {
 	if f == nil {
-		return []employee.Employee{}
-	}
-	newList := make([]employee.Employee, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+		return &FutureEmployer{future: fp.NewFuture(nil)}
 	}
-	return newList
+	return &FutureEmployer{future: fp.NewFuture(func() (interface{}, error) { return f() })}
 }
 
-// PMapEmployerEmployee applies the function(1st argument) on each item of the list and returns new list.
+func NewFutureCtxEmployer(ctx context.Context, f func(context.Context) (employer.Employer, error)) *FutureEmployer {
+	if f == nil {
+		return &FutureEmployer{future: fp.NewFutureCtx(ctx, nil)}
+	}
+	return &FutureEmployer{future: fp.NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+func NewPromiseEmployer() *PromiseEmployer {
+	return &PromiseEmployer{promise: fp.NewPromise()}
+}
+
+func (p *PromiseEmployer) Deliver(v employer.Employer) bool {
+	return p.promise.Deliver(v)
+}
+
+func (p *PromiseEmployer) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+func (p *PromiseEmployer) Future() *FutureEmployer {
+	return &FutureEmployer{future: p.promise.Future()}
+}
+
+func (f *FutureEmployer) Await(ctx context.Context) (employer.Employer, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero employer.Employer
+		return zero, err
+	}
+	return futureValueEmployer(v), nil
+}
+
+func (f *FutureEmployer) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+func (f *FutureEmployer) IsDone() bool {
+	return f.future.IsDone()
+}
+
+func (f *FutureEmployer) Untyped() *fp.Future {
+	return f.future
+}
+
+func (f *FutureEmployer) Then(fn func(employer.Employer) (employer.Employer, error)) *FutureEmployer {
+	if fn == nil {
+		return f
+	}
+	return &FutureEmployer{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueEmployer(v)) })}
+}
+
+func (f *FutureEmployer) ThenCtx(ctx context.Context, fn func(employer.Employer) (employer.Employer, error)) *FutureEmployer {
+	if fn == nil {
+		return &FutureEmployer{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureEmployer{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueEmployer(v)) })}
+}
+
+func AwaitAllEmployer(ctx context.Context, futures ...*FutureEmployer) ([]employer.Employer, error) {
+	values, err := AllCtxEmployer(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]employer.Employer), nil
+}
+
+func AllEmployer(futures ...*FutureEmployer) *fp.Future {
+	return AllCtxEmployer(context.Background(), futures...)
+}
+
+func AllCtxEmployer(ctx context.Context, futures ...*FutureEmployer) *fp.Future {
+	return fp.AllCtx(ctx, untypedFuturesEmployer(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]employer.Employer, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueEmployer(v)
+		}
+		return list, nil
+	})
+}
+
+func AnyEmployer(futures ...*FutureEmployer) *FutureEmployer {
+	return &FutureEmployer{future: fp.Any(untypedFuturesEmployer(futures)...)}
+}
+
+func RaceEmployer(futures ...*FutureEmployer) *FutureEmployer {
+	return &FutureEmployer{future: fp.Race(untypedFuturesEmployer(futures)...)}
+}
+
+func AnyCtxEmployer(ctx context.Context, futures ...*FutureEmployer) *FutureEmployer {
+	return &FutureEmployer{future: fp.AnyCtx(ctx, untypedFuturesEmployer(futures)...)}
+}
+
+func RaceCtxEmployer(ctx context.Context, futures ...*FutureEmployer) *FutureEmployer {
+	return &FutureEmployer{future: fp.RaceCtx(ctx, untypedFuturesEmployer(futures)...)}
+}
+
+func futureValueEmployer(v interface{}) employer.Employer {
+	if v == nil {
+		var zero employer.Employer
+		return zero
+	}
+	return v.(employer.Employer)
+}
+
+func untypedFuturesEmployer(futures []*FutureEmployer) []*fp.Future {
+	list := make([]*fp.Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+func Zip3Employer(list1, list2, list3 []employer.Employer) [][3]employer.Employer {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]employer.Employer{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+func Unzip3Employer(list [][3]employer.Employer) ([]employer.Employer, []employer.Employer, []employer.Employer) {
+	list1 := make([]employer.Employer, len(list))
+	list2 := make([]employer.Employer, len(list))
+	list3 := make([]employer.Employer, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+func TreeSeqEmployer(children func(employer.Employer) []employer.Employer, root employer.Employer) []employer.Employer {
+	var result []employer.Employer
+	stack := []employer.Employer{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if children != nil {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+func PrewalkEmployer(f func(employer.Employer) employer.Employer, children func(employer.Employer) []employer.Employer, withChildren func(employer.Employer, []employer.Employer) employer.Employer, root employer.Employer) employer.Employer {
+	if f == nil {
+		return root
+	}
+	node := f(root)
+	if children == nil || withChildren == nil {
+		return node
+	}
+
+	nodes := children(node)
+	if len(nodes) == 0 {
+		return node
+	}
+	newNodes := make([]employer.Employer, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PrewalkEmployer(f, children, withChildren, v)
+	}
+	return withChildren(node, newNodes)
+}
+
+func PostwalkEmployer(f func(employer.Employer) employer.Employer, children func(employer.Employer) []employer.Employer, withChildren func(employer.Employer, []employer.Employer) employer.Employer, root employer.Employer) employer.Employer {
+	if f == nil {
+		return root
+	}
+	if children == nil || withChildren == nil {
+		return f(root)
+	}
+
+	nodes := children(root)
+	if len(nodes) == 0 {
+		return f(root)
+	}
+	newNodes := make([]employer.Employer, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PostwalkEmployer(f, children, withChildren, v)
+	}
+	return f(withChildren(root, newNodes))
+}
+
+// StackEmployer - persistent(immutable) stack of employer.Employer. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackEmployer struct {
+	top  employer.Employer
+	rest *StackEmployer
+	size int
+}
+
+// NewStackEmployer creates stack from the list. Last item of the list is on top
+func NewStackEmployer(list []employer.Employer) *StackEmployer {
+	var s *StackEmployer
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackEmployer) Push(v employer.Employer) *StackEmployer {
+	return &StackEmployer{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackEmployer) Pop() *StackEmployer {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackEmployer) Peek() (employer.Employer, bool) {
+	if s == nil {
+		var zero employer.Employer
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackEmployer) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackEmployer) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackEmployer(s.ToSlice()) is same as the stack
+func (s *StackEmployer) ToSlice() []employer.Employer {
+	list := make([]employer.Employer, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueEmployer - persistent(immutable) FIFO queue of employer.Employer. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueEmployer struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackEmployer
+	rear  *StackEmployer
+}
+
+// NewQueueEmployer creates queue from the list. First item of the list is at the front
+func NewQueueEmployer(list []employer.Employer) *QueueEmployer {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueEmployer{front: reversedStackEmployer(list)}
+}
+
+// reversedStackEmployer creates stack with the first item of the list on top
+func reversedStackEmployer(list []employer.Employer) *StackEmployer {
+	var s *StackEmployer
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueEmployer) Push(v employer.Employer) *QueueEmployer {
+	if q == nil {
+		return &QueueEmployer{front: (*StackEmployer)(nil).Push(v)}
+	}
+	return &QueueEmployer{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueEmployer
+func (q *QueueEmployer) Pop() *QueueEmployer {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueEmployer{front: front, rear: q.rear}
+	}
+	return &QueueEmployer{front: reversedStackEmployer(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueEmployer) Peek() (employer.Employer, bool) {
+	if q == nil {
+		var zero employer.Employer
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueEmployer) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueEmployer) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueEmployer) ToSlice() []employer.Employer {
+	if q == nil {
+		return []employer.Employer{}
+	}
+	list := make([]employer.Employer, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeEmployer - persistent(immutable) double-ended queue of employer.Employer. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeEmployer struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackEmployer
+	back  *StackEmployer
+}
+
+// NewDequeEmployer creates deque from the list. First item of the list is at the front
+func NewDequeEmployer(list []employer.Employer) *DequeEmployer {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeEmployer{front: reversedStackEmployer(list[:half]), back: NewStackEmployer(list[half:])}
+}
+
+// newDequeEmployer creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeEmployer(front, back *StackEmployer) *DequeEmployer {
+	d := &DequeEmployer{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeEmployer(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeEmployer) PushFront(v employer.Employer) *DequeEmployer {
+	if d == nil {
+		return newDequeEmployer((*StackEmployer)(nil).Push(v), nil)
+	}
+	return newDequeEmployer(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeEmployer) PushBack(v employer.Employer) *DequeEmployer {
+	if d == nil {
+		return newDequeEmployer(nil, (*StackEmployer)(nil).Push(v))
+	}
+	return newDequeEmployer(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployer) PopFront() *DequeEmployer {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployer(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployer) PopBack() *DequeEmployer {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployer(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeEmployer) PeekFront() (employer.Employer, bool) {
+	if d == nil {
+		var zero employer.Employer
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeEmployer) PeekBack() (employer.Employer, bool) {
+	if d == nil {
+		var zero employer.Employer
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeEmployer) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeEmployer) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeEmployer) ToSlice() []employer.Employer {
+	if d == nil {
+		return []employer.Employer{}
+	}
+	list := make([]employer.Employer, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueEmployer - binary heap of employer.Employer. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueEmployer, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueEmployer struct {
+	key   func(employer.Employer) float64
+	items []priorityItemEmployer
+	seq   int
+}
+
+type priorityItemEmployer struct {
+	value employer.Employer
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueEmployer creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueEmployer(key func(employer.Employer) float64, list []employer.Employer) *PriorityQueueEmployer {
+	pq := &PriorityQueueEmployer{key: key, items: make([]priorityItemEmployer, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueEmployer) Push(v employer.Employer) *PriorityQueueEmployer {
+	item := priorityItemEmployer{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployer) Pop() (employer.Employer, bool) {
+	if len(pq.items) == 0 {
+		var zero employer.Employer
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployer) Peek() (employer.Employer, bool) {
+	if len(pq.items) == 0 {
+		var zero employer.Employer
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueEmployer) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueEmployer) ToSlice() []employer.Employer {
+	clone := &PriorityQueueEmployer{items: make([]priorityItemEmployer, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]employer.Employer, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueEmployer) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// AtomEmployer - holds employer.Employer shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of employer.Employer
+type AtomEmployer struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   employer.Employer
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(employer.Employer) error
+	watches   map[string]func(key string, oldVal, newVal employer.Employer)
+}
+
+// NewAtomEmployer creates atom with the initial value
+func NewAtomEmployer(v employer.Employer) *AtomEmployer {
+	return &AtomEmployer{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomEmployer) load() (employer.Employer, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomEmployer) store(version uint64, v employer.Employer) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomEmployer) Deref() employer.Employer {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomEmployer(0)
+//	counter.Swap(func(v employer.Employer) employer.Employer { return v + 1 })
+func (a *AtomEmployer) Swap(f func(employer.Employer) employer.Employer) (employer.Employer, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomEmployer) Reset(v employer.Employer) error {
+	_, err := a.Swap(func(employer.Employer) employer.Employer { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomEmployer) CompareAndSet(oldVal, newVal employer.Employer) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomEmployer) SetValidator(validator func(employer.Employer) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomEmployer) validate(v employer.Employer) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomEmployer) AddWatch(key string, f func(key string, oldVal, newVal employer.Employer)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, employer.Employer, employer.Employer))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomEmployer) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomEmployer) notify(oldVal, newVal employer.Employer) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, employer.Employer, employer.Employer), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AgentEmployer - holds employer.Employer which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentEmployer struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   employer.Employer
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionEmployer
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionEmployer struct {
+	f     func(employer.Employer) employer.Employer
+	epoch int
+}
+
+// NewAgentEmployer creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentEmployer(v employer.Employer, queueSize int) *AgentEmployer {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentEmployer{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentEmployer(0, 100)
+//	total.Send(func(v employer.Employer) employer.Employer { return v + 1 })
+//	total.Await()
+func (a *AgentEmployer) Send(f func(employer.Employer) employer.Employer) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentEmployer(0, 1)
+//	counter.SendNested(func(v employer.Employer, send func(func(employer.Employer) employer.Employer)) employer.Employer {
+//		send(func(v employer.Employer) employer.Employer { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentEmployer) SendNested(f func(employer.Employer, func(func(employer.Employer) employer.Employer)) employer.Employer) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v employer.Employer) employer.Employer {
+		var held []func(employer.Employer) employer.Employer
+		v = f(v, func(g func(employer.Employer) employer.Employer) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionEmployer{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentEmployer) enqueue(f func(employer.Employer) employer.Employer) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionEmployer{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentEmployer) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionEmployer{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentEmployer) apply(f func(employer.Employer) employer.Employer, v employer.Employer) (newVal employer.Employer, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentEmployer) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentEmployer) Deref() employer.Employer {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentEmployer) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentEmployer) Restart(v employer.Employer) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// OptionEmployer - employer.Employer which may be absent. The zero value is absent
+type OptionEmployer struct {
+	value employer.Employer
+	ok    bool
+}
+
+// SomeOptEmployer returns option with the value
+func SomeOptEmployer(v employer.Employer) OptionEmployer {
+	return OptionEmployer{value: v, ok: true}
+}
+
+// NoneOptEmployer returns option without value
+func NoneOptEmployer() OptionEmployer {
+	return OptionEmployer{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionEmployer) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionEmployer) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionEmployer) Get() (employer.Employer, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionEmployer) Unwrap() employer.Employer {
+	if !o.ok {
+		panic("OptionEmployer: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionEmployer) OrElse(v employer.Employer) employer.Employer {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionEmployer) OrElseGet(f func() employer.Employer) employer.Employer {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployer) Map(f func(employer.Employer) employer.Employer) OptionEmployer {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptEmployer(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployer) FlatMap(f func(employer.Employer) OptionEmployer) OptionEmployer {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionEmployer) Filter(pred func(employer.Employer) bool) OptionEmployer {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionEmployer{}
+	}
+	return o
+}
+
+// ResultEmployer - employer.Employer or error. The zero value is Ok with zero value of employer.Employer
+type ResultEmployer struct {
+	value employer.Employer
+	err   error
+}
+
+// OkEmployer returns result with the value
+func OkEmployer(v employer.Employer) ResultEmployer {
+	return ResultEmployer{value: v}
+}
+
+// ErrEmployer returns result with the error
+func ErrEmployer(err error) ResultEmployer {
+	return ResultEmployer{err: err}
+}
+
+// ResultOfEmployer returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfEmployer(f(v))
+func ResultOfEmployer(v employer.Employer, err error) ResultEmployer {
+	if err != nil {
+		return ResultEmployer{err: err}
+	}
+	return ResultEmployer{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultEmployer) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultEmployer) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultEmployer) Get() (employer.Employer, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultEmployer) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultEmployer) Unwrap() employer.Employer {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultEmployer) OrElse(v employer.Employer) employer.Employer {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployer) Map(f func(employer.Employer) employer.Employer) ResultEmployer {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkEmployer(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployer) FlatMap(f func(employer.Employer) ResultEmployer) ResultEmployer {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultEmployer) Option() OptionEmployer {
+	if r.err != nil {
+		return OptionEmployer{}
+	}
+	return SomeOptEmployer(r.value)
+}
+
+// FindOptEmployer returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptEmployer(pred func(employer.Employer) bool, list []employer.Employer) OptionEmployer {
+	if pred == nil {
+		return OptionEmployer{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptEmployer(v)
+		}
+	}
+	return OptionEmployer{}
+}
+
+// NthOptEmployer returns the item at the index. Returns None if the index is out of range
+func NthOptEmployer(list []employer.Employer, i int) OptionEmployer {
+	if i < 0 || i >= len(list) {
+		return OptionEmployer{}
+	}
+	return SomeOptEmployer(list[i])
+}
+
+// ReduceOptEmployer reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptEmployer(f func(employer.Employer, employer.Employer) employer.Employer, list []employer.Employer) OptionEmployer {
+	if f == nil || len(list) == 0 {
+		return OptionEmployer{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptEmployer(acc)
+}
+
+// TraverseResultEmployer applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v employer.Employer) ResultEmployer { return ResultOfEmployer(check(v)) }
+//	TraverseResultEmployer(parse, list)
+func TraverseResultEmployer(f func(employer.Employer) ResultEmployer, list []employer.Employer) ([]employer.Employer, error) {
+	if f == nil {
+		return []employer.Employer{}, nil
+	}
+	newList := make([]employer.Employer, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// MapEmployerInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]employer.Employer, 0, 1024)
+//	for ... {
+//		buf = MapEmployerInto(buf, f, list)
+//	}
+func MapEmployerInto(dst []employer.Employer, f func(employer.Employer) employer.Employer, list []employer.Employer) []employer.Employer {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]employer.Employer, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterEmployerInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterEmployerInto(dst []employer.Employer, f func(employer.Employer) bool, list []employer.Employer) []employer.Employer {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropEmployerInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropEmployerInto(dst []employer.Employer, item employer.Employer, list []employer.Employer) []employer.Employer {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapEmployerInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapEmployerInPlace(f func(employer.Employer) employer.Employer, list []employer.Employer) []employer.Employer {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterEmployerInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterEmployerInPlace(f, list)
+func FilterEmployerInPlace(f func(employer.Employer) bool, list []employer.Employer) []employer.Employer {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero employer.Employer
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+func MapEmployee(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	newList := make([]employee.Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+func FilterEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if f(v) {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func RemoveEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if !f(v) {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func SomeEmployee(f func(employee.Employee) bool, list []employee.Employee) bool {
+	if f == nil {
+		return false
+	}
+	for _, v := range list {
+		if f(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func EveryEmployee(f func(employee.Employee) bool, list []employee.Employee) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	for _, v := range list {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func DropWhileEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for i, v := range list {
+		if !f(v) {
+			listLen := len(list)
+			newList = make([]employee.Employee, listLen-i)
+			j := 0
+			for i < listLen {
+				newList[j] = list[i]
+				i++
+				j++
+			}
+			return newList
+		}
+	}
+	return newList
+}
+
+func TakeWhileEmployee(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if !f(v) {
+			return newList
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func PMapEmployee(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	ch := make(chan map[int]employee.Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v employee.Employee) {
+			defer wg.Done()
+			ch <- map[int]employee.Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]employee.Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+func PMapStreamEmployee(ctx context.Context, f func(employee.Employee) employee.Employee, list []employee.Employee, opts ...fp.ParallelOptions) <-chan employee.Employee {
+	out := make(chan employee.Employee)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]employee.Employee, len(list))
+	indexes := fp.ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero employee.Employee
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func FilterMapEmployee(fFilter func(employee.Employee) bool, fMap func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	if fFilter == nil || fMap == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+func PFilterEmployee(f func(employee.Employee) bool, list []employee.Employee, opts ...fp.ParallelOptions) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	keep := make([]bool, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]employee.Employee, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PRemoveEmployee(f func(employee.Employee) bool, list []employee.Employee, opts ...fp.ParallelOptions) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	return PFilterEmployee(func(v employee.Employee) bool { return !f(v) }, list, opts...)
+}
+
+func PFilterMapEmployee(fFilter func(employee.Employee) bool, fMap func(employee.Employee) employee.Employee, list []employee.Employee, opts ...fp.ParallelOptions) []employee.Employee {
+	if fFilter == nil || fMap == nil {
+		return []employee.Employee{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]employee.Employee, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]employee.Employee, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PSomeEmployee(f func(employee.Employee) bool, list []employee.Employee, opts ...fp.ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !fp.ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+func PEveryEmployee(f func(employee.Employee) bool, list []employee.Employee, opts ...fp.ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return fp.ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+
+func RestEmployee(l []employee.Employee) []employee.Employee {
+	if l == nil {
+		return []employee.Employee{}
+	}
+
+	len := len(l)
+	if len == 0 || len == 1 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, len-1)
+
+	for i, v := range l[1:] {
+		newList[i] = v
+	}
+
+	return newList
+}
+
+func ReduceEmployee(f func(employee.Employee, employee.Employee) employee.Employee, list []employee.Employee, initializer ...employee.Employee) employee.Employee {
+	var init employee.Employee 
+	lenList := len(list)
+
+	if initializer != nil {
+		init = initializer[0]
+	} else if lenList > 0 {
+		init = list[0]
+		if lenList == 1 {
+			return list[0]
+		}
+		if lenList >= 2 {
+			list = list[1:]
+		}
+	}
+	
+	if lenList == 0 {
+		return init
+	}
+	r := f(init, list[0])
+	return ReduceEmployee(f, list[1:], r)
+}
+
+func PReduceEmployee(fReduce, fCombine func(employee.Employee, employee.Employee) employee.Employee, identity employee.Employee, list []employee.Employee, opts ...fp.ParallelOptions) employee.Employee {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := fp.ParallelChunkSize(len(list), opts...)
+	results := make([]employee.Employee, (len(list)+size-1)/size)
+	fp.ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+
+// DropLastEmployee drops last item from the list and returns new list.
+// Returns empty list if there is only one item in the list or list empty
+func DropLastEmployee(list []employee.Employee) []employee.Employee {
+	listLen := len(list)
+
+	if list == nil || listLen == 0 || listLen == 1 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, listLen-1)
+
+	for i := 0; i < listLen-1; i++ {
+		newList[i] = list[i]
+	}
+	return newList
+}
+
+func DistinctEmployee(list []employee.Employee) []employee.Employee {
+	var newList []employee.Employee
+	s := make(map[employee.Employee]struct{}, len(list))
+	for _, v := range list {
+		if _, ok := s[v]; ok {
+			continue
+		}
+		s[v] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func DedupeEmployee(list []employee.Employee) []employee.Employee {
+	newList := make([]employee.Employee, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+func RepeatEmployee(n int, v employee.Employee) []employee.Employee {
+	if n <= 0 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+
+func CycleEmployee(n int, list []employee.Employee) []employee.Employee {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+
+func IterateEmployee(f func(employee.Employee) employee.Employee, seed employee.Employee, n int) []employee.Employee {
+	if f == nil || n <= 0 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+
+func RepeatedlyEmployee(n int, f func() employee.Employee) []employee.Employee {
+	if f == nil || n <= 0 {
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+
+func CompEmployee(fs ...func(employee.Employee) employee.Employee) func(employee.Employee) employee.Employee {
+	return func(v employee.Employee) employee.Employee {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+func PipeEmployee(fs ...func(employee.Employee) employee.Employee) func(employee.Employee) employee.Employee {
+	return func(v employee.Employee) employee.Employee {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+
+func PartialEmployee2(f func(employee.Employee, employee.Employee) employee.Employee, v1 employee.Employee) func(employee.Employee) employee.Employee {
+	if f == nil {
+		return nil
+	}
+	return func(v2 employee.Employee) employee.Employee {
+		return f(v1, v2)
+	}
+}
+
+func PartialEmployee3(f func(employee.Employee, employee.Employee, employee.Employee) employee.Employee, v1, v2 employee.Employee) func(employee.Employee) employee.Employee {
+	if f == nil {
+		return nil
+	}
+	return func(v3 employee.Employee) employee.Employee {
+		return f(v1, v2, v3)
+	}
+}
+
+func ComplementEmployee(pred func(employee.Employee) bool) func(employee.Employee) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v employee.Employee) bool {
+		return !pred(v)
+	}
+}
+
+func ConstantlyEmployee(v employee.Employee) func(employee.Employee) employee.Employee {
+	return func(employee.Employee) employee.Employee {
+		return v
+	}
+}
+
+func JuxtEmployee(fs ...func(employee.Employee) employee.Employee) func(employee.Employee) []employee.Employee {
+	funcs := make([]func(employee.Employee) employee.Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v employee.Employee) []employee.Employee {
+		newList := make([]employee.Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func AndEmployee(preds ...func(employee.Employee) bool) func(employee.Employee) bool {
+	return func(v employee.Employee) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func OrEmployee(preds ...func(employee.Employee) bool) func(employee.Employee) bool {
+	return func(v employee.Employee) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func NotEmployee(pred func(employee.Employee) bool) func(employee.Employee) bool {
+	return ComplementEmployee(pred)
+}
+
+func NoneEmployee(pred func(employee.Employee) bool, list []employee.Employee) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func EqEmployee(v employee.Employee) func(employee.Employee) bool {
+	return func(item employee.Employee) bool {
+		return item == v
+	}
+}
+
+func NeEmployee(v employee.Employee) func(employee.Employee) bool {
+	return func(item employee.Employee) bool {
+		return item != v
+	}
+}
+
+func MemoizeEmployee(f func(employee.Employee) employee.Employee, opts ...fp.MemoizeOptions) func(employee.Employee) employee.Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v employee.Employee) employee.Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(employee.Employee)
+	}
+}
+
+type RefEmployee struct {
+	ref *fp.Ref
+}
+
+func NewRefEmployee(v employee.Employee) *RefEmployee {
+	return &RefEmployee{ref: fp.NewRef(v)}
+}
+
+func (r *RefEmployee) Deref() employee.Employee {
+	return r.ref.Deref().(employee.Employee)
+}
+
+func (r *RefEmployee) Get(tx *fp.Tx) employee.Employee {
+	return tx.Get(r.ref).(employee.Employee)
+}
+
+func (r *RefEmployee) Set(tx *fp.Tx, v employee.Employee) {
+	tx.Set(r.ref, v)
+}
+
+func (r *RefEmployee) Alter(tx *fp.Tx, f func(employee.Employee) employee.Employee) employee.Employee {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(employee.Employee)) }).(employee.Employee)
+}
+
+func (r *RefEmployee) Commute(tx *fp.Tx, f func(employee.Employee) employee.Employee) employee.Employee {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(employee.Employee)) }).(employee.Employee)
+}
+
+type FutureEmployee struct {
+	future *fp.Future
+}
+
+type PromiseEmployee struct {
+	promise *fp.Promise
+}
+
+func NewFutureEmployee(f func() (employee.Employee, error)) *FutureEmployee {
+	if f == nil {
+		return &FutureEmployee{future: fp.NewFuture(nil)}
+	}
+	return &FutureEmployee{future: fp.NewFuture(func() (interface{}, error) { return f() })}
+}
+
+func NewFutureCtxEmployee(ctx context.Context, f func(context.Context) (employee.Employee, error)) *FutureEmployee {
+	if f == nil {
+		return &FutureEmployee{future: fp.NewFutureCtx(ctx, nil)}
+	}
+	return &FutureEmployee{future: fp.NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+func NewPromiseEmployee() *PromiseEmployee {
+	return &PromiseEmployee{promise: fp.NewPromise()}
+}
+
+func (p *PromiseEmployee) Deliver(v employee.Employee) bool {
+	return p.promise.Deliver(v)
+}
+
+func (p *PromiseEmployee) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+func (p *PromiseEmployee) Future() *FutureEmployee {
+	return &FutureEmployee{future: p.promise.Future()}
+}
+
+func (f *FutureEmployee) Await(ctx context.Context) (employee.Employee, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero employee.Employee
+		return zero, err
+	}
+	return futureValueEmployee(v), nil
+}
+
+func (f *FutureEmployee) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+func (f *FutureEmployee) IsDone() bool {
+	return f.future.IsDone()
+}
+
+func (f *FutureEmployee) Untyped() *fp.Future {
+	return f.future
+}
+
+func (f *FutureEmployee) Then(fn func(employee.Employee) (employee.Employee, error)) *FutureEmployee {
+	if fn == nil {
+		return f
+	}
+	return &FutureEmployee{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValueEmployee(v)) })}
+}
+
+func (f *FutureEmployee) ThenCtx(ctx context.Context, fn func(employee.Employee) (employee.Employee, error)) *FutureEmployee {
+	if fn == nil {
+		return &FutureEmployee{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &FutureEmployee{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValueEmployee(v)) })}
+}
+
+func AwaitAllEmployee(ctx context.Context, futures ...*FutureEmployee) ([]employee.Employee, error) {
+	values, err := AllCtxEmployee(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]employee.Employee), nil
+}
+
+func AllEmployee(futures ...*FutureEmployee) *fp.Future {
+	return AllCtxEmployee(context.Background(), futures...)
+}
+
+func AllCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *fp.Future {
+	return fp.AllCtx(ctx, untypedFuturesEmployee(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]employee.Employee, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValueEmployee(v)
+		}
+		return list, nil
+	})
+}
+
+func AnyEmployee(futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.Any(untypedFuturesEmployee(futures)...)}
+}
+
+func RaceEmployee(futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.Race(untypedFuturesEmployee(futures)...)}
+}
+
+func AnyCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.AnyCtx(ctx, untypedFuturesEmployee(futures)...)}
+}
+
+func RaceCtxEmployee(ctx context.Context, futures ...*FutureEmployee) *FutureEmployee {
+	return &FutureEmployee{future: fp.RaceCtx(ctx, untypedFuturesEmployee(futures)...)}
+}
+
+func futureValueEmployee(v interface{}) employee.Employee {
+	if v == nil {
+		var zero employee.Employee
+		return zero
+	}
+	return v.(employee.Employee)
+}
+
+func untypedFuturesEmployee(futures []*FutureEmployee) []*fp.Future {
+	list := make([]*fp.Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+
+func Zip3Employee(list1, list2, list3 []employee.Employee) [][3]employee.Employee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]employee.Employee{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+func Unzip3Employee(list [][3]employee.Employee) ([]employee.Employee, []employee.Employee, []employee.Employee) {
+	list1 := make([]employee.Employee, len(list))
+	list2 := make([]employee.Employee, len(list))
+	list3 := make([]employee.Employee, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+
+func TreeSeqEmployee(children func(employee.Employee) []employee.Employee, root employee.Employee) []employee.Employee {
+	var result []employee.Employee
+	stack := []employee.Employee{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if children != nil {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+func PrewalkEmployee(f func(employee.Employee) employee.Employee, children func(employee.Employee) []employee.Employee, withChildren func(employee.Employee, []employee.Employee) employee.Employee, root employee.Employee) employee.Employee {
+	if f == nil {
+		return root
+	}
+	node := f(root)
+	if children == nil || withChildren == nil {
+		return node
+	}
+
+	nodes := children(node)
+	if len(nodes) == 0 {
+		return node
+	}
+	newNodes := make([]employee.Employee, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PrewalkEmployee(f, children, withChildren, v)
+	}
+	return withChildren(node, newNodes)
+}
+
+func PostwalkEmployee(f func(employee.Employee) employee.Employee, children func(employee.Employee) []employee.Employee, withChildren func(employee.Employee, []employee.Employee) employee.Employee, root employee.Employee) employee.Employee {
+	if f == nil {
+		return root
+	}
+	if children == nil || withChildren == nil {
+		return f(root)
+	}
+
+	nodes := children(root)
+	if len(nodes) == 0 {
+		return f(root)
+	}
+	newNodes := make([]employee.Employee, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = PostwalkEmployee(f, children, withChildren, v)
+	}
+	return f(withChildren(root, newNodes))
+}
+
+// StackEmployee - persistent(immutable) stack of employee.Employee. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type StackEmployee struct {
+	top  employee.Employee
+	rest *StackEmployee
+	size int
+}
+
+// NewStackEmployee creates stack from the list. Last item of the list is on top
+func NewStackEmployee(list []employee.Employee) *StackEmployee {
+	var s *StackEmployee
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *StackEmployee) Push(v employee.Employee) *StackEmployee {
+	return &StackEmployee{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *StackEmployee) Pop() *StackEmployee {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *StackEmployee) Peek() (employee.Employee, bool) {
+	if s == nil {
+		var zero employee.Employee
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *StackEmployee) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *StackEmployee) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStackEmployee(s.ToSlice()) is same as the stack
+func (s *StackEmployee) ToSlice() []employee.Employee {
+	list := make([]employee.Employee, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// QueueEmployee - persistent(immutable) FIFO queue of employee.Employee. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type QueueEmployee struct {
+	// front has the first item on top. rear has the last item on top
+	front *StackEmployee
+	rear  *StackEmployee
+}
+
+// NewQueueEmployee creates queue from the list. First item of the list is at the front
+func NewQueueEmployee(list []employee.Employee) *QueueEmployee {
+	if len(list) == 0 {
+		return nil
+	}
+	return &QueueEmployee{front: reversedStackEmployee(list)}
+}
+
+// reversedStackEmployee creates stack with the first item of the list on top
+func reversedStackEmployee(list []employee.Employee) *StackEmployee {
+	var s *StackEmployee
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *QueueEmployee) Push(v employee.Employee) *QueueEmployee {
+	if q == nil {
+		return &QueueEmployee{front: (*StackEmployee)(nil).Push(v)}
+	}
+	return &QueueEmployee{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See QueueEmployee
+func (q *QueueEmployee) Pop() *QueueEmployee {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &QueueEmployee{front: front, rear: q.rear}
+	}
+	return &QueueEmployee{front: reversedStackEmployee(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *QueueEmployee) Peek() (employee.Employee, bool) {
+	if q == nil {
+		var zero employee.Employee
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *QueueEmployee) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *QueueEmployee) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *QueueEmployee) ToSlice() []employee.Employee {
+	if q == nil {
+		return []employee.Employee{}
+	}
+	list := make([]employee.Employee, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// DequeEmployee - persistent(immutable) double-ended queue of employee.Employee. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type DequeEmployee struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *StackEmployee
+	back  *StackEmployee
+}
+
+// NewDequeEmployee creates deque from the list. First item of the list is at the front
+func NewDequeEmployee(list []employee.Employee) *DequeEmployee {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &DequeEmployee{front: reversedStackEmployee(list[:half]), back: NewStackEmployee(list[half:])}
+}
+
+// newDequeEmployee creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDequeEmployee(front, back *StackEmployee) *DequeEmployee {
+	d := &DequeEmployee{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDequeEmployee(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *DequeEmployee) PushFront(v employee.Employee) *DequeEmployee {
+	if d == nil {
+		return newDequeEmployee((*StackEmployee)(nil).Push(v), nil)
+	}
+	return newDequeEmployee(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *DequeEmployee) PushBack(v employee.Employee) *DequeEmployee {
+	if d == nil {
+		return newDequeEmployee(nil, (*StackEmployee)(nil).Push(v))
+	}
+	return newDequeEmployee(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployee) PopFront() *DequeEmployee {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployee(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *DequeEmployee) PopBack() *DequeEmployee {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDequeEmployee(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *DequeEmployee) PeekFront() (employee.Employee, bool) {
+	if d == nil {
+		var zero employee.Employee
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *DequeEmployee) PeekBack() (employee.Employee, bool) {
+	if d == nil {
+		var zero employee.Employee
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *DequeEmployee) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *DequeEmployee) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *DequeEmployee) ToSlice() []employee.Employee {
+	if d == nil {
+		return []employee.Employee{}
+	}
+	list := make([]employee.Employee, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueueEmployee - binary heap of employee.Employee. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike QueueEmployee, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueueEmployee struct {
+	key   func(employee.Employee) float64
+	items []priorityItemEmployee
+	seq   int
+}
+
+type priorityItemEmployee struct {
+	value employee.Employee
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueueEmployee creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueueEmployee(key func(employee.Employee) float64, list []employee.Employee) *PriorityQueueEmployee {
+	pq := &PriorityQueueEmployee{key: key, items: make([]priorityItemEmployee, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueueEmployee) Push(v employee.Employee) *PriorityQueueEmployee {
+	item := priorityItemEmployee{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployee) Pop() (employee.Employee, bool) {
+	if len(pq.items) == 0 {
+		var zero employee.Employee
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueueEmployee) Peek() (employee.Employee, bool) {
+	if len(pq.items) == 0 {
+		var zero employee.Employee
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueueEmployee) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueueEmployee) ToSlice() []employee.Employee {
+	clone := &PriorityQueueEmployee{items: make([]priorityItemEmployee, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]employee.Employee, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueueEmployee) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+
+// AtomEmployee - holds employee.Employee shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of employee.Employee
+type AtomEmployee struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   employee.Employee
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(employee.Employee) error
+	watches   map[string]func(key string, oldVal, newVal employee.Employee)
+}
+
+// NewAtomEmployee creates atom with the initial value
+func NewAtomEmployee(v employee.Employee) *AtomEmployee {
+	return &AtomEmployee{value: v}
+}
+
+// load returns the current value and its version
+func (a *AtomEmployee) load() (employee.Employee, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *AtomEmployee) store(version uint64, v employee.Employee) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *AtomEmployee) Deref() employee.Employee {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtomEmployee(0)
+//	counter.Swap(func(v employee.Employee) employee.Employee { return v + 1 })
+func (a *AtomEmployee) Swap(f func(employee.Employee) employee.Employee) (employee.Employee, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *AtomEmployee) Reset(v employee.Employee) error {
+	_, err := a.Swap(func(employee.Employee) employee.Employee { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *AtomEmployee) CompareAndSet(oldVal, newVal employee.Employee) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *AtomEmployee) SetValidator(validator func(employee.Employee) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AtomEmployee) validate(v employee.Employee) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *AtomEmployee) AddWatch(key string, f func(key string, oldVal, newVal employee.Employee)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, employee.Employee, employee.Employee))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *AtomEmployee) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *AtomEmployee) notify(oldVal, newVal employee.Employee) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, employee.Employee, employee.Employee), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+
+// AgentEmployee - holds employee.Employee which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type AgentEmployee struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   employee.Employee
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentActionEmployee
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentActionEmployee struct {
+	f     func(employee.Employee) employee.Employee
+	epoch int
+}
+
+// NewAgentEmployee creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgentEmployee(v employee.Employee, queueSize int) *AgentEmployee {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AgentEmployee{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgentEmployee(0, 100)
+//	total.Send(func(v employee.Employee) employee.Employee { return v + 1 })
+//	total.Await()
+func (a *AgentEmployee) Send(f func(employee.Employee) employee.Employee) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgentEmployee(0, 1)
+//	counter.SendNested(func(v employee.Employee, send func(func(employee.Employee) employee.Employee)) employee.Employee {
+//		send(func(v employee.Employee) employee.Employee { return v + 1 })
+//		return v + 1
+//	})
+func (a *AgentEmployee) SendNested(f func(employee.Employee, func(func(employee.Employee) employee.Employee)) employee.Employee) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v employee.Employee) employee.Employee {
+		var held []func(employee.Employee) employee.Employee
+		v = f(v, func(g func(employee.Employee) employee.Employee) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentActionEmployee{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *AgentEmployee) enqueue(f func(employee.Employee) employee.Employee) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentActionEmployee{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *AgentEmployee) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentActionEmployee{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *AgentEmployee) apply(f func(employee.Employee) employee.Employee, v employee.Employee) (newVal employee.Employee, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *AgentEmployee) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *AgentEmployee) Deref() employee.Employee {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *AgentEmployee) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *AgentEmployee) Restart(v employee.Employee) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+
+// OptionEmployee - employee.Employee which may be absent. The zero value is absent
+type OptionEmployee struct {
+	value employee.Employee
+	ok    bool
+}
+
+// SomeOptEmployee returns option with the value
+func SomeOptEmployee(v employee.Employee) OptionEmployee {
+	return OptionEmployee{value: v, ok: true}
+}
+
+// NoneOptEmployee returns option without value
+func NoneOptEmployee() OptionEmployee {
+	return OptionEmployee{}
+}
+
+// IsSome returns true if the option has value
+func (o OptionEmployee) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o OptionEmployee) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o OptionEmployee) Get() (employee.Employee, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o OptionEmployee) Unwrap() employee.Employee {
+	if !o.ok {
+		panic("OptionEmployee: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o OptionEmployee) OrElse(v employee.Employee) employee.Employee {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o OptionEmployee) OrElseGet(f func() employee.Employee) employee.Employee {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployee) Map(f func(employee.Employee) employee.Employee) OptionEmployee {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOptEmployee(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o OptionEmployee) FlatMap(f func(employee.Employee) OptionEmployee) OptionEmployee {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o OptionEmployee) Filter(pred func(employee.Employee) bool) OptionEmployee {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return OptionEmployee{}
+	}
+	return o
+}
+
+// ResultEmployee - employee.Employee or error. The zero value is Ok with zero value of employee.Employee
+type ResultEmployee struct {
+	value employee.Employee
+	err   error
+}
+
+// OkEmployee returns result with the value
+func OkEmployee(v employee.Employee) ResultEmployee {
+	return ResultEmployee{value: v}
+}
+
+// ErrEmployee returns result with the error
+func ErrEmployee(err error) ResultEmployee {
+	return ResultEmployee{err: err}
+}
+
+// ResultOfEmployee returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOfEmployee(f(v))
+func ResultOfEmployee(v employee.Employee, err error) ResultEmployee {
+	if err != nil {
+		return ResultEmployee{err: err}
+	}
+	return ResultEmployee{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r ResultEmployee) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r ResultEmployee) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r ResultEmployee) Get() (employee.Employee, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r ResultEmployee) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r ResultEmployee) Unwrap() employee.Employee {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r ResultEmployee) OrElse(v employee.Employee) employee.Employee {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployee) Map(f func(employee.Employee) employee.Employee) ResultEmployee {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return OkEmployee(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r ResultEmployee) FlatMap(f func(employee.Employee) ResultEmployee) ResultEmployee {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r ResultEmployee) Option() OptionEmployee {
+	if r.err != nil {
+		return OptionEmployee{}
+	}
+	return SomeOptEmployee(r.value)
+}
+
+// FindOptEmployee returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOptEmployee(pred func(employee.Employee) bool, list []employee.Employee) OptionEmployee {
+	if pred == nil {
+		return OptionEmployee{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOptEmployee(v)
+		}
+	}
+	return OptionEmployee{}
+}
+
+// NthOptEmployee returns the item at the index. Returns None if the index is out of range
+func NthOptEmployee(list []employee.Employee, i int) OptionEmployee {
+	if i < 0 || i >= len(list) {
+		return OptionEmployee{}
+	}
+	return SomeOptEmployee(list[i])
+}
+
+// ReduceOptEmployee reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOptEmployee(f func(employee.Employee, employee.Employee) employee.Employee, list []employee.Employee) OptionEmployee {
+	if f == nil || len(list) == 0 {
+		return OptionEmployee{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOptEmployee(acc)
+}
+
+// TraverseResultEmployee applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v employee.Employee) ResultEmployee { return ResultOfEmployee(check(v)) }
+//	TraverseResultEmployee(parse, list)
+func TraverseResultEmployee(f func(employee.Employee) ResultEmployee, list []employee.Employee) ([]employee.Employee, error) {
+	if f == nil {
+		return []employee.Employee{}, nil
+	}
+	newList := make([]employee.Employee, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+
+// MapEmployeeInto applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]employee.Employee, 0, 1024)
+//	for ... {
+//		buf = MapEmployeeInto(buf, f, list)
+//	}
+func MapEmployeeInto(dst []employee.Employee, f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]employee.Employee, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// FilterEmployeeInto stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func FilterEmployeeInto(dst []employee.Employee, f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DropEmployeeInto stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func DropEmployeeInto(dst []employee.Employee, item employee.Employee, list []employee.Employee) []employee.Employee {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// MapEmployeeInPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func MapEmployeeInPlace(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// FilterEmployeeInPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = FilterEmployeeInPlace(f, list)
+func FilterEmployeeInPlace(f func(employee.Employee) bool, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero employee.Employee
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+
+
+// MapEmployerEmployee takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployerEmployee(f func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	newList := make([]employee.Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployerEmployee applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employer.Employer output type: employee.Employee
+//	2. List
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployerEmployee(f func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	ch := make(chan map[int]employee.Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v employer.Employer) {
+			defer wg.Done()
+			ch <- map[int]employee.Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]employee.Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployerEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - employer.Employer and returns true/false.
+//	2. Function: takes employer.Employer as argument and returns employee.Employee
+// 	3. List of type employer.Employer
+//
+// Returns:
+//	New List of type employee.Employee
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployerEmployee(fFilter func(employer.Employer) bool, fMap func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
+	if fFilter == nil || fMap == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployerEmployee takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: employer.Employer output type: employee.Employee
+//
+// Returns
+//	Function which returns the list of type employee.Employee
+func JuxtEmployerEmployee(fs ...func(employer.Employer) employee.Employee) func(employer.Employer) []employee.Employee {
+	funcs := make([]func(employer.Employer) employee.Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v employer.Employer) []employee.Employee {
+		newList := make([]employee.Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployerEmployee(f func(employer.Employer) employee.Employee, opts ...fp.MemoizeOptions) func(employer.Employer) employee.Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v employer.Employer) employee.Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(employee.Employee)
+	}
+}
+
+// MapEmployerInt takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployerInt(f func(employer.Employer) int, list []employer.Employer) []int {
+	if f == nil {
+		return []int{}
+	}
+	newList := make([]int, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployerInt applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employer.Employer output type: int
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployerInt(f func(employer.Employer) int, list []employer.Employer) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	ch := make(chan map[int]int)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v employer.Employer) {
+			defer wg.Done()
+			ch <- map[int]int{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]int, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployerInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - employer.Employer and returns true/false.
+//	2. Function: takes employer.Employer as argument and returns int
+// 	3. List of type employer.Employer
+//
+// Returns:
+//	New List of type int
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployerInt(fFilter func(employer.Employer) bool, fMap func(employer.Employer) int, list []employer.Employer) []int {
+	if fFilter == nil || fMap == nil {
+		return []int{}
+	}
+	var newList []int
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployerInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: employer.Employer output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtEmployerInt(fs ...func(employer.Employer) int) func(employer.Employer) []int {
+	funcs := make([]func(employer.Employer) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v employer.Employer) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployerInt(f func(employer.Employer) int, opts ...fp.MemoizeOptions) func(employer.Employer) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v employer.Employer) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MapEmployeeEmployer takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployeeEmployer(f func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+	newList := make([]employer.Employer, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployeeEmployer applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employee.Employee output type: employer.Employer
+//	2. List
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployeeEmployer(f func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	ch := make(chan map[int]employer.Employer)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]employer.Employer, i int, v employee.Employee) {
+			defer wg.Done()
+			ch <- map[int]employer.Employer{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]employer.Employer, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployeeEmployer filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - employee.Employee and returns true/false.
+//	2. Function: takes employee.Employee as argument and returns employer.Employer
+// 	3. List of type employee.Employee
+//
+// Returns:
+//	New List of type employer.Employer
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployeeEmployer(fFilter func(employee.Employee) bool, fMap func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
+	if fFilter == nil || fMap == nil {
+		return []employer.Employer{}
+	}
+	var newList []employer.Employer
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployeeEmployer takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: employee.Employee output type: employer.Employer
+//
+// Returns
+//	Function which returns the list of type employer.Employer
+func JuxtEmployeeEmployer(fs ...func(employee.Employee) employer.Employer) func(employee.Employee) []employer.Employer {
+	funcs := make([]func(employee.Employee) employer.Employer, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v employee.Employee) []employer.Employer {
+		newList := make([]employer.Employer, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployeeEmployer(f func(employee.Employee) employer.Employer, opts ...fp.MemoizeOptions) func(employee.Employee) employer.Employer {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v employee.Employee) employer.Employer {
+		return memo.Get(v, func() interface{} { return f(v) }).(employer.Employer)
+	}
+}
+
+// MapEmployeeInt takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapEmployeeInt(f func(employee.Employee) int, list []employee.Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+	newList := make([]int, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapEmployeeInt applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employee.Employee output type: int
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if all arguments are nil or either one is nil
+func PMapEmployeeInt(f func(employee.Employee) int, list []employee.Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	ch := make(chan map[int]int)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v employee.Employee) {
+			defer wg.Done()
+			ch <- map[int]int{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]int, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapEmployeeInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - employee.Employee and returns true/false.
+//	2. Function: takes employee.Employee as argument and returns int
+// 	3. List of type employee.Employee
+//
+// Returns:
+//	New List of type int
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapEmployeeInt(fFilter func(employee.Employee) bool, fMap func(employee.Employee) int, list []employee.Employee) []int {
+	if fFilter == nil || fMap == nil {
+		return []int{}
+	}
+	var newList []int
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtEmployeeInt takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: employee.Employee output type: int
+//
+// Returns
+//	Function which returns the list of type int
+func JuxtEmployeeInt(fs ...func(employee.Employee) int) func(employee.Employee) []int {
+	funcs := make([]func(employee.Employee) int, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v employee.Employee) []int {
+		newList := make([]int, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeEmployeeInt(f func(employee.Employee) int, opts ...fp.MemoizeOptions) func(employee.Employee) int {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v employee.Employee) int {
+		return memo.Get(v, func() interface{} { return f(v) }).(int)
+	}
+}
+
+// MapIntEmployer takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapIntEmployer(f func(int) employer.Employer, list []int) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+	newList := make([]employer.Employer, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapIntEmployer applies the function(1st argument) on each item of the list and returns new list.
+// Run in parallel. no_of_goroutines = no_of_items_in_list
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int output type: employer.Employer
+//	2. List
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if all arguments are nil or either one is nil
+func PMapIntEmployer(f func(int) employer.Employer, list []int) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	ch := make(chan map[int]employer.Employer)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]employer.Employer, i int, v int) {
+			defer wg.Done()
+			ch <- map[int]employer.Employer{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]employer.Employer, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapIntEmployer filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - int and returns true/false.
+//	2. Function: takes int as argument and returns employer.Employer
+// 	3. List of type int
+//
+// Returns:
+//	New List of type employer.Employer
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapIntEmployer(fFilter func(int) bool, fMap func(int) employer.Employer, list []int) []employer.Employer {
+	if fFilter == nil || fMap == nil {
+		return []employer.Employer{}
+	}
+	var newList []employer.Employer
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtIntEmployer takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: employer.Employer
+//
+// Returns
+//	Function which returns the list of type employer.Employer
+func JuxtIntEmployer(fs ...func(int) employer.Employer) func(int) []employer.Employer {
+	funcs := make([]func(int) employer.Employer, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []employer.Employer {
+		newList := make([]employer.Employer, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeIntEmployer(f func(int) employer.Employer, opts ...fp.MemoizeOptions) func(int) employer.Employer {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v int) employer.Employer {
+		return memo.Get(v, func() interface{} { return f(v) }).(employer.Employer)
+	}
+}
+
+// MapIntEmployee takes two inputs -
+// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
+func MapIntEmployee(f func(int) employee.Employee, list []int) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+	newList := make([]employee.Employee, len(list))
+	for i, v := range list {
+		newList[i] = f(v)
+	}
+	return newList
+}
+
+// PMapIntEmployee applies the function(1st argument) on each item of the list and returns new list.
 // Run in parallel. no_of_goroutines = no_of_items_in_list
 //
 // Takes 2 inputs
-//	1. Function - takes 1 input type: employer.Employer output type: employee.Employee
+//	1. Function - takes 1 input type: int output type: employee.Employee
+//	2. List
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if all arguments are nil or either one is nil
+func PMapIntEmployee(f func(int) employee.Employee, list []int) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	ch := make(chan map[int]employee.Employee)
+	var wg sync.WaitGroup
+
+	for i, v := range list {
+		wg.Add(1)
+
+		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v int) {
+			defer wg.Done()
+			ch <- map[int]employee.Employee{i: f(v)}
+		}(&wg, ch, i, v)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	newList := make([]employee.Employee, len(list))
+	for m := range ch {
+		for k, v := range m {
+			newList[k] = v
+		}
+	}
+	return newList
+}
+
+// FilterMapIntEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// Takes 3 inputs
+//	1. Function: takes one input type - int and returns true/false.
+//	2. Function: takes int as argument and returns employee.Employee
+// 	3. List of type int
+//
+// Returns:
+//	New List of type employee.Employee
+//  Empty list if all there parameters are nil or either of parameter is nil
+func FilterMapIntEmployee(fFilter func(int) bool, fMap func(int) employee.Employee, list []int) []employee.Employee {
+	if fFilter == nil || fMap == nil {
+		return []employee.Employee{}
+	}
+	var newList []employee.Employee
+	for _, v := range list {
+		if fFilter(v) {
+			newList = append(newList, fMap(v))
+		}
+	}
+	return newList
+}
+
+// JuxtIntEmployee takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: int output type: employee.Employee
+//
+// Returns
+//	Function which returns the list of type employee.Employee
+func JuxtIntEmployee(fs ...func(int) employee.Employee) func(int) []employee.Employee {
+	funcs := make([]func(int) employee.Employee, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v int) []employee.Employee {
+		newList := make([]employee.Employee, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+
+func MemoizeIntEmployee(f func(int) employee.Employee, opts ...fp.MemoizeOptions) func(int) employee.Employee {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v int) employee.Employee {
+		return memo.Get(v, func() interface{} { return f(v) }).(employee.Employee)
+	}
+}
+
+
+// MergeEmployer takes two or more inputs of type map[employer.Employer]employer.Employer and merge them and returns a new map[employer.Employer]employer.Employer.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployer(map1, map2 map[employer.Employer]employer.Employer, maps ...map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployer merges maps of type map[employer.Employer]employer.Employer and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployer(f func(employer.Employer, employer.Employer) employer.Employer, maps ...map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployer returns list of keys of the map[employer.Employer]employer.Employer.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployer(m map[employer.Employer]employer.Employer, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	keys := make([]employer.Employer, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployer returns list of values of the map[employer.Employer]employer.Employer.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployer(m map[employer.Employer]employer.Employer, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	vals := make([]employer.Employer, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployer returns a new map which contains only the given keys of the map.
+func SelectKeysEmployer(m map[employer.Employer]employer.Employer, keys ...employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployer returns a new map without the given keys.
+func DissocKeysEmployer(m map[employer.Employer]employer.Employer, keys ...employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployer returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployer(m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployer applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployer(f func(employer.Employer) employer.Employer, m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	if f == nil {
+		return map[employer.Employer]employer.Employer{}
+	}
+	newMap := make(map[employer.Employer]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployer applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployer(f func(employer.Employer) employer.Employer, m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	if f == nil {
+		return map[employer.Employer]employer.Employer{}
+	}
+	newMap := make(map[employer.Employer]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployer returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployer(pred func(employer.Employer) bool, m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	if pred == nil {
+		return map[employer.Employer]employer.Employer{}
+	}
+	return FilterKVEmployer(func(k employer.Employer, _ employer.Employer) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployer returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployer(pred func(employer.Employer) bool, m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	if pred == nil {
+		return map[employer.Employer]employer.Employer{}
+	}
+	return FilterKVEmployer(func(_ employer.Employer, v employer.Employer) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployer returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployer(pred func(employer.Employer, employer.Employer) bool, m map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployer reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: employer.Employer, employer.Employer, employer.Employer and returns employer.Employer
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployer(f func(employer.Employer, employer.Employer, employer.Employer) employer.Employer, m map[employer.Employer]employer.Employer, initializer employer.Employer) employer.Employer {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployer takes two inputs: first list of type: []employer.Employer, second list of type: []employer.Employer.
+// Then it merges two list and returns a new map of type: map[employer.Employer]employer.Employer
+func ZipEmployer(list1 []employer.Employer, list2 []employer.Employer) map[employer.Employer]employer.Employer {
+	newMap := make(map[employer.Employer]employer.Employer)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployer removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employer.Employer and returns key of type: employer.Employer
+//	2. List
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if either of arguments is nil
+func DistinctByEmployer(f func(employer.Employer) employer.Employer, list []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	newList := make([]employer.Employer, 0, len(list))
+	s := make(map[employer.Employer]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployerEmployer holds two values: First of type employer.Employer and Second of type employer.Employer.
+type PairEmployerEmployer struct {
+	First  employer.Employer
+	Second employer.Employer
+}
+
+// ZipPairsEmployer takes two inputs: first list of type: []employer.Employer, second list of type: []employer.Employer.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployer, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployer(list1 []employer.Employer, list2 []employer.Employer) []PairEmployerEmployer {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployerEmployer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployerEmployer{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployer takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployer
+func UnzipEmployer(pairs []PairEmployerEmployer) ([]employer.Employer, []employer.Employer) {
+	list1 := make([]employer.Employer, len(pairs))
+	list2 := make([]employer.Employer, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployerEmployerEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employer.Employer and returns type: employer.Employer
+//	2. List of type employer.Employer
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithEmployerEmployerEmployer(f func(employer.Employer, employer.Employer) employer.Employer, list1 []employer.Employer, list2 []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employer.Employer and returns type: employer.Employer
+//	2. List of type employer.Employer
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithEmployer(f func(employer.Employer, employer.Employer) employer.Employer, list1 []employer.Employer, list2 []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerEmployerEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employer.Employer and returns type: employee.Employee
+//	2. List of type employer.Employer
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployerEmployerEmployee(f func(employer.Employer, employer.Employer) employee.Employee, list1 []employer.Employer, list2 []employer.Employer) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerEmployerInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employer.Employer and returns type: int
+//	2. List of type employer.Employer
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployerEmployerInt(f func(employer.Employer, employer.Employer) int, list1 []employer.Employer, list2 []employer.Employer) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployerEmployee takes two or more inputs of type map[employer.Employer]employee.Employee and merge them and returns a new map[employer.Employer]employee.Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployerEmployee(map1, map2 map[employer.Employer]employee.Employee, maps ...map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployerEmployee merges maps of type map[employer.Employer]employee.Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployerEmployee(f func(employee.Employee, employee.Employee) employee.Employee, maps ...map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployerEmployee returns list of keys of the map[employer.Employer]employee.Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployerEmployee(m map[employer.Employer]employee.Employee, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	keys := make([]employer.Employer, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployerEmployee returns list of values of the map[employer.Employer]employee.Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployerEmployee(m map[employer.Employer]employee.Employee, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	vals := make([]employee.Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployerEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysEmployerEmployee(m map[employer.Employer]employee.Employee, keys ...employer.Employer) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployerEmployee returns a new map without the given keys.
+func DissocKeysEmployerEmployee(m map[employer.Employer]employee.Employee, keys ...employer.Employer) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployerEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployerEmployee(m map[employer.Employer]employee.Employee) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployerEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployerEmployee(f func(employee.Employee) employee.Employee, m map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	if f == nil {
+		return map[employer.Employer]employee.Employee{}
+	}
+	newMap := make(map[employer.Employer]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployerEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployerEmployee(f func(employer.Employer) employer.Employer, m map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	if f == nil {
+		return map[employer.Employer]employee.Employee{}
+	}
+	newMap := make(map[employer.Employer]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployerEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployerEmployee(pred func(employer.Employer) bool, m map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	if pred == nil {
+		return map[employer.Employer]employee.Employee{}
+	}
+	return FilterKVEmployerEmployee(func(k employer.Employer, _ employee.Employee) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployerEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployerEmployee(pred func(employee.Employee) bool, m map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	if pred == nil {
+		return map[employer.Employer]employee.Employee{}
+	}
+	return FilterKVEmployerEmployee(func(_ employer.Employer, v employee.Employee) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployerEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployerEmployee(pred func(employer.Employer, employee.Employee) bool, m map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployerEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: employee.Employee, employer.Employer, employee.Employee and returns employee.Employee
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployerEmployee(f func(employee.Employee, employer.Employer, employee.Employee) employee.Employee, m map[employer.Employer]employee.Employee, initializer employee.Employee) employee.Employee {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployerEmployee takes two inputs: first list of type: []employer.Employer, second list of type: []employee.Employee.
+// Then it merges two list and returns a new map of type: map[employer.Employer]employee.Employee
+func ZipEmployerEmployee(list1 []employer.Employer, list2 []employee.Employee) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployerEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employer.Employer and returns key of type: employee.Employee
+//	2. List
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if either of arguments is nil
+func DistinctByEmployerEmployee(f func(employer.Employer) employee.Employee, list []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	newList := make([]employer.Employer, 0, len(list))
+	s := make(map[employee.Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployerEmployee holds two values: First of type employer.Employer and Second of type employee.Employee.
+type PairEmployerEmployee struct {
+	First  employer.Employer
+	Second employee.Employee
+}
+
+// ZipPairsEmployerEmployee takes two inputs: first list of type: []employer.Employer, second list of type: []employee.Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployerEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployerEmployee(list1 []employer.Employer, list2 []employee.Employee) []PairEmployerEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployerEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployerEmployee{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployerEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployerEmployee
+func UnzipEmployerEmployee(pairs []PairEmployerEmployee) ([]employer.Employer, []employee.Employee) {
+	list1 := make([]employer.Employer, len(pairs))
+	list2 := make([]employee.Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployerEmployeeEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employee.Employee and returns type: employer.Employer
+//	2. List of type employer.Employer
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithEmployerEmployeeEmployer(f func(employer.Employer, employee.Employee) employer.Employer, list1 []employer.Employer, list2 []employee.Employee) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employee.Employee and returns type: employee.Employee
+//	2. List of type employer.Employer
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployerEmployeeEmployee(f func(employer.Employer, employee.Employee) employee.Employee, list1 []employer.Employer, list2 []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, employee.Employee and returns type: int
+//	2. List of type employer.Employer
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployerEmployeeInt(f func(employer.Employer, employee.Employee) int, list1 []employer.Employer, list2 []employee.Employee) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployerInt takes two or more inputs of type map[employer.Employer]int and merge them and returns a new map[employer.Employer]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployerInt(map1, map2 map[employer.Employer]int, maps ...map[employer.Employer]int) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployerInt merges maps of type map[employer.Employer]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployerInt(f func(int, int) int, maps ...map[employer.Employer]int) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployerInt returns list of keys of the map[employer.Employer]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployerInt(m map[employer.Employer]int, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	keys := make([]employer.Employer, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployerInt returns list of values of the map[employer.Employer]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployerInt(m map[employer.Employer]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployerInt returns a new map which contains only the given keys of the map.
+func SelectKeysEmployerInt(m map[employer.Employer]int, keys ...employer.Employer) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployerInt returns a new map without the given keys.
+func DissocKeysEmployerInt(m map[employer.Employer]int, keys ...employer.Employer) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployerInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployerInt(m map[employer.Employer]int) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployerInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployerInt(f func(int) int, m map[employer.Employer]int) map[employer.Employer]int {
+	if f == nil {
+		return map[employer.Employer]int{}
+	}
+	newMap := make(map[employer.Employer]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployerInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployerInt(f func(employer.Employer) employer.Employer, m map[employer.Employer]int) map[employer.Employer]int {
+	if f == nil {
+		return map[employer.Employer]int{}
+	}
+	newMap := make(map[employer.Employer]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployerInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployerInt(pred func(employer.Employer) bool, m map[employer.Employer]int) map[employer.Employer]int {
+	if pred == nil {
+		return map[employer.Employer]int{}
+	}
+	return FilterKVEmployerInt(func(k employer.Employer, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployerInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployerInt(pred func(int) bool, m map[employer.Employer]int) map[employer.Employer]int {
+	if pred == nil {
+		return map[employer.Employer]int{}
+	}
+	return FilterKVEmployerInt(func(_ employer.Employer, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployerInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployerInt(pred func(employer.Employer, int) bool, m map[employer.Employer]int) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployerInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, employer.Employer, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployerInt(f func(int, employer.Employer, int) int, m map[employer.Employer]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployerInt takes two inputs: first list of type: []employer.Employer, second list of type: []int.
+// Then it merges two list and returns a new map of type: map[employer.Employer]int
+func ZipEmployerInt(list1 []employer.Employer, list2 []int) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployerInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employer.Employer and returns key of type: int
+//	2. List
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if either of arguments is nil
+func DistinctByEmployerInt(f func(employer.Employer) int, list []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	newList := make([]employer.Employer, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+
+// PairEmployerInt holds two values: First of type employer.Employer and Second of type int.
+type PairEmployerInt struct {
+	First  employer.Employer
+	Second int
+}
+
+// ZipPairsEmployerInt takes two inputs: first list of type: []employer.Employer, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployerInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployerInt(list1 []employer.Employer, list2 []int) []PairEmployerInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]PairEmployerInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployerInt{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// UnzipEmployerInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployerInt
+func UnzipEmployerInt(pairs []PairEmployerInt) ([]employer.Employer, []int) {
+	list1 := make([]employer.Employer, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployerIntEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, int and returns type: employer.Employer
+//	2. List of type employer.Employer
+//	3. List of type int
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithEmployerIntEmployer(f func(employer.Employer, int) employer.Employer, list1 []employer.Employer, list2 []int) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerIntEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, int and returns type: employee.Employee
+//	2. List of type employer.Employer
+//	3. List of type int
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployerIntEmployee(f func(employer.Employer, int) employee.Employee, list1 []employer.Employer, list2 []int) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// ZipWithEmployerIntInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employer.Employer, int and returns type: int
+//	2. List of type employer.Employer
+//	3. List of type int
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployerIntInt(f func(employer.Employer, int) int, list1 []employer.Employer, list2 []int) []int {
+	if f == nil {
+		return []int{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+
+// MergeEmployeeEmployer takes two or more inputs of type map[employee.Employee]employer.Employer and merge them and returns a new map[employee.Employee]employer.Employer.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployeeEmployer(map1, map2 map[employee.Employee]employer.Employer, maps ...map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
+	}
+
+	for k, v := range map2 {
+		newMap[k] = v
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// MergeWithEmployeeEmployer merges maps of type map[employee.Employee]employer.Employer and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployeeEmployer(f func(employer.Employer, employer.Employer) employer.Employer, maps ...map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployeeEmployer returns list of keys of the map[employee.Employee]employer.Employer.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployeeEmployer(m map[employee.Employee]employer.Employer, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	keys := make([]employee.Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployeeEmployer returns list of values of the map[employee.Employee]employer.Employer.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployeeEmployer(m map[employee.Employee]employer.Employer, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	vals := make([]employer.Employer, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployeeEmployer returns a new map which contains only the given keys of the map.
+func SelectKeysEmployeeEmployer(m map[employee.Employee]employer.Employer, keys ...employee.Employee) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployeeEmployer returns a new map without the given keys.
+func DissocKeysEmployeeEmployer(m map[employee.Employee]employer.Employer, keys ...employee.Employee) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployeeEmployer returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployeeEmployer(m map[employee.Employee]employer.Employer) map[employer.Employer]employee.Employee {
+	newMap := make(map[employer.Employer]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployeeEmployer applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployeeEmployer(f func(employer.Employer) employer.Employer, m map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	if f == nil {
+		return map[employee.Employee]employer.Employer{}
+	}
+	newMap := make(map[employee.Employee]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployeeEmployer applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployeeEmployer(f func(employee.Employee) employee.Employee, m map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	if f == nil {
+		return map[employee.Employee]employer.Employer{}
+	}
+	newMap := make(map[employee.Employee]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployeeEmployer returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployeeEmployer(pred func(employee.Employee) bool, m map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	if pred == nil {
+		return map[employee.Employee]employer.Employer{}
+	}
+	return FilterKVEmployeeEmployer(func(k employee.Employee, _ employer.Employer) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployeeEmployer returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployeeEmployer(pred func(employer.Employer) bool, m map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	if pred == nil {
+		return map[employee.Employee]employer.Employer{}
+	}
+	return FilterKVEmployeeEmployer(func(_ employee.Employee, v employer.Employer) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployeeEmployer returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployeeEmployer(pred func(employee.Employee, employer.Employer) bool, m map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployeeEmployer reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: employer.Employer, employee.Employee, employer.Employer and returns employer.Employer
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployeeEmployer(f func(employer.Employer, employee.Employee, employer.Employer) employer.Employer, m map[employee.Employee]employer.Employer, initializer employer.Employer) employer.Employer {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployeeEmployer takes two inputs: first list of type: []employee.Employee, second list of type: []employer.Employer.
+// Then it merges two list and returns a new map of type: map[employee.Employee]employer.Employer
+func ZipEmployeeEmployer(list1 []employee.Employee, list2 []employer.Employer) map[employee.Employee]employer.Employer {
+	newMap := make(map[employee.Employee]employer.Employer)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployeeEmployer removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employee.Employee and returns key of type: employer.Employer
 //	2. List
 //
 // Returns
 //	New List of type employee.Employee
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployerEmployee(f func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
+//	Empty list if either of arguments is nil
+func DistinctByEmployeeEmployer(f func(employee.Employee) employer.Employer, list []employee.Employee) []employee.Employee {
 	if f == nil {
 		return []employee.Employee{}
 	}
 
-	ch := make(chan map[int]employee.Employee)
-	var wg sync.WaitGroup
+	newList := make([]employee.Employee, 0, len(list))
+	s := make(map[employer.Employer]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
 
-	for i, v := range list {
-		wg.Add(1)
+// PairEmployeeEmployer holds two values: First of type employee.Employee and Second of type employer.Employer.
+type PairEmployeeEmployer struct {
+	First  employee.Employee
+	Second employer.Employer
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v employer.Employer) {
-			defer wg.Done()
-			ch <- map[int]employee.Employee{i: f(v)}
-		}(&wg, ch, i, v)
+// ZipPairsEmployeeEmployer takes two inputs: first list of type: []employee.Employee, second list of type: []employer.Employer.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployeeEmployer, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployeeEmployer(list1 []employee.Employee, list2 []employer.Employer) []PairEmployeeEmployer {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]employee.Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]PairEmployeeEmployer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeEmployer{First: list1[i], Second: list2[i]}
 	}
 	return newList
 }
 
-// FilterMapEmployerEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// UnzipEmployeeEmployer takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployeeEmployer
+func UnzipEmployeeEmployer(pairs []PairEmployeeEmployer) ([]employee.Employee, []employer.Employer) {
+	list1 := make([]employee.Employee, len(pairs))
+	list2 := make([]employer.Employer, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeEmployerEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - employer.Employer and returns true/false.
-//	2. Function: takes employer.Employer as argument and returns employee.Employee
-// 	3. List of type employer.Employer
+//	1. Function - takes 2 inputs type: employee.Employee, employer.Employer and returns type: employer.Employer
+//	2. List of type employee.Employee
+//	3. List of type employer.Employer
 //
-// Returns:
-//	New List of type employee.Employee
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployerEmployee(fFilter func(employer.Employer) bool, fMap func(employer.Employer) employee.Employee, list []employer.Employer) []employee.Employee {
-	if fFilter == nil || fMap == nil {
-		return []employee.Employee{}
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployerEmployer(f func(employee.Employee, employer.Employer) employer.Employer, list1 []employee.Employee, list2 []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
 	}
-	var newList []employee.Employee
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// MapEmployerInt takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployerInt(f func(employer.Employer) int, list []employer.Employer) []int {
+// ZipWithEmployeeEmployerEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, employer.Employer and returns type: employee.Employee
+//	2. List of type employee.Employee
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployerEmployee(f func(employee.Employee, employer.Employer) employee.Employee, list1 []employee.Employee, list2 []employer.Employer) []employee.Employee {
 	if f == nil {
-		return []int{}
+		return []employee.Employee{}
 	}
-	newList := make([]int, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapEmployerInt applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithEmployeeEmployerInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: employer.Employer output type: int
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, employer.Employer and returns type: int
+//	2. List of type employee.Employee
+//	3. List of type employer.Employer
 //
 // Returns
 //	New List of type int
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployerInt(f func(employer.Employer) int, list []employer.Employer) []int {
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployerInt(f func(employee.Employee, employer.Employer) int, list1 []employee.Employee, list2 []employer.Employer) []int {
 	if f == nil {
 		return []int{}
 	}
 
-	ch := make(chan map[int]int)
-	var wg sync.WaitGroup
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	for i, v := range list {
-		wg.Add(1)
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v employer.Employer) {
-			defer wg.Done()
-			ch <- map[int]int{i: f(v)}
-		}(&wg, ch, i, v)
+// MergeEmployee takes two or more inputs of type map[employee.Employee]employee.Employee and merge them and returns a new map[employee.Employee]employee.Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployee(map1, map2 map[employee.Employee]employee.Employee, maps ...map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	newList := make([]int, len(list))
-	for m := range ch {
+	for _, m := range maps {
 		for k, v := range m {
-			newList[k] = v
+			newMap[k] = v
 		}
 	}
-	return newList
+
+	return newMap
 }
 
-// FilterMapEmployerInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// MergeWithEmployee merges maps of type map[employee.Employee]employee.Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployee(f func(employee.Employee, employee.Employee) employee.Employee, maps ...map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
+	}
+
+	return newMap
+}
+
+// KeysEmployee returns list of keys of the map[employee.Employee]employee.Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployee(m map[employee.Employee]employee.Employee, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	keys := make([]employee.Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployee returns list of values of the map[employee.Employee]employee.Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployee(m map[employee.Employee]employee.Employee, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	vals := make([]employee.Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysEmployee(m map[employee.Employee]employee.Employee, keys ...employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeysEmployee returns a new map without the given keys.
+func DissocKeysEmployee(m map[employee.Employee]employee.Employee, keys ...employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployee(m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployee(f func(employee.Employee) employee.Employee, m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	if f == nil {
+		return map[employee.Employee]employee.Employee{}
+	}
+	newMap := make(map[employee.Employee]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeysEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployee(f func(employee.Employee) employee.Employee, m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	if f == nil {
+		return map[employee.Employee]employee.Employee{}
+	}
+	newMap := make(map[employee.Employee]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployee(pred func(employee.Employee) bool, m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	if pred == nil {
+		return map[employee.Employee]employee.Employee{}
+	}
+	return FilterKVEmployee(func(k employee.Employee, _ employee.Employee) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployee(pred func(employee.Employee) bool, m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	if pred == nil {
+		return map[employee.Employee]employee.Employee{}
+	}
+	return FilterKVEmployee(func(_ employee.Employee, v employee.Employee) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployee(pred func(employee.Employee, employee.Employee) bool, m map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - employer.Employer and returns true/false.
-//	2. Function: takes employer.Employer as argument and returns int
-// 	3. List of type employer.Employer
+//	1. Function - takes 3 inputs: employee.Employee, employee.Employee, employee.Employee and returns employee.Employee
+//	2. Map
+//	3. Initial value
 //
-// Returns:
-//	New List of type int
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployerInt(fFilter func(employer.Employer) bool, fMap func(employer.Employer) int, list []employer.Employer) []int {
-	if fFilter == nil || fMap == nil {
-		return []int{}
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployee(f func(employee.Employee, employee.Employee, employee.Employee) employee.Employee, m map[employee.Employee]employee.Employee, initializer employee.Employee) employee.Employee {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployee takes two inputs: first list of type: []employee.Employee, second list of type: []employee.Employee.
+// Then it merges two list and returns a new map of type: map[employee.Employee]employee.Employee
+func ZipEmployee(list1 []employee.Employee, list2 []employee.Employee) map[employee.Employee]employee.Employee {
+	newMap := make(map[employee.Employee]employee.Employee)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: employee.Employee and returns key of type: employee.Employee
+//	2. List
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if either of arguments is nil
+func DistinctByEmployee(f func(employee.Employee) employee.Employee, list []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
 	}
-	var newList []int
+
+	newList := make([]employee.Employee, 0, len(list))
+	s := make(map[employee.Employee]struct{}, len(list))
 	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
 	return newList
 }
 
-// MapEmployeeEmployer takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployeeEmployer(f func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
-	if f == nil {
-		return []employer.Employer{}
+// PairEmployeeEmployee holds two values: First of type employee.Employee and Second of type employee.Employee.
+type PairEmployeeEmployee struct {
+	First  employee.Employee
+	Second employee.Employee
+}
+
+// ZipPairsEmployee takes two inputs: first list of type: []employee.Employee, second list of type: []employee.Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployee(list1 []employee.Employee, list2 []employee.Employee) []PairEmployeeEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
-	newList := make([]employer.Employer, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	newList := make([]PairEmployeeEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeEmployee{First: list1[i], Second: list2[i]}
 	}
 	return newList
 }
 
-// PMapEmployeeEmployer applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// UnzipEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployee
+func UnzipEmployee(pairs []PairEmployeeEmployee) ([]employee.Employee, []employee.Employee) {
+	list1 := make([]employee.Employee, len(pairs))
+	list2 := make([]employee.Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeEmployeeEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: employee.Employee output type: employer.Employer
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, employee.Employee and returns type: employer.Employer
+//	2. List of type employee.Employee
+//	3. List of type employee.Employee
 //
 // Returns
 //	New List of type employer.Employer
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployeeEmployer(f func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeEmployer(f func(employee.Employee, employee.Employee) employer.Employer, list1 []employee.Employee, list2 []employee.Employee) []employer.Employer {
 	if f == nil {
 		return []employer.Employer{}
 	}
 
-	ch := make(chan map[int]employer.Employer)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]employer.Employer, i int, v employee.Employee) {
-			defer wg.Done()
-			ch <- map[int]employer.Employer{i: f(v)}
-		}(&wg, ch, i, v)
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]employer.Employer, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// FilterMapEmployeeEmployer filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithEmployeeEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - employee.Employee and returns true/false.
-//	2. Function: takes employee.Employee as argument and returns employer.Employer
-// 	3. List of type employee.Employee
+//	1. Function - takes 2 inputs type: employee.Employee, employee.Employee and returns type: employee.Employee
+//	2. List of type employee.Employee
+//	3. List of type employee.Employee
 //
-// Returns:
-//	New List of type employer.Employer
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployeeEmployer(fFilter func(employee.Employee) bool, fMap func(employee.Employee) employer.Employer, list []employee.Employee) []employer.Employer {
-	if fFilter == nil || fMap == nil {
-		return []employer.Employer{}
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeEmployee(f func(employee.Employee, employee.Employee) employee.Employee, list1 []employee.Employee, list2 []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
 	}
-	var newList []employer.Employer
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// MapEmployeeInt takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapEmployeeInt(f func(employee.Employee) int, list []employee.Employee) []int {
+// ZipWithEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, employee.Employee and returns type: employee.Employee
+//	2. List of type employee.Employee
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithEmployee(f func(employee.Employee, employee.Employee) employee.Employee, list1 []employee.Employee, list2 []employee.Employee) []employee.Employee {
 	if f == nil {
-		return []int{}
+		return []employee.Employee{}
 	}
-	newList := make([]int, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapEmployeeInt applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithEmployeeEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: employee.Employee output type: int
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, employee.Employee and returns type: int
+//	2. List of type employee.Employee
+//	3. List of type employee.Employee
 //
 // Returns
 //	New List of type int
-//	Empty list if all arguments are nil or either one is nil
-func PMapEmployeeInt(f func(employee.Employee) int, list []employee.Employee) []int {
+//	Empty list if the function is nil
+func ZipWithEmployeeEmployeeInt(f func(employee.Employee, employee.Employee) int, list1 []employee.Employee, list2 []employee.Employee) []int {
 	if f == nil {
 		return []int{}
 	}
 
-	ch := make(chan map[int]int)
-	var wg sync.WaitGroup
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
 
-	for i, v := range list {
-		wg.Add(1)
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]int, i int, v employee.Employee) {
-			defer wg.Done()
-			ch <- map[int]int{i: f(v)}
-		}(&wg, ch, i, v)
+// MergeEmployeeInt takes two or more inputs of type map[employee.Employee]int and merge them and returns a new map[employee.Employee]int.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeEmployeeInt(map1, map2 map[employee.Employee]int, maps ...map[employee.Employee]int) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int, len(map1)+len(map2))
+
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	newList := make([]int, len(list))
-	for m := range ch {
+	for _, m := range maps {
 		for k, v := range m {
-			newList[k] = v
+			newMap[k] = v
 		}
 	}
-	return newList
+
+	return newMap
 }
 
-// FilterMapEmployeeInt filters given list, then apply function(2nd argument) on each item in the list and returns a new list
-// Takes 3 inputs
-//	1. Function: takes one input type - employee.Employee and returns true/false.
-//	2. Function: takes employee.Employee as argument and returns int
-// 	3. List of type employee.Employee
+// MergeWithEmployeeInt merges maps of type map[employee.Employee]int and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
 //
-// Returns:
-//	New List of type int
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapEmployeeInt(fFilter func(employee.Employee) bool, fMap func(employee.Employee) int, list []employee.Employee) []int {
-	if fFilter == nil || fMap == nil {
-		return []int{}
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithEmployeeInt(f func(int, int) int, maps ...map[employee.Employee]int) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
 	}
-	var newList []int
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
+
+	return newMap
+}
+
+// KeysEmployeeInt returns list of keys of the map[employee.Employee]int.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysEmployeeInt(m map[employee.Employee]int, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	keys := make([]employee.Employee, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// ValsEmployeeInt returns list of values of the map[employee.Employee]int.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsEmployeeInt(m map[employee.Employee]int, less ...func(int, int) bool) []int {
+	vals := make([]int, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeysEmployeeInt returns a new map which contains only the given keys of the map.
+func SelectKeysEmployeeInt(m map[employee.Employee]int, keys ...employee.Employee) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
 		}
 	}
-	return newList
+	return newMap
 }
 
-// MapIntEmployer takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapIntEmployer(f func(int) employer.Employer, list []int) []employer.Employer {
+// DissocKeysEmployeeInt returns a new map without the given keys.
+func DissocKeysEmployeeInt(m map[employee.Employee]int, keys ...employee.Employee) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// InvertEmployeeInt returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertEmployeeInt(m map[employee.Employee]int) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapValsEmployeeInt applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsEmployeeInt(f func(int) int, m map[employee.Employee]int) map[employee.Employee]int {
 	if f == nil {
-		return []employer.Employer{}
+		return map[employee.Employee]int{}
 	}
-	newList := make([]employer.Employer, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+	newMap := make(map[employee.Employee]int, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
-	return newList
+	return newMap
 }
 
-// PMapIntEmployer applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// MapKeysEmployeeInt applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysEmployeeInt(f func(employee.Employee) employee.Employee, m map[employee.Employee]int) map[employee.Employee]int {
+	if f == nil {
+		return map[employee.Employee]int{}
+	}
+	newMap := make(map[employee.Employee]int, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeysEmployeeInt returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysEmployeeInt(pred func(employee.Employee) bool, m map[employee.Employee]int) map[employee.Employee]int {
+	if pred == nil {
+		return map[employee.Employee]int{}
+	}
+	return FilterKVEmployeeInt(func(k employee.Employee, _ int) bool { return pred(k) }, m)
+}
+
+// FilterValsEmployeeInt returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsEmployeeInt(pred func(int) bool, m map[employee.Employee]int) map[employee.Employee]int {
+	if pred == nil {
+		return map[employee.Employee]int{}
+	}
+	return FilterKVEmployeeInt(func(_ employee.Employee, v int) bool { return pred(v) }, m)
+}
+
+// FilterKVEmployeeInt returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVEmployeeInt(pred func(employee.Employee, int) bool, m map[employee.Employee]int) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKVEmployeeInt reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: int, employee.Employee, int and returns int
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVEmployeeInt(f func(int, employee.Employee, int) int, m map[employee.Employee]int, initializer int) int {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+
+// ZipEmployeeInt takes two inputs: first list of type: []employee.Employee, second list of type: []int.
+// Then it merges two list and returns a new map of type: map[employee.Employee]int
+func ZipEmployeeInt(list1 []employee.Employee, list2 []int) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int)
+
+	len1 := len(list1)
+	len2 := len(list2)
+
+	if len1 == 0 || len2 == 0 {
+		return newMap
+	}
+
+	minLen := len1
+	if len2 < minLen {
+		minLen = len2
+	}
+
+	for i := 0; i < minLen; i++ {
+		newMap[list1[i]] = list2[i]
+	}
+
+	return newMap
+}
+
+// DistinctByEmployeeInt removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
 //
 // Takes 2 inputs
-//	1. Function - takes 1 input type: int output type: employer.Employer
+//	1. Function - takes 1 input type: employee.Employee and returns key of type: int
 //	2. List
 //
 // Returns
-//	New List of type employer.Employer
-//	Empty list if all arguments are nil or either one is nil
-func PMapIntEmployer(f func(int) employer.Employer, list []int) []employer.Employer {
+//	New List of type employee.Employee
+//	Empty list if either of arguments is nil
+func DistinctByEmployeeInt(f func(employee.Employee) int, list []employee.Employee) []employee.Employee {
 	if f == nil {
-		return []employer.Employer{}
+		return []employee.Employee{}
+	}
+
+	newList := make([]employee.Employee, 0, len(list))
+	s := make(map[int]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	ch := make(chan map[int]employer.Employer)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
+// PairEmployeeInt holds two values: First of type employee.Employee and Second of type int.
+type PairEmployeeInt struct {
+	First  employee.Employee
+	Second int
+}
 
-		go func(wg *sync.WaitGroup, ch chan map[int]employer.Employer, i int, v int) {
-			defer wg.Done()
-			ch <- map[int]employer.Employer{i: f(v)}
-		}(&wg, ch, i, v)
+// ZipPairsEmployeeInt takes two inputs: first list of type: []employee.Employee, second list of type: []int.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipEmployeeInt, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsEmployeeInt(list1 []employee.Employee, list2 []int) []PairEmployeeInt {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]employer.Employer, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]PairEmployeeInt, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairEmployeeInt{First: list1[i], Second: list2[i]}
 	}
 	return newList
 }
 
-// FilterMapIntEmployer filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// UnzipEmployeeInt takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsEmployeeInt
+func UnzipEmployeeInt(pairs []PairEmployeeInt) ([]employee.Employee, []int) {
+	list1 := make([]employee.Employee, len(pairs))
+	list2 := make([]int, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+
+// ZipWithEmployeeIntEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - int and returns true/false.
-//	2. Function: takes int as argument and returns employer.Employer
-// 	3. List of type int
+//	1. Function - takes 2 inputs type: employee.Employee, int and returns type: employer.Employer
+//	2. List of type employee.Employee
+//	3. List of type int
 //
-// Returns:
+// Returns
 //	New List of type employer.Employer
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapIntEmployer(fFilter func(int) bool, fMap func(int) employer.Employer, list []int) []employer.Employer {
-	if fFilter == nil || fMap == nil {
+//	Empty list if the function is nil
+func ZipWithEmployeeIntEmployer(f func(employee.Employee, int) employer.Employer, list1 []employee.Employee, list2 []int) []employer.Employer {
+	if f == nil {
 		return []employer.Employer{}
 	}
-	var newList []employer.Employer
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
-	}
-	return newList
-}
 
-// MapIntEmployee takes two inputs -
-// 1. Function 2. List. Then It returns a new list after applying the function on each item of the list
-func MapIntEmployee(f func(int) employee.Employee, list []int) []employee.Employee {
-	if f == nil {
-		return []employee.Employee{}
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
-	newList := make([]employee.Employee, len(list))
-	for i, v := range list {
-		newList[i] = f(v)
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// PMapIntEmployee applies the function(1st argument) on each item of the list and returns new list.
-// Run in parallel. no_of_goroutines = no_of_items_in_list
+// ZipWithEmployeeIntEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
 //
-// Takes 2 inputs
-//	1. Function - takes 1 input type: int output type: employee.Employee
-//	2. List
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: employee.Employee, int and returns type: employee.Employee
+//	2. List of type employee.Employee
+//	3. List of type int
 //
 // Returns
 //	New List of type employee.Employee
-//	Empty list if all arguments are nil or either one is nil
-func PMapIntEmployee(f func(int) employee.Employee, list []int) []employee.Employee {
+//	Empty list if the function is nil
+func ZipWithEmployeeIntEmployee(f func(employee.Employee, int) employee.Employee, list1 []employee.Employee, list2 []int) []employee.Employee {
 	if f == nil {
 		return []employee.Employee{}
 	}
 
-	ch := make(chan map[int]employee.Employee)
-	var wg sync.WaitGroup
-
-	for i, v := range list {
-		wg.Add(1)
-
-		go func(wg *sync.WaitGroup, ch chan map[int]employee.Employee, i int, v int) {
-			defer wg.Done()
-			ch <- map[int]employee.Employee{i: f(v)}
-		}(&wg, ch, i, v)
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	newList := make([]employee.Employee, len(list))
-	for m := range ch {
-		for k, v := range m {
-			newList[k] = v
-		}
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
-// FilterMapIntEmployee filters given list, then apply function(2nd argument) on each item in the list and returns a new list
+// ZipWithEmployeeIntInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
 // Takes 3 inputs
-//	1. Function: takes one input type - int and returns true/false.
-//	2. Function: takes int as argument and returns employee.Employee
-// 	3. List of type int
+//	1. Function - takes 2 inputs type: employee.Employee, int and returns type: int
+//	2. List of type employee.Employee
+//	3. List of type int
 //
-// Returns:
-//	New List of type employee.Employee
-//  Empty list if all there parameters are nil or either of parameter is nil
-func FilterMapIntEmployee(fFilter func(int) bool, fMap func(int) employee.Employee, list []int) []employee.Employee {
-	if fFilter == nil || fMap == nil {
-		return []employee.Employee{}
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithEmployeeIntInt(f func(employee.Employee, int) int, list1 []employee.Employee, list2 []int) []int {
+	if f == nil {
+		return []int{}
 	}
-	var newList []employee.Employee
-	for _, v := range list {
-		if fFilter(v) {
-			newList = append(newList, fMap(v))
-		}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
 	return newList
 }
 
+// MergeIntEmployer takes two or more inputs of type map[int]employer.Employer and merge them and returns a new map[int]employer.Employer.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntEmployer(map1, map2 map[int]employer.Employer, maps ...map[int]employer.Employer) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer, len(map1)+len(map2))
 
-// MergeEmployer takes two inputs: map[employer.Employer]employer.Employer and map[employer.Employer]employer.Employer and merge two maps and returns a new map[employer.Employer]employer.Employer.
-func MergeEmployer(map1, map2 map[employer.Employer]employer.Employer) map[employer.Employer]employer.Employer {
-	if map1 == nil && map2 == nil {
-		return map[employer.Employer]employer.Employer{}
+	for k, v := range map1 {
+		newMap[k] = v
 	}
 
-	newMap := make(map[employer.Employer]employer.Employer)
+	for k, v := range map2 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	return newMap
+}
+
+// MergeWithIntEmployer merges maps of type map[int]employer.Employer and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntEmployer(f func(employer.Employer, employer.Employer) employer.Employer, maps ...map[int]employer.Employer) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	for k, v := range map1 {
-		newMap[k] = v
+	return newMap
+}
+
+// KeysIntEmployer returns list of keys of the map[int]employer.Employer.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntEmployer(m map[int]employer.Employer, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
 
-	for k, v := range map2 {
-		newMap[k] = v
+// ValsIntEmployer returns list of values of the map[int]employer.Employer.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntEmployer(m map[int]employer.Employer, less ...func(employer.Employer, employer.Employer) bool) []employer.Employer {
+	vals := make([]employer.Employer, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
 
+// SelectKeysIntEmployer returns a new map which contains only the given keys of the map.
+func SelectKeysIntEmployer(m map[int]employer.Employer, keys ...int) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
 	return newMap
 }
 
-// ZipEmployer takes two inputs: first list of type: []employer.Employer, second list of type: []employer.Employer.
-// Then it merges two list and returns a new map of type: map[employer.Employer]employer.Employer
-func ZipEmployer(list1 []employer.Employer, list2 []employer.Employer) map[employer.Employer]employer.Employer {
-	newMap := make(map[employer.Employer]employer.Employer)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// DissocKeysIntEmployer returns a new map without the given keys.
+func DissocKeysIntEmployer(m map[int]employer.Employer, keys ...int) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = v
 	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+// InvertIntEmployer returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntEmployer(m map[int]employer.Employer) map[employer.Employer]int {
+	newMap := make(map[employer.Employer]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
 	}
+	return newMap
+}
 
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+// MapValsIntEmployer applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntEmployer(f func(employer.Employer) employer.Employer, m map[int]employer.Employer) map[int]employer.Employer {
+	if f == nil {
+		return map[int]employer.Employer{}
 	}
+	newMap := make(map[int]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
 
+// MapKeysIntEmployer applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntEmployer(f func(int) int, m map[int]employer.Employer) map[int]employer.Employer {
+	if f == nil {
+		return map[int]employer.Employer{}
+	}
+	newMap := make(map[int]employer.Employer, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
 	return newMap
 }
 
-// MergeEmployerEmployee takes two inputs: map[employer.Employer]employee.Employee and map[employer.Employer]employee.Employee and merge two maps and returns a new map[employer.Employer]employee.Employee.
-func MergeEmployerEmployee(map1, map2 map[employer.Employer]employee.Employee) map[employer.Employer]employee.Employee {
-	if map1 == nil && map2 == nil {
-		return map[employer.Employer]employee.Employee{}
+// FilterKeysIntEmployer returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntEmployer(pred func(int) bool, m map[int]employer.Employer) map[int]employer.Employer {
+	if pred == nil {
+		return map[int]employer.Employer{}
 	}
+	return FilterKVIntEmployer(func(k int, _ employer.Employer) bool { return pred(k) }, m)
+}
 
-	newMap := make(map[employer.Employer]employee.Employee)
+// FilterValsIntEmployer returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntEmployer(pred func(employer.Employer) bool, m map[int]employer.Employer) map[int]employer.Employer {
+	if pred == nil {
+		return map[int]employer.Employer{}
+	}
+	return FilterKVIntEmployer(func(_ int, v employer.Employer) bool { return pred(v) }, m)
+}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
+// FilterKVIntEmployer returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntEmployer(pred func(int, employer.Employer) bool, m map[int]employer.Employer) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer)
+	if pred == nil {
 		return newMap
 	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for k, v := range m {
+		if pred(k, v) {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ReduceKVIntEmployer reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: employer.Employer, int, employer.Employer and returns employer.Employer
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntEmployer(f func(employer.Employer, int, employer.Employer) employer.Employer, m map[int]employer.Employer, initializer employer.Employer) employer.Employer {
+	if f == nil {
+		return initializer
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-
-	return newMap
+	return result
 }
 
-// ZipEmployerEmployee takes two inputs: first list of type: []employer.Employer, second list of type: []employee.Employee.
-// Then it merges two list and returns a new map of type: map[employer.Employer]employee.Employee
-func ZipEmployerEmployee(list1 []employer.Employer, list2 []employee.Employee) map[employer.Employer]employee.Employee {
-	newMap := make(map[employer.Employer]employee.Employee)
+// ZipIntEmployer takes two inputs: first list of type: []int, second list of type: []employer.Employer.
+// Then it merges two list and returns a new map of type: map[int]employer.Employer
+func ZipIntEmployer(list1 []int, list2 []employer.Employer) map[int]employer.Employer {
+	newMap := make(map[int]employer.Employer)
 
 	len1 := len(list1)
 	len2 := len(list2)
@@ -974,84 +6684,165 @@ func ZipEmployerEmployee(list1 []employer.Employer, list2 []employee.Employee) m
 	return newMap
 }
 
-// MergeEmployerInt takes two inputs: map[employer.Employer]int and map[employer.Employer]int and merge two maps and returns a new map[employer.Employer]int.
-func MergeEmployerInt(map1, map2 map[employer.Employer]int) map[employer.Employer]int {
-	if map1 == nil && map2 == nil {
-		return map[employer.Employer]int{}
+// DistinctByIntEmployer removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: employer.Employer
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntEmployer(f func(int) employer.Employer, list []int) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	newMap := make(map[employer.Employer]int)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
+	newList := make([]int, 0, len(list))
+	s := make(map[employer.Employer]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
-		return newMap
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+// PairIntEmployer holds two values: First of type int and Second of type employer.Employer.
+type PairIntEmployer struct {
+	First  int
+	Second employer.Employer
+}
+
+// ZipPairsIntEmployer takes two inputs: first list of type: []int, second list of type: []employer.Employer.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntEmployer, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntEmployer(list1 []int, list2 []employer.Employer) []PairIntEmployer {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map1 {
-		newMap[k] = v
+	newList := make([]PairIntEmployer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntEmployer{First: list1[i], Second: list2[i]}
 	}
+	return newList
+}
 
-	for k, v := range map2 {
-		newMap[k] = v
+// UnzipIntEmployer takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntEmployer
+func UnzipIntEmployer(pairs []PairIntEmployer) ([]int, []employer.Employer) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]employer.Employer, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
 	}
+	return list1, list2
+}
 
-	return newMap
+func ZipIndexEmployer(list []employer.Employer) []PairIntEmployer {
+	newList := make([]PairIntEmployer, len(list))
+	for i, v := range list {
+		newList[i] = PairIntEmployer{First: i, Second: v}
+	}
+	return newList
 }
 
-// ZipEmployerInt takes two inputs: first list of type: []employer.Employer, second list of type: []int.
-// Then it merges two list and returns a new map of type: map[employer.Employer]int
-func ZipEmployerInt(list1 []employer.Employer, list2 []int) map[employer.Employer]int {
-	newMap := make(map[employer.Employer]int)
+// ZipWithIntEmployerEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employer.Employer and returns type: employer.Employer
+//	2. List of type int
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithIntEmployerEmployer(f func(int, employer.Employer) employer.Employer, list1 []int, list2 []employer.Employer) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
+	}
 
-	len1 := len(list1)
-	len2 := len(list2)
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
 
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithIntEmployerEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employer.Employer and returns type: employee.Employee
+//	2. List of type int
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithIntEmployerEmployee(f func(int, employer.Employer) employee.Employee, list1 []int, list2 []employer.Employer) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]employee.Employee, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeEmployeeEmployer takes two inputs: map[employee.Employee]employer.Employer and map[employee.Employee]employer.Employer and merge two maps and returns a new map[employee.Employee]employer.Employer.
-func MergeEmployeeEmployer(map1, map2 map[employee.Employee]employer.Employer) map[employee.Employee]employer.Employer {
-	if map1 == nil && map2 == nil {
-		return map[employee.Employee]employer.Employer{}
+// ZipWithIntEmployerInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employer.Employer and returns type: int
+//	2. List of type int
+//	3. List of type employer.Employer
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithIntEmployerInt(f func(int, employer.Employer) int, list1 []int, list2 []employer.Employer) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	newMap := make(map[employee.Employee]employer.Employer)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+	newList := make([]int, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
+	return newList
+}
+
+// MergeIntEmployee takes two or more inputs of type map[int]employee.Employee and merge them and returns a new map[int]employee.Employee.
+// When a key exists in more than one map, the value from the latter map is kept.
+func MergeIntEmployee(map1, map2 map[int]employee.Employee, maps ...map[int]employee.Employee) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee, len(map1)+len(map2))
 
 	for k, v := range map1 {
 		newMap[k] = v
@@ -1061,127 +6852,183 @@ func MergeEmployeeEmployer(map1, map2 map[employee.Employee]employer.Employer) m
 		newMap[k] = v
 	}
 
+	for _, m := range maps {
+		for k, v := range m {
+			newMap[k] = v
+		}
+	}
+
 	return newMap
 }
 
-// ZipEmployeeEmployer takes two inputs: first list of type: []employee.Employee, second list of type: []employer.Employer.
-// Then it merges two list and returns a new map of type: map[employee.Employee]employer.Employer
-func ZipEmployeeEmployer(list1 []employee.Employee, list2 []employer.Employer) map[employee.Employee]employer.Employer {
-	newMap := make(map[employee.Employee]employer.Employer)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
-	}
-
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
-	}
+// MergeWithIntEmployee merges maps of type map[int]employee.Employee and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWithIntEmployee(f func(employee.Employee, employee.Employee) employee.Employee, maps ...map[int]employee.Employee) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee)
 
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
 	}
 
 	return newMap
 }
 
-// MergeEmployee takes two inputs: map[employee.Employee]employee.Employee and map[employee.Employee]employee.Employee and merge two maps and returns a new map[employee.Employee]employee.Employee.
-func MergeEmployee(map1, map2 map[employee.Employee]employee.Employee) map[employee.Employee]employee.Employee {
-	if map1 == nil && map2 == nil {
-		return map[employee.Employee]employee.Employee{}
+// KeysIntEmployee returns list of keys of the map[int]employee.Employee.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func KeysIntEmployee(m map[int]employee.Employee, less ...func(int, int) bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
 
-	newMap := make(map[employee.Employee]employee.Employee)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+// ValsIntEmployee returns list of values of the map[int]employee.Employee.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func ValsIntEmployee(m map[int]employee.Employee, less ...func(employee.Employee, employee.Employee) bool) []employee.Employee {
+	vals := make([]employee.Employee, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
 	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
+// SelectKeysIntEmployee returns a new map which contains only the given keys of the map.
+func SelectKeysIntEmployee(m map[int]employee.Employee, keys ...int) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
+// DissocKeysIntEmployee returns a new map without the given keys.
+func DissocKeysIntEmployee(m map[int]employee.Employee, keys ...int) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee, len(m))
+	for k, v := range m {
 		newMap[k] = v
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	for _, k := range keys {
+		delete(newMap, k)
 	}
-
 	return newMap
 }
 
-// ZipEmployee takes two inputs: first list of type: []employee.Employee, second list of type: []employee.Employee.
-// Then it merges two list and returns a new map of type: map[employee.Employee]employee.Employee
-func ZipEmployee(list1 []employee.Employee, list2 []employee.Employee) map[employee.Employee]employee.Employee {
-	newMap := make(map[employee.Employee]employee.Employee)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// InvertIntEmployee returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func InvertIntEmployee(m map[int]employee.Employee) map[employee.Employee]int {
+	newMap := make(map[employee.Employee]int, len(m))
+	for k, v := range m {
+		newMap[v] = k
 	}
+	return newMap
+}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+// MapValsIntEmployee applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapValsIntEmployee(f func(employee.Employee) employee.Employee, m map[int]employee.Employee) map[int]employee.Employee {
+	if f == nil {
+		return map[int]employee.Employee{}
 	}
-
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+	newMap := make(map[int]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
 	}
+	return newMap
+}
 
+// MapKeysIntEmployee applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeysIntEmployee(f func(int) int, m map[int]employee.Employee) map[int]employee.Employee {
+	if f == nil {
+		return map[int]employee.Employee{}
+	}
+	newMap := make(map[int]employee.Employee, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
 	return newMap
 }
 
-// MergeEmployeeInt takes two inputs: map[employee.Employee]int and map[employee.Employee]int and merge two maps and returns a new map[employee.Employee]int.
-func MergeEmployeeInt(map1, map2 map[employee.Employee]int) map[employee.Employee]int {
-	if map1 == nil && map2 == nil {
-		return map[employee.Employee]int{}
+// FilterKeysIntEmployee returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeysIntEmployee(pred func(int) bool, m map[int]employee.Employee) map[int]employee.Employee {
+	if pred == nil {
+		return map[int]employee.Employee{}
 	}
+	return FilterKVIntEmployee(func(k int, _ employee.Employee) bool { return pred(k) }, m)
+}
 
-	newMap := make(map[employee.Employee]int)
+// FilterValsIntEmployee returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterValsIntEmployee(pred func(employee.Employee) bool, m map[int]employee.Employee) map[int]employee.Employee {
+	if pred == nil {
+		return map[int]employee.Employee{}
+	}
+	return FilterKVIntEmployee(func(_ int, v employee.Employee) bool { return pred(v) }, m)
+}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
+// FilterKVIntEmployee returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKVIntEmployee(pred func(int, employee.Employee) bool, m map[int]employee.Employee) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee)
+	if pred == nil {
 		return newMap
 	}
-
-	if map2 == nil {
-		for k, v := range map1 {
+	for k, v := range m {
+		if pred(k, v) {
 			newMap[k] = v
 		}
-		return newMap
 	}
+	return newMap
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ReduceKVIntEmployee reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: employee.Employee, int, employee.Employee and returns employee.Employee
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKVIntEmployee(f func(employee.Employee, int, employee.Employee) employee.Employee, m map[int]employee.Employee, initializer employee.Employee) employee.Employee {
+	if f == nil {
+		return initializer
 	}
-
-	for k, v := range map2 {
-		newMap[k] = v
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
 	}
-
-	return newMap
+	return result
 }
 
-// ZipEmployeeInt takes two inputs: first list of type: []employee.Employee, second list of type: []int.
-// Then it merges two list and returns a new map of type: map[employee.Employee]int
-func ZipEmployeeInt(list1 []employee.Employee, list2 []int) map[employee.Employee]int {
-	newMap := make(map[employee.Employee]int)
+// ZipIntEmployee takes two inputs: first list of type: []int, second list of type: []employee.Employee.
+// Then it merges two list and returns a new map of type: map[int]employee.Employee
+func ZipIntEmployee(list1 []int, list2 []employee.Employee) map[int]employee.Employee {
+	newMap := make(map[int]employee.Employee)
 
 	len1 := len(list1)
 	len2 := len(list2)
@@ -1202,116 +7049,157 @@ func ZipEmployeeInt(list1 []employee.Employee, list2 []int) map[employee.Employe
 	return newMap
 }
 
-// MergeIntEmployer takes two inputs: map[int]employer.Employer and map[int]employer.Employer and merge two maps and returns a new map[int]employer.Employer.
-func MergeIntEmployer(map1, map2 map[int]employer.Employer) map[int]employer.Employer {
-	if map1 == nil && map2 == nil {
-		return map[int]employer.Employer{}
+// DistinctByIntEmployee removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: int and returns key of type: employee.Employee
+//	2. List
+//
+// Returns
+//	New List of type int
+//	Empty list if either of arguments is nil
+func DistinctByIntEmployee(f func(int) employee.Employee, list []int) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	newMap := make(map[int]employer.Employer)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
+	newList := make([]int, 0, len(list))
+	s := make(map[employee.Employee]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
 		}
-		return newMap
+		s[key] = struct{}{}
+		newList = append(newList, v)
 	}
+	return newList
+}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
-	}
+// PairIntEmployee holds two values: First of type int and Second of type employee.Employee.
+type PairIntEmployee struct {
+	First  int
+	Second employee.Employee
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ZipPairsIntEmployee takes two inputs: first list of type: []int, second list of type: []employee.Employee.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike ZipIntEmployee, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairsIntEmployee(list1 []int, list2 []employee.Employee) []PairIntEmployee {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	newList := make([]PairIntEmployee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = PairIntEmployee{First: list1[i], Second: list2[i]}
 	}
-
-	return newMap
+	return newList
 }
 
-// ZipIntEmployer takes two inputs: first list of type: []int, second list of type: []employer.Employer.
-// Then it merges two list and returns a new map of type: map[int]employer.Employer
-func ZipIntEmployer(list1 []int, list2 []employer.Employer) map[int]employer.Employer {
-	newMap := make(map[int]employer.Employer)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
-	}
-
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+// UnzipIntEmployee takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairsIntEmployee
+func UnzipIntEmployee(pairs []PairIntEmployee) ([]int, []employee.Employee) {
+	list1 := make([]int, len(pairs))
+	list2 := make([]employee.Employee, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
 	}
+	return list1, list2
+}
 
-	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+func ZipIndexEmployee(list []employee.Employee) []PairIntEmployee {
+	newList := make([]PairIntEmployee, len(list))
+	for i, v := range list {
+		newList[i] = PairIntEmployee{First: i, Second: v}
 	}
-
-	return newMap
+	return newList
 }
 
-// MergeIntEmployee takes two inputs: map[int]employee.Employee and map[int]employee.Employee and merge two maps and returns a new map[int]employee.Employee.
-func MergeIntEmployee(map1, map2 map[int]employee.Employee) map[int]employee.Employee {
-	if map1 == nil && map2 == nil {
-		return map[int]employee.Employee{}
+// ZipWithIntEmployeeEmployer applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employee.Employee and returns type: employer.Employer
+//	2. List of type int
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type employer.Employer
+//	Empty list if the function is nil
+func ZipWithIntEmployeeEmployer(f func(int, employee.Employee) employer.Employer, list1 []int, list2 []employee.Employee) []employer.Employer {
+	if f == nil {
+		return []employer.Employer{}
 	}
 
-	newMap := make(map[int]employee.Employee)
-
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
-			newMap[k] = v
-		}
-		return newMap
+	newList := make([]employer.Employer, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
 	}
+	return newList
+}
 
-	for k, v := range map1 {
-		newMap[k] = v
+// ZipWithIntEmployeeEmployee applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employee.Employee and returns type: employee.Employee
+//	2. List of type int
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type employee.Employee
+//	Empty list if the function is nil
+func ZipWithIntEmployeeEmployee(f func(int, employee.Employee) employee.Employee, list1 []int, list2 []employee.Employee) []employee.Employee {
+	if f == nil {
+		return []employee.Employee{}
 	}
 
-	for k, v := range map2 {
-		newMap[k] = v
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
-	return newMap
+	newList := make([]employee.Employee, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
 }
 
-// ZipIntEmployee takes two inputs: first list of type: []int, second list of type: []employee.Employee.
-// Then it merges two list and returns a new map of type: map[int]employee.Employee
-func ZipIntEmployee(list1 []int, list2 []employee.Employee) map[int]employee.Employee {
-	newMap := make(map[int]employee.Employee)
-
-	len1 := len(list1)
-	len2 := len(list2)
-
-	if len1 == 0 || len2 == 0 {
-		return newMap
+// ZipWithIntEmployeeInt applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: int, employee.Employee and returns type: int
+//	2. List of type int
+//	3. List of type employee.Employee
+//
+// Returns
+//	New List of type int
+//	Empty list if the function is nil
+func ZipWithIntEmployeeInt(f func(int, employee.Employee) int, list1 []int, list2 []employee.Employee) []int {
+	if f == nil {
+		return []int{}
 	}
 
-	minLen := len1
-	if len2 < minLen {
-		minLen = len2
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
 	}
 
+	newList := make([]int, minLen)
 	for i := 0; i < minLen; i++ {
-		newMap[list1[i]] = list2[i]
+		newList[i] = f(list1[i], list2[i])
 	}
-
-	return newMap
+	return newList
 }
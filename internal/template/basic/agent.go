@@ -0,0 +1,198 @@
+package basic
+
+// Agent is template to generate itself for different combination of data type.
+// It generates Agent: value changed by actions which run one at a time in the background
+func Agent() string {
+	return `
+// Agent<FTYPE> - holds <TYPE> which is changed by actions sent with Send. Actions run one at a time
+// in a background goroutine in the order they are sent, so they don't need locks.
+// If an action panics, the agent fails: the panic is kept as error, queued actions are discarded
+// and Send returns the error until Restart
+type Agent<FTYPE> struct {
+	mu      sync.Mutex
+	done    *sync.Cond
+	space   *sync.Cond
+	state   <TYPE>
+	err     error
+	pending int
+	running bool
+	size    int
+	queue   []agentAction<FTYPE>
+	// epoch changes when the agent fails, so actions sent before the failure are discarded even after Restart
+	epoch int
+}
+
+type agentAction<FTYPE> struct {
+	f     func(<TYPE>) <TYPE>
+	epoch int
+}
+
+// NewAgent<FTYPE> creates agent with the initial value. queueSize is the number of actions which can wait to run,
+// Send blocks when the queue is full. queueSize less than 1 is 1
+func NewAgent<FTYPE>(v <TYPE>, queueSize int) *Agent<FTYPE> {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &Agent<FTYPE>{state: v, size: queueSize}
+	a.done = sync.NewCond(&a.mu)
+	a.space = sync.NewCond(&a.mu)
+	return a
+}
+
+// Send queues the action which is called with the current value and returns the new value.
+// Returns error of the failed agent and the action is not queued.
+//
+// Send blocks while the queue is full, so an action which calls Send of its own agent can block forever.
+// Send the action with SendNested and use its send instead
+//
+// Example:
+//	total := NewAgent<FTYPE>(0, 100)
+//	total.Send(func(v <TYPE>) <TYPE> { return v + 1 })
+//	total.Await()
+func (a *Agent<FTYPE>) Send(f func(<TYPE>) <TYPE>) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.err == nil && len(a.queue) >= a.size {
+		a.space.Wait()
+	}
+	return a.enqueue(f)
+}
+
+// SendNested is Send for an action which sends to its own agent. The action is called with send,
+// which doesn't block: actions sent with it are held and queued after the action returns,
+// and discarded if the action fails. send must be called only by the action, before it returns
+//
+// Example:
+//	counter := NewAgent<FTYPE>(0, 1)
+//	counter.SendNested(func(v <TYPE>, send func(func(<TYPE>) <TYPE>)) <TYPE> {
+//		send(func(v <TYPE>) <TYPE> { return v + 1 })
+//		return v + 1
+//	})
+func (a *Agent<FTYPE>) SendNested(f func(<TYPE>, func(func(<TYPE>) <TYPE>)) <TYPE>) error {
+	if f == nil {
+		return a.Send(nil)
+	}
+	return a.Send(func(v <TYPE>) <TYPE> {
+		var held []func(<TYPE>) <TYPE>
+		v = f(v, func(g func(<TYPE>) <TYPE>) {
+			if g != nil {
+				held = append(held, g)
+			}
+		})
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, g := range held {
+			a.pending++
+			a.queue = append(a.queue, agentAction<FTYPE>{f: g, epoch: a.epoch})
+		}
+		return v
+	})
+}
+
+// enqueue queues the action and starts the goroutine which runs actions. It must be called with the lock held
+func (a *Agent<FTYPE>) enqueue(f func(<TYPE>) <TYPE>) error {
+	if a.err != nil || f == nil {
+		return a.err
+	}
+	a.pending++
+	a.queue = append(a.queue, agentAction<FTYPE>{f: f, epoch: a.epoch})
+	if !a.running {
+		a.running = true
+		go a.run()
+	}
+	return nil
+}
+
+// run calls queued actions and exits when the queue is empty. Send starts it again
+func (a *Agent<FTYPE>) run() {
+	for {
+		a.mu.Lock()
+		if len(a.queue) == 0 {
+			a.running = false
+			a.mu.Unlock()
+			return
+		}
+		action := a.queue[0]
+		a.queue[0] = agentAction<FTYPE>{}
+		a.queue = a.queue[1:]
+		a.space.Signal()
+		state, epoch := a.state, a.epoch
+		discard := action.epoch != epoch
+		a.mu.Unlock()
+
+		var err error
+		if !discard {
+			state, err = a.apply(action.f, state)
+		}
+
+		a.mu.Lock()
+		switch {
+		case discard:
+		case err != nil:
+			a.err = err
+			a.epoch++
+			// wake up the blocked senders, Send returns the error
+			a.space.Broadcast()
+		default:
+			a.state = state
+		}
+		a.pending--
+		if a.pending == 0 {
+			a.done.Broadcast()
+		}
+		a.mu.Unlock()
+	}
+}
+
+func (a *Agent<FTYPE>) apply(f func(<TYPE>) <TYPE>, v <TYPE>) (newVal <TYPE>, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("agent action panicked: %v", r)
+			}
+		}
+	}()
+	return f(v), nil
+}
+
+// Await blocks until all the actions sent so far are done, and returns error of the failed agent.
+// Calling it from an action blocks forever
+func (a *Agent<FTYPE>) Await() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.pending > 0 {
+		a.done.Wait()
+	}
+	return a.err
+}
+
+// Deref returns the current value. Actions which are queued may not be done yet, use Await to wait for them
+func (a *Agent<FTYPE>) Deref() <TYPE> {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Err returns error of the failed agent. Returns nil if the agent is not failed
+func (a *Agent<FTYPE>) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// Restart clears error of the failed agent and sets the value. Returns false and does nothing if the agent is not failed
+func (a *Agent<FTYPE>) Restart(v <TYPE>) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.err == nil {
+		return false
+	}
+	a.state = v
+	a.err = nil
+	return true
+}
+`
+}
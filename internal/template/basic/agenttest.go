@@ -0,0 +1,134 @@
+package basic
+
+// AgentTest is template to generate itself for different combination of data type.
+func AgentTest() string {
+	return `
+func TestAgent<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>(1, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Send(func(v <TYPE>) <TYPE> { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if err := a.Await(); err != nil || a.Deref() != 101 {
+		t.Errorf("TestAgent<FTYPE> failed. Expected=%v, actual=%v, error=%v", 101, a.Deref(), err)
+	}
+	if a.Send(nil) != nil || a.Await() != nil || a.Deref() != 101 {
+		t.Errorf("TestAgent<FTYPE> failed. Expected nil action to be ignored")
+	}
+}
+
+func TestAgentNested<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>(0, 1)
+	inc := func(v <TYPE>) <TYPE> { return v + 1 }
+	a.SendNested(func(v <TYPE>, send func(func(<TYPE>) <TYPE>)) <TYPE> {
+		// the queue is full, Send would block forever here
+		for i := 0; i < 3; i++ {
+			send(inc)
+		}
+		send(nil)
+		send(func(v <TYPE>) <TYPE> { return v * 2 })
+		return v + 1
+	})
+	if err := a.Await(); err != nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNested<FTYPE> failed. Expected=%v, actual=%v, error=%v", 8, a.Deref(), err)
+	}
+
+	a.SendNested(func(v <TYPE>, send func(func(<TYPE>) <TYPE>)) <TYPE> {
+		send(inc)
+		panic("boom")
+	})
+	if err := a.Await(); err == nil || a.Deref() != 8 {
+		t.Errorf("TestAgentNested<FTYPE> failed. Expected action sent by failed action to be discarded, actual=%v", a.Deref())
+	}
+	a.Restart(0)
+	if err := a.SendNested(nil); err != nil || a.Await() != nil || a.Deref() != 0 {
+		t.Errorf("TestAgentNested<FTYPE> failed. Expected nil action to be ignored, actual=%v", a.Deref())
+	}
+}
+
+func TestAgentError<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>(1, 0)
+	a.Send(func(v <TYPE>) <TYPE> { return v + 1 })
+	a.Send(func(v <TYPE>) <TYPE> { panic("boom") })
+	a.Send(func(v <TYPE>) <TYPE> { return v + 10 })
+
+	if err := a.Await(); err == nil || a.Err() != err || a.Deref() != 2 {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected error and value=%v, actual=%v, error=%v", 2, a.Deref(), err)
+	}
+	if err := a.Send(func(v <TYPE>) <TYPE> { return v + 1 }); err == nil {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected Send to return error of the failed agent")
+	}
+
+	if !a.Restart(5) || a.Restart(6) {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected Restart to succeed only for failed agent")
+	}
+	a.Send(func(v <TYPE>) <TYPE> { return v + 1 })
+	if err := a.Await(); err != nil || a.Deref() != 6 {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected=%v, actual=%v, error=%v", 6, a.Deref(), err)
+	}
+}
+`
+}
+
+// AgentStrTest is template to generate itself for different combination of data type.
+func AgentStrTest() string {
+	return `
+func TestAgent<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>("", 2)
+	for _, s := range []<TYPE>{"a", "b", "c", "d", "e"} {
+		s := s
+		a.Send(func(v <TYPE>) <TYPE> { return v + s })
+	}
+	if err := a.Await(); err != nil || a.Deref() != "abcde" {
+		t.Errorf("TestAgent<FTYPE> failed. Expected actions in the order they are sent=%v, actual=%v", "abcde", a.Deref())
+	}
+}
+
+func TestAgentError<FTYPE>(t *testing.T) {
+	errBoom := errors.New("boom")
+	a := NewAgent<FTYPE>("a", 1)
+	a.Send(func(v <TYPE>) <TYPE> { panic(errBoom) })
+	a.Send(func(v <TYPE>) <TYPE> { return v + "b" })
+
+	if err := a.Await(); err != errBoom || a.Deref() != "a" {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	a.Restart("x")
+	a.Send(func(v <TYPE>) <TYPE> { return v + "y" })
+	if err := a.Await(); err != nil || a.Deref() != "xy" {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected=%v, actual=%v", "xy", a.Deref())
+	}
+}
+`
+}
+
+// AgentBoolTest is template to generate itself for different combination of data type.
+func AgentBoolTest() string {
+	return `
+func TestAgent<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>(false, 3)
+	for i := 0; i < 5; i++ {
+		a.Send(func(v <TYPE>) <TYPE> { return !v })
+	}
+	if err := a.Await(); err != nil || a.Deref() != true {
+		t.Errorf("TestAgent<FTYPE> failed. Expected=%v, actual=%v", true, a.Deref())
+	}
+}
+
+func TestAgentError<FTYPE>(t *testing.T) {
+	a := NewAgent<FTYPE>(true, 1)
+	a.Send(func(v <TYPE>) <TYPE> { panic("boom") })
+	if err := a.Await(); err == nil || a.Deref() != true {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected error, actual=%v", err)
+	}
+	if !a.Restart(false) || a.Err() != nil {
+		t.Errorf("TestAgentError<FTYPE> failed. Expected Restart to clear error")
+	}
+}
+`
+}
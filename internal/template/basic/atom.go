@@ -0,0 +1,160 @@
+package basic
+
+// Atom is template to generate itself for different combination of data type.
+// It generates Atom: value shared between goroutines which is changed with compare-and-set
+func Atom() string {
+	return `
+// Atom<FTYPE> - holds <TYPE> shared between goroutines. Swap and CompareAndSet change the value atomically.
+// The functions passed to Swap are called without holding the lock. The zero value holds zero value of <TYPE>
+type Atom<FTYPE> struct {
+	// stateMu guards value and version. version is incremented on every change, so Swap can detect a change made in the meantime
+	stateMu sync.RWMutex
+	value   <TYPE>
+	version uint64
+
+	mu        sync.RWMutex
+	validator func(<TYPE>) error
+	watches   map[string]func(key string, oldVal, newVal <TYPE>)
+}
+
+// NewAtom<FTYPE> creates atom with the initial value
+func NewAtom<FTYPE>(v <TYPE>) *Atom<FTYPE> {
+	return &Atom<FTYPE>{value: v}
+}
+
+// load returns the current value and its version
+func (a *Atom<FTYPE>) load() (<TYPE>, uint64) {
+	a.stateMu.RLock()
+	defer a.stateMu.RUnlock()
+	return a.value, a.version
+}
+
+// store sets the value if it is not changed since the version was loaded
+func (a *Atom<FTYPE>) store(version uint64, v <TYPE>) bool {
+	a.stateMu.Lock()
+	defer a.stateMu.Unlock()
+	if a.version != version {
+		return false
+	}
+	a.value = v
+	a.version++
+	return true
+}
+
+// Deref returns the current value
+func (a *Atom<FTYPE>) Deref() <TYPE> {
+	v, _ := a.load()
+	return v
+}
+
+// Swap sets the value to f(current value) and returns the new value.
+// If another goroutine changes the value in the meantime, f is called again with the latest value,
+// so f should be free of side effects. Returns error of the validator if the new value is rejected
+//
+// Example:
+//	counter := NewAtom<FTYPE>(0)
+//	counter.Swap(func(v <TYPE>) <TYPE> { return v + 1 })
+func (a *Atom<FTYPE>) Swap(f func(<TYPE>) <TYPE>) (<TYPE>, error) {
+	for {
+		oldVal, version := a.load()
+		if f == nil {
+			return oldVal, nil
+		}
+		newVal := f(oldVal)
+		if err := a.validate(newVal); err != nil {
+			return oldVal, err
+		}
+		if a.store(version, newVal) {
+			a.notify(oldVal, newVal)
+			return newVal, nil
+		}
+	}
+}
+
+// Reset sets the value regardless of the current value. Returns error of the validator if the value is rejected
+func (a *Atom<FTYPE>) Reset(v <TYPE>) error {
+	_, err := a.Swap(func(<TYPE>) <TYPE> { return v })
+	return err
+}
+
+// CompareAndSet sets the value to newVal only if the current value is oldVal. Returns true if the value is set.
+// Returns error of the validator if newVal is rejected
+func (a *Atom<FTYPE>) CompareAndSet(oldVal, newVal <TYPE>) (bool, error) {
+	if err := a.validate(newVal); err != nil {
+		return false, err
+	}
+	for {
+		current, version := a.load()
+		if current != oldVal {
+			return false, nil
+		}
+		if a.store(version, newVal) {
+			a.notify(current, newVal)
+			return true, nil
+		}
+	}
+}
+
+// SetValidator sets the function which checks every new value. A new value is rejected when the validator returns error.
+// Returns error and the validator is not set if the current value is rejected. nil removes the validator
+func (a *Atom<FTYPE>) SetValidator(validator func(<TYPE>) error) error {
+	if validator != nil {
+		if err := validator(a.Deref()); err != nil {
+			return err
+		}
+	}
+	a.mu.Lock()
+	a.validator = validator
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *Atom<FTYPE>) validate(v <TYPE>) error {
+	a.mu.RLock()
+	validator := a.validator
+	a.mu.RUnlock()
+	if validator == nil {
+		return nil
+	}
+	return validator(v)
+}
+
+// AddWatch adds the function which is called with the old and the new value after every change.
+// It is called in the goroutine which changed the value. Adding a watch with the existing key replaces it
+func (a *Atom<FTYPE>) AddWatch(key string, f func(key string, oldVal, newVal <TYPE>)) {
+	if f == nil {
+		return
+	}
+	a.mu.Lock()
+	if a.watches == nil {
+		a.watches = make(map[string]func(string, <TYPE>, <TYPE>))
+	}
+	a.watches[key] = f
+	a.mu.Unlock()
+}
+
+// RemoveWatch removes the watch added with the key
+func (a *Atom<FTYPE>) RemoveWatch(key string) {
+	a.mu.Lock()
+	delete(a.watches, key)
+	a.mu.Unlock()
+}
+
+func (a *Atom<FTYPE>) notify(oldVal, newVal <TYPE>) {
+	a.mu.RLock()
+	if len(a.watches) == 0 {
+		a.mu.RUnlock()
+		return
+	}
+	watches := make(map[string]func(string, <TYPE>, <TYPE>), len(a.watches))
+	for key, f := range a.watches {
+		watches[key] = f
+	}
+	a.mu.RUnlock()
+
+	for key, f := range watches {
+		f(key, oldVal, newVal)
+	}
+}
+`
+}
@@ -0,0 +1,224 @@
+package basic
+
+// AtomTest is template to generate itself for different combination of data type.
+func AtomTest() string {
+	return `
+func TestAtom<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v <TYPE>) <TYPE> { return v + 1 })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != 101 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", 101, v)
+	}
+
+	if ok, _ := a.CompareAndSet(1, 5); ok || a.Deref() != 101 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(101, 5); !ok || a.Deref() != 5 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", 5, a.Deref())
+	}
+	if a.Reset(7); a.Deref() != 7 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", 7, a.Deref())
+	}
+	if v, err := a.Swap(nil); v != 7 || err != nil {
+		t.Errorf("TestAtom<FTYPE> failed. Expected current value for nil function, actual=%v", v)
+	}
+
+	var zero Atom<FTYPE>
+	if zero.Deref() != 0 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected zero value, actual=%v", zero.Deref())
+	}
+	if v, _ := zero.Swap(func(v <TYPE>) <TYPE> { return v + 2 }); v != 2 || zero.Deref() != 2 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", 2, v)
+	}
+}
+
+func TestAtomValidator<FTYPE>(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	a := NewAtom<FTYPE>(10)
+	if err := a.SetValidator(func(v <TYPE>) error {
+		if v > 20 {
+			return errTooLarge
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Unexpected error %v", err)
+	}
+
+	if v, err := a.Swap(func(v <TYPE>) <TYPE> { return v + 11 }); err != errTooLarge || v != 10 {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if err := a.Reset(30); err != errTooLarge || a.Deref() != 10 {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected value not to change, actual=%v", a.Deref())
+	}
+	if ok, err := a.CompareAndSet(10, 30); ok || err != errTooLarge {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected CompareAndSet to be rejected")
+	}
+	if err := a.Reset(20); err != nil || a.Deref() != 20 {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected=%v, actual=%v", 20, a.Deref())
+	}
+
+	if err := a.SetValidator(func(v <TYPE>) error { return errTooLarge }); err != errTooLarge {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected validator to be rejected for the current value")
+	}
+	a.SetValidator(nil)
+	if err := a.Reset(30); err != nil {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected no validator, actual error=%v", err)
+	}
+}
+
+func TestAtomWatch<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>(1)
+	var changes [][2]<TYPE>
+	a.AddWatch("log", func(key string, oldVal, newVal <TYPE>) {
+		if key != "log" {
+			t.Errorf("TestAtomWatch<FTYPE> failed. Expected key=log, actual=%v", key)
+		}
+		changes = append(changes, [2]<TYPE>{oldVal, newVal})
+	})
+
+	a.Swap(func(v <TYPE>) <TYPE> { return v + 1 })
+	a.Reset(5)
+	a.CompareAndSet(1, 6)
+	a.CompareAndSet(5, 6)
+	a.RemoveWatch("log")
+	a.Reset(7)
+
+	expected := [][2]<TYPE>{{1, 2}, {2, 5}, {5, 6}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatch<FTYPE> failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+`
+}
+
+// AtomStrTest is template to generate itself for different combination of data type.
+func AtomStrTest() string {
+	return `
+func TestAtom<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>("")
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v <TYPE>) <TYPE> { return v + "a" })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); len(v) != 100 {
+		t.Errorf("TestAtom<FTYPE> failed. Expected length=%v, actual=%v", 100, len(v))
+	}
+
+	a.Reset("a")
+	if ok, _ := a.CompareAndSet("b", "c"); ok || a.Deref() != "a" {
+		t.Errorf("TestAtom<FTYPE> failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet("a", "c"); !ok || a.Deref() != "c" {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", "c", a.Deref())
+	}
+
+	var zero Atom<FTYPE>
+	if v, _ := zero.Swap(func(v <TYPE>) <TYPE> { return v + "x" }); v != "x" {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", "x", v)
+	}
+}
+
+func TestAtomValidator<FTYPE>(t *testing.T) {
+	errEmpty := errors.New("empty")
+	a := NewAtom<FTYPE>("a")
+	a.SetValidator(func(v <TYPE>) error {
+		if v == "" {
+			return errEmpty
+		}
+		return nil
+	})
+
+	if err := a.Reset(""); err != errEmpty || a.Deref() != "a" {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected error=%v, actual=%v", errEmpty, err)
+	}
+	if err := a.Reset("b"); err != nil || a.Deref() != "b" {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected=%v, actual=%v", "b", a.Deref())
+	}
+}
+
+func TestAtomWatch<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>("a")
+	var changes [][2]<TYPE>
+	a.AddWatch("log", func(_ string, oldVal, newVal <TYPE>) {
+		changes = append(changes, [2]<TYPE>{oldVal, newVal})
+	})
+	a.Swap(func(v <TYPE>) <TYPE> { return v + "b" })
+	a.RemoveWatch("log")
+	a.Reset("c")
+
+	expected := [][2]<TYPE>{{"a", "ab"}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatch<FTYPE> failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+`
+}
+
+// AtomBoolTest is template to generate itself for different combination of data type.
+func AtomBoolTest() string {
+	return `
+func TestAtom<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>(false)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Swap(func(v <TYPE>) <TYPE> { return !v })
+		}()
+	}
+	wg.Wait()
+	if v := a.Deref(); v != false {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", false, v)
+	}
+
+	if ok, _ := a.CompareAndSet(true, false); ok {
+		t.Errorf("TestAtom<FTYPE> failed. Expected CompareAndSet to fail when current value is different")
+	}
+	if ok, _ := a.CompareAndSet(false, true); !ok || a.Deref() != true {
+		t.Errorf("TestAtom<FTYPE> failed. Expected=%v, actual=%v", true, a.Deref())
+	}
+}
+
+func TestAtomValidator<FTYPE>(t *testing.T) {
+	errFalse := errors.New("false")
+	a := NewAtom<FTYPE>(true)
+	a.SetValidator(func(v <TYPE>) error {
+		if !v {
+			return errFalse
+		}
+		return nil
+	})
+	if err := a.Reset(false); err != errFalse || a.Deref() != true {
+		t.Errorf("TestAtomValidator<FTYPE> failed. Expected error=%v, actual=%v", errFalse, err)
+	}
+}
+
+func TestAtomWatch<FTYPE>(t *testing.T) {
+	a := NewAtom<FTYPE>(false)
+	var changes [][2]<TYPE>
+	a.AddWatch("log", func(_ string, oldVal, newVal <TYPE>) {
+		changes = append(changes, [2]<TYPE>{oldVal, newVal})
+	})
+	a.Reset(true)
+
+	expected := [][2]<TYPE>{{false, true}}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("TestAtomWatch<FTYPE> failed. Expected=%v, actual=%v", expected, changes)
+	}
+}
+`
+}
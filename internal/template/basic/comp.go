@@ -0,0 +1,114 @@
+package basic
+
+// Comp is template to generate itself for different combination of data type.
+func Comp() string {
+	return `
+// Comp<FTYPE> composes functions from right to left and returns the composed function.
+// Comp<FTYPE>(f, g, h)(v) is same as f(g(h(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func Comp<FTYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) <TYPE> {
+	return func(v <TYPE>) <TYPE> {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+// Pipe<FTYPE> composes functions from left to right and returns the composed function.
+// Pipe<FTYPE>(f, g, h)(v) is same as h(g(f(v))).
+// Nil functions are skipped. Returns identity function if no function is passed
+func Pipe<FTYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) <TYPE> {
+	return func(v <TYPE>) <TYPE> {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+`
+}
+
+// Partial is template to generate itself for different combination of data type.
+func Partial() string {
+	return `
+// Partial<FTYPE>2 fixes the 1st argument of the function which takes 2 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func Partial<FTYPE>2(f func(<TYPE>, <TYPE>) <TYPE>, v1 <TYPE>) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+	return func(v2 <TYPE>) <TYPE> {
+		return f(v1, v2)
+	}
+}
+
+// Partial<FTYPE>3 fixes the 1st and 2nd arguments of the function which takes 3 arguments and
+// returns the function which takes the remaining argument.
+// Returns nil if the function is nil
+func Partial<FTYPE>3(f func(<TYPE>, <TYPE>, <TYPE>) <TYPE>, v1, v2 <TYPE>) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+	return func(v3 <TYPE>) <TYPE> {
+		return f(v1, v2, v3)
+	}
+}
+`
+}
+
+// Complement is template to generate itself for different combination of data type.
+func Complement() string {
+	return `
+// Complement<FTYPE> takes a predicate and returns the function which returns the opposite truth value.
+// Returns nil if the predicate is nil
+func Complement<FTYPE>(pred func(<TYPE>) bool) func(<TYPE>) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v <TYPE>) bool {
+		return !pred(v)
+	}
+}
+`
+}
+
+// Constantly is template to generate itself for different combination of data type.
+func Constantly() string {
+	return `
+// Constantly<FTYPE> returns the function which ignores its argument and always returns v.
+func Constantly<FTYPE>(v <TYPE>) func(<TYPE>) <TYPE> {
+	return func(<TYPE>) <TYPE> {
+		return v
+	}
+}
+`
+}
+
+// Juxt is template to generate itself for different combination of data type.
+func Juxt() string {
+	return `
+// Juxt<FTYPE> takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+func Juxt<FTYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) []<TYPE> {
+	funcs := make([]func(<TYPE>) <TYPE>, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v <TYPE>) []<TYPE> {
+		newList := make([]<TYPE>, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+`
+}
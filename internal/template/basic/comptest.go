@@ -0,0 +1,406 @@
+package basic
+
+// CompTest is template to generate itself for different combination of data type.
+func CompTest() string {
+	return `
+func TestComp<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + 1 }
+	f2 := func(v <TYPE>) <TYPE> { return v * 2 }
+
+	expected := <TYPE>(7)
+	actual := Comp<FTYPE>(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(4)
+	actual = Comp<FTYPE>(f1, nil)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(3)
+	actual = Comp<FTYPE>()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipe<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + 1 }
+	f2 := func(v <TYPE>) <TYPE> { return v * 2 }
+
+	expected := <TYPE>(8)
+	actual := Pipe<FTYPE>(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(3)
+	actual = Pipe<FTYPE>()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// CompStrTest is template to generate itself for different combination of data type.
+func CompStrTest() string {
+	return `
+func TestComp<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + "a" }
+	f2 := func(v <TYPE>) <TYPE> { return v + "b" }
+
+	expected := <TYPE>("xba")
+	actual := Comp<FTYPE>(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>("xa")
+	actual = Comp<FTYPE>(f1, nil)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>("x")
+	actual = Comp<FTYPE>()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipe<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + "a" }
+	f2 := func(v <TYPE>) <TYPE> { return v + "b" }
+
+	expected := <TYPE>("xab")
+	actual := Pipe<FTYPE>(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>("x")
+	actual = Pipe<FTYPE>()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// CompBoolTest is template to generate itself for different combination of data type.
+func CompBoolTest() string {
+	return `
+func TestComp<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return !v }
+	f2 := func(v <TYPE>) <TYPE> { return v }
+
+	expected := <TYPE>(false)
+	actual := Comp<FTYPE>(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(false)
+	actual = Comp<FTYPE>(f1, nil)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(true)
+	actual = Comp<FTYPE>()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComp<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestPipe<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return !v }
+	f2 := func(v <TYPE>) <TYPE> { return v }
+
+	expected := <TYPE>(false)
+	actual := Pipe<FTYPE>(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = <TYPE>(true)
+	actual = Pipe<FTYPE>()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPipe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PartialTest is template to generate itself for different combination of data type.
+func PartialTest() string {
+	return `
+func TestPartial<FTYPE>(t *testing.T) {
+	f2 := func(v1, v2 <TYPE>) <TYPE> { return v1 - v2 }
+	expected := <TYPE>(2)
+	actual := Partial<FTYPE>2(f2, 5)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 <TYPE>) <TYPE> { return v1*v2 + v3 }
+	expected = <TYPE>(16)
+	actual = Partial<FTYPE>3(f3, 5, 3)(1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if Partial<FTYPE>2(nil, 5) != nil || Partial<FTYPE>3(nil, 5, 3) != nil {
+		t.Errorf("TestPartial<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// PartialStrTest is template to generate itself for different combination of data type.
+func PartialStrTest() string {
+	return `
+func TestPartial<FTYPE>(t *testing.T) {
+	f2 := func(v1, v2 <TYPE>) <TYPE> { return v1 + v2 }
+	expected := <TYPE>("ab")
+	actual := Partial<FTYPE>2(f2, "a")("b")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 <TYPE>) <TYPE> { return v1 + v2 + v3 }
+	expected = <TYPE>("abc")
+	actual = Partial<FTYPE>3(f3, "a", "b")("c")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if Partial<FTYPE>2(nil, "a") != nil || Partial<FTYPE>3(nil, "a", "b") != nil {
+		t.Errorf("TestPartial<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// PartialBoolTest is template to generate itself for different combination of data type.
+func PartialBoolTest() string {
+	return `
+func TestPartial<FTYPE>(t *testing.T) {
+	f2 := func(v1, v2 <TYPE>) <TYPE> { return v1 && v2 }
+	expected := <TYPE>(false)
+	actual := Partial<FTYPE>2(f2, true)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	f3 := func(v1, v2, v3 <TYPE>) <TYPE> { return v1 && v2 || v3 }
+	expected = <TYPE>(true)
+	actual = Partial<FTYPE>3(f3, true, false)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPartial<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if Partial<FTYPE>2(nil, true) != nil || Partial<FTYPE>3(nil, true, false) != nil {
+		t.Errorf("TestPartial<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// ComplementTest is template to generate itself for different combination of data type.
+func ComplementTest() string {
+	return `
+func TestComplement<FTYPE>(t *testing.T) {
+	pred := func(v <TYPE>) bool { return v > 2 }
+	notPred := Complement<FTYPE>(pred)
+
+	if notPred(3) || !notPred(1) {
+		t.Errorf("TestComplement<FTYPE> failed.")
+	}
+
+	expected := []<TYPE>{1, 2}
+	actual := Filter<FTYPE>(notPred, []<TYPE>{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplement<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if Complement<FTYPE>(nil) != nil {
+		t.Errorf("TestComplement<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// ComplementStrTest is template to generate itself for different combination of data type.
+func ComplementStrTest() string {
+	return `
+func TestComplement<FTYPE>(t *testing.T) {
+	pred := func(v <TYPE>) bool { return v == "" }
+	notPred := Complement<FTYPE>(pred)
+
+	if notPred("") || !notPred("a") {
+		t.Errorf("TestComplement<FTYPE> failed.")
+	}
+
+	expected := []<TYPE>{"a", "b"}
+	actual := Filter<FTYPE>(notPred, []<TYPE>{"a", "", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestComplement<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if Complement<FTYPE>(nil) != nil {
+		t.Errorf("TestComplement<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// ComplementBoolTest is template to generate itself for different combination of data type.
+func ComplementBoolTest() string {
+	return `
+func TestComplement<FTYPE>(t *testing.T) {
+	pred := func(v <TYPE>) bool { return v }
+	notPred := Complement<FTYPE>(pred)
+
+	if notPred(true) || !notPred(false) {
+		t.Errorf("TestComplement<FTYPE> failed.")
+	}
+
+	if Complement<FTYPE>(nil) != nil {
+		t.Errorf("TestComplement<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// ConstantlyTest is template to generate itself for different combination of data type.
+func ConstantlyTest() string {
+	return `
+func TestConstantly<FTYPE>(t *testing.T) {
+	f := Constantly<FTYPE>(7)
+
+	if f(7) != 7 || f(1) != 7 {
+		t.Errorf("TestConstantly<FTYPE> failed.")
+	}
+
+	expected := []<TYPE>{7, 7, 7}
+	actual := Map<FTYPE>(f, []<TYPE>{1, 2, 3})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestConstantly<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ConstantlyStrTest is template to generate itself for different combination of data type.
+func ConstantlyStrTest() string {
+	return `
+func TestConstantly<FTYPE>(t *testing.T) {
+	f := Constantly<FTYPE>("a")
+
+	if f("a") != "a" || f("b") != "a" {
+		t.Errorf("TestConstantly<FTYPE> failed.")
+	}
+}
+`
+}
+
+// ConstantlyBoolTest is template to generate itself for different combination of data type.
+func ConstantlyBoolTest() string {
+	return `
+func TestConstantly<FTYPE>(t *testing.T) {
+	f := Constantly<FTYPE>(true)
+
+	if !f(true) || !f(false) {
+		t.Errorf("TestConstantly<FTYPE> failed.")
+	}
+}
+`
+}
+
+// JuxtTest is template to generate itself for different combination of data type.
+func JuxtTest() string {
+	return `
+func TestJuxt<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + 1 }
+	f2 := func(v <TYPE>) <TYPE> { return v * 2 }
+
+	expected := []<TYPE>{4, 6}
+	actual := Juxt<FTYPE>(f1, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FTYPE>(nil, f1, nil, f2)(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{}
+	actual = Juxt<FTYPE>()(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtStrTest is template to generate itself for different combination of data type.
+func JuxtStrTest() string {
+	return `
+func TestJuxt<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return v + "a" }
+	f2 := func(v <TYPE>) <TYPE> { return v + "b" }
+
+	expected := []<TYPE>{"xa", "xb"}
+	actual := Juxt<FTYPE>(f1, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FTYPE>(nil, f1, nil, f2)("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{}
+	actual = Juxt<FTYPE>()("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtBoolTest is template to generate itself for different combination of data type.
+func JuxtBoolTest() string {
+	return `
+func TestJuxt<FTYPE>(t *testing.T) {
+	f1 := func(v <TYPE>) <TYPE> { return !v }
+	f2 := func(v <TYPE>) <TYPE> { return v }
+
+	expected := []<TYPE>{false, true}
+	actual := Juxt<FTYPE>(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FTYPE>(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{}
+	actual = Juxt<FTYPE>()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
@@ -0,0 +1,20 @@
+package basic
+
+// Dedupe is template to generate itself for different combination of data type.
+func Dedupe() string {
+	return `
+// Dedupe<FTYPE> removes consecutive duplicates from the list and returns new list.
+// Items repeated later in the list, but not next to each other, are kept.
+// Returns empty list if list is empty or nil
+func Dedupe<FTYPE>(list []<TYPE>) []<TYPE> {
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+`
+}
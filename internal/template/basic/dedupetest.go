@@ -0,0 +1,86 @@
+package basic
+
+// DedupeTest is template to generate itself for different combination of data type.
+func DedupeTest() string {
+	return `
+func TestDedupe<FTYPE>(t *testing.T) {
+	list := []<TYPE>{1, 1, 2, 2, 2, 1, 3, 3}
+	expectedList := []<TYPE>{1, 2, 1, 3}
+	actualList := Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []<TYPE>{1, 2, 3}
+	expectedList = []<TYPE>{1, 2, 3}
+	actualList = Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []<TYPE>{}
+	expectedList = []<TYPE>{}
+	actualList = Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Dedupe<FTYPE>(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// DedupeStrTest is template to generate itself for different combination of data type.
+func DedupeStrTest() string {
+	return `
+func TestDedupe<FTYPE>(t *testing.T) {
+	list := []<TYPE>{"a", "a", "b", "b", "b", "a", "c", "c"}
+	expectedList := []<TYPE>{"a", "b", "a", "c"}
+	actualList := Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []<TYPE>{}
+	expectedList = []<TYPE>{}
+	actualList = Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Dedupe<FTYPE>(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// DedupeBoolTest is template to generate itself for different combination of data type.
+func DedupeBoolTest() string {
+	return `
+func TestDedupe<FTYPE>(t *testing.T) {
+	list := []<TYPE>{true, true, false, false, true}
+	expectedList := []<TYPE>{true, false, true}
+	actualList := Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	list = []<TYPE>{}
+	expectedList = []<TYPE>{}
+	actualList = Dedupe<FTYPE>(list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Dedupe<FTYPE>(nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDedupe<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
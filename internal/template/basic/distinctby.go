@@ -0,0 +1,34 @@
+package basic
+
+// DistinctBy is template to generate itself for different combination of data type.
+func DistinctBy() string {
+	return `
+// DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> removes duplicates based on the key returned by the function(1st argument).
+// The first item for each key is kept and the order of the list is preserved.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: <INPUT_TYPE1> and returns key of type: <INPUT_TYPE2>
+//	2. List
+//
+// Returns
+//	New List of type <INPUT_TYPE1>
+//	Empty list if either of arguments is nil
+func DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(f func(<INPUT_TYPE1>) <INPUT_TYPE2>, list []<INPUT_TYPE1>) []<INPUT_TYPE1> {
+	if f == nil {
+		return []<INPUT_TYPE1>{}
+	}
+
+	newList := make([]<INPUT_TYPE1>, 0, len(list))
+	s := make(map[<INPUT_TYPE2>]struct{}, len(list))
+	for _, v := range list {
+		key := f(v)
+		if _, ok := s[key]; ok {
+			continue
+		}
+		s[key] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+`
+}
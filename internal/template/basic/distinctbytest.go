@@ -0,0 +1,259 @@
+package basic
+
+// DistinctByTestNumberToNumber is template to generate itself for different combination of data type.
+func DistinctByTestNumberToNumber() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return <INPUT_TYPE2>(v % 3) }
+
+	list := []<INPUT_TYPE1>{1, 2, 3, 4, 5, 6}
+	expectedList := []<INPUT_TYPE1>{1, 2, 3}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestNumberToStr is template to generate itself for different combination of data type.
+func DistinctByTestNumberToStr() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	list := []<INPUT_TYPE1>{1, 2, 3, 4, 5, 6}
+	expectedList := []<INPUT_TYPE1>{1, 2}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestStrToNumber is template to generate itself for different combination of data type.
+func DistinctByTestStrToNumber() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return <INPUT_TYPE2>(len(v)) }
+
+	list := []<INPUT_TYPE1>{"a", "bb", "cc", "d", "eee"}
+	expectedList := []<INPUT_TYPE1>{"a", "bb", "eee"}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestStrToBool is template to generate itself for different combination of data type.
+func DistinctByTestStrToBool() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return len(v) > 1 }
+
+	list := []<INPUT_TYPE1>{"a", "bb", "cc", "d", "eee"}
+	expectedList := []<INPUT_TYPE1>{"a", "bb"}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestBoolToStr is template to generate itself for different combination of data type.
+func DistinctByTestBoolToStr() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> {
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+
+	list := []<INPUT_TYPE1>{true, true, false, true, false}
+	expectedList := []<INPUT_TYPE1>{true, false}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestNumberToBool is template to generate itself for different combination of data type.
+func DistinctByTestNumberToBool() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return v%2 == 0 }
+
+	list := []<INPUT_TYPE1>{1, 3, 2, 5, 4}
+	expectedList := []<INPUT_TYPE1>{1, 2}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestBoolToNumber is template to generate itself for different combination of data type.
+func DistinctByTestBoolToNumber() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	list := []<INPUT_TYPE1>{false, false, true, true}
+	expectedList := []<INPUT_TYPE1>{false, true}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestBoolToBool is template to generate itself for different combination of data type.
+func DistinctByTestBoolToBool() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return !v }
+
+	list := []<INPUT_TYPE1>{true, true, false, true, false}
+	expectedList := []<INPUT_TYPE1>{true, false}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
+
+// DistinctByTestStrToStr is template to generate itself for different combination of data type.
+func DistinctByTestStrToStr() string {
+	return `
+func TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	key := func(v <INPUT_TYPE1>) <INPUT_TYPE2> { return v[:1] }
+
+	list := []<INPUT_TYPE1>{"apple", "avocado", "banana", "blueberry", "cherry"}
+	expectedList := []<INPUT_TYPE1>{"apple", "banana", "cherry"}
+	actualList := DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	expectedList = []<INPUT_TYPE1>{}
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(key, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+
+	actualList = DistinctBy<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestDistinctBy<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedList, actualList)
+	}
+}
+`
+}
@@ -0,0 +1,134 @@
+package basic
+
+// Fluent is template to generate itself for different combination of data type.
+// It generates slice type whose methods call the functions of the same name, so calls can be chained
+func Fluent() string {
+	return `
+// <FTYPE>s - list of <TYPE> whose methods can be chained.
+//
+// Example:
+//	fp.<FTYPE>s(list).Filter(pred).Map(f).Reduce(f2)
+type <FTYPE>s []<TYPE>
+
+// ToSlice returns the list as []<TYPE>
+func (l <FTYPE>s) ToSlice() []<TYPE> {
+	return []<TYPE>(l)
+}
+
+// Len returns number of items
+func (l <FTYPE>s) Len() int {
+	return len(l)
+}
+
+// Filter - see Filter<FTYPE>
+func (l <FTYPE>s) Filter(f func(<TYPE>) bool) <FTYPE>s {
+	return Filter<FTYPE>(f, l)
+}
+
+// Remove - see Remove<FTYPE>
+func (l <FTYPE>s) Remove(f func(<TYPE>) bool) <FTYPE>s {
+	return Remove<FTYPE>(f, l)
+}
+
+// Map - see Map<FTYPE>
+func (l <FTYPE>s) Map(f func(<TYPE>) <TYPE>) <FTYPE>s {
+	return Map<FTYPE>(f, l)
+}
+
+// PMap - see PMap<FTYPE>
+func (l <FTYPE>s) PMap(f func(<TYPE>) <TYPE>) <FTYPE>s {
+	return PMap<FTYPE>(f, l)
+}
+
+// FilterMap - see FilterMap<FTYPE>
+func (l <FTYPE>s) FilterMap(fFilter func(<TYPE>) bool, fMap func(<TYPE>) <TYPE>) <FTYPE>s {
+	return FilterMap<FTYPE>(fFilter, fMap, l)
+}
+
+// DropWhile - see DropWhile<FTYPE>
+func (l <FTYPE>s) DropWhile(f func(<TYPE>) bool) <FTYPE>s {
+	return DropWhile<FTYPE>(f, l)
+}
+
+// TakeWhile - see TakeWhile<FTYPE>
+func (l <FTYPE>s) TakeWhile(f func(<TYPE>) bool) <FTYPE>s {
+	return TakeWhile<FTYPE>(f, l)
+}
+
+// Distinct - see Distinct<FTYPE>
+func (l <FTYPE>s) Distinct() <FTYPE>s {
+	return Distinct<FTYPE>(l)
+}
+
+// Dedupe - see Dedupe<FTYPE>
+func (l <FTYPE>s) Dedupe() <FTYPE>s {
+	return Dedupe<FTYPE>(l)
+}
+
+// Drop - see Drop<FTYPE>
+func (l <FTYPE>s) Drop(v <TYPE>) <FTYPE>s {
+	return Drop<FTYPE>(v, l)
+}
+
+// Rest - see Rest<FTYPE>
+func (l <FTYPE>s) Rest() <FTYPE>s {
+	return Rest<FTYPE>(l)
+}
+
+// Reduce - see Reduce<FTYPE>
+func (l <FTYPE>s) Reduce(f func(<TYPE>, <TYPE>) <TYPE>, initializer ...<TYPE>) <TYPE> {
+	return Reduce<FTYPE>(f, l, initializer...)
+}
+
+// Some - see Some<FTYPE>
+func (l <FTYPE>s) Some(f func(<TYPE>) bool) bool {
+	return Some<FTYPE>(f, l)
+}
+
+// Every - see Every<FTYPE>
+func (l <FTYPE>s) Every(f func(<TYPE>) bool) bool {
+	return Every<FTYPE>(f, l)
+}
+
+// Exists - see Exists<FTYPE>
+func (l <FTYPE>s) Exists(v <TYPE>) bool {
+	return Exists<FTYPE>(v, l)
+}
+
+// Find - see FindOpt<FTYPE>
+func (l <FTYPE>s) Find(pred func(<TYPE>) bool) Option<FTYPE> {
+	return FindOpt<FTYPE>(pred, l)
+}
+
+// Max - see MaxOpt<FTYPE>
+func (l <FTYPE>s) Max() Option<FTYPE> {
+	return MaxOpt<FTYPE>(l)
+}
+
+// Min - see MinOpt<FTYPE>
+func (l <FTYPE>s) Min() Option<FTYPE> {
+	return MinOpt<FTYPE>(l)
+}
+`
+}
+
+// FluentIO is template to generate itself for different combination of data type.
+// It generates methods of the slice types which return list of another type
+func FluentIO() string {
+	return `
+// MapTo<FOUTPUT_TYPE> - see Map<FINPUT_TYPE><FOUTPUT_TYPE>
+func (l <FINPUT_TYPE>s) MapTo<FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>) <FOUTPUT_TYPE>s {
+	return Map<FINPUT_TYPE><FOUTPUT_TYPE>(f, l)
+}
+
+// PMapTo<FOUTPUT_TYPE> - see PMap<FINPUT_TYPE><FOUTPUT_TYPE>
+func (l <FINPUT_TYPE>s) PMapTo<FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>) <FOUTPUT_TYPE>s {
+	return PMap<FINPUT_TYPE><FOUTPUT_TYPE>(f, l)
+}
+
+// FilterMapTo<FOUTPUT_TYPE> - see FilterMap<FINPUT_TYPE><FOUTPUT_TYPE>
+func (l <FINPUT_TYPE>s) FilterMapTo<FOUTPUT_TYPE>(fFilter func(<INPUT_TYPE>) bool, fMap func(<INPUT_TYPE>) <OUTPUT_TYPE>) <FOUTPUT_TYPE>s {
+	return FilterMap<FINPUT_TYPE><FOUTPUT_TYPE>(fFilter, fMap, l)
+}
+`
+}
@@ -0,0 +1,118 @@
+package basic
+
+// FluentTest is template to generate itself for different combination of data type.
+func FluentTest() string {
+	return `
+func TestFluent<FTYPE>(t *testing.T) {
+	list := <FTYPE>s{1, 2, 3, 4, 4}
+	large := func(v <TYPE>) bool { return v > 2 }
+	double := func(v <TYPE>) <TYPE> { return v * 2 }
+	add := func(a, b <TYPE>) <TYPE> { return a + b }
+
+	if v := list.Filter(large).Map(double).Reduce(add); v != 22 {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=%v, actual=%v", 22, v)
+	}
+	if v := list.Remove(large).PMap(double).Reduce(add, 10); v != 16 {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=%v, actual=%v", 16, v)
+	}
+	if v := list.FilterMap(large, double).Distinct().ToSlice(); !reflect.DeepEqual([]<TYPE>{6, 8}, v) {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=%v, actual=%v", []<TYPE>{6, 8}, v)
+	}
+	if v := list.DropWhile(func(v <TYPE>) bool { return v < 3 }).Dedupe().Drop(3); !reflect.DeepEqual(<FTYPE>s{4}, v) {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=[4], actual=%v", v)
+	}
+	if v := list.TakeWhile(func(v <TYPE>) bool { return v < 3 }).Rest(); !reflect.DeepEqual(<FTYPE>s{2}, v) || v.Len() != 1 {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=[2], actual=%v", v)
+	}
+	if !list.Some(large) || list.Every(large) || !list.Exists(3) || list.Exists(5) {
+		t.Errorf("TestFluent<FTYPE> failed. Unexpected Some, Every or Exists")
+	}
+	if list.Find(large).Unwrap() != 3 || list.Max().Unwrap() != 4 || list.Min().Unwrap() != 1 || <FTYPE>s(nil).Max().IsSome() {
+		t.Errorf("TestFluent<FTYPE> failed. Unexpected Find, Max or Min")
+	}
+}
+`
+}
+
+// FluentStrTest is template to generate itself for different combination of data type.
+func FluentStrTest() string {
+	return `
+func TestFluent<FTYPE>(t *testing.T) {
+	list := <FTYPE>s{"a", "bb", "cc", "cc"}
+	long := func(v <TYPE>) bool { return len(v) > 1 }
+	upper := func(v <TYPE>) <TYPE> { return v + "!" }
+
+	if v := list.Filter(long).Distinct().Map(upper).Reduce(func(a, b <TYPE>) <TYPE> { return a + b }); v != "bb!cc!" {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=%v, actual=%v", "bb!cc!", v)
+	}
+	if v := list.Dedupe().Drop("a").PMap(upper).ToSlice(); !reflect.DeepEqual([]<TYPE>{"bb!", "cc!"}, v) {
+		t.Errorf("TestFluent<FTYPE> failed. Expected=[bb! cc!], actual=%v", v)
+	}
+	if !list.Some(long) || list.Every(long) || list.Max().Unwrap() != "cc" || list.Find(long).Unwrap() != "bb" {
+		t.Errorf("TestFluent<FTYPE> failed. Unexpected Some, Every, Max or Find")
+	}
+}
+`
+}
+
+// FluentIONumber is template to generate itself for different combination of data type.
+func FluentIONumber() string {
+	return `
+func TestFluent<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	list := <FINPUT_TYPE>s{1, 2, 3}
+	double := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(v * 2) }
+	large := func(v <INPUT_TYPE>) bool { return v > 1 }
+
+	if v := list.Filter(large).MapTo<FOUTPUT_TYPE>(double); !reflect.DeepEqual(<FOUTPUT_TYPE>s{4, 6}, v) {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=[4 6], actual=%v", v)
+	}
+	if v := list.PMapTo<FOUTPUT_TYPE>(double); !reflect.DeepEqual(<FOUTPUT_TYPE>s{2, 4, 6}, v) {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=[2 4 6], actual=%v", v)
+	}
+	if v := list.FilterMapTo<FOUTPUT_TYPE>(large, double); !reflect.DeepEqual(<FOUTPUT_TYPE>s{4, 6}, v) {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=[4 6], actual=%v", v)
+	}
+}
+`
+}
+
+// FluentIONumberStr is template to generate itself for different combination of data type.
+func FluentIONumberStr() string {
+	return `
+func TestFluent<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	size := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		if v > 1 {
+			return "large"
+		}
+		return "small"
+	}
+	if v := (<FINPUT_TYPE>s{1, 2}).MapTo<FOUTPUT_TYPE>(size).Distinct(); !reflect.DeepEqual(<FOUTPUT_TYPE>s{"small", "large"}, v) {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=[small large], actual=%v", v)
+	}
+}
+`
+}
+
+// FluentIOStrNumber is template to generate itself for different combination of data type.
+func FluentIOStrNumber() string {
+	return `
+func TestFluent<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	length := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(len(v)) }
+	if v := (<FINPUT_TYPE>s{"a", "bb"}).MapTo<FOUTPUT_TYPE>(length).Reduce(func(a, b <OUTPUT_TYPE>) <OUTPUT_TYPE> { return a + b }); v != 3 {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", 3, v)
+	}
+}
+`
+}
+
+// FluentIONumberBool is template to generate itself for different combination of data type.
+func FluentIONumberBool() string {
+	return `
+func TestFluent<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	large := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return v > 1 }
+	if v := (<FINPUT_TYPE>s{1, 2}).MapTo<FOUTPUT_TYPE>(large); !reflect.DeepEqual(<FOUTPUT_TYPE>s{false, true}, v) || v.Every(True) {
+		t.Errorf("TestFluent<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=[false true], actual=%v", v)
+	}
+}
+`
+}
@@ -0,0 +1,163 @@
+package basic
+
+// Future is template to generate itself for different combination of data type.
+// It generates typed Future and Promise
+func Future() string {
+	return `
+// Future<FTYPE> - result of type <TYPE> which is computed in the background. See Future
+type Future<FTYPE> struct {
+	future *Future
+}
+
+// Promise<FTYPE> - write side of Future<FTYPE>. The first Deliver or Fail completes the future
+type Promise<FTYPE> struct {
+	promise *Promise
+}
+
+// NewFuture<FTYPE> runs the function in a new goroutine and returns future of its result.
+// If the function panics, the future fails with *PanicError
+func NewFuture<FTYPE>(f func() (<TYPE>, error)) *Future<FTYPE> {
+	if f == nil {
+		return &Future<FTYPE>{future: NewFuture(nil)}
+	}
+	return &Future<FTYPE>{future: NewFuture(func() (interface{}, error) { return f() })}
+}
+
+// NewFutureCtx<FTYPE> runs the function with the context in a new goroutine and returns future of its result.
+// The future fails with error of the context as soon as the context is done. See NewFutureCtx
+func NewFutureCtx<FTYPE>(ctx context.Context, f func(context.Context) (<TYPE>, error)) *Future<FTYPE> {
+	if f == nil {
+		return &Future<FTYPE>{future: NewFutureCtx(ctx, nil)}
+	}
+	return &Future<FTYPE>{future: NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+// NewPromise<FTYPE> creates promise with a future which is not completed
+func NewPromise<FTYPE>() *Promise<FTYPE> {
+	return &Promise<FTYPE>{promise: NewPromise()}
+}
+
+// Deliver completes the future with the value. Returns false if the future is already completed
+func (p *Promise<FTYPE>) Deliver(v <TYPE>) bool {
+	return p.promise.Deliver(v)
+}
+
+// Fail completes the future with the error. Returns false if the future is already completed
+func (p *Promise<FTYPE>) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+// Future returns the future completed by the promise
+func (p *Promise<FTYPE>) Future() *Future<FTYPE> {
+	return &Future<FTYPE>{future: p.promise.Future()}
+}
+
+// Await waits until the future is completed and returns its value and error.
+// Returns error of the context if the context is done first. nil context waits forever
+func (f *Future<FTYPE>) Await(ctx context.Context) (<TYPE>, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero <TYPE>
+		return zero, err
+	}
+	return futureValue<FTYPE>(v), nil
+}
+
+// Done returns channel which is closed when the future is completed
+func (f *Future<FTYPE>) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+// IsDone returns true if the future is completed
+func (f *Future<FTYPE>) IsDone() bool {
+	return f.future.IsDone()
+}
+
+// Untyped returns the future as *Future, to combine it with futures of other types
+func (f *Future<FTYPE>) Untyped() *Future {
+	return f.future
+}
+
+// Then returns future of the function applied to the value of this future.
+// If this future fails, the returned future fails with the same error and the function is not called
+func (f *Future<FTYPE>) Then(fn func(<TYPE>) (<TYPE>, error)) *Future<FTYPE> {
+	if fn == nil {
+		return f
+	}
+	return &Future<FTYPE>{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValue<FTYPE>(v)) })}
+}
+
+// ThenCtx is Then which fails with error of the context if the context is done before this future is completed
+func (f *Future<FTYPE>) ThenCtx(ctx context.Context, fn func(<TYPE>) (<TYPE>, error)) *Future<FTYPE> {
+	if fn == nil {
+		return &Future<FTYPE>{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &Future<FTYPE>{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValue<FTYPE>(v)) })}
+}
+
+// AwaitAll<FTYPE> waits for all the futures and returns their values in the same order.
+// Returns error as soon as one of the futures fails, or the context is done
+func AwaitAll<FTYPE>(ctx context.Context, futures ...*Future<FTYPE>) ([]<TYPE>, error) {
+	values, err := AllCtx<FTYPE>(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]<TYPE>), nil
+}
+
+// All<FTYPE> returns future of the values of all the futures in the same order, as []<TYPE>.
+// It fails as soon as one of the futures fails, with the error of that future
+func All<FTYPE>(futures ...*Future<FTYPE>) *Future {
+	return AllCtx<FTYPE>(context.Background(), futures...)
+}
+
+// AllCtx<FTYPE> is All<FTYPE> which fails with error of the context if the context is done first
+func AllCtx<FTYPE>(ctx context.Context, futures ...*Future<FTYPE>) *Future {
+	return AllCtx(ctx, untypedFutures<FTYPE>(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]<TYPE>, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValue<FTYPE>(v)
+		}
+		return list, nil
+	})
+}
+
+// Any<FTYPE> returns future of the value of the first future which succeeds.
+// If all the futures fail, it fails with the error of the first future in the list
+func Any<FTYPE>(futures ...*Future<FTYPE>) *Future<FTYPE> {
+	return &Future<FTYPE>{future: Any(untypedFutures<FTYPE>(futures)...)}
+}
+
+// Race<FTYPE> returns future of the value or the error of the first future which is completed
+func Race<FTYPE>(futures ...*Future<FTYPE>) *Future<FTYPE> {
+	return &Future<FTYPE>{future: Race(untypedFutures<FTYPE>(futures)...)}
+}
+
+// AnyCtx<FTYPE> is Any<FTYPE> which fails with error of the context if the context is done first
+func AnyCtx<FTYPE>(ctx context.Context, futures ...*Future<FTYPE>) *Future<FTYPE> {
+	return &Future<FTYPE>{future: AnyCtx(ctx, untypedFutures<FTYPE>(futures)...)}
+}
+
+// RaceCtx<FTYPE> is Race<FTYPE> which fails with error of the context if the context is done first
+func RaceCtx<FTYPE>(ctx context.Context, futures ...*Future<FTYPE>) *Future<FTYPE> {
+	return &Future<FTYPE>{future: RaceCtx(ctx, untypedFutures<FTYPE>(futures)...)}
+}
+
+// futureValue<FTYPE> returns the value as <TYPE>. nil is the zero value
+func futureValue<FTYPE>(v interface{}) <TYPE> {
+	if v == nil {
+		var zero <TYPE>
+		return zero
+	}
+	return v.(<TYPE>)
+}
+
+func untypedFutures<FTYPE>(futures []*Future<FTYPE>) []*Future {
+	list := make([]*Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+`
+}
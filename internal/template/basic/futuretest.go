@@ -0,0 +1,192 @@
+package basic
+
+// FutureTest is template to generate itself for different combination of data type.
+func FutureTest() string {
+	return `
+func TestFuture<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	f := NewFuture<FTYPE>(func() (<TYPE>, error) { return 2, nil }).
+		Then(func(v <TYPE>) (<TYPE>, error) { return v + 1, nil })
+	if v, err := f.Await(ctx); err != nil || v != 3 {
+		t.Errorf("TestFuture<FTYPE> failed. Expected=%v, actual=%v, error=%v", 3, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFuture<FTYPE>(func() (<TYPE>, error) { return 0, errBoom }).
+		Then(func(v <TYPE>) (<TYPE>, error) { return v + 1, nil })
+	if _, err := failed.Await(ctx); err != errBoom {
+		t.Errorf("TestFuture<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+
+	panicked := NewFuture<FTYPE>(func() (<TYPE>, error) { panic("boom") })
+	if _, err := panicked.Await(ctx); err == nil {
+		t.Errorf("TestFuture<FTYPE> failed. Expected panic to be returned as error")
+	} else if e, ok := err.(*PanicError); !ok || e.Value != "boom" {
+		t.Errorf("TestFuture<FTYPE> failed. Expected *PanicError, actual=%v", err)
+	}
+
+	p := NewPromise<FTYPE>()
+	timeout, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := p.Future().Await(timeout); err != context.Canceled || p.Future().IsDone() {
+		t.Errorf("TestFuture<FTYPE> failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+	if !p.Deliver(5) || p.Deliver(6) || p.Fail(errBoom) {
+		t.Errorf("TestFuture<FTYPE> failed. Expected only the first Deliver to succeed")
+	}
+	<-p.Future().Done()
+	if v, err := p.Future().Await(timeout); err != nil || v != 5 {
+		t.Errorf("TestFuture<FTYPE> failed. Expected=%v, actual=%v, error=%v", 5, v, err)
+	}
+}
+
+func TestFutureCombinators<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	slow := NewPromise<FTYPE>()
+	fast := NewFuture<FTYPE>(func() (<TYPE>, error) { return 1, nil })
+
+	if v, err := Race<FTYPE>(slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := NewFuture<FTYPE>(func() (<TYPE>, error) { return 0, errBoom })
+	if v, err := Any<FTYPE>(failed, slow.Future(), fast).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if _, err := Any<FTYPE>(failed, failed).Await(ctx); err != errBoom {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	if _, err := Race<FTYPE>().Await(ctx); err != ErrNoFutures {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected error=%v, actual=%v", ErrNoFutures, err)
+	}
+
+	// All fails without waiting for the slow future
+	if _, err := AwaitAll<FTYPE>(ctx, slow.Future(), failed); err != errBoom {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+	slow.Deliver(2)
+	values, err := AwaitAll<FTYPE>(ctx, slow.Future(), fast)
+	if err != nil || len(values) != 2 || values[0] != 2 || values[1] != 1 {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=[2 1], actual=%v, error=%v", values, err)
+	}
+	if values, err := AwaitAll<FTYPE>(ctx); err != nil || len(values) != 0 {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected empty list, actual=%v", values)
+	}
+}
+
+func TestFutureNilValue<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	inc := func(v <TYPE>) (<TYPE>, error) { return v + 1, nil }
+	empty := NewFuture<FTYPE>(nil) // completed with nil value
+	if v, err := empty.Then(inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValue<FTYPE> failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+	if v, err := empty.ThenCtx(ctx, inc).Await(ctx); err != nil || v != 1 {
+		t.Errorf("TestFutureNilValue<FTYPE> failed. Expected=%v, actual=%v, error=%v", 1, v, err)
+	}
+
+	two := NewFuture<FTYPE>(func() (<TYPE>, error) { return 2, nil })
+	values, err := AwaitAll<FTYPE>(ctx, empty, two)
+	if err != nil || len(values) != 2 || values[0] != 0 || values[1] != 2 {
+		t.Errorf("TestFutureNilValue<FTYPE> failed. Expected=[0 2], actual=%v, error=%v", values, err)
+	}
+	all, err := All<FTYPE>(two, empty).Await(ctx)
+	if list, ok := all.([]<TYPE>); err != nil || !ok || len(list) != 2 || list[0] != 2 || list[1] != 0 {
+		t.Errorf("TestFutureNilValue<FTYPE> failed. Expected=[2 0], actual=%v, error=%v", all, err)
+	}
+}
+
+func TestFutureCtx<FTYPE>(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := NewPromise<FTYPE>().Future()
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	f := NewFutureCtx<FTYPE>(ctx, func(ctx context.Context) (<TYPE>, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	futures := []*Future<FTYPE>{
+		never.ThenCtx(ctx, func(v <TYPE>) (<TYPE>, error) { return v, nil }),
+		AnyCtx<FTYPE>(ctx, never),
+		RaceCtx<FTYPE>(ctx, never),
+		f,
+	}
+	<-started
+	cancel()
+	for i, future := range futures {
+		if _, err := future.Await(context.Background()); err != context.Canceled {
+			t.Errorf("TestFutureCtx<FTYPE> failed. Expected future %v to fail with %v, actual=%v", i, context.Canceled, err)
+		}
+	}
+	if _, err := AwaitAll<FTYPE>(ctx, never); err != context.Canceled {
+		t.Errorf("TestFutureCtx<FTYPE> failed. Expected error=%v, actual=%v", context.Canceled, err)
+	}
+}
+`
+}
+
+// FutureStrTest is template to generate itself for different combination of data type.
+func FutureStrTest() string {
+	return `
+func TestFuture<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	f := NewFuture<FTYPE>(func() (<TYPE>, error) { return "a", nil }).
+		Then(func(v <TYPE>) (<TYPE>, error) { return v + "b", nil })
+	if v, err := f.Await(ctx); err != nil || v != "ab" {
+		t.Errorf("TestFuture<FTYPE> failed. Expected=%v, actual=%v, error=%v", "ab", v, err)
+	}
+
+	errBoom := errors.New("boom")
+	p := NewPromise<FTYPE>()
+	p.Fail(errBoom)
+	if _, err := p.Future().Then(func(v <TYPE>) (<TYPE>, error) { return v, nil }).Await(ctx); err != errBoom {
+		t.Errorf("TestFuture<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+}
+
+func TestFutureCombinators<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	a := NewFuture<FTYPE>(func() (<TYPE>, error) { return "a", nil })
+	b := NewFuture<FTYPE>(func() (<TYPE>, error) { return "b", nil })
+	values, err := AwaitAll<FTYPE>(ctx, a, b)
+	if err != nil || len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=[a b], actual=%v, error=%v", values, err)
+	}
+	if v, _ := Any<FTYPE>(a).Await(ctx); v != "a" {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=%v, actual=%v", "a", v)
+	}
+	if v, _ := Race<FTYPE>(b).Await(ctx); v != "b" {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=%v, actual=%v", "b", v)
+	}
+}
+`
+}
+
+// FutureBoolTest is template to generate itself for different combination of data type.
+func FutureBoolTest() string {
+	return `
+func TestFuture<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	f := NewFuture<FTYPE>(func() (<TYPE>, error) { return true, nil }).
+		Then(func(v <TYPE>) (<TYPE>, error) { return !v, nil })
+	if v, err := f.Await(ctx); err != nil || v != false {
+		t.Errorf("TestFuture<FTYPE> failed. Expected=%v, actual=%v, error=%v", false, v, err)
+	}
+}
+
+func TestFutureCombinators<FTYPE>(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+	failed := NewFuture<FTYPE>(func() (<TYPE>, error) { return false, errBoom })
+	ok := NewFuture<FTYPE>(func() (<TYPE>, error) { return true, nil })
+	if v, err := Any<FTYPE>(failed, ok).Await(ctx); err != nil || v != true {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected=%v, actual=%v, error=%v", true, v, err)
+	}
+	if _, err := AwaitAll<FTYPE>(ctx, ok, failed); err != errBoom {
+		t.Errorf("TestFutureCombinators<FTYPE> failed. Expected error=%v, actual=%v", errBoom, err)
+	}
+}
+`
+}
@@ -0,0 +1,102 @@
+package basic
+
+// Into is template to generate itself for different combination of data type.
+// It generates Map, Filter and Drop which reuse the memory of the list passed by the caller
+func Into() string {
+	return `
+// Map<FTYPE>Into applies the function to each item of the list and stores the results in dst.
+// dst is reused if its capacity is enough for the list, so no memory is allocated.
+// dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the results. dst[:0] if the function is nil
+//
+// Example:
+//	buf := make([]<TYPE>, 0, 1024)
+//	for ... {
+//		buf = Map<FTYPE>Into(buf, f, list)
+//	}
+func Map<FTYPE>Into(dst []<TYPE>, f func(<TYPE>) <TYPE>, list []<TYPE>) []<TYPE> {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	if cap(dst) < len(list) {
+		dst = make([]<TYPE>, 0, len(list))
+	}
+	for _, v := range list {
+		dst = append(dst, f(v))
+	}
+	return dst
+}
+
+// Filter<FTYPE>Into stores the items of the list which satisfy the function in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the filtered items. dst[:0] if the function is nil
+func Filter<FTYPE>Into(dst []<TYPE>, f func(<TYPE>) bool, list []<TYPE>) []<TYPE> {
+	dst = dst[:0]
+	if f == nil {
+		return dst
+	}
+	for _, v := range list {
+		if f(v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// Drop<FTYPE>Into stores the items of the list except the given item in dst.
+// dst is reused if its capacity is enough, so no memory is allocated. dst may be the list itself.
+//
+// Returns:
+//	dst resliced to the remaining items
+func Drop<FTYPE>Into(dst []<TYPE>, item <TYPE>, list []<TYPE>) []<TYPE> {
+	dst = dst[:0]
+	for _, v := range list {
+		if v != item {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// Map<FTYPE>InPlace replaces each item of the list with the result of the function and returns the list.
+// The list is returned unchanged if the function is nil
+func Map<FTYPE>InPlace(f func(<TYPE>) <TYPE>, list []<TYPE>) []<TYPE> {
+	if f == nil {
+		return list
+	}
+	for i, v := range list {
+		list[i] = f(v)
+	}
+	return list
+}
+
+// Filter<FTYPE>InPlace moves the items of the list which satisfy the function to the front, keeping their order,
+// and returns the list resliced to them. The rest of the list is set to zero value.
+// Returns list[:0] if the function is nil
+//
+// Example:
+//	list = Filter<FTYPE>InPlace(f, list)
+func Filter<FTYPE>InPlace(f func(<TYPE>) bool, list []<TYPE>) []<TYPE> {
+	if f == nil {
+		return list[:0]
+	}
+	n := 0
+	for _, v := range list {
+		if f(v) {
+			list[n] = v
+			n++
+		}
+	}
+	var zero <TYPE>
+	for i := n; i < len(list); i++ {
+		list[i] = zero
+	}
+	return list[:n]
+}
+`
+}
@@ -0,0 +1,102 @@
+package basic
+
+// IntoTest is template to generate itself for different combination of data type.
+func IntoTest() string {
+	return `
+func TestMap<FTYPE>Into(t *testing.T) {
+	double := func(v <TYPE>) <TYPE> { return v * 2 }
+	list := []<TYPE>{1, 2, 3}
+
+	dst := make([]<TYPE>, 5, 10)
+	result := Map<FTYPE>Into(dst, double, list)
+	if !reflect.DeepEqual([]<TYPE>{2, 4, 6}, result) || &result[0] != &dst[0] {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected=[2 4 6] in dst, actual=%v", result)
+	}
+	if result := Map<FTYPE>Into(nil, double, list); !reflect.DeepEqual([]<TYPE>{2, 4, 6}, result) {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected=[2 4 6], actual=%v", result)
+	}
+	if result := Map<FTYPE>Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected=[], actual=%v", result)
+	}
+	if result := Map<FTYPE>Into(list, double, list); !reflect.DeepEqual([]<TYPE>{2, 4, 6}, result) {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected=[2 4 6] in the list, actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() { dst = Map<FTYPE>Into(dst, double, list) })
+	if allocs != 0 {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilter<FTYPE>Into(t *testing.T) {
+	large := func(v <TYPE>) bool { return v > 1 }
+	list := []<TYPE>{1, 2, 3, 1}
+
+	dst := make([]<TYPE>, 0, 4)
+	if result := Filter<FTYPE>Into(dst, large, list); !reflect.DeepEqual([]<TYPE>{2, 3}, result) {
+		t.Errorf("TestFilter<FTYPE>Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := Drop<FTYPE>Into(dst, 1, list); !reflect.DeepEqual([]<TYPE>{2, 3}, result) {
+		t.Errorf("TestDrop<FTYPE>Into failed. Expected=[2 3], actual=%v", result)
+	}
+	if result := Filter<FTYPE>Into(dst, nil, list); len(result) != 0 {
+		t.Errorf("TestFilter<FTYPE>Into failed. Expected=[], actual=%v", result)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = Filter<FTYPE>Into(dst, large, list)
+		dst = Drop<FTYPE>Into(dst, 1, list)
+	})
+	if allocs != 0 {
+		t.Errorf("TestFilter<FTYPE>Into failed. Expected no allocation, actual=%v", allocs)
+	}
+}
+
+func TestFilter<FTYPE>InPlace(t *testing.T) {
+	list := []<TYPE>{1, 2, 3, 1, 4}
+	result := Filter<FTYPE>InPlace(func(v <TYPE>) bool { return v > 1 }, list)
+	if !reflect.DeepEqual([]<TYPE>{2, 3, 4}, result) || !reflect.DeepEqual([]<TYPE>{2, 3, 4, 0, 0}, list) {
+		t.Errorf("TestFilter<FTYPE>InPlace failed. Expected=[2 3 4] and list=[2 3 4 0 0], actual=%v and list=%v", result, list)
+	}
+	if result := Map<FTYPE>InPlace(func(v <TYPE>) <TYPE> { return v + 1 }, result); !reflect.DeepEqual([]<TYPE>{3, 4, 5}, list[:3]) || len(result) != 3 {
+		t.Errorf("TestMap<FTYPE>InPlace failed. Expected=[3 4 5], actual=%v", result)
+	}
+	if Filter<FTYPE>InPlace(nil, list) == nil || len(Filter<FTYPE>InPlace(nil, list)) != 0 || len(Map<FTYPE>InPlace(nil, list)) != 5 {
+		t.Errorf("TestFilter<FTYPE>InPlace failed. Unexpected result for nil function")
+	}
+}
+`
+}
+
+// IntoStrTest is template to generate itself for different combination of data type.
+func IntoStrTest() string {
+	return `
+func TestMap<FTYPE>Into(t *testing.T) {
+	upper := func(v <TYPE>) <TYPE> { return v + "!" }
+	list := []<TYPE>{"a", "b"}
+
+	dst := make([]<TYPE>, 0, 2)
+	if result := Map<FTYPE>Into(dst, upper, list); !reflect.DeepEqual([]<TYPE>{"a!", "b!"}, result) {
+		t.Errorf("TestMap<FTYPE>Into failed. Expected=[a! b!], actual=%v", result)
+	}
+	if result := Filter<FTYPE>Into(dst, func(v <TYPE>) bool { return v == "b" }, list); !reflect.DeepEqual([]<TYPE>{"b"}, result) {
+		t.Errorf("TestFilter<FTYPE>Into failed. Expected=[b], actual=%v", result)
+	}
+	if result := Drop<FTYPE>Into(dst, "b", list); !reflect.DeepEqual([]<TYPE>{"a"}, result) {
+		t.Errorf("TestDrop<FTYPE>Into failed. Expected=[a], actual=%v", result)
+	}
+}
+
+func TestFilter<FTYPE>InPlace(t *testing.T) {
+	list := []<TYPE>{"a", "bb", "c"}
+	result := Filter<FTYPE>InPlace(func(v <TYPE>) bool { return len(v) == 1 }, list)
+	if !reflect.DeepEqual([]<TYPE>{"a", "c"}, result) || list[2] != "" {
+		t.Errorf("TestFilter<FTYPE>InPlace failed. Expected=[a c], actual=%v and list=%v", result, list)
+	}
+	Map<FTYPE>InPlace(func(v <TYPE>) <TYPE> { return v + v }, result)
+	if !reflect.DeepEqual([]<TYPE>{"aa", "cc", ""}, list) {
+		t.Errorf("TestMap<FTYPE>InPlace failed. Expected=[aa cc ], actual=%v", list)
+	}
+}
+`
+}
@@ -0,0 +1,29 @@
+package basic
+
+// JuxtIO is template to generate itself for different combination of data type.
+func JuxtIO() string {
+	return `
+// Juxt<FINPUT_TYPE><FOUTPUT_TYPE> takes functions and returns the function which applies each of them on its argument
+// and returns the list of results in the same order as the functions. Nil functions are skipped
+//
+// Takes functions - each takes 1 input type: <INPUT_TYPE> output type: <OUTPUT_TYPE>
+//
+// Returns
+//	Function which returns the list of type <OUTPUT_TYPE>
+func Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(fs ...func(<INPUT_TYPE>) <OUTPUT_TYPE>) func(<INPUT_TYPE>) []<OUTPUT_TYPE> {
+	funcs := make([]func(<INPUT_TYPE>) <OUTPUT_TYPE>, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v <INPUT_TYPE>) []<OUTPUT_TYPE> {
+		newList := make([]<OUTPUT_TYPE>, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+`
+}
@@ -0,0 +1,212 @@
+package basic
+
+// JuxtIONumber is template to generate itself for different combination of data type.
+func JuxtIONumber() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(v) }
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(v + 1) }
+
+	expected := []<OUTPUT_TYPE>{2, 3}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIOStrNumber is template to generate itself for different combination of data type.
+func JuxtIOStrNumber() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(len(v)) }
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(len(v) + 1) }
+
+	expected := []<OUTPUT_TYPE>{2, 3}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIONumberStr is template to generate itself for different combination of data type.
+func JuxtIONumberStr() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return "a" }
+
+	expected := []<OUTPUT_TYPE>{"big", "a"}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIONumberBool is template to generate itself for different combination of data type.
+func JuxtIONumberBool() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return v > 1 }
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return v > 5 }
+
+	expected := []<OUTPUT_TYPE>{true, false}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIOStrBool is template to generate itself for different combination of data type.
+func JuxtIOStrBool() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return len(v) > 1 }
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return v == "" }
+
+	expected := []<OUTPUT_TYPE>{true, false}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIOBoolNumber is template to generate itself for different combination of data type.
+func JuxtIOBoolNumber() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return 5 }
+
+	expected := []<OUTPUT_TYPE>{1, 5}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// JuxtIOBoolStr is template to generate itself for different combination of data type.
+func JuxtIOBoolStr() string {
+	return `
+func TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	f1 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+	f2 := func(v <INPUT_TYPE>) <OUTPUT_TYPE> { return "a" }
+
+	expected := []<OUTPUT_TYPE>{"no", "a"}
+	actual := Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(f1, f2)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	// nil functions are skipped
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>(nil, f1, nil, f2)(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = Juxt<FINPUT_TYPE><FOUTPUT_TYPE>()(false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestJuxt<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
@@ -0,0 +1,146 @@
+package basic
+
+// MapKV is template to generate itself for different combination of data type.
+func MapKV() string {
+	return `
+// Keys<FINPUT_TYPE1><FINPUT_TYPE2> returns list of keys of the map[<INPUT_TYPE1>]<INPUT_TYPE2>.
+// Order of the keys is random unless the 2nd argument(less function) is passed to sort them
+func Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m map[<INPUT_TYPE1>]<INPUT_TYPE2>, less ...func(<INPUT_TYPE1>, <INPUT_TYPE1>) bool) []<INPUT_TYPE1> {
+	keys := make([]<INPUT_TYPE1>, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(keys, func(i, j int) bool { return less[0](keys[i], keys[j]) })
+	}
+	return keys
+}
+
+// Vals<FINPUT_TYPE1><FINPUT_TYPE2> returns list of values of the map[<INPUT_TYPE1>]<INPUT_TYPE2>.
+// Order of the values is random unless the 2nd argument(less function) is passed to sort them
+func Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m map[<INPUT_TYPE1>]<INPUT_TYPE2>, less ...func(<INPUT_TYPE2>, <INPUT_TYPE2>) bool) []<INPUT_TYPE2> {
+	vals := make([]<INPUT_TYPE2>, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(vals, func(i, j int) bool { return less[0](vals[i], vals[j]) })
+	}
+	return vals
+}
+
+// SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map which contains only the given keys of the map.
+func SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m map[<INPUT_TYPE1>]<INPUT_TYPE2>, keys ...<INPUT_TYPE1>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map without the given keys.
+func DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m map[<INPUT_TYPE1>]<INPUT_TYPE2>, keys ...<INPUT_TYPE1>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	for _, k := range keys {
+		delete(newMap, k)
+	}
+	return newMap
+}
+
+// Invert<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map where keys and values are swapped.
+// If more than one key has the same value, any one of those keys is kept
+func Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE2>]<INPUT_TYPE1> {
+	newMap := make(map[<INPUT_TYPE2>]<INPUT_TYPE1>, len(m))
+	for k, v := range m {
+		newMap[v] = k
+	}
+	return newMap
+}
+
+// MapVals<FINPUT_TYPE1><FINPUT_TYPE2> applies the function(1st argument) on each value of the map and returns a new map.
+// Returns empty map if the function is nil
+func MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(f func(<INPUT_TYPE2>) <INPUT_TYPE2>, m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	if f == nil {
+		return map[<INPUT_TYPE1>]<INPUT_TYPE2>{}
+	}
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>, len(m))
+	for k, v := range m {
+		newMap[k] = f(v)
+	}
+	return newMap
+}
+
+// MapKeys<FINPUT_TYPE1><FINPUT_TYPE2> applies the function(1st argument) on each key of the map and returns a new map.
+// If the function returns the same key for more than one key, any one of their values is kept.
+// Returns empty map if the function is nil
+func MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(f func(<INPUT_TYPE1>) <INPUT_TYPE1>, m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	if f == nil {
+		return map[<INPUT_TYPE1>]<INPUT_TYPE2>{}
+	}
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>, len(m))
+	for k, v := range m {
+		newMap[f(k)] = v
+	}
+	return newMap
+}
+
+// FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map with entries whose key satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(pred func(<INPUT_TYPE1>) bool, m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	if pred == nil {
+		return map[<INPUT_TYPE1>]<INPUT_TYPE2>{}
+	}
+	return FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, _ <INPUT_TYPE2>) bool { return pred(k) }, m)
+}
+
+// FilterVals<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map with entries whose value satisfies the predicate(1st argument).
+// Returns empty map if the predicate is nil
+func FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(pred func(<INPUT_TYPE2>) bool, m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	if pred == nil {
+		return map[<INPUT_TYPE1>]<INPUT_TYPE2>{}
+	}
+	return FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(_ <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return pred(v) }, m)
+}
+
+// FilterKV<FINPUT_TYPE1><FINPUT_TYPE2> returns a new map with entries which satisfy the predicate(1st argument).
+// The predicate takes key and value. Returns empty map if the predicate is nil
+func FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(pred func(<INPUT_TYPE1>, <INPUT_TYPE2>) bool, m map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>)
+	if pred == nil {
+		return newMap
+	}
+	for k, v := range m {
+		if pred(k, v) {
+			newMap[k] = v
+		}
+	}
+	return newMap
+}
+
+// ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> reduces the map to a single value. The function(1st argument) takes
+// value reduced so far, key and value. Map is iterated in random order, so the function should not depend on order.
+//
+// Takes 3 inputs
+//	1. Function - takes 3 inputs: <INPUT_TYPE2>, <INPUT_TYPE1>, <INPUT_TYPE2> and returns <INPUT_TYPE2>
+//	2. Map
+//	3. Initial value
+//
+// Returns
+//	Reduced value. Initial value if the function is nil or map is empty
+func ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(f func(<INPUT_TYPE2>, <INPUT_TYPE1>, <INPUT_TYPE2>) <INPUT_TYPE2>, m map[<INPUT_TYPE1>]<INPUT_TYPE2>, initializer <INPUT_TYPE2>) <INPUT_TYPE2> {
+	if f == nil {
+		return initializer
+	}
+	result := initializer
+	for k, v := range m {
+		result = f(result, k, v)
+	}
+	return result
+}
+`
+}
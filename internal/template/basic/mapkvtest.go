@@ -0,0 +1,718 @@
+package basic
+
+// MapKVTest is template to generate itself for different combination of data type.
+func MapKVTest() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 10, 2: 20}
+
+	expectedKeys := []<INPUT_TYPE1>{1, 2}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{10, 20}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 10}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == 1 && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: 20}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{10: 1, 20: 2}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 11, 2: 21}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{10: 10, 20: 20}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(30)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestNumberToStr is template to generate itself for different combination of data type.
+func MapKVTestNumberToStr() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "x", 2: "y"}
+
+	expectedKeys := []<INPUT_TYPE1>{1, 2}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{"x", "y"}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "x"}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == 1 && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: "y"}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{"x": 1, "y": 2}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "x!", 2: "y!"}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{10: "x", 20: "y"}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>("y")
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return func() <INPUT_TYPE2> {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestStrToNumber is template to generate itself for different combination of data type.
+func MapKVTestStrToNumber() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 10, "b": 20}
+
+	expectedKeys := []<INPUT_TYPE1>{"a", "b"}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{10, 20}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 10}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == "a" && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": 20}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{10: "a", 20: "b"}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 11, "b": 21}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a!": 10, "b!": 20}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(30)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestStrToBool is template to generate itself for different combination of data type.
+func MapKVTestStrToBool() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": false, "b": true}
+
+	expectedKeys := []<INPUT_TYPE1>{"a", "b"}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{false, true}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": false}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == "a" && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": true}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{false: "a", true: "b"}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": true, "b": false}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a!": false, "b!": true}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(true)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestBoolToStr is template to generate itself for different combination of data type.
+func MapKVTestBoolToStr() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: "x", true: "y"}
+
+	expectedKeys := []<INPUT_TYPE1>{false, true}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{"x", "y"}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: "x"}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == false && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: "y"}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{"x": false, "y": true}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: "x!", true: "y!"}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: "x", false: "y"}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>("y")
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return func() <INPUT_TYPE2> {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestNumberToBool is template to generate itself for different combination of data type.
+func MapKVTestNumberToBool() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: false, 2: true}
+
+	expectedKeys := []<INPUT_TYPE1>{1, 2}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{false, true}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: false}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == 1 && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: true}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, 1)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{false: 1, true: 2}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: true, 2: false}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{10: false, 20: true}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k * 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(true)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestBoolToNumber is template to generate itself for different combination of data type.
+func MapKVTestBoolToNumber() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: 10, true: 20}
+
+	expectedKeys := []<INPUT_TYPE1>{false, true}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{10, 20}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: 10}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == false && v == 10 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: 20}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == 20 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{10: false, 20: true}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: 11, true: 21}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + 1 }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: 10, false: 20}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(30)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc + v
+	}, m, 0)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestBoolToBool is template to generate itself for different combination of data type.
+func MapKVTestBoolToBool() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: false, true: true}
+
+	expectedKeys := []<INPUT_TYPE1>{false, true}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{false, true}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return !a && b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: false}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == false && v == false }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: true}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, false)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == true }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{false: false, true: true}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: true, true: false}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return !v }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: false, false: true}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return !k }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>(true)
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return acc || v
+	}, m, false)
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
+
+// MapKVTestStrToStr is template to generate itself for different combination of data type.
+func MapKVTestStrToStr() string {
+	return `
+func TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	m := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "x", "b": "y"}
+
+	expectedKeys := []<INPUT_TYPE1>{"a", "b"}
+	actualKeys := Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE1>) bool { return a < b })
+	if !reflect.DeepEqual(expectedKeys, actualKeys) || len(Keys<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedKeys, actualKeys)
+	}
+
+	expectedVals := []<INPUT_TYPE2>{"x", "y"}
+	actualVals := Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m, func(a, b <INPUT_TYPE2>) bool { return a < b })
+	if !reflect.DeepEqual(expectedVals, actualVals) || len(Vals<FINPUT_TYPE1><FINPUT_TYPE2>(m)) != 2 {
+		t.Errorf("TestVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedVals, actualVals)
+	}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "x"}
+	actual := SelectKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSelectKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) bool { return k == "a" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>, v <INPUT_TYPE2>) bool { return k == "a" && v == "x" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": "y"}
+	actual = DissocKeys<FINPUT_TYPE1><FINPUT_TYPE2>(m, "a")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestDissocKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = FilterVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) bool { return v == "y" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestFilterVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedInverted := map[<INPUT_TYPE2>]<INPUT_TYPE1>{"x": "a", "y": "b"}
+	actualInverted := Invert<FINPUT_TYPE1><FINPUT_TYPE2>(m)
+	if !reflect.DeepEqual(expectedInverted, actualInverted) {
+		t.Errorf("TestInvert<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedInverted, actualInverted)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "x!", "b": "y!"}
+	actual = MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(func(v <INPUT_TYPE2>) <INPUT_TYPE2> { return v + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapVals<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a!": "x", "b!": "y"}
+	actual = MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(func(k <INPUT_TYPE1>) <INPUT_TYPE1> { return k + "!" }, m)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapKeys<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expectedReduced := <INPUT_TYPE2>("y")
+	actualReduced := ReduceKV<FINPUT_TYPE1><FINPUT_TYPE2>(func(acc <INPUT_TYPE2>, k <INPUT_TYPE1>, v <INPUT_TYPE2>) <INPUT_TYPE2> {
+		return func() <INPUT_TYPE2> {
+			if v > acc {
+				return v
+			}
+			return acc
+		}()
+	}, m, "")
+	if expectedReduced != actualReduced {
+		t.Errorf("TestReduceKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expectedReduced, actualReduced)
+	}
+
+	if len(MapVals<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(MapKeys<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 || len(FilterKV<FINPUT_TYPE1><FINPUT_TYPE2>(nil, m)) != 0 {
+		t.Errorf("TestMapKV<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected empty map for nil function")
+	}
+}
+`
+}
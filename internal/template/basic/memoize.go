@@ -0,0 +1,46 @@
+package basic
+
+// Memoize is template to generate itself for different combination of data type.
+func Memoize() string {
+	return `
+// Memoize<FTYPE> returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+// Returns nil if the function is nil
+func Memoize<FTYPE>(f func(<TYPE>) <TYPE>, opts ...MemoizeOptions) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v <TYPE>) <TYPE> {
+		return memo.Get(v, func() interface{} { return f(v) }).(<TYPE>)
+	}
+}
+`
+}
+
+// MemoizeIO is template to generate itself for different combination of data type.
+func MemoizeIO() string {
+	return `
+// Memoize<FINPUT_TYPE><FOUTPUT_TYPE> returns the memoized version of the function. The result for each input is computed once and cached.
+// The cache is safe for concurrent use and can be bounded with MemoizeOptions - Capacity(LRU) and TTL.
+//
+// Takes 2 inputs
+//	1. Function - takes 1 input type: <INPUT_TYPE> output type: <OUTPUT_TYPE>
+//	2. MemoizeOptions (optional)
+//
+// Returns
+//	Memoized function
+//	nil if the function is nil
+func Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>, opts ...MemoizeOptions) func(<INPUT_TYPE>) <OUTPUT_TYPE> {
+	if f == nil {
+		return nil
+	}
+
+	memo := NewMemo(opts...)
+	return func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		return memo.Get(v, func() interface{} { return f(v) }).(<OUTPUT_TYPE>)
+	}
+}
+`
+}
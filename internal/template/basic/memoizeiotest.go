@@ -0,0 +1,241 @@
+package basic
+
+// MemoizeIONumber is template to generate itself for different combination of data type.
+func MemoizeIONumber() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		return <OUTPUT_TYPE>(v + 1)
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>(3)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIOStrNumber is template to generate itself for different combination of data type.
+func MemoizeIOStrNumber() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		return <OUTPUT_TYPE>(len(v))
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>(2)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("abc")
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIONumberStr is template to generate itself for different combination of data type.
+func MemoizeIONumberStr() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		if v > 1 {
+			return "big"
+		}
+		return "small"
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>("big")
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIONumberBool is template to generate itself for different combination of data type.
+func MemoizeIONumberBool() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		return v > 1
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>(true)
+	memoized(2)
+	actual := memoized(2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(1)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIOStrBool is template to generate itself for different combination of data type.
+func MemoizeIOStrBool() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		return len(v) > 1
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>(true)
+	memoized("ab")
+	actual := memoized("ab")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("a")
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIOBoolNumber is template to generate itself for different combination of data type.
+func MemoizeIOBoolNumber() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>(1)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeIOBoolStr is template to generate itself for different combination of data type.
+func MemoizeIOBoolStr() string {
+	return `
+func TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		calls++
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+
+	memoized := Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f)
+	expected := <OUTPUT_TYPE>("yes")
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FINPUT_TYPE><FOUTPUT_TYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
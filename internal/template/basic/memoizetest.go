@@ -0,0 +1,100 @@
+package basic
+
+// MemoizeTest is template to generate itself for different combination of data type.
+func MemoizeTest() string {
+	return `
+func TestMemoize<FTYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <TYPE>) <TYPE> {
+		calls++
+		return v * 2
+	}
+
+	memoized := Memoize<FTYPE>(f)
+	expected := <TYPE>(6)
+	memoized(3)
+	actual := memoized(3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(4)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FTYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeStrTest is template to generate itself for different combination of data type.
+func MemoizeStrTest() string {
+	return `
+func TestMemoize<FTYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <TYPE>) <TYPE> {
+		calls++
+		return v + "a"
+	}
+
+	memoized := Memoize<FTYPE>(f)
+	expected := <TYPE>("xa")
+	memoized("x")
+	actual := memoized("x")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized("y")
+	if calls != 2 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FTYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
+
+// MemoizeBoolTest is template to generate itself for different combination of data type.
+func MemoizeBoolTest() string {
+	return `
+func TestMemoize<FTYPE>(t *testing.T) {
+	calls := 0
+	f := func(v <TYPE>) <TYPE> {
+		calls++
+		return !v
+	}
+
+	memoized := Memoize<FTYPE>(f)
+	expected := <TYPE>(false)
+	memoized(true)
+	actual := memoized(true)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if calls != 1 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called once, actual=%v", calls)
+	}
+
+	memoized(false)
+	if calls != 2 {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected function to be called twice, actual=%v", calls)
+	}
+
+	if Memoize<FTYPE>(nil) != nil {
+		t.Errorf("TestMemoize<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+`
+}
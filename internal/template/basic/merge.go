@@ -3,34 +3,52 @@ package basic
 // Merge is template to generate itself for different combination of data type.
 func Merge() string {
 	return `
-// Merge<FINPUT_TYPE1><FINPUT_TYPE2> takes two inputs: map[<INPUT_TYPE1>]<INPUT_TYPE2> and map[<INPUT_TYPE1>]<INPUT_TYPE2> and merge two maps and returns a new map[<INPUT_TYPE1>]<INPUT_TYPE2>.
-func Merge<FINPUT_TYPE1><FINPUT_TYPE2>(map1, map2 map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
-	if map1 == nil && map2 == nil {
-		return map[<INPUT_TYPE1>]<INPUT_TYPE2>{}
-	}
+// Merge<FINPUT_TYPE1><FINPUT_TYPE2> takes two or more inputs of type map[<INPUT_TYPE1>]<INPUT_TYPE2> and merge them and returns a new map[<INPUT_TYPE1>]<INPUT_TYPE2>.
+// When a key exists in more than one map, the value from the latter map is kept.
+func Merge<FINPUT_TYPE1><FINPUT_TYPE2>(map1, map2 map[<INPUT_TYPE1>]<INPUT_TYPE2>, maps ...map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>, len(map1)+len(map2))
 
-	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>)
+	for k, v := range map1 {
+		newMap[k] = v
+	}
 
-	if map1 == nil {
-		for k, v := range map2 {
-			newMap[k] = v
-		}
-		return newMap
+	for k, v := range map2 {
+		newMap[k] = v
 	}
 
-	if map2 == nil {
-		for k, v := range map1 {
+	for _, m := range maps {
+		for k, v := range m {
 			newMap[k] = v
 		}
-		return newMap
 	}
 
-	for k, v := range map1 {
-		newMap[k] = v
-	}
+	return newMap
+}
+`
+}
 
-	for k, v := range map2 {
-		newMap[k] = v
+// MergeWith is template to generate itself for different combination of data type.
+func MergeWith() string {
+	return `
+// MergeWith<FINPUT_TYPE1><FINPUT_TYPE2> merges maps of type map[<INPUT_TYPE1>]<INPUT_TYPE2> and returns a new map.
+// When a key exists in more than one map, values are combined by the function(1st argument)
+// which takes value merged so far and value from the latter map.
+// The value from the latter map is kept if the function is nil
+//
+// Example: count words
+//	sum := func(v1, v2 int) int { return v1 + v2 }
+//	MergeWithStrInt(sum, map[string]int{"a": 1, "b": 1}, map[string]int{"a": 2}) // Returns map[a:3 b:1]
+func MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f func(<INPUT_TYPE2>, <INPUT_TYPE2>) <INPUT_TYPE2>, maps ...map[<INPUT_TYPE1>]<INPUT_TYPE2>) map[<INPUT_TYPE1>]<INPUT_TYPE2> {
+	newMap := make(map[<INPUT_TYPE1>]<INPUT_TYPE2>)
+
+	for _, m := range maps {
+		for k, v := range m {
+			if merged, ok := newMap[k]; ok && f != nil {
+				newMap[k] = f(merged, v)
+				continue
+			}
+			newMap[k] = v
+		}
 	}
 
 	return newMap
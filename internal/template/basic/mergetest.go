@@ -59,6 +59,17 @@ func TestMerge<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
 	if len(actual) != 0 {
 		t.Errorf("TestMerge<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty mape, actual=%v", actual)
 	}
+
+	map1 = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 10, 2: 20}
+	map2 = map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: 30}
+	map3 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: 40, 3: 50}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 10, 2: 40, 3: 50}
+	actual = Merge<FINPUT_TYPE1><FINPUT_TYPE2>(map1, map2, map3, nil)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMerge<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
 }
 `
 }
@@ -0,0 +1,253 @@
+package basic
+
+// MergeWithTest is template to generate itself for different combination of data type.
+func MergeWithTest() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 1, 2: 2}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: 10}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 1, 2: 12}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: 1, 2: 10}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestNumberToStr is template to generate itself for different combination of data type.
+func MergeWithTestNumberToStr() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "a", 2: "b"}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: "c"}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "a", 2: "bc"}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: "a", 2: "c"}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestStrToNumber is template to generate itself for different combination of data type.
+func MergeWithTestStrToNumber() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 1, "b": 2}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": 10}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 1, "b": 12}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": 1, "b": 10}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestStrToBool is template to generate itself for different combination of data type.
+func MergeWithTestStrToBool() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 || v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": false, "b": false}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": true}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": false, "b": true}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": false, "b": true}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestBoolToStr is template to generate itself for different combination of data type.
+func MergeWithTestBoolToStr() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: "a", false: "b"}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: "c"}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: "a", false: "bc"}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: "a", false: "c"}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestNumberToBool is template to generate itself for different combination of data type.
+func MergeWithTestNumberToBool() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 || v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: false, 2: false}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{2: true}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: false, 2: true}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{1: false, 2: true}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestBoolToNumber is template to generate itself for different combination of data type.
+func MergeWithTestBoolToNumber() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: 1, false: 2}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: 10}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: 1, false: 12}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: 1, false: 10}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestBoolToBool is template to generate itself for different combination of data type.
+func MergeWithTestBoolToBool() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 || v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: false, false: false}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{false: true}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: false, false: true}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{true: false, false: true}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
+
+// MergeWithTestStrToStr is template to generate itself for different combination of data type.
+func MergeWithTestStrToStr() string {
+	return `
+func TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	f := func(v1, v2 <INPUT_TYPE2>) <INPUT_TYPE2> { return v1 + v2 }
+	map1 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "a", "b": "b"}
+	map2 := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"b": "c"}
+
+	expected := map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "a", "b": "bc"}
+	actual := MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f, map1, nil, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = map[<INPUT_TYPE1>]<INPUT_TYPE2>{"a": "a", "b": "c"}
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(nil, map1, map2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actual = MergeWith<FINPUT_TYPE1><FINPUT_TYPE2>(f)
+	if len(actual) != 0 {
+		t.Errorf("TestMergeWith<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=empty map, actual=%v", actual)
+	}
+}
+`
+}
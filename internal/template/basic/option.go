@@ -0,0 +1,262 @@
+package basic
+
+// Option is template to generate itself for different combination of data type.
+// It generates Option, Result and the functions which return them
+func Option() string {
+	return `
+// Option<FTYPE> - <TYPE> which may be absent. The zero value is absent
+type Option<FTYPE> struct {
+	value <TYPE>
+	ok    bool
+}
+
+// SomeOpt<FTYPE> returns option with the value
+func SomeOpt<FTYPE>(v <TYPE>) Option<FTYPE> {
+	return Option<FTYPE>{value: v, ok: true}
+}
+
+// NoneOpt<FTYPE> returns option without value
+func NoneOpt<FTYPE>() Option<FTYPE> {
+	return Option<FTYPE>{}
+}
+
+// IsSome returns true if the option has value
+func (o Option<FTYPE>) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option doesn't have value
+func (o Option<FTYPE>) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and true. Returns false if the option doesn't have value
+func (o Option<FTYPE>) Get() (<TYPE>, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value. Panics if the option doesn't have value
+func (o Option<FTYPE>) Unwrap() <TYPE> {
+	if !o.ok {
+		panic("Option<FTYPE>: Unwrap of None")
+	}
+	return o.value
+}
+
+// OrElse returns the value. Returns the argument if the option doesn't have value
+func (o Option<FTYPE>) OrElse(v <TYPE>) <TYPE> {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// OrElseGet returns the value. Returns result of the function if the option doesn't have value
+func (o Option<FTYPE>) OrElseGet(f func() <TYPE>) <TYPE> {
+	if !o.ok && f != nil {
+		return f()
+	}
+	return o.value
+}
+
+// Map returns option with the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o Option<FTYPE>) Map(f func(<TYPE>) <TYPE>) Option<FTYPE> {
+	if !o.ok || f == nil {
+		return o
+	}
+	return SomeOpt<FTYPE>(f(o.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same option if it doesn't have value or the function is nil
+func (o Option<FTYPE>) FlatMap(f func(<TYPE>) Option<FTYPE>) Option<FTYPE> {
+	if !o.ok || f == nil {
+		return o
+	}
+	return f(o.value)
+}
+
+// Filter returns the option if the value satisfies the predicate. Returns None otherwise
+func (o Option<FTYPE>) Filter(pred func(<TYPE>) bool) Option<FTYPE> {
+	if !o.ok || pred == nil || !pred(o.value) {
+		return Option<FTYPE>{}
+	}
+	return o
+}
+
+// Result<FTYPE> - <TYPE> or error. The zero value is Ok with zero value of <TYPE>
+type Result<FTYPE> struct {
+	value <TYPE>
+	err   error
+}
+
+// Ok<FTYPE> returns result with the value
+func Ok<FTYPE>(v <TYPE>) Result<FTYPE> {
+	return Result<FTYPE>{value: v}
+}
+
+// Err<FTYPE> returns result with the error
+func Err<FTYPE>(err error) Result<FTYPE> {
+	return Result<FTYPE>{err: err}
+}
+
+// ResultOf<FTYPE> returns result of the value and the error which are returned by a function
+//
+// Example:
+//	ResultOf<FTYPE>(f(v))
+func ResultOf<FTYPE>(v <TYPE>, err error) Result<FTYPE> {
+	if err != nil {
+		return Result<FTYPE>{err: err}
+	}
+	return Result<FTYPE>{value: v}
+}
+
+// IsOk returns true if the result doesn't have error
+func (r Result<FTYPE>) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the result has error
+func (r Result<FTYPE>) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error
+func (r Result<FTYPE>) Get() (<TYPE>, error) {
+	return r.value, r.err
+}
+
+// Err returns the error. Returns nil if the result is Ok
+func (r Result<FTYPE>) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if the result has error
+func (r Result<FTYPE>) Unwrap() <TYPE> {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value. Returns the argument if the result has error
+func (r Result<FTYPE>) OrElse(v <TYPE>) <TYPE> {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Map returns result with the function applied to the value. Returns the same result if it has error or the function is nil
+func (r Result<FTYPE>) Map(f func(<TYPE>) <TYPE>) Result<FTYPE> {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return Ok<FTYPE>(f(r.value))
+}
+
+// FlatMap returns result of the function applied to the value. Returns the same result if it has error or the function is nil
+func (r Result<FTYPE>) FlatMap(f func(<TYPE>) Result<FTYPE>) Result<FTYPE> {
+	if r.err != nil || f == nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// Option returns option with the value. Returns None if the result has error
+func (r Result<FTYPE>) Option() Option<FTYPE> {
+	if r.err != nil {
+		return Option<FTYPE>{}
+	}
+	return SomeOpt<FTYPE>(r.value)
+}
+
+// FindOpt<FTYPE> returns the first item which satisfies the predicate. Returns None if no item satisfies the predicate or the predicate is nil
+func FindOpt<FTYPE>(pred func(<TYPE>) bool, list []<TYPE>) Option<FTYPE> {
+	if pred == nil {
+		return Option<FTYPE>{}
+	}
+	for _, v := range list {
+		if pred(v) {
+			return SomeOpt<FTYPE>(v)
+		}
+	}
+	return Option<FTYPE>{}
+}
+
+// NthOpt<FTYPE> returns the item at the index. Returns None if the index is out of range
+func NthOpt<FTYPE>(list []<TYPE>, i int) Option<FTYPE> {
+	if i < 0 || i >= len(list) {
+		return Option<FTYPE>{}
+	}
+	return SomeOpt<FTYPE>(list[i])
+}
+
+// ReduceOpt<FTYPE> reduces the list to a single value with the function. The first item is the initial value.
+// Returns None if the list is empty or the function is nil
+func ReduceOpt<FTYPE>(f func(<TYPE>, <TYPE>) <TYPE>, list []<TYPE>) Option<FTYPE> {
+	if f == nil || len(list) == 0 {
+		return Option<FTYPE>{}
+	}
+	acc := list[0]
+	for _, v := range list[1:] {
+		acc = f(acc, v)
+	}
+	return SomeOpt<FTYPE>(acc)
+}
+
+// TraverseResult<FTYPE> applies the function to each item of the list and returns the values.
+// Stops at the first error and returns it
+//
+// Example:
+//	parse := func(v <TYPE>) Result<FTYPE> { return ResultOf<FTYPE>(check(v)) }
+//	TraverseResult<FTYPE>(parse, list)
+func TraverseResult<FTYPE>(f func(<TYPE>) Result<FTYPE>, list []<TYPE>) ([]<TYPE>, error) {
+	if f == nil {
+		return []<TYPE>{}, nil
+	}
+	newList := make([]<TYPE>, len(list))
+	for i, v := range list {
+		r := f(v)
+		if r.err != nil {
+			return nil, r.err
+		}
+		newList[i] = r.value
+	}
+	return newList, nil
+}
+`
+}
+
+// MaxOpt is template to generate itself for different combination of data type.
+// It generates MaxOpt and MinOpt for ordered types
+func MaxOpt() string {
+	return `
+// MaxOpt<FTYPE> returns max item from the list. Returns None if the list is empty
+func MaxOpt<FTYPE>(list []<TYPE>) Option<FTYPE> {
+	if len(list) == 0 {
+		return Option<FTYPE>{}
+	}
+	max := list[0]
+	for _, v := range list[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return SomeOpt<FTYPE>(max)
+}
+
+// MinOpt<FTYPE> returns min item from the list. Returns None if the list is empty
+func MinOpt<FTYPE>(list []<TYPE>) Option<FTYPE> {
+	if len(list) == 0 {
+		return Option<FTYPE>{}
+	}
+	min := list[0]
+	for _, v := range list[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return SomeOpt<FTYPE>(min)
+}
+`
+}
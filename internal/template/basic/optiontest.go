@@ -0,0 +1,228 @@
+package basic
+
+// OptionTest is template to generate itself for different combination of data type.
+func OptionTest() string {
+	return `
+func TestOption<FTYPE>(t *testing.T) {
+	inc := func(v <TYPE>) <TYPE> { return v + 1 }
+	some := SomeOpt<FTYPE>(1)
+	none := NoneOpt<FTYPE>()
+
+	if v, ok := some.Map(inc).Get(); !ok || v != 2 || !some.IsSome() {
+		t.Errorf("TestOption<FTYPE> failed. Expected=%v, actual=%v", 2, v)
+	}
+	if none.Map(inc).IsSome() || !none.IsNone() || none.OrElse(5) != 5 || some.OrElse(5) != 1 {
+		t.Errorf("TestOption<FTYPE> failed. Expected None to stay None")
+	}
+	if v := none.OrElseGet(func() <TYPE> { return 3 }); v != 3 {
+		t.Errorf("TestOption<FTYPE> failed. Expected=%v, actual=%v", 3, v)
+	}
+
+	positive := func(v <TYPE>) Option<FTYPE> {
+		if v > 1 {
+			return SomeOpt<FTYPE>(v)
+		}
+		return NoneOpt<FTYPE>()
+	}
+	if some.FlatMap(positive).IsSome() || some.Map(inc).FlatMap(positive).Unwrap() != 2 {
+		t.Errorf("TestOption<FTYPE> failed. Unexpected FlatMap")
+	}
+	if some.Filter(func(v <TYPE>) bool { return v > 1 }).IsSome() || some.Filter(nil).IsSome() {
+		t.Errorf("TestOption<FTYPE> failed. Expected Filter to return None")
+	}
+	if some.Map(nil).Unwrap() != 1 || some.FlatMap(nil).Unwrap() != 1 || Ok<FTYPE>(1).Map(nil).Unwrap() != 1 || none.Map(nil).IsSome() {
+		t.Errorf("TestOption<FTYPE> failed. Expected nil function to return the same option and result")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestOption<FTYPE> failed. Expected Unwrap of None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestResult<FTYPE>(t *testing.T) {
+	errBoom := errors.New("boom")
+	inc := func(v <TYPE>) <TYPE> { return v + 1 }
+
+	if v, err := Ok<FTYPE>(1).Map(inc).Get(); err != nil || v != 2 {
+		t.Errorf("TestResult<FTYPE> failed. Expected=%v, actual=%v", 2, v)
+	}
+	failed := Err<FTYPE>(errBoom)
+	if failed.Map(inc).Err() != errBoom || failed.OrElse(5) != 5 || !failed.IsErr() || failed.Option().IsSome() {
+		t.Errorf("TestResult<FTYPE> failed. Expected error to be kept")
+	}
+	if r := Ok<FTYPE>(1).FlatMap(func(<TYPE>) Result<FTYPE> { return failed }); r.Err() != errBoom {
+		t.Errorf("TestResult<FTYPE> failed. Expected error=%v, actual=%v", errBoom, r.Err())
+	}
+	if !ResultOf<FTYPE>(1, nil).IsOk() || ResultOf<FTYPE>(1, errBoom).IsOk() {
+		t.Errorf("TestResult<FTYPE> failed. Unexpected ResultOf")
+	}
+
+	defer func() {
+		if r := recover(); r != errBoom {
+			t.Errorf("TestResult<FTYPE> failed. Expected Unwrap to panic with the error, actual=%v", r)
+		}
+	}()
+	failed.Unwrap()
+}
+
+func TestFindOpt<FTYPE>(t *testing.T) {
+	list := []<TYPE>{1, 2, 3}
+	if v, ok := FindOpt<FTYPE>(func(v <TYPE>) bool { return v > 1 }, list).Get(); !ok || v != 2 {
+		t.Errorf("TestFindOpt<FTYPE> failed. Expected=%v, actual=%v", 2, v)
+	}
+	if FindOpt<FTYPE>(func(v <TYPE>) bool { return v > 3 }, list).IsSome() || FindOpt<FTYPE>(nil, list).IsSome() {
+		t.Errorf("TestFindOpt<FTYPE> failed. Expected None")
+	}
+	if NthOpt<FTYPE>(list, 2).Unwrap() != 3 || NthOpt<FTYPE>(list, 3).IsSome() || NthOpt<FTYPE>(list, -1).IsSome() {
+		t.Errorf("TestFindOpt<FTYPE> failed. Unexpected NthOpt")
+	}
+	add := func(a, b <TYPE>) <TYPE> { return a + b }
+	if ReduceOpt<FTYPE>(add, list).Unwrap() != 6 || ReduceOpt<FTYPE>(add, nil).IsSome() || ReduceOpt<FTYPE>(nil, list).IsSome() {
+		t.Errorf("TestFindOpt<FTYPE> failed. Unexpected ReduceOpt")
+	}
+}
+
+func TestTraverseResult<FTYPE>(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	check := func(v <TYPE>) Result<FTYPE> {
+		if v > 2 {
+			return Err<FTYPE>(errTooLarge)
+		}
+		return Ok<FTYPE>(v + 1)
+	}
+	if list, err := TraverseResult<FTYPE>(check, []<TYPE>{1, 2}); err != nil || !reflect.DeepEqual([]<TYPE>{2, 3}, list) {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected=[2 3], actual=%v", list)
+	}
+	if list, err := TraverseResult<FTYPE>(check, []<TYPE>{1, 3, 2}); err != errTooLarge || list != nil {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected error=%v, actual=%v", errTooLarge, err)
+	}
+	if list, err := TraverseResult<FTYPE>(nil, []<TYPE>{1}); err != nil || len(list) != 0 {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected empty list for nil function")
+	}
+}
+`
+}
+
+// OptionStrTest is template to generate itself for different combination of data type.
+func OptionStrTest() string {
+	return `
+func TestOption<FTYPE>(t *testing.T) {
+	upper := func(v <TYPE>) <TYPE> { return v + "!" }
+	if v := SomeOpt<FTYPE>("a").Map(upper).OrElse("z"); v != "a!" {
+		t.Errorf("TestOption<FTYPE> failed. Expected=%v, actual=%v", "a!", v)
+	}
+	if v := NoneOpt<FTYPE>().Map(upper).OrElse("z"); v != "z" {
+		t.Errorf("TestOption<FTYPE> failed. Expected=%v, actual=%v", "z", v)
+	}
+	var zero Option<FTYPE>
+	if zero.IsSome() {
+		t.Errorf("TestOption<FTYPE> failed. Expected zero value to be None")
+	}
+}
+
+func TestResult<FTYPE>(t *testing.T) {
+	errBoom := errors.New("boom")
+	if v, err := ResultOf<FTYPE>("a", nil).Map(func(v <TYPE>) <TYPE> { return v + "b" }).Get(); err != nil || v != "ab" {
+		t.Errorf("TestResult<FTYPE> failed. Expected=%v, actual=%v", "ab", v)
+	}
+	if Err<FTYPE>(errBoom).OrElse("z") != "z" {
+		t.Errorf("TestResult<FTYPE> failed. Expected default value for error")
+	}
+}
+
+func TestFindOpt<FTYPE>(t *testing.T) {
+	list := []<TYPE>{"a", "bb", "cc"}
+	if v := FindOpt<FTYPE>(func(v <TYPE>) bool { return len(v) == 2 }, list).Unwrap(); v != "bb" {
+		t.Errorf("TestFindOpt<FTYPE> failed. Expected=%v, actual=%v", "bb", v)
+	}
+	if NthOpt<FTYPE>(list, 5).IsSome() || ReduceOpt<FTYPE>(func(a, b <TYPE>) <TYPE> { return a + b }, list).Unwrap() != "abbcc" {
+		t.Errorf("TestFindOpt<FTYPE> failed. Unexpected NthOpt or ReduceOpt")
+	}
+}
+
+func TestTraverseResult<FTYPE>(t *testing.T) {
+	errEmpty := errors.New("empty")
+	check := func(v <TYPE>) Result<FTYPE> {
+		if v == "" {
+			return Err<FTYPE>(errEmpty)
+		}
+		return Ok<FTYPE>(v)
+	}
+	if list, err := TraverseResult<FTYPE>(check, []<TYPE>{"a", "b"}); err != nil || !reflect.DeepEqual([]<TYPE>{"a", "b"}, list) {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected=[a b], actual=%v", list)
+	}
+	if _, err := TraverseResult<FTYPE>(check, []<TYPE>{"a", ""}); err != errEmpty {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected error=%v, actual=%v", errEmpty, err)
+	}
+}
+`
+}
+
+// OptionBoolTest is template to generate itself for different combination of data type.
+func OptionBoolTest() string {
+	return `
+func TestOption<FTYPE>(t *testing.T) {
+	not := func(v <TYPE>) <TYPE> { return !v }
+	if v := SomeOpt<FTYPE>(true).Map(not).OrElse(true); v != false {
+		t.Errorf("TestOption<FTYPE> failed. Expected=%v, actual=%v", false, v)
+	}
+	if NoneOpt<FTYPE>().Map(not).IsSome() {
+		t.Errorf("TestOption<FTYPE> failed. Expected None")
+	}
+}
+
+func TestResult<FTYPE>(t *testing.T) {
+	errBoom := errors.New("boom")
+	if Err<FTYPE>(errBoom).Option().IsSome() || !Ok<FTYPE>(true).Option().Unwrap() {
+		t.Errorf("TestResult<FTYPE> failed. Unexpected Option")
+	}
+}
+
+func TestFindOpt<FTYPE>(t *testing.T) {
+	list := []<TYPE>{false, true}
+	if !FindOpt<FTYPE>(func(v <TYPE>) bool { return v }, list).IsSome() || NthOpt<FTYPE>(list, 2).IsSome() {
+		t.Errorf("TestFindOpt<FTYPE> failed. Unexpected FindOpt or NthOpt")
+	}
+}
+
+func TestTraverseResult<FTYPE>(t *testing.T) {
+	list, err := TraverseResult<FTYPE>(func(v <TYPE>) Result<FTYPE> { return Ok<FTYPE>(!v) }, []<TYPE>{true, false})
+	if err != nil || !reflect.DeepEqual([]<TYPE>{false, true}, list) {
+		t.Errorf("TestTraverseResult<FTYPE> failed. Expected=[false true], actual=%v", list)
+	}
+}
+`
+}
+
+// MaxOptTest is template to generate itself for different combination of data type.
+func MaxOptTest() string {
+	return `
+func TestMaxOpt<FTYPE>(t *testing.T) {
+	list := []<TYPE>{3, 1, 5, 2}
+	if v := MaxOpt<FTYPE>(list).Unwrap(); v != 5 {
+		t.Errorf("TestMaxOpt<FTYPE> failed. Expected=%v, actual=%v", 5, v)
+	}
+	if v := MinOpt<FTYPE>(list).Unwrap(); v != 1 {
+		t.Errorf("TestMaxOpt<FTYPE> failed. Expected=%v, actual=%v", 1, v)
+	}
+	if MaxOpt<FTYPE>(nil).IsSome() || MinOpt<FTYPE>([]<TYPE>{}).IsSome() {
+		t.Errorf("TestMaxOpt<FTYPE> failed. Expected None for empty list")
+	}
+}
+`
+}
+
+// MaxOptStrTest is template to generate itself for different combination of data type.
+func MaxOptStrTest() string {
+	return `
+func TestMaxOpt<FTYPE>(t *testing.T) {
+	list := []<TYPE>{"b", "a", "c"}
+	if MaxOpt<FTYPE>(list).Unwrap() != "c" || MinOpt<FTYPE>(list).Unwrap() != "a" || MaxOpt<FTYPE>(nil).IsSome() {
+		t.Errorf("TestMaxOpt<FTYPE> failed. Expected max=c and min=a")
+	}
+}
+`
+}
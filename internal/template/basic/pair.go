@@ -0,0 +1,125 @@
+package basic
+
+// Pair is template to generate itself for different combination of data type.
+func Pair() string {
+	return `
+// Pair<FTYPE1><FTYPE2> holds two values: First of type <INPUT_TYPE1> and Second of type <INPUT_TYPE2>.
+type Pair<FTYPE1><FTYPE2> struct {
+	First  <INPUT_TYPE1>
+	Second <INPUT_TYPE2>
+}
+
+// ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> takes two inputs: first list of type: []<INPUT_TYPE1>, second list of type: []<INPUT_TYPE2>.
+// Then it returns a new list of pairs where nth pair holds nth item of each list.
+// Unlike Zip<FINPUT_TYPE1><FINPUT_TYPE2>, order and duplicate items are preserved.
+// Length of returned list is length of the shorter list
+func ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1 []<INPUT_TYPE1>, list2 []<INPUT_TYPE2>) []Pair<FTYPE1><FTYPE2> {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]Pair<FTYPE1><FTYPE2>, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = Pair<FTYPE1><FTYPE2>{First: list1[i], Second: list2[i]}
+	}
+	return newList
+}
+
+// Unzip<FINPUT_TYPE1><FINPUT_TYPE2> takes list of pairs and returns two lists: list of first items and list of second items.
+// It is the reverse of ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>
+func Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(pairs []Pair<FTYPE1><FTYPE2>) ([]<INPUT_TYPE1>, []<INPUT_TYPE2>) {
+	list1 := make([]<INPUT_TYPE1>, len(pairs))
+	list2 := make([]<INPUT_TYPE2>, len(pairs))
+	for i, pair := range pairs {
+		list1[i] = pair.First
+		list2[i] = pair.Second
+	}
+	return list1, list2
+}
+`
+}
+
+// ZipWith is template to generate itself for different combination of data type.
+func ZipWith() string {
+	return `
+// ZipWith<FZIPWITH> applies the function(1st argument) on nth item of each list and returns new list.
+// Length of returned list is length of the shorter list
+//
+// Takes 3 inputs
+//	1. Function - takes 2 inputs type: <INPUT_TYPE1>, <INPUT_TYPE2> and returns type: <OUTPUT_TYPE>
+//	2. List of type <INPUT_TYPE1>
+//	3. List of type <INPUT_TYPE2>
+//
+// Returns
+//	New List of type <OUTPUT_TYPE>
+//	Empty list if the function is nil
+func ZipWith<FZIPWITH>(f func(<INPUT_TYPE1>, <INPUT_TYPE2>) <OUTPUT_TYPE>, list1 []<INPUT_TYPE1>, list2 []<INPUT_TYPE2>) []<OUTPUT_TYPE> {
+	if f == nil {
+		return []<OUTPUT_TYPE>{}
+	}
+
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	newList := make([]<OUTPUT_TYPE>, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = f(list1[i], list2[i])
+	}
+	return newList
+}
+`
+}
+
+// ZipIndex is template to generate itself for different combination of data type.
+func ZipIndex() string {
+	return `
+// ZipIndex<FTYPE> returns a new list of pairs where each pair holds index and item of the list.
+func ZipIndex<FTYPE>(list []<TYPE>) []PairInt<FTYPE> {
+	newList := make([]PairInt<FTYPE>, len(list))
+	for i, v := range list {
+		newList[i] = PairInt<FTYPE>{First: i, Second: v}
+	}
+	return newList
+}
+`
+}
+
+// Zip3 is template to generate itself for different combination of data type.
+func Zip3() string {
+	return `
+// Zip3<FTYPE> takes three lists and returns a new list where nth item holds nth item of each list.
+// Length of returned list is length of the shortest list.
+//
+// The lists are of the same type, so the item is an array and no triple type is generated for
+// every combination of 3 types. Use ZipWith with a function which builds the item for lists of different types
+func Zip3<FTYPE>(list1, list2, list3 []<TYPE>) [][3]<TYPE> {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]<TYPE>, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]<TYPE>{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+// Unzip3<FTYPE> takes list returned by Zip3<FTYPE> and returns three lists.
+func Unzip3<FTYPE>(list [][3]<TYPE>) ([]<TYPE>, []<TYPE>, []<TYPE>) {
+	list1 := make([]<TYPE>, len(list))
+	list2 := make([]<TYPE>, len(list))
+	list3 := make([]<TYPE>, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+`
+}
@@ -0,0 +1,460 @@
+package basic
+
+// PairTest is template to generate itself for different combination of data type.
+func PairTest() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{1, 1, 2}
+	list2 := []<INPUT_TYPE2>{10, 20}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{1, 10}, {1, 20}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestNumberToStr is template to generate itself for different combination of data type.
+func PairTestNumberToStr() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{1, 1, 2}
+	list2 := []<INPUT_TYPE2>{"x", "y"}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{1, "x"}, {1, "y"}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestStrToNumber is template to generate itself for different combination of data type.
+func PairTestStrToNumber() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{"a", "a", "b"}
+	list2 := []<INPUT_TYPE2>{10, 20}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{"a", 10}, {"a", 20}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestStrToBool is template to generate itself for different combination of data type.
+func PairTestStrToBool() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{"a", "a", "b"}
+	list2 := []<INPUT_TYPE2>{false, true}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{"a", false}, {"a", true}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestBoolToStr is template to generate itself for different combination of data type.
+func PairTestBoolToStr() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{true, true, false}
+	list2 := []<INPUT_TYPE2>{"x", "y"}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{true, "x"}, {true, "y"}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestNumberToBool is template to generate itself for different combination of data type.
+func PairTestNumberToBool() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{1, 1, 2}
+	list2 := []<INPUT_TYPE2>{false, true}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{1, false}, {1, true}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestBoolToNumber is template to generate itself for different combination of data type.
+func PairTestBoolToNumber() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{true, true, false}
+	list2 := []<INPUT_TYPE2>{10, 20}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{true, 10}, {true, 20}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestBoolToBool is template to generate itself for different combination of data type.
+func PairTestBoolToBool() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{true, true, false}
+	list2 := []<INPUT_TYPE2>{false, true}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{true, false}, {true, true}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// PairTestStrToStr is template to generate itself for different combination of data type.
+func PairTestStrToStr() string {
+	return `
+func TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(t *testing.T) {
+	list1 := []<INPUT_TYPE1>{"a", "a", "b"}
+	list2 := []<INPUT_TYPE2>{"x", "y"}
+
+	expected := []Pair<FTYPE1><FTYPE2>{{"a", "x"}, {"a", "y"}}
+	actual := ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(list1, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2 := Unzip<FINPUT_TYPE1><FINPUT_TYPE2>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) {
+		t.Errorf("TestUnzip<FINPUT_TYPE1><FINPUT_TYPE2> failed. actual=%v, %v", actualList1, actualList2)
+	}
+
+	expected = []Pair<FTYPE1><FTYPE2>{}
+	actual = ZipPairs<FINPUT_TYPE1><FINPUT_TYPE2>(nil, list2)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipPairs<FINPUT_TYPE1><FINPUT_TYPE2> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipWithTest is template to generate itself for different combination of data type.
+func ZipWithTest() string {
+	return `
+func TestZipWith<FZIPWITH>(t *testing.T) {
+	f := func(v1 <INPUT_TYPE1>, v2 <INPUT_TYPE2>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(v1) + <OUTPUT_TYPE>(v2) }
+
+	expected := []<OUTPUT_TYPE>{11, 22}
+	actual := ZipWith<FZIPWITH>(f, []<INPUT_TYPE1>{1, 2, 3}, []<INPUT_TYPE2>{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = ZipWith<FZIPWITH>(nil, []<INPUT_TYPE1>{1, 2, 3}, []<INPUT_TYPE2>{10, 20})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipWithTestStrToStr is template to generate itself for different combination of data type.
+func ZipWithTestStrToStr() string {
+	return `
+func TestZipWith<FZIPWITH>(t *testing.T) {
+	f := func(v1 <INPUT_TYPE1>, v2 <INPUT_TYPE2>) <OUTPUT_TYPE> { return v1 + v2 }
+
+	expected := []<OUTPUT_TYPE>{"ax", "by"}
+	actual := ZipWith<FZIPWITH>(f, []<INPUT_TYPE1>{"a", "b"}, []<INPUT_TYPE2>{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = ZipWith<FZIPWITH>(nil, []<INPUT_TYPE1>{"a", "b"}, []<INPUT_TYPE2>{"x", "y", "z"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipWithTestBoolToBool is template to generate itself for different combination of data type.
+func ZipWithTestBoolToBool() string {
+	return `
+func TestZipWith<FZIPWITH>(t *testing.T) {
+	f := func(v1 <INPUT_TYPE1>, v2 <INPUT_TYPE2>) <OUTPUT_TYPE> { return v1 && v2 }
+
+	expected := []<OUTPUT_TYPE>{false, true}
+	actual := ZipWith<FZIPWITH>(f, []<INPUT_TYPE1>{true, true, false}, []<INPUT_TYPE2>{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<OUTPUT_TYPE>{}
+	actual = ZipWith<FZIPWITH>(nil, []<INPUT_TYPE1>{true, true, false}, []<INPUT_TYPE2>{false, true})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipWithTestNumberToBool is template to generate itself for different combination of data type.
+func ZipWithTestNumberToBool() string {
+	return `
+func TestZipWith<FZIPWITH>(t *testing.T) {
+	f := func(v1 <INPUT_TYPE1>, v2 <INPUT_TYPE2>) <OUTPUT_TYPE> { return v1 < v2 }
+
+	expected := []<OUTPUT_TYPE>{true, false}
+	actual := ZipWith<FZIPWITH>(f, []<INPUT_TYPE1>{1, 5, 3}, []<INPUT_TYPE2>{2, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipWithTestStrToNumber is template to generate itself for different combination of data type.
+func ZipWithTestStrToNumber() string {
+	return `
+func TestZipWith<FZIPWITH>(t *testing.T) {
+	f := func(v1 <INPUT_TYPE1>, v2 <INPUT_TYPE2>) <OUTPUT_TYPE> { return <OUTPUT_TYPE>(len(v1) + len(v2)) }
+
+	expected := []<OUTPUT_TYPE>{3, 5}
+	actual := ZipWith<FZIPWITH>(f, []<INPUT_TYPE1>{"a", "bc"}, []<INPUT_TYPE2>{"xy", "zzz", "w"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipWith<FZIPWITH> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipIndexTest is template to generate itself for different combination of data type.
+func ZipIndexTest() string {
+	return `
+func TestZipIndex<FTYPE>(t *testing.T) {
+	expected := []PairInt<FTYPE>{{0, 7}, {1, 8}}
+	actual := ZipIndex<FTYPE>([]<TYPE>{7, 8})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairInt<FTYPE>{}
+	actual = ZipIndex<FTYPE>(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipIndexStrTest is template to generate itself for different combination of data type.
+func ZipIndexStrTest() string {
+	return `
+func TestZipIndex<FTYPE>(t *testing.T) {
+	expected := []PairInt<FTYPE>{{0, "a"}, {1, "b"}}
+	actual := ZipIndex<FTYPE>([]<TYPE>{"a", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairInt<FTYPE>{}
+	actual = ZipIndex<FTYPE>(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// ZipIndexBoolTest is template to generate itself for different combination of data type.
+func ZipIndexBoolTest() string {
+	return `
+func TestZipIndex<FTYPE>(t *testing.T) {
+	expected := []PairInt<FTYPE>{{0, true}, {1, false}}
+	actual := ZipIndex<FTYPE>([]<TYPE>{true, false})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []PairInt<FTYPE>{}
+	actual = ZipIndex<FTYPE>(nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZipIndex<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// Zip3Test is template to generate itself for different combination of data type.
+func Zip3Test() string {
+	return `
+func TestZip3<FTYPE>(t *testing.T) {
+	list1 := []<TYPE>{1, 2, 3}
+	list2 := []<TYPE>{4, 5}
+	list3 := []<TYPE>{6, 7, 8}
+
+	expected := [][3]<TYPE>{{1, 4, 6}, {2, 5, 7}}
+	actual := Zip3<FTYPE>(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3<FTYPE>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3<FTYPE> failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+`
+}
+
+// Zip3StrTest is template to generate itself for different combination of data type.
+func Zip3StrTest() string {
+	return `
+func TestZip3<FTYPE>(t *testing.T) {
+	list1 := []<TYPE>{"a", "b", "c"}
+	list2 := []<TYPE>{"d", "e"}
+	list3 := []<TYPE>{"f", "g", "h"}
+
+	expected := [][3]<TYPE>{{"a", "d", "f"}, {"b", "e", "g"}}
+	actual := Zip3<FTYPE>(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3<FTYPE>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3<FTYPE> failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+`
+}
+
+// Zip3BoolTest is template to generate itself for different combination of data type.
+func Zip3BoolTest() string {
+	return `
+func TestZip3<FTYPE>(t *testing.T) {
+	list1 := []<TYPE>{true, true, true}
+	list2 := []<TYPE>{false, false}
+	list3 := []<TYPE>{true, false, true}
+
+	expected := [][3]<TYPE>{{true, false, true}, {true, false, false}}
+	actual := Zip3<FTYPE>(list1, list2, list3)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestZip3<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	actualList1, actualList2, actualList3 := Unzip3<FTYPE>(actual)
+	if !reflect.DeepEqual(list1[:2], actualList1) || !reflect.DeepEqual(list2, actualList2) || !reflect.DeepEqual(list3[:2], actualList3) {
+		t.Errorf("TestUnzip3<FTYPE> failed. actual=%v, %v, %v", actualList1, actualList2, actualList3)
+	}
+}
+`
+}
@@ -0,0 +1,99 @@
+package basic
+
+// PFilter is template to generate itself for different combination of data type.
+// It generates parallel versions of Filter, Remove, FilterMap, Some and Every
+func PFilter() string {
+	return `
+// PFilter<FTYPE> filters list based on function passed as 1st argument. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New Filtered List.
+//	Empty list if the function is nil
+//
+// Example:
+//	PFilter<FTYPE>(isValid, list, ParallelOptions{Workers: 8})
+func PFilter<FTYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...ParallelOptions) []<TYPE> {
+	if f == nil {
+		return []<TYPE>{}
+	}
+	keep := make([]bool, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PRemove<FTYPE> removes the items of the list which satisfy the function. Run in parallel with bounded number of goroutines.
+// The order of the list is kept. See ParallelOptions for the number of goroutines
+//
+// Returns:
+//	New List.
+//	Empty list if the function is nil
+func PRemove<FTYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...ParallelOptions) []<TYPE> {
+	if f == nil {
+		return []<TYPE>{}
+	}
+	return PFilter<FTYPE>(func(v <TYPE>) bool { return !f(v) }, list, opts...)
+}
+
+// PFilterMap<FTYPE> filters the list with the 1st function and applies the 2nd function on each item which is kept.
+// Run in parallel with bounded number of goroutines. The order of the list is kept
+//
+// Returns:
+//	New List.
+//	Empty list if either of the functions is nil
+func PFilterMap<FTYPE>(fFilter func(<TYPE>) bool, fMap func(<TYPE>) <TYPE>, list []<TYPE>, opts ...ParallelOptions) []<TYPE> {
+	if fFilter == nil || fMap == nil {
+		return []<TYPE>{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]<TYPE>, len(list))
+	ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+// PSome<FTYPE> returns true if any item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item satisfies the function
+//
+// Returns false if the function is nil or the list is empty
+func PSome<FTYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+// PEvery<FTYPE> returns true if every item of the list satisfies the function. Run in parallel with bounded number of goroutines.
+// No more items are checked once an item doesn't satisfy the function
+//
+// Returns false if the function is nil or the list is empty
+func PEvery<FTYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+`
+}
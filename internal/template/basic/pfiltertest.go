@@ -0,0 +1,70 @@
+package basic
+
+// PFilterTest is template to generate itself for different combination of data type.
+func PFilterTest() string {
+	return `
+func TestPFilter<FTYPE>(t *testing.T) {
+	large := func(v <TYPE>) bool { return v > 2 }
+	list := []<TYPE>{1, 5, 2, 4, 3}
+
+	if result := PFilter<FTYPE>(large, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]<TYPE>{5, 4, 3}, result) {
+		t.Errorf("TestPFilter<FTYPE> failed. Expected=[5 4 3], actual=%v", result)
+	}
+	if result := PRemove<FTYPE>(large, list); !reflect.DeepEqual([]<TYPE>{1, 2}, result) {
+		t.Errorf("TestPRemove<FTYPE> failed. Expected=[1 2], actual=%v", result)
+	}
+	if result := PFilterMap<FTYPE>(large, func(v <TYPE>) <TYPE> { return v * 2 }, list); !reflect.DeepEqual([]<TYPE>{10, 8, 6}, result) {
+		t.Errorf("TestPFilterMap<FTYPE> failed. Expected=[10 8 6], actual=%v", result)
+	}
+	if len(PFilter<FTYPE>(nil, list)) != 0 || len(PRemove<FTYPE>(nil, list)) != 0 || len(PFilterMap<FTYPE>(large, nil, list)) != 0 || len(PFilter<FTYPE>(large, nil)) != 0 {
+		t.Errorf("TestPFilter<FTYPE> failed. Expected empty list for nil arguments")
+	}
+}
+
+func TestPSome<FTYPE>(t *testing.T) {
+	large := func(v <TYPE>) bool { return v > 2 }
+	if !PSome<FTYPE>(large, []<TYPE>{1, 3}) || PSome<FTYPE>(large, []<TYPE>{1, 2}) || PSome<FTYPE>(nil, []<TYPE>{3}) || PSome<FTYPE>(large, nil) {
+		t.Errorf("TestPSome<FTYPE> failed")
+	}
+	if !PEvery<FTYPE>(large, []<TYPE>{3, 4}) || PEvery<FTYPE>(large, []<TYPE>{3, 1}) || PEvery<FTYPE>(nil, []<TYPE>{3}) || PEvery<FTYPE>(large, nil) {
+		t.Errorf("TestPEvery<FTYPE> failed")
+	}
+
+	// stops checking once the answer is known
+	list := make([]<TYPE>, 100)
+	list[0] = 3
+	calls := 0
+	count := func(v <TYPE>) bool { calls++; return v > 2 }
+	if !PSome<FTYPE>(count, list, ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPSome<FTYPE> failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+	calls = 0
+	if PEvery<FTYPE>(count, list[1:], ParallelOptions{Workers: 1}) || calls != 1 {
+		t.Errorf("TestPEvery<FTYPE> failed. Expected to stop after 1 call, actual=%v calls", calls)
+	}
+}
+`
+}
+
+// PFilterStrTest is template to generate itself for different combination of data type.
+func PFilterStrTest() string {
+	return `
+func TestPFilter<FTYPE>(t *testing.T) {
+	long := func(v <TYPE>) bool { return len(v) > 1 }
+	list := []<TYPE>{"a", "bb", "c", "dd"}
+
+	if result := PFilter<FTYPE>(long, list, ParallelOptions{Workers: 2}); !reflect.DeepEqual([]<TYPE>{"bb", "dd"}, result) {
+		t.Errorf("TestPFilter<FTYPE> failed. Expected=[bb dd], actual=%v", result)
+	}
+	if result := PRemove<FTYPE>(long, list); !reflect.DeepEqual([]<TYPE>{"a", "c"}, result) {
+		t.Errorf("TestPRemove<FTYPE> failed. Expected=[a c], actual=%v", result)
+	}
+	if result := PFilterMap<FTYPE>(long, func(v <TYPE>) <TYPE> { return v + "!" }, list); !reflect.DeepEqual([]<TYPE>{"bb!", "dd!"}, result) {
+		t.Errorf("TestPFilterMap<FTYPE> failed. Expected=[bb! dd!], actual=%v", result)
+	}
+	if !PSome<FTYPE>(long, list) || PEvery<FTYPE>(long, list) || !PEvery<FTYPE>(long, []<TYPE>{"bb"}) {
+		t.Errorf("TestPSome<FTYPE> failed")
+	}
+}
+`
+}
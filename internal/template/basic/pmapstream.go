@@ -0,0 +1,48 @@
+package basic
+
+// PMapStream is template to generate itself for different combination of data type.
+func PMapStream() string {
+	return `
+// PMapStream<FTYPE> applies the function on each item of the list in parallel and sends the results to the returned channel
+// in the order of the list. A result is sent as soon as it and all the results before it are ready,
+// so the reader can start before all the items are done.
+// At most LookAhead items are started ahead of the reader. See ParallelOptions and ParallelStream
+//
+// The channel is closed after the last result, or when the context is done. nil context never gets done.
+// The reader must read all the results or cancel the context, otherwise goroutines are left blocked.
+//
+// Example:
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	for v := range PMapStream<FTYPE>(ctx, f, list, ParallelOptions{Workers: 8, LookAhead: 64}) {
+//		save(v)
+//	}
+func PMapStream<FTYPE>(ctx context.Context, f func(<TYPE>) <TYPE>, list []<TYPE>, opts ...ParallelOptions) <-chan <TYPE> {
+	out := make(chan <TYPE>)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]<TYPE>, len(list))
+	indexes := ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero <TYPE>
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+`
+}
@@ -0,0 +1,90 @@
+package basic
+
+// PMapStreamTest is template to generate itself for different combination of data type.
+func PMapStreamTest() string {
+	return `
+func TestPMapStream<FTYPE>(t *testing.T) {
+	list := make([]<TYPE>, 50)
+	for i := range list {
+		list[i] = <TYPE>(i % 10)
+	}
+	double := func(v <TYPE>) <TYPE> { return v * 2 }
+
+	var result []<TYPE>
+	for v := range PMapStream<FTYPE>(context.Background(), double, list, ParallelOptions{Workers: 4, LookAhead: 3}) {
+		result = append(result, v)
+	}
+	if expected := Map<FTYPE>(double, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStream<FTYPE> failed. Expected=%v, actual=%v", expected, result)
+	}
+
+	if _, ok := <-PMapStream<FTYPE>(nil, nil, list); ok {
+		t.Errorf("TestPMapStream<FTYPE> failed. Expected closed channel for nil function")
+	}
+	if _, ok := <-PMapStream<FTYPE>(nil, double, nil); ok {
+		t.Errorf("TestPMapStream<FTYPE> failed. Expected closed channel for empty list")
+	}
+}
+
+func TestPMapStream<FTYPE>LookAhead(t *testing.T) {
+	const lookAhead = 3
+	// requested is the number of results the reader has started to receive
+	var started, requested, violations int32
+	count := func(v <TYPE>) <TYPE> {
+		if atomic.AddInt32(&started, 1) > atomic.LoadInt32(&requested)+lookAhead {
+			atomic.AddInt32(&violations, 1)
+		}
+		return v
+	}
+	// waits until the look-ahead is full. The deadline only stops the test from hanging
+	waitStarted := func(n int32) {
+		deadline := time.Now().Add(10 * time.Second)
+		for atomic.LoadInt32(&started) < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("TestPMapStream<FTYPE>LookAhead failed. Expected %v items to be started, actual=%v", n, atomic.LoadInt32(&started))
+			}
+			runtime.Gosched()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PMapStream<FTYPE>(ctx, count, make([]<TYPE>, 100), ParallelOptions{Workers: 8, LookAhead: lookAhead})
+	for r := int32(0); r < 10; r++ {
+		waitStarted(r + lookAhead)
+		atomic.AddInt32(&requested, 1)
+		<-ch
+	}
+
+	cancel()
+	for range ch {
+	}
+	if n := atomic.LoadInt32(&violations); n != 0 {
+		t.Errorf("TestPMapStream<FTYPE>LookAhead failed. Expected at most %v items started ahead of the reader, actual %v violations", lookAhead, n)
+	}
+	if n := atomic.LoadInt32(&started); n > 10+lookAhead {
+		t.Errorf("TestPMapStream<FTYPE>LookAhead failed. Expected to stop after cancel, actual=%v started", n)
+	}
+}
+`
+}
+
+// PMapStreamStrTest is template to generate itself for different combination of data type.
+func PMapStreamStrTest() string {
+	return `
+func TestPMapStream<FTYPE>(t *testing.T) {
+	list := []<TYPE>{"a", "b", "c", "d", "e"}
+	upper := func(v <TYPE>) <TYPE> { return v + "!" }
+
+	var result []<TYPE>
+	for v := range PMapStream<FTYPE>(nil, upper, list, ParallelOptions{Workers: 2, LookAhead: 2}) {
+		result = append(result, v)
+	}
+	if expected := Map<FTYPE>(upper, list); !reflect.DeepEqual(expected, result) {
+		t.Errorf("TestPMapStream<FTYPE> failed. Expected=%v, actual=%v", expected, result)
+	}
+	if _, ok := <-PMapStream<FTYPE>(nil, nil, list); ok {
+		t.Errorf("TestPMapStream<FTYPE> failed. Expected closed channel for nil function")
+	}
+}
+`
+}
@@ -0,0 +1,127 @@
+package basic
+
+// Predicate is template to generate itself for different combination of data type.
+func Predicate() string {
+	return `
+// And<FTYPE> combines predicates and returns the predicate which is true when all of them are true.
+// Nil predicates are skipped. Returns the predicate which is always true if no predicate is passed
+func And<FTYPE>(preds ...func(<TYPE>) bool) func(<TYPE>) bool {
+	return func(v <TYPE>) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or<FTYPE> combines predicates and returns the predicate which is true when any of them is true.
+// Nil predicates are skipped. Returns the predicate which is always false if no predicate is passed
+func Or<FTYPE>(preds ...func(<TYPE>) bool) func(<TYPE>) bool {
+	return func(v <TYPE>) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not<FTYPE> returns the predicate which returns the opposite truth value. Same as Complement<FTYPE>.
+// Returns nil if the predicate is nil
+func Not<FTYPE>(pred func(<TYPE>) bool) func(<TYPE>) bool {
+	return Complement<FTYPE>(pred)
+}
+
+// None<FTYPE> returns true if the predicate(1st argument) is false for every item in the list.
+//
+// Returns:
+//	true if no item satisfies the predicate or list is empty
+//	false if any item satisfies the predicate or the predicate is nil
+func None<FTYPE>(pred func(<TYPE>) bool, list []<TYPE>) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+`
+}
+
+// Eq is template to generate itself for different combination of data type.
+func Eq() string {
+	return `
+// Eq<FTYPE> returns the predicate which checks if the item is equal to v.
+func Eq<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item == v
+	}
+}
+
+// Ne<FTYPE> returns the predicate which checks if the item is not equal to v.
+func Ne<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item != v
+	}
+}
+`
+}
+
+// Compare is template to generate itself for different combination of data type.
+func Compare() string {
+	return `
+// Gt<FTYPE> returns the predicate which checks if the item is greater than v.
+func Gt<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item > v
+	}
+}
+
+// Ge<FTYPE> returns the predicate which checks if the item is greater than or equal to v.
+func Ge<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item >= v
+	}
+}
+
+// Lt<FTYPE> returns the predicate which checks if the item is less than v.
+func Lt<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item < v
+	}
+}
+
+// Le<FTYPE> returns the predicate which checks if the item is less than or equal to v.
+func Le<FTYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item <= v
+	}
+}
+
+// Between<FTYPE> returns the predicate which checks if the item is between lower and upper value(both inclusive).
+func Between<FTYPE>(lower, upper <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item >= lower && item <= upper
+	}
+}
+`
+}
+
+// InSet is template to generate itself for different combination of data type.
+func InSet() string {
+	return `
+// InSet<FTYPE> returns the predicate which checks if the item exists in the set.
+// Works with set.<FTYPE> and set.<FTYPE>Sync of package "github.com/logic-building/functional-go/set".
+func InSet<FTYPE>(s interface{ Contains(<TYPE>) bool }) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return s.Contains(item)
+	}
+}
+`
+}
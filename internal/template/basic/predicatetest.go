@@ -0,0 +1,261 @@
+package basic
+
+// PredicateTest is template to generate itself for different combination of data type.
+func PredicateTest() string {
+	return `
+func TestAndOrNot<FTYPE>(t *testing.T) {
+	gt1 := func(v <TYPE>) bool { return v > 1 }
+	lt4 := func(v <TYPE>) bool { return v < 4 }
+
+	expected := []<TYPE>{2, 3}
+	actual := Filter<FTYPE>(And<FTYPE>(gt1, lt4), []<TYPE>{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAnd<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{1, 4}
+	actual = Filter<FTYPE>(Or<FTYPE>(Not<FTYPE>(gt1), Not<FTYPE>(lt4)), []<TYPE>{1, 2, 3, 4})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOr<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if !And<FTYPE>()(1) || !And<FTYPE>(nil, gt1)(2) {
+		t.Errorf("TestAnd<FTYPE> failed.")
+	}
+
+	if Or<FTYPE>()(1) || !Or<FTYPE>(nil, gt1)(2) {
+		t.Errorf("TestOr<FTYPE> failed.")
+	}
+
+	if Not<FTYPE>(nil) != nil {
+		t.Errorf("TestNot<FTYPE> failed. Expected nil function for nil input")
+	}
+}
+
+func TestNone<FTYPE>(t *testing.T) {
+	gt5 := func(v <TYPE>) bool { return v > 5 }
+
+	if !None<FTYPE>(gt5, []<TYPE>{1, 2, 3}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+
+	if None<FTYPE>(gt5, []<TYPE>{1, 6, 3}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+
+	if !None<FTYPE>(gt5, nil) || None<FTYPE>(nil, []<TYPE>{1}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+}
+`
+}
+
+// PredicateStrTest is template to generate itself for different combination of data type.
+func PredicateStrTest() string {
+	return `
+func TestAndOrNot<FTYPE>(t *testing.T) {
+	notEmpty := func(v <TYPE>) bool { return v != "" }
+	short := func(v <TYPE>) bool { return len(v) < 3 }
+
+	expected := []<TYPE>{"a", "bb"}
+	actual := Filter<FTYPE>(And<FTYPE>(notEmpty, short), []<TYPE>{"a", "", "bb", "ccc"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestAnd<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{"", "ccc"}
+	actual = Filter<FTYPE>(Or<FTYPE>(Not<FTYPE>(notEmpty), Not<FTYPE>(short)), []<TYPE>{"a", "", "bb", "ccc"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestOr<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestNone<FTYPE>(t *testing.T) {
+	empty := func(v <TYPE>) bool { return v == "" }
+
+	if !None<FTYPE>(empty, []<TYPE>{"a", "b"}) || None<FTYPE>(empty, []<TYPE>{"a", ""}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+
+	if None<FTYPE>(nil, []<TYPE>{"a"}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+}
+`
+}
+
+// PredicateBoolTest is template to generate itself for different combination of data type.
+func PredicateBoolTest() string {
+	return `
+func TestAndOrNot<FTYPE>(t *testing.T) {
+	identity := func(v <TYPE>) bool { return v }
+
+	if !And<FTYPE>(identity, identity)(true) || And<FTYPE>(identity, Not<FTYPE>(identity))(true) {
+		t.Errorf("TestAnd<FTYPE> failed.")
+	}
+
+	if !Or<FTYPE>(identity, Not<FTYPE>(identity))(false) || Or<FTYPE>(identity)(false) {
+		t.Errorf("TestOr<FTYPE> failed.")
+	}
+}
+
+func TestNone<FTYPE>(t *testing.T) {
+	identity := func(v <TYPE>) bool { return v }
+
+	if !None<FTYPE>(identity, []<TYPE>{false, false}) || None<FTYPE>(identity, []<TYPE>{false, true}) {
+		t.Errorf("TestNone<FTYPE> failed.")
+	}
+}
+`
+}
+
+// EqTest is template to generate itself for different combination of data type.
+func EqTest() string {
+	return `
+func TestEqNe<FTYPE>(t *testing.T) {
+	expected := []<TYPE>{2, 2}
+	actual := Filter<FTYPE>(Eq<FTYPE>(2), []<TYPE>{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEq<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{1, 3}
+	actual = Filter<FTYPE>(Ne<FTYPE>(2), []<TYPE>{1, 2, 3, 2})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// EqStrTest is template to generate itself for different combination of data type.
+func EqStrTest() string {
+	return `
+func TestEqNe<FTYPE>(t *testing.T) {
+	expected := []<TYPE>{"b", "b"}
+	actual := Filter<FTYPE>(Eq<FTYPE>("b"), []<TYPE>{"a", "b", "c", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestEq<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{"a", "c"}
+	actual = Filter<FTYPE>(Ne<FTYPE>("b"), []<TYPE>{"a", "b", "c", "b"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestNe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// EqBoolTest is template to generate itself for different combination of data type.
+func EqBoolTest() string {
+	return `
+func TestEqNe<FTYPE>(t *testing.T) {
+	if !Eq<FTYPE>(true)(true) || Eq<FTYPE>(true)(false) {
+		t.Errorf("TestEq<FTYPE> failed.")
+	}
+
+	if Ne<FTYPE>(true)(true) || !Ne<FTYPE>(true)(false) {
+		t.Errorf("TestNe<FTYPE> failed.")
+	}
+}
+`
+}
+
+// CompareTest is template to generate itself for different combination of data type.
+func CompareTest() string {
+	return `
+func TestCompare<FTYPE>(t *testing.T) {
+	list := []<TYPE>{1, 2, 3, 4, 5}
+
+	expected := []<TYPE>{4, 5}
+	actual := Filter<FTYPE>(Gt<FTYPE>(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{3, 4, 5}
+	actual = Filter<FTYPE>(Ge<FTYPE>(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{1, 2}
+	actual = Filter<FTYPE>(Lt<FTYPE>(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{1, 2, 3}
+	actual = Filter<FTYPE>(Le<FTYPE>(3), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{2, 3, 4}
+	actual = Filter<FTYPE>(Between<FTYPE>(2, 4), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetween<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// CompareStrTest is template to generate itself for different combination of data type.
+func CompareStrTest() string {
+	return `
+func TestCompare<FTYPE>(t *testing.T) {
+	list := []<TYPE>{"a", "b", "c", "d"}
+
+	expected := []<TYPE>{"c", "d"}
+	actual := Filter<FTYPE>(Gt<FTYPE>("b"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestGt<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{"a", "b"}
+	actual = Filter<FTYPE>(Le<FTYPE>("b"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestLe<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{"b", "c"}
+	actual = Filter<FTYPE>(Between<FTYPE>("b", "c"), list)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestBetween<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// InSetTest is template to generate itself for different combination of data type.
+func InSetTest() string {
+	return `
+func TestInSet<FTYPE>(t *testing.T) {
+	expected := []<TYPE>{2, 4}
+	actual := Filter<FTYPE>(InSet<FTYPE>(set.New<FTYPE>([]<TYPE>{2, 4, 6})), []<TYPE>{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSet<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	expected = []<TYPE>{1, 3, 5}
+	actual = Remove<FTYPE>(InSet<FTYPE>(set.New<FTYPE>Sync([]<TYPE>{2, 4, 6})), []<TYPE>{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSet<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
+
+// InSetStrTest is template to generate itself for different combination of data type.
+func InSetStrTest() string {
+	return `
+func TestInSet<FTYPE>(t *testing.T) {
+	expected := []<TYPE>{"b", "d"}
+	actual := Filter<FTYPE>(InSet<FTYPE>(set.New<FTYPE>([]<TYPE>{"b", "d", "f"})), []<TYPE>{"a", "b", "c", "d"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestInSet<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+}
+`
+}
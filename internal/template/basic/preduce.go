@@ -0,0 +1,48 @@
+package basic
+
+// PReduce is template to generate itself for different combination of data type.
+func PReduce() string {
+	return `
+// PReduce<FTYPE> divides the list into chunks, reduces each chunk in parallel with fReduce starting from identity,
+// and combines the results of the chunks in order with fCombine. fReduce is used to combine if fCombine is nil.
+// fCombine must be associative and identity must not change the result of it(eg. 0 for +, 1 for *).
+// See ParallelOptions for the number of goroutines and the size of the chunks
+//
+// Returns:
+//	single value. identity if the list is empty or fReduce is nil
+//
+// Example: sum of squares
+//	add := func(a, b <TYPE>) <TYPE> { return a + b }
+//	addSquare := func(acc, v <TYPE>) <TYPE> { return acc + v*v }
+//	PReduce<FTYPE>(addSquare, add, 0, list, ParallelOptions{ChunkSize: 4096})
+func PReduce<FTYPE>(fReduce, fCombine func(<TYPE>, <TYPE>) <TYPE>, identity <TYPE>, list []<TYPE>, opts ...ParallelOptions) <TYPE> {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := ParallelChunkSize(len(list), opts...)
+	results := make([]<TYPE>, (len(list)+size-1)/size)
+	ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+`
+}
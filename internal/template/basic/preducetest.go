@@ -0,0 +1,28 @@
+package basic
+
+// PReduceTest is template to generate itself for different combination of data type.
+func PReduceTest() string {
+	return `
+func TestPReduce<FTYPE>(t *testing.T) {
+	add := func(a, b <TYPE>) <TYPE> { return a + b }
+	addSquare := func(acc, v <TYPE>) <TYPE> { return acc + v*v }
+	list := []<TYPE>{1, 2, 3, 4, 5, 6}
+
+	if v := PReduce<FTYPE>(addSquare, add, 0, list, ParallelOptions{Workers: 3, ChunkSize: 2}); v != 91 {
+		t.Errorf("TestPReduce<FTYPE> failed. Expected=%v, actual=%v", 91, v)
+	}
+	if v := PReduce<FTYPE>(add, nil, 0, list, ParallelOptions{Workers: 2}); v != 21 {
+		t.Errorf("TestPReduce<FTYPE> failed. Expected=%v, actual=%v", 21, v)
+	}
+	if v := PReduce<FTYPE>(func(a, b <TYPE>) <TYPE> { return a * b }, nil, 1, list[:5]); v != 120 {
+		t.Errorf("TestPReduce<FTYPE> failed. Expected=%v, actual=%v", 120, v)
+	}
+	if v := PReduce<FTYPE>(add, add, 9, nil); v != 9 {
+		t.Errorf("TestPReduce<FTYPE> failed. Expected identity for empty list, actual=%v", v)
+	}
+	if v := PReduce<FTYPE>(nil, add, 9, list); v != 9 {
+		t.Errorf("TestPReduce<FTYPE> failed. Expected identity for nil function, actual=%v", v)
+	}
+}
+`
+}
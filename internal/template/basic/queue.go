@@ -0,0 +1,380 @@
+package basic
+
+// Queue is template to generate itself for different combination of data type.
+// It generates persistent Stack, Queue, Deque and heap based PriorityQueue
+func Queue() string {
+	return `
+// Stack<FTYPE> - persistent(immutable) stack of <TYPE>. Push and Pop return a new stack
+// and the original stack is not modified. nil is an empty stack
+type Stack<FTYPE> struct {
+	top  <TYPE>
+	rest *Stack<FTYPE>
+	size int
+}
+
+// NewStack<FTYPE> creates stack from the list. Last item of the list is on top
+func NewStack<FTYPE>(list []<TYPE>) *Stack<FTYPE> {
+	var s *Stack<FTYPE>
+	for _, v := range list {
+		s = s.Push(v)
+	}
+	return s
+}
+
+// Push returns a new stack with the item on top
+func (s *Stack<FTYPE>) Push(v <TYPE>) *Stack<FTYPE> {
+	return &Stack<FTYPE>{top: v, rest: s, size: s.Size() + 1}
+}
+
+// Pop returns a new stack without the top item. Returns nil(empty stack) if the stack is empty
+func (s *Stack<FTYPE>) Pop() *Stack<FTYPE> {
+	if s == nil {
+		return nil
+	}
+	return s.rest
+}
+
+// Peek returns the top item and true. Returns false if the stack is empty
+func (s *Stack<FTYPE>) Peek() (<TYPE>, bool) {
+	if s == nil {
+		var zero <TYPE>
+		return zero, false
+	}
+	return s.top, true
+}
+
+// Size returns number of items
+func (s *Stack<FTYPE>) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.size
+}
+
+// IsEmpty returns true if the stack has no item
+func (s *Stack<FTYPE>) IsEmpty() bool {
+	return s == nil
+}
+
+// ToSlice returns items from bottom to top, so NewStack<FTYPE>(s.ToSlice()) is same as the stack
+func (s *Stack<FTYPE>) ToSlice() []<TYPE> {
+	list := make([]<TYPE>, s.Size())
+	for i := len(list) - 1; s != nil; i-- {
+		list[i] = s.top
+		s = s.rest
+	}
+	return list
+}
+
+// Queue<FTYPE> - persistent(immutable) FIFO queue of <TYPE>. Push and Pop return a new queue
+// and the original queue is not modified. nil is an empty queue.
+//
+// Push is O(1). Pop is amortized O(1) only when each version of the queue is popped once:
+// when the front is used up, Pop reverses the rear, which is O(n), and the result isn't shared
+// with the other versions. Popping the same old version again and again costs O(n) every time
+type Queue<FTYPE> struct {
+	// front has the first item on top. rear has the last item on top
+	front *Stack<FTYPE>
+	rear  *Stack<FTYPE>
+}
+
+// NewQueue<FTYPE> creates queue from the list. First item of the list is at the front
+func NewQueue<FTYPE>(list []<TYPE>) *Queue<FTYPE> {
+	if len(list) == 0 {
+		return nil
+	}
+	return &Queue<FTYPE>{front: reversedStack<FTYPE>(list)}
+}
+
+// reversedStack<FTYPE> creates stack with the first item of the list on top
+func reversedStack<FTYPE>(list []<TYPE>) *Stack<FTYPE> {
+	var s *Stack<FTYPE>
+	for i := len(list) - 1; i >= 0; i-- {
+		s = s.Push(list[i])
+	}
+	return s
+}
+
+// Push returns a new queue with the item at the end
+func (q *Queue<FTYPE>) Push(v <TYPE>) *Queue<FTYPE> {
+	if q == nil {
+		return &Queue<FTYPE>{front: (*Stack<FTYPE>)(nil).Push(v)}
+	}
+	return &Queue<FTYPE>{front: q.front, rear: q.rear.Push(v)}
+}
+
+// Pop returns a new queue without the first item. Returns nil(empty queue) if the queue is empty.
+// It is O(n) when the front has only one item. See Queue<FTYPE>
+func (q *Queue<FTYPE>) Pop() *Queue<FTYPE> {
+	if q.Size() <= 1 {
+		return nil
+	}
+	if front := q.front.Pop(); front != nil {
+		return &Queue<FTYPE>{front: front, rear: q.rear}
+	}
+	return &Queue<FTYPE>{front: reversedStack<FTYPE>(q.rear.ToSlice())}
+}
+
+// Peek returns the first item and true. Returns false if the queue is empty
+func (q *Queue<FTYPE>) Peek() (<TYPE>, bool) {
+	if q == nil {
+		var zero <TYPE>
+		return zero, false
+	}
+	return q.front.Peek()
+}
+
+// Size returns number of items
+func (q *Queue<FTYPE>) Size() int {
+	if q == nil {
+		return 0
+	}
+	return q.front.Size() + q.rear.Size()
+}
+
+// IsEmpty returns true if the queue has no item
+func (q *Queue<FTYPE>) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (q *Queue<FTYPE>) ToSlice() []<TYPE> {
+	if q == nil {
+		return []<TYPE>{}
+	}
+	list := make([]<TYPE>, 0, q.Size())
+	for s := q.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, q.rear.ToSlice()...)
+}
+
+// Deque<FTYPE> - persistent(immutable) double-ended queue of <TYPE>. Push and Pop return a new deque
+// and the original deque is not modified. nil is an empty deque.
+//
+// Push and Pop are amortized O(1) only when each version of the deque is changed once:
+// when one end is used up, the items are split into halves again, which is O(n).
+// Doing that on the same old version again and again costs O(n) every time
+type Deque<FTYPE> struct {
+	// front has the first item on top. back has the last item on top.
+	// Both are non-empty when the deque has more than one item
+	front *Stack<FTYPE>
+	back  *Stack<FTYPE>
+}
+
+// NewDeque<FTYPE> creates deque from the list. First item of the list is at the front
+func NewDeque<FTYPE>(list []<TYPE>) *Deque<FTYPE> {
+	if len(list) == 0 {
+		return nil
+	}
+	half := (len(list) + 1) / 2
+	return &Deque<FTYPE>{front: reversedStack<FTYPE>(list[:half]), back: NewStack<FTYPE>(list[half:])}
+}
+
+// newDeque<FTYPE> creates deque from the stacks. Items are split into halves when one of the stacks is empty
+func newDeque<FTYPE>(front, back *Stack<FTYPE>) *Deque<FTYPE> {
+	d := &Deque<FTYPE>{front: front, back: back}
+	if (front == nil || back == nil) && d.Size() > 1 {
+		return NewDeque<FTYPE>(d.ToSlice())
+	}
+	if d.Size() == 0 {
+		return nil
+	}
+	return d
+}
+
+// PushFront returns a new deque with the item at the front
+func (d *Deque<FTYPE>) PushFront(v <TYPE>) *Deque<FTYPE> {
+	if d == nil {
+		return newDeque<FTYPE>((*Stack<FTYPE>)(nil).Push(v), nil)
+	}
+	return newDeque<FTYPE>(d.front.Push(v), d.back)
+}
+
+// PushBack returns a new deque with the item at the end
+func (d *Deque<FTYPE>) PushBack(v <TYPE>) *Deque<FTYPE> {
+	if d == nil {
+		return newDeque<FTYPE>(nil, (*Stack<FTYPE>)(nil).Push(v))
+	}
+	return newDeque<FTYPE>(d.front, d.back.Push(v))
+}
+
+// PopFront returns a new deque without the first item. Returns nil(empty deque) if the deque is empty
+func (d *Deque<FTYPE>) PopFront() *Deque<FTYPE> {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDeque<FTYPE>(d.front.Pop(), d.back)
+}
+
+// PopBack returns a new deque without the last item. Returns nil(empty deque) if the deque is empty
+func (d *Deque<FTYPE>) PopBack() *Deque<FTYPE> {
+	if d.Size() <= 1 {
+		return nil
+	}
+	return newDeque<FTYPE>(d.front, d.back.Pop())
+}
+
+// PeekFront returns the first item and true. Returns false if the deque is empty
+func (d *Deque<FTYPE>) PeekFront() (<TYPE>, bool) {
+	if d == nil {
+		var zero <TYPE>
+		return zero, false
+	}
+	if d.front == nil {
+		return d.back.Peek()
+	}
+	return d.front.Peek()
+}
+
+// PeekBack returns the last item and true. Returns false if the deque is empty
+func (d *Deque<FTYPE>) PeekBack() (<TYPE>, bool) {
+	if d == nil {
+		var zero <TYPE>
+		return zero, false
+	}
+	if d.back == nil {
+		return d.front.Peek()
+	}
+	return d.back.Peek()
+}
+
+// Size returns number of items
+func (d *Deque<FTYPE>) Size() int {
+	if d == nil {
+		return 0
+	}
+	return d.front.Size() + d.back.Size()
+}
+
+// IsEmpty returns true if the deque has no item
+func (d *Deque<FTYPE>) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// ToSlice returns items from the front to the end
+func (d *Deque<FTYPE>) ToSlice() []<TYPE> {
+	if d == nil {
+		return []<TYPE>{}
+	}
+	list := make([]<TYPE>, 0, d.Size())
+	for s := d.front; s != nil; s = s.rest {
+		list = append(list, s.top)
+	}
+	return append(list, d.back.ToSlice()...)
+}
+
+// PriorityQueue<FTYPE> - binary heap of <TYPE>. Item with the smallest key is popped first.
+// Items with the same key are popped in the order they were pushed. Unlike Queue<FTYPE>, it is mutable.
+//
+// Keys are float64, which holds integers exactly only up to 2^53. Larger int64 or uint64 keys
+// which differ only in the low bits become equal, and such items are popped in the order they were pushed
+type PriorityQueue<FTYPE> struct {
+	key   func(<TYPE>) float64
+	items []priorityItem<FTYPE>
+	seq   int
+}
+
+type priorityItem<FTYPE> struct {
+	value <TYPE>
+	key   float64
+	seq   int
+}
+
+// NewPriorityQueue<FTYPE> creates priority queue with the items of the list.
+// key returns priority of the item, use negative key to pop the largest first.
+// If key is nil, all the items have the same priority
+func NewPriorityQueue<FTYPE>(key func(<TYPE>) float64, list []<TYPE>) *PriorityQueue<FTYPE> {
+	pq := &PriorityQueue<FTYPE>{key: key, items: make([]priorityItem<FTYPE>, 0, len(list))}
+	for _, v := range list {
+		pq.Push(v)
+	}
+	return pq
+}
+
+// Push an item
+func (pq *PriorityQueue<FTYPE>) Push(v <TYPE>) *PriorityQueue<FTYPE> {
+	item := priorityItem<FTYPE>{value: v, seq: pq.seq}
+	if pq.key != nil {
+		item.key = pq.key(v)
+	}
+	pq.seq++
+	pq.items = append(pq.items, item)
+
+	// sift up
+	i := len(pq.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+	return pq
+}
+
+// Pop removes and returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueue<FTYPE>) Pop() (<TYPE>, bool) {
+	if len(pq.items) == 0 {
+		var zero <TYPE>
+		return zero, false
+	}
+	top := pq.items[0].value
+	last := len(pq.items) - 1
+	pq.items[0] = pq.items[last]
+	pq.items = pq.items[:last]
+
+	// sift down
+	i := 0
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(pq.items) && pq.less(child, smallest) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+	return top, true
+}
+
+// Peek returns the item with the smallest key and true. Returns false if the queue is empty
+func (pq *PriorityQueue<FTYPE>) Peek() (<TYPE>, bool) {
+	if len(pq.items) == 0 {
+		var zero <TYPE>
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Size returns number of items
+func (pq *PriorityQueue<FTYPE>) Size() int {
+	return len(pq.items)
+}
+
+// ToSlice returns items in the order they would be popped. The queue is not modified
+func (pq *PriorityQueue<FTYPE>) ToSlice() []<TYPE> {
+	clone := &PriorityQueue<FTYPE>{items: make([]priorityItem<FTYPE>, len(pq.items))}
+	copy(clone.items, pq.items)
+
+	list := make([]<TYPE>, 0, len(pq.items))
+	for clone.Size() > 0 {
+		v, _ := clone.Pop()
+		list = append(list, v)
+	}
+	return list
+}
+
+func (pq *PriorityQueue<FTYPE>) less(i, j int) bool {
+	if pq.items[i].key != pq.items[j].key {
+		return pq.items[i].key < pq.items[j].key
+	}
+	return pq.items[i].seq < pq.items[j].seq
+}
+`
+}
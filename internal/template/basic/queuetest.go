@@ -0,0 +1,279 @@
+package basic
+
+// QueueTest is template to generate itself for different combination of data type.
+func QueueTest() string {
+	return `
+func TestStack<FTYPE>(t *testing.T) {
+	s1 := NewStack<FTYPE>([]<TYPE>{1, 2})
+	s2 := s1.Push(3)
+
+	if v, ok := s2.Peek(); !ok || v != 3 || s2.Size() != 3 {
+		t.Errorf("TestStack<FTYPE> failed. Expected top=%v, actual=%v", 3, v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{1, 2, 3}, s2.ToSlice()) || !reflect.DeepEqual([]<TYPE>{1, 2}, s1.ToSlice()) {
+		t.Errorf("TestStack<FTYPE> failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != 2 {
+		t.Errorf("TestStack<FTYPE> failed. Expected top=%v after Pop, actual=%v", 2, v)
+	}
+
+	var empty *Stack<FTYPE>
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStack<FTYPE> failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueue<FTYPE>(t *testing.T) {
+	q1 := NewQueue<FTYPE>([]<TYPE>{1, 2})
+	q2 := q1.Push(3).Pop()
+	q3 := q2.Push(1)
+
+	if v, ok := q2.Peek(); !ok || v != 2 {
+		t.Errorf("TestQueue<FTYPE> failed. Expected front=%v, actual=%v", 2, v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{1, 2}, q1.ToSlice()) || !reflect.DeepEqual([]<TYPE>{2, 3, 1}, q3.ToSlice()) {
+		t.Errorf("TestQueue<FTYPE> failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []<TYPE>
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{2, 3, 1}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueue<FTYPE> failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *Queue<FTYPE>
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push(1).Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueue<FTYPE> failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDeque<FTYPE>(t *testing.T) {
+	d1 := NewDeque<FTYPE>([]<TYPE>{2})
+	d2 := d1.PushFront(1).PushBack(3)
+
+	if !reflect.DeepEqual([]<TYPE>{1, 2, 3}, d2.ToSlice()) || !reflect.DeepEqual([]<TYPE>{2}, d1.ToSlice()) {
+		t.Errorf("TestDeque<FTYPE> failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected front=%v, actual=%v", 1, v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != 3 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected back=%v, actual=%v", 3, v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != 1 || d3.Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected back=%v after PopBack, actual=%v", 1, v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != 3 || d4.Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected front=%v after PopFront, actual=%v", 3, v)
+	}
+
+	var empty *Deque<FTYPE>
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack(1).Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueue<FTYPE>(t *testing.T) {
+	pq := NewPriorityQueue<FTYPE>(func(v <TYPE>) float64 { return float64(v) }, []<TYPE>{2, 3})
+	pq.Push(1).Push(2)
+
+	expected := []<TYPE>{1, 2, 2, 3}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != 1 {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected peek=%v, actual=%v", 1, v)
+	}
+
+	var actual []<TYPE>
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueue<FTYPE>(nil, []<TYPE>{3, 1, 2})
+	if !reflect.DeepEqual([]<TYPE>{3, 1, 2}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+`
+}
+
+// QueueStrTest is template to generate itself for different combination of data type.
+func QueueStrTest() string {
+	return `
+func TestStack<FTYPE>(t *testing.T) {
+	s1 := NewStack<FTYPE>([]<TYPE>{"a", "b"})
+	s2 := s1.Push("c")
+
+	if v, ok := s2.Peek(); !ok || v != "c" || s2.Size() != 3 {
+		t.Errorf("TestStack<FTYPE> failed. Expected top=%v, actual=%v", "c", v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{"a", "b", "c"}, s2.ToSlice()) || !reflect.DeepEqual([]<TYPE>{"a", "b"}, s1.ToSlice()) {
+		t.Errorf("TestStack<FTYPE> failed. Unexpected items %v, %v", s1.ToSlice(), s2.ToSlice())
+	}
+	if v, _ := s2.Pop().Peek(); v != "b" {
+		t.Errorf("TestStack<FTYPE> failed. Expected top=%v after Pop, actual=%v", "b", v)
+	}
+
+	var empty *Stack<FTYPE>
+	if _, ok := empty.Peek(); ok || !empty.IsEmpty() || empty.Pop() != nil || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestStack<FTYPE> failed. Expected nil to be empty stack")
+	}
+}
+
+func TestQueue<FTYPE>(t *testing.T) {
+	q1 := NewQueue<FTYPE>([]<TYPE>{"a", "b"})
+	q2 := q1.Push("c").Pop()
+	q3 := q2.Push("a")
+
+	if v, ok := q2.Peek(); !ok || v != "b" {
+		t.Errorf("TestQueue<FTYPE> failed. Expected front=%v, actual=%v", "b", v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{"a", "b"}, q1.ToSlice()) || !reflect.DeepEqual([]<TYPE>{"b", "c", "a"}, q3.ToSlice()) {
+		t.Errorf("TestQueue<FTYPE> failed. Unexpected items %v, %v", q1.ToSlice(), q3.ToSlice())
+	}
+
+	var actual []<TYPE>
+	for q := q3; !q.IsEmpty(); q = q.Pop() {
+		v, _ := q.Peek()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual([]<TYPE>{"b", "c", "a"}, actual) || q3.Size() != 3 {
+		t.Errorf("TestQueue<FTYPE> failed. Expected items in FIFO order, actual=%v", actual)
+	}
+
+	var empty *Queue<FTYPE>
+	if _, ok := empty.Peek(); ok || empty.Pop() != nil || empty.Push("a").Size() != 1 || len(empty.ToSlice()) != 0 {
+		t.Errorf("TestQueue<FTYPE> failed. Expected nil to be empty queue")
+	}
+}
+
+func TestDeque<FTYPE>(t *testing.T) {
+	d1 := NewDeque<FTYPE>([]<TYPE>{"b"})
+	d2 := d1.PushFront("a").PushBack("c")
+
+	if !reflect.DeepEqual([]<TYPE>{"a", "b", "c"}, d2.ToSlice()) || !reflect.DeepEqual([]<TYPE>{"b"}, d1.ToSlice()) {
+		t.Errorf("TestDeque<FTYPE> failed. Unexpected items %v, %v", d1.ToSlice(), d2.ToSlice())
+	}
+	if v, ok := d2.PeekFront(); !ok || v != "a" {
+		t.Errorf("TestDeque<FTYPE> failed. Expected front=%v, actual=%v", "a", v)
+	}
+	if v, ok := d2.PeekBack(); !ok || v != "c" {
+		t.Errorf("TestDeque<FTYPE> failed. Expected back=%v, actual=%v", "c", v)
+	}
+
+	d3 := d2.PopBack().PopBack()
+	if v, _ := d3.PeekBack(); v != "a" || d3.Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected back=%v after PopBack, actual=%v", "a", v)
+	}
+	d4 := d2.PopFront().PopFront()
+	if v, _ := d4.PeekFront(); v != "c" || d4.Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected front=%v after PopFront, actual=%v", "c", v)
+	}
+
+	var empty *Deque<FTYPE>
+	if _, ok := empty.PeekFront(); ok || empty.PopFront() != nil || empty.PopBack() != nil || empty.PushBack("a").Size() != 1 {
+		t.Errorf("TestDeque<FTYPE> failed. Expected nil to be empty deque")
+	}
+}
+
+func TestPriorityQueue<FTYPE>(t *testing.T) {
+	pq := NewPriorityQueue<FTYPE>(func(v <TYPE>) float64 { return float64(v[0]) }, []<TYPE>{"b", "c"})
+	pq.Push("a").Push("b")
+
+	expected := []<TYPE>{"a", "b", "b", "c"}
+	if !reflect.DeepEqual(expected, pq.ToSlice()) || pq.Size() != 4 {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected=%v, actual=%v", expected, pq.ToSlice())
+	}
+	if v, ok := pq.Peek(); !ok || v != "a" {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected peek=%v, actual=%v", "a", v)
+	}
+
+	var actual []<TYPE>
+	for pq.Size() > 0 {
+		v, _ := pq.Pop()
+		actual = append(actual, v)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected=%v, actual=%v", expected, actual)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected Pop on empty queue to return false")
+	}
+
+	// Same priority: items are popped in the order they were pushed
+	fifo := NewPriorityQueue<FTYPE>(nil, []<TYPE>{"c", "a", "b"})
+	if !reflect.DeepEqual([]<TYPE>{"c", "a", "b"}, fifo.ToSlice()) {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected insertion order for nil key, actual=%v", fifo.ToSlice())
+	}
+}
+`
+}
+
+// QueueBoolTest is template to generate itself for different combination of data type.
+func QueueBoolTest() string {
+	return `
+func TestStack<FTYPE>(t *testing.T) {
+	s := NewStack<FTYPE>([]<TYPE>{false}).Push(true)
+	if v, ok := s.Peek(); !ok || !v || s.Size() != 2 || !reflect.DeepEqual([]<TYPE>{false, true}, s.ToSlice()) {
+		t.Errorf("TestStack<FTYPE> failed. Unexpected items %v", s.ToSlice())
+	}
+	if v, _ := s.Pop().Peek(); v {
+		t.Errorf("TestStack<FTYPE> failed. Expected false after Pop")
+	}
+}
+
+func TestQueue<FTYPE>(t *testing.T) {
+	q := NewQueue<FTYPE>([]<TYPE>{false}).Push(true)
+	if v, ok := q.Peek(); !ok || v || !reflect.DeepEqual([]<TYPE>{false, true}, q.ToSlice()) {
+		t.Errorf("TestQueue<FTYPE> failed. Unexpected items %v", q.ToSlice())
+	}
+	if v, _ := q.Pop().Peek(); !v {
+		t.Errorf("TestQueue<FTYPE> failed. Expected true after Pop")
+	}
+}
+
+func TestDeque<FTYPE>(t *testing.T) {
+	d := NewDeque<FTYPE>(nil).PushBack(true).PushFront(false)
+	if !reflect.DeepEqual([]<TYPE>{false, true}, d.ToSlice()) {
+		t.Errorf("TestDeque<FTYPE> failed. Unexpected items %v", d.ToSlice())
+	}
+	if v, _ := d.PopFront().PeekBack(); !v {
+		t.Errorf("TestDeque<FTYPE> failed. Expected true after PopFront")
+	}
+	if v, _ := d.PopBack().PeekFront(); v {
+		t.Errorf("TestDeque<FTYPE> failed. Expected false after PopBack")
+	}
+}
+
+func TestPriorityQueue<FTYPE>(t *testing.T) {
+	trueFirst := func(v <TYPE>) float64 {
+		if v {
+			return 0
+		}
+		return 1
+	}
+	pq := NewPriorityQueue<FTYPE>(trueFirst, []<TYPE>{false, true, false})
+	if !reflect.DeepEqual([]<TYPE>{true, false, false}, pq.ToSlice()) {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Unexpected items %v", pq.ToSlice())
+	}
+	if v, ok := pq.Pop(); !ok || !v || pq.Size() != 2 {
+		t.Errorf("TestPriorityQueue<FTYPE> failed. Expected true to be popped first")
+	}
+}
+`
+}
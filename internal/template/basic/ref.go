@@ -0,0 +1,49 @@
+package basic
+
+// Ref is template to generate itself for different combination of data type.
+// It generates typed wrapper of Ref which is changed with Dosync
+func Ref() string {
+	return `
+// Ref<FTYPE> - transactional reference to <TYPE>. Change it inside Dosync
+type Ref<FTYPE> struct {
+	ref *Ref
+}
+
+// NewRef<FTYPE> creates ref with the initial value
+func NewRef<FTYPE>(v <TYPE>) *Ref<FTYPE> {
+	return &Ref<FTYPE>{ref: NewRef(v)}
+}
+
+// Deref returns the latest committed value
+func (r *Ref<FTYPE>) Deref() <TYPE> {
+	return r.ref.Deref().(<TYPE>)
+}
+
+// Get returns the value in the transaction
+func (r *Ref<FTYPE>) Get(tx *Tx) <TYPE> {
+	return tx.Get(r.ref).(<TYPE>)
+}
+
+// Set sets the value in the transaction
+func (r *Ref<FTYPE>) Set(tx *Tx, v <TYPE>) {
+	tx.Set(r.ref, v)
+}
+
+// Alter sets the value to f(value in the transaction) and returns the new value
+func (r *Ref<FTYPE>) Alter(tx *Tx, f func(<TYPE>) <TYPE>) <TYPE> {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(<TYPE>)) }).(<TYPE>)
+}
+
+// Commute sets the value to f(value in the transaction) and returns the new value.
+// f is called again with the latest value when committed. See Tx.Commute
+func (r *Ref<FTYPE>) Commute(tx *Tx, f func(<TYPE>) <TYPE>) <TYPE> {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(<TYPE>)) }).(<TYPE>)
+}
+`
+}
@@ -0,0 +1,65 @@
+package basic
+
+// RefTest is template to generate itself for different combination of data type.
+func RefTest() string {
+	return `
+func TestRef<FTYPE>(t *testing.T) {
+	from, to := NewRef<FTYPE>(10), NewRef<FTYPE>(1)
+	err := Dosync(func(tx *Tx) error {
+		v := from.Alter(tx, func(v <TYPE>) <TYPE> { return v - 3 })
+		to.Set(tx, to.Get(tx)+3)
+		if v != 7 || from.Deref() != 10 {
+			t.Errorf("TestRef<FTYPE> failed. Expected change to be seen only in the transaction")
+		}
+		to.Commute(tx, nil)
+		return nil
+	})
+	if err != nil || from.Deref() != 7 || to.Deref() != 4 {
+		t.Errorf("TestRef<FTYPE> failed. Expected=%v %v, actual=%v %v", 7, 4, from.Deref(), to.Deref())
+	}
+
+	Dosync(func(tx *Tx) error {
+		if v := to.Commute(tx, func(v <TYPE>) <TYPE> { return v + 1 }); v != 5 {
+			t.Errorf("TestRef<FTYPE> failed. Expected=%v, actual=%v", 5, v)
+		}
+		return nil
+	})
+	if to.Deref() != 5 {
+		t.Errorf("TestRef<FTYPE> failed. Expected=%v, actual=%v", 5, to.Deref())
+	}
+}
+`
+}
+
+// RefStrTest is template to generate itself for different combination of data type.
+func RefStrTest() string {
+	return `
+func TestRef<FTYPE>(t *testing.T) {
+	r := NewRef<FTYPE>("a")
+	Dosync(func(tx *Tx) error {
+		r.Alter(tx, func(v <TYPE>) <TYPE> { return v + "b" })
+		r.Set(tx, r.Get(tx)+"c")
+		return nil
+	})
+	if r.Deref() != "abc" {
+		t.Errorf("TestRef<FTYPE> failed. Expected=%v, actual=%v", "abc", r.Deref())
+	}
+}
+`
+}
+
+// RefBoolTest is template to generate itself for different combination of data type.
+func RefBoolTest() string {
+	return `
+func TestRef<FTYPE>(t *testing.T) {
+	r := NewRef<FTYPE>(false)
+	Dosync(func(tx *Tx) error {
+		r.Commute(tx, func(v <TYPE>) <TYPE> { return !v })
+		return nil
+	})
+	if r.Deref() != true {
+		t.Errorf("TestRef<FTYPE> failed. Expected=%v, actual=%v", true, r.Deref())
+	}
+}
+`
+}
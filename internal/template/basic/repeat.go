@@ -0,0 +1,80 @@
+package basic
+
+// Repeat is template to generate itself for different combination of data type.
+func Repeat() string {
+	return `
+// Repeat<FTYPE> returns a list of length n where every item is v.
+// Returns empty list if n is 0 or negative number
+func Repeat<FTYPE>(n int, v <TYPE>) []<TYPE> {
+	if n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+`
+}
+
+// Cycle is template to generate itself for different combination of data type.
+func Cycle() string {
+	return `
+// Cycle<FTYPE> repeats the items of the list over and over and returns the first n items.
+// Returns empty list if n is 0 or negative number or the list is empty
+func Cycle<FTYPE>(n int, list []<TYPE>) []<TYPE> {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+`
+}
+
+// Iterate is template to generate itself for different combination of data type.
+func Iterate() string {
+	return `
+// Iterate<FTYPE> returns a list of n items: seed, f(seed), f(f(seed)), ...
+// Returns empty list if n is 0 or negative number or the function is nil
+func Iterate<FTYPE>(f func(<TYPE>) <TYPE>, seed <TYPE>, n int) []<TYPE> {
+	if f == nil || n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+`
+}
+
+// Repeatedly is template to generate itself for different combination of data type.
+func Repeatedly() string {
+	return `
+// Repeatedly<FTYPE> calls the function(2nd argument) n times and returns the results in a list.
+// Useful when the function has side effects, for example random or sequence generators.
+// Returns empty list if n is 0 or negative number or the function is nil
+func Repeatedly<FTYPE>(n int, f func() <TYPE>) []<TYPE> {
+	if f == nil || n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+`
+}
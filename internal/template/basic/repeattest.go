@@ -0,0 +1,279 @@
+package basic
+
+// RepeatTest is template to generate itself for different combination of data type.
+func RepeatTest() string {
+	return `
+func TestRepeat<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{5, 5, 5}
+	actualList := Repeat<FTYPE>(3, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeat<FTYPE>(0, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Repeat<FTYPE>(-1, 5)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// RepeatStrTest is template to generate itself for different combination of data type.
+func RepeatStrTest() string {
+	return `
+func TestRepeat<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{"a", "a", "a"}
+	actualList := Repeat<FTYPE>(3, "a")
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeat<FTYPE>(0, "a")
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// RepeatBoolTest is template to generate itself for different combination of data type.
+func RepeatBoolTest() string {
+	return `
+func TestRepeat<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{true, true, true}
+	actualList := Repeat<FTYPE>(3, true)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeat<FTYPE>(0, true)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeat<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// CycleTest is template to generate itself for different combination of data type.
+func CycleTest() string {
+	return `
+func TestCycle<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{1, 2, 3, 1, 2, 3, 1}
+	actualList := Cycle<FTYPE>(7, []<TYPE>{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{1, 2}
+	actualList = Cycle<FTYPE>(2, []<TYPE>{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Cycle<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Cycle<FTYPE>(0, []<TYPE>{1, 2, 3})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// CycleStrTest is template to generate itself for different combination of data type.
+func CycleStrTest() string {
+	return `
+func TestCycle<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{"a", "b", "a", "b", "a"}
+	actualList := Cycle<FTYPE>(5, []<TYPE>{"a", "b"})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Cycle<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// CycleBoolTest is template to generate itself for different combination of data type.
+func CycleBoolTest() string {
+	return `
+func TestCycle<FTYPE>(t *testing.T) {
+	expectedList := []<TYPE>{true, false, true, false, true}
+	actualList := Cycle<FTYPE>(5, []<TYPE>{true, false})
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Cycle<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestCycle<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// IterateTest is template to generate itself for different combination of data type.
+func IterateTest() string {
+	return `
+func TestIterate<FTYPE>(t *testing.T) {
+	double := func(v <TYPE>) <TYPE> { return v * 2 }
+
+	expectedList := []<TYPE>{1, 2, 4, 8}
+	actualList := Iterate<FTYPE>(double, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Iterate<FTYPE>(double, 1, 0)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Iterate<FTYPE>(nil, 1, 4)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// IterateStrTest is template to generate itself for different combination of data type.
+func IterateStrTest() string {
+	return `
+func TestIterate<FTYPE>(t *testing.T) {
+	appendA := func(v <TYPE>) <TYPE> { return v + "a" }
+
+	expectedList := []<TYPE>{"", "a", "aa"}
+	actualList := Iterate<FTYPE>(appendA, "", 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Iterate<FTYPE>(nil, "", 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// IterateBoolTest is template to generate itself for different combination of data type.
+func IterateBoolTest() string {
+	return `
+func TestIterate<FTYPE>(t *testing.T) {
+	not := func(v <TYPE>) <TYPE> { return !v }
+
+	expectedList := []<TYPE>{true, false, true}
+	actualList := Iterate<FTYPE>(not, true, 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Iterate<FTYPE>(nil, true, 3)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestIterate<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// RepeatedlyTest is template to generate itself for different combination of data type.
+func RepeatedlyTest() string {
+	return `
+func TestRepeatedly<FTYPE>(t *testing.T) {
+	var counter <TYPE>
+	next := func() <TYPE> {
+		counter++
+		return counter
+	}
+
+	expectedList := []<TYPE>{1, 2, 3}
+	actualList := Repeatedly<FTYPE>(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeatedly<FTYPE>(0, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	actualList = Repeatedly<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// RepeatedlyStrTest is template to generate itself for different combination of data type.
+func RepeatedlyStrTest() string {
+	return `
+func TestRepeatedly<FTYPE>(t *testing.T) {
+	var counter <TYPE>
+	next := func() <TYPE> {
+		counter += "a"
+		return counter
+	}
+
+	expectedList := []<TYPE>{"a", "aa", "aaa"}
+	actualList := Repeatedly<FTYPE>(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeatedly<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
+
+// RepeatedlyBoolTest is template to generate itself for different combination of data type.
+func RepeatedlyBoolTest() string {
+	return `
+func TestRepeatedly<FTYPE>(t *testing.T) {
+	var flag <TYPE>
+	next := func() <TYPE> {
+		flag = !flag
+		return flag
+	}
+
+	expectedList := []<TYPE>{true, false, true}
+	actualList := Repeatedly<FTYPE>(3, next)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+
+	expectedList = []<TYPE>{}
+	actualList = Repeatedly<FTYPE>(3, nil)
+	if !reflect.DeepEqual(expectedList, actualList) {
+		t.Errorf("TestRepeatedly<FTYPE> failed. actual_list=%v, expected_list=%v", actualList, expectedList)
+	}
+}
+`
+}
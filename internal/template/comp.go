@@ -0,0 +1,97 @@
+package template
+
+// Comp is template to generate function(Comp) for user defined data type
+func Comp() string {
+	return `
+func Comp<CONDITIONAL_TYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) <TYPE> {
+	return func(v <TYPE>) <TYPE> {
+		for i := len(fs) - 1; i >= 0; i-- {
+			if fs[i] != nil {
+				v = fs[i](v)
+			}
+		}
+		return v
+	}
+}
+
+func Pipe<CONDITIONAL_TYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) <TYPE> {
+	return func(v <TYPE>) <TYPE> {
+		for _, f := range fs {
+			if f != nil {
+				v = f(v)
+			}
+		}
+		return v
+	}
+}
+`
+}
+
+// Partial is template to generate function(Partial) for user defined data type
+func Partial() string {
+	return `
+func Partial<CONDITIONAL_TYPE>2(f func(<TYPE>, <TYPE>) <TYPE>, v1 <TYPE>) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+	return func(v2 <TYPE>) <TYPE> {
+		return f(v1, v2)
+	}
+}
+
+func Partial<CONDITIONAL_TYPE>3(f func(<TYPE>, <TYPE>, <TYPE>) <TYPE>, v1, v2 <TYPE>) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+	return func(v3 <TYPE>) <TYPE> {
+		return f(v1, v2, v3)
+	}
+}
+`
+}
+
+// Complement is template to generate function(Complement) for user defined data type
+func Complement() string {
+	return `
+func Complement<CONDITIONAL_TYPE>(pred func(<TYPE>) bool) func(<TYPE>) bool {
+	if pred == nil {
+		return nil
+	}
+	return func(v <TYPE>) bool {
+		return !pred(v)
+	}
+}
+`
+}
+
+// Constantly is template to generate function(Constantly) for user defined data type
+func Constantly() string {
+	return `
+func Constantly<CONDITIONAL_TYPE>(v <TYPE>) func(<TYPE>) <TYPE> {
+	return func(<TYPE>) <TYPE> {
+		return v
+	}
+}
+`
+}
+
+// Juxt is template to generate function(Juxt) for user defined data type
+func Juxt() string {
+	return `
+func Juxt<CONDITIONAL_TYPE>(fs ...func(<TYPE>) <TYPE>) func(<TYPE>) []<TYPE> {
+	funcs := make([]func(<TYPE>) <TYPE>, 0, len(fs))
+	for _, f := range fs {
+		if f != nil {
+			funcs = append(funcs, f)
+		}
+	}
+	return func(v <TYPE>) []<TYPE> {
+		newList := make([]<TYPE>, len(funcs))
+		for i, f := range funcs {
+			newList[i] = f(v)
+		}
+		return newList
+	}
+}
+`
+}
@@ -0,0 +1,17 @@
+package template
+
+// Dedupe is template to generate function(Dedupe) for user defined data type
+func Dedupe() string {
+	return `
+func Dedupe<CONDITIONAL_TYPE>(list []<TYPE>) []<TYPE> {
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range list {
+		if i > 0 && v == list[i-1] {
+			continue
+		}
+		newList = append(newList, v)
+	}
+	return newList
+}
+`
+}
@@ -0,0 +1,19 @@
+package template
+
+// Distinct is template to generate function(Distinct) for user defined data type
+func Distinct() string {
+	return `
+func Distinct<CONDITIONAL_TYPE>(list []<TYPE>) []<TYPE> {
+	var newList []<TYPE>
+	s := make(map[<TYPE>]struct{}, len(list))
+	for _, v := range list {
+		if _, ok := s[v]; ok {
+			continue
+		}
+		s[v] = struct{}{}
+		newList = append(newList, v)
+	}
+	return newList
+}
+`
+}
@@ -0,0 +1,89 @@
+package template
+
+// Fluent is template to generate slice type of user defined data type whose methods can be chained
+func Fluent() string {
+	return `
+type <TYPES> []<TYPE>
+
+func (l <TYPES>) ToSlice() []<TYPE> {
+	return []<TYPE>(l)
+}
+
+func (l <TYPES>) Len() int {
+	return len(l)
+}
+
+func (l <TYPES>) Filter(f func(<TYPE>) bool) <TYPES> {
+	return Filter<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) Remove(f func(<TYPE>) bool) <TYPES> {
+	return Remove<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) Map(f func(<TYPE>) <TYPE>) <TYPES> {
+	return Map<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) PMap(f func(<TYPE>) <TYPE>) <TYPES> {
+	return PMap<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) FilterMap(fFilter func(<TYPE>) bool, fMap func(<TYPE>) <TYPE>) <TYPES> {
+	return FilterMap<CONDITIONAL_TYPE>(fFilter, fMap, l)
+}
+
+func (l <TYPES>) DropWhile(f func(<TYPE>) bool) <TYPES> {
+	return DropWhile<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) TakeWhile(f func(<TYPE>) bool) <TYPES> {
+	return TakeWhile<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) Distinct() <TYPES> {
+	return Distinct<CONDITIONAL_TYPE>(l)
+}
+
+func (l <TYPES>) Dedupe() <TYPES> {
+	return Dedupe<CONDITIONAL_TYPE>(l)
+}
+
+func (l <TYPES>) Rest() <TYPES> {
+	return Rest<CONDITIONAL_TYPE>(l)
+}
+
+func (l <TYPES>) Reduce(f func(<TYPE>, <TYPE>) <TYPE>, initializer ...<TYPE>) <TYPE> {
+	return Reduce<CONDITIONAL_TYPE>(f, l, initializer...)
+}
+
+func (l <TYPES>) Some(f func(<TYPE>) bool) bool {
+	return Some<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) Every(f func(<TYPE>) bool) bool {
+	return Every<CONDITIONAL_TYPE>(f, l)
+}
+
+func (l <TYPES>) Find(pred func(<TYPE>) bool) Option<FTYPE> {
+	return FindOpt<FTYPE>(pred, l)
+}
+`
+}
+
+// FluentIO is template to generate methods of the slice type of user defined data type which return list of another type
+func FluentIO() string {
+	return `
+func (l <FINPUT_TYPE>s) MapTo<FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>) <OUTPUT_TYPES> {
+	return Map<FINPUT_TYPE><FOUTPUT_TYPE>(f, l)
+}
+
+func (l <FINPUT_TYPE>s) PMapTo<FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>) <OUTPUT_TYPES> {
+	return PMap<FINPUT_TYPE><FOUTPUT_TYPE>(f, l)
+}
+
+func (l <FINPUT_TYPE>s) FilterMapTo<FOUTPUT_TYPE>(fFilter func(<INPUT_TYPE>) bool, fMap func(<INPUT_TYPE>) <OUTPUT_TYPE>) <OUTPUT_TYPES> {
+	return FilterMap<FINPUT_TYPE><FOUTPUT_TYPE>(fFilter, fMap, l)
+}
+`
+}
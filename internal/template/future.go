@@ -0,0 +1,133 @@
+package template
+
+// Future is template to generate typed Future and Promise for user defined data type
+func Future() string {
+	return `
+type Future<CONDITIONAL_TYPE> struct {
+	future *fp.Future
+}
+
+type Promise<CONDITIONAL_TYPE> struct {
+	promise *fp.Promise
+}
+
+func NewFuture<CONDITIONAL_TYPE>(f func() (<TYPE>, error)) *Future<CONDITIONAL_TYPE> {
+	if f == nil {
+		return &Future<CONDITIONAL_TYPE>{future: fp.NewFuture(nil)}
+	}
+	return &Future<CONDITIONAL_TYPE>{future: fp.NewFuture(func() (interface{}, error) { return f() })}
+}
+
+func NewFutureCtx<CONDITIONAL_TYPE>(ctx context.Context, f func(context.Context) (<TYPE>, error)) *Future<CONDITIONAL_TYPE> {
+	if f == nil {
+		return &Future<CONDITIONAL_TYPE>{future: fp.NewFutureCtx(ctx, nil)}
+	}
+	return &Future<CONDITIONAL_TYPE>{future: fp.NewFutureCtx(ctx, func(ctx context.Context) (interface{}, error) { return f(ctx) })}
+}
+
+func NewPromise<CONDITIONAL_TYPE>() *Promise<CONDITIONAL_TYPE> {
+	return &Promise<CONDITIONAL_TYPE>{promise: fp.NewPromise()}
+}
+
+func (p *Promise<CONDITIONAL_TYPE>) Deliver(v <TYPE>) bool {
+	return p.promise.Deliver(v)
+}
+
+func (p *Promise<CONDITIONAL_TYPE>) Fail(err error) bool {
+	return p.promise.Fail(err)
+}
+
+func (p *Promise<CONDITIONAL_TYPE>) Future() *Future<CONDITIONAL_TYPE> {
+	return &Future<CONDITIONAL_TYPE>{future: p.promise.Future()}
+}
+
+func (f *Future<CONDITIONAL_TYPE>) Await(ctx context.Context) (<TYPE>, error) {
+	v, err := f.future.Await(ctx)
+	if err != nil {
+		var zero <TYPE>
+		return zero, err
+	}
+	return futureValue<CONDITIONAL_TYPE>(v), nil
+}
+
+func (f *Future<CONDITIONAL_TYPE>) Done() <-chan struct{} {
+	return f.future.Done()
+}
+
+func (f *Future<CONDITIONAL_TYPE>) IsDone() bool {
+	return f.future.IsDone()
+}
+
+func (f *Future<CONDITIONAL_TYPE>) Untyped() *fp.Future {
+	return f.future
+}
+
+func (f *Future<CONDITIONAL_TYPE>) Then(fn func(<TYPE>) (<TYPE>, error)) *Future<CONDITIONAL_TYPE> {
+	if fn == nil {
+		return f
+	}
+	return &Future<CONDITIONAL_TYPE>{future: f.future.Then(func(v interface{}) (interface{}, error) { return fn(futureValue<CONDITIONAL_TYPE>(v)) })}
+}
+
+func (f *Future<CONDITIONAL_TYPE>) ThenCtx(ctx context.Context, fn func(<TYPE>) (<TYPE>, error)) *Future<CONDITIONAL_TYPE> {
+	if fn == nil {
+		return &Future<CONDITIONAL_TYPE>{future: f.future.ThenCtx(ctx, nil)}
+	}
+	return &Future<CONDITIONAL_TYPE>{future: f.future.ThenCtx(ctx, func(v interface{}) (interface{}, error) { return fn(futureValue<CONDITIONAL_TYPE>(v)) })}
+}
+
+func AwaitAll<CONDITIONAL_TYPE>(ctx context.Context, futures ...*Future<CONDITIONAL_TYPE>) ([]<TYPE>, error) {
+	values, err := AllCtx<CONDITIONAL_TYPE>(ctx, futures...).Await(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return values.([]<TYPE>), nil
+}
+
+func All<CONDITIONAL_TYPE>(futures ...*Future<CONDITIONAL_TYPE>) *fp.Future {
+	return AllCtx<CONDITIONAL_TYPE>(context.Background(), futures...)
+}
+
+func AllCtx<CONDITIONAL_TYPE>(ctx context.Context, futures ...*Future<CONDITIONAL_TYPE>) *fp.Future {
+	return fp.AllCtx(ctx, untypedFutures<CONDITIONAL_TYPE>(futures)...).Then(func(values interface{}) (interface{}, error) {
+		list := make([]<TYPE>, len(futures))
+		for i, v := range values.([]interface{}) {
+			list[i] = futureValue<CONDITIONAL_TYPE>(v)
+		}
+		return list, nil
+	})
+}
+
+func Any<CONDITIONAL_TYPE>(futures ...*Future<CONDITIONAL_TYPE>) *Future<CONDITIONAL_TYPE> {
+	return &Future<CONDITIONAL_TYPE>{future: fp.Any(untypedFutures<CONDITIONAL_TYPE>(futures)...)}
+}
+
+func Race<CONDITIONAL_TYPE>(futures ...*Future<CONDITIONAL_TYPE>) *Future<CONDITIONAL_TYPE> {
+	return &Future<CONDITIONAL_TYPE>{future: fp.Race(untypedFutures<CONDITIONAL_TYPE>(futures)...)}
+}
+
+func AnyCtx<CONDITIONAL_TYPE>(ctx context.Context, futures ...*Future<CONDITIONAL_TYPE>) *Future<CONDITIONAL_TYPE> {
+	return &Future<CONDITIONAL_TYPE>{future: fp.AnyCtx(ctx, untypedFutures<CONDITIONAL_TYPE>(futures)...)}
+}
+
+func RaceCtx<CONDITIONAL_TYPE>(ctx context.Context, futures ...*Future<CONDITIONAL_TYPE>) *Future<CONDITIONAL_TYPE> {
+	return &Future<CONDITIONAL_TYPE>{future: fp.RaceCtx(ctx, untypedFutures<CONDITIONAL_TYPE>(futures)...)}
+}
+
+func futureValue<CONDITIONAL_TYPE>(v interface{}) <TYPE> {
+	if v == nil {
+		var zero <TYPE>
+		return zero
+	}
+	return v.(<TYPE>)
+}
+
+func untypedFutures<CONDITIONAL_TYPE>(futures []*Future<CONDITIONAL_TYPE>) []*fp.Future {
+	list := make([]*fp.Future, len(futures))
+	for i, f := range futures {
+		list[i] = f.future
+	}
+	return list
+}
+`
+}
@@ -0,0 +1,33 @@
+package template
+
+// Memoize is template to generate function(Memoize) for user defined data type
+func Memoize() string {
+	return `
+func Memoize<CONDITIONAL_TYPE>(f func(<TYPE>) <TYPE>, opts ...fp.MemoizeOptions) func(<TYPE>) <TYPE> {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v <TYPE>) <TYPE> {
+		return memo.Get(v, func() interface{} { return f(v) }).(<TYPE>)
+	}
+}
+`
+}
+
+// MemoizeIO is template to generate function(Memoize) for different combination of input and output type
+func MemoizeIO() string {
+	return `
+func Memoize<FINPUT_TYPE><FOUTPUT_TYPE>(f func(<INPUT_TYPE>) <OUTPUT_TYPE>, opts ...fp.MemoizeOptions) func(<INPUT_TYPE>) <OUTPUT_TYPE> {
+	if f == nil {
+		return nil
+	}
+
+	memo := fp.NewMemo(opts...)
+	return func(v <INPUT_TYPE>) <OUTPUT_TYPE> {
+		return memo.Get(v, func() interface{} { return f(v) }).(<OUTPUT_TYPE>)
+	}
+}
+`
+}
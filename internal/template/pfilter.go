@@ -0,0 +1,69 @@
+package template
+
+// PFilter is template to generate parallel versions of Filter, Remove, FilterMap, Some and Every for user defined data type
+func PFilter() string {
+	return `
+func PFilter<CONDITIONAL_TYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...fp.ParallelOptions) []<TYPE> {
+	if f == nil {
+		return []<TYPE>{}
+	}
+	keep := make([]bool, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		keep[i] = f(list[i])
+		return true
+	}, opts...)
+
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range list {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PRemove<CONDITIONAL_TYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...fp.ParallelOptions) []<TYPE> {
+	if f == nil {
+		return []<TYPE>{}
+	}
+	return PFilter<CONDITIONAL_TYPE>(func(v <TYPE>) bool { return !f(v) }, list, opts...)
+}
+
+func PFilterMap<CONDITIONAL_TYPE>(fFilter func(<TYPE>) bool, fMap func(<TYPE>) <TYPE>, list []<TYPE>, opts ...fp.ParallelOptions) []<TYPE> {
+	if fFilter == nil || fMap == nil {
+		return []<TYPE>{}
+	}
+	keep := make([]bool, len(list))
+	values := make([]<TYPE>, len(list))
+	fp.ParallelFor(len(list), func(i int) bool {
+		if fFilter(list[i]) {
+			keep[i] = true
+			values[i] = fMap(list[i])
+		}
+		return true
+	}, opts...)
+
+	newList := make([]<TYPE>, 0, len(list))
+	for i, v := range values {
+		if keep[i] {
+			newList = append(newList, v)
+		}
+	}
+	return newList
+}
+
+func PSome<CONDITIONAL_TYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...fp.ParallelOptions) bool {
+	if f == nil {
+		return false
+	}
+	return !fp.ParallelFor(len(list), func(i int) bool { return !f(list[i]) }, opts...)
+}
+
+func PEvery<CONDITIONAL_TYPE>(f func(<TYPE>) bool, list []<TYPE>, opts ...fp.ParallelOptions) bool {
+	if f == nil || len(list) == 0 {
+		return false
+	}
+	return fp.ParallelFor(len(list), func(i int) bool { return f(list[i]) }, opts...)
+}
+`
+}
@@ -0,0 +1,34 @@
+package template
+
+// PMapStream is template to generate function(PMapStream) for user defined data type
+func PMapStream() string {
+	return `
+func PMapStream<CONDITIONAL_TYPE>(ctx context.Context, f func(<TYPE>) <TYPE>, list []<TYPE>, opts ...fp.ParallelOptions) <-chan <TYPE> {
+	out := make(chan <TYPE>)
+	if f == nil {
+		close(out)
+		return out
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	results := make([]<TYPE>, len(list))
+	indexes := fp.ParallelStream(ctx, len(list), func(i int) { results[i] = f(list[i]) }, opts...)
+	go func() {
+		defer close(out)
+		var zero <TYPE>
+		for i := range indexes {
+			v := results[i]
+			results[i] = zero
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+`
+}
@@ -0,0 +1,61 @@
+package template
+
+// Predicate is template to generate function(And, Or, Not, None) for user defined data type
+func Predicate() string {
+	return `
+func And<CONDITIONAL_TYPE>(preds ...func(<TYPE>) bool) func(<TYPE>) bool {
+	return func(v <TYPE>) bool {
+		for _, pred := range preds {
+			if pred != nil && !pred(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func Or<CONDITIONAL_TYPE>(preds ...func(<TYPE>) bool) func(<TYPE>) bool {
+	return func(v <TYPE>) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func Not<CONDITIONAL_TYPE>(pred func(<TYPE>) bool) func(<TYPE>) bool {
+	return Complement<CONDITIONAL_TYPE>(pred)
+}
+
+func None<CONDITIONAL_TYPE>(pred func(<TYPE>) bool, list []<TYPE>) bool {
+	if pred == nil {
+		return false
+	}
+	for _, v := range list {
+		if pred(v) {
+			return false
+		}
+	}
+	return true
+}
+`
+}
+
+// Eq is template to generate function(Eq, Ne) for user defined data type
+func Eq() string {
+	return `
+func Eq<CONDITIONAL_TYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item == v
+	}
+}
+
+func Ne<CONDITIONAL_TYPE>(v <TYPE>) func(<TYPE>) bool {
+	return func(item <TYPE>) bool {
+		return item != v
+	}
+}
+`
+}
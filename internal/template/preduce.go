@@ -0,0 +1,36 @@
+package template
+
+// PReduce is template to generate function(PReduce) for user defined data type
+func PReduce() string {
+	return `
+func PReduce<CONDITIONAL_TYPE>(fReduce, fCombine func(<TYPE>, <TYPE>) <TYPE>, identity <TYPE>, list []<TYPE>, opts ...fp.ParallelOptions) <TYPE> {
+	if fReduce == nil || len(list) == 0 {
+		return identity
+	}
+	if fCombine == nil {
+		fCombine = fReduce
+	}
+
+	size := fp.ParallelChunkSize(len(list), opts...)
+	results := make([]<TYPE>, (len(list)+size-1)/size)
+	fp.ParallelFor(len(results), func(i int) bool {
+		chunk := list[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		acc := identity
+		for _, v := range chunk {
+			acc = fReduce(acc, v)
+		}
+		results[i] = acc
+		return true
+	}, opts...)
+
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = fCombine(acc, v)
+	}
+	return acc
+}
+`
+}
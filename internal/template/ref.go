@@ -0,0 +1,40 @@
+package template
+
+// Ref is template to generate typed Ref(transactional reference) for user defined data type
+func Ref() string {
+	return `
+type Ref<CONDITIONAL_TYPE> struct {
+	ref *fp.Ref
+}
+
+func NewRef<CONDITIONAL_TYPE>(v <TYPE>) *Ref<CONDITIONAL_TYPE> {
+	return &Ref<CONDITIONAL_TYPE>{ref: fp.NewRef(v)}
+}
+
+func (r *Ref<CONDITIONAL_TYPE>) Deref() <TYPE> {
+	return r.ref.Deref().(<TYPE>)
+}
+
+func (r *Ref<CONDITIONAL_TYPE>) Get(tx *fp.Tx) <TYPE> {
+	return tx.Get(r.ref).(<TYPE>)
+}
+
+func (r *Ref<CONDITIONAL_TYPE>) Set(tx *fp.Tx, v <TYPE>) {
+	tx.Set(r.ref, v)
+}
+
+func (r *Ref<CONDITIONAL_TYPE>) Alter(tx *fp.Tx, f func(<TYPE>) <TYPE>) <TYPE> {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Alter(r.ref, func(v interface{}) interface{} { return f(v.(<TYPE>)) }).(<TYPE>)
+}
+
+func (r *Ref<CONDITIONAL_TYPE>) Commute(tx *fp.Tx, f func(<TYPE>) <TYPE>) <TYPE> {
+	if f == nil {
+		return r.Get(tx)
+	}
+	return tx.Commute(r.ref, func(v interface{}) interface{} { return f(v.(<TYPE>)) }).(<TYPE>)
+}
+`
+}
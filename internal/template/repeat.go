@@ -0,0 +1,71 @@
+package template
+
+// Repeat is template to generate function(Repeat) for user defined data type
+func Repeat() string {
+	return `
+func Repeat<CONDITIONAL_TYPE>(n int, v <TYPE>) []<TYPE> {
+	if n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = v
+	}
+	return newList
+}
+`
+}
+
+// Cycle is template to generate function(Cycle) for user defined data type
+func Cycle() string {
+	return `
+func Cycle<CONDITIONAL_TYPE>(n int, list []<TYPE>) []<TYPE> {
+	listLen := len(list)
+	if n <= 0 || listLen == 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = list[i%listLen]
+	}
+	return newList
+}
+`
+}
+
+// Iterate is template to generate function(Iterate) for user defined data type
+func Iterate() string {
+	return `
+func Iterate<CONDITIONAL_TYPE>(f func(<TYPE>) <TYPE>, seed <TYPE>, n int) []<TYPE> {
+	if f == nil || n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	newList[0] = seed
+	for i := 1; i < n; i++ {
+		newList[i] = f(newList[i-1])
+	}
+	return newList
+}
+`
+}
+
+// Repeatedly is template to generate function(Repeatedly) for user defined data type
+func Repeatedly() string {
+	return `
+func Repeatedly<CONDITIONAL_TYPE>(n int, f func() <TYPE>) []<TYPE> {
+	if f == nil || n <= 0 {
+		return []<TYPE>{}
+	}
+
+	newList := make([]<TYPE>, n)
+	for i := range newList {
+		newList[i] = f()
+	}
+	return newList
+}
+`
+}
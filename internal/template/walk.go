@@ -0,0 +1,64 @@
+package template
+
+// Walk is template to generate function(TreeSeq, Prewalk, Postwalk) for user defined data type.
+// children returns children of the node, withChildren returns the node with new children
+func Walk() string {
+	return `
+func TreeSeq<CONDITIONAL_TYPE>(children func(<TYPE>) []<TYPE>, root <TYPE>) []<TYPE> {
+	var result []<TYPE>
+	stack := []<TYPE>{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node)
+
+		if children != nil {
+			nodes := children(node)
+			for i := len(nodes) - 1; i >= 0; i-- {
+				stack = append(stack, nodes[i])
+			}
+		}
+	}
+	return result
+}
+
+func Prewalk<CONDITIONAL_TYPE>(f func(<TYPE>) <TYPE>, children func(<TYPE>) []<TYPE>, withChildren func(<TYPE>, []<TYPE>) <TYPE>, root <TYPE>) <TYPE> {
+	if f == nil {
+		return root
+	}
+	node := f(root)
+	if children == nil || withChildren == nil {
+		return node
+	}
+
+	nodes := children(node)
+	if len(nodes) == 0 {
+		return node
+	}
+	newNodes := make([]<TYPE>, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = Prewalk<CONDITIONAL_TYPE>(f, children, withChildren, v)
+	}
+	return withChildren(node, newNodes)
+}
+
+func Postwalk<CONDITIONAL_TYPE>(f func(<TYPE>) <TYPE>, children func(<TYPE>) []<TYPE>, withChildren func(<TYPE>, []<TYPE>) <TYPE>, root <TYPE>) <TYPE> {
+	if f == nil {
+		return root
+	}
+	if children == nil || withChildren == nil {
+		return f(root)
+	}
+
+	nodes := children(root)
+	if len(nodes) == 0 {
+		return f(root)
+	}
+	newNodes := make([]<TYPE>, len(nodes))
+	for i, v := range nodes {
+		newNodes[i] = Postwalk<CONDITIONAL_TYPE>(f, children, withChildren, v)
+	}
+	return f(withChildren(root, newNodes))
+}
+`
+}
@@ -0,0 +1,46 @@
+package template
+
+// ZipIndex is template to generate function(ZipIndex) for user defined data type.
+// It is generated along with type PairInt<FTYPE2> when "int" is one of the types
+func ZipIndex() string {
+	return `
+func ZipIndex<FINPUT_TYPE2>(list []<INPUT_TYPE2>) []PairInt<FTYPE2> {
+	newList := make([]PairInt<FTYPE2>, len(list))
+	for i, v := range list {
+		newList[i] = PairInt<FTYPE2>{First: i, Second: v}
+	}
+	return newList
+}
+`
+}
+
+// Zip3 is template to generate function(Zip3, Unzip3) for user defined data type
+func Zip3() string {
+	return `
+func Zip3<CONDITIONAL_TYPE>(list1, list2, list3 []<TYPE>) [][3]<TYPE> {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+	if len(list3) < minLen {
+		minLen = len(list3)
+	}
+
+	newList := make([][3]<TYPE>, minLen)
+	for i := 0; i < minLen; i++ {
+		newList[i] = [3]<TYPE>{list1[i], list2[i], list3[i]}
+	}
+	return newList
+}
+
+func Unzip3<CONDITIONAL_TYPE>(list [][3]<TYPE>) ([]<TYPE>, []<TYPE>, []<TYPE>) {
+	list1 := make([]<TYPE>, len(list))
+	list2 := make([]<TYPE>, len(list))
+	list3 := make([]<TYPE>, len(list))
+	for i, v := range list {
+		list1[i], list2[i], list3[i] = v[0], v[1], v[2]
+	}
+	return list1, list2, list3
+}
+`
+}
@@ -0,0 +1,352 @@
+package persistent
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	mathbits "math/bits"
+	"reflect"
+)
+
+// Hasher can be implemented by keys of Map and items of Set to provide their own hash.
+// Keys which are equal(==) must have the same hash
+type Hasher interface {
+	Hash() uint32
+}
+
+// hamtNode is a node of hash array mapped trie. Nodes are never modified after they are created
+type hamtNode interface {
+	assoc(shift uint, hash uint32, key, val interface{}) (hamtNode, bool)
+	// dissoc returns nil if the node becomes empty
+	dissoc(shift uint, hash uint32, key interface{}) (hamtNode, bool)
+	get(shift uint, hash uint32, key interface{}) (interface{}, bool)
+	each(f func(key, val interface{}) bool) bool
+}
+
+// entry is either a key-value pair or a child node
+type entry struct {
+	hash  uint32
+	key   interface{}
+	val   interface{}
+	child hamtNode
+}
+
+type bitmapNode struct {
+	bitmap  uint32
+	entries []entry
+}
+
+type collisionNode struct {
+	hash    uint32
+	entries []entry
+}
+
+var emptyBitmapNode = &bitmapNode{}
+
+func bitpos(hash uint32, shift uint) uint32 {
+	return 1 << ((hash >> shift) & mask)
+}
+
+func (n *bitmapNode) index(bit uint32) int {
+	return mathbits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *bitmapNode) assoc(shift uint, hash uint32, key, val interface{}) (hamtNode, bool) {
+	bit := bitpos(hash, shift)
+	idx := n.index(bit)
+
+	if n.bitmap&bit == 0 {
+		entries := make([]entry, len(n.entries)+1)
+		copy(entries, n.entries[:idx])
+		entries[idx] = entry{hash: hash, key: key, val: val}
+		copy(entries[idx+1:], n.entries[idx:])
+		return &bitmapNode{bitmap: n.bitmap | bit, entries: entries}, true
+	}
+
+	e := n.entries[idx]
+	var newEntry entry
+	added := false
+	switch {
+	case e.child != nil:
+		var child hamtNode
+		child, added = e.child.assoc(shift+bits, hash, key, val)
+		newEntry = entry{child: child}
+	case e.key == key:
+		newEntry = entry{hash: hash, key: key, val: val}
+	default:
+		newEntry = entry{child: createNode(shift+bits, e, entry{hash: hash, key: key, val: val})}
+		added = true
+	}
+
+	entries := make([]entry, len(n.entries))
+	copy(entries, n.entries)
+	entries[idx] = newEntry
+	return &bitmapNode{bitmap: n.bitmap, entries: entries}, added
+}
+
+func createNode(shift uint, e1, e2 entry) hamtNode {
+	if e1.hash == e2.hash {
+		return &collisionNode{hash: e1.hash, entries: []entry{e1, e2}}
+	}
+	n, _ := emptyBitmapNode.assoc(shift, e1.hash, e1.key, e1.val)
+	n, _ = n.assoc(shift, e2.hash, e2.key, e2.val)
+	return n
+}
+
+func (n *bitmapNode) dissoc(shift uint, hash uint32, key interface{}) (hamtNode, bool) {
+	bit := bitpos(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	idx := n.index(bit)
+	e := n.entries[idx]
+
+	if e.child != nil {
+		child, removed := e.child.dissoc(shift+bits, hash, key)
+		if !removed {
+			return n, false
+		}
+		if child != nil {
+			entries := make([]entry, len(n.entries))
+			copy(entries, n.entries)
+			entries[idx] = compact(child)
+			return &bitmapNode{bitmap: n.bitmap, entries: entries}, true
+		}
+	} else if e.key != key {
+		return n, false
+	}
+
+	if len(n.entries) == 1 {
+		return nil, true
+	}
+	entries := make([]entry, len(n.entries)-1)
+	copy(entries, n.entries[:idx])
+	copy(entries[idx:], n.entries[idx+1:])
+	return &bitmapNode{bitmap: n.bitmap ^ bit, entries: entries}, true
+}
+
+// compact replaces the node which has only one key-value pair with the pair
+func compact(n hamtNode) entry {
+	switch node := n.(type) {
+	case *bitmapNode:
+		if len(node.entries) == 1 && node.entries[0].child == nil {
+			return node.entries[0]
+		}
+	case *collisionNode:
+		if len(node.entries) == 1 {
+			return node.entries[0]
+		}
+	}
+	return entry{child: n}
+}
+
+func (n *bitmapNode) get(shift uint, hash uint32, key interface{}) (interface{}, bool) {
+	bit := bitpos(hash, shift)
+	if n.bitmap&bit == 0 {
+		return nil, false
+	}
+	e := n.entries[n.index(bit)]
+	if e.child != nil {
+		return e.child.get(shift+bits, hash, key)
+	}
+	if e.key == key {
+		return e.val, true
+	}
+	return nil, false
+}
+
+func (n *bitmapNode) each(f func(key, val interface{}) bool) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.each(f) {
+				return false
+			}
+		} else if !f(e.key, e.val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *collisionNode) find(key interface{}) int {
+	for i, e := range n.entries {
+		if e.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *collisionNode) assoc(shift uint, hash uint32, key, val interface{}) (hamtNode, bool) {
+	if hash != n.hash {
+		// nest this node in a bitmap node, and add the key there
+		parent := &bitmapNode{bitmap: bitpos(n.hash, shift), entries: []entry{{child: n}}}
+		return parent.assoc(shift, hash, key, val)
+	}
+
+	idx := n.find(key)
+	if idx < 0 {
+		entries := make([]entry, len(n.entries)+1)
+		copy(entries, n.entries)
+		entries[len(n.entries)] = entry{hash: hash, key: key, val: val}
+		return &collisionNode{hash: hash, entries: entries}, true
+	}
+	entries := make([]entry, len(n.entries))
+	copy(entries, n.entries)
+	entries[idx] = entry{hash: hash, key: key, val: val}
+	return &collisionNode{hash: hash, entries: entries}, false
+}
+
+func (n *collisionNode) dissoc(shift uint, hash uint32, key interface{}) (hamtNode, bool) {
+	idx := n.find(key)
+	if hash != n.hash || idx < 0 {
+		return n, false
+	}
+	if len(n.entries) == 1 {
+		return nil, true
+	}
+	entries := make([]entry, len(n.entries)-1)
+	copy(entries, n.entries[:idx])
+	copy(entries[idx:], n.entries[idx+1:])
+	return &collisionNode{hash: hash, entries: entries}, true
+}
+
+func (n *collisionNode) get(shift uint, hash uint32, key interface{}) (interface{}, bool) {
+	if hash != n.hash {
+		return nil, false
+	}
+	if idx := n.find(key); idx >= 0 {
+		return n.entries[idx].val, true
+	}
+	return nil, false
+}
+
+func (n *collisionNode) each(f func(key, val interface{}) bool) bool {
+	for _, e := range n.entries {
+		if !f(e.key, e.val) {
+			return false
+		}
+	}
+	return true
+}
+
+// hash returns hash of the key. Keys must be comparable(==), it panics otherwise.
+// Keys of types other than built-in types and Hasher are hashed by their value as == compares them:
+// pointers and channels by the address, structs and arrays by their fields and items
+func hash(key interface{}) uint32 {
+	switch k := key.(type) {
+	case Hasher:
+		return k.Hash()
+	case string:
+		return hashString(k)
+	case int:
+		return hashUint64(uint64(k))
+	case int64:
+		return hashUint64(uint64(k))
+	case int32:
+		return hashUint64(uint64(k))
+	case int16:
+		return hashUint64(uint64(k))
+	case int8:
+		return hashUint64(uint64(k))
+	case uint:
+		return hashUint64(uint64(k))
+	case uint64:
+		return hashUint64(k)
+	case uint32:
+		return hashUint64(uint64(k))
+	case uint16:
+		return hashUint64(uint64(k))
+	case uint8:
+		return hashUint64(uint64(k))
+	case float64:
+		return hashFloat64(k)
+	case float32:
+		return hashFloat64(float64(k))
+	case bool:
+		if k {
+			return 1
+		}
+		return 0
+	case nil:
+		return 0
+	}
+	rv := reflect.ValueOf(key)
+	if !rv.Type().Comparable() {
+		panic(fmt.Sprintf("persistent: key of type %T is not comparable", key))
+	}
+	return hashValue(rv)
+}
+
+// hashValue returns hash of the value which is consistent with ==.
+// Pointers, channels, functions, maps and slices are hashed by identity, not by the data they point to
+func hashValue(rv reflect.Value) uint32 {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return 0
+	case reflect.String:
+		return hashString(rv.String())
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+		return hashUint64(uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
+		return hashUint64(rv.Uint())
+	case reflect.Float64, reflect.Float32:
+		return hashFloat64(rv.Float())
+	case reflect.Complex128, reflect.Complex64:
+		c := rv.Complex()
+		return combineHash(hashFloat64(real(c)), hashFloat64(imag(c)))
+	case reflect.Bool:
+		if rv.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Ptr, reflect.Chan, reflect.Func, reflect.Map, reflect.Slice, reflect.UnsafePointer:
+		return hashUint64(uint64(rv.Pointer()))
+	case reflect.Interface:
+		if rv.IsNil() {
+			return 0
+		}
+		return hashValue(rv.Elem())
+	case reflect.Array:
+		var h uint32
+		for i := 0; i < rv.Len(); i++ {
+			h = combineHash(h, hashValue(rv.Index(i)))
+		}
+		return h
+	case reflect.Struct:
+		var h uint32
+		for i := 0; i < rv.NumField(); i++ {
+			h = combineHash(h, hashValue(rv.Field(i)))
+		}
+		return h
+	}
+	return 0
+}
+
+func combineHash(h1, h2 uint32) uint32 {
+	return hashUint64(uint64(h1)<<32 | uint64(h2))
+}
+
+func hashString(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// hashUint64 mixes the bits(finalizer of splitmix64) so that sequential numbers spread across the trie
+func hashUint64(v uint64) uint32 {
+	v ^= v >> 30
+	v *= 0xbf58476d1ce4e5b9
+	v ^= v >> 27
+	v *= 0x94d049bb133111eb
+	v ^= v >> 31
+	return uint32(v) ^ uint32(v>>32)
+}
+
+func hashFloat64(f float64) uint32 {
+	if f == 0 {
+		// -0 == +0
+		f = 0
+	}
+	return hashUint64(math.Float64bits(f))
+}
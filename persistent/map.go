@@ -0,0 +1,120 @@
+package persistent
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var emptyMap = &Map{}
+
+// Map - persistent hash map(hash array mapped trie) like Clojure's PersistentHashMap.
+// Assoc, Dissoc and Get are O(log32 n). A Map is never modified, so it can be read by many goroutines
+// while writers create new versions. Keys must be comparable(==), see Hasher for custom hash.
+// The zero value is an empty map
+type Map struct {
+	count int
+	root  hamtNode
+}
+
+// EmptyMap returns empty map
+func EmptyMap() *Map {
+	return emptyMap
+}
+
+// FromMap creates persistent map from a map of any type, eg. map[string]int.
+// Panics if the argument is not a map
+func FromMap(m interface{}) *Map {
+	rv := reflect.ValueOf(m)
+	if rv.Kind() != reflect.Map {
+		panic(fmt.Sprintf("persistent.FromMap: %T is not a map", m))
+	}
+
+	newMap := emptyMap
+	for _, k := range rv.MapKeys() {
+		newMap = newMap.Assoc(k.Interface(), rv.MapIndex(k).Interface())
+	}
+	return newMap
+}
+
+// Count returns number of entries
+func (m *Map) Count() int {
+	return m.count
+}
+
+// Assoc returns a new map with the key set to the value
+func (m *Map) Assoc(key, val interface{}) *Map {
+	root := m.root
+	if root == nil {
+		root = emptyBitmapNode
+	}
+	newRoot, added := root.assoc(0, hash(key), key, val)
+	count := m.count
+	if added {
+		count++
+	}
+	return &Map{count: count, root: newRoot}
+}
+
+// Dissoc returns a new map without the key. Returns the map itself if the key doesn't exist
+func (m *Map) Dissoc(key interface{}) *Map {
+	if m.root == nil {
+		return m
+	}
+	newRoot, removed := m.root.dissoc(0, hash(key), key)
+	if !removed {
+		return m
+	}
+	return &Map{count: m.count - 1, root: newRoot}
+}
+
+// Get returns the value of the key and true. Returns nil and false if the key doesn't exist
+func (m *Map) Get(key interface{}) (interface{}, bool) {
+	if m.root == nil {
+		return nil, false
+	}
+	return m.root.get(0, hash(key), key)
+}
+
+// Contains returns true if the key exists
+func (m *Map) Contains(key interface{}) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Each calls the function for every entry in random order. Iteration stops when the function returns false
+func (m *Map) Each(f func(key, val interface{}) bool) {
+	if m.root != nil {
+		m.root.each(f)
+	}
+}
+
+// Keys returns list of keys in random order
+func (m *Map) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.count)
+	m.Each(func(key, _ interface{}) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Into copies entries to the map pointed by dst, eg. *map[string]int. Panics if entries can't be assigned to
+// the key and value types of the map
+func (m *Map) Into(dst interface{}) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Map {
+		panic(fmt.Sprintf("persistent.Map.Into: %T is not a pointer to map", dst))
+	}
+
+	mapType := rv.Elem().Type()
+	newMap := reflect.MakeMapWithSize(mapType, m.count)
+	m.Each(func(key, val interface{}) bool {
+		v := reflect.Zero(mapType.Elem())
+		if val != nil {
+			v = reflect.ValueOf(val)
+		}
+		newMap.SetMapIndex(reflect.ValueOf(key), v)
+		return true
+	})
+	rv.Elem().Set(newMap)
+}
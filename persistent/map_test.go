@@ -0,0 +1,239 @@
+package persistent
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// collidingKey has the same hash for every value
+type collidingKey int
+
+func (collidingKey) Hash() uint32 {
+	return 42
+}
+
+type point struct {
+	x, y int
+}
+
+func TestMapAssocDissoc(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := EmptyMap()
+	expected := map[interface{}]interface{}{}
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(5000)
+		if r.Intn(3) == 0 {
+			m = m.Dissoc(key)
+			delete(expected, key)
+		} else {
+			m = m.Assoc(key, i)
+			expected[key] = i
+		}
+	}
+
+	if m.Count() != len(expected) {
+		t.Fatalf("TestMapAssocDissoc failed. Expected count=%v, actual=%v", len(expected), m.Count())
+	}
+	for k, v := range expected {
+		if actual, ok := m.Get(k); !ok || actual != v {
+			t.Fatalf("TestMapAssocDissoc failed. Expected %v=%v, actual=%v", k, v, actual)
+		}
+	}
+	for i := 5000; i < 5100; i++ {
+		if m.Contains(i) {
+			t.Fatalf("TestMapAssocDissoc failed. Expected %v not to exist", i)
+		}
+	}
+
+	actual := map[interface{}]interface{}{}
+	m.Each(func(k, v interface{}) bool {
+		actual[k] = v
+		return true
+	})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestMapAssocDissoc failed. Each doesn't match")
+	}
+
+	for k := range expected {
+		m = m.Dissoc(k)
+	}
+	if m.Count() != 0 || len(m.Keys()) != 0 {
+		t.Errorf("TestMapAssocDissoc failed. Expected empty map, actual count=%v", m.Count())
+	}
+}
+
+func TestMapImmutable(t *testing.T) {
+	m1 := FromMap(map[string]int{"a": 1, "b": 2})
+	m2 := m1.Assoc("a", 10).Assoc("c", 3)
+	m3 := m1.Dissoc("b")
+
+	var actual map[string]int
+	m1.Into(&actual)
+	if !reflect.DeepEqual(map[string]int{"a": 1, "b": 2}, actual) {
+		t.Errorf("TestMapImmutable failed. Expected original map not to be modified, actual=%v", actual)
+	}
+	m2.Into(&actual)
+	if !reflect.DeepEqual(map[string]int{"a": 10, "b": 2, "c": 3}, actual) {
+		t.Errorf("TestMapImmutable failed. Expected=map[a:10 b:2 c:3], actual=%v", actual)
+	}
+	if m3.Count() != 1 || m3.Contains("b") {
+		t.Errorf("TestMapImmutable failed. Expected b to be removed")
+	}
+	if m1.Dissoc("missing") != m1 {
+		t.Errorf("TestMapImmutable failed. Expected same map when the key doesn't exist")
+	}
+
+	var zero Map
+	if zero.Count() != 0 || zero.Contains(1) || zero.Dissoc(1).Count() != 0 || zero.Assoc(1, 1).Count() != 1 {
+		t.Errorf("TestMapImmutable failed. Expected zero value to be empty map")
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	m := EmptyMap().
+		Assoc(1, "int").
+		Assoc(int64(1), "int64").
+		Assoc("1", "string").
+		Assoc(1.0, "float64").
+		Assoc(true, "bool").
+		Assoc(nil, "nil").
+		Assoc(point{1, 2}, "struct")
+
+	cases := map[interface{}]interface{}{1: "int", int64(1): "int64", "1": "string", 1.0: "float64", true: "bool", nil: "nil", point{1, 2}: "struct"}
+	for k, v := range cases {
+		if actual, ok := m.Get(k); !ok || actual != v {
+			t.Errorf("TestMapKeys failed. Expected %#v=%v, actual=%v", k, v, actual)
+		}
+	}
+	if _, ok := m.Get(point{2, 1}); ok {
+		t.Errorf("TestMapKeys failed. Expected point{2, 1} not to exist")
+	}
+}
+
+func TestMapCollision(t *testing.T) {
+	m := EmptyMap()
+	for i := 0; i < 10; i++ {
+		m = m.Assoc(collidingKey(i), i)
+	}
+	m = m.Assoc(1, "one")
+
+	if m.Count() != 11 {
+		t.Errorf("TestMapCollision failed. Expected count=11, actual=%v", m.Count())
+	}
+	for i := 0; i < 10; i++ {
+		if v, _ := m.Get(collidingKey(i)); v != i {
+			t.Errorf("TestMapCollision failed. Expected=%v, actual=%v", i, v)
+		}
+	}
+
+	m = m.Assoc(collidingKey(3), "three")
+	for i := 0; i < 10; i++ {
+		if i != 3 {
+			m = m.Dissoc(collidingKey(i))
+		}
+	}
+	if v, ok := m.Get(collidingKey(3)); !ok || v != "three" || m.Count() != 2 {
+		t.Errorf("TestMapCollision failed. Expected=three, actual=%v", v)
+	}
+
+	m = m.Dissoc(collidingKey(3))
+	if m.Count() != 1 || !m.Contains(1) {
+		t.Errorf("TestMapCollision failed. Expected only key 1, actual count=%v", m.Count())
+	}
+}
+
+func TestMapPointerKey(t *testing.T) {
+	type emp struct {
+		name   string
+		salary float64
+		boss   *point
+	}
+	m := EmptyMap()
+	for i := 0; i < 100; i++ {
+		m = m.Assoc(i, i)
+	}
+	e := &emp{name: "A"}
+	m = m.Assoc(e, "e")
+
+	// the pointer is the key, not the data it points to
+	e.salary = 1000
+	e.boss = &point{1, 2}
+	if v, ok := m.Get(e); !ok || v != "e" {
+		t.Errorf("TestMapPointerKey failed. Expected pointer key to be found after its data is changed, actual=%v", v)
+	}
+	if m = m.Assoc(e, "e2"); m.Count() != 101 {
+		t.Errorf("TestMapPointerKey failed. Expected count=101, actual=%v", m.Count())
+	}
+	if m.Contains(&emp{name: "A", salary: 1000}) {
+		t.Errorf("TestMapPointerKey failed. Expected other pointer to the same data not to be found")
+	}
+
+	// structs are keys by value, including their pointer fields
+	boss := &point{3, 4}
+	m = m.Assoc(emp{name: "B", boss: boss}, "b")
+	if v, _ := m.Get(emp{name: "B", boss: boss}); v != "b" || m.Contains(emp{name: "B", boss: &point{3, 4}}) {
+		t.Errorf("TestMapPointerKey failed. Expected struct key to be found by its value, actual=%v", v)
+	}
+	if m = m.Dissoc(e); m.Contains(e) || m.Count() != 101 {
+		t.Errorf("TestMapPointerKey failed. Expected pointer key to be removed, actual count=%v", m.Count())
+	}
+}
+
+func TestMapSnapshot(t *testing.T) {
+	var mu sync.Mutex
+	current := EmptyMap()
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			mu.Lock()
+			current = current.Assoc(i, i)
+			mu.Unlock()
+		}
+	}()
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				mu.Lock()
+				snapshot := current
+				mu.Unlock()
+
+				count := 0
+				snapshot.Each(func(_, _ interface{}) bool {
+					count++
+					return true
+				})
+				if count != snapshot.Count() {
+					t.Errorf("TestMapSnapshot failed. Snapshot changed while reading")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMapPanic(t *testing.T) {
+	cases := map[string]func(){
+		"FromMap": func() { FromMap([]int{}) },
+		"Into":    func() { EmptyMap().Into(map[int]int{}) },
+		"Key":     func() { EmptyMap().Assoc([]int{1}, 1).Get([]int{1}) },
+	}
+	for name, f := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("TestMapPanic failed. Expected %v to panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,166 @@
+package persistent
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var emptySet = &Set{}
+
+// Set - persistent hash set. Methods are like set.Int, but every "modifying" method returns a new set
+// and the original set stays unchanged. Items must be comparable(==), see Hasher for custom hash.
+// The zero value and nil are empty sets
+type Set struct {
+	m Map
+}
+
+// EmptySet returns empty set
+func EmptySet() *Set {
+	return emptySet
+}
+
+// NewSet creates set with the items
+func NewSet(items ...interface{}) *Set {
+	s := emptySet
+	for _, item := range items {
+		s = s.Add(item)
+	}
+	return s
+}
+
+// SetFromSlice creates set from a slice of any type, eg. []int. Panics if the argument is not a slice
+func SetFromSlice(slice interface{}) *Set {
+	if items, ok := slice.([]interface{}); ok {
+		return NewSet(items...)
+	}
+
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("persistent.SetFromSlice: %T is not a slice", slice))
+	}
+	s := emptySet
+	for i := 0; i < rv.Len(); i++ {
+		s = s.Add(rv.Index(i).Interface())
+	}
+	return s
+}
+
+// Add returns a new set with the item
+func (s *Set) Add(item interface{}) *Set {
+	s = s.orEmpty()
+	if s.m.Contains(item) {
+		return s
+	}
+	return &Set{m: *s.m.Assoc(item, nil)}
+}
+
+// Remove returns a new set without the item
+func (s *Set) Remove(item interface{}) *Set {
+	s = s.orEmpty()
+	m := s.m.Dissoc(item)
+	if m == &s.m {
+		return s
+	}
+	return &Set{m: *m}
+}
+
+// Contains returns true if the item is in the set
+func (s *Set) Contains(item interface{}) bool {
+	return s != nil && s.m.Contains(item)
+}
+
+// Size returns number of items
+func (s *Set) Size() int {
+	if s == nil {
+		return 0
+	}
+	return s.m.Count()
+}
+
+// Each calls the function for every item in random order. Iteration stops when the function returns false
+func (s *Set) Each(f func(interface{}) bool) {
+	if s == nil {
+		return
+	}
+	s.m.Each(func(key, _ interface{}) bool {
+		return f(key)
+	})
+}
+
+// GetList returns items in random order
+func (s *Set) GetList() []interface{} {
+	return s.orEmpty().m.Keys()
+}
+
+// orEmpty returns the empty set for nil
+func (s *Set) orEmpty() *Set {
+	if s == nil {
+		return emptySet
+	}
+	return s
+}
+
+// Into copies items to the slice pointed by dst, eg. *[]int
+func (s *Set) Into(dst interface{}) {
+	FromSlice(s.GetList()).Into(dst)
+}
+
+// Union returns a new set with the items of both sets
+func (s *Set) Union(s2 *Set) *Set {
+	larger, smaller := s.orEmpty(), s2.orEmpty()
+	if smaller.Size() > larger.Size() {
+		larger, smaller = smaller, larger
+	}
+
+	result := larger
+	smaller.Each(func(item interface{}) bool {
+		result = result.Add(item)
+		return true
+	})
+	return result
+}
+
+// Intersection returns a new set with the items which are in both sets
+func (s *Set) Intersection(s2 *Set) *Set {
+	larger, smaller := s.orEmpty(), s2.orEmpty()
+	if smaller.Size() > larger.Size() {
+		larger, smaller = smaller, larger
+	}
+
+	result := emptySet
+	smaller.Each(func(item interface{}) bool {
+		if larger.Contains(item) {
+			result = result.Add(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Minus returns a new set with the items of the set which are not in the set(argument)
+func (s *Set) Minus(s2 *Set) *Set {
+	result := s.orEmpty()
+	s2.Each(func(item interface{}) bool {
+		result = result.Remove(item)
+		return true
+	})
+	return result
+}
+
+// Subset returns true if all the items of the set are in the set(argument)
+func (s *Set) Subset(s2 *Set) bool {
+	if s.Size() > s2.Size() {
+		return false
+	}
+	subset := true
+	s.Each(func(item interface{}) bool {
+		subset = s2.Contains(item)
+		return subset
+	})
+	return subset
+}
+
+// Superset returns true if all the items of the set(argument) are in the set
+func (s *Set) Superset(s2 *Set) bool {
+	return s2.Subset(s)
+}
@@ -0,0 +1,68 @@
+package persistent
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortedInts(s *Set) []int {
+	var list []int
+	s.Into(&list)
+	sort.Ints(list)
+	return list
+}
+
+func TestSet(t *testing.T) {
+	s1 := NewSet(1, 2, 3)
+	s2 := s1.Add(4).Add(4).Remove(1)
+
+	if !reflect.DeepEqual([]int{1, 2, 3}, sortedInts(s1)) {
+		t.Errorf("TestSet failed. Expected original set not to be modified, actual=%v", sortedInts(s1))
+	}
+	if !reflect.DeepEqual([]int{2, 3, 4}, sortedInts(s2)) || s2.Size() != 3 {
+		t.Errorf("TestSet failed. Expected=[2 3 4], actual=%v", sortedInts(s2))
+	}
+	if !s2.Contains(4) || s2.Contains(1) {
+		t.Errorf("TestSet failed. Unexpected Contains result")
+	}
+	if s1.Add(1) != s1 || s1.Remove(10) != s1 {
+		t.Errorf("TestSet failed. Expected same set when nothing changes")
+	}
+
+	var zero Set
+	if zero.Size() != 0 || zero.Add(1).Size() != 1 || len(zero.GetList()) != 0 {
+		t.Errorf("TestSet failed. Expected zero value to be empty set")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	s1 := SetFromSlice([]int{1, 2, 3, 4})
+	s2 := SetFromSlice([]int{3, 4, 5})
+
+	if actual := sortedInts(s1.Union(s2)); !reflect.DeepEqual([]int{1, 2, 3, 4, 5}, actual) {
+		t.Errorf("TestSetOperations failed. Union expected=[1 2 3 4 5], actual=%v", actual)
+	}
+	if actual := sortedInts(s1.Intersection(s2)); !reflect.DeepEqual([]int{3, 4}, actual) {
+		t.Errorf("TestSetOperations failed. Intersection expected=[3 4], actual=%v", actual)
+	}
+	if actual := sortedInts(s1.Minus(s2)); !reflect.DeepEqual([]int{1, 2}, actual) {
+		t.Errorf("TestSetOperations failed. Minus expected=[1 2], actual=%v", actual)
+	}
+
+	subset := NewSet(3, 4)
+	if !subset.Subset(s1) || !s1.Superset(subset) || s2.Subset(s1) || subset.Superset(s1) || !EmptySet().Subset(s1) {
+		t.Errorf("TestSetOperations failed. Unexpected Subset/Superset result")
+	}
+
+	if s1.Size() != 4 || s2.Size() != 3 {
+		t.Errorf("TestSetOperations failed. Expected original sets not to be modified")
+	}
+
+	// nil is the empty set
+	var empty *Set
+	if s1.Union(empty).Size() != 4 || empty.Union(s1).Size() != 4 || s1.Intersection(empty).Size() != 0 ||
+		empty.Intersection(s1).Size() != 0 || empty.Minus(s1).Size() != 0 || !empty.Subset(s1) || empty.Add(1).Size() != 1 {
+		t.Errorf("TestSetOperations failed. Expected nil set to be the empty set")
+	}
+}
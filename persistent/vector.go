@@ -0,0 +1,478 @@
+// Package persistent provides immutable data structures which share their structure between versions.
+// Every "modifying" operation returns a new version in (nearly) constant time, and the old version stays
+// unchanged. So a value can be shared between goroutines without copying or locking.
+package persistent
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// owner marks the nodes which can be modified in place by a transient.
+// It must not be zero-size, as pointers to distinct zero-size values may be equal
+type owner struct {
+	_ byte
+}
+
+type node struct {
+	edit  *owner
+	array [width]interface{}
+}
+
+var emptyNode = &node{}
+
+var emptyVector = &Vector{shift: bits, root: emptyNode, tail: []interface{}{}}
+
+// Vector - persistent vector(32-way trie with tail) like Clojure's PersistentVector.
+// Nth, Assoc, Conj and Pop are O(log32 n). The zero value is not usable, use New or Empty
+type Vector struct {
+	cnt   int
+	shift uint
+	root  *node
+	tail  []interface{}
+}
+
+// Empty returns empty vector
+func Empty() *Vector {
+	return emptyVector
+}
+
+// New creates vector with the items
+func New(items ...interface{}) *Vector {
+	t := emptyVector.Transient()
+	for _, item := range items {
+		t.Conj(item)
+	}
+	return t.Persistent()
+}
+
+// FromSlice creates vector from a slice of any type, eg. []int or []employee.Employee.
+// Panics if the argument is not a slice
+func FromSlice(slice interface{}) *Vector {
+	if items, ok := slice.([]interface{}); ok {
+		return New(items...)
+	}
+
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("persistent.FromSlice: %T is not a slice", slice))
+	}
+	t := emptyVector.Transient()
+	for i := 0; i < rv.Len(); i++ {
+		t.Conj(rv.Index(i).Interface())
+	}
+	return t.Persistent()
+}
+
+// Count returns number of items
+func (v *Vector) Count() int {
+	return v.cnt
+}
+
+func (v *Vector) tailOff() int {
+	return tailOff(v.cnt)
+}
+
+func tailOff(cnt int) int {
+	if cnt < width {
+		return 0
+	}
+	return ((cnt - 1) >> bits) << bits
+}
+
+func arrayFor(root *node, shift uint, i int) []interface{} {
+	n := root
+	for level := shift; level > 0; level -= bits {
+		n = n.array[(i>>level)&mask].(*node)
+	}
+	return n.array[:]
+}
+
+func (v *Vector) arrayFor(i int) []interface{} {
+	if i >= v.tailOff() {
+		return v.tail
+	}
+	return arrayFor(v.root, v.shift, i)
+}
+
+// Nth returns the item at the index.
+// If the index is out of range, returns notFound when it is passed, panics otherwise
+func (v *Vector) Nth(i int, notFound ...interface{}) interface{} {
+	if i < 0 || i >= v.cnt {
+		if len(notFound) > 0 {
+			return notFound[0]
+		}
+		panic(fmt.Sprintf("persistent.Vector: index %d out of range with count %d", i, v.cnt))
+	}
+	return v.arrayFor(i)[i&mask]
+}
+
+// Peek returns the last item and true. Returns nil and false if the vector is empty
+func (v *Vector) Peek() (interface{}, bool) {
+	if v.cnt == 0 {
+		return nil, false
+	}
+	return v.Nth(v.cnt - 1), true
+}
+
+// Conj returns a new vector with the item added at the end
+func (v *Vector) Conj(item interface{}) *Vector {
+	if v.cnt-v.tailOff() < width {
+		newTail := make([]interface{}, len(v.tail)+1)
+		copy(newTail, v.tail)
+		newTail[len(v.tail)] = item
+		return &Vector{cnt: v.cnt + 1, shift: v.shift, root: v.root, tail: newTail}
+	}
+
+	// tail is full, push it into the tree
+	tailNode := &node{}
+	copy(tailNode.array[:], v.tail)
+
+	var newRoot *node
+	newShift := v.shift
+	if (v.cnt >> bits) > (1 << v.shift) {
+		// root is full
+		newRoot = &node{}
+		newRoot.array[0] = v.root
+		newRoot.array[1] = newPath(nil, v.shift, tailNode)
+		newShift += bits
+	} else {
+		newRoot = pushTail(nil, v.cnt, v.shift, v.root, tailNode)
+	}
+	return &Vector{cnt: v.cnt + 1, shift: newShift, root: newRoot, tail: []interface{}{item}}
+}
+
+// pushTail copies the path to the tail node. Nodes owned by edit are modified in place
+func pushTail(edit *owner, cnt int, level uint, parent, tailNode *node) *node {
+	ret := editableNode(edit, parent)
+	subIdx := ((cnt - 1) >> level) & mask
+
+	var nodeToInsert *node
+	if level == bits {
+		nodeToInsert = tailNode
+	} else if child := parent.array[subIdx]; child != nil {
+		nodeToInsert = pushTail(edit, cnt, level-bits, child.(*node), tailNode)
+	} else {
+		nodeToInsert = newPath(edit, level-bits, tailNode)
+	}
+	ret.array[subIdx] = nodeToInsert
+	return ret
+}
+
+func newPath(edit *owner, level uint, n *node) *node {
+	if level == 0 {
+		return n
+	}
+	ret := &node{edit: edit}
+	ret.array[0] = newPath(edit, level-bits, n)
+	return ret
+}
+
+// editableNode returns the node itself if it is owned by edit, its copy otherwise
+func editableNode(edit *owner, n *node) *node {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	return &node{edit: edit, array: n.array}
+}
+
+// Assoc returns a new vector with the item at the index replaced.
+// Index equal to Count adds the item at the end. Panics if the index is out of range
+func (v *Vector) Assoc(i int, item interface{}) *Vector {
+	if i == v.cnt {
+		return v.Conj(item)
+	}
+	if i < 0 || i > v.cnt {
+		panic(fmt.Sprintf("persistent.Vector: index %d out of range with count %d", i, v.cnt))
+	}
+
+	if i >= v.tailOff() {
+		newTail := make([]interface{}, len(v.tail))
+		copy(newTail, v.tail)
+		newTail[i&mask] = item
+		return &Vector{cnt: v.cnt, shift: v.shift, root: v.root, tail: newTail}
+	}
+	return &Vector{cnt: v.cnt, shift: v.shift, root: doAssoc(nil, v.shift, v.root, i, item), tail: v.tail}
+}
+
+func doAssoc(edit *owner, level uint, n *node, i int, item interface{}) *node {
+	ret := editableNode(edit, n)
+	if level == 0 {
+		ret.array[i&mask] = item
+		return ret
+	}
+	subIdx := (i >> level) & mask
+	ret.array[subIdx] = doAssoc(edit, level-bits, n.array[subIdx].(*node), i, item)
+	return ret
+}
+
+// Pop returns a new vector without the last item. Panics if the vector is empty
+func (v *Vector) Pop() *Vector {
+	if v.cnt == 0 {
+		panic("persistent.Vector: can't pop empty vector")
+	}
+	if v.cnt == 1 {
+		return emptyVector
+	}
+
+	if v.cnt-v.tailOff() > 1 {
+		newTail := make([]interface{}, len(v.tail)-1)
+		copy(newTail, v.tail)
+		return &Vector{cnt: v.cnt - 1, shift: v.shift, root: v.root, tail: newTail}
+	}
+
+	// tail has one item, last node of the tree becomes the tail
+	newTail := v.arrayFor(v.cnt - 2)
+	newRoot := popTail(nil, v.cnt, v.shift, v.root)
+	newShift := v.shift
+	if newRoot == nil {
+		newRoot = emptyNode
+	}
+	if v.shift > bits && newRoot.array[1] == nil {
+		newRoot = newRoot.array[0].(*node)
+		newShift -= bits
+	}
+	return &Vector{cnt: v.cnt - 1, shift: newShift, root: newRoot, tail: newTail}
+}
+
+// popTail removes the last node of the tree. Returns nil if the node becomes empty
+func popTail(edit *owner, cnt int, level uint, n *node) *node {
+	subIdx := ((cnt - 2) >> level) & mask
+	if level > bits {
+		newChild := popTail(edit, cnt, level-bits, n.array[subIdx].(*node))
+		if newChild == nil && subIdx == 0 {
+			return nil
+		}
+		ret := editableNode(edit, n)
+		if newChild == nil {
+			ret.array[subIdx] = nil
+		} else {
+			ret.array[subIdx] = newChild
+		}
+		return ret
+	}
+	if subIdx == 0 {
+		return nil
+	}
+	ret := editableNode(edit, n)
+	ret.array[subIdx] = nil
+	return ret
+}
+
+// Each calls the function for every item in order
+func (v *Vector) Each(f func(int, interface{})) {
+	for i := 0; i < v.cnt; i += width {
+		for j, item := range v.arrayFor(i) {
+			if i+j >= v.cnt {
+				return
+			}
+			f(i+j, item)
+		}
+	}
+}
+
+// ToSlice returns items as a new slice
+func (v *Vector) ToSlice() []interface{} {
+	list := make([]interface{}, 0, v.cnt)
+	v.Each(func(_ int, item interface{}) {
+		list = append(list, item)
+	})
+	return list
+}
+
+// Into copies items to the slice pointed by dst, eg. *[]int. Panics if items can't be assigned to
+// the element type of the slice
+func (v *Vector) Into(dst interface{}) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		panic(fmt.Sprintf("persistent.Vector.Into: %T is not a pointer to slice", dst))
+	}
+
+	slice := reflect.MakeSlice(rv.Elem().Type(), v.cnt, v.cnt)
+	v.Each(func(i int, item interface{}) {
+		if item != nil {
+			slice.Index(i).Set(reflect.ValueOf(item))
+		}
+	})
+	rv.Elem().Set(slice)
+}
+
+// Map returns a new vector after applying the function on each item. Returns empty vector if the function is nil
+func (v *Vector) Map(f func(interface{}) interface{}) *Vector {
+	if f == nil {
+		return emptyVector
+	}
+	t := emptyVector.Transient()
+	v.Each(func(_ int, item interface{}) {
+		t.Conj(f(item))
+	})
+	return t.Persistent()
+}
+
+// Filter returns a new vector with the items which satisfy the predicate. Returns empty vector if the predicate is nil
+func (v *Vector) Filter(pred func(interface{}) bool) *Vector {
+	if pred == nil {
+		return emptyVector
+	}
+	t := emptyVector.Transient()
+	v.Each(func(_ int, item interface{}) {
+		if pred(item) {
+			t.Conj(item)
+		}
+	})
+	return t.Persistent()
+}
+
+// Reduce reduces the items to a single value, from the first item to the last.
+// Returns the initial value if the function is nil
+func (v *Vector) Reduce(f func(interface{}, interface{}) interface{}, initializer interface{}) interface{} {
+	result := initializer
+	if f == nil {
+		return result
+	}
+	v.Each(func(_ int, item interface{}) {
+		result = f(result, item)
+	})
+	return result
+}
+
+// Transient returns a mutable copy of the vector for efficient batch updates.
+// The vector itself is not affected
+func (v *Vector) Transient() *TransientVector {
+	tail := make([]interface{}, width)
+	copy(tail, v.tail)
+	edit := &owner{}
+	return &TransientVector{cnt: v.cnt, shift: v.shift, root: &node{edit: edit, array: v.root.array}, tail: tail, edit: edit}
+}
+
+// TransientVector - mutable version of Vector which modifies its own nodes in place.
+// It is not safe for concurrent use and can't be used after Persistent is called
+type TransientVector struct {
+	cnt   int
+	shift uint
+	root  *node
+	tail  []interface{}
+	edit  *owner
+}
+
+func (t *TransientVector) ensureEditable() {
+	if t.edit == nil {
+		panic("persistent.TransientVector: used after Persistent")
+	}
+}
+
+// Count returns number of items
+func (t *TransientVector) Count() int {
+	t.ensureEditable()
+	return t.cnt
+}
+
+// Nth returns the item at the index.
+// If the index is out of range, returns notFound when it is passed, panics otherwise
+func (t *TransientVector) Nth(i int, notFound ...interface{}) interface{} {
+	t.ensureEditable()
+	if i < 0 || i >= t.cnt {
+		if len(notFound) > 0 {
+			return notFound[0]
+		}
+		panic(fmt.Sprintf("persistent.TransientVector: index %d out of range with count %d", i, t.cnt))
+	}
+	if i >= tailOff(t.cnt) {
+		return t.tail[i&mask]
+	}
+	return arrayFor(t.root, t.shift, i)[i&mask]
+}
+
+// Conj adds the item at the end
+func (t *TransientVector) Conj(item interface{}) *TransientVector {
+	t.ensureEditable()
+	if t.cnt-tailOff(t.cnt) < width {
+		t.tail[t.cnt&mask] = item
+		t.cnt++
+		return t
+	}
+
+	tailNode := &node{edit: t.edit}
+	copy(tailNode.array[:], t.tail)
+	t.tail = make([]interface{}, width)
+	t.tail[0] = item
+
+	if (t.cnt >> bits) > (1 << t.shift) {
+		newRoot := &node{edit: t.edit}
+		newRoot.array[0] = t.root
+		newRoot.array[1] = newPath(t.edit, t.shift, tailNode)
+		t.root = newRoot
+		t.shift += bits
+	} else {
+		t.root = pushTail(t.edit, t.cnt, t.shift, t.root, tailNode)
+	}
+	t.cnt++
+	return t
+}
+
+// Assoc replaces the item at the index. Index equal to Count adds the item at the end.
+// Panics if the index is out of range
+func (t *TransientVector) Assoc(i int, item interface{}) *TransientVector {
+	t.ensureEditable()
+	if i == t.cnt {
+		return t.Conj(item)
+	}
+	if i < 0 || i > t.cnt {
+		panic(fmt.Sprintf("persistent.TransientVector: index %d out of range with count %d", i, t.cnt))
+	}
+
+	if i >= tailOff(t.cnt) {
+		t.tail[i&mask] = item
+	} else {
+		t.root = doAssoc(t.edit, t.shift, t.root, i, item)
+	}
+	return t
+}
+
+// Pop removes the last item. Panics if the vector is empty
+func (t *TransientVector) Pop() *TransientVector {
+	t.ensureEditable()
+	if t.cnt == 0 {
+		panic("persistent.TransientVector: can't pop empty vector")
+	}
+	if t.cnt == 1 || t.cnt-tailOff(t.cnt) > 1 {
+		t.cnt--
+		t.tail[t.cnt&mask] = nil
+		return t
+	}
+
+	newTail := make([]interface{}, width)
+	copy(newTail, arrayFor(t.root, t.shift, t.cnt-2))
+
+	newRoot := popTail(t.edit, t.cnt, t.shift, t.root)
+	if newRoot == nil {
+		newRoot = &node{edit: t.edit}
+	}
+	if t.shift > bits && newRoot.array[1] == nil {
+		newRoot = editableNode(t.edit, newRoot.array[0].(*node))
+		t.shift -= bits
+	}
+	t.root = newRoot
+	t.tail = newTail
+	t.cnt--
+	return t
+}
+
+// Persistent returns persistent vector with the items. The transient can't be used after that
+func (t *TransientVector) Persistent() *Vector {
+	t.ensureEditable()
+	t.edit = nil
+	if t.cnt == 0 {
+		return emptyVector
+	}
+
+	tail := make([]interface{}, t.cnt-tailOff(t.cnt))
+	copy(tail, t.tail)
+	return &Vector{cnt: t.cnt, shift: t.shift, root: t.root, tail: tail}
+}
@@ -0,0 +1,199 @@
+package persistent
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/logic-building/functional-go/fp"
+)
+
+func checkVector(t *testing.T, name string, expected []interface{}, v *Vector) {
+	t.Helper()
+	if v.Count() != len(expected) {
+		t.Fatalf("%s failed. Expected count=%v, actual=%v", name, len(expected), v.Count())
+	}
+	for i, item := range expected {
+		if v.Nth(i) != item {
+			t.Fatalf("%s failed. Expected item at %v=%v, actual=%v", name, i, item, v.Nth(i))
+		}
+	}
+	if len(expected) > 0 && !reflect.DeepEqual(expected, v.ToSlice()) {
+		t.Fatalf("%s failed. ToSlice doesn't match", name)
+	}
+}
+
+func TestVectorConjPop(t *testing.T) {
+	// crosses the boundaries of tail, one and two levels of the tree
+	sizes := []int{0, 1, 31, 32, 33, 64, 1024, 1056, 1057, 32*32*32 + 33}
+	for _, size := range sizes {
+		v := Empty()
+		var expected []interface{}
+		for i := 0; i < size; i++ {
+			v = v.Conj(i)
+			expected = append(expected, i)
+		}
+		checkVector(t, "TestVectorConj", expected, v)
+
+		for v.Count() > 0 {
+			v = v.Pop()
+			expected = expected[:len(expected)-1]
+			if v.Count()%97 == 0 {
+				checkVector(t, "TestVectorPop", expected, v)
+			}
+		}
+		checkVector(t, "TestVectorPop", expected, v)
+	}
+}
+
+func TestVectorImmutable(t *testing.T) {
+	v1 := FromSlice(fp.RangeInt(0, 100))
+	v2 := v1.Assoc(5, "five").Conj(100)
+	v3 := v1.Pop()
+
+	if v1.Nth(5) != 5 || v1.Count() != 100 {
+		t.Errorf("TestVectorImmutable failed. Expected original vector not to be modified")
+	}
+	if v2.Nth(5) != "five" || v2.Count() != 101 || v2.Nth(100) != 100 {
+		t.Errorf("TestVectorImmutable failed. Expected=five and 101 items, actual=%v and %v items", v2.Nth(5), v2.Count())
+	}
+	if v3.Count() != 99 {
+		t.Errorf("TestVectorImmutable failed. Expected=99 items, actual=%v", v3.Count())
+	}
+	if v1.Assoc(100, "end").Nth(100) != "end" {
+		t.Errorf("TestVectorImmutable failed. Expected Assoc at count to add the item")
+	}
+}
+
+func TestVectorRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v := Empty()
+	var expected []interface{}
+	versions := map[*Vector][]interface{}{}
+
+	for i := 0; i < 20000; i++ {
+		switch op := r.Intn(10); {
+		case op < 6:
+			v = v.Conj(i)
+			expected = append(expected, i)
+		case op < 8 && len(expected) > 0:
+			idx := r.Intn(len(expected))
+			v = v.Assoc(idx, -i)
+			expected = append([]interface{}{}, expected...)
+			expected[idx] = -i
+		case len(expected) > 0:
+			v = v.Pop()
+			expected = expected[:len(expected)-1]
+		}
+		if i%1000 == 0 {
+			versions[v] = append([]interface{}{}, expected...)
+		}
+	}
+	checkVector(t, "TestVectorRandom", expected, v)
+
+	for version, items := range versions {
+		checkVector(t, "TestVectorRandom old version", items, version)
+	}
+}
+
+func TestTransientVector(t *testing.T) {
+	base := FromSlice(fp.RangeInt(0, 2000))
+	tr := base.Transient()
+	for i := 0; i < 1000; i++ {
+		tr.Conj(2000 + i)
+	}
+	tr.Assoc(0, "first").Assoc(2500, "middle")
+	for i := 0; i < 1500; i++ {
+		tr.Pop()
+	}
+	if tr.Count() != 1500 || tr.Nth(0) != "first" || tr.Nth(1499) != 1499 || tr.Nth(1500, "none") != "none" {
+		t.Errorf("TestTransientVector failed. Unexpected state, count=%v", tr.Count())
+	}
+	v := tr.Persistent()
+
+	expected := fp.RangeInt(0, 1500)
+	var actual []int
+	v.Pop().Conj(1499).Assoc(0, 0).Into(&actual)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestTransientVector failed. Expected=%v, actual=%v", expected, actual)
+	}
+
+	if base.Count() != 2000 || base.Nth(0) != 0 {
+		t.Errorf("TestTransientVector failed. Expected original vector not to be modified")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestTransientVector failed. Expected panic when transient is used after Persistent")
+		}
+	}()
+	tr.Conj(1)
+}
+
+func TestVectorFp(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5})
+	square := func(item interface{}) interface{} { return item.(int) * item.(int) }
+	isEven := func(item interface{}) bool { return item.(int)%2 == 0 }
+	sum := func(acc, item interface{}) interface{} { return acc.(int) + item.(int) }
+
+	if actual := v.Map(square).Filter(isEven).Reduce(sum, 0); actual != 20 {
+		t.Errorf("TestVectorFp failed. Expected=20, actual=%v", actual)
+	}
+
+	var list []int
+	v.Into(&list)
+	if actual := fp.ReduceInt(func(a, b int) int { return a + b }, fp.FilterInt(func(n int) bool { return n%2 == 0 }, list)); actual != 6 {
+		t.Errorf("TestVectorFp failed. Expected=6, actual=%v", actual)
+	}
+
+	if v.Map(nil).Count() != 0 || v.Filter(nil).Count() != 0 || v.Reduce(nil, 7) != 7 {
+		t.Errorf("TestVectorFp failed. Expected empty result for nil function")
+	}
+
+	if last, ok := v.Peek(); !ok || last != 5 {
+		t.Errorf("TestVectorFp failed. Expected Peek=5, actual=%v", last)
+	}
+	if _, ok := Empty().Peek(); ok {
+		t.Errorf("TestVectorFp failed. Expected Peek on empty vector to return false")
+	}
+}
+
+func TestVectorConcurrentRead(t *testing.T) {
+	v := FromSlice(fp.RangeInt(0, 5000))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			local := v
+			for i := 0; i < 100; i++ {
+				local = local.Assoc(i, g).Conj(g)
+			}
+			if local.Nth(0) != g || v.Nth(0) != 0 {
+				t.Errorf("TestVectorConcurrentRead failed. Unexpected shared state")
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestVectorPanic(t *testing.T) {
+	cases := map[string]func(){
+		"Nth":       func() { New(1).Nth(1) },
+		"Assoc":     func() { New(1).Assoc(2, 1) },
+		"Pop":       func() { Empty().Pop() },
+		"FromSlice": func() { FromSlice(1) },
+		"Into":      func() { New(1).Into([]int{}) },
+	}
+	for name, f := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("TestVectorPanic failed. Expected %v to panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,361 @@
+package set
+
+// SortedFloat32 - sorted set of float32. Items are kept in a left-leaning red-black tree,
+// so GetList and Each return items in ascending order and range queries are O(log n)
+type SortedFloat32 struct {
+	root *sortedFloat32Node
+	size int
+}
+
+type sortedFloat32Node struct {
+	item        float32
+	left, right *sortedFloat32Node
+	red         bool
+}
+
+// NewSortedFloat32 creates sorted set
+func NewSortedFloat32(numList []float32) *SortedFloat32 {
+	s := &SortedFloat32{}
+	for _, num := range numList {
+		s.Add(num)
+	}
+	return s
+}
+
+// Add an item
+func (s *SortedFloat32) Add(num float32) *SortedFloat32 {
+	var added bool
+	s.root, added = s.root.insert(num)
+	s.root.red = false
+	if added {
+		s.size++
+	}
+	return s
+}
+
+// Clear set
+func (s *SortedFloat32) Clear() {
+	s.root = nil
+	s.size = 0
+}
+
+// Remove an item
+func (s *SortedFloat32) Remove(num float32) bool {
+	if !s.Contains(num) {
+		return false
+	}
+	if !s.root.left.isRed() && !s.root.right.isRed() {
+		s.root.red = true
+	}
+	s.root = s.root.delete(num)
+	if s.root != nil {
+		s.root.red = false
+	}
+	s.size--
+	return true
+}
+
+// Contains - Check if item exists in set
+func (s *SortedFloat32) Contains(num float32) bool {
+	h := s.root
+	for h != nil {
+		switch {
+		case num < h.item:
+			h = h.left
+		case h.item < num:
+			h = h.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// GetList - Get set items in ascending order
+func (s *SortedFloat32) GetList() []float32 {
+	numList := make([]float32, 0, s.size)
+	s.Each(func(num float32) bool {
+		numList = append(numList, num)
+		return true
+	})
+	return numList
+}
+
+// Size - Get size of set
+func (s *SortedFloat32) Size() int {
+	return s.size
+}
+
+// Each calls the function for every item in ascending order. Iteration stops when the function returns false
+func (s *SortedFloat32) Each(f func(float32) bool) {
+	var stack []*sortedFloat32Node
+	h := s.root
+	for h != nil || len(stack) > 0 {
+		for h != nil {
+			stack = append(stack, h)
+			h = h.left
+		}
+		h = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(h.item) {
+			return
+		}
+		h = h.right
+	}
+}
+
+// Min returns the smallest item and true. Returns false if the set is empty
+func (s *SortedFloat32) Min() (float32, bool) {
+	if s.root == nil {
+		return 0, false
+	}
+	h := s.root
+	for h.left != nil {
+		h = h.left
+	}
+	return h.item, true
+}
+
+// Max returns the largest item and true. Returns false if the set is empty
+func (s *SortedFloat32) Max() (float32, bool) {
+	if s.root == nil {
+		return 0, false
+	}
+	h := s.root
+	for h.right != nil {
+		h = h.right
+	}
+	return h.item, true
+}
+
+// Floor returns the largest item less than or equal to the argument and true. Returns false if there is no such item
+func (s *SortedFloat32) Floor(num float32) (float32, bool) {
+	var floor float32
+	found := false
+	h := s.root
+	for h != nil {
+		switch {
+		case num < h.item:
+			h = h.left
+		case h.item < num:
+			floor, found = h.item, true
+			h = h.right
+		default:
+			return h.item, true
+		}
+	}
+	return floor, found
+}
+
+// Ceiling returns the smallest item greater than or equal to the argument and true. Returns false if there is no such item
+func (s *SortedFloat32) Ceiling(num float32) (float32, bool) {
+	var ceiling float32
+	found := false
+	h := s.root
+	for h != nil {
+		switch {
+		case num < h.item:
+			ceiling, found = h.item, true
+			h = h.left
+		case h.item < num:
+			h = h.right
+		default:
+			return h.item, true
+		}
+	}
+	return ceiling, found
+}
+
+// Range returns items between lower and higher(both inclusive) in ascending order
+func (s *SortedFloat32) Range(lower, higher float32) []float32 {
+	numList := []float32{}
+	s.root.rangeItems(lower, higher, func(num float32) {
+		numList = append(numList, num)
+	})
+	return numList
+}
+
+// Union returns all the items that are in S or in S2
+func (s *SortedFloat32) Union(s2 *SortedFloat32) *SortedFloat32 {
+	s3 := NewSortedFloat32(s.GetList())
+	s2.Each(func(num float32) bool {
+		s3.Add(num)
+		return true
+	})
+	return s3
+}
+
+// Intersection - Common items in S and S2
+func (s *SortedFloat32) Intersection(s2 *SortedFloat32) *SortedFloat32 {
+	s3 := &SortedFloat32{}
+	s.Each(func(num float32) bool {
+		if s2.Contains(num) {
+			s3.Add(num)
+		}
+		return true
+	})
+	return s3
+}
+
+// Minus - s.Minus(s2) : all of S but not in S2
+func (s *SortedFloat32) Minus(s2 *SortedFloat32) *SortedFloat32 {
+	s3 := &SortedFloat32{}
+	s.Each(func(num float32) bool {
+		if !s2.Contains(num) {
+			s3.Add(num)
+		}
+		return true
+	})
+	return s3
+}
+
+// Subset checks if S is subset of S2
+func (s *SortedFloat32) Subset(s2 *SortedFloat32) bool {
+	if s.size > s2.size {
+		return false
+	}
+	subset := true
+	s.Each(func(num float32) bool {
+		subset = s2.Contains(num)
+		return subset
+	})
+	return subset
+}
+
+// Superset checks if S is superset of S2
+func (s *SortedFloat32) Superset(s2 *SortedFloat32) bool {
+	return s2.Subset(s)
+}
+
+func (h *sortedFloat32Node) isRed() bool {
+	return h != nil && h.red
+}
+
+func (h *sortedFloat32Node) rotateLeft() *sortedFloat32Node {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedFloat32Node) rotateRight() *sortedFloat32Node {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedFloat32Node) flipColors() {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func (h *sortedFloat32Node) balance() *sortedFloat32Node {
+	if h.right.isRed() && !h.left.isRed() {
+		h = h.rotateLeft()
+	}
+	if h.left.isRed() && h.left.left.isRed() {
+		h = h.rotateRight()
+	}
+	if h.left.isRed() && h.right.isRed() {
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedFloat32Node) insert(num float32) (*sortedFloat32Node, bool) {
+	if h == nil {
+		return &sortedFloat32Node{item: num, red: true}, true
+	}
+
+	var added bool
+	switch {
+	case num < h.item:
+		h.left, added = h.left.insert(num)
+	case h.item < num:
+		h.right, added = h.right.insert(num)
+	}
+	return h.balance(), added
+}
+
+func (h *sortedFloat32Node) moveRedLeft() *sortedFloat32Node {
+	h.flipColors()
+	if h.right.left.isRed() {
+		h.right = h.right.rotateRight()
+		h = h.rotateLeft()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedFloat32Node) moveRedRight() *sortedFloat32Node {
+	h.flipColors()
+	if h.left.left.isRed() {
+		h = h.rotateRight()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedFloat32Node) deleteMin() *sortedFloat32Node {
+	if h.left == nil {
+		return nil
+	}
+	if !h.left.isRed() && !h.left.left.isRed() {
+		h = h.moveRedLeft()
+	}
+	h.left = h.left.deleteMin()
+	return h.balance()
+}
+
+// delete removes the item which must exist in the tree
+func (h *sortedFloat32Node) delete(num float32) *sortedFloat32Node {
+	if num < h.item {
+		if !h.left.isRed() && !h.left.left.isRed() {
+			h = h.moveRedLeft()
+		}
+		h.left = h.left.delete(num)
+		return h.balance()
+	}
+
+	if h.left.isRed() {
+		h = h.rotateRight()
+	}
+	if !(h.item < num) && h.right == nil {
+		return nil
+	}
+	if !h.right.isRed() && !h.right.left.isRed() {
+		h = h.moveRedRight()
+	}
+	if !(h.item < num) {
+		min := h.right
+		for min.left != nil {
+			min = min.left
+		}
+		h.item = min.item
+		h.right = h.right.deleteMin()
+	} else {
+		h.right = h.right.delete(num)
+	}
+	return h.balance()
+}
+
+func (h *sortedFloat32Node) rangeItems(lower, higher float32, f func(float32)) {
+	if h == nil {
+		return
+	}
+	if lower < h.item {
+		h.left.rangeItems(lower, higher, f)
+	}
+	if !(h.item < lower) && !(higher < h.item) {
+		f(h.item)
+	}
+	if h.item < higher {
+		h.right.rangeItems(lower, higher, f)
+	}
+}
@@ -0,0 +1,116 @@
+package set
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortedFloat32(t *testing.T) {
+	mySet := NewSortedFloat32([]float32{30, 10, 50, 20, 10})
+	mySet.Add(40)
+	mySet.Add(40)
+
+	expected := []float32{10, 20, 30, 40, 50}
+	if !reflect.DeepEqual(expected, mySet.GetList()) || mySet.Size() != 5 {
+		t.Errorf("TestSortedFloat32 failed. Expected=%v, actual=%v", expected, mySet.GetList())
+	}
+
+	if !mySet.Contains(20) || mySet.Contains(25) {
+		t.Errorf("TestSortedFloat32 failed. Unexpected Contains result")
+	}
+
+	if !mySet.Remove(30) || mySet.Remove(30) || mySet.Size() != 4 {
+		t.Errorf("TestSortedFloat32 failed. Unexpected Remove result")
+	}
+
+	expected = []float32{10, 20, 40, 50}
+	if !reflect.DeepEqual(expected, mySet.GetList()) {
+		t.Errorf("TestSortedFloat32 failed. Expected=%v, actual=%v", expected, mySet.GetList())
+	}
+
+	mySet.Clear()
+	if mySet.Size() != 0 || len(mySet.GetList()) != 0 {
+		t.Errorf("TestSortedFloat32 failed. Expected empty set after Clear")
+	}
+}
+
+func TestSortedFloat32Query(t *testing.T) {
+	mySet := NewSortedFloat32([]float32{10, 20, 30, 40, 50})
+
+	if v, ok := mySet.Min(); !ok || v != 10 {
+		t.Errorf("TestSortedFloat32Query failed. Min expected=%v, actual=%v", 10, v)
+	}
+	if v, ok := mySet.Max(); !ok || v != 50 {
+		t.Errorf("TestSortedFloat32Query failed. Max expected=%v, actual=%v", 50, v)
+	}
+	if v, ok := mySet.Floor(25); !ok || v != 20 {
+		t.Errorf("TestSortedFloat32Query failed. Floor expected=%v, actual=%v", 20, v)
+	}
+	if v, ok := mySet.Floor(30); !ok || v != 30 {
+		t.Errorf("TestSortedFloat32Query failed. Floor expected=%v, actual=%v", 30, v)
+	}
+	if _, ok := mySet.Floor(5); ok {
+		t.Errorf("TestSortedFloat32Query failed. Expected no floor")
+	}
+	if v, ok := mySet.Ceiling(25); !ok || v != 30 {
+		t.Errorf("TestSortedFloat32Query failed. Ceiling expected=%v, actual=%v", 30, v)
+	}
+	if _, ok := mySet.Ceiling(55); ok {
+		t.Errorf("TestSortedFloat32Query failed. Expected no ceiling")
+	}
+
+	expected := []float32{20, 30, 40}
+	if actual := mySet.Range(20, 40); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedFloat32Query failed. Range expected=%v, actual=%v", expected, actual)
+	}
+	expected = []float32{30}
+	if actual := mySet.Range(25, 35); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedFloat32Query failed. Range expected=%v, actual=%v", expected, actual)
+	}
+	if actual := mySet.Range(55, 10); len(actual) != 0 {
+		t.Errorf("TestSortedFloat32Query failed. Expected empty range, actual=%v", actual)
+	}
+
+	var visited []float32
+	mySet.Each(func(v float32) bool {
+		visited = append(visited, v)
+		return v != 20
+	})
+	if !reflect.DeepEqual([]float32{10, 20}, visited) {
+		t.Errorf("TestSortedFloat32Query failed. Expected Each to stop, actual=%v", visited)
+	}
+
+	empty := NewSortedFloat32(nil)
+	if _, ok := empty.Min(); ok {
+		t.Errorf("TestSortedFloat32Query failed. Expected no min in empty set")
+	}
+	if _, ok := empty.Max(); ok {
+		t.Errorf("TestSortedFloat32Query failed. Expected no max in empty set")
+	}
+}
+
+func TestSortedFloat32Operations(t *testing.T) {
+	s1 := NewSortedFloat32([]float32{10, 20, 30, 40})
+	s2 := NewSortedFloat32([]float32{30, 40, 50})
+
+	expected := []float32{10, 20, 30, 40, 50}
+	if actual := s1.Union(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedFloat32Operations failed. Union expected=%v, actual=%v", expected, actual)
+	}
+	expected = []float32{30, 40}
+	if actual := s1.Intersection(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedFloat32Operations failed. Intersection expected=%v, actual=%v", expected, actual)
+	}
+	expected = []float32{10, 20}
+	if actual := s1.Minus(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedFloat32Operations failed. Minus expected=%v, actual=%v", expected, actual)
+	}
+
+	s3 := NewSortedFloat32([]float32{30, 40})
+	if !s3.Subset(s1) || !s1.Superset(s3) || s2.Subset(s1) || s3.Superset(s1) {
+		t.Errorf("TestSortedFloat32Operations failed. Unexpected Subset/Superset result")
+	}
+	if s1.Size() != 4 || s2.Size() != 3 {
+		t.Errorf("TestSortedFloat32Operations failed. Expected sets not to be modified")
+	}
+}
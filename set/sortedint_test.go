@@ -0,0 +1,169 @@
+package set
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortedInt(t *testing.T) {
+	mySet := NewSortedInt([]int{30, 10, 50, 20, 10})
+	mySet.Add(40)
+	mySet.Add(40)
+
+	expected := []int{10, 20, 30, 40, 50}
+	if !reflect.DeepEqual(expected, mySet.GetList()) || mySet.Size() != 5 {
+		t.Errorf("TestSortedInt failed. Expected=%v, actual=%v", expected, mySet.GetList())
+	}
+
+	if !mySet.Contains(20) || mySet.Contains(25) {
+		t.Errorf("TestSortedInt failed. Unexpected Contains result")
+	}
+
+	if !mySet.Remove(30) || mySet.Remove(30) || mySet.Size() != 4 {
+		t.Errorf("TestSortedInt failed. Unexpected Remove result")
+	}
+
+	expected = []int{10, 20, 40, 50}
+	if !reflect.DeepEqual(expected, mySet.GetList()) {
+		t.Errorf("TestSortedInt failed. Expected=%v, actual=%v", expected, mySet.GetList())
+	}
+
+	mySet.Clear()
+	if mySet.Size() != 0 || len(mySet.GetList()) != 0 {
+		t.Errorf("TestSortedInt failed. Expected empty set after Clear")
+	}
+}
+
+func TestSortedIntQuery(t *testing.T) {
+	mySet := NewSortedInt([]int{10, 20, 30, 40, 50})
+
+	if v, ok := mySet.Min(); !ok || v != 10 {
+		t.Errorf("TestSortedIntQuery failed. Min expected=%v, actual=%v", 10, v)
+	}
+	if v, ok := mySet.Max(); !ok || v != 50 {
+		t.Errorf("TestSortedIntQuery failed. Max expected=%v, actual=%v", 50, v)
+	}
+	if v, ok := mySet.Floor(25); !ok || v != 20 {
+		t.Errorf("TestSortedIntQuery failed. Floor expected=%v, actual=%v", 20, v)
+	}
+	if v, ok := mySet.Floor(30); !ok || v != 30 {
+		t.Errorf("TestSortedIntQuery failed. Floor expected=%v, actual=%v", 30, v)
+	}
+	if _, ok := mySet.Floor(5); ok {
+		t.Errorf("TestSortedIntQuery failed. Expected no floor")
+	}
+	if v, ok := mySet.Ceiling(25); !ok || v != 30 {
+		t.Errorf("TestSortedIntQuery failed. Ceiling expected=%v, actual=%v", 30, v)
+	}
+	if _, ok := mySet.Ceiling(55); ok {
+		t.Errorf("TestSortedIntQuery failed. Expected no ceiling")
+	}
+
+	expected := []int{20, 30, 40}
+	if actual := mySet.Range(20, 40); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedIntQuery failed. Range expected=%v, actual=%v", expected, actual)
+	}
+	expected = []int{30}
+	if actual := mySet.Range(25, 35); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedIntQuery failed. Range expected=%v, actual=%v", expected, actual)
+	}
+	if actual := mySet.Range(55, 10); len(actual) != 0 {
+		t.Errorf("TestSortedIntQuery failed. Expected empty range, actual=%v", actual)
+	}
+
+	var visited []int
+	mySet.Each(func(v int) bool {
+		visited = append(visited, v)
+		return v != 20
+	})
+	if !reflect.DeepEqual([]int{10, 20}, visited) {
+		t.Errorf("TestSortedIntQuery failed. Expected Each to stop, actual=%v", visited)
+	}
+
+	empty := NewSortedInt(nil)
+	if _, ok := empty.Min(); ok {
+		t.Errorf("TestSortedIntQuery failed. Expected no min in empty set")
+	}
+	if _, ok := empty.Max(); ok {
+		t.Errorf("TestSortedIntQuery failed. Expected no max in empty set")
+	}
+}
+
+func TestSortedIntOperations(t *testing.T) {
+	s1 := NewSortedInt([]int{10, 20, 30, 40})
+	s2 := NewSortedInt([]int{30, 40, 50})
+
+	expected := []int{10, 20, 30, 40, 50}
+	if actual := s1.Union(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedIntOperations failed. Union expected=%v, actual=%v", expected, actual)
+	}
+	expected = []int{30, 40}
+	if actual := s1.Intersection(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedIntOperations failed. Intersection expected=%v, actual=%v", expected, actual)
+	}
+	expected = []int{10, 20}
+	if actual := s1.Minus(s2).GetList(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedIntOperations failed. Minus expected=%v, actual=%v", expected, actual)
+	}
+
+	s3 := NewSortedInt([]int{30, 40})
+	if !s3.Subset(s1) || !s1.Superset(s3) || s2.Subset(s1) || s3.Superset(s1) {
+		t.Errorf("TestSortedIntOperations failed. Unexpected Subset/Superset result")
+	}
+	if s1.Size() != 4 || s2.Size() != 3 {
+		t.Errorf("TestSortedIntOperations failed. Expected sets not to be modified")
+	}
+}
+
+func TestSortedIntRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	mySet := NewSortedInt(nil)
+	expected := make(map[int]bool)
+
+	for i := 0; i < 20000; i++ {
+		num := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			if mySet.Remove(num) != expected[num] {
+				t.Fatalf("TestSortedIntRandom failed. Unexpected Remove result for %v", num)
+			}
+			delete(expected, num)
+		} else {
+			mySet.Add(num)
+			expected[num] = true
+		}
+	}
+
+	var expectedList []int
+	for num := range expected {
+		expectedList = append(expectedList, num)
+	}
+	sort.Ints(expectedList)
+	if !reflect.DeepEqual(expectedList, mySet.GetList()) || mySet.Size() != len(expectedList) {
+		t.Fatalf("TestSortedIntRandom failed. GetList doesn't match")
+	}
+
+	if mySet.root.isRed() || mySet.root.blackHeight() < 0 {
+		t.Errorf("TestSortedIntRandom failed. Tree is not balanced")
+	}
+}
+
+// blackHeight returns number of black nodes on every path, -1 if paths have different number of black nodes
+// or a red node has a red child
+func (h *sortedIntNode) blackHeight() int {
+	if h == nil {
+		return 0
+	}
+	if h.red && (h.left.isRed() || h.right.isRed()) {
+		return -1
+	}
+	left, right := h.left.blackHeight(), h.right.blackHeight()
+	if left < 0 || left != right {
+		return -1
+	}
+	if h.red {
+		return left
+	}
+	return left + 1
+}
@@ -0,0 +1,380 @@
+package set
+
+import "fmt"
+
+// Entry - key and value of SortedMap
+type Entry struct {
+	Key interface{}
+	Val interface{}
+}
+
+// SortedMap - map sorted by keys. Entries are kept in a left-leaning red-black tree,
+// so Entries and Each return entries in ascending order of keys and range queries are O(log n).
+// The zero value is an empty map of built-in type keys
+type SortedMap struct {
+	root *sortedMapNode
+	size int
+	less func(a, b interface{}) bool
+}
+
+type sortedMapNode struct {
+	key, val    interface{}
+	left, right *sortedMapNode
+	red         bool
+}
+
+// NewSortedMap creates sorted map. less compares the keys.
+// If less is nil, keys must be of the same built-in type: int.., uint.., float.. or string
+func NewSortedMap(less func(a, b interface{}) bool) *SortedMap {
+	return &SortedMap{less: less}
+}
+
+func (m *SortedMap) lessKey(a, b interface{}) bool {
+	if m.less == nil {
+		return lessBasic(a, b)
+	}
+	return m.less(a, b)
+}
+
+// lessBasic compares keys of the same built-in type. Panics for other types
+func lessBasic(a, b interface{}) bool {
+	switch k := a.(type) {
+	case int:
+		return k < b.(int)
+	case int64:
+		return k < b.(int64)
+	case int32:
+		return k < b.(int32)
+	case int16:
+		return k < b.(int16)
+	case int8:
+		return k < b.(int8)
+	case uint:
+		return k < b.(uint)
+	case uint64:
+		return k < b.(uint64)
+	case uint32:
+		return k < b.(uint32)
+	case uint16:
+		return k < b.(uint16)
+	case uint8:
+		return k < b.(uint8)
+	case float64:
+		return k < b.(float64)
+	case float32:
+		return k < b.(float32)
+	case string:
+		return k < b.(string)
+	}
+	panic(fmt.Sprintf("set.SortedMap: can't compare key of type %T, pass less function to NewSortedMap", a))
+}
+
+// Put sets the value of the key
+func (m *SortedMap) Put(key, val interface{}) *SortedMap {
+	var added bool
+	m.root, added = m.insert(m.root, key, val)
+	m.root.red = false
+	if added {
+		m.size++
+	}
+	return m
+}
+
+// Get returns the value of the key and true. Returns nil and false if the key doesn't exist
+func (m *SortedMap) Get(key interface{}) (interface{}, bool) {
+	h := m.root
+	for h != nil {
+		switch {
+		case m.lessKey(key, h.key):
+			h = h.left
+		case m.lessKey(h.key, key):
+			h = h.right
+		default:
+			return h.val, true
+		}
+	}
+	return nil, false
+}
+
+// Contains - Check if key exists in map
+func (m *SortedMap) Contains(key interface{}) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Remove the key. Returns false if the key doesn't exist
+func (m *SortedMap) Remove(key interface{}) bool {
+	if !m.Contains(key) {
+		return false
+	}
+	if !m.root.left.isRed() && !m.root.right.isRed() {
+		m.root.red = true
+	}
+	m.root = m.delete(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+	return true
+}
+
+// Clear map
+func (m *SortedMap) Clear() {
+	m.root = nil
+	m.size = 0
+}
+
+// Size - Get number of entries
+func (m *SortedMap) Size() int {
+	return m.size
+}
+
+// Keys returns keys in ascending order
+func (m *SortedMap) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.size)
+	m.Each(func(key, _ interface{}) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Entries returns entries in ascending order of keys
+func (m *SortedMap) Entries() []Entry {
+	entries := make([]Entry, 0, m.size)
+	m.Each(func(key, val interface{}) bool {
+		entries = append(entries, Entry{Key: key, Val: val})
+		return true
+	})
+	return entries
+}
+
+// Each calls the function for every entry in ascending order of keys. Iteration stops when the function returns false
+func (m *SortedMap) Each(f func(key, val interface{}) bool) {
+	var stack []*sortedMapNode
+	h := m.root
+	for h != nil || len(stack) > 0 {
+		for h != nil {
+			stack = append(stack, h)
+			h = h.left
+		}
+		h = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(h.key, h.val) {
+			return
+		}
+		h = h.right
+	}
+}
+
+// Min returns the entry with the smallest key and true. Returns false if the map is empty
+func (m *SortedMap) Min() (Entry, bool) {
+	if m.root == nil {
+		return Entry{}, false
+	}
+	h := m.root
+	for h.left != nil {
+		h = h.left
+	}
+	return Entry{Key: h.key, Val: h.val}, true
+}
+
+// Max returns the entry with the largest key and true. Returns false if the map is empty
+func (m *SortedMap) Max() (Entry, bool) {
+	if m.root == nil {
+		return Entry{}, false
+	}
+	h := m.root
+	for h.right != nil {
+		h = h.right
+	}
+	return Entry{Key: h.key, Val: h.val}, true
+}
+
+// Floor returns the entry with the largest key less than or equal to the argument and true.
+// Returns false if there is no such entry
+func (m *SortedMap) Floor(key interface{}) (Entry, bool) {
+	var floor *sortedMapNode
+	h := m.root
+	for h != nil {
+		switch {
+		case m.lessKey(key, h.key):
+			h = h.left
+		case m.lessKey(h.key, key):
+			floor = h
+			h = h.right
+		default:
+			return Entry{Key: h.key, Val: h.val}, true
+		}
+	}
+	if floor == nil {
+		return Entry{}, false
+	}
+	return Entry{Key: floor.key, Val: floor.val}, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the argument and true.
+// Returns false if there is no such entry
+func (m *SortedMap) Ceiling(key interface{}) (Entry, bool) {
+	var ceiling *sortedMapNode
+	h := m.root
+	for h != nil {
+		switch {
+		case m.lessKey(key, h.key):
+			ceiling = h
+			h = h.left
+		case m.lessKey(h.key, key):
+			h = h.right
+		default:
+			return Entry{Key: h.key, Val: h.val}, true
+		}
+	}
+	if ceiling == nil {
+		return Entry{}, false
+	}
+	return Entry{Key: ceiling.key, Val: ceiling.val}, true
+}
+
+// Range returns entries with keys between lower and higher(both inclusive) in ascending order of keys
+func (m *SortedMap) Range(lower, higher interface{}) []Entry {
+	entries := []Entry{}
+	m.rangeEntries(m.root, lower, higher, func(h *sortedMapNode) {
+		entries = append(entries, Entry{Key: h.key, Val: h.val})
+	})
+	return entries
+}
+
+func (m *SortedMap) rangeEntries(h *sortedMapNode, lower, higher interface{}, f func(*sortedMapNode)) {
+	if h == nil {
+		return
+	}
+	if m.lessKey(lower, h.key) {
+		m.rangeEntries(h.left, lower, higher, f)
+	}
+	if !m.lessKey(h.key, lower) && !m.lessKey(higher, h.key) {
+		f(h)
+	}
+	if m.lessKey(h.key, higher) {
+		m.rangeEntries(h.right, lower, higher, f)
+	}
+}
+
+func (h *sortedMapNode) isRed() bool {
+	return h != nil && h.red
+}
+
+func (h *sortedMapNode) rotateLeft() *sortedMapNode {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedMapNode) rotateRight() *sortedMapNode {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedMapNode) flipColors() {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func (h *sortedMapNode) balance() *sortedMapNode {
+	if h.right.isRed() && !h.left.isRed() {
+		h = h.rotateLeft()
+	}
+	if h.left.isRed() && h.left.left.isRed() {
+		h = h.rotateRight()
+	}
+	if h.left.isRed() && h.right.isRed() {
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedMapNode) moveRedLeft() *sortedMapNode {
+	h.flipColors()
+	if h.right.left.isRed() {
+		h.right = h.right.rotateRight()
+		h = h.rotateLeft()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedMapNode) moveRedRight() *sortedMapNode {
+	h.flipColors()
+	if h.left.left.isRed() {
+		h = h.rotateRight()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedMapNode) deleteMin() *sortedMapNode {
+	if h.left == nil {
+		return nil
+	}
+	if !h.left.isRed() && !h.left.left.isRed() {
+		h = h.moveRedLeft()
+	}
+	h.left = h.left.deleteMin()
+	return h.balance()
+}
+
+func (m *SortedMap) insert(h *sortedMapNode, key, val interface{}) (*sortedMapNode, bool) {
+	if h == nil {
+		return &sortedMapNode{key: key, val: val, red: true}, true
+	}
+
+	var added bool
+	switch {
+	case m.lessKey(key, h.key):
+		h.left, added = m.insert(h.left, key, val)
+	case m.lessKey(h.key, key):
+		h.right, added = m.insert(h.right, key, val)
+	default:
+		h.val = val
+	}
+	return h.balance(), added
+}
+
+// delete removes the key which must exist in the tree
+func (m *SortedMap) delete(h *sortedMapNode, key interface{}) *sortedMapNode {
+	if m.lessKey(key, h.key) {
+		if !h.left.isRed() && !h.left.left.isRed() {
+			h = h.moveRedLeft()
+		}
+		h.left = m.delete(h.left, key)
+		return h.balance()
+	}
+
+	if h.left.isRed() {
+		h = h.rotateRight()
+	}
+	if !m.lessKey(h.key, key) && h.right == nil {
+		return nil
+	}
+	if !h.right.isRed() && !h.right.left.isRed() {
+		h = h.moveRedRight()
+	}
+	if !m.lessKey(h.key, key) {
+		min := h.right
+		for min.left != nil {
+			min = min.left
+		}
+		h.key, h.val = min.key, min.val
+		h.right = h.right.deleteMin()
+	} else {
+		h.right = m.delete(h.right, key)
+	}
+	return h.balance()
+}
@@ -0,0 +1,131 @@
+package set
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSortedMap(t *testing.T) {
+	m := NewSortedMap(nil)
+	m.Put(30, "c").Put(10, "a").Put(50, "e").Put(20, "b").Put(40, "d").Put(10, "A")
+
+	expected := []Entry{{10, "A"}, {20, "b"}, {30, "c"}, {40, "d"}, {50, "e"}}
+	if !reflect.DeepEqual(expected, m.Entries()) || m.Size() != 5 {
+		t.Errorf("TestSortedMap failed. Expected=%v, actual=%v", expected, m.Entries())
+	}
+
+	if v, ok := m.Get(20); !ok || v != "b" {
+		t.Errorf("TestSortedMap failed. Expected=b, actual=%v", v)
+	}
+	if m.Contains(25) || !m.Contains(50) {
+		t.Errorf("TestSortedMap failed. Unexpected Contains result")
+	}
+
+	if !m.Remove(30) || m.Remove(30) || m.Size() != 4 {
+		t.Errorf("TestSortedMap failed. Unexpected Remove result")
+	}
+	if !reflect.DeepEqual([]interface{}{10, 20, 40, 50}, m.Keys()) {
+		t.Errorf("TestSortedMap failed. Expected=[10 20 40 50], actual=%v", m.Keys())
+	}
+
+	m.Clear()
+	if m.Size() != 0 || len(m.Entries()) != 0 {
+		t.Errorf("TestSortedMap failed. Expected empty map after Clear")
+	}
+}
+
+func TestSortedMapQuery(t *testing.T) {
+	var m SortedMap
+	for _, k := range []string{"b", "d", "a", "c"} {
+		m.Put(k, strings.ToUpper(k))
+	}
+
+	if e, ok := m.Min(); !ok || e.Key != "a" || e.Val != "A" {
+		t.Errorf("TestSortedMapQuery failed. Min expected=a, actual=%v", e)
+	}
+	if e, ok := m.Max(); !ok || e.Key != "d" {
+		t.Errorf("TestSortedMapQuery failed. Max expected=d, actual=%v", e)
+	}
+	if e, ok := m.Floor("bb"); !ok || e.Key != "b" {
+		t.Errorf("TestSortedMapQuery failed. Floor expected=b, actual=%v", e)
+	}
+	if e, ok := m.Ceiling("bb"); !ok || e.Key != "c" {
+		t.Errorf("TestSortedMapQuery failed. Ceiling expected=c, actual=%v", e)
+	}
+	if _, ok := m.Floor("0"); ok {
+		t.Errorf("TestSortedMapQuery failed. Expected no floor")
+	}
+	if _, ok := m.Ceiling("z"); ok {
+		t.Errorf("TestSortedMapQuery failed. Expected no ceiling")
+	}
+
+	expected := []Entry{{"b", "B"}, {"c", "C"}}
+	if actual := m.Range("ab", "c"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("TestSortedMapQuery failed. Range expected=%v, actual=%v", expected, actual)
+	}
+
+	var empty SortedMap
+	if _, ok := empty.Min(); ok {
+		t.Errorf("TestSortedMapQuery failed. Expected no min in empty map")
+	}
+}
+
+func TestSortedMapLess(t *testing.T) {
+	type version struct {
+		major, minor int
+	}
+	less := func(a, b interface{}) bool {
+		v1, v2 := a.(version), b.(version)
+		return v1.major < v2.major || (v1.major == v2.major && v1.minor < v2.minor)
+	}
+
+	m := NewSortedMap(less)
+	m.Put(version{1, 10}, "1.10").Put(version{1, 2}, "1.2").Put(version{0, 9}, "0.9")
+
+	if e, _ := m.Floor(version{1, 5}); e.Val != "1.2" {
+		t.Errorf("TestSortedMapLess failed. Expected=1.2, actual=%v", e.Val)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TestSortedMapLess failed. Expected panic for key without less function")
+		}
+	}()
+	NewSortedMap(nil).Put(version{1, 0}, "").Put(version{2, 0}, "")
+}
+
+func TestSortedMapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewSortedMap(nil)
+	expected := make(map[int]int)
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			m.Remove(key)
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+
+	var keys []int
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	entries := m.Entries()
+	if len(entries) != len(keys) || m.Size() != len(keys) {
+		t.Fatalf("TestSortedMapRandom failed. Expected size=%v, actual=%v", len(keys), len(entries))
+	}
+	for i, k := range keys {
+		if entries[i].Key != k || entries[i].Val != expected[k] {
+			t.Fatalf("TestSortedMapRandom failed. Expected %v=%v, actual=%v", k, expected[k], entries[i])
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package set
+
+// SortedStr - sorted set of string. Items are kept in a left-leaning red-black tree,
+// so GetList and Each return items in ascending order and range queries are O(log n)
+type SortedStr struct {
+	root *sortedStrNode
+	size int
+}
+
+type sortedStrNode struct {
+	item        string
+	left, right *sortedStrNode
+	red         bool
+}
+
+// NewSortedStr creates sorted set
+func NewSortedStr(strList []string) *SortedStr {
+	s := &SortedStr{}
+	for _, str := range strList {
+		s.Add(str)
+	}
+	return s
+}
+
+// Add an item
+func (s *SortedStr) Add(str string) *SortedStr {
+	var added bool
+	s.root, added = s.root.insert(str)
+	s.root.red = false
+	if added {
+		s.size++
+	}
+	return s
+}
+
+// Clear set
+func (s *SortedStr) Clear() {
+	s.root = nil
+	s.size = 0
+}
+
+// Remove an item
+func (s *SortedStr) Remove(str string) bool {
+	if !s.Contains(str) {
+		return false
+	}
+	if !s.root.left.isRed() && !s.root.right.isRed() {
+		s.root.red = true
+	}
+	s.root = s.root.delete(str)
+	if s.root != nil {
+		s.root.red = false
+	}
+	s.size--
+	return true
+}
+
+// Contains - Check if item exists in set
+func (s *SortedStr) Contains(str string) bool {
+	h := s.root
+	for h != nil {
+		switch {
+		case str < h.item:
+			h = h.left
+		case h.item < str:
+			h = h.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// GetList - Get set items in ascending order
+func (s *SortedStr) GetList() []string {
+	strList := make([]string, 0, s.size)
+	s.Each(func(str string) bool {
+		strList = append(strList, str)
+		return true
+	})
+	return strList
+}
+
+// Size - Get size of set
+func (s *SortedStr) Size() int {
+	return s.size
+}
+
+// Each calls the function for every item in ascending order. Iteration stops when the function returns false
+func (s *SortedStr) Each(f func(string) bool) {
+	var stack []*sortedStrNode
+	h := s.root
+	for h != nil || len(stack) > 0 {
+		for h != nil {
+			stack = append(stack, h)
+			h = h.left
+		}
+		h = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(h.item) {
+			return
+		}
+		h = h.right
+	}
+}
+
+// Min returns the smallest item and true. Returns false if the set is empty
+func (s *SortedStr) Min() (string, bool) {
+	if s.root == nil {
+		return "", false
+	}
+	h := s.root
+	for h.left != nil {
+		h = h.left
+	}
+	return h.item, true
+}
+
+// Max returns the largest item and true. Returns false if the set is empty
+func (s *SortedStr) Max() (string, bool) {
+	if s.root == nil {
+		return "", false
+	}
+	h := s.root
+	for h.right != nil {
+		h = h.right
+	}
+	return h.item, true
+}
+
+// Floor returns the largest item less than or equal to the argument and true. Returns false if there is no such item
+func (s *SortedStr) Floor(str string) (string, bool) {
+	var floor string
+	found := false
+	h := s.root
+	for h != nil {
+		switch {
+		case str < h.item:
+			h = h.left
+		case h.item < str:
+			floor, found = h.item, true
+			h = h.right
+		default:
+			return h.item, true
+		}
+	}
+	return floor, found
+}
+
+// Ceiling returns the smallest item greater than or equal to the argument and true. Returns false if there is no such item
+func (s *SortedStr) Ceiling(str string) (string, bool) {
+	var ceiling string
+	found := false
+	h := s.root
+	for h != nil {
+		switch {
+		case str < h.item:
+			ceiling, found = h.item, true
+			h = h.left
+		case h.item < str:
+			h = h.right
+		default:
+			return h.item, true
+		}
+	}
+	return ceiling, found
+}
+
+// Range returns items between lower and higher(both inclusive) in ascending order
+func (s *SortedStr) Range(lower, higher string) []string {
+	strList := []string{}
+	s.root.rangeItems(lower, higher, func(str string) {
+		strList = append(strList, str)
+	})
+	return strList
+}
+
+// Union returns all the items that are in S or in S2
+func (s *SortedStr) Union(s2 *SortedStr) *SortedStr {
+	s3 := NewSortedStr(s.GetList())
+	s2.Each(func(str string) bool {
+		s3.Add(str)
+		return true
+	})
+	return s3
+}
+
+// Intersection - Common items in S and S2
+func (s *SortedStr) Intersection(s2 *SortedStr) *SortedStr {
+	s3 := &SortedStr{}
+	s.Each(func(str string) bool {
+		if s2.Contains(str) {
+			s3.Add(str)
+		}
+		return true
+	})
+	return s3
+}
+
+// Minus - s.Minus(s2) : all of S but not in S2
+func (s *SortedStr) Minus(s2 *SortedStr) *SortedStr {
+	s3 := &SortedStr{}
+	s.Each(func(str string) bool {
+		if !s2.Contains(str) {
+			s3.Add(str)
+		}
+		return true
+	})
+	return s3
+}
+
+// Subset checks if S is subset of S2
+func (s *SortedStr) Subset(s2 *SortedStr) bool {
+	if s.size > s2.size {
+		return false
+	}
+	subset := true
+	s.Each(func(str string) bool {
+		subset = s2.Contains(str)
+		return subset
+	})
+	return subset
+}
+
+// Superset checks if S is superset of S2
+func (s *SortedStr) Superset(s2 *SortedStr) bool {
+	return s2.Subset(s)
+}
+
+func (h *sortedStrNode) isRed() bool {
+	return h != nil && h.red
+}
+
+func (h *sortedStrNode) rotateLeft() *sortedStrNode {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedStrNode) rotateRight() *sortedStrNode {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+func (h *sortedStrNode) flipColors() {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+func (h *sortedStrNode) balance() *sortedStrNode {
+	if h.right.isRed() && !h.left.isRed() {
+		h = h.rotateLeft()
+	}
+	if h.left.isRed() && h.left.left.isRed() {
+		h = h.rotateRight()
+	}
+	if h.left.isRed() && h.right.isRed() {
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedStrNode) insert(str string) (*sortedStrNode, bool) {
+	if h == nil {
+		return &sortedStrNode{item: str, red: true}, true
+	}
+
+	var added bool
+	switch {
+	case str < h.item:
+		h.left, added = h.left.insert(str)
+	case h.item < str:
+		h.right, added = h.right.insert(str)
+	}
+	return h.balance(), added
+}
+
+func (h *sortedStrNode) moveRedLeft() *sortedStrNode {
+	h.flipColors()
+	if h.right.left.isRed() {
+		h.right = h.right.rotateRight()
+		h = h.rotateLeft()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedStrNode) moveRedRight() *sortedStrNode {
+	h.flipColors()
+	if h.left.left.isRed() {
+		h = h.rotateRight()
+		h.flipColors()
+	}
+	return h
+}
+
+func (h *sortedStrNode) deleteMin() *sortedStrNode {
+	if h.left == nil {
+		return nil
+	}
+	if !h.left.isRed() && !h.left.left.isRed() {
+		h = h.moveRedLeft()
+	}
+	h.left = h.left.deleteMin()
+	return h.balance()
+}
+
+// delete removes the item which must exist in the tree
+func (h *sortedStrNode) delete(str string) *sortedStrNode {
+	if str < h.item {
+		if !h.left.isRed() && !h.left.left.isRed() {
+			h = h.moveRedLeft()
+		}
+		h.left = h.left.delete(str)
+		return h.balance()
+	}
+
+	if h.left.isRed() {
+		h = h.rotateRight()
+	}
+	if !(h.item < str) && h.right == nil {
+		return nil
+	}
+	if !h.right.isRed() && !h.right.left.isRed() {
+		h = h.moveRedRight()
+	}
+	if !(h.item < str) {
+		min := h.right
+		for min.left != nil {
+			min = min.left
+		}
+		h.item = min.item
+		h.right = h.right.deleteMin()
+	} else {
+		h.right = h.right.delete(str)
+	}
+	return h.balance()
+}
+
+func (h *sortedStrNode) rangeItems(lower, higher string, f func(string)) {
+	if h == nil {
+		return
+	}
+	if lower < h.item {
+		h.left.rangeItems(lower, higher, f)
+	}
+	if !(h.item < lower) && !(higher < h.item) {
+		f(h.item)
+	}
+	if h.item < higher {
+		h.right.rangeItems(lower, higher, f)
+	}
+}